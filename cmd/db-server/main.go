@@ -42,7 +42,9 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/rpcserver"
 	"github.com/matrixorigin/matrixone/pkg/sql/compile"
+	"github.com/matrixorigin/matrixone/pkg/sql/foreignkey"
 	"github.com/matrixorigin/matrixone/pkg/sql/handler"
+	"github.com/matrixorigin/matrixone/pkg/sql/viewexec/mview"
 	"github.com/matrixorigin/matrixone/pkg/vm/driver"
 	aoeDriver "github.com/matrixorigin/matrixone/pkg/vm/driver/aoe"
 	dConfig "github.com/matrixorigin/matrixone/pkg/vm/driver/config"
@@ -326,6 +328,8 @@ func initTae() *taeHandler {
 	}
 
 	eng := moengine.NewEngine(tae)
+	eng.SetViewMaintainer(mview.Maintain)
+	eng.SetConstraintChecker(foreignkey.Check)
 
 	//test storage aoe_storage
 	config.StorageEngine = eng
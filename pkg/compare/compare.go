@@ -25,11 +25,14 @@ import (
 	aint32s "github.com/matrixorigin/matrixone/pkg/compare/asc/int32s"
 	aint64s "github.com/matrixorigin/matrixone/pkg/compare/asc/int64s"
 	aint8s "github.com/matrixorigin/matrixone/pkg/compare/asc/int8s"
+	atimes "github.com/matrixorigin/matrixone/pkg/compare/asc/times"
 	auint16s "github.com/matrixorigin/matrixone/pkg/compare/asc/uint16s"
 	auint32s "github.com/matrixorigin/matrixone/pkg/compare/asc/uint32s"
 	auint64s "github.com/matrixorigin/matrixone/pkg/compare/asc/uint64s"
 	auint8s "github.com/matrixorigin/matrixone/pkg/compare/asc/uint8s"
+	auuids "github.com/matrixorigin/matrixone/pkg/compare/asc/uuids"
 	avarchar "github.com/matrixorigin/matrixone/pkg/compare/asc/varchar"
+	ayears "github.com/matrixorigin/matrixone/pkg/compare/asc/years"
 	ddates "github.com/matrixorigin/matrixone/pkg/compare/desc/dates"
 	ddatetimes "github.com/matrixorigin/matrixone/pkg/compare/desc/datetimes"
 	ddecimal128s "github.com/matrixorigin/matrixone/pkg/compare/desc/decimal128s"
@@ -40,11 +43,14 @@ import (
 	dint32s "github.com/matrixorigin/matrixone/pkg/compare/desc/int32s"
 	dint64s "github.com/matrixorigin/matrixone/pkg/compare/desc/int64s"
 	dint8s "github.com/matrixorigin/matrixone/pkg/compare/desc/int8s"
+	dtimes "github.com/matrixorigin/matrixone/pkg/compare/desc/times"
 	duint16s "github.com/matrixorigin/matrixone/pkg/compare/desc/uint16s"
 	duint32s "github.com/matrixorigin/matrixone/pkg/compare/desc/uint32s"
 	duint64s "github.com/matrixorigin/matrixone/pkg/compare/desc/uint64s"
 	duint8s "github.com/matrixorigin/matrixone/pkg/compare/desc/uint8s"
+	duuids "github.com/matrixorigin/matrixone/pkg/compare/desc/uuids"
 	dvarchar "github.com/matrixorigin/matrixone/pkg/compare/desc/varchar"
+	dyears "github.com/matrixorigin/matrixone/pkg/compare/desc/years"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 )
 
@@ -75,7 +81,7 @@ func New(typ types.T, desc bool) Compare {
 			return duint8s.New()
 		}
 		return auint8s.New()
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		if desc {
 			return duint16s.New()
 		}
@@ -85,7 +91,7 @@ func New(typ types.T, desc bool) Compare {
 			return duint32s.New()
 		}
 		return auint32s.New()
-	case types.T_uint64:
+	case types.T_uint64, types.T_set:
 		if desc {
 			return duint64s.New()
 		}
@@ -100,7 +106,7 @@ func New(typ types.T, desc bool) Compare {
 			return dfloat64s.New()
 		}
 		return afloat64s.New()
-	case types.T_char, types.T_json, types.T_varchar:
+	case types.T_char, types.T_json, types.T_varchar, types.T_text, types.T_blob:
 		if desc {
 			return dvarchar.New()
 		}
@@ -115,6 +121,16 @@ func New(typ types.T, desc bool) Compare {
 			return ddatetimes.New()
 		}
 		return adatetimes.New()
+	case types.T_time:
+		if desc {
+			return dtimes.New()
+		}
+		return atimes.New()
+	case types.T_year:
+		if desc {
+			return dyears.New()
+		}
+		return ayears.New()
 	case types.T_decimal64:
 		if desc {
 			return ddecimal64s.New()
@@ -125,6 +141,11 @@ func New(typ types.T, desc bool) Compare {
 			return ddecimal128s.New()
 		}
 		return adecimal128s.New()
+	case types.T_uuid:
+		if desc {
+			return duuids.New()
+		}
+		return auuids.New()
 	}
 	return nil
 }
@@ -0,0 +1,49 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package uuids
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew(t *testing.T) {
+	require.Equal(t, &compare{xs: make([][]types.Uuid, 2),
+		ns: make([]*nulls.Nulls, 2),
+		vs: make([]*vector.Vector, 2)}, New())
+}
+
+func TestCompare_Set(t *testing.T) {
+	c := New()
+	vector := vector.New(types.Type{Oid: types.T_uuid, Size: 16})
+	c.Set(1, vector)
+	require.Equal(t, vector, c.vs[1])
+}
+
+func TestCompare_Compare(t *testing.T) {
+	a, _ := types.ParseUuid("00000000-0000-1000-8000-000000000001")
+	b, _ := types.ParseUuid("00000000-0000-1000-8000-000000000002")
+	c := New()
+	c.xs[0] = []types.Uuid{a}
+	c.xs[1] = []types.Uuid{b}
+	require.Equal(t, 1, c.Compare(0, 1, 0, 0))
+	c.xs[1] = []types.Uuid{a}
+	require.Equal(t, 0, c.Compare(0, 1, 0, 0))
+	c.xs[0] = []types.Uuid{b}
+	require.Equal(t, -1, c.Compare(0, 1, 0, 0))
+}
@@ -15,12 +15,47 @@
 package compress
 
 import (
+	"github.com/golang/snappy"
+	"github.com/klauspost/compress/zstd"
 	"github.com/pierrec/lz4"
 )
 
 var Algorithms map[string]int = map[string]int{
-	"lz4":  Lz4,
-	"none": None,
+	"lz4":    Lz4,
+	"none":   None,
+	"zstd":   Zstd,
+	"snappy": Snappy,
+}
+
+var Encodings map[string]Encoding = map[string]Encoding{
+	"plain": Plain,
+	"dict":  Dict,
+	"rle":   RLE,
+	"delta": Delta,
+	"for":   FOR,
+}
+
+var (
+	zstdEncoder, _ = zstd.NewWriter(nil)
+	zstdDecoder, _ = zstd.NewReader(nil)
+)
+
+// CompressBound returns the max size of the data compressed from n bytes.
+func CompressBound(n int, typ int) int {
+	switch typ {
+	case Lz4:
+		return lz4.CompressBlockBound(n)
+	case Zstd:
+		// the same as ZSTD_COMPRESSBOUND with the room for the frame header
+		bound := n + n>>8 + 32
+		if n < 128<<10 {
+			bound += (128<<10 - n) >> 11
+		}
+		return bound
+	case Snappy:
+		return snappy.MaxEncodedLen(n)
+	}
+	return n
 }
 
 func Compress(src, dst []byte, typ int) ([]byte, error) {
@@ -31,6 +66,10 @@ func Compress(src, dst []byte, typ int) ([]byte, error) {
 			return nil, err
 		}
 		return dst[:n], nil
+	case Zstd:
+		return zstdEncoder.EncodeAll(src, dst[:0]), nil
+	case Snappy:
+		return snappy.Encode(dst, src), nil
 	}
 	return nil, nil
 }
@@ -43,6 +82,10 @@ func Decompress(src, dst []byte, typ int) ([]byte, error) {
 			return nil, err
 		}
 		return dst[:n], nil
+	case Zstd:
+		return zstdDecoder.DecodeAll(src, dst[:0])
+	case Snappy:
+		return snappy.Decode(dst, src)
 	}
 	return nil, nil
 }
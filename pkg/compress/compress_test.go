@@ -22,6 +22,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/encoding"
 
 	"github.com/pierrec/lz4"
+	"github.com/stretchr/testify/require"
 )
 
 func TestLz4(t *testing.T) {
@@ -41,3 +42,44 @@ func TestLz4(t *testing.T) {
 	}
 	fmt.Printf("dat: %v\n", data)
 }
+
+func TestCodecs(t *testing.T) {
+	xs := make([]int64, 1024)
+	for i := range xs {
+		xs[i] = int64(i % 7)
+	}
+	raw := encoding.EncodeInt64Slice(xs)
+	for _, typ := range []int{Lz4, Zstd, Snappy} {
+		buf := make([]byte, CompressBound(len(raw), typ))
+		buf, err := Compress(raw, buf, typ)
+		require.NoError(t, err)
+		require.Less(t, len(buf), len(raw))
+		data := make([]byte, len(raw))
+		data, err = Decompress(buf, data, typ)
+		require.NoError(t, err)
+		require.Equal(t, raw, data)
+	}
+}
+
+func TestParse(t *testing.T) {
+	typ, err := Parse("zstd")
+	require.NoError(t, err)
+	require.Equal(t, T(Zstd), typ.Codec())
+	require.Equal(t, Plain, typ.Encoding())
+
+	typ, err = Parse("DICT, snappy")
+	require.NoError(t, err)
+	require.Equal(t, T(Snappy), typ.Codec())
+	require.Equal(t, Dict, typ.Encoding())
+	require.Equal(t, "DICT,SNAPPY", typ.String())
+
+	typ, err = Parse("delta")
+	require.NoError(t, err)
+	require.Equal(t, T(Lz4), typ.Codec())
+	require.Equal(t, Delta, typ.Encoding())
+
+	_, err = Parse("lz4,zstd")
+	require.Error(t, err)
+	_, err = Parse("gzip")
+	require.Error(t, err)
+}
@@ -0,0 +1,476 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compress
+
+import (
+	"encoding/binary"
+	"errors"
+	"math/bits"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+)
+
+var ErrCorruptedEncoding = errors.New("corrupted encoded column")
+
+/*
+Encode encodes the column serialized by vector.Show. The header of the column,
+which is the type and the nulls, is kept as it is and only the values are encoded.
+Dict is used for char and varchar, RLE, Delta and FOR are used for integers,
+dates, datetimes and decimal64s. The column which can not be encoded by the
+encoding, or which is not smaller after encoding, is returned with Plain.
+*/
+func Encode(data []byte, enc Encoding) ([]byte, Encoding, error) {
+	if enc == Plain {
+		return data, Plain, nil
+	}
+	typ, header, body, err := splitColumn(data)
+	if err != nil {
+		return nil, Plain, err
+	}
+	var encoded []byte
+	switch enc {
+	case Dict:
+		if typ.Oid != types.T_char && typ.Oid != types.T_varchar {
+			return data, Plain, nil
+		}
+		if encoded, err = encodeDict(body); err != nil {
+			return nil, Plain, err
+		}
+	case RLE, Delta, FOR:
+		width, signed, ok := integerLayout(typ.Oid)
+		if !ok || len(body) == 0 || len(body)%width != 0 {
+			return data, Plain, nil
+		}
+		vs := readIntegers(body, width, signed)
+		switch enc {
+		case RLE:
+			encoded = encodeRLE(vs)
+		case Delta:
+			encoded = encodeDelta(vs)
+		case FOR:
+			encoded = encodeFOR(vs, signed)
+		}
+	default:
+		return data, Plain, nil
+	}
+	if encoded == nil || len(encoded) >= len(body) {
+		return data, Plain, nil
+	}
+	buf := make([]byte, 0, len(header)+len(encoded))
+	buf = append(buf, header...)
+	return append(buf, encoded...), enc, nil
+}
+
+// Decode decodes the column encoded by Encode into the layout of vector.Show.
+func Decode(data []byte, enc Encoding) ([]byte, error) {
+	if enc == Plain {
+		return data, nil
+	}
+	typ, header, body, err := splitColumn(data)
+	if err != nil {
+		return nil, err
+	}
+	buf := make([]byte, 0, len(header)+len(body)*2)
+	buf = append(buf, header...)
+	switch enc {
+	case Dict:
+		return decodeDict(buf, body)
+	case RLE, Delta, FOR:
+		width, _, ok := integerLayout(typ.Oid)
+		if !ok {
+			return nil, ErrCorruptedEncoding
+		}
+		var vs []uint64
+		switch enc {
+		case RLE:
+			vs, err = decodeRLE(body)
+		case Delta:
+			vs, err = decodeDelta(body)
+		case FOR:
+			vs, err = decodeFOR(body)
+		}
+		if err != nil {
+			return nil, err
+		}
+		return writeIntegers(buf, vs, width), nil
+	}
+	return nil, ErrCorruptedEncoding
+}
+
+func splitColumn(data []byte) (types.Type, []byte, []byte, error) {
+	if len(data) < encoding.TypeSize+4 {
+		return types.Type{}, nil, nil, ErrCorruptedEncoding
+	}
+	typ := encoding.DecodeType(data[:encoding.TypeSize])
+	size := int(encoding.DecodeUint32(data[encoding.TypeSize:]))
+	n := encoding.TypeSize + 4 + size
+	if len(data) < n {
+		return types.Type{}, nil, nil, ErrCorruptedEncoding
+	}
+	return typ, data[:n], data[n:], nil
+}
+
+// integerLayout returns the width and the signedness of the integer-like type.
+func integerLayout(oid types.T) (int, bool, bool) {
+	switch oid {
+	case types.T_int8, types.T_int16, types.T_int32, types.T_int64,
+		types.T_date, types.T_datetime, types.T_timestamp, types.T_decimal64:
+		return oid.TypeLen(), true, true
+	case types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64:
+		return oid.TypeLen(), false, true
+	}
+	return 0, false, false
+}
+
+// readIntegers reads the values, the signed values are sign extended.
+func readIntegers(data []byte, width int, signed bool) []uint64 {
+	vs := make([]uint64, len(data)/width)
+	for i := range vs {
+		b := data[i*width:]
+		switch width {
+		case 1:
+			if signed {
+				vs[i] = uint64(int64(int8(b[0])))
+			} else {
+				vs[i] = uint64(b[0])
+			}
+		case 2:
+			if signed {
+				vs[i] = uint64(int64(int16(binary.LittleEndian.Uint16(b))))
+			} else {
+				vs[i] = uint64(binary.LittleEndian.Uint16(b))
+			}
+		case 4:
+			if signed {
+				vs[i] = uint64(int64(int32(binary.LittleEndian.Uint32(b))))
+			} else {
+				vs[i] = uint64(binary.LittleEndian.Uint32(b))
+			}
+		default:
+			vs[i] = binary.LittleEndian.Uint64(b)
+		}
+	}
+	return vs
+}
+
+func writeIntegers(buf []byte, vs []uint64, width int) []byte {
+	var tmp [8]byte
+	for _, v := range vs {
+		binary.LittleEndian.PutUint64(tmp[:], v)
+		buf = append(buf, tmp[:width]...)
+	}
+	return buf
+}
+
+// encodeRLE stores the runs of the same value as the pairs of the value and the length.
+func encodeRLE(vs []uint64) []byte {
+	buf := appendUvarint(nil, uint64(len(vs)))
+	for i := 0; i < len(vs); {
+		j := i + 1
+		for j < len(vs) && vs[j] == vs[i] {
+			j++
+		}
+		buf = appendVarint(buf, int64(vs[i]))
+		buf = appendUvarint(buf, uint64(j-i))
+		i = j
+	}
+	return buf
+}
+
+func decodeRLE(data []byte) ([]uint64, error) {
+	r := &varintReader{data: data}
+	n := r.uvarint()
+	if r.err != nil {
+		return nil, ErrCorruptedEncoding
+	}
+	vs := make([]uint64, 0)
+	for uint64(len(vs)) < n {
+		v := uint64(r.varint())
+		cnt := r.uvarint()
+		if r.err != nil || cnt == 0 || cnt > n-uint64(len(vs)) {
+			return nil, ErrCorruptedEncoding
+		}
+		for ; cnt > 0; cnt-- {
+			vs = append(vs, v)
+		}
+	}
+	return vs, nil
+}
+
+// encodeDelta stores the first value and the differences between the adjacent values.
+func encodeDelta(vs []uint64) []byte {
+	buf := appendUvarint(nil, uint64(len(vs)))
+	prev := uint64(0)
+	for _, v := range vs {
+		buf = appendVarint(buf, int64(v-prev))
+		prev = v
+	}
+	return buf
+}
+
+func decodeDelta(data []byte) ([]uint64, error) {
+	r := &varintReader{data: data}
+	n := r.uvarint()
+	if r.err != nil || n > uint64(len(data)) {
+		return nil, ErrCorruptedEncoding
+	}
+	vs := make([]uint64, n)
+	prev := uint64(0)
+	for i := range vs {
+		prev += uint64(r.varint())
+		vs[i] = prev
+	}
+	if r.err != nil {
+		return nil, ErrCorruptedEncoding
+	}
+	return vs, nil
+}
+
+// encodeFOR stores the min value and the offsets from it packed in the least bits.
+func encodeFOR(vs []uint64, signed bool) []byte {
+	min, max := vs[0], vs[0]
+	for _, v := range vs[1:] {
+		if signed {
+			if int64(v) < int64(min) {
+				min = v
+			}
+			if int64(v) > int64(max) {
+				max = v
+			}
+		} else {
+			if v < min {
+				min = v
+			}
+			if v > max {
+				max = v
+			}
+		}
+	}
+	width := uint8(bits.Len64(max - min))
+	offsets := make([]uint64, len(vs))
+	for i, v := range vs {
+		offsets[i] = v - min
+	}
+	buf := appendUvarint(nil, uint64(len(vs)))
+	buf = appendVarint(buf, int64(min))
+	buf = append(buf, width)
+	return packBits(buf, offsets, width)
+}
+
+func decodeFOR(data []byte) ([]uint64, error) {
+	r := &varintReader{data: data}
+	n := r.uvarint()
+	min := uint64(r.varint())
+	if r.err != nil || r.pos >= len(data) {
+		return nil, ErrCorruptedEncoding
+	}
+	width := data[r.pos]
+	vs, err := unpackBits(data[r.pos+1:], n, width)
+	if err != nil {
+		return nil, err
+	}
+	for i := range vs {
+		vs[i] += min
+	}
+	return vs, nil
+}
+
+/*
+encodeDict stores the distinct strings once and the codes of the strings
+packed in the least bits. The body of the strings is the count, the lengths
+and the data.
+*/
+func encodeDict(body []byte) ([]byte, error) {
+	if len(body) < 4 {
+		return nil, ErrCorruptedEncoding
+	}
+	cnt := int(encoding.DecodeInt32(body))
+	if cnt == 0 || len(body) < 4+4*cnt {
+		return nil, nil
+	}
+	lengths := encoding.DecodeUint32Slice(body[4 : 4+4*cnt])
+	data := body[4+4*cnt:]
+	dict := make(map[string]uint64)
+	words := make([][]byte, 0)
+	codes := make([]uint64, cnt)
+	offset := uint32(0)
+	for i, n := range lengths {
+		if int(offset+n) > len(data) {
+			return nil, ErrCorruptedEncoding
+		}
+		word := data[offset : offset+n]
+		offset += n
+		code, ok := dict[string(word)]
+		if !ok {
+			code = uint64(len(words))
+			dict[string(word)] = code
+			words = append(words, word)
+		}
+		codes[i] = code
+	}
+	buf := appendUvarint(nil, uint64(cnt))
+	buf = appendUvarint(buf, uint64(len(words)))
+	for _, word := range words {
+		buf = appendUvarint(buf, uint64(len(word)))
+		buf = append(buf, word...)
+	}
+	width := uint8(bits.Len64(uint64(len(words) - 1)))
+	buf = append(buf, width)
+	return packBits(buf, codes, width), nil
+}
+
+func decodeDict(buf []byte, data []byte) ([]byte, error) {
+	r := &varintReader{data: data}
+	cnt := r.uvarint()
+	size := r.uvarint()
+	if r.err != nil || size > uint64(len(data)) {
+		return nil, ErrCorruptedEncoding
+	}
+	words := make([][]byte, size)
+	for i := range words {
+		n := r.uvarint()
+		if r.err != nil || uint64(len(data)-r.pos) < n {
+			return nil, ErrCorruptedEncoding
+		}
+		words[i] = data[r.pos : r.pos+int(n)]
+		r.pos += int(n)
+	}
+	if r.pos >= len(data) {
+		return nil, ErrCorruptedEncoding
+	}
+	codes, err := unpackBits(data[r.pos+1:], cnt, data[r.pos])
+	if err != nil {
+		return nil, err
+	}
+	buf = append(buf, encoding.EncodeInt32(int32(cnt))...)
+	for _, code := range codes {
+		if code >= size {
+			return nil, ErrCorruptedEncoding
+		}
+		buf = append(buf, encoding.EncodeUint32(uint32(len(words[code])))...)
+	}
+	for _, code := range codes {
+		buf = append(buf, words[code]...)
+	}
+	return buf, nil
+}
+
+// packBits appends the values in the least width bits of them.
+func packBits(buf []byte, vs []uint64, width uint8) []byte {
+	if width == 0 {
+		return buf
+	}
+	var acc uint64
+	var n uint8
+	for _, v := range vs {
+		if width == 64 {
+			buf = appendUint64(buf, v)
+			continue
+		}
+		v &= 1<<width - 1
+		acc |= v << n
+		if n+width >= 64 {
+			buf = appendUint64(buf, acc)
+			acc = v >> (64 - n)
+			n = n + width - 64
+		} else {
+			n += width
+		}
+	}
+	for ; n > 0; n -= minOf(n, 8) {
+		buf = append(buf, byte(acc))
+		acc >>= 8
+	}
+	return buf
+}
+
+func unpackBits(data []byte, cnt uint64, width uint8) ([]uint64, error) {
+	if width > 64 || uint64(width)*cnt > uint64(len(data))*8 {
+		return nil, ErrCorruptedEncoding
+	}
+	vs := make([]uint64, cnt)
+	if width == 0 {
+		return vs, nil
+	}
+	pos := uint64(0)
+	for i := range vs {
+		var v uint64
+		for got := uint64(0); got < uint64(width); {
+			b := data[pos/8] >> (pos % 8)
+			take := minOf(uint64(width)-got, 8-pos%8)
+			v |= uint64(b&byte(1<<take-1)) << got
+			got += take
+			pos += take
+		}
+		vs[i] = v
+	}
+	return vs, nil
+}
+
+func minOf[T uint8 | uint64](a, b T) T {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+type varintReader struct {
+	data []byte
+	pos  int
+	err  error
+}
+
+func (r *varintReader) uvarint() uint64 {
+	if r.err != nil {
+		return 0
+	}
+	v, n := binary.Uvarint(r.data[r.pos:])
+	if n <= 0 {
+		r.err = ErrCorruptedEncoding
+		return 0
+	}
+	r.pos += n
+	return v
+}
+
+func (r *varintReader) varint() int64 {
+	if r.err != nil {
+		return 0
+	}
+	v, n := binary.Varint(r.data[r.pos:])
+	if n <= 0 {
+		r.err = ErrCorruptedEncoding
+		return 0
+	}
+	r.pos += n
+	return v
+}
+
+func appendUvarint(buf []byte, v uint64) []byte {
+	var tmp [binary.MaxVarintLen64]byte
+	return append(buf, tmp[:binary.PutUvarint(tmp[:], v)]...)
+}
+
+func appendVarint(buf []byte, v int64) []byte {
+	var tmp [binary.MaxVarintLen64]byte
+	return append(buf, tmp[:binary.PutVarint(tmp[:], v)]...)
+}
+
+func appendUint64(buf []byte, v uint64) []byte {
+	var tmp [8]byte
+	binary.LittleEndian.PutUint64(tmp[:], v)
+	return append(buf, tmp[:]...)
+}
@@ -0,0 +1,112 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compress
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/stretchr/testify/require"
+)
+
+func newVector(t *testing.T, typ types.Type, vs any) *vector.Vector {
+	vec := vector.New(typ)
+	require.NoError(t, vector.Append(vec, vs))
+	return vec
+}
+
+func checkEncoding(t *testing.T, vec *vector.Vector, enc Encoding, expected Encoding) {
+	data, err := vec.Show()
+	require.NoError(t, err)
+	buf, used, err := Encode(data, enc)
+	require.NoError(t, err)
+	require.Equal(t, expected, used)
+	if used != Plain {
+		require.Less(t, len(buf), len(data))
+	}
+	decoded, err := Decode(buf, used)
+	require.NoError(t, err)
+	require.Equal(t, data, decoded)
+}
+
+func TestEncodeIntegers(t *testing.T) {
+	n := 1000
+	i64s := make([]int64, n)
+	u32s := make([]uint32, n)
+	i8s := make([]int8, n)
+	dates := make([]types.Date, n)
+	for i := 0; i < n; i++ {
+		i64s[i] = int64(-500 + i)
+		u32s[i] = uint32(1<<31 + i/100)
+		i8s[i] = int8(i % 3)
+		dates[i] = types.Date(738000 + i/10)
+	}
+	vecs := []*vector.Vector{
+		newVector(t, types.Type{Oid: types.T_int64, Size: 8}, i64s),
+		newVector(t, types.Type{Oid: types.T_uint32, Size: 4}, u32s),
+		newVector(t, types.Type{Oid: types.T_int8, Size: 1}, i8s),
+		newVector(t, types.Type{Oid: types.T_date, Size: 4}, dates),
+	}
+	nulls.Add(vecs[0].Nsp, 3, 7)
+	for _, vec := range vecs {
+		checkEncoding(t, vec, FOR, FOR)
+		checkEncoding(t, vec, Dict, Plain)
+	}
+	checkEncoding(t, vecs[0], Delta, Delta)
+	checkEncoding(t, vecs[1], Delta, Delta)
+	checkEncoding(t, vecs[3], Delta, Delta)
+	checkEncoding(t, vecs[1], RLE, RLE)
+	checkEncoding(t, vecs[3], RLE, RLE)
+	// the columns are not smaller after the encodings
+	checkEncoding(t, vecs[2], RLE, Plain)
+	checkEncoding(t, vecs[2], Delta, Plain)
+}
+
+func TestEncodeStrings(t *testing.T) {
+	vec := vector.New(types.Type{Oid: types.T_varchar, Size: 24})
+	for i := 0; i < 1000; i++ {
+		require.NoError(t, vector.Append(vec, [][]byte{[]byte(fmt.Sprintf("city-%d", i%5))}))
+	}
+	nulls.Add(vec.Nsp, 10)
+	checkEncoding(t, vec, Dict, Dict)
+	checkEncoding(t, vec, Delta, Plain)
+
+	empty := vector.New(types.Type{Oid: types.T_varchar, Size: 24})
+	checkEncoding(t, empty, Dict, Plain)
+
+	floats := newVector(t, types.Type{Oid: types.T_float64, Size: 8}, make([]float64, 100))
+	checkEncoding(t, floats, FOR, Plain)
+}
+
+func TestPackBits(t *testing.T) {
+	for _, width := range []uint8{0, 1, 3, 7, 8, 13, 33, 63, 64} {
+		vs := make([]uint64, 100)
+		for i := range vs {
+			vs[i] = uint64(i*2654435761) & (1<<width - 1)
+			if width == 64 {
+				vs[i] = uint64(i) * 0x9E3779B97F4A7C15
+			}
+		}
+		buf := packBits(nil, vs, width)
+		got, err := unpackBits(buf, uint64(len(vs)), width)
+		require.NoError(t, err)
+		require.Equal(t, vs, got)
+	}
+	_, err := Decode([]byte{1, 2}, FOR)
+	require.Error(t, err)
+}
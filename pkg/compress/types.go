@@ -14,21 +14,100 @@
 
 package compress
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+)
 
 const (
 	None = iota
 	Lz4
+	Zstd
+	Snappy
 )
 
+// the lightweight encodings applied to the column before the codec
+const (
+	Plain Encoding = iota
+	Dict
+	RLE
+	Delta
+	FOR
+)
+
+/*
+T is the compression of a column, the codec is kept in the low 4 bits
+and the lightweight encoding is kept in the high 4 bits.
+*/
 type T uint8
 
+type Encoding uint8
+
+func NewT(codec T, enc Encoding) T {
+	return codec&0x0f | T(enc)<<4
+}
+
+func (t T) Codec() T {
+	return t & 0x0f
+}
+
+func (t T) Encoding() Encoding {
+	return Encoding(t >> 4)
+}
+
 func (t T) String() string {
-	switch t {
+	var s string
+	switch t.Codec() {
 	case None:
-		return "None"
+		s = "None"
 	case Lz4:
-		return "LZ4"
+		s = "LZ4"
+	case Zstd:
+		s = "ZSTD"
+	case Snappy:
+		s = "SNAPPY"
+	default:
+		return fmt.Sprintf("unexpected compress type: %d", t)
+	}
+	if t.Encoding() != Plain {
+		return t.Encoding().String() + "," + s
+	}
+	return s
+}
+
+func (e Encoding) String() string {
+	switch e {
+	case Plain:
+		return "PLAIN"
+	case Dict:
+		return "DICT"
+	case RLE:
+		return "RLE"
+	case Delta:
+		return "DELTA"
+	case FOR:
+		return "FOR"
+	}
+	return fmt.Sprintf("unexpected encoding type: %d", e)
+}
+
+/*
+Parse parses the compression option of the column, which is a codec,
+an encoding, or both of them separated by the comma, such as 'dict,zstd'.
+The codec is Lz4 if it is not specified.
+*/
+func Parse(s string) (T, error) {
+	codec, enc := T(Lz4), Plain
+	hasCodec, hasEnc := false, false
+	for _, name := range strings.Split(strings.ToLower(s), ",") {
+		name = strings.TrimSpace(name)
+		if typ, ok := Algorithms[name]; ok && !hasCodec {
+			codec, hasCodec = T(typ), true
+		} else if typ, ok := Encodings[name]; ok && !hasEnc {
+			enc, hasEnc = typ, true
+		} else {
+			return 0, fmt.Errorf("invalid compression '%s'", s)
+		}
 	}
-	return fmt.Sprintf("unexpected compress type: %d", t)
+	return NewT(codec, enc), nil
 }
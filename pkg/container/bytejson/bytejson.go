@@ -0,0 +1,420 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bytejson
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"math"
+	"sort"
+	"strconv"
+	"unicode/utf8"
+)
+
+// ParseFromString parses a JSON text to be a ByteJson
+func ParseFromString(s string) (ByteJson, error) {
+	return ParseFromByteSlice([]byte(s))
+}
+
+// ParseFromByteSlice parses a JSON text to be a ByteJson
+func ParseFromByteSlice(buf []byte) (ByteJson, error) {
+	if !json.Valid(buf) {
+		return Null, ErrInvalidJson
+	}
+	decoder := json.NewDecoder(bytes.NewReader(buf))
+	decoder.UseNumber()
+	var v any
+	if err := decoder.Decode(&v); err != nil {
+		return Null, ErrInvalidJson
+	}
+	return CreateByteJson(v)
+}
+
+// CreateByteJson converts a value decoded by encoding/json to be a ByteJson,
+// the numbers are expected to be json.Number.
+func CreateByteJson(v any) (ByteJson, error) {
+	switch x := v.(type) {
+	case nil:
+		return Null, nil
+	case bool:
+		if x {
+			return ByteJson{Type: TpCodeLiteral, Data: []byte{LiteralTrue}}, nil
+		}
+		return ByteJson{Type: TpCodeLiteral, Data: []byte{LiteralFalse}}, nil
+	case json.Number:
+		return createNumber(string(x))
+	case int64:
+		return createInt64(x), nil
+	case uint64:
+		return createUint64(x), nil
+	case float64:
+		return createFloat64(x), nil
+	case string:
+		return createString(x), nil
+	case []any:
+		elems := make([]ByteJson, len(x))
+		for i := range x {
+			elem, err := CreateByteJson(x[i])
+			if err != nil {
+				return Null, err
+			}
+			elems[i] = elem
+		}
+		return CreateArray(elems), nil
+	case map[string]any:
+		keys := make([]string, 0, len(x))
+		for k := range x {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		values := make([]ByteJson, len(keys))
+		for i, k := range keys {
+			value, err := CreateByteJson(x[k])
+			if err != nil {
+				return Null, err
+			}
+			values[i] = value
+		}
+		return createObject(keys, values)
+	}
+	return Null, ErrInvalidJson
+}
+
+func createNumber(s string) (ByteJson, error) {
+	if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return createInt64(i), nil
+	}
+	if u, err := strconv.ParseUint(s, 10, 64); err == nil {
+		return createUint64(u), nil
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return Null, ErrInvalidJson
+	}
+	return createFloat64(f), nil
+}
+
+func createInt64(v int64) ByteJson {
+	data := make([]byte, numberSize)
+	binary.LittleEndian.PutUint64(data, uint64(v))
+	return ByteJson{Type: TpCodeInt64, Data: data}
+}
+
+func createUint64(v uint64) ByteJson {
+	data := make([]byte, numberSize)
+	binary.LittleEndian.PutUint64(data, v)
+	return ByteJson{Type: TpCodeUint64, Data: data}
+}
+
+func createFloat64(v float64) ByteJson {
+	data := make([]byte, numberSize)
+	binary.LittleEndian.PutUint64(data, math.Float64bits(v))
+	return ByteJson{Type: TpCodeFloat64, Data: data}
+}
+
+func createString(s string) ByteJson {
+	data := make([]byte, binary.MaxVarintLen64+len(s))
+	n := binary.PutUvarint(data, uint64(len(s)))
+	copy(data[n:], s)
+	return ByteJson{Type: TpCodeString, Data: data[:n+len(s)]}
+}
+
+// CreateArray builds a JSON array from its elements
+func CreateArray(elems []ByteJson) ByteJson {
+	size := headerSize + len(elems)*valueEntrySize
+	for _, elem := range elems {
+		size += len(elem.Data)
+	}
+	data := make([]byte, headerSize+len(elems)*valueEntrySize, size)
+	binary.LittleEndian.PutUint32(data, uint32(len(elems)))
+	binary.LittleEndian.PutUint32(data[4:], uint32(size))
+	for i, elem := range elems {
+		entry := data[headerSize+i*valueEntrySize:]
+		entry[0] = byte(elem.Type)
+		binary.LittleEndian.PutUint32(entry[1:], uint32(len(data)))
+		data = append(data, elem.Data...)
+	}
+	return ByteJson{Type: TpCodeArray, Data: data}
+}
+
+// createObject builds a JSON object, keys must be sorted and unique
+func createObject(keys []string, values []ByteJson) (ByteJson, error) {
+	count := len(keys)
+	size := headerSize + count*(keyEntrySize+valueEntrySize)
+	for i := range keys {
+		if len(keys[i]) > math.MaxUint16 {
+			return Null, ErrInvalidJson
+		}
+		size += len(keys[i]) + len(values[i].Data)
+	}
+	data := make([]byte, headerSize+count*(keyEntrySize+valueEntrySize), size)
+	binary.LittleEndian.PutUint32(data, uint32(count))
+	binary.LittleEndian.PutUint32(data[4:], uint32(size))
+	for i, key := range keys {
+		entry := data[headerSize+i*keyEntrySize:]
+		binary.LittleEndian.PutUint32(entry, uint32(len(data)))
+		binary.LittleEndian.PutUint16(entry[4:], uint16(len(key)))
+		data = append(data, key...)
+	}
+	for i, value := range values {
+		entry := data[headerSize+count*keyEntrySize+i*valueEntrySize:]
+		entry[0] = byte(value.Type)
+		binary.LittleEndian.PutUint32(entry[1:], uint32(len(data)))
+		data = append(data, value.Data...)
+	}
+	return ByteJson{Type: TpCodeObject, Data: data}, nil
+}
+
+// Marshal returns the binary format of the ByteJson which is stored in a vector
+func (bj ByteJson) Marshal() []byte {
+	buf := make([]byte, len(bj.Data)+1)
+	buf[0] = byte(bj.Type)
+	copy(buf[1:], bj.Data)
+	return buf
+}
+
+// Unmarshal reads a ByteJson from its binary format, the data is not copied
+func Unmarshal(buf []byte) (ByteJson, error) {
+	if len(buf) < 2 {
+		return Null, ErrInvalidJsonData
+	}
+	bj := ByteJson{Type: TpCode(buf[0]), Data: buf[1:]}
+	switch bj.Type {
+	case TpCodeObject, TpCodeArray:
+		if len(bj.Data) < headerSize || int(bj.getUint32(4)) != len(bj.Data) {
+			return Null, ErrInvalidJsonData
+		}
+	case TpCodeLiteral, TpCodeInt64, TpCodeUint64, TpCodeFloat64, TpCodeString:
+		if valueLength(bj.Type, bj.Data) != len(bj.Data) {
+			return Null, ErrInvalidJsonData
+		}
+	default:
+		return Null, ErrInvalidJsonData
+	}
+	return bj, nil
+}
+
+// valueLength returns the length of the value data begins at data
+func valueLength(typ TpCode, data []byte) int {
+	switch typ {
+	case TpCodeObject, TpCodeArray:
+		if len(data) < headerSize {
+			return -1
+		}
+		return int(binary.LittleEndian.Uint32(data[4:]))
+	case TpCodeLiteral:
+		return 1
+	case TpCodeInt64, TpCodeUint64, TpCodeFloat64:
+		return numberSize
+	case TpCodeString:
+		l, n := binary.Uvarint(data)
+		if n <= 0 {
+			return -1
+		}
+		return n + int(l)
+	}
+	return -1
+}
+
+func (bj ByteJson) getUint32(off int) uint32 {
+	return binary.LittleEndian.Uint32(bj.Data[off:])
+}
+
+// IsNull returns true if the value is the JSON null literal
+func (bj ByteJson) IsNull() bool {
+	return bj.Type == TpCodeLiteral && bj.Data[0] == LiteralNull
+}
+
+// GetElemCount returns the number of elements of an array or members of an object
+func (bj ByteJson) GetElemCount() int {
+	return int(bj.getUint32(0))
+}
+
+// GetInt64 returns the value of an integer
+func (bj ByteJson) GetInt64() int64 {
+	return int64(binary.LittleEndian.Uint64(bj.Data))
+}
+
+// GetUint64 returns the value of an unsigned integer
+func (bj ByteJson) GetUint64() uint64 {
+	return binary.LittleEndian.Uint64(bj.Data)
+}
+
+// GetFloat64 returns the value of a float
+func (bj ByteJson) GetFloat64() float64 {
+	return math.Float64frombits(binary.LittleEndian.Uint64(bj.Data))
+}
+
+// GetString returns the value of a string
+func (bj ByteJson) GetString() []byte {
+	l, n := binary.Uvarint(bj.Data)
+	return bj.Data[n : n+int(l)]
+}
+
+// GetKey returns the i-th key of an object
+func (bj ByteJson) GetKey(i int) []byte {
+	entry := headerSize + i*keyEntrySize
+	off := bj.getUint32(entry)
+	l := binary.LittleEndian.Uint16(bj.Data[entry+4:])
+	return bj.Data[off : off+uint32(l)]
+}
+
+// GetObjectValue returns the value of the i-th member of an object
+func (bj ByteJson) GetObjectValue(i int) ByteJson {
+	return bj.valueEntry(headerSize + bj.GetElemCount()*keyEntrySize + i*valueEntrySize)
+}
+
+// GetArrayElem returns the i-th element of an array
+func (bj ByteJson) GetArrayElem(i int) ByteJson {
+	return bj.valueEntry(headerSize + i*valueEntrySize)
+}
+
+func (bj ByteJson) valueEntry(entry int) ByteJson {
+	typ := TpCode(bj.Data[entry])
+	off := int(bj.getUint32(entry + 1))
+	return ByteJson{Type: typ, Data: bj.Data[off : off+valueLength(typ, bj.Data[off:])]}
+}
+
+// lookupKey returns the value of the member named key in an object
+func (bj ByteJson) lookupKey(key string) (ByteJson, bool) {
+	count := bj.GetElemCount()
+	i := sort.Search(count, func(i int) bool {
+		return string(bj.GetKey(i)) >= key
+	})
+	if i < count && string(bj.GetKey(i)) == key {
+		return bj.GetObjectValue(i), true
+	}
+	return Null, false
+}
+
+// String returns the JSON text of the value
+func (bj ByteJson) String() string {
+	buf := make([]byte, 0, len(bj.Data))
+	return string(bj.appendText(buf))
+}
+
+// Unquote returns the unquoted string if the value is a JSON string, otherwise the JSON text
+func (bj ByteJson) Unquote() string {
+	if bj.Type == TpCodeString {
+		return string(bj.GetString())
+	}
+	return bj.String()
+}
+
+// UnquoteString unquotes a JSON string literal, a string which is not quoted is returned as it is
+func UnquoteString(s string) (string, error) {
+	if len(s) < 2 || s[0] != '"' || s[len(s)-1] != '"' {
+		return s, nil
+	}
+	var r string
+	if err := json.Unmarshal([]byte(s), &r); err != nil {
+		return "", ErrInvalidJson
+	}
+	return r, nil
+}
+
+func (bj ByteJson) appendText(buf []byte) []byte {
+	switch bj.Type {
+	case TpCodeObject:
+		buf = append(buf, '{')
+		for i, count := 0, bj.GetElemCount(); i < count; i++ {
+			if i > 0 {
+				buf = append(buf, ", "...)
+			}
+			buf = appendQuoted(buf, bj.GetKey(i))
+			buf = append(buf, ": "...)
+			buf = bj.GetObjectValue(i).appendText(buf)
+		}
+		return append(buf, '}')
+	case TpCodeArray:
+		buf = append(buf, '[')
+		for i, count := 0, bj.GetElemCount(); i < count; i++ {
+			if i > 0 {
+				buf = append(buf, ", "...)
+			}
+			buf = bj.GetArrayElem(i).appendText(buf)
+		}
+		return append(buf, ']')
+	case TpCodeLiteral:
+		switch bj.Data[0] {
+		case LiteralTrue:
+			return append(buf, "true"...)
+		case LiteralFalse:
+			return append(buf, "false"...)
+		}
+		return append(buf, "null"...)
+	case TpCodeInt64:
+		return strconv.AppendInt(buf, bj.GetInt64(), 10)
+	case TpCodeUint64:
+		return strconv.AppendUint(buf, bj.GetUint64(), 10)
+	case TpCodeFloat64:
+		return appendFloat(buf, bj.GetFloat64())
+	case TpCodeString:
+		return appendQuoted(buf, bj.GetString())
+	}
+	return buf
+}
+
+func appendFloat(buf []byte, f float64) []byte {
+	abs := math.Abs(f)
+	format := byte('f')
+	if abs != 0 && (abs < 1e-6 || abs >= 1e21) {
+		format = 'e'
+	}
+	buf = strconv.AppendFloat(buf, f, format, -1, 64)
+	if format == 'f' && f == math.Trunc(f) {
+		// keep the float a float after a round trip, e.g. 1.0
+		buf = append(buf, ".0"...)
+	}
+	return buf
+}
+
+const hexDigits = "0123456789abcdef"
+
+func appendQuoted(buf []byte, s []byte) []byte {
+	buf = append(buf, '"')
+	for i := 0; i < len(s); {
+		c := s[i]
+		if c < utf8.RuneSelf {
+			switch c {
+			case '"', '\\':
+				buf = append(buf, '\\', c)
+			case '\n':
+				buf = append(buf, '\\', 'n')
+			case '\r':
+				buf = append(buf, '\\', 'r')
+			case '\t':
+				buf = append(buf, '\\', 't')
+			case '\b':
+				buf = append(buf, '\\', 'b')
+			case '\f':
+				buf = append(buf, '\\', 'f')
+			default:
+				if c < 0x20 {
+					buf = append(buf, '\\', 'u', '0', '0', hexDigits[c>>4], hexDigits[c&0xf])
+				} else {
+					buf = append(buf, c)
+				}
+			}
+			i++
+			continue
+		}
+		_, size := utf8.DecodeRune(s[i:])
+		buf = append(buf, s[i:i+size]...)
+		i += size
+	}
+	return append(buf, '"')
+}
@@ -0,0 +1,151 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bytejson
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFromString(t *testing.T) {
+	cases := []struct {
+		input  string
+		output string
+		noerr  bool
+	}{
+		{`null`, `null`, true},
+		{` true `, `true`, true},
+		{`false`, `false`, true},
+		{`-12`, `-12`, true},
+		{`18446744073709551615`, `18446744073709551615`, true},
+		{`1.5`, `1.5`, true},
+		{`1.0`, `1.0`, true},
+		{`1e30`, `1e+30`, true},
+		{`"a\"b\né"`, `"a\"b\né"`, true},
+		{`[1, "a", [], {}]`, `[1, "a", [], {}]`, true},
+		{`{"b": 1, "a": {"c": [true, null]}, "b": 2}`, `{"a": {"c": [true, null]}, "b": 2}`, true},
+		{``, ``, false},
+		{`{"a": 1`, ``, false},
+		{`[1] [2]`, ``, false},
+		{`abc`, ``, false},
+	}
+	for _, c := range cases {
+		bj, err := ParseFromString(c.input)
+		require.Equal(t, c.noerr, err == nil, c.input)
+		if err != nil {
+			continue
+		}
+		require.Equal(t, c.output, bj.String(), c.input)
+
+		// round trip through the binary format
+		bj2, err := Unmarshal(bj.Marshal())
+		require.NoError(t, err)
+		require.Equal(t, c.output, bj2.String())
+	}
+
+	_, err := Unmarshal([]byte{byte(TpCodeArray), 1, 2})
+	require.Error(t, err)
+	_, err = Unmarshal([]byte{0xff, 0})
+	require.Error(t, err)
+}
+
+func TestParseJsonPath(t *testing.T) {
+	cases := []struct {
+		input    string
+		output   string
+		wildcard bool
+		noerr    bool
+	}{
+		{`$`, `$`, false, true},
+		{`$.a`, `$.a`, false, true},
+		{` $ . a [ 1 ] `, `$.a[1]`, false, true},
+		{`$."a b".c`, `$."a b".c`, false, true},
+		{`$."a"`, `$.a`, false, true},
+		{`$[*].*`, `$[*].*`, true, true},
+		{`$**.a`, `$**.a`, true, true},
+		{`a`, ``, false, false},
+		{`$.`, ``, false, false},
+		{`$.1a`, ``, false, false},
+		{`$[a]`, ``, false, false},
+		{`$[1`, ``, false, false},
+		{`$."a`, ``, false, false},
+		{`$**`, ``, false, false},
+	}
+	for _, c := range cases {
+		p, err := ParseJsonPath(c.input)
+		require.Equal(t, c.noerr, err == nil, c.input)
+		if err != nil {
+			continue
+		}
+		require.Equal(t, c.output, p.String(), c.input)
+		require.Equal(t, c.wildcard, p.HasWildcard(), c.input)
+	}
+}
+
+func TestExtract(t *testing.T) {
+	bj, err := ParseFromString(`{"a": [1, {"b": "x"}, [2, 3]], "c d": {"b": true}, "e": 1.5}`)
+	require.NoError(t, err)
+	cases := []struct {
+		paths  []string
+		output string
+		found  bool
+	}{
+		{[]string{`$`}, bj.String(), true},
+		{[]string{`$.a[0]`}, `1`, true},
+		{[]string{`$.a[1].b`}, `"x"`, true},
+		{[]string{`$."c d".b`}, `true`, true},
+		{[]string{`$.e[0]`}, `1.5`, true},
+		{[]string{`$.a[3]`}, ``, false},
+		{[]string{`$.x`}, ``, false},
+		{[]string{`$.a[2][*]`}, `[2, 3]`, true},
+		{[]string{`$.*.b`}, `[true]`, true},
+		{[]string{`$**.b`}, `["x", true]`, true},
+		{[]string{`$.a[0]`, `$.e`}, `[1, 1.5]`, true},
+		{[]string{`$.a[0]`, `$.x`}, `[1]`, true},
+	}
+	for _, c := range cases {
+		paths := make([]Path, len(c.paths))
+		for i, s := range c.paths {
+			paths[i], err = ParseJsonPath(s)
+			require.NoError(t, err)
+		}
+		v, found := bj.Extract(paths)
+		require.Equal(t, c.found, found, c.paths)
+		if found {
+			require.Equal(t, c.output, v.String(), c.paths)
+		}
+	}
+
+	v, _ := bj.Extract([]Path{mustParsePath(t, `$.a[1].b`)})
+	require.Equal(t, `x`, v.Unquote())
+	v, _ = bj.Extract([]Path{mustParsePath(t, `$.a[2]`)})
+	require.Equal(t, `[2, 3]`, v.Unquote())
+
+	r, err := UnquoteString(`"a\tb"`)
+	require.NoError(t, err)
+	require.Equal(t, "a\tb", r)
+	r, err = UnquoteString(`abc`)
+	require.NoError(t, err)
+	require.Equal(t, "abc", r)
+	_, err = UnquoteString(`"a\"`)
+	require.Error(t, err)
+}
+
+func mustParsePath(t *testing.T, s string) Path {
+	p, err := ParseJsonPath(s)
+	require.NoError(t, err)
+	return p
+}
@@ -0,0 +1,258 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bytejson
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+type pathLegType byte
+
+const (
+	pathLegKey pathLegType = iota
+	pathLegIndex
+	pathLegDoubleWildcard
+)
+
+const wildcardIndex = -1
+
+type pathLeg struct {
+	typ pathLegType
+	// key is the member name of pathLegKey, "*" means any member
+	key string
+	// index is the array index of pathLegIndex, wildcardIndex means any element
+	index int
+}
+
+// Path is a parsed JSON path expression, e.g. $.a[0]."b c"
+type Path struct {
+	legs        []pathLeg
+	hasWildcard bool
+}
+
+// ParseJsonPath parses a JSON path expression
+// Support Format:
+// 1. $ is the document itself
+// 2. .key, ."quoted key" and .* select the members of an object
+// 3. [n] and [*] select the elements of an array
+// 4. ** selects all the paths beginning with the prefix and ending with the suffix
+func ParseJsonPath(s string) (Path, error) {
+	var p Path
+	s = strings.TrimSpace(s)
+	if len(s) == 0 || s[0] != '$' {
+		return p, ErrInvalidJsonPath
+	}
+	s = strings.TrimLeftFunc(s[1:], unicode.IsSpace)
+	for len(s) > 0 {
+		var leg pathLeg
+		var err error
+		switch {
+		case s[0] == '.':
+			leg, s, err = parseKeyLeg(s[1:])
+		case s[0] == '[':
+			leg, s, err = parseIndexLeg(s[1:])
+		case strings.HasPrefix(s, "**"):
+			leg, s = pathLeg{typ: pathLegDoubleWildcard}, s[2:]
+		default:
+			err = ErrInvalidJsonPath
+		}
+		if err != nil {
+			return p, err
+		}
+		if leg.typ == pathLegDoubleWildcard || leg.key == "*" || leg.index == wildcardIndex {
+			p.hasWildcard = true
+		}
+		p.legs = append(p.legs, leg)
+		s = strings.TrimLeftFunc(s, unicode.IsSpace)
+	}
+	if n := len(p.legs); n > 0 && p.legs[n-1].typ == pathLegDoubleWildcard {
+		// ** must be followed by a member or an element
+		return p, ErrInvalidJsonPath
+	}
+	return p, nil
+}
+
+func parseKeyLeg(s string) (pathLeg, string, error) {
+	leg := pathLeg{typ: pathLegKey}
+	s = strings.TrimLeftFunc(s, unicode.IsSpace)
+	switch {
+	case len(s) == 0:
+		return leg, s, ErrInvalidJsonPath
+	case s[0] == '*':
+		leg.key = "*"
+		return leg, s[1:], nil
+	case s[0] == '"':
+		end := 1
+		for ; end < len(s); end++ {
+			if s[end] == '\\' {
+				end++
+			} else if s[end] == '"' {
+				break
+			}
+		}
+		if end >= len(s) {
+			return leg, s, ErrInvalidJsonPath
+		}
+		if err := json.Unmarshal([]byte(s[:end+1]), &leg.key); err != nil {
+			return leg, s, ErrInvalidJsonPath
+		}
+		return leg, s[end+1:], nil
+	}
+	end := strings.IndexFunc(s, func(r rune) bool {
+		return !(r == '_' || r == '$' || unicode.IsLetter(r) || unicode.IsDigit(r))
+	})
+	if end < 0 {
+		end = len(s)
+	}
+	if end == 0 || unicode.IsDigit(rune(s[0])) {
+		return leg, s, ErrInvalidJsonPath
+	}
+	leg.key = s[:end]
+	return leg, s[end:], nil
+}
+
+func parseIndexLeg(s string) (pathLeg, string, error) {
+	leg := pathLeg{typ: pathLegIndex}
+	end := strings.IndexByte(s, ']')
+	if end < 0 {
+		return leg, s, ErrInvalidJsonPath
+	}
+	idx := strings.TrimSpace(s[:end])
+	if idx == "*" {
+		leg.index = wildcardIndex
+		return leg, s[end+1:], nil
+	}
+	v, err := strconv.ParseUint(idx, 10, 32)
+	if err != nil {
+		return leg, s, ErrInvalidJsonPath
+	}
+	leg.index = int(v)
+	return leg, s[end+1:], nil
+}
+
+// HasWildcard returns true if the path may select more than one value
+func (p Path) HasWildcard() bool {
+	return p.hasWildcard
+}
+
+func (p Path) String() string {
+	var b strings.Builder
+	b.WriteByte('$')
+	for _, leg := range p.legs {
+		switch leg.typ {
+		case pathLegKey:
+			b.WriteByte('.')
+			if leg.key == "*" || isIdentifier(leg.key) {
+				b.WriteString(leg.key)
+			} else {
+				b.Write(appendQuoted(nil, []byte(leg.key)))
+			}
+		case pathLegIndex:
+			if leg.index == wildcardIndex {
+				b.WriteString("[*]")
+			} else {
+				b.WriteString("[" + strconv.Itoa(leg.index) + "]")
+			}
+		case pathLegDoubleWildcard:
+			b.WriteString("**")
+		}
+	}
+	return b.String()
+}
+
+func isIdentifier(s string) bool {
+	if len(s) == 0 || unicode.IsDigit(rune(s[0])) {
+		return false
+	}
+	for _, r := range s {
+		if !(r == '_' || r == '$' || unicode.IsLetter(r) || unicode.IsDigit(r)) {
+			return false
+		}
+	}
+	return true
+}
+
+// Query returns all the values selected by the path
+func (bj ByteJson) Query(p Path) []ByteJson {
+	return bj.query(nil, p.legs)
+}
+
+func (bj ByteJson) query(results []ByteJson, legs []pathLeg) []ByteJson {
+	if len(legs) == 0 {
+		return append(results, bj)
+	}
+	leg := legs[0]
+	switch leg.typ {
+	case pathLegKey:
+		if bj.Type != TpCodeObject {
+			return results
+		}
+		if leg.key == "*" {
+			for i, count := 0, bj.GetElemCount(); i < count; i++ {
+				results = bj.GetObjectValue(i).query(results, legs[1:])
+			}
+		} else if v, ok := bj.lookupKey(leg.key); ok {
+			results = v.query(results, legs[1:])
+		}
+	case pathLegIndex:
+		if bj.Type != TpCodeArray {
+			// a scalar or an object is treated as an array of one element
+			if leg.index == 0 || leg.index == wildcardIndex {
+				results = bj.query(results, legs[1:])
+			}
+			return results
+		}
+		if leg.index == wildcardIndex {
+			for i, count := 0, bj.GetElemCount(); i < count; i++ {
+				results = bj.GetArrayElem(i).query(results, legs[1:])
+			}
+		} else if leg.index < bj.GetElemCount() {
+			results = bj.GetArrayElem(leg.index).query(results, legs[1:])
+		}
+	case pathLegDoubleWildcard:
+		results = bj.query(results, legs[1:])
+		switch bj.Type {
+		case TpCodeObject:
+			for i, count := 0, bj.GetElemCount(); i < count; i++ {
+				results = bj.GetObjectValue(i).query(results, legs)
+			}
+		case TpCodeArray:
+			for i, count := 0, bj.GetElemCount(); i < count; i++ {
+				results = bj.GetArrayElem(i).query(results, legs)
+			}
+		}
+	}
+	return results
+}
+
+// Extract returns the values selected by the paths with the semantic of JSON_EXTRACT,
+// a single path without wildcards returns the value itself, otherwise all the values
+// are wrapped into an array. The second result is false if nothing is selected.
+func (bj ByteJson) Extract(paths []Path) (ByteJson, bool) {
+	var results []ByteJson
+	for _, p := range paths {
+		results = bj.query(results, p.legs)
+	}
+	if len(results) == 0 {
+		return Null, false
+	}
+	if len(paths) == 1 && !paths[0].hasWildcard {
+		return results[0], true
+	}
+	return CreateArray(results), true
+}
@@ -0,0 +1,75 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package bytejson implements the binary format of the JSON data type.
+//
+// A JSON value is stored as one type byte followed by its data:
+//
+//	object:  count(uint32) size(uint32) keyEntry*count valueEntry*count keys values
+//	array:   count(uint32) size(uint32) valueEntry*count values
+//	literal: one byte, null / true / false
+//	integer: int64 in little endian
+//	uint64:  uint64 in little endian
+//	float:   float64 bits in little endian
+//	string:  uvarint length followed by the bytes
+//
+// keyEntry is keyOffset(uint32) keyLength(uint16), valueEntry is type(byte) valueOffset(uint32),
+// all the offsets are relative to the beginning of the container's data, so a nested value
+// can be read and copied without touching its parents. Keys of an object are sorted, which
+// makes the member lookup a binary search.
+package bytejson
+
+import (
+	"errors"
+)
+
+type TpCode byte
+
+const (
+	TpCodeObject  TpCode = 0x01
+	TpCodeArray   TpCode = 0x03
+	TpCodeLiteral TpCode = 0x04
+	TpCodeInt64   TpCode = 0x09
+	TpCodeUint64  TpCode = 0x0a
+	TpCodeFloat64 TpCode = 0x0b
+	TpCodeString  TpCode = 0x0c
+)
+
+const (
+	LiteralNull  byte = 0x00
+	LiteralTrue  byte = 0x01
+	LiteralFalse byte = 0x02
+)
+
+const (
+	headerSize     = 8 // count and size
+	keyEntrySize   = 6 // keyOffset and keyLength
+	valueEntrySize = 5 // type and valueOffset
+	numberSize     = 8
+)
+
+var (
+	ErrInvalidJson     = errors.New("invalid JSON text")
+	ErrInvalidJsonPath = errors.New("invalid JSON path expression")
+	ErrInvalidJsonData = errors.New("invalid JSON binary data")
+)
+
+// ByteJson is a JSON value in the binary format.
+type ByteJson struct {
+	Type TpCode
+	Data []byte
+}
+
+// Null is the JSON null literal
+var Null = ByteJson{Type: TpCodeLiteral, Data: []byte{LiteralNull}}
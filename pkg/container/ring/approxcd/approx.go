@@ -16,6 +16,7 @@ package approxcd
 
 import (
 	"fmt"
+	"math"
 
 	hll "github.com/axiomhq/hyperloglog"
 	"github.com/matrixorigin/matrixone/pkg/container/nulls"
@@ -32,6 +33,18 @@ func NewApproxCountDistinct(typ types.Type) *ApproxCountDistinctRing {
 	}
 }
 
+// NewApproxCountDistinctError returns a ring which evaluates the standard errors of the estimated count distinct
+func NewApproxCountDistinctError(typ types.Type) *ApproxCountDistinctRing {
+	return &ApproxCountDistinctRing{
+		Typ:   typ,
+		Bound: true,
+	}
+}
+
+// StandardError is the relative standard error of the estimates of the sketches, which is 1.04/sqrt(m)
+// for the m = 2^14 registers of a sketch
+var StandardError = 1.04 / math.Sqrt(1<<14)
+
 // impl Ring interface
 var _ ring.Ring = (*ApproxCountDistinctRing)(nil)
 
@@ -58,7 +71,10 @@ func (r *ApproxCountDistinctRing) Size() int {
 
 // Create a ring with same type using interface
 func (r *ApproxCountDistinctRing) Dup() ring.Ring {
-	return NewApproxCountDistinct(r.Typ)
+	return &ApproxCountDistinctRing{
+		Typ:   r.Typ,
+		Bound: r.Bound,
+	}
 }
 
 func (r *ApproxCountDistinctRing) Type() types.Type {
@@ -188,6 +204,19 @@ func (r *ApproxCountDistinctRing) Eval(_ []int64) *vector.Vector {
 		r.Vs = nil
 		r.Sk = nil
 	}()
+	if r.Bound {
+		vs := encoding.DecodeFloat64Slice(r.Da)[:len(r.Vs)]
+		for i, sk := range r.Sk {
+			vs[i] = float64(sk.Estimate()) * StandardError
+		}
+		return &vector.Vector{
+			Nsp:  new(nulls.Nulls),
+			Data: r.Da,
+			Col:  vs,
+			Or:   false,
+			Typ:  types.Type{Oid: types.T_float64, Size: 8},
+		}
+	}
 	for i, sk := range r.Sk {
 		r.Vs[i] = sk.Estimate()
 	}
@@ -137,7 +137,7 @@ func newBytesIterSolo(vec *vector.Vector) bytesIter {
 	var data []byte
 	var stride int
 	switch vec.Typ.Oid {
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 		return newVarBytes(vec)
 	case types.T_int8:
 		data, stride = encoding.EncodeInt8Slice(vec.Col.([]int8)), 1
@@ -25,10 +25,11 @@ import (
 )
 
 type ApproxCountDistinctRing struct {
-	Typ types.Type
-	Sk  []*hll.Sketch
-	Vs  []uint64
-	Da  []byte
+	Typ   types.Type
+	Bound bool // return the standard errors of the estimates
+	Sk    []*hll.Sketch
+	Vs    []uint64
+	Da    []byte
 }
 
 // impl Serialize & Deserialize for sql/protocol
@@ -63,6 +64,14 @@ func (r *ApproxCountDistinctRing) Marshal(w io.Writer) error {
 	if _, err := w.Write(encoding.EncodeType(r.Typ)); err != nil {
 		return err
 	}
+	// bound
+	bound := []byte{0}
+	if r.Bound {
+		bound[0] = 1
+	}
+	if _, err := w.Write(bound); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -121,5 +130,7 @@ func (r *ApproxCountDistinctRing) unmarshal(data []byte, proc *process.Process)
 	}
 	r.Typ = encoding.DecodeType(data[:encoding.TypeSize])
 	data = data[encoding.TypeSize:]
+	r.Bound = data[0] == 1
+	data = data[1:]
 	return data, nil
 }
@@ -0,0 +1,319 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package approxpct
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/ring"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// ApproxPercentileRing estimates the percentile of every group by a sketch with the relative accuracy
+// RelativeAccuracy. If Bound is set, it returns the error bounds of the estimates instead.
+// It backs approx_percentile and its alias percentile_approx.
+type ApproxPercentileRing struct {
+	Typ        types.Type
+	Percentile float64 // from 0 to 1
+	Bound      bool
+	Sk         []*sketch
+	Vs         []float64
+	Da         []byte
+}
+
+func NewApproxPercentileRingWithTypeCheck(typ types.Type, percentile float64, bound bool) (*ApproxPercentileRing, error) {
+	switch typ.Oid {
+	case types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64:
+	case types.T_int8, types.T_int16, types.T_int32, types.T_int64:
+	case types.T_float32, types.T_float64:
+	default:
+		return nil, errors.New(errno.FeatureNotSupported, fmt.Sprintf("'%v' not support approx_percentile", typ))
+	}
+	return NewApproxPercentile(typ, percentile, bound), nil
+}
+
+func NewApproxPercentile(typ types.Type, percentile float64, bound bool) *ApproxPercentileRing {
+	return &ApproxPercentileRing{
+		Typ:        typ,
+		Percentile: percentile,
+		Bound:      bound,
+	}
+}
+
+// impl Ring interface
+var _ ring.Ring = (*ApproxPercentileRing)(nil)
+
+func (r *ApproxPercentileRing) String() string {
+	return fmt.Sprintf("approx-percentile-ring(%v, %d sketches)", r.Percentile, len(r.Sk))
+}
+
+func (r *ApproxPercentileRing) Free(m *mheap.Mheap) {
+	if r.Da != nil {
+		mheap.Free(m, r.Da)
+		r.Da = nil
+		r.Vs = nil
+		r.Sk = nil
+	}
+}
+
+func (r *ApproxPercentileRing) Count() int {
+	return len(r.Vs)
+}
+
+func (r *ApproxPercentileRing) Size() int {
+	return cap(r.Da)
+}
+
+func (r *ApproxPercentileRing) Dup() ring.Ring {
+	return NewApproxPercentile(r.Typ, r.Percentile, r.Bound)
+}
+
+func (r *ApproxPercentileRing) Type() types.Type {
+	return r.Typ
+}
+
+func (r *ApproxPercentileRing) SetLength(n int) {
+	r.Vs = r.Vs[:n]
+	r.Sk = r.Sk[:n]
+}
+
+func (r *ApproxPercentileRing) Shrink(sels []int64) {
+	for i, sel := range sels {
+		r.Vs[i] = r.Vs[sel]
+		r.Sk[i] = r.Sk[sel]
+	}
+	r.Vs = r.Vs[:len(sels)]
+	r.Sk = r.Sk[:len(sels)]
+}
+
+func (r *ApproxPercentileRing) Shuffle(_ []int64, _ *mheap.Mheap) error {
+	return nil
+}
+
+func (r *ApproxPercentileRing) Grow(m *mheap.Mheap) error {
+	return r.Grows(1, m)
+}
+
+func (r *ApproxPercentileRing) Grows(size int, m *mheap.Mheap) error {
+	n := len(r.Vs)
+	if n == 0 {
+		data, err := mheap.Alloc(m, int64(size*8))
+		if err != nil {
+			return err
+		}
+		r.Da = data
+		r.Vs = encoding.DecodeFloat64Slice(data)
+	} else if n+size >= cap(r.Vs) {
+		r.Da = r.Da[:n*8]
+		data, err := mheap.Grow(m, r.Da, int64(n+size)*8)
+		if err != nil {
+			return err
+		}
+		mheap.Free(m, r.Da)
+		r.Da = data
+		r.Vs = encoding.DecodeFloat64Slice(data)
+	}
+	r.Vs = r.Vs[:n+size]
+	for i := 0; i < size; i++ {
+		r.Vs[n+i] = 0
+		r.Sk = append(r.Sk, newSketch())
+	}
+	return nil
+}
+
+func (r *ApproxPercentileRing) Fill(i int64, sel, z int64, vec *vector.Vector) {
+	if !nulls.Contains(vec.Nsp, uint64(sel)) {
+		r.Sk[i].insert(value(vec, sel), z)
+	}
+}
+
+func (r *ApproxPercentileRing) BatchFill(start int64, os []uint8, vps []uint64, zs []int64, vec *vector.Vector) {
+	for i := range os {
+		sel := int64(i) + start
+		if !nulls.Contains(vec.Nsp, uint64(sel)) {
+			r.Sk[vps[i]-1].insert(value(vec, sel), zs[sel])
+		}
+	}
+}
+
+func (r *ApproxPercentileRing) BulkFill(i int64, zs []int64, vec *vector.Vector) {
+	for j, z := range zs {
+		if !nulls.Contains(vec.Nsp, uint64(j)) {
+			r.Sk[i].insert(value(vec, int64(j)), z)
+		}
+	}
+}
+
+func (r *ApproxPercentileRing) Add(a interface{}, x, y int64) {
+	ar := a.(*ApproxPercentileRing)
+	r.Sk[x].merge(ar.Sk[y])
+}
+
+func (r *ApproxPercentileRing) BatchAdd(a interface{}, start int64, os []uint8, vps []uint64) {
+	ar := a.(*ApproxPercentileRing)
+	for i := range os {
+		r.Sk[vps[i]-1].merge(ar.Sk[start+int64(i)])
+	}
+}
+
+func (r *ApproxPercentileRing) Mul(a interface{}, x, y, z int64) {
+	ar := a.(*ApproxPercentileRing)
+	sk := ar.Sk[y]
+	for i, n := range sk.pos {
+		r.Sk[x].pos[i] += n * z
+	}
+	for i, n := range sk.neg {
+		r.Sk[x].neg[i] += n * z
+	}
+	r.Sk[x].zero += sk.zero * z
+	r.Sk[x].count += sk.count * z
+}
+
+func (r *ApproxPercentileRing) Eval(_ []int64) *vector.Vector {
+	defer func() {
+		r.Da = nil
+		r.Vs = nil
+		r.Sk = nil
+	}()
+	nsp := new(nulls.Nulls)
+	for i, sk := range r.Sk {
+		v, ok := sk.quantile(r.Percentile)
+		switch {
+		case !ok:
+			nulls.Add(nsp, uint64(i))
+		case r.Bound:
+			if v < 0 {
+				v = -v
+			}
+			r.Vs[i] = v * RelativeAccuracy / (1 - RelativeAccuracy)
+		default:
+			r.Vs[i] = v
+		}
+	}
+	return &vector.Vector{
+		Nsp:  nsp,
+		Data: r.Da,
+		Col:  r.Vs,
+		Or:   false,
+		Typ:  types.Type{Oid: types.T_float64, Size: 8},
+	}
+}
+
+// impl Serialize & Deserialize for sql/protocol
+
+func (r *ApproxPercentileRing) Marshal(w io.Writer) error {
+	// length
+	n := len(r.Sk)
+	if _, err := w.Write(encoding.EncodeUint32(uint32(n))); err != nil {
+		return err
+	}
+	// values
+	if n > 0 {
+		if _, err := w.Write(encoding.EncodeFloat64Slice(r.Vs)); err != nil {
+			return err
+		}
+	}
+	// sketches
+	for _, sk := range r.Sk {
+		if err := sk.marshal(w); err != nil {
+			return err
+		}
+	}
+	// type, percentile and bound
+	if _, err := w.Write(encoding.EncodeType(r.Typ)); err != nil {
+		return err
+	}
+	if _, err := w.Write(encoding.EncodeFloat64(r.Percentile)); err != nil {
+		return err
+	}
+	bound := []byte{0}
+	if r.Bound {
+		bound[0] = 1
+	}
+	_, err := w.Write(bound)
+	return err
+}
+
+// Unmarshal builds ApproxPercentileRing from data, the bytes of data are reused directly
+func (r *ApproxPercentileRing) Unmarshal(data []byte) ([]byte, error) {
+	return r.unmarshal(data, nil)
+}
+
+// UnmarshalWithProc builds ApproxPercentileRing from data, the values are copied into the memory of the process
+func (r *ApproxPercentileRing) UnmarshalWithProc(data []byte, proc *process.Process) ([]byte, error) {
+	return r.unmarshal(data, proc)
+}
+
+func (r *ApproxPercentileRing) unmarshal(data []byte, proc *process.Process) ([]byte, error) {
+	n := encoding.DecodeUint32(data[:4])
+	data = data[4:]
+	if n > 0 {
+		if proc == nil {
+			r.Da = data[:n*8]
+		} else {
+			da, err := mheap.Alloc(proc.Mp, int64(n*8))
+			if err != nil {
+				return nil, err
+			}
+			copy(da, data[:n*8])
+			r.Da = da
+		}
+		r.Vs = encoding.DecodeFloat64Slice(r.Da)
+		data = data[n*8:]
+	}
+	r.Sk = make([]*sketch, n)
+	for i := range r.Sk {
+		r.Sk[i], data = unmarshalSketch(data)
+	}
+	r.Typ = encoding.DecodeType(data[:encoding.TypeSize])
+	data = data[encoding.TypeSize:]
+	r.Percentile = encoding.DecodeFloat64(data[:8])
+	r.Bound = data[8] == 1
+	return data[9:], nil
+}
+
+func value(vec *vector.Vector, i int64) float64 {
+	switch vec.Typ.Oid {
+	case types.T_int8:
+		return float64(vec.Col.([]int8)[i])
+	case types.T_int16:
+		return float64(vec.Col.([]int16)[i])
+	case types.T_int32:
+		return float64(vec.Col.([]int32)[i])
+	case types.T_int64:
+		return float64(vec.Col.([]int64)[i])
+	case types.T_uint8:
+		return float64(vec.Col.([]uint8)[i])
+	case types.T_uint16:
+		return float64(vec.Col.([]uint16)[i])
+	case types.T_uint32:
+		return float64(vec.Col.([]uint32)[i])
+	case types.T_uint64:
+		return float64(vec.Col.([]uint64)[i])
+	case types.T_float32:
+		return float64(vec.Col.([]float32)[i])
+	case types.T_float64:
+		return vec.Col.([]float64)[i]
+	}
+	return 0
+}
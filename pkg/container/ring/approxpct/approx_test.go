@@ -0,0 +1,90 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package approxpct
+
+import (
+	"math"
+	"sort"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSketch(t *testing.T) {
+	vs := make([]float64, 0, 20000)
+	for i := -5000; i < 15000; i++ {
+		vs = append(vs, float64(i)*1.5)
+	}
+	sk0, sk1 := newSketch(), newSketch()
+	for i, v := range vs {
+		if i%2 == 0 {
+			sk0.insert(v, 1)
+		} else {
+			sk1.insert(v, 1)
+		}
+	}
+	sk0.merge(sk1)
+	sort.Float64s(vs)
+	for _, p := range []float64{0, 0.1, 0.25, 0.5, 0.9, 0.99, 1} {
+		v := vs[int(p*float64(len(vs)-1))]
+		e, ok := sk0.quantile(p)
+		require.True(t, ok)
+		require.LessOrEqual(t, math.Abs(e-v), RelativeAccuracy*math.Abs(v)+1e-9)
+	}
+	_, ok := newSketch().quantile(0.5)
+	require.False(t, ok)
+}
+
+func TestApproxPercentile(t *testing.T) {
+	m := mheap.New(guest.New(1<<30, host.New(1<<30)))
+	vec := vector.New(types.Type{Oid: types.T_int64, Size: 8})
+	vs := make([]int64, 1000)
+	for i := range vs {
+		vs[i] = int64(i + 1)
+	}
+	vec.Col = vs
+	nulls.Add(vec.Nsp, 999)
+	zs := make([]int64, len(vs))
+	for i := range zs {
+		zs[i] = 1
+	}
+
+	for _, bound := range []bool{false, true} {
+		r, err := NewApproxPercentileRingWithTypeCheck(vec.Typ, 0.5, bound)
+		require.NoError(t, err)
+		require.NoError(t, r.Grows(2, m))
+		r.BulkFill(0, zs, vec)
+		rv := r.Eval(zs[:2])
+		require.False(t, nulls.Contains(rv.Nsp, 0))
+		require.True(t, nulls.Contains(rv.Nsp, 1))
+		v := rv.Col.([]float64)[0]
+		if bound {
+			require.InDelta(t, 5, v, 0.2)
+		} else {
+			require.InDelta(t, 500, v, 5)
+		}
+		mheap.Free(m, rv.Data)
+	}
+	require.Equal(t, int64(0), mheap.Size(m))
+
+	_, err := NewApproxPercentileRingWithTypeCheck(types.Type{Oid: types.T_char}, 0.5, false)
+	require.Error(t, err)
+}
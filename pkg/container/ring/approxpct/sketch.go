@@ -0,0 +1,171 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package approxpct
+
+import (
+	"io"
+	"math"
+	"sort"
+
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+)
+
+// RelativeAccuracy is the relative accuracy of the sketch, the estimated percentile v' of the value v
+// of the percentile always satisfies |v' - v| <= RelativeAccuracy * |v|.
+const RelativeAccuracy = 0.01
+
+var (
+	gamma    = (1 + RelativeAccuracy) / (1 - RelativeAccuracy)
+	logGamma = math.Log(gamma)
+)
+
+// sketch is a DDSketch, it counts the values in buckets of exponentially growing width, bucket i
+// holds the values in (gamma^(i-1), gamma^i]. The negative values are counted by their absolute
+// value, so a sketch is mergeable and its size is logarithmic to the range of the values.
+type sketch struct {
+	pos   map[int32]int64
+	neg   map[int32]int64
+	zero  int64
+	count int64
+}
+
+func newSketch() *sketch {
+	return &sketch{
+		pos: make(map[int32]int64),
+		neg: make(map[int32]int64),
+	}
+}
+
+func bucket(v float64) int32 {
+	return int32(math.Ceil(math.Log(v) / logGamma))
+}
+
+// bucketValue returns the value of bucket i which has the smallest relative error to all the values of the bucket
+func bucketValue(i int32) float64 {
+	return 2 * math.Pow(gamma, float64(i)) / (gamma + 1)
+}
+
+func (s *sketch) insert(v float64, n int64) {
+	switch {
+	case math.IsNaN(v):
+		return
+	case v > math.SmallestNonzeroFloat64:
+		s.pos[bucket(v)] += n
+	case v < -math.SmallestNonzeroFloat64:
+		s.neg[bucket(-v)] += n
+	default:
+		s.zero += n
+	}
+	s.count += n
+}
+
+func (s *sketch) merge(o *sketch) {
+	for i, n := range o.pos {
+		s.pos[i] += n
+	}
+	for i, n := range o.neg {
+		s.neg[i] += n
+	}
+	s.zero += o.zero
+	s.count += o.count
+}
+
+// quantile returns the estimated value of the p-quantile, and false if the sketch is empty
+func (s *sketch) quantile(p float64) (float64, bool) {
+	if s.count == 0 {
+		return 0, false
+	}
+	rank := int64(p * float64(s.count-1))
+	// the negative values, from the smallest one
+	keys := sortedKeys(s.neg)
+	for i := len(keys) - 1; i >= 0; i-- {
+		if rank < s.neg[keys[i]] {
+			return -bucketValue(keys[i]), true
+		}
+		rank -= s.neg[keys[i]]
+	}
+	if rank < s.zero {
+		return 0, true
+	}
+	rank -= s.zero
+	keys = sortedKeys(s.pos)
+	for _, k := range keys {
+		if rank < s.pos[k] {
+			return bucketValue(k), true
+		}
+		rank -= s.pos[k]
+	}
+	return bucketValue(keys[len(keys)-1]), true
+}
+
+func sortedKeys(m map[int32]int64) []int32 {
+	keys := make([]int32, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+	return keys
+}
+
+// marshal writes the sketch as [zero][count][buckets of pos][buckets of neg], where the buckets
+// are laid out as [n u32][keys i32 * n][counts i64 * n]
+func (s *sketch) marshal(w io.Writer) error {
+	if _, err := w.Write(encoding.EncodeInt64(s.zero)); err != nil {
+		return err
+	}
+	if _, err := w.Write(encoding.EncodeInt64(s.count)); err != nil {
+		return err
+	}
+	for _, m := range []map[int32]int64{s.pos, s.neg} {
+		keys := sortedKeys(m)
+		counts := make([]int64, len(keys))
+		for i, k := range keys {
+			counts[i] = m[k]
+		}
+		if _, err := w.Write(encoding.EncodeUint32(uint32(len(keys)))); err != nil {
+			return err
+		}
+		if _, err := w.Write(encoding.EncodeInt32Slice(keys)); err != nil {
+			return err
+		}
+		if _, err := w.Write(encoding.EncodeInt64Slice(counts)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// unmarshalSketch reads a sketch written by marshal, and returns the bytes left
+func unmarshalSketch(data []byte) (*sketch, []byte) {
+	s := newSketch()
+	s.zero = encoding.DecodeInt64(data[:8])
+	s.count = encoding.DecodeInt64(data[8:16])
+	data = data[16:]
+	for _, m := range []map[int32]int64{s.pos, s.neg} {
+		n := encoding.DecodeUint32(data[:4])
+		data = data[4:]
+		if n == 0 {
+			continue
+		}
+		keys := encoding.DecodeInt32Slice(data[:n*4])
+		data = data[n*4:]
+		counts := encoding.DecodeInt64Slice(data[:n*8])
+		data = data[n*8:]
+		for i, k := range keys {
+			m[k] = counts[i]
+		}
+	}
+	return s, data
+}
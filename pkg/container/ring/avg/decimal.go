@@ -13,7 +13,8 @@
 // limitations under the License.
 
 // the average aggregation function for decimal types(decimal64, decimal128)
-// the avg function for decimal types has result of type decimal128, precision 38, and the result's scale is the same as the original column's scale
+// the avg function for decimal types has result of type decimal128, the result's precision and scale are given by
+// types.DecimalAvgResultType, that is 4 more digits in the scale than the original column, and the result is rounded half up
 
 package avg
 
@@ -111,7 +112,7 @@ func (r *DecimalRing) Grow(m *mheap.Mheap) error {
 func (r *DecimalRing) Grows(size int, m *mheap.Mheap) error {
 	n := len(r.Vs)
 	if n == 0 {
-		data, err := mheap.Alloc(m, int64(size*8))
+		data, err := mheap.Alloc(m, int64(size*Decimal128Size))
 		if err != nil {
 			return err
 		}
@@ -138,11 +139,9 @@ func (r *DecimalRing) Grows(size int, m *mheap.Mheap) error {
 func (r *DecimalRing) Fill(i int64, sel, z int64, vec *vector.Vector) {
 	switch vec.Typ.Oid {
 	case types.T_decimal64:
-		tmp := types.Decimal64Decimal64Mul(vec.Col.([]types.Decimal64)[sel], types.Decimal64(z))
-		r.Vs[i] = types.Decimal128AddAligned(r.Vs[i], tmp)
+		r.Vs[i] = decimal128MulAdd(r.Vs[i], types.Decimal64ToDecimal128(vec.Col.([]types.Decimal64)[sel]), z)
 	case types.T_decimal128:
-		tmp := types.Decimal128Int64Mul(vec.Col.([]types.Decimal128)[sel], z)
-		r.Vs[i] = types.Decimal128AddAligned(r.Vs[i], tmp)
+		r.Vs[i] = decimal128MulAdd(r.Vs[i], vec.Col.([]types.Decimal128)[sel], z)
 	}
 	if nulls.Contains(vec.Nsp, uint64(sel)) {
 		r.Ns[i] += z
@@ -154,14 +153,12 @@ func (r *DecimalRing) BatchFill(start int64, os []uint8, vps []uint64, zs []int6
 	case types.T_decimal64:
 		vs := vec.Col.([]types.Decimal64)
 		for i := range os {
-			tmp := types.Decimal64Decimal64Mul(vs[int64(i)+start], types.Decimal64(zs[int64(i)+start]))
-			r.Vs[vps[i]-1] = types.Decimal128AddAligned(r.Vs[vps[i]-1], tmp)
+			r.Vs[vps[i]-1] = decimal128MulAdd(r.Vs[vps[i]-1], types.Decimal64ToDecimal128(vs[int64(i)+start]), zs[int64(i)+start])
 		}
 	case types.T_decimal128:
 		vs := vec.Col.([]types.Decimal128)
 		for i := range os {
-			tmp := types.Decimal128Int64Mul(vs[int64(i)+start], zs[int64(i)+start])
-			r.Vs[vps[i]-1] = types.Decimal128AddAligned(r.Vs[vps[i]-1], tmp)
+			r.Vs[vps[i]-1] = decimal128MulAdd(r.Vs[vps[i]-1], vs[int64(i)+start], zs[int64(i)+start])
 		}
 	}
 	if nulls.Any(vec.Nsp) {
@@ -176,29 +173,18 @@ func (r *DecimalRing) BatchFill(start int64, os []uint8, vps []uint64, zs []int6
 func (r *DecimalRing) BulkFill(i int64, zs []int64, vec *vector.Vector) {
 	switch vec.Typ.Oid {
 	case types.T_decimal64:
-		vs := vec.Col.([]types.Decimal64)
-		for j, v := range vs {
-			tmp := types.Decimal64Decimal64Mul(v, types.Decimal64(zs[j]))
-			r.Vs[i] = types.Decimal128AddAligned(r.Vs[i], tmp)
-		}
-		if nulls.Any(vec.Nsp) {
-			for j := range vs {
-				if nulls.Contains(vec.Nsp, uint64(j)) {
-					r.Ns[i] += zs[j]
-				}
-			}
+		for j, v := range vec.Col.([]types.Decimal64) {
+			r.Vs[i] = decimal128MulAdd(r.Vs[i], types.Decimal64ToDecimal128(v), zs[j])
 		}
 	case types.T_decimal128:
-		vs := vec.Col.([]types.Decimal128)
-		for j, v := range vs {
-			tmp := types.Decimal128Int64Mul(v, zs[j])
-			r.Vs[i] = types.Decimal128AddAligned(r.Vs[i], tmp)
+		for j, v := range vec.Col.([]types.Decimal128) {
+			r.Vs[i] = decimal128MulAdd(r.Vs[i], v, zs[j])
 		}
-		if nulls.Any(vec.Nsp) {
-			for j := range vs {
-				if nulls.Contains(vec.Nsp, uint64(j)) {
-					r.Ns[i] += zs[j]
-				}
+	}
+	if nulls.Any(vec.Nsp) {
+		for j := range zs {
+			if nulls.Contains(vec.Nsp, uint64(j)) {
+				r.Ns[i] += zs[j]
 			}
 		}
 	}
@@ -206,14 +192,14 @@ func (r *DecimalRing) BulkFill(i int64, zs []int64, vec *vector.Vector) {
 
 func (r *DecimalRing) Add(a interface{}, x, y int64) {
 	ar := a.(*DecimalRing)
-	r.Vs[x] = types.Decimal128AddAligned(r.Vs[x], ar.Vs[y])
+	r.Vs[x] = decimal128MulAdd(r.Vs[x], ar.Vs[y], 1)
 	r.Ns[x] += ar.Ns[y]
 }
 
 func (r *DecimalRing) BatchAdd(a interface{}, start int64, os []uint8, vps []uint64) {
 	ar := a.(*DecimalRing)
 	for i := range os {
-		r.Vs[vps[i]-1] = types.Decimal128AddAligned(r.Vs[vps[i]-1], ar.Vs[int64(i)+start])
+		r.Vs[vps[i]-1] = decimal128MulAdd(r.Vs[vps[i]-1], ar.Vs[int64(i)+start], 1)
 		r.Ns[vps[i]-1] += ar.Ns[int64(i)+start]
 	}
 }
@@ -222,8 +208,7 @@ func (r *DecimalRing) BatchAdd(a interface{}, start int64, os []uint8, vps []uin
 func (r *DecimalRing) Mul(a interface{}, x, y, z int64) {
 	ar := a.(*DecimalRing)
 	r.Ns[x] += ar.Ns[y] * z
-	tmp := types.Decimal128Int64Mul(ar.Vs[y], z)
-	r.Vs[x] = types.Decimal128AddAligned(r.Vs[x], tmp)
+	r.Vs[x] = decimal128MulAdd(r.Vs[x], ar.Vs[y], z)
 }
 
 func (r *DecimalRing) Eval(zs []int64) *vector.Vector {
@@ -232,16 +217,21 @@ func (r *DecimalRing) Eval(zs []int64) *vector.Vector {
 		r.Vs = nil
 		r.Ns = nil
 	}()
+	var err error
+
+	// the DecimalRing can have two types, but the result vector's type is Decimal128
+	width, scale := types.DecimalAvgResultType(r.Typ.Width, r.Typ.Scale)
+	resultTyp := types.Type{Oid: types.T_decimal128, Size: 16, Width: width, Scale: scale}
 	nsp := new(nulls.Nulls)
 	for i, z := range zs {
 		if n := z - r.Ns[i]; n == 0 {
 			nulls.Add(nsp, uint64(i))
 		} else {
-			r.Vs[i] = types.Decimal128Int64Div(r.Vs[i], n)
+			if r.Vs[i], err = types.Decimal128DivChecked(r.Vs[i], types.InitDecimal128(n), r.Typ.Scale, 0, scale); err != nil {
+				panic(err)
+			}
 		}
 	}
-	// the DecimalRing can have two types, but the result vector's type is Decimal128
-	resultTyp := types.Type{Oid: types.T_decimal128, Size: 16, Width: 38, Scale: r.Typ.Scale}
 
 	return &vector.Vector{
 		Nsp:  nsp,
@@ -251,3 +241,19 @@ func (r *DecimalRing) Eval(zs []int64) *vector.Vector {
 		Typ:  resultTyp,
 	}
 }
+
+// decimal128MulAdd returns a+b*z, it panics with types.ErrDecimalOverflow if the result is out of range,
+// and the panic is turned into an error of the query by the executor.
+func decimal128MulAdd(a, b types.Decimal128, z int64) types.Decimal128 {
+	var err error
+
+	if z != 1 {
+		if b, err = types.Decimal128MulChecked(b, types.InitDecimal128(z), 0, 0, 0); err != nil {
+			panic(err)
+		}
+	}
+	if a, err = types.Decimal128AddChecked(a, b, 0, 0); err != nil {
+		panic(err)
+	}
+	return a
+}
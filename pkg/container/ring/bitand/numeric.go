@@ -150,7 +150,7 @@ func (r *NumericRing) Grows(size int, m *mheap.Mheap) error {
 func (r *NumericRing) Fill(idxOfGroup, idxOfRow, cntOfRow int64, vec *vector.Vector) {
 	var rowData uint64
 	switch vec.Typ.Oid {
-	// case types.T_char, types.T_varchar: // currently not support
+	// case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob: // currently not support
 	case types.T_float32:
 		rowData = uint64(vec.Col.([]float32)[idxOfRow])
 	case types.T_float64:
@@ -0,0 +1,403 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package moment
+
+import (
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/ring"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// the statistics computed from the second central moments
+const (
+	VarSample = iota
+	StdDevSample
+	CovarPop
+	CovarSample
+	Corr
+)
+
+var names = [...]string{
+	VarSample:    "var_samp",
+	StdDevSample: "stddev_samp",
+	CovarPop:     "covar_pop",
+	CovarSample:  "covar_samp",
+	Corr:         "corr",
+}
+
+// MomentRing computes the statistics of every group from the count, the means and the second
+// central moments of its values. A value is added by the updating formula of Welford, and two
+// groups are merged by the formula of Chan et al, so the partial results are mergeable and not
+// prone to the cancellation of E(x^2) - E(x)^2.
+// The aggregates of one argument x take the pairs (x, x), and the rows are skipped if x or y is null.
+type MomentRing struct {
+	Typ  types.Type
+	Kind int
+	Da   []byte
+	Vs   []float64 // the result, its memory is Da
+	Ns   []float64 // count of the pairs
+	Mx   []float64 // mean of x
+	My   []float64 // mean of y
+	Cxy  []float64 // sum of (x - mean of x) * (y - mean of y)
+	Cxx  []float64 // sum of (x - mean of x)^2
+	Cyy  []float64 // sum of (y - mean of y)^2
+
+	ys *vector.Vector // the second argument, nil if the aggregate has one argument
+}
+
+func NewMomentRingWithTypeCheck(typ types.Type, kind int) (*MomentRing, error) {
+	if !supported(typ) {
+		return nil, errors.New(errno.FeatureNotSupported, fmt.Sprintf("'%v' not support %s", typ, names[kind]))
+	}
+	return NewMomentRing(typ, kind), nil
+}
+
+func NewMomentRing(typ types.Type, kind int) *MomentRing {
+	return &MomentRing{Typ: typ, Kind: kind}
+}
+
+func supported(typ types.Type) bool {
+	switch typ.Oid {
+	case types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64:
+	case types.T_int8, types.T_int16, types.T_int32, types.T_int64:
+	case types.T_float32, types.T_float64:
+	default:
+		return false
+	}
+	return true
+}
+
+// impl BinaryRing interface
+var _ ring.BinaryRing = (*MomentRing)(nil)
+
+func (r *MomentRing) String() string {
+	return fmt.Sprintf("%s-ring", names[r.Kind])
+}
+
+func (r *MomentRing) Free(m *mheap.Mheap) {
+	if r.Da != nil {
+		mheap.Free(m, r.Da)
+		r.Da = nil
+		r.Vs = nil
+		r.Ns, r.Mx, r.My = nil, nil, nil
+		r.Cxy, r.Cxx, r.Cyy = nil, nil, nil
+	}
+}
+
+func (r *MomentRing) Count() int {
+	return len(r.Vs)
+}
+
+func (r *MomentRing) Size() int {
+	size := cap(r.Da)
+	for _, vs := range [][]float64{r.Ns, r.Mx, r.My, r.Cxy, r.Cxx, r.Cyy} {
+		size += cap(vs) * 8
+	}
+	return size
+}
+
+func (r *MomentRing) Dup() ring.Ring {
+	return NewMomentRing(r.Typ, r.Kind)
+}
+
+func (r *MomentRing) Type() types.Type {
+	return r.Typ
+}
+
+func (r *MomentRing) SetArgument(vec *vector.Vector) {
+	r.ys = vec
+}
+
+func (r *MomentRing) SetLength(n int) {
+	r.Vs = r.Vs[:n]
+	r.Ns, r.Mx, r.My = r.Ns[:n], r.Mx[:n], r.My[:n]
+	r.Cxy, r.Cxx, r.Cyy = r.Cxy[:n], r.Cxx[:n], r.Cyy[:n]
+}
+
+func (r *MomentRing) Shrink(sels []int64) {
+	for i, sel := range sels {
+		r.Vs[i] = r.Vs[sel]
+		r.Ns[i], r.Mx[i], r.My[i] = r.Ns[sel], r.Mx[sel], r.My[sel]
+		r.Cxy[i], r.Cxx[i], r.Cyy[i] = r.Cxy[sel], r.Cxx[sel], r.Cyy[sel]
+	}
+	r.SetLength(len(sels))
+}
+
+func (r *MomentRing) Shuffle(_ []int64, _ *mheap.Mheap) error {
+	return nil
+}
+
+func (r *MomentRing) Grow(m *mheap.Mheap) error {
+	return r.Grows(1, m)
+}
+
+func (r *MomentRing) Grows(size int, m *mheap.Mheap) error {
+	n := len(r.Vs)
+	if n == 0 {
+		data, err := mheap.Alloc(m, int64(size*8))
+		if err != nil {
+			return err
+		}
+		r.Da = data
+		r.Vs = encoding.DecodeFloat64Slice(data)
+	} else if n+size >= cap(r.Vs) {
+		r.Da = r.Da[:n*8]
+		data, err := mheap.Grow(m, r.Da, int64(n+size)*8)
+		if err != nil {
+			return err
+		}
+		mheap.Free(m, r.Da)
+		r.Da = data
+		r.Vs = encoding.DecodeFloat64Slice(data)
+	}
+	r.Vs = r.Vs[:n+size]
+	for i := 0; i < size; i++ {
+		r.Vs[n+i] = 0
+		r.Ns = append(r.Ns, 0)
+		r.Mx = append(r.Mx, 0)
+		r.My = append(r.My, 0)
+		r.Cxy = append(r.Cxy, 0)
+		r.Cxx = append(r.Cxx, 0)
+		r.Cyy = append(r.Cyy, 0)
+	}
+	return nil
+}
+
+func (r *MomentRing) Fill(i int64, sel, z int64, vec *vector.Vector) {
+	r.fill(i, sel, z, vec)
+}
+
+func (r *MomentRing) BatchFill(start int64, os []uint8, vps []uint64, zs []int64, vec *vector.Vector) {
+	for i := range os {
+		sel := int64(i) + start
+		r.fill(int64(vps[i]-1), sel, zs[sel], vec)
+	}
+}
+
+func (r *MomentRing) BulkFill(i int64, zs []int64, vec *vector.Vector) {
+	for j, z := range zs {
+		r.fill(i, int64(j), z, vec)
+	}
+}
+
+// fill adds z times the pair of row sel to group i
+func (r *MomentRing) fill(i int64, sel, z int64, vec *vector.Vector) {
+	x, ok := value(vec, sel)
+	if !ok {
+		return
+	}
+	y := x
+	if r.ys != nil {
+		if y, ok = value(r.ys, sel); !ok {
+			return
+		}
+	}
+	w := float64(z)
+	n := r.Ns[i] + w
+	dx, dy := x-r.Mx[i], y-r.My[i]
+	r.Mx[i] += dx * w / n
+	r.My[i] += dy * w / n
+	r.Cxy[i] += w * dx * (y - r.My[i])
+	r.Cxx[i] += w * dx * (x - r.Mx[i])
+	r.Cyy[i] += w * dy * (y - r.My[i])
+	r.Ns[i] = n
+}
+
+// merge merges z times the group y of ring b into the group x
+func (r *MomentRing) merge(b *MomentRing, x, y int64, z float64) {
+	nb := b.Ns[y] * z
+	if nb == 0 {
+		return
+	}
+	na := r.Ns[x]
+	n := na + nb
+	dx, dy := b.Mx[y]-r.Mx[x], b.My[y]-r.My[x]
+	r.Mx[x] += dx * nb / n
+	r.My[x] += dy * nb / n
+	r.Cxy[x] += b.Cxy[y]*z + dx*dy*na*nb/n
+	r.Cxx[x] += b.Cxx[y]*z + dx*dx*na*nb/n
+	r.Cyy[x] += b.Cyy[y]*z + dy*dy*na*nb/n
+	r.Ns[x] = n
+}
+
+func (r *MomentRing) Add(a interface{}, x, y int64) {
+	r.merge(a.(*MomentRing), x, y, 1)
+}
+
+func (r *MomentRing) BatchAdd(a interface{}, start int64, os []uint8, vps []uint64) {
+	ar := a.(*MomentRing)
+	for i := range os {
+		r.merge(ar, int64(vps[i]-1), start+int64(i), 1)
+	}
+}
+
+func (r *MomentRing) Mul(a interface{}, x, y, z int64) {
+	r.merge(a.(*MomentRing), x, y, float64(z))
+}
+
+// Eval returns the statistics, it is null if there are not enough pairs, or
+// the correlation of the group whose x or y is constant.
+func (r *MomentRing) Eval(_ []int64) *vector.Vector {
+	defer func() {
+		r.Da = nil
+		r.Vs = nil
+		r.Ns, r.Mx, r.My = nil, nil, nil
+		r.Cxy, r.Cxx, r.Cyy = nil, nil, nil
+		r.ys = nil
+	}()
+	nsp := new(nulls.Nulls)
+	for i, n := range r.Ns {
+		switch r.Kind {
+		case VarSample, StdDevSample:
+			if n < 2 {
+				nulls.Add(nsp, uint64(i))
+				continue
+			}
+			r.Vs[i] = r.Cxx[i] / (n - 1)
+			if r.Kind == StdDevSample {
+				r.Vs[i] = math.Sqrt(r.Vs[i])
+			}
+		case CovarPop:
+			if n < 1 {
+				nulls.Add(nsp, uint64(i))
+				continue
+			}
+			r.Vs[i] = r.Cxy[i] / n
+		case CovarSample:
+			if n < 2 {
+				nulls.Add(nsp, uint64(i))
+				continue
+			}
+			r.Vs[i] = r.Cxy[i] / (n - 1)
+		case Corr:
+			if n < 1 || r.Cxx[i] == 0 || r.Cyy[i] == 0 {
+				nulls.Add(nsp, uint64(i))
+				continue
+			}
+			r.Vs[i] = r.Cxy[i] / math.Sqrt(r.Cxx[i]*r.Cyy[i])
+		}
+	}
+	return &vector.Vector{
+		Nsp:  nsp,
+		Data: r.Da,
+		Col:  r.Vs,
+		Or:   false,
+		Typ:  types.Type{Oid: types.T_float64, Size: 8},
+	}
+}
+
+func (r *MomentRing) Marshal(w io.Writer) error {
+	// length
+	n := len(r.Vs)
+	if _, err := w.Write(encoding.EncodeUint32(uint32(n))); err != nil {
+		return err
+	}
+	// values and moments
+	if n > 0 {
+		for _, vs := range [][]float64{r.Vs, r.Ns, r.Mx, r.My, r.Cxy, r.Cxx, r.Cyy} {
+			if _, err := w.Write(encoding.EncodeFloat64Slice(vs)); err != nil {
+				return err
+			}
+		}
+	}
+	// type and kind
+	if _, err := w.Write(encoding.EncodeType(r.Typ)); err != nil {
+		return err
+	}
+	_, err := w.Write(encoding.EncodeInt64(int64(r.Kind)))
+	return err
+}
+
+// Unmarshal builds MomentRing from data, the bytes of the values are reused directly
+func (r *MomentRing) Unmarshal(data []byte) ([]byte, error) {
+	return r.unmarshal(data, nil)
+}
+
+// UnmarshalWithProc builds MomentRing from data, the values are copied into the memory of the process
+func (r *MomentRing) UnmarshalWithProc(data []byte, proc *process.Process) ([]byte, error) {
+	return r.unmarshal(data, proc)
+}
+
+func (r *MomentRing) unmarshal(data []byte, proc *process.Process) ([]byte, error) {
+	n := encoding.DecodeUint32(data[:4])
+	data = data[4:]
+	if n > 0 {
+		if proc == nil {
+			r.Da = data[:n*8]
+		} else {
+			da, err := mheap.Alloc(proc.Mp, int64(n*8))
+			if err != nil {
+				return nil, err
+			}
+			copy(da, data[:n*8])
+			r.Da = da
+		}
+		r.Vs = encoding.DecodeFloat64Slice(r.Da)
+		data = data[n*8:]
+		// the moments are appended to by Grows, so they are always copied
+		for _, vs := range []*[]float64{&r.Ns, &r.Mx, &r.My, &r.Cxy, &r.Cxx, &r.Cyy} {
+			*vs = make([]float64, n)
+			copy(*vs, encoding.DecodeFloat64Slice(data[:n*8]))
+			data = data[n*8:]
+		}
+	}
+	r.Typ = encoding.DecodeType(data[:encoding.TypeSize])
+	data = data[encoding.TypeSize:]
+	r.Kind = int(encoding.DecodeInt64(data[:8]))
+	return data[8:], nil
+}
+
+// value returns the value of row i as a float64, and false if it is null
+func value(vec *vector.Vector, i int64) (float64, bool) {
+	if vec.IsConst {
+		i = 0
+	}
+	if nulls.Contains(vec.Nsp, uint64(i)) {
+		return 0, false
+	}
+	switch vec.Typ.Oid {
+	case types.T_int8:
+		return float64(vec.Col.([]int8)[i]), true
+	case types.T_int16:
+		return float64(vec.Col.([]int16)[i]), true
+	case types.T_int32:
+		return float64(vec.Col.([]int32)[i]), true
+	case types.T_int64:
+		return float64(vec.Col.([]int64)[i]), true
+	case types.T_uint8:
+		return float64(vec.Col.([]uint8)[i]), true
+	case types.T_uint16:
+		return float64(vec.Col.([]uint16)[i]), true
+	case types.T_uint32:
+		return float64(vec.Col.([]uint32)[i]), true
+	case types.T_uint64:
+		return float64(vec.Col.([]uint64)[i]), true
+	case types.T_float32:
+		return float64(vec.Col.([]float32)[i]), true
+	case types.T_float64:
+		return vec.Col.([]float64)[i], true
+	}
+	return 0, false
+}
@@ -0,0 +1,138 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package moment
+
+import (
+	"math"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMoment(t *testing.T) {
+	m := mheap.New(guest.New(1<<30, host.New(1<<30)))
+	// the pairs are (1, 2), (2, 3), (null, 7), (4, null), (3, 6), (1e9+5, 1)
+	xs := vector.New(types.Type{Oid: types.T_int64, Size: 8})
+	xs.Col = []int64{1, 2, 0, 4, 3, 1e9 + 5}
+	nulls.Add(xs.Nsp, 2)
+	ys := vector.New(types.Type{Oid: types.T_float64, Size: 8})
+	ys.Col = []float64{2, 3, 7, 0, 6, 1}
+	nulls.Add(ys.Nsp, 3)
+	zs := []int64{1, 1, 1, 1, 1, 1}
+
+	tests := []struct {
+		kind int
+		want float64
+	}{
+		// the pairs (1, 2), (2, 3), (3, 6) of group 0
+		{VarSample, 1},
+		{StdDevSample, 1},
+		{CovarPop, 4.0 / 3},
+		{CovarSample, 2},
+		{Corr, 2 / math.Sqrt(1*(13.0/3))},
+	}
+	for _, tt := range tests {
+		// group 0 is filled by two rings which are merged, and group 1 has a single pair
+		r0, err := NewMomentRingWithTypeCheck(xs.Typ, tt.kind)
+		require.NoError(t, err)
+		r1 := r0.Dup().(*MomentRing)
+		require.NoError(t, r0.Grows(2, m))
+		require.NoError(t, r1.Grows(1, m))
+		// the result and the six moments of every group
+		require.GreaterOrEqual(t, r0.Size(), 2*7*8)
+		if tt.kind != VarSample && tt.kind != StdDevSample {
+			r0.SetArgument(ys)
+			r1.SetArgument(ys)
+		}
+		r0.BulkFill(0, zs[:2], xs)
+		for j := int64(2); j < 5; j++ {
+			r1.Fill(0, j, 1, xs)
+		}
+		r0.Fill(1, 5, 1, xs)
+		r0.Add(r1, 0, 0)
+		r1.Free(m)
+
+		v := r0.Eval([]int64{5, 1})
+		want := tt.want
+		if tt.kind == VarSample {
+			// the x of group 0 are 1, 2, 4, 3 without the second argument
+			want = 5.0 / 3
+		} else if tt.kind == StdDevSample {
+			want = math.Sqrt(5.0 / 3)
+		}
+		require.InDelta(t, want, v.Col.([]float64)[0], 1e-9, names[tt.kind])
+		// only the population covariance of a single pair is not null
+		require.Equal(t, tt.kind != CovarPop, nulls.Contains(v.Nsp, 1), names[tt.kind])
+		mheap.Free(m, v.Data)
+	}
+	require.Equal(t, int64(0), mheap.Size(m))
+
+	_, err := NewMomentRingWithTypeCheck(types.Type{Oid: types.T_varchar}, Corr)
+	require.Error(t, err)
+}
+
+func TestMomentMerge(t *testing.T) {
+	m := mheap.New(guest.New(1<<30, host.New(1<<30)))
+	vec := vector.New(types.Type{Oid: types.T_float64, Size: 8})
+	vs := make([]float64, 1000)
+	for i := range vs {
+		// a large mean makes E(x^2) - E(x)^2 lose all the digits
+		vs[i] = 1e9 + float64(i%10)
+	}
+	vec.Col = vs
+	zs := make([]int64, len(vs))
+	for i := range zs {
+		zs[i] = 1
+	}
+
+	r := NewMomentRing(vec.Typ, VarSample)
+	require.NoError(t, r.Grow(m))
+	// every row of a batch fills the group vps[i]-1, which is 0 here
+	vps := make([]uint64, 250)
+	for i := range vps {
+		vps[i] = 1
+	}
+	parts := make([]*MomentRing, 4)
+	for i := range parts {
+		parts[i] = r.Dup().(*MomentRing)
+		require.NoError(t, parts[i].Grow(m))
+		parts[i].BatchFill(int64(i*250), make([]uint8, 250), vps, zs, vec)
+	}
+	for _, p := range parts {
+		r.Add(p, 0, 0)
+		p.Free(m)
+	}
+	v := r.Eval(zs[:1])
+	require.InDelta(t, 8.25*1000/999, v.Col.([]float64)[0], 1e-6)
+	mheap.Free(m, v.Data)
+
+	// a group joined with 3 rows counts its values 3 times
+	r0, r1 := NewMomentRing(vec.Typ, VarSample), NewMomentRing(vec.Typ, VarSample)
+	require.NoError(t, r0.Grow(m))
+	require.NoError(t, r1.Grow(m))
+	r1.BulkFill(0, zs[:10], vec)
+	r0.Mul(r1, 0, 0, 3)
+	r1.Free(m)
+	v = r0.Eval(zs[:1])
+	require.InDelta(t, 8.25*30/29, v.Col.([]float64)[0], 1e-6)
+	mheap.Free(m, v.Data)
+	require.Equal(t, int64(0), mheap.Size(m))
+}
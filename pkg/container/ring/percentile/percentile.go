@@ -0,0 +1,409 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package percentile
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"sort"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/ring"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// PercentileRing computes the exact percentile of every group, it keeps all the values
+// of a group and their counts, so the partial results are merged by concatenation.
+// The continuous percentile interpolates between the two values around the position
+// Percentile * (n - 1), and the discrete one is the first value whose cumulative
+// distribution is not less than Percentile.
+type PercentileRing struct {
+	Typ        types.Type
+	Percentile float64 // from 0 to 1
+	Disc       bool
+	Vs         []float64 // the result, its memory is Da
+	Da         []byte
+	Xs         [][]float64 // the values of every group
+	Zs         [][]int64   // the counts of the values
+}
+
+func NewPercentileRingWithTypeCheck(typ types.Type, percentile float64, disc bool) (*PercentileRing, error) {
+	if !supported(typ) {
+		return nil, errors.New(errno.FeatureNotSupported, fmt.Sprintf("'%v' not support percentile", typ))
+	}
+	return NewPercentile(typ, percentile, disc), nil
+}
+
+func NewPercentile(typ types.Type, percentile float64, disc bool) *PercentileRing {
+	return &PercentileRing{
+		Typ:        typ,
+		Percentile: percentile,
+		Disc:       disc,
+	}
+}
+
+func supported(typ types.Type) bool {
+	switch typ.Oid {
+	case types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64:
+	case types.T_int8, types.T_int16, types.T_int32, types.T_int64:
+	case types.T_float32, types.T_float64:
+	default:
+		return false
+	}
+	return true
+}
+
+// impl Ring interface
+var _ ring.Ring = (*PercentileRing)(nil)
+
+func (r *PercentileRing) String() string {
+	if r.Disc {
+		return fmt.Sprintf("percentile-disc-ring(%v)", r.Percentile)
+	}
+	return fmt.Sprintf("percentile-cont-ring(%v)", r.Percentile)
+}
+
+func (r *PercentileRing) Free(m *mheap.Mheap) {
+	if r.Da != nil {
+		mheap.Free(m, r.Da)
+		r.Da = nil
+		r.Vs = nil
+		r.Xs = nil
+		r.Zs = nil
+	}
+}
+
+func (r *PercentileRing) Count() int {
+	return len(r.Vs)
+}
+
+func (r *PercentileRing) Size() int {
+	size := cap(r.Da)
+	for _, xs := range r.Xs {
+		size += cap(xs) * 16
+	}
+	return size
+}
+
+func (r *PercentileRing) Dup() ring.Ring {
+	return NewPercentile(r.Typ, r.Percentile, r.Disc)
+}
+
+func (r *PercentileRing) Type() types.Type {
+	return r.Typ
+}
+
+func (r *PercentileRing) SetLength(n int) {
+	r.Vs = r.Vs[:n]
+	r.Xs = r.Xs[:n]
+	r.Zs = r.Zs[:n]
+}
+
+func (r *PercentileRing) Shrink(sels []int64) {
+	for i, sel := range sels {
+		r.Vs[i] = r.Vs[sel]
+		r.Xs[i] = r.Xs[sel]
+		r.Zs[i] = r.Zs[sel]
+	}
+	r.SetLength(len(sels))
+}
+
+func (r *PercentileRing) Shuffle(_ []int64, _ *mheap.Mheap) error {
+	return nil
+}
+
+func (r *PercentileRing) Grow(m *mheap.Mheap) error {
+	return r.Grows(1, m)
+}
+
+func (r *PercentileRing) Grows(size int, m *mheap.Mheap) error {
+	n := len(r.Vs)
+	if n == 0 {
+		data, err := mheap.Alloc(m, int64(size*8))
+		if err != nil {
+			return err
+		}
+		r.Da = data
+		r.Vs = encoding.DecodeFloat64Slice(data)
+	} else if n+size >= cap(r.Vs) {
+		r.Da = r.Da[:n*8]
+		data, err := mheap.Grow(m, r.Da, int64(n+size)*8)
+		if err != nil {
+			return err
+		}
+		mheap.Free(m, r.Da)
+		r.Da = data
+		r.Vs = encoding.DecodeFloat64Slice(data)
+	}
+	r.Vs = r.Vs[:n+size]
+	for i := 0; i < size; i++ {
+		r.Vs[n+i] = 0
+		r.Xs = append(r.Xs, nil)
+		r.Zs = append(r.Zs, nil)
+	}
+	return nil
+}
+
+func (r *PercentileRing) Fill(i int64, sel, z int64, vec *vector.Vector) {
+	if v, ok := value(vec, sel); ok {
+		r.Xs[i] = append(r.Xs[i], v)
+		r.Zs[i] = append(r.Zs[i], z)
+	}
+}
+
+func (r *PercentileRing) BatchFill(start int64, os []uint8, vps []uint64, zs []int64, vec *vector.Vector) {
+	for i := range os {
+		sel := int64(i) + start
+		if v, ok := value(vec, sel); ok {
+			r.Xs[vps[i]-1] = append(r.Xs[vps[i]-1], v)
+			r.Zs[vps[i]-1] = append(r.Zs[vps[i]-1], zs[sel])
+		}
+	}
+}
+
+func (r *PercentileRing) BulkFill(i int64, zs []int64, vec *vector.Vector) {
+	for j, z := range zs {
+		if v, ok := value(vec, int64(j)); ok {
+			r.Xs[i] = append(r.Xs[i], v)
+			r.Zs[i] = append(r.Zs[i], z)
+		}
+	}
+}
+
+func (r *PercentileRing) Add(a interface{}, x, y int64) {
+	ar := a.(*PercentileRing)
+	r.Xs[x] = append(r.Xs[x], ar.Xs[y]...)
+	r.Zs[x] = append(r.Zs[x], ar.Zs[y]...)
+}
+
+func (r *PercentileRing) BatchAdd(a interface{}, start int64, os []uint8, vps []uint64) {
+	ar := a.(*PercentileRing)
+	for i := range os {
+		r.Add(ar, int64(vps[i]-1), start+int64(i))
+	}
+}
+
+func (r *PercentileRing) Mul(a interface{}, x, y, z int64) {
+	ar := a.(*PercentileRing)
+	r.Xs[x] = append(r.Xs[x], ar.Xs[y]...)
+	for _, n := range ar.Zs[y] {
+		r.Zs[x] = append(r.Zs[x], n*z)
+	}
+}
+
+func (r *PercentileRing) Eval(_ []int64) *vector.Vector {
+	defer func() {
+		r.Da = nil
+		r.Vs = nil
+		r.Xs = nil
+		r.Zs = nil
+	}()
+	nsp := new(nulls.Nulls)
+	for i := range r.Vs {
+		v, ok := r.percentile(r.Xs[i], r.Zs[i])
+		if !ok {
+			nulls.Add(nsp, uint64(i))
+			continue
+		}
+		r.Vs[i] = v
+	}
+	return &vector.Vector{
+		Nsp:  nsp,
+		Data: r.Da,
+		Col:  r.Vs,
+		Or:   false,
+		Typ:  types.Type{Oid: types.T_float64, Size: 8},
+	}
+}
+
+func (r *PercentileRing) Marshal(w io.Writer) error {
+	// length
+	n := len(r.Vs)
+	if _, err := w.Write(encoding.EncodeUint32(uint32(n))); err != nil {
+		return err
+	}
+	// values
+	if n > 0 {
+		if _, err := w.Write(encoding.EncodeFloat64Slice(r.Vs)); err != nil {
+			return err
+		}
+	}
+	// the values and the counts of every group
+	for i, xs := range r.Xs {
+		if _, err := w.Write(encoding.EncodeUint32(uint32(len(xs)))); err != nil {
+			return err
+		}
+		if _, err := w.Write(encoding.EncodeFloat64Slice(xs)); err != nil {
+			return err
+		}
+		if _, err := w.Write(encoding.EncodeInt64Slice(r.Zs[i])); err != nil {
+			return err
+		}
+	}
+	// type, percentile and disc
+	if _, err := w.Write(encoding.EncodeType(r.Typ)); err != nil {
+		return err
+	}
+	if _, err := w.Write(encoding.EncodeFloat64(r.Percentile)); err != nil {
+		return err
+	}
+	disc := []byte{0}
+	if r.Disc {
+		disc[0] = 1
+	}
+	_, err := w.Write(disc)
+	return err
+}
+
+// Unmarshal builds PercentileRing from data, the bytes of the values are reused directly
+func (r *PercentileRing) Unmarshal(data []byte) ([]byte, error) {
+	return r.unmarshal(data, nil)
+}
+
+// UnmarshalWithProc builds PercentileRing from data, the values are copied into the memory of the process
+func (r *PercentileRing) UnmarshalWithProc(data []byte, proc *process.Process) ([]byte, error) {
+	return r.unmarshal(data, proc)
+}
+
+func (r *PercentileRing) unmarshal(data []byte, proc *process.Process) ([]byte, error) {
+	n := encoding.DecodeUint32(data[:4])
+	data = data[4:]
+	if n > 0 {
+		if proc == nil {
+			r.Da = data[:n*8]
+		} else {
+			da, err := mheap.Alloc(proc.Mp, int64(n*8))
+			if err != nil {
+				return nil, err
+			}
+			copy(da, data[:n*8])
+			r.Da = da
+		}
+		r.Vs = encoding.DecodeFloat64Slice(r.Da)
+		data = data[n*8:]
+	}
+	r.Xs = make([][]float64, n)
+	r.Zs = make([][]int64, n)
+	for i := range r.Xs {
+		m := encoding.DecodeUint32(data[:4])
+		data = data[4:]
+		if m == 0 {
+			continue
+		}
+		r.Xs[i] = make([]float64, m)
+		copy(r.Xs[i], encoding.DecodeFloat64Slice(data[:m*8]))
+		data = data[m*8:]
+		r.Zs[i] = make([]int64, m)
+		copy(r.Zs[i], encoding.DecodeInt64Slice(data[:m*8]))
+		data = data[m*8:]
+	}
+	r.Typ = encoding.DecodeType(data[:encoding.TypeSize])
+	data = data[encoding.TypeSize:]
+	r.Percentile = encoding.DecodeFloat64(data[:8])
+	r.Disc = data[8] == 1
+	return data[9:], nil
+}
+
+// percentile returns the percentile of the values, and false if there is no value
+func (r *PercentileRing) percentile(xs []float64, zs []int64) (float64, bool) {
+	sort.Sort(&valueCounts{xs: xs, zs: zs})
+	n := int64(0)
+	for _, z := range zs {
+		n += z
+	}
+	if n == 0 {
+		return 0, false
+	}
+	if r.Disc {
+		// the first value whose cumulative distribution is not less than the percentile
+		rank := int64(math.Ceil(r.Percentile * float64(n)))
+		return at(xs, zs, rank-1), true
+	}
+	pos := r.Percentile * float64(n-1)
+	lower := int64(math.Floor(pos))
+	x := at(xs, zs, lower)
+	if frac := pos - float64(lower); frac > 0 {
+		return x + (at(xs, zs, lower+1)-x)*frac, true
+	}
+	return x, true
+}
+
+// at returns the value of rank k from 0 of the sorted values
+func at(xs []float64, zs []int64, k int64) float64 {
+	if k < 0 {
+		k = 0
+	}
+	for i, z := range zs {
+		if k < z {
+			return xs[i]
+		}
+		k -= z
+	}
+	return xs[len(xs)-1]
+}
+
+// valueCounts sorts the values with their counts
+type valueCounts struct {
+	xs []float64
+	zs []int64
+}
+
+func (v *valueCounts) Len() int           { return len(v.xs) }
+func (v *valueCounts) Less(i, j int) bool { return v.xs[i] < v.xs[j] }
+func (v *valueCounts) Swap(i, j int) {
+	v.xs[i], v.xs[j] = v.xs[j], v.xs[i]
+	v.zs[i], v.zs[j] = v.zs[j], v.zs[i]
+}
+
+// value returns the value of row i as a float64, and false if it is null or NaN
+func value(vec *vector.Vector, i int64) (float64, bool) {
+	if vec.IsConst {
+		i = 0
+	}
+	if nulls.Contains(vec.Nsp, uint64(i)) {
+		return 0, false
+	}
+	var v float64
+	switch vec.Typ.Oid {
+	case types.T_int8:
+		v = float64(vec.Col.([]int8)[i])
+	case types.T_int16:
+		v = float64(vec.Col.([]int16)[i])
+	case types.T_int32:
+		v = float64(vec.Col.([]int32)[i])
+	case types.T_int64:
+		v = float64(vec.Col.([]int64)[i])
+	case types.T_uint8:
+		v = float64(vec.Col.([]uint8)[i])
+	case types.T_uint16:
+		v = float64(vec.Col.([]uint16)[i])
+	case types.T_uint32:
+		v = float64(vec.Col.([]uint32)[i])
+	case types.T_uint64:
+		v = float64(vec.Col.([]uint64)[i])
+	case types.T_float32:
+		v = float64(vec.Col.([]float32)[i])
+	case types.T_float64:
+		v = vec.Col.([]float64)[i]
+	}
+	return v, !math.IsNaN(v)
+}
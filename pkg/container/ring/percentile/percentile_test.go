@@ -0,0 +1,85 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package percentile
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPercentile(t *testing.T) {
+	m := mheap.New(guest.New(1<<30, host.New(1<<30)))
+	vec := vector.New(types.Type{Oid: types.T_int32, Size: 4})
+	vec.Col = []int32{3, 1, 0, 4, 2}
+	nulls.Add(vec.Nsp, 2)
+	zs := []int64{1, 1, 1, 1, 1}
+
+	tests := []struct {
+		percentile float64
+		disc       bool
+		want       float64
+	}{
+		{0.5, false, 2.5},
+		{0.5, true, 2},
+		{0.25, false, 1.75},
+		{0.25, true, 1},
+		{0, false, 1},
+		{0, true, 1},
+		{1, false, 4},
+		{1, true, 4},
+	}
+	for _, tt := range tests {
+		// group 0 is filled by two rings which are merged, and group 1 has only the null
+		r0, err := NewPercentileRingWithTypeCheck(vec.Typ, tt.percentile, tt.disc)
+		require.NoError(t, err)
+		r1 := r0.Dup().(*PercentileRing)
+		require.NoError(t, r0.Grows(2, m))
+		require.NoError(t, r1.Grow(m))
+		r0.BulkFill(0, zs[:2], vec)
+		r0.Fill(1, 2, 1, vec)
+		r1.Fill(0, 3, 1, vec)
+		r1.Fill(0, 4, 1, vec)
+		r0.Add(r1, 0, 0)
+		r1.Free(m)
+
+		v := r0.Eval(zs[:2])
+		require.Equal(t, tt.want, v.Col.([]float64)[0], r0.String())
+		require.True(t, nulls.Contains(v.Nsp, 1))
+		mheap.Free(m, v.Data)
+	}
+
+	// a group joined with 3 rows counts its values 3 times, the values are 1, 1, 1, 5, 5, 5
+	r0, r1 := NewPercentile(vec.Typ, 0.4, true), NewPercentile(vec.Typ, 0.4, true)
+	require.NoError(t, r0.Grow(m))
+	require.NoError(t, r1.Grow(m))
+	r1.Fill(0, 1, 1, vec)
+	r1.Fill(0, 3, 1, vec)
+	r0.Mul(r1, 0, 0, 3)
+	r1.Free(m)
+	v := r0.Eval(zs[:1])
+	require.Equal(t, float64(1), v.Col.([]float64)[0])
+	mheap.Free(m, v.Data)
+	require.Equal(t, int64(0), mheap.Size(m))
+
+	_, err := NewPercentileRingWithTypeCheck(types.Type{Oid: types.T_char}, 0.5, false)
+	require.Error(t, err)
+}
@@ -132,20 +132,32 @@ func (r *Decimal128Ring) Grows(size int, m *mheap.Mheap) error {
 	return nil
 }
 
-// what is this z?
+// Fill adds the value at sel of vec which appears z times to the i-th group.
+// vec may be a decimal64 vector, the sum of decimal64 values is accumulated in decimal128.
 func (r *Decimal128Ring) Fill(i int64, sel, z int64, vec *vector.Vector) {
-	tmp := types.Decimal128Int64Mul(vec.Col.([]types.Decimal128)[sel], z)
-	r.Vs[i] = types.Decimal128AddAligned(r.Vs[i], tmp)
+	switch vec.Typ.Oid {
+	case types.T_decimal64:
+		r.Vs[i] = decimal128MulAdd(r.Vs[i], types.Decimal64ToDecimal128(vec.Col.([]types.Decimal64)[sel]), z)
+	case types.T_decimal128:
+		r.Vs[i] = decimal128MulAdd(r.Vs[i], vec.Col.([]types.Decimal128)[sel], z)
+	}
 	if nulls.Contains(vec.Nsp, uint64(sel)) {
 		r.Ns[i] += z
 	}
 }
 
 func (r *Decimal128Ring) BatchFill(start int64, os []uint8, vps []uint64, zs []int64, vec *vector.Vector) {
-	vs := vec.Col.([]types.Decimal128)
-	for i := range os {
-		tmp := types.Decimal128Int64Mul(vs[int64(i)+start], zs[int64(i)+start])
-		r.Vs[vps[i]-1] = types.Decimal128AddAligned(r.Vs[vps[i]-1], tmp)
+	switch vec.Typ.Oid {
+	case types.T_decimal64:
+		vs := vec.Col.([]types.Decimal64)
+		for i := range os {
+			r.Vs[vps[i]-1] = decimal128MulAdd(r.Vs[vps[i]-1], types.Decimal64ToDecimal128(vs[int64(i)+start]), zs[int64(i)+start])
+		}
+	case types.T_decimal128:
+		vs := vec.Col.([]types.Decimal128)
+		for i := range os {
+			r.Vs[vps[i]-1] = decimal128MulAdd(r.Vs[vps[i]-1], vs[int64(i)+start], zs[int64(i)+start])
+		}
 	}
 	if nulls.Any(vec.Nsp) {
 		for i := range os {
@@ -157,15 +169,20 @@ func (r *Decimal128Ring) BatchFill(start int64, os []uint8, vps []uint64, zs []i
 }
 
 func (r *Decimal128Ring) BulkFill(i int64, zs []int64, vec *vector.Vector) {
-	vs := vec.Col.([]types.Decimal128)
-	for j, v := range vs {
-		tmp := types.Decimal128Int64Mul(v, zs[j])
-		r.Vs[i] = types.Decimal128AddAligned(r.Vs[i], tmp)
-		if nulls.Any(vec.Nsp) {
-			for k := range vs {
-				if nulls.Contains(vec.Nsp, uint64(k)) {
-					r.Ns[i] += zs[k]
-				}
+	switch vec.Typ.Oid {
+	case types.T_decimal64:
+		for j, v := range vec.Col.([]types.Decimal64) {
+			r.Vs[i] = decimal128MulAdd(r.Vs[i], types.Decimal64ToDecimal128(v), zs[j])
+		}
+	case types.T_decimal128:
+		for j, v := range vec.Col.([]types.Decimal128) {
+			r.Vs[i] = decimal128MulAdd(r.Vs[i], v, zs[j])
+		}
+	}
+	if nulls.Any(vec.Nsp) {
+		for j := range zs {
+			if nulls.Contains(vec.Nsp, uint64(j)) {
+				r.Ns[i] += zs[j]
 			}
 		}
 	}
@@ -173,14 +190,14 @@ func (r *Decimal128Ring) BulkFill(i int64, zs []int64, vec *vector.Vector) {
 
 func (r *Decimal128Ring) Add(a interface{}, x, y int64) {
 	ar := a.(*Decimal128Ring)
-	r.Vs[x] = types.Decimal128AddAligned(r.Vs[x], ar.Vs[y])
+	r.Vs[x] = decimal128MulAdd(r.Vs[x], ar.Vs[y], 1)
 	r.Ns[x] += ar.Ns[y]
 }
 
 func (r *Decimal128Ring) BatchAdd(a interface{}, start int64, os []uint8, vps []uint64) {
 	ar := a.(*Decimal128Ring)
 	for i := range os {
-		r.Vs[vps[i]-1] = types.Decimal128AddAligned(r.Vs[vps[i]-1], ar.Vs[int64(i)+start])
+		r.Vs[vps[i]-1] = decimal128MulAdd(r.Vs[vps[i]-1], ar.Vs[int64(i)+start], 1)
 		r.Ns[vps[i]-1] += ar.Ns[int64(i)+start]
 	}
 }
@@ -189,8 +206,7 @@ func (r *Decimal128Ring) BatchAdd(a interface{}, start int64, os []uint8, vps []
 func (r *Decimal128Ring) Mul(a interface{}, x, y, z int64) {
 	ar := a.(*Decimal128Ring)
 	r.Ns[x] += ar.Ns[y] * z
-	tmp := types.Decimal128Int64Mul(r.Vs[y], z)
-	r.Vs[x] = types.Decimal128AddAligned(r.Vs[x], tmp)
+	r.Vs[x] = decimal128MulAdd(r.Vs[x], ar.Vs[y], z)
 }
 
 func (r *Decimal128Ring) Eval(zs []int64) *vector.Vector {
@@ -205,7 +221,9 @@ func (r *Decimal128Ring) Eval(zs []int64) *vector.Vector {
 			nulls.Add(nsp, uint64(i))
 		}
 	}
-	resultVecType := r.Typ
+	// the sum of decimal64 values is decimal128 as well, and it has 22 more digits than the column
+	width, scale := types.DecimalSumResultType(r.Typ.Width, r.Typ.Scale)
+	resultVecType := types.Type{Oid: types.T_decimal128, Size: 16, Width: width, Scale: scale}
 	return &vector.Vector{
 		Nsp:  nsp,
 		Data: r.Da,
@@ -214,3 +232,19 @@ func (r *Decimal128Ring) Eval(zs []int64) *vector.Vector {
 		Typ:  resultVecType,
 	}
 }
+
+// decimal128MulAdd returns a+b*z, it panics with types.ErrDecimalOverflow if the result is out of range,
+// and the panic is turned into an error of the query by the executor.
+func decimal128MulAdd(a, b types.Decimal128, z int64) types.Decimal128 {
+	var err error
+
+	if z != 1 {
+		if b, err = types.Decimal128MulChecked(b, types.InitDecimal128(z), 0, 0, 0); err != nil {
+			panic(err)
+		}
+	}
+	if a, err = types.Decimal128AddChecked(a, b, 0, 0); err != nil {
+		panic(err)
+	}
+	return a
+}
@@ -158,11 +158,11 @@ func (r *Decimal64Ring) BulkFill(i int64, zs []int64, vec *vector.Vector) {
 	for j, v := range vs {
 		tmp := types.Decimal64Int64Mul(v, zs[j])
 		r.Vs[i] = types.Decimal64AddAligned(r.Vs[i], tmp)
-		if nulls.Any(vec.Nsp) {
-			for k := range vs {
-				if nulls.Contains(vec.Nsp, uint64(k)) {
-					r.Ns[i] += zs[k]
-				}
+	}
+	if nulls.Any(vec.Nsp) {
+		for j := range vs {
+			if nulls.Contains(vec.Nsp, uint64(j)) {
+				r.Ns[i] += zs[j]
 			}
 		}
 	}
@@ -186,7 +186,7 @@ func (r *Decimal64Ring) BatchAdd(a interface{}, start int64, os []uint8, vps []u
 func (r *Decimal64Ring) Mul(a interface{}, x, y, z int64) {
 	ar := a.(*Decimal64Ring)
 	r.Ns[x] += ar.Ns[y] * z
-	r.Vs[x] += types.Decimal64Int64Mul(r.Vs[y], z)
+	r.Vs[x] += types.Decimal64Int64Mul(ar.Vs[y], z)
 }
 
 func (r *Decimal64Ring) Eval(zs []int64) *vector.Vector {
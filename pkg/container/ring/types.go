@@ -109,3 +109,13 @@ type Ring interface {
 	// Mul is the function to merge 2 rings when join
 	Mul(interface{}, int64, int64, int64)
 }
+
+// BinaryRing is the ring of an aggregate with two arguments, such as corr(x, y).
+// The vector of the second argument is set before the ring is filled with the vector
+// of the first argument, and the rows of the two vectors are one-to-one.
+type BinaryRing interface {
+	Ring
+
+	// SetArgument sets the vector of the second argument for the following fills.
+	SetArgument(*vector.Vector)
+}
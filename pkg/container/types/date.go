@@ -363,6 +363,19 @@ func isLeap(year int32) bool {
 	return year%4 == 0 && (year%100 != 0 || year%400 == 0)
 }
 
+// unixEpochDays is the day number of "1970-01-01"
+var unixEpochDays = FromCalendar(1970, 1, 1)
+
+// DaysSinceUnixEpoch returns the number of days since 1970-01-01
+func (d Date) DaysSinceUnixEpoch() int32 {
+	return int32(d - unixEpochDays)
+}
+
+// DateFromUnixDays returns the date which is days after 1970-01-01
+func DateFromUnixDays(days int32) Date {
+	return unixEpochDays + Date(days)
+}
+
 func (d Date) ToTime() Datetime {
 	return Datetime(int64(d)*secsPerDay) << 20
 }
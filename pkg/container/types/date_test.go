@@ -103,3 +103,13 @@ func TestParseDate(t *testing.T) {
 		})
 	}
 }
+
+func TestDaysSinceUnixEpoch(t *testing.T) {
+	d, _ := ParseDate("1970-01-02")
+	if got := d.DaysSinceUnixEpoch(); got != 1 {
+		t.Errorf("DaysSinceUnixEpoch() got %v, want 1", got)
+	}
+	if got := DateFromUnixDays(-1).String(); got != "1969-12-31" {
+		t.Errorf("DateFromUnixDays() got %v, want 1969-12-31", got)
+	}
+}
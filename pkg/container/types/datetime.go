@@ -183,6 +183,26 @@ func FromClock(year int32, month, day, hour, min, sec uint8, msec uint32) Dateti
 	return Datetime((secs << 20) + int64(msec))
 }
 
+// UnixMicro returns the number of microseconds elapsed since 1970-01-01 00:00:00
+func (dt Datetime) UnixMicro() int64 {
+	return (dt.sec()-int64(unixEpochDays)*secsPerDay)*1000000 + int64(dt)&0xfffff
+}
+
+// DatetimeFromUnixMicro returns the datetime which is us microseconds after 1970-01-01 00:00:00
+func DatetimeFromUnixMicro(us int64) Datetime {
+	secs, msec := us/1000000, us%1000000
+	if msec < 0 {
+		secs--
+		msec += 1000000
+	}
+	return Datetime(((secs + int64(unixEpochDays)*secsPerDay) << 20) + msec)
+}
+
+// MicroSec returns the microseconds within the second of the datetime
+func (dt Datetime) MicroSec() int64 {
+	return int64(dt) & 0xfffff
+}
+
 func (dt Datetime) sec() int64 {
 	return int64(dt) >> 20
 }
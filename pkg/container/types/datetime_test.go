@@ -154,3 +154,18 @@ func TestUTC(t *testing.T) {
 		t.Errorf("UTC() args %v got %v and time zone UTC+%v", args, utc, offset/secsPerHour)
 	}
 }
+
+func TestUnixMicro(t *testing.T) {
+	dt, _ := ParseDatetime("2022-05-01 11:11:11")
+	want := time.Date(2022, 5, 1, 11, 11, 11, 0, time.UTC).UnixMicro()
+	if got := dt.UnixMicro(); got != want {
+		t.Errorf("UnixMicro() got %v, want %v", got, want)
+	}
+	if got := DatetimeFromUnixMicro(want); got != dt {
+		t.Errorf("DatetimeFromUnixMicro() got %v, want %v", got, dt)
+	}
+	before, _ := ParseDatetime("1969-12-31 23:59:59")
+	if got := DatetimeFromUnixMicro(-1000000); got != before {
+		t.Errorf("DatetimeFromUnixMicro() got %v, want %v", got, before)
+	}
+}
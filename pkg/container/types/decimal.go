@@ -26,11 +26,13 @@
 //
 // we support addition, subtraction, multiplication, division between decimal data types, and between decimal and integers
 // for decimal64, addition and subtraction operation
-// have result of type decimal64, the result's scale is the maximum of its two operands, overflow may happen in these two operations and no precaution is implemented,
-// nor does any indication. for multiplication and division on decimal64, the result is of type Decimal128 and therefore these two operations are safe on Decimal64, that is,
-// overflow is guaranteed IMPOSSIBLE.
+// have result of type decimal64, the result's scale is the maximum of its two operands. for multiplication and division on decimal64,
+// the result is of type Decimal128.
 //
-// For Decimal128, operations on this data type may overflow and no precautions nor indications are implemented, but these will only happen in extreme use cases, that is, when the result can not fit into a 128 bit representation
+// The precision and scale of the results follow the rules of MySQL, see DecimalAddResultType, DecimalMulResultType, DecimalDivResultType,
+// DecimalSumResultType and DecimalAvgResultType. The checked operations (Decimal64AddChecked, Decimal128MulChecked, ...) return
+// ErrDecimalOverflow if the result can't fit into its representation, and the results that have more digits than the result scale
+// are rounded half up. Decimal64Rescale and Decimal128Rescale change the scale of a value with the other rounding modes.
 //
 // Comparison operations <, >, =, !=, <=, >= are also supported between decimal types, and between decimals and integers.
 //
@@ -45,6 +47,7 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"math/big"
 	"strconv"
 	"strings"
 	"unsafe"
@@ -167,6 +170,87 @@ import (
 // void int64_to_int128(void*a, void* result) {
 // 		*(__int128*)result = *(int64_t*)a;
 // }
+// bool add_int128_int128_overflow(void* a, void* b, void* result) {
+//      return __builtin_add_overflow(*(__int128*)a, *(__int128*)b, (__int128*)result);
+// }
+// bool sub_int128_int128_overflow(void* a, void* b, void* result) {
+//      return __builtin_sub_overflow(*(__int128*)a, *(__int128*)b, (__int128*)result);
+// }
+// bool mul_int128_int128_overflow(void* a, void* b, void* result) {
+//      return __builtin_mul_overflow(*(__int128*)a, *(__int128*)b, (__int128*)result);
+// }
+// // scale a by 10^n, returns true if it overflows
+// bool scale_int128_overflow(void* a, int32_t n, void* result) {
+//      __int128 v = *(__int128*)a;
+//      for (int i = 0; i < n; i++) {
+//          if (__builtin_mul_overflow(v, 10, &v)) {
+//              return true;
+//          }
+//      }
+//      *(__int128*)result = v;
+//      return false;
+// }
+// // returns true if |a| >= 10^width, the width is in the range [1, 38]
+// bool int128_exceeds_width(void* a, int32_t width) {
+//      __int128 v = *(__int128*)a;
+//      __int128 p = 1;
+//      for (int i = 0; i < width; i++) {
+//          p *= 10;
+//      }
+//      return v >= p || v <= -p;
+// }
+// // round a to the multiple of 10^n and divide it by 10^n, the mode is a RoundMode
+// void round_int128(void* a, int32_t n, int32_t mode, void* result) {
+//      __int128 v = *(__int128*)a;
+//      __int128 q = 0, r = v, p = 1;
+//      bool exceeds = false;
+//      for (int i = 0; i < n; i++) {
+//          if (__builtin_mul_overflow(p, 10, &p)) {
+//              exceeds = true;
+//              break;
+//          }
+//      }
+//      if (!exceeds) {
+//          q = v / p;
+//          r = v % p;
+//      }
+//      __int128 absr = r < 0 ? -r : r;
+//      int sign = v < 0 ? -1 : 1;
+//      switch (mode) {
+//      case 0:
+//          if (!exceeds && absr >= p - absr) {
+//              q += sign;
+//          }
+//          break;
+//      case 1:
+//          if (!exceeds && (absr > p - absr || (absr == p - absr && q % 2 != 0))) {
+//              q += sign;
+//          }
+//          break;
+//      case 3:
+//          if (r > 0) {
+//              q += 1;
+//          }
+//          break;
+//      case 4:
+//          if (r < 0) {
+//              q -= 1;
+//          }
+//          break;
+//      }
+//      *(__int128*)result = q;
+// }
+// // a / b rounded half up, b is not zero
+// void div_int128_round(void* a, void* b, void* result) {
+//      __int128 x = *(__int128*)a, y = *(__int128*)b;
+//      __int128 q = x / y, r = x % y;
+//      __int128 absr = r < 0 ? -r : r;
+//      __int128 absy = y < 0 ? -y : y;
+//      if (absr >= absy - absr) {
+//          q += ((x < 0) == (y < 0)) ? 1 : -1;
+//      }
+//      *(__int128*)result = q;
+// }
 import "C"
 
 func ScaleDecimal64(a Decimal64, b int64) (result Decimal64) {
@@ -328,9 +412,13 @@ func NegDecimal128(a Decimal128) (result Decimal128) {
 }
 
 func decimalStringPreprocess(s string, precision, scale int32) (result []byte, carry bool, neg bool, err error) {
+	s = strings.TrimSpace(s)
 	if s == "" {
 		return result, carry, neg, errors.New("invalid decimal string")
 	}
+	if s, err = expandDecimalExponent(s); err != nil {
+		return result, carry, neg, err
+	}
 	parts := strings.Split(s, ".")
 	partsNumber := len(parts)
 	if partsNumber == 2 { // this means the input string is of the form "123.456"
@@ -340,11 +428,15 @@ func decimalStringPreprocess(s string, precision, scale int32) (result []byte, c
 			if part0Bytes[0] == '+' {
 				part0Bytes = part0Bytes[1:]
 			}
-			if part0Bytes[0] == '-' {
+			if len(part0Bytes) > 0 && part0Bytes[0] == '-' {
 				neg = true
 				part0Bytes = part0Bytes[1:]
 			}
 		}
+		part0Bytes = trimLeadingZeros(part0Bytes)
+		if len(part0Bytes)+len(part1Bytes) == 0 {
+			return result, carry, neg, errors.New("invalid decimal string")
+		}
 		if len(part0Bytes) > int(precision-scale) { // for example, input "123.45" is invalid for Decimal(5, 3)
 			return result, carry, neg, fmt.Errorf("input decimal value out of range for Decimal(%d, %d)", precision, scale)
 		}
@@ -371,10 +463,14 @@ func decimalStringPreprocess(s string, precision, scale int32) (result []byte, c
 		if part0Bytes[0] == '+' {
 			part0Bytes = part0Bytes[1:]
 		}
-		if part0Bytes[0] == '-' {
+		if len(part0Bytes) > 0 && part0Bytes[0] == '-' {
 			neg = true
 			part0Bytes = part0Bytes[1:]
 		}
+		if len(part0Bytes) == 0 {
+			return result, carry, neg, errors.New("invalid decimal string")
+		}
+		part0Bytes = trimLeadingZeros(part0Bytes)
 		if len(part0Bytes) > int(precision-scale) { // for example, input "123" is invalid for Decimal(5, 3)
 			return result, carry, neg, fmt.Errorf("input decimal value out of range for Decimal(%d, %d)", precision, scale)
 		}
@@ -388,6 +484,14 @@ func decimalStringPreprocess(s string, precision, scale int32) (result []byte, c
 	}
 }
 
+// trimLeadingZeros drops the leading zeros of an integer part so that they don't count into the precision
+func trimLeadingZeros(s []byte) []byte {
+	for len(s) > 0 && s[0] == '0' {
+		s = s[1:]
+	}
+	return s
+}
+
 //todo: use strconv to simplify this code
 func ParseStringToDecimal64(s string, precision, scale int32) (result Decimal64, err error) {
 	sInBytes, carry, neg, err := decimalStringPreprocess(s, precision, scale)
@@ -411,11 +515,18 @@ func ParseStringToDecimal64(s string, precision, scale int32) (result Decimal64,
 		resultInInt64 = -resultInInt64
 	}
 	result = Decimal64(resultInInt64)
+	// the carry of rounding may make the value exceed the precision, for example, "99.99" for Decimal(3, 1)
+	if CheckDecimal64Width(result, precision) != nil {
+		return result, fmt.Errorf("input decimal value out of range for Decimal(%d, %d)", precision, scale)
+	}
 	return result, nil
 }
 
 func ParseStringToDecimal128WithoutTable(s string) (result Decimal128, scale int32, err error) {
 	precision := int32(38)
+	if s, err = expandDecimalExponent(strings.TrimSpace(s)); err != nil {
+		return result, scale, err
+	}
 	parts := strings.Split(s, ".")
 	scale = int32(0)
 	if len(parts) == 1 || len(parts[1]) == 0 { // this means the input string is of the form "123", "123."
@@ -448,6 +559,9 @@ func ParseStringToDecimal128(s string, precision, scale int32) (result Decimal12
 	if neg {
 		result = NegDecimal128(result)
 	}
+	if CheckDecimal128Width(result, precision) != nil {
+		return result, fmt.Errorf("input decimal value out of range for Decimal(%d, %d)", precision, scale)
+	}
 	return result, nil
 }
 
@@ -643,3 +757,401 @@ func Decimal64ToDecimal128(a Decimal64) (result Decimal128) {
 	C.int64_to_int128(unsafe.Pointer(&a), unsafe.Pointer(&result))
 	return result
 }
+
+const (
+	// MaxDecimal64Width and MaxDecimal128Width are the max precisions of Decimal64 and Decimal128
+	MaxDecimal64Width  = 18
+	MaxDecimal128Width = 38
+	// MaxDecimalResultScale is the max scale of the results of multiplication, division and avg, same as mysql
+	MaxDecimalResultScale = 30
+	// DecimalDivScaleIncrement is the count of digits added to the scale of a division result,
+	// it is the default div_precision_increment of mysql
+	DecimalDivScaleIncrement = 4
+	// decimalSumWidthIncrement is the count of digits added to the precision of a sum result
+	decimalSumWidthIncrement = 22
+)
+
+var (
+	ErrDecimalOverflow  = errors.New("decimal value is out of range")
+	ErrDecimalDivByZero = errors.New("division by zero")
+)
+
+// RoundMode is the way to drop the digits of a decimal value when its scale is reduced
+type RoundMode int32
+
+const (
+	// RoundHalfUp rounds half away from zero, it is the rounding of mysql
+	RoundHalfUp RoundMode = iota
+	// RoundHalfEven rounds half to the even neighbor, which is the banker's rounding
+	RoundHalfEven
+	// RoundDown truncates the digits, that is rounding towards zero
+	RoundDown
+	// RoundCeiling rounds towards positive infinity
+	RoundCeiling
+	// RoundFloor rounds towards negative infinity
+	RoundFloor
+)
+
+func normalizeDecimalWidth(width int32) int32 {
+	if width <= 0 || width > MaxDecimal128Width {
+		return MaxDecimal128Width
+	}
+	return width
+}
+
+func minInt32(a, b int32) int32 {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func maxInt32(a, b int32) int32 {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+// DecimalAddResultType returns the precision and scale of a+b and a-b,
+// a width of 0 means the precision of the operand is unknown.
+func DecimalAddResultType(aWidth, aScale, bWidth, bScale int32) (int32, int32) {
+	aWidth, bWidth = normalizeDecimalWidth(aWidth), normalizeDecimalWidth(bWidth)
+	scale := maxInt32(aScale, bScale)
+	width := maxInt32(aWidth-aScale, bWidth-bScale) + scale + 1
+	return minInt32(width, MaxDecimal128Width), scale
+}
+
+// DecimalMulResultType returns the precision and scale of a*b
+func DecimalMulResultType(aWidth, aScale, bWidth, bScale int32) (int32, int32) {
+	aWidth, bWidth = normalizeDecimalWidth(aWidth), normalizeDecimalWidth(bWidth)
+	scale := minInt32(aScale+bScale, maxInt32(MaxDecimalResultScale, maxInt32(aScale, bScale)))
+	return minInt32(aWidth+bWidth, MaxDecimal128Width), scale
+}
+
+// DecimalDivResultType returns the precision and scale of a/b
+func DecimalDivResultType(aWidth, aScale, bWidth, bScale int32) (int32, int32) {
+	aWidth = normalizeDecimalWidth(aWidth)
+	scale := minInt32(aScale+DecimalDivScaleIncrement, maxInt32(MaxDecimalResultScale, aScale))
+	return minInt32(aWidth-aScale+bScale+scale, MaxDecimal128Width), scale
+}
+
+// DecimalSumResultType returns the precision and scale of sum(a)
+func DecimalSumResultType(width, scale int32) (int32, int32) {
+	width = normalizeDecimalWidth(width)
+	return minInt32(width+decimalSumWidthIncrement, MaxDecimal128Width), scale
+}
+
+// DecimalAvgResultType returns the precision and scale of avg(a)
+func DecimalAvgResultType(width, scale int32) (int32, int32) {
+	width = normalizeDecimalWidth(width)
+	newScale := minInt32(scale+DecimalDivScaleIncrement, maxInt32(MaxDecimalResultScale, scale))
+	return minInt32(width+newScale-scale, MaxDecimal128Width), newScale
+}
+
+var decimal64Bound = [MaxDecimal64Width + 1]int64{
+	1, 1e1, 1e2, 1e3, 1e4, 1e5, 1e6, 1e7, 1e8, 1e9, 1e10,
+	1e11, 1e12, 1e13, 1e14, 1e15, 1e16, 1e17, 1e18,
+}
+
+// CheckDecimal64Width returns ErrDecimalOverflow if a has more than width digits
+func CheckDecimal64Width(a Decimal64, width int32) error {
+	if width <= 0 || width > MaxDecimal64Width {
+		width = MaxDecimal64Width
+	}
+	if int64(a) >= decimal64Bound[width] || int64(a) <= -decimal64Bound[width] {
+		return ErrDecimalOverflow
+	}
+	return nil
+}
+
+// CheckDecimal128Width returns ErrDecimalOverflow if a has more than width digits
+func CheckDecimal128Width(a Decimal128, width int32) error {
+	width = normalizeDecimalWidth(width)
+	if bool(C.int128_exceeds_width(unsafe.Pointer(&a), C.int32_t(width))) {
+		return ErrDecimalOverflow
+	}
+	return nil
+}
+
+// scaleInt64 returns a*10^n, and false if it overflows
+func scaleInt64(a int64, n int32) (int64, bool) {
+	for i := int32(0); i < n; i++ {
+		if a > math.MaxInt64/10 || a < math.MinInt64/10 {
+			return 0, false
+		}
+		a *= 10
+	}
+	return a, true
+}
+
+// alignDecimal64 scales the one with the smaller scale of a and b to have the same scale with the other
+func alignDecimal64(a, b Decimal64, aScale, bScale int32) (int64, int64, bool) {
+	x, y := int64(a), int64(b)
+	ok := true
+	if aScale > bScale {
+		y, ok = scaleInt64(y, aScale-bScale)
+	} else if aScale < bScale {
+		x, ok = scaleInt64(x, bScale-aScale)
+	}
+	return x, y, ok
+}
+
+// Decimal64AddChecked returns a+b in the larger scale of the two,
+// ErrDecimalOverflow is returned if the result has more than 18 digits.
+func Decimal64AddChecked(a, b Decimal64, aScale, bScale int32) (Decimal64, error) {
+	x, y, ok := alignDecimal64(a, b, aScale, bScale)
+	if !ok {
+		return 0, ErrDecimalOverflow
+	}
+	r := x + y
+	if (x > 0 && y > 0 && r < 0) || (x < 0 && y < 0 && r >= 0) {
+		return 0, ErrDecimalOverflow
+	}
+	return Decimal64(r), CheckDecimal64Width(Decimal64(r), MaxDecimal64Width)
+}
+
+// Decimal64SubChecked returns a-b in the larger scale of the two,
+// ErrDecimalOverflow is returned if the result has more than 18 digits.
+func Decimal64SubChecked(a, b Decimal64, aScale, bScale int32) (Decimal64, error) {
+	x, y, ok := alignDecimal64(a, b, aScale, bScale)
+	if !ok {
+		return 0, ErrDecimalOverflow
+	}
+	r := x - y
+	if (x >= 0 && y < 0 && r < 0) || (x < 0 && y > 0 && r >= 0) {
+		return 0, ErrDecimalOverflow
+	}
+	return Decimal64(r), CheckDecimal64Width(Decimal64(r), MaxDecimal64Width)
+}
+
+// scaleDecimal128 returns a*10^n, ErrDecimalOverflow is returned if it overflows
+func scaleDecimal128(a Decimal128, n int32) (result Decimal128, err error) {
+	if bool(C.scale_int128_overflow(unsafe.Pointer(&a), C.int32_t(n), unsafe.Pointer(&result))) {
+		return result, ErrDecimalOverflow
+	}
+	return result, nil
+}
+
+func alignDecimal128(a, b Decimal128, aScale, bScale int32) (Decimal128, Decimal128, error) {
+	var err error
+
+	if aScale > bScale {
+		b, err = scaleDecimal128(b, aScale-bScale)
+	} else if aScale < bScale {
+		a, err = scaleDecimal128(a, bScale-aScale)
+	}
+	return a, b, err
+}
+
+// Decimal128AddChecked returns a+b in the larger scale of the two,
+// ErrDecimalOverflow is returned if the result has more than 38 digits.
+func Decimal128AddChecked(a, b Decimal128, aScale, bScale int32) (result Decimal128, err error) {
+	if a, b, err = alignDecimal128(a, b, aScale, bScale); err != nil {
+		return result, err
+	}
+	if bool(C.add_int128_int128_overflow(unsafe.Pointer(&a), unsafe.Pointer(&b), unsafe.Pointer(&result))) {
+		return result, ErrDecimalOverflow
+	}
+	return result, CheckDecimal128Width(result, MaxDecimal128Width)
+}
+
+// Decimal128SubChecked returns a-b in the larger scale of the two,
+// ErrDecimalOverflow is returned if the result has more than 38 digits.
+func Decimal128SubChecked(a, b Decimal128, aScale, bScale int32) (result Decimal128, err error) {
+	if a, b, err = alignDecimal128(a, b, aScale, bScale); err != nil {
+		return result, err
+	}
+	if bool(C.sub_int128_int128_overflow(unsafe.Pointer(&a), unsafe.Pointer(&b), unsafe.Pointer(&result))) {
+		return result, ErrDecimalOverflow
+	}
+	return result, CheckDecimal128Width(result, MaxDecimal128Width)
+}
+
+// Decimal64Rescale changes the scale of a to be newScale, the dropped digits are rounded by mode,
+// ErrDecimalOverflow is returned if the result has more than 18 digits.
+func Decimal64Rescale(a Decimal64, scale, newScale int32, mode RoundMode) (Decimal64, error) {
+	r, err := Decimal128Rescale(Decimal64ToDecimal128(a), scale, newScale, mode)
+	if err != nil {
+		return 0, err
+	}
+	if err = CheckDecimal128Width(r, MaxDecimal64Width); err != nil {
+		return 0, err
+	}
+	return Decimal64(r.Lo), nil
+}
+
+// Decimal128Rescale changes the scale of a to be newScale, the dropped digits are rounded by mode,
+// ErrDecimalOverflow is returned if the result has more than 38 digits.
+func Decimal128Rescale(a Decimal128, scale, newScale int32, mode RoundMode) (result Decimal128, err error) {
+	switch {
+	case newScale > scale:
+		if result, err = scaleDecimal128(a, newScale-scale); err != nil {
+			return result, err
+		}
+	case newScale < scale:
+		C.round_int128(unsafe.Pointer(&a), C.int32_t(scale-newScale), C.int32_t(mode), unsafe.Pointer(&result))
+	default:
+		result = a
+	}
+	return result, CheckDecimal128Width(result, MaxDecimal128Width)
+}
+
+// Decimal128MulChecked returns a*b in the resultScale, which is normally given by DecimalMulResultType.
+// The result is rounded half up if resultScale is less than aScale+bScale.
+func Decimal128MulChecked(a, b Decimal128, aScale, bScale, resultScale int32) (result Decimal128, err error) {
+	if !bool(C.mul_int128_int128_overflow(unsafe.Pointer(&a), unsafe.Pointer(&b), unsafe.Pointer(&result))) {
+		return Decimal128Rescale(result, aScale+bScale, resultScale, RoundHalfUp)
+	}
+	// the product doesn't fit into 128 bits, but it may fit after its scale is reduced
+	v := new(big.Int).Mul(decimal128ToBig(a), decimal128ToBig(b))
+	return bigToDecimal128(roundBig(v, aScale+bScale-resultScale))
+}
+
+// Decimal128DivChecked returns a/b in the resultScale, which is normally given by DecimalDivResultType.
+// The result is rounded half up.
+func Decimal128DivChecked(a, b Decimal128, aScale, bScale, resultScale int32) (result Decimal128, err error) {
+	if Decimal128IsZero(b) {
+		return result, ErrDecimalDivByZero
+	}
+	// a/10^aScale / (b/10^bScale) * 10^resultScale = a*10^n / b
+	n := resultScale - aScale + bScale
+	if n >= 0 {
+		if x, err := scaleDecimal128(a, n); err == nil {
+			C.div_int128_round(unsafe.Pointer(&x), unsafe.Pointer(&b), unsafe.Pointer(&result))
+			return result, CheckDecimal128Width(result, MaxDecimal128Width)
+		}
+	}
+	x, y := decimal128ToBig(a), decimal128ToBig(b)
+	if n >= 0 {
+		x.Mul(x, pow10Big(n))
+	} else {
+		y.Mul(y, pow10Big(-n))
+	}
+	return bigToDecimal128(divRoundBig(x, y))
+}
+
+// Decimal64MulChecked returns a*b in the resultScale, see Decimal128MulChecked
+func Decimal64MulChecked(a, b Decimal64, aScale, bScale, resultScale int32) (Decimal128, error) {
+	return Decimal128Rescale(Decimal64Decimal64Mul(a, b), aScale+bScale, resultScale, RoundHalfUp)
+}
+
+// Decimal64DivChecked returns a/b in the resultScale, see Decimal128DivChecked
+func Decimal64DivChecked(a, b Decimal64, aScale, bScale, resultScale int32) (Decimal128, error) {
+	return Decimal128DivChecked(Decimal64ToDecimal128(a), Decimal64ToDecimal128(b), aScale, bScale, resultScale)
+}
+
+var bigTwo64 = new(big.Int).Lsh(big.NewInt(1), 64)
+
+func decimal128ToBig(a Decimal128) *big.Int {
+	v := big.NewInt(a.Hi)
+	v.Lsh(v, 64)
+	return v.Add(v, new(big.Int).SetUint64(uint64(a.Lo)))
+}
+
+// bigToDecimal128 converts v to Decimal128, ErrDecimalOverflow is returned if v has more than 38 digits
+func bigToDecimal128(v *big.Int) (result Decimal128, err error) {
+	if new(big.Int).Abs(v).Cmp(pow10Big(MaxDecimal128Width)) >= 0 {
+		return result, ErrDecimalOverflow
+	}
+	lo := new(big.Int).Mod(v, bigTwo64)
+	hi := new(big.Int).Rsh(v, 64)
+	return Decimal128{Lo: int64(lo.Uint64()), Hi: hi.Int64()}, nil
+}
+
+func pow10Big(n int32) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
+// roundBig returns v/10^n rounded half up
+func roundBig(v *big.Int, n int32) *big.Int {
+	if n <= 0 {
+		return v.Mul(v, pow10Big(-n))
+	}
+	return divRoundBig(v, pow10Big(n))
+}
+
+// divRoundBig returns x/y rounded half up
+func divRoundBig(x, y *big.Int) *big.Int {
+	q, r := new(big.Int).QuoRem(x, y, new(big.Int))
+	r.Abs(r)
+	if r.Lsh(r, 1).CmpAbs(y) >= 0 {
+		if x.Sign() == y.Sign() {
+			q.Add(q, big.NewInt(1))
+		} else {
+			q.Sub(q, big.NewInt(1))
+		}
+	}
+	return q
+}
+
+// Decimal64FromFloat64 converts f to be a decimal of the width and scale, the value is rounded half up
+func Decimal64FromFloat64(f float64, width, scale int32) (Decimal64, error) {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return 0, ErrDecimalOverflow
+	}
+	return ParseStringToDecimal64(strconv.FormatFloat(f, 'g', -1, 64), width, scale)
+}
+
+// Decimal128FromFloat64 converts f to be a decimal of the width and scale, the value is rounded half up
+func Decimal128FromFloat64(f float64, width, scale int32) (result Decimal128, err error) {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return result, ErrDecimalOverflow
+	}
+	return ParseStringToDecimal128(strconv.FormatFloat(f, 'g', -1, 64), width, scale)
+}
+
+// ToFloat64 returns the nearest float64 of the decimal value
+func (a Decimal64) ToFloat64(scale int32) float64 {
+	f, _ := strconv.ParseFloat(string(a.Decimal64ToString(scale)), 64)
+	return f
+}
+
+// ToFloat64 returns the nearest float64 of the decimal value
+func (a Decimal128) ToFloat64(scale int32) float64 {
+	f, _ := strconv.ParseFloat(string(a.Decimal128ToString(scale)), 64)
+	return f
+}
+
+// maxDecimalExponent limits the length of the expanded text of a decimal in scientific notation
+const maxDecimalExponent = 1000
+
+// expandDecimalExponent rewrites a decimal in scientific notation such as "1.25e3" to be "1250"
+func expandDecimalExponent(s string) (string, error) {
+	i := strings.IndexAny(s, "eE")
+	if i < 0 {
+		return s, nil
+	}
+	exp, err := strconv.Atoi(s[i+1:])
+	if err != nil {
+		return "", errors.New("invalid decimal string")
+	}
+	mantissa := s[:i]
+	sign := ""
+	if len(mantissa) > 0 && (mantissa[0] == '-' || mantissa[0] == '+') {
+		sign, mantissa = mantissa[:1], mantissa[1:]
+	}
+	intPart, fracPart := mantissa, ""
+	if j := strings.IndexByte(mantissa, '.'); j >= 0 {
+		intPart, fracPart = mantissa[:j], mantissa[j+1:]
+	}
+	if len(intPart)+len(fracPart) == 0 {
+		return "", errors.New("invalid decimal string")
+	}
+	if exp > maxDecimalExponent {
+		return "", ErrDecimalOverflow
+	}
+	if exp < -maxDecimalExponent {
+		return "0", nil
+	}
+	digits := intPart + fracPart
+	point := len(intPart) + exp
+	switch {
+	case point <= 0:
+		return sign + "0." + strings.Repeat("0", -point) + digits, nil
+	case point >= len(digits):
+		return sign + digits + strings.Repeat("0", point-len(digits)), nil
+	default:
+		return sign + digits[:point] + "." + digits[point:], nil
+	}
+}
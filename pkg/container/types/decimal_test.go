@@ -282,3 +282,151 @@ func TestDecimal128Int64Div(t *testing.T) {
 	require.Equal(t, Decimal128{-123400, -1}, result[5])
 
 }
+
+func TestParseStringToDecimalWithExponent(t *testing.T) {
+	a0, err := ParseStringToDecimal64("1.25e2", 18, 2)
+	require.NoError(t, err)
+	require.Equal(t, Decimal64(12500), a0)
+	a1, err := ParseStringToDecimal64(" -125E-3 ", 18, 2)
+	require.NoError(t, err)
+	require.Equal(t, Decimal64(-13), a1)
+	a2, err := ParseStringToDecimal128("0.5", 5, 5)
+	require.NoError(t, err)
+	require.Equal(t, Decimal128{50000, 0}, a2)
+	_, err = ParseStringToDecimal64("99.99", 3, 1)
+	require.Error(t, err)
+	_, err = ParseStringToDecimal128("1e40", 38, 0)
+	require.Error(t, err)
+	_, err = ParseStringToDecimal64("-", 18, 0)
+	require.Error(t, err)
+}
+
+func TestDecimalResultType(t *testing.T) {
+	w, s := DecimalAddResultType(10, 2, 5, 4)
+	require.Equal(t, int32(13), w)
+	require.Equal(t, int32(4), s)
+	w, s = DecimalMulResultType(10, 2, 5, 4)
+	require.Equal(t, int32(15), w)
+	require.Equal(t, int32(6), s)
+	w, s = DecimalMulResultType(38, 20, 38, 20)
+	require.Equal(t, int32(38), w)
+	require.Equal(t, int32(30), s)
+	w, s = DecimalDivResultType(10, 2, 5, 4)
+	require.Equal(t, int32(18), w)
+	require.Equal(t, int32(6), s)
+	w, s = DecimalSumResultType(10, 2)
+	require.Equal(t, int32(32), w)
+	require.Equal(t, int32(2), s)
+	w, s = DecimalAvgResultType(10, 2)
+	require.Equal(t, int32(14), w)
+	require.Equal(t, int32(6), s)
+}
+
+func TestDecimal64AddChecked(t *testing.T) {
+	r, err := Decimal64AddChecked(Decimal64(12345), Decimal64(5), 2, 0)
+	require.NoError(t, err)
+	require.Equal(t, Decimal64(12845), r)
+	r, err = Decimal64SubChecked(Decimal64(12345), Decimal64(5), 2, 0)
+	require.NoError(t, err)
+	require.Equal(t, Decimal64(11845), r)
+	_, err = Decimal64AddChecked(Decimal64(999999999999999999), Decimal64(1), 0, 0)
+	require.Equal(t, ErrDecimalOverflow, err)
+	_, err = Decimal64SubChecked(Decimal64(-999999999999999999), Decimal64(1), 0, 0)
+	require.Equal(t, ErrDecimalOverflow, err)
+	_, err = Decimal64AddChecked(Decimal64(1), Decimal64(1), 0, 18)
+	require.Equal(t, ErrDecimalOverflow, err)
+}
+
+func TestDecimal128AddChecked(t *testing.T) {
+	max, _ := ParseStringToDecimal128("99999999999999999999999999999999999999", 38, 0)
+	r, err := Decimal128AddChecked(InitDecimal128(150), InitDecimal128(-2), 1, 0)
+	require.NoError(t, err)
+	require.Equal(t, InitDecimal128(130), r)
+	r, err = Decimal128SubChecked(InitDecimal128(150), InitDecimal128(-2), 1, 0)
+	require.NoError(t, err)
+	require.Equal(t, InitDecimal128(170), r)
+	_, err = Decimal128AddChecked(max, InitDecimal128(1), 0, 0)
+	require.Equal(t, ErrDecimalOverflow, err)
+	_, err = Decimal128SubChecked(NegDecimal128(max), InitDecimal128(1), 0, 0)
+	require.Equal(t, ErrDecimalOverflow, err)
+	_, err = Decimal128AddChecked(max, InitDecimal128(0), 0, 1)
+	require.Equal(t, ErrDecimalOverflow, err)
+}
+
+func TestDecimal128MulChecked(t *testing.T) {
+	// 1.25 * 0.5 = 0.625, rounded to 0.63
+	r, err := Decimal128MulChecked(InitDecimal128(125), InitDecimal128(5), 2, 1, 2)
+	require.NoError(t, err)
+	require.Equal(t, InitDecimal128(63), r)
+	r, err = Decimal64MulChecked(Decimal64(-125), Decimal64(5), 2, 1, 3)
+	require.NoError(t, err)
+	require.Equal(t, InitDecimal128(-625), r)
+	// the product of the two has 40 digits, but it fits after reducing the scale
+	a, _ := ParseStringToDecimal128("12345678901234567890.12345678901234567890", 38, 18)
+	b, _ := ParseStringToDecimal128("10.00", 38, 2)
+	r, err = Decimal128MulChecked(a, b, 18, 2, 0)
+	require.NoError(t, err)
+	require.Equal(t, "123456789012345678901", string(r.Decimal128ToString(0)))
+	a, _ = ParseStringToDecimal128("99999999999999999999999999999999999999", 38, 0)
+	_, err = Decimal128MulChecked(a, InitDecimal128(2), 0, 0, 0)
+	require.Equal(t, ErrDecimalOverflow, err)
+}
+
+func TestDecimal128DivChecked(t *testing.T) {
+	// 2 / 3 = 0.666667
+	r, err := Decimal64DivChecked(Decimal64(2), Decimal64(3), 0, 0, 6)
+	require.NoError(t, err)
+	require.Equal(t, InitDecimal128(666667), r)
+	r, err = Decimal128DivChecked(InitDecimal128(-200), InitDecimal128(3), 2, 0, 4)
+	require.NoError(t, err)
+	require.Equal(t, InitDecimal128(-6667), r)
+	r, err = Decimal128DivChecked(InitDecimal128(1), InitDecimal128(3000), 0, 3, 2)
+	require.NoError(t, err)
+	require.Equal(t, InitDecimal128(33), r)
+	a, _ := ParseStringToDecimal128("9999999999999999999999999999999999999", 38, 0)
+	r, err = Decimal128DivChecked(a, InitDecimal128(3), 0, 0, 1)
+	require.NoError(t, err)
+	require.Equal(t, "3333333333333333333333333333333333333.0", string(r.Decimal128ToString(1)))
+	_, err = Decimal128DivChecked(InitDecimal128(1), InitDecimal128(0), 0, 0, 4)
+	require.Equal(t, ErrDecimalDivByZero, err)
+}
+
+func TestDecimalRescale(t *testing.T) {
+	cases := []struct {
+		v    int64
+		mode RoundMode
+		want int64
+	}{
+		{125, RoundHalfUp, 13}, {-125, RoundHalfUp, -13},
+		{125, RoundHalfEven, 12}, {135, RoundHalfEven, 14}, {-125, RoundHalfEven, -12},
+		{129, RoundDown, 12}, {-129, RoundDown, -12},
+		{121, RoundCeiling, 13}, {-129, RoundCeiling, -12},
+		{129, RoundFloor, 12}, {-121, RoundFloor, -13},
+	}
+	for _, c := range cases {
+		r, err := Decimal128Rescale(InitDecimal128(c.v), 2, 1, c.mode)
+		require.NoError(t, err)
+		require.Equal(t, InitDecimal128(c.want), r)
+		r64, err := Decimal64Rescale(Decimal64(c.v), 2, 1, c.mode)
+		require.NoError(t, err)
+		require.Equal(t, Decimal64(c.want), r64)
+	}
+	r, err := Decimal64Rescale(Decimal64(125), 2, 4, RoundHalfUp)
+	require.NoError(t, err)
+	require.Equal(t, Decimal64(12500), r)
+	_, err = Decimal64Rescale(Decimal64(100000000000000000), 0, 2, RoundHalfUp)
+	require.Equal(t, ErrDecimalOverflow, err)
+}
+
+func TestDecimalFloat64(t *testing.T) {
+	a, err := Decimal64FromFloat64(3.14159, 10, 3)
+	require.NoError(t, err)
+	require.Equal(t, Decimal64(3142), a)
+	require.Equal(t, 3.142, a.ToFloat64(3))
+	b, err := Decimal128FromFloat64(-1e20, 38, 2)
+	require.NoError(t, err)
+	require.Equal(t, "-100000000000000000000.00", string(b.Decimal128ToString(2)))
+	require.Equal(t, -1e20, b.ToFloat64(2))
+	_, err = Decimal64FromFloat64(1e20, 18, 0)
+	require.Error(t, err)
+}
@@ -0,0 +1,147 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// enum and set data types:
+// An ENUM value is one of the members listed in the column definition, and a SET
+// value is any number of them. The members are kept in the catalog with the column.
+//
+// Internal representation:
+// enum values are represented using an uint16 which holds the 1-based index of
+// the member, so the values are ordered by the order of the members as mysql does.
+// set values are represented using an uint64 whose i-th bit stands for the i-th member.
+
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+)
+
+const (
+	MaxEnumMembers = 65535
+	MaxSetMembers  = 64
+)
+
+// CheckEnumValues checks the members of an enum or set column
+func CheckEnumValues(values []string, isSet bool) error {
+	if len(values) == 0 {
+		return errors.New(errno.InvalidColumnDefinition, "the enum or set type must have members")
+	}
+	if isSet && len(values) > MaxSetMembers {
+		return errors.New(errno.InvalidColumnDefinition, fmt.Sprintf("too many members for the set type, the max is %d", MaxSetMembers))
+	}
+	if len(values) > MaxEnumMembers {
+		return errors.New(errno.InvalidColumnDefinition, fmt.Sprintf("too many members for the enum type, the max is %d", MaxEnumMembers))
+	}
+	for i, v := range values {
+		if isSet && strings.Contains(v, ",") {
+			return errors.New(errno.InvalidColumnDefinition, fmt.Sprintf("illegal set member '%s', it can't contain ','", v))
+		}
+		for _, w := range values[:i] {
+			if strings.EqualFold(v, w) {
+				return errors.New(errno.InvalidColumnDefinition, fmt.Sprintf("duplicate member '%s'", v))
+			}
+		}
+	}
+	return nil
+}
+
+// findMember returns the 0-based index of the member, the comparison is case-insensitive
+// and the trailing spaces are ignored
+func findMember(values []string, s string) int {
+	s = strings.TrimRight(s, " ")
+	for i, v := range values {
+		if strings.EqualFold(strings.TrimRight(v, " "), s) {
+			return i
+		}
+	}
+	return -1
+}
+
+// ParseEnum returns the enum value of the member s, a string of number is
+// taken as the index of the member if no member matches it.
+func ParseEnum(values []string, s string) (uint16, error) {
+	if i := findMember(values, s); i >= 0 {
+		return uint16(i + 1), nil
+	}
+	if n, err := strconv.ParseInt(strings.TrimSpace(s), 10, 64); err == nil {
+		return EnumFromInt(values, n)
+	}
+	return 0, errors.New(errno.DataException, fmt.Sprintf("Incorrect enum value: '%s'", s))
+}
+
+// EnumFromInt returns the enum value of the index n, which is in the range 1 to len(values)
+func EnumFromInt(values []string, n int64) (uint16, error) {
+	if n < 1 || n > int64(len(values)) {
+		return 0, errors.New(errno.DataException, fmt.Sprintf("Incorrect enum value: '%d'", n))
+	}
+	return uint16(n), nil
+}
+
+// EnumString returns the member of the enum value, the invalid value is the empty string
+func EnumString(values []string, v uint16) string {
+	if v == 0 || int(v) > len(values) {
+		return ""
+	}
+	return values[v-1]
+}
+
+// ParseSet returns the set value of the comma separated members, a string of
+// number is taken as the bitmap of the members if it isn't a member list.
+func ParseSet(values []string, s string) (uint64, error) {
+	var v uint64
+
+	if len(s) == 0 {
+		return 0, nil
+	}
+	for _, m := range strings.Split(s, ",") {
+		i := findMember(values, m)
+		if i < 0 {
+			if n, err := strconv.ParseInt(strings.TrimSpace(s), 10, 64); err == nil {
+				return SetFromInt(values, n)
+			}
+			return 0, errors.New(errno.DataException, fmt.Sprintf("Incorrect set value: '%s'", s))
+		}
+		v |= 1 << uint(i)
+	}
+	return v, nil
+}
+
+// SetFromInt returns the set value of the bitmap n, only the bits of the members can be set
+func SetFromInt(values []string, n int64) (uint64, error) {
+	if n < 0 || (len(values) < MaxSetMembers && uint64(n)>>uint(len(values)) != 0) {
+		return 0, errors.New(errno.DataException, fmt.Sprintf("Incorrect set value: '%d'", n))
+	}
+	return uint64(n), nil
+}
+
+// SetString returns the members of the set value joined by ',' in the order of the definition
+func SetString(values []string, v uint64) string {
+	var buf strings.Builder
+
+	for i := range values {
+		if v&(1<<uint(i)) == 0 {
+			continue
+		}
+		if buf.Len() > 0 {
+			buf.WriteByte(',')
+		}
+		buf.WriteString(values[i])
+	}
+	return buf.String()
+}
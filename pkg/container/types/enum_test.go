@@ -0,0 +1,86 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckEnumValues(t *testing.T) {
+	require.NoError(t, CheckEnumValues([]string{"a", "b"}, false))
+	require.NoError(t, CheckEnumValues([]string{"a,b", "c"}, false))
+	require.Error(t, CheckEnumValues(nil, false))
+	require.Error(t, CheckEnumValues([]string{"a", "A"}, false))
+	require.Error(t, CheckEnumValues([]string{"a,b", "c"}, true))
+	require.Error(t, CheckEnumValues(make([]string, MaxSetMembers+1), true))
+}
+
+func TestParseEnum(t *testing.T) {
+	values := []string{"small", "medium", "large"}
+	tests := []struct {
+		s       string
+		want    uint16
+		wantErr bool
+	}{
+		{s: "small", want: 1},
+		{s: "LARGE", want: 3},
+		{s: "medium  ", want: 2},
+		{s: "2", want: 2},
+		{s: "0", wantErr: true},
+		{s: "4", wantErr: true},
+		{s: "huge", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := ParseEnum(values, tt.s)
+		if tt.wantErr {
+			require.Error(t, err, tt.s)
+			continue
+		}
+		require.NoError(t, err, tt.s)
+		require.Equal(t, tt.want, got, tt.s)
+	}
+	require.Equal(t, "large", EnumString(values, 3))
+	require.Equal(t, "", EnumString(values, 0))
+	require.Equal(t, "", EnumString(values, 4))
+}
+
+func TestParseSet(t *testing.T) {
+	values := []string{"r", "w", "x"}
+	tests := []struct {
+		s       string
+		want    uint64
+		wantErr bool
+	}{
+		{s: "", want: 0},
+		{s: "r", want: 1},
+		{s: "x,R", want: 5},
+		{s: "w,w", want: 2},
+		{s: "7", want: 7},
+		{s: "8", wantErr: true},
+		{s: "r,d", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := ParseSet(values, tt.s)
+		if tt.wantErr {
+			require.Error(t, err, tt.s)
+			continue
+		}
+		require.NoError(t, err, tt.s)
+		require.Equal(t, tt.want, got, tt.s)
+	}
+	require.Equal(t, "r,x", SetString(values, 5))
+	require.Equal(t, "", SetString(values, 0))
+}
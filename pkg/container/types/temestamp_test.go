@@ -15,16 +15,18 @@
 package types
 
 import (
-	"github.com/stretchr/testify/require"
 	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
 )
 
 func TestTimestamp_String(t *testing.T) {
-	a, err := ParseTimestamp("2012-01-01 11:11:11", 6)
+	a, err := ParseTimestamp(time.Local, "2012-01-01 11:11:11", 6)
 	require.NoError(t, err)
 	resultStr := a.String()
 	require.Equal(t, "2012-01-01 11:11:11.000000", resultStr)
-	a, err = ParseTimestamp("20120101111111", 6)
+	a, err = ParseTimestamp(time.Local, "20120101111111", 6)
 	require.NoError(t, err)
 	resultStr = a.String()
 	require.Equal(t, "2012-01-01 11:11:11.000000", resultStr)
@@ -37,99 +39,130 @@ func TestTimestamp_String(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, "2012-01-01 11:11:11.000000", resultStr2)
 
-	a, err = ParseTimestamp("2012-01-01 11:11:11.123", 6)
+	a, err = ParseTimestamp(time.Local, "2012-01-01 11:11:11.123", 6)
 	resultStr3 := a.String()
 	require.NoError(t, err)
 	require.Equal(t, "2012-01-01 11:11:11.123000", resultStr3)
-	a, err = ParseTimestamp("20120101111111.123", 6)
+	a, err = ParseTimestamp(time.Local, "20120101111111.123", 6)
 	resultStr3 = a.String()
 	require.NoError(t, err)
 	require.Equal(t, "2012-01-01 11:11:11.123000", resultStr3)
 
-	resultStr4 := a.String2(3)
+	resultStr4 := a.String2(time.Local, 3)
 	require.NoError(t, err)
 	require.Equal(t, "2012-01-01 11:11:11.123", resultStr4)
 
-	resultStr5 := a.String2(6)
+	resultStr5 := a.String2(time.Local, 6)
 	require.NoError(t, err)
 	require.Equal(t, "2012-01-01 11:11:11.123000", resultStr5)
 
-	a, err = ParseTimestamp("2012-01-01 11:11:11.123456", 3)
-	resultStr6 := a.String2(0)
+	a, err = ParseTimestamp(time.Local, "2012-01-01 11:11:11.123456", 3)
+	resultStr6 := a.String2(time.Local, 0)
 	require.NoError(t, err)
 	require.Equal(t, "2012-01-01 11:11:11", resultStr6)
 
-	resultStr7 := a.String2(3)
+	resultStr7 := a.String2(time.Local, 3)
 	require.NoError(t, err)
 	require.Equal(t, "2012-01-01 11:11:11.123", resultStr7)
 
-	resultStr8 := a.String2(6)
+	resultStr8 := a.String2(time.Local, 6)
 	require.NoError(t, err)
 	require.Equal(t, "2012-01-01 11:11:11.123000", resultStr8)
 }
 func TestTimestamp_String2(t *testing.T) {
-	a, err := ParseTimestamp("2012-01-01 11:11:11", 6)
+	a, err := ParseTimestamp(time.Local, "2012-01-01 11:11:11", 6)
 	require.NoError(t, err)
-	resultStr := a.String2(6)
+	resultStr := a.String2(time.Local, 6)
 	require.Equal(t, "2012-01-01 11:11:11.000000", resultStr)
-	a, err = ParseTimestamp("20120101111111", 6)
+	a, err = ParseTimestamp(time.Local, "20120101111111", 6)
 	require.NoError(t, err)
-	resultStr = a.String2(6)
+	resultStr = a.String2(time.Local, 6)
 	require.Equal(t, "2012-01-01 11:11:11.000000", resultStr)
 
-	resultStr1 := a.String2(3)
+	resultStr1 := a.String2(time.Local, 3)
 	require.NoError(t, err)
 	require.Equal(t, "2012-01-01 11:11:11.000", resultStr1)
 
-	resultStr2 := a.String2(0)
+	resultStr2 := a.String2(time.Local, 0)
 	require.NoError(t, err)
 	require.Equal(t, "2012-01-01 11:11:11", resultStr2)
 
-	a, err = ParseTimestamp("2012-01-01 11:11:11.123", 6)
-	resultStr3 := a.String2(0)
+	a, err = ParseTimestamp(time.Local, "2012-01-01 11:11:11.123", 6)
+	resultStr3 := a.String2(time.Local, 0)
 	require.NoError(t, err)
 	require.Equal(t, "2012-01-01 11:11:11", resultStr3)
-	a, err = ParseTimestamp("20120101111111.123", 6)
-	resultStr3 = a.String2(0)
+	a, err = ParseTimestamp(time.Local, "20120101111111.123", 6)
+	resultStr3 = a.String2(time.Local, 0)
 	require.NoError(t, err)
 	require.Equal(t, "2012-01-01 11:11:11", resultStr3)
 
-	resultStr4 := a.String2(3)
+	resultStr4 := a.String2(time.Local, 3)
 	require.NoError(t, err)
 	require.Equal(t, "2012-01-01 11:11:11.123", resultStr4)
 
-	resultStr5 := a.String2(6)
+	resultStr5 := a.String2(time.Local, 6)
 	require.NoError(t, err)
 	require.Equal(t, "2012-01-01 11:11:11.123000", resultStr5)
 
-	a, err = ParseTimestamp("2012-01-01 11:11:11.123456", 3)
-	resultStr6 := a.String2(0)
+	a, err = ParseTimestamp(time.Local, "2012-01-01 11:11:11.123456", 3)
+	resultStr6 := a.String2(time.Local, 0)
 	require.NoError(t, err)
 	require.Equal(t, "2012-01-01 11:11:11", resultStr6)
 
-	resultStr7 := a.String2(3)
+	resultStr7 := a.String2(time.Local, 3)
 	require.NoError(t, err)
 	require.Equal(t, "2012-01-01 11:11:11.123", resultStr7)
 
-	resultStr8 := a.String2(6)
+	resultStr8 := a.String2(time.Local, 6)
 	require.NoError(t, err)
 	require.Equal(t, "2012-01-01 11:11:11.123000", resultStr8)
 }
 
 func TestParseTimestamp(t *testing.T) {
-	a, err := ParseTimestamp("0001-01-01 00:00:00", 6)
+	a, err := ParseTimestamp(time.UTC, "0001-01-01 00:00:00", 6)
 	require.NoError(t, err)
-	require.Equal(t, int64(a)+(localTZ<<20), int64(0))
+	require.Equal(t, int64(a), int64(0))
 
-	a, err = ParseTimestamp("0001-01-01 00:00:00.123", 6)
+	a, err = ParseTimestamp(time.UTC, "0001-01-01 00:00:00.123", 6)
 	require.NoError(t, err)
-	require.Equal(t, int64(a)+(localTZ<<20), int64(123000))
+	require.Equal(t, int64(a), int64(123000))
 
-	a, err = ParseTimestamp("0001-01-01 00:00:00.123456", 6)
+	a, err = ParseTimestamp(time.UTC, "0001-01-01 00:00:00.123456", 6)
 	require.NoError(t, err)
-	require.Equal(t, int64(a)+(localTZ<<20), int64(123456))
+	require.Equal(t, int64(a), int64(123456))
 
-	a, err = ParseTimestamp("0001-01-01 00:00:00.123456", 3)
+	a, err = ParseTimestamp(time.UTC, "0001-01-01 00:00:00.123456", 3)
 	require.NoError(t, err)
-	require.Equal(t, int64(a)+(localTZ<<20), int64(123000))
+	require.Equal(t, int64(a), int64(123000))
+}
+
+func TestTimestampTimeZone(t *testing.T) {
+	shanghai := time.FixedZone("UTC+8", 8*secsPerHour)
+	tokyo := time.FixedZone("UTC+9", 9*secsPerHour)
+
+	a, err := ParseTimestamp(shanghai, "2022-05-01 11:11:11", 6)
+	require.NoError(t, err)
+	b, err := ParseTimestamp(time.UTC, "2022-05-01 03:11:11", 6)
+	require.NoError(t, err)
+	require.Equal(t, b, a)
+	require.Equal(t, "2022-05-01 11:11:11", a.String2(shanghai, 0))
+	require.Equal(t, "2022-05-01 12:11:11", a.String2(tokyo, 0))
+	require.Equal(t, "2022-05-01 03:11:11", a.String2(time.UTC, 0))
+
+	dts := make([]Datetime, 1)
+	_, err = TimestampToDatetime(tokyo, []Timestamp{a}, dts)
+	require.NoError(t, err)
+	require.Equal(t, "2022-05-01 12:11:11", dts[0].String())
+
+	// the offset depends on the daylight saving time of the instant
+	ny, err := time.LoadLocation("America/New_York")
+	if err == nil {
+		a, err = ParseTimestamp(ny, "2022-01-01 12:00:00", 0)
+		require.NoError(t, err)
+		require.Equal(t, "2022-01-01 17:00:00", a.String2(time.UTC, 0))
+		a, err = ParseTimestamp(ny, "2022-07-01 12:00:00", 0)
+		require.NoError(t, err)
+		require.Equal(t, "2022-07-01 16:00:00", a.String2(time.UTC, 0))
+		require.Equal(t, "2022-07-01 12:00:00", a.String2(ny, 0))
+	}
 }
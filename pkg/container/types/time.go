@@ -0,0 +1,200 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// time data type:
+// A TIME value is an elapsed time or a time of day, it may be negative and may be
+// greater than 24 hours, the range is '-838:59:59.000000' to '838:59:59.000000'.
+//
+// Internal representation:
+// time values are represented using a signed 64bit integer which holds the number of
+// microseconds, so the order of the integers is the same as the order of the values.
+
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+)
+
+const (
+	MaxTimeHour = 838
+
+	microSecsPerSec  = 1000000
+	microSecsPerHour = secsPerHour * microSecsPerSec
+	maxTimeValue     = (MaxTimeHour*secsPerHour + 59*secsPerMinute + 59) * microSecsPerSec
+)
+
+var (
+	errIncorrectTimeValue = errors.New(errno.DataException, "Incorrect time value")
+)
+
+// ParseTime will parse a string to be a Time
+// Support Format:
+// 1. [-][d ]hh:mm:ss(.msec), [-][d ]hh:mm, [-]d hh
+// 2. [-]hhmmss(.msec), [-]mmss(.msec), [-]ss(.msec)
+func ParseTime(s string, precision int32) (Time, error) {
+	s = strings.TrimSpace(s)
+	if len(s) == 0 {
+		return -1, errIncorrectTimeValue
+	}
+	isNeg := false
+	if s[0] == '-' {
+		isNeg = true
+		s = s[1:]
+	}
+
+	var msec uint32
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		m, err := parseMicroseconds(s[i+1:], precision)
+		if err != nil {
+			return -1, errIncorrectTimeValue
+		}
+		msec = m
+		s = s[:i]
+	}
+
+	var hour uint64
+	var minute, sec uint8
+	var err error
+	if strings.ContainsAny(s, ": ") {
+		hour, minute, sec, err = parseTimeWithColon(s)
+	} else {
+		hour, minute, sec, err = parseTimeWithoutColon(s)
+	}
+	if err != nil {
+		return -1, err
+	}
+	if minute > maxMinuteInHour || sec > maxSecondInMinute {
+		return -1, errIncorrectTimeValue
+	}
+	t := TimeFromClock(isNeg, hour, minute, sec, msec)
+	if t > maxTimeValue || t < -maxTimeValue {
+		return -1, errIncorrectTimeValue
+	}
+	return t, nil
+}
+
+func parseTimeWithColon(s string) (hour uint64, minute, sec uint8, err error) {
+	var days uint64
+	if i := strings.IndexByte(s, ' '); i >= 0 {
+		if days, err = strconv.ParseUint(s[:i], 10, 32); err != nil {
+			return 0, 0, 0, errIncorrectTimeValue
+		}
+		s = strings.TrimSpace(s[i+1:])
+	}
+	parts := strings.Split(s, ":")
+	if len(parts) > 3 {
+		return 0, 0, 0, errIncorrectTimeValue
+	}
+	vals := make([]uint64, len(parts))
+	for i, part := range parts {
+		if vals[i], err = strconv.ParseUint(part, 10, 32); err != nil {
+			return 0, 0, 0, errIncorrectTimeValue
+		}
+		if i > 0 && (len(part) > 2 || vals[i] > 59) {
+			return 0, 0, 0, errIncorrectTimeValue
+		}
+	}
+	hour = days*24 + vals[0]
+	if len(vals) > 1 {
+		minute = uint8(vals[1])
+	}
+	if len(vals) > 2 {
+		sec = uint8(vals[2])
+	}
+	return hour, minute, sec, nil
+}
+
+func parseTimeWithoutColon(s string) (hour uint64, minute, sec uint8, err error) {
+	v, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		return 0, 0, 0, errIncorrectTimeValue
+	}
+	sec = uint8(v % 100)
+	minute = uint8(v / 100 % 100)
+	hour = v / 10000
+	return hour, minute, sec, nil
+}
+
+// parseMicroseconds parses the fractional seconds part, s is truncated to precision digits
+// and padded with zeros, e.g. "123" will be interpreted as 123000 microseconds
+func parseMicroseconds(s string, precision int32) (uint32, error) {
+	if len(s) == 0 {
+		return 0, nil
+	}
+	if precision < 0 || precision > microSecondsDigits {
+		precision = microSecondsDigits
+	}
+	if len(s) > int(precision) {
+		s = s[:precision]
+	}
+	for len(s) < microSecondsDigits {
+		s += "0"
+	}
+	m, err := strconv.ParseUint(s, 10, 32)
+	if err != nil {
+		return 0, err
+	}
+	return uint32(m), nil
+}
+
+// TimeFromClock gets the Time value from its clock parts
+func TimeFromClock(isNeg bool, hour uint64, minute, sec uint8, msec uint32) Time {
+	t := int64(hour)*microSecsPerHour + int64(minute)*secsPerMinute*microSecsPerSec +
+		int64(sec)*microSecsPerSec + int64(msec)
+	if isNeg {
+		return Time(-t)
+	}
+	return Time(t)
+}
+
+// ClockFormat returns the clock parts of the Time value
+func (t Time) ClockFormat() (isNeg bool, hour uint64, minute, sec uint8, msec uint32) {
+	v := int64(t)
+	if v < 0 {
+		isNeg = true
+		v = -v
+	}
+	msec = uint32(v % microSecsPerSec)
+	v /= microSecsPerSec
+	hour = uint64(v / secsPerHour)
+	minute = uint8(v % secsPerHour / secsPerMinute)
+	sec = uint8(v % secsPerMinute)
+	return
+}
+
+func (t Time) String() string {
+	return t.String2(0)
+}
+
+// String2 stringify time, including its fractional seconds precision part(fsp)
+func (t Time) String2(precision int32) string {
+	isNeg, hour, minute, sec, msec := t.ClockFormat()
+	sign := ""
+	if isNeg {
+		sign = "-"
+	}
+	if precision > 0 {
+		if precision > microSecondsDigits {
+			precision = microSecondsDigits
+		}
+		msecInstr := fmt.Sprintf("%06d", msec)[:precision]
+		return fmt.Sprintf("%s%02d:%02d:%02d.%s", sign, hour, minute, sec, msecInstr)
+	}
+	return fmt.Sprintf("%s%02d:%02d:%02d", sign, hour, minute, sec)
+}
@@ -0,0 +1,100 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTime(t *testing.T) {
+	tests := []struct {
+		s         string
+		precision int32
+		want      string
+		wantErr   bool
+	}{
+		{s: "11:12:13", want: "11:12:13"},
+		{s: "-11:12:13", want: "-11:12:13"},
+		{s: "838:59:59", want: "838:59:59"},
+		{s: "-838:59:59", want: "-838:59:59"},
+		{s: "839:00:00", wantErr: true},
+		{s: "100:00:00", want: "100:00:00"},
+		{s: "2 10:00:00", want: "58:00:00"},
+		{s: "11:12", want: "11:12:00"},
+		{s: "111213", want: "11:12:13"},
+		{s: "1213", want: "00:12:13"},
+		{s: "13", want: "00:00:13"},
+		{s: "11:12:13.123456", precision: 6, want: "11:12:13.123456"},
+		{s: "11:12:13.123456", precision: 3, want: "11:12:13.123000"},
+		{s: "-00:00:01.5", precision: 6, want: "-00:00:01.500000"},
+		{s: "11:60:00", wantErr: true},
+		{s: "11:12:60", wantErr: true},
+		{s: "abc", wantErr: true},
+		{s: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := ParseTime(tt.s, tt.precision)
+		if tt.wantErr {
+			require.Error(t, err, tt.s)
+			continue
+		}
+		require.NoError(t, err, tt.s)
+		require.Equal(t, tt.want, got.String2(6)[:len(tt.want)], tt.s)
+	}
+
+	a, _ := ParseTime("-01:00:00", 0)
+	b, _ := ParseTime("00:59:59", 0)
+	c, _ := ParseTime("25:00:00", 0)
+	require.True(t, a < b && b < c)
+}
+
+func TestParseYear(t *testing.T) {
+	tests := []struct {
+		s       string
+		want    string
+		wantErr bool
+	}{
+		{s: "2022", want: "2022"},
+		{s: "1901", want: "1901"},
+		{s: "2155", want: "2155"},
+		{s: "0000", want: "0000"},
+		{s: "0", want: "2000"},
+		{s: "69", want: "2069"},
+		{s: "70", want: "1970"},
+		{s: "1900", wantErr: true},
+		{s: "2156", wantErr: true},
+		{s: "abc", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := ParseYear(tt.s)
+		if tt.wantErr {
+			require.Error(t, err, tt.s)
+			continue
+		}
+		require.NoError(t, err, tt.s)
+		require.Equal(t, tt.want, got.String(), tt.s)
+	}
+
+	y, err := YearFromInt(0)
+	require.NoError(t, err)
+	require.Equal(t, "0000", y.String())
+	y, err = YearFromInt(5)
+	require.NoError(t, err)
+	require.Equal(t, "2005", y.String())
+	_, err = YearFromInt(3000)
+	require.Error(t, err)
+}
@@ -15,9 +15,9 @@
 // timestamp data type:
 // Question 1: When should I use Datetime, and when should I use Timestamp?
 // 		Well, during insertion, the Datetime value will be stored as is(we have some bugs in here now),
-//		but for timestamp, the timestamp value passed in will be converted to a UTC timestamp, that is,
-//		the value passed in subtract by the server's local Time Zone
-// 		so, during retrieval, if the server's time zone is the same as the time zone when the timestamp value got inserted,
+//		but for timestamp, the timestamp value passed in will be converted from the session time zone
+//		to a UTC timestamp, and during retrieval, it will be converted back from UTC to the session time zone,
+// 		so, if the session time zone of retrieval is the same as the one of insertion,
 //		the timestamp valued retrieved is the same value as the inserted, but if these two timezones are different, you
 // 		will get different timestamp value.
 //      for example:     		insertion timezone	insertion value					retrieval timezone  retrieval value
@@ -27,7 +27,7 @@
 // you may otherwise encounter by using DATETIME
 //
 // Internal representation:
-// timestamp values are represented using a 64bit integer, the higher 40 bits stores the secs since January 1, year 1, local time zone, in Gregorian
+// timestamp values are represented using a 64bit integer, the higher 44 bits stores the secs since January 1, year 1, UTC, in Gregorian
 // calendar, and lower 20 bits hold the number of microseconds
 // the default fractional seconds precision(fsp) for TIMESTAMP is 6, as SQL standard requires.
 
@@ -36,22 +36,27 @@ package types
 import (
 	"fmt"
 	"strconv"
-	"unsafe"
+	"strings"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
 )
 
 const microSecondsDigits = 6
 
+// String stringify timestamp in the local time zone of the server
 func (ts Timestamp) String() string {
-	dt := Datetime(int64(ts) + localTZ<<20)
+	dt := ts.ToDatetime(time.Local)
 	y, m, d, _ := dt.ToDate().Calendar(true)
 	hour, minute, sec := dt.Clock()
 	msec := int64(ts) & 0xfffff // the lower 20 bits of timestamp stores the microseconds value
 	return fmt.Sprintf("%04d-%02d-%02d %02d:%02d:%02d.%06d", y, m, d, hour, minute, sec, msec)
 }
 
-// String2 stringify timestamp, including its fractional seconds precision part(fsp)
-func (ts Timestamp) String2(precision int32) string {
-	dt := Datetime(int64(ts) + localTZ<<20)
+// String2 stringify timestamp in time zone loc, including its fractional seconds precision part(fsp)
+func (ts Timestamp) String2(loc *time.Location, precision int32) string {
+	dt := ts.ToDatetime(loc)
 	y, m, d, _ := dt.ToDate().Calendar(true)
 	hour, minute, sec := dt.Clock()
 	if precision > 0 {
@@ -65,15 +70,32 @@ func (ts Timestamp) String2(precision int32) string {
 	return fmt.Sprintf("%04d-%02d-%02d %02d:%02d:%02d", y, m, d, hour, minute, sec)
 }
 
+// ToDatetime returns the wall clock of the timestamp in time zone loc
+func (ts Timestamp) ToDatetime(loc *time.Location) Datetime {
+	return Datetime(int64(ts) + ts.zoneOffset(loc)<<20)
+}
+
+// zoneOffset returns the offset in seconds east of UTC of time zone loc at the instant ts
+func (ts Timestamp) zoneOffset(loc *time.Location) int64 {
+	if loc == time.UTC {
+		return 0
+	}
+	unix := int64(ts)>>20 - int64(unixEpochDays)*secsPerDay
+	_, offset := time.Unix(unix, 0).In(loc).Zone()
+	return int64(offset)
+}
+
 // ParseTimestamp will parse a string to be a Timestamp
 // Support Format:
 // 1. all the Date value
 // 2. yyyy-mm-dd hh:mm:ss(.msec)
 // 3. yyyymmddhhmmss(.msec)
-func ParseTimestamp(s string, precision int32) (Timestamp, error) {
+// the value is interpreted as a wall clock in time zone loc
+func ParseTimestamp(loc *time.Location, s string, precision int32) (Timestamp, error) {
 	if len(s) < 14 {
 		if d, err := ParseDate(s); err == nil {
-			return Timestamp(d.ToTime()), nil
+			y, m, dd, _ := d.Calendar(true)
+			return FromClockZone(loc, y, m, dd, 0, 0, 0, 0), nil
 		}
 		return -1, errIncorrectDatetimeValue
 	}
@@ -161,24 +183,86 @@ func ParseTimestamp(s string, precision int32) (Timestamp, error) {
 			}
 		}
 	}
-	result := FromClockUTC(year, month, day, hour, minute, second, msec)
+	result := FromClockZone(loc, year, month, day, hour, minute, second, msec)
 
 	return result, nil
 }
 
-func TimestampToDatetime(xs []Timestamp, rs []Datetime) ([]Datetime, error) {
-	localTZAligned := localTZ << 20
-	xsInInt64 := *(*[]int64)(unsafe.Pointer(&xs))
-	rsInInt64 := *(*[]int64)(unsafe.Pointer(&rs))
-	for i, x := range xsInInt64 {
-		rsInInt64[i] = x + localTZAligned
+// TimestampToDatetime converts the timestamps to the wall clocks in time zone loc
+func TimestampToDatetime(loc *time.Location, xs []Timestamp, rs []Datetime) ([]Datetime, error) {
+	for i, x := range xs {
+		rs[i] = x.ToDatetime(loc)
 	}
 	return rs, nil
 }
 
-// FromClockUTC gets the utc time value in Timestamp
+// FromClockUTC gets the Timestamp value of a UTC wall clock
 func FromClockUTC(year int32, month, day, hour, min, sec uint8, msec uint32) Timestamp {
 	days := FromCalendar(year, month, day)
-	secs := int64(days)*secsPerDay + int64(hour)*secsPerHour + int64(min)*secsPerMinute + int64(sec) - localTZ
+	secs := int64(days)*secsPerDay + int64(hour)*secsPerHour + int64(min)*secsPerMinute + int64(sec)
 	return Timestamp((secs << 20) + int64(msec))
 }
+
+// FromClockZone gets the Timestamp value of a wall clock in time zone loc
+func FromClockZone(loc *time.Location, year int32, month, day, hour, min, sec uint8, msec uint32) Timestamp {
+	ts := FromClockUTC(year, month, day, hour, min, sec, msec)
+	if loc == time.UTC {
+		return ts
+	}
+	_, offset := time.Date(int(year), time.Month(month), int(day), int(hour), int(min), int(sec), 0, loc).Zone()
+	return ts - Timestamp(int64(offset)<<20)
+}
+
+// ToTimestamp returns the timestamp of the wall clock dt in time zone loc
+func (dt Datetime) ToTimestamp(loc *time.Location) Timestamp {
+	y, m, d, _ := dt.ToDate().Calendar(true)
+	hour, minute, sec := dt.Clock()
+	return FromClockZone(loc, y, m, d, uint8(hour), uint8(minute), uint8(sec), uint32(dt.MicroSec()))
+}
+
+// UnixMicro returns the number of microseconds elapsed since 1970-01-01 00:00:00 UTC
+func (ts Timestamp) UnixMicro() int64 {
+	return Datetime(ts).UnixMicro()
+}
+
+// TimestampFromUnixMicro returns the timestamp which is us microseconds after 1970-01-01 00:00:00 UTC
+func TimestampFromUnixMicro(us int64) Timestamp {
+	return Timestamp(DatetimeFromUnixMicro(us))
+}
+
+// ParseTimeZone parses the name of a time zone, which can be 'SYSTEM', an offset
+// from UTC like '+08:00', or a named time zone like 'Asia/Shanghai'.
+func ParseTimeZone(name string) (*time.Location, error) {
+	errUnknownTimeZone := errors.New(errno.DataException, fmt.Sprintf("Unknown or incorrect time zone: '%s'", name))
+	if strings.EqualFold(name, "SYSTEM") {
+		return time.Local, nil
+	}
+	if len(name) > 0 && (name[0] == '+' || name[0] == '-') {
+		parts := strings.Split(name[1:], ":")
+		if len(parts) != 2 || len(parts[1]) != 2 {
+			return nil, errUnknownTimeZone
+		}
+		hour, err := strconv.ParseUint(parts[0], 10, 8)
+		if err != nil {
+			return nil, errUnknownTimeZone
+		}
+		minute, err := strconv.ParseUint(parts[1], 10, 8)
+		if err != nil || minute > 59 {
+			return nil, errUnknownTimeZone
+		}
+		offset := int(hour*secsPerHour + minute*secsPerMinute)
+		if name[0] == '-' {
+			offset = -offset
+		}
+		// the valid range is '-13:59' to '+14:00'
+		if offset > 14*secsPerHour || offset < -(13*secsPerHour+59*secsPerMinute) {
+			return nil, errUnknownTimeZone
+		}
+		return time.FixedZone(name, offset), nil
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil || len(name) == 0 {
+		return nil, errUnknownTimeZone
+	}
+	return loc, nil
+}
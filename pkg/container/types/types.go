@@ -47,17 +47,30 @@ const (
 
 	// date family
 	T_date      T = T(plan.Type_DATE)
+	T_time      T = T(plan.Type_TIME)
 	T_datetime  T = T(plan.Type_DATETIME)
 	T_timestamp T = T(plan.Type_TIMESTAMP)
 	T_interval  T = T(plan.Type_INTERVAL)
+	T_year      T = T(plan.Type_YEAR)
 
 	// string family
 	T_char    T = T(plan.Type_CHAR)
 	T_varchar T = T(plan.Type_VARCHAR)
+	T_text    T = T(plan.Type_TEXT) // large string, may be stored out of line
+
+	// binary family
+	T_blob T = T(plan.Type_BLOB) // large binary, may be stored out of line
 
 	// json family
 	T_json T = T(plan.Type_JSON)
 
+	// uuid family
+	T_uuid T = T(plan.Type_UUID)
+
+	// enum family, the members are kept in the catalog
+	T_enum T = T(plan.Type_ENUM) // index of the member, stored as uint16
+	T_set  T = T(plan.Type_SET)  // bitmap of the members, stored as uint64
+
 	// numeric/decimal family - unsigned attribute is deprecated
 	T_decimal64  = T(plan.Type_DECIMAL64)
 	T_decimal128 = T(plan.Type_DECIMAL128)
@@ -87,8 +100,12 @@ type Bytes struct {
 
 type Date int32
 
+type Time int64
 type Datetime int64
 type Timestamp int64
+type YearValue int16
+
+type Uuid [16]byte
 
 type Decimal64 int64
 type Decimal128 struct {
@@ -118,14 +135,31 @@ var Types map[string]T = map[string]T{
 	"double": T_float64,
 
 	"date":      T_date,
+	"time":      T_time,
 	"datetime":  T_datetime,
 	"timestamp": T_timestamp,
 	"interval":  T_interval,
+	"year":      T_year,
 
 	"char":    T_char,
 	"varchar": T_varchar,
 
+	"text":       T_text,
+	"tinytext":   T_text,
+	"mediumtext": T_text,
+	"longtext":   T_text,
+
+	"blob":       T_blob,
+	"tinyblob":   T_blob,
+	"mediumblob": T_blob,
+	"longblob":   T_blob,
+
 	"json": T_json,
+
+	"uuid": T_uuid,
+
+	"enum": T_enum,
+	"set":  T_set,
 }
 
 func (t Type) String() string {
@@ -143,11 +177,11 @@ func (t T) ToType() Type {
 	switch t {
 	case T_int8:
 		typ.Size = 1
-	case T_int16:
+	case T_int16, T_year, T_enum:
 		typ.Size = 2
 	case T_int32, T_date:
 		typ.Size = 4
-	case T_int64, T_datetime, T_timestamp:
+	case T_int64, T_time, T_datetime, T_timestamp:
 		typ.Size = 8
 	case T_uint8:
 		typ.Size = 1
@@ -155,7 +189,7 @@ func (t T) ToType() Type {
 		typ.Size = 2
 	case T_uint32:
 		typ.Size = 4
-	case T_uint64:
+	case T_uint64, T_set:
 		typ.Size = 8
 	case T_float32:
 		typ.Size = 4
@@ -163,13 +197,13 @@ func (t T) ToType() Type {
 		typ.Size = 8
 	case T_char:
 		typ.Size = 24
-	case T_varchar:
+	case T_varchar, T_json, T_text, T_blob:
 		typ.Size = 24
 	case T_sel:
 		typ.Size = 8
 	case T_decimal64:
 		typ.Size = 8
-	case T_decimal128:
+	case T_decimal128, T_uuid:
 		typ.Size = 16
 	}
 	return typ
@@ -201,6 +235,10 @@ func (t T) String() string {
 		return "DOUBLE"
 	case T_date:
 		return "DATE"
+	case T_time:
+		return "TIME"
+	case T_year:
+		return "YEAR"
 	case T_datetime:
 		return "DATETIME"
 	case T_timestamp:
@@ -209,8 +247,18 @@ func (t T) String() string {
 		return "CHAR"
 	case T_varchar:
 		return "VARCHAR"
+	case T_text:
+		return "TEXT"
+	case T_blob:
+		return "BLOB"
 	case T_json:
 		return "JSON"
+	case T_uuid:
+		return "UUID"
+	case T_enum:
+		return "ENUM"
+	case T_set:
+		return "SET"
 	case T_sel:
 		return "SEL"
 	case T_tuple:
@@ -254,8 +302,24 @@ func (t T) OidString() string {
 		return "T_char"
 	case T_varchar:
 		return "T_varchar"
+	case T_text:
+		return "T_text"
+	case T_blob:
+		return "T_blob"
+	case T_json:
+		return "T_json"
+	case T_uuid:
+		return "T_uuid"
+	case T_enum:
+		return "T_enum"
+	case T_set:
+		return "T_set"
 	case T_date:
 		return "T_date"
+	case T_time:
+		return "T_time"
+	case T_year:
+		return "T_year"
 	case T_datetime:
 		return "T_datetime"
 	case T_timestamp:
@@ -285,20 +349,24 @@ func (t T) GoType() string {
 		return "float32"
 	case T_uint8:
 		return "uint8"
-	case T_uint16:
+	case T_uint16, T_enum:
 		return "uint16"
 	case T_uint32:
 		return "uint32"
-	case T_uint64:
+	case T_uint64, T_set:
 		return "uint64"
 	case T_sel:
 		return "int64"
 	case T_char:
 		return "string"
-	case T_varchar:
+	case T_varchar, T_json, T_text, T_blob:
 		return "string"
 	case T_date:
 		return "date"
+	case T_time:
+		return "time"
+	case T_year:
+		return "yearValue"
 	case T_datetime:
 		return "datetime"
 	case T_timestamp:
@@ -307,13 +375,15 @@ func (t T) GoType() string {
 		return "decimal64"
 	case T_decimal128:
 		return "decimal128"
+	case T_uuid:
+		return "uuid"
 	}
 	return "unknown type"
 }
 
 // GoGoType returns special go type string for T
 func (t T) GoGoType() string {
-	if t == T_char || t == T_varchar {
+	if t == T_char || t == T_varchar || t == T_json || t == T_text || t == T_blob {
 		return "Str"
 	}
 	k := t.GoType()
@@ -325,19 +395,19 @@ func (t T) TypeLen() int {
 	switch t {
 	case T_int8:
 		return 1
-	case T_int16:
+	case T_int16, T_year:
 		return 2
 	case T_int32, T_date:
 		return 4
-	case T_int64, T_datetime, T_timestamp:
+	case T_int64, T_time, T_datetime, T_timestamp:
 		return 8
 	case T_uint8:
 		return 1
-	case T_uint16:
+	case T_uint16, T_enum:
 		return 2
 	case T_uint32:
 		return 4
-	case T_uint64:
+	case T_uint64, T_set:
 		return 8
 	case T_float32:
 		return 4
@@ -345,13 +415,13 @@ func (t T) TypeLen() int {
 		return 8
 	case T_char:
 		return 24
-	case T_varchar:
+	case T_varchar, T_json, T_text, T_blob:
 		return 24
 	case T_sel:
 		return 8
 	case T_decimal64:
 		return 8
-	case T_decimal128:
+	case T_decimal128, T_uuid:
 		return 16
 	}
 	panic(moerr.NewInternalError("Unknow type %s", t))
@@ -361,19 +431,21 @@ func (t T) FixedLength() int {
 	switch t {
 	case T_int8, T_uint8:
 		return 1
-	case T_int16, T_uint16:
+	case T_int16, T_uint16, T_year, T_enum:
 		return 2
 	case T_int32, T_uint32, T_date, T_float32:
 		return 4
-	case T_int64, T_uint64, T_datetime, T_float64, T_timestamp:
+	case T_int64, T_uint64, T_time, T_datetime, T_float64, T_timestamp, T_set:
 		return 8
+	case T_uuid:
+		return 16
 	case T_decimal64:
 		return -8
 	case T_decimal128:
 		return -16
 	case T_char:
 		return -24
-	case T_varchar:
+	case T_varchar, T_json, T_text, T_blob:
 		return -24
 	case T_sel:
 		return 8
@@ -0,0 +1,166 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// uuid data type:
+// A UUID value is the 128-bit identifier defined by RFC 4122, its text form is
+// 32 hex digits in five groups like '6ccd780c-baba-1026-9564-5b8c656024db'.
+//
+// Internal representation:
+// uuid values are represented using 16 bytes in the order of the text form,
+// so the values can be ordered and hashed by their bytes.
+
+package types
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+)
+
+const (
+	UuidStringLen = 36
+
+	// the count of 100-nanosecond intervals between 1582-10-15 and 1970-01-01
+	uuidEpochOffset = 0x01B21DD213814000
+)
+
+var (
+	errIncorrectUuidValue = errors.New(errno.DataException, "Incorrect uuid value")
+)
+
+// ParseUuid will parse a string to be a Uuid
+// Support Format:
+// 1. 'aaaaaaaa-bbbb-cccc-dddd-eeeeeeeeeeee'
+// 2. 'aaaaaaaabbbbccccddddeeeeeeeeeeee'
+// 3. '{aaaaaaaa-bbbb-cccc-dddd-eeeeeeeeeeee}'
+func ParseUuid(s string) (Uuid, error) {
+	var u Uuid
+
+	switch len(s) {
+	case 32:
+	case UuidStringLen + 2:
+		if s[0] != '{' || s[len(s)-1] != '}' {
+			return u, errIncorrectUuidValue
+		}
+		s = s[1 : len(s)-1]
+		fallthrough
+	case UuidStringLen:
+		if s[8] != '-' || s[13] != '-' || s[18] != '-' || s[23] != '-' {
+			return u, errIncorrectUuidValue
+		}
+		s = s[:8] + s[9:13] + s[14:18] + s[19:23] + s[24:]
+	default:
+		return u, errIncorrectUuidValue
+	}
+	if _, err := hex.Decode(u[:], []byte(s)); err != nil {
+		return u, errIncorrectUuidValue
+	}
+	return u, nil
+}
+
+// IsUuid returns true if the string is a valid text form of uuid
+func IsUuid(s string) bool {
+	_, err := ParseUuid(s)
+	return err == nil
+}
+
+func (u Uuid) String() string {
+	var buf [UuidStringLen]byte
+
+	hex.Encode(buf[:8], u[:4])
+	buf[8] = '-'
+	hex.Encode(buf[9:13], u[4:6])
+	buf[13] = '-'
+	hex.Encode(buf[14:18], u[6:8])
+	buf[18] = '-'
+	hex.Encode(buf[19:23], u[8:10])
+	buf[23] = '-'
+	hex.Encode(buf[24:], u[10:])
+	return string(buf[:])
+}
+
+// SwapTime moves the time-high part to the front and the time-low part to the end,
+// so the version 1 uuids generated one by one are in increasing order.
+// It is the swap flag of UUID_TO_BIN.
+func (u Uuid) SwapTime() Uuid {
+	var w Uuid
+
+	copy(w[0:2], u[6:8])
+	copy(w[2:4], u[4:6])
+	copy(w[4:8], u[0:4])
+	copy(w[8:], u[8:])
+	return w
+}
+
+// UnswapTime is the reverse of SwapTime, it is the swap flag of BIN_TO_UUID.
+func (u Uuid) UnswapTime() Uuid {
+	var w Uuid
+
+	copy(w[0:4], u[4:8])
+	copy(w[4:6], u[2:4])
+	copy(w[6:8], u[0:2])
+	copy(w[8:], u[8:])
+	return w
+}
+
+func CompareUuid(a, b Uuid) int {
+	return bytes.Compare(a[:], b[:])
+}
+
+var uuidGenerator struct {
+	sync.Mutex
+	inited   bool
+	lastTime uint64
+	clockSeq uint16
+	node     [6]byte
+}
+
+// NewUuid returns a version 1 uuid as the UUID() of mysql does, the node is a
+// random multicast address because the hardware address is not always available.
+func NewUuid() (Uuid, error) {
+	var u Uuid
+
+	g := &uuidGenerator
+	g.Lock()
+	defer g.Unlock()
+	if !g.inited {
+		var seed [8]byte
+		if _, err := rand.Read(seed[:]); err != nil {
+			return u, err
+		}
+		g.clockSeq = binary.BigEndian.Uint16(seed[:2])
+		copy(g.node[:], seed[2:])
+		g.node[0] |= 0x01
+		g.inited = true
+	}
+	now := uint64(time.Now().UnixNano()/100) + uuidEpochOffset
+	if now <= g.lastTime {
+		// keep the uuids unique when the clock is not moving forward
+		now = g.lastTime + 1
+	}
+	g.lastTime = now
+
+	binary.BigEndian.PutUint32(u[0:], uint32(now))
+	binary.BigEndian.PutUint16(u[4:], uint16(now>>32))
+	binary.BigEndian.PutUint16(u[6:], uint16(now>>48)&0x0fff|0x1000)
+	binary.BigEndian.PutUint16(u[8:], g.clockSeq&0x3fff|0x8000)
+	copy(u[10:], g.node[:])
+	return u, nil
+}
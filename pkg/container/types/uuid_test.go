@@ -0,0 +1,70 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseUuid(t *testing.T) {
+	want := "6ccd780c-baba-1026-9564-5b8c656024db"
+	tests := []struct {
+		s       string
+		wantErr bool
+	}{
+		{s: "6ccd780c-baba-1026-9564-5b8c656024db"},
+		{s: "6CCD780C-BABA-1026-9564-5B8C656024DB"},
+		{s: "6ccd780cbaba102695645b8c656024db"},
+		{s: "{6ccd780c-baba-1026-9564-5b8c656024db}"},
+		{s: "6ccd780c-baba-1026-9564-5b8c656024d", wantErr: true},
+		{s: "6ccd780c+baba-1026-9564-5b8c656024db", wantErr: true},
+		{s: "(6ccd780c-baba-1026-9564-5b8c656024db)", wantErr: true},
+		{s: "6ccd780c-baba-1026-9564-5b8c656024dx", wantErr: true},
+		{s: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		u, err := ParseUuid(tt.s)
+		if tt.wantErr {
+			require.Error(t, err, tt.s)
+			require.False(t, IsUuid(tt.s), tt.s)
+			continue
+		}
+		require.NoError(t, err, tt.s)
+		require.Equal(t, want, u.String(), tt.s)
+	}
+}
+
+func TestUuidSwapTime(t *testing.T) {
+	u, err := ParseUuid("6ccd780c-baba-1026-9564-5b8c656024db")
+	require.NoError(t, err)
+	w := u.SwapTime()
+	require.Equal(t, "1026baba-6ccd-780c-9564-5b8c656024db", w.String())
+	require.Equal(t, u, w.UnswapTime())
+}
+
+func TestNewUuid(t *testing.T) {
+	prev, err := NewUuid()
+	require.NoError(t, err)
+	require.Equal(t, byte(0x10), prev[6]&0xf0)
+	require.Equal(t, byte(0x80), prev[8]&0xc0)
+	for i := 0; i < 100; i++ {
+		u, err := NewUuid()
+		require.NoError(t, err)
+		// the swapped uuids are increasing
+		require.Equal(t, 1, CompareUuid(u.SwapTime(), prev.SwapTime()))
+		prev = u
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+)
+
+const (
+	MinYear = 1901
+	MaxYear = 2155
+)
+
+var (
+	errIncorrectYearValue = errors.New(errno.DataException, "Incorrect year value")
+)
+
+// ParseYear will parse a string to be a YearValue
+// Support Format:
+// 1. 4-digit year in range 1901 to 2155, or '0000'
+// 2. 1 or 2-digit year, '0' to '69' are 2000 to 2069, '70' to '99' are 1970 to 1999
+func ParseYear(s string) (YearValue, error) {
+	s = strings.TrimSpace(s)
+	v, err := strconv.ParseUint(s, 10, 16)
+	if err != nil {
+		return 0, errIncorrectYearValue
+	}
+	if len(s) == 4 && v == 0 {
+		return 0, nil
+	}
+	if len(s) <= 2 {
+		if v < 70 {
+			return YearValue(2000 + v), nil
+		}
+		return YearValue(1900 + v), nil
+	}
+	if v < MinYear || v > MaxYear {
+		return 0, errIncorrectYearValue
+	}
+	return YearValue(v), nil
+}
+
+// YearFromInt converts an integer to a YearValue, the number 0 is the year 0000,
+// 1 to 69 are 2001 to 2069, 70 to 99 are 1970 to 1999
+func YearFromInt(v int64) (YearValue, error) {
+	switch {
+	case v == 0:
+		return 0, nil
+	case v > 0 && v < 70:
+		return YearValue(2000 + v), nil
+	case v >= 70 && v < 100:
+		return YearValue(1900 + v), nil
+	case v >= MinYear && v <= MaxYear:
+		return YearValue(v), nil
+	}
+	return 0, errIncorrectYearValue
+}
+
+func (y YearValue) String() string {
+	return fmt.Sprintf("%04d", int16(y))
+}
@@ -22,6 +22,7 @@ import (
 	"strconv"
 	"unsafe"
 
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
 	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/encoding"
@@ -71,7 +72,7 @@ func New(typ types.Type) *Vector {
 			Col: []uint8{},
 			Nsp: &nulls.Nulls{},
 		}
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		return &Vector{
 			Typ: typ,
 			Col: []uint16{},
@@ -83,7 +84,7 @@ func New(typ types.Type) *Vector {
 			Col: []uint32{},
 			Nsp: &nulls.Nulls{},
 		}
-	case types.T_uint64:
+	case types.T_uint64, types.T_set:
 		return &Vector{
 			Typ: typ,
 			Col: []uint64{},
@@ -119,6 +120,24 @@ func New(typ types.Type) *Vector {
 			Col: []types.Timestamp{},
 			Nsp: &nulls.Nulls{},
 		}
+	case types.T_time:
+		return &Vector{
+			Typ: typ,
+			Col: []types.Time{},
+			Nsp: &nulls.Nulls{},
+		}
+	case types.T_year:
+		return &Vector{
+			Typ: typ,
+			Col: []types.YearValue{},
+			Nsp: &nulls.Nulls{},
+		}
+	case types.T_uuid:
+		return &Vector{
+			Typ: typ,
+			Col: []types.Uuid{},
+			Nsp: &nulls.Nulls{},
+		}
 	case types.T_sel:
 		return &Vector{
 			Typ: typ,
@@ -131,7 +150,7 @@ func New(typ types.Type) *Vector {
 			Nsp: &nulls.Nulls{},
 			Col: [][]interface{}{},
 		}
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 		return &Vector{
 			Typ: typ,
 			Col: &types.Bytes{},
@@ -162,7 +181,7 @@ func NewConst(typ types.Type) *Vector {
 
 func Reset(v *Vector) {
 	switch v.Typ.Oid {
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 		v.Col.(*types.Bytes).Reset()
 	default:
 		*(*int)(unsafe.Pointer(uintptr((*(*emptyInterface)(unsafe.Pointer(&v.Col))).word) + uintptr(strconv.IntSize>>3))) = 0
@@ -228,7 +247,7 @@ func PreAlloc(v, w *Vector, rows int, m *mheap.Mheap) {
 		}
 		v.Data = data
 		v.Col = encoding.DecodeUint8Slice(v.Data)[:0]
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		data, err := mheap.Alloc(m, int64(rows*2))
 		if err != nil {
 			return
@@ -242,7 +261,7 @@ func PreAlloc(v, w *Vector, rows int, m *mheap.Mheap) {
 		}
 		v.Data = data
 		v.Col = encoding.DecodeUint32Slice(v.Data)[:0]
-	case types.T_uint64:
+	case types.T_uint64, types.T_set:
 		data, err := mheap.Alloc(m, int64(rows*8))
 		if err != nil {
 			return
@@ -284,7 +303,28 @@ func PreAlloc(v, w *Vector, rows int, m *mheap.Mheap) {
 		}
 		v.Data = data
 		v.Col = encoding.DecodeTimestampSlice(v.Data)[:0]
-	case types.T_char, types.T_varchar:
+	case types.T_time:
+		data, err := mheap.Alloc(m, int64(rows*8))
+		if err != nil {
+			return
+		}
+		v.Data = data
+		v.Col = encoding.DecodeTimeSlice(v.Data)[:0]
+	case types.T_year:
+		data, err := mheap.Alloc(m, int64(rows*2))
+		if err != nil {
+			return
+		}
+		v.Data = data
+		v.Col = encoding.DecodeYearValueSlice(v.Data)[:0]
+	case types.T_uuid:
+		data, err := mheap.Alloc(m, int64(rows*16))
+		if err != nil {
+			return
+		}
+		v.Data = data
+		v.Col = encoding.DecodeUuidSlice(v.Data)[:0]
+	case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 		vs, ws := v.Col.(*types.Bytes), w.Col.(*types.Bytes)
 		data, err := mheap.Alloc(m, int64(rows*len(ws.Data)/len(ws.Offsets)))
 		if err != nil {
@@ -299,7 +339,7 @@ func PreAlloc(v, w *Vector, rows int, m *mheap.Mheap) {
 
 func Length(v *Vector) int {
 	switch v.Typ.Oid {
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 		return len(v.Col.(*types.Bytes).Offsets)
 	default:
 		return reflect.ValueOf(v.Col).Len()
@@ -325,11 +365,11 @@ func SetLength(v *Vector, n int) {
 		setLengthFixed[int64](v, n)
 	case types.T_uint8:
 		setLengthFixed[uint8](v, n)
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		setLengthFixed[uint16](v, n)
 	case types.T_uint32:
 		setLengthFixed[uint32](v, n)
-	case types.T_uint64:
+	case types.T_uint64, types.T_set:
 		setLengthFixed[uint64](v, n)
 	case types.T_float32:
 		setLengthFixed[float32](v, n)
@@ -341,6 +381,12 @@ func SetLength(v *Vector, n int) {
 		setLengthFixed[types.Datetime](v, n)
 	case types.T_timestamp:
 		setLengthFixed[types.Timestamp](v, n)
+	case types.T_time:
+		setLengthFixed[types.Time](v, n)
+	case types.T_year:
+		setLengthFixed[types.YearValue](v, n)
+	case types.T_uuid:
+		setLengthFixed[types.Uuid](v, n)
 	case types.T_decimal64:
 		setLengthFixed[types.Decimal64](v, n)
 	case types.T_decimal128:
@@ -356,7 +402,7 @@ func SetLength(v *Vector, n int) {
 		m := len(vs)
 		v.Col = vs[:n]
 		nulls.RemoveRange(v.Nsp, uint64(n), uint64(m))
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 		vs := v.Col.(*types.Bytes)
 		m := len(vs.Offsets)
 		vs.Data = vs.Data[:vs.Offsets[n-1]+vs.Lengths[n-1]]
@@ -466,7 +512,7 @@ func Dup(v *Vector, m *mheap.Mheap) (*Vector, error) {
 			Ref:  v.Ref,
 			Link: v.Link,
 		}, nil
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		vs := v.Col.([]uint16)
 		data, err := mheap.Alloc(m, int64(len(vs)*2))
 		if err != nil {
@@ -498,7 +544,7 @@ func Dup(v *Vector, m *mheap.Mheap) (*Vector, error) {
 			Ref:  v.Ref,
 			Link: v.Link,
 		}, nil
-	case types.T_uint64:
+	case types.T_uint64, types.T_set:
 		vs := v.Col.([]uint64)
 		data, err := mheap.Alloc(m, int64(len(vs)*8))
 		if err != nil {
@@ -546,7 +592,7 @@ func Dup(v *Vector, m *mheap.Mheap) (*Vector, error) {
 			Ref:  v.Ref,
 			Link: v.Link,
 		}, nil
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 		var err error
 		var data []byte
 
@@ -622,6 +668,54 @@ func Dup(v *Vector, m *mheap.Mheap) (*Vector, error) {
 			Ref:  v.Ref,
 			Link: v.Link,
 		}, nil
+	case types.T_time:
+		vs := v.Col.([]types.Time)
+		data, err := mheap.Alloc(m, int64(len(vs)*8))
+		if err != nil {
+			return nil, err
+		}
+		ws := encoding.DecodeTimeSlice(data)
+		copy(ws, vs)
+		return &Vector{
+			Col:  ws,
+			Data: data,
+			Typ:  v.Typ,
+			Nsp:  v.Nsp,
+			Ref:  v.Ref,
+			Link: v.Link,
+		}, nil
+	case types.T_year:
+		vs := v.Col.([]types.YearValue)
+		data, err := mheap.Alloc(m, int64(len(vs)*2))
+		if err != nil {
+			return nil, err
+		}
+		ws := encoding.DecodeYearValueSlice(data)
+		copy(ws, vs)
+		return &Vector{
+			Col:  ws,
+			Data: data,
+			Typ:  v.Typ,
+			Nsp:  v.Nsp,
+			Ref:  v.Ref,
+			Link: v.Link,
+		}, nil
+	case types.T_uuid:
+		vs := v.Col.([]types.Uuid)
+		data, err := mheap.Alloc(m, int64(len(vs)*16))
+		if err != nil {
+			return nil, err
+		}
+		ws := encoding.DecodeUuidSlice(data)
+		copy(ws, vs)
+		return &Vector{
+			Col:  ws,
+			Data: data,
+			Typ:  v.Typ,
+			Nsp:  v.Nsp,
+			Ref:  v.Ref,
+			Link: v.Link,
+		}, nil
 	case types.T_decimal64:
 		vs := v.Col.([]types.Decimal64)
 		data, err := mheap.Alloc(m, int64(len(vs)*8))
@@ -676,13 +770,13 @@ func Window(v *Vector, start, end int, w *Vector) *Vector {
 	case types.T_uint8:
 		w.Col = v.Col.([]uint8)[start:end]
 		w.Nsp = nulls.Range(v.Nsp, uint64(start), uint64(end), w.Nsp)
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		w.Col = v.Col.([]uint16)[start:end]
 		w.Nsp = nulls.Range(v.Nsp, uint64(start), uint64(end), w.Nsp)
 	case types.T_uint32:
 		w.Col = v.Col.([]uint32)[start:end]
 		w.Nsp = nulls.Range(v.Nsp, uint64(start), uint64(end), w.Nsp)
-	case types.T_uint64:
+	case types.T_uint64, types.T_set:
 		w.Col = v.Col.([]uint64)[start:end]
 		w.Nsp = nulls.Range(v.Nsp, uint64(start), uint64(end), w.Nsp)
 	case types.T_float32:
@@ -697,7 +791,7 @@ func Window(v *Vector, start, end int, w *Vector) *Vector {
 	case types.T_tuple:
 		w.Col = v.Col.([][]interface{})[start:end]
 		w.Nsp = nulls.Range(v.Nsp, uint64(start), uint64(end), w.Nsp)
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 		w.Col = v.Col.(*types.Bytes).Window(start, end)
 		w.Nsp = nulls.Range(v.Nsp, uint64(start), uint64(end), w.Nsp)
 	case types.T_date:
@@ -709,6 +803,15 @@ func Window(v *Vector, start, end int, w *Vector) *Vector {
 	case types.T_timestamp:
 		w.Col = v.Col.([]types.Timestamp)[start:end]
 		w.Nsp = nulls.Range(v.Nsp, uint64(start), uint64(end), w.Nsp)
+	case types.T_time:
+		w.Col = v.Col.([]types.Time)[start:end]
+		w.Nsp = nulls.Range(v.Nsp, uint64(start), uint64(end), w.Nsp)
+	case types.T_year:
+		w.Col = v.Col.([]types.YearValue)[start:end]
+		w.Nsp = nulls.Range(v.Nsp, uint64(start), uint64(end), w.Nsp)
+	case types.T_uuid:
+		w.Col = v.Col.([]types.Uuid)[start:end]
+		w.Nsp = nulls.Range(v.Nsp, uint64(start), uint64(end), w.Nsp)
 	case types.T_decimal64:
 		w.Col = v.Col.([]types.Decimal64)[start:end]
 		w.Nsp = nulls.Range(v.Nsp, uint64(start), uint64(end), w.Nsp)
@@ -735,11 +838,11 @@ func Append(v *Vector, arg interface{}) error {
 		v.Col = append(v.Col.([]int64), arg.([]int64)...)
 	case types.T_uint8:
 		v.Col = append(v.Col.([]uint8), arg.([]uint8)...)
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		v.Col = append(v.Col.([]uint16), arg.([]uint16)...)
 	case types.T_uint32:
 		v.Col = append(v.Col.([]uint32), arg.([]uint32)...)
-	case types.T_uint64:
+	case types.T_uint64, types.T_set:
 		v.Col = append(v.Col.([]uint64), arg.([]uint64)...)
 	case types.T_float32:
 		v.Col = append(v.Col.([]float32), arg.([]float32)...)
@@ -751,11 +854,17 @@ func Append(v *Vector, arg interface{}) error {
 		v.Col = append(v.Col.([]types.Datetime), arg.([]types.Datetime)...)
 	case types.T_timestamp:
 		v.Col = append(v.Col.([]types.Timestamp), arg.([]types.Timestamp)...)
+	case types.T_time:
+		v.Col = append(v.Col.([]types.Time), arg.([]types.Time)...)
+	case types.T_year:
+		v.Col = append(v.Col.([]types.YearValue), arg.([]types.YearValue)...)
+	case types.T_uuid:
+		v.Col = append(v.Col.([]types.Uuid), arg.([]types.Uuid)...)
 	case types.T_sel:
 		v.Col = append(v.Col.([]int64), arg.([]int64)...)
 	case types.T_tuple:
 		v.Col = append(v.Col.([][]interface{}), arg.([][]interface{})...)
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 		return v.Col.(*types.Bytes).Append(arg.([][]byte))
 	case types.T_decimal64:
 		v.Col = append(v.Col.([]types.Decimal64), arg.([]types.Decimal64)...)
@@ -804,7 +913,7 @@ func Shrink(v *Vector, sels []int64) {
 		}
 		v.Col = vs[:len(sels)]
 		v.Nsp = nulls.Filter(v.Nsp, sels)
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		vs := v.Col.([]uint16)
 		for i, sel := range sels {
 			vs[i] = vs[sel]
@@ -818,7 +927,7 @@ func Shrink(v *Vector, sels []int64) {
 		}
 		v.Col = vs[:len(sels)]
 		v.Nsp = nulls.Filter(v.Nsp, sels)
-	case types.T_uint64:
+	case types.T_uint64, types.T_set:
 		vs := v.Col.([]uint64)
 		for i, sel := range sels {
 			vs[i] = vs[sel]
@@ -853,7 +962,7 @@ func Shrink(v *Vector, sels []int64) {
 		}
 		v.Col = vs[:len(sels)]
 		v.Nsp = nulls.Filter(v.Nsp, sels)
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 		vs := v.Col.(*types.Bytes)
 		for i, sel := range sels {
 			vs.Offsets[i] = vs.Offsets[sel]
@@ -883,6 +992,27 @@ func Shrink(v *Vector, sels []int64) {
 		}
 		v.Col = vs[:len(sels)]
 		v.Nsp = nulls.Filter(v.Nsp, sels)
+	case types.T_time:
+		vs := v.Col.([]types.Time)
+		for i, sel := range sels {
+			vs[i] = vs[sel]
+		}
+		v.Col = vs[:len(sels)]
+		v.Nsp = nulls.Filter(v.Nsp, sels)
+	case types.T_year:
+		vs := v.Col.([]types.YearValue)
+		for i, sel := range sels {
+			vs[i] = vs[sel]
+		}
+		v.Col = vs[:len(sels)]
+		v.Nsp = nulls.Filter(v.Nsp, sels)
+	case types.T_uuid:
+		vs := v.Col.([]types.Uuid)
+		for i, sel := range sels {
+			vs[i] = vs[sel]
+		}
+		v.Col = vs[:len(sels)]
+		v.Nsp = nulls.Filter(v.Nsp, sels)
 	case types.T_decimal64:
 		vs := v.Col.([]types.Decimal64)
 		for i, sel := range sels {
@@ -952,7 +1082,7 @@ func Shuffle(v *Vector, sels []int64, m *mheap.Mheap) error {
 		v.Col = shuffle.Uint8Shuffle(vs, ws, sels)
 		v.Nsp = nulls.Filter(v.Nsp, sels)
 		mheap.Free(m, data)
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		vs := v.Col.([]uint16)
 		data, err := mheap.Alloc(m, int64(len(vs)*2))
 		if err != nil {
@@ -972,7 +1102,7 @@ func Shuffle(v *Vector, sels []int64, m *mheap.Mheap) error {
 		v.Col = shuffle.Uint32Shuffle(vs, ws, sels)
 		v.Nsp = nulls.Filter(v.Nsp, sels)
 		mheap.Free(m, data)
-	case types.T_uint64:
+	case types.T_uint64, types.T_set:
 		vs := v.Col.([]uint64)
 		data, err := mheap.Alloc(m, int64(len(vs)*8))
 		if err != nil {
@@ -1017,7 +1147,7 @@ func Shuffle(v *Vector, sels []int64, m *mheap.Mheap) error {
 		ws := make([][]interface{}, len(vs))
 		v.Col = shuffle.TupleShuffle(vs, ws, sels)
 		v.Nsp = nulls.Filter(v.Nsp, sels)
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 		vs := v.Col.(*types.Bytes)
 		odata, err := mheap.Alloc(m, int64(len(vs.Offsets)*4))
 		if err != nil {
@@ -1064,6 +1194,36 @@ func Shuffle(v *Vector, sels []int64, m *mheap.Mheap) error {
 		v.Col = shuffle.TimestampShuffle(vs, ws, sels)
 		v.Nsp = nulls.Filter(v.Nsp, sels)
 		mheap.Free(m, data)
+	case types.T_time:
+		vs := v.Col.([]types.Time)
+		data, err := mheap.Alloc(m, int64(len(vs)*8))
+		if err != nil {
+			return err
+		}
+		ws := encoding.DecodeTimeSlice(data)
+		v.Col = shuffle.TimeShuffle(vs, ws, sels)
+		v.Nsp = nulls.Filter(v.Nsp, sels)
+		mheap.Free(m, data)
+	case types.T_year:
+		vs := v.Col.([]types.YearValue)
+		data, err := mheap.Alloc(m, int64(len(vs)*2))
+		if err != nil {
+			return err
+		}
+		ws := encoding.DecodeYearValueSlice(data)
+		v.Col = shuffle.YearValueShuffle(vs, ws, sels)
+		v.Nsp = nulls.Filter(v.Nsp, sels)
+		mheap.Free(m, data)
+	case types.T_uuid:
+		vs := v.Col.([]types.Uuid)
+		data, err := mheap.Alloc(m, int64(len(vs)*16))
+		if err != nil {
+			return err
+		}
+		ws := encoding.DecodeUuidSlice(data)
+		v.Col = shuffle.UuidShuffle(vs, ws, sels)
+		v.Nsp = nulls.Filter(v.Nsp, sels)
+		mheap.Free(m, data)
 	case types.T_decimal64:
 		vs := v.Col.([]types.Decimal64)
 		data, err := mheap.Alloc(m, int64(len(vs)*8))
@@ -1258,7 +1418,7 @@ func UnionOne(v, w *Vector, sel int64, m *mheap.Mheap) error {
 			vs = append(vs, w.Col.([]uint8)[sel])
 			v.Col = vs
 		}
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		if len(v.Data) == 0 {
 			data, err := mheap.Alloc(m, 2*8)
 			if err != nil {
@@ -1312,7 +1472,7 @@ func UnionOne(v, w *Vector, sel int64, m *mheap.Mheap) error {
 			vs = append(vs, w.Col.([]uint32)[sel])
 			v.Col = vs
 		}
-	case types.T_uint64:
+	case types.T_uint64, types.T_set:
 		if len(v.Data) == 0 {
 			data, err := mheap.Alloc(m, 8*8)
 			if err != nil {
@@ -1398,7 +1558,7 @@ func UnionOne(v, w *Vector, sel int64, m *mheap.Mheap) error {
 		vs, ws := v.Col.([][]interface{}), w.Col.([][]interface{})
 		vs = append(vs, ws[sel])
 		v.Col = vs
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 		vs, ws := v.Col.(*types.Bytes), w.Col.(*types.Bytes)
 		from := ws.Get(sel)
 		if len(v.Data) == 0 {
@@ -1511,6 +1671,87 @@ func UnionOne(v, w *Vector, sel int64, m *mheap.Mheap) error {
 			vs = append(vs, w.Col.([]types.Timestamp)[sel])
 			v.Col = vs
 		}
+	case types.T_time:
+		if len(v.Data) == 0 {
+			data, err := mheap.Alloc(m, 8*8)
+			if err != nil {
+				return err
+			}
+			v.Ref = w.Ref
+			vs := encoding.DecodeTimeSlice(data)
+			vs[0] = w.Col.([]types.Time)[sel]
+			v.Col = vs[:1]
+			v.Data = data
+		} else {
+			vs := v.Col.([]types.Time)
+			if n := len(vs); n+1 >= cap(vs) {
+				data, err := mheap.Grow(m, v.Data[:n*8], int64(n+1)*8)
+				if err != nil {
+					return err
+				}
+				mheap.Free(m, v.Data)
+				vs = encoding.DecodeTimeSlice(data)
+				vs = vs[:n]
+				v.Col = vs
+				v.Data = data
+			}
+			vs = append(vs, w.Col.([]types.Time)[sel])
+			v.Col = vs
+		}
+	case types.T_year:
+		if len(v.Data) == 0 {
+			data, err := mheap.Alloc(m, 8*2)
+			if err != nil {
+				return err
+			}
+			v.Ref = w.Ref
+			vs := encoding.DecodeYearValueSlice(data)
+			vs[0] = w.Col.([]types.YearValue)[sel]
+			v.Col = vs[:1]
+			v.Data = data
+		} else {
+			vs := v.Col.([]types.YearValue)
+			if n := len(vs); n+1 >= cap(vs) {
+				data, err := mheap.Grow(m, v.Data[:n*2], int64(n+1)*2)
+				if err != nil {
+					return err
+				}
+				mheap.Free(m, v.Data)
+				vs = encoding.DecodeYearValueSlice(data)
+				vs = vs[:n]
+				v.Col = vs
+				v.Data = data
+			}
+			vs = append(vs, w.Col.([]types.YearValue)[sel])
+			v.Col = vs
+		}
+	case types.T_uuid:
+		if len(v.Data) == 0 {
+			data, err := mheap.Alloc(m, 8*16)
+			if err != nil {
+				return err
+			}
+			v.Ref = w.Ref
+			vs := encoding.DecodeUuidSlice(data)
+			vs[0] = w.Col.([]types.Uuid)[sel]
+			v.Col = vs[:1]
+			v.Data = data
+		} else {
+			vs := v.Col.([]types.Uuid)
+			if n := len(vs); n+1 >= cap(vs) {
+				data, err := mheap.Grow(m, v.Data[:n*16], int64(n+1)*16)
+				if err != nil {
+					return err
+				}
+				mheap.Free(m, v.Data)
+				vs = encoding.DecodeUuidSlice(data)
+				vs = vs[:n]
+				v.Col = vs
+				v.Data = data
+			}
+			vs = append(vs, w.Col.([]types.Uuid)[sel])
+			v.Col = vs
+		}
 	case types.T_decimal64:
 		if len(v.Data) == 0 {
 			data, err := mheap.Alloc(m, 8*8)
@@ -1707,7 +1948,7 @@ func UnionNull(v, w *Vector, m *mheap.Mheap) error {
 			vs = append(vs, vs[0])
 			v.Col = vs
 		}
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		if len(v.Data) == 0 {
 			data, err := mheap.Alloc(m, 2*8)
 			if err != nil {
@@ -1759,7 +2000,7 @@ func UnionNull(v, w *Vector, m *mheap.Mheap) error {
 			vs = append(vs, vs[0])
 			v.Col = vs
 		}
-	case types.T_uint64:
+	case types.T_uint64, types.T_set:
 		if len(v.Data) == 0 {
 			data, err := mheap.Alloc(m, 8*8)
 			if err != nil {
@@ -1837,7 +2078,7 @@ func UnionNull(v, w *Vector, m *mheap.Mheap) error {
 			vs = append(vs, vs[0])
 			v.Col = vs
 		}
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 		vs := v.Col.(*types.Bytes)
 		vs.Offsets = append(vs.Offsets, 0)
 		vs.Lengths = append(vs.Lengths, 0)
@@ -1920,6 +2161,84 @@ func UnionNull(v, w *Vector, m *mheap.Mheap) error {
 			vs = append(vs, vs[0])
 			v.Col = vs
 		}
+	case types.T_time:
+		if len(v.Data) == 0 {
+			data, err := mheap.Alloc(m, 8*8)
+			if err != nil {
+				return err
+			}
+			v.Ref = w.Ref
+			vs := encoding.DecodeTimeSlice(data)
+			v.Col = vs[:1]
+			v.Data = data
+		} else {
+			vs := v.Col.([]types.Time)
+			if n := len(vs); n+1 >= cap(vs) {
+				data, err := mheap.Grow(m, v.Data[:n*8], int64(n+1)*8)
+				if err != nil {
+					return err
+				}
+				mheap.Free(m, v.Data)
+				vs = encoding.DecodeTimeSlice(data)
+				vs = vs[:n]
+				v.Col = vs
+				v.Data = data
+			}
+			vs = append(vs, vs[0])
+			v.Col = vs
+		}
+	case types.T_year:
+		if len(v.Data) == 0 {
+			data, err := mheap.Alloc(m, 8*2)
+			if err != nil {
+				return err
+			}
+			v.Ref = w.Ref
+			vs := encoding.DecodeYearValueSlice(data)
+			v.Col = vs[:1]
+			v.Data = data
+		} else {
+			vs := v.Col.([]types.YearValue)
+			if n := len(vs); n+1 >= cap(vs) {
+				data, err := mheap.Grow(m, v.Data[:n*2], int64(n+1)*2)
+				if err != nil {
+					return err
+				}
+				mheap.Free(m, v.Data)
+				vs = encoding.DecodeYearValueSlice(data)
+				vs = vs[:n]
+				v.Col = vs
+				v.Data = data
+			}
+			vs = append(vs, vs[0])
+			v.Col = vs
+		}
+	case types.T_uuid:
+		if len(v.Data) == 0 {
+			data, err := mheap.Alloc(m, 8*16)
+			if err != nil {
+				return err
+			}
+			v.Ref = w.Ref
+			vs := encoding.DecodeUuidSlice(data)
+			v.Col = vs[:1]
+			v.Data = data
+		} else {
+			vs := v.Col.([]types.Uuid)
+			if n := len(vs); n+1 >= cap(vs) {
+				data, err := mheap.Grow(m, v.Data[:n*16], int64(n+1)*16)
+				if err != nil {
+					return err
+				}
+				mheap.Free(m, v.Data)
+				vs = encoding.DecodeUuidSlice(data)
+				vs = vs[:n]
+				v.Col = vs
+				v.Data = data
+			}
+			vs = append(vs, vs[0])
+			v.Col = vs
+		}
 	case types.T_decimal64:
 		if len(v.Data) == 0 {
 			data, err := mheap.Alloc(m, 8*8)
@@ -2088,7 +2407,7 @@ func Union(v, w *Vector, sels []int64, m *mheap.Mheap) error {
 			j++
 		}
 		v.Col = vs
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		cnt := len(sels)
 		ws := w.Col.([]uint16)
 		vs := v.Col.([]uint16)
@@ -2130,7 +2449,7 @@ func Union(v, w *Vector, sels []int64, m *mheap.Mheap) error {
 			j++
 		}
 		v.Col = vs
-	case types.T_uint64:
+	case types.T_uint64, types.T_set:
 		cnt := len(sels)
 		ws := w.Col.([]uint64)
 		vs := v.Col.([]uint64)
@@ -2193,7 +2512,7 @@ func Union(v, w *Vector, sels []int64, m *mheap.Mheap) error {
 			j++
 		}
 		v.Col = vs
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 		vs, ws := v.Col.(*types.Bytes), w.Col.(*types.Bytes)
 		incSize := 0
 		for _, sel := range sels {
@@ -2278,30 +2597,93 @@ func Union(v, w *Vector, sels []int64, m *mheap.Mheap) error {
 			j++
 		}
 		v.Col = vs
-	}
-	if nulls.Any(w.Nsp) {
-		j := uint64(oldLen)
-		for _, sel := range sels {
-			if nulls.Contains(w.Nsp, uint64(sel)) {
-				nulls.Add(v.Nsp, j)
-				j++
+	case types.T_time:
+		cnt := len(sels)
+		ws := w.Col.([]types.Time)
+		vs := v.Col.([]types.Time)
+		n := len(vs)
+		if n+cnt >= cap(vs) {
+			data, err := mheap.Grow(m, v.Data[:n], int64(n+cnt)*8)
+			if err != nil {
+				return err
 			}
+			mheap.Free(m, v.Data)
+			vs = encoding.DecodeTimeSlice(data)
+			v.Data = data
 		}
-	}
-	return nil
-}
-
-func UnionBatch(v, w *Vector, offset int64, cnt int, flags []uint8, m *mheap.Mheap) error {
-	if v.Or {
-		return errors.New("UnionOne operation cannot be performed for origin vector")
-	}
-
-	oldLen := Length(v)
-
-	switch v.Typ.Oid {
-	case types.T_int8:
-		col := w.Col.([]int8)
-		if len(v.Data) == 0 {
+		vs = vs[:n+cnt]
+		j := n
+		for i, sel := range sels {
+			vs[i] = ws[sel]
+			j++
+		}
+		v.Col = vs
+	case types.T_year:
+		cnt := len(sels)
+		ws := w.Col.([]types.YearValue)
+		vs := v.Col.([]types.YearValue)
+		n := len(vs)
+		if n+cnt >= cap(vs) {
+			data, err := mheap.Grow(m, v.Data[:n], int64(n+cnt)*2)
+			if err != nil {
+				return err
+			}
+			mheap.Free(m, v.Data)
+			vs = encoding.DecodeYearValueSlice(data)
+			v.Data = data
+		}
+		vs = vs[:n+cnt]
+		j := n
+		for i, sel := range sels {
+			vs[i] = ws[sel]
+			j++
+		}
+		v.Col = vs
+	case types.T_uuid:
+		cnt := len(sels)
+		ws := w.Col.([]types.Uuid)
+		vs := v.Col.([]types.Uuid)
+		n := len(vs)
+		if n+cnt >= cap(vs) {
+			data, err := mheap.Grow(m, v.Data[:n], int64(n+cnt)*16)
+			if err != nil {
+				return err
+			}
+			mheap.Free(m, v.Data)
+			vs = encoding.DecodeUuidSlice(data)
+			v.Data = data
+		}
+		vs = vs[:n+cnt]
+		j := n
+		for i, sel := range sels {
+			vs[i] = ws[sel]
+			j++
+		}
+		v.Col = vs
+	}
+	if nulls.Any(w.Nsp) {
+		j := uint64(oldLen)
+		for _, sel := range sels {
+			if nulls.Contains(w.Nsp, uint64(sel)) {
+				nulls.Add(v.Nsp, j)
+				j++
+			}
+		}
+	}
+	return nil
+}
+
+func UnionBatch(v, w *Vector, offset int64, cnt int, flags []uint8, m *mheap.Mheap) error {
+	if v.Or {
+		return errors.New("UnionOne operation cannot be performed for origin vector")
+	}
+
+	oldLen := Length(v)
+
+	switch v.Typ.Oid {
+	case types.T_int8:
+		col := w.Col.([]int8)
+		if len(v.Data) == 0 {
 			newSize := 8
 			for newSize < cnt {
 				newSize <<= 1
@@ -2514,7 +2896,7 @@ func UnionBatch(v, w *Vector, offset int64, cnt int, flags []uint8, m *mheap.Mhe
 			v.Col = vs
 		}
 
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		col := w.Col.([]uint16)
 		if len(v.Data) == 0 {
 			newSize := 8
@@ -2601,7 +2983,7 @@ func UnionBatch(v, w *Vector, offset int64, cnt int, flags []uint8, m *mheap.Mhe
 			v.Col = vs
 		}
 
-	case types.T_uint64:
+	case types.T_uint64, types.T_set:
 		col := w.Col.([]uint64)
 		if len(v.Data) == 0 {
 			newSize := 8
@@ -2740,7 +3122,7 @@ func UnionBatch(v, w *Vector, offset int64, cnt int, flags []uint8, m *mheap.Mhe
 		}
 		v.Col = vs
 
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 		vs, ws := v.Col.(*types.Bytes), w.Col.(*types.Bytes)
 		incSize := 0
 		for i, flag := range flags {
@@ -2910,6 +3292,135 @@ func UnionBatch(v, w *Vector, offset int64, cnt int, flags []uint8, m *mheap.Mhe
 			v.Col = vs
 		}
 
+	case types.T_time:
+		col := w.Col.([]types.Time)
+		if len(v.Data) == 0 {
+			newSize := 8
+			for newSize < cnt {
+				newSize <<= 1
+			}
+			data, err := mheap.Alloc(m, int64(newSize)*8)
+			if err != nil {
+				return err
+			}
+			v.Ref = w.Ref
+			vs := encoding.DecodeTimeSlice(data)[:cnt]
+			for i, j := 0, 0; i < len(flags); i++ {
+				if flags[i] > 0 {
+					vs[j] = col[int(offset)+i]
+					j++
+				}
+			}
+			v.Col = vs
+			v.Data = data
+		} else {
+			vs := v.Col.([]types.Time)
+			n := len(vs)
+			if n+cnt > cap(vs) {
+				data, err := mheap.Grow(m, v.Data[:n*8], int64(n+cnt)*8)
+				if err != nil {
+					return err
+				}
+				mheap.Free(m, v.Data)
+				vs = encoding.DecodeTimeSlice(data)
+				v.Data = data
+			}
+			vs = vs[:n+cnt]
+			for i, j := 0, n; i < len(flags); i++ {
+				if flags[i] > 0 {
+					vs[j] = col[int(offset)+i]
+					j++
+				}
+			}
+			v.Col = vs
+		}
+
+	case types.T_year:
+		col := w.Col.([]types.YearValue)
+		if len(v.Data) == 0 {
+			newSize := 8
+			for newSize < cnt {
+				newSize <<= 1
+			}
+			data, err := mheap.Alloc(m, int64(newSize)*2)
+			if err != nil {
+				return err
+			}
+			v.Ref = w.Ref
+			vs := encoding.DecodeYearValueSlice(data)[:cnt]
+			for i, j := 0, 0; i < len(flags); i++ {
+				if flags[i] > 0 {
+					vs[j] = col[int(offset)+i]
+					j++
+				}
+			}
+			v.Col = vs
+			v.Data = data
+		} else {
+			vs := v.Col.([]types.YearValue)
+			n := len(vs)
+			if n+cnt > cap(vs) {
+				data, err := mheap.Grow(m, v.Data[:n*2], int64(n+cnt)*2)
+				if err != nil {
+					return err
+				}
+				mheap.Free(m, v.Data)
+				vs = encoding.DecodeYearValueSlice(data)
+				v.Data = data
+			}
+			vs = vs[:n+cnt]
+			for i, j := 0, n; i < len(flags); i++ {
+				if flags[i] > 0 {
+					vs[j] = col[int(offset)+i]
+					j++
+				}
+			}
+			v.Col = vs
+		}
+
+	case types.T_uuid:
+		col := w.Col.([]types.Uuid)
+		if len(v.Data) == 0 {
+			newSize := 8
+			for newSize < cnt {
+				newSize <<= 1
+			}
+			data, err := mheap.Alloc(m, int64(newSize)*16)
+			if err != nil {
+				return err
+			}
+			v.Ref = w.Ref
+			vs := encoding.DecodeUuidSlice(data)[:cnt]
+			for i, j := 0, 0; i < len(flags); i++ {
+				if flags[i] > 0 {
+					vs[j] = col[int(offset)+i]
+					j++
+				}
+			}
+			v.Col = vs
+			v.Data = data
+		} else {
+			vs := v.Col.([]types.Uuid)
+			n := len(vs)
+			if n+cnt > cap(vs) {
+				data, err := mheap.Grow(m, v.Data[:n*16], int64(n+cnt)*16)
+				if err != nil {
+					return err
+				}
+				mheap.Free(m, v.Data)
+				vs = encoding.DecodeUuidSlice(data)
+				v.Data = data
+			}
+			vs = vs[:n+cnt]
+			for i, j := 0, n; i < len(flags); i++ {
+				if flags[i] > 0 {
+					vs[j] = col[int(offset)+i]
+					j++
+				}
+			}
+			v.Col = vs
+		}
+
 	case types.T_decimal64:
 		col := w.Col.([]types.Decimal64)
 		if len(v.Data) == 0 {
@@ -3072,7 +3583,7 @@ func (v *Vector) Show() ([]byte, error) {
 		}
 		buf.Write(encoding.EncodeUint8Slice(v.Col.([]uint8)))
 		return buf.Bytes(), nil
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		buf.Write(encoding.EncodeType(v.Typ))
 		nb, err := v.Nsp.Show()
 		if err != nil {
@@ -3096,7 +3607,7 @@ func (v *Vector) Show() ([]byte, error) {
 		}
 		buf.Write(encoding.EncodeUint32Slice(v.Col.([]uint32)))
 		return buf.Bytes(), nil
-	case types.T_uint64:
+	case types.T_uint64, types.T_set:
 		buf.Write(encoding.EncodeType(v.Typ))
 		nb, err := v.Nsp.Show()
 		if err != nil {
@@ -3168,6 +3679,42 @@ func (v *Vector) Show() ([]byte, error) {
 		}
 		buf.Write(encoding.EncodeTimestampSlice(v.Col.([]types.Timestamp)))
 		return buf.Bytes(), nil
+	case types.T_time:
+		buf.Write(encoding.EncodeType(v.Typ))
+		nb, err := v.Nsp.Show()
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(encoding.EncodeUint32(uint32(len(nb))))
+		if len(nb) > 0 {
+			buf.Write(nb)
+		}
+		buf.Write(encoding.EncodeTimeSlice(v.Col.([]types.Time)))
+		return buf.Bytes(), nil
+	case types.T_year:
+		buf.Write(encoding.EncodeType(v.Typ))
+		nb, err := v.Nsp.Show()
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(encoding.EncodeUint32(uint32(len(nb))))
+		if len(nb) > 0 {
+			buf.Write(nb)
+		}
+		buf.Write(encoding.EncodeYearValueSlice(v.Col.([]types.YearValue)))
+		return buf.Bytes(), nil
+	case types.T_uuid:
+		buf.Write(encoding.EncodeType(v.Typ))
+		nb, err := v.Nsp.Show()
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(encoding.EncodeUint32(uint32(len(nb))))
+		if len(nb) > 0 {
+			buf.Write(nb)
+		}
+		buf.Write(encoding.EncodeUuidSlice(v.Col.([]types.Uuid)))
+		return buf.Bytes(), nil
 	case types.T_sel:
 		buf.Write(encoding.EncodeType(v.Typ))
 		nb, err := v.Nsp.Show()
@@ -3180,7 +3727,7 @@ func (v *Vector) Show() ([]byte, error) {
 		}
 		buf.Write(encoding.EncodeInt64Slice(v.Col.([]int64)))
 		return buf.Bytes(), nil
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 		buf.Write(encoding.EncodeType(v.Typ))
 		nb, err := v.Nsp.Show()
 		if err != nil {
@@ -3317,7 +3864,7 @@ func (v *Vector) Read(data []byte) error {
 			}
 			v.Col = encoding.DecodeUint8Slice(data[size:])
 		}
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		size := encoding.DecodeUint32(data)
 		if size == 0 {
 			v.Col = encoding.DecodeUint16Slice(data[4:])
@@ -3339,7 +3886,7 @@ func (v *Vector) Read(data []byte) error {
 			}
 			v.Col = encoding.DecodeUint32Slice(data[size:])
 		}
-	case types.T_uint64:
+	case types.T_uint64, types.T_set:
 		size := encoding.DecodeUint32(data)
 		if size == 0 {
 			v.Col = encoding.DecodeUint64Slice(data[4:])
@@ -3405,7 +3952,40 @@ func (v *Vector) Read(data []byte) error {
 			}
 			v.Col = encoding.DecodeTimestampSlice(data[size:])
 		}
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_time:
+		size := encoding.DecodeUint32(data)
+		if size == 0 {
+			v.Col = encoding.DecodeTimeSlice(data[4:])
+		} else {
+			data = data[4:]
+			if err := v.Nsp.Read(data[:size]); err != nil {
+				return err
+			}
+			v.Col = encoding.DecodeTimeSlice(data[size:])
+		}
+	case types.T_year:
+		size := encoding.DecodeUint32(data)
+		if size == 0 {
+			v.Col = encoding.DecodeYearValueSlice(data[4:])
+		} else {
+			data = data[4:]
+			if err := v.Nsp.Read(data[:size]); err != nil {
+				return err
+			}
+			v.Col = encoding.DecodeYearValueSlice(data[size:])
+		}
+	case types.T_uuid:
+		size := encoding.DecodeUint32(data)
+		if size == 0 {
+			v.Col = encoding.DecodeUuidSlice(data[4:])
+		} else {
+			data = data[4:]
+			if err := v.Nsp.Read(data[:size]); err != nil {
+				return err
+			}
+			v.Col = encoding.DecodeUuidSlice(data[size:])
+		}
+	case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 		Col := v.Col.(*types.Bytes)
 		Col.Reset()
 		size := encoding.DecodeUint32(data)
@@ -3522,7 +4102,7 @@ func (v *Vector) String() string {
 				return fmt.Sprintf("%v", col[0])
 			}
 		}
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		col := v.Col.([]uint16)
 		if len(col) == 1 {
 			if nulls.Contains(v.Nsp, 0) {
@@ -3540,7 +4120,7 @@ func (v *Vector) String() string {
 				return fmt.Sprintf("%v", col[0])
 			}
 		}
-	case types.T_uint64:
+	case types.T_uint64, types.T_set:
 		col := v.Col.([]uint64)
 		if len(col) == 1 {
 			if nulls.Contains(v.Nsp, 0) {
@@ -3594,6 +4174,33 @@ func (v *Vector) String() string {
 				return fmt.Sprintf("%v", col[0])
 			}
 		}
+	case types.T_time:
+		col := v.Col.([]types.Time)
+		if len(col) == 1 {
+			if nulls.Contains(v.Nsp, 0) {
+				return "null"
+			} else {
+				return fmt.Sprintf("%v", col[0])
+			}
+		}
+	case types.T_year:
+		col := v.Col.([]types.YearValue)
+		if len(col) == 1 {
+			if nulls.Contains(v.Nsp, 0) {
+				return "null"
+			} else {
+				return fmt.Sprintf("%v", col[0])
+			}
+		}
+	case types.T_uuid:
+		col := v.Col.([]types.Uuid)
+		if len(col) == 1 {
+			if nulls.Contains(v.Nsp, 0) {
+				return "null"
+			} else {
+				return fmt.Sprintf("%v", col[0])
+			}
+		}
 	case types.T_sel:
 		col := v.Col.([]int64)
 		if len(col) == 1 {
@@ -3612,7 +4219,7 @@ func (v *Vector) String() string {
 				return fmt.Sprintf("%v", col[0])
 			}
 		}
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 		col := v.Col.(*types.Bytes)
 		if len(col.Offsets) == 1 {
 			if nulls.Contains(v.Nsp, 0) {
@@ -3787,7 +4394,7 @@ func (v *Vector) GetColumnData(selectIndexs []int64, occurCounts []int64, rs []s
 				rs[i] = rs[i-1]
 			}
 		}
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		vs := v.Col.([]uint16)
 		for i := 0; i < rows; i++ {
 			index := i
@@ -3841,7 +4448,7 @@ func (v *Vector) GetColumnData(selectIndexs []int64, occurCounts []int64, rs []s
 				rs[i] = rs[i-1]
 			}
 		}
-	case types.T_uint64:
+	case types.T_uint64, types.T_set:
 		vs := v.Col.([]uint64)
 		for i := 0; i < rows; i++ {
 			index := i
@@ -3922,7 +4529,7 @@ func (v *Vector) GetColumnData(selectIndexs []int64, occurCounts []int64, rs []s
 				rs[i] = rs[i-1]
 			}
 		}
-	case types.T_char, types.T_varchar:
+	case types.T_char, types.T_varchar, types.T_text, types.T_blob:
 		vs := v.Col.(*types.Bytes)
 		var i int64
 		for i = 0; i < int64(rows); i++ {
@@ -3950,6 +4557,34 @@ func (v *Vector) GetColumnData(selectIndexs []int64, occurCounts []int64, rs []s
 				rs[i] = rs[i-1]
 			}
 		}
+	case types.T_json:
+		vs := v.Col.(*types.Bytes)
+		var i int64
+		for i = 0; i < int64(rows); i++ {
+			index := i
+			count := occurCounts[i]
+			if count <= 0 {
+				i--
+				continue
+			}
+			if ifSel {
+				index = selectIndexs[i]
+			}
+			if !allData && nulls.Contains(v.Nsp, uint64(index)) {
+				rs[i] = nullStr
+			} else {
+				bj, err := bytejson.Unmarshal(vs.Get(index))
+				if err != nil {
+					return err
+				}
+				rs[i] = bj.String()
+			}
+			for count > 1 {
+				count--
+				i++
+				rs[i] = rs[i-1]
+			}
+		}
 	case types.T_date:
 		vs := v.Col.([]types.Date)
 		for i := 0; i < rows; i++ {
@@ -4031,6 +4666,87 @@ func (v *Vector) GetColumnData(selectIndexs []int64, occurCounts []int64, rs []s
 				rs[i] = rs[i-1]
 			}
 		}
+	case types.T_time:
+		vs := v.Col.([]types.Time)
+		for i := 0; i < rows; i++ {
+			index := i
+			count := occurCounts[i]
+			if count <= 0 {
+				i--
+				continue
+			}
+			if ifSel {
+				index = int(selectIndexs[i])
+			}
+			if allData {
+				rs[i] = vs[index].String2(typ.Precision)
+			} else {
+				if nulls.Contains(v.Nsp, uint64(index)) {
+					rs[i] = nullStr
+				} else {
+					rs[i] = vs[index].String2(typ.Precision)
+				}
+			}
+			for count > 1 {
+				count--
+				i++
+				rs[i] = rs[i-1]
+			}
+		}
+	case types.T_year:
+		vs := v.Col.([]types.YearValue)
+		for i := 0; i < rows; i++ {
+			index := i
+			count := occurCounts[i]
+			if count <= 0 {
+				i--
+				continue
+			}
+			if ifSel {
+				index = int(selectIndexs[i])
+			}
+			if allData {
+				rs[i] = vs[index].String()
+			} else {
+				if nulls.Contains(v.Nsp, uint64(index)) {
+					rs[i] = nullStr
+				} else {
+					rs[i] = vs[index].String()
+				}
+			}
+			for count > 1 {
+				count--
+				i++
+				rs[i] = rs[i-1]
+			}
+		}
+	case types.T_uuid:
+		vs := v.Col.([]types.Uuid)
+		for i := 0; i < rows; i++ {
+			index := i
+			count := occurCounts[i]
+			if count <= 0 {
+				i--
+				continue
+			}
+			if ifSel {
+				index = int(selectIndexs[i])
+			}
+			if allData {
+				rs[i] = vs[index].String()
+			} else {
+				if nulls.Contains(v.Nsp, uint64(index)) {
+					rs[i] = nullStr
+				} else {
+					rs[i] = vs[index].String()
+				}
+			}
+			for count > 1 {
+				count--
+				i++
+				rs[i] = rs[i-1]
+			}
+		}
 	case types.T_decimal64:
 		vs := v.Col.([]types.Decimal64)
 		for i := 0; i < rows; i++ {
@@ -39,6 +39,7 @@ const (
 	MYSQL_TYPE_TIME2       uint8 = 0x13 /**< Internal to MySQL. Not used in protocol */
 	MYSQL_TYPE_TYPED_ARRAY uint8 = 0x14 /**< Used for replication only */
 
+	MYSQL_TYPE_UUID        uint8 = 0xf2 /**< Internal to MatrixOne, sent as a string */
 	MYSQL_TYPE_INVALID     uint8 = 243
 	MYSQL_TYPE_BOOL        uint8 = 244 /**< Currently just a placeholder */
 	MYSQL_TYPE_JSON        uint8 = 0xf5
@@ -54,6 +55,15 @@ const (
 	MYSQL_TYPE_GEOMETRY    uint8 = 0xff
 )
 
+//the max byte length of the blob and text types.
+//mysql allows 4GB for the LONGBLOB, but the width of the column is int32.
+var BlobMaxLength = map[uint8]int32{
+	MYSQL_TYPE_TINY_BLOB:   1<<8 - 1,
+	MYSQL_TYPE_BLOB:        1<<16 - 1,
+	MYSQL_TYPE_MEDIUM_BLOB: 1<<24 - 1,
+	MYSQL_TYPE_LONG_BLOB:   1<<31 - 1,
+}
+
 //flags
 //in mysql 8.0.23 source code : include/mysql_com.h
 const (
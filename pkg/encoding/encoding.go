@@ -27,18 +27,24 @@ import (
 
 var TypeSize int
 var DateSize int
+var TimeSize int
 var DatetimeSize int
 var TimestampSize int
+var YearValueSize int
 var Decimal64Size int
 var Decimal128Size int
+var UuidSize int
 
 func init() {
 	TypeSize = int(unsafe.Sizeof(types.Type{}))
 	DateSize = int(unsafe.Sizeof(types.Date(0)))
+	TimeSize = int(unsafe.Sizeof(types.Time(0)))
 	DatetimeSize = int(unsafe.Sizeof(types.Datetime(0)))
 	TimestampSize = int(unsafe.Sizeof(types.Timestamp(0)))
+	YearValueSize = int(unsafe.Sizeof(types.YearValue(0)))
 	Decimal64Size = int(unsafe.Sizeof(types.Decimal64(0)))
 	Decimal128Size = int(unsafe.Sizeof(types.Decimal128{}))
+	UuidSize = int(unsafe.Sizeof(types.Uuid{}))
 }
 
 func Encode(v interface{}) ([]byte, error) {
@@ -166,6 +172,14 @@ func DecodeDate(v []byte) types.Date {
 	return *(*types.Date)(unsafe.Pointer(&v[0]))
 }
 
+func EncodeTime(v types.Time) []byte {
+	return unsafe.Slice((*byte)(unsafe.Pointer(&v)), 8)
+}
+
+func DecodeTime(v []byte) types.Time {
+	return *(*types.Time)(unsafe.Pointer(&v[0]))
+}
+
 func EncodeDatetime(v types.Datetime) []byte {
 	return unsafe.Slice((*byte)(unsafe.Pointer(&v)), 8)
 }
@@ -182,6 +196,14 @@ func DecodeTimestamp(v []byte) types.Timestamp {
 	return *(*types.Timestamp)(unsafe.Pointer(&v[0]))
 }
 
+func EncodeYearValue(v types.YearValue) []byte {
+	return unsafe.Slice((*byte)(unsafe.Pointer(&v)), 2)
+}
+
+func DecodeYearValue(v []byte) types.YearValue {
+	return *(*types.YearValue)(unsafe.Pointer(&v[0]))
+}
+
 func EncodeDecimal64(v types.Decimal64) []byte {
 	return unsafe.Slice((*byte)(unsafe.Pointer(&v)), Decimal64Size)
 }
@@ -198,6 +220,14 @@ func DecodeDecimal128(v []byte) types.Decimal128 {
 	return *(*types.Decimal128)(unsafe.Pointer(&v[0]))
 }
 
+func EncodeUuid(v types.Uuid) []byte {
+	return unsafe.Slice((*byte)(unsafe.Pointer(&v)), UuidSize)
+}
+
+func DecodeUuid(v []byte) types.Uuid {
+	return *(*types.Uuid)(unsafe.Pointer(&v[0]))
+}
+
 func EncodeFixedSlice[T any](v []T, sz int) (ret []byte) {
 	if len(v) > 0 {
 		ret = unsafe.Slice((*byte)(unsafe.Pointer(&v[0])), cap(v)*sz)[:len(v)*sz]
@@ -322,6 +352,14 @@ func DecodeDateSlice(v []byte) []types.Date {
 	return DecodeFixedSlice[types.Date](v, DateSize)
 }
 
+func EncodeTimeSlice(v []types.Time) []byte {
+	return EncodeFixedSlice(v, TimeSize)
+}
+
+func DecodeTimeSlice(v []byte) []types.Time {
+	return DecodeFixedSlice[types.Time](v, TimeSize)
+}
+
 func EncodeDatetimeSlice(v []types.Datetime) []byte {
 	return EncodeFixedSlice(v, DatetimeSize)
 }
@@ -338,6 +376,14 @@ func DecodeTimestampSlice(v []byte) (ret []types.Timestamp) {
 	return DecodeFixedSlice[types.Timestamp](v, TimestampSize)
 }
 
+func EncodeYearValueSlice(v []types.YearValue) []byte {
+	return EncodeFixedSlice(v, YearValueSize)
+}
+
+func DecodeYearValueSlice(v []byte) []types.YearValue {
+	return DecodeFixedSlice[types.YearValue](v, YearValueSize)
+}
+
 func EncodeDecimal64Slice(v []types.Decimal64) []byte {
 	return EncodeFixedSlice(v, Decimal64Size)
 }
@@ -354,6 +400,14 @@ func DecodeDecimal128Slice(v []byte) (ret []types.Decimal128) {
 	return DecodeFixedSlice[types.Decimal128](v, Decimal128Size)
 }
 
+func EncodeUuidSlice(v []types.Uuid) []byte {
+	return EncodeFixedSlice(v, UuidSize)
+}
+
+func DecodeUuidSlice(v []byte) (ret []types.Uuid) {
+	return DecodeFixedSlice[types.Uuid](v, UuidSize)
+}
+
 func EncodeStringSlice(vs []string) []byte {
 	var o int32
 	var buf bytes.Buffer
@@ -0,0 +1,116 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/external"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func Test_getExportFilePath(t *testing.T) {
+	convey.Convey("getExportFilePath succ", t, func() {
+		convey.So(getExportFilePath("a.csv", 0), convey.ShouldEqual, "a.csv")
+		convey.So(getExportFilePath("a.csv", 2), convey.ShouldEqual, "a.csv.2")
+		convey.So(getExportFilePath("a.csv.gz", 0), convey.ShouldEqual, "a.csv.gz")
+		convey.So(getExportFilePath("a.csv.gz", 2), convey.ShouldEqual, "a.csv.2.gz")
+	})
+}
+
+func Test_exportCompressedFile(t *testing.T) {
+	convey.Convey("export compressed csv files", t, func() {
+		mrs := &MysqlResultSet{}
+		for _, name := range []string{"a", "b"} {
+			col := new(MysqlColumn)
+			col.SetName(name)
+			col.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+			mrs.AddColumn(col)
+		}
+
+		ep := &tree.ExportParam{
+			Outfile:        true,
+			FilePath:       filepath.Join(t.TempDir(), "export.csv.gz"),
+			Lines:          &tree.Lines{TerminatedBy: "\n"},
+			Fields:         &tree.Fields{Terminated: ",", EnclosedBy: '"'},
+			Compression:    tree.AUTO,
+			MaxFileSize:    6,
+			DefaultBufSize: 1,
+		}
+		initExportFileParam(ep, mrs)
+		convey.So(openNewFile(ep, mrs), convey.ShouldBeNil)
+		convey.So(ep.CompressWriter, convey.ShouldNotBeNil)
+		for _, row := range [][]interface{}{{[]byte("1"), []byte("abc")}, {[]byte("2"), []byte("xyz")}} {
+			oq := &outputQueue{mrs: &MysqlResultSet{Columns: mrs.Columns, Data: [][]interface{}{row}}, ep: ep}
+			convey.So(exportDataToFile(oq), convey.ShouldBeNil)
+		}
+		convey.So(closeExportFile(ep), convey.ShouldBeNil)
+
+		// every part is a whole gzip file which holds whole lines
+		for i, want := range []string{"1,abc\n", "2,xyz\n"} {
+			path := getExportFilePath(ep.FilePath, uint(i))
+			if i > 0 {
+				convey.So(path, convey.ShouldEndWith, ".1.gz")
+			}
+			f, err := os.Open(path)
+			convey.So(err, convey.ShouldBeNil)
+			r, err := external.GetUnCompressReader(external.GetCompressType(tree.AUTO, path), f)
+			convey.So(err, convey.ShouldBeNil)
+			got, err := io.ReadAll(r)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(string(got), convey.ShouldEqual, want)
+			convey.So(r.Close(), convey.ShouldBeNil)
+			convey.So(f.Close(), convey.ShouldBeNil)
+		}
+	})
+}
+
+func Test_exportCompressedFileSize(t *testing.T) {
+	convey.Convey("the compressed file is split by the compressed size", t, func() {
+		mrs := &MysqlResultSet{}
+		col := new(MysqlColumn)
+		col.SetName("a")
+		col.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+		mrs.AddColumn(col)
+
+		ep := &tree.ExportParam{
+			Outfile:        true,
+			FilePath:       filepath.Join(t.TempDir(), "export.csv.gz"),
+			Lines:          &tree.Lines{TerminatedBy: "\n"},
+			Fields:         &tree.Fields{Terminated: ","},
+			Compression:    tree.AUTO,
+			MaxFileSize:    64,
+			DefaultBufSize: 1,
+		}
+		initExportFileParam(ep, mrs)
+		convey.So(openNewFile(ep, mrs), convey.ShouldBeNil)
+		// the lines are far over the maxFileSize before the compression
+		row := []interface{}{[]byte(strings.Repeat("a", 32))}
+		for i := 0; i < 20; i++ {
+			oq := &outputQueue{mrs: &MysqlResultSet{Columns: mrs.Columns, Data: [][]interface{}{row}}, ep: ep}
+			convey.So(exportDataToFile(oq), convey.ShouldBeNil)
+		}
+		convey.So(closeExportFile(ep), convey.ShouldBeNil)
+		convey.So(ep.FileCnt, convey.ShouldEqual, 0)
+		_, err := os.Stat(getExportFilePath(ep.FilePath, 1))
+		convey.So(os.IsNotExist(err), convey.ShouldBeTrue)
+	})
+}
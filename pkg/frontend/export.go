@@ -6,12 +6,16 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
 	"strconv"
+	"strings"
 	"sync"
 
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/external"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/xitongsys/parquet-go/writer"
 )
 
 var OpenFile = os.OpenFile
@@ -60,12 +64,47 @@ var openNewFile = func(ep *tree.ExportParam, mrs *MysqlResultSet) error {
 	lineSize := ep.LineSize
 	var err error
 	ep.CurFileSize = 0
+	ep.CompressedFileSize = 0
 	filePath := getExportFilePath(ep.FilePath, ep.FileCnt)
 	ep.File, err = OpenFile(filePath, os.O_RDWR|os.O_EXCL|os.O_CREATE, 0o666)
 	if err != nil {
 		return err
 	}
-	ep.Writer = bufio.NewWriterSize(ep.File, int(ep.DefaultBufSize))
+	var w io.Writer = ep.File
+	//the parquet file compresses the pages in itself
+	if ep.FileFormat != tree.PARQUET {
+		if compression := external.GetCompressType(ep.Compression, ep.FilePath); compression != tree.NOCOMPRESS {
+			if ep.CompressWriter, err = external.GetCompressWriter(compression, &compressedFileWriter{ep: ep}); err != nil {
+				return err
+			}
+			w = ep.CompressWriter
+		}
+	}
+	ep.Writer = bufio.NewWriterSize(w, int(ep.DefaultBufSize))
+	switch ep.FileFormat {
+	case tree.PARQUET:
+		if ep.FormatWriter, err = newParquetWriter(ep, mrs); err != nil {
+			return err
+		}
+		return nil
+	case tree.JSONLINE:
+		//the header is meaningless for the json lines
+	default:
+		if err := writeCSVHeader(ep, mrs); err != nil {
+			return err
+		}
+	}
+	if lineSize != 0 {
+		ep.LineSize = 0
+		ep.Rows = 0
+		if err := writeDataToCSVFile(ep, ep.OutputStr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func writeCSVHeader(ep *tree.ExportParam, mrs *MysqlResultSet) error {
 	if ep.Header {
 		var header string
 		n := len(mrs.Columns)
@@ -83,22 +122,39 @@ var openNewFile = func(ep *tree.ExportParam, mrs *MysqlResultSet) error {
 			return err
 		}
 	}
-	if lineSize != 0 {
-		ep.LineSize = 0
-		ep.Rows = 0
-		if err := writeDataToCSVFile(ep, ep.OutputStr); err != nil {
+	return nil
+}
+
+/*
+closeExportFile finishes the exported file and closes it.
+*/
+var closeExportFile = func(ep *tree.ExportParam) error {
+	if pw, ok := ep.FormatWriter.(*writer.CSVWriter); ok {
+		ep.FormatWriter = nil
+		if err := pw.WriteStop(); err != nil {
 			return err
 		}
 	}
-	return nil
+	if err := Flush(ep); err != nil {
+		return err
+	}
+	return Close(ep)
 }
 
+/*
+getExportFilePath returns the path of the fileCnt-th part of the exported file.
+The number of the part is put before the extension of the compressed file,
+so that the compression of the part can still be known by its extension.
+*/
 func getExportFilePath(filename string, fileCnt uint) string {
 	if fileCnt == 0 {
 		return filename
-	} else {
-		return fmt.Sprintf("%s.%d", filename, fileCnt)
 	}
+	ext := filepath.Ext(filename)
+	if external.GetCompressType(tree.AUTO, filename) != tree.NOCOMPRESS {
+		return fmt.Sprintf("%s.%d%s", strings.TrimSuffix(filename, ext), fileCnt, ext)
+	}
+	return fmt.Sprintf("%s.%d", filename, fileCnt)
 }
 
 var formatOutputString = func(oq *outputQueue, tmp, symbol []byte, enclosed byte, flag bool) error {
@@ -140,6 +196,13 @@ var Truncate = func(ep *tree.ExportParam) error {
 }
 
 var Close = func(ep *tree.ExportParam) error {
+	if ep.CompressWriter != nil {
+		cw := ep.CompressWriter
+		ep.CompressWriter = nil
+		if err := cw.Close(); err != nil {
+			return err
+		}
+	}
 	return ep.File.Close()
 }
 
@@ -148,7 +211,8 @@ var Write = func(ep *tree.ExportParam, output []byte) (int, error) {
 }
 
 func writeToCSVFile(oq *outputQueue, output []byte) error {
-	if oq.ep.MaxFileSize != 0 && oq.ep.CurFileSize+uint64(len(output)) > oq.ep.MaxFileSize {
+	//the compressed file is split after the whole line is written
+	if oq.ep.MaxFileSize != 0 && oq.ep.CompressWriter == nil && oq.ep.CurFileSize+uint64(len(output)) > oq.ep.MaxFileSize {
 		if oq.ep.Rows == 0 {
 			return errors.New("the OneLine size is over the maxFileSize")
 		}
@@ -198,6 +262,63 @@ var writeDataToCSVFile = func(ep *tree.ExportParam, output []byte) error {
 	return nil
 }
 
+/*
+exportDataToFile writes the row into the exported file in its format.
+*/
+func exportDataToFile(oq *outputQueue) error {
+	if oq.ep.CompressWriter != nil && oq.ep.MaxFileSize != 0 {
+		//the buffered lines are compressed to count the size of the file
+		if err := Flush(oq.ep); err != nil {
+			return err
+		}
+		if oq.ep.CompressedFileSize >= oq.ep.MaxFileSize {
+			if err := splitCompressedFile(oq); err != nil {
+				return err
+			}
+		}
+	}
+	switch oq.ep.FileFormat {
+	case tree.JSONLINE:
+		return exportDataToJSONLineFile(oq)
+	case tree.PARQUET:
+		return exportDataToParquetFile(oq)
+	}
+	return exportDataToCSVFile(oq)
+}
+
+/*
+compressedFileWriter writes the compressed data into the file, and counts the
+size of the file.
+*/
+type compressedFileWriter struct {
+	ep *tree.ExportParam
+}
+
+func (w *compressedFileWriter) Write(p []byte) (int, error) {
+	n, err := w.ep.File.Write(p)
+	w.ep.CompressedFileSize += uint64(n)
+	return n, err
+}
+
+/*
+splitCompressedFile closes the compressed part and opens the next one.
+The compressed file is split between the lines, so that every part is
+compressed independently. The size of the part is counted after the
+compression, the part may exceed the maxFileSize by the data the compressor
+holds and one line.
+*/
+func splitCompressedFile(oq *outputQueue) error {
+	oq.ep.FileCnt++
+	oq.ep.LineSize = 0
+	if err := Flush(oq.ep); err != nil {
+		return err
+	}
+	if err := Close(oq.ep); err != nil {
+		return err
+	}
+	return openNewFile(oq.ep, oq.mrs)
+}
+
 func exportDataToCSVFile(oq *outputQueue) error {
 	oq.ep.LineSize = 0
 
@@ -279,11 +400,11 @@ func exportDataToCSVFile(oq *outputQueue) error {
 					}
 				}
 			}
-		case defines.MYSQL_TYPE_VARCHAR, defines.MYSQL_TYPE_VAR_STRING, defines.MYSQL_TYPE_STRING:
-			if value, err2 := oq.mrs.GetValue(0, i); err2 != nil {
+		case defines.MYSQL_TYPE_VARCHAR, defines.MYSQL_TYPE_VAR_STRING, defines.MYSQL_TYPE_STRING, defines.MYSQL_TYPE_BLOB:
+			if value, err2 := oq.mrs.GetString(0, i); err2 != nil {
 				return err2
 			} else {
-				if err = formatOutputString(oq, value.([]byte), oq.ep.Symbol[i], oq.ep.Fields.EnclosedBy, oq.ep.ColumnFlag[i]); err != nil {
+				if err = formatOutputString(oq, []byte(value), oq.ep.Symbol[i], oq.ep.Fields.EnclosedBy, oq.ep.ColumnFlag[i]); err != nil {
 					return err
 				}
 			}
@@ -303,8 +424,14 @@ func exportDataToCSVFile(oq *outputQueue) error {
 					return err
 				}
 			}
-		case defines.MYSQL_TYPE_TIMESTAMP, defines.MYSQL_TYPE_TIME:
-			return fmt.Errorf("unsupported DATE/DATETIME/TIMESTAMP/MYSQL_TYPE_TIME")
+		case defines.MYSQL_TYPE_TIMESTAMP, defines.MYSQL_TYPE_TIME, defines.MYSQL_TYPE_JSON:
+			if value, err2 := oq.mrs.GetString(0, i); err2 != nil {
+				return err2
+			} else {
+				if err = formatOutputString(oq, []byte(value), oq.ep.Symbol[i], oq.ep.Fields.EnclosedBy, oq.ep.ColumnFlag[i]); err != nil {
+					return err
+				}
+			}
 		default:
 			return fmt.Errorf("unsupported column type %d ", mysqlColumn.ColumnType())
 		}
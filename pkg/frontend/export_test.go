@@ -255,7 +255,7 @@ func Test_exportDataToCSVFile(t *testing.T) {
 			},
 		}
 		var col []MysqlColumn = make([]MysqlColumn, 1)
-		var colType = []uint8{defines.MYSQL_TYPE_TIMESTAMP}
+		var colType = []uint8{defines.MYSQL_TYPE_BIT}
 		for i := 0; i < len(col); i++ {
 			col[i].SetColumnType(colType[i])
 			oq.mrs.AddColumn(&col[i])
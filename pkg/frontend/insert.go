@@ -17,10 +17,12 @@ package frontend
 import (
 	"fmt"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
 	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/errno"
+	colexec "github.com/matrixorigin/matrixone/pkg/sql/colexec2"
 	"github.com/matrixorigin/matrixone/pkg/sql/errors"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
@@ -28,6 +30,7 @@ import (
 	"math"
 	"strconv"
 	"strings"
+	"time"
 )
 
 type InsertValues struct {
@@ -36,12 +39,16 @@ type InsertValues struct {
 	currentDb string
 	dataBatch *batch.Batch
 	relation  engine.Relation
+	timeZone  *time.Location
 }
 
 func (mce *MysqlCmdExecutor) handleInsertValues(stmt *tree.Insert, ts uint64) error {
 	snapshot := mce.GetSession().GetTxnHandler().GetTxn().GetCtx()
 
-	plan := &InsertValues{currentDb: mce.GetSession().GetDatabaseName()}
+	plan := &InsertValues{
+		currentDb: mce.GetSession().GetDatabaseName(),
+		timeZone:  mce.GetSession().GetTimeZone(),
+	}
 
 	if err := buildInsertValues(stmt, plan, mce.GetSession().GetStorage(), snapshot); err != nil {
 		return err
@@ -49,7 +56,15 @@ func (mce *MysqlCmdExecutor) handleInsertValues(stmt *tree.Insert, ts uint64) er
 
 	defer plan.relation.Close(snapshot)
 
-	resp := NewOkResponse(uint64(vector.Length(plan.dataBatch.Vecs[0])), 0, 0, 0, int(COM_QUERY), "")
+	lastInsertId, err := colexec.FillAutoIncrement(plan.relation, plan.dataBatch, snapshot)
+	if err != nil {
+		return err
+	}
+	if lastInsertId != 0 {
+		mce.GetSession().SetLastInsertId(lastInsertId)
+	}
+
+	resp := NewOkResponse(uint64(vector.Length(plan.dataBatch.Vecs[0])), lastInsertId, 0, 0, int(COM_QUERY), "")
 	if err := mce.GetSession().protocol.SendResponse(resp); err != nil {
 		return fmt.Errorf("routine send response failed. error:%v ", err)
 	}
@@ -95,16 +110,21 @@ func buildInsertValues(stmt *tree.Insert, plan *InsertValues, eg engine.Engine,
 
 	attrType := make(map[string]types.Type)   // Map from relation's attribute name to its type
 	attrDefault := make(map[string]tree.Expr) // Map from relation's attribute name to its default value
+	attrMembers := make(map[string][]string)  // Map from relation's attribute name to the members of enum or set
 	orderAttr := make([]string, 0, 32)        // order relation's attribute names
 	{
 		count := 0
 		for _, def := range relation.TableDefs(snapshot) {
 			if v, ok := def.(*engine.AttributeDef); ok {
 				attrType[v.Attr.Name] = v.Attr.Type
+				attrMembers[v.Attr.Name] = v.Attr.EnumValues
 				orderAttr = append(orderAttr, v.Attr.Name)
 				if v.Attr.HasDefaultExpr() {
 					value, null := v.Attr.GetDefaultExpr()
-					attrDefault[v.Attr.Name] = makeExprFromVal(v.Attr.Type, value, null)
+					attrDefault[v.Attr.Name] = makeExprFromVal(v.Attr.Type, value, null, plan.timeZone)
+				} else if v.Attr.AutoIncrement {
+					// the null values are generated by the sequence of the table
+					attrDefault[v.Attr.Name] = makeExprFromVal(v.Attr.Type, nil, true, plan.timeZone)
 				}
 				count++
 			}
@@ -154,7 +174,7 @@ func buildInsertValues(stmt *tree.Insert, plan *InsertValues, eg engine.Engine,
 			vs := make([]bool, len(rows.Rows))
 			{
 				for j, row := range rows.Rows {
-					v, err := buildConstant(vec.Typ, row[i])
+					v, err := buildConstant(vec.Typ, row[i], plan.timeZone)
 					if err != nil {
 						return err
 					}
@@ -176,7 +196,7 @@ func buildInsertValues(stmt *tree.Insert, plan *InsertValues, eg engine.Engine,
 			vs := make([]int8, len(rows.Rows))
 			{
 				for j, row := range rows.Rows {
-					v, err := buildConstant(vec.Typ, row[i])
+					v, err := buildConstant(vec.Typ, row[i], plan.timeZone)
 					if err != nil {
 						return err
 					}
@@ -198,7 +218,7 @@ func buildInsertValues(stmt *tree.Insert, plan *InsertValues, eg engine.Engine,
 			vs := make([]int16, len(rows.Rows))
 			{
 				for j, row := range rows.Rows {
-					v, err := buildConstant(vec.Typ, row[i])
+					v, err := buildConstant(vec.Typ, row[i], plan.timeZone)
 					if err != nil {
 						return err
 					}
@@ -220,7 +240,7 @@ func buildInsertValues(stmt *tree.Insert, plan *InsertValues, eg engine.Engine,
 			vs := make([]int32, len(rows.Rows))
 			{
 				for j, row := range rows.Rows {
-					v, err := buildConstant(vec.Typ, row[i])
+					v, err := buildConstant(vec.Typ, row[i], plan.timeZone)
 					if err != nil {
 						return err
 					}
@@ -242,7 +262,7 @@ func buildInsertValues(stmt *tree.Insert, plan *InsertValues, eg engine.Engine,
 			vs := make([]int64, len(rows.Rows))
 			{
 				for j, row := range rows.Rows {
-					v, err := buildConstant(vec.Typ, row[i])
+					v, err := buildConstant(vec.Typ, row[i], plan.timeZone)
 					if err != nil {
 						return err
 					}
@@ -264,7 +284,7 @@ func buildInsertValues(stmt *tree.Insert, plan *InsertValues, eg engine.Engine,
 			vs := make([]uint8, len(rows.Rows))
 			{
 				for j, row := range rows.Rows {
-					v, err := buildConstant(vec.Typ, row[i])
+					v, err := buildConstant(vec.Typ, row[i], plan.timeZone)
 					if err != nil {
 						return err
 					}
@@ -286,7 +306,7 @@ func buildInsertValues(stmt *tree.Insert, plan *InsertValues, eg engine.Engine,
 			vs := make([]uint16, len(rows.Rows))
 			{
 				for j, row := range rows.Rows {
-					v, err := buildConstant(vec.Typ, row[i])
+					v, err := buildConstant(vec.Typ, row[i], plan.timeZone)
 					if err != nil {
 						return err
 					}
@@ -308,7 +328,7 @@ func buildInsertValues(stmt *tree.Insert, plan *InsertValues, eg engine.Engine,
 			vs := make([]uint32, len(rows.Rows))
 			{
 				for j, row := range rows.Rows {
-					v, err := buildConstant(vec.Typ, row[i])
+					v, err := buildConstant(vec.Typ, row[i], plan.timeZone)
 					if err != nil {
 						return err
 					}
@@ -330,7 +350,7 @@ func buildInsertValues(stmt *tree.Insert, plan *InsertValues, eg engine.Engine,
 			vs := make([]uint64, len(rows.Rows))
 			{
 				for j, row := range rows.Rows {
-					v, err := buildConstant(vec.Typ, row[i])
+					v, err := buildConstant(vec.Typ, row[i], plan.timeZone)
 					if err != nil {
 						return err
 					}
@@ -352,7 +372,7 @@ func buildInsertValues(stmt *tree.Insert, plan *InsertValues, eg engine.Engine,
 			vs := make([]float32, len(rows.Rows))
 			{
 				for j, row := range rows.Rows {
-					v, err := buildConstant(vec.Typ, row[i])
+					v, err := buildConstant(vec.Typ, row[i], plan.timeZone)
 					if err != nil {
 						return err
 					}
@@ -374,7 +394,7 @@ func buildInsertValues(stmt *tree.Insert, plan *InsertValues, eg engine.Engine,
 			vs := make([]float64, len(rows.Rows))
 			{
 				for j, row := range rows.Rows {
-					v, err := buildConstant(vec.Typ, row[i])
+					v, err := buildConstant(vec.Typ, row[i], plan.timeZone)
 					if err != nil {
 						return err
 					}
@@ -392,11 +412,11 @@ func buildInsertValues(stmt *tree.Insert, plan *InsertValues, eg engine.Engine,
 			if err := vector.Append(vec, vs); err != nil {
 				return err
 			}
-		case types.T_char, types.T_varchar:
+		case types.T_char, types.T_varchar, types.T_text, types.T_blob:
 			vs := make([][]byte, len(rows.Rows))
 			{
 				for j, row := range rows.Rows {
-					v, err := buildConstant(vec.Typ, row[i])
+					v, err := buildConstant(vec.Typ, row[i], plan.timeZone)
 					if err != nil {
 						return err
 					}
@@ -418,7 +438,7 @@ func buildInsertValues(stmt *tree.Insert, plan *InsertValues, eg engine.Engine,
 			vs := make([]types.Date, len(rows.Rows))
 			{
 				for j, row := range rows.Rows {
-					v, err := buildConstant(vec.Typ, row[i])
+					v, err := buildConstant(vec.Typ, row[i], plan.timeZone)
 					if err != nil {
 						return err
 					}
@@ -440,7 +460,7 @@ func buildInsertValues(stmt *tree.Insert, plan *InsertValues, eg engine.Engine,
 			vs := make([]types.Datetime, len(rows.Rows))
 			{
 				for j, row := range rows.Rows {
-					v, err := buildConstant(vec.Typ, row[i])
+					v, err := buildConstant(vec.Typ, row[i], plan.timeZone)
 					if err != nil {
 						return err
 					}
@@ -462,7 +482,7 @@ func buildInsertValues(stmt *tree.Insert, plan *InsertValues, eg engine.Engine,
 			vs := make([]types.Timestamp, len(rows.Rows))
 			{
 				for j, row := range rows.Rows {
-					v, err := buildConstant(vec.Typ, row[i])
+					v, err := buildConstant(vec.Typ, row[i], plan.timeZone)
 					if err != nil {
 						return err
 					}
@@ -480,11 +500,145 @@ func buildInsertValues(stmt *tree.Insert, plan *InsertValues, eg engine.Engine,
 			if err := vector.Append(vec, vs); err != nil {
 				return err
 			}
+		case types.T_time:
+			vs := make([]types.Time, len(rows.Rows))
+			{
+				for j, row := range rows.Rows {
+					v, err := buildConstant(vec.Typ, row[i], plan.timeZone)
+					if err != nil {
+						return err
+					}
+					if v == nil {
+						nulls.Add(vec.Nsp, uint64(j))
+					} else {
+						if vv, err := rangeCheck(v.(types.Time), vec.Typ, bat.Attrs[i], j+1); err != nil {
+							return err
+						} else {
+							vs[j] = vv.(types.Time)
+						}
+					}
+				}
+			}
+			if err := vector.Append(vec, vs); err != nil {
+				return err
+			}
+		case types.T_year:
+			vs := make([]types.YearValue, len(rows.Rows))
+			{
+				for j, row := range rows.Rows {
+					v, err := buildConstant(vec.Typ, row[i], plan.timeZone)
+					if err != nil {
+						return err
+					}
+					if v == nil {
+						nulls.Add(vec.Nsp, uint64(j))
+					} else {
+						if vv, err := rangeCheck(v.(types.YearValue), vec.Typ, bat.Attrs[i], j+1); err != nil {
+							return err
+						} else {
+							vs[j] = vv.(types.YearValue)
+						}
+					}
+				}
+			}
+			if err := vector.Append(vec, vs); err != nil {
+				return err
+			}
+		case types.T_uuid:
+			vs := make([]types.Uuid, len(rows.Rows))
+			{
+				for j, row := range rows.Rows {
+					v, err := buildConstant(vec.Typ, row[i], plan.timeZone)
+					if err != nil {
+						return err
+					}
+					if v == nil {
+						nulls.Add(vec.Nsp, uint64(j))
+					} else {
+						if vv, err := rangeCheck(v, vec.Typ, bat.Attrs[i], j+1); err != nil {
+							return err
+						} else {
+							vs[j] = vv.(types.Uuid)
+						}
+					}
+				}
+			}
+			if err := vector.Append(vec, vs); err != nil {
+				return err
+			}
+		case types.T_enum:
+			vs := make([]uint16, len(rows.Rows))
+			{
+				members := attrMembers[bat.Attrs[i]]
+				for j, row := range rows.Rows {
+					v, err := buildConstant(vec.Typ, row[i], plan.timeZone)
+					if err != nil {
+						return err
+					}
+					if v == nil {
+						nulls.Add(vec.Nsp, uint64(j))
+					} else {
+						if vv, err := buildEnumValue(v, members); err != nil {
+							return err
+						} else {
+							vs[j] = vv
+						}
+					}
+				}
+			}
+			if err := vector.Append(vec, vs); err != nil {
+				return err
+			}
+		case types.T_set:
+			vs := make([]uint64, len(rows.Rows))
+			{
+				members := attrMembers[bat.Attrs[i]]
+				for j, row := range rows.Rows {
+					v, err := buildConstant(vec.Typ, row[i], plan.timeZone)
+					if err != nil {
+						return err
+					}
+					if v == nil {
+						nulls.Add(vec.Nsp, uint64(j))
+					} else {
+						if vv, err := buildSetValue(v, members); err != nil {
+							return err
+						} else {
+							vs[j] = vv
+						}
+					}
+				}
+			}
+			if err := vector.Append(vec, vs); err != nil {
+				return err
+			}
+		case types.T_json:
+			vs := make([][]byte, len(rows.Rows))
+			{
+				for j, row := range rows.Rows {
+					v, err := buildConstant(vec.Typ, row[i], plan.timeZone)
+					if err != nil {
+						return err
+					}
+					if v == nil {
+						nulls.Add(vec.Nsp, uint64(j))
+					} else {
+						if vv, err := rangeCheck(v, vec.Typ, bat.Attrs[i], j+1); err != nil {
+							return err
+						} else {
+							vs[j] = vv.(bytejson.ByteJson).Marshal()
+						}
+					}
+				}
+			}
+			if err := vector.Append(vec, vs); err != nil {
+				return err
+			}
 		case types.T_decimal64:
 			vs := make([]types.Decimal64, len(rows.Rows))
 			{
 				for j, row := range rows.Rows {
-					v, err := buildConstant(vec.Typ, row[i])
+					v, err := buildConstant(vec.Typ, row[i], plan.timeZone)
 					if err != nil {
 						return err
 					}
@@ -506,7 +660,7 @@ func buildInsertValues(stmt *tree.Insert, plan *InsertValues, eg engine.Engine,
 			vs := make([]types.Decimal128, len(rows.Rows))
 			{
 				for j, row := range rows.Rows {
-					v, err := buildConstant(vec.Typ, row[i])
+					v, err := buildConstant(vec.Typ, row[i], plan.timeZone)
 					if err != nil {
 						return err
 					}
@@ -546,17 +700,17 @@ func buildInsertValues(stmt *tree.Insert, plan *InsertValues, eg engine.Engine,
 			vec.Col = make([]int64, len(rows.Rows))
 		case types.T_uint8:
 			vec.Col = make([]uint8, len(rows.Rows))
-		case types.T_uint16:
+		case types.T_uint16, types.T_enum:
 			vec.Col = make([]uint16, len(rows.Rows))
 		case types.T_uint32:
 			vec.Col = make([]uint32, len(rows.Rows))
-		case types.T_uint64:
+		case types.T_uint64, types.T_set:
 			vec.Col = make([]uint64, len(rows.Rows))
 		case types.T_float32:
 			vec.Col = make([]float32, len(rows.Rows))
 		case types.T_float64:
 			vec.Col = make([]float64, len(rows.Rows))
-		case types.T_char, types.T_varchar:
+		case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 			col := &types.Bytes{}
 			if err = col.Append(make([][]byte, len(rows.Rows))); err != nil {
 				return err
@@ -564,8 +718,16 @@ func buildInsertValues(stmt *tree.Insert, plan *InsertValues, eg engine.Engine,
 			vec.Col = col
 		case types.T_date:
 			vec.Col = make([]types.Date, len(rows.Rows))
+		case types.T_time:
+			vec.Col = make([]types.Time, len(rows.Rows))
 		case types.T_datetime:
 			vec.Col = make([]types.Datetime, len(rows.Rows))
+		case types.T_timestamp:
+			vec.Col = make([]types.Timestamp, len(rows.Rows))
+		case types.T_year:
+			vec.Col = make([]types.YearValue, len(rows.Rows))
+		case types.T_uuid:
+			vec.Col = make([]types.Uuid, len(rows.Rows))
 		case types.T_decimal64:
 			vec.Col = make([]types.Decimal64, len(rows.Rows))
 		case types.T_decimal128:
@@ -580,7 +742,7 @@ func buildInsertValues(stmt *tree.Insert, plan *InsertValues, eg engine.Engine,
 }
 
 // makeExprFromVal make an expr from value
-func makeExprFromVal(typ types.Type, value interface{}, isNull bool) tree.Expr {
+func makeExprFromVal(typ types.Type, value interface{}, isNull bool, loc *time.Location) tree.Expr {
 	if isNull {
 		return tree.NewNumVal(constant.MakeUnknown(), "NULL", false)
 	}
@@ -600,15 +762,24 @@ func makeExprFromVal(typ types.Type, value interface{}, isNull bool) tree.Expr {
 		res := value.(float64)
 		str := strconv.FormatFloat(res, 'f', 10, 64)
 		return tree.NewNumVal(constant.MakeFloat64(res), str, res < 0)
-	case types.T_char, types.T_varchar:
+	case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob, types.T_uuid, types.T_enum, types.T_set:
 		res := value.(string)
 		return tree.NewNumVal(constant.MakeString(res), res, false)
 	case types.T_date:
 		res := value.(types.Date).String()
 		return tree.NewNumVal(constant.MakeString(res), res, false)
+	case types.T_time:
+		res := value.(types.Time).String2(typ.Precision)
+		return tree.NewNumVal(constant.MakeString(res), res, false)
 	case types.T_datetime:
 		res := value.(types.Datetime).String()
 		return tree.NewNumVal(constant.MakeString(res), res, false)
+	case types.T_timestamp:
+		res := value.(types.Timestamp).String2(loc, typ.Precision)
+		return tree.NewNumVal(constant.MakeString(res), res, false)
+	case types.T_year:
+		res := value.(types.YearValue).String()
+		return tree.NewNumVal(constant.MakeString(res), res, false)
 	}
 	return tree.NewNumVal(constant.MakeUnknown(), "NULL", false)
 }
@@ -720,23 +891,23 @@ var (
 	errUnaryOutRange    = errors.New(errno.DataException, "unary result out of range")
 )
 
-func buildConstant(typ types.Type, n tree.Expr) (interface{}, error) {
+func buildConstant(typ types.Type, n tree.Expr, loc *time.Location) (interface{}, error) {
 	switch e := n.(type) {
 	case *tree.ParenExpr:
-		return buildConstant(typ, e.Expr)
+		return buildConstant(typ, e.Expr, loc)
 	case *tree.NumVal:
-		return buildConstantValue(typ, e)
+		return buildConstantValue(typ, e, loc)
 	case *tree.UnaryExpr:
 		if e.Op == tree.UNARY_PLUS {
-			return buildConstant(typ, e.Expr)
+			return buildConstant(typ, e.Expr, loc)
 		}
 		if e.Op == tree.UNARY_MINUS {
 			switch n := e.Expr.(type) {
 			case *tree.NumVal:
-				return buildConstantValue(typ, tree.NewNumVal(n.Value, "-"+n.String(), true))
+				return buildConstantValue(typ, tree.NewNumVal(n.Value, "-"+n.String(), true), loc)
 			}
 
-			v, err := buildConstant(typ, e.Expr)
+			v, err := buildConstant(typ, e.Expr, loc)
 			if err != nil {
 				return nil, err
 			}
@@ -758,11 +929,11 @@ func buildConstant(typ types.Type, n tree.Expr) (interface{}, error) {
 		var floatResult float64
 		var argTyp = types.Type{Oid: types.T_float64, Size: 8}
 		// build values of Part left and Part right.
-		left, err := buildConstant(argTyp, e.Left)
+		left, err := buildConstant(argTyp, e.Left, loc)
 		if err != nil {
 			return nil, err
 		}
-		right, err := buildConstant(argTyp, e.Right)
+		right, err := buildConstant(argTyp, e.Right, loc)
 		if err != nil {
 			return nil, err
 		}
@@ -902,7 +1073,7 @@ func buildConstant(typ types.Type, n tree.Expr) (interface{}, error) {
 	return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("'%v' is not support now", n))
 }
 
-func buildConstantValue(typ types.Type, num *tree.NumVal) (interface{}, error) {
+func buildConstantValue(typ types.Type, num *tree.NumVal, loc *time.Location) (interface{}, error) {
 	val := num.Value
 	str := num.String()
 
@@ -933,7 +1104,7 @@ func buildConstantValue(typ types.Type, num *tree.NumVal) (interface{}, error) {
 			return types.ParseStringToDecimal64(str, typ.Width, typ.Scale)
 		case types.T_decimal128:
 			return types.ParseStringToDecimal128(str, typ.Width, typ.Scale)
-		case types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64:
+		case types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64, types.T_enum, types.T_set:
 			v, _ := constant.Uint64Val(val)
 			if num.Negative() {
 				if v != 0 {
@@ -961,6 +1132,13 @@ func buildConstantValue(typ types.Type, num *tree.NumVal) (interface{}, error) {
 			if !num.Negative() {
 				return types.ParseDatetime(str)
 			}
+		case types.T_time:
+			return types.ParseTime(str, typ.Precision)
+		case types.T_year:
+			if !num.Negative() {
+				v, _ := constant.Int64Val(val)
+				return types.YearFromInt(v)
+			}
 		}
 	case constant.Float:
 		switch typ.Oid {
@@ -1028,14 +1206,26 @@ func buildConstantValue(typ types.Type, num *tree.NumVal) (interface{}, error) {
 		}
 		if !num.Negative() {
 			switch typ.Oid {
-			case types.T_char, types.T_varchar:
+			case types.T_char, types.T_varchar, types.T_text, types.T_blob, types.T_enum, types.T_set:
 				return constant.StringVal(val), nil
+			case types.T_uuid:
+				return types.ParseUuid(constant.StringVal(val))
 			case types.T_date:
 				return types.ParseDate(constant.StringVal(val))
 			case types.T_datetime:
 				return types.ParseDatetime(constant.StringVal(val))
+			case types.T_time:
+				return types.ParseTime(constant.StringVal(val), typ.Precision)
 			case types.T_timestamp:
-				return types.ParseTimestamp(constant.StringVal(val), typ.Precision)
+				return types.ParseTimestamp(loc, constant.StringVal(val), typ.Precision)
+			case types.T_year:
+				return types.ParseYear(constant.StringVal(val))
+			case types.T_json:
+				bj, err := bytejson.ParseFromString(constant.StringVal(val))
+				if err != nil {
+					return nil, errors.New(errno.DataException, fmt.Sprintf("Invalid JSON text: '%s'", constant.StringVal(val)))
+				}
+				return bj, nil
 			}
 		}
 	}
@@ -1113,13 +1303,49 @@ func rangeCheck(value interface{}, typ types.Type, columnName string, rowNumber
 			if len(v) <= int(typ.Width) {
 				return v, nil
 			}
+		case types.T_text, types.T_blob: // large values are not limited by uint16
+			if len(v) <= int(typ.Width) {
+				return v, nil
+			}
 		default:
 			return nil, errors.New(errno.DatatypeMismatch, "unexpected type and value")
 		}
 		return nil, errors.New(errno.DataException, fmt.Sprintf("Data too long for column '%s' at row %d", columnName, rowNumber))
-	case types.Date, types.Datetime, types.Timestamp, types.Decimal64, types.Decimal128, bool:
+	case types.Date, types.Time, types.Datetime, types.Timestamp, types.YearValue, types.Decimal64, types.Decimal128, bool:
 		return v, nil
+	case bytejson.ByteJson:
+		if typ.Oid == types.T_json {
+			return v, nil
+		}
+		return nil, errors.New(errno.DatatypeMismatch, "unexpected type and value")
+	case types.Uuid:
+		if typ.Oid == types.T_uuid {
+			return v, nil
+		}
+		return nil, errors.New(errno.DatatypeMismatch, "unexpected type and value")
 	default:
 		return nil, errors.New(errno.DatatypeMismatch, "unexpected type and value")
 	}
 }
+
+// buildEnumValue converts a string or a number to the index of the member of an enum column
+func buildEnumValue(value interface{}, members []string) (uint16, error) {
+	switch v := value.(type) {
+	case string:
+		return types.ParseEnum(members, v)
+	case uint64:
+		return types.EnumFromInt(members, int64(v))
+	}
+	return 0, errors.New(errno.DatatypeMismatch, "unexpected type and value")
+}
+
+// buildSetValue converts a string or a number to the bitmap of the members of a set column
+func buildSetValue(value interface{}, members []string) (uint64, error) {
+	switch v := value.(type) {
+	case string:
+		return types.ParseSet(members, v)
+	case uint64:
+		return types.SetFromInt(members, int64(v))
+	}
+	return 0, errors.New(errno.DatatypeMismatch, "unexpected type and value")
+}
@@ -0,0 +1,109 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/matrixorigin/matrixone/pkg/defines"
+)
+
+/*
+exportDataToJSONLineFile writes the row as a json object named by the columns.
+*/
+func exportDataToJSONLineFile(oq *outputQueue) error {
+	oq.ep.LineSize = 0
+	oq.ResetLineStr()
+	oq.lineStr = append(oq.lineStr, '{')
+	for i := uint64(0); i < oq.mrs.GetColumnCount(); i++ {
+		column, err := oq.mrs.GetColumn(i)
+		if err != nil {
+			return err
+		}
+		if i > 0 {
+			oq.lineStr = append(oq.lineStr, ',')
+		}
+		if oq.lineStr, err = appendJSONString(oq.lineStr, column.Name()); err != nil {
+			return err
+		}
+		oq.lineStr = append(oq.lineStr, ':')
+
+		value, err := oq.mrs.GetValue(0, i)
+		if err != nil {
+			return err
+		}
+		if s, ok := value.(string); ok && column.ColumnType() == defines.MYSQL_TYPE_JSON {
+			// the json document is embedded as it is
+			oq.lineStr = append(oq.lineStr, s...)
+			continue
+		}
+		switch val := value.(type) {
+		case nil:
+			oq.lineStr = append(oq.lineStr, "null"...)
+		case bool:
+			oq.lineStr = strconv.AppendBool(oq.lineStr, val)
+		case int8:
+			oq.lineStr = strconv.AppendInt(oq.lineStr, int64(val), 10)
+		case int16:
+			oq.lineStr = strconv.AppendInt(oq.lineStr, int64(val), 10)
+		case int32:
+			oq.lineStr = strconv.AppendInt(oq.lineStr, int64(val), 10)
+		case int64:
+			oq.lineStr = strconv.AppendInt(oq.lineStr, val, 10)
+		case uint8:
+			oq.lineStr = strconv.AppendUint(oq.lineStr, uint64(val), 10)
+		case uint16:
+			oq.lineStr = strconv.AppendUint(oq.lineStr, uint64(val), 10)
+		case uint32:
+			oq.lineStr = strconv.AppendUint(oq.lineStr, uint64(val), 10)
+		case uint64:
+			oq.lineStr = strconv.AppendUint(oq.lineStr, val, 10)
+		case float32:
+			oq.lineStr = strconv.AppendFloat(oq.lineStr, float64(val), 'g', -1, 32)
+		case float64:
+			oq.lineStr = strconv.AppendFloat(oq.lineStr, val, 'g', -1, 64)
+		case []byte:
+			if oq.lineStr, err = appendJSONString(oq.lineStr, string(val)); err != nil {
+				return err
+			}
+		case string:
+			if oq.lineStr, err = appendJSONString(oq.lineStr, val); err != nil {
+				return err
+			}
+		case fmt.Stringer:
+			if oq.lineStr, err = appendJSONString(oq.lineStr, val.String()); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("unsupported column type %T", value)
+		}
+	}
+	oq.lineStr = append(oq.lineStr, '}', '\n')
+	if err := writeToCSVFile(oq, oq.lineStr); err != nil {
+		return err
+	}
+	oq.ep.Rows++
+	return nil
+}
+
+func appendJSONString(dst []byte, s string) ([]byte, error) {
+	data, err := json.Marshal(s)
+	if err != nil {
+		return nil, err
+	}
+	return append(dst, data...), nil
+}
@@ -0,0 +1,50 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"bufio"
+	"bytes"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func Test_exportDataToJSONLineFile(t *testing.T) {
+	convey.Convey("exportDataToJSONLineFile succ", t, func() {
+		var buf bytes.Buffer
+		oq := &outputQueue{
+			mrs: &MysqlResultSet{},
+			ep: &tree.ExportParam{
+				FileFormat: tree.JSONLINE,
+				Writer:     bufio.NewWriter(&buf),
+			},
+		}
+		for _, name := range []string{"a", "b", "c", "d"} {
+			col := new(MysqlColumn)
+			col.SetName(name)
+			oq.mrs.AddColumn(col)
+		}
+		date, _ := types.ParseDate("2022-05-01")
+		oq.mrs.AddRow([]interface{}{int64(1), []byte("a\"b"), nil, date})
+
+		convey.So(exportDataToFile(oq), convey.ShouldBeNil)
+		convey.So(oq.ep.Writer.Flush(), convey.ShouldBeNil)
+		convey.So(buf.String(), convey.ShouldEqual, `{"a":1,"b":"a\"b","c":null,"d":"2022-05-01"}`+"\n")
+		convey.So(oq.ep.Rows, convey.ShouldEqual, 1)
+	})
+}
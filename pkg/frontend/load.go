@@ -30,10 +30,13 @@ import (
 	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
 	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
+	colexec "github.com/matrixorigin/matrixone/pkg/sql/colexec2"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/external"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 
@@ -103,6 +106,9 @@ type SharePart struct {
 	attrName  []string
 	timestamp uint64
 
+	//time zone of the session, timestamp fields are in it
+	timeZone *time.Location
+
 	//simd csv
 	simdCsvLineArray [][]string
 
@@ -126,7 +132,7 @@ const (
 	NOTIFY_EVENT_END
 )
 
-const NULL_FLAG = "\\N"
+const NULL_FLAG = external.NullFlag
 
 type notifyEvent struct {
 	neType notifyEventType
@@ -174,7 +180,10 @@ type ParseLineHandler struct {
 	DebugTime
 
 	threadInfo                  map[int]*ThreadInfo
-	simdCsvReader               *simdcsv.Reader
+	simdCsvReader               external.LineReader
+	parquetReader               *external.ParquetReader
+	stopParquet                 chan struct{}
+	closeOnceStopParquet        sync.Once
 	closeOnceGetParsedLinesChan sync.Once
 	//csv read put lines into the channel
 	simdCsvGetParsedLinesChan atomic.Value // chan simdcsv.LineOut
@@ -338,7 +347,9 @@ func (plh *ParseLineHandler) close() {
 	plh.closeOnce.Do(func() {
 		close(plh.simdCsvBatchPool)
 		close(plh.simdCsvNotiyEventChan)
-		plh.simdCsvReader.Close()
+		if plh.simdCsvReader != nil {
+			plh.simdCsvReader.Close()
+		}
 	})
 	plh.closeRef.Close()
 }
@@ -367,17 +378,17 @@ func makeBatch(handler *ParseLineHandler, id int) *PoolElement {
 			vec.Col = make([]int64, batchSize)
 		case types.T_uint8:
 			vec.Col = make([]uint8, batchSize)
-		case types.T_uint16:
+		case types.T_uint16, types.T_enum:
 			vec.Col = make([]uint16, batchSize)
 		case types.T_uint32:
 			vec.Col = make([]uint32, batchSize)
-		case types.T_uint64:
+		case types.T_uint64, types.T_set:
 			vec.Col = make([]uint64, batchSize)
 		case types.T_float32:
 			vec.Col = make([]float32, batchSize)
 		case types.T_float64:
 			vec.Col = make([]float64, batchSize)
-		case types.T_char, types.T_varchar:
+		case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 			vBytes := &types.Bytes{
 				Offsets: make([]uint32, batchSize),
 				Lengths: make([]uint32, batchSize),
@@ -386,8 +397,16 @@ func makeBatch(handler *ParseLineHandler, id int) *PoolElement {
 			vec.Col = vBytes
 		case types.T_date:
 			vec.Col = make([]types.Date, batchSize)
+		case types.T_time:
+			vec.Col = make([]types.Time, batchSize)
 		case types.T_datetime:
 			vec.Col = make([]types.Datetime, batchSize)
+		case types.T_timestamp:
+			vec.Col = make([]types.Timestamp, batchSize)
+		case types.T_year:
+			vec.Col = make([]types.YearValue, batchSize)
+		case types.T_uuid:
+			vec.Col = make([]types.Uuid, batchSize)
 		case types.T_decimal64:
 			vec.Col = make([]types.Decimal64, batchSize)
 		case types.T_decimal128:
@@ -466,6 +485,23 @@ func initParseLineHandler(handler *ParseLineHandler) error {
 	return nil
 }
 
+/*
+getLoadColumnNames returns the names of the columns in the data file.
+The name of the column which is dropped is empty.
+*/
+func getLoadColumnNames(handler *ParseLineHandler) []string {
+	if len(handler.load.ColumnList) == 0 {
+		return handler.attrName
+	}
+	names := make([]string, len(handler.load.ColumnList))
+	for i, col := range handler.load.ColumnList {
+		if name, ok := col.(*tree.UnresolvedName); ok {
+			names[i] = name.Parts[0]
+		}
+	}
+	return names
+}
+
 /*
 alloc a batch from the pool.
 if the pool does not have batch anymore, the caller routine will be suspended.
@@ -484,7 +520,7 @@ func releaseBatch(handler *ParseLineHandler, pl *PoolElement) {
 	for _, vec := range pl.bat.Vecs {
 		vec.Nsp = &nulls.Nulls{}
 		switch vec.Typ.Oid {
-		case types.T_char, types.T_varchar:
+		case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 			vBytes := vec.Col.(*types.Bytes)
 			vBytes.Data = vBytes.Data[:0]
 		}
@@ -506,6 +542,7 @@ func initWriteBatchHandler(handler *ParseLineHandler, wHandler *WriteBatchHandle
 	wHandler.txnHandler = handler.txnHandler
 	wHandler.oneTxnPerBatch = handler.oneTxnPerBatch
 	wHandler.timestamp = handler.timestamp
+	wHandler.timeZone = handler.timeZone
 	wHandler.result = &LoadResult{}
 	wHandler.closeRef = handler.closeRef
 	wHandler.lineCount = handler.lineCount
@@ -855,7 +892,27 @@ func rowToColumnAndSaveToStorage(handler *WriteBatchHandler, forceConvert bool,
 						}
 						cols[rowIdx] = d
 					}
-				case types.T_char, types.T_varchar:
+				case types.T_json:
+					vBytes := vec.Col.(*types.Bytes)
+					vBytes.Offsets[rowIdx] = uint32(len(vBytes.Data))
+					if isNullOrEmpty {
+						nulls.Add(vec.Nsp, uint64(rowIdx))
+						vBytes.Lengths[rowIdx] = 0
+					} else {
+						bj, err := bytejson.ParseFromString(field)
+						if err != nil {
+							logutil.Errorf("parse field[%v] err:%v", field, err)
+							if !ignoreFieldError {
+								return makeParsedFailedError(vec.Typ.String(), field, vecAttr, base, offset)
+							}
+							result.Warnings++
+							bj = bytejson.Null
+						}
+						data := bj.Marshal()
+						vBytes.Data = append(vBytes.Data, data...)
+						vBytes.Lengths[rowIdx] = uint32(len(data))
+					}
+				case types.T_char, types.T_varchar, types.T_text, types.T_blob:
 					vBytes := vec.Col.(*types.Bytes)
 					if isNullOrEmpty {
 						nulls.Add(vec.Nsp, uint64(rowIdx))
@@ -902,6 +959,105 @@ func rowToColumnAndSaveToStorage(handler *WriteBatchHandler, forceConvert bool,
 						}
 						cols[rowIdx] = d
 					}
+				case types.T_time:
+					cols := vec.Col.([]types.Time)
+					if isNullOrEmpty {
+						nulls.Add(vec.Nsp, uint64(rowIdx))
+					} else {
+						fs := field
+						d, err := types.ParseTime(fs, vec.Typ.Precision)
+						if err != nil {
+							logutil.Errorf("parse field[%v] err:%v", field, err)
+							if !ignoreFieldError {
+								return makeParsedFailedError(vec.Typ.String(), field, vecAttr, base, offset)
+							}
+							result.Warnings++
+							d = 0
+						}
+						cols[rowIdx] = d
+					}
+				case types.T_timestamp:
+					cols := vec.Col.([]types.Timestamp)
+					if isNullOrEmpty {
+						nulls.Add(vec.Nsp, uint64(rowIdx))
+					} else {
+						fs := field
+						d, err := types.ParseTimestamp(handler.timeZone, fs, vec.Typ.Precision)
+						if err != nil {
+							logutil.Errorf("parse field[%v] err:%v", field, err)
+							if !ignoreFieldError {
+								return makeParsedFailedError(vec.Typ.String(), field, vecAttr, base, offset)
+							}
+							result.Warnings++
+							d = 0
+						}
+						cols[rowIdx] = d
+					}
+				case types.T_year:
+					cols := vec.Col.([]types.YearValue)
+					if isNullOrEmpty {
+						nulls.Add(vec.Nsp, uint64(rowIdx))
+					} else {
+						fs := field
+						d, err := types.ParseYear(fs)
+						if err != nil {
+							logutil.Errorf("parse field[%v] err:%v", field, err)
+							if !ignoreFieldError {
+								return makeParsedFailedError(vec.Typ.String(), field, vecAttr, base, offset)
+							}
+							result.Warnings++
+							d = 0
+						}
+						cols[rowIdx] = d
+					}
+				case types.T_uuid:
+					cols := vec.Col.([]types.Uuid)
+					if isNullOrEmpty {
+						nulls.Add(vec.Nsp, uint64(rowIdx))
+					} else {
+						d, err := types.ParseUuid(field)
+						if err != nil {
+							logutil.Errorf("parse field[%v] err:%v", field, err)
+							if !ignoreFieldError {
+								return makeParsedFailedError(vec.Typ.String(), field, vecAttr, base, offset)
+							}
+							result.Warnings++
+							d = types.Uuid{}
+						}
+						cols[rowIdx] = d
+					}
+				case types.T_enum:
+					cols := vec.Col.([]uint16)
+					if isNullOrEmpty {
+						nulls.Add(vec.Nsp, uint64(rowIdx))
+					} else {
+						d, err := types.ParseEnum(handler.cols[colIdx].Attr.EnumValues, field)
+						if err != nil {
+							logutil.Errorf("parse field[%v] err:%v", field, err)
+							if !ignoreFieldError {
+								return makeParsedFailedError(vec.Typ.String(), field, vecAttr, base, offset)
+							}
+							result.Warnings++
+							d = 0
+						}
+						cols[rowIdx] = d
+					}
+				case types.T_set:
+					cols := vec.Col.([]uint64)
+					if isNullOrEmpty {
+						nulls.Add(vec.Nsp, uint64(rowIdx))
+					} else {
+						d, err := types.ParseSet(handler.cols[colIdx].Attr.EnumValues, field)
+						if err != nil {
+							logutil.Errorf("parse field[%v] err:%v", field, err)
+							if !ignoreFieldError {
+								return makeParsedFailedError(vec.Typ.String(), field, vecAttr, base, offset)
+							}
+							result.Warnings++
+							d = 0
+						}
+						cols[rowIdx] = d
+					}
 				default:
 					panic("unsupported oid")
 				}
@@ -914,7 +1070,7 @@ func rowToColumnAndSaveToStorage(handler *WriteBatchHandler, forceConvert bool,
 				if columnFLags[k] == 0 {
 					vec := batchData.Vecs[k]
 					switch vec.Typ.Oid {
-					case types.T_char, types.T_varchar:
+					case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 						vBytes := vec.Col.(*types.Bytes)
 						vBytes.Offsets[rowIdx] = uint32(len(vBytes.Data))
 						vBytes.Lengths[rowIdx] = uint32(0)
@@ -1175,7 +1331,31 @@ func rowToColumnAndSaveToStorage(handler *WriteBatchHandler, forceConvert bool,
 						cols[i] = d
 					}
 				}
-			case types.T_char, types.T_varchar:
+			case types.T_json:
+				vBytes := vec.Col.(*types.Bytes)
+				for i := 0; i < countOfLineArray; i++ {
+					line := fetchLines[i]
+					vBytes.Offsets[i] = uint32(len(vBytes.Data))
+					if j >= len(line) || len(line[j]) == 0 {
+						nulls.Add(vec.Nsp, uint64(i))
+						vBytes.Lengths[i] = 0
+					} else {
+						field := line[j]
+						bj, err := bytejson.ParseFromString(field)
+						if err != nil {
+							logutil.Errorf("parse field[%v] err:%v", field, err)
+							if !ignoreFieldError {
+								return err
+							}
+							result.Warnings++
+							bj = bytejson.Null
+						}
+						data := bj.Marshal()
+						vBytes.Data = append(vBytes.Data, data...)
+						vBytes.Lengths[i] = uint32(len(data))
+					}
+				}
+			case types.T_char, types.T_varchar, types.T_text, types.T_blob:
 				vBytes := vec.Col.(*types.Bytes)
 				//row
 				for i := 0; i < countOfLineArray; i++ {
@@ -1236,6 +1416,132 @@ func rowToColumnAndSaveToStorage(handler *WriteBatchHandler, forceConvert bool,
 						cols[i] = d
 					}
 				}
+			case types.T_time:
+				cols := vec.Col.([]types.Time)
+				for i := 0; i < countOfLineArray; i++ {
+					line := fetchLines[i]
+					if j >= len(line) || len(line[j]) == 0 {
+						nulls.Add(vec.Nsp, uint64(i))
+					} else {
+						field := line[j]
+						//logutil.Infof("==== > field string [%s] ",fs)
+						d, err := types.ParseTime(field, vec.Typ.Precision)
+						if err != nil {
+							logutil.Errorf("parse field[%v] err:%v", field, err)
+							if !ignoreFieldError {
+								return err
+							}
+							result.Warnings++
+							d = 0
+							//break
+						}
+						cols[i] = d
+					}
+				}
+			case types.T_timestamp:
+				cols := vec.Col.([]types.Timestamp)
+				for i := 0; i < countOfLineArray; i++ {
+					line := fetchLines[i]
+					if j >= len(line) || len(line[j]) == 0 {
+						nulls.Add(vec.Nsp, uint64(i))
+					} else {
+						field := line[j]
+						//logutil.Infof("==== > field string [%s] ",fs)
+						d, err := types.ParseTimestamp(handler.timeZone, field, vec.Typ.Precision)
+						if err != nil {
+							logutil.Errorf("parse field[%v] err:%v", field, err)
+							if !ignoreFieldError {
+								return err
+							}
+							result.Warnings++
+							d = 0
+							//break
+						}
+						cols[i] = d
+					}
+				}
+			case types.T_year:
+				cols := vec.Col.([]types.YearValue)
+				for i := 0; i < countOfLineArray; i++ {
+					line := fetchLines[i]
+					if j >= len(line) || len(line[j]) == 0 {
+						nulls.Add(vec.Nsp, uint64(i))
+					} else {
+						field := line[j]
+						//logutil.Infof("==== > field string [%s] ",fs)
+						d, err := types.ParseYear(field)
+						if err != nil {
+							logutil.Errorf("parse field[%v] err:%v", field, err)
+							if !ignoreFieldError {
+								return err
+							}
+							result.Warnings++
+							d = 0
+							//break
+						}
+						cols[i] = d
+					}
+				}
+			case types.T_uuid:
+				cols := vec.Col.([]types.Uuid)
+				for i := 0; i < countOfLineArray; i++ {
+					line := fetchLines[i]
+					if j >= len(line) || len(line[j]) == 0 {
+						nulls.Add(vec.Nsp, uint64(i))
+					} else {
+						field := line[j]
+						d, err := types.ParseUuid(field)
+						if err != nil {
+							logutil.Errorf("parse field[%v] err:%v", field, err)
+							if !ignoreFieldError {
+								return err
+							}
+							result.Warnings++
+							d = types.Uuid{}
+						}
+						cols[i] = d
+					}
+				}
+			case types.T_enum:
+				cols := vec.Col.([]uint16)
+				for i := 0; i < countOfLineArray; i++ {
+					line := fetchLines[i]
+					if j >= len(line) || len(line[j]) == 0 {
+						nulls.Add(vec.Nsp, uint64(i))
+					} else {
+						field := line[j]
+						d, err := types.ParseEnum(handler.cols[colIdx].Attr.EnumValues, field)
+						if err != nil {
+							logutil.Errorf("parse field[%v] err:%v", field, err)
+							if !ignoreFieldError {
+								return err
+							}
+							result.Warnings++
+							d = 0
+						}
+						cols[i] = d
+					}
+				}
+			case types.T_set:
+				cols := vec.Col.([]uint64)
+				for i := 0; i < countOfLineArray; i++ {
+					line := fetchLines[i]
+					if j >= len(line) || len(line[j]) == 0 {
+						nulls.Add(vec.Nsp, uint64(i))
+					} else {
+						field := line[j]
+						d, err := types.ParseSet(handler.cols[colIdx].Attr.EnumValues, field)
+						if err != nil {
+							logutil.Errorf("parse field[%v] err:%v", field, err)
+							if !ignoreFieldError {
+								return err
+							}
+							result.Warnings++
+							d = 0
+						}
+						cols[i] = d
+					}
+				}
 			default:
 				panic("unsupported oid")
 			}
@@ -1250,7 +1556,7 @@ func rowToColumnAndSaveToStorage(handler *WriteBatchHandler, forceConvert bool,
 				//row
 				for i := 0; i < countOfLineArray; i++ {
 					switch vec.Typ.Oid {
-					case types.T_char, types.T_varchar:
+					case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 						vBytes := vec.Col.(*types.Bytes)
 						vBytes.Offsets[i] = uint32(len(vBytes.Data))
 						vBytes.Lengths[i] = uint32(0)
@@ -1321,7 +1627,7 @@ func writeBatchToStorage(handler *WriteBatchHandler, force bool) error {
 		//for _, vec := range handler.batchData.Vecs {
 		//	//logutil.Infof("len %d type %d %s ",vec.Length(),vec.Typ.Oid,vec.Typ.String())
 		//	switch vec.Typ.Oid {
-		//	case types.T_char, types.T_varchar:
+		//	case types.T_char, types.T_varchar, types.T_text, types.T_blob:
 		//		vBytes := vec.Col.(*types.Bytes)
 		//		batchBytes += len(vBytes.Data)
 		//	default:
@@ -1343,7 +1649,10 @@ func writeBatchToStorage(handler *WriteBatchHandler, force bool) error {
 					return err
 				}
 			}
-			err = handler.tableHandler.Write(handler.timestamp, handler.batchData, txnHandler.GetTxn().GetCtx())
+			_, err = colexec.FillAutoIncrement(handler.tableHandler, handler.batchData, txnHandler.GetTxn().GetCtx())
+			if err == nil {
+				err = handler.tableHandler.Write(handler.timestamp, handler.batchData, txnHandler.GetTxn().GetCtx())
+			}
 			if handler.oneTxnPerBatch {
 				err = txnHandler.CommitAfterAutocommitOnly()
 				if err != nil {
@@ -1372,7 +1681,7 @@ func writeBatchToStorage(handler *WriteBatchHandler, force bool) error {
 		for _, vec := range handler.batchData.Vecs {
 			vec.Nsp = &nulls.Nulls{}
 			switch vec.Typ.Oid {
-			case types.T_char, types.T_varchar:
+			case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 				vBytes := vec.Col.(*types.Bytes)
 				vBytes.Data = vBytes.Data[:0]
 			}
@@ -1409,13 +1718,13 @@ func writeBatchToStorage(handler *WriteBatchHandler, force bool) error {
 					case types.T_uint8:
 						cols := vec.Col.([]uint8)
 						vec.Col = cols[:needLen]
-					case types.T_uint16:
+					case types.T_uint16, types.T_enum:
 						cols := vec.Col.([]uint16)
 						vec.Col = cols[:needLen]
 					case types.T_uint32:
 						cols := vec.Col.([]uint32)
 						vec.Col = cols[:needLen]
-					case types.T_uint64:
+					case types.T_uint64, types.T_set:
 						cols := vec.Col.([]uint64)
 						vec.Col = cols[:needLen]
 					case types.T_float32:
@@ -1424,7 +1733,7 @@ func writeBatchToStorage(handler *WriteBatchHandler, force bool) error {
 					case types.T_float64:
 						cols := vec.Col.([]float64)
 						vec.Col = cols[:needLen]
-					case types.T_char, types.T_varchar: //bytes is different
+					case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob: //bytes is different
 						vBytes := vec.Col.(*types.Bytes)
 						//logutil.Infof("saveBatchToStorage before data %s ",vBytes.String())
 						if len(vBytes.Offsets) > needLen {
@@ -1435,9 +1744,21 @@ func writeBatchToStorage(handler *WriteBatchHandler, force bool) error {
 					case types.T_date:
 						cols := vec.Col.([]types.Date)
 						vec.Col = cols[:needLen]
+					case types.T_time:
+						cols := vec.Col.([]types.Time)
+						vec.Col = cols[:needLen]
 					case types.T_datetime:
 						cols := vec.Col.([]types.Datetime)
 						vec.Col = cols[:needLen]
+					case types.T_timestamp:
+						cols := vec.Col.([]types.Timestamp)
+						vec.Col = cols[:needLen]
+					case types.T_year:
+						cols := vec.Col.([]types.YearValue)
+						vec.Col = cols[:needLen]
+					case types.T_uuid:
+						cols := vec.Col.([]types.Uuid)
+						vec.Col = cols[:needLen]
 					}
 				}
 
@@ -1457,7 +1778,10 @@ func writeBatchToStorage(handler *WriteBatchHandler, force bool) error {
 							return err
 						}
 					}
-					err = handler.tableHandler.Write(handler.timestamp, handler.batchData, txnHandler.GetTxn().GetCtx())
+					_, err = colexec.FillAutoIncrement(handler.tableHandler, handler.batchData, txnHandler.GetTxn().GetCtx())
+					if err == nil {
+						err = handler.tableHandler.Write(handler.timestamp, handler.batchData, txnHandler.GetTxn().GetCtx())
+					}
 					if handler.oneTxnPerBatch {
 						err = txnHandler.CommitAfterAutocommitOnly()
 						if err != nil {
@@ -1499,12 +1823,23 @@ var saveLinesToStorage = func(handler *ParseLineHandler, force bool) error {
 		return err
 	}
 
+	asyncSaveBatch(handler, writeHandler, func() error {
+		//step 3 : save into storage
+		return rowToColumnAndSaveToStorage(writeHandler, force, row2colChoose)
+	})
+	return nil
+}
+
+/*
+asyncSaveBatch saves the batch of the writeHandler in a new routine, and
+returns the batch into the pool after that.
+*/
+func asyncSaveBatch(handler *ParseLineHandler, writeHandler *WriteBatchHandler, save func() error) {
 	handler.simdCsvWaitWriteRoutineToQuit.Add(1)
 	go func() {
 		defer handler.simdCsvWaitWriteRoutineToQuit.Done()
 
-		//step 3 : save into storage
-		err = rowToColumnAndSaveToStorage(writeHandler, force, row2colChoose)
+		err := save()
 		writeHandler.simdCsvErr = err
 
 		releaseBatch(handler, writeHandler.pl)
@@ -1517,7 +1852,95 @@ var saveLinesToStorage = func(handler *ParseLineHandler, force bool) error {
 			handler.simdCsvNotiyEventChan <- newNotifyEvent(NOTIFY_EVENT_WRITE_BATCH_RESULT, nil, writeHandler)
 		}
 	}()
-	return nil
+}
+
+/*
+getLoadAttributes returns the columns of the table the columns in the data file
+are loaded into. The column which is dropped is nil.
+*/
+func getLoadAttributes(handler *ParseLineHandler) []*engine.Attribute {
+	attrs := make([]*engine.Attribute, len(handler.dataColumnId2TableColumnId))
+	for i, colIdx := range handler.dataColumnId2TableColumnId {
+		if colIdx != -1 {
+			attrs[i] = &handler.cols[colIdx].Attr
+		}
+	}
+	return attrs
+}
+
+/*
+getBatchFromParquetRoutine decodes the rows of the parquet file straight into
+the batches from the pool, and delivers them to the async routines writing batch.
+*/
+func (plh *ParseLineHandler) getBatchFromParquetRoutine() error {
+	pr := plh.parquetReader
+	if err := pr.Skip(int64(plh.load.IgnoredLines)); err != nil {
+		return err
+	}
+	warnings := pr.Warnings()
+	vecs := make([]*vector.Vector, len(plh.dataColumnId2TableColumnId))
+	loaded := make([]bool, len(plh.cols))
+	for {
+		select {
+		case <-plh.closeRef.stopLoadData:
+			logutil.Infof("----- get stop in getBatchFromParquetRoutine")
+			return nil
+		case <-plh.stopParquet:
+			return nil
+		default:
+		}
+
+		writeHandler := &WriteBatchHandler{}
+		err := initWriteBatchHandler(plh, writeHandler)
+		if err != nil {
+			return err
+		}
+		batchData := writeHandler.batchData
+		for i := range loaded {
+			loaded[i] = false
+		}
+		for i, colIdx := range plh.dataColumnId2TableColumnId {
+			vecs[i] = nil
+			if colIdx != -1 {
+				vecs[i] = batchData.Vecs[colIdx]
+				loaded[colIdx] = true
+			}
+		}
+		n, err := pr.Read(vecs, 0, plh.batchSize)
+		if err != nil || n == 0 {
+			releaseBatch(plh, writeHandler.pl)
+			return err
+		}
+
+		//the columns which are not in the data file are null
+		for k, vec := range batchData.Vecs {
+			if loaded[k] {
+				continue
+			}
+			for i := 0; i < n; i++ {
+				switch vec.Typ.Oid {
+				case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
+					vBytes := vec.Col.(*types.Bytes)
+					vBytes.Offsets[i] = uint32(len(vBytes.Data))
+					vBytes.Lengths[i] = 0
+				}
+				nulls.Add(vec.Nsp, uint64(i))
+			}
+		}
+
+		plh.lineCount += uint64(n)
+		writeHandler.lineCount = plh.lineCount
+		writeHandler.batchFilled = n
+		writeHandler.result.Warnings = pr.Warnings() - warnings
+		warnings = pr.Warnings()
+		last := n < plh.batchSize
+		asyncSaveBatch(plh, writeHandler, func() error {
+			return writeBatchToStorage(writeHandler, last)
+		})
+		if last {
+			return nil
+		}
+	}
 }
 
 func PrintThreadInfo(handler *ParseLineHandler, close *CloseFlag, a time.Duration) {
@@ -1592,6 +2015,7 @@ func (mce *MysqlCmdExecutor) LoadLoop(load *tree.Load, dbHandler engine.Database
 			result:               result,
 			maxEntryBytesForCube: ses.Pu.SV.GetCubeMaxEntriesBytes(),
 			skipWriteBatch:       ses.Pu.SV.GetLoadDataSkipWritingBatch(),
+			timeZone:             ses.GetTimeZone(),
 		},
 		threadInfo:                    make(map[int]*ThreadInfo),
 		simdCsvGetParsedLinesChan:     atomic.Value{},
@@ -1630,12 +2054,6 @@ func (mce *MysqlCmdExecutor) LoadLoop(load *tree.Load, dbHandler engine.Database
 	//put closeRef into the executor
 	mce.loadDataClose = handler.closeRef
 
-	handler.simdCsvReader = simdcsv.NewReaderWithOptions(dataFile,
-		rune(load.Fields.Terminated[0]),
-		'#',
-		false,
-		false)
-
 	/*
 		error channel
 	*/
@@ -1649,38 +2067,94 @@ func (mce *MysqlCmdExecutor) LoadLoop(load *tree.Load, dbHandler engine.Database
 		return nil, err
 	}
 
-	wg := sync.WaitGroup{}
-
-	/*
-		read from the output channel of the simdcsv parser, make a batch,
-		deliver it to async routine writing batch
-	*/
-	wg.Add(1)
-	go func() {
-		defer wg.Done()
-		err := handler.getLineOutFromSimdCsvRoutine()
+	compression := external.GetCompressType(load.Compression, load.File)
+	if load.FileFormat == tree.PARQUET && compression != tree.NOCOMPRESS {
+		//the parquet file compresses the pages in itself
+		return nil, fmt.Errorf("the parquet file %s can not be compressed by %s", load.File, compression)
+	}
+	unCompressReader, err := external.GetUnCompressReader(compression, dataFile)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		err := unCompressReader.Close()
 		if err != nil {
-			logutil.Errorf("get line from simdcsv failed. err:%v", err)
-			handler.simdCsvNotiyEventChan <- newNotifyEvent(NOTIFY_EVENT_OUTPUT_SIMDCSV_ERROR, err, nil)
+			logutil.Errorf("close decompressor failed. err:%v", err)
 		}
 	}()
 
-	/*
-		get lines from simdcsv, deliver them to the output channel.
-	*/
-	wg.Add(1)
-	go func() {
-		defer wg.Done()
-		wait_b := time.Now()
-
-		m.Lock()
-		defer m.Unlock()
-		err := handler.simdCsvReader.ReadLoop(getLineOutChan(handler.simdCsvGetParsedLinesChan))
+	switch load.FileFormat {
+	case tree.JSONLINE:
+		handler.simdCsvReader = external.NewJsonLineReader(unCompressReader, getLoadColumnNames(handler))
+	case tree.PARQUET:
+		handler.parquetReader, err = external.NewParquetReader(load.File, getLoadAttributes(handler), handler.timeZone, handler.ignoreFieldError)
 		if err != nil {
-			handler.simdCsvNotiyEventChan <- newNotifyEvent(NOTIFY_EVENT_READ_SIMDCSV_ERROR, err, nil)
+			return nil, err
 		}
-		process_block += time.Since(wait_b)
-	}()
+		defer func() {
+			err := handler.parquetReader.Close()
+			if err != nil {
+				logutil.Errorf("close parquet file failed. err:%v", err)
+			}
+		}()
+		handler.stopParquet = make(chan struct{})
+	default:
+		handler.simdCsvReader = simdcsv.NewReaderWithOptions(unCompressReader,
+			rune(load.Fields.Terminated[0]),
+			'#',
+			false,
+			false)
+	}
+
+	wg := sync.WaitGroup{}
+
+	if handler.parquetReader != nil {
+		/*
+			decode the parquet file into batches, deliver them to async routine writing batch
+		*/
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			wait_b := time.Now()
+			err := handler.getBatchFromParquetRoutine()
+			if err != nil {
+				logutil.Errorf("read parquet file failed. err:%v", err)
+				handler.simdCsvNotiyEventChan <- newNotifyEvent(NOTIFY_EVENT_READ_SIMDCSV_ERROR, err, nil)
+			}
+			process_block += time.Since(wait_b)
+		}()
+	} else {
+		/*
+			read from the output channel of the simdcsv parser, make a batch,
+			deliver it to async routine writing batch
+		*/
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			err := handler.getLineOutFromSimdCsvRoutine()
+			if err != nil {
+				logutil.Errorf("get line from simdcsv failed. err:%v", err)
+				handler.simdCsvNotiyEventChan <- newNotifyEvent(NOTIFY_EVENT_OUTPUT_SIMDCSV_ERROR, err, nil)
+			}
+		}()
+
+		/*
+			get lines from simdcsv, deliver them to the output channel.
+		*/
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			wait_b := time.Now()
+
+			m.Lock()
+			defer m.Unlock()
+			err := handler.simdCsvReader.ReadLoop(getLineOutChan(handler.simdCsvGetParsedLinesChan))
+			if err != nil {
+				handler.simdCsvNotiyEventChan <- newNotifyEvent(NOTIFY_EVENT_READ_SIMDCSV_ERROR, err, nil)
+			}
+			process_block += time.Since(wait_b)
+		}()
+	}
 
 	var statsWg sync.WaitGroup
 	statsWg.Add(1)
@@ -1724,7 +2198,14 @@ func (mce *MysqlCmdExecutor) LoadLoop(load *tree.Load, dbHandler engine.Database
 
 			if quit {
 				//
-				handler.simdCsvReader.Close()
+				if handler.simdCsvReader != nil {
+					handler.simdCsvReader.Close()
+				}
+				if handler.stopParquet != nil {
+					handler.closeOnceStopParquet.Do(func() {
+						close(handler.stopParquet)
+					})
+				}
 				handler.closeOnceGetParsedLinesChan.Do(func() {
 					m.Lock()
 					defer m.Unlock()
@@ -40,6 +40,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
 
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
@@ -166,8 +167,8 @@ func (o *outputQueue) flush() error {
 		return nil
 	}
 	if o.ep.Outfile {
-		if err := exportDataToCSVFile(o); err != nil {
-			logutil.Errorf("export to csv file error %v \n", err)
+		if err := exportDataToFile(o); err != nil {
+			logutil.Errorf("export to file error %v \n", err)
 			return err
 		}
 	} else {
@@ -413,7 +414,7 @@ func getDataFromPipeline(obj interface{}, bat *batch.Batch) error {
 						row[i] = vs.Get(int64(rowIndex))
 					}
 				}
-			case types.T_varchar:
+			case types.T_varchar, types.T_text, types.T_blob:
 				if !nulls.Any(vec.Nsp) { //all data in this column are not null
 					vs := vec.Col.(*types.Bytes)
 					row[i] = vs.Get(int64(rowIndex))
@@ -449,17 +450,82 @@ func getDataFromPipeline(obj interface{}, bat *batch.Batch) error {
 						row[i] = vs[rowIndex]
 					}
 				}
+			case types.T_json:
+				if nulls.Contains(vec.Nsp, uint64(rowIndex)) { //is null
+					row[i] = nil
+				} else {
+					vs := vec.Col.(*types.Bytes)
+					bj, err := bytejson.Unmarshal(vs.Get(int64(rowIndex)))
+					if err != nil {
+						return err
+					}
+					row[i] = bj.String()
+				}
+			case types.T_uuid:
+				if nulls.Contains(vec.Nsp, uint64(rowIndex)) { //is null
+					row[i] = nil
+				} else {
+					vs := vec.Col.([]types.Uuid)
+					row[i] = vs[rowIndex].String()
+				}
+			case types.T_enum:
+				if nulls.Contains(vec.Nsp, uint64(rowIndex)) { //is null
+					row[i] = nil
+				} else {
+					vs := vec.Col.([]uint16)
+					if members := getEnumValues(mrs, i); len(members) > 0 {
+						row[i] = types.EnumString(members, vs[rowIndex])
+					} else {
+						row[i] = vs[rowIndex]
+					}
+				}
+			case types.T_set:
+				if nulls.Contains(vec.Nsp, uint64(rowIndex)) { //is null
+					row[i] = nil
+				} else {
+					vs := vec.Col.([]uint64)
+					if members := getEnumValues(mrs, i); len(members) > 0 {
+						row[i] = types.SetString(members, vs[rowIndex])
+					} else {
+						row[i] = vs[rowIndex]
+					}
+				}
+			case types.T_time:
+				precision := vec.Typ.Precision
+				if !nulls.Any(vec.Nsp) { //all data in this column are not null
+					vs := vec.Col.([]types.Time)
+					row[i] = vs[rowIndex].String2(precision)
+				} else {
+					if nulls.Contains(vec.Nsp, uint64(rowIndex)) { //is null
+						row[i] = nil
+					} else {
+						vs := vec.Col.([]types.Time)
+						row[i] = vs[rowIndex].String2(precision)
+					}
+				}
+			case types.T_year:
+				if !nulls.Any(vec.Nsp) { //all data in this column are not null
+					vs := vec.Col.([]types.YearValue)
+					row[i] = int16(vs[rowIndex])
+				} else {
+					if nulls.Contains(vec.Nsp, uint64(rowIndex)) { //is null
+						row[i] = nil
+					} else {
+						vs := vec.Col.([]types.YearValue)
+						row[i] = int16(vs[rowIndex])
+					}
+				}
 			case types.T_timestamp:
 				precision := vec.Typ.Precision
 				if !nulls.Any(vec.Nsp) { //all data in this column are not null
 					vs := vec.Col.([]types.Timestamp)
-					row[i] = vs[rowIndex].String2(precision)
+					row[i] = vs[rowIndex].String2(ses.GetTimeZone(), precision)
 				} else {
 					if nulls.Contains(vec.Nsp, uint64(rowIndex)) { //is null
 						row[i] = nil
 					} else {
 						vs := vec.Col.([]types.Timestamp)
-						row[i] = vs[rowIndex].String2(precision)
+						row[i] = vs[rowIndex].String2(ses.GetTimeZone(), precision)
 					}
 				}
 			case types.T_decimal64:
@@ -583,6 +649,28 @@ func (mce *MysqlCmdExecutor) handleSelectDatabase(sel *tree.Select) error {
 	return nil
 }
 
+//handle SELECT LAST_INSERT_ID()
+func (mce *MysqlCmdExecutor) handleSelectLastInsertId() error {
+	var err error = nil
+	ses := mce.GetSession()
+	proto := ses.protocol
+
+	col := new(MysqlColumn)
+	col.SetName("last_insert_id()")
+	col.SetColumnType(defines.MYSQL_TYPE_LONGLONG)
+	col.SetSigned(false)
+	ses.Mrs.AddColumn(col)
+	ses.Mrs.AddRow([]interface{}{ses.GetLastInsertId()})
+
+	mer := NewMysqlExecutionResult(0, 0, 0, 0, ses.Mrs)
+	resp := NewResponse(ResultResponse, 0, int(COM_QUERY), mer)
+
+	if err = proto.SendResponse(resp); err != nil {
+		return fmt.Errorf("routine send response failed. error:%v ", err)
+	}
+	return nil
+}
+
 /*
 handle "SELECT @@max_allowed_packet"
 */
@@ -679,6 +767,24 @@ func (mce *MysqlCmdExecutor) handleSelectVariables(v string) error {
 		var data = make([]interface{}, 1)
 		data[0] = "REPEATABLE-READ"
 		ses.Mrs.AddRow(data)
+	} else if v == "time_zone" {
+		col := new(MysqlColumn)
+		col.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+		col.SetName("@@time_zone")
+		ses.Mrs.AddColumn(col)
+
+		var data = make([]interface{}, 1)
+		data[0] = ses.GetTimeZone().String()
+		ses.Mrs.AddRow(data)
+	} else if v == "cte_max_recursion_depth" {
+		col := new(MysqlColumn)
+		col.SetColumnType(defines.MYSQL_TYPE_LONGLONG)
+		col.SetName("@@cte_max_recursion_depth")
+		ses.Mrs.AddColumn(col)
+
+		var data = make([]interface{}, 1)
+		data[0] = ses.GetCteMaxRecursionDepth()
+		ses.Mrs.AddRow(data)
 	} else {
 		return fmt.Errorf("unsupported system variable %s", v)
 	}
@@ -708,7 +814,7 @@ func (mce *MysqlCmdExecutor) handleLoadData(load *tree.Load) error {
 		return fmt.Errorf("LOCAL is unsupported now")
 	}
 
-	if load.Fields == nil || len(load.Fields.Terminated) == 0 {
+	if (load.FileFormat == "" || load.FileFormat == tree.CSV) && (load.Fields == nil || len(load.Fields.Terminated) == 0) {
 		return fmt.Errorf("load need FIELDS TERMINATED BY ")
 	}
 
@@ -862,9 +968,30 @@ func (mce *MysqlCmdExecutor) handleCmdFieldList(tableName string) error {
 /*
 handle setvar
 */
-func (mce *MysqlCmdExecutor) handleSetVar(_ *tree.SetVar) error {
+func (mce *MysqlCmdExecutor) handleSetVar(sv *tree.SetVar) error {
 	var err error = nil
-	proto := mce.GetSession().protocol
+	ses := mce.GetSession()
+	proto := ses.protocol
+
+	if sv != nil {
+		for _, assign := range sv.Assignments {
+			if assign.System && strings.ToLower(assign.Name) == "time_zone" {
+				value := strings.Trim(tree.String(assign.Value, dialect.MYSQL), "'\"")
+				loc, err := parseTimeZone(value)
+				if err != nil {
+					return err
+				}
+				ses.SetTimeZone(loc)
+			} else if assign.System && strings.ToLower(assign.Name) == "cte_max_recursion_depth" {
+				value := strings.Trim(tree.String(assign.Value, dialect.MYSQL), "'\"")
+				depth, err := parseCteMaxRecursionDepth(value)
+				if err != nil {
+					return err
+				}
+				ses.SetCteMaxRecursionDepth(depth)
+			}
+		}
+	}
 
 	resp := NewOkResponse(0, 0, 0, 0, int(COM_QUERY), "")
 	if err = proto.SendResponse(resp); err != nil {
@@ -962,6 +1089,9 @@ func (mce *MysqlCmdExecutor) handleExplainStmt(stmt *tree.ExplainStmt) error {
 	if err != nil {
 		return err
 	}
+	if qry := buildPlan.GetQuery(); qry != nil {
+		plan2.ChooseJoinAlgos(qry)
+	}
 
 	if err != nil {
 		logutil.Errorf("build query plan and optimize failed, error: %v", err)
@@ -1117,6 +1247,10 @@ func (cw *ComputationWrapperImpl) GetAffectedRows() uint64 {
 	return cw.exec.GetAffectedRows()
 }
 
+func (cw *ComputationWrapperImpl) GetLastInsertId() uint64 {
+	return 0
+}
+
 func (cw *ComputationWrapperImpl) Compile(u interface{}, fill func(interface{}, *batch.Batch) error) (interface{}, error) {
 	return cw.exec, cw.exec.Compile(u, fill)
 }
@@ -1128,10 +1262,11 @@ func (cw *ComputationWrapperImpl) Run(ts uint64) error {
 var _ ComputationWrapper = &TxnComputationWrapper{}
 
 type TxnComputationWrapper struct {
-	stmt tree.Statement
-	plan *plan2.Plan
-	proc *process.Process
-	ses  *Session
+	stmt    tree.Statement
+	plan    *plan2.Plan
+	proc    *process.Process
+	ses     *Session
+	compile *compile2.Compile
 }
 
 func InitTxnComputationWrapper(ses *Session, stmt tree.Statement, proc *process.Process) *TxnComputationWrapper {
@@ -1161,13 +1296,24 @@ func (cwft *TxnComputationWrapper) GetColumns() ([]interface{}, error) {
 		if err != nil {
 			return nil, err
 		}
+		c.SetEnumValues(col.Typ.GetEnumValues())
 		columns[i] = c
 	}
 	return columns, err
 }
 
 func (cwft *TxnComputationWrapper) GetAffectedRows() uint64 {
-	return 0
+	if cwft.compile == nil {
+		return 0
+	}
+	return cwft.compile.GetAffectedRows()
+}
+
+func (cwft *TxnComputationWrapper) GetLastInsertId() uint64 {
+	if cwft.compile == nil {
+		return 0
+	}
+	return cwft.compile.GetLastInsertId()
 }
 
 func (cwft *TxnComputationWrapper) Compile(u interface{}, fill func(interface{}, *batch.Batch) error) (interface{}, error) {
@@ -1176,16 +1322,19 @@ func (cwft *TxnComputationWrapper) Compile(u interface{}, fill func(interface{},
 	if err != nil {
 		return nil, err
 	}
+	if qry := cwft.plan.GetQuery(); qry != nil {
+		plan2.ChooseJoinAlgos(qry)
+	}
 
 	cwft.proc.UnixTime = time.Now().UnixNano()
 	txnHandler := cwft.ses.GetTxnHandler()
 	cwft.proc.Snapshot = txnHandler.GetTxn().GetCtx()
-	comp := compile2.New(cwft.ses.GetDatabaseName(), cwft.ses.GetSql(), cwft.ses.GetUserName(), cwft.ses.GetStorage(), cwft.proc)
-	err = comp.Compile(cwft.plan, cwft.ses, fill)
+	cwft.compile = compile2.New(cwft.ses.GetDatabaseName(), cwft.ses.GetSql(), cwft.ses.GetUserName(), cwft.ses.GetStorage(), cwft.proc)
+	err = cwft.compile.Compile(cwft.plan, cwft.ses, fill)
 	if err != nil {
 		return nil, err
 	}
-	return comp, err
+	return cwft.compile, err
 }
 
 func (cwft *TxnComputationWrapper) Run(ts uint64) error {
@@ -1246,6 +1395,9 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) (retErr error) {
 	proc.Lim.Size = ses.Pu.SV.GetProcessLimitationSize()
 	proc.Lim.BatchRows = ses.Pu.SV.GetProcessLimitationBatchRows()
 	proc.Lim.PartitionRows = ses.Pu.SV.GetProcessLimitationPartitionRows()
+	proc.Lim.MaxRecursionDepth = ses.GetCteMaxRecursionDepth()
+	proc.Lim.ExternalDataDir = ses.Pu.SV.GetExternalDataDir()
+	proc.TimeZone = ses.GetTimeZone()
 
 	cws, err := GetComputationWrapper(proto.GetDatabaseName(),
 		sql,
@@ -1316,6 +1468,14 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) (retErr error) {
 									goto handleFailed
 								}
 
+								//next statement
+								goto handleSucceeded
+							} else if strings.ToUpper(un.Parts[0]) == "LAST_INSERT_ID" && len(fe.Exprs) == 0 {
+								err = mce.handleSelectLastInsertId()
+								if err != nil {
+									goto handleFailed
+								}
+
 								//next statement
 								goto handleSucceeded
 							}
@@ -1343,6 +1503,22 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) (retErr error) {
 								goto handleFailed
 							}
 
+							//next statement
+							goto handleSucceeded
+						} else if strings.ToLower(ve.Name) == "time_zone" {
+							err = mce.handleSelectVariables("time_zone")
+							if err != nil {
+								goto handleFailed
+							}
+
+							//next statement
+							goto handleSucceeded
+						} else if strings.ToLower(ve.Name) == "cte_max_recursion_depth" {
+							err = mce.handleSelectVariables("cte_max_recursion_depth")
+							if err != nil {
+								goto handleFailed
+							}
+
 							//next statement
 							goto handleSucceeded
 						}
@@ -1389,8 +1565,9 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) (retErr error) {
 				goto handleFailed
 			}
 		case *tree.Insert:
+			// INSERT ... ON DUPLICATE KEY UPDATE reads the table, so it is executed by the plan
 			_, ok := st.Rows.Select.(*tree.ValuesClause)
-			if ok && usePlan2 {
+			if ok && usePlan2 && len(st.OnDuplicateUpdate) == 0 {
 				selfHandle = true
 				err = mce.handleInsertValues(st, epoch)
 				if err != nil {
@@ -1459,7 +1636,7 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) (retErr error) {
 		switch cw.GetAst().(type) {
 		//produce result set
 		case *tree.Select,
-			*tree.ShowCreateTable, *tree.ShowCreateDatabase, *tree.ShowTables, *tree.ShowDatabases, *tree.ShowColumns,
+			*tree.ShowCreateTable, *tree.ShowCreateView, *tree.ShowCreateDatabase, *tree.ShowTables, *tree.ShowDatabases, *tree.ShowColumns,
 			*tree.ShowProcessList, *tree.ShowErrors, *tree.ShowWarnings, *tree.ShowVariables, *tree.ShowStatus,
 			*tree.ShowIndex,
 			*tree.ExplainFor, *tree.ExplainAnalyze, *tree.ExplainStmt:
@@ -1520,10 +1697,7 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) (retErr error) {
 				goto handleFailed
 			}
 			if ses.ep.Outfile {
-				if err = ses.ep.Writer.Flush(); err != nil {
-					goto handleFailed
-				}
-				if err = ses.ep.File.Close(); err != nil {
+				if err = closeExportFile(ses.ep); err != nil {
 					goto handleFailed
 				}
 			}
@@ -1541,8 +1715,8 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) (retErr error) {
 				goto handleFailed
 			}
 		//just status, no result set
-		case *tree.CreateTable, *tree.DropTable, *tree.CreateDatabase, *tree.DropDatabase,
-			*tree.CreateIndex, *tree.DropIndex,
+		case *tree.CreateTable, *tree.DropTable, *tree.CreateView, *tree.DropView, *tree.CreateDatabase, *tree.DropDatabase,
+			*tree.CreateIndex, *tree.DropIndex, *tree.RefreshMaterializedView,
 			*tree.Insert, *tree.Update,
 			*tree.BeginTransaction, *tree.CommitTransaction, *tree.RollbackTransaction,
 			*tree.SetVar,
@@ -1567,7 +1741,7 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) (retErr error) {
 
 			//record ddl drop xxx after the success
 			switch stmt.(type) {
-			case *tree.DropTable, *tree.DropDatabase,
+			case *tree.DropTable, *tree.DropView, *tree.DropDatabase,
 				*tree.DropIndex, *tree.DropUser, *tree.DropRole:
 				//test ddl
 				pdHook.IncDDLCountAtEpoch(epoch, 1)
@@ -1576,9 +1750,13 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) (retErr error) {
 			/*
 				Step 2: Echo client
 			*/
+			lastInsertId := cw.GetLastInsertId()
+			if lastInsertId != 0 {
+				ses.SetLastInsertId(lastInsertId)
+			}
 			resp := NewOkResponse(
 				cw.GetAffectedRows(),
-				0,
+				lastInsertId,
 				0,
 				0,
 				int(COM_QUERY),
@@ -1767,6 +1945,17 @@ func NewMysqlCmdExecutor() *MysqlCmdExecutor {
 	return &MysqlCmdExecutor{}
 }
 
+// getEnumValues returns the members of the i-th column of the result set,
+// the value is sent as a number if the members are unknown
+func getEnumValues(mrs *MysqlResultSet, i int) []string {
+	if i < len(mrs.Columns) {
+		if col, ok := mrs.Columns[i].(*MysqlColumn); ok {
+			return col.EnumValues()
+		}
+	}
+	return nil
+}
+
 /*
 convert the type in computation engine to the type in mysql.
 */
@@ -1802,8 +1991,32 @@ func convertEngineTypeToMysqlType(engineType types.T, col *MysqlColumn) error {
 		col.SetColumnType(defines.MYSQL_TYPE_STRING)
 	case types.T_varchar:
 		col.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+	case types.T_text:
+		col.SetColumnType(defines.MYSQL_TYPE_BLOB)
+		col.SetFlag(col.Flag() | uint16(defines.BLOB_FLAG))
+	case types.T_blob:
+		col.SetColumnType(defines.MYSQL_TYPE_BLOB)
+		col.SetFlag(col.Flag() | uint16(defines.BLOB_FLAG|defines.BINARY_FLAG))
+		col.SetCharset(binaryCollationID)
+	case types.T_json:
+		col.SetColumnType(defines.MYSQL_TYPE_JSON)
+		col.SetFlag(col.Flag() | uint16(defines.BLOB_FLAG|defines.BINARY_FLAG))
+		col.SetCharset(binaryCollationID)
+	case types.T_uuid:
+		col.SetColumnType(defines.MYSQL_TYPE_STRING)
+	case types.T_enum:
+		col.SetColumnType(defines.MYSQL_TYPE_STRING)
+		col.SetFlag(col.Flag() | uint16(defines.ENUM_FLAG))
+	case types.T_set:
+		col.SetColumnType(defines.MYSQL_TYPE_STRING)
+		col.SetFlag(col.Flag() | uint16(defines.SET_FLAG))
 	case types.T_date:
 		col.SetColumnType(defines.MYSQL_TYPE_DATE)
+	case types.T_time:
+		col.SetColumnType(defines.MYSQL_TYPE_TIME)
+	case types.T_year:
+		col.SetColumnType(defines.MYSQL_TYPE_YEAR)
+		col.SetFlag(col.Flag() | uint16(defines.UNSIGNED_FLAG|defines.ZEROFILL_FLAG))
 	case types.T_datetime:
 		col.SetColumnType(defines.MYSQL_TYPE_DATETIME)
 	case types.T_timestamp:
@@ -404,6 +404,7 @@ func Test_mce(t *testing.T) {
 		create_1.EXPECT().Compile(gomock.Any(), gomock.Any()).Return(runner, nil).AnyTimes()
 		create_1.EXPECT().Run(gomock.Any()).Return(nil).AnyTimes()
 		create_1.EXPECT().GetAffectedRows().Return(uint64(0)).AnyTimes()
+		create_1.EXPECT().GetLastInsertId().Return(uint64(0)).AnyTimes()
 
 		select_1 := mock_frontend.NewMockComputationWrapper(ctrl)
 		stmts, err = parsers.Parse(dialect.MYSQL, "select a,b,c from A")
@@ -457,6 +458,7 @@ func Test_mce(t *testing.T) {
 			select_2.EXPECT().Compile(gomock.Any(), gomock.Any()).Return(runner, nil).AnyTimes()
 			select_2.EXPECT().Run(gomock.Any()).Return(nil).AnyTimes()
 			select_2.EXPECT().GetAffectedRows().Return(uint64(0)).AnyTimes()
+			select_2.EXPECT().GetLastInsertId().Return(uint64(0)).AnyTimes()
 			cws = append(cws, select_2)
 		}
 
@@ -813,6 +815,8 @@ func Test_typeconvert(t *testing.T) {
 			types.T_varchar,
 			types.T_date,
 			types.T_datetime,
+			types.T_text,
+			types.T_blob,
 		}
 
 		type kase struct {
@@ -834,6 +838,8 @@ func Test_typeconvert(t *testing.T) {
 			{tp: defines.MYSQL_TYPE_VARCHAR, signed: true},
 			{tp: defines.MYSQL_TYPE_DATE, signed: true},
 			{tp: defines.MYSQL_TYPE_DATETIME, signed: true},
+			{tp: defines.MYSQL_TYPE_BLOB, signed: true},
+			{tp: defines.MYSQL_TYPE_BLOB, signed: true},
 		}
 
 		convey.So(len(input), convey.ShouldEqual, len(output))
@@ -846,6 +852,11 @@ func Test_typeconvert(t *testing.T) {
 			convey.So(col.IsSigned() && output[i].signed ||
 				!col.IsSigned() && !output[i].signed, convey.ShouldBeTrue)
 		}
+
+		col := &MysqlColumn{}
+		convey.So(convertEngineTypeToMysqlType(types.T_blob, col), convey.ShouldBeNil)
+		convey.So(col.Charset(), convey.ShouldEqual, binaryCollationID)
+		convey.So(uint32(col.Flag())&defines.BINARY_FLAG, convey.ShouldNotEqual, 0)
 	})
 }
 
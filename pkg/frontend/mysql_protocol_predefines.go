@@ -303,6 +303,9 @@ var collationID2CharsetAndName = map[int]charsetCollationName{
 	309: {"utf8mb4", "utf8mb4_0900_bin"},
 }
 
+//the collation id of the binary strings like BLOB
+const binaryCollationID uint16 = 63
+
 //mysql client capabilities
 const (
 	CLIENT_LONG_PASSWORD                  uint32 = 0x00000001
@@ -0,0 +1,179 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/xitongsys/parquet-go/parquet"
+	"github.com/xitongsys/parquet-go/writer"
+)
+
+var errParquetMaxFileSize = errors.New("max_file_size is unsupported for the parquet format")
+
+/*
+newParquetWriter makes the parquet writer of the exported file. The schema of
+the parquet file is decided by the columns of the result set.
+*/
+func newParquetWriter(ep *tree.ExportParam, mrs *MysqlResultSet) (*writer.CSVWriter, error) {
+	if ep.MaxFileSize != 0 {
+		return nil, errParquetMaxFileSize
+	}
+	md := make([]string, len(mrs.Columns))
+	for i, col := range mrs.Columns {
+		mysqlColumn, ok := col.(*MysqlColumn)
+		if !ok {
+			return nil, fmt.Errorf("sendColumn need MysqlColumn")
+		}
+		md[i] = fmt.Sprintf("name=%s, %s, repetitiontype=OPTIONAL", mysqlColumn.Name(), parquetTypeOfColumn(mysqlColumn))
+	}
+	pw, err := writer.NewCSVWriterFromWriter(md, ep.Writer, 1)
+	if err != nil {
+		return nil, err
+	}
+	switch ep.Compression {
+	case "", tree.AUTO:
+		//the pages are compressed by snappy as default
+		pw.CompressionType = parquet.CompressionCodec_SNAPPY
+	case tree.NOCOMPRESS:
+		pw.CompressionType = parquet.CompressionCodec_UNCOMPRESSED
+	case tree.GZIP:
+		pw.CompressionType = parquet.CompressionCodec_GZIP
+	case tree.ZSTD:
+		pw.CompressionType = parquet.CompressionCodec_ZSTD
+	case tree.LZ4:
+		pw.CompressionType = parquet.CompressionCodec_LZ4
+	default:
+		return nil, fmt.Errorf("unsupported compression %s of the parquet file", ep.Compression)
+	}
+	return pw, nil
+}
+
+func parquetTypeOfColumn(col *MysqlColumn) string {
+	unsigned := uint32(col.Flag())&defines.UNSIGNED_FLAG != 0
+	switch col.ColumnType() {
+	case defines.MYSQL_TYPE_TINY, defines.MYSQL_TYPE_SHORT, defines.MYSQL_TYPE_INT24, defines.MYSQL_TYPE_YEAR:
+		return "type=INT32"
+	case defines.MYSQL_TYPE_LONG:
+		if unsigned {
+			return "type=INT64"
+		}
+		return "type=INT32"
+	case defines.MYSQL_TYPE_LONGLONG:
+		if unsigned {
+			return "type=INT64, convertedtype=UINT_64"
+		}
+		return "type=INT64"
+	case defines.MYSQL_TYPE_FLOAT:
+		return "type=FLOAT"
+	case defines.MYSQL_TYPE_DOUBLE:
+		return "type=DOUBLE"
+	case defines.MYSQL_TYPE_DATE:
+		return "type=INT32, convertedtype=DATE"
+	case defines.MYSQL_TYPE_DATETIME:
+		return "type=INT64, convertedtype=TIMESTAMP_MICROS"
+	}
+	return "type=BYTE_ARRAY, convertedtype=UTF8"
+}
+
+/*
+exportDataToParquetFile appends the row into the parquet writer.
+*/
+func exportDataToParquetFile(oq *outputQueue) error {
+	pw, ok := oq.ep.FormatWriter.(*writer.CSVWriter)
+	if !ok {
+		return fmt.Errorf("the parquet writer is not opened")
+	}
+	rec := make([]interface{}, oq.mrs.GetColumnCount())
+	for i := range rec {
+		value, err := oq.mrs.GetValue(0, uint64(i))
+		if err != nil {
+			return err
+		}
+		if value == nil {
+			continue
+		}
+		element := pw.SchemaHandler.SchemaElements[i+1]
+		switch element.GetType() {
+		case parquet.Type_INT32:
+			if d, ok := value.(types.Date); ok {
+				rec[i] = d.DaysSinceUnixEpoch()
+				continue
+			}
+			v, err := oq.mrs.GetInt64(0, uint64(i))
+			if err != nil {
+				return err
+			}
+			rec[i] = int32(v)
+		case parquet.Type_INT64:
+			if dt, ok := value.(types.Datetime); ok {
+				rec[i] = dt.UnixMicro()
+				continue
+			}
+			if element.GetConvertedType() == parquet.ConvertedType_UINT_64 || isUnsignedValue(value) {
+				v, err := oq.mrs.GetUint64(0, uint64(i))
+				if err != nil {
+					return err
+				}
+				rec[i] = int64(v)
+				continue
+			}
+			v, err := oq.mrs.GetInt64(0, uint64(i))
+			if err != nil {
+				return err
+			}
+			rec[i] = v
+		case parquet.Type_FLOAT:
+			v, err := oq.mrs.GetFloat64(0, uint64(i))
+			if err != nil {
+				return err
+			}
+			rec[i] = float32(v)
+		case parquet.Type_DOUBLE:
+			v, err := oq.mrs.GetFloat64(0, uint64(i))
+			if err != nil {
+				return err
+			}
+			rec[i] = v
+		default:
+			if s, ok := value.(fmt.Stringer); ok {
+				rec[i] = s.String()
+				continue
+			}
+			v, err := oq.mrs.GetString(0, uint64(i))
+			if err != nil {
+				return err
+			}
+			rec[i] = v
+		}
+	}
+	if err := pw.Write(rec); err != nil {
+		return err
+	}
+	oq.ep.Rows++
+	return nil
+}
+
+func isUnsignedValue(v interface{}) bool {
+	switch v.(type) {
+	case uint8, uint16, uint32, uint64:
+		return true
+	}
+	return false
+}
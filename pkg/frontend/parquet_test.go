@@ -0,0 +1,123 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"bufio"
+	"io"
+	"path/filepath"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/external"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/smartystreets/goconvey/convey"
+	"github.com/xitongsys/parquet-go/parquet"
+)
+
+func Test_parquetExportAndLoad(t *testing.T) {
+	convey.Convey("export parquet file and read it back", t, func() {
+		mrs := &MysqlResultSet{}
+		names := []string{"a", "b", "c", "d", "e"}
+		colTypes := []uint8{defines.MYSQL_TYPE_LONG, defines.MYSQL_TYPE_LONGLONG, defines.MYSQL_TYPE_DOUBLE,
+			defines.MYSQL_TYPE_VARCHAR, defines.MYSQL_TYPE_DATE}
+		for i := range names {
+			col := new(MysqlColumn)
+			col.SetName(names[i])
+			col.SetColumnType(colTypes[i])
+			mrs.AddColumn(col)
+		}
+		date, _ := types.ParseDate("2022-05-01")
+		rows := [][]interface{}{
+			{int32(1), int64(-2), float64(1.5), []byte("abc"), date},
+			{int32(3), nil, float64(2.5), []byte("xyz"), nil},
+		}
+
+		ep := &tree.ExportParam{
+			Outfile:        true,
+			FilePath:       filepath.Join(t.TempDir(), "export.parquet"),
+			Lines:          &tree.Lines{TerminatedBy: "\n"},
+			Fields:         &tree.Fields{Terminated: ","},
+			FileFormat:     tree.PARQUET,
+			DefaultBufSize: 1,
+		}
+		initExportFileParam(ep, mrs)
+		convey.So(openNewFile(ep, mrs), convey.ShouldBeNil)
+		for _, row := range rows {
+			oq := &outputQueue{mrs: &MysqlResultSet{Columns: mrs.Columns, Data: [][]interface{}{row}}, ep: ep}
+			convey.So(exportDataToFile(oq), convey.ShouldBeNil)
+		}
+		convey.So(closeExportFile(ep), convey.ShouldBeNil)
+
+		attrs := []*engine.Attribute{
+			{Name: "E", Type: types.Type{Oid: types.T_date}},
+			{Name: "d", Type: types.Type{Oid: types.T_varchar, Width: 10}},
+			nil,
+			{Name: "a", Type: types.Type{Oid: types.T_int64}},
+			{Name: "b", Type: types.Type{Oid: types.T_float64}},
+			{Name: "x", Type: types.Type{Oid: types.T_int32}},
+		}
+		pr, err := external.NewParquetReader(ep.FilePath, attrs, nil, false)
+		convey.So(err, convey.ShouldBeNil)
+		defer pr.Close()
+		vecs := make([]*vector.Vector, len(attrs))
+		for i, attr := range attrs {
+			if attr != nil {
+				vecs[i] = vector.New(attr.Type)
+			}
+		}
+		n, err := pr.Read(vecs, 0, 10)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(n, convey.ShouldEqual, 2)
+		convey.So(vecs[0].Col, convey.ShouldResemble, []types.Date{date, 0})
+		convey.So(nulls.Contains(vecs[0].Nsp, 1), convey.ShouldBeTrue)
+		convey.So(vecs[1].Col.(*types.Bytes).Get(1), convey.ShouldResemble, []byte("xyz"))
+		convey.So(vecs[3].Col, convey.ShouldResemble, []int64{1, 3})
+		convey.So(vecs[4].Col, convey.ShouldResemble, []float64{-2, 0})
+		convey.So(nulls.Contains(vecs[4].Nsp, 1), convey.ShouldBeTrue)
+		convey.So(nulls.Contains(vecs[5].Nsp, 0) && nulls.Contains(vecs[5].Nsp, 1), convey.ShouldBeTrue)
+		n, err = pr.Read(vecs, 0, 10)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(n, convey.ShouldEqual, 0)
+	})
+
+	convey.Convey("parquet does not support max_file_size", t, func() {
+		ep := &tree.ExportParam{MaxFileSize: 1024}
+		_, err := newParquetWriter(ep, &MysqlResultSet{})
+		convey.So(err, convey.ShouldEqual, errParquetMaxFileSize)
+	})
+
+	convey.Convey("parquet compressions", t, func() {
+		for _, c := range []struct {
+			compression string
+			codec       parquet.CompressionCodec
+		}{
+			{tree.AUTO, parquet.CompressionCodec_SNAPPY},
+			{tree.NOCOMPRESS, parquet.CompressionCodec_UNCOMPRESSED},
+			{tree.ZSTD, parquet.CompressionCodec_ZSTD},
+		} {
+			ep := &tree.ExportParam{Compression: c.compression, Writer: bufio.NewWriter(io.Discard)}
+			pw, err := newParquetWriter(ep, &MysqlResultSet{})
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(pw.CompressionType, convey.ShouldEqual, c.codec)
+		}
+		_, err := newParquetWriter(&tree.ExportParam{Compression: "bz2", Writer: bufio.NewWriter(io.Discard)}, &MysqlResultSet{})
+		convey.So(err, convey.ShouldNotBeNil)
+	})
+}
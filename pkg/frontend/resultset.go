@@ -128,6 +128,17 @@ type MysqlColumn struct {
 
 	//default value
 	defaultValue []byte
+
+	//the members of an enum or set column
+	enumValues []string
+}
+
+func (mc *MysqlColumn) EnumValues() []string {
+	return mc.enumValues
+}
+
+func (mc *MysqlColumn) SetEnumValues(enumValues []string) {
+	mc.enumValues = enumValues
 }
 
 func (mc *MysqlColumn) DefaultValue() []byte {
@@ -26,6 +26,8 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/moengine"
 	"github.com/matrixorigin/matrixone/pkg/vm/mempool"
 	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"time"
 )
 
 var (
@@ -166,6 +168,15 @@ type Session struct {
 	txnCompileCtx *TxnCompilerContext
 	storage       engine.Engine
 	sql           string
+
+	//the time zone of the session, set by "set time_zone = xxx"
+	timeZone *time.Location
+
+	//the max iterations of a recursive cte, set by "set cte_max_recursion_depth = xxx"
+	cteMaxRecursionDepth int64
+
+	//the first auto increment value generated by the last insert, returned by LAST_INSERT_ID()
+	lastInsertId uint64
 }
 
 func NewSession(proto Protocol, pdHook *PDCallbackImpl, gm *guest.Mmu, mp *mempool.Mempool, PU *config.ParameterUnit) *Session {
@@ -185,6 +196,9 @@ func NewSession(proto Protocol, pdHook *PDCallbackImpl, gm *guest.Mmu, mp *mempo
 		//TODO:fix database name after the catalog is ready
 		txnCompileCtx: InitTxnCompilerContext(txnHandler, proto.GetDatabaseName()),
 		storage:       config.StorageEngine,
+		timeZone:      time.Local,
+
+		cteMaxRecursionDepth: process.DefaultMaxRecursionDepth,
 	}
 }
 
@@ -230,6 +244,38 @@ func (ses *Session) GetUserName() string {
 	return ses.protocol.GetUserName()
 }
 
+// GetTimeZone returns the time zone of the session, timestamp values are
+// converted from and to it. It is the local time zone of the server by default.
+func (ses *Session) GetTimeZone() *time.Location {
+	if ses.timeZone == nil {
+		return time.Local
+	}
+	return ses.timeZone
+}
+
+func (ses *Session) SetTimeZone(loc *time.Location) {
+	ses.timeZone = loc
+}
+
+// GetCteMaxRecursionDepth returns the max iterations of a recursive cte
+func (ses *Session) GetCteMaxRecursionDepth() int64 {
+	return ses.cteMaxRecursionDepth
+}
+
+func (ses *Session) SetCteMaxRecursionDepth(depth int64) {
+	ses.cteMaxRecursionDepth = depth
+}
+
+// GetLastInsertId returns the first auto increment value generated by the last insert
+// of the session which generated any, it is 0 if there is no one.
+func (ses *Session) GetLastInsertId() uint64 {
+	return ses.lastInsertId
+}
+
+func (ses *Session) SetLastInsertId(id uint64) {
+	ses.lastInsertId = id
+}
+
 func (th *TxnHandler) GetStorage() engine.Engine {
 	return th.storage
 }
@@ -534,20 +580,57 @@ func (tcc *TxnCompilerContext) Resolve(dbName string, tableName string) (*plan2.
 	engineDefs := table.TableDefs(tcc.txnHandler.GetTxn().GetCtx())
 
 	var defs []*plan2.ColDef
+	var tableDefs []*plan.TableDef_DefType
 	for _, def := range engineDefs {
+		if propertiesDef, ok := def.(*engine.PropertiesDef); ok {
+			properties := make([]*plan.Property, len(propertiesDef.Properties))
+			for i, property := range propertiesDef.Properties {
+				properties[i] = &plan.Property{
+					Key:   property.Key,
+					Value: property.Value,
+				}
+			}
+			tableDefs = append(tableDefs, &plan.TableDef_DefType{
+				Def: &plan.TableDef_DefType_Properties{
+					Properties: &plan.PropertiesDef{
+						Properties: properties,
+					},
+				},
+			})
+		}
 		if attr, ok := def.(*engine.AttributeDef); ok {
 			defs = append(defs, &plan2.ColDef{
 				Name: attr.Attr.Name,
 				Typ: &plan2.Type{
-					Id:        plan.Type_TypeId(attr.Attr.Type.Oid),
-					Width:     attr.Attr.Type.Width,
-					Precision: attr.Attr.Type.Precision,
+					Id:         plan.Type_TypeId(attr.Attr.Type.Oid),
+					Width:      attr.Attr.Type.Width,
+					Precision:  attr.Attr.Type.Precision,
+					Scale:      attr.Attr.Type.Scale,
+					Size:       attr.Attr.Type.Size,
+					EnumValues: attr.Attr.EnumValues,
+				},
+				Default: &plan.DefaultExpr{
+					Exist:  attr.Attr.Default.Exist,
+					Value:  plan2.ConvertToPlanValue(attr.Attr.Default.Value),
+					IsNull: attr.Attr.Default.IsNull,
 				},
-				Primary: attr.Attr.Primary,
+				Primary:       attr.Attr.Primary,
+				AutoIncrement: attr.Attr.AutoIncrement,
 			})
 		}
 	}
 
+	// the scans of a sorted table read the rows in the order of the primary key
+	if rel, ok := table.(engine.SortedRelation); ok && rel.Sorted(tcc.txnHandler.GetTxn().GetCtx()) {
+		tableDefs = append(tableDefs, &plan.TableDef_DefType{
+			Def: &plan.TableDef_DefType_Properties{
+				Properties: &plan.PropertiesDef{
+					Properties: []*plan.Property{{Key: plan2.SortedKey, Value: "true"}},
+				},
+			},
+		})
+	}
+
 	//convert
 	obj := &plan2.ObjectRef{
 		SchemaName: dbName,
@@ -557,10 +640,22 @@ func (tcc *TxnCompilerContext) Resolve(dbName string, tableName string) (*plan2.
 	tableDef := &plan2.TableDef{
 		Name: tableName,
 		Cols: defs,
+		Defs: tableDefs,
 	}
 	return obj, tableDef
 }
 
 func (tcc *TxnCompilerContext) Cost(obj *plan2.ObjectRef, e *plan2.Expr) *plan2.Cost {
-	return &plan2.Cost{}
+	if obj == nil {
+		return &plan2.Cost{}
+	}
+	db, err := tcc.txnHandler.GetStorage().Database(obj.SchemaName, tcc.txnHandler.GetTxn().GetCtx())
+	if err != nil {
+		return &plan2.Cost{}
+	}
+	table, err := db.Relation(obj.ObjName, tcc.txnHandler.GetTxn().GetCtx())
+	if err != nil {
+		return &plan2.Cost{}
+	}
+	return &plan2.Cost{Card: float64(table.Rows())}
 }
@@ -10,6 +10,7 @@ import (
 	roaring64 "github.com/RoaringBitmap/roaring/roaring64"
 	gomock "github.com/golang/mock/gomock"
 	batch "github.com/matrixorigin/matrixone/pkg/container/batch"
+	vector "github.com/matrixorigin/matrixone/pkg/container/vector"
 	extend "github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
 	engine "github.com/matrixorigin/matrixone/pkg/vm/engine"
 )
@@ -163,6 +164,20 @@ func (mr *MockRelationMockRecorder) DelTableDef(arg0, arg1, arg2 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DelTableDef", reflect.TypeOf((*MockRelation)(nil).DelTableDef), arg0, arg1, arg2)
 }
 
+// Delete mocks base method.
+func (m *MockRelation) Delete(arg0 uint64, arg1 *vector.Vector, arg2 string, arg3 engine.Snapshot) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockRelationMockRecorder) Delete(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRelation)(nil).Delete), arg0, arg1, arg2, arg3)
+}
+
 // GetPriKeyOrHideKey mocks base method.
 func (m *MockRelation) GetPriKeyOrHideKey(arg0 engine.Snapshot) ([]engine.Attribute, bool) {
 	m.ctrl.T.Helper()
@@ -262,6 +277,20 @@ func (mr *MockRelationMockRecorder) TableDefs(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TableDefs", reflect.TypeOf((*MockRelation)(nil).TableDefs), arg0)
 }
 
+// Update mocks base method.
+func (m *MockRelation) Update(arg0 uint64, arg1 *batch.Batch, arg2 engine.Snapshot) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockRelationMockRecorder) Update(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockRelation)(nil).Update), arg0, arg1, arg2)
+}
+
 // Write mocks base method.
 func (m *MockRelation) Write(arg0 uint64, arg1 *batch.Batch, arg2 engine.Snapshot) error {
 	m.ctrl.T.Helper()
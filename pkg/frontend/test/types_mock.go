@@ -101,6 +101,20 @@ func (mr *MockComputationWrapperMockRecorder) GetAffectedRows() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAffectedRows", reflect.TypeOf((*MockComputationWrapper)(nil).GetAffectedRows))
 }
 
+// GetLastInsertId mocks base method.
+func (m *MockComputationWrapper) GetLastInsertId() uint64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLastInsertId")
+	ret0, _ := ret[0].(uint64)
+	return ret0
+}
+
+// GetLastInsertId indicates an expected call of GetLastInsertId.
+func (mr *MockComputationWrapperMockRecorder) GetLastInsertId() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastInsertId", reflect.TypeOf((*MockComputationWrapper)(nil).GetLastInsertId))
+}
+
 // GetAst mocks base method.
 func (m *MockComputationWrapper) GetAst() tree.Statement {
 	m.ctrl.T.Helper()
@@ -20,5 +20,7 @@ type ComputationWrapper interface {
 
 	GetAffectedRows() uint64
 
+	GetLastInsertId() uint64
+
 	Compile(u interface{}, fill func(interface{}, *batch.Batch) error) (interface{}, error)
 }
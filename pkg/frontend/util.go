@@ -502,7 +502,7 @@ func AllocateBatchBasedOnEngineAttributeDefinition(attributeDefs []*engine.Attri
 		case types.T_float64:
 			vec.Data = make([]byte, rowCount*int(toTypesType(types.T_float64).Size))
 			vec.Col = encoding.DecodeFloat64Slice(vec.Data)
-		case types.T_char, types.T_varchar:
+		case types.T_char, types.T_varchar, types.T_text, types.T_blob:
 			vBytes := &types.Bytes{
 				Offsets: make([]uint32, rowCount),
 				Lengths: make([]uint32, rowCount),
@@ -656,7 +656,7 @@ func FillBatchWithData(data [][]string, batch *batch.Batch) {
 					}
 					cols[rowIdx] = d
 				}
-			case types.T_char, types.T_varchar:
+			case types.T_char, types.T_varchar, types.T_text, types.T_blob:
 				vBytes := vec.Col.(*types.Bytes)
 				if isNullOrEmpty {
 					nulls.Add(vec.Nsp, uint64(rowIdx))
@@ -838,7 +838,7 @@ func FormatLineInBatch(bat *batch.Batch, rowIndex int) []string {
 					row[i] = string(vs.Get(int64(rowIndex)))
 				}
 			}
-		case types.T_varchar:
+		case types.T_varchar, types.T_text, types.T_blob:
 			if !nulls.Any(vec.Nsp) { //all data in this column are not null
 				vs := vec.Col.(*types.Bytes)
 				row[i] = string(vs.Get(int64(rowIndex)))
@@ -881,3 +881,23 @@ func FormatLineInBatch(bat *batch.Batch, rowIndex int) []string {
 	}
 	return res
 }
+
+// parseTimeZone parses the value of the system variable time_zone.
+// It can be 'SYSTEM', an offset from UTC like '+08:00', or a named time zone like 'Asia/Shanghai'.
+func parseTimeZone(name string) (*time.Location, error) {
+	loc, err := types.ParseTimeZone(name)
+	if err != nil {
+		return nil, NewMysqlError(ER_UNKNOWN_TIME_ZONE, name)
+	}
+	return loc, nil
+}
+
+// parseCteMaxRecursionDepth parses the value of the system variable cte_max_recursion_depth,
+// it is an integer from 0 to 4294967295.
+func parseCteMaxRecursionDepth(value string) (int64, error) {
+	depth, err := strconv.ParseUint(value, 10, 32)
+	if err != nil {
+		return 0, NewMysqlError(ER_WRONG_VALUE_FOR_VAR, "cte_max_recursion_depth", value)
+	}
+	return int64(depth), nil
+}
@@ -144,3 +144,54 @@ func Test_makedebuginfo(t *testing.T) {
 			6, 3)
 	})
 }
+
+func Test_parseTimeZone(t *testing.T) {
+	cases := [...]struct {
+		name   string
+		offset int
+		noerr  bool
+	}{
+		{"SYSTEM", 0, true},
+		{"+08:00", 8 * 3600, true},
+		{"-05:30", -(5*3600 + 30*60), true},
+		{"+14:00", 14 * 3600, true},
+		{"+14:01", 0, false},
+		{"-14:00", 0, false},
+		{"+8", 0, false},
+		{"+08:60", 0, false},
+		{"UTC", 0, true},
+		{"No/Such_Zone", 0, false},
+		{"", 0, false},
+	}
+
+	for _, c := range cases {
+		loc, err := parseTimeZone(c.name)
+		require.True(t, (err == nil) == c.noerr, c.name)
+		if err != nil || c.name == "SYSTEM" {
+			continue
+		}
+		_, offset := time.Date(2022, 1, 1, 0, 0, 0, 0, loc).Zone()
+		require.Equal(t, c.offset, offset, c.name)
+	}
+}
+
+func Test_parseCteMaxRecursionDepth(t *testing.T) {
+	cases := [...]struct {
+		value string
+		depth int64
+		noerr bool
+	}{
+		{"0", 0, true},
+		{"1000", 1000, true},
+		{"4294967295", 4294967295, true},
+		{"4294967296", 0, false},
+		{"-1", 0, false},
+		{"abc", 0, false},
+	}
+
+	for _, c := range cases {
+		depth, err := parseCteMaxRecursionDepth(c.value)
+		require.True(t, (err == nil) == c.noerr, c.value)
+		require.Equal(t, c.depth, depth, c.value)
+	}
+}
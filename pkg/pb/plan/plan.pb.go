@@ -23,8 +23,10 @@ const (
 type CompressType int32
 
 const (
-	CompressType_None CompressType = 0
-	CompressType_Lz4  CompressType = 1
+	CompressType_None   CompressType = 0
+	CompressType_Lz4    CompressType = 1
+	CompressType_Zstd   CompressType = 2
+	CompressType_Snappy CompressType = 3
 )
 
 // Enum value maps for CompressType.
@@ -32,10 +34,14 @@ var (
 	CompressType_name = map[int32]string{
 		0: "None",
 		1: "Lz4",
+		2: "Zstd",
+		3: "Snappy",
 	}
 	CompressType_value = map[string]int32{
-		"None": 0,
-		"Lz4":  1,
+		"None":   0,
+		"Lz4":    1,
+		"Zstd":   2,
+		"Snappy": 3,
 	}
 )
 
@@ -66,6 +72,62 @@ func (CompressType) EnumDescriptor() ([]byte, []int) {
 	return file_plan_proto_rawDescGZIP(), []int{0}
 }
 
+// the lightweight encoding applied to the column before the compression
+type EncodingType int32
+
+const (
+	EncodingType_Plain EncodingType = 0
+	EncodingType_Dict  EncodingType = 1
+	EncodingType_Rle   EncodingType = 2
+	EncodingType_Delta EncodingType = 3
+	EncodingType_For   EncodingType = 4
+)
+
+// Enum value maps for EncodingType.
+var (
+	EncodingType_name = map[int32]string{
+		0: "Plain",
+		1: "Dict",
+		2: "Rle",
+		3: "Delta",
+		4: "For",
+	}
+	EncodingType_value = map[string]int32{
+		"Plain": 0,
+		"Dict":  1,
+		"Rle":   2,
+		"Delta": 3,
+		"For":   4,
+	}
+)
+
+func (x EncodingType) Enum() *EncodingType {
+	p := new(EncodingType)
+	*p = x
+	return p
+}
+
+func (x EncodingType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EncodingType) Descriptor() protoreflect.EnumDescriptor {
+	return file_plan_proto_enumTypes[1].Descriptor()
+}
+
+func (EncodingType) Type() protoreflect.EnumType {
+	return &file_plan_proto_enumTypes[1]
+}
+
+func (x EncodingType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EncodingType.Descriptor instead.
+func (EncodingType) EnumDescriptor() ([]byte, []int) {
+	return file_plan_proto_rawDescGZIP(), []int{1}
+}
+
 type TransationCompletionType int32
 
 const (
@@ -99,11 +161,11 @@ func (x TransationCompletionType) String() string {
 }
 
 func (TransationCompletionType) Descriptor() protoreflect.EnumDescriptor {
-	return file_plan_proto_enumTypes[1].Descriptor()
+	return file_plan_proto_enumTypes[2].Descriptor()
 }
 
 func (TransationCompletionType) Type() protoreflect.EnumType {
-	return &file_plan_proto_enumTypes[1]
+	return &file_plan_proto_enumTypes[2]
 }
 
 func (x TransationCompletionType) Number() protoreflect.EnumNumber {
@@ -112,7 +174,7 @@ func (x TransationCompletionType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use TransationCompletionType.Descriptor instead.
 func (TransationCompletionType) EnumDescriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{1}
+	return file_plan_proto_rawDescGZIP(), []int{2}
 }
 
 type Type_TypeId int32
@@ -154,13 +216,19 @@ const (
 	Type_DATETIME  Type_TypeId = 52
 	Type_TIMESTAMP Type_TypeId = 53
 	Type_INTERVAL  Type_TypeId = 54
+	Type_YEAR      Type_TypeId = 55
 	Type_ANYTIME   Type_TypeId = 59
 	// Strings and binaries
 	Type_CHAR      Type_TypeId = 60
 	Type_VARCHAR   Type_TypeId = 61
 	Type_JSON      Type_TypeId = 62
+	Type_TEXT      Type_TypeId = 63
 	Type_BINARY    Type_TypeId = 70
 	Type_VARBINARY Type_TypeId = 71
+	Type_BLOB      Type_TypeId = 72
+	// Enumerations, stored as the index or the bitmap of the members
+	Type_ENUM Type_TypeId = 80
+	Type_SET  Type_TypeId = 81
 	// Special
 	Type_ARRAY      Type_TypeId = 90
 	Type_FLEXBUFFER Type_TypeId = 91
@@ -208,12 +276,17 @@ var (
 		52:  "DATETIME",
 		53:  "TIMESTAMP",
 		54:  "INTERVAL",
+		55:  "YEAR",
 		59:  "ANYTIME",
 		60:  "CHAR",
 		61:  "VARCHAR",
 		62:  "JSON",
+		63:  "TEXT",
 		70:  "BINARY",
 		71:  "VARBINARY",
+		72:  "BLOB",
+		80:  "ENUM",
+		81:  "SET",
 		90:  "ARRAY",
 		91:  "FLEXBUFFER",
 		100: "BYTEA8",
@@ -255,12 +328,17 @@ var (
 		"DATETIME":   52,
 		"TIMESTAMP":  53,
 		"INTERVAL":   54,
+		"YEAR":       55,
 		"ANYTIME":    59,
 		"CHAR":       60,
 		"VARCHAR":    61,
 		"JSON":       62,
+		"TEXT":       63,
 		"BINARY":     70,
 		"VARBINARY":  71,
+		"BLOB":       72,
+		"ENUM":       80,
+		"SET":        81,
 		"ARRAY":      90,
 		"FLEXBUFFER": 91,
 		"BYTEA8":     100,
@@ -282,11 +360,11 @@ func (x Type_TypeId) String() string {
 }
 
 func (Type_TypeId) Descriptor() protoreflect.EnumDescriptor {
-	return file_plan_proto_enumTypes[2].Descriptor()
+	return file_plan_proto_enumTypes[3].Descriptor()
 }
 
 func (Type_TypeId) Type() protoreflect.EnumType {
-	return &file_plan_proto_enumTypes[2]
+	return &file_plan_proto_enumTypes[3]
 }
 
 func (x Type_TypeId) Number() protoreflect.EnumNumber {
@@ -359,11 +437,11 @@ func (x Function_FuncFlag) String() string {
 }
 
 func (Function_FuncFlag) Descriptor() protoreflect.EnumDescriptor {
-	return file_plan_proto_enumTypes[3].Descriptor()
+	return file_plan_proto_enumTypes[4].Descriptor()
 }
 
 func (Function_FuncFlag) Type() protoreflect.EnumType {
-	return &file_plan_proto_enumTypes[3]
+	return &file_plan_proto_enumTypes[4]
 }
 
 func (x Function_FuncFlag) Number() protoreflect.EnumNumber {
@@ -408,11 +486,11 @@ func (x IndexDef_IndexType) String() string {
 }
 
 func (IndexDef_IndexType) Descriptor() protoreflect.EnumDescriptor {
-	return file_plan_proto_enumTypes[4].Descriptor()
+	return file_plan_proto_enumTypes[5].Descriptor()
 }
 
 func (IndexDef_IndexType) Type() protoreflect.EnumType {
-	return &file_plan_proto_enumTypes[4]
+	return &file_plan_proto_enumTypes[5]
 }
 
 func (x IndexDef_IndexType) Number() protoreflect.EnumNumber {
@@ -466,11 +544,11 @@ func (x OrderBySpec_OrderByFlag) String() string {
 }
 
 func (OrderBySpec_OrderByFlag) Descriptor() protoreflect.EnumDescriptor {
-	return file_plan_proto_enumTypes[5].Descriptor()
+	return file_plan_proto_enumTypes[6].Descriptor()
 }
 
 func (OrderBySpec_OrderByFlag) Type() protoreflect.EnumType {
-	return &file_plan_proto_enumTypes[5]
+	return &file_plan_proto_enumTypes[6]
 }
 
 func (x OrderBySpec_OrderByFlag) Number() protoreflect.EnumNumber {
@@ -595,11 +673,11 @@ func (x Node_NodeType) String() string {
 }
 
 func (Node_NodeType) Descriptor() protoreflect.EnumDescriptor {
-	return file_plan_proto_enumTypes[6].Descriptor()
+	return file_plan_proto_enumTypes[7].Descriptor()
 }
 
 func (Node_NodeType) Type() protoreflect.EnumType {
-	return &file_plan_proto_enumTypes[6]
+	return &file_plan_proto_enumTypes[7]
 }
 
 func (x Node_NodeType) Number() protoreflect.EnumNumber {
@@ -621,6 +699,7 @@ const (
 	Node_SINGLE Node_JoinFlag = 8
 	Node_MARK   Node_JoinFlag = 16
 	Node_APPLY  Node_JoinFlag = 32
+	Node_RIGHT  Node_JoinFlag = 64
 )
 
 // Enum value maps for Node_JoinFlag.
@@ -633,6 +712,7 @@ var (
 		8:  "SINGLE",
 		16: "MARK",
 		32: "APPLY",
+		64: "RIGHT",
 	}
 	Node_JoinFlag_value = map[string]int32{
 		"INNER":  0,
@@ -642,6 +722,7 @@ var (
 		"SINGLE": 8,
 		"MARK":   16,
 		"APPLY":  32,
+		"RIGHT":  64,
 	}
 )
 
@@ -656,11 +737,11 @@ func (x Node_JoinFlag) String() string {
 }
 
 func (Node_JoinFlag) Descriptor() protoreflect.EnumDescriptor {
-	return file_plan_proto_enumTypes[7].Descriptor()
+	return file_plan_proto_enumTypes[8].Descriptor()
 }
 
 func (Node_JoinFlag) Type() protoreflect.EnumType {
-	return &file_plan_proto_enumTypes[7]
+	return &file_plan_proto_enumTypes[8]
 }
 
 func (x Node_JoinFlag) Number() protoreflect.EnumNumber {
@@ -705,11 +786,11 @@ func (x Node_AggMode) String() string {
 }
 
 func (Node_AggMode) Descriptor() protoreflect.EnumDescriptor {
-	return file_plan_proto_enumTypes[8].Descriptor()
+	return file_plan_proto_enumTypes[9].Descriptor()
 }
 
 func (Node_AggMode) Type() protoreflect.EnumType {
-	return &file_plan_proto_enumTypes[8]
+	return &file_plan_proto_enumTypes[9]
 }
 
 func (x Node_AggMode) Number() protoreflect.EnumNumber {
@@ -721,6 +802,55 @@ func (Node_AggMode) EnumDescriptor() ([]byte, []int) {
 	return file_plan_proto_rawDescGZIP(), []int{26, 2}
 }
 
+type Node_JoinAlgo int32
+
+const (
+	Node_HASH  Node_JoinAlgo = 0
+	Node_MERGE Node_JoinAlgo = 1
+	Node_INDEX Node_JoinAlgo = 2
+)
+
+// Enum value maps for Node_JoinAlgo.
+var (
+	Node_JoinAlgo_name = map[int32]string{
+		0: "HASH",
+		1: "MERGE",
+		2: "INDEX",
+	}
+	Node_JoinAlgo_value = map[string]int32{
+		"HASH":  0,
+		"MERGE": 1,
+		"INDEX": 2,
+	}
+)
+
+func (x Node_JoinAlgo) Enum() *Node_JoinAlgo {
+	p := new(Node_JoinAlgo)
+	*p = x
+	return p
+}
+
+func (x Node_JoinAlgo) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Node_JoinAlgo) Descriptor() protoreflect.EnumDescriptor {
+	return file_plan_proto_enumTypes[10].Descriptor()
+}
+
+func (Node_JoinAlgo) Type() protoreflect.EnumType {
+	return &file_plan_proto_enumTypes[10]
+}
+
+func (x Node_JoinAlgo) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Node_JoinAlgo.Descriptor instead.
+func (Node_JoinAlgo) EnumDescriptor() ([]byte, []int) {
+	return file_plan_proto_rawDescGZIP(), []int{26, 3}
+}
+
 type Query_StatementType int32
 
 const (
@@ -763,11 +893,11 @@ func (x Query_StatementType) String() string {
 }
 
 func (Query_StatementType) Descriptor() protoreflect.EnumDescriptor {
-	return file_plan_proto_enumTypes[9].Descriptor()
+	return file_plan_proto_enumTypes[11].Descriptor()
 }
 
 func (Query_StatementType) Type() protoreflect.EnumType {
-	return &file_plan_proto_enumTypes[9]
+	return &file_plan_proto_enumTypes[11]
 }
 
 func (x Query_StatementType) Number() protoreflect.EnumNumber {
@@ -812,11 +942,11 @@ func (x TransationControl_TclType) String() string {
 }
 
 func (TransationControl_TclType) Descriptor() protoreflect.EnumDescriptor {
-	return file_plan_proto_enumTypes[10].Descriptor()
+	return file_plan_proto_enumTypes[12].Descriptor()
 }
 
 func (TransationControl_TclType) Type() protoreflect.EnumType {
-	return &file_plan_proto_enumTypes[10]
+	return &file_plan_proto_enumTypes[12]
 }
 
 func (x TransationControl_TclType) Number() protoreflect.EnumNumber {
@@ -861,11 +991,11 @@ func (x TransationBegin_TransationMode) String() string {
 }
 
 func (TransationBegin_TransationMode) Descriptor() protoreflect.EnumDescriptor {
-	return file_plan_proto_enumTypes[11].Descriptor()
+	return file_plan_proto_enumTypes[13].Descriptor()
 }
 
 func (TransationBegin_TransationMode) Type() protoreflect.EnumType {
-	return &file_plan_proto_enumTypes[11]
+	return &file_plan_proto_enumTypes[13]
 }
 
 func (x TransationBegin_TransationMode) Number() protoreflect.EnumNumber {
@@ -880,27 +1010,29 @@ func (TransationBegin_TransationMode) EnumDescriptor() ([]byte, []int) {
 type DataDefinition_DdlType int32
 
 const (
-	DataDefinition_CREATE_DATABASE     DataDefinition_DdlType = 0
-	DataDefinition_ALTER_DATABASE      DataDefinition_DdlType = 1
-	DataDefinition_DROP_DATABASE       DataDefinition_DdlType = 2
-	DataDefinition_CREATE_TABLE        DataDefinition_DdlType = 3
-	DataDefinition_ALTER_TABLE         DataDefinition_DdlType = 4
-	DataDefinition_DROP_TABLE          DataDefinition_DdlType = 5
-	DataDefinition_CREATE_INDEX        DataDefinition_DdlType = 6
-	DataDefinition_ALTER_INDEX         DataDefinition_DdlType = 7
-	DataDefinition_DROP_INDEX          DataDefinition_DdlType = 8
-	DataDefinition_TRUNCATE_TABLE      DataDefinition_DdlType = 9
-	DataDefinition_SHOW_CREATEDATABASE DataDefinition_DdlType = 10
-	DataDefinition_SHOW_CREATETABLE    DataDefinition_DdlType = 11
-	DataDefinition_SHOW_DATABASES      DataDefinition_DdlType = 12
-	DataDefinition_SHOW_TABLES         DataDefinition_DdlType = 13
-	DataDefinition_SHOW_COLUMNS        DataDefinition_DdlType = 14
-	DataDefinition_SHOW_INDEX          DataDefinition_DdlType = 15
-	DataDefinition_SHOW_VARIABLES      DataDefinition_DdlType = 16
-	DataDefinition_SHOW_WARNINGS       DataDefinition_DdlType = 17
-	DataDefinition_SHOW_ERRORS         DataDefinition_DdlType = 18
-	DataDefinition_SHOW_STATUS         DataDefinition_DdlType = 19
-	DataDefinition_SHOW_PROCESSLIST    DataDefinition_DdlType = 20
+	DataDefinition_CREATE_DATABASE          DataDefinition_DdlType = 0
+	DataDefinition_ALTER_DATABASE           DataDefinition_DdlType = 1
+	DataDefinition_DROP_DATABASE            DataDefinition_DdlType = 2
+	DataDefinition_CREATE_TABLE             DataDefinition_DdlType = 3
+	DataDefinition_ALTER_TABLE              DataDefinition_DdlType = 4
+	DataDefinition_DROP_TABLE               DataDefinition_DdlType = 5
+	DataDefinition_CREATE_INDEX             DataDefinition_DdlType = 6
+	DataDefinition_ALTER_INDEX              DataDefinition_DdlType = 7
+	DataDefinition_DROP_INDEX               DataDefinition_DdlType = 8
+	DataDefinition_TRUNCATE_TABLE           DataDefinition_DdlType = 9
+	DataDefinition_SHOW_CREATEDATABASE      DataDefinition_DdlType = 10
+	DataDefinition_SHOW_CREATETABLE         DataDefinition_DdlType = 11
+	DataDefinition_SHOW_DATABASES           DataDefinition_DdlType = 12
+	DataDefinition_SHOW_TABLES              DataDefinition_DdlType = 13
+	DataDefinition_SHOW_COLUMNS             DataDefinition_DdlType = 14
+	DataDefinition_SHOW_INDEX               DataDefinition_DdlType = 15
+	DataDefinition_SHOW_VARIABLES           DataDefinition_DdlType = 16
+	DataDefinition_SHOW_WARNINGS            DataDefinition_DdlType = 17
+	DataDefinition_SHOW_ERRORS              DataDefinition_DdlType = 18
+	DataDefinition_SHOW_STATUS              DataDefinition_DdlType = 19
+	DataDefinition_SHOW_PROCESSLIST         DataDefinition_DdlType = 20
+	DataDefinition_SHOW_CREATEVIEW          DataDefinition_DdlType = 21
+	DataDefinition_REFRESH_MATERIALIZEDVIEW DataDefinition_DdlType = 22
 )
 
 // Enum value maps for DataDefinition_DdlType.
@@ -927,29 +1059,33 @@ var (
 		18: "SHOW_ERRORS",
 		19: "SHOW_STATUS",
 		20: "SHOW_PROCESSLIST",
+		21: "SHOW_CREATEVIEW",
+		22: "REFRESH_MATERIALIZEDVIEW",
 	}
 	DataDefinition_DdlType_value = map[string]int32{
-		"CREATE_DATABASE":     0,
-		"ALTER_DATABASE":      1,
-		"DROP_DATABASE":       2,
-		"CREATE_TABLE":        3,
-		"ALTER_TABLE":         4,
-		"DROP_TABLE":          5,
-		"CREATE_INDEX":        6,
-		"ALTER_INDEX":         7,
-		"DROP_INDEX":          8,
-		"TRUNCATE_TABLE":      9,
-		"SHOW_CREATEDATABASE": 10,
-		"SHOW_CREATETABLE":    11,
-		"SHOW_DATABASES":      12,
-		"SHOW_TABLES":         13,
-		"SHOW_COLUMNS":        14,
-		"SHOW_INDEX":          15,
-		"SHOW_VARIABLES":      16,
-		"SHOW_WARNINGS":       17,
-		"SHOW_ERRORS":         18,
-		"SHOW_STATUS":         19,
-		"SHOW_PROCESSLIST":    20,
+		"CREATE_DATABASE":          0,
+		"ALTER_DATABASE":           1,
+		"DROP_DATABASE":            2,
+		"CREATE_TABLE":             3,
+		"ALTER_TABLE":              4,
+		"DROP_TABLE":               5,
+		"CREATE_INDEX":             6,
+		"ALTER_INDEX":              7,
+		"DROP_INDEX":               8,
+		"TRUNCATE_TABLE":           9,
+		"SHOW_CREATEDATABASE":      10,
+		"SHOW_CREATETABLE":         11,
+		"SHOW_DATABASES":           12,
+		"SHOW_TABLES":              13,
+		"SHOW_COLUMNS":             14,
+		"SHOW_INDEX":               15,
+		"SHOW_VARIABLES":           16,
+		"SHOW_WARNINGS":            17,
+		"SHOW_ERRORS":              18,
+		"SHOW_STATUS":              19,
+		"SHOW_PROCESSLIST":         20,
+		"SHOW_CREATEVIEW":          21,
+		"REFRESH_MATERIALIZEDVIEW": 22,
 	}
 )
 
@@ -964,11 +1100,11 @@ func (x DataDefinition_DdlType) String() string {
 }
 
 func (DataDefinition_DdlType) Descriptor() protoreflect.EnumDescriptor {
-	return file_plan_proto_enumTypes[12].Descriptor()
+	return file_plan_proto_enumTypes[14].Descriptor()
 }
 
 func (DataDefinition_DdlType) Type() protoreflect.EnumType {
-	return &file_plan_proto_enumTypes[12]
+	return &file_plan_proto_enumTypes[14]
 }
 
 func (x DataDefinition_DdlType) Number() protoreflect.EnumNumber {
@@ -991,6 +1127,8 @@ type Type struct {
 	Precision int32       `protobuf:"varint,4,opt,name=precision,proto3" json:"precision,omitempty"`
 	Size      int32       `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`
 	Scale     int32       `protobuf:"varint,6,opt,name=scale,proto3" json:"scale,omitempty"`
+	// the members of the enum or set type
+	EnumValues []string `protobuf:"bytes,7,rep,name=enum_values,json=enumValues,proto3" json:"enum_values,omitempty"`
 }
 
 func (x *Type) Reset() {
@@ -1067,6 +1205,13 @@ func (x *Type) GetScale() int32 {
 	return 0
 }
 
+func (x *Type) GetEnumValues() []string {
+	if x != nil {
+		return x.EnumValues
+	}
+	return nil
+}
+
 // Const: if a const value can be reprensented by int64 or
 // double, use that, otherwise store a string representation.
 type Const struct {
@@ -2181,13 +2326,15 @@ type ColDef struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name    string       `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Alias   string       `protobuf:"bytes,2,opt,name=alias,proto3" json:"alias,omitempty"`
-	Alg     CompressType `protobuf:"varint,3,opt,name=alg,proto3,enum=CompressType" json:"alg,omitempty"`
-	Typ     *Type        `protobuf:"bytes,4,opt,name=typ,proto3" json:"typ,omitempty"`
-	Default *DefaultExpr `protobuf:"bytes,5,opt,name=default,proto3" json:"default,omitempty"`
-	Primary bool         `protobuf:"varint,6,opt,name=primary,proto3" json:"primary,omitempty"`
-	Pkidx   int32        `protobuf:"varint,7,opt,name=pkidx,proto3" json:"pkidx,omitempty"`
+	Name          string       `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Alias         string       `protobuf:"bytes,2,opt,name=alias,proto3" json:"alias,omitempty"`
+	Alg           CompressType `protobuf:"varint,3,opt,name=alg,proto3,enum=CompressType" json:"alg,omitempty"`
+	Typ           *Type        `protobuf:"bytes,4,opt,name=typ,proto3" json:"typ,omitempty"`
+	Default       *DefaultExpr `protobuf:"bytes,5,opt,name=default,proto3" json:"default,omitempty"`
+	Primary       bool         `protobuf:"varint,6,opt,name=primary,proto3" json:"primary,omitempty"`
+	Pkidx         int32        `protobuf:"varint,7,opt,name=pkidx,proto3" json:"pkidx,omitempty"`
+	AutoIncrement bool         `protobuf:"varint,8,opt,name=auto_increment,json=autoIncrement,proto3" json:"auto_increment,omitempty"`
+	Encoding      EncodingType `protobuf:"varint,9,opt,name=encoding,proto3,enum=EncodingType" json:"encoding,omitempty"`
 }
 
 func (x *ColDef) Reset() {
@@ -2271,6 +2418,20 @@ func (x *ColDef) GetPkidx() int32 {
 	return 0
 }
 
+func (x *ColDef) GetAutoIncrement() bool {
+	if x != nil {
+		return x.AutoIncrement
+	}
+	return false
+}
+
+func (x *ColDef) GetEncoding() EncodingType {
+	if x != nil {
+		return x.Encoding
+	}
+	return EncodingType_Plain
+}
+
 type IndexDef struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -3005,6 +3166,10 @@ type Node struct {
 	ObjRef       *ObjectRef     `protobuf:"bytes,18,opt,name=obj_ref,json=objRef,proto3" json:"obj_ref,omitempty"`
 	RowsetData   *RowsetData    `protobuf:"bytes,19,opt,name=rowset_data,json=rowsetData,proto3" json:"rowset_data,omitempty"`
 	ExtraOptions string         `protobuf:"bytes,20,opt,name=extra_options,json=extraOptions,proto3" json:"extra_options,omitempty"`
+	// the arguments of the table function scanned by FUNCTION_SCAN
+	TableFuncArgs []*Expr `protobuf:"bytes,21,rep,name=table_func_args,json=tableFuncArgs,proto3" json:"table_func_args,omitempty"`
+	// the algorithm joining the children of JOIN
+	JoinAlgo Node_JoinAlgo `protobuf:"varint,22,opt,name=join_algo,json=joinAlgo,proto3,enum=Node_JoinAlgo" json:"join_algo,omitempty"`
 }
 
 func (x *Node) Reset() {
@@ -3179,6 +3344,20 @@ func (x *Node) GetExtraOptions() string {
 	return ""
 }
 
+func (x *Node) GetTableFuncArgs() []*Expr {
+	if x != nil {
+		return x.TableFuncArgs
+	}
+	return nil
+}
+
+func (x *Node) GetJoinAlgo() Node_JoinAlgo {
+	if x != nil {
+		return x.JoinAlgo
+	}
+	return Node_HASH
+}
+
 type Query struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -3616,6 +3795,7 @@ type DataDefinition struct {
 	//	*DataDefinition_DropIndex
 	//	*DataDefinition_TruncateTable
 	//	*DataDefinition_ShowVariables
+	//	*DataDefinition_RefreshMaterializedView
 	Definition isDataDefinition_Definition `protobuf_oneof:"definition"`
 }
 
@@ -3749,6 +3929,13 @@ func (x *DataDefinition) GetShowVariables() *ShowVariables {
 	return nil
 }
 
+func (x *DataDefinition) GetRefreshMaterializedView() *RefreshMaterializedView {
+	if x, ok := x.GetDefinition().(*DataDefinition_RefreshMaterializedView); ok {
+		return x.RefreshMaterializedView
+	}
+	return nil
+}
+
 type isDataDefinition_Definition interface {
 	isDataDefinition_Definition()
 }
@@ -3797,6 +3984,10 @@ type DataDefinition_ShowVariables struct {
 	ShowVariables *ShowVariables `protobuf:"bytes,13,opt,name=show_variables,json=showVariables,proto3,oneof"`
 }
 
+type DataDefinition_RefreshMaterializedView struct {
+	RefreshMaterializedView *RefreshMaterializedView `protobuf:"bytes,14,opt,name=refresh_materialized_view,json=refreshMaterializedView,proto3,oneof"`
+}
+
 func (*DataDefinition_CreateDatabase) isDataDefinition_Definition() {}
 
 func (*DataDefinition_AlterDatabase) isDataDefinition_Definition() {}
@@ -3819,6 +4010,8 @@ func (*DataDefinition_TruncateTable) isDataDefinition_Definition() {}
 
 func (*DataDefinition_ShowVariables) isDataDefinition_Definition() {}
 
+func (*DataDefinition_RefreshMaterializedView) isDataDefinition_Definition() {}
+
 type CreateDatabase struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -4432,6 +4625,61 @@ func (x *ShowVariables) GetWhere() []*Expr {
 	return nil
 }
 
+type RefreshMaterializedView struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Database string `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
+	Table    string `protobuf:"bytes,2,opt,name=table,proto3" json:"table,omitempty"`
+}
+
+func (x *RefreshMaterializedView) Reset() {
+	*x = RefreshMaterializedView{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plan_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RefreshMaterializedView) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshMaterializedView) ProtoMessage() {}
+
+func (x *RefreshMaterializedView) ProtoReflect() protoreflect.Message {
+	mi := &file_plan_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshMaterializedView.ProtoReflect.Descriptor instead.
+func (*RefreshMaterializedView) Descriptor() ([]byte, []int) {
+	return file_plan_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *RefreshMaterializedView) GetDatabase() string {
+	if x != nil {
+		return x.Database
+	}
+	return ""
+}
+
+func (x *RefreshMaterializedView) GetTable() string {
+	if x != nil {
+		return x.Table
+	}
+	return ""
+}
+
 type TableDef_DefType struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -4447,7 +4695,7 @@ type TableDef_DefType struct {
 func (x *TableDef_DefType) Reset() {
 	*x = TableDef_DefType{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plan_proto_msgTypes[45]
+		mi := &file_plan_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4460,7 +4708,7 @@ func (x *TableDef_DefType) String() string {
 func (*TableDef_DefType) ProtoMessage() {}
 
 func (x *TableDef_DefType) ProtoReflect() protoreflect.Message {
-	mi := &file_plan_proto_msgTypes[45]
+	mi := &file_plan_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4529,7 +4777,7 @@ func (*TableDef_DefType_Properties) isTableDef_DefType_Def() {}
 var File_plan_proto protoreflect.FileDescriptor
 
 var file_plan_proto_rawDesc = []byte{
-	0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc6, 0x05, 0x0a,
+	0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x98, 0x06, 0x0a,
 	0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x0e, 0x32, 0x0c, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x49, 0x64, 0x52,
 	0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x75, 0x6c, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18,
@@ -4539,513 +4787,553 @@ var file_plan_proto_rawDesc = []byte{
 	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x72, 0x65, 0x63, 0x69, 0x73,
 	0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
 	0x05, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x61, 0x6c, 0x65,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x22, 0xa5, 0x04,
-	0x0a, 0x06, 0x54, 0x79, 0x70, 0x65, 0x49, 0x64, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x4e, 0x59, 0x10,
-	0x00, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x54, 0x41, 0x52, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x42,
-	0x4f, 0x4f, 0x4c, 0x10, 0x0a, 0x12, 0x08, 0x0a, 0x04, 0x49, 0x4e, 0x54, 0x38, 0x10, 0x14, 0x12,
-	0x09, 0x0a, 0x05, 0x49, 0x4e, 0x54, 0x31, 0x36, 0x10, 0x15, 0x12, 0x09, 0x0a, 0x05, 0x49, 0x4e,
-	0x54, 0x33, 0x32, 0x10, 0x16, 0x12, 0x09, 0x0a, 0x05, 0x49, 0x4e, 0x54, 0x36, 0x34, 0x10, 0x17,
-	0x12, 0x0a, 0x0a, 0x06, 0x49, 0x4e, 0x54, 0x31, 0x32, 0x38, 0x10, 0x18, 0x12, 0x09, 0x0a, 0x05,
-	0x55, 0x49, 0x4e, 0x54, 0x38, 0x10, 0x19, 0x12, 0x0a, 0x0a, 0x06, 0x55, 0x49, 0x4e, 0x54, 0x31,
-	0x36, 0x10, 0x1a, 0x12, 0x0a, 0x0a, 0x06, 0x55, 0x49, 0x4e, 0x54, 0x33, 0x32, 0x10, 0x1b, 0x12,
-	0x0a, 0x0a, 0x06, 0x55, 0x49, 0x4e, 0x54, 0x36, 0x34, 0x10, 0x1c, 0x12, 0x0b, 0x0a, 0x07, 0x55,
-	0x49, 0x4e, 0x54, 0x31, 0x32, 0x38, 0x10, 0x1d, 0x12, 0x0b, 0x0a, 0x07, 0x46, 0x4c, 0x4f, 0x41,
-	0x54, 0x33, 0x32, 0x10, 0x1e, 0x12, 0x0b, 0x0a, 0x07, 0x46, 0x4c, 0x4f, 0x41, 0x54, 0x36, 0x34,
-	0x10, 0x1f, 0x12, 0x0d, 0x0a, 0x09, 0x44, 0x45, 0x43, 0x49, 0x4d, 0x41, 0x4c, 0x36, 0x34, 0x10,
-	0x20, 0x12, 0x0e, 0x0a, 0x0a, 0x44, 0x45, 0x43, 0x49, 0x4d, 0x41, 0x4c, 0x31, 0x32, 0x38, 0x10,
-	0x21, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x45, 0x43, 0x49, 0x4d, 0x41, 0x4c, 0x10, 0x22, 0x12, 0x0a,
-	0x0a, 0x06, 0x41, 0x4e, 0x59, 0x49, 0x4e, 0x54, 0x10, 0x25, 0x12, 0x0c, 0x0a, 0x08, 0x41, 0x4e,
-	0x59, 0x46, 0x4c, 0x4f, 0x41, 0x54, 0x10, 0x26, 0x12, 0x0d, 0x0a, 0x09, 0x41, 0x4e, 0x59, 0x4e,
-	0x55, 0x4d, 0x42, 0x45, 0x52, 0x10, 0x27, 0x12, 0x08, 0x0a, 0x04, 0x55, 0x55, 0x49, 0x44, 0x10,
-	0x28, 0x12, 0x09, 0x0a, 0x05, 0x55, 0x42, 0x31, 0x36, 0x30, 0x10, 0x29, 0x12, 0x09, 0x0a, 0x05,
-	0x55, 0x42, 0x31, 0x38, 0x34, 0x10, 0x2a, 0x12, 0x09, 0x0a, 0x05, 0x55, 0x42, 0x31, 0x39, 0x32,
-	0x10, 0x2b, 0x12, 0x09, 0x0a, 0x05, 0x55, 0x42, 0x32, 0x32, 0x34, 0x10, 0x2c, 0x12, 0x09, 0x0a,
-	0x05, 0x55, 0x42, 0x32, 0x35, 0x36, 0x10, 0x2d, 0x12, 0x08, 0x0a, 0x04, 0x44, 0x41, 0x54, 0x45,
-	0x10, 0x32, 0x12, 0x08, 0x0a, 0x04, 0x54, 0x49, 0x4d, 0x45, 0x10, 0x33, 0x12, 0x0c, 0x0a, 0x08,
-	0x44, 0x41, 0x54, 0x45, 0x54, 0x49, 0x4d, 0x45, 0x10, 0x34, 0x12, 0x0d, 0x0a, 0x09, 0x54, 0x49,
-	0x4d, 0x45, 0x53, 0x54, 0x41, 0x4d, 0x50, 0x10, 0x35, 0x12, 0x0c, 0x0a, 0x08, 0x49, 0x4e, 0x54,
-	0x45, 0x52, 0x56, 0x41, 0x4c, 0x10, 0x36, 0x12, 0x0b, 0x0a, 0x07, 0x41, 0x4e, 0x59, 0x54, 0x49,
-	0x4d, 0x45, 0x10, 0x3b, 0x12, 0x08, 0x0a, 0x04, 0x43, 0x48, 0x41, 0x52, 0x10, 0x3c, 0x12, 0x0b,
-	0x0a, 0x07, 0x56, 0x41, 0x52, 0x43, 0x48, 0x41, 0x52, 0x10, 0x3d, 0x12, 0x08, 0x0a, 0x04, 0x4a,
-	0x53, 0x4f, 0x4e, 0x10, 0x3e, 0x12, 0x0a, 0x0a, 0x06, 0x42, 0x49, 0x4e, 0x41, 0x52, 0x59, 0x10,
-	0x46, 0x12, 0x0d, 0x0a, 0x09, 0x56, 0x41, 0x52, 0x42, 0x49, 0x4e, 0x41, 0x52, 0x59, 0x10, 0x47,
-	0x12, 0x09, 0x0a, 0x05, 0x41, 0x52, 0x52, 0x41, 0x59, 0x10, 0x5a, 0x12, 0x0e, 0x0a, 0x0a, 0x46,
-	0x4c, 0x45, 0x58, 0x42, 0x55, 0x46, 0x46, 0x45, 0x52, 0x10, 0x5b, 0x12, 0x0a, 0x0a, 0x06, 0x42,
-	0x59, 0x54, 0x45, 0x41, 0x38, 0x10, 0x64, 0x12, 0x0b, 0x0a, 0x07, 0x42, 0x59, 0x54, 0x45, 0x41,
-	0x31, 0x36, 0x10, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x42, 0x59, 0x54, 0x45, 0x41, 0x10, 0x66, 0x12,
-	0x08, 0x0a, 0x03, 0x53, 0x45, 0x4c, 0x10, 0xc8, 0x01, 0x12, 0x0a, 0x0a, 0x05, 0x54, 0x55, 0x50,
-	0x4c, 0x45, 0x10, 0xc9, 0x01, 0x22, 0x6a, 0x0a, 0x05, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x12, 0x16,
-	0x0a, 0x06, 0x69, 0x73, 0x6e, 0x75, 0x6c, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
-	0x69, 0x73, 0x6e, 0x75, 0x6c, 0x6c, 0x12, 0x14, 0x0a, 0x04, 0x69, 0x76, 0x61, 0x6c, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x04, 0x69, 0x76, 0x61, 0x6c, 0x12, 0x14, 0x0a, 0x04,
-	0x64, 0x76, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x04, 0x64, 0x76,
-	0x61, 0x6c, 0x12, 0x14, 0x0a, 0x04, 0x73, 0x76, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x48, 0x00, 0x52, 0x04, 0x73, 0x76, 0x61, 0x6c, 0x42, 0x07, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x22, 0x1c, 0x0a, 0x08, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x52, 0x65, 0x66, 0x12, 0x10, 0x0a,
-	0x03, 0x70, 0x6f, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x70, 0x6f, 0x73, 0x22,
-	0x1c, 0x0a, 0x06, 0x56, 0x61, 0x72, 0x52, 0x65, 0x66, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x0a,
-	0x06, 0x43, 0x6f, 0x6c, 0x52, 0x65, 0x66, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x65, 0x6c, 0x5f, 0x70,
-	0x6f, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x65, 0x6c, 0x50, 0x6f, 0x73,
-	0x12, 0x17, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x5f, 0x70, 0x6f, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x06, 0x63, 0x6f, 0x6c, 0x50, 0x6f, 0x73, 0x22, 0x3e, 0x0a, 0x0a, 0x43, 0x6f, 0x72,
-	0x72, 0x43, 0x6f, 0x6c, 0x52, 0x65, 0x66, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64,
-	0x12, 0x17, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x5f, 0x70, 0x6f, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x06, 0x63, 0x6f, 0x6c, 0x50, 0x6f, 0x73, 0x22, 0x25, 0x0a, 0x08, 0x45, 0x78, 0x70,
-	0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74,
-	0x22, 0x65, 0x0a, 0x08, 0x53, 0x75, 0x62, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x17, 0x0a, 0x07,
-	0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6e,
-	0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x73, 0x5f, 0x63, 0x6f, 0x72, 0x72,
-	0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x69, 0x73,
-	0x43, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73,
-	0x5f, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69,
-	0x73, 0x53, 0x63, 0x61, 0x6c, 0x61, 0x72, 0x22, 0xd3, 0x01, 0x0a, 0x09, 0x4f, 0x62, 0x6a, 0x65,
-	0x63, 0x74, 0x52, 0x65, 0x66, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x0e, 0x0a,
-	0x02, 0x64, 0x62, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x64, 0x62, 0x12, 0x16, 0x0a,
-	0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x73,
-	0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x10, 0x0a, 0x03, 0x6f, 0x62, 0x6a, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x03, 0x6f, 0x62, 0x6a, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x62, 0x5f, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x4e, 0x61,
-	0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x62, 0x6a, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x08,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x62, 0x6a, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xd7, 0x01,
-	0x0a, 0x08, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x04, 0x66, 0x75,
-	0x6e, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63,
-	0x74, 0x52, 0x65, 0x66, 0x52, 0x04, 0x66, 0x75, 0x6e, 0x63, 0x12, 0x19, 0x0a, 0x04, 0x61, 0x72,
-	0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52,
-	0x04, 0x61, 0x72, 0x67, 0x73, 0x22, 0x8f, 0x01, 0x0a, 0x08, 0x46, 0x75, 0x6e, 0x63, 0x46, 0x6c,
-	0x61, 0x67, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08,
-	0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x54,
-	0x41, 0x42, 0x4c, 0x45, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x56, 0x4f, 0x4c, 0x41, 0x54, 0x49,
-	0x4c, 0x45, 0x10, 0x04, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x54, 0x52, 0x49, 0x43, 0x54, 0x10, 0x08,
-	0x12, 0x10, 0x0a, 0x0c, 0x50, 0x52, 0x4f, 0x44, 0x55, 0x43, 0x45, 0x5f, 0x4e, 0x55, 0x4c, 0x4c,
-	0x10, 0x10, 0x12, 0x13, 0x0a, 0x0f, 0x50, 0x52, 0x4f, 0x44, 0x55, 0x43, 0x45, 0x5f, 0x4e, 0x4f,
-	0x5f, 0x4e, 0x55, 0x4c, 0x4c, 0x10, 0x20, 0x12, 0x0a, 0x0a, 0x06, 0x56, 0x41, 0x52, 0x41, 0x52,
-	0x47, 0x10, 0x40, 0x12, 0x08, 0x0a, 0x03, 0x41, 0x47, 0x47, 0x10, 0x80, 0x01, 0x12, 0x08, 0x0a,
-	0x03, 0x57, 0x49, 0x4e, 0x10, 0x80, 0x02, 0x22, 0xc8, 0x02, 0x0a, 0x04, 0x45, 0x78, 0x70, 0x72,
-	0x12, 0x17, 0x0a, 0x03, 0x74, 0x79, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e,
-	0x54, 0x79, 0x70, 0x65, 0x52, 0x03, 0x74, 0x79, 0x70, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x61, 0x62,
-	0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74,
-	0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x6f, 0x6c, 0x5f,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x4e,
-	0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x01, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06,
-	0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x48, 0x00, 0x52, 0x01, 0x63, 0x12, 0x19, 0x0a, 0x01, 0x70,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x52, 0x65,
-	0x66, 0x48, 0x00, 0x52, 0x01, 0x70, 0x12, 0x17, 0x0a, 0x01, 0x76, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x07, 0x2e, 0x56, 0x61, 0x72, 0x52, 0x65, 0x66, 0x48, 0x00, 0x52, 0x01, 0x76, 0x12,
-	0x1b, 0x0a, 0x03, 0x63, 0x6f, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x43,
-	0x6f, 0x6c, 0x52, 0x65, 0x66, 0x48, 0x00, 0x52, 0x03, 0x63, 0x6f, 0x6c, 0x12, 0x19, 0x0a, 0x01,
-	0x66, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x48, 0x00, 0x52, 0x01, 0x66, 0x12, 0x1f, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18,
-	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x4c, 0x69, 0x73, 0x74,
-	0x48, 0x00, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x03, 0x73, 0x75, 0x62, 0x18,
-	0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x53, 0x75, 0x62, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x48, 0x00, 0x52, 0x03, 0x73, 0x75, 0x62, 0x12, 0x21, 0x0a, 0x04, 0x63, 0x6f, 0x72, 0x72, 0x18,
-	0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x43, 0x6f, 0x72, 0x72, 0x43, 0x6f, 0x6c, 0x52,
-	0x65, 0x66, 0x48, 0x00, 0x52, 0x04, 0x63, 0x6f, 0x72, 0x72, 0x42, 0x06, 0x0a, 0x04, 0x65, 0x78,
-	0x70, 0x72, 0x22, 0x62, 0x0a, 0x0b, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x45, 0x78, 0x70,
-	0x72, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x78, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x05, 0x65, 0x78, 0x69, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e,
-	0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x17, 0x0a,
-	0x07, 0x69, 0x73, 0x5f, 0x6e, 0x75, 0x6c, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
-	0x69, 0x73, 0x4e, 0x75, 0x6c, 0x6c, 0x22, 0x84, 0x03, 0x0a, 0x0d, 0x43, 0x6f, 0x6e, 0x73, 0x74,
-	0x61, 0x6e, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1d, 0x0a, 0x09, 0x75, 0x6e, 0x6b, 0x6e,
-	0x6f, 0x77, 0x6e, 0x5f, 0x76, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x08, 0x75,
-	0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x56, 0x12, 0x19, 0x0a, 0x07, 0x69, 0x6e, 0x74, 0x36, 0x34,
-	0x5f, 0x76, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x06, 0x69, 0x6e, 0x74, 0x36,
-	0x34, 0x56, 0x12, 0x21, 0x0a, 0x0b, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x36, 0x34, 0x5f,
-	0x76, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x0a, 0x64, 0x65, 0x63, 0x69, 0x6d,
-	0x61, 0x6c, 0x36, 0x34, 0x56, 0x12, 0x30, 0x0a, 0x0c, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c,
-	0x31, 0x32, 0x38, 0x5f, 0x76, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x64, 0x65,
-	0x63, 0x69, 0x6d, 0x61, 0x6c, 0x31, 0x32, 0x38, 0x48, 0x00, 0x52, 0x0b, 0x64, 0x65, 0x63, 0x69,
-	0x6d, 0x61, 0x6c, 0x31, 0x32, 0x38, 0x56, 0x12, 0x1b, 0x0a, 0x08, 0x75, 0x69, 0x6e, 0x74, 0x36,
-	0x34, 0x5f, 0x76, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x07, 0x75, 0x69, 0x6e,
-	0x74, 0x36, 0x34, 0x56, 0x12, 0x1d, 0x0a, 0x09, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x33, 0x32, 0x5f,
-	0x76, 0x18, 0x06, 0x20, 0x01, 0x28, 0x02, 0x48, 0x00, 0x52, 0x08, 0x66, 0x6c, 0x6f, 0x61, 0x74,
-	0x33, 0x32, 0x56, 0x12, 0x1d, 0x0a, 0x09, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x36, 0x34, 0x5f, 0x76,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x08, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x36,
-	0x34, 0x56, 0x12, 0x17, 0x0a, 0x06, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x76, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x05, 0x48, 0x00, 0x52, 0x05, 0x64, 0x61, 0x74, 0x65, 0x56, 0x12, 0x1f, 0x0a, 0x0a, 0x64,
-	0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x5f, 0x76, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x48,
-	0x00, 0x52, 0x09, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x56, 0x12, 0x21, 0x0a, 0x0b,
-	0x74, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x5f, 0x76, 0x18, 0x0a, 0x20, 0x01, 0x28,
-	0x03, 0x48, 0x00, 0x52, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x56, 0x12,
-	0x1b, 0x0a, 0x08, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x76, 0x18, 0x0b, 0x20, 0x01, 0x28,
-	0x09, 0x48, 0x00, 0x52, 0x07, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x42, 0x0f, 0x0a, 0x0d,
-	0x63, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x2c, 0x0a,
-	0x0a, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x31, 0x32, 0x38, 0x12, 0x0e, 0x0a, 0x02, 0x4c,
-	0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x4c, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x48,
-	0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x48, 0x69, 0x22, 0xc4, 0x01, 0x0a, 0x06,
-	0x43, 0x6f, 0x6c, 0x44, 0x65, 0x66, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x12, 0x1f, 0x0a,
+	0x0b, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0a, 0x65, 0x6e, 0x75, 0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0xd6,
+	0x04, 0x0a, 0x06, 0x54, 0x79, 0x70, 0x65, 0x49, 0x64, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x4e, 0x59,
+	0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x54, 0x41, 0x52, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04,
+	0x42, 0x4f, 0x4f, 0x4c, 0x10, 0x0a, 0x12, 0x08, 0x0a, 0x04, 0x49, 0x4e, 0x54, 0x38, 0x10, 0x14,
+	0x12, 0x09, 0x0a, 0x05, 0x49, 0x4e, 0x54, 0x31, 0x36, 0x10, 0x15, 0x12, 0x09, 0x0a, 0x05, 0x49,
+	0x4e, 0x54, 0x33, 0x32, 0x10, 0x16, 0x12, 0x09, 0x0a, 0x05, 0x49, 0x4e, 0x54, 0x36, 0x34, 0x10,
+	0x17, 0x12, 0x0a, 0x0a, 0x06, 0x49, 0x4e, 0x54, 0x31, 0x32, 0x38, 0x10, 0x18, 0x12, 0x09, 0x0a,
+	0x05, 0x55, 0x49, 0x4e, 0x54, 0x38, 0x10, 0x19, 0x12, 0x0a, 0x0a, 0x06, 0x55, 0x49, 0x4e, 0x54,
+	0x31, 0x36, 0x10, 0x1a, 0x12, 0x0a, 0x0a, 0x06, 0x55, 0x49, 0x4e, 0x54, 0x33, 0x32, 0x10, 0x1b,
+	0x12, 0x0a, 0x0a, 0x06, 0x55, 0x49, 0x4e, 0x54, 0x36, 0x34, 0x10, 0x1c, 0x12, 0x0b, 0x0a, 0x07,
+	0x55, 0x49, 0x4e, 0x54, 0x31, 0x32, 0x38, 0x10, 0x1d, 0x12, 0x0b, 0x0a, 0x07, 0x46, 0x4c, 0x4f,
+	0x41, 0x54, 0x33, 0x32, 0x10, 0x1e, 0x12, 0x0b, 0x0a, 0x07, 0x46, 0x4c, 0x4f, 0x41, 0x54, 0x36,
+	0x34, 0x10, 0x1f, 0x12, 0x0d, 0x0a, 0x09, 0x44, 0x45, 0x43, 0x49, 0x4d, 0x41, 0x4c, 0x36, 0x34,
+	0x10, 0x20, 0x12, 0x0e, 0x0a, 0x0a, 0x44, 0x45, 0x43, 0x49, 0x4d, 0x41, 0x4c, 0x31, 0x32, 0x38,
+	0x10, 0x21, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x45, 0x43, 0x49, 0x4d, 0x41, 0x4c, 0x10, 0x22, 0x12,
+	0x0a, 0x0a, 0x06, 0x41, 0x4e, 0x59, 0x49, 0x4e, 0x54, 0x10, 0x25, 0x12, 0x0c, 0x0a, 0x08, 0x41,
+	0x4e, 0x59, 0x46, 0x4c, 0x4f, 0x41, 0x54, 0x10, 0x26, 0x12, 0x0d, 0x0a, 0x09, 0x41, 0x4e, 0x59,
+	0x4e, 0x55, 0x4d, 0x42, 0x45, 0x52, 0x10, 0x27, 0x12, 0x08, 0x0a, 0x04, 0x55, 0x55, 0x49, 0x44,
+	0x10, 0x28, 0x12, 0x09, 0x0a, 0x05, 0x55, 0x42, 0x31, 0x36, 0x30, 0x10, 0x29, 0x12, 0x09, 0x0a,
+	0x05, 0x55, 0x42, 0x31, 0x38, 0x34, 0x10, 0x2a, 0x12, 0x09, 0x0a, 0x05, 0x55, 0x42, 0x31, 0x39,
+	0x32, 0x10, 0x2b, 0x12, 0x09, 0x0a, 0x05, 0x55, 0x42, 0x32, 0x32, 0x34, 0x10, 0x2c, 0x12, 0x09,
+	0x0a, 0x05, 0x55, 0x42, 0x32, 0x35, 0x36, 0x10, 0x2d, 0x12, 0x08, 0x0a, 0x04, 0x44, 0x41, 0x54,
+	0x45, 0x10, 0x32, 0x12, 0x08, 0x0a, 0x04, 0x54, 0x49, 0x4d, 0x45, 0x10, 0x33, 0x12, 0x0c, 0x0a,
+	0x08, 0x44, 0x41, 0x54, 0x45, 0x54, 0x49, 0x4d, 0x45, 0x10, 0x34, 0x12, 0x0d, 0x0a, 0x09, 0x54,
+	0x49, 0x4d, 0x45, 0x53, 0x54, 0x41, 0x4d, 0x50, 0x10, 0x35, 0x12, 0x0c, 0x0a, 0x08, 0x49, 0x4e,
+	0x54, 0x45, 0x52, 0x56, 0x41, 0x4c, 0x10, 0x36, 0x12, 0x08, 0x0a, 0x04, 0x59, 0x45, 0x41, 0x52,
+	0x10, 0x37, 0x12, 0x0b, 0x0a, 0x07, 0x41, 0x4e, 0x59, 0x54, 0x49, 0x4d, 0x45, 0x10, 0x3b, 0x12,
+	0x08, 0x0a, 0x04, 0x43, 0x48, 0x41, 0x52, 0x10, 0x3c, 0x12, 0x0b, 0x0a, 0x07, 0x56, 0x41, 0x52,
+	0x43, 0x48, 0x41, 0x52, 0x10, 0x3d, 0x12, 0x08, 0x0a, 0x04, 0x4a, 0x53, 0x4f, 0x4e, 0x10, 0x3e,
+	0x12, 0x08, 0x0a, 0x04, 0x54, 0x45, 0x58, 0x54, 0x10, 0x3f, 0x12, 0x0a, 0x0a, 0x06, 0x42, 0x49,
+	0x4e, 0x41, 0x52, 0x59, 0x10, 0x46, 0x12, 0x0d, 0x0a, 0x09, 0x56, 0x41, 0x52, 0x42, 0x49, 0x4e,
+	0x41, 0x52, 0x59, 0x10, 0x47, 0x12, 0x08, 0x0a, 0x04, 0x42, 0x4c, 0x4f, 0x42, 0x10, 0x48, 0x12,
+	0x08, 0x0a, 0x04, 0x45, 0x4e, 0x55, 0x4d, 0x10, 0x50, 0x12, 0x07, 0x0a, 0x03, 0x53, 0x45, 0x54,
+	0x10, 0x51, 0x12, 0x09, 0x0a, 0x05, 0x41, 0x52, 0x52, 0x41, 0x59, 0x10, 0x5a, 0x12, 0x0e, 0x0a,
+	0x0a, 0x46, 0x4c, 0x45, 0x58, 0x42, 0x55, 0x46, 0x46, 0x45, 0x52, 0x10, 0x5b, 0x12, 0x0a, 0x0a,
+	0x06, 0x42, 0x59, 0x54, 0x45, 0x41, 0x38, 0x10, 0x64, 0x12, 0x0b, 0x0a, 0x07, 0x42, 0x59, 0x54,
+	0x45, 0x41, 0x31, 0x36, 0x10, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x42, 0x59, 0x54, 0x45, 0x41, 0x10,
+	0x66, 0x12, 0x08, 0x0a, 0x03, 0x53, 0x45, 0x4c, 0x10, 0xc8, 0x01, 0x12, 0x0a, 0x0a, 0x05, 0x54,
+	0x55, 0x50, 0x4c, 0x45, 0x10, 0xc9, 0x01, 0x22, 0x80, 0x01, 0x0a, 0x05, 0x43, 0x6f, 0x6e, 0x73,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x73, 0x6e, 0x75, 0x6c, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x69, 0x73, 0x6e, 0x75, 0x6c, 0x6c, 0x12, 0x14, 0x0a, 0x04, 0x69, 0x76, 0x61,
+	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x04, 0x69, 0x76, 0x61, 0x6c, 0x12,
+	0x14, 0x0a, 0x04, 0x64, 0x76, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52,
+	0x04, 0x64, 0x76, 0x61, 0x6c, 0x12, 0x14, 0x0a, 0x04, 0x73, 0x76, 0x61, 0x6c, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x73, 0x76, 0x61, 0x6c, 0x12, 0x14, 0x0a, 0x04, 0x62,
+	0x76, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x04, 0x62, 0x76, 0x61,
+	0x6c, 0x42, 0x07, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x1c, 0x0a, 0x08, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x52, 0x65, 0x66, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x6f, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x03, 0x70, 0x6f, 0x73, 0x22, 0x1c, 0x0a, 0x06, 0x56, 0x61, 0x72, 0x52,
+	0x65, 0x66, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x0a, 0x06, 0x43, 0x6f, 0x6c, 0x52, 0x65, 0x66,
+	0x12, 0x17, 0x0a, 0x07, 0x72, 0x65, 0x6c, 0x5f, 0x70, 0x6f, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x06, 0x72, 0x65, 0x6c, 0x50, 0x6f, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x63, 0x6f, 0x6c,
+	0x5f, 0x70, 0x6f, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x63, 0x6f, 0x6c, 0x50,
+	0x6f, 0x73, 0x22, 0x3e, 0x0a, 0x0a, 0x43, 0x6f, 0x72, 0x72, 0x43, 0x6f, 0x6c, 0x52, 0x65, 0x66,
+	0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x63, 0x6f, 0x6c,
+	0x5f, 0x70, 0x6f, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x63, 0x6f, 0x6c, 0x50,
+	0x6f, 0x73, 0x22, 0x25, 0x0a, 0x08, 0x45, 0x78, 0x70, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x19,
+	0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x45,
+	0x78, 0x70, 0x72, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x22, 0x65, 0x0a, 0x08, 0x53, 0x75, 0x62,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x23,
+	0x0a, 0x0d, 0x69, 0x73, 0x5f, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x69, 0x73, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61,
+	0x74, 0x65, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x53, 0x63, 0x61, 0x6c, 0x61, 0x72,
+	0x22, 0xd3, 0x01, 0x0a, 0x09, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x66, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x64, 0x62, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x02, 0x64, 0x62, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x10,
+	0x0a, 0x03, 0x6f, 0x62, 0x6a, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6f, 0x62, 0x6a,
+	0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x63,
+	0x68, 0x65, 0x6d, 0x61, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6f,
+	0x62, 0x6a, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f,
+	0x62, 0x6a, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xd7, 0x01, 0x0a, 0x08, 0x46, 0x75, 0x6e, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x04, 0x66, 0x75, 0x6e, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0a, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x66, 0x52, 0x04, 0x66,
+	0x75, 0x6e, 0x63, 0x12, 0x19, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x05, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x22, 0x8f,
+	0x01, 0x0a, 0x08, 0x46, 0x75, 0x6e, 0x63, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x08, 0x0a, 0x04, 0x4e,
+	0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41,
+	0x4c, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x02, 0x12,
+	0x0c, 0x0a, 0x08, 0x56, 0x4f, 0x4c, 0x41, 0x54, 0x49, 0x4c, 0x45, 0x10, 0x04, 0x12, 0x0a, 0x0a,
+	0x06, 0x53, 0x54, 0x52, 0x49, 0x43, 0x54, 0x10, 0x08, 0x12, 0x10, 0x0a, 0x0c, 0x50, 0x52, 0x4f,
+	0x44, 0x55, 0x43, 0x45, 0x5f, 0x4e, 0x55, 0x4c, 0x4c, 0x10, 0x10, 0x12, 0x13, 0x0a, 0x0f, 0x50,
+	0x52, 0x4f, 0x44, 0x55, 0x43, 0x45, 0x5f, 0x4e, 0x4f, 0x5f, 0x4e, 0x55, 0x4c, 0x4c, 0x10, 0x20,
+	0x12, 0x0a, 0x0a, 0x06, 0x56, 0x41, 0x52, 0x41, 0x52, 0x47, 0x10, 0x40, 0x12, 0x08, 0x0a, 0x03,
+	0x41, 0x47, 0x47, 0x10, 0x80, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x57, 0x49, 0x4e, 0x10, 0x80, 0x02,
+	0x22, 0xc8, 0x02, 0x0a, 0x04, 0x45, 0x78, 0x70, 0x72, 0x12, 0x17, 0x0a, 0x03, 0x74, 0x79, 0x70,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x03, 0x74,
+	0x79, 0x70, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x6f, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x01,
+	0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x48,
+	0x00, 0x52, 0x01, 0x63, 0x12, 0x19, 0x0a, 0x01, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x09, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x52, 0x65, 0x66, 0x48, 0x00, 0x52, 0x01, 0x70, 0x12,
+	0x17, 0x0a, 0x01, 0x76, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x56, 0x61, 0x72,
+	0x52, 0x65, 0x66, 0x48, 0x00, 0x52, 0x01, 0x76, 0x12, 0x1b, 0x0a, 0x03, 0x63, 0x6f, 0x6c, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x43, 0x6f, 0x6c, 0x52, 0x65, 0x66, 0x48, 0x00,
+	0x52, 0x03, 0x63, 0x6f, 0x6c, 0x12, 0x19, 0x0a, 0x01, 0x66, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x09, 0x2e, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x01, 0x66,
+	0x12, 0x1f, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09,
+	0x2e, 0x45, 0x78, 0x70, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x00, 0x52, 0x04, 0x6c, 0x69, 0x73,
+	0x74, 0x12, 0x1d, 0x0a, 0x03, 0x73, 0x75, 0x62, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09,
+	0x2e, 0x53, 0x75, 0x62, 0x51, 0x75, 0x65, 0x72, 0x79, 0x48, 0x00, 0x52, 0x03, 0x73, 0x75, 0x62,
+	0x12, 0x21, 0x0a, 0x04, 0x63, 0x6f, 0x72, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b,
+	0x2e, 0x43, 0x6f, 0x72, 0x72, 0x43, 0x6f, 0x6c, 0x52, 0x65, 0x66, 0x48, 0x00, 0x52, 0x04, 0x63,
+	0x6f, 0x72, 0x72, 0x42, 0x06, 0x0a, 0x04, 0x65, 0x78, 0x70, 0x72, 0x22, 0x62, 0x0a, 0x0b, 0x44,
+	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x45, 0x78, 0x70, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x78,
+	0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x65, 0x78, 0x69, 0x73, 0x74,
+	0x12, 0x24, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0e, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x73, 0x5f, 0x6e, 0x75, 0x6c,
+	0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x73, 0x4e, 0x75, 0x6c, 0x6c, 0x22,
+	0x84, 0x03, 0x0a, 0x0d, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x12, 0x1d, 0x0a, 0x09, 0x75, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x5f, 0x76, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x08, 0x75, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x56,
+	0x12, 0x19, 0x0a, 0x07, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x76, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x48, 0x00, 0x52, 0x06, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x12, 0x21, 0x0a, 0x0b, 0x64,
+	0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x36, 0x34, 0x5f, 0x76, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x48, 0x00, 0x52, 0x0a, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x36, 0x34, 0x56, 0x12, 0x30,
+	0x0a, 0x0c, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x31, 0x32, 0x38, 0x5f, 0x76, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x31, 0x32,
+	0x38, 0x48, 0x00, 0x52, 0x0b, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x31, 0x32, 0x38, 0x56,
+	0x12, 0x1b, 0x0a, 0x08, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x76, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x04, 0x48, 0x00, 0x52, 0x07, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x12, 0x1d, 0x0a,
+	0x09, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x33, 0x32, 0x5f, 0x76, 0x18, 0x06, 0x20, 0x01, 0x28, 0x02,
+	0x48, 0x00, 0x52, 0x08, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x33, 0x32, 0x56, 0x12, 0x1d, 0x0a, 0x09,
+	0x66, 0x6c, 0x6f, 0x61, 0x74, 0x36, 0x34, 0x5f, 0x76, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x48,
+	0x00, 0x52, 0x08, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x36, 0x34, 0x56, 0x12, 0x17, 0x0a, 0x06, 0x64,
+	0x61, 0x74, 0x65, 0x5f, 0x76, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x05, 0x64,
+	0x61, 0x74, 0x65, 0x56, 0x12, 0x1f, 0x0a, 0x0a, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65,
+	0x5f, 0x76, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x09, 0x64, 0x61, 0x74, 0x65,
+	0x54, 0x69, 0x6d, 0x65, 0x56, 0x12, 0x21, 0x0a, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61,
+	0x6d, 0x70, 0x5f, 0x76, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x0a, 0x74, 0x69,
+	0x6d, 0x65, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x56, 0x12, 0x1b, 0x0a, 0x08, 0x73, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x5f, 0x76, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x73, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x56, 0x42, 0x0f, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x2c, 0x0a, 0x0a, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61,
+	0x6c, 0x31, 0x32, 0x38, 0x12, 0x0e, 0x0a, 0x02, 0x4c, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x02, 0x4c, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x48, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x02, 0x48, 0x69, 0x22, 0x96, 0x02, 0x0a, 0x06, 0x43, 0x6f, 0x6c, 0x44, 0x65, 0x66, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x1f, 0x0a, 0x03, 0x61, 0x6c, 0x67,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73,
+	0x73, 0x54, 0x79, 0x70, 0x65, 0x52, 0x03, 0x61, 0x6c, 0x67, 0x12, 0x17, 0x0a, 0x03, 0x74, 0x79,
+	0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x03,
+	0x74, 0x79, 0x70, 0x12, 0x26, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x45, 0x78,
+	0x70, 0x72, 0x52, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70,
+	0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x72,
+	0x69, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x6b, 0x69, 0x64, 0x78, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x70, 0x6b, 0x69, 0x64, 0x78, 0x12, 0x25, 0x0a, 0x0e, 0x61,
+	0x75, 0x74, 0x6f, 0x5f, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0d, 0x61, 0x75, 0x74, 0x6f, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x29, 0x0a, 0x08, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x08, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x22, 0x92, 0x01,
+	0x0a, 0x08, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x44, 0x65, 0x66, 0x12, 0x25, 0x0a, 0x03, 0x74, 0x79,
+	0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x44,
+	0x65, 0x66, 0x2e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x54, 0x79, 0x70, 0x65, 0x52, 0x03, 0x74, 0x79,
+	0x70, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x6c, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6c, 0x4e, 0x61, 0x6d,
+	0x65, 0x73, 0x22, 0x2e, 0x0a, 0x09, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x0b, 0x0a, 0x07, 0x49, 0x4e, 0x56, 0x41, 0x49, 0x4c, 0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07,
+	0x5a, 0x4f, 0x4e, 0x45, 0x4d, 0x41, 0x50, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x42, 0x53, 0x49,
+	0x10, 0x02, 0x22, 0x25, 0x0a, 0x0d, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x4b, 0x65, 0x79,
+	0x44, 0x65, 0x66, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x32, 0x0a, 0x08, 0x50, 0x72, 0x6f,
+	0x70, 0x65, 0x72, 0x74, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x3a, 0x0a,
+	0x0d, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x44, 0x65, 0x66, 0x12, 0x29,
+	0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x09, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x52, 0x0a, 0x70,
+	0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0xfe, 0x01, 0x0a, 0x08, 0x54, 0x61,
+	0x62, 0x6c, 0x65, 0x44, 0x65, 0x66, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c,
 	0x69, 0x61, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73,
-	0x12, 0x1f, 0x0a, 0x03, 0x61, 0x6c, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e,
-	0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x54, 0x79, 0x70, 0x65, 0x52, 0x03, 0x61, 0x6c,
-	0x67, 0x12, 0x17, 0x0a, 0x03, 0x74, 0x79, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05,
-	0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x03, 0x74, 0x79, 0x70, 0x12, 0x26, 0x0a, 0x07, 0x64, 0x65,
-	0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x44, 0x65,
-	0x66, 0x61, 0x75, 0x6c, 0x74, 0x45, 0x78, 0x70, 0x72, 0x52, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75,
-	0x6c, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x14, 0x0a, 0x05,
-	0x70, 0x6b, 0x69, 0x64, 0x78, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x70, 0x6b, 0x69,
-	0x64, 0x78, 0x22, 0x92, 0x01, 0x0a, 0x08, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x44, 0x65, 0x66, 0x12,
-	0x25, 0x0a, 0x03, 0x74, 0x79, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x49,
-	0x6e, 0x64, 0x65, 0x78, 0x44, 0x65, 0x66, 0x2e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x54, 0x79, 0x70,
-	0x65, 0x52, 0x03, 0x74, 0x79, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f,
-	0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x63,
-	0x6f, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x2e, 0x0a, 0x09, 0x49, 0x6e, 0x64, 0x65, 0x78,
-	0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x49, 0x4e, 0x56, 0x41, 0x49, 0x4c, 0x44, 0x10,
-	0x00, 0x12, 0x0b, 0x0a, 0x07, 0x5a, 0x4f, 0x4e, 0x45, 0x4d, 0x41, 0x50, 0x10, 0x01, 0x12, 0x07,
-	0x0a, 0x03, 0x42, 0x53, 0x49, 0x10, 0x02, 0x22, 0x25, 0x0a, 0x0d, 0x50, 0x72, 0x69, 0x6d, 0x61,
-	0x72, 0x79, 0x4b, 0x65, 0x79, 0x44, 0x65, 0x66, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x61, 0x6d, 0x65,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x32,
-	0x0a, 0x08, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x22, 0x3a, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73,
-	0x44, 0x65, 0x66, 0x12, 0x29, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72,
-	0x74, 0x79, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0xfe,
-	0x01, 0x0a, 0x08, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x65, 0x66, 0x12, 0x12, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x14, 0x0a, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x61, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x1b, 0x0a, 0x04, 0x63, 0x6f, 0x6c, 0x73, 0x18, 0x03, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x43, 0x6f, 0x6c, 0x44, 0x65, 0x66, 0x52, 0x04, 0x63, 0x6f,
-	0x6c, 0x73, 0x12, 0x25, 0x0a, 0x04, 0x64, 0x65, 0x66, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x11, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x65, 0x66, 0x2e, 0x44, 0x65, 0x66, 0x54,
-	0x79, 0x70, 0x65, 0x52, 0x04, 0x64, 0x65, 0x66, 0x73, 0x1a, 0x83, 0x01, 0x0a, 0x07, 0x44, 0x65,
-	0x66, 0x54, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x02, 0x70, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0e, 0x2e, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x4b, 0x65, 0x79, 0x44, 0x65,
-	0x66, 0x48, 0x00, 0x52, 0x02, 0x70, 0x6b, 0x12, 0x1d, 0x0a, 0x03, 0x69, 0x64, 0x78, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x44, 0x65, 0x66, 0x48,
-	0x00, 0x52, 0x03, 0x69, 0x64, 0x78, 0x12, 0x30, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72,
-	0x74, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x50, 0x72, 0x6f,
-	0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x44, 0x65, 0x66, 0x48, 0x00, 0x52, 0x0a, 0x70, 0x72,
-	0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x42, 0x05, 0x0a, 0x03, 0x64, 0x65, 0x66, 0x22,
-	0x72, 0x0a, 0x04, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x61, 0x72, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x63, 0x61, 0x72, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x72,
-	0x6f, 0x77, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x72, 0x6f,
-	0x77, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6e, 0x64, 0x76, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x01, 0x52, 0x03, 0x6e, 0x64, 0x76, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x14, 0x0a,
-	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x74, 0x6f,
-	0x74, 0x61, 0x6c, 0x22, 0xb1, 0x01, 0x0a, 0x07, 0x43, 0x6f, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x12,
-	0x1b, 0x0a, 0x09, 0x72, 0x6f, 0x77, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x08, 0x72, 0x6f, 0x77, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
-	0x6e, 0x75, 0x6c, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x09, 0x6e, 0x75, 0x6c, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6e,
-	0x75, 0x6c, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x08, 0x52, 0x05, 0x6e, 0x75, 0x6c, 0x6c,
-	0x73, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x33, 0x32, 0x18, 0x04, 0x20, 0x03, 0x28, 0x05, 0x52, 0x03,
-	0x69, 0x33, 0x32, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x36, 0x34, 0x18, 0x05, 0x20, 0x03, 0x28, 0x03,
-	0x52, 0x03, 0x69, 0x36, 0x34, 0x12, 0x10, 0x0a, 0x03, 0x66, 0x33, 0x32, 0x18, 0x06, 0x20, 0x03,
-	0x28, 0x02, 0x52, 0x03, 0x66, 0x33, 0x32, 0x12, 0x10, 0x0a, 0x03, 0x66, 0x36, 0x34, 0x18, 0x07,
-	0x20, 0x03, 0x28, 0x01, 0x52, 0x03, 0x66, 0x36, 0x34, 0x12, 0x0c, 0x0a, 0x01, 0x73, 0x18, 0x08,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x01, 0x73, 0x22, 0x4d, 0x0a, 0x0a, 0x52, 0x6f, 0x77, 0x73, 0x65,
-	0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x21, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x65, 0x66,
-	0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x1c, 0x0a, 0x04, 0x63, 0x6f, 0x6c, 0x73,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x43, 0x6f, 0x6c, 0x44, 0x61, 0x74, 0x61,
-	0x52, 0x04, 0x63, 0x6f, 0x6c, 0x73, 0x22, 0xd1, 0x01, 0x0a, 0x0b, 0x4f, 0x72, 0x64, 0x65, 0x72,
-	0x42, 0x79, 0x53, 0x70, 0x65, 0x63, 0x12, 0x19, 0x0a, 0x04, 0x65, 0x78, 0x70, 0x72, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x04, 0x65, 0x78, 0x70,
-	0x72, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x2c, 0x0a, 0x04, 0x66, 0x6c, 0x61, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e,
-	0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x4f, 0x72, 0x64, 0x65,
-	0x72, 0x42, 0x79, 0x46, 0x6c, 0x61, 0x67, 0x52, 0x04, 0x66, 0x6c, 0x61, 0x67, 0x22, 0x5b, 0x0a,
-	0x0b, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x07, 0x0a, 0x03,
-	0x41, 0x53, 0x43, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x44, 0x45, 0x53, 0x43, 0x10, 0x01, 0x12,
-	0x0f, 0x0a, 0x0b, 0x4e, 0x55, 0x4c, 0x4c, 0x53, 0x5f, 0x46, 0x49, 0x52, 0x53, 0x54, 0x10, 0x02,
-	0x12, 0x0e, 0x0a, 0x0a, 0x4e, 0x55, 0x4c, 0x4c, 0x53, 0x5f, 0x4c, 0x41, 0x53, 0x54, 0x10, 0x04,
-	0x12, 0x0a, 0x0a, 0x06, 0x55, 0x4e, 0x49, 0x51, 0x55, 0x45, 0x10, 0x08, 0x12, 0x0c, 0x0a, 0x08,
-	0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x10, 0x10, 0x22, 0x85, 0x01, 0x0a, 0x0a, 0x57,
-	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x53, 0x70, 0x65, 0x63, 0x12, 0x28, 0x0a, 0x0c, 0x70, 0x61, 0x72,
-	0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x79, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x05, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x0b, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f,
-	0x6e, 0x42, 0x79, 0x12, 0x27, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x53,
-	0x70, 0x65, 0x63, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x12, 0x12, 0x0a, 0x04,
-	0x6c, 0x65, 0x61, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x6c, 0x65, 0x61, 0x64,
-	0x12, 0x10, 0x0a, 0x03, 0x6c, 0x61, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6c,
-	0x61, 0x67, 0x22, 0x4c, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74,
-	0x12, 0x1f, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x05, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e,
-	0x73, 0x12, 0x1d, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x05, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73,
-	0x22, 0xec, 0x09, 0x0a, 0x04, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x2b, 0x0a, 0x09, 0x6e, 0x6f, 0x64,
-	0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x4e,
-	0x6f, 0x64, 0x65, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x6e, 0x6f,
-	0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12,
-	0x19, 0x0a, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e,
-	0x43, 0x6f, 0x73, 0x74, 0x52, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x0c, 0x70, 0x72,
-	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x05, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
-	0x4c, 0x69, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e,
-	0x18, 0x05, 0x20, 0x03, 0x28, 0x05, 0x52, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e,
-	0x12, 0x2b, 0x0a, 0x09, 0x6a, 0x6f, 0x69, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x46,
-	0x6c, 0x61, 0x67, 0x52, 0x08, 0x6a, 0x6f, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a,
-	0x07, 0x6f, 0x6e, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x05,
-	0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x06, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x24, 0x0a,
-	0x0a, 0x77, 0x68, 0x65, 0x72, 0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x08, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x05, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x09, 0x77, 0x68, 0x65, 0x72, 0x65, 0x4c,
-	0x69, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x62, 0x79, 0x18,
-	0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x07, 0x67, 0x72,
-	0x6f, 0x75, 0x70, 0x42, 0x79, 0x12, 0x28, 0x0a, 0x0c, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x69, 0x6e,
-	0x67, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x45, 0x78,
-	0x70, 0x72, 0x52, 0x0b, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x74, 0x12,
-	0x20, 0x0a, 0x08, 0x61, 0x67, 0x67, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x0b, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x05, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x07, 0x61, 0x67, 0x67, 0x4c, 0x69, 0x73,
-	0x74, 0x12, 0x27, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x18, 0x0c, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x53, 0x70, 0x65,
-	0x63, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x12, 0x2c, 0x0a, 0x0b, 0x75, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0b, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x0a, 0x75, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x08, 0x77, 0x69, 0x6e, 0x5f,
-	0x73, 0x70, 0x65, 0x63, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x57, 0x69, 0x6e,
-	0x64, 0x6f, 0x77, 0x53, 0x70, 0x65, 0x63, 0x52, 0x07, 0x77, 0x69, 0x6e, 0x53, 0x70, 0x65, 0x63,
-	0x12, 0x1b, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x05, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1d, 0x0a,
-	0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e,
-	0x45, 0x78, 0x70, 0x72, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x26, 0x0a, 0x09,
-	0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x64, 0x65, 0x66, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x09, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x65, 0x66, 0x52, 0x08, 0x74, 0x61, 0x62, 0x6c,
-	0x65, 0x44, 0x65, 0x66, 0x12, 0x23, 0x0a, 0x07, 0x6f, 0x62, 0x6a, 0x5f, 0x72, 0x65, 0x66, 0x18,
-	0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65,
-	0x66, 0x52, 0x06, 0x6f, 0x62, 0x6a, 0x52, 0x65, 0x66, 0x12, 0x2c, 0x0a, 0x0b, 0x72, 0x6f, 0x77,
-	0x73, 0x65, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b,
-	0x2e, 0x52, 0x6f, 0x77, 0x73, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0a, 0x72, 0x6f, 0x77,
-	0x73, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x74, 0x72, 0x61,
-	0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
-	0x65, 0x78, 0x74, 0x72, 0x61, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xff, 0x02, 0x0a,
-	0x08, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b,
-	0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x56, 0x41, 0x4c, 0x55, 0x45, 0x5f,
-	0x53, 0x43, 0x41, 0x4e, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x5f,
-	0x53, 0x43, 0x41, 0x4e, 0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d, 0x46, 0x55, 0x4e, 0x43, 0x54, 0x49,
-	0x4f, 0x4e, 0x5f, 0x53, 0x43, 0x41, 0x4e, 0x10, 0x03, 0x12, 0x11, 0x0a, 0x0d, 0x45, 0x58, 0x54,
-	0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x53, 0x43, 0x41, 0x4e, 0x10, 0x04, 0x12, 0x11, 0x0a, 0x0d,
-	0x4d, 0x41, 0x54, 0x45, 0x52, 0x49, 0x41, 0x4c, 0x5f, 0x53, 0x43, 0x41, 0x4e, 0x10, 0x05, 0x12,
-	0x0b, 0x0a, 0x07, 0x50, 0x52, 0x4f, 0x4a, 0x45, 0x43, 0x54, 0x10, 0x0a, 0x12, 0x15, 0x0a, 0x11,
-	0x45, 0x58, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x46, 0x55, 0x4e, 0x43, 0x54, 0x49, 0x4f,
-	0x4e, 0x10, 0x0b, 0x12, 0x0c, 0x0a, 0x08, 0x4d, 0x41, 0x54, 0x45, 0x52, 0x49, 0x41, 0x4c, 0x10,
-	0x14, 0x12, 0x11, 0x0a, 0x0d, 0x52, 0x45, 0x43, 0x55, 0x52, 0x53, 0x49, 0x56, 0x45, 0x5f, 0x43,
-	0x54, 0x45, 0x10, 0x15, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x49, 0x4e, 0x4b, 0x10, 0x16, 0x12, 0x0d,
-	0x0a, 0x09, 0x53, 0x49, 0x4e, 0x4b, 0x5f, 0x53, 0x43, 0x41, 0x4e, 0x10, 0x17, 0x12, 0x07, 0x0a,
-	0x03, 0x41, 0x47, 0x47, 0x10, 0x1e, 0x12, 0x08, 0x0a, 0x04, 0x4a, 0x4f, 0x49, 0x4e, 0x10, 0x1f,
-	0x12, 0x0a, 0x0a, 0x06, 0x53, 0x41, 0x4d, 0x50, 0x4c, 0x45, 0x10, 0x20, 0x12, 0x08, 0x0a, 0x04,
-	0x53, 0x4f, 0x52, 0x54, 0x10, 0x21, 0x12, 0x09, 0x0a, 0x05, 0x55, 0x4e, 0x49, 0x4f, 0x4e, 0x10,
-	0x22, 0x12, 0x0d, 0x0a, 0x09, 0x55, 0x4e, 0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x4c, 0x4c, 0x10, 0x23,
-	0x12, 0x0a, 0x0a, 0x06, 0x55, 0x4e, 0x49, 0x51, 0x55, 0x45, 0x10, 0x24, 0x12, 0x0a, 0x0a, 0x06,
-	0x57, 0x49, 0x4e, 0x44, 0x4f, 0x57, 0x10, 0x25, 0x12, 0x0d, 0x0a, 0x09, 0x42, 0x52, 0x4f, 0x41,
-	0x44, 0x43, 0x41, 0x53, 0x54, 0x10, 0x28, 0x12, 0x09, 0x0a, 0x05, 0x53, 0x50, 0x4c, 0x49, 0x54,
-	0x10, 0x29, 0x12, 0x0a, 0x0a, 0x06, 0x47, 0x41, 0x54, 0x48, 0x45, 0x52, 0x10, 0x2a, 0x12, 0x0a,
-	0x0a, 0x06, 0x41, 0x53, 0x53, 0x45, 0x52, 0x54, 0x10, 0x32, 0x12, 0x0a, 0x0a, 0x06, 0x49, 0x4e,
-	0x53, 0x45, 0x52, 0x54, 0x10, 0x33, 0x12, 0x0a, 0x0a, 0x06, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45,
-	0x10, 0x34, 0x12, 0x0a, 0x0a, 0x06, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x35, 0x22, 0x55,
-	0x0a, 0x08, 0x4a, 0x6f, 0x69, 0x6e, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x09, 0x0a, 0x05, 0x49, 0x4e,
-	0x4e, 0x45, 0x52, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x4f, 0x55, 0x54, 0x45, 0x52, 0x10, 0x01,
-	0x12, 0x08, 0x0a, 0x04, 0x53, 0x45, 0x4d, 0x49, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x41, 0x4e,
-	0x54, 0x49, 0x10, 0x04, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x49, 0x4e, 0x47, 0x4c, 0x45, 0x10, 0x08,
-	0x12, 0x08, 0x0a, 0x04, 0x4d, 0x41, 0x52, 0x4b, 0x10, 0x10, 0x12, 0x09, 0x0a, 0x05, 0x41, 0x50,
-	0x50, 0x4c, 0x59, 0x10, 0x20, 0x22, 0x28, 0x0a, 0x07, 0x41, 0x67, 0x67, 0x4d, 0x6f, 0x64, 0x65,
-	0x12, 0x08, 0x0a, 0x04, 0x46, 0x55, 0x4c, 0x4c, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x42, 0x4f,
-	0x54, 0x54, 0x4f, 0x4d, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x54, 0x4f, 0x50, 0x10, 0x02, 0x22,
-	0xe5, 0x01, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x31, 0x0a, 0x09, 0x73, 0x74, 0x6d,
-	0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79,
-	0x70, 0x65, 0x52, 0x08, 0x73, 0x74, 0x6d, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05,
-	0x73, 0x74, 0x65, 0x70, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x05, 0x52, 0x05, 0x73, 0x74, 0x65,
-	0x70, 0x73, 0x12, 0x1b, 0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x05, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x12,
-	0x1d, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x05, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x57,
-	0x0a, 0x0d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12,
-	0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06,
-	0x53, 0x45, 0x4c, 0x45, 0x43, 0x54, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x49, 0x4e, 0x53, 0x45,
-	0x52, 0x54, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x03,
-	0x12, 0x0a, 0x0a, 0x06, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x10, 0x04, 0x12, 0x09, 0x0a, 0x05,
-	0x4d, 0x45, 0x52, 0x47, 0x45, 0x10, 0x05, 0x22, 0x8e, 0x02, 0x0a, 0x11, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x12, 0x35, 0x0a,
-	0x08, 0x74, 0x63, 0x6c, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x1a, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74,
-	0x72, 0x6f, 0x6c, 0x2e, 0x54, 0x63, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x52, 0x07, 0x74, 0x63, 0x6c,
-	0x54, 0x79, 0x70, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x62, 0x65, 0x67, 0x69, 0x6e, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x42, 0x65, 0x67, 0x69, 0x6e, 0x48, 0x00, 0x52, 0x05, 0x62, 0x65, 0x67, 0x69, 0x6e, 0x12, 0x2b,
-	0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
-	0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
-	0x74, 0x48, 0x00, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x31, 0x0a, 0x08, 0x72,
-	0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61,
-	0x63, 0x6b, 0x48, 0x00, 0x52, 0x08, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x22, 0x2e,
-	0x0a, 0x07, 0x54, 0x63, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x42, 0x45, 0x47,
-	0x49, 0x4e, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x10, 0x01,
-	0x12, 0x0c, 0x0a, 0x08, 0x52, 0x4f, 0x4c, 0x4c, 0x42, 0x41, 0x43, 0x4b, 0x10, 0x02, 0x42, 0x08,
-	0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x81, 0x01, 0x0a, 0x0f, 0x54, 0x72, 0x61,
-	0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x12, 0x33, 0x0a, 0x04,
-	0x6d, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x54, 0x72, 0x61,
-	0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x2e, 0x54, 0x72, 0x61,
-	0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64,
-	0x65, 0x22, 0x39, 0x0a, 0x0e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d,
-	0x6f, 0x64, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x0d, 0x0a,
-	0x09, 0x52, 0x45, 0x41, 0x44, 0x5f, 0x4f, 0x4e, 0x4c, 0x59, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a,
-	0x52, 0x45, 0x41, 0x44, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0x02, 0x22, 0x56, 0x0a, 0x10,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
-	0x12, 0x42, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74,
-	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e,
-	0x54, 0x79, 0x70, 0x65, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e,
-	0x54, 0x79, 0x70, 0x65, 0x22, 0x58, 0x0a, 0x12, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x42, 0x0a, 0x0f, 0x63, 0x6f,
-	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0e,
-	0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x22, 0x7b,
-	0x0a, 0x04, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x1e, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x48, 0x00, 0x52,
-	0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x26, 0x0a, 0x03, 0x74, 0x63, 0x6c, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x48, 0x00, 0x52, 0x03, 0x74, 0x63, 0x6c, 0x12, 0x23,
-	0x0a, 0x03, 0x64, 0x64, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x44, 0x61,
-	0x74, 0x61, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x03,
-	0x64, 0x64, 0x6c, 0x42, 0x06, 0x0a, 0x04, 0x70, 0x6c, 0x61, 0x6e, 0x22, 0xc4, 0x08, 0x0a, 0x0e,
-	0x44, 0x61, 0x74, 0x61, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x32,
-	0x0a, 0x08, 0x64, 0x64, 0x6c, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x17, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f,
-	0x6e, 0x2e, 0x44, 0x64, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x52, 0x07, 0x64, 0x64, 0x6c, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x1c, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x06, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79,
-	0x12, 0x3a, 0x0a, 0x0f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x62,
-	0x61, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0e, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x0e,
-	0x61, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61,
-	0x62, 0x61, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0d, 0x61, 0x6c, 0x74, 0x65, 0x72, 0x44, 0x61, 0x74,
-	0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x0d, 0x64, 0x72, 0x6f, 0x70, 0x5f, 0x64, 0x61,
-	0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x44,
-	0x72, 0x6f, 0x70, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0c, 0x64,
-	0x72, 0x6f, 0x70, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x0c, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0c, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x48,
-	0x00, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x2e,
-	0x0a, 0x0b, 0x61, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x54, 0x61, 0x62, 0x6c, 0x65,
-	0x48, 0x00, 0x52, 0x0a, 0x61, 0x6c, 0x74, 0x65, 0x72, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x2b,
-	0x0a, 0x0a, 0x64, 0x72, 0x6f, 0x70, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x44, 0x72, 0x6f, 0x70, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x48, 0x00,
-	0x52, 0x09, 0x64, 0x72, 0x6f, 0x70, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x31, 0x0a, 0x0c, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x09, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0c, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x48,
-	0x00, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x2e,
-	0x0a, 0x0b, 0x61, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x0a, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78,
-	0x48, 0x00, 0x52, 0x0a, 0x61, 0x6c, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x2b,
-	0x0a, 0x0a, 0x64, 0x72, 0x6f, 0x70, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x0b, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x44, 0x72, 0x6f, 0x70, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x48, 0x00,
-	0x52, 0x09, 0x64, 0x72, 0x6f, 0x70, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x37, 0x0a, 0x0e, 0x74,
-	0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x0c, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x54, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x54, 0x61,
-	0x62, 0x6c, 0x65, 0x48, 0x00, 0x52, 0x0d, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x54,
-	0x61, 0x62, 0x6c, 0x65, 0x12, 0x37, 0x0a, 0x0e, 0x73, 0x68, 0x6f, 0x77, 0x5f, 0x76, 0x61, 0x72,
-	0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x53,
-	0x68, 0x6f, 0x77, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x48, 0x00, 0x52, 0x0d,
-	0x73, 0x68, 0x6f, 0x77, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x22, 0x94, 0x03,
-	0x0a, 0x07, 0x44, 0x64, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x13, 0x0a, 0x0f, 0x43, 0x52, 0x45,
-	0x41, 0x54, 0x45, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x42, 0x41, 0x53, 0x45, 0x10, 0x00, 0x12, 0x12,
-	0x0a, 0x0e, 0x41, 0x4c, 0x54, 0x45, 0x52, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x42, 0x41, 0x53, 0x45,
-	0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x44, 0x52, 0x4f, 0x50, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x42,
-	0x41, 0x53, 0x45, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x5f,
-	0x54, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x03, 0x12, 0x0f, 0x0a, 0x0b, 0x41, 0x4c, 0x54, 0x45, 0x52,
-	0x5f, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x04, 0x12, 0x0e, 0x0a, 0x0a, 0x44, 0x52, 0x4f, 0x50,
-	0x5f, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x05, 0x12, 0x10, 0x0a, 0x0c, 0x43, 0x52, 0x45, 0x41,
-	0x54, 0x45, 0x5f, 0x49, 0x4e, 0x44, 0x45, 0x58, 0x10, 0x06, 0x12, 0x0f, 0x0a, 0x0b, 0x41, 0x4c,
-	0x54, 0x45, 0x52, 0x5f, 0x49, 0x4e, 0x44, 0x45, 0x58, 0x10, 0x07, 0x12, 0x0e, 0x0a, 0x0a, 0x44,
-	0x52, 0x4f, 0x50, 0x5f, 0x49, 0x4e, 0x44, 0x45, 0x58, 0x10, 0x08, 0x12, 0x12, 0x0a, 0x0e, 0x54,
-	0x52, 0x55, 0x4e, 0x43, 0x41, 0x54, 0x45, 0x5f, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x09, 0x12,
-	0x17, 0x0a, 0x13, 0x53, 0x48, 0x4f, 0x57, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x44, 0x41,
-	0x54, 0x41, 0x42, 0x41, 0x53, 0x45, 0x10, 0x0a, 0x12, 0x14, 0x0a, 0x10, 0x53, 0x48, 0x4f, 0x57,
-	0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x0b, 0x12, 0x12,
-	0x0a, 0x0e, 0x53, 0x48, 0x4f, 0x57, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x42, 0x41, 0x53, 0x45, 0x53,
-	0x10, 0x0c, 0x12, 0x0f, 0x0a, 0x0b, 0x53, 0x48, 0x4f, 0x57, 0x5f, 0x54, 0x41, 0x42, 0x4c, 0x45,
-	0x53, 0x10, 0x0d, 0x12, 0x10, 0x0a, 0x0c, 0x53, 0x48, 0x4f, 0x57, 0x5f, 0x43, 0x4f, 0x4c, 0x55,
-	0x4d, 0x4e, 0x53, 0x10, 0x0e, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x48, 0x4f, 0x57, 0x5f, 0x49, 0x4e,
-	0x44, 0x45, 0x58, 0x10, 0x0f, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x48, 0x4f, 0x57, 0x5f, 0x56, 0x41,
-	0x52, 0x49, 0x41, 0x42, 0x4c, 0x45, 0x53, 0x10, 0x10, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x48, 0x4f,
-	0x57, 0x5f, 0x57, 0x41, 0x52, 0x4e, 0x49, 0x4e, 0x47, 0x53, 0x10, 0x11, 0x12, 0x0f, 0x0a, 0x0b,
-	0x53, 0x48, 0x4f, 0x57, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x53, 0x10, 0x12, 0x12, 0x0f, 0x0a,
-	0x0b, 0x53, 0x48, 0x4f, 0x57, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x10, 0x13, 0x12, 0x14,
-	0x0a, 0x10, 0x53, 0x48, 0x4f, 0x57, 0x5f, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x53, 0x53, 0x4c, 0x49,
-	0x53, 0x54, 0x10, 0x14, 0x42, 0x0c, 0x0a, 0x0a, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69,
-	0x6f, 0x6e, 0x22, 0x50, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61,
-	0x62, 0x61, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x69, 0x66, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x65,
-	0x78, 0x69, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x66, 0x4e,
-	0x6f, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61,
-	0x62, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61,
-	0x62, 0x61, 0x73, 0x65, 0x22, 0x48, 0x0a, 0x0d, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x44, 0x61, 0x74,
-	0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x66, 0x5f, 0x65, 0x78, 0x69, 0x73,
-	0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x66, 0x45, 0x78, 0x69, 0x73,
-	0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x22, 0x47,
-	0x0a, 0x0c, 0x44, 0x72, 0x6f, 0x70, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x1b,
+	0x12, 0x1b, 0x0a, 0x04, 0x63, 0x6f, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x07,
+	0x2e, 0x43, 0x6f, 0x6c, 0x44, 0x65, 0x66, 0x52, 0x04, 0x63, 0x6f, 0x6c, 0x73, 0x12, 0x25, 0x0a,
+	0x04, 0x64, 0x65, 0x66, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x54, 0x61,
+	0x62, 0x6c, 0x65, 0x44, 0x65, 0x66, 0x2e, 0x44, 0x65, 0x66, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04,
+	0x64, 0x65, 0x66, 0x73, 0x1a, 0x83, 0x01, 0x0a, 0x07, 0x44, 0x65, 0x66, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x20, 0x0a, 0x02, 0x70, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x50,
+	0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x4b, 0x65, 0x79, 0x44, 0x65, 0x66, 0x48, 0x00, 0x52, 0x02,
+	0x70, 0x6b, 0x12, 0x1d, 0x0a, 0x03, 0x69, 0x64, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x09, 0x2e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x44, 0x65, 0x66, 0x48, 0x00, 0x52, 0x03, 0x69, 0x64,
+	0x78, 0x12, 0x30, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69,
+	0x65, 0x73, 0x44, 0x65, 0x66, 0x48, 0x00, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74,
+	0x69, 0x65, 0x73, 0x42, 0x05, 0x0a, 0x03, 0x64, 0x65, 0x66, 0x22, 0x72, 0x0a, 0x04, 0x43, 0x6f,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x61, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x04, 0x63, 0x61, 0x72, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x6f, 0x77, 0x73, 0x69, 0x7a,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x72, 0x6f, 0x77, 0x73, 0x69, 0x7a, 0x65,
+	0x12, 0x10, 0x0a, 0x03, 0x6e, 0x64, 0x76, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x6e,
+	0x64, 0x76, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0xb1,
+	0x01, 0x0a, 0x07, 0x43, 0x6f, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x6f,
+	0x77, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x72,
+	0x6f, 0x77, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x75, 0x6c, 0x6c, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6e, 0x75, 0x6c,
+	0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x75, 0x6c, 0x6c, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x08, 0x52, 0x05, 0x6e, 0x75, 0x6c, 0x6c, 0x73, 0x12, 0x10, 0x0a, 0x03,
+	0x69, 0x33, 0x32, 0x18, 0x04, 0x20, 0x03, 0x28, 0x05, 0x52, 0x03, 0x69, 0x33, 0x32, 0x12, 0x10,
+	0x0a, 0x03, 0x69, 0x36, 0x34, 0x18, 0x05, 0x20, 0x03, 0x28, 0x03, 0x52, 0x03, 0x69, 0x36, 0x34,
+	0x12, 0x10, 0x0a, 0x03, 0x66, 0x33, 0x32, 0x18, 0x06, 0x20, 0x03, 0x28, 0x02, 0x52, 0x03, 0x66,
+	0x33, 0x32, 0x12, 0x10, 0x0a, 0x03, 0x66, 0x36, 0x34, 0x18, 0x07, 0x20, 0x03, 0x28, 0x01, 0x52,
+	0x03, 0x66, 0x36, 0x34, 0x12, 0x0c, 0x0a, 0x01, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x01, 0x73, 0x22, 0x4d, 0x0a, 0x0a, 0x52, 0x6f, 0x77, 0x73, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61,
+	0x12, 0x21, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x09, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x65, 0x66, 0x52, 0x06, 0x73, 0x63, 0x68,
+	0x65, 0x6d, 0x61, 0x12, 0x1c, 0x0a, 0x04, 0x63, 0x6f, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x08, 0x2e, 0x43, 0x6f, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x63, 0x6f, 0x6c,
+	0x73, 0x22, 0xd1, 0x01, 0x0a, 0x0b, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x53, 0x70, 0x65,
+	0x63, 0x12, 0x19, 0x0a, 0x04, 0x65, 0x78, 0x70, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x05, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x04, 0x65, 0x78, 0x70, 0x72, 0x12, 0x1c, 0x0a, 0x09,
+	0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x04, 0x66, 0x6c,
+	0x61, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x42, 0x79, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x46, 0x6c,
+	0x61, 0x67, 0x52, 0x04, 0x66, 0x6c, 0x61, 0x67, 0x22, 0x5b, 0x0a, 0x0b, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x42, 0x79, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x53, 0x43, 0x10, 0x00,
+	0x12, 0x08, 0x0a, 0x04, 0x44, 0x45, 0x53, 0x43, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x4e, 0x55,
+	0x4c, 0x4c, 0x53, 0x5f, 0x46, 0x49, 0x52, 0x53, 0x54, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x4e,
+	0x55, 0x4c, 0x4c, 0x53, 0x5f, 0x4c, 0x41, 0x53, 0x54, 0x10, 0x04, 0x12, 0x0a, 0x0a, 0x06, 0x55,
+	0x4e, 0x49, 0x51, 0x55, 0x45, 0x10, 0x08, 0x12, 0x0c, 0x0a, 0x08, 0x49, 0x4e, 0x54, 0x45, 0x52,
+	0x4e, 0x41, 0x4c, 0x10, 0x10, 0x22, 0x85, 0x01, 0x0a, 0x0a, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77,
+	0x53, 0x70, 0x65, 0x63, 0x12, 0x28, 0x0a, 0x0c, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x62, 0x79, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x45, 0x78, 0x70,
+	0x72, 0x52, 0x0b, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x79, 0x12, 0x27,
+	0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0c, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x53, 0x70, 0x65, 0x63, 0x52, 0x07,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x65, 0x61, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x6c, 0x65, 0x61, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x6c,
+	0x61, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6c, 0x61, 0x67, 0x22, 0x4c, 0x0a,
+	0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x07, 0x63,
+	0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x45,
+	0x78, 0x70, 0x72, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x1d, 0x0a, 0x06,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x45,
+	0x78, 0x70, 0x72, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0xff, 0x0a, 0x0a, 0x04,
+	0x4e, 0x6f, 0x64, 0x65, 0x12, 0x2b, 0x0a, 0x09, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x2e, 0x4e,
+	0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x04, 0x63, 0x6f,
+	0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x43, 0x6f, 0x73, 0x74, 0x52,
+	0x04, 0x63, 0x6f, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x45, 0x78,
+	0x70, 0x72, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x05, 0x52, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x12, 0x2b, 0x0a, 0x09, 0x6a,
+	0x6f, 0x69, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e,
+	0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x46, 0x6c, 0x61, 0x67, 0x52, 0x08,
+	0x6a, 0x6f, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x07, 0x6f, 0x6e, 0x5f, 0x6c,
+	0x69, 0x73, 0x74, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x45, 0x78, 0x70, 0x72,
+	0x52, 0x06, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0a, 0x77, 0x68, 0x65, 0x72,
+	0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x45,
+	0x78, 0x70, 0x72, 0x52, 0x09, 0x77, 0x68, 0x65, 0x72, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x20,
+	0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x62, 0x79, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x05, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79,
+	0x12, 0x28, 0x0a, 0x0c, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x65, 0x74,
+	0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x0b, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x74, 0x12, 0x20, 0x0a, 0x08, 0x61, 0x67,
+	0x67, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x45,
+	0x78, 0x70, 0x72, 0x52, 0x07, 0x61, 0x67, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x08,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c,
+	0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x53, 0x70, 0x65, 0x63, 0x52, 0x07, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x42, 0x79, 0x12, 0x2c, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f,
+	0x6c, 0x69, 0x73, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c,
+	0x69, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x08, 0x77, 0x69, 0x6e, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x18,
+	0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x53, 0x70,
+	0x65, 0x63, 0x52, 0x07, 0x77, 0x69, 0x6e, 0x53, 0x70, 0x65, 0x63, 0x12, 0x1b, 0x0a, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x45, 0x78, 0x70,
+	0x72, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1d, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52,
+	0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x26, 0x0a, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x5f, 0x64, 0x65, 0x66, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x54, 0x61, 0x62,
+	0x6c, 0x65, 0x44, 0x65, 0x66, 0x52, 0x08, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x65, 0x66, 0x12,
+	0x23, 0x0a, 0x07, 0x6f, 0x62, 0x6a, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0a, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x66, 0x52, 0x06, 0x6f, 0x62,
+	0x6a, 0x52, 0x65, 0x66, 0x12, 0x2c, 0x0a, 0x0b, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x74, 0x5f, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x52, 0x6f, 0x77, 0x73,
+	0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0a, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x74, 0x44, 0x61,
+	0x74, 0x61, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x78, 0x74, 0x72, 0x61,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2d, 0x0a, 0x0f, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x5f, 0x66, 0x75, 0x6e, 0x63, 0x5f, 0x61, 0x72, 0x67, 0x73, 0x18, 0x15, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x05, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x0d, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x46, 0x75,
+	0x6e, 0x63, 0x41, 0x72, 0x67, 0x73, 0x12, 0x2b, 0x0a, 0x09, 0x6a, 0x6f, 0x69, 0x6e, 0x5f, 0x61,
+	0x6c, 0x67, 0x6f, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x4e, 0x6f, 0x64, 0x65,
+	0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x41, 0x6c, 0x67, 0x6f, 0x52, 0x08, 0x6a, 0x6f, 0x69, 0x6e, 0x41,
+	0x6c, 0x67, 0x6f, 0x22, 0xff, 0x02, 0x0a, 0x08, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0e, 0x0a,
+	0x0a, 0x56, 0x41, 0x4c, 0x55, 0x45, 0x5f, 0x53, 0x43, 0x41, 0x4e, 0x10, 0x01, 0x12, 0x0e, 0x0a,
+	0x0a, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x53, 0x43, 0x41, 0x4e, 0x10, 0x02, 0x12, 0x11, 0x0a,
+	0x0d, 0x46, 0x55, 0x4e, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x43, 0x41, 0x4e, 0x10, 0x03,
+	0x12, 0x11, 0x0a, 0x0d, 0x45, 0x58, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x53, 0x43, 0x41,
+	0x4e, 0x10, 0x04, 0x12, 0x11, 0x0a, 0x0d, 0x4d, 0x41, 0x54, 0x45, 0x52, 0x49, 0x41, 0x4c, 0x5f,
+	0x53, 0x43, 0x41, 0x4e, 0x10, 0x05, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x52, 0x4f, 0x4a, 0x45, 0x43,
+	0x54, 0x10, 0x0a, 0x12, 0x15, 0x0a, 0x11, 0x45, 0x58, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f,
+	0x46, 0x55, 0x4e, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x0b, 0x12, 0x0c, 0x0a, 0x08, 0x4d, 0x41,
+	0x54, 0x45, 0x52, 0x49, 0x41, 0x4c, 0x10, 0x14, 0x12, 0x11, 0x0a, 0x0d, 0x52, 0x45, 0x43, 0x55,
+	0x52, 0x53, 0x49, 0x56, 0x45, 0x5f, 0x43, 0x54, 0x45, 0x10, 0x15, 0x12, 0x08, 0x0a, 0x04, 0x53,
+	0x49, 0x4e, 0x4b, 0x10, 0x16, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x49, 0x4e, 0x4b, 0x5f, 0x53, 0x43,
+	0x41, 0x4e, 0x10, 0x17, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x47, 0x47, 0x10, 0x1e, 0x12, 0x08, 0x0a,
+	0x04, 0x4a, 0x4f, 0x49, 0x4e, 0x10, 0x1f, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x41, 0x4d, 0x50, 0x4c,
+	0x45, 0x10, 0x20, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x4f, 0x52, 0x54, 0x10, 0x21, 0x12, 0x09, 0x0a,
+	0x05, 0x55, 0x4e, 0x49, 0x4f, 0x4e, 0x10, 0x22, 0x12, 0x0d, 0x0a, 0x09, 0x55, 0x4e, 0x49, 0x4f,
+	0x4e, 0x5f, 0x41, 0x4c, 0x4c, 0x10, 0x23, 0x12, 0x0a, 0x0a, 0x06, 0x55, 0x4e, 0x49, 0x51, 0x55,
+	0x45, 0x10, 0x24, 0x12, 0x0a, 0x0a, 0x06, 0x57, 0x49, 0x4e, 0x44, 0x4f, 0x57, 0x10, 0x25, 0x12,
+	0x0d, 0x0a, 0x09, 0x42, 0x52, 0x4f, 0x41, 0x44, 0x43, 0x41, 0x53, 0x54, 0x10, 0x28, 0x12, 0x09,
+	0x0a, 0x05, 0x53, 0x50, 0x4c, 0x49, 0x54, 0x10, 0x29, 0x12, 0x0a, 0x0a, 0x06, 0x47, 0x41, 0x54,
+	0x48, 0x45, 0x52, 0x10, 0x2a, 0x12, 0x0a, 0x0a, 0x06, 0x41, 0x53, 0x53, 0x45, 0x52, 0x54, 0x10,
+	0x32, 0x12, 0x0a, 0x0a, 0x06, 0x49, 0x4e, 0x53, 0x45, 0x52, 0x54, 0x10, 0x33, 0x12, 0x0a, 0x0a,
+	0x06, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x10, 0x34, 0x12, 0x0a, 0x0a, 0x06, 0x44, 0x45, 0x4c,
+	0x45, 0x54, 0x45, 0x10, 0x35, 0x22, 0x60, 0x0a, 0x08, 0x4a, 0x6f, 0x69, 0x6e, 0x46, 0x6c, 0x61,
+	0x67, 0x12, 0x09, 0x0a, 0x05, 0x49, 0x4e, 0x4e, 0x45, 0x52, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05,
+	0x4f, 0x55, 0x54, 0x45, 0x52, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x45, 0x4d, 0x49, 0x10,
+	0x02, 0x12, 0x08, 0x0a, 0x04, 0x41, 0x4e, 0x54, 0x49, 0x10, 0x04, 0x12, 0x0a, 0x0a, 0x06, 0x53,
+	0x49, 0x4e, 0x47, 0x4c, 0x45, 0x10, 0x08, 0x12, 0x08, 0x0a, 0x04, 0x4d, 0x41, 0x52, 0x4b, 0x10,
+	0x10, 0x12, 0x09, 0x0a, 0x05, 0x41, 0x50, 0x50, 0x4c, 0x59, 0x10, 0x20, 0x12, 0x09, 0x0a, 0x05,
+	0x52, 0x49, 0x47, 0x48, 0x54, 0x10, 0x40, 0x22, 0x28, 0x0a, 0x07, 0x41, 0x67, 0x67, 0x4d, 0x6f,
+	0x64, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x46, 0x55, 0x4c, 0x4c, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06,
+	0x42, 0x4f, 0x54, 0x54, 0x4f, 0x4d, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x54, 0x4f, 0x50, 0x10,
+	0x02, 0x22, 0x2a, 0x0a, 0x08, 0x4a, 0x6f, 0x69, 0x6e, 0x41, 0x6c, 0x67, 0x6f, 0x12, 0x08, 0x0a,
+	0x04, 0x48, 0x41, 0x53, 0x48, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x4d, 0x45, 0x52, 0x47, 0x45,
+	0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x49, 0x4e, 0x44, 0x45, 0x58, 0x10, 0x02, 0x22, 0xe5, 0x01,
+	0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x31, 0x0a, 0x09, 0x73, 0x74, 0x6d, 0x74, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x08, 0x73, 0x74, 0x6d, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74,
+	0x65, 0x70, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x05, 0x52, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73,
+	0x12, 0x1b, 0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x05, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x1d, 0x0a,
+	0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x05, 0x2e,
+	0x45, 0x78, 0x70, 0x72, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x57, 0x0a, 0x0d,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a,
+	0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x45,
+	0x4c, 0x45, 0x43, 0x54, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x49, 0x4e, 0x53, 0x45, 0x52, 0x54,
+	0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x03, 0x12, 0x0a,
+	0x0a, 0x06, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x10, 0x04, 0x12, 0x09, 0x0a, 0x05, 0x4d, 0x45,
+	0x52, 0x47, 0x45, 0x10, 0x05, 0x22, 0x8e, 0x02, 0x0a, 0x11, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x12, 0x35, 0x0a, 0x08, 0x74,
+	0x63, 0x6c, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x2e, 0x54, 0x63, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x52, 0x07, 0x74, 0x63, 0x6c, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x62, 0x65, 0x67, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x65,
+	0x67, 0x69, 0x6e, 0x48, 0x00, 0x52, 0x05, 0x62, 0x65, 0x67, 0x69, 0x6e, 0x12, 0x2b, 0x0a, 0x06,
+	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x48,
+	0x00, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x31, 0x0a, 0x08, 0x72, 0x6f, 0x6c,
+	0x6c, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b,
+	0x48, 0x00, 0x52, 0x08, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x22, 0x2e, 0x0a, 0x07,
+	0x54, 0x63, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x42, 0x45, 0x47, 0x49, 0x4e,
+	0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x10, 0x01, 0x12, 0x0c,
+	0x0a, 0x08, 0x52, 0x4f, 0x4c, 0x4c, 0x42, 0x41, 0x43, 0x4b, 0x10, 0x02, 0x42, 0x08, 0x0a, 0x06,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x81, 0x01, 0x0a, 0x0f, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x12, 0x33, 0x0a, 0x04, 0x6d, 0x6f,
+	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x22,
+	0x39, 0x0a, 0x0e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64,
+	0x65, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x52,
+	0x45, 0x41, 0x44, 0x5f, 0x4f, 0x4e, 0x4c, 0x59, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x52, 0x45,
+	0x41, 0x44, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0x02, 0x22, 0x56, 0x0a, 0x10, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x42,
+	0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79,
+	0x70, 0x65, 0x22, 0x58, 0x0a, 0x12, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x42, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x70,
+	0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x19, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f,
+	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0e, 0x63, 0x6f,
+	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x22, 0x7b, 0x0a, 0x04,
+	0x50, 0x6c, 0x61, 0x6e, 0x12, 0x1e, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x48, 0x00, 0x52, 0x05, 0x71,
+	0x75, 0x65, 0x72, 0x79, 0x12, 0x26, 0x0a, 0x03, 0x74, 0x63, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f,
+	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x48, 0x00, 0x52, 0x03, 0x74, 0x63, 0x6c, 0x12, 0x23, 0x0a, 0x03,
+	0x64, 0x64, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x44, 0x61, 0x74, 0x61,
+	0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x03, 0x64, 0x64,
+	0x6c, 0x42, 0x06, 0x0a, 0x04, 0x70, 0x6c, 0x61, 0x6e, 0x22, 0xcf, 0x09, 0x0a, 0x0e, 0x44, 0x61,
+	0x74, 0x61, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x08,
+	0x64, 0x64, 0x6c, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17,
+	0x2e, 0x44, 0x61, 0x74, 0x61, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x44, 0x64, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x52, 0x07, 0x64, 0x64, 0x6c, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x1c, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x06, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x3a,
+	0x0a, 0x0f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0e, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x0e, 0x61, 0x6c,
+	0x74, 0x65, 0x72, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61,
+	0x73, 0x65, 0x48, 0x00, 0x52, 0x0d, 0x61, 0x6c, 0x74, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x62,
+	0x61, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x0d, 0x64, 0x72, 0x6f, 0x70, 0x5f, 0x64, 0x61, 0x74, 0x61,
+	0x62, 0x61, 0x73, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x44, 0x72, 0x6f,
+	0x70, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0c, 0x64, 0x72, 0x6f,
+	0x70, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x0c, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0c, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x48, 0x00, 0x52,
+	0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x2e, 0x0a, 0x0b,
+	0x61, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0b, 0x2e, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x48, 0x00,
+	0x52, 0x0a, 0x61, 0x6c, 0x74, 0x65, 0x72, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x2b, 0x0a, 0x0a,
+	0x64, 0x72, 0x6f, 0x70, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0a, 0x2e, 0x44, 0x72, 0x6f, 0x70, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x48, 0x00, 0x52, 0x09,
+	0x64, 0x72, 0x6f, 0x70, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x31, 0x0a, 0x0c, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0c, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x48, 0x00, 0x52,
+	0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x2e, 0x0a, 0x0b,
+	0x61, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0b, 0x2e, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x48, 0x00,
+	0x52, 0x0a, 0x61, 0x6c, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x2b, 0x0a, 0x0a,
+	0x64, 0x72, 0x6f, 0x70, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0a, 0x2e, 0x44, 0x72, 0x6f, 0x70, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x48, 0x00, 0x52, 0x09,
+	0x64, 0x72, 0x6f, 0x70, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x37, 0x0a, 0x0e, 0x74, 0x72, 0x75,
+	0x6e, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x54, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x54, 0x61, 0x62, 0x6c,
+	0x65, 0x48, 0x00, 0x52, 0x0d, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x54, 0x61, 0x62,
+	0x6c, 0x65, 0x12, 0x37, 0x0a, 0x0e, 0x73, 0x68, 0x6f, 0x77, 0x5f, 0x76, 0x61, 0x72, 0x69, 0x61,
+	0x62, 0x6c, 0x65, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x53, 0x68, 0x6f,
+	0x77, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x48, 0x00, 0x52, 0x0d, 0x73, 0x68,
+	0x6f, 0x77, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x12, 0x56, 0x0a, 0x19, 0x72,
+	0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x6d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69,
+	0x7a, 0x65, 0x64, 0x5f, 0x76, 0x69, 0x65, 0x77, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x4d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c,
+	0x69, 0x7a, 0x65, 0x64, 0x56, 0x69, 0x65, 0x77, 0x48, 0x00, 0x52, 0x17, 0x72, 0x65, 0x66, 0x72,
+	0x65, 0x73, 0x68, 0x4d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x56,
+	0x69, 0x65, 0x77, 0x22, 0xc7, 0x03, 0x0a, 0x07, 0x44, 0x64, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x13, 0x0a, 0x0f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x42, 0x41,
+	0x53, 0x45, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x41, 0x4c, 0x54, 0x45, 0x52, 0x5f, 0x44, 0x41,
+	0x54, 0x41, 0x42, 0x41, 0x53, 0x45, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x44, 0x52, 0x4f, 0x50,
+	0x5f, 0x44, 0x41, 0x54, 0x41, 0x42, 0x41, 0x53, 0x45, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x43,
+	0x52, 0x45, 0x41, 0x54, 0x45, 0x5f, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x03, 0x12, 0x0f, 0x0a,
+	0x0b, 0x41, 0x4c, 0x54, 0x45, 0x52, 0x5f, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x04, 0x12, 0x0e,
+	0x0a, 0x0a, 0x44, 0x52, 0x4f, 0x50, 0x5f, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x05, 0x12, 0x10,
+	0x0a, 0x0c, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x5f, 0x49, 0x4e, 0x44, 0x45, 0x58, 0x10, 0x06,
+	0x12, 0x0f, 0x0a, 0x0b, 0x41, 0x4c, 0x54, 0x45, 0x52, 0x5f, 0x49, 0x4e, 0x44, 0x45, 0x58, 0x10,
+	0x07, 0x12, 0x0e, 0x0a, 0x0a, 0x44, 0x52, 0x4f, 0x50, 0x5f, 0x49, 0x4e, 0x44, 0x45, 0x58, 0x10,
+	0x08, 0x12, 0x12, 0x0a, 0x0e, 0x54, 0x52, 0x55, 0x4e, 0x43, 0x41, 0x54, 0x45, 0x5f, 0x54, 0x41,
+	0x42, 0x4c, 0x45, 0x10, 0x09, 0x12, 0x17, 0x0a, 0x13, 0x53, 0x48, 0x4f, 0x57, 0x5f, 0x43, 0x52,
+	0x45, 0x41, 0x54, 0x45, 0x44, 0x41, 0x54, 0x41, 0x42, 0x41, 0x53, 0x45, 0x10, 0x0a, 0x12, 0x14,
+	0x0a, 0x10, 0x53, 0x48, 0x4f, 0x57, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x54, 0x41, 0x42,
+	0x4c, 0x45, 0x10, 0x0b, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x48, 0x4f, 0x57, 0x5f, 0x44, 0x41, 0x54,
+	0x41, 0x42, 0x41, 0x53, 0x45, 0x53, 0x10, 0x0c, 0x12, 0x0f, 0x0a, 0x0b, 0x53, 0x48, 0x4f, 0x57,
+	0x5f, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x53, 0x10, 0x0d, 0x12, 0x10, 0x0a, 0x0c, 0x53, 0x48, 0x4f,
+	0x57, 0x5f, 0x43, 0x4f, 0x4c, 0x55, 0x4d, 0x4e, 0x53, 0x10, 0x0e, 0x12, 0x0e, 0x0a, 0x0a, 0x53,
+	0x48, 0x4f, 0x57, 0x5f, 0x49, 0x4e, 0x44, 0x45, 0x58, 0x10, 0x0f, 0x12, 0x12, 0x0a, 0x0e, 0x53,
+	0x48, 0x4f, 0x57, 0x5f, 0x56, 0x41, 0x52, 0x49, 0x41, 0x42, 0x4c, 0x45, 0x53, 0x10, 0x10, 0x12,
+	0x11, 0x0a, 0x0d, 0x53, 0x48, 0x4f, 0x57, 0x5f, 0x57, 0x41, 0x52, 0x4e, 0x49, 0x4e, 0x47, 0x53,
+	0x10, 0x11, 0x12, 0x0f, 0x0a, 0x0b, 0x53, 0x48, 0x4f, 0x57, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52,
+	0x53, 0x10, 0x12, 0x12, 0x0f, 0x0a, 0x0b, 0x53, 0x48, 0x4f, 0x57, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x10, 0x13, 0x12, 0x14, 0x0a, 0x10, 0x53, 0x48, 0x4f, 0x57, 0x5f, 0x50, 0x52, 0x4f,
+	0x43, 0x45, 0x53, 0x53, 0x4c, 0x49, 0x53, 0x54, 0x10, 0x14, 0x12, 0x13, 0x0a, 0x0f, 0x53, 0x48,
+	0x4f, 0x57, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x56, 0x49, 0x45, 0x57, 0x10, 0x15, 0x12,
+	0x1c, 0x0a, 0x18, 0x52, 0x45, 0x46, 0x52, 0x45, 0x53, 0x48, 0x5f, 0x4d, 0x41, 0x54, 0x45, 0x52,
+	0x49, 0x41, 0x4c, 0x49, 0x5a, 0x45, 0x44, 0x56, 0x49, 0x45, 0x57, 0x10, 0x16, 0x42, 0x0c, 0x0a,
+	0x0a, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x50, 0x0a, 0x0e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x22, 0x0a,
+	0x0d, 0x69, 0x66, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x66, 0x4e, 0x6f, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74,
+	0x73, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x22, 0x48, 0x0a,
+	0x0d, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x1b,
 	0x0a, 0x09, 0x69, 0x66, 0x5f, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x08, 0x52, 0x08, 0x69, 0x66, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x64,
 	0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64,
-	0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x22, 0x93, 0x01, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x69, 0x66, 0x5f, 0x6e, 0x6f,
-	0x74, 0x5f, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b,
-	0x69, 0x66, 0x4e, 0x6f, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74,
-	0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
-	0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x72, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x61, 0x74,
-	0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x61, 0x74,
-	0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x64,
-	0x65, 0x66, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65,
-	0x44, 0x65, 0x66, 0x52, 0x08, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x65, 0x66, 0x22, 0x4a, 0x0a,
-	0x0a, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74,
-	0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x61, 0x62, 0x6c,
-	0x65, 0x12, 0x26, 0x0a, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x64, 0x65, 0x66, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x65, 0x66, 0x52,
-	0x08, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x65, 0x66, 0x22, 0x5a, 0x0a, 0x09, 0x44, 0x72, 0x6f,
-	0x70, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x66, 0x5f, 0x65, 0x78, 0x69,
-	0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x66, 0x45, 0x78, 0x69,
-	0x73, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12,
-	0x14, 0x0a, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x74, 0x61, 0x62, 0x6c, 0x65, 0x22, 0x47, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49,
-	0x6e, 0x64, 0x65, 0x78, 0x12, 0x22, 0x0a, 0x0d, 0x69, 0x66, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x65,
-	0x78, 0x69, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x66, 0x4e,
-	0x6f, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65,
-	0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x22,
-	0x0a, 0x0a, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x14, 0x0a, 0x05,
-	0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6e, 0x64,
-	0x65, 0x78, 0x22, 0x3e, 0x0a, 0x09, 0x44, 0x72, 0x6f, 0x70, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12,
+	0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x22, 0x47, 0x0a, 0x0c, 0x44, 0x72, 0x6f, 0x70, 0x44,
+	0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x66, 0x5f, 0x65, 0x78,
+	0x69, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x66, 0x45, 0x78,
+	0x69, 0x73, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65,
+	0x22, 0x93, 0x01, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65,
+	0x12, 0x22, 0x0a, 0x0d, 0x69, 0x66, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x65, 0x78, 0x69, 0x73, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x66, 0x4e, 0x6f, 0x74, 0x45, 0x78,
+	0x69, 0x73, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x72,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61,
+	0x72, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x26,
+	0x0a, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x64, 0x65, 0x66, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x09, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x65, 0x66, 0x52, 0x08, 0x74, 0x61,
+	0x62, 0x6c, 0x65, 0x44, 0x65, 0x66, 0x22, 0x4a, 0x0a, 0x0a, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x54,
+	0x61, 0x62, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x26, 0x0a, 0x09, 0x74, 0x61,
+	0x62, 0x6c, 0x65, 0x5f, 0x64, 0x65, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e,
+	0x54, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x65, 0x66, 0x52, 0x08, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x44,
+	0x65, 0x66, 0x22, 0x5a, 0x0a, 0x09, 0x44, 0x72, 0x6f, 0x70, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12,
 	0x1b, 0x0a, 0x09, 0x69, 0x66, 0x5f, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x08, 0x69, 0x66, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05,
-	0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6e, 0x64,
-	0x65, 0x78, 0x22, 0x25, 0x0a, 0x0d, 0x54, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x54, 0x61,
-	0x62, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x22, 0x44, 0x0a, 0x0d, 0x53, 0x68, 0x6f,
-	0x77, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x6c,
-	0x6f, 0x62, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x67, 0x6c, 0x6f, 0x62,
-	0x61, 0x6c, 0x12, 0x1b, 0x0a, 0x05, 0x77, 0x68, 0x65, 0x72, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x05, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x05, 0x77, 0x68, 0x65, 0x72, 0x65, 0x2a,
-	0x21, 0x0a, 0x0c, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x54, 0x79, 0x70, 0x65, 0x12,
-	0x08, 0x0a, 0x04, 0x4e, 0x6f, 0x6e, 0x65, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x4c, 0x7a, 0x34,
-	0x10, 0x01, 0x2a, 0x40, 0x0a, 0x18, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x09,
-	0x0a, 0x05, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x4e, 0x4f, 0x5f,
-	0x43, 0x48, 0x41, 0x49, 0x4e, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x45, 0x4c, 0x45, 0x41,
-	0x53, 0x45, 0x10, 0x02, 0x42, 0x07, 0x5a, 0x05, 0x2f, 0x70, 0x6c, 0x61, 0x6e, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x28, 0x08, 0x52, 0x08, 0x69, 0x66, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08,
+	0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x61, 0x62, 0x6c,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x22, 0x47,
+	0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x22, 0x0a,
+	0x0d, 0x69, 0x66, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x66, 0x4e, 0x6f, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x22, 0x0a, 0x0a, 0x41, 0x6c, 0x74, 0x65, 0x72,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x3e, 0x0a, 0x09, 0x44,
+	0x72, 0x6f, 0x70, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x66, 0x5f, 0x65,
+	0x78, 0x69, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x66, 0x45,
+	0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x25, 0x0a, 0x0d, 0x54,
+	0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x61, 0x62,
+	0x6c, 0x65, 0x22, 0x44, 0x0a, 0x0d, 0x53, 0x68, 0x6f, 0x77, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62,
+	0x6c, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x12, 0x1b, 0x0a, 0x05, 0x77,
+	0x68, 0x65, 0x72, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x45, 0x78, 0x70,
+	0x72, 0x52, 0x05, 0x77, 0x68, 0x65, 0x72, 0x65, 0x22, 0x4b, 0x0a, 0x17, 0x52, 0x65, 0x66, 0x72,
+	0x65, 0x73, 0x68, 0x4d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x56,
+	0x69, 0x65, 0x77, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x74, 0x61, 0x62, 0x6c, 0x65, 0x2a, 0x37, 0x0a, 0x0c, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73,
+	0x73, 0x54, 0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x6f, 0x6e, 0x65, 0x10, 0x00, 0x12,
+	0x07, 0x0a, 0x03, 0x4c, 0x7a, 0x34, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x5a, 0x73, 0x74, 0x64,
+	0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x6e, 0x61, 0x70, 0x70, 0x79, 0x10, 0x03, 0x2a, 0x40,
+	0x0a, 0x0c, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x79, 0x70, 0x65, 0x12, 0x09,
+	0x0a, 0x05, 0x50, 0x6c, 0x61, 0x69, 0x6e, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x44, 0x69, 0x63,
+	0x74, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x52, 0x6c, 0x65, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05,
+	0x44, 0x65, 0x6c, 0x74, 0x61, 0x10, 0x03, 0x12, 0x07, 0x0a, 0x03, 0x46, 0x6f, 0x72, 0x10, 0x04,
+	0x2a, 0x40, 0x0a, 0x18, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f,
+	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x09, 0x0a, 0x05,
+	0x43, 0x48, 0x41, 0x49, 0x4e, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x4e, 0x4f, 0x5f, 0x43, 0x48,
+	0x41, 0x49, 0x4e, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x45, 0x4c, 0x45, 0x41, 0x53, 0x45,
+	0x10, 0x02, 0x42, 0x07, 0x5a, 0x05, 0x2f, 0x70, 0x6c, 0x61, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
@@ -5060,154 +5348,161 @@ func file_plan_proto_rawDescGZIP() []byte {
 	return file_plan_proto_rawDescData
 }
 
-var file_plan_proto_enumTypes = make([]protoimpl.EnumInfo, 13)
-var file_plan_proto_msgTypes = make([]protoimpl.MessageInfo, 46)
+var file_plan_proto_enumTypes = make([]protoimpl.EnumInfo, 15)
+var file_plan_proto_msgTypes = make([]protoimpl.MessageInfo, 47)
 var file_plan_proto_goTypes = []interface{}{
 	(CompressType)(0),                   // 0: CompressType
-	(TransationCompletionType)(0),       // 1: TransationCompletionType
-	(Type_TypeId)(0),                    // 2: Type.TypeId
-	(Function_FuncFlag)(0),              // 3: Function.FuncFlag
-	(IndexDef_IndexType)(0),             // 4: IndexDef.IndexType
-	(OrderBySpec_OrderByFlag)(0),        // 5: OrderBySpec.OrderByFlag
-	(Node_NodeType)(0),                  // 6: Node.NodeType
-	(Node_JoinFlag)(0),                  // 7: Node.JoinFlag
-	(Node_AggMode)(0),                   // 8: Node.AggMode
-	(Query_StatementType)(0),            // 9: Query.StatementType
-	(TransationControl_TclType)(0),      // 10: TransationControl.TclType
-	(TransationBegin_TransationMode)(0), // 11: TransationBegin.TransationMode
-	(DataDefinition_DdlType)(0),         // 12: DataDefinition.DdlType
-	(*Type)(nil),                        // 13: Type
-	(*Const)(nil),                       // 14: Const
-	(*ParamRef)(nil),                    // 15: ParamRef
-	(*VarRef)(nil),                      // 16: VarRef
-	(*ColRef)(nil),                      // 17: ColRef
-	(*CorrColRef)(nil),                  // 18: CorrColRef
-	(*ExprList)(nil),                    // 19: ExprList
-	(*SubQuery)(nil),                    // 20: SubQuery
-	(*ObjectRef)(nil),                   // 21: ObjectRef
-	(*Function)(nil),                    // 22: Function
-	(*Expr)(nil),                        // 23: Expr
-	(*DefaultExpr)(nil),                 // 24: DefaultExpr
-	(*ConstantValue)(nil),               // 25: ConstantValue
-	(*Decimal128)(nil),                  // 26: decimal128
-	(*ColDef)(nil),                      // 27: ColDef
-	(*IndexDef)(nil),                    // 28: IndexDef
-	(*PrimaryKeyDef)(nil),               // 29: PrimaryKeyDef
-	(*Property)(nil),                    // 30: Property
-	(*PropertiesDef)(nil),               // 31: PropertiesDef
-	(*TableDef)(nil),                    // 32: TableDef
-	(*Cost)(nil),                        // 33: Cost
-	(*ColData)(nil),                     // 34: ColData
-	(*RowsetData)(nil),                  // 35: RowsetData
-	(*OrderBySpec)(nil),                 // 36: OrderBySpec
-	(*WindowSpec)(nil),                  // 37: WindowSpec
-	(*UpdateList)(nil),                  // 38: UpdateList
-	(*Node)(nil),                        // 39: Node
-	(*Query)(nil),                       // 40: Query
-	(*TransationControl)(nil),           // 41: TransationControl
-	(*TransationBegin)(nil),             // 42: TransationBegin
-	(*TransationCommit)(nil),            // 43: TransationCommit
-	(*TransationRollback)(nil),          // 44: TransationRollback
-	(*Plan)(nil),                        // 45: Plan
-	(*DataDefinition)(nil),              // 46: DataDefinition
-	(*CreateDatabase)(nil),              // 47: CreateDatabase
-	(*AlterDatabase)(nil),               // 48: AlterDatabase
-	(*DropDatabase)(nil),                // 49: DropDatabase
-	(*CreateTable)(nil),                 // 50: CreateTable
-	(*AlterTable)(nil),                  // 51: AlterTable
-	(*DropTable)(nil),                   // 52: DropTable
-	(*CreateIndex)(nil),                 // 53: CreateIndex
-	(*AlterIndex)(nil),                  // 54: AlterIndex
-	(*DropIndex)(nil),                   // 55: DropIndex
-	(*TruncateTable)(nil),               // 56: TruncateTable
-	(*ShowVariables)(nil),               // 57: ShowVariables
-	(*TableDef_DefType)(nil),            // 58: TableDef.DefType
+	(EncodingType)(0),                   // 1: EncodingType
+	(TransationCompletionType)(0),       // 2: TransationCompletionType
+	(Type_TypeId)(0),                    // 3: Type.TypeId
+	(Function_FuncFlag)(0),              // 4: Function.FuncFlag
+	(IndexDef_IndexType)(0),             // 5: IndexDef.IndexType
+	(OrderBySpec_OrderByFlag)(0),        // 6: OrderBySpec.OrderByFlag
+	(Node_NodeType)(0),                  // 7: Node.NodeType
+	(Node_JoinFlag)(0),                  // 8: Node.JoinFlag
+	(Node_AggMode)(0),                   // 9: Node.AggMode
+	(Node_JoinAlgo)(0),                  // 10: Node.JoinAlgo
+	(Query_StatementType)(0),            // 11: Query.StatementType
+	(TransationControl_TclType)(0),      // 12: TransationControl.TclType
+	(TransationBegin_TransationMode)(0), // 13: TransationBegin.TransationMode
+	(DataDefinition_DdlType)(0),         // 14: DataDefinition.DdlType
+	(*Type)(nil),                        // 15: Type
+	(*Const)(nil),                       // 16: Const
+	(*ParamRef)(nil),                    // 17: ParamRef
+	(*VarRef)(nil),                      // 18: VarRef
+	(*ColRef)(nil),                      // 19: ColRef
+	(*CorrColRef)(nil),                  // 20: CorrColRef
+	(*ExprList)(nil),                    // 21: ExprList
+	(*SubQuery)(nil),                    // 22: SubQuery
+	(*ObjectRef)(nil),                   // 23: ObjectRef
+	(*Function)(nil),                    // 24: Function
+	(*Expr)(nil),                        // 25: Expr
+	(*DefaultExpr)(nil),                 // 26: DefaultExpr
+	(*ConstantValue)(nil),               // 27: ConstantValue
+	(*Decimal128)(nil),                  // 28: decimal128
+	(*ColDef)(nil),                      // 29: ColDef
+	(*IndexDef)(nil),                    // 30: IndexDef
+	(*PrimaryKeyDef)(nil),               // 31: PrimaryKeyDef
+	(*Property)(nil),                    // 32: Property
+	(*PropertiesDef)(nil),               // 33: PropertiesDef
+	(*TableDef)(nil),                    // 34: TableDef
+	(*Cost)(nil),                        // 35: Cost
+	(*ColData)(nil),                     // 36: ColData
+	(*RowsetData)(nil),                  // 37: RowsetData
+	(*OrderBySpec)(nil),                 // 38: OrderBySpec
+	(*WindowSpec)(nil),                  // 39: WindowSpec
+	(*UpdateList)(nil),                  // 40: UpdateList
+	(*Node)(nil),                        // 41: Node
+	(*Query)(nil),                       // 42: Query
+	(*TransationControl)(nil),           // 43: TransationControl
+	(*TransationBegin)(nil),             // 44: TransationBegin
+	(*TransationCommit)(nil),            // 45: TransationCommit
+	(*TransationRollback)(nil),          // 46: TransationRollback
+	(*Plan)(nil),                        // 47: Plan
+	(*DataDefinition)(nil),              // 48: DataDefinition
+	(*CreateDatabase)(nil),              // 49: CreateDatabase
+	(*AlterDatabase)(nil),               // 50: AlterDatabase
+	(*DropDatabase)(nil),                // 51: DropDatabase
+	(*CreateTable)(nil),                 // 52: CreateTable
+	(*AlterTable)(nil),                  // 53: AlterTable
+	(*DropTable)(nil),                   // 54: DropTable
+	(*CreateIndex)(nil),                 // 55: CreateIndex
+	(*AlterIndex)(nil),                  // 56: AlterIndex
+	(*DropIndex)(nil),                   // 57: DropIndex
+	(*TruncateTable)(nil),               // 58: TruncateTable
+	(*ShowVariables)(nil),               // 59: ShowVariables
+	(*RefreshMaterializedView)(nil),     // 60: RefreshMaterializedView
+	(*TableDef_DefType)(nil),            // 61: TableDef.DefType
 }
 var file_plan_proto_depIdxs = []int32{
-	2,  // 0: Type.id:type_name -> Type.TypeId
-	23, // 1: ExprList.list:type_name -> Expr
-	21, // 2: Function.func:type_name -> ObjectRef
-	23, // 3: Function.args:type_name -> Expr
-	13, // 4: Expr.typ:type_name -> Type
-	14, // 5: Expr.c:type_name -> Const
-	15, // 6: Expr.p:type_name -> ParamRef
-	16, // 7: Expr.v:type_name -> VarRef
-	17, // 8: Expr.col:type_name -> ColRef
-	22, // 9: Expr.f:type_name -> Function
-	19, // 10: Expr.list:type_name -> ExprList
-	20, // 11: Expr.sub:type_name -> SubQuery
-	18, // 12: Expr.corr:type_name -> CorrColRef
-	25, // 13: DefaultExpr.value:type_name -> ConstantValue
-	26, // 14: ConstantValue.decimal128_v:type_name -> decimal128
+	3,  // 0: Type.id:type_name -> Type.TypeId
+	25, // 1: ExprList.list:type_name -> Expr
+	23, // 2: Function.func:type_name -> ObjectRef
+	25, // 3: Function.args:type_name -> Expr
+	15, // 4: Expr.typ:type_name -> Type
+	16, // 5: Expr.c:type_name -> Const
+	17, // 6: Expr.p:type_name -> ParamRef
+	18, // 7: Expr.v:type_name -> VarRef
+	19, // 8: Expr.col:type_name -> ColRef
+	24, // 9: Expr.f:type_name -> Function
+	21, // 10: Expr.list:type_name -> ExprList
+	22, // 11: Expr.sub:type_name -> SubQuery
+	20, // 12: Expr.corr:type_name -> CorrColRef
+	27, // 13: DefaultExpr.value:type_name -> ConstantValue
+	28, // 14: ConstantValue.decimal128_v:type_name -> decimal128
 	0,  // 15: ColDef.alg:type_name -> CompressType
-	13, // 16: ColDef.typ:type_name -> Type
-	24, // 17: ColDef.default:type_name -> DefaultExpr
-	4,  // 18: IndexDef.typ:type_name -> IndexDef.IndexType
-	30, // 19: PropertiesDef.properties:type_name -> Property
-	27, // 20: TableDef.cols:type_name -> ColDef
-	58, // 21: TableDef.defs:type_name -> TableDef.DefType
-	32, // 22: RowsetData.schema:type_name -> TableDef
-	34, // 23: RowsetData.cols:type_name -> ColData
-	23, // 24: OrderBySpec.expr:type_name -> Expr
-	5,  // 25: OrderBySpec.flag:type_name -> OrderBySpec.OrderByFlag
-	23, // 26: WindowSpec.partition_by:type_name -> Expr
-	36, // 27: WindowSpec.order_by:type_name -> OrderBySpec
-	23, // 28: UpdateList.columns:type_name -> Expr
-	23, // 29: UpdateList.values:type_name -> Expr
-	6,  // 30: Node.node_type:type_name -> Node.NodeType
-	33, // 31: Node.cost:type_name -> Cost
-	23, // 32: Node.project_list:type_name -> Expr
-	7,  // 33: Node.join_type:type_name -> Node.JoinFlag
-	23, // 34: Node.on_list:type_name -> Expr
-	23, // 35: Node.where_list:type_name -> Expr
-	23, // 36: Node.group_by:type_name -> Expr
-	23, // 37: Node.grouping_set:type_name -> Expr
-	23, // 38: Node.agg_list:type_name -> Expr
-	36, // 39: Node.order_by:type_name -> OrderBySpec
-	38, // 40: Node.update_list:type_name -> UpdateList
-	37, // 41: Node.win_spec:type_name -> WindowSpec
-	23, // 42: Node.limit:type_name -> Expr
-	23, // 43: Node.offset:type_name -> Expr
-	32, // 44: Node.table_def:type_name -> TableDef
-	21, // 45: Node.obj_ref:type_name -> ObjectRef
-	35, // 46: Node.rowset_data:type_name -> RowsetData
-	9,  // 47: Query.stmt_type:type_name -> Query.StatementType
-	39, // 48: Query.nodes:type_name -> Node
-	23, // 49: Query.params:type_name -> Expr
-	10, // 50: TransationControl.tcl_type:type_name -> TransationControl.TclType
-	42, // 51: TransationControl.begin:type_name -> TransationBegin
-	43, // 52: TransationControl.commit:type_name -> TransationCommit
-	44, // 53: TransationControl.rollback:type_name -> TransationRollback
-	11, // 54: TransationBegin.mode:type_name -> TransationBegin.TransationMode
-	1,  // 55: TransationCommit.completion_type:type_name -> TransationCompletionType
-	1,  // 56: TransationRollback.completion_type:type_name -> TransationCompletionType
-	40, // 57: Plan.query:type_name -> Query
-	41, // 58: Plan.tcl:type_name -> TransationControl
-	46, // 59: Plan.ddl:type_name -> DataDefinition
-	12, // 60: DataDefinition.ddl_type:type_name -> DataDefinition.DdlType
-	40, // 61: DataDefinition.query:type_name -> Query
-	47, // 62: DataDefinition.create_database:type_name -> CreateDatabase
-	48, // 63: DataDefinition.alter_database:type_name -> AlterDatabase
-	49, // 64: DataDefinition.drop_database:type_name -> DropDatabase
-	50, // 65: DataDefinition.create_table:type_name -> CreateTable
-	51, // 66: DataDefinition.alter_table:type_name -> AlterTable
-	52, // 67: DataDefinition.drop_table:type_name -> DropTable
-	53, // 68: DataDefinition.create_index:type_name -> CreateIndex
-	54, // 69: DataDefinition.alter_index:type_name -> AlterIndex
-	55, // 70: DataDefinition.drop_index:type_name -> DropIndex
-	56, // 71: DataDefinition.truncate_table:type_name -> TruncateTable
-	57, // 72: DataDefinition.show_variables:type_name -> ShowVariables
-	32, // 73: CreateTable.table_def:type_name -> TableDef
-	32, // 74: AlterTable.table_def:type_name -> TableDef
-	23, // 75: ShowVariables.where:type_name -> Expr
-	29, // 76: TableDef.DefType.pk:type_name -> PrimaryKeyDef
-	28, // 77: TableDef.DefType.idx:type_name -> IndexDef
-	31, // 78: TableDef.DefType.properties:type_name -> PropertiesDef
-	79, // [79:79] is the sub-list for method output_type
-	79, // [79:79] is the sub-list for method input_type
-	79, // [79:79] is the sub-list for extension type_name
-	79, // [79:79] is the sub-list for extension extendee
-	0,  // [0:79] is the sub-list for field type_name
+	15, // 16: ColDef.typ:type_name -> Type
+	26, // 17: ColDef.default:type_name -> DefaultExpr
+	1,  // 18: ColDef.encoding:type_name -> EncodingType
+	5,  // 19: IndexDef.typ:type_name -> IndexDef.IndexType
+	32, // 20: PropertiesDef.properties:type_name -> Property
+	29, // 21: TableDef.cols:type_name -> ColDef
+	61, // 22: TableDef.defs:type_name -> TableDef.DefType
+	34, // 23: RowsetData.schema:type_name -> TableDef
+	36, // 24: RowsetData.cols:type_name -> ColData
+	25, // 25: OrderBySpec.expr:type_name -> Expr
+	6,  // 26: OrderBySpec.flag:type_name -> OrderBySpec.OrderByFlag
+	25, // 27: WindowSpec.partition_by:type_name -> Expr
+	38, // 28: WindowSpec.order_by:type_name -> OrderBySpec
+	25, // 29: UpdateList.columns:type_name -> Expr
+	25, // 30: UpdateList.values:type_name -> Expr
+	7,  // 31: Node.node_type:type_name -> Node.NodeType
+	35, // 32: Node.cost:type_name -> Cost
+	25, // 33: Node.project_list:type_name -> Expr
+	8,  // 34: Node.join_type:type_name -> Node.JoinFlag
+	25, // 35: Node.on_list:type_name -> Expr
+	25, // 36: Node.where_list:type_name -> Expr
+	25, // 37: Node.group_by:type_name -> Expr
+	25, // 38: Node.grouping_set:type_name -> Expr
+	25, // 39: Node.agg_list:type_name -> Expr
+	38, // 40: Node.order_by:type_name -> OrderBySpec
+	40, // 41: Node.update_list:type_name -> UpdateList
+	39, // 42: Node.win_spec:type_name -> WindowSpec
+	25, // 43: Node.limit:type_name -> Expr
+	25, // 44: Node.offset:type_name -> Expr
+	34, // 45: Node.table_def:type_name -> TableDef
+	23, // 46: Node.obj_ref:type_name -> ObjectRef
+	37, // 47: Node.rowset_data:type_name -> RowsetData
+	25, // 48: Node.table_func_args:type_name -> Expr
+	10, // 49: Node.join_algo:type_name -> Node.JoinAlgo
+	11, // 50: Query.stmt_type:type_name -> Query.StatementType
+	41, // 51: Query.nodes:type_name -> Node
+	25, // 52: Query.params:type_name -> Expr
+	12, // 53: TransationControl.tcl_type:type_name -> TransationControl.TclType
+	44, // 54: TransationControl.begin:type_name -> TransationBegin
+	45, // 55: TransationControl.commit:type_name -> TransationCommit
+	46, // 56: TransationControl.rollback:type_name -> TransationRollback
+	13, // 57: TransationBegin.mode:type_name -> TransationBegin.TransationMode
+	2,  // 58: TransationCommit.completion_type:type_name -> TransationCompletionType
+	2,  // 59: TransationRollback.completion_type:type_name -> TransationCompletionType
+	42, // 60: Plan.query:type_name -> Query
+	43, // 61: Plan.tcl:type_name -> TransationControl
+	48, // 62: Plan.ddl:type_name -> DataDefinition
+	14, // 63: DataDefinition.ddl_type:type_name -> DataDefinition.DdlType
+	42, // 64: DataDefinition.query:type_name -> Query
+	49, // 65: DataDefinition.create_database:type_name -> CreateDatabase
+	50, // 66: DataDefinition.alter_database:type_name -> AlterDatabase
+	51, // 67: DataDefinition.drop_database:type_name -> DropDatabase
+	52, // 68: DataDefinition.create_table:type_name -> CreateTable
+	53, // 69: DataDefinition.alter_table:type_name -> AlterTable
+	54, // 70: DataDefinition.drop_table:type_name -> DropTable
+	55, // 71: DataDefinition.create_index:type_name -> CreateIndex
+	56, // 72: DataDefinition.alter_index:type_name -> AlterIndex
+	57, // 73: DataDefinition.drop_index:type_name -> DropIndex
+	58, // 74: DataDefinition.truncate_table:type_name -> TruncateTable
+	59, // 75: DataDefinition.show_variables:type_name -> ShowVariables
+	60, // 76: DataDefinition.refresh_materialized_view:type_name -> RefreshMaterializedView
+	34, // 77: CreateTable.table_def:type_name -> TableDef
+	34, // 78: AlterTable.table_def:type_name -> TableDef
+	25, // 79: ShowVariables.where:type_name -> Expr
+	31, // 80: TableDef.DefType.pk:type_name -> PrimaryKeyDef
+	30, // 81: TableDef.DefType.idx:type_name -> IndexDef
+	33, // 82: TableDef.DefType.properties:type_name -> PropertiesDef
+	83, // [83:83] is the sub-list for method output_type
+	83, // [83:83] is the sub-list for method input_type
+	83, // [83:83] is the sub-list for extension type_name
+	83, // [83:83] is the sub-list for extension extendee
+	0,  // [0:83] is the sub-list for field type_name
 }
 
 func init() { file_plan_proto_init() }
@@ -5757,6 +6052,18 @@ func file_plan_proto_init() {
 			}
 		}
 		file_plan_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefreshMaterializedView); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plan_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TableDef_DefType); i {
 			case 0:
 				return &v.state
@@ -5820,8 +6127,9 @@ func file_plan_proto_init() {
 		(*DataDefinition_DropIndex)(nil),
 		(*DataDefinition_TruncateTable)(nil),
 		(*DataDefinition_ShowVariables)(nil),
+		(*DataDefinition_RefreshMaterializedView)(nil),
 	}
-	file_plan_proto_msgTypes[45].OneofWrappers = []interface{}{
+	file_plan_proto_msgTypes[46].OneofWrappers = []interface{}{
 		(*TableDef_DefType_Pk)(nil),
 		(*TableDef_DefType_Idx)(nil),
 		(*TableDef_DefType_Properties)(nil),
@@ -5831,8 +6139,8 @@ func file_plan_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_plan_proto_rawDesc,
-			NumEnums:      13,
-			NumMessages:   46,
+			NumEnums:      15,
+			NumMessages:   47,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,58 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package uuids
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/stretchr/testify/require"
+)
+
+const Num = 20
+
+func generate() ([]types.Uuid, []int64) {
+	os := make([]int64, Num)
+	xs := make([]types.Uuid, Num)
+	for i := 0; i < Num; i++ {
+		os[i] = int64(i)
+		xs[i], _ = types.NewUuid()
+		xs[i][0] = byte((i * 7) % Num)
+	}
+	return xs, os
+}
+
+func TestSort(t *testing.T) {
+	vs, os := generate()
+	Sort(vs, os)
+	for i := 1; i < len(os); i++ {
+		require.LessOrEqual(t, types.CompareUuid(vs[os[i]], vs[os[i-1]]), 0)
+	}
+}
+
+func TestHeapSort(t *testing.T) {
+	vs, os := generate()
+	heapSort(vs, os, 0, len(vs))
+	for i := 1; i < len(os); i++ {
+		require.LessOrEqual(t, types.CompareUuid(vs[os[i]], vs[os[i-1]]), 0)
+	}
+}
+
+func TestSwapRange(t *testing.T) {
+	vs, os := generate()
+	osOriginal := make([]int64, len(os))
+	copy(osOriginal, os)
+	swapRange(vs, os, 0, 5, 5)
+	require.Equal(t, osOriginal[:5], os[5:10])
+}
@@ -29,6 +29,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sort/asc/uint32s"
 	"github.com/matrixorigin/matrixone/pkg/sort/asc/uint64s"
 	"github.com/matrixorigin/matrixone/pkg/sort/asc/uint8s"
+	"github.com/matrixorigin/matrixone/pkg/sort/asc/uuids"
 	"github.com/matrixorigin/matrixone/pkg/sort/asc/varchar"
 	ddecimal128s "github.com/matrixorigin/matrixone/pkg/sort/desc/decimal128s"
 	ddecimal64s "github.com/matrixorigin/matrixone/pkg/sort/desc/decimal64s"
@@ -42,6 +43,7 @@ import (
 	duint32s "github.com/matrixorigin/matrixone/pkg/sort/desc/uint32s"
 	duint64s "github.com/matrixorigin/matrixone/pkg/sort/desc/uint64s"
 	duint8s "github.com/matrixorigin/matrixone/pkg/sort/desc/uint8s"
+	duuids "github.com/matrixorigin/matrixone/pkg/sort/desc/uuids"
 	dvarchar "github.com/matrixorigin/matrixone/pkg/sort/desc/varchar"
 	"unsafe"
 )
@@ -86,13 +88,27 @@ func Sort(desc bool, os []int64, vec *vector.Vector) {
 		} else {
 			int64s.Sort(*(*[]int64)(unsafe.Pointer(&vs)), os)
 		}
+	case types.T_time:
+		vs := vec.Col.([]types.Time)
+		if desc {
+			dint64s.Sort(*(*[]int64)(unsafe.Pointer(&vs)), os)
+		} else {
+			int64s.Sort(*(*[]int64)(unsafe.Pointer(&vs)), os)
+		}
+	case types.T_year:
+		vs := vec.Col.([]types.YearValue)
+		if desc {
+			dint16s.Sort(*(*[]int16)(unsafe.Pointer(&vs)), os)
+		} else {
+			int16s.Sort(*(*[]int16)(unsafe.Pointer(&vs)), os)
+		}
 	case types.T_uint8:
 		if desc {
 			duint8s.Sort(vec.Col.([]uint8), os)
 		} else {
 			uint8s.Sort(vec.Col.([]uint8), os)
 		}
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		if desc {
 			duint16s.Sort(vec.Col.([]uint16), os)
 		} else {
@@ -104,7 +120,7 @@ func Sort(desc bool, os []int64, vec *vector.Vector) {
 		} else {
 			uint32s.Sort(vec.Col.([]uint32), os)
 		}
-	case types.T_uint64:
+	case types.T_uint64, types.T_set:
 		if desc {
 			duint64s.Sort(vec.Col.([]uint64), os)
 		} else {
@@ -122,7 +138,7 @@ func Sort(desc bool, os []int64, vec *vector.Vector) {
 		} else {
 			float64s.Sort(vec.Col.([]float64), os)
 		}
-	case types.T_char, types.T_json, types.T_varchar:
+	case types.T_char, types.T_json, types.T_varchar, types.T_text, types.T_blob:
 		if desc {
 			dvarchar.Sort(vec.Col.(*types.Bytes), os)
 		} else {
@@ -140,5 +156,11 @@ func Sort(desc bool, os []int64, vec *vector.Vector) {
 		} else {
 			decimal128s.Sort(vec.Col.([]types.Decimal128), os)
 		}
+	case types.T_uuid:
+		if desc {
+			duuids.Sort(vec.Col.([]types.Uuid), os)
+		} else {
+			uuids.Sort(vec.Col.([]types.Uuid), os)
+		}
 	}
 }
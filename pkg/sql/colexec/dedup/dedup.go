@@ -64,7 +64,7 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 				size += 4 + nullable
 			case types.T_int64, types.T_uint64, types.T_float64, types.T_datetime:
 				size += 8 + nullable
-			case types.T_char, types.T_varchar:
+			case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 				if width := vec.Typ.Width; width > 0 {
 					size += int(width) + nullable
 				} else {
@@ -371,7 +371,7 @@ func (ctr *Container) processH8(bat *batch.Batch, proc *process.Process) error {
 						}
 					}
 				}
-			case types.T_char, types.T_varchar:
+			case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 				vs := vecs[j].Col.(*types.Bytes)
 				vData := vs.Data
 				vOff := vs.Offsets
@@ -661,7 +661,7 @@ func (ctr *Container) processH24(bat *batch.Batch, proc *process.Process) error
 						}
 					}
 				}
-			case types.T_char, types.T_varchar:
+			case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 				vs := vecs[j].Col.(*types.Bytes)
 				vData := vs.Data
 				vOff := vs.Offsets
@@ -950,7 +950,7 @@ func (ctr *Container) processH32(bat *batch.Batch, proc *process.Process) error
 						}
 					}
 				}
-			case types.T_char, types.T_varchar:
+			case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 				vs := vecs[j].Col.(*types.Bytes)
 				vData := vs.Data
 				vOff := vs.Offsets
@@ -1239,7 +1239,7 @@ func (ctr *Container) processH40(bat *batch.Batch, proc *process.Process) error
 						}
 					}
 				}
-			case types.T_char, types.T_varchar:
+			case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 				vs := vecs[j].Col.(*types.Bytes)
 				vData := vs.Data
 				vOff := vs.Offsets
@@ -1502,7 +1502,7 @@ func (ctr *Container) processHStr(bat *batch.Batch, proc *process.Process) error
 						}
 					}
 				}
-			case types.T_char, types.T_varchar:
+			case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 				vs := vecs[j].Col.(*types.Bytes)
 				if !nulls.Any(vecs[j].Nsp) {
 					for k := int64(0); k < n; k++ {
@@ -45,7 +45,7 @@ var (
 	// dates contains all time-related types in mo. It has its own compute and express logic, so
 	// it is different to other types and can not use the same template as others.
 	dates = []types.T{
-		types.T_date, types.T_datetime, types.T_timestamp,
+		types.T_date, types.T_time, types.T_datetime, types.T_timestamp, types.T_year,
 	}
 )
 
@@ -525,7 +525,7 @@ func GenerateCast() error {
 		Specials1   []lrt // left type is T_char or T_varchar
 		Specials2   []lrt // right type is T_char or T_varchar
 		Specials3   []lrt // conversion between char and varchar
-		Specials4   []lrt // cast ints to decimal128
+		Specials4   []lrt // cast ints to decimal128 and decimal64
 	}
 
 	var pTs = pts{
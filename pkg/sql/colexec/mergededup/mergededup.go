@@ -274,7 +274,7 @@ func (ctr *container) processH8(bat *batch.Batch, proc *process.Process) error {
 					*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h8.keys[k]), ctr.keyOffs[k])) = int64(vs[i+k])
 				}
 				add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
-			case types.T_char, types.T_varchar:
+			case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 				vs := vecs[j].Col.(*types.Bytes)
 				vData := vs.Data
 				vOff := vs.Offsets
@@ -395,7 +395,7 @@ func (ctr *container) processH24(bat *batch.Batch, proc *process.Process) error
 					*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = int64(vs[i+k])
 				}
 				add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
-			case types.T_char, types.T_varchar:
+			case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 				vs := vecs[j].Col.(*types.Bytes)
 				for k := int64(0); k < n; k++ {
 					key := vs.Get(i + k)
@@ -513,7 +513,7 @@ func (ctr *container) processH32(bat *batch.Batch, proc *process.Process) error
 					*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = int64(vs[i+k])
 				}
 				add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
-			case types.T_char, types.T_varchar:
+			case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 				vs := vecs[j].Col.(*types.Bytes)
 				for k := int64(0); k < n; k++ {
 					key := vs.Get(i + k)
@@ -631,7 +631,7 @@ func (ctr *container) processH40(bat *batch.Batch, proc *process.Process) error
 					*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = int64(vs[i+k])
 				}
 				add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
-			case types.T_char, types.T_varchar:
+			case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 				vs := vecs[j].Col.(*types.Bytes)
 				for k := int64(0); k < n; k++ {
 					key := vs.Get(i + k)
@@ -747,7 +747,7 @@ func (ctr *container) processHStr(bat *batch.Batch, proc *process.Process) error
 				for k := int64(0); k < n; k++ {
 					keys[k] = append(keys[k], data[(i+k)*8:(i+k+1)*8]...)
 				}
-			case types.T_char, types.T_varchar:
+			case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 				vs := vecs[j].Col.(*types.Bytes)
 				for k := int64(0); k < n; k++ {
 					keys[k] = append(keys[k], vs.Get(i+k)...)
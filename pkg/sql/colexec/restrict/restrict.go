@@ -103,7 +103,7 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 			} else {
 				proc.Reg.InputBatch = bat
 			}
-		case types.T_char, types.T_varchar:
+		case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 			if len(v.Data) == 0 {
 				proc.Reg.InputBatch = &batch.Batch{}
 			} else {
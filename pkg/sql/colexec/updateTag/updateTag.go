@@ -216,7 +216,7 @@ func constantPadding(vec *vector.Vector, count uint64) error {
 			values = append(values, value)
 		}
 		vector.SetCol(vec, values)
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 		value := vec.Col.(*types.Bytes).Data
 		offset := vec.Col.(*types.Bytes).Offsets[0]
 		cnt := vec.Col.(*types.Bytes).Lengths[0]
@@ -20,11 +20,14 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/ring/bitand"
 	"github.com/matrixorigin/matrixone/pkg/container/ring/bitor"
 	"github.com/matrixorigin/matrixone/pkg/container/ring/bitxor"
+	"github.com/matrixorigin/matrixone/pkg/container/ring/moment"
+	"github.com/matrixorigin/matrixone/pkg/container/ring/percentile"
 	"github.com/matrixorigin/matrixone/pkg/container/ring/stddevpop"
 	"github.com/matrixorigin/matrixone/pkg/container/ring/variance"
 
 	"github.com/matrixorigin/matrixone/pkg/container/ring"
 	"github.com/matrixorigin/matrixone/pkg/container/ring/approxcd"
+	"github.com/matrixorigin/matrixone/pkg/container/ring/approxpct"
 	"github.com/matrixorigin/matrixone/pkg/container/ring/avg"
 	"github.com/matrixorigin/matrixone/pkg/container/ring/count"
 	"github.com/matrixorigin/matrixone/pkg/container/ring/max"
@@ -45,11 +48,17 @@ var sumReturnTypes = map[types.T]types.T{
 	types.T_uint64:  types.T_uint64,
 	types.T_float32: types.T_float64,
 	types.T_float64: types.T_float64,
+
+	types.T_decimal64:  types.T_decimal128,
+	types.T_decimal128: types.T_decimal128,
 }
 
 func ReturnType(op int, typ types.T) types.T {
 	switch op {
 	case Avg:
+		if typ == types.T_decimal64 || typ == types.T_decimal128 {
+			return types.T_decimal128
+		}
 		return types.T_float64
 	case Max:
 		return typ
@@ -71,11 +80,22 @@ func ReturnType(op int, typ types.T) types.T {
 		return types.T_uint64
 	case StdDevPop:
 		return types.T_float64
+	case ApproxCountDistinctError, ApproxPercentile, ApproxPercentileError:
+		return types.T_float64
+	case Median, PercentileCont, PercentileDisc:
+		return types.T_float64
+	case VarSample, StdDevSample, CovarPop, CovarSample, Corr:
+		return types.T_float64
 	}
 	return 0
 }
 
 func New(op int, typ types.Type) (ring.Ring, error) {
+	return NewWithParam(op, 0, typ)
+}
+
+// NewWithParam returns the ring of the aggregate which has a constant parameter besides its argument
+func NewWithParam(op int, param float64, typ types.Type) (ring.Ring, error) {
 	switch op {
 	case Sum:
 		return NewSum(typ)
@@ -104,6 +124,28 @@ func New(op int, typ types.Type) (ring.Ring, error) {
 		return NewBitOr(typ)
 	case StdDevPop:
 		return stddevpop.NewStdDevPopRingWithTypeCheck(typ)
+	case ApproxCountDistinctError:
+		return approxcd.NewApproxCountDistinctError(typ), nil
+	case ApproxPercentile:
+		return approxpct.NewApproxPercentileRingWithTypeCheck(typ, param, false)
+	case ApproxPercentileError:
+		return approxpct.NewApproxPercentileRingWithTypeCheck(typ, param, true)
+	case Median:
+		return percentile.NewPercentileRingWithTypeCheck(typ, 0.5, false)
+	case PercentileCont:
+		return percentile.NewPercentileRingWithTypeCheck(typ, param, false)
+	case PercentileDisc:
+		return percentile.NewPercentileRingWithTypeCheck(typ, param, true)
+	case VarSample:
+		return moment.NewMomentRingWithTypeCheck(typ, moment.VarSample)
+	case StdDevSample:
+		return moment.NewMomentRingWithTypeCheck(typ, moment.StdDevSample)
+	case CovarPop:
+		return moment.NewMomentRingWithTypeCheck(typ, moment.CovarPop)
+	case CovarSample:
+		return moment.NewMomentRingWithTypeCheck(typ, moment.CovarSample)
+	case Corr:
+		return moment.NewMomentRingWithTypeCheck(typ, moment.Corr)
 	}
 	return nil, nil
 }
@@ -140,6 +182,9 @@ func NewSum(typ types.Type) (ring.Ring, error) {
 		return sum.NewInt(typ), nil
 	case types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64:
 		return sum.NewUint(typ), nil
+	case types.T_decimal64, types.T_decimal128:
+		// decimal64 values are summed up in decimal128 to keep away from overflow
+		return sum.NewDecimal128(typ), nil
 	}
 	return nil, fmt.Errorf("'%v' not support Sum", typ)
 }
@@ -166,7 +211,7 @@ func NewMax(typ types.Type) (ring.Ring, error) {
 		return max.NewFloat32(typ), nil
 	case types.T_float64:
 		return max.NewFloat64(typ), nil
-	case types.T_char, types.T_varchar:
+	case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 		return max.NewStr(typ), nil
 	case types.T_date:
 		return max.NewDate(typ), nil
@@ -198,7 +243,7 @@ func NewMin(typ types.Type) (ring.Ring, error) {
 		return min.NewFloat32(typ), nil
 	case types.T_float64:
 		return min.NewFloat64(typ), nil
-	case types.T_char, types.T_varchar:
+	case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 		return min.NewStr(typ), nil
 	case types.T_date:
 		return min.NewDate(typ), nil
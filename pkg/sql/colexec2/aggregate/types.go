@@ -29,6 +29,17 @@ const (
 	BitXor
 	BitOr
 	StdDevPop
+	ApproxCountDistinctError
+	ApproxPercentile
+	ApproxPercentileError
+	Median
+	PercentileCont
+	PercentileDisc
+	VarSample
+	StdDevSample
+	CovarPop
+	CovarSample
+	Corr
 )
 
 var Names = [...]string{
@@ -44,9 +55,28 @@ var Names = [...]string{
 	BitXor:              "bit_xor",
 	BitOr:               "bit_or",
 	StdDevPop:           "stddev_pop",
+
+	ApproxCountDistinctError: "approx_count_distinct_error",
+	ApproxPercentile:         "approx_percentile",
+	ApproxPercentileError:    "approx_percentile_error",
+	Median:                   "median",
+	PercentileCont:           "percentile_cont",
+	PercentileDisc:           "percentile_disc",
+	VarSample:                "var_samp",
+	StdDevSample:             "stddev_samp",
+	CovarPop:                 "covar_pop",
+	CovarSample:              "covar_samp",
+	Corr:                     "corr",
 }
 
 type Aggregate struct {
-	Op int
-	E  *plan.Expr
+	Op    int
+	Param float64 // the constant parameter of the aggregate, such as the percentile of approx_percentile
+	E     *plan.Expr
+	E2    *plan.Expr // the second argument of the aggregate with two arguments, such as corr(x, y)
+}
+
+// Binary returns true if the aggregate has two arguments
+func Binary(op int) bool {
+	return op == CovarPop || op == CovarSample || op == Corr
 }
@@ -0,0 +1,115 @@
+// Copyright 2021 - 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package colexec2
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"golang.org/x/exp/constraints"
+)
+
+// FillAutoIncrement generates the values of the auto increment column of the rows written into
+// the relation, the null or zero values are replaced by a range of values reserved at once.
+// It returns the first value generated, 0 if there is none.
+func FillAutoIncrement(rel engine.Relation, bat *batch.Batch, snapshot engine.Snapshot) (uint64, error) {
+	attr, ok := autoIncrementAttribute(rel, snapshot)
+	if !ok {
+		return 0, nil
+	}
+	var vec *vector.Vector
+	for i, name := range bat.Attrs {
+		if name == attr {
+			vec = bat.Vecs[i]
+		}
+	}
+	if vec == nil {
+		return 0, nil
+	}
+	arel, ok := rel.(engine.AutoIncrementRelation)
+	if !ok {
+		return 0, errors.New(errno.FeatureNotSupported, fmt.Sprintf("auto increment column '%s' is not supported by the engine", attr))
+	}
+	switch vs := vec.Col.(type) {
+	case []int8:
+		return fillAutoIncrement(arel, attr, vec, vs, math.MaxInt8, snapshot)
+	case []int16:
+		return fillAutoIncrement(arel, attr, vec, vs, math.MaxInt16, snapshot)
+	case []int32:
+		return fillAutoIncrement(arel, attr, vec, vs, math.MaxInt32, snapshot)
+	case []int64:
+		return fillAutoIncrement(arel, attr, vec, vs, math.MaxInt64, snapshot)
+	case []uint8:
+		return fillAutoIncrement(arel, attr, vec, vs, math.MaxUint8, snapshot)
+	case []uint16:
+		return fillAutoIncrement(arel, attr, vec, vs, math.MaxUint16, snapshot)
+	case []uint32:
+		return fillAutoIncrement(arel, attr, vec, vs, math.MaxUint32, snapshot)
+	case []uint64:
+		return fillAutoIncrement(arel, attr, vec, vs, math.MaxUint64, snapshot)
+	}
+	return 0, errors.New(errno.DatatypeMismatch, fmt.Sprintf("auto increment column '%s' must be an integer", attr))
+}
+
+// autoIncrementAttribute returns the name of the auto increment column of the relation
+func autoIncrementAttribute(rel engine.Relation, snapshot engine.Snapshot) (string, bool) {
+	for _, def := range rel.TableDefs(snapshot) {
+		if attr, ok := def.(*engine.AttributeDef); ok && attr.Attr.AutoIncrement {
+			return attr.Attr.Name, true
+		}
+	}
+	return "", false
+}
+
+func fillAutoIncrement[T constraints.Integer](rel engine.AutoIncrementRelation, attr string, vec *vector.Vector, vs []T, limit uint64, snapshot engine.Snapshot) (uint64, error) {
+	var n, max uint64
+	for i, v := range vs {
+		switch {
+		case v == 0 || nulls.Contains(vec.Nsp, uint64(i)):
+			n++
+		case v > 0 && uint64(v) > max:
+			max = uint64(v)
+		}
+	}
+	if n == 0 && max == 0 {
+		return 0, nil
+	}
+	// the sequence is moved past the values written explicitly even if nothing is generated
+	first, err := rel.AllocAutoIncrement(n, max, snapshot)
+	if err != nil {
+		return 0, err
+	}
+	if n == 0 {
+		return 0, nil
+	}
+	if last := first + n - 1; last > limit || last < first {
+		return 0, errors.New(errno.DataException, fmt.Sprintf("out of range value for auto increment column '%s'", attr))
+	}
+	next := first
+	for i, v := range vs {
+		if v == 0 || nulls.Contains(vec.Nsp, uint64(i)) {
+			nulls.Del(vec.Nsp, uint64(i))
+			vs[i] = T(next)
+			next++
+		}
+	}
+	return first, nil
+}
@@ -0,0 +1,75 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package colexec2
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// Check is a CHECK constraint of a table, the columns of its expression are the columns of the table
+type Check struct {
+	Name string
+	Expr *plan.Expr
+}
+
+// CheckRows returns an error if a row of the batch makes the expression of a constraint false,
+// the vectors of the batch are the columns of the table. A row making it null passes the check.
+func CheckRows(bat *batch.Batch, checks []*Check, proc *process.Process) error {
+	if len(bat.Zs) == 0 {
+		return nil
+	}
+	for _, check := range checks {
+		vec, err := EvalExpr(bat, proc, check.Expr)
+		if err != nil {
+			return err
+		}
+		violated := false
+		bs, _ := vec.Col.([]bool)
+		for i := range bat.Zs {
+			j := i
+			if vec.IsConst {
+				j = 0
+			}
+			if !nulls.Contains(vec.Nsp, uint64(j)) && !bs[j] {
+				violated = true
+				break
+			}
+		}
+		if !isBatchVector(bat, vec) {
+			vector.Clean(vec, proc.Mp)
+		}
+		if violated {
+			return errors.New(errno.IntegrityConstraintViolation, fmt.Sprintf("Check constraint '%s' is violated.", check.Name))
+		}
+	}
+	return nil
+}
+
+func isBatchVector(bat *batch.Batch, vec *vector.Vector) bool {
+	for _, v := range bat.Vecs {
+		if v == vec {
+			return true
+		}
+	}
+	return false
+}
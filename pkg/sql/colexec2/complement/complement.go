@@ -88,7 +88,9 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 			bat := <-proc.Reg.MergeReceivers[0].Ch
 			if bat == nil {
 				ctr.state = End
-				ctr.bat.Clean(proc.Mp)
+				if ctr.bat != nil {
+					ctr.bat.Clean(proc.Mp)
+				}
 				continue
 			}
 			if len(bat.Zs) == 0 {
@@ -137,6 +139,9 @@ func (ctr *Container) build(ap *Argument, proc *process.Process) error {
 		}
 		bat.Clean(proc.Mp)
 	}
+	if ctr.bat == nil { // the right side is empty, every row of the left side is kept
+		return nil
+	}
 	count := len(ctr.bat.Zs)
 	for i := 0; i < count; i += UnitLimit {
 		n := count - i
@@ -167,6 +172,8 @@ func (ctr *Container) build(ap *Argument, proc *process.Process) error {
 				} else {
 					fillGroupStr[types.Decimal128](ctr, vec, n, 16, i)
 				}
+			case 16:
+				fillGroupStr[types.Uuid](ctr, vec, n, 16, i)
 			default:
 				vs := vec.Col.(*types.Bytes)
 				if !nulls.Any(vec.Nsp) {
@@ -243,6 +250,8 @@ func (ctr *Container) probe(bat *batch.Batch, ap *Argument, proc *process.Proces
 				} else {
 					fillGroupStr[types.Decimal128](ctr, vec, n, 16, i)
 				}
+			case 16:
+				fillGroupStr[types.Uuid](ctr, vec, n, 16, i)
 			default:
 				vs := vec.Col.(*types.Bytes)
 				if !nulls.Any(vec.Nsp) {
@@ -200,6 +200,27 @@ func TestComplement(t *testing.T) {
 	}
 }
 
+func TestComplementEmpty(t *testing.T) {
+	// every row of the left side is kept if the right side is empty
+	tc := tcs[0]
+	Prepare(tc.proc, tc.arg)
+	tc.proc.Reg.MergeReceivers[0].Ch <- newBatch(t, tc.flgs, tc.types, tc.proc, Rows)
+	tc.proc.Reg.MergeReceivers[0].Ch <- nil
+	tc.proc.Reg.MergeReceivers[1].Ch <- nil
+	rows := 0
+	for {
+		ok, err := Call(tc.proc, tc.arg)
+		require.NoError(t, err)
+		if ok {
+			break
+		}
+		rows += len(tc.proc.Reg.InputBatch.Zs)
+		tc.proc.Reg.InputBatch.Clean(tc.proc.Mp)
+	}
+	require.Equal(t, Rows, rows)
+	require.Equal(t, mheap.Size(tc.proc.Mp), int64(0))
+}
+
 func BenchmarkComplement(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		hm := host.New(1 << 30)
@@ -0,0 +1,90 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deletion
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	colexec "github.com/matrixorigin/matrixone/pkg/sql/colexec2"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func String(arg interface{}, buf *bytes.Buffer) {
+	ap := arg.(*Argument)
+	buf.WriteString(fmt.Sprintf("delete(%s)", ap.PrimaryKey))
+}
+
+func Prepare(_ *process.Process, arg interface{}) error {
+	ap := arg.(*Argument)
+	ap.ctr = new(container)
+	return nil
+}
+
+// Call collects the primary keys of the input and deletes the rows at the end
+func Call(proc *process.Process, arg interface{}) (bool, error) {
+	ap := arg.(*Argument)
+	bat := proc.Reg.InputBatch
+	if bat == nil {
+		if ap.ctr.vec == nil {
+			return true, nil
+		}
+		defer vector.Clean(ap.ctr.vec, proc.Mp)
+		return true, ap.ctr.delete(ap, proc)
+	}
+	if len(bat.Zs) == 0 {
+		return false, nil
+	}
+	if ap.ctr.vec == nil {
+		ap.ctr.vec = vector.New(bat.Vecs[0].Typ)
+	}
+	err := colexec.AppendVector(ap.ctr.vec, bat.Vecs[0], len(bat.Zs), proc)
+	bat.Clean(proc.Mp)
+	proc.Reg.InputBatch = nil
+	return false, err
+}
+
+func (ctr *container) delete(ap *Argument, proc *process.Process) error {
+	// a row joined with several rows is deleted once
+	sels := make([]int64, 0, vector.Length(ctr.vec))
+	seen := make(map[string]struct{})
+	for i, n := int64(0), int64(vector.Length(ctr.vec)); i < n; i++ {
+		if nulls.Contains(ctr.vec.Nsp, uint64(i)) {
+			continue
+		}
+		key := colexec.ValueKey(ctr.vec, i)
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		sels = append(sels, i)
+	}
+	if len(sels) == 0 {
+		return nil
+	}
+	vec, err := colexec.CopyRows(ctr.vec, sels, proc)
+	if err != nil {
+		return err
+	}
+	defer vector.Clean(vec, proc.Mp)
+	if err := ap.TargetTable.Delete(ap.Ts, vec, ap.PrimaryKey, engine.Snapshot(proc.Snapshot)); err != nil {
+		return err
+	}
+	ap.AffectedRows = uint64(len(sels))
+	return nil
+}
@@ -0,0 +1,86 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deletion
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/stretchr/testify/require"
+)
+
+// testRelation records the primary keys deleted from it
+type testRelation struct {
+	engine.Relation
+	attr    string
+	deleted []*vector.Vector
+}
+
+func (r *testRelation) Delete(_ uint64, vec *vector.Vector, attr string, _ engine.Snapshot) error {
+	r.attr = attr
+	r.deleted = append(r.deleted, vec)
+	return nil
+}
+
+func TestString(t *testing.T) {
+	buf := new(bytes.Buffer)
+	String(&Argument{PrimaryKey: "a"}, buf)
+	require.Equal(t, "delete(a)", buf.String())
+}
+
+func TestDeletion(t *testing.T) {
+	hm := host.New(1 << 30)
+	gm := guest.New(1<<30, hm)
+	proc := process.New(mheap.New(gm))
+	rel := &testRelation{}
+	arg := &Argument{
+		TargetTable: rel,
+		PrimaryKey:  "a",
+	}
+	require.NoError(t, Prepare(proc, arg))
+
+	// the duplicate keys and the nulls are skipped
+	for _, col := range [][]int64{{1, 2, 3}, {3, 4, 0}} {
+		bat := batch.NewWithSize(1)
+		bat.Vecs[0] = vector.New(types.Type{Oid: types.T_int64})
+		require.NoError(t, vector.Append(bat.Vecs[0], col))
+		bat.InitZsOne(len(col))
+		if col[2] == 0 {
+			nulls.Add(bat.Vecs[0].Nsp, 2)
+		}
+		proc.Reg.InputBatch = bat
+		end, err := Call(proc, arg)
+		require.NoError(t, err)
+		require.False(t, end)
+	}
+	proc.Reg.InputBatch = nil
+	end, err := Call(proc, arg)
+	require.NoError(t, err)
+	require.True(t, end)
+
+	require.Equal(t, uint64(4), arg.AffectedRows)
+	require.Equal(t, "a", rel.attr)
+	require.Equal(t, 1, len(rel.deleted))
+	require.Equal(t, []int64{1, 2, 3, 4}, rel.deleted[0].Col)
+}
@@ -0,0 +1,35 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deletion
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+)
+
+type container struct {
+	vec *vector.Vector // primary keys collected from the input
+}
+
+// Argument of deletion, the input is the primary key of the rows to delete.
+type Argument struct {
+	Ts          uint64
+	TargetTable engine.Relation
+	// PrimaryKey is the name of the primary key
+	PrimaryKey string
+	// AffectedRows is the number of rows deleted
+	AffectedRows uint64
+	ctr          *container
+}
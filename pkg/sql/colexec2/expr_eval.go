@@ -32,6 +32,7 @@ var (
 	constIType = types.Type{Oid: types.T_int64}
 	constDType = types.Type{Oid: types.T_float64}
 	constSType = types.Type{Oid: types.T_varchar}
+	constBType = types.Type{Oid: types.T_bool, Size: 1}
 )
 
 func EvalExpr(bat *batch.Batch, proc *process.Process, expr *plan.Expr) (*vector.Vector, error) {
@@ -50,6 +51,9 @@ func EvalExpr(bat *batch.Batch, proc *process.Process, expr *plan.Expr) (*vector
 			case *plan.Const_Dval:
 				vec = vector.NewConst(constDType)
 				vec.Col = []float64{t.C.GetDval()}
+			case *plan.Const_Bval:
+				vec = vector.NewConst(constBType)
+				vec.Col = []bool{t.C.GetBval()}
 			case *plan.Const_Sval:
 				vec = vector.NewConst(constSType)
 				sval := t.C.GetSval()
@@ -83,6 +87,13 @@ func EvalExpr(bat *batch.Batch, proc *process.Process, expr *plan.Expr) (*vector
 			}
 			vs[i] = v
 		}
+		if len(vs) == 0 {
+			// functions without arguments, such as uuid(), get the row count from a constant null
+			vec := vector.NewConst(constIType)
+			nulls.Add(vec.Nsp, 0)
+			vec.Length = len(bat.Zs)
+			vs = append(vs, vec)
+		}
 		return f.VecFn(vs, proc)
 	default:
 		// *plan.Expr_Corr, *plan.Expr_List, *plan.Expr_P, *plan.Expr_V, *plan.Expr_Sub
@@ -0,0 +1,87 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package external
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/pierrec/lz4"
+)
+
+// the extensions of the compressed files
+var compressExtensions = map[string]string{
+	".gz":   tree.GZIP,
+	".gzip": tree.GZIP,
+	".zst":  tree.ZSTD,
+	".zstd": tree.ZSTD,
+	".lz4":  tree.LZ4,
+}
+
+/*
+GetCompressType returns the compression of the file.
+The AUTO compression is decided by the extension of the file.
+*/
+func GetCompressType(compression, path string) string {
+	if compression != "" && compression != tree.AUTO {
+		return compression
+	}
+	if typ, ok := compressExtensions[strings.ToLower(filepath.Ext(path))]; ok {
+		return typ
+	}
+	return tree.NOCOMPRESS
+}
+
+/*
+GetUnCompressReader wraps the reader of the file with the decompressor.
+*/
+func GetUnCompressReader(compression string, r io.Reader) (io.ReadCloser, error) {
+	switch compression {
+	case tree.GZIP:
+		return gzip.NewReader(r)
+	case tree.ZSTD:
+		zr, err := zstd.NewReader(r)
+		if err != nil {
+			return nil, err
+		}
+		return zr.IOReadCloser(), nil
+	case tree.LZ4:
+		return io.NopCloser(lz4.NewReader(r)), nil
+	case tree.NOCOMPRESS:
+		return io.NopCloser(r), nil
+	}
+	return nil, fmt.Errorf("unsupported compression %s", compression)
+}
+
+/*
+GetCompressWriter wraps the writer of the file with the compressor.
+The compressor must be closed before the file is closed.
+*/
+func GetCompressWriter(compression string, w io.Writer) (io.WriteCloser, error) {
+	switch compression {
+	case tree.GZIP:
+		return gzip.NewWriter(w), nil
+	case tree.ZSTD:
+		return zstd.NewWriter(w)
+	case tree.LZ4:
+		return lz4.NewWriter(w), nil
+	}
+	return nil, fmt.Errorf("unsupported compression %s", compression)
+}
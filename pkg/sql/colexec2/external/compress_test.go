@@ -0,0 +1,62 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package external
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func Test_GetCompressType(t *testing.T) {
+	convey.Convey("GetCompressType succ", t, func() {
+		convey.So(GetCompressType(tree.AUTO, "a.csv"), convey.ShouldEqual, tree.NOCOMPRESS)
+		convey.So(GetCompressType(tree.AUTO, "a.csv.GZ"), convey.ShouldEqual, tree.GZIP)
+		convey.So(GetCompressType("", "a.csv.zst"), convey.ShouldEqual, tree.ZSTD)
+		convey.So(GetCompressType(tree.AUTO, "a.jsonl.lz4"), convey.ShouldEqual, tree.LZ4)
+		convey.So(GetCompressType(tree.GZIP, "a.csv"), convey.ShouldEqual, tree.GZIP)
+		convey.So(GetCompressType(tree.NOCOMPRESS, "a.csv.gz"), convey.ShouldEqual, tree.NOCOMPRESS)
+	})
+}
+
+func TestCompressRoundTrip(t *testing.T) {
+	convey.Convey("compress and uncompress", t, func() {
+		data := bytes.Repeat([]byte("1,abc,2022-05-01\n"), 1000)
+		for _, compression := range []string{tree.GZIP, tree.ZSTD, tree.LZ4} {
+			var buf bytes.Buffer
+			w, err := GetCompressWriter(compression, &buf)
+			convey.So(err, convey.ShouldBeNil)
+			_, err = w.Write(data)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(w.Close(), convey.ShouldBeNil)
+			convey.So(buf.Len(), convey.ShouldBeLessThan, len(data))
+
+			r, err := GetUnCompressReader(compression, &buf)
+			convey.So(err, convey.ShouldBeNil)
+			got, err := io.ReadAll(r)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(r.Close(), convey.ShouldBeNil)
+			convey.So(got, convey.ShouldResemble, data)
+		}
+
+		_, err := GetCompressWriter("bz2", &bytes.Buffer{})
+		convey.So(err, convey.ShouldNotBeNil)
+		_, err = GetUnCompressReader("bz2", &bytes.Buffer{})
+		convey.So(err, convey.ShouldNotBeNil)
+	})
+}
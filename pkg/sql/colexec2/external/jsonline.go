@@ -0,0 +1,137 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package external
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/matrixorigin/simdcsv"
+)
+
+/*
+JsonLineReader reads the file of the JSON lines format. Every line is a json
+object, the values of the load columns are picked from it by the name and
+delivered into the channel in the same shape as simdcsv does.
+*/
+type JsonLineReader struct {
+	r       *bufio.Reader
+	columns []string
+	done    chan struct{}
+	once    sync.Once
+}
+
+func NewJsonLineReader(r io.Reader, columns []string) *JsonLineReader {
+	return &JsonLineReader{
+		r:       bufio.NewReaderSize(r, 1<<20),
+		columns: columns,
+		done:    make(chan struct{}),
+	}
+}
+
+func (jr *JsonLineReader) ReadLoop(lineOutChan chan simdcsv.LineOut) error {
+	lineNo := uint64(0)
+	for {
+		data, err := jr.r.ReadBytes('\n')
+		if err != nil && err != io.EOF {
+			return err
+		}
+		eof := err == io.EOF
+		data = bytes.TrimSpace(data)
+		if len(data) > 0 {
+			lineNo++
+			line, err := jr.parseLine(data)
+			if err != nil {
+				return fmt.Errorf("parse json line %d failed. err:%v", lineNo, err)
+			}
+			select {
+			case lineOutChan <- simdcsv.LineOut{Line: line}:
+			case <-jr.done:
+				return nil
+			}
+		}
+		if eof {
+			break
+		}
+	}
+	select {
+	case lineOutChan <- simdcsv.LineOut{}:
+	case <-jr.done:
+	}
+	return nil
+}
+
+func (jr *JsonLineReader) parseLine(data []byte) ([]string, error) {
+	var obj map[string]interface{}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err := dec.Decode(&obj); err != nil {
+		return nil, err
+	}
+	if obj == nil {
+		return nil, fmt.Errorf("the line is not a json object")
+	}
+	keys := make(map[string]string, len(obj))
+	for k := range obj {
+		keys[strings.ToLower(k)] = k
+	}
+	line := make([]string, len(jr.columns))
+	for i, col := range jr.columns {
+		k, ok := obj[col]
+		if !ok {
+			k = obj[keys[strings.ToLower(col)]]
+		}
+		field, err := JsonValueToField(k)
+		if err != nil {
+			return nil, err
+		}
+		line[i] = field
+	}
+	return line, nil
+}
+
+// JsonValueToField converts a json value into the text form the loader parses.
+func JsonValueToField(v interface{}) (string, error) {
+	switch val := v.(type) {
+	case nil:
+		return NullFlag, nil
+	case string:
+		return val, nil
+	case json.Number:
+		return val.String(), nil
+	case bool:
+		if val {
+			return "1", nil
+		}
+		return "0", nil
+	default:
+		data, err := json.Marshal(val)
+		if err != nil {
+			return "", err
+		}
+		return string(data), nil
+	}
+}
+
+func (jr *JsonLineReader) Close() {
+	jr.once.Do(func() {
+		close(jr.done)
+	})
+}
@@ -0,0 +1,52 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package external
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/matrixorigin/simdcsv"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func TestJsonLineReader(t *testing.T) {
+	convey.Convey("read json lines", t, func() {
+		data := `{"a": 1, "B": "x", "c": null}
+
+{"a": 2.5, "c": {"k": [1, 2]}, "d": true}
+`
+		lineOutChan := make(chan simdcsv.LineOut, 10)
+		jr := NewJsonLineReader(strings.NewReader(data), []string{"a", "b", "c", "d"})
+		defer jr.Close()
+		convey.So(jr.ReadLoop(lineOutChan), convey.ShouldBeNil)
+		convey.So((<-lineOutChan).Line, convey.ShouldResemble, []string{"1", "x", NullFlag, NullFlag})
+		convey.So((<-lineOutChan).Line, convey.ShouldResemble, []string{"2.5", NullFlag, `{"k":[1,2]}`, "1"})
+		convey.So((<-lineOutChan).Line, convey.ShouldBeNil)
+	})
+
+	convey.Convey("read bad json lines", t, func() {
+		lineOutChan := make(chan simdcsv.LineOut, 10)
+		jr := NewJsonLineReader(strings.NewReader("{\"a\": 1}\n[1, 2]\n"), []string{"a"})
+		defer jr.Close()
+		convey.So(jr.ReadLoop(lineOutChan), convey.ShouldNotBeNil)
+	})
+
+	convey.Convey("stop reading json lines", t, func() {
+		jr := NewJsonLineReader(strings.NewReader("{\"a\": 1}\n"), []string{"a"})
+		jr.Close()
+		convey.So(jr.ReadLoop(make(chan simdcsv.LineOut)), convey.ShouldBeNil)
+	})
+}
@@ -0,0 +1,721 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package external
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+	"unsafe"
+
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/xitongsys/parquet-go-source/local"
+	"github.com/xitongsys/parquet-go/common"
+	"github.com/xitongsys/parquet-go/parquet"
+	"github.com/xitongsys/parquet-go/reader"
+	"github.com/xitongsys/parquet-go/source"
+	ptypes "github.com/xitongsys/parquet-go/types"
+)
+
+/*
+ParquetReader reads the parquet file. The file is opened once and every loaded
+column is read by its own column buffer in the order of the rows, so the row
+groups are never skipped or reopened. The values of the columns are decoded
+straight into the vectors of the types of the columns they are loaded into,
+only the values whose parquet type does not match the column are converted
+through their text form.
+*/
+type ParquetReader struct {
+	path  string
+	attrs []*engine.Attribute
+	// loc is the time zone of the timestamp values in the text form
+	loc *time.Location
+	// ignoreFieldError makes the value which can not be converted a zero value with a warning
+	ignoreFieldError bool
+
+	file     source.ParquetFile
+	pr       *reader.ParquetReader
+	columns  []*parquetColumn
+	row      int64
+	rows     int64
+	warnings uint64
+}
+
+/*
+NewParquetReader opens the parquet file. The attrs are the columns the columns
+of the file are loaded into, they are picked by the names. The nil attr drops
+the column.
+*/
+func NewParquetReader(path string, attrs []*engine.Attribute, loc *time.Location, ignoreFieldError bool) (*ParquetReader, error) {
+	if loc == nil {
+		loc = time.Local
+	}
+	file, err := local.NewLocalFileReader(path)
+	if err != nil {
+		return nil, err
+	}
+	pr, err := reader.NewParquetColumnReader(file, 1)
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	r := &ParquetReader{
+		path:             path,
+		attrs:            attrs,
+		loc:              loc,
+		ignoreFieldError: ignoreFieldError,
+		file:             file,
+		pr:               pr,
+		rows:             pr.GetNumRows(),
+	}
+	if r.columns, err = r.projection(); err != nil {
+		r.Close()
+		return nil, err
+	}
+	return r, nil
+}
+
+type parquetKind int
+
+const (
+	parquetText parquetKind = iota
+	parquetBool
+	parquetInt
+	parquetUint
+	parquetFloat
+	parquetDecimal
+	parquetDate
+	parquetTimestamp
+	parquetInt96
+)
+
+// parquetColumn is a column of the parquet file which is loaded.
+type parquetColumn struct {
+	path   string
+	schema *parquet.SchemaElement
+	kind   parquetKind
+	// micros converts the tick of the timestamp into microseconds
+	micros func(int64) int64
+}
+
+/*
+projection maps the load columns to the top level columns of the parquet file.
+The load column which is not in the file gets nil.
+*/
+func (r *ParquetReader) projection() ([]*parquetColumn, error) {
+	sh := r.pr.SchemaHandler
+	root := sh.GetRootExName()
+	name2Column := make(map[string]*parquetColumn)
+	for i := 1; i < len(sh.SchemaElements); i++ {
+		if sh.SchemaElements[i].GetNumChildren() != 0 {
+			continue
+		}
+		// only the columns of the top level can be loaded
+		if strings.Count(sh.IndexMap[int32(i)], common.PAR_GO_PATH_DELIMITER) != 1 {
+			continue
+		}
+		name := sh.Infos[i].ExName
+		name2Column[strings.ToLower(name)] = newParquetColumn(root+common.PAR_GO_PATH_DELIMITER+name, sh.SchemaElements[i])
+	}
+	columns := make([]*parquetColumn, len(r.attrs))
+	found, loaded := false, false
+	for i, attr := range r.attrs {
+		if attr == nil {
+			continue
+		}
+		loaded = true
+		columns[i] = name2Column[strings.ToLower(attr.Name)]
+		found = found || columns[i] != nil
+	}
+	if loaded && !found {
+		return nil, fmt.Errorf("the parquet file %s does not have any column of the table", r.path)
+	}
+	return columns, nil
+}
+
+func newParquetColumn(path string, schema *parquet.SchemaElement) *parquetColumn {
+	col := &parquetColumn{path: path, schema: schema}
+	logical := schema.GetLogicalType()
+	converted := schema.ConvertedType
+	switch {
+	case (converted != nil && *converted == parquet.ConvertedType_DECIMAL) || (logical != nil && logical.IsSetDECIMAL()):
+		col.kind = parquetDecimal
+		return col
+	case (converted != nil && *converted == parquet.ConvertedType_DATE) || (logical != nil && logical.IsSetDATE()):
+		col.kind = parquetDate
+		return col
+	case converted != nil && *converted == parquet.ConvertedType_TIMESTAMP_MILLIS:
+		col.kind, col.micros = parquetTimestamp, func(v int64) int64 { return v * 1000 }
+		return col
+	case converted != nil && *converted == parquet.ConvertedType_TIMESTAMP_MICROS:
+		col.kind, col.micros = parquetTimestamp, func(v int64) int64 { return v }
+		return col
+	case logical != nil && logical.IsSetTIMESTAMP():
+		unit := logical.GetTIMESTAMP().GetUnit()
+		switch {
+		case unit.IsSetMILLIS():
+			col.micros = func(v int64) int64 { return v * 1000 }
+		case unit.IsSetNANOS():
+			col.micros = func(v int64) int64 { return v / 1000 }
+		default:
+			col.micros = func(v int64) int64 { return v }
+		}
+		col.kind = parquetTimestamp
+		return col
+	}
+	switch schema.GetType() {
+	case parquet.Type_BOOLEAN:
+		col.kind = parquetBool
+	case parquet.Type_INT32, parquet.Type_INT64:
+		col.kind = parquetInt
+		if isUnsignedParquetType(schema) {
+			col.kind = parquetUint
+		}
+	case parquet.Type_FLOAT, parquet.Type_DOUBLE:
+		col.kind = parquetFloat
+	case parquet.Type_INT96:
+		col.kind = parquetInt96
+	}
+	return col
+}
+
+func isUnsignedParquetType(schema *parquet.SchemaElement) bool {
+	switch schema.GetConvertedType() {
+	case parquet.ConvertedType_UINT_8, parquet.ConvertedType_UINT_16,
+		parquet.ConvertedType_UINT_32, parquet.ConvertedType_UINT_64:
+		return schema.ConvertedType != nil
+	}
+	logical := schema.GetLogicalType()
+	return logical != nil && logical.IsSetINTEGER() && !logical.GetINTEGER().GetIsSigned()
+}
+
+// Skip drops the next n rows of the file.
+func (r *ParquetReader) Skip(n int64) error {
+	if n > r.rows {
+		n = r.rows
+	}
+	for _, col := range r.columns {
+		if col == nil {
+			continue
+		}
+		if err := r.pr.SkipRowsByPath(col.path, n); err != nil {
+			return err
+		}
+	}
+	r.row += n
+	r.rows -= n
+	return nil
+}
+
+/*
+Read decodes the next n rows at most into the rows of the vectors from the row
+start, vecs[i] is the vector of the attrs[i] and the nil vector is not filled.
+The column which is dropped or not in the file is read as null. The columns of
+the vectors grow when they are shorter than the rows. It returns the count of
+the rows read, and 0 at the end of the file.
+*/
+func (r *ParquetReader) Read(vecs []*vector.Vector, start, n int) (int, error) {
+	if int64(n) > r.rows {
+		n = int(r.rows)
+	}
+	if n <= 0 {
+		return 0, nil
+	}
+	for i, vec := range vecs {
+		if vec == nil {
+			continue
+		}
+		col := r.columns[i]
+		if col == nil {
+			fillNulls(vec, start, n)
+			continue
+		}
+		values, _, _, err := r.pr.ReadColumnByPath(col.path, int64(n))
+		if err != nil {
+			return 0, err
+		}
+		if len(values) != n {
+			return 0, fmt.Errorf("the column %s has %d values, but %d rows are read", col.schema.GetName(), len(values), n)
+		}
+		if err = r.decode(vec, start, values, col, r.attrs[i]); err != nil {
+			return 0, err
+		}
+	}
+	r.row += int64(n)
+	r.rows -= int64(n)
+	return n, nil
+}
+
+// Warnings returns the count of the values which are read as zero values.
+func (r *ParquetReader) Warnings() uint64 {
+	return r.warnings
+}
+
+func (r *ParquetReader) Close() error {
+	if r.pr != nil {
+		for _, cb := range r.pr.ColumnBuffers {
+			cb.PFile.Close()
+		}
+		r.pr = nil
+	}
+	if r.file == nil {
+		return nil
+	}
+	err := r.file.Close()
+	r.file = nil
+	return err
+}
+
+// decode converts the values of the parquet column into the rows of the vector from the row start.
+func (r *ParquetReader) decode(vec *vector.Vector, start int, values []interface{}, col *parquetColumn, attr *engine.Attribute) error {
+	typ := vec.Typ
+	switch typ.Oid {
+	case types.T_bool:
+		return decodeFixed(r, vec, start, values, col, func(v interface{}) (bool, error) {
+			if col.kind == parquetBool {
+				return v.(bool), nil
+			}
+			return strconv.ParseBool(col.text(v))
+		})
+	case types.T_int8:
+		return decodeFixed(r, vec, start, values, col, func(v interface{}) (int8, error) {
+			d, err := col.int64Value(v, math.MinInt8, math.MaxInt8)
+			return int8(d), err
+		})
+	case types.T_int16:
+		return decodeFixed(r, vec, start, values, col, func(v interface{}) (int16, error) {
+			d, err := col.int64Value(v, math.MinInt16, math.MaxInt16)
+			return int16(d), err
+		})
+	case types.T_int32:
+		return decodeFixed(r, vec, start, values, col, func(v interface{}) (int32, error) {
+			d, err := col.int64Value(v, math.MinInt32, math.MaxInt32)
+			return int32(d), err
+		})
+	case types.T_int64:
+		return decodeFixed(r, vec, start, values, col, func(v interface{}) (int64, error) {
+			return col.int64Value(v, math.MinInt64, math.MaxInt64)
+		})
+	case types.T_uint8:
+		return decodeFixed(r, vec, start, values, col, func(v interface{}) (uint8, error) {
+			d, err := col.uint64Value(v, math.MaxUint8)
+			return uint8(d), err
+		})
+	case types.T_uint16:
+		return decodeFixed(r, vec, start, values, col, func(v interface{}) (uint16, error) {
+			d, err := col.uint64Value(v, math.MaxUint16)
+			return uint16(d), err
+		})
+	case types.T_uint32:
+		return decodeFixed(r, vec, start, values, col, func(v interface{}) (uint32, error) {
+			d, err := col.uint64Value(v, math.MaxUint32)
+			return uint32(d), err
+		})
+	case types.T_uint64:
+		return decodeFixed(r, vec, start, values, col, func(v interface{}) (uint64, error) {
+			return col.uint64Value(v, math.MaxUint64)
+		})
+	case types.T_float32:
+		return decodeFixed(r, vec, start, values, col, func(v interface{}) (float32, error) {
+			d, err := col.float64Value(v)
+			if err == nil && math.Abs(d) > math.MaxFloat32 && !math.IsInf(d, 0) {
+				err = errValueOutOfRange
+			}
+			return float32(d), err
+		})
+	case types.T_float64:
+		return decodeFixed(r, vec, start, values, col, col.float64Value)
+	case types.T_decimal64:
+		return decodeFixed(r, vec, start, values, col, func(v interface{}) (types.Decimal64, error) {
+			if d, ok := col.unscaled(v, typ.Scale); ok {
+				return types.Decimal64(d), types.CheckDecimal64Width(types.Decimal64(d), typ.Width)
+			}
+			return types.ParseStringToDecimal64(col.text(v), typ.Width, typ.Scale)
+		})
+	case types.T_decimal128:
+		return decodeFixed(r, vec, start, values, col, func(v interface{}) (types.Decimal128, error) {
+			if d, ok := col.unscaled(v, typ.Scale); ok {
+				return types.InitDecimal128(d), nil
+			}
+			return types.ParseStringToDecimal128(col.text(v), typ.Width, typ.Scale)
+		})
+	case types.T_date:
+		return decodeFixed(r, vec, start, values, col, func(v interface{}) (types.Date, error) {
+			switch col.kind {
+			case parquetDate:
+				return types.DateFromUnixDays(v.(int32)), nil
+			case parquetTimestamp, parquetInt96:
+				return col.datetime(v).ToDate(), nil
+			}
+			return types.ParseDate(col.text(v))
+		})
+	case types.T_datetime:
+		return decodeFixed(r, vec, start, values, col, func(v interface{}) (types.Datetime, error) {
+			switch col.kind {
+			case parquetDate:
+				return types.DateFromUnixDays(v.(int32)).ToTime(), nil
+			case parquetTimestamp, parquetInt96:
+				return col.datetime(v), nil
+			}
+			return types.ParseDatetime(col.text(v))
+		})
+	case types.T_timestamp:
+		return decodeFixed(r, vec, start, values, col, func(v interface{}) (types.Timestamp, error) {
+			switch col.kind {
+			case parquetDate:
+				return types.DateFromUnixDays(v.(int32)).ToTime().ToTimestamp(r.loc), nil
+			case parquetTimestamp, parquetInt96:
+				return types.TimestampFromUnixMicro(col.datetime(v).UnixMicro()), nil
+			}
+			return types.ParseTimestamp(r.loc, col.text(v), typ.Precision)
+		})
+	case types.T_time:
+		return decodeFixed(r, vec, start, values, col, func(v interface{}) (types.Time, error) {
+			return types.ParseTime(col.text(v), typ.Precision)
+		})
+	case types.T_year:
+		return decodeFixed(r, vec, start, values, col, func(v interface{}) (types.YearValue, error) {
+			if col.kind == parquetInt || col.kind == parquetUint {
+				d, err := col.int64Value(v, math.MinInt64, math.MaxInt64)
+				if err != nil {
+					return 0, err
+				}
+				return types.YearFromInt(d)
+			}
+			return types.ParseYear(col.text(v))
+		})
+	case types.T_uuid:
+		return decodeFixed(r, vec, start, values, col, func(v interface{}) (types.Uuid, error) {
+			return types.ParseUuid(col.text(v))
+		})
+	case types.T_enum:
+		return decodeFixed(r, vec, start, values, col, func(v interface{}) (uint16, error) {
+			return types.ParseEnum(attr.EnumValues, col.text(v))
+		})
+	case types.T_set:
+		return decodeFixed(r, vec, start, values, col, func(v interface{}) (uint64, error) {
+			return types.ParseSet(attr.EnumValues, col.text(v))
+		})
+	case types.T_json:
+		return decodeBytes(r, vec, start, values, col, func(v interface{}) ([]byte, error) {
+			bj, err := bytejson.ParseFromString(col.text(v))
+			if err != nil {
+				return nil, err
+			}
+			return bj.Marshal(), nil
+		})
+	case types.T_char, types.T_varchar, types.T_text, types.T_blob:
+		return decodeBytes(r, vec, start, values, col, func(v interface{}) ([]byte, error) {
+			if s, ok := v.(string); ok && col.kind == parquetText {
+				return []byte(s), nil
+			}
+			return []byte(col.text(v)), nil
+		})
+	}
+	return fmt.Errorf("unsupported type %s", typ)
+}
+
+var errValueOutOfRange = fmt.Errorf("value out of range")
+
+// fieldError handles the value which can not be converted, the ignored error is counted as a warning.
+func (r *ParquetReader) fieldError(col *parquetColumn, row int, v interface{}, err error) error {
+	if r.ignoreFieldError {
+		r.warnings++
+		return nil
+	}
+	return fmt.Errorf("convert the value %v of the column %s at row %d of the file %s failed: %v",
+		v, col.schema.GetName(), r.row+int64(row)+1, r.path, err)
+}
+
+func decodeFixed[T any](r *ParquetReader, vec *vector.Vector, start int, values []interface{}, col *parquetColumn, conv func(interface{}) (T, error)) error {
+	var zero T
+	rs := growFixed[T](vec, start+len(values))
+	for i, v := range values {
+		if v == nil {
+			nulls.Add(vec.Nsp, uint64(start+i))
+			continue
+		}
+		d, err := conv(v)
+		if err != nil {
+			if err = r.fieldError(col, i, v, err); err != nil {
+				return err
+			}
+			d = zero
+		}
+		rs[start+i] = d
+	}
+	vec.Col = rs
+	vec.Data = encoding.EncodeFixedSlice(rs, int(unsafe.Sizeof(zero)))
+	return nil
+}
+
+func decodeBytes(r *ParquetReader, vec *vector.Vector, start int, values []interface{}, col *parquetColumn, conv func(interface{}) ([]byte, error)) error {
+	rs := growBytes(vec, start+len(values))
+	for i, v := range values {
+		rs.Offsets[start+i] = uint32(len(rs.Data))
+		rs.Lengths[start+i] = 0
+		if v == nil {
+			nulls.Add(vec.Nsp, uint64(start+i))
+			continue
+		}
+		data, err := conv(v)
+		if err != nil {
+			if err = r.fieldError(col, i, v, err); err != nil {
+				return err
+			}
+			data = nil
+		}
+		rs.Data = append(rs.Data, data...)
+		rs.Lengths[start+i] = uint32(len(data))
+	}
+	vec.Data = rs.Data
+	return nil
+}
+
+func growFixed[T any](vec *vector.Vector, n int) []T {
+	rs := vec.Col.([]T)
+	if len(rs) < n {
+		rs = append(rs, make([]T, n-len(rs))...)
+	}
+	return rs
+}
+
+func growBytes(vec *vector.Vector, n int) *types.Bytes {
+	rs := vec.Col.(*types.Bytes)
+	if len(rs.Offsets) < n {
+		rs.Offsets = append(rs.Offsets, make([]uint32, n-len(rs.Offsets))...)
+		rs.Lengths = append(rs.Lengths, make([]uint32, n-len(rs.Lengths))...)
+	}
+	return rs
+}
+
+// fillNulls makes the rows [start, start+n) of the vector null.
+func fillNulls(vec *vector.Vector, start, n int) {
+	switch vec.Typ.Oid {
+	case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
+		rs := growBytes(vec, start+n)
+		for i := start; i < start+n; i++ {
+			rs.Offsets[i] = uint32(len(rs.Data))
+			rs.Lengths[i] = 0
+		}
+		vec.Data = rs.Data
+	case types.T_bool:
+		vec.Col = growFixed[bool](vec, start+n)
+	case types.T_int8:
+		vec.Col = growFixed[int8](vec, start+n)
+	case types.T_int16:
+		vec.Col = growFixed[int16](vec, start+n)
+	case types.T_int32:
+		vec.Col = growFixed[int32](vec, start+n)
+	case types.T_int64:
+		vec.Col = growFixed[int64](vec, start+n)
+	case types.T_uint8:
+		vec.Col = growFixed[uint8](vec, start+n)
+	case types.T_uint16, types.T_enum:
+		vec.Col = growFixed[uint16](vec, start+n)
+	case types.T_uint32:
+		vec.Col = growFixed[uint32](vec, start+n)
+	case types.T_uint64, types.T_set:
+		vec.Col = growFixed[uint64](vec, start+n)
+	case types.T_float32:
+		vec.Col = growFixed[float32](vec, start+n)
+	case types.T_float64:
+		vec.Col = growFixed[float64](vec, start+n)
+	case types.T_decimal64:
+		vec.Col = growFixed[types.Decimal64](vec, start+n)
+	case types.T_decimal128:
+		vec.Col = growFixed[types.Decimal128](vec, start+n)
+	case types.T_date:
+		vec.Col = growFixed[types.Date](vec, start+n)
+	case types.T_datetime:
+		vec.Col = growFixed[types.Datetime](vec, start+n)
+	case types.T_timestamp:
+		vec.Col = growFixed[types.Timestamp](vec, start+n)
+	case types.T_time:
+		vec.Col = growFixed[types.Time](vec, start+n)
+	case types.T_year:
+		vec.Col = growFixed[types.YearValue](vec, start+n)
+	case types.T_uuid:
+		vec.Col = growFixed[types.Uuid](vec, start+n)
+	}
+	for i := start; i < start+n; i++ {
+		nulls.Add(vec.Nsp, uint64(i))
+	}
+}
+
+// int64Value converts the number of the column into an integer in [min, max].
+func (c *parquetColumn) int64Value(v interface{}, min, max int64) (int64, error) {
+	var d int64
+	switch c.kind {
+	case parquetBool:
+		if v.(bool) {
+			d = 1
+		}
+	case parquetInt:
+		d = toInt64(v)
+	case parquetUint:
+		u := toUint64(v)
+		if u > math.MaxInt64 {
+			return 0, errValueOutOfRange
+		}
+		d = int64(u)
+	case parquetFloat:
+		f := toFloat64(v)
+		if math.IsNaN(f) || f < float64(min) || f > float64(max) {
+			return 0, errValueOutOfRange
+		}
+		d = int64(f)
+	default:
+		var err error
+		if d, err = strconv.ParseInt(c.text(v), 10, 64); err != nil {
+			return 0, err
+		}
+	}
+	if d < min || d > max {
+		return 0, errValueOutOfRange
+	}
+	return d, nil
+}
+
+// uint64Value converts the number of the column into an unsigned integer in [0, max].
+func (c *parquetColumn) uint64Value(v interface{}, max uint64) (uint64, error) {
+	var d uint64
+	switch c.kind {
+	case parquetBool:
+		if v.(bool) {
+			d = 1
+		}
+	case parquetInt:
+		i := toInt64(v)
+		if i < 0 {
+			return 0, errValueOutOfRange
+		}
+		d = uint64(i)
+	case parquetUint:
+		d = toUint64(v)
+	case parquetFloat:
+		f := toFloat64(v)
+		if math.IsNaN(f) || f < 0 || f > float64(max) {
+			return 0, errValueOutOfRange
+		}
+		d = uint64(f)
+	default:
+		var err error
+		if d, err = strconv.ParseUint(c.text(v), 10, 64); err != nil {
+			return 0, err
+		}
+	}
+	if d > max {
+		return 0, errValueOutOfRange
+	}
+	return d, nil
+}
+
+func (c *parquetColumn) float64Value(v interface{}) (float64, error) {
+	switch c.kind {
+	case parquetFloat:
+		return toFloat64(v), nil
+	case parquetInt:
+		return float64(toInt64(v)), nil
+	case parquetUint:
+		return float64(toUint64(v)), nil
+	}
+	return strconv.ParseFloat(c.text(v), 64)
+}
+
+// unscaled returns the unscaled integer of the decimal when the decimal has the scale.
+func (c *parquetColumn) unscaled(v interface{}, scale int32) (int64, bool) {
+	if c.kind != parquetDecimal || c.schema.GetScale() != scale {
+		return 0, false
+	}
+	switch val := v.(type) {
+	case int32:
+		return int64(val), true
+	case int64:
+		return val, true
+	}
+	return 0, false
+}
+
+func (c *parquetColumn) datetime(v interface{}) types.Datetime {
+	if c.kind == parquetInt96 {
+		return types.DatetimeFromUnixMicro(ptypes.INT96ToTime(v.(string)).UnixMicro())
+	}
+	return types.DatetimeFromUnixMicro(c.micros(toInt64(v)))
+}
+
+// text returns the text form of the value for the columns whose types do not match.
+func (c *parquetColumn) text(v interface{}) string {
+	precision, scale := int(c.schema.GetPrecision()), int(c.schema.GetScale())
+	switch c.kind {
+	case parquetDate:
+		return types.DateFromUnixDays(v.(int32)).String()
+	case parquetTimestamp, parquetInt96:
+		return c.datetime(v).String()
+	case parquetDecimal:
+		if s, ok := v.(string); ok {
+			return ptypes.DECIMAL_BYTE_ARRAY_ToString([]byte(s), precision, scale)
+		}
+		return ptypes.DECIMAL_INT_ToString(toInt64(v), precision, scale)
+	case parquetUint:
+		return strconv.FormatUint(toUint64(v), 10)
+	}
+	switch val := v.(type) {
+	case bool:
+		if val {
+			return "1"
+		}
+		return "0"
+	case int32:
+		return strconv.FormatInt(int64(val), 10)
+	case int64:
+		return strconv.FormatInt(val, 10)
+	case float32:
+		return strconv.FormatFloat(float64(val), 'g', -1, 32)
+	case float64:
+		return strconv.FormatFloat(val, 'g', -1, 64)
+	case string:
+		return val
+	}
+	return fmt.Sprint(v)
+}
+
+func toInt64(v interface{}) int64 {
+	if d, ok := v.(int32); ok {
+		return int64(d)
+	}
+	return v.(int64)
+}
+
+func toUint64(v interface{}) uint64 {
+	if d, ok := v.(int32); ok {
+		return uint64(uint32(d))
+	}
+	return uint64(v.(int64))
+}
+
+func toFloat64(v interface{}) float64 {
+	if d, ok := v.(float32); ok {
+		return float64(d)
+	}
+	return v.(float64)
+}
@@ -0,0 +1,422 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package external
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+	"unsafe"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/simdcsv"
+)
+
+// NewReader returns the reader of the file, only the used columns are parsed.
+func NewReader(param *tree.ExternParam, path string, attrs []engine.Attribute, used []bool, loc *time.Location) *Reader {
+	if loc == nil {
+		loc = time.Local
+	}
+	return &Reader{
+		path:  path,
+		param: param,
+		attrs: attrs,
+		used:  used,
+		loc:   loc,
+	}
+}
+
+func (r *Reader) Read(refCnts []uint64, attrs []string) (*batch.Batch, error) {
+	if r.end {
+		r.Close()
+		return nil, nil
+	}
+	if r.lr == nil && r.pr == nil {
+		if err := r.open(); err != nil {
+			r.Close()
+			return nil, err
+		}
+	}
+	if r.pr != nil {
+		return r.readParquet(refCnts, attrs)
+	}
+	lines, err := r.readLines()
+	if err != nil {
+		r.Close()
+		return nil, err
+	}
+	if len(lines) == 0 {
+		r.Close()
+		return nil, nil
+	}
+	bat := batch.New(true, attrs)
+	for i, attr := range attrs {
+		idx := r.index(attr)
+		if idx < 0 {
+			r.Close()
+			return nil, errors.New(errno.UndefinedColumn, fmt.Sprintf("column '%s' is not in the external table", attr))
+		}
+		vec := vector.New(r.attrs[idx].Type)
+		if row, err := r.fillVector(vec, idx, lines); err != nil {
+			r.Close()
+			return nil, errors.New(errno.DataException, fmt.Sprintf("parse the field '%s' of the column '%s' at line %d of the file %s failed: %v",
+				lines[row].field(idx), attr, lines[row].no, r.path, err))
+		}
+		if i < len(refCnts) {
+			vec.Ref = refCnts[i]
+		}
+		bat.Vecs[i] = vec
+	}
+	bat.InitZsOne(len(lines))
+	return bat, nil
+}
+
+// Close stops reading the file, the lines the file reader is delivering are dropped.
+func (r *Reader) Close() error {
+	var err error
+	r.once.Do(func() {
+		r.end = true
+		if r.pr != nil {
+			err = r.pr.Close()
+		}
+		if r.lr != nil {
+			r.lr.Close()
+			go func() {
+				for {
+					select {
+					case <-r.lines:
+					case <-r.done:
+						return
+					}
+				}
+			}()
+		}
+		if r.dec != nil {
+			err = r.dec.Close()
+		}
+		if r.file != nil {
+			if cerr := r.file.Close(); err == nil {
+				err = cerr
+			}
+		}
+	})
+	return err
+}
+
+func (r *Reader) open() error {
+	compression := GetCompressType(r.param.Compression, r.path)
+	if r.param.FileFormat == tree.PARQUET {
+		if compression != tree.NOCOMPRESS {
+			return fmt.Errorf("the parquet file %s can not be compressed by %s", r.path, compression)
+		}
+		attrs := make([]*engine.Attribute, len(r.attrs))
+		for i := range r.attrs {
+			if r.used[i] {
+				attrs[i] = &r.attrs[i]
+			}
+		}
+		pr, err := NewParquetReader(r.path, attrs, r.loc, false)
+		if err != nil {
+			return err
+		}
+		r.pr = pr
+		return r.pr.Skip(int64(r.param.IgnoredLines))
+	}
+	names := make([]string, len(r.attrs))
+	for i, attr := range r.attrs {
+		if r.used[i] {
+			names[i] = attr.Name
+		}
+	}
+	if len(names) > 0 && strings.Join(names, "") == "" {
+		// a column is still decoded to know the count of the rows
+		names[0] = r.attrs[0].Name
+	}
+	file, err := os.Open(r.path)
+	if err != nil {
+		return err
+	}
+	r.file = file
+	if r.dec, err = GetUnCompressReader(compression, file); err != nil {
+		return err
+	}
+	if r.param.FileFormat == tree.JSONLINE {
+		r.lr = NewJsonLineReader(r.dec, names)
+	} else {
+		sep := ','
+		if r.param.Fields != nil && len(r.param.Fields.Terminated) > 0 {
+			sep = rune(r.param.Fields.Terminated[0])
+		}
+		r.lr = simdcsv.NewReaderWithOptions(r.dec, sep, '#', false, false)
+	}
+	r.lines = make(chan simdcsv.LineOut, BatchSize)
+	r.done = make(chan struct{})
+	go func(lr LineReader) {
+		r.err = lr.ReadLoop(r.lines)
+		close(r.done)
+	}(r.lr)
+	return nil
+}
+
+// readParquet decodes the next rows of the parquet file into the vectors of the columns.
+func (r *Reader) readParquet(refCnts []uint64, attrs []string) (*batch.Batch, error) {
+	bat := batch.New(true, attrs)
+	vecs := make([]*vector.Vector, len(r.attrs))
+	for i, attr := range attrs {
+		idx := r.index(attr)
+		if idx < 0 {
+			r.Close()
+			return nil, errors.New(errno.UndefinedColumn, fmt.Sprintf("column '%s' is not in the external table", attr))
+		}
+		vec := vector.New(r.attrs[idx].Type)
+		if i < len(refCnts) {
+			vec.Ref = refCnts[i]
+		}
+		bat.Vecs[i] = vec
+		vecs[idx] = vec
+	}
+	n, err := r.pr.Read(vecs, 0, BatchSize)
+	if err != nil {
+		r.Close()
+		return nil, errors.New(errno.DataException, err.Error())
+	}
+	if n == 0 {
+		r.Close()
+		return nil, nil
+	}
+	bat.InitZsOne(n)
+	return bat, nil
+}
+
+// line is a line of the file with its number
+type line struct {
+	no     uint64
+	fields []string
+}
+
+func (l line) field(idx int) string {
+	if idx < len(l.fields) {
+		return strings.TrimSpace(l.fields[idx])
+	}
+	return ""
+}
+
+// readLines reads at most BatchSize lines, it returns no lines at the end of the file.
+func (r *Reader) readLines() ([]line, error) {
+	lines := make([]line, 0, BatchSize)
+	for len(lines) < BatchSize {
+		var lo simdcsv.LineOut
+		select {
+		case lo = <-r.lines:
+		case <-r.done:
+			// all the lines are received when the file reader returns
+			select {
+			case lo = <-r.lines:
+			default:
+				if r.err != nil {
+					return nil, r.err
+				}
+				return lines, nil
+			}
+		}
+		if lo.Line == nil && lo.Lines == nil {
+			r.end = true
+			return lines, nil
+		}
+		for _, fields := range append(lo.Lines, lo.Line) {
+			if fields == nil {
+				continue
+			}
+			if r.lineNo++; r.lineNo <= r.param.IgnoredLines {
+				continue
+			}
+			lines = append(lines, line{no: r.lineNo, fields: fields})
+		}
+	}
+	return lines, nil
+}
+
+func (r *Reader) index(name string) int {
+	for i, attr := range r.attrs {
+		if attr.Name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+func isNull(field string) bool {
+	return len(field) == 0 || field == NullFlag
+}
+
+// fillVector parses the fields of the column in the lines into the vector,
+// it returns the row of the field which can not be parsed.
+func (r *Reader) fillVector(vec *vector.Vector, idx int, lines []line) (int, error) {
+	if !r.used[idx] {
+		// the column is not referred by the query, so it is read as null
+		lines = make([]line, len(lines))
+	}
+	typ := vec.Typ
+	switch typ.Oid {
+	case types.T_bool:
+		return fillFixed(vec, idx, lines, strconv.ParseBool)
+	case types.T_int8:
+		return fillFixed(vec, idx, lines, func(s string) (int8, error) {
+			v, err := strconv.ParseInt(s, 10, 8)
+			return int8(v), err
+		})
+	case types.T_int16:
+		return fillFixed(vec, idx, lines, func(s string) (int16, error) {
+			v, err := strconv.ParseInt(s, 10, 16)
+			return int16(v), err
+		})
+	case types.T_int32:
+		return fillFixed(vec, idx, lines, func(s string) (int32, error) {
+			v, err := strconv.ParseInt(s, 10, 32)
+			return int32(v), err
+		})
+	case types.T_int64:
+		return fillFixed(vec, idx, lines, func(s string) (int64, error) {
+			return strconv.ParseInt(s, 10, 64)
+		})
+	case types.T_uint8:
+		return fillFixed(vec, idx, lines, func(s string) (uint8, error) {
+			v, err := strconv.ParseUint(s, 10, 8)
+			return uint8(v), err
+		})
+	case types.T_uint16:
+		return fillFixed(vec, idx, lines, func(s string) (uint16, error) {
+			v, err := strconv.ParseUint(s, 10, 16)
+			return uint16(v), err
+		})
+	case types.T_uint32:
+		return fillFixed(vec, idx, lines, func(s string) (uint32, error) {
+			v, err := strconv.ParseUint(s, 10, 32)
+			return uint32(v), err
+		})
+	case types.T_uint64:
+		return fillFixed(vec, idx, lines, func(s string) (uint64, error) {
+			return strconv.ParseUint(s, 10, 64)
+		})
+	case types.T_float32:
+		return fillFixed(vec, idx, lines, func(s string) (float32, error) {
+			v, err := strconv.ParseFloat(s, 32)
+			return float32(v), err
+		})
+	case types.T_float64:
+		return fillFixed(vec, idx, lines, func(s string) (float64, error) {
+			return strconv.ParseFloat(s, 64)
+		})
+	case types.T_decimal64:
+		return fillFixed(vec, idx, lines, func(s string) (types.Decimal64, error) {
+			return types.ParseStringToDecimal64(s, typ.Width, typ.Scale)
+		})
+	case types.T_decimal128:
+		return fillFixed(vec, idx, lines, func(s string) (types.Decimal128, error) {
+			return types.ParseStringToDecimal128(s, typ.Width, typ.Scale)
+		})
+	case types.T_date:
+		return fillFixed(vec, idx, lines, types.ParseDate)
+	case types.T_datetime:
+		return fillFixed(vec, idx, lines, types.ParseDatetime)
+	case types.T_time:
+		return fillFixed(vec, idx, lines, func(s string) (types.Time, error) {
+			return types.ParseTime(s, typ.Precision)
+		})
+	case types.T_timestamp:
+		return fillFixed(vec, idx, lines, func(s string) (types.Timestamp, error) {
+			return types.ParseTimestamp(r.loc, s, typ.Precision)
+		})
+	case types.T_year:
+		return fillFixed(vec, idx, lines, types.ParseYear)
+	case types.T_uuid:
+		return fillFixed(vec, idx, lines, types.ParseUuid)
+	case types.T_enum:
+		return fillFixed(vec, idx, lines, func(s string) (uint16, error) {
+			return types.ParseEnum(r.attrs[idx].EnumValues, s)
+		})
+	case types.T_set:
+		return fillFixed(vec, idx, lines, func(s string) (uint64, error) {
+			return types.ParseSet(r.attrs[idx].EnumValues, s)
+		})
+	case types.T_json:
+		return fillBytes(vec, idx, lines, func(s string) ([]byte, error) {
+			bj, err := bytejson.ParseFromString(s)
+			if err != nil {
+				return nil, err
+			}
+			return bj.Marshal(), nil
+		})
+	case types.T_char, types.T_varchar, types.T_text, types.T_blob:
+		return fillBytes(vec, idx, lines, func(s string) ([]byte, error) {
+			return []byte(s), nil
+		})
+	}
+	return 0, fmt.Errorf("unsupported type %s", typ)
+}
+
+func fillFixed[T any](vec *vector.Vector, idx int, lines []line, parse func(string) (T, error)) (int, error) {
+	var v T
+	var err error
+	col := make([]T, len(lines))
+	for i, l := range lines {
+		field := l.field(idx)
+		if isNull(field) {
+			nulls.Add(vec.Nsp, uint64(i))
+			continue
+		}
+		if col[i], err = parse(field); err != nil {
+			return i, err
+		}
+	}
+	vec.Col = col
+	vec.Data = encoding.EncodeFixedSlice(col, int(unsafe.Sizeof(v)))
+	return 0, nil
+}
+
+func fillBytes(vec *vector.Vector, idx int, lines []line, parse func(string) ([]byte, error)) (int, error) {
+	col := &types.Bytes{
+		Offsets: make([]uint32, len(lines)),
+		Lengths: make([]uint32, len(lines)),
+	}
+	for i, l := range lines {
+		col.Offsets[i] = uint32(len(col.Data))
+		field := l.field(idx)
+		if isNull(field) {
+			nulls.Add(vec.Nsp, uint64(i))
+			continue
+		}
+		data, err := parse(field)
+		if err != nil {
+			return i, err
+		}
+		col.Data = append(col.Data, data...)
+		col.Lengths[i] = uint32(len(data))
+	}
+	vec.Col = col
+	vec.Data = col.Data
+	return 0, nil
+}
@@ -0,0 +1,200 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package external
+
+import (
+	"compress/gzip"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/stretchr/testify/require"
+	"github.com/xitongsys/parquet-go-source/local"
+	"github.com/xitongsys/parquet-go/writer"
+)
+
+var testAttrs = []engine.Attribute{
+	{Name: "a", Type: types.Type{Oid: types.T_int32, Size: 4}},
+	{Name: "b", Type: types.Type{Oid: types.T_varchar, Size: 24}},
+	{Name: "c", Type: types.Type{Oid: types.T_date, Size: 4}},
+	{Name: "d", Type: types.Type{Oid: types.T_float64, Size: 8}},
+}
+
+var testNames = []string{"a", "b", "c", "d"}
+
+func TestReadCsv(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "t.csv")
+	data := "a|b|c|d\n1|x|2022-05-01|1.5\n2|\\N|2022-05-02|\n3||bad date|-2\n"
+	require.NoError(t, os.WriteFile(path, []byte(data), 0644))
+	param := &tree.ExternParam{
+		Location:     path,
+		FileFormat:   tree.CSV,
+		Fields:       &tree.Fields{Terminated: "|"},
+		IgnoredLines: 1,
+	}
+	// the column c is not used, so its fields are not parsed
+	r := NewReader(param, path, testAttrs, []bool{true, true, false, true}, nil)
+	bat, err := r.Read(make([]uint64, 4), testNames)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(bat.Zs))
+	require.Equal(t, []int32{1, 2, 3}, bat.Vecs[0].Col.([]int32))
+	require.Equal(t, "x", string(bat.Vecs[1].Col.(*types.Bytes).Get(0)))
+	require.True(t, nulls.Contains(bat.Vecs[1].Nsp, 1))
+	require.True(t, nulls.Contains(bat.Vecs[1].Nsp, 2))
+	for i := 0; i < 3; i++ {
+		require.True(t, nulls.Contains(bat.Vecs[2].Nsp, uint64(i)))
+	}
+	require.Equal(t, []float64{1.5, 0, -2}, bat.Vecs[3].Col.([]float64))
+	require.True(t, nulls.Contains(bat.Vecs[3].Nsp, 1))
+	require.Equal(t, []float64{1.5, 0, -2}, vector.DecodeFixedCol[float64](bat.Vecs[3], 8))
+	bat, err = r.Read(make([]uint64, 4), testNames)
+	require.NoError(t, err)
+	require.Nil(t, bat)
+
+	// all the columns are parsed
+	r = NewReader(param, path, testAttrs, []bool{true, true, true, true}, nil)
+	_, err = r.Read(make([]uint64, 4), testNames)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "line 4")
+}
+
+func TestReadBatches(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "t.csv")
+	var buf strings.Builder
+	rows := BatchSize + 10
+	for i := 0; i < rows; i++ {
+		fmt.Fprintf(&buf, "%d,v%d,2022-05-01,%d.5\n", i, i, i)
+	}
+	require.NoError(t, os.WriteFile(path, []byte(buf.String()), 0644))
+	param := &tree.ExternParam{Location: path, FileFormat: tree.CSV}
+	r := NewReader(param, path, testAttrs, []bool{true, false, false, false}, nil)
+	cnt := 0
+	for {
+		bat, err := r.Read(make([]uint64, 1), []string{"a"})
+		require.NoError(t, err)
+		if bat == nil {
+			break
+		}
+		for _, v := range bat.Vecs[0].Col.([]int32) {
+			require.Equal(t, int32(cnt), v)
+			cnt++
+		}
+	}
+	require.Equal(t, rows, cnt)
+
+	// the reader can be closed before the end of the file
+	r = NewReader(param, path, testAttrs, []bool{true, false, false, false}, nil)
+	bat, err := r.Read(make([]uint64, 1), []string{"a"})
+	require.NoError(t, err)
+	require.Equal(t, BatchSize, len(bat.Zs))
+	require.NoError(t, r.Close())
+	bat, err = r.Read(make([]uint64, 1), []string{"a"})
+	require.NoError(t, err)
+	require.Nil(t, bat)
+}
+
+func TestReadJsonLine(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "t.jsonl.gz")
+	f, err := os.Create(path)
+	require.NoError(t, err)
+	w := gzip.NewWriter(f)
+	_, err = w.Write([]byte(`{"a": 1, "b": "x", "d": 2.5}` + "\n" + `{"A": 2, "c": "2022-05-01", "d": null}` + "\n"))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	require.NoError(t, f.Close())
+
+	param := &tree.ExternParam{Location: path, FileFormat: tree.JSONLINE, Compression: tree.AUTO}
+	r := NewReader(param, path, testAttrs, []bool{true, true, true, true}, nil)
+	bat, err := r.Read(make([]uint64, 4), testNames)
+	require.NoError(t, err)
+	require.Equal(t, []int32{1, 2}, bat.Vecs[0].Col.([]int32))
+	require.True(t, nulls.Contains(bat.Vecs[1].Nsp, 1))
+	require.True(t, nulls.Contains(bat.Vecs[2].Nsp, 0))
+	require.Equal(t, "2022-05-01", bat.Vecs[2].Col.([]types.Date)[1].String())
+	require.Equal(t, 2.5, bat.Vecs[3].Col.([]float64)[0])
+	require.True(t, nulls.Contains(bat.Vecs[3].Nsp, 1))
+	bat, err = r.Read(make([]uint64, 4), testNames)
+	require.NoError(t, err)
+	require.Nil(t, bat)
+
+	// the missing file
+	r = NewReader(param, path+".x", testAttrs, []bool{true, true, true, true}, nil)
+	_, err = r.Read(make([]uint64, 4), testNames)
+	require.Error(t, err)
+}
+
+func TestReadParquet(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "t.parquet")
+	file, err := local.NewLocalFileWriter(path)
+	require.NoError(t, err)
+	md := []string{
+		"name=a, type=INT64, repetitiontype=OPTIONAL",
+		"name=b, type=BYTE_ARRAY, convertedtype=UTF8, repetitiontype=OPTIONAL",
+		"name=c, type=INT32, convertedtype=DATE, repetitiontype=OPTIONAL",
+		"name=d, type=DOUBLE, repetitiontype=OPTIONAL",
+	}
+	pw, err := writer.NewCSVWriter(md, file, 1)
+	require.NoError(t, err)
+	// a small row group size makes the rows span the row groups
+	pw.RowGroupSize = 1
+	date, _ := types.ParseDate("2022-05-01")
+	rows := [][]interface{}{
+		{int64(0), "skipped", date.DaysSinceUnixEpoch(), float64(0)},
+		{int64(1), "x", date.DaysSinceUnixEpoch(), float64(1.5)},
+		{int64(2), nil, nil, nil},
+		{int64(1) << 40, "", date.DaysSinceUnixEpoch(), float64(-2)},
+	}
+	for _, row := range rows {
+		require.NoError(t, pw.Write(row))
+	}
+	require.NoError(t, pw.WriteStop())
+	require.NoError(t, file.Close())
+
+	param := &tree.ExternParam{
+		Location:     path,
+		FileFormat:   tree.PARQUET,
+		IgnoredLines: 1,
+	}
+	// the column a is not used, so the values out of the range of int32 are not read
+	r := NewReader(param, path, testAttrs, []bool{false, true, true, true}, nil)
+	bat, err := r.Read(make([]uint64, 4), testNames)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(bat.Zs))
+	for i := 0; i < 3; i++ {
+		require.True(t, nulls.Contains(bat.Vecs[0].Nsp, uint64(i)))
+	}
+	require.Equal(t, "x", string(bat.Vecs[1].Col.(*types.Bytes).Get(0)))
+	require.True(t, nulls.Contains(bat.Vecs[1].Nsp, 1))
+	require.False(t, nulls.Contains(bat.Vecs[1].Nsp, 2))
+	require.Equal(t, []types.Date{date, 0, date}, bat.Vecs[2].Col.([]types.Date))
+	require.True(t, nulls.Contains(bat.Vecs[2].Nsp, 1))
+	require.Equal(t, []float64{1.5, 0, -2}, vector.DecodeFixedCol[float64](bat.Vecs[3], 8))
+	bat, err = r.Read(make([]uint64, 4), testNames)
+	require.NoError(t, err)
+	require.Nil(t, bat)
+
+	// all the columns are read
+	r = NewReader(param, path, testAttrs, []bool{true, true, true, true}, nil)
+	_, err = r.Read(make([]uint64, 4), testNames)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "row 4")
+}
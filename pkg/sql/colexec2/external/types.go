@@ -0,0 +1,70 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package external
+
+import (
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/simdcsv"
+)
+
+const (
+	// NullFlag is the field of the null value
+	NullFlag = "\\N"
+	// BatchSize is the max count of the rows in a batch read from the file
+	BatchSize = 8192
+)
+
+/*
+LineReader reads the lines of the data file and delivers the fields of them into the channel.
+*/
+type LineReader interface {
+	ReadLoop(lineOutChan chan simdcsv.LineOut) error
+	Close()
+}
+
+/*
+Reader reads the rows of a file of the external table. It implements the
+engine.Reader, the lines of the file are parsed into batches of the columns
+of the table. The fields are in the order of the columns in the csv file,
+and are picked by the names of the columns from the jsonline file. The
+columns of the parquet file are picked by the names too, and are decoded
+into the batches without the lines.
+*/
+type Reader struct {
+	path  string
+	param *tree.ExternParam
+	attrs []engine.Attribute
+	// used marks the columns to parse, the other columns are read as null
+	used []bool
+	// loc is the time zone of the timestamp fields
+	loc *time.Location
+
+	file   *os.File
+	dec    io.ReadCloser
+	lr     LineReader
+	pr     *ParquetReader
+	lines  chan simdcsv.LineOut
+	done   chan struct{}
+	err    error
+	lineNo uint64
+	end    bool
+	once   sync.Once
+}
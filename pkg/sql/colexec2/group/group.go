@@ -107,6 +107,10 @@ func (ctr *Container) process(ap *Argument, proc *process.Process) (bool, error)
 			}
 		}
 	}()
+	if err := ctr.evalArguments(bat, ap, proc); err != nil {
+		return false, err
+	}
+	defer ctr.cleanArguments(proc)
 	if ctr.bat == nil {
 		var err error
 
@@ -114,7 +118,7 @@ func (ctr *Container) process(ap *Argument, proc *process.Process) (bool, error)
 		ctr.bat.Zs = []int64{0}
 		ctr.bat.Rs = make([]ring.Ring, len(ap.Aggs))
 		for i, agg := range ap.Aggs {
-			if ctr.bat.Rs[i], err = aggregate.New(agg.Op, ctr.aggVecs[i].vec.Typ); err != nil {
+			if ctr.bat.Rs[i], err = aggregate.NewWithParam(agg.Op, agg.Param, ctr.aggVecs[i].vec.Typ); err != nil {
 				return false, err
 			}
 		}
@@ -125,6 +129,7 @@ func (ctr *Container) process(ap *Argument, proc *process.Process) (bool, error)
 			}
 		}
 	}
+	ctr.setArguments()
 	if err := ctr.processH0(bat, ap, proc); err != nil {
 		ctr.bat.Clean(proc.Mp)
 		return false, err
@@ -189,6 +194,10 @@ func (ctr *Container) processWithGroup(ap *Argument, proc *process.Process) (boo
 			}
 		}
 	}()
+	if err := ctr.evalArguments(bat, ap, proc); err != nil {
+		return false, err
+	}
+	defer ctr.cleanArguments(proc)
 	if len(ctr.groupVecs) == 0 {
 		ctr.groupVecs = make([]evalVector, len(ap.Exprs))
 	}
@@ -227,15 +236,15 @@ func (ctr *Container) processWithGroup(ap *Argument, proc *process.Process) (boo
 			switch vec.Typ.Oid {
 			case types.T_int8, types.T_uint8:
 				size += 1 + 1
-			case types.T_int16, types.T_uint16:
+			case types.T_int16, types.T_uint16, types.T_enum, types.T_year:
 				size += 2 + 1
 			case types.T_int32, types.T_uint32, types.T_float32, types.T_date:
 				size += 4 + 1
-			case types.T_int64, types.T_uint64, types.T_float64, types.T_datetime, types.T_decimal64:
+			case types.T_int64, types.T_uint64, types.T_float64, types.T_datetime, types.T_time, types.T_decimal64, types.T_set:
 				size += 8 + 1
-			case types.T_decimal128:
+			case types.T_decimal128, types.T_uuid:
 				size += 16 + 1
-			case types.T_char, types.T_varchar:
+			case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 				if width := vec.Typ.Width; width > 0 {
 					size += int(width) + 1
 				} else {
@@ -245,7 +254,7 @@ func (ctr *Container) processWithGroup(ap *Argument, proc *process.Process) (boo
 		}
 		ctr.bat.Rs = make([]ring.Ring, len(ap.Aggs))
 		for i, agg := range ap.Aggs {
-			if ctr.bat.Rs[i], err = aggregate.New(agg.Op, ctr.aggVecs[i].vec.Typ); err != nil {
+			if ctr.bat.Rs[i], err = aggregate.NewWithParam(agg.Op, agg.Param, ctr.aggVecs[i].vec.Typ); err != nil {
 				return false, err
 			}
 		}
@@ -285,6 +294,7 @@ func (ctr *Container) processWithGroup(ap *Argument, proc *process.Process) (boo
 			ctr.strHashMap.Init()
 		}
 	}
+	ctr.setArguments()
 	switch ctr.typ {
 	case H8:
 		err = ctr.processH8(bat, ap, proc)
@@ -305,6 +315,50 @@ func (ctr *Container) processWithGroup(ap *Argument, proc *process.Process) (boo
 	return false, err
 }
 
+// evalArguments evaluates the second arguments of the aggregates with two arguments
+func (ctr *Container) evalArguments(bat *batch.Batch, ap *Argument, proc *process.Process) error {
+	if len(ctr.argVecs) == 0 {
+		ctr.argVecs = make([]evalVector, len(ap.Aggs))
+	}
+	for i, agg := range ap.Aggs {
+		if agg.E2 == nil {
+			continue
+		}
+		vec, err := colexec.EvalExpr(bat, proc, agg.E2)
+		if err != nil {
+			ctr.cleanArguments(proc)
+			return err
+		}
+		ctr.argVecs[i].vec = vec
+		ctr.argVecs[i].needFree = true
+		for j := range bat.Vecs {
+			if bat.Vecs[j] == vec {
+				ctr.argVecs[i].needFree = false
+				break
+			}
+		}
+	}
+	return nil
+}
+
+// setArguments sets the second arguments to the rings of the aggregates with two arguments
+func (ctr *Container) setArguments() {
+	for i, evec := range ctr.argVecs {
+		if evec.vec != nil {
+			ctr.bat.Rs[i].(ring.BinaryRing).SetArgument(evec.vec)
+		}
+	}
+}
+
+func (ctr *Container) cleanArguments(proc *process.Process) {
+	for i := range ctr.argVecs {
+		if ctr.argVecs[i].needFree {
+			vector.Clean(ctr.argVecs[i].vec, proc.Mp)
+		}
+		ctr.argVecs[i] = evalVector{}
+	}
+}
+
 func (ctr *Container) processH0(bat *batch.Batch, ap *Argument, proc *process.Process) error {
 	for _, z := range bat.Zs {
 		ctr.bat.Zs[0] += z
@@ -339,6 +393,8 @@ func (ctr *Container) processH8(bat *batch.Batch, ap *Argument, proc *process.Pr
 				fillGroup[uint64](ctr, vec, ctr.h8.keys, n, 8, i)
 			case -16:
 				fillGroup[types.Decimal128](ctr, vec, ctr.h8.keys, n, 16, i)
+			case 16:
+				fillGroup[types.Uuid](ctr, vec, ctr.h8.keys, n, 16, i)
 			default:
 				fillStringGroup(ctr, vec, ctr.h8.keys, n, 8, i)
 			}
@@ -376,6 +432,8 @@ func (ctr *Container) processH24(bat *batch.Batch, ap *Argument, proc *process.P
 				fillGroup[types.Decimal64](ctr, vec, ctr.h24.keys, n, 8, i)
 			case -16:
 				fillGroup[types.Decimal128](ctr, vec, ctr.h24.keys, n, 16, i)
+			case 16:
+				fillGroup[types.Uuid](ctr, vec, ctr.h24.keys, n, 16, i)
 			default:
 				fillStringGroup(ctr, vec, ctr.h24.keys, n, 24, i)
 			}
@@ -412,6 +470,8 @@ func (ctr *Container) processH32(bat *batch.Batch, ap *Argument, proc *process.P
 				fillGroup[uint64](ctr, vec, ctr.h32.keys, n, 8, i)
 			case -16:
 				fillGroup[types.Decimal128](ctr, vec, ctr.h32.keys, n, 16, i)
+			case 16:
+				fillGroup[types.Uuid](ctr, vec, ctr.h32.keys, n, 16, i)
 			default:
 				fillStringGroup(ctr, vec, ctr.h32.keys, n, 32, i)
 			}
@@ -448,6 +508,8 @@ func (ctr *Container) processH40(bat *batch.Batch, ap *Argument, proc *process.P
 				fillGroup[uint64](ctr, vec, ctr.h40.keys, n, 8, i)
 			case -16:
 				fillGroup[types.Decimal128](ctr, vec, ctr.h40.keys, n, 16, i)
+			case 16:
+				fillGroup[types.Uuid](ctr, vec, ctr.h40.keys, n, 16, i)
 			default:
 				fillStringGroup(ctr, vec, ctr.h40.keys, n, 40, i)
 			}
@@ -482,6 +544,8 @@ func (ctr *Container) processHStr(bat *batch.Batch, ap *Argument, proc *process.
 				fillGroupStr[uint64](ctr, vec, n, 8, i)
 			case -16:
 				fillGroupStr[types.Decimal128](ctr, vec, n, 16, i)
+			case 16:
+				fillGroupStr[types.Uuid](ctr, vec, n, 16, i)
 			default:
 				vs := vec.Col.(*types.Bytes)
 				if !nulls.Any(vec.Nsp) {
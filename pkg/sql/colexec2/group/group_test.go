@@ -90,6 +90,18 @@ func init() {
 			{Oid: types.T_varchar},
 			{Oid: types.T_decimal128},
 		}, []*plan.Expr{newExpression(1), newExpression(2), newExpression(3)}, []aggregate.Aggregate{{Op: 0, E: newExpression(0)}}),
+		newTestCase(mheap.New(gm), []bool{false, true}, []types.Type{
+			{Oid: types.T_int64},
+			{Oid: types.T_int64},
+		}, []*plan.Expr{}, []aggregate.Aggregate{{Op: aggregate.Corr, E: newExpression(0), E2: newExpression(1)}}),
+		newTestCase(mheap.New(gm), []bool{false, true, false}, []types.Type{
+			{Oid: types.T_int64},
+			{Oid: types.T_int64},
+			{Oid: types.T_int64},
+		}, []*plan.Expr{newExpression(2)}, []aggregate.Aggregate{
+			{Op: aggregate.CovarSample, E: newExpression(0), E2: newExpression(1)},
+			{Op: aggregate.Median, E: newExpression(1)},
+		}),
 	}
 }
 
@@ -53,6 +53,7 @@ type Container struct {
 	strHashMap    *hashtable.StringHashMap
 
 	aggVecs   []evalVector
+	argVecs   []evalVector // the second arguments of the aggregates with two arguments
 	groupVecs []evalVector
 
 	h8 struct {
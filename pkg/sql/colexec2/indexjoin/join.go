@@ -0,0 +1,74 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexjoin
+
+import (
+	"bytes"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func String(_ interface{}, buf *bytes.Buffer) {
+	buf.WriteString(" index ⨝ ")
+}
+
+func Prepare(_ *process.Process, _ interface{}) error {
+	return nil
+}
+
+func Call(proc *process.Process, arg interface{}) (bool, error) {
+	ap := arg.(*Argument)
+	bat := proc.Reg.InputBatch
+	if bat == nil {
+		return true, nil
+	}
+	if len(bat.Zs) == 0 {
+		return false, nil
+	}
+	defer bat.Clean(proc.Mp)
+	rows, sels, err := ap.Rel.GetByKeys(bat.Vecs[ap.Pos], ap.Attrs, engine.Snapshot(proc.Snapshot))
+	if err != nil {
+		proc.Reg.InputBatch = nil
+		return true, err
+	}
+	rbat := batch.NewWithSize(len(ap.Result))
+	for i, rp := range ap.Result {
+		if rp.Rel == 0 {
+			rbat.Vecs[i] = vector.New(bat.Vecs[rp.Pos].Typ)
+		} else {
+			rbat.Vecs[i] = vector.New(rows.Vecs[rp.Pos].Typ)
+		}
+	}
+	for i, sel := range sels {
+		for j, rp := range ap.Result {
+			if rp.Rel == 0 {
+				err = vector.UnionOne(rbat.Vecs[j], bat.Vecs[rp.Pos], sel, proc.Mp)
+			} else {
+				err = vector.UnionOne(rbat.Vecs[j], rows.Vecs[rp.Pos], int64(i), proc.Mp)
+			}
+			if err != nil {
+				rbat.Clean(proc.Mp)
+				proc.Reg.InputBatch = nil
+				return true, err
+			}
+		}
+		rbat.Zs = append(rbat.Zs, bat.Zs[sel])
+	}
+	proc.Reg.InputBatch = rbat
+	return false, nil
+}
@@ -0,0 +1,108 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexjoin
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/stretchr/testify/require"
+)
+
+// keyRelation is a relation whose primary key is the only column, holding the even numbers
+type keyRelation struct {
+	engine.Relation
+}
+
+func (r *keyRelation) GetByKeys(vec *vector.Vector, _ []string, _ engine.Snapshot) (*batch.Batch, []int64, error) {
+	bat := batch.NewWithSize(1)
+	bat.Vecs[0] = vector.New(vec.Typ)
+	var vs []int64
+	var sels []int64
+	for i, v := range vec.Col.([]int64) {
+		if v%2 == 0 {
+			vs = append(vs, v)
+			sels = append(sels, int64(i))
+		}
+	}
+	bat.Vecs[0].Col = vs
+	bat.InitZsOne(len(sels))
+	return bat, sels, nil
+}
+
+func TestString(t *testing.T) {
+	buf := new(bytes.Buffer)
+	String(&Argument{}, buf)
+	require.Equal(t, " index ⨝ ", buf.String())
+}
+
+func TestJoin(t *testing.T) {
+	hm := host.New(1 << 30)
+	gm := guest.New(1<<30, hm)
+	proc := process.New(mheap.New(gm))
+	arg := &Argument{
+		Rel:    &keyRelation{},
+		Attrs:  []string{"a"},
+		Pos:    0,
+		Result: []ResultPos{{0, 0}, {1, 0}},
+	}
+	require.NoError(t, Prepare(proc, arg))
+
+	proc.Reg.InputBatch = &batch.Batch{}
+	ok, err := Call(proc, arg)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	proc.Reg.InputBatch = newBatch(t, proc, 10)
+	ok, err = Call(proc, arg)
+	require.NoError(t, err)
+	require.False(t, ok)
+	rbat := proc.Reg.InputBatch
+	require.Equal(t, 5, len(rbat.Zs))
+	require.Equal(t, []int64{0, 2, 4, 6, 8}, rbat.Vecs[0].Col)
+	require.Equal(t, []int64{0, 2, 4, 6, 8}, rbat.Vecs[1].Col)
+	rbat.Clean(proc.Mp)
+
+	proc.Reg.InputBatch = nil
+	ok, err = Call(proc, arg)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, int64(0), mheap.Size(proc.Mp))
+}
+
+func newBatch(t *testing.T, proc *process.Process, rows int64) *batch.Batch {
+	bat := batch.NewWithSize(1)
+	bat.InitZsOne(int(rows))
+	vec := vector.New(types.Type{Oid: types.T_int64})
+	data, err := mheap.Alloc(proc.Mp, rows*8)
+	require.NoError(t, err)
+	vec.Data = data
+	vs := encoding.DecodeInt64Slice(vec.Data)[:rows]
+	for i := range vs {
+		vs[i] = int64(i)
+	}
+	vec.Col = vs
+	bat.Vecs[0] = vec
+	return bat
+}
@@ -0,0 +1,33 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexjoin
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+)
+
+type ResultPos struct {
+	Rel int32
+	Pos int32
+}
+
+// Argument of the index nested-loop join, the rows of Rel are looked up
+// by the key of every row of the left side instead of being scanned.
+type Argument struct {
+	Rel    engine.KeyRelation
+	Attrs  []string // the columns read from Rel, the Pos of the right results refers to them
+	Pos    int32    // pos of the key of the left rows, matched with the primary key of Rel
+	Result []ResultPos
+}
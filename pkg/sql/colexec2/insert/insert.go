@@ -0,0 +1,262 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package insert
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	colexec "github.com/matrixorigin/matrixone/pkg/sql/colexec2"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// rowRef refers to a value of the collected rows
+type rowRef struct {
+	vec int
+	row int64
+}
+
+func String(arg interface{}, buf *bytes.Buffer) {
+	ap := arg.(*Argument)
+	if ap.OnDuplicate {
+		buf.WriteString(fmt.Sprintf("insert(%v) on duplicate key update", ap.Attrs))
+		return
+	}
+	buf.WriteString(fmt.Sprintf("insert(%v)", ap.Attrs))
+}
+
+func Prepare(_ *process.Process, arg interface{}) error {
+	ap := arg.(*Argument)
+	ap.ctr = new(container)
+	if ap.OnDuplicate {
+		typs := make([]types.Type, 0, 3*len(ap.Types))
+		for i := 0; i < 3; i++ {
+			typs = append(typs, ap.Types...)
+		}
+		ap.ctr.bat = colexec.NewWriteBatch(nil, typs)
+	} else if ap.Buffered {
+		ap.ctr.bat = colexec.NewWriteBatch(ap.Attrs, ap.Types)
+	}
+	return nil
+}
+
+// Call writes the rows of each input batch into the table. If the input reads the table,
+// all the rows are collected and written at the end, so the rows written by the statement
+// are never read by itself.
+func Call(proc *process.Process, arg interface{}) (bool, error) {
+	ap := arg.(*Argument)
+	bat := proc.Reg.InputBatch
+	if bat == nil {
+		if ap.ctr.bat == nil {
+			return true, nil
+		}
+		defer ap.ctr.bat.Clean(proc.Mp)
+		if ap.OnDuplicate {
+			return true, ap.ctr.writeOnDuplicate(ap, proc)
+		}
+		return true, ap.ctr.write(ap, ap.ctr.bat, proc)
+	}
+	if len(bat.Zs) == 0 {
+		return false, nil
+	}
+	defer func() {
+		bat.Clean(proc.Mp)
+		proc.Reg.InputBatch = nil
+	}()
+	if ap.ctr.bat != nil {
+		return false, colexec.AppendBatch(ap.ctr.bat, bat, proc)
+	}
+	wbat := colexec.NewWriteBatch(ap.Attrs, ap.Types)
+	defer wbat.Clean(proc.Mp)
+	if err := colexec.AppendBatch(wbat, bat, proc); err != nil {
+		return false, err
+	}
+	return false, ap.ctr.write(ap, wbat, proc)
+}
+
+func (ctr *container) write(ap *Argument, bat *batch.Batch, proc *process.Process) error {
+	if len(bat.Zs) == 0 {
+		return nil
+	}
+	snap := engine.Snapshot(proc.Snapshot)
+	id, err := colexec.FillAutoIncrement(ap.TargetTable, bat, snap)
+	if err != nil {
+		return err
+	}
+	if err := colexec.CheckRows(bat, ap.Checks, proc); err != nil {
+		return err
+	}
+	if err := ap.TargetTable.Write(ap.Ts, bat, snap); err != nil {
+		return err
+	}
+	ap.AffectedRows += uint64(len(bat.Zs))
+	if ap.LastInsertId == 0 {
+		ap.LastInsertId = id
+	}
+	return nil
+}
+
+// writeOnDuplicate inserts the rows without an existing row and updates the others,
+// a row conflicting with a row inserted before by the statement updates the assigned
+// columns of that row.
+func (ctr *container) writeOnDuplicate(ap *Argument, proc *process.Process) error {
+	var inserts, updates [][]rowRef
+	var oldRows []int64
+
+	n := len(ap.Types)
+	snap := engine.Snapshot(proc.Snapshot)
+	// the auto increment values are generated before the rows are keyed,
+	// so the rows inserted without a value do not conflict with each other
+	id, err := colexec.FillAutoIncrement(ap.TargetTable, &batch.Batch{Attrs: ap.Attrs, Vecs: ctr.bat.Vecs[:n], Zs: ctr.bat.Zs}, snap)
+	if err != nil {
+		return err
+	}
+	if ap.LastInsertId == 0 {
+		ap.LastInsertId = id
+	}
+
+	pk := ap.PrimaryKey
+	oldPks := ctr.bat.Vecs[2*n+pk]
+	inserted := make(map[string]int)
+	updated := make(map[string]int)
+	for i := range ctr.bat.Zs {
+		row := int64(i)
+		if !nulls.Contains(oldPks.Nsp, uint64(i)) {
+			key := colexec.ValueKey(oldPks, row)
+			j, ok := updated[key]
+			if !ok {
+				j = len(updates)
+				updated[key] = j
+				updates = append(updates, newRow(n, 2*n, row))
+				oldRows = append(oldRows, row)
+			}
+			if ctr.changed(ap, updates[j], row) {
+				ap.AffectedRows += 2
+			}
+			updates[j] = newRow(n, n, row)
+			continue
+		}
+		key := colexec.ValueKey(ctr.bat.Vecs[pk], row)
+		if j, ok := inserted[key]; ok {
+			if ctr.changed(ap, inserts[j], row) {
+				ap.AffectedRows += 2
+			}
+			for k := range inserts[j] {
+				if ap.Assigned[k] {
+					inserts[j][k] = rowRef{vec: n + k, row: row}
+				}
+			}
+			if ap.Assigned[pk] {
+				delete(inserted, key)
+				inserted[colexec.ValueKey(ctr.bat.Vecs[n+pk], row)] = j
+			}
+			continue
+		}
+		inserted[key] = len(inserts)
+		inserts = append(inserts, newRow(n, 0, row))
+		ap.AffectedRows++
+	}
+
+	if len(updates) > 0 {
+		bat, err := ctr.buildBatch(ap, updates, proc)
+		if err != nil {
+			return err
+		}
+		defer bat.Clean(proc.Mp)
+		if err := colexec.CheckRows(bat, ap.Checks, proc); err != nil {
+			return err
+		}
+		if ap.Assigned[pk] {
+			// the primary key is changed, so the old rows are deleted and the new rows are written
+			vec, err := colexec.CopyRows(oldPks, oldRows, proc)
+			if err != nil {
+				return err
+			}
+			err = ap.TargetTable.Delete(ap.Ts, vec, ap.Attrs[pk], snap)
+			vector.Clean(vec, proc.Mp)
+			if err != nil {
+				return err
+			}
+			if err := ap.TargetTable.Write(ap.Ts, bat, snap); err != nil {
+				return err
+			}
+		} else if err := ap.TargetTable.Update(ap.Ts, bat, snap); err != nil {
+			return err
+		}
+	}
+	if len(inserts) > 0 {
+		bat, err := ctr.buildBatch(ap, inserts, proc)
+		if err != nil {
+			return err
+		}
+		defer bat.Clean(proc.Mp)
+		if err := colexec.CheckRows(bat, ap.Checks, proc); err != nil {
+			return err
+		}
+		if err := ap.TargetTable.Write(ap.Ts, bat, snap); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// changed returns true if the values assigned by the updated columns of the row
+// differ from the values of the current row refs
+func (ctr *container) changed(ap *Argument, refs []rowRef, row int64) bool {
+	n := len(ap.Types)
+	for k, ref := range refs {
+		if !ap.Assigned[k] {
+			continue
+		}
+		cur, upd := ctr.bat.Vecs[ref.vec], ctr.bat.Vecs[n+k]
+		curNull, updNull := nulls.Contains(cur.Nsp, uint64(ref.row)), nulls.Contains(upd.Nsp, uint64(row))
+		if curNull != updNull {
+			return true
+		}
+		if !curNull && colexec.ValueKey(cur, ref.row) != colexec.ValueKey(upd, row) {
+			return true
+		}
+	}
+	return false
+}
+
+// buildBatch returns a batch of the table's columns holding the rows
+func (ctr *container) buildBatch(ap *Argument, rows [][]rowRef, proc *process.Process) (*batch.Batch, error) {
+	bat := colexec.NewWriteBatch(ap.Attrs, ap.Types)
+	for _, refs := range rows {
+		for k, ref := range refs {
+			if err := vector.UnionOne(bat.Vecs[k], ctr.bat.Vecs[ref.vec], ref.row, proc.Mp); err != nil {
+				bat.Clean(proc.Mp)
+				return nil, err
+			}
+		}
+		bat.Zs = append(bat.Zs, 1)
+	}
+	return bat, nil
+}
+
+// newRow refers to the n columns start from vec of a row
+func newRow(n int, vec int, row int64) []rowRef {
+	refs := make([]rowRef, n)
+	for k := range refs {
+		refs[k] = rowRef{vec: vec + k, row: row}
+	}
+	return refs
+}
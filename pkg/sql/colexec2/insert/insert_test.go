@@ -0,0 +1,347 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package insert
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	colexec "github.com/matrixorigin/matrixone/pkg/sql/colexec2"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan2/function"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/stretchr/testify/require"
+)
+
+// testRelation records the vectors written into it
+type testRelation struct {
+	engine.Relation
+	written [][]*vector.Vector
+	updated [][]*vector.Vector
+	deleted []*vector.Vector
+	defs    []engine.TableDef
+	// next is the next value of the auto increment column
+	next uint64
+}
+
+func (r *testRelation) TableDefs(_ engine.Snapshot) []engine.TableDef {
+	return r.defs
+}
+
+func (r *testRelation) AllocAutoIncrement(n, max uint64, _ engine.Snapshot) (uint64, error) {
+	if r.next <= max {
+		r.next = max + 1
+	}
+	first := r.next
+	r.next += n
+	return first, nil
+}
+
+func (r *testRelation) Write(_ uint64, bat *batch.Batch, _ engine.Snapshot) error {
+	r.written = append(r.written, append([]*vector.Vector{}, bat.Vecs...))
+	return nil
+}
+
+func (r *testRelation) Update(_ uint64, bat *batch.Batch, _ engine.Snapshot) error {
+	r.updated = append(r.updated, append([]*vector.Vector{}, bat.Vecs...))
+	return nil
+}
+
+func (r *testRelation) Delete(_ uint64, vec *vector.Vector, _ string, _ engine.Snapshot) error {
+	r.deleted = append(r.deleted, vec)
+	return nil
+}
+
+func newProcess() *process.Process {
+	hm := host.New(1 << 30)
+	gm := guest.New(1<<30, hm)
+	return process.New(mheap.New(gm))
+}
+
+func TestString(t *testing.T) {
+	buf := new(bytes.Buffer)
+	String(&Argument{Attrs: []string{"a", "b"}}, buf)
+	String(&Argument{Attrs: []string{"a", "b"}, OnDuplicate: true}, buf)
+	require.Equal(t, "insert([a b])insert([a b]) on duplicate key update", buf.String())
+}
+
+func TestInsert(t *testing.T) {
+	proc := newProcess()
+	rel := &testRelation{}
+	arg := &Argument{
+		TargetTable: rel,
+		Attrs:       []string{"a", "b"},
+		Types:       []types.Type{{Oid: types.T_int32}, {Oid: types.T_varchar}},
+	}
+	require.NoError(t, Prepare(proc, arg))
+
+	// the values are converted to the types of the table, the constants are expanded
+	for i := 0; i < 2; i++ {
+		bat := batch.NewWithSize(2)
+		bat.Vecs[0] = vector.New(types.Type{Oid: types.T_int64})
+		require.NoError(t, vector.Append(bat.Vecs[0], []int64{int64(2 * i), int64(2*i + 1)}))
+		bat.Vecs[1] = vector.NewConst(types.Type{Oid: types.T_varchar})
+		nulls.Add(bat.Vecs[1].Nsp, 0)
+		bat.InitZsOne(2)
+		proc.Reg.InputBatch = bat
+		end, err := Call(proc, arg)
+		require.NoError(t, err)
+		require.False(t, end)
+	}
+	proc.Reg.InputBatch = nil
+	end, err := Call(proc, arg)
+	require.NoError(t, err)
+	require.True(t, end)
+
+	// the rows are written batch by batch
+	require.Equal(t, uint64(4), arg.AffectedRows)
+	require.Equal(t, 2, len(rel.written))
+	require.Equal(t, []int32{0, 1}, rel.written[0][0].Col)
+	require.Equal(t, []int32{2, 3}, rel.written[1][0].Col)
+	require.Equal(t, 2, nulls.Length(rel.written[1][1].Nsp))
+
+	// the rows are written at the end if the input reads the table
+	rel.written = nil
+	arg.Buffered, arg.AffectedRows = true, 0
+	require.NoError(t, Prepare(proc, arg))
+	for i := 0; i < 2; i++ {
+		bat := batch.NewWithSize(2)
+		bat.Vecs[0] = vector.New(types.Type{Oid: types.T_int64})
+		require.NoError(t, vector.Append(bat.Vecs[0], []int64{int64(2 * i), int64(2*i + 1)}))
+		bat.Vecs[1] = vector.NewConst(types.Type{Oid: types.T_varchar})
+		nulls.Add(bat.Vecs[1].Nsp, 0)
+		bat.InitZsOne(2)
+		proc.Reg.InputBatch = bat
+		_, err := Call(proc, arg)
+		require.NoError(t, err)
+		require.Equal(t, 0, len(rel.written))
+	}
+	proc.Reg.InputBatch = nil
+	_, err = Call(proc, arg)
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), arg.AffectedRows)
+	require.Equal(t, 1, len(rel.written))
+	require.Equal(t, []int32{0, 1, 2, 3}, rel.written[0][0].Col)
+	require.Equal(t, 4, vector.Length(rel.written[0][1]))
+	require.Equal(t, 4, nulls.Length(rel.written[0][1].Nsp))
+}
+
+func TestInsertOnDuplicate(t *testing.T) {
+	proc := newProcess()
+	rel := &testRelation{}
+	arg := &Argument{
+		TargetTable: rel,
+		Attrs:       []string{"a", "b"},
+		Types:       []types.Type{{Oid: types.T_int64}, {Oid: types.T_int64}},
+		PrimaryKey:  0,
+		OnDuplicate: true,
+		Assigned:    []bool{false, true},
+	}
+	require.NoError(t, Prepare(proc, arg))
+
+	// rows are (new a, new b, updated a, updated b, old a, old b):
+	// 1 is inserted, 2 is updated, 3 is inserted and then updated by the next row,
+	// 4 is updated to its own values which is not counted as affected
+	bat := batch.NewWithSize(6)
+	cols := [][]int64{
+		{1, 2, 3, 3, 4},
+		{10, 20, 30, 31, 40},
+		{0, 2, 0, 0, 4},
+		{0, 21, 0, 32, 7},
+		{0, 2, 0, 0, 4},
+		{0, 5, 0, 0, 7},
+	}
+	for i, col := range cols {
+		bat.Vecs[i] = vector.New(types.Type{Oid: types.T_int64})
+		require.NoError(t, vector.Append(bat.Vecs[i], col))
+	}
+	for _, row := range []uint64{0, 2, 3} {
+		nulls.Add(bat.Vecs[4].Nsp, row)
+		nulls.Add(bat.Vecs[5].Nsp, row)
+	}
+	bat.InitZsOne(5)
+	proc.Reg.InputBatch = bat
+	_, err := Call(proc, arg)
+	require.NoError(t, err)
+	proc.Reg.InputBatch = nil
+	end, err := Call(proc, arg)
+	require.NoError(t, err)
+	require.True(t, end)
+
+	require.Equal(t, uint64(6), arg.AffectedRows)
+	require.Equal(t, 1, len(rel.updated))
+	require.Equal(t, []int64{2, 4}, rel.updated[0][0].Col)
+	require.Equal(t, []int64{21, 7}, rel.updated[0][1].Col)
+	require.Equal(t, 1, len(rel.written))
+	require.Equal(t, []int64{1, 3}, rel.written[0][0].Col)
+	require.Equal(t, []int64{10, 32}, rel.written[0][1].Col)
+	require.Equal(t, 0, len(rel.deleted))
+}
+
+func TestInsertAutoIncrement(t *testing.T) {
+	proc := newProcess()
+	rel := &testRelation{
+		defs: []engine.TableDef{
+			&engine.AttributeDef{Attr: engine.Attribute{Name: "a", Type: types.Type{Oid: types.T_int32}, AutoIncrement: true}},
+			&engine.AttributeDef{Attr: engine.Attribute{Name: "b", Type: types.Type{Oid: types.T_int64}}},
+		},
+		next: 1,
+	}
+	arg := &Argument{
+		TargetTable: rel,
+		Attrs:       []string{"a", "b"},
+		Types:       []types.Type{{Oid: types.T_int32}, {Oid: types.T_int64}},
+	}
+	require.NoError(t, Prepare(proc, arg))
+
+	// the null and zero values are generated after the largest value written explicitly
+	bat := batch.NewWithSize(2)
+	bat.Vecs[0] = vector.New(types.Type{Oid: types.T_int32})
+	require.NoError(t, vector.Append(bat.Vecs[0], []int32{0, 5, 0, 0}))
+	nulls.Add(bat.Vecs[0].Nsp, 2)
+	bat.Vecs[1] = vector.New(types.Type{Oid: types.T_int64})
+	require.NoError(t, vector.Append(bat.Vecs[1], []int64{1, 2, 3, 4}))
+	bat.InitZsOne(4)
+	proc.Reg.InputBatch = bat
+	_, err := Call(proc, arg)
+	require.NoError(t, err)
+	proc.Reg.InputBatch = nil
+	end, err := Call(proc, arg)
+	require.NoError(t, err)
+	require.True(t, end)
+
+	require.Equal(t, uint64(6), arg.LastInsertId)
+	require.Equal(t, []int32{6, 5, 7, 8}, rel.written[0][0].Col)
+	require.Equal(t, 0, nulls.Length(rel.written[0][0].Nsp))
+}
+
+func TestInsertOnDuplicateAutoIncrement(t *testing.T) {
+	proc := newProcess()
+	rel := &testRelation{
+		defs: []engine.TableDef{
+			&engine.AttributeDef{Attr: engine.Attribute{Name: "a", Type: types.Type{Oid: types.T_int64}, AutoIncrement: true}},
+			&engine.AttributeDef{Attr: engine.Attribute{Name: "b", Type: types.Type{Oid: types.T_int64}}},
+		},
+		next: 1,
+	}
+	arg := &Argument{
+		TargetTable: rel,
+		Attrs:       []string{"a", "b"},
+		Types:       []types.Type{{Oid: types.T_int64}, {Oid: types.T_int64}},
+		PrimaryKey:  0,
+		OnDuplicate: true,
+		Assigned:    []bool{false, true},
+	}
+	require.NoError(t, Prepare(proc, arg))
+
+	// rows are (new a, new b, updated a, updated b, old a, old b), the rows
+	// without a value of a are all inserted with generated values, and 2 is updated
+	bat := batch.NewWithSize(6)
+	cols := [][]int64{
+		{0, 0, 2, 0},
+		{10, 20, 30, 40},
+		{0, 0, 2, 0},
+		{0, 0, 31, 0},
+		{0, 0, 2, 0},
+		{0, 0, 5, 0},
+	}
+	for i, col := range cols {
+		bat.Vecs[i] = vector.New(types.Type{Oid: types.T_int64})
+		require.NoError(t, vector.Append(bat.Vecs[i], col))
+	}
+	nulls.Add(bat.Vecs[0].Nsp, 1)
+	for _, row := range []uint64{0, 1, 3} {
+		nulls.Add(bat.Vecs[4].Nsp, row)
+		nulls.Add(bat.Vecs[5].Nsp, row)
+	}
+	bat.InitZsOne(4)
+	proc.Reg.InputBatch = bat
+	_, err := Call(proc, arg)
+	require.NoError(t, err)
+	proc.Reg.InputBatch = nil
+	end, err := Call(proc, arg)
+	require.NoError(t, err)
+	require.True(t, end)
+
+	require.Equal(t, uint64(5), arg.AffectedRows)
+	require.Equal(t, uint64(3), arg.LastInsertId)
+	require.Equal(t, 1, len(rel.updated))
+	require.Equal(t, []int64{2}, rel.updated[0][0].Col)
+	require.Equal(t, []int64{31}, rel.updated[0][1].Col)
+	require.Equal(t, 1, len(rel.written))
+	require.Equal(t, []int64{3, 4, 5}, rel.written[0][0].Col)
+	require.Equal(t, []int64{10, 20, 40}, rel.written[0][1].Col)
+	require.Equal(t, 0, nulls.Length(rel.written[0][0].Nsp))
+}
+
+func TestInsertCheck(t *testing.T) {
+	proc := newProcess()
+	rel := &testRelation{}
+	_, id, _, err := function.GetFunctionByName(">", []types.T{types.T_int64, types.T_int64})
+	require.NoError(t, err)
+	arg := &Argument{
+		TargetTable: rel,
+		Attrs:       []string{"a", "b"},
+		Types:       []types.Type{{Oid: types.T_int64}, {Oid: types.T_int64}},
+		Checks: []*colexec.Check{{
+			Name: "b_positive",
+			Expr: &plan.Expr{
+				Typ: &plan.Type{Id: plan.Type_BOOL},
+				Expr: &plan.Expr_F{F: &plan.Function{
+					Func: &plan.ObjectRef{Obj: id},
+					Args: []*plan.Expr{
+						{Typ: &plan.Type{Id: plan.Type_INT64}, Expr: &plan.Expr_Col{Col: &plan.ColRef{ColPos: 1}}},
+						{Typ: &plan.Type{Id: plan.Type_INT64}, Expr: &plan.Expr_C{C: &plan.Const{Value: &plan.Const_Ival{Ival: 0}}}},
+					},
+				}},
+			},
+		}},
+	}
+	require.NoError(t, Prepare(proc, arg))
+
+	// the rows making the expression null pass the check, the rows are checked when written
+	newBatch := func(bs []int64, null uint64) *batch.Batch {
+		bat := batch.NewWithSize(2)
+		bat.Vecs[0] = vector.New(types.Type{Oid: types.T_int64})
+		require.NoError(t, vector.Append(bat.Vecs[0], []int64{1, 2, 3}))
+		bat.Vecs[1] = vector.New(types.Type{Oid: types.T_int64})
+		require.NoError(t, vector.Append(bat.Vecs[1], bs))
+		nulls.Add(bat.Vecs[1].Nsp, null)
+		bat.InitZsOne(len(bs))
+		return bat
+	}
+	proc.Reg.InputBatch = newBatch([]int64{1, 0, 2}, 1)
+	_, err = Call(proc, arg)
+	require.NoError(t, err)
+	proc.Reg.InputBatch = nil
+	_, err = Call(proc, arg)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(rel.written))
+
+	rel.written = nil
+	require.NoError(t, Prepare(proc, arg))
+	proc.Reg.InputBatch = newBatch([]int64{1, 0, 2}, 0)
+	_, err = Call(proc, arg)
+	require.Error(t, err)
+	require.Equal(t, 0, len(rel.written))
+}
@@ -0,0 +1,53 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package insert
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	colexec "github.com/matrixorigin/matrixone/pkg/sql/colexec2"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+)
+
+type container struct {
+	bat *batch.Batch // rows collected from the input, nil if the rows are not buffered
+}
+
+// Argument of insert, the input is the rows to insert in the order of the table's columns.
+// For INSERT ... ON DUPLICATE KEY UPDATE, the input is the rows to insert, followed by the
+// rows to update and the existing rows whose columns are null if there is no one.
+type Argument struct {
+	Ts          uint64
+	TargetTable engine.Relation
+	// Attrs and Types are the columns of the table
+	Attrs []string
+	Types []types.Type
+	// PrimaryKey is the position of the primary key in the table's columns
+	PrimaryKey int
+	// Buffered is true if the input reads the table, the rows are written
+	// after all of them are read. It is implied by OnDuplicate.
+	Buffered bool
+	// OnDuplicate is true for INSERT ... ON DUPLICATE KEY UPDATE
+	OnDuplicate bool
+	// Assigned are the columns assigned by ON DUPLICATE KEY UPDATE
+	Assigned []bool
+	// Checks are the CHECK constraints of the table, checked on the rows written
+	Checks []*colexec.Check
+	// AffectedRows is the number of rows inserted plus twice the number of rows changed by updates
+	AffectedRows uint64
+	// LastInsertId is the first value generated for the auto increment column, 0 if there is none
+	LastInsertId uint64
+	ctr          *container
+}
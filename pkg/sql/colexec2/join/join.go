@@ -23,6 +23,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
 
@@ -83,6 +84,14 @@ func Prepare(proc *process.Process, arg interface{}) error {
 	}
 	ap.ctr.decimal64Slice = make([]types.Decimal64, UnitLimit)
 	ap.ctr.decimal128Slice = make([]types.Decimal128, UnitLimit)
+	if len(ap.Filters) > 0 && !ap.IsPreBuild {
+		ap.ctr.builders = make([]*engine.RuntimeFilterBuilder, len(ap.Filters))
+		for i, f := range ap.Filters {
+			if f != nil {
+				ap.ctr.builders[i] = f.NewBuilder()
+			}
+		}
+	}
 	return nil
 }
 
@@ -96,6 +105,11 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 				ctr.state = End
 				return true, err
 			}
+			for _, b := range ctr.builders {
+				if b != nil {
+					b.Build()
+				}
+			}
 			ctr.state = Probe
 		case Probe:
 			bat := <-proc.Reg.MergeReceivers[0].Ch
@@ -144,6 +158,7 @@ func (ctr *Container) build(ap *Argument, proc *process.Process) error {
 					ctr.bat.Vecs[i] = vector.New(vec.Typ)
 				}
 			}
+			ctr.addFilterKeys(ap, bat)
 			if ctr.bat, err = ctr.bat.Append(proc.Mp, bat); err != nil {
 				bat.Clean(proc.Mp)
 				ctr.bat.Clean(proc.Mp)
@@ -181,6 +196,8 @@ func (ctr *Container) build(ap *Argument, proc *process.Process) error {
 					} else {
 						fillGroupStr[types.Decimal128](ctr, vec, n, 16, i)
 					}
+				case 16:
+					fillGroupStr[types.Uuid](ctr, vec, n, 16, i)
 				default:
 					vs := vec.Col.(*types.Bytes)
 					if !nulls.Any(vec.Nsp) {
@@ -228,6 +245,7 @@ func (ctr *Container) build(ap *Argument, proc *process.Process) error {
 		if len(bat.Zs) == 0 {
 			continue
 		}
+		ctr.addFilterKeys(ap, bat)
 		if ctr.bat == nil {
 			ctr.bat = batch.NewWithSize(len(bat.Vecs))
 			for _, pos := range ctr.poses {
@@ -264,6 +282,8 @@ func (ctr *Container) build(ap *Argument, proc *process.Process) error {
 					} else {
 						fillGroupStr[types.Decimal128](ctr, vec, n, 16, i)
 					}
+				case 16:
+					fillGroupStr[types.Uuid](ctr, vec, n, 16, i)
 				default:
 					vs := vec.Col.(*types.Bytes)
 					if !nulls.Any(vec.Nsp) {
@@ -320,6 +340,15 @@ func (ctr *Container) build(ap *Argument, proc *process.Process) error {
 	}
 }
 
+// addFilterKeys adds the keys of a batch of the build side to the runtime filters
+func (ctr *Container) addFilterKeys(ap *Argument, bat *batch.Batch) {
+	for i, b := range ctr.builders {
+		if b != nil {
+			b.Add(bat.Vecs[ap.Conditions[1][i].Pos])
+		}
+	}
+}
+
 func (ctr *Container) probe(bat *batch.Batch, ap *Argument, proc *process.Process) error {
 	defer bat.Clean(proc.Mp)
 	rbat := batch.NewWithSize(len(ap.Result))
@@ -360,6 +389,8 @@ func (ctr *Container) probe(bat *batch.Batch, ap *Argument, proc *process.Proces
 				} else {
 					fillGroupStr[types.Decimal128](ctr, vec, n, 16, i)
 				}
+			case 16:
+				fillGroupStr[types.Uuid](ctr, vec, n, 16, i)
 			default:
 				vs := vec.Col.(*types.Bytes)
 				if !nulls.Any(vec.Nsp) {
@@ -25,6 +25,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
 	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
 	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
@@ -200,6 +201,42 @@ func TestJoin(t *testing.T) {
 	}
 }
 
+func TestRuntimeFilter(t *testing.T) {
+	hm := host.New(1 << 30)
+	gm := guest.New(1<<30, hm)
+	typ := types.Type{Oid: types.T_int8}
+	tc := newTestCase(mheap.New(gm), []bool{true}, []types.Type{typ}, []ResultPos{{0, 0}, {1, 0}},
+		[][]Condition{
+			{
+				{0, 0, typ},
+			},
+			{
+				{0, 0, typ},
+			},
+		})
+	f := engine.NewRuntimeFilter("a", typ)
+	tc.arg.Filters = []*engine.RuntimeFilter{f}
+	Prepare(tc.proc, tc.arg)
+	tc.proc.Reg.MergeReceivers[0].Ch <- nil
+	tc.proc.Reg.MergeReceivers[1].Ch <- newBatch(t, tc.flgs, tc.types, tc.proc, Rows)
+	tc.proc.Reg.MergeReceivers[1].Ch <- nil
+	require.False(t, f.Ready())
+	for {
+		if ok, err := Call(tc.proc, tc.arg); ok || err != nil {
+			break
+		}
+		tc.proc.Reg.InputBatch.Clean(tc.proc.Mp)
+	}
+	require.True(t, f.Ready())
+	// the null key of the build side is not a key of the filter
+	vec := vector.New(typ)
+	vec.Col = []int8{0, 5, 9, 10, -1}
+	sels, ok := f.Filter(vec)
+	require.True(t, ok)
+	require.Equal(t, []int64{1, 2}, sels)
+	require.Equal(t, mheap.Size(tc.proc.Mp), int64(0))
+}
+
 func BenchmarkJoin(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		hm := host.New(1 << 30)
@@ -18,6 +18,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/hashtable"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 )
 
 const (
@@ -51,6 +52,9 @@ type Container struct {
 
 	bat *batch.Batch
 
+	// builders collect the keys of the runtime filters
+	builders []*engine.RuntimeFilterBuilder
+
 	decimal64Slice  []types.Decimal64
 	decimal128Slice []types.Decimal128
 }
@@ -71,4 +75,7 @@ type Argument struct {
 	IsPreBuild bool // hashtable is pre-build
 	Result     []ResultPos
 	Conditions [][]Condition
+	// Filters are the runtime filters of the scan of the left side, Filters[i] is
+	// built from the keys of Conditions[1][i] if it is not nil.
+	Filters []*engine.RuntimeFilter
 }
@@ -181,6 +181,8 @@ func (ctr *Container) build(ap *Argument, proc *process.Process) error {
 					} else {
 						fillGroupStr[types.Decimal128](ctr, vec, n, 16, i)
 					}
+				case 16:
+					fillGroupStr[types.Uuid](ctr, vec, n, 16, i)
 				default:
 					vs := vec.Col.(*types.Bytes)
 					if !nulls.Any(vec.Nsp) {
@@ -264,6 +266,8 @@ func (ctr *Container) build(ap *Argument, proc *process.Process) error {
 					} else {
 						fillGroupStr[types.Decimal128](ctr, vec, n, 16, i)
 					}
+				case 16:
+					fillGroupStr[types.Uuid](ctr, vec, n, 16, i)
 				default:
 					vs := vec.Col.(*types.Bytes)
 					if !nulls.Any(vec.Nsp) {
@@ -360,6 +364,8 @@ func (ctr *Container) probe(bat *batch.Batch, ap *Argument, proc *process.Proces
 				} else {
 					fillGroupStr[types.Decimal128](ctr, vec, n, 16, i)
 				}
+			case 16:
+				fillGroupStr[types.Uuid](ctr, vec, n, 16, i)
 			default:
 				vs := vec.Col.(*types.Bytes)
 				if !nulls.Any(vec.Nsp) {
@@ -0,0 +1,273 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mark
+
+import (
+	"bytes"
+	"unsafe"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/hashtable"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func init() {
+	OneInt64s = make([]int64, UnitLimit)
+	for i := range OneInt64s {
+		OneInt64s[i] = 1
+	}
+}
+
+func String(_ interface{}, buf *bytes.Buffer) {
+	buf.WriteString(" mark ⋉ ")
+}
+
+func Prepare(proc *process.Process, arg interface{}) error {
+	ap := arg.(*Argument)
+	ap.ctr = new(Container)
+	ap.ctr.keys = make([][]byte, UnitLimit)
+	ap.ctr.values = make([]uint64, UnitLimit)
+	ap.ctr.zValues = make([]int64, UnitLimit)
+	ap.ctr.strHashStates = make([][3]uint64, UnitLimit)
+	ap.ctr.strHashMap = &hashtable.StringHashMap{}
+	ap.ctr.strHashMap.Init()
+	for i, cond := range ap.Conditions[0] { // aligning the precision of decimal
+		switch cond.Typ.Oid {
+		case types.T_decimal64, types.T_decimal128:
+			typ := ap.Conditions[1][i].Typ
+			if typ.Scale > cond.Typ.Scale {
+				ap.Conditions[0][i].Scale = typ.Scale - cond.Typ.Scale
+			} else if typ.Scale < cond.Typ.Scale {
+				ap.Conditions[1][i].Scale = cond.Typ.Scale - typ.Scale
+			}
+		}
+	}
+	ap.ctr.decimal64Slice = make([]types.Decimal64, UnitLimit)
+	ap.ctr.decimal128Slice = make([]types.Decimal128, UnitLimit)
+	return nil
+}
+
+func Call(proc *process.Process, arg interface{}) (bool, error) {
+	ap := arg.(*Argument)
+	ctr := ap.ctr
+	for {
+		switch ctr.state {
+		case Build:
+			if err := ctr.build(ap, proc); err != nil {
+				ctr.state = End
+				return true, err
+			}
+			ctr.state = Probe
+		case Probe:
+			bat := <-proc.Reg.MergeReceivers[0].Ch
+			if bat == nil {
+				ctr.state = End
+				continue
+			}
+			if len(bat.Zs) == 0 {
+				continue
+			}
+			if err := ctr.probe(bat, ap, proc); err != nil {
+				ctr.state = End
+				proc.Reg.InputBatch = nil
+				return true, err
+			}
+			return false, nil
+		default:
+			proc.Reg.InputBatch = nil
+			return true, nil
+		}
+	}
+}
+
+// build inserts the keys of the right side into the hash table, and remembers
+// if the right side has rows and if any of its keys is null.
+func (ctr *Container) build(ap *Argument, proc *process.Process) error {
+	for {
+		bat := <-proc.Reg.MergeReceivers[1].Ch
+		if bat == nil {
+			return nil
+		}
+		count := len(bat.Zs)
+		ctr.rows += int64(count)
+		for i := 0; i < count; i += UnitLimit {
+			n := count - i
+			if n > UnitLimit {
+				n = UnitLimit
+			}
+			ctr.fillKeys(bat, ap.Conditions[1], i, n)
+			for k := 0; k < n; k++ {
+				if ctr.zValues[k] == 0 {
+					ctr.hasNull = true
+				}
+			}
+			ctr.strHashMap.InsertStringBatchWithRing(ctr.zValues, ctr.strHashStates, ctr.keys[:n], ctr.values)
+		}
+		bat.Clean(proc.Mp)
+	}
+}
+
+func (ctr *Container) probe(bat *batch.Batch, ap *Argument, proc *process.Process) error {
+	defer bat.Clean(proc.Mp)
+	rbat := batch.NewWithSize(len(ap.Result) + 1)
+	for i, pos := range ap.Result { // every row of the left side is returned
+		vec, err := vector.Dup(bat.Vecs[pos], proc.Mp)
+		if err != nil {
+			rbat.Clean(proc.Mp)
+			return err
+		}
+		rbat.Vecs[i] = vec
+	}
+	count := len(bat.Zs)
+	rbat.Zs = append(rbat.Zs, bat.Zs...)
+	marks := make([]bool, count)
+	mvec := vector.New(types.Type{Oid: types.T_bool})
+	rbat.Vecs[len(ap.Result)] = mvec
+	if ctr.rows == 0 { // x IN (empty set) is false even if x is null
+		mvec.Col = marks
+		proc.Reg.InputBatch = rbat
+		return nil
+	}
+	for i := 0; i < count; i += UnitLimit {
+		n := count - i
+		if n > UnitLimit {
+			n = UnitLimit
+		}
+		ctr.fillKeys(bat, ap.Conditions[0], i, n)
+		ctr.strHashMap.FindStringBatch(ctr.strHashStates, ctr.keys[:n], ctr.values)
+		for k := 0; k < n; k++ {
+			switch {
+			case ctr.zValues[k] == 0:
+				nulls.Add(mvec.Nsp, uint64(i+k))
+			case ctr.values[k] != 0:
+				marks[i+k] = true
+			case ctr.hasNull:
+				nulls.Add(mvec.Nsp, uint64(i+k))
+			}
+		}
+	}
+	mvec.Col = marks
+	proc.Reg.InputBatch = rbat
+	return nil
+}
+
+// fillKeys encodes the keys of the rows [start, start+n) of bat, zValues[k] is set to 0
+// if the keys of the k-th row have null.
+func (ctr *Container) fillKeys(bat *batch.Batch, conds []Condition, start, n int) {
+	copy(ctr.zValues[:n], OneInt64s[:n])
+	for k := 0; k < n; k++ {
+		ctr.keys[k] = ctr.keys[k][:0]
+	}
+	for _, cond := range conds {
+		vec := bat.Vecs[cond.Pos]
+		switch typLen := vec.Typ.Oid.FixedLength(); typLen {
+		case 1:
+			fillGroupStr[uint8](ctr, vec, n, 1, start)
+		case 2:
+			fillGroupStr[uint16](ctr, vec, n, 2, start)
+		case 4:
+			fillGroupStr[uint32](ctr, vec, n, 4, start)
+		case 8:
+			fillGroupStr[uint64](ctr, vec, n, 8, start)
+		case -8:
+			if cond.Scale > 0 {
+				fillGroupStrWithDecimal64(ctr, vec, n, start, cond.Scale)
+			} else {
+				fillGroupStr[uint64](ctr, vec, n, 8, start)
+			}
+		case -16:
+			if cond.Scale > 0 {
+				fillGroupStrWithDecimal128(ctr, vec, n, start, cond.Scale)
+			} else {
+				fillGroupStr[types.Decimal128](ctr, vec, n, 16, start)
+			}
+		case 16:
+			fillGroupStr[types.Uuid](ctr, vec, n, 16, start)
+		default:
+			vs := vec.Col.(*types.Bytes)
+			for k := 0; k < n; k++ {
+				if nulls.Contains(vec.Nsp, uint64(start+k)) {
+					ctr.zValues[k] = 0
+				} else {
+					ctr.keys[k] = append(ctr.keys[k], vs.Get(int64(start+k))...)
+				}
+			}
+		}
+	}
+	for k := 0; k < n; k++ {
+		if l := len(ctr.keys[k]); l < 16 {
+			ctr.keys[k] = append(ctr.keys[k], hashtable.StrKeyPadding[l:]...)
+		}
+	}
+}
+func fillGroupStr[T any](ctr *Container, vec *vector.Vector, n int, sz int, start int) {
+	vs := vector.DecodeFixedCol[T](vec, sz)
+	data := unsafe.Slice((*byte)(unsafe.Pointer(&vs[0])), cap(vs)*sz)[:len(vs)*sz]
+	if !nulls.Any(vec.Nsp) {
+		for i := 0; i < n; i++ {
+			ctr.keys[i] = append(ctr.keys[i], data[(i+start)*sz:(i+start+1)*sz]...)
+		}
+	} else {
+		for i := 0; i < n; i++ {
+			if vec.Nsp.Np.Contains(uint64(i + start)) {
+				ctr.zValues[i] = 0
+			} else {
+				ctr.keys[i] = append(ctr.keys[i], data[(i+start)*sz:(i+start+1)*sz]...)
+			}
+		}
+	}
+}
+
+func fillGroupStrWithDecimal64(ctr *Container, vec *vector.Vector, n int, start int, scale int32) {
+	src := vector.DecodeFixedCol[types.Decimal64](vec, 8)
+	vs := types.AlignDecimal64UsingScaleDiffBatch(src[start:start+n], ctr.decimal64Slice[:n], scale)
+	data := unsafe.Slice((*byte)(unsafe.Pointer(&vs[0])), cap(vs)*8)[:len(vs)*8]
+	if !nulls.Any(vec.Nsp) {
+		for i := 0; i < n; i++ {
+			ctr.keys[i] = append(ctr.keys[i], data[(i)*8:(i+1)*8]...)
+		}
+	} else {
+		for i := 0; i < n; i++ {
+			if vec.Nsp.Np.Contains(uint64(i + start)) {
+				ctr.zValues[i] = 0
+			} else {
+				ctr.keys[i] = append(ctr.keys[i], data[(i)*8:(i+1)*8]...)
+			}
+		}
+	}
+}
+
+func fillGroupStrWithDecimal128(ctr *Container, vec *vector.Vector, n int, start int, scale int32) {
+	src := vector.DecodeFixedCol[types.Decimal128](vec, 16)
+	vs := ctr.decimal128Slice[:n]
+	types.AlignDecimal128UsingScaleDiffBatch(src[start:start+n], vs, scale)
+	data := unsafe.Slice((*byte)(unsafe.Pointer(&vs[0])), cap(vs)*16)[:len(vs)*16]
+	if !nulls.Any(vec.Nsp) {
+		for i := 0; i < n; i++ {
+			ctr.keys[i] = append(ctr.keys[i], data[(i)*16:(i+1)*16]...)
+		}
+	} else {
+		for i := 0; i < n; i++ {
+			if vec.Nsp.Np.Contains(uint64(i + start)) {
+				ctr.zValues[i] = 0
+			} else {
+				ctr.keys[i] = append(ctr.keys[i], data[(i)*16:(i+1)*16]...)
+			}
+		}
+	}
+}
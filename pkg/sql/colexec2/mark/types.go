@@ -0,0 +1,67 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mark
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/hashtable"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+const (
+	Build = iota
+	Probe
+	End
+)
+
+const (
+	UnitLimit = 256
+)
+
+var OneInt64s []int64
+
+type Container struct {
+	state         int
+	rows          int64 // number of rows of the right side
+	hasNull       bool  // some keys of the right side have null
+	keys          [][]byte
+	values        []uint64
+	zValues       []int64
+	strHashStates [][3]uint64
+	strHashMap    *hashtable.StringHashMap
+
+	decimal64Slice  []types.Decimal64
+	decimal128Slice []types.Decimal128
+}
+
+type Condition struct {
+	Pos   int32
+	Scale int32
+	Typ   types.Type
+}
+
+// Argument of the mark join, every row of the left side is returned with a boolean mark
+// after the columns of Result, it is the result of the IN predicate of the row's keys over
+// the keys of the right side:
+//
+//	true if the keys are found in the right side.
+//	false if the right side is empty, or the keys are not found and no key of the right side is null.
+//	null otherwise, the keys of the row have null or they may be equal to a null key of the right side.
+//
+// NOT IN is the negation of the mark.
+type Argument struct {
+	ctr        *Container
+	Result     []int32
+	Conditions [][]Condition
+}
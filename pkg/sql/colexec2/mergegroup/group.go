@@ -50,6 +50,9 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 			ctr.state = Eval
 		case Eval:
 			ctr.state = End
+			if ctr.bat == nil { // no group received, a partition may have no rows
+				continue
+			}
 			if ap.NeedEval {
 				for _, r := range ctr.bat.Rs {
 					ctr.bat.Vecs = append(ctr.bat.Vecs, r.Eval(ctr.bat.Zs))
@@ -105,15 +108,15 @@ func (ctr *Container) process(bat *batch.Batch, proc *process.Process) error {
 			switch vec.Typ.Oid {
 			case types.T_int8, types.T_uint8:
 				size += 1 + 1
-			case types.T_int16, types.T_uint16:
+			case types.T_int16, types.T_uint16, types.T_enum, types.T_year:
 				size += 2 + 1
 			case types.T_int32, types.T_uint32, types.T_float32, types.T_date:
 				size += 4 + 1
-			case types.T_int64, types.T_uint64, types.T_float64, types.T_datetime, types.T_decimal64:
+			case types.T_int64, types.T_uint64, types.T_float64, types.T_datetime, types.T_time, types.T_decimal64, types.T_set:
 				size += 8 + 1
-			case types.T_decimal128:
+			case types.T_decimal128, types.T_uuid:
 				size += 16 + 1
-			case types.T_char, types.T_varchar:
+			case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 				if width := vec.Typ.Width; width > 0 {
 					size += int(width) + 1
 				} else {
@@ -223,6 +226,8 @@ func (ctr *Container) processH8(bat *batch.Batch, proc *process.Process) error {
 				fillGroup[uint64](ctr, vec, ctr.h8.keys, n, 8, i)
 			case -16:
 				fillGroup[types.Decimal128](ctr, vec, ctr.h8.keys, n, 16, i)
+			case 16:
+				fillGroup[types.Uuid](ctr, vec, ctr.h8.keys, n, 16, i)
 			default:
 				fillStringGroup(ctr, vec, ctr.h8.keys, n, 8, i)
 			}
@@ -269,6 +274,8 @@ func (ctr *Container) processH24(bat *batch.Batch, proc *process.Process) error
 				fillGroup[types.Decimal64](ctr, vec, ctr.h24.keys, n, 8, i)
 			case -16:
 				fillGroup[types.Decimal128](ctr, vec, ctr.h24.keys, n, 16, i)
+			case 16:
+				fillGroup[types.Uuid](ctr, vec, ctr.h24.keys, n, 16, i)
 			default:
 				fillStringGroup(ctr, vec, ctr.h24.keys, n, 24, i)
 			}
@@ -314,6 +321,8 @@ func (ctr *Container) processH32(bat *batch.Batch, proc *process.Process) error
 				fillGroup[uint64](ctr, vec, ctr.h32.keys, n, 8, i)
 			case -16:
 				fillGroup[types.Decimal128](ctr, vec, ctr.h32.keys, n, 16, i)
+			case 16:
+				fillGroup[types.Uuid](ctr, vec, ctr.h32.keys, n, 16, i)
 			default:
 				fillStringGroup(ctr, vec, ctr.h32.keys, n, 32, i)
 			}
@@ -359,6 +368,8 @@ func (ctr *Container) processH40(bat *batch.Batch, proc *process.Process) error
 				fillGroup[uint64](ctr, vec, ctr.h40.keys, n, 8, i)
 			case -16:
 				fillGroup[types.Decimal128](ctr, vec, ctr.h40.keys, n, 16, i)
+			case 16:
+				fillGroup[types.Uuid](ctr, vec, ctr.h40.keys, n, 16, i)
 			default:
 				fillStringGroup(ctr, vec, ctr.h40.keys, n, 40, i)
 			}
@@ -402,6 +413,8 @@ func (ctr *Container) processHStr(bat *batch.Batch, proc *process.Process) error
 				fillGroupStr[uint64](ctr, vec, n, 8, i)
 			case -16:
 				fillGroupStr[types.Decimal128](ctr, vec, n, 16, i)
+			case 16:
+				fillGroupStr[types.Uuid](ctr, vec, n, 16, i)
 			default:
 				vs := vec.Col.(*types.Bytes)
 				if !nulls.Any(vec.Nsp) {
@@ -0,0 +1,289 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mergejoin
+
+import (
+	"bytes"
+
+	"github.com/matrixorigin/matrixone/pkg/compare"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func String(_ interface{}, buf *bytes.Buffer) {
+	buf.WriteString(" merge ⨝ ")
+}
+
+func Prepare(proc *process.Process, arg interface{}) error {
+	ap := arg.(*Argument)
+	ap.ctr = new(Container)
+	ap.ctr.lcmps = make([]compare.Compare, len(ap.Conditions[0]))
+	ap.ctr.rcmps = make([]compare.Compare, len(ap.Conditions[1]))
+	for i, cond := range ap.Conditions[0] {
+		ap.ctr.lcmps[i] = compare.New(cond.Typ.Oid, false)
+		ap.ctr.rcmps[i] = compare.New(ap.Conditions[1][i].Typ.Oid, false)
+	}
+	for _, rp := range ap.Result {
+		if rp.Rel == 1 {
+			ap.ctr.poses = append(ap.ctr.poses, rp.Pos)
+		}
+	}
+	return nil
+}
+
+// Call joins the rows of both sides in the order of the keys. The rows of the right side
+// sharing the same keys are buffered as a group, each row of the left side is joined with
+// the group if it has the same keys, is skipped if its keys are smaller, and moves the right
+// side to the next group if its keys are larger. Only one group is kept in memory.
+func Call(proc *process.Process, arg interface{}) (bool, error) {
+	ap := arg.(*Argument)
+	ctr := ap.ctr
+	for {
+		switch ctr.state {
+		case Probe:
+			if ctr.lbat == nil {
+				bat := <-proc.Reg.MergeReceivers[0].Ch
+				if bat == nil {
+					ctr.lend = true
+					ctr.state = End
+					continue
+				}
+				if len(bat.Zs) == 0 {
+					continue
+				}
+				ctr.lbat, ctr.li = bat, 0
+				for i, cond := range ap.Conditions[0] {
+					ctr.lcmps[i].Set(0, bat.Vecs[cond.Pos])
+				}
+			}
+			ok, err := ctr.probe(ap, proc)
+			if err != nil {
+				ctr.state = End
+				ctr.clean(proc)
+				proc.Reg.InputBatch = nil
+				return true, err
+			}
+			if !ok {
+				ctr.state = End
+			}
+			return false, nil
+		default:
+			ctr.clean(proc)
+			proc.Reg.InputBatch = nil
+			return true, nil
+		}
+	}
+}
+
+// probe joins the rows of the current left batch, it returns false if the
+// right side is exhausted and no more rows can be joined.
+func (ctr *Container) probe(ap *Argument, proc *process.Process) (bool, error) {
+	bat := ctr.lbat
+	rbat := batch.NewWithSize(len(ap.Result))
+	for ; ctr.li < len(bat.Zs); ctr.li++ {
+		if hasNull(bat, ap.Conditions[0], ctr.li) {
+			continue
+		}
+		for {
+			if ctr.key == nil {
+				ok, err := ctr.nextGroup(ap, proc)
+				if err != nil {
+					rbat.Clean(proc.Mp)
+					return false, err
+				}
+				if !ok {
+					setResult(rbat, proc)
+					return false, nil
+				}
+			}
+			if r := ctr.compare(ctr.lcmps, int64(ctr.li)); r > 0 {
+				ctr.cleanGroup(proc)
+				continue
+			} else if r == 0 {
+				if err := ctr.join(rbat, ap, proc); err != nil {
+					rbat.Clean(proc.Mp)
+					return false, err
+				}
+			}
+			break
+		}
+	}
+	bat.Clean(proc.Mp)
+	ctr.lbat = nil
+	setResult(rbat, proc)
+	return true, nil
+}
+
+func setResult(rbat *batch.Batch, proc *process.Process) {
+	if len(rbat.Zs) == 0 {
+		rbat.Clean(proc.Mp)
+		rbat = &batch.Batch{}
+	}
+	proc.Reg.InputBatch = rbat
+}
+
+// join appends the current left row joined with every row of the group to rbat
+func (ctr *Container) join(rbat *batch.Batch, ap *Argument, proc *process.Process) error {
+	bat := ctr.lbat
+	if len(rbat.Zs) == 0 {
+		for i, rp := range ap.Result {
+			if rp.Rel == 0 {
+				rbat.Vecs[i] = vector.New(bat.Vecs[rp.Pos].Typ)
+			} else {
+				rbat.Vecs[i] = vector.New(ctr.group.Vecs[rp.Pos].Typ)
+			}
+		}
+	}
+	for sel := range ctr.group.Zs {
+		for i, rp := range ap.Result {
+			if rp.Rel == 0 {
+				if err := vector.UnionOne(rbat.Vecs[i], bat.Vecs[rp.Pos], int64(ctr.li), proc.Mp); err != nil {
+					return err
+				}
+			} else {
+				if err := vector.UnionOne(rbat.Vecs[i], ctr.group.Vecs[rp.Pos], int64(sel), proc.Mp); err != nil {
+					return err
+				}
+			}
+		}
+		rbat.Zs = append(rbat.Zs, bat.Zs[ctr.li]*ctr.group.Zs[sel])
+	}
+	return nil
+}
+
+// nextGroup reads the rows of the right side sharing the keys of the next non-null row,
+// it returns false if the right side is exhausted.
+func (ctr *Container) nextGroup(ap *Argument, proc *process.Process) (bool, error) {
+	ctr.cleanGroup(proc)
+	for !ctr.rend {
+		if ctr.rbat == nil {
+			bat := <-proc.Reg.MergeReceivers[1].Ch
+			if bat == nil {
+				ctr.rend = true
+				break
+			}
+			if len(bat.Zs) == 0 {
+				continue
+			}
+			ctr.rbat, ctr.ri = bat, 0
+			for i, cond := range ap.Conditions[1] {
+				ctr.rcmps[i].Set(0, bat.Vecs[cond.Pos])
+			}
+		}
+		for ; ctr.ri < len(ctr.rbat.Zs); ctr.ri++ {
+			if hasNull(ctr.rbat, ap.Conditions[1], ctr.ri) {
+				continue
+			}
+			if ctr.key == nil {
+				if err := ctr.newGroup(ap, proc); err != nil {
+					return false, err
+				}
+			} else if ctr.compare(ctr.rcmps, int64(ctr.ri)) != 0 {
+				return true, nil
+			}
+			for _, pos := range ctr.poses {
+				if err := vector.UnionOne(ctr.group.Vecs[pos], ctr.rbat.Vecs[pos], int64(ctr.ri), proc.Mp); err != nil {
+					return false, err
+				}
+			}
+			ctr.group.Zs = append(ctr.group.Zs, ctr.rbat.Zs[ctr.ri])
+		}
+		ctr.rbat.Clean(proc.Mp)
+		ctr.rbat = nil
+	}
+	return ctr.key != nil, nil
+}
+
+// newGroup starts a group with the keys of the current right row
+func (ctr *Container) newGroup(ap *Argument, proc *process.Process) error {
+	bat := ctr.rbat
+	ctr.key = batch.NewWithSize(len(ap.Conditions[1]))
+	for i, cond := range ap.Conditions[1] {
+		ctr.key.Vecs[i] = vector.New(bat.Vecs[cond.Pos].Typ)
+		if err := vector.UnionOne(ctr.key.Vecs[i], bat.Vecs[cond.Pos], int64(ctr.ri), proc.Mp); err != nil {
+			return err
+		}
+		ctr.lcmps[i].Set(1, ctr.key.Vecs[i])
+		ctr.rcmps[i].Set(1, ctr.key.Vecs[i])
+	}
+	ctr.group = batch.NewWithSize(len(bat.Vecs))
+	for _, pos := range ctr.poses {
+		if ctr.group.Vecs[pos] == nil {
+			ctr.group.Vecs[pos] = vector.New(bat.Vecs[pos].Typ)
+		}
+	}
+	return nil
+}
+
+// compare compares the row of the vectors set at index 0 of cmps with the key of the group
+func (ctr *Container) compare(cmps []compare.Compare, row int64) int {
+	for _, cmp := range cmps {
+		if r := cmp.Compare(0, 1, row, 0); r != 0 {
+			return r
+		}
+	}
+	return 0
+}
+
+func (ctr *Container) cleanGroup(proc *process.Process) {
+	if ctr.key != nil {
+		ctr.key.Clean(proc.Mp)
+		ctr.key = nil
+	}
+	if ctr.group != nil {
+		ctr.group.Clean(proc.Mp)
+		ctr.group = nil
+	}
+}
+
+// clean frees the batches held by the join, the rest of both sides is discarded
+func (ctr *Container) clean(proc *process.Process) {
+	ctr.cleanGroup(proc)
+	if ctr.lbat != nil {
+		ctr.lbat.Clean(proc.Mp)
+		ctr.lbat = nil
+	}
+	if ctr.rbat != nil {
+		ctr.rbat.Clean(proc.Mp)
+		ctr.rbat = nil
+	}
+	for !ctr.lend {
+		bat := <-proc.Reg.MergeReceivers[0].Ch
+		if bat == nil {
+			ctr.lend = true
+			break
+		}
+		bat.Clean(proc.Mp)
+	}
+	for !ctr.rend {
+		bat := <-proc.Reg.MergeReceivers[1].Ch
+		if bat == nil {
+			ctr.rend = true
+			break
+		}
+		bat.Clean(proc.Mp)
+	}
+}
+
+func hasNull(bat *batch.Batch, conds []Condition, row int) bool {
+	for _, cond := range conds {
+		if vec := bat.Vecs[cond.Pos]; nulls.Any(vec.Nsp) && nulls.Contains(vec.Nsp, uint64(row)) {
+			return true
+		}
+	}
+	return false
+}
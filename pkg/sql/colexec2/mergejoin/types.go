@@ -0,0 +1,63 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mergejoin
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/compare"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+const (
+	Probe = iota
+	End
+)
+
+type Container struct {
+	state int
+	lend  bool // indicates if the left side is exhausted
+	rend  bool // indicates if the right side is exhausted
+
+	li   int          // the next row of lbat
+	ri   int          // the next row of rbat
+	lbat *batch.Batch // the current batch of the left side
+	rbat *batch.Batch // the current batch of the right side
+
+	key   *batch.Batch // the join keys of the current group
+	group *batch.Batch // the rows of the right side sharing the keys of the current group
+
+	poses []int32 // pos of the right vectors need to be copied
+
+	lcmps []compare.Compare // compare the left rows with the key of the group
+	rcmps []compare.Compare // compare the right rows with the key of the group
+}
+
+type ResultPos struct {
+	Rel int32
+	Pos int32
+}
+
+type Condition struct {
+	Pos int32
+	Typ types.Type
+}
+
+// Argument of the merge join, both sides must be sorted ascending on the
+// join keys, and the keys of both sides must have the same type.
+type Argument struct {
+	ctr        *Container
+	Result     []ResultPos
+	Conditions [][]Condition
+}
@@ -16,6 +16,7 @@ package order
 
 import (
 	"bytes"
+	"sort"
 	"strconv"
 	"testing"
 
@@ -55,6 +56,8 @@ func init() {
 		newTestCase(mheap.New(gm), []types.Type{{Oid: types.T_int8}}, []Field{{E: newExpression(0), Type: 2}}),
 		newTestCase(mheap.New(gm), []types.Type{{Oid: types.T_int8}, {Oid: types.T_int64}}, []Field{{E: newExpression(0), Type: 0}, {E: newExpression(1), Type: 0}}),
 		newTestCase(mheap.New(gm), []types.Type{{Oid: types.T_int8}, {Oid: types.T_int64}}, []Field{{E: newExpression(0), Type: 2}, {E: newExpression(1), Type: 2}}),
+		newTestCase(mheap.New(gm), []types.Type{{Oid: types.T_time}}, []Field{{E: newExpression(0), Type: 0}}),
+		newTestCase(mheap.New(gm), []types.Type{{Oid: types.T_year}, {Oid: types.T_time}}, []Field{{E: newExpression(0), Type: 2}, {E: newExpression(1), Type: 0}}),
 	}
 }
 
@@ -92,6 +95,27 @@ func TestOrder(t *testing.T) {
 	}
 }
 
+func TestOrderTimeAndYear(t *testing.T) {
+	hm := host.New(1 << 30)
+	gm := guest.New(1<<30, hm)
+	tc := newTestCase(mheap.New(gm), []types.Type{{Oid: types.T_time}, {Oid: types.T_year}}, []Field{{E: newExpression(0), Type: 0}})
+	Prepare(tc.proc, tc.arg)
+	tc.proc.Reg.InputBatch = newBatch(t, tc.types, tc.proc, Rows)
+	Call(tc.proc, tc.arg)
+	times := tc.proc.Reg.InputBatch.Vecs[0].Col.([]types.Time)
+	require.True(t, sort.SliceIsSorted(times, func(i, j int) bool { return times[i] < times[j] }))
+	tc.proc.Reg.InputBatch.Clean(tc.proc.Mp)
+
+	tc = newTestCase(mheap.New(gm), []types.Type{{Oid: types.T_year}}, []Field{{E: newExpression(0), Type: 0}})
+	Prepare(tc.proc, tc.arg)
+	tc.proc.Reg.InputBatch = newBatch(t, tc.types, tc.proc, Rows)
+	Call(tc.proc, tc.arg)
+	years := tc.proc.Reg.InputBatch.Vecs[0].Col.([]types.YearValue)
+	require.True(t, sort.SliceIsSorted(years, func(i, j int) bool { return years[i] < years[j] }))
+	tc.proc.Reg.InputBatch.Clean(tc.proc.Mp)
+	require.Equal(t, int64(0), mheap.Size(tc.proc.Mp))
+}
+
 func BenchmarkOrder(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		hm := host.New(1 << 30)
@@ -184,6 +208,24 @@ func newBatch(t *testing.T, ts []types.Type, proc *process.Process, rows int64)
 				vs[i] = types.Date(i)
 			}
 			vec.Col = vs
+		case types.T_time:
+			data, err := mheap.Alloc(proc.Mp, rows*8)
+			require.NoError(t, err)
+			vec.Data = data
+			vs := encoding.DecodeTimeSlice(vec.Data)[:rows]
+			for i := range vs {
+				vs[i] = types.Time(rows - int64(i))
+			}
+			vec.Col = vs
+		case types.T_year:
+			data, err := mheap.Alloc(proc.Mp, rows*2)
+			require.NoError(t, err)
+			vec.Data = data
+			vs := encoding.DecodeYearValueSlice(vec.Data)[:rows]
+			for i := range vs {
+				vs[i] = types.YearValue(1901 + rows - int64(i))
+			}
+			vec.Col = vs
 		case types.T_char, types.T_varchar:
 			size := 0
 			vs := make([][]byte, rows)
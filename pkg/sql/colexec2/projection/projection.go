@@ -18,6 +18,7 @@ import (
 	"bytes"
 
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	colexec "github.com/matrixorigin/matrixone/pkg/sql/colexec2"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
@@ -55,6 +56,16 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 			rbat.Clean(proc.Mp)
 			return false, err
 		}
+		for j := 0; j < i; j++ {
+			if rbat.Vecs[j] == vec { // a column projected twice is copied, the vectors of a batch are modified separately
+				if vec, err = vector.Dup(vec, proc.Mp); err != nil {
+					bat.Clean(proc.Mp)
+					rbat.Clean(proc.Mp)
+					return false, err
+				}
+				break
+			}
+		}
 		rbat.Vecs[i] = vec
 	}
 	for _, vec := range rbat.Vecs {
@@ -98,6 +98,26 @@ func TestProjection(t *testing.T) {
 	}
 }
 
+func TestProjectionTwice(t *testing.T) {
+	proc := process.New(mheap.New(guest.New(1<<30, host.New(1<<30))))
+	arg := &Argument{
+		Es: []*plan.Expr{
+			{Expr: &plan.Expr_Col{Col: &plan.ColRef{ColPos: 0}}},
+			{Expr: &plan.Expr_Col{Col: &plan.ColRef{ColPos: 0}}},
+		},
+	}
+	Prepare(proc, arg)
+	proc.Reg.InputBatch = newBatch(t, []types.Type{{Oid: types.T_int64, Size: 8}}, proc, Rows)
+	_, err := Call(proc, arg)
+	require.NoError(t, err)
+	bat := proc.Reg.InputBatch
+	// a column projected twice is freed once for every vector
+	require.NotSame(t, bat.Vecs[0], bat.Vecs[1])
+	require.Equal(t, bat.Vecs[0].Col, bat.Vecs[1].Col)
+	bat.Clean(proc.Mp)
+	require.Equal(t, int64(0), mheap.Size(proc.Mp))
+}
+
 // create a new block based on the type information
 func newBatch(t *testing.T, ts []types.Type, proc *process.Process, rows int64) *batch.Batch {
 	bat := batch.NewWithSize(len(ts))
@@ -18,6 +18,7 @@ import (
 	"bytes"
 	"fmt"
 
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	colexec "github.com/matrixorigin/matrixone/pkg/sql/colexec2"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
@@ -45,10 +46,15 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 		bat.Clean(proc.Mp)
 		return false, err
 	}
-	bs := vec.Col.([]bool)
+	// the rows whose condition is null are filtered out like the false ones
+	bs, _ := vec.Col.([]bool)
 	sels := make([]int64, 0, 8)
-	for i, b := range bs {
-		if b {
+	for i := range bat.Zs {
+		j := i
+		if vec.IsConst {
+			j = 0
+		}
+		if !nulls.Contains(vec.Nsp, uint64(j)) && bs[j] {
 			sels = append(sels, int64(i))
 		}
 	}
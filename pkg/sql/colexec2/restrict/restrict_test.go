@@ -0,0 +1,111 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package restrict
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan2/function"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/stretchr/testify/require"
+)
+
+func TestString(t *testing.T) {
+	buf := new(bytes.Buffer)
+	String(&Argument{E: boolColumn()}, buf)
+}
+
+func TestRestrict(t *testing.T) {
+	proc := process.New(mheap.New(guest.New(1<<30, host.New(1<<30))))
+
+	tests := []struct {
+		name string
+		e    *plan.Expr
+		want []int64
+	}{
+		// the rows whose condition is false or null are filtered out
+		{"column", boolColumn(), []int64{0, 3}},
+		{"const true", intEqual(t, 1, 1), []int64{0, 1, 2, 3}},
+		{"const false", intEqual(t, 1, 2), []int64{}},
+		{"const null", &plan.Expr{
+			Typ:  &plan.Type{Id: plan.Type_BOOL},
+			Expr: &plan.Expr_C{C: &plan.Const{Isnull: true}},
+		}, []int64{}},
+	}
+	for _, tt := range tests {
+		arg := &Argument{E: tt.e}
+		require.NoError(t, Prepare(proc, arg))
+		proc.Reg.InputBatch = newBatch(t, proc)
+		_, err := Call(proc, arg)
+		require.NoError(t, err, tt.name)
+		bat := proc.Reg.InputBatch
+		require.Equal(t, len(tt.want), len(bat.Zs), tt.name)
+		ids := bat.Vecs[1].Col.([]int64)
+		for i, want := range tt.want {
+			require.Equal(t, want, ids[i], tt.name)
+		}
+		bat.Clean(proc.Mp)
+	}
+	require.Equal(t, int64(0), mheap.Size(proc.Mp))
+}
+
+func boolColumn() *plan.Expr {
+	return &plan.Expr{
+		Typ:  &plan.Type{Id: plan.Type_BOOL},
+		Expr: &plan.Expr_Col{Col: &plan.ColRef{ColPos: 0}},
+	}
+}
+
+// intEqual returns the expression l = r of two integer constants
+func intEqual(t *testing.T, l, r int64) *plan.Expr {
+	_, id, _, err := function.GetFunctionByName("=", []types.T{types.T_int64, types.T_int64})
+	require.NoError(t, err)
+	arg := func(v int64) *plan.Expr {
+		return &plan.Expr{
+			Typ:  &plan.Type{Id: plan.Type_INT64},
+			Expr: &plan.Expr_C{C: &plan.Const{Value: &plan.Const_Ival{Ival: v}}},
+		}
+	}
+	return &plan.Expr{
+		Typ: &plan.Type{Id: plan.Type_BOOL},
+		Expr: &plan.Expr_F{
+			F: &plan.Function{
+				Func: &plan.ObjectRef{Obj: id},
+				Args: []*plan.Expr{arg(l), arg(r)},
+			},
+		},
+	}
+}
+
+// newBatch returns the rows (true, 0), (false, 1), (null, 2), (true, 3)
+func newBatch(t *testing.T, proc *process.Process) *batch.Batch {
+	bat := batch.NewWithSize(2)
+	bat.InitZsOne(4)
+	bat.Vecs[0] = vector.New(types.Type{Oid: types.T_bool, Size: 1})
+	require.NoError(t, vector.Append(bat.Vecs[0], []bool{true, false, false, true}))
+	nulls.Add(bat.Vecs[0].Nsp, 2)
+	bat.Vecs[1] = vector.New(types.Type{Oid: types.T_int64, Size: 8})
+	require.NoError(t, vector.Append(bat.Vecs[1], []int64{0, 1, 2, 3}))
+	return bat
+}
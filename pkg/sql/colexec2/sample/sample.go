@@ -0,0 +1,75 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sample
+
+import (
+	"bytes"
+	"fmt"
+	"math/rand"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func String(arg interface{}, buf *bytes.Buffer) {
+	n := arg.(*Argument)
+	if n.Method == System {
+		buf.WriteString(fmt.Sprintf("sample(system %v%%)", n.Percent))
+	} else {
+		buf.WriteString(fmt.Sprintf("sample(bernoulli %v%%)", n.Percent))
+	}
+}
+
+func Prepare(_ *process.Process, arg interface{}) error {
+	ap := arg.(*Argument)
+	ap.rnd = rand.New(rand.NewSource(ap.Seed))
+	return nil
+}
+
+// Call keeps a random part of the batches or rows of its input
+func Call(proc *process.Process, arg interface{}) (bool, error) {
+	bat := proc.Reg.InputBatch
+	if bat == nil {
+		return true, nil
+	}
+	if len(bat.Zs) == 0 {
+		return false, nil
+	}
+	ap := arg.(*Argument)
+	p := ap.Percent / 100
+	switch ap.Method {
+	case System:
+		if ap.rnd.Float64() >= p {
+			bat.Shrink(nil)
+		}
+	case Bernoulli:
+		sels := make([]int64, 0, int(float64(len(bat.Zs))*p)+1)
+		for i, z := range bat.Zs {
+			// a row with count z stands for z same rows, every one of them is sampled
+			n := int64(0)
+			for j := int64(0); j < z; j++ {
+				if ap.rnd.Float64() < p {
+					n++
+				}
+			}
+			if n > 0 {
+				bat.Zs[i] = n
+				sels = append(sels, int64(i))
+			}
+		}
+		bat.Shrink(sels)
+	}
+	proc.Reg.InputBatch = bat
+	return false, nil
+}
@@ -0,0 +1,149 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sample
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	Rows    = 1000 // default rows
+	Batches = 100  // default batches
+)
+
+// add unit tests for cases
+type sampleTestCase struct {
+	arg  *Argument
+	proc *process.Process
+}
+
+func newTestCase(gm *guest.Mmu, method int, percent float64, seed int64) sampleTestCase {
+	return sampleTestCase{
+		proc: process.New(mheap.New(gm)),
+		arg: &Argument{
+			Method:  method,
+			Percent: percent,
+			Seed:    seed,
+		},
+	}
+}
+
+func TestString(t *testing.T) {
+	buf := new(bytes.Buffer)
+	gm := guest.New(1<<30, host.New(1<<30))
+	String(newTestCase(gm, System, 10, 0).arg, buf)
+	require.Equal(t, "sample(system 10%)", buf.String())
+	buf.Reset()
+	String(newTestCase(gm, Bernoulli, 0.5, 0).arg, buf)
+	require.Equal(t, "sample(bernoulli 0.5%)", buf.String())
+}
+
+func TestBernoulli(t *testing.T) {
+	gm := guest.New(1<<30, host.New(1<<30))
+	for _, percent := range []float64{0, 30, 100} {
+		tc := newTestCase(gm, Bernoulli, percent, 42)
+		require.NoError(t, Prepare(tc.proc, tc.arg))
+		rows := 0
+		for i := 0; i < Batches; i++ {
+			tc.proc.Reg.InputBatch = newBatch(t, tc.proc, Rows)
+			end, err := Call(tc.proc, tc.arg)
+			require.NoError(t, err)
+			require.False(t, end)
+			rows += len(tc.proc.Reg.InputBatch.Zs)
+			tc.proc.Reg.InputBatch.Clean(tc.proc.Mp)
+		}
+		switch percent {
+		case 0:
+			require.Equal(t, 0, rows)
+		case 100:
+			require.Equal(t, Rows*Batches, rows)
+		default:
+			require.InDelta(t, Rows*Batches*percent/100, rows, Rows*Batches*0.01)
+		}
+		tc.proc.Reg.InputBatch = &batch.Batch{}
+		Call(tc.proc, tc.arg)
+		tc.proc.Reg.InputBatch = nil
+		end, _ := Call(tc.proc, tc.arg)
+		require.True(t, end)
+		require.Equal(t, int64(0), mheap.Size(tc.proc.Mp))
+	}
+}
+
+func TestSystem(t *testing.T) {
+	gm := guest.New(1<<30, host.New(1<<30))
+	tc := newTestCase(gm, System, 30, 42)
+	require.NoError(t, Prepare(tc.proc, tc.arg))
+	batches := 0
+	for i := 0; i < Batches*10; i++ {
+		tc.proc.Reg.InputBatch = newBatch(t, tc.proc, 10)
+		_, err := Call(tc.proc, tc.arg)
+		require.NoError(t, err)
+		// a batch is kept or dropped as a whole
+		switch n := len(tc.proc.Reg.InputBatch.Zs); n {
+		case 10:
+			batches++
+		default:
+			require.Equal(t, 0, n)
+		}
+		tc.proc.Reg.InputBatch.Clean(tc.proc.Mp)
+	}
+	require.InDelta(t, Batches*10*0.3, batches, Batches*10*0.05)
+	require.Equal(t, int64(0), mheap.Size(tc.proc.Mp))
+}
+
+func TestRepeatable(t *testing.T) {
+	gm := guest.New(1<<30, host.New(1<<30))
+	run := func(seed int64) []int64 {
+		tc := newTestCase(gm, Bernoulli, 10, seed)
+		require.NoError(t, Prepare(tc.proc, tc.arg))
+		tc.proc.Reg.InputBatch = newBatch(t, tc.proc, Rows)
+		_, err := Call(tc.proc, tc.arg)
+		require.NoError(t, err)
+		bat := tc.proc.Reg.InputBatch
+		rows := append([]int64{}, bat.Vecs[0].Col.([]int64)...)
+		bat.Clean(tc.proc.Mp)
+		return rows
+	}
+	require.Equal(t, run(7), run(7))
+	require.NotEqual(t, run(7), run(8))
+}
+
+// create a new block with an int64 column
+func newBatch(t *testing.T, proc *process.Process, rows int64) *batch.Batch {
+	bat := batch.NewWithSize(1)
+	bat.InitZsOne(int(rows))
+	vec := vector.New(types.Type{Oid: types.T_int64, Size: 8})
+	data, err := mheap.Alloc(proc.Mp, rows*8)
+	require.NoError(t, err)
+	vec.Data = data
+	vs := encoding.DecodeInt64Slice(vec.Data)[:rows]
+	for i := range vs {
+		vs[i] = int64(i)
+	}
+	vec.Col = vs
+	bat.Vecs[0] = vec
+	return bat
+}
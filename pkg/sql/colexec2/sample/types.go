@@ -0,0 +1,31 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sample
+
+import "math/rand"
+
+const (
+	// System keeps or drops every batch read from the storage, which is a block of the table, as a whole.
+	System = iota
+	// Bernoulli keeps or drops every row.
+	Bernoulli
+)
+
+type Argument struct {
+	Method  int
+	Percent float64 // percent of the blocks or rows to keep, from 0 to 100
+	Seed    int64   // seed of the random numbers, a repeatable sample uses the same seed every time
+	rnd     *rand.Rand
+}
@@ -0,0 +1,151 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package semi
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/stretchr/testify/require"
+)
+
+// add unit tests for cases
+type joinTestCase struct {
+	arg    *Argument
+	left   [][]int64 // the keys of the batches of the left side, -1 is null
+	right  [][]int64 // the keys of the batches of the right side, -1 is null
+	result []int64   // the expected keys of the returned rows
+	proc   *process.Process
+	cancel context.CancelFunc
+}
+
+var (
+	tcs []joinTestCase
+)
+
+func init() {
+	hm := host.New(1 << 30)
+	gm := guest.New(1<<30, hm)
+	tcs = []joinTestCase{
+		newTestCase(mheap.New(gm), [][]int64{{-1, 0, 1, 1}, {1, 2, 3, 5}}, [][]int64{{1, 1}, {1, 2, 4}, {-1, 5}}, []int64{1, 1, 1, 2, 5}),
+		newTestCase(mheap.New(gm), [][]int64{{0, 1, 2}}, [][]int64{{3, 4}}, nil),
+		newTestCase(mheap.New(gm), [][]int64{{-1, 3}}, [][]int64{{-1}}, nil),
+		newTestCase(mheap.New(gm), [][]int64{{3, 4}, {6}}, nil, nil),
+	}
+}
+
+func TestString(t *testing.T) {
+	buf := new(bytes.Buffer)
+	for _, tc := range tcs {
+		String(tc.arg, buf)
+	}
+}
+
+func TestPrepare(t *testing.T) {
+	for _, tc := range tcs {
+		Prepare(tc.proc, tc.arg)
+	}
+}
+
+func TestJoin(t *testing.T) {
+	for _, tc := range tcs {
+		Prepare(tc.proc, tc.arg)
+		for _, vs := range tc.left {
+			tc.proc.Reg.MergeReceivers[0].Ch <- newBatch(t, tc.proc, vs)
+		}
+		tc.proc.Reg.MergeReceivers[0].Ch <- &batch.Batch{}
+		tc.proc.Reg.MergeReceivers[0].Ch <- nil
+		for _, vs := range tc.right {
+			tc.proc.Reg.MergeReceivers[1].Ch <- newBatch(t, tc.proc, vs)
+		}
+		tc.proc.Reg.MergeReceivers[1].Ch <- nil
+		var result []int64
+		for {
+			if ok, err := Call(tc.proc, tc.arg); ok || err != nil {
+				require.NoError(t, err)
+				break
+			}
+			bat := tc.proc.Reg.InputBatch
+			result = append(result, bat.Vecs[0].Col.([]int64)...)
+			bat.Clean(tc.proc.Mp)
+		}
+		require.Equal(t, tc.result, result)
+		for len(tc.proc.Reg.MergeReceivers[0].Ch) > 0 { // the left side is not read if the right side is empty
+			if bat := <-tc.proc.Reg.MergeReceivers[0].Ch; bat != nil {
+				bat.Clean(tc.proc.Mp)
+			}
+		}
+		require.Equal(t, int64(0), mheap.Size(tc.proc.Mp))
+	}
+}
+
+func newTestCase(m *mheap.Mheap, left, right [][]int64, result []int64) joinTestCase {
+	proc := process.New(m)
+	proc.Reg.MergeReceivers = make([]*process.WaitRegister, 2)
+	ctx, cancel := context.WithCancel(context.Background())
+	proc.Reg.MergeReceivers[0] = &process.WaitRegister{
+		Ctx: ctx,
+		Ch:  make(chan *batch.Batch, 10),
+	}
+	proc.Reg.MergeReceivers[1] = &process.WaitRegister{
+		Ctx: ctx,
+		Ch:  make(chan *batch.Batch, 10),
+	}
+	typ := types.Type{Oid: types.T_int64}
+	return joinTestCase{
+		left:   left,
+		right:  right,
+		result: result,
+		proc:   proc,
+		cancel: cancel,
+		arg: &Argument{
+			Result: []int32{0},
+			Conditions: [][]Condition{
+				{{0, 0, typ}},
+				{{0, 0, typ}},
+			},
+		},
+	}
+}
+
+// create a new batch of an int64 column with the values, -1 is null
+func newBatch(t *testing.T, proc *process.Process, vs []int64) *batch.Batch {
+	bat := batch.NewWithSize(1)
+	bat.InitZsOne(len(vs))
+	vec := vector.New(types.Type{Oid: types.T_int64})
+	data, err := mheap.Alloc(proc.Mp, int64(len(vs))*8)
+	require.NoError(t, err)
+	vec.Data = data
+	col := encoding.DecodeInt64Slice(vec.Data)[:len(vs)]
+	for i, v := range vs {
+		col[i] = v
+		if v < 0 {
+			nulls.Add(vec.Nsp, uint64(i))
+		}
+	}
+	vec.Col = col
+	bat.Vecs[0] = vec
+	return bat
+}
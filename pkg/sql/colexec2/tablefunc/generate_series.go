@@ -0,0 +1,67 @@
+// Copyright 2021 - 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tablefunc
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+)
+
+/*
+GenerateSeries produces the integers from start to stop, both ends included,
+increased by the step. The step can be negative to count down, and the series
+is empty if the stop can not be reached from the start.
+*/
+type GenerateSeries struct {
+	cur, stop, step int64
+	end             bool
+}
+
+func NewGenerateSeries(start, stop, step int64) (*GenerateSeries, error) {
+	if step == 0 {
+		return nil, errors.New(errno.DataException, "the step of generate_series can not be zero")
+	}
+	return &GenerateSeries{
+		cur:  start,
+		stop: stop,
+		step: step,
+	}, nil
+}
+
+func (r *GenerateSeries) Read(refCnts []uint64, attrs []string) (*batch.Batch, error) {
+	col := make([]int64, 0, BatchSize)
+	for !r.end && len(col) < BatchSize {
+		if (r.step > 0 && r.cur > r.stop) || (r.step < 0 && r.cur < r.stop) {
+			r.end = true
+			break
+		}
+		col = append(col, r.cur)
+		next := r.cur + r.step
+		// stop before the value overflows
+		if (r.step > 0 && next < r.cur) || (r.step < 0 && next > r.cur) {
+			r.end = true
+		}
+		r.cur = next
+	}
+	if len(col) == 0 {
+		return nil, nil
+	}
+	vec := vector.New(types.Type{Oid: types.T_int64, Size: 8})
+	vec.Col = col
+	return newBatch(refCnts, attrs, map[string]*vector.Vector{"generate_series": vec}, len(col))
+}
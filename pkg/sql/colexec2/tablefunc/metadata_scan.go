@@ -0,0 +1,110 @@
+// Copyright 2021 - 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tablefunc
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+)
+
+/*
+MetadataScan produces a row for every block of the relation, with the ids of the
+segment and the block, the count of the rows, the range of the primary key
+and the size of the block.
+*/
+type MetadataScan struct {
+	blocks []engine.BlockInfo
+}
+
+func NewMetadataScan(rel engine.Relation, snap engine.Snapshot) (*MetadataScan, error) {
+	mrel, ok := rel.(engine.MetadataRelation)
+	if !ok {
+		return nil, errors.New(errno.FeatureNotSupported, fmt.Sprintf("the engine of table '%s' has no metadata of the blocks", rel.ID(snap)))
+	}
+	blocks, err := mrel.Blocks(snap)
+	if err != nil {
+		return nil, err
+	}
+	return &MetadataScan{
+		blocks: blocks,
+	}, nil
+}
+
+func (r *MetadataScan) Read(refCnts []uint64, attrs []string) (*batch.Batch, error) {
+	if len(r.blocks) == 0 {
+		return nil, nil
+	}
+	blocks := r.blocks
+	if len(blocks) > BatchSize {
+		blocks = blocks[:BatchSize]
+	}
+	r.blocks = r.blocks[len(blocks):]
+
+	n := len(blocks)
+	segIds, blkIds := make([]uint64, n), make([]uint64, n)
+	appendables := make([]bool, n)
+	rows, sizes, originSizes := make([]int64, n), make([]int64, n), make([]int64, n)
+	minKeys, maxKeys := make([][]byte, n), make([][]byte, n)
+	minVec := vector.New(varcharType)
+	maxVec := vector.New(varcharType)
+	for i, blk := range blocks {
+		segIds[i], blkIds[i] = blk.SegmentID, blk.BlockID
+		appendables[i] = blk.Appendable
+		rows[i], sizes[i], originSizes[i] = blk.Rows, blk.Size, blk.OriginSize
+		if blk.MinKey == nil {
+			nulls.Add(minVec.Nsp, uint64(i))
+			nulls.Add(maxVec.Nsp, uint64(i))
+			continue
+		}
+		minKeys[i], maxKeys[i] = formatKey(blk.MinKey), formatKey(blk.MaxKey)
+	}
+	if err := vector.Append(minVec, minKeys); err != nil {
+		return nil, err
+	}
+	if err := vector.Append(maxVec, maxKeys); err != nil {
+		return nil, err
+	}
+	return newBatch(refCnts, attrs, map[string]*vector.Vector{
+		"segment_id":  newFixedVector(types.T_uint64, 8, segIds),
+		"block_id":    newFixedVector(types.T_uint64, 8, blkIds),
+		"appendable":  newFixedVector(types.T_bool, 1, appendables),
+		"rows":        newFixedVector(types.T_int64, 8, rows),
+		"min_key":     minVec,
+		"max_key":     maxVec,
+		"size":        newFixedVector(types.T_int64, 8, sizes),
+		"origin_size": newFixedVector(types.T_int64, 8, originSizes),
+	}, n)
+}
+
+func newFixedVector[T any](oid types.T, size int32, col []T) *vector.Vector {
+	vec := vector.New(types.Type{Oid: oid, Size: size})
+	vec.Col = col
+	return vec
+}
+
+// formatKey returns the text of the key in the zonemap
+func formatKey(key any) []byte {
+	if v, ok := key.([]byte); ok {
+		return v
+	}
+	return []byte(fmt.Sprint(key))
+}
@@ -0,0 +1,63 @@
+// Copyright 2021 - 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tablefunc
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+)
+
+// New returns the reader of the rows produced by the table function, the arguments are constant vectors.
+// The function produces no rows if any argument is null.
+func New(name string, args []*vector.Vector, e engine.Engine, snap engine.Snapshot) (engine.Reader, error) {
+	for _, arg := range args {
+		if nulls.Contains(arg.Nsp, 0) {
+			return emptyReader{}, nil
+		}
+	}
+	switch name {
+	case "generate_series":
+		step := int64(1)
+		if len(args) == 3 {
+			step = args[2].Col.([]int64)[0]
+		}
+		return NewGenerateSeries(args[0].Col.([]int64)[0], args[1].Col.([]int64)[0], step)
+	case "unnest":
+		return NewUnnest(args[0].Col.(*types.Bytes).Get(0), args[0].Typ.Oid == types.T_json)
+	case "metadata_scan":
+		name := string(args[0].Col.(*types.Bytes).Get(0))
+		i := strings.IndexByte(name, '.')
+		if i < 0 {
+			return nil, errors.New(errno.InvalidTableDefinition, fmt.Sprintf("table '%s' does not exist", name))
+		}
+		db, err := e.Database(name[:i], snap)
+		if err != nil {
+			return nil, err
+		}
+		rel, err := db.Relation(name[i+1:], snap)
+		if err != nil {
+			return nil, err
+		}
+		return NewMetadataScan(rel, snap)
+	}
+	return nil, errors.New(errno.UndefinedFunction, fmt.Sprintf("table function '%s' does not exist", name))
+}
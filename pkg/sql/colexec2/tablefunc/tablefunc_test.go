@@ -0,0 +1,145 @@
+// Copyright 2021 - 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tablefunc
+
+import (
+	"math"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/stretchr/testify/require"
+)
+
+func readInt64s(t *testing.T, r engine.Reader, attr string) []int64 {
+	var vs []int64
+	for {
+		bat, err := r.Read([]uint64{0}, []string{attr})
+		require.NoError(t, err)
+		if bat == nil {
+			return vs
+		}
+		vs = append(vs, bat.Vecs[0].Col.([]int64)...)
+	}
+}
+
+func TestGenerateSeries(t *testing.T) {
+	kases := []struct {
+		start, stop, step int64
+		want              []int64
+	}{
+		{1, 10, 3, []int64{1, 4, 7, 10}},
+		{5, 1, -2, []int64{5, 3, 1}},
+		{1, 0, 1, nil},
+		{math.MaxInt64 - 1, math.MaxInt64, 1, []int64{math.MaxInt64 - 1, math.MaxInt64}},
+		{math.MinInt64 + 1, math.MinInt64, -3, []int64{math.MinInt64 + 1}},
+	}
+	for _, kase := range kases {
+		r, err := NewGenerateSeries(kase.start, kase.stop, kase.step)
+		require.NoError(t, err)
+		require.Equal(t, kase.want, readInt64s(t, r, "generate_series"))
+	}
+
+	r, err := NewGenerateSeries(1, BatchSize*2+1, 1)
+	require.NoError(t, err)
+	require.Equal(t, BatchSize*2+1, len(readInt64s(t, r, "generate_series")))
+
+	_, err = NewGenerateSeries(1, 10, 0)
+	require.Error(t, err)
+
+	r, err = NewGenerateSeries(1, 10, 1)
+	require.NoError(t, err)
+	_, err = r.Read([]uint64{0}, []string{"n"})
+	require.Error(t, err)
+}
+
+func TestUnnest(t *testing.T) {
+	r, err := NewUnnest([]byte(`[1, "a", null, {"k": [true]}]`), false)
+	require.NoError(t, err)
+	bat, err := r.Read([]uint64{0}, []string{"unnest"})
+	require.NoError(t, err)
+	require.Equal(t, 4, len(bat.Zs))
+	col := bat.Vecs[0].Col.(*types.Bytes)
+	require.Equal(t, "1", string(col.Get(0)))
+	require.Equal(t, "a", string(col.Get(1)))
+	require.True(t, nulls.Contains(bat.Vecs[0].Nsp, 2))
+	require.Equal(t, `{"k": [true]}`, string(col.Get(3)))
+	bat, err = r.Read([]uint64{0}, []string{"unnest"})
+	require.NoError(t, err)
+	require.Nil(t, bat)
+
+	bj, err := bytejson.ParseFromString(`["x", 2.5]`)
+	require.NoError(t, err)
+	r, err = NewUnnest(bj.Marshal(), true)
+	require.NoError(t, err)
+	bat, err = r.Read([]uint64{0}, []string{"unnest"})
+	require.NoError(t, err)
+	require.Equal(t, "x", string(bat.Vecs[0].Col.(*types.Bytes).Get(0)))
+	require.Equal(t, "2.5", string(bat.Vecs[0].Col.(*types.Bytes).Get(1)))
+
+	_, err = NewUnnest([]byte(`{"a": 1}`), false)
+	require.Error(t, err)
+	_, err = NewUnnest([]byte(`[1,`), false)
+	require.Error(t, err)
+}
+
+type testRelation struct {
+	engine.Relation
+	blocks []engine.BlockInfo
+}
+
+func (rel *testRelation) Blocks(engine.Snapshot) ([]engine.BlockInfo, error) {
+	return rel.blocks, nil
+}
+
+type noMetadataRelation struct {
+	engine.Relation
+}
+
+func (rel *noMetadataRelation) ID(engine.Snapshot) string {
+	return "t"
+}
+
+func TestMetadataScan(t *testing.T) {
+	rel := &testRelation{
+		blocks: []engine.BlockInfo{
+			{SegmentID: 1, BlockID: 2, Rows: 10, MinKey: int32(3), MaxKey: int32(12), Size: 40, OriginSize: 80},
+			{SegmentID: 1, BlockID: 3, Appendable: true, Rows: 2, MinKey: []byte("a"), MaxKey: []byte("b")},
+			{SegmentID: 4, BlockID: 5, Appendable: true},
+		},
+	}
+	r, err := NewMetadataScan(rel, nil)
+	require.NoError(t, err)
+	attrs := []string{"block_id", "appendable", "rows", "min_key", "max_key", "size"}
+	bat, err := r.Read(make([]uint64, len(attrs)), attrs)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(bat.Zs))
+	require.Equal(t, []uint64{2, 3, 5}, bat.Vecs[0].Col.([]uint64))
+	require.Equal(t, []bool{false, true, true}, bat.Vecs[1].Col.([]bool))
+	require.Equal(t, []int64{10, 2, 0}, bat.Vecs[2].Col.([]int64))
+	require.Equal(t, "3", string(bat.Vecs[3].Col.(*types.Bytes).Get(0)))
+	require.Equal(t, "b", string(bat.Vecs[4].Col.(*types.Bytes).Get(1)))
+	require.True(t, nulls.Contains(bat.Vecs[3].Nsp, 2))
+	require.Equal(t, []int64{40, 0, 0}, bat.Vecs[5].Col.([]int64))
+	bat, err = r.Read(make([]uint64, len(attrs)), attrs)
+	require.NoError(t, err)
+	require.Nil(t, bat)
+
+	// the relation without the metadata of the blocks
+	_, err = NewMetadataScan(&noMetadataRelation{}, nil)
+	require.Error(t, err)
+}
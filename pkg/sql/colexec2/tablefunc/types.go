@@ -0,0 +1,58 @@
+// Copyright 2021 - 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tablefunc
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+)
+
+const (
+	// BatchSize is the max count of the rows in a batch produced by a table function
+	BatchSize = 8192
+)
+
+// varcharType is the type of the text columns produced by the table functions
+var varcharType = types.Type{Oid: types.T_varchar, Size: 24, Width: math.MaxInt32}
+
+// emptyReader produces no rows, it is used when an argument of the table function is null
+type emptyReader struct{}
+
+func (emptyReader) Read([]uint64, []string) (*batch.Batch, error) {
+	return nil, nil
+}
+
+// newBatch returns the batch of n rows whose columns are picked from cols by the attributes
+func newBatch(refCnts []uint64, attrs []string, cols map[string]*vector.Vector, n int) (*batch.Batch, error) {
+	bat := batch.New(true, attrs)
+	for i, attr := range attrs {
+		vec, ok := cols[attr]
+		if !ok {
+			return nil, errors.New(errno.UndefinedColumn, fmt.Sprintf("column '%s' is not produced by the table function", attr))
+		}
+		if i < len(refCnts) {
+			vec.Ref = refCnts[i]
+		}
+		bat.Vecs[i] = vec
+	}
+	bat.InitZsOne(n)
+	return bat, nil
+}
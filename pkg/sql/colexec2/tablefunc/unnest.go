@@ -0,0 +1,82 @@
+// Copyright 2021 - 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tablefunc
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+)
+
+/*
+Unnest produces the elements of a json array as the rows of text, a string
+element is unquoted and a null element is a null value.
+*/
+type Unnest struct {
+	elems []bytejson.ByteJson
+}
+
+// NewUnnest parses the json array, the data is in the binary format if isJson is true, or the json text otherwise.
+func NewUnnest(data []byte, isJson bool) (*Unnest, error) {
+	var bj bytejson.ByteJson
+	var err error
+	if isJson {
+		bj, err = bytejson.Unmarshal(data)
+	} else {
+		bj, err = bytejson.ParseFromByteSlice(data)
+	}
+	if err != nil {
+		return nil, errors.New(errno.DataException, fmt.Sprintf("the argument of unnest is not a valid json: %v", err))
+	}
+	if bj.Type != bytejson.TpCodeArray {
+		return nil, errors.New(errno.DataException, fmt.Sprintf("the argument of unnest must be a json array, got '%s'", bj.String()))
+	}
+	r := &Unnest{
+		elems: make([]bytejson.ByteJson, bj.GetElemCount()),
+	}
+	for i := range r.elems {
+		r.elems[i] = bj.GetArrayElem(i)
+	}
+	return r, nil
+}
+
+func (r *Unnest) Read(refCnts []uint64, attrs []string) (*batch.Batch, error) {
+	if len(r.elems) == 0 {
+		return nil, nil
+	}
+	elems := r.elems
+	if len(elems) > BatchSize {
+		elems = elems[:BatchSize]
+	}
+	r.elems = r.elems[len(elems):]
+	vec := vector.New(varcharType)
+	vs := make([][]byte, len(elems))
+	for i, elem := range elems {
+		if elem.IsNull() {
+			nulls.Add(vec.Nsp, uint64(i))
+			continue
+		}
+		vs[i] = []byte(elem.Unquote())
+	}
+	if err := vector.Append(vec, vs); err != nil {
+		return nil, err
+	}
+	return newBatch(refCnts, attrs, map[string]*vector.Vector{"unnest": vec}, len(elems))
+}
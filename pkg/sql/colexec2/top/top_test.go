@@ -54,6 +54,8 @@ func init() {
 		newTestCase(mheap.New(gm), []types.Type{{Oid: types.T_int8}}, 3, []Field{{E: newExpression(0), Type: 0}}),
 		newTestCase(mheap.New(gm), []types.Type{{Oid: types.T_int8}}, 3, []Field{{E: newExpression(0), Type: 2}}),
 		newTestCase(mheap.New(gm), []types.Type{{Oid: types.T_int8}, {Oid: types.T_int64}}, 3, []Field{{E: newExpression(0), Type: 2}, {E: newExpression(1), Type: 0}}),
+		newTestCase(mheap.New(gm), []types.Type{{Oid: types.T_time}}, 3, []Field{{E: newExpression(0), Type: 0}}),
+		newTestCase(mheap.New(gm), []types.Type{{Oid: types.T_year}, {Oid: types.T_time}}, 3, []Field{{E: newExpression(0), Type: 2}, {E: newExpression(1), Type: 0}}),
 	}
 }
 
@@ -90,6 +92,29 @@ func TestTop(t *testing.T) {
 	}
 }
 
+func TestTopTimeAndYear(t *testing.T) {
+	hm := host.New(1 << 30)
+	gm := guest.New(1<<30, hm)
+	tc := newTestCase(mheap.New(gm), []types.Type{{Oid: types.T_year}, {Oid: types.T_time}}, 3, []Field{{E: newExpression(1), Type: 0}})
+	Prepare(tc.proc, tc.arg)
+	tc.proc.Reg.InputBatch = newBatch(t, tc.types, tc.proc, Rows)
+	Call(tc.proc, tc.arg)
+	tc.proc.Reg.InputBatch = nil
+	Call(tc.proc, tc.arg)
+	require.Equal(t, []types.Time{1, 2, 3}, tc.proc.Reg.InputBatch.Vecs[1].Col.([]types.Time))
+	tc.proc.Reg.InputBatch.Clean(tc.proc.Mp)
+
+	tc = newTestCase(mheap.New(gm), []types.Type{{Oid: types.T_year}}, 3, []Field{{E: newExpression(0), Type: 2}})
+	Prepare(tc.proc, tc.arg)
+	tc.proc.Reg.InputBatch = newBatch(t, tc.types, tc.proc, Rows)
+	Call(tc.proc, tc.arg)
+	tc.proc.Reg.InputBatch = nil
+	Call(tc.proc, tc.arg)
+	require.Equal(t, []types.YearValue{1911, 1910, 1909}, tc.proc.Reg.InputBatch.Vecs[0].Col.([]types.YearValue))
+	tc.proc.Reg.InputBatch.Clean(tc.proc.Mp)
+	require.Equal(t, int64(0), mheap.Size(tc.proc.Mp))
+}
+
 func BenchmarkTop(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		hm := host.New(1 << 30)
@@ -180,6 +205,24 @@ func newBatch(t *testing.T, ts []types.Type, proc *process.Process, rows int64)
 				vs[i] = types.Date(i)
 			}
 			vec.Col = vs
+		case types.T_time:
+			data, err := mheap.Alloc(proc.Mp, rows*8)
+			require.NoError(t, err)
+			vec.Data = data
+			vs := encoding.DecodeTimeSlice(vec.Data)[:rows]
+			for i := range vs {
+				vs[i] = types.Time(rows - int64(i))
+			}
+			vec.Col = vs
+		case types.T_year:
+			data, err := mheap.Alloc(proc.Mp, rows*2)
+			require.NoError(t, err)
+			vec.Data = data
+			vs := encoding.DecodeYearValueSlice(vec.Data)[:rows]
+			for i := range vs {
+				vs[i] = types.YearValue(1901 + rows - int64(i))
+			}
+			vec.Col = vs
 		case types.T_char, types.T_varchar:
 			size := 0
 			vs := make([][]byte, rows)
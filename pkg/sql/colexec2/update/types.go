@@ -0,0 +1,45 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package update
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	colexec "github.com/matrixorigin/matrixone/pkg/sql/colexec2"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+)
+
+type container struct {
+	bat *batch.Batch // rows collected from the input
+}
+
+// Argument of update, the input is the updated rows in the order of the table's
+// columns followed by the primary key of the old rows.
+type Argument struct {
+	Ts          uint64
+	TargetTable engine.Relation
+	// Attrs and Types are the columns of the table
+	Attrs []string
+	Types []types.Type
+	// PrimaryKey is the position of the primary key in the table's columns
+	PrimaryKey int
+	// UpdatePrimaryKey is true if the primary key is assigned by the statement
+	UpdatePrimaryKey bool
+	// Checks are the CHECK constraints of the table, checked on the updated rows
+	Checks []*colexec.Check
+	// AffectedRows is the number of rows updated
+	AffectedRows uint64
+	ctr          *container
+}
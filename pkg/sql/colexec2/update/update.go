@@ -0,0 +1,116 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package update
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	colexec "github.com/matrixorigin/matrixone/pkg/sql/colexec2"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func String(arg interface{}, buf *bytes.Buffer) {
+	ap := arg.(*Argument)
+	buf.WriteString(fmt.Sprintf("update(%v)", ap.Attrs))
+}
+
+func Prepare(_ *process.Process, arg interface{}) error {
+	ap := arg.(*Argument)
+	ap.ctr = new(container)
+	typs := make([]types.Type, 0, len(ap.Types)+1)
+	typs = append(typs, ap.Types...)
+	typs = append(typs, ap.Types[ap.PrimaryKey])
+	ap.ctr.bat = colexec.NewWriteBatch(nil, typs)
+	return nil
+}
+
+// Call collects all the rows of the input and updates them at the end,
+// so a row is never updated twice by the statement.
+func Call(proc *process.Process, arg interface{}) (bool, error) {
+	ap := arg.(*Argument)
+	bat := proc.Reg.InputBatch
+	if bat == nil {
+		defer ap.ctr.bat.Clean(proc.Mp)
+		return true, ap.ctr.update(ap, proc)
+	}
+	if len(bat.Zs) == 0 {
+		return false, nil
+	}
+	err := colexec.AppendBatch(ap.ctr.bat, bat, proc)
+	bat.Clean(proc.Mp)
+	proc.Reg.InputBatch = nil
+	return false, err
+}
+
+func (ctr *container) update(ap *Argument, proc *process.Process) error {
+	n := len(ap.Types)
+	oldPks := ctr.bat.Vecs[n]
+
+	// a row joined with several rows is updated once
+	sels := make([]int64, 0, len(ctr.bat.Zs))
+	seen := make(map[string]struct{})
+	for i := range ctr.bat.Zs {
+		key := colexec.ValueKey(oldPks, int64(i))
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		sels = append(sels, int64(i))
+	}
+	if len(sels) == 0 {
+		return nil
+	}
+
+	bat := batch.NewWithSize(n)
+	bat.Attrs = ap.Attrs
+	defer bat.Clean(proc.Mp)
+	for i := range bat.Vecs {
+		vec, err := colexec.CopyRows(ctr.bat.Vecs[i], sels, proc)
+		if err != nil {
+			return err
+		}
+		bat.Vecs[i] = vec
+	}
+	bat.InitZsOne(len(sels))
+	if err := colexec.CheckRows(bat, ap.Checks, proc); err != nil {
+		return err
+	}
+
+	snap := engine.Snapshot(proc.Snapshot)
+	if ap.UpdatePrimaryKey {
+		// the primary key is changed, so the old rows are deleted and the new rows are written
+		vec, err := colexec.CopyRows(oldPks, sels, proc)
+		if err != nil {
+			return err
+		}
+		err = ap.TargetTable.Delete(ap.Ts, vec, ap.Attrs[ap.PrimaryKey], snap)
+		vector.Clean(vec, proc.Mp)
+		if err != nil {
+			return err
+		}
+		if err := ap.TargetTable.Write(ap.Ts, bat, snap); err != nil {
+			return err
+		}
+	} else if err := ap.TargetTable.Update(ap.Ts, bat, snap); err != nil {
+		return err
+	}
+	ap.AffectedRows = uint64(len(sels))
+	return nil
+}
@@ -0,0 +1,131 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package update
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/stretchr/testify/require"
+)
+
+// testRelation records the vectors written into it
+type testRelation struct {
+	engine.Relation
+	written [][]*vector.Vector
+	updated [][]*vector.Vector
+	deleted []*vector.Vector
+}
+
+func (r *testRelation) Write(_ uint64, bat *batch.Batch, _ engine.Snapshot) error {
+	r.written = append(r.written, append([]*vector.Vector{}, bat.Vecs...))
+	return nil
+}
+
+func (r *testRelation) Update(_ uint64, bat *batch.Batch, _ engine.Snapshot) error {
+	r.updated = append(r.updated, append([]*vector.Vector{}, bat.Vecs...))
+	return nil
+}
+
+func (r *testRelation) Delete(_ uint64, vec *vector.Vector, _ string, _ engine.Snapshot) error {
+	r.deleted = append(r.deleted, vec)
+	return nil
+}
+
+func newProcess() *process.Process {
+	hm := host.New(1 << 30)
+	gm := guest.New(1<<30, hm)
+	return process.New(mheap.New(gm))
+}
+
+// newBatch returns a batch of (a, b, old a)
+func newBatch(t *testing.T, cols [][]int64) *batch.Batch {
+	bat := batch.NewWithSize(len(cols))
+	for i, col := range cols {
+		bat.Vecs[i] = vector.New(types.Type{Oid: types.T_int64})
+		require.NoError(t, vector.Append(bat.Vecs[i], col))
+	}
+	bat.InitZsOne(len(cols[0]))
+	return bat
+}
+
+func TestString(t *testing.T) {
+	buf := new(bytes.Buffer)
+	String(&Argument{Attrs: []string{"a", "b"}}, buf)
+	require.Equal(t, "update([a b])", buf.String())
+}
+
+func TestUpdate(t *testing.T) {
+	proc := newProcess()
+	rel := &testRelation{}
+	arg := &Argument{
+		TargetTable: rel,
+		Attrs:       []string{"a", "b"},
+		Types:       []types.Type{{Oid: types.T_int64}, {Oid: types.T_int64}},
+	}
+	require.NoError(t, Prepare(proc, arg))
+
+	// the row 2 is joined twice and is updated once
+	proc.Reg.InputBatch = newBatch(t, [][]int64{{1, 2}, {10, 20}, {1, 2}})
+	_, err := Call(proc, arg)
+	require.NoError(t, err)
+	proc.Reg.InputBatch = newBatch(t, [][]int64{{2}, {21}, {2}})
+	_, err = Call(proc, arg)
+	require.NoError(t, err)
+	proc.Reg.InputBatch = nil
+	end, err := Call(proc, arg)
+	require.NoError(t, err)
+	require.True(t, end)
+
+	require.Equal(t, uint64(2), arg.AffectedRows)
+	require.Equal(t, 1, len(rel.updated))
+	require.Equal(t, []int64{1, 2}, rel.updated[0][0].Col)
+	require.Equal(t, []int64{10, 20}, rel.updated[0][1].Col)
+	require.Equal(t, 0, len(rel.written))
+}
+
+func TestUpdatePrimaryKey(t *testing.T) {
+	proc := newProcess()
+	rel := &testRelation{}
+	arg := &Argument{
+		TargetTable:      rel,
+		Attrs:            []string{"a", "b"},
+		Types:            []types.Type{{Oid: types.T_int64}, {Oid: types.T_int64}},
+		UpdatePrimaryKey: true,
+	}
+	require.NoError(t, Prepare(proc, arg))
+
+	proc.Reg.InputBatch = newBatch(t, [][]int64{{11, 12}, {10, 20}, {1, 2}})
+	_, err := Call(proc, arg)
+	require.NoError(t, err)
+	proc.Reg.InputBatch = nil
+	_, err = Call(proc, arg)
+	require.NoError(t, err)
+
+	require.Equal(t, uint64(2), arg.AffectedRows)
+	require.Equal(t, 1, len(rel.deleted))
+	require.Equal(t, []int64{1, 2}, rel.deleted[0].Col)
+	require.Equal(t, 1, len(rel.written))
+	require.Equal(t, []int64{11, 12}, rel.written[0][0].Col)
+	require.Equal(t, 0, len(rel.updated))
+}
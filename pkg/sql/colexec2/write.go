@@ -0,0 +1,227 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package colexec2
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// NewWriteBatch returns an empty batch with the columns of a table, it is used to
+// collect the rows written into the table.
+func NewWriteBatch(attrs []string, typs []types.Type) *batch.Batch {
+	bat := batch.NewWithSize(len(typs))
+	bat.Attrs = attrs
+	for i, typ := range typs {
+		bat.Vecs[i] = vector.New(typ)
+	}
+	return bat
+}
+
+// AppendVector appends the rows of vec to v, the constant vector is expanded
+// and the values are converted to the type of v.
+func AppendVector(v, vec *vector.Vector, rows int, proc *process.Process) error {
+	if vec.IsConst && nulls.Contains(vec.Nsp, 0) {
+		for i := 0; i < rows; i++ {
+			if err := vector.UnionNull(v, v, proc.Mp); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	src, err := convertVector(vec, v.Typ, proc)
+	if err != nil {
+		return err
+	}
+	if src != vec {
+		defer vector.Clean(src, proc.Mp)
+	}
+	for i := 0; i < rows; i++ {
+		sel := int64(i)
+		if vec.IsConst {
+			sel = 0
+		}
+		if err := vector.UnionOne(v, src, sel, proc.Mp); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CopyRows returns a new vector holding the rows sels of vec
+func CopyRows(vec *vector.Vector, sels []int64, proc *process.Process) (*vector.Vector, error) {
+	rv := vector.New(vec.Typ)
+	for _, sel := range sels {
+		if err := vector.UnionOne(rv, vec, sel, proc.Mp); err != nil {
+			vector.Clean(rv, proc.Mp)
+			return nil, err
+		}
+	}
+	return rv, nil
+}
+
+// ValueKey returns a string that identifies the value of a row, it is used to
+// find the duplicate keys. The key of a fixed-size value is its raw bytes.
+func ValueKey(vec *vector.Vector, row int64) string {
+	switch vs := vec.Col.(type) {
+	case *types.Bytes:
+		return string(vs.Get(row))
+	case []bool:
+		return fixedKey(vs[row])
+	case []int8:
+		return fixedKey(vs[row])
+	case []int16:
+		return fixedKey(vs[row])
+	case []int32:
+		return fixedKey(vs[row])
+	case []int64:
+		return fixedKey(vs[row])
+	case []uint8:
+		return fixedKey(vs[row])
+	case []uint16:
+		return fixedKey(vs[row])
+	case []uint32:
+		return fixedKey(vs[row])
+	case []uint64:
+		return fixedKey(vs[row])
+	case []float32:
+		if vs[row] == 0 { // -0 equals 0
+			return fixedKey(float32(0))
+		}
+		return fixedKey(vs[row])
+	case []float64:
+		if vs[row] == 0 {
+			return fixedKey(float64(0))
+		}
+		return fixedKey(vs[row])
+	case []types.Date:
+		return fixedKey(vs[row])
+	case []types.Datetime:
+		return fixedKey(vs[row])
+	case []types.Timestamp:
+		return fixedKey(vs[row])
+	case []types.Time:
+		return fixedKey(vs[row])
+	case []types.YearValue:
+		return fixedKey(vs[row])
+	case []types.Decimal64:
+		return fixedKey(vs[row])
+	case []types.Decimal128:
+		return fixedKey(vs[row])
+	case []types.Uuid:
+		return fixedKey(vs[row])
+	}
+	panic(fmt.Sprintf("unexpected type %v of the key", vec.Typ))
+}
+
+// fixedKey returns the raw bytes of v as a key
+func fixedKey[T any](v T) string {
+	return string(encoding.EncodeFixed(v))
+}
+
+func isBytesType(oid types.T) bool {
+	switch oid {
+	case types.T_char, types.T_varchar, types.T_text, types.T_blob:
+		return true
+	}
+	return false
+}
+
+// convertVector returns the vector converted to typ, vec itself is returned if
+// the conversion is not needed.
+func convertVector(vec *vector.Vector, typ types.Type, proc *process.Process) (*vector.Vector, error) {
+	if vec.Typ.Oid == typ.Oid || (isBytesType(vec.Typ.Oid) && isBytesType(typ.Oid)) {
+		return vec, nil
+	}
+	if isBytesType(vec.Typ.Oid) {
+		switch typ.Oid {
+		case types.T_json, types.T_uuid:
+			return parseVector(vec, typ)
+		}
+	}
+	// the vector must not be released by the cast
+	vec.Ref++
+	defer func() { vec.Ref-- }()
+	rv, err := overload.BinaryEval(overload.Typecast, vec.Typ.Oid, typ.Oid, vec.IsConst, false, vec, vector.New(typ), proc)
+	if err != nil {
+		return nil, errors.New(errno.DatatypeMismatch, fmt.Sprintf("cannot convert '%v' to '%v': %v", vec.Typ, typ, err))
+	}
+	rv.Typ = typ
+	return rv, nil
+}
+
+// parseVector parses the strings of vec to the values of typ
+func parseVector(vec *vector.Vector, typ types.Type) (*vector.Vector, error) {
+	vs := vec.Col.(*types.Bytes)
+	rv := vector.New(typ)
+	nulls.Set(rv.Nsp, vec.Nsp)
+	switch typ.Oid {
+	case types.T_json:
+		rs := make([][]byte, len(vs.Offsets))
+		for i := range rs {
+			if nulls.Contains(vec.Nsp, uint64(i)) {
+				continue
+			}
+			bj, err := bytejson.ParseFromByteSlice(vs.Get(int64(i)))
+			if err != nil {
+				return nil, errors.New(errno.DataException, fmt.Sprintf("invalid json text '%s'", vs.Get(int64(i))))
+			}
+			rs[i] = bj.Marshal()
+		}
+		col := &types.Bytes{}
+		if err := col.Append(rs); err != nil {
+			return nil, err
+		}
+		rv.Col = col
+	case types.T_uuid:
+		rs := make([]types.Uuid, len(vs.Offsets))
+		for i := range rs {
+			if nulls.Contains(vec.Nsp, uint64(i)) {
+				continue
+			}
+			u, err := types.ParseUuid(string(vs.Get(int64(i))))
+			if err != nil {
+				return nil, errors.New(errno.DataException, fmt.Sprintf("invalid uuid '%s'", vs.Get(int64(i))))
+			}
+			rs[i] = u
+		}
+		rv.Col = rs
+	}
+	return rv, nil
+}
+
+// AppendBatch appends the rows of bat to the write batch dst
+func AppendBatch(dst, bat *batch.Batch, proc *process.Process) error {
+	rows := len(bat.Zs)
+	for i, vec := range dst.Vecs {
+		if err := AppendVector(vec, bat.Vecs[i], rows, proc); err != nil {
+			return err
+		}
+	}
+	for range bat.Zs {
+		dst.Zs = append(dst.Zs, 1)
+	}
+	return nil
+}
@@ -378,6 +378,7 @@ func (e *Exec) compilePlanScope(s *plan.Scope) (*Scope, error) {
 		rs.Proc.Cancel = cancel
 		rs.Proc.Id = e.c.proc.Id
 		rs.Proc.Lim = e.c.proc.Lim
+		rs.Proc.TimeZone = e.c.proc.TimeZone
 		rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, len(ss))
 		{
 			for i := 0; i < len(ss); i++ {
@@ -557,6 +558,7 @@ func (e *Exec) compileQ(ps *plan.Scope) ([]*Scope, error) {
 		rs.Proc.Cancel = cancel
 		rs.Proc.Id = e.c.proc.Id
 		rs.Proc.Lim = e.c.proc.Lim
+		rs.Proc.TimeZone = e.c.proc.TimeZone
 		rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, len(ss))
 		{
 			for i := 0; i < len(ss); i++ {
@@ -609,6 +611,7 @@ func (e *Exec) compileQ(ps *plan.Scope) ([]*Scope, error) {
 		rs.Proc.Cancel = cancel
 		rs.Proc.Id = e.c.proc.Id
 		rs.Proc.Lim = e.c.proc.Lim
+		rs.Proc.TimeZone = e.c.proc.TimeZone
 		rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, len(ss))
 		{
 			for i := 0; i < len(ss); i++ {
@@ -676,6 +679,7 @@ func (e *Exec) compileQ(ps *plan.Scope) ([]*Scope, error) {
 		rs.Proc.Cancel = cancel
 		rs.Proc.Id = e.c.proc.Id
 		rs.Proc.Lim = e.c.proc.Lim
+		rs.Proc.TimeZone = e.c.proc.TimeZone
 		rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, len(ss))
 		{
 			for i := 0; i < len(ss); i++ {
@@ -722,6 +726,7 @@ func (e *Exec) compileQ(ps *plan.Scope) ([]*Scope, error) {
 		rs.Proc.Cancel = cancel
 		rs.Proc.Id = e.c.proc.Id
 		rs.Proc.Lim = e.c.proc.Lim
+		rs.Proc.TimeZone = e.c.proc.TimeZone
 		rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, len(ss))
 		{
 			for i := 0; i < len(ss); i++ {
@@ -798,6 +803,7 @@ func (e *Exec) compileQ(ps *plan.Scope) ([]*Scope, error) {
 			ss[i].Proc = process.New(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)))
 			ss[i].Proc.Id = e.c.proc.Id
 			ss[i].Proc.Lim = e.c.proc.Lim
+			ss[i].Proc.TimeZone = e.c.proc.TimeZone
 			ss[i].Instructions = append(ss[i].Instructions, vm.Instruction{
 				Op:  vm.Transform,
 				Arg: constructBareTransform(op),
@@ -824,6 +830,7 @@ func (e *Exec) compileQ(ps *plan.Scope) ([]*Scope, error) {
 		rs.Proc.Cancel = cancel
 		rs.Proc.Id = e.c.proc.Id
 		rs.Proc.Lim = e.c.proc.Lim
+		rs.Proc.TimeZone = e.c.proc.TimeZone
 		rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, 1)
 		rs.Proc.Reg.MergeReceivers[0] = &process.WaitRegister{
 			Ctx: ctx,
@@ -879,6 +886,7 @@ func (e *Exec) compileQ(ps *plan.Scope) ([]*Scope, error) {
 		rs.Proc.Cancel = cancel
 		rs.Proc.Id = e.c.proc.Id
 		rs.Proc.Lim = e.c.proc.Lim
+		rs.Proc.TimeZone = e.c.proc.TimeZone
 		rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, len(ss))
 		{
 			for i := 0; i < len(ss); i++ {
@@ -1002,6 +1010,7 @@ func (e *Exec) compileAQ(ps *plan.Scope) (*Scope, error) {
 		rs.Proc.Cancel = cancel
 		rs.Proc.Id = e.c.proc.Id
 		rs.Proc.Lim = e.c.proc.Lim
+		rs.Proc.TimeZone = e.c.proc.TimeZone
 		rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, 1)
 		rs.Proc.Reg.MergeReceivers[0] = &process.WaitRegister{
 			Ctx: ctx,
@@ -1048,6 +1057,7 @@ func (e *Exec) compileAQ(ps *plan.Scope) (*Scope, error) {
 			ss[i].Proc = process.New(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)))
 			ss[i].Proc.Id = e.c.proc.Id
 			ss[i].Proc.Lim = e.c.proc.Lim
+			ss[i].Proc.TimeZone = e.c.proc.TimeZone
 			ss[i].Instructions = append(ss[i].Instructions, vm.Instruction{
 				Op:  vm.Transform,
 				Arg: constructTransform(op),
@@ -1065,6 +1075,7 @@ func (e *Exec) compileAQ(ps *plan.Scope) (*Scope, error) {
 		rs.Proc.Cancel = cancel
 		rs.Proc.Id = e.c.proc.Id
 		rs.Proc.Lim = e.c.proc.Lim
+		rs.Proc.TimeZone = e.c.proc.TimeZone
 		rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, len(ss))
 		{
 			for i := 0; i < len(ss); i++ {
@@ -1104,6 +1115,7 @@ func (e *Exec) compileAQ(ps *plan.Scope) (*Scope, error) {
 		rs.Proc.Cancel = cancel
 		rs.Proc.Id = e.c.proc.Id
 		rs.Proc.Lim = e.c.proc.Lim
+		rs.Proc.TimeZone = e.c.proc.TimeZone
 		rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, 1)
 		rs.Proc.Reg.MergeReceivers[0] = &process.WaitRegister{
 			Ctx: ctx,
@@ -1191,6 +1203,7 @@ func (e *Exec) compileCQ(ps *plan.Scope) (*Scope, error) {
 		rs.Proc.Cancel = cancel
 		rs.Proc.Id = e.c.proc.Id
 		rs.Proc.Lim = e.c.proc.Lim
+		rs.Proc.TimeZone = e.c.proc.TimeZone
 		rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, len(ss))
 		{
 			for i := 0; i < len(ss); i++ {
@@ -1234,6 +1247,7 @@ func (e *Exec) compileCQ(ps *plan.Scope) (*Scope, error) {
 		rs.Proc.Cancel = cancel
 		rs.Proc.Id = e.c.proc.Id
 		rs.Proc.Lim = e.c.proc.Lim
+		rs.Proc.TimeZone = e.c.proc.TimeZone
 		rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, 1)
 		{
 			rs.Proc.Reg.MergeReceivers[0] = &process.WaitRegister{
@@ -1273,6 +1287,7 @@ func (e *Exec) compileCQ(ps *plan.Scope) (*Scope, error) {
 		rs.Proc.Cancel = cancel
 		rs.Proc.Id = e.c.proc.Id
 		rs.Proc.Lim = e.c.proc.Lim
+		rs.Proc.TimeZone = e.c.proc.TimeZone
 		rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, 1)
 		{
 			rs.Proc.Reg.MergeReceivers[0] = &process.WaitRegister{
@@ -1312,6 +1327,7 @@ func (e *Exec) compileCQ(ps *plan.Scope) (*Scope, error) {
 		rs.Proc.Cancel = cancel
 		rs.Proc.Id = e.c.proc.Id
 		rs.Proc.Lim = e.c.proc.Lim
+		rs.Proc.TimeZone = e.c.proc.TimeZone
 		rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, 1)
 		{
 			rs.Proc.Reg.MergeReceivers[0] = &process.WaitRegister{
@@ -1351,6 +1367,7 @@ func (e *Exec) compileCQ(ps *plan.Scope) (*Scope, error) {
 		rs.Proc.Cancel = cancel
 		rs.Proc.Id = e.c.proc.Id
 		rs.Proc.Lim = e.c.proc.Lim
+		rs.Proc.TimeZone = e.c.proc.TimeZone
 		rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, 1)
 		{
 			rs.Proc.Reg.MergeReceivers[0] = &process.WaitRegister{
@@ -1519,6 +1536,7 @@ func (e *Exec) compileCAQ(ps *plan.Scope) (*Scope, error) {
 		rs.Proc.Cancel = cancel
 		rs.Proc.Id = e.c.proc.Id
 		rs.Proc.Lim = e.c.proc.Lim
+		rs.Proc.TimeZone = e.c.proc.TimeZone
 		rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, len(ss))
 		{
 			for i := 0; i < len(ss); i++ {
@@ -1641,6 +1659,7 @@ func (e *Exec) compileFact(ps *plan.Scope) ([]*Scope, error) {
 			ss[i].Proc = process.New(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)))
 			ss[i].Proc.Id = e.c.proc.Id
 			ss[i].Proc.Lim = e.c.proc.Lim
+			ss[i].Proc.TimeZone = e.c.proc.TimeZone
 			ss[i].Instructions = append(ss[i].Instructions, vm.Instruction{
 				Op:  vm.Transform,
 				Arg: constructBareTransform(op),
@@ -1673,6 +1692,7 @@ func (e *Exec) compileFact(ps *plan.Scope) ([]*Scope, error) {
 		rs.Proc.Cancel = cancel
 		rs.Proc.Id = e.c.proc.Id
 		rs.Proc.Lim = e.c.proc.Lim
+		rs.Proc.TimeZone = e.c.proc.TimeZone
 		rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, 1)
 		rs.Proc.Reg.MergeReceivers[0] = &process.WaitRegister{
 			Ctx: ctx,
@@ -1761,6 +1781,7 @@ func (e *Exec) compileCAQFact(ps *plan.Scope) ([]*Scope, error) {
 			ss[i].Proc = process.New(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)))
 			ss[i].Proc.Id = e.c.proc.Id
 			ss[i].Proc.Lim = e.c.proc.Lim
+			ss[i].Proc.TimeZone = e.c.proc.TimeZone
 			ss[i].Instructions = append(ss[i].Instructions, vm.Instruction{
 				Op:  vm.Transform,
 				Arg: constructCAQTransform(op),
@@ -1793,6 +1814,7 @@ func (e *Exec) compileCAQFact(ps *plan.Scope) ([]*Scope, error) {
 		rs.Proc.Cancel = cancel
 		rs.Proc.Id = e.c.proc.Id
 		rs.Proc.Lim = e.c.proc.Lim
+		rs.Proc.TimeZone = e.c.proc.TimeZone
 		rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, 1)
 		rs.Proc.Reg.MergeReceivers[0] = &process.WaitRegister{
 			Ctx: ctx,
@@ -743,6 +743,7 @@ func (s *Scope) RunQ(e engine.Engine) error {
 		ss[i].Proc = process.New(mheap.New(guest.New(s.Proc.Mp.Gm.Limit, s.Proc.Mp.Gm.Mmu)))
 		ss[i].Proc.Id = s.Proc.Id
 		ss[i].Proc.Lim = s.Proc.Lim
+		ss[i].Proc.TimeZone = s.Proc.TimeZone
 	}
 	{
 		var flg bool
@@ -911,6 +912,7 @@ func (s *Scope) RunAQ(e engine.Engine) error {
 		ss[i].Proc = process.New(mheap.New(guest.New(s.Proc.Mp.Gm.Limit, s.Proc.Mp.Gm.Mmu)))
 		ss[i].Proc.Id = s.Proc.Id
 		ss[i].Proc.Lim = s.Proc.Lim
+		ss[i].Proc.TimeZone = s.Proc.TimeZone
 	}
 	if len(ss) > 3 {
 		ss = newMergeScope(ss, arg.Typ, s.Proc)
@@ -1110,6 +1112,7 @@ func (s *Scope) RunCQ(e engine.Engine, op *join.Argument) error {
 		ss[i].Proc = process.New(mheap.New(guest.New(s.Proc.Mp.Gm.Limit, s.Proc.Mp.Gm.Mmu)))
 		ss[i].Proc.Id = s.Proc.Id
 		ss[i].Proc.Lim = s.Proc.Lim
+		ss[i].Proc.TimeZone = s.Proc.TimeZone
 		{
 			for _, in := range s.Instructions {
 				ss[i].Instructions = append(ss[i].Instructions, dupInstruction(in))
@@ -1141,6 +1144,7 @@ func (s *Scope) RunCQ(e engine.Engine, op *join.Argument) error {
 	rs.Proc.Cancel = cancel
 	rs.Proc.Id = s.Proc.Id
 	rs.Proc.Lim = s.Proc.Lim
+	rs.Proc.TimeZone = s.Proc.TimeZone
 	rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, len(ss))
 	{
 		for i := 0; i < len(ss); i++ {
@@ -1473,6 +1477,7 @@ func (s *Scope) RunCAQ(e engine.Engine, op *times.Argument) error {
 		ss[i].Proc = process.New(mheap.New(guest.New(s.Proc.Mp.Gm.Limit, s.Proc.Mp.Gm.Mmu)))
 		ss[i].Proc.Id = s.Proc.Id
 		ss[i].Proc.Lim = s.Proc.Lim
+		ss[i].Proc.TimeZone = s.Proc.TimeZone
 		{
 			for _, in := range s.Instructions {
 				ss[i].Instructions = append(ss[i].Instructions, dupInstruction(in))
@@ -1523,6 +1528,7 @@ func (s *Scope) RunCAQ(e engine.Engine, op *times.Argument) error {
 	rs.Proc.Cancel = cancel
 	rs.Proc.Id = s.Proc.Id
 	rs.Proc.Lim = s.Proc.Lim
+	rs.Proc.TimeZone = s.Proc.TimeZone
 	rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, len(ss))
 	{
 		for i := 0; i < len(ss); i++ {
@@ -1718,6 +1724,7 @@ func newMergeScope(ss []*Scope, typ int, proc *process.Process) []*Scope {
 			rs[i].Proc.Cancel = cancel
 			rs[i].Proc.Id = proc.Id
 			rs[i].Proc.Lim = proc.Lim
+			rs[i].Proc.TimeZone = proc.TimeZone
 			rs[i].Proc.Reg.MergeReceivers = make([]*process.WaitRegister, m)
 			{
 				for j := 0; j < m; j++ {
@@ -1772,6 +1779,7 @@ func newMergeOrderScope(ss []*Scope, arg *mergeorder.Argument, proc *process.Pro
 			rs[i].Proc.Cancel = cancel
 			rs[i].Proc.Id = proc.Id
 			rs[i].Proc.Lim = proc.Lim
+			rs[i].Proc.TimeZone = proc.TimeZone
 			rs[i].Proc.Reg.MergeReceivers = make([]*process.WaitRegister, m)
 			{
 				for j := 0; j < m; j++ {
@@ -1826,6 +1834,7 @@ func newMergeLimitScope(ss []*Scope, limit uint64, proc *process.Process) []*Sco
 			rs[i].Proc.Cancel = cancel
 			rs[i].Proc.Id = proc.Id
 			rs[i].Proc.Lim = proc.Lim
+			rs[i].Proc.TimeZone = proc.TimeZone
 			rs[i].Proc.Reg.MergeReceivers = make([]*process.WaitRegister, m)
 			{
 				for j := 0; j < m; j++ {
@@ -1883,6 +1892,7 @@ func newMergeTopScope(ss []*Scope, arg *mergetop.Argument, proc *process.Process
 			rs[i].Proc.Cancel = cancel
 			rs[i].Proc.Id = proc.Id
 			rs[i].Proc.Lim = proc.Lim
+			rs[i].Proc.TimeZone = proc.TimeZone
 			rs[i].Proc.Reg.MergeReceivers = make([]*process.WaitRegister, m)
 			{
 				for j := 0; j < m; j++ {
@@ -1937,6 +1947,7 @@ func newMergeDedupScope(ss []*Scope, proc *process.Process) []*Scope {
 			rs[i].Proc.Cancel = cancel
 			rs[i].Proc.Id = proc.Id
 			rs[i].Proc.Lim = proc.Lim
+			rs[i].Proc.TimeZone = proc.TimeZone
 			rs[i].Proc.Reg.MergeReceivers = make([]*process.WaitRegister, m)
 			{
 				for j := 0; j < m; j++ {
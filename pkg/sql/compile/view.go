@@ -273,7 +273,7 @@ func constructView(bat *batch.Batch, vars []string) {
 						}
 					}
 				}
-			case types.T_char, types.T_varchar:
+			case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 				vs := vecs[j].Col.(*types.Bytes)
 				if !nulls.Any(vecs[j].Nsp) {
 					for k := int64(0); k < n; k++ {
@@ -1074,7 +1074,7 @@ func constructViewWithOneVar(bat *batch.Batch, fvar string) {
 			}
 		}
 		bat.Ht = ht
-	case types.T_char, types.T_varchar:
+	case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 		ht := &join.HashTable{
 			StrHashMap: &hashtable.StringHashMap{},
 		}
@@ -17,15 +17,28 @@ package compile2
 import (
 	"context"
 	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/errno"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	colexec "github.com/matrixorigin/matrixone/pkg/sql/colexec2"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/connector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/deletion"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/external"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/insert"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/merge"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/output"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/tablefunc"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/update"
 	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan2"
+	"github.com/matrixorigin/matrixone/pkg/sql/spool"
 	"github.com/matrixorigin/matrixone/pkg/vm"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
@@ -40,8 +53,8 @@ func InitAddress(addr string) {
 
 // New is used to new an object of compile
 func New(db string, sql string, uid string,
-	e engine.Engine, proc *process.Process) *compile {
-	return &compile{
+	e engine.Engine, proc *process.Process) *Compile {
+	return &Compile{
 		e:    e,
 		db:   db,
 		uid:  uid,
@@ -52,7 +65,7 @@ func New(db string, sql string, uid string,
 
 // Compile is the entrance of the compute-layer, it compiles AST tree to scope list.
 // A scope is an execution unit.
-func (c *compile) Compile(pn *plan.Plan, u interface{}, fill func(interface{}, *batch.Batch) error) (err error) {
+func (c *Compile) Compile(pn *plan.Plan, u interface{}, fill func(interface{}, *batch.Batch) error) (err error) {
 	defer func() {
 		if e := recover(); e != nil {
 			err = moerr.NewPanicError(e)
@@ -71,7 +84,7 @@ func (c *compile) Compile(pn *plan.Plan, u interface{}, fill func(interface{}, *
 }
 
 // Run is an important function of the compute-layer, it executes a single sql according to its scope
-func (c *compile) Run(ts uint64) (err error) {
+func (c *Compile) Run(ts uint64) (err error) {
 	defer func() {
 		if e := recover(); e != nil {
 			err = moerr.NewPanicError(e)
@@ -81,6 +94,14 @@ func (c *compile) Run(ts uint64) (err error) {
 	if c.scope == nil {
 		return nil
 	}
+	defer func() {
+		for _, m := range c.materials {
+			if ferr := m.spool.Free(); err == nil {
+				err = ferr
+			}
+		}
+	}()
+	c.setTs(ts)
 
 	PrintScope(nil, []*Scope{c.scope})
 
@@ -103,11 +124,58 @@ func (c *compile) Run(ts uint64) (err error) {
 		return c.scope.CreateIndex(ts, c.proc.Snapshot, c.e)
 	case DropIndex:
 		return c.scope.DropIndex(ts, c.proc.Snapshot, c.e)
+	case RefreshMaterializedView:
+		return c.scope.RefreshMaterializedView(ts, c.proc.Snapshot, c.e)
 	}
 	return nil
 }
 
-func (c *compile) compileScope(pn *plan.Plan) (*Scope, error) {
+// GetAffectedRows returns the number of rows written by an insert, update or delete
+func (c *Compile) GetAffectedRows() uint64 {
+	if c.scope == nil {
+		return 0
+	}
+	for _, in := range c.scope.Instructions {
+		switch arg := in.Arg.(type) {
+		case *insert.Argument:
+			return arg.AffectedRows
+		case *update.Argument:
+			return arg.AffectedRows
+		case *deletion.Argument:
+			return arg.AffectedRows
+		}
+	}
+	return 0
+}
+
+// GetLastInsertId returns the first auto increment value generated by an insert
+func (c *Compile) GetLastInsertId() uint64 {
+	if c.scope == nil {
+		return 0
+	}
+	for _, in := range c.scope.Instructions {
+		if arg, ok := in.Arg.(*insert.Argument); ok {
+			return arg.LastInsertId
+		}
+	}
+	return 0
+}
+
+// setTs sets the timestamp of the instruction writing the rows
+func (c *Compile) setTs(ts uint64) {
+	for _, in := range c.scope.Instructions {
+		switch arg := in.Arg.(type) {
+		case *insert.Argument:
+			arg.Ts = ts
+		case *update.Argument:
+			arg.Ts = ts
+		case *deletion.Argument:
+			arg.Ts = ts
+		}
+	}
+}
+
+func (c *Compile) compileScope(pn *plan.Plan) (*Scope, error) {
 	switch qry := pn.Plan.(type) {
 	case *plan.Plan_Query:
 		return c.compileQuery(qry.Query)
@@ -143,16 +211,33 @@ func (c *compile) compileScope(pn *plan.Plan) (*Scope, error) {
 				Magic: DropIndex,
 				Plan:  pn,
 			}, nil
+		case plan.DataDefinition_REFRESH_MATERIALIZEDVIEW:
+			return &Scope{
+				Magic: RefreshMaterializedView,
+				Plan:  pn,
+			}, nil
 		}
 	}
 	return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("query '%s' not support now", pn))
 }
 
-func (c *compile) compileQuery(qry *plan.Query) (*Scope, error) {
-	if len(qry.Steps) != 1 {
+func (c *Compile) compileQuery(qry *plan.Query) (*Scope, error) {
+	if len(qry.Steps) == 0 {
 		return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("query '%s' not support now", qry))
 	}
-	ss, err := c.compilePlanScope(qry.Nodes[qry.Steps[0]], qry.Nodes)
+	// the steps before the last one are the ctes and the subqueries of the query
+	c.materials = make(map[int32]*Material)
+	c.joinFilters = make(map[int32][]*engine.RuntimeFilter)
+	c.scanFilters = make(map[int32][]*engine.RuntimeFilter)
+	for _, step := range qry.Steps[:len(qry.Steps)-1] {
+		switch n := qry.Nodes[step]; n.NodeType {
+		case plan.Node_MATERIAL, plan.Node_SINK, plan.Node_RECURSIVE_CTE:
+		default:
+			return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("query '%s' not support now", qry))
+		}
+	}
+	root := qry.Nodes[qry.Steps[len(qry.Steps)-1]]
+	ss, err := c.compilePlanScope(root, qry.Nodes)
 	if err != nil {
 		return nil, err
 	}
@@ -166,19 +251,29 @@ func (c *compile) compileQuery(qry *plan.Query) (*Scope, error) {
 	rs.Proc.Id = c.proc.Id
 	rs.Proc.Lim = c.proc.Lim
 	rs.Proc.UnixTime = c.proc.UnixTime
+	rs.Proc.TimeZone = c.proc.TimeZone
 	rs.Proc.Snapshot = c.proc.Snapshot
 	rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, len(ss))
 	rs.Instructions = append(rs.Instructions, vm.Instruction{
 		Op:  overload.Merge,
 		Arg: &merge.Argument{},
 	})
-	rs.Instructions = append(rs.Instructions, vm.Instruction{
-		Op: overload.Output,
-		Arg: &output.Argument{
-			Data: c.u,
-			Func: c.fill,
-		},
-	})
+	switch root.NodeType {
+	case plan.Node_INSERT, plan.Node_UPDATE, plan.Node_DELETE:
+		in, err := c.compileWrite(root, qry.Nodes)
+		if err != nil {
+			return nil, err
+		}
+		rs.Instructions = append(rs.Instructions, in)
+	default:
+		rs.Instructions = append(rs.Instructions, vm.Instruction{
+			Op: overload.Output,
+			Arg: &output.Argument{
+				Data: c.u,
+				Func: c.fill,
+			},
+		})
+	}
 	{
 		for i := 0; i < len(ss); i++ {
 			rs.Proc.Reg.MergeReceivers[i] = &process.WaitRegister{
@@ -199,9 +294,14 @@ func (c *compile) compileQuery(qry *plan.Query) (*Scope, error) {
 	return rs, nil
 }
 
-func (c *compile) compilePlanScope(n *plan.Node, ns []*plan.Node) ([]*Scope, error) {
+func (c *Compile) compilePlanScope(n *plan.Node, ns []*plan.Node) ([]*Scope, error) {
 	switch n.NodeType {
-	//	case plan.Node_VALUE_SCAN:
+	case plan.Node_VALUE_SCAN:
+		bat, err := constructValueScan(n, c.proc)
+		if err != nil {
+			return nil, err
+		}
+		return c.compileProjection(n, []*Scope{c.constructBatchScope(bat)}), nil
 	case plan.Node_TABLE_SCAN:
 		snap := engine.Snapshot(c.proc.Snapshot)
 		db, err := c.e.Database(n.ObjRef.SchemaName, snap)
@@ -213,9 +313,10 @@ func (c *compile) compilePlanScope(n *plan.Node, ns []*plan.Node) ([]*Scope, err
 			return nil, err
 		}
 		src := &Source{
-			RelationName: n.TableDef.Name,
-			SchemaName:   n.ObjRef.SchemaName,
-			Attributes:   make([]string, len(n.TableDef.Cols)),
+			RelationName:   n.TableDef.Name,
+			SchemaName:     n.ObjRef.SchemaName,
+			Attributes:     make([]string, len(n.TableDef.Cols)),
+			RuntimeFilters: c.scanFilters[n.NodeId],
 		}
 		for i, col := range n.TableDef.Cols {
 			src.Attributes[i] = col.Name
@@ -232,9 +333,48 @@ func (c *compile) compilePlanScope(n *plan.Node, ns []*plan.Node) ([]*Scope, err
 			ss[i].Proc.Id = c.proc.Id
 			ss[i].Proc.Lim = c.proc.Lim
 			ss[i].Proc.UnixTime = c.proc.UnixTime
+			ss[i].Proc.TimeZone = c.proc.TimeZone
 			ss[i].Proc.Snapshot = c.proc.Snapshot
 		}
 		return c.compileSort(n, c.compileProjection(n, c.compileRestrict(n, ss))), nil
+	case plan.Node_EXTERNAL_SCAN:
+		ss, err := c.compileExternalScan(n)
+		if err != nil {
+			return nil, err
+		}
+		return c.compileSort(n, c.compileProjection(n, c.compileRestrict(n, ss))), nil
+	case plan.Node_FUNCTION_SCAN:
+		ss, err := c.compileFunctionScan(n)
+		if err != nil {
+			return nil, err
+		}
+		return c.compileSort(n, c.compileProjection(n, c.compileRestrict(n, ss))), nil
+	case plan.Node_SAMPLE:
+		ss, err := c.compilePlanScope(ns[n.Children[0]], ns)
+		if err != nil {
+			return nil, err
+		}
+		if ss, err = c.compileSample(n, ss); err != nil {
+			return nil, err
+		}
+		return c.compileSort(n, c.compileProjection(n, c.compileRestrict(n, ss))), nil
+	case plan.Node_MATERIAL_SCAN:
+		ss, err := c.compileMaterialScan(n, ns)
+		if err != nil {
+			return nil, err
+		}
+		return c.compileSort(n, c.compileProjection(n, c.compileRestrict(n, ss))), nil
+	case plan.Node_SINK_SCAN:
+		ss, err := c.compileSinkScan(n, ns)
+		if err != nil {
+			return nil, err
+		}
+		return c.compileSort(n, c.compileProjection(n, c.compileRestrict(n, ss))), nil
+	case plan.Node_MATERIAL:
+		// the rows of the cte are computed for every reference of it
+		return c.compilePlanScope(ns[n.Children[0]], ns)
+	case plan.Node_RECURSIVE_CTE:
+		return c.compileRecursiveCte(n, ns)
 	case plan.Node_PROJECT:
 		ss, err := c.compilePlanScope(ns[n.Children[0]], ns)
 		if err != nil {
@@ -249,6 +389,16 @@ func (c *compile) compilePlanScope(n *plan.Node, ns []*plan.Node) ([]*Scope, err
 		ss = c.compileGroup(n, ss)
 		return c.compileSort(n, c.compileProjection(n, c.compileRestrict(n, ss))), nil
 	case plan.Node_JOIN:
+		if n.JoinAlgo == plan.Node_INDEX {
+			ss, ok, err := c.compileIndexJoin(n, ns)
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				return c.compileSort(n, ss), nil
+			}
+		}
+		c.compileRuntimeFilters(n, ns)
 		ss, err := c.compilePlanScope(ns[n.Children[0]], ns)
 		if err != nil {
 			return nil, err
@@ -257,6 +407,14 @@ func (c *compile) compilePlanScope(n *plan.Node, ns []*plan.Node) ([]*Scope, err
 		if err != nil {
 			return nil, err
 		}
+		if n.JoinAlgo == plan.Node_MERGE {
+			// the sides can not keep the order of the tables read by several nodes
+			if ok, err := c.readInOrder(ss, children); err != nil {
+				return nil, err
+			} else if !ok {
+				n.JoinAlgo = plan.Node_HASH
+			}
+		}
 		return c.compileSort(n, c.compileJoin(n, ss, children)), nil
 	case plan.Node_SORT:
 		ss, err := c.compilePlanScope(ns[n.Children[0]], ns)
@@ -265,12 +423,377 @@ func (c *compile) compilePlanScope(n *plan.Node, ns []*plan.Node) ([]*Scope, err
 		}
 		ss = c.compileSort(n, ss)
 		return c.compileProjection(n, c.compileRestrict(n, ss)), nil
+	case plan.Node_INSERT, plan.Node_UPDATE, plan.Node_DELETE:
+		// the rows are written by the root scope, see compileWrite
+		ss, err := c.compilePlanScope(ns[n.Children[0]], ns)
+		if err != nil {
+			return nil, err
+		}
+		return c.compileProjection(n, ss), nil
 	default:
 		return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("query '%s' not support now", n))
 	}
 }
 
-func (c *compile) compileRestrict(n *plan.Node, ss []*Scope) []*Scope {
+// constructBatchScope returns a scope reading the rows of bat
+func (c *Compile) constructBatchScope(bat *batch.Batch) *Scope {
+	ds := &Scope{
+		Magic:      Normal,
+		DataSource: &Source{Bat: bat},
+	}
+	ds.Proc = process.New(mheap.New(c.proc.Mp.Gm))
+	ds.Proc.Id = c.proc.Id
+	ds.Proc.Lim = c.proc.Lim
+	ds.Proc.UnixTime = c.proc.UnixTime
+	ds.Proc.TimeZone = c.proc.TimeZone
+	ds.Proc.Snapshot = c.proc.Snapshot
+	return ds
+}
+
+// compileExternalScan returns a scope for every file of the external table, the files are read in parallel.
+func (c *Compile) compileExternalScan(n *plan.Node) ([]*Scope, error) {
+	param, err := plan2.GetExternParam(n.TableDef)
+	if err != nil {
+		return nil, err
+	}
+	files, err := externalFiles(param.Location, c.proc.Lim.ExternalDataDir)
+	if err != nil {
+		return nil, err
+	}
+	attrs := make([]engine.Attribute, len(n.TableDef.Cols))
+	names := make([]string, len(n.TableDef.Cols))
+	for i, col := range n.TableDef.Cols {
+		names[i] = col.Name
+		attrs[i] = engine.Attribute{
+			Name:       col.Name,
+			Type:       constructType(col.Typ),
+			EnumValues: col.Typ.EnumValues,
+		}
+	}
+	if len(files) == 0 {
+		bat := batch.New(true, names)
+		for i := range bat.Vecs {
+			bat.Vecs[i] = vector.New(attrs[i].Type)
+		}
+		bat.InitZsOne(0)
+		return []*Scope{c.constructBatchScope(bat)}, nil
+	}
+	// only the columns referred by the node are parsed
+	used := make([]bool, len(attrs))
+	for _, e := range n.ProjectList {
+		markColumns(e, used)
+	}
+	for _, e := range n.WhereList {
+		markColumns(e, used)
+	}
+	ss := make([]*Scope, len(files))
+	for i, file := range files {
+		ss[i] = c.constructBatchScope(nil)
+		ss[i].DataSource = &Source{
+			R:            external.NewReader(param, file, attrs, used, c.proc.TimeZone),
+			SchemaName:   n.ObjRef.SchemaName,
+			RelationName: n.TableDef.Name,
+			Attributes:   names,
+		}
+	}
+	return ss, nil
+}
+
+// externalFiles returns the files matching the location of an external table, the location
+// and the files it matches must be in the directory dir, no file can be read if dir is empty.
+func externalFiles(location, dir string) ([]string, error) {
+	if dir == "" {
+		return nil, errors.New(errno.InsufficientPrivilege, "the external tables can not be read, the externalDataDir is not configured")
+	}
+	pattern := strings.TrimPrefix(location, plan2.ExternFilePrefix)
+	for _, elem := range strings.Split(filepath.ToSlash(pattern), "/") {
+		if elem == ".." {
+			return nil, errors.New(errno.InvalidOptionValue, fmt.Sprintf("the location '%v' of the external table must not refer to the parent directory", location))
+		}
+	}
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, err
+	}
+	root, err := filepath.EvalSymlinks(abs)
+	if err != nil {
+		return nil, err
+	}
+	if !inDir(pattern, abs) && !inDir(pattern, root) {
+		return nil, errors.New(errno.InsufficientPrivilege, fmt.Sprintf("the location '%v' of the external table is not in the externalDataDir", location))
+	}
+	files, err := filepath.Glob(pattern)
+	if err != nil {
+		return nil, err
+	}
+	// a symbolic link must not lead out of the directory
+	for _, file := range files {
+		path, err := filepath.EvalSymlinks(file)
+		if err != nil {
+			return nil, err
+		}
+		if !inDir(path, root) {
+			return nil, errors.New(errno.InsufficientPrivilege, fmt.Sprintf("the file '%v' of the external table is not in the externalDataDir", file))
+		}
+	}
+	return files, nil
+}
+
+// inDir returns true if the path is under the directory dir
+func inDir(path, dir string) bool {
+	rel, err := filepath.Rel(dir, path)
+	return err == nil && rel != "." && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+// compileFunctionScan returns a scope reading the rows produced by the table function,
+// the arguments are constant and evaluated once.
+func (c *Compile) compileFunctionScan(n *plan.Node) ([]*Scope, error) {
+	args := make([]*vector.Vector, len(n.TableFuncArgs))
+	for i, e := range n.TableFuncArgs {
+		vec, err := colexec.EvalExpr(constBat, c.proc, e)
+		if err != nil {
+			return nil, err
+		}
+		args[i] = vec
+	}
+	r, err := tablefunc.New(n.TableDef.Name, args, c.e, engine.Snapshot(c.proc.Snapshot))
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, len(n.TableDef.Cols))
+	for i, col := range n.TableDef.Cols {
+		names[i] = col.Name
+	}
+	s := c.constructBatchScope(nil)
+	s.DataSource = &Source{
+		R:            r,
+		RelationName: n.TableDef.Name,
+		Attributes:   names,
+	}
+	return []*Scope{s}, nil
+}
+
+// compileMaterialScan returns the scopes computing the rows of a cte, the recursive part of
+// a recursive cte reads the rows of the last iteration instead.
+func (c *Compile) compileMaterialScan(n *plan.Node, ns []*plan.Node) ([]*Scope, error) {
+	name := n.TableDef.Name
+	if bat, ok := c.working[name]; ok {
+		return []*Scope{c.constructBatchScope(bat)}, nil
+	}
+	cte, err := refNode(n, ns)
+	if err != nil {
+		return nil, err
+	}
+	return c.compilePlanScope(cte, ns)
+}
+
+// compileSinkScan returns a scope reading the rows of a SINK, the sink of a cte referenced more
+// than once or of an uncorrelated subquery. The rows are computed once and shared by all the references.
+func (c *Compile) compileSinkScan(n *plan.Node, ns []*plan.Node) ([]*Scope, error) {
+	sink, err := refNode(n, ns)
+	if err != nil {
+		return nil, err
+	}
+	m, ok := c.materials[sink.NodeId]
+	if !ok {
+		ss, err := c.compilePlanScope(ns[sink.Children[0]], ns)
+		if err != nil {
+			return nil, err
+		}
+		m = &Material{
+			e:      c.e,
+			proc:   c.proc,
+			spool:  spool.New(c.proc.Lim.Size),
+			Scopes: ss,
+		}
+		c.materials[sink.NodeId] = m
+	}
+	names := make([]string, len(n.TableDef.Cols))
+	for i, col := range n.TableDef.Cols {
+		names[i] = col.Name
+	}
+	s := c.constructBatchScope(nil)
+	s.DataSource = &Source{
+		R:            m.NewReader(s.Proc),
+		RelationName: n.TableDef.Name,
+		Attributes:   names,
+	}
+	return []*Scope{s}, nil
+}
+
+// refNode returns the node computing the rows read by a MATERIAL_SCAN or a SINK_SCAN,
+// the scan refers to the node by the Obj of its ObjRef.
+func refNode(n *plan.Node, ns []*plan.Node) (*plan.Node, error) {
+	if n.ObjRef == nil || n.ObjRef.Obj < 0 || n.ObjRef.Obj >= int64(len(ns)) {
+		return nil, errors.New(errno.UndefinedTable, fmt.Sprintf("cte '%s' not found", n.TableDef.Name))
+	}
+	return ns[n.ObjRef.Obj], nil
+}
+
+// compileRecursiveCte returns the scope running a recursive cte, its pre-scopes compute the rows of the
+// non-recursive part, and the recursive part is compiled again for every iteration.
+func (c *Compile) compileRecursiveCte(n *plan.Node, ns []*plan.Node) ([]*Scope, error) {
+	union := ns[n.Children[0]]
+	ss, err := c.compilePlanScope(ns[union.Children[0]], ns)
+	if err != nil {
+		return nil, err
+	}
+	rc := &RecursiveCte{
+		Distinct: union.NodeType == plan.Node_UNION,
+		Attrs:    make([]string, len(n.TableDef.Cols)),
+		Types:    make([]types.Type, len(n.TableDef.Cols)),
+	}
+	for i, col := range n.TableDef.Cols {
+		rc.Attrs[i] = col.Name
+		rc.Types[i] = constructType(col.Typ)
+	}
+	rc.Iterate = func(bat *batch.Batch) ([]*Scope, error) {
+		// every iteration is compiled by its own copy of the compile, as the scopes of a cte
+		// referenced more than once run at the same time.
+		ic := *c
+		ic.working = make(map[string]*batch.Batch, len(c.working)+1)
+		for k, v := range c.working {
+			ic.working[k] = v
+		}
+		ic.working[n.TableDef.Name] = bat
+		return ic.compilePlanScope(ns[union.Children[1]], ns)
+	}
+	rs := &Scope{
+		Magic:     Recursive,
+		PreScopes: ss,
+		Recursive: rc,
+	}
+	rs.Proc = process.New(mheap.New(c.proc.Mp.Gm))
+	rs.Proc.Id = c.proc.Id
+	rs.Proc.Lim = c.proc.Lim
+	rs.Proc.UnixTime = c.proc.UnixTime
+	rs.Proc.TimeZone = c.proc.TimeZone
+	rs.Proc.Snapshot = c.proc.Snapshot
+	return []*Scope{rs}, nil
+}
+
+// compileWrite returns the instruction writing the rows of the insert, update or delete node into its table
+func (c *Compile) compileWrite(n *plan.Node, ns []*plan.Node) (vm.Instruction, error) {
+	snap := engine.Snapshot(c.proc.Snapshot)
+	db, err := c.e.Database(n.ObjRef.SchemaName, snap)
+	if err != nil {
+		return vm.Instruction{}, err
+	}
+	rel, err := db.Relation(n.TableDef.Name, snap)
+	if err != nil {
+		return vm.Instruction{}, err
+	}
+	switch n.NodeType {
+	case plan.Node_INSERT:
+		checks, err := constructChecks(n.TableDef)
+		if err != nil {
+			return vm.Instruction{}, err
+		}
+		arg := constructInsert(n, rel)
+		arg.Checks = checks
+		arg.Buffered = arg.OnDuplicate || readsTable(ns, n)
+		return vm.Instruction{
+			Op:  overload.Insert,
+			Arg: arg,
+		}, nil
+	case plan.Node_UPDATE:
+		checks, err := constructChecks(n.TableDef)
+		if err != nil {
+			return vm.Instruction{}, err
+		}
+		arg := constructUpdate(n, rel)
+		arg.Checks = checks
+		return vm.Instruction{
+			Op:  overload.Update,
+			Arg: arg,
+		}, nil
+	default:
+		return vm.Instruction{
+			Op:  overload.Deletion,
+			Arg: constructDeletion(n, rel),
+		}, nil
+	}
+}
+
+// readsTable returns true if any of the nodes scans the table written by the node n
+func readsTable(ns []*plan.Node, n *plan.Node) bool {
+	for _, m := range ns {
+		if m.NodeType == plan.Node_TABLE_SCAN && m.ObjRef.GetSchemaName() == n.ObjRef.GetSchemaName() &&
+			m.TableDef.GetName() == n.TableDef.GetName() {
+			return true
+		}
+	}
+	return false
+}
+
+// compileSample appends the sample instruction to the scopes scanning the table. The scopes of a repeatable
+// sample read the table by a single reader, so that the blocks are always read in the same order.
+func (c *Compile) compileSample(n *plan.Node, ss []*Scope) ([]*Scope, error) {
+	smp, err := plan2.ParseSample(n.ExtraOptions)
+	if err != nil {
+		return nil, err
+	}
+	seed := time.Now().UnixNano()
+	if smp.Repeatable {
+		seed = smp.Seed
+		if err := c.readBySingleReader(ss); err != nil {
+			return nil, err
+		}
+	}
+	for i := range ss {
+		ss[i].Instructions = append(ss[i].Instructions, vm.Instruction{
+			Op:  overload.Sample,
+			Arg: constructSample(smp, seed+int64(i)),
+		})
+	}
+	return ss, nil
+}
+
+// readBySingleReader makes the scopes scanning a table read it by a single reader, instead of
+// the parallel readers of the scope, so that the rows are read in the order of the table.
+func (c *Compile) readBySingleReader(ss []*Scope) error {
+	snap := engine.Snapshot(c.proc.Snapshot)
+	for i := range ss {
+		if ss[i].Magic != Remote || ss[i].DataSource == nil {
+			continue
+		}
+		db, err := c.e.Database(ss[i].DataSource.SchemaName, snap)
+		if err != nil {
+			return err
+		}
+		rel, err := db.Relation(ss[i].DataSource.RelationName, snap)
+		if err != nil {
+			return err
+		}
+		ss[i].Magic = Normal
+		ss[i].DataSource = &Source{
+			R:              rel.NewReader(1, nil, ss[i].NodeInfo.Data, snap)[0],
+			SchemaName:     ss[i].DataSource.SchemaName,
+			RelationName:   ss[i].DataSource.RelationName,
+			Attributes:     ss[i].DataSource.Attributes,
+			RuntimeFilters: ss[i].DataSource.RuntimeFilters,
+		}
+	}
+	return nil
+}
+
+// readInOrder makes the sorted table scans of the sides of a merge join keep the order of the
+// primary key. It returns false if a side has several scopes, whose rows are interleaved.
+func (c *Compile) readInOrder(sides ...[]*Scope) (bool, error) {
+	for _, ss := range sides {
+		if len(ss) > 1 {
+			return false, nil
+		}
+	}
+	for _, ss := range sides {
+		if err := c.readBySingleReader(ss); err != nil {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+func (c *Compile) compileRestrict(n *plan.Node, ss []*Scope) []*Scope {
 	if len(n.WhereList) == 0 {
 		return ss
 	}
@@ -283,7 +806,7 @@ func (c *compile) compileRestrict(n *plan.Node, ss []*Scope) []*Scope {
 	return ss
 }
 
-func (c *compile) compileProjection(n *plan.Node, ss []*Scope) []*Scope {
+func (c *Compile) compileProjection(n *plan.Node, ss []*Scope) []*Scope {
 	for i := range ss {
 		ss[i].Instructions = append(ss[i].Instructions, vm.Instruction{
 			Op:  overload.Projection,
@@ -293,12 +816,24 @@ func (c *compile) compileProjection(n *plan.Node, ss []*Scope) []*Scope {
 	return ss
 }
 
-func (c *compile) compileJoin(n *plan.Node, ss []*Scope, children []*Scope) []*Scope {
+func (c *Compile) compileJoin(n *plan.Node, ss []*Scope, children []*Scope) []*Scope {
+	magic := Remote
+	if n.JoinType&plan.Node_RIGHT != 0 {
+		// the unmatched rows of the right side are known only after all the rows of
+		// the left side are probed, so the left side is not partitioned.
+		ss, magic = c.compileGather(ss), Merge
+	}
 	rs := make([]*Scope, len(ss))
 	for i := range ss {
+		// every partition of the join needs all the rows of the children
 		chp := &Scope{
-			PreScopes: children,
-			Magic:     Merge,
+			PreScopes:   children,
+			Magic:       Merge,
+			DispatchAll: true,
+			Instructions: vm.Instructions{vm.Instruction{
+				Op:  overload.Merge,
+				Arg: &merge.Argument{},
+			}},
 		}
 		{ // build merge scope for children
 			ctx, cancel := context.WithCancel(context.Background())
@@ -307,6 +842,7 @@ func (c *compile) compileJoin(n *plan.Node, ss []*Scope, children []*Scope) []*S
 			chp.Proc.Id = c.proc.Id
 			chp.Proc.Lim = c.proc.Lim
 			chp.Proc.UnixTime = c.proc.UnixTime
+			chp.Proc.TimeZone = c.proc.TimeZone
 			chp.Proc.Snapshot = c.proc.Snapshot
 			chp.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, len(children))
 			{
@@ -327,47 +863,23 @@ func (c *compile) compileJoin(n *plan.Node, ss []*Scope, children []*Scope) []*S
 				})
 			}
 		}
-		rs[i] = &Scope{
-			Magic:     Remote,
-			PreScopes: []*Scope{ss[i], chp},
-		}
-		ctx, cancel := context.WithCancel(context.Background())
-		rs[i].Proc = process.New(mheap.New(c.proc.Mp.Gm))
-		rs[i].Proc.Cancel = cancel
-		rs[i].Proc.Id = c.proc.Id
-		rs[i].Proc.Lim = c.proc.Lim
-		rs[i].Proc.UnixTime = c.proc.UnixTime
-		rs[i].Proc.Snapshot = c.proc.Snapshot
-		rs[i].Proc.Reg.MergeReceivers = make([]*process.WaitRegister, 2)
-		{
-			rs[i].Proc.Reg.MergeReceivers[0] = &process.WaitRegister{
-				Ctx: ctx,
-				Ch:  make(chan *batch.Batch, 1),
-			}
-			rs[i].Proc.Reg.MergeReceivers[1] = &process.WaitRegister{
-				Ctx: ctx,
-				Ch:  make(chan *batch.Batch, 1),
-			}
-
-		}
-		ss[i].Instructions = append(ss[i].Instructions, vm.Instruction{
-			Op: overload.Connector,
-			Arg: &connector.Argument{
-				Mmu: rs[i].Proc.Mp.Gm,
-				Reg: rs[i].Proc.Reg.MergeReceivers[0],
-			},
-		})
-		chp.Instructions = append(chp.Instructions, vm.Instruction{
-			Op: overload.Connector,
-			Arg: &connector.Argument{
-				Mmu: rs[i].Proc.Mp.Gm,
-				Reg: rs[i].Proc.Reg.MergeReceivers[1],
-			},
-		})
+		rs[i] = c.constructJoinScope(magic, ss[i], chp)
 	}
+	return c.compileJoinType(n, rs)
+}
+
+// compileJoinType appends the instruction of the join to the scopes
+func (c *Compile) compileJoinType(n *plan.Node, rs []*Scope) []*Scope {
 	switch n.JoinType {
-	case plan.Node_INNER, plan.Node_SEMI:
-		if len(n.OnList) == 0 {
+	case plan.Node_INNER:
+		if n.JoinAlgo == plan.Node_MERGE {
+			for i := range rs {
+				rs[i].Instructions = append(rs[i].Instructions, vm.Instruction{
+					Op:  overload.MergeJoin,
+					Arg: constructMergeJoin(n, c.proc),
+				})
+			}
+		} else if len(n.OnList) == 0 {
 			for i := range rs {
 				rs[i].Instructions = append(rs[i].Instructions, vm.Instruction{
 					Op:  overload.Product,
@@ -376,12 +888,21 @@ func (c *compile) compileJoin(n *plan.Node, ss []*Scope, children []*Scope) []*S
 			}
 		} else {
 			for i := range rs {
+				arg := constructJoin(n, c.proc)
+				arg.Filters = c.joinFilters[n.NodeId]
 				rs[i].Instructions = append(rs[i].Instructions, vm.Instruction{
 					Op:  overload.Join,
-					Arg: constructJoin(n, c.proc),
+					Arg: arg,
 				})
 			}
 		}
+	case plan.Node_SEMI:
+		for i := range rs {
+			rs[i].Instructions = append(rs[i].Instructions, vm.Instruction{
+				Op:  overload.Semi,
+				Arg: constructSemi(n, c.proc),
+			})
+		}
 	case plan.Node_OUTER:
 		for i := range rs {
 			rs[i].Instructions = append(rs[i].Instructions, vm.Instruction{
@@ -389,6 +910,20 @@ func (c *compile) compileJoin(n *plan.Node, ss []*Scope, children []*Scope) []*S
 				Arg: constructLeft(n, c.proc),
 			})
 		}
+	case plan.Node_RIGHT, plan.Node_OUTER | plan.Node_RIGHT:
+		for i := range rs {
+			rs[i].Instructions = append(rs[i].Instructions, vm.Instruction{
+				Op:  overload.Right,
+				Arg: constructRight(n, c.proc),
+			})
+		}
+	case plan.Node_MARK:
+		for i := range rs {
+			rs[i].Instructions = append(rs[i].Instructions, vm.Instruction{
+				Op:  overload.Mark,
+				Arg: constructMark(n, c.proc),
+			})
+		}
 	case plan.Node_ANTI:
 		for i := range rs {
 			rs[i].Instructions = append(rs[i].Instructions, vm.Instruction{
@@ -402,8 +937,139 @@ func (c *compile) compileJoin(n *plan.Node, ss []*Scope, children []*Scope) []*S
 	return rs
 }
 
-func (c *compile) compileSort(n *plan.Node, ss []*Scope) []*Scope {
+// compileRuntimeFilters creates the runtime filters of an inner hash join, the filters are built from the
+// keys of the right side and applied by the readers of the table scanned by the left side. A key is filtered
+// only if the left key is a column of the scan, and has the same type as the right key. The joins partitioned
+// by the keys build their hash tables from a part of the right side, they have no runtime filters.
+func (c *Compile) compileRuntimeFilters(n *plan.Node, ns []*plan.Node) {
+	if n.JoinType != plan.Node_INNER || n.JoinAlgo != plan.Node_HASH || len(n.OnList) == 0 {
+		return
+	}
+	var fs []*engine.RuntimeFilter
+	for i, expr := range n.OnList {
+		lpos, ltyp, _, rtyp := constructJoinCondition(expr)
+		if ltyp.Oid != rtyp.Oid || ltyp.Scale != rtyp.Scale {
+			continue
+		}
+		scan, attr, ok := scanColumn(ns[n.Children[0]], lpos, ns)
+		if !ok {
+			continue
+		}
+		f := engine.NewRuntimeFilter(attr, ltyp)
+		if f == nil {
+			continue
+		}
+		if fs == nil {
+			fs = make([]*engine.RuntimeFilter, len(n.OnList))
+		}
+		fs[i] = f
+		c.scanFilters[scan.NodeId] = append(c.scanFilters[scan.NodeId], f)
+	}
+	c.joinFilters[n.NodeId] = fs
+}
+
+// scanColumn returns the table scan and the name of the column producing the column at pos of n,
+// if the column is passed up by projections only. The rows of the nodes must not be limited.
+func scanColumn(n *plan.Node, pos int32, ns []*plan.Node) (*plan.Node, string, bool) {
+	for {
+		if n.Limit != nil || n.Offset != nil || int(pos) >= len(n.ProjectList) {
+			return nil, "", false
+		}
+		col, ok := n.ProjectList[pos].Expr.(*plan.Expr_Col)
+		if !ok {
+			return nil, "", false
+		}
+		switch n.NodeType {
+		case plan.Node_TABLE_SCAN:
+			if int(col.Col.ColPos) >= len(n.TableDef.Cols) {
+				return nil, "", false
+			}
+			return n, n.TableDef.Cols[col.Col.ColPos].Name, true
+		case plan.Node_PROJECT:
+			n, pos = ns[n.Children[0]], col.Col.ColPos
+		default:
+			return nil, "", false
+		}
+	}
+}
+
+// compileIndexJoin appends the index join to the scopes of the left side, the right side is not
+// scanned but looked up by the keys of the left rows. It returns false if the table scanned by the
+// right side can not look up its rows by the primary key, the join is compiled as a hash join then.
+func (c *Compile) compileIndexJoin(n *plan.Node, ns []*plan.Node) ([]*Scope, bool, error) {
+	right := ns[n.Children[1]]
+	snap := engine.Snapshot(c.proc.Snapshot)
+	db, err := c.e.Database(right.ObjRef.SchemaName, snap)
+	if err != nil {
+		return nil, false, err
+	}
+	rel, err := db.Relation(right.TableDef.Name, snap)
+	if err != nil {
+		return nil, false, err
+	}
+	krel, ok := rel.(engine.KeyRelation)
+	if !ok {
+		return nil, false, nil
+	}
+	ss, err := c.compilePlanScope(ns[n.Children[0]], ns)
+	if err != nil {
+		return nil, false, err
+	}
+	for i := range ss {
+		ss[i].Instructions = append(ss[i].Instructions, vm.Instruction{
+			Op:  overload.IndexJoin,
+			Arg: constructIndexJoin(n, right, krel),
+		})
+	}
+	return ss, true, nil
+}
+
+// constructJoinScope returns the scope joining the rows of the left scope and the right scope
+func (c *Compile) constructJoinScope(magic int, left, right *Scope) *Scope {
+	rs := &Scope{
+		Magic:     magic,
+		PreScopes: []*Scope{left, right},
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	rs.Proc = process.New(mheap.New(c.proc.Mp.Gm))
+	rs.Proc.Cancel = cancel
+	rs.Proc.Id = c.proc.Id
+	rs.Proc.Lim = c.proc.Lim
+	rs.Proc.UnixTime = c.proc.UnixTime
+	rs.Proc.TimeZone = c.proc.TimeZone
+	rs.Proc.Snapshot = c.proc.Snapshot
+	rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, 2)
+	{
+		rs.Proc.Reg.MergeReceivers[0] = &process.WaitRegister{
+			Ctx: ctx,
+			Ch:  make(chan *batch.Batch, 1),
+		}
+		rs.Proc.Reg.MergeReceivers[1] = &process.WaitRegister{
+			Ctx: ctx,
+			Ch:  make(chan *batch.Batch, 1),
+		}
+	}
+	left.Instructions = append(left.Instructions, vm.Instruction{
+		Op: overload.Connector,
+		Arg: &connector.Argument{
+			Mmu: rs.Proc.Mp.Gm,
+			Reg: rs.Proc.Reg.MergeReceivers[0],
+		},
+	})
+	right.Instructions = append(right.Instructions, vm.Instruction{
+		Op: overload.Connector,
+		Arg: &connector.Argument{
+			Mmu: rs.Proc.Mp.Gm,
+			Reg: rs.Proc.Reg.MergeReceivers[1],
+		},
+	})
+	return rs
+}
+
+func (c *Compile) compileSort(n *plan.Node, ss []*Scope) []*Scope {
 	switch {
+	case n.Limit == nil && n.Offset == nil && len(n.OrderBy) > 0: // order
+		return c.compileOrder(n, ss)
 	case n.Limit != nil && n.Offset == nil && len(n.OrderBy) > 0: // top
 		return c.compileTop(n, ss)
 	case n.Limit == nil && n.Offset != nil && len(n.OrderBy) > 0: // order and offset
@@ -421,7 +1087,7 @@ func (c *compile) compileSort(n *plan.Node, ss []*Scope) []*Scope {
 	}
 }
 
-func (c *compile) compileTop(n *plan.Node, ss []*Scope) []*Scope {
+func (c *Compile) compileTop(n *plan.Node, ss []*Scope) []*Scope {
 	for i := range ss {
 		ss[i].Instructions = append(ss[i].Instructions, vm.Instruction{
 			Op:  overload.Top,
@@ -438,6 +1104,7 @@ func (c *compile) compileTop(n *plan.Node, ss []*Scope) []*Scope {
 	rs.Proc.Id = c.proc.Id
 	rs.Proc.Lim = c.proc.Lim
 	rs.Proc.UnixTime = c.proc.UnixTime
+	rs.Proc.TimeZone = c.proc.TimeZone
 	rs.Proc.Snapshot = c.proc.Snapshot
 	rs.Instructions = append(rs.Instructions, vm.Instruction{
 		Op:  overload.MergeTop,
@@ -464,7 +1131,7 @@ func (c *compile) compileTop(n *plan.Node, ss []*Scope) []*Scope {
 	return []*Scope{rs}
 }
 
-func (c *compile) compileOrder(n *plan.Node, ss []*Scope) []*Scope {
+func (c *Compile) compileOrder(n *plan.Node, ss []*Scope) []*Scope {
 	for i := range ss {
 		ss[i].Instructions = append(ss[i].Instructions, vm.Instruction{
 			Op:  overload.Order,
@@ -481,6 +1148,7 @@ func (c *compile) compileOrder(n *plan.Node, ss []*Scope) []*Scope {
 	rs.Proc.Id = c.proc.Id
 	rs.Proc.Lim = c.proc.Lim
 	rs.Proc.UnixTime = c.proc.UnixTime
+	rs.Proc.TimeZone = c.proc.TimeZone
 	rs.Proc.Snapshot = c.proc.Snapshot
 	rs.Instructions = append(rs.Instructions, vm.Instruction{
 		Op:  overload.MergeOrder,
@@ -507,7 +1175,7 @@ func (c *compile) compileOrder(n *plan.Node, ss []*Scope) []*Scope {
 	return []*Scope{rs}
 }
 
-func (c *compile) compileOffset(n *plan.Node, ss []*Scope) []*Scope {
+func (c *Compile) compileOffset(n *plan.Node, ss []*Scope) []*Scope {
 	for i := range ss {
 		ss[i].Instructions = append(ss[i].Instructions, vm.Instruction{
 			Op:  overload.Offset,
@@ -524,6 +1192,7 @@ func (c *compile) compileOffset(n *plan.Node, ss []*Scope) []*Scope {
 	rs.Proc.Id = c.proc.Id
 	rs.Proc.Lim = c.proc.Lim
 	rs.Proc.UnixTime = c.proc.UnixTime
+	rs.Proc.TimeZone = c.proc.TimeZone
 	rs.Proc.Snapshot = c.proc.Snapshot
 	rs.Instructions = append(rs.Instructions, vm.Instruction{
 		Op:  overload.MergeOffset,
@@ -550,7 +1219,7 @@ func (c *compile) compileOffset(n *plan.Node, ss []*Scope) []*Scope {
 	return []*Scope{rs}
 }
 
-func (c *compile) compileLimit(n *plan.Node, ss []*Scope) []*Scope {
+func (c *Compile) compileLimit(n *plan.Node, ss []*Scope) []*Scope {
 	for i := range ss {
 		ss[i].Instructions = append(ss[i].Instructions, vm.Instruction{
 			Op:  overload.Limit,
@@ -567,6 +1236,7 @@ func (c *compile) compileLimit(n *plan.Node, ss []*Scope) []*Scope {
 	rs.Proc.Id = c.proc.Id
 	rs.Proc.Lim = c.proc.Lim
 	rs.Proc.UnixTime = c.proc.UnixTime
+	rs.Proc.TimeZone = c.proc.TimeZone
 	rs.Proc.Snapshot = c.proc.Snapshot
 	rs.Instructions = append(rs.Instructions, vm.Instruction{
 		Op:  overload.MergeLimit,
@@ -593,7 +1263,7 @@ func (c *compile) compileLimit(n *plan.Node, ss []*Scope) []*Scope {
 	return []*Scope{rs}
 }
 
-func (c *compile) compileGroup(n *plan.Node, ss []*Scope) []*Scope {
+func (c *Compile) compileGroup(n *plan.Node, ss []*Scope) []*Scope {
 	for i := range ss {
 		ss[i].Instructions = append(ss[i].Instructions, vm.Instruction{
 			Op:  overload.Group,
@@ -610,6 +1280,7 @@ func (c *compile) compileGroup(n *plan.Node, ss []*Scope) []*Scope {
 	rs.Proc.Id = c.proc.Id
 	rs.Proc.Lim = c.proc.Lim
 	rs.Proc.UnixTime = c.proc.UnixTime
+	rs.Proc.TimeZone = c.proc.TimeZone
 	rs.Proc.Snapshot = c.proc.Snapshot
 	rs.Instructions = append(rs.Instructions, vm.Instruction{
 		Op:  overload.MergeGroup,
@@ -635,3 +1306,45 @@ func (c *compile) compileGroup(n *plan.Node, ss []*Scope) []*Scope {
 	}
 	return []*Scope{rs}
 }
+
+// compileGather merges the rows of the partitions
+func (c *Compile) compileGather(ss []*Scope) []*Scope {
+	if len(ss) == 1 {
+		return ss
+	}
+	rs := &Scope{
+		PreScopes: ss,
+		Magic:     Merge,
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	rs.Proc = process.New(mheap.New(c.proc.Mp.Gm))
+	rs.Proc.Cancel = cancel
+	rs.Proc.Id = c.proc.Id
+	rs.Proc.Lim = c.proc.Lim
+	rs.Proc.UnixTime = c.proc.UnixTime
+	rs.Proc.TimeZone = c.proc.TimeZone
+	rs.Proc.Snapshot = c.proc.Snapshot
+	rs.Instructions = append(rs.Instructions, vm.Instruction{
+		Op:  overload.Merge,
+		Arg: &merge.Argument{},
+	})
+	rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, len(ss))
+	{
+		for i := 0; i < len(ss); i++ {
+			rs.Proc.Reg.MergeReceivers[i] = &process.WaitRegister{
+				Ctx: ctx,
+				Ch:  make(chan *batch.Batch, 1),
+			}
+		}
+	}
+	for i := range ss {
+		ss[i].Instructions = append(ss[i].Instructions, vm.Instruction{
+			Op: overload.Connector,
+			Arg: &connector.Argument{
+				Mmu: rs.Proc.Mp.Gm,
+				Reg: rs.Proc.Reg.MergeReceivers[i],
+			},
+		})
+	}
+	return []*Scope{rs}
+}
@@ -0,0 +1,53 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compile2
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestExternalFiles(t *testing.T) {
+	base := t.TempDir()
+	dir := filepath.Join(base, "data")
+	require.NoError(t, os.Mkdir(dir, 0755))
+	for _, name := range []string{"a.csv", "b.csv"} {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), nil, 0644))
+	}
+	secret := filepath.Join(base, "secret.csv")
+	require.NoError(t, os.WriteFile(secret, nil, 0644))
+
+	files, err := externalFiles("file://"+filepath.Join(dir, "*.csv"), dir)
+	require.NoError(t, err)
+	require.Equal(t, []string{filepath.Join(dir, "a.csv"), filepath.Join(dir, "b.csv")}, files)
+
+	// no file can be read without the directory
+	_, err = externalFiles("file://"+filepath.Join(dir, "*.csv"), "")
+	require.Error(t, err)
+	// the files out of the directory
+	_, err = externalFiles("file://"+secret, dir)
+	require.Error(t, err)
+	_, err = externalFiles("file://"+dir+"/../secret.csv", dir)
+	require.Error(t, err)
+	_, err = externalFiles("file://"+base+"/*/*.csv", dir)
+	require.Error(t, err)
+	// a symbolic link leading out of the directory
+	require.NoError(t, os.Symlink(secret, filepath.Join(dir, "c.csv")))
+	_, err = externalFiles("file://"+filepath.Join(dir, "*.csv"), dir)
+	require.Error(t, err)
+}
@@ -16,20 +16,30 @@ package compile2
 
 import (
 	"fmt"
+	"math/rand"
 
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/errno"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	colexec "github.com/matrixorigin/matrixone/pkg/sql/colexec2"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/aggregate"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/complement"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/connector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/deletion"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/dispatch"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/group"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/indexjoin"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/insert"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/join"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/left"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/limit"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/mark"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/mergegroup"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/mergejoin"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/mergelimit"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/mergeoffset"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/mergeorder"
@@ -40,10 +50,17 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/product"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/projection"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/restrict"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/right"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/sample"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/semi"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/top"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/update"
 	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan2"
 	"github.com/matrixorigin/matrixone/pkg/sql/plan2/function"
 	"github.com/matrixorigin/matrixone/pkg/vm"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
 
@@ -72,6 +89,7 @@ func dupInstruction(in vm.Instruction) vm.Instruction {
 			IsPreBuild: arg.IsPreBuild,
 			Result:     arg.Result,
 			Conditions: arg.Conditions,
+			Filters:    arg.Filters,
 		}
 	case *left.Argument:
 		rin.Arg = &left.Argument{
@@ -79,6 +97,12 @@ func dupInstruction(in vm.Instruction) vm.Instruction {
 			Result:     arg.Result,
 			Conditions: arg.Conditions,
 		}
+	case *sample.Argument:
+		rin.Arg = &sample.Argument{
+			Method:  arg.Method,
+			Percent: arg.Percent,
+			Seed:    rand.Int63(),
+		}
 	case *product.Argument:
 		rin.Arg = &product.Argument{
 			Result: arg.Result,
@@ -89,6 +113,35 @@ func dupInstruction(in vm.Instruction) vm.Instruction {
 			Result:     arg.Result,
 			Conditions: arg.Conditions,
 		}
+	case *right.Argument:
+		rin.Arg = &right.Argument{
+			Full:       arg.Full,
+			Typs:       arg.Typs,
+			Result:     arg.Result,
+			Conditions: arg.Conditions,
+		}
+	case *semi.Argument:
+		rin.Arg = &semi.Argument{
+			Result:     arg.Result,
+			Conditions: arg.Conditions,
+		}
+	case *mark.Argument:
+		rin.Arg = &mark.Argument{
+			Result:     arg.Result,
+			Conditions: arg.Conditions,
+		}
+	case *mergejoin.Argument:
+		rin.Arg = &mergejoin.Argument{
+			Result:     arg.Result,
+			Conditions: arg.Conditions,
+		}
+	case *indexjoin.Argument:
+		rin.Arg = &indexjoin.Argument{
+			Rel:    arg.Rel,
+			Attrs:  arg.Attrs,
+			Pos:    arg.Pos,
+			Result: arg.Result,
+		}
 	case *offset.Argument:
 		rin.Arg = &offset.Argument{
 			Offset: arg.Offset,
@@ -111,6 +164,7 @@ func dupInstruction(in vm.Instruction) vm.Instruction {
 			Func: arg.Func,
 		}
 	case *connector.Argument:
+	case *dispatch.Argument:
 	default:
 		panic(errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("Unsupport instruction %T\n", in.Arg)))
 	}
@@ -119,14 +173,64 @@ func dupInstruction(in vm.Instruction) vm.Instruction {
 
 func constructRestrict(n *plan.Node) *restrict.Argument {
 	return &restrict.Argument{
-		E: colexec.RewriteFilterExprList(n.WhereList),
+		E: colexec.RewriteFilterExprList(aggregateExprList(n, n.WhereList)),
 	}
 }
 
 func constructProjection(n *plan.Node) *projection.Argument {
 	return &projection.Argument{
-		Es: n.ProjectList,
+		Es: aggregateExprList(n, n.ProjectList),
+	}
+}
+
+// aggregateExprList returns the expressions of an AGG node referring to the batch of the group operator,
+// where the aggregates follow the columns of the group by.
+func aggregateExprList(n *plan.Node, es []*plan.Expr) []*plan.Expr {
+	if n.NodeType != plan.Node_AGG || len(n.GroupBy) == 0 {
+		return es
+	}
+	rs := make([]*plan.Expr, len(es))
+	for i, e := range es {
+		rs[i] = aggregateExpr(e, int32(len(n.GroupBy)))
+	}
+	return rs
+}
+
+func aggregateExpr(e *plan.Expr, offset int32) *plan.Expr {
+	switch ex := e.Expr.(type) {
+	case *plan.Expr_Col:
+		if ex.Col.RelPos != -2 {
+			return e
+		}
+		return &plan.Expr{
+			Typ:       e.Typ,
+			TableName: e.TableName,
+			ColName:   e.ColName,
+			Expr: &plan.Expr_Col{
+				Col: &plan.ColRef{
+					RelPos: ex.Col.RelPos,
+					ColPos: ex.Col.ColPos + offset,
+				},
+			},
+		}
+	case *plan.Expr_F:
+		args := make([]*plan.Expr, len(ex.F.Args))
+		for i, arg := range ex.F.Args {
+			args[i] = aggregateExpr(arg, offset)
+		}
+		return &plan.Expr{
+			Typ:       e.Typ,
+			TableName: e.TableName,
+			ColName:   e.ColName,
+			Expr: &plan.Expr_F{
+				F: &plan.Function{
+					Func: ex.F.Func,
+					Args: args,
+				},
+			},
+		}
 	}
+	return e
 }
 
 func constructTop(n *plan.Node, proc *process.Process) *top.Argument {
@@ -137,7 +241,7 @@ func constructTop(n *plan.Node, proc *process.Process) *top.Argument {
 	fs := make([]top.Field, len(n.OrderBy))
 	for i, e := range n.OrderBy {
 		fs[i].E = e.Expr
-		if e.Collation == "DESC" {
+		if e.Flag == plan.OrderBySpec_DESC || e.Collation == "DESC" {
 			fs[i].Type = top.Descending
 		}
 	}
@@ -170,6 +274,46 @@ func constructJoin(n *plan.Node, proc *process.Process) *join.Argument {
 	}
 }
 
+func constructMergeJoin(n *plan.Node, proc *process.Process) *mergejoin.Argument {
+	result := make([]mergejoin.ResultPos, len(n.ProjectList))
+	for i, expr := range n.ProjectList {
+		result[i].Rel, result[i].Pos = constructJoinResult(expr)
+	}
+	conds := make([][]mergejoin.Condition, 2)
+	{
+		conds[0] = make([]mergejoin.Condition, len(n.OnList))
+		conds[1] = make([]mergejoin.Condition, len(n.OnList))
+	}
+	for i, expr := range n.OnList {
+		lpos, ltyp, rpos, rtyp := constructJoinCondition(expr)
+		conds[0][i].Pos, conds[1][i].Pos = lpos, rpos
+		conds[0][i].Typ, conds[1][i].Typ = ltyp, rtyp
+	}
+	return &mergejoin.Argument{
+		Conditions: conds,
+		Result:     result,
+	}
+}
+
+// constructIndexJoin returns the join looking up the rows of the table scanned by right
+func constructIndexJoin(n, right *plan.Node, rel engine.KeyRelation) *indexjoin.Argument {
+	result := make([]indexjoin.ResultPos, len(n.ProjectList))
+	for i, expr := range n.ProjectList {
+		result[i].Rel, result[i].Pos = constructJoinResult(expr)
+	}
+	attrs := make([]string, len(right.ProjectList))
+	for i, expr := range right.ProjectList {
+		attrs[i] = right.TableDef.Cols[expr.Expr.(*plan.Expr_Col).Col.ColPos].Name
+	}
+	pos, _, _, _ := constructJoinCondition(n.OnList[0])
+	return &indexjoin.Argument{
+		Rel:    rel,
+		Attrs:  attrs,
+		Pos:    pos,
+		Result: result,
+	}
+}
+
 func constructLeft(n *plan.Node, proc *process.Process) *left.Argument {
 	result := make([]left.ResultPos, len(n.ProjectList))
 	for i, expr := range n.ProjectList {
@@ -193,6 +337,87 @@ func constructLeft(n *plan.Node, proc *process.Process) *left.Argument {
 	}
 }
 
+// constructRight returns the right join, the join is full if the rows of the left side are kept too
+func constructRight(n *plan.Node, proc *process.Process) *right.Argument {
+	typs := make([]types.Type, len(n.ProjectList))
+	result := make([]right.ResultPos, len(n.ProjectList))
+	for i, expr := range n.ProjectList {
+		typs[i] = constructType(expr.Typ)
+		result[i].Rel, result[i].Pos = constructJoinResult(expr)
+	}
+	conds := make([][]right.Condition, 2)
+	{
+		conds[0] = make([]right.Condition, len(n.OnList))
+		conds[1] = make([]right.Condition, len(n.OnList))
+	}
+	for i, expr := range n.OnList {
+		lpos, ltyp, rpos, rtyp := constructJoinCondition(expr)
+		conds[0][i].Pos, conds[1][i].Pos = lpos, rpos
+		conds[0][i].Typ, conds[1][i].Typ = ltyp, rtyp
+	}
+	return &right.Argument{
+		Full:       n.JoinType&plan.Node_OUTER != 0,
+		Typs:       typs,
+		Conditions: conds,
+		Result:     result,
+	}
+}
+
+func constructSemi(n *plan.Node, proc *process.Process) *semi.Argument {
+	result := make([]int32, len(n.ProjectList))
+	for i, expr := range n.ProjectList {
+		rel, pos := constructJoinResult(expr)
+		if rel != 0 {
+			panic(errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("semi join result '%s' not support now", expr)))
+		}
+		result[i] = pos
+	}
+	conds := make([][]semi.Condition, 2)
+	{
+		conds[0] = make([]semi.Condition, len(n.OnList))
+		conds[1] = make([]semi.Condition, len(n.OnList))
+	}
+	for i, expr := range n.OnList {
+		lpos, ltyp, rpos, rtyp := constructJoinCondition(expr)
+		conds[0][i].Pos, conds[1][i].Pos = lpos, rpos
+		conds[0][i].Typ, conds[1][i].Typ = ltyp, rtyp
+	}
+	return &semi.Argument{
+		Conditions: conds,
+		Result:     result,
+	}
+}
+
+// constructMark returns the mark join, the last column of the join is the mark
+// and the others are the columns of the left side.
+func constructMark(n *plan.Node, proc *process.Process) *mark.Argument {
+	if len(n.ProjectList) == 0 {
+		panic(errors.New(errno.SyntaxErrororAccessRuleViolation, "mark join without mark column"))
+	}
+	result := make([]int32, len(n.ProjectList)-1)
+	for i, expr := range n.ProjectList[:len(result)] {
+		rel, pos := constructJoinResult(expr)
+		if rel != 0 {
+			panic(errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("mark join result '%s' not support now", expr)))
+		}
+		result[i] = pos
+	}
+	conds := make([][]mark.Condition, 2)
+	{
+		conds[0] = make([]mark.Condition, len(n.OnList))
+		conds[1] = make([]mark.Condition, len(n.OnList))
+	}
+	for i, expr := range n.OnList {
+		lpos, ltyp, rpos, rtyp := constructJoinCondition(expr)
+		conds[0][i].Pos, conds[1][i].Pos = lpos, rpos
+		conds[0][i].Typ, conds[1][i].Typ = ltyp, rtyp
+	}
+	return &mark.Argument{
+		Conditions: conds,
+		Result:     result,
+	}
+}
+
 func constructProduct(n *plan.Node, proc *process.Process) *product.Argument {
 	result := make([]product.ResultPos, len(n.ProjectList))
 	for i, expr := range n.ProjectList {
@@ -233,7 +458,7 @@ func constructOrder(n *plan.Node, proc *process.Process) *order.Argument {
 	fs := make([]order.Field, len(n.OrderBy))
 	for i, e := range n.OrderBy {
 		fs[i].E = e.Expr
-		if e.Collation == "DESC" {
+		if e.Flag == plan.OrderBySpec_DESC || e.Collation == "DESC" {
 			fs[i].Type = order.Descending
 		}
 	}
@@ -262,6 +487,17 @@ func constructLimit(n *plan.Node, proc *process.Process) *limit.Argument {
 	}
 }
 
+func constructSample(smp *plan2.Sample, seed int64) *sample.Argument {
+	arg := &sample.Argument{
+		Percent: smp.Percent,
+		Seed:    seed,
+	}
+	if smp.Method == tree.SampleBernoulli {
+		arg.Method = sample.Bernoulli
+	}
+	return arg
+}
+
 func constructGroup(n *plan.Node) *group.Argument {
 	aggs := make([]aggregate.Aggregate, len(n.AggList))
 	for i, expr := range n.AggList {
@@ -274,6 +510,13 @@ func constructGroup(n *plan.Node) *group.Argument {
 				Op: fun.AggregateInfo,
 				E:  f.F.Args[0],
 			}
+			if len(f.F.Args) > 1 {
+				if aggregate.Binary(fun.AggregateInfo) {
+					aggs[i].E2 = f.F.Args[1]
+				} else {
+					aggs[i].Param = constantParam(f.F.Args[1])
+				}
+			}
 		}
 	}
 
@@ -283,6 +526,19 @@ func constructGroup(n *plan.Node) *group.Argument {
 	}
 }
 
+// constantParam returns the value of the constant parameter of an aggregate
+func constantParam(e *plan.Expr) float64 {
+	if c, ok := e.Expr.(*plan.Expr_C); ok {
+		switch v := c.C.Value.(type) {
+		case *plan.Const_Dval:
+			return v.Dval
+		case *plan.Const_Ival:
+			return float64(v.Ival)
+		}
+	}
+	return 0
+}
+
 func constructMergeGroup(_ *plan.Node, needEval bool) *mergegroup.Argument {
 	return &mergegroup.Argument{
 		NeedEval: needEval,
@@ -297,7 +553,7 @@ func constructMergeTop(n *plan.Node, proc *process.Process) *mergetop.Argument {
 	fs := make([]top.Field, len(n.OrderBy))
 	for i, e := range n.OrderBy {
 		fs[i].E = e.Expr
-		if e.Collation == "DESC" {
+		if e.Flag == plan.OrderBySpec_DESC || e.Collation == "DESC" {
 			fs[i].Type = top.Descending
 		}
 	}
@@ -331,7 +587,7 @@ func constructMergeOrder(n *plan.Node, proc *process.Process) *mergeorder.Argume
 	fs := make([]order.Field, len(n.OrderBy))
 	for i, e := range n.OrderBy {
 		fs[i].E = e.Expr
-		if e.Collation == "DESC" {
+		if e.Flag == plan.OrderBySpec_DESC || e.Collation == "DESC" {
 			fs[i].Type = order.Descending
 		}
 	}
@@ -390,3 +646,347 @@ func constructJoinCondition(expr *plan.Expr) (int32, types.Type, int32, types.Ty
 			Precision: e.F.Args[0].Typ.Precision,
 		}
 }
+
+func constructInsert(n *plan.Node, rel engine.Relation) *insert.Argument {
+	attrs, typs := constructTableColumns(n.TableDef)
+	arg := &insert.Argument{
+		TargetTable: rel,
+		Attrs:       attrs,
+		Types:       typs,
+		PrimaryKey:  getPrimaryKeyPos(n.TableDef),
+	}
+	if n.UpdateList != nil {
+		arg.OnDuplicate = true
+		arg.Assigned = make([]bool, len(attrs))
+		for _, e := range n.UpdateList.Columns {
+			for i, attr := range attrs {
+				if attr == e.ColName {
+					arg.Assigned[i] = true
+				}
+			}
+		}
+	}
+	return arg
+}
+
+func constructUpdate(n *plan.Node, rel engine.Relation) *update.Argument {
+	attrs, typs := constructTableColumns(n.TableDef)
+	arg := &update.Argument{
+		TargetTable: rel,
+		Attrs:       attrs,
+		Types:       typs,
+		PrimaryKey:  getPrimaryKeyPos(n.TableDef),
+	}
+	for _, e := range n.UpdateList.GetColumns() {
+		if e.ColName == attrs[arg.PrimaryKey] {
+			arg.UpdatePrimaryKey = true
+		}
+	}
+	return arg
+}
+
+// constructChecks returns the enforced CHECK constraints of the table
+func constructChecks(tableDef *plan.TableDef) ([]*colexec.Check, error) {
+	defs, err := plan2.GetCheckDefs(tableDef)
+	if err != nil {
+		return nil, err
+	}
+	var checks []*colexec.Check
+	for _, def := range defs {
+		if !def.Enforced {
+			continue
+		}
+		expr, err := def.CheckExpr()
+		if err != nil {
+			return nil, err
+		}
+		checks = append(checks, &colexec.Check{
+			Name: def.Name,
+			Expr: expr,
+		})
+	}
+	return checks, nil
+}
+
+func constructDeletion(n *plan.Node, rel engine.Relation) *deletion.Argument {
+	return &deletion.Argument{
+		TargetTable: rel,
+		PrimaryKey:  n.TableDef.Cols[getPrimaryKeyPos(n.TableDef)].Name,
+	}
+}
+
+func constructTableColumns(tableDef *plan.TableDef) ([]string, []types.Type) {
+	attrs := make([]string, len(tableDef.Cols))
+	typs := make([]types.Type, len(tableDef.Cols))
+	for i, col := range tableDef.Cols {
+		attrs[i] = col.Name
+		typs[i] = constructType(col.Typ)
+	}
+	return attrs, typs
+}
+
+// markColumns marks the columns of the scanned table referred by the expression
+func markColumns(e *plan.Expr, used []bool) {
+	switch ex := e.Expr.(type) {
+	case *plan.Expr_Col:
+		if ex.Col.RelPos == 0 && int(ex.Col.ColPos) < len(used) {
+			used[ex.Col.ColPos] = true
+		}
+	case *plan.Expr_F:
+		for _, arg := range ex.F.Args {
+			markColumns(arg, used)
+		}
+	case *plan.Expr_List:
+		for _, arg := range ex.List.List {
+			markColumns(arg, used)
+		}
+	}
+}
+
+func constructType(typ *plan.Type) types.Type {
+	return types.Type{
+		Oid:       types.T(typ.Id),
+		Size:      typ.Size,
+		Width:     typ.Width,
+		Scale:     typ.Scale,
+		Precision: typ.Precision,
+	}
+}
+
+// getPrimaryKeyPos returns the position of the primary key in the table's columns,
+// the first column is used as the hidden key of the tables without primary key.
+func getPrimaryKeyPos(tableDef *plan.TableDef) int {
+	for i, col := range tableDef.Cols {
+		if col.Primary {
+			return i
+		}
+	}
+	return 0
+}
+
+// constructValueScan returns the batch of the rows of a VALUES clause
+func constructValueScan(n *plan.Node, proc *process.Process) (*batch.Batch, error) {
+	rowset := n.RowsetData
+	bat := batch.NewWithSize(len(rowset.Cols))
+	rows := 0
+	for i, col := range rowset.Cols {
+		vec, err := constructValueVector(col, rowset.Schema.Cols[i], proc)
+		if err != nil {
+			return nil, err
+		}
+		bat.Vecs[i] = vec
+		rows = int(col.RowCount)
+	}
+	bat.InitZsOne(rows)
+	return bat, nil
+}
+
+func constructValueVector(col *plan.ColData, def *plan.ColDef, proc *process.Process) (*vector.Vector, error) {
+	vec := vector.New(constructType(def.Typ))
+	for i, isNull := range col.Nulls {
+		if isNull {
+			nulls.Add(vec.Nsp, uint64(i))
+		}
+	}
+	outOfRange := func(row int) error {
+		return errors.New(errno.DataException, fmt.Sprintf("Out of range value for column '%s' at row %v", def.Name, row+1))
+	}
+	invalid := func(row int, err error) error {
+		return errors.New(errno.DataException, fmt.Sprintf("Incorrect value '%s' for column '%s' at row %v: %v", col.S[row], def.Name, row+1, err))
+	}
+
+	var err error
+	switch vec.Typ.Oid {
+	case types.T_int8:
+		vs := make([]int8, len(col.I32))
+		for i, v := range col.I32 {
+			if vs[i] = int8(v); int32(vs[i]) != v {
+				return nil, outOfRange(i)
+			}
+		}
+		vec.Col = vs
+	case types.T_int16:
+		vs := make([]int16, len(col.I32))
+		for i, v := range col.I32 {
+			if vs[i] = int16(v); int32(vs[i]) != v {
+				return nil, outOfRange(i)
+			}
+		}
+		vec.Col = vs
+	case types.T_int32:
+		vec.Col = col.I32
+	case types.T_uint8:
+		vs := make([]uint8, len(col.I32))
+		for i, v := range col.I32 {
+			if vs[i] = uint8(v); int32(vs[i]) != v {
+				return nil, outOfRange(i)
+			}
+		}
+		vec.Col = vs
+	case types.T_uint16:
+		vs := make([]uint16, len(col.I32))
+		for i, v := range col.I32 {
+			if vs[i] = uint16(v); int32(vs[i]) != v {
+				return nil, outOfRange(i)
+			}
+		}
+		vec.Col = vs
+	case types.T_int64:
+		vec.Col = col.I64
+	case types.T_uint32:
+		vs := make([]uint32, len(col.I64))
+		for i, v := range col.I64 {
+			if vs[i] = uint32(v); int64(vs[i]) != v {
+				return nil, outOfRange(i)
+			}
+		}
+		vec.Col = vs
+	case types.T_uint64:
+		vs := make([]uint64, len(col.I64))
+		for i, v := range col.I64 {
+			if v < 0 {
+				return nil, outOfRange(i)
+			}
+			vs[i] = uint64(v)
+		}
+		vec.Col = vs
+	case types.T_float32:
+		vec.Col = col.F32
+	case types.T_float64:
+		vec.Col = col.F64
+	case types.T_decimal64:
+		vs := make([]types.Decimal64, len(col.F64))
+		for i, v := range col.F64 {
+			if vs[i], err = types.Decimal64FromFloat64(v, vec.Typ.Width, vec.Typ.Scale); err != nil {
+				return nil, outOfRange(i)
+			}
+		}
+		vec.Col = vs
+	case types.T_decimal128:
+		vs := make([]types.Decimal128, len(col.F64))
+		for i, v := range col.F64 {
+			if vs[i], err = types.Decimal128FromFloat64(v, vec.Typ.Width, vec.Typ.Scale); err != nil {
+				return nil, outOfRange(i)
+			}
+		}
+		vec.Col = vs
+	case types.T_char, types.T_varchar, types.T_text, types.T_blob:
+		vs := make([][]byte, len(col.S))
+		for i, v := range col.S {
+			vs[i] = []byte(v)
+		}
+		if err := vector.Append(vec, vs); err != nil {
+			return nil, err
+		}
+	case types.T_json:
+		vs := make([][]byte, len(col.S))
+		for i, v := range col.S {
+			if col.Nulls[i] {
+				continue
+			}
+			bj, err := bytejson.ParseFromString(v)
+			if err != nil {
+				return nil, invalid(i, err)
+			}
+			vs[i] = bj.Marshal()
+		}
+		if err := vector.Append(vec, vs); err != nil {
+			return nil, err
+		}
+	case types.T_date:
+		vs := make([]types.Date, len(col.S))
+		for i, v := range col.S {
+			if col.Nulls[i] {
+				continue
+			}
+			if vs[i], err = types.ParseDate(v); err != nil {
+				return nil, invalid(i, err)
+			}
+		}
+		vec.Col = vs
+	case types.T_datetime:
+		vs := make([]types.Datetime, len(col.S))
+		for i, v := range col.S {
+			if col.Nulls[i] {
+				continue
+			}
+			if vs[i], err = types.ParseDatetime(v); err != nil {
+				return nil, invalid(i, err)
+			}
+		}
+		vec.Col = vs
+	case types.T_timestamp:
+		vs := make([]types.Timestamp, len(col.S))
+		for i, v := range col.S {
+			if col.Nulls[i] {
+				continue
+			}
+			if vs[i], err = types.ParseTimestamp(proc.TimeZone, v, vec.Typ.Precision); err != nil {
+				return nil, invalid(i, err)
+			}
+		}
+		vec.Col = vs
+	case types.T_time:
+		vs := make([]types.Time, len(col.S))
+		for i, v := range col.S {
+			if col.Nulls[i] {
+				continue
+			}
+			if vs[i], err = types.ParseTime(v, vec.Typ.Precision); err != nil {
+				return nil, invalid(i, err)
+			}
+		}
+		vec.Col = vs
+	case types.T_year:
+		vs := make([]types.YearValue, len(col.S))
+		for i, v := range col.S {
+			if col.Nulls[i] {
+				continue
+			}
+			if vs[i], err = types.ParseYear(v); err != nil {
+				return nil, invalid(i, err)
+			}
+		}
+		vec.Col = vs
+	case types.T_uuid:
+		vs := make([]types.Uuid, len(col.S))
+		for i, v := range col.S {
+			if col.Nulls[i] {
+				continue
+			}
+			if vs[i], err = types.ParseUuid(v); err != nil {
+				return nil, invalid(i, err)
+			}
+		}
+		vec.Col = vs
+	case types.T_enum:
+		vs := make([]uint16, len(col.S))
+		for i, v := range col.S {
+			if col.Nulls[i] {
+				continue
+			}
+			if vs[i], err = types.ParseEnum(def.Typ.EnumValues, v); err != nil {
+				return nil, invalid(i, err)
+			}
+		}
+		vec.Col = vs
+	case types.T_set:
+		vs := make([]uint64, len(col.S))
+		for i, v := range col.S {
+			if col.Nulls[i] {
+				continue
+			}
+			if vs[i], err = types.ParseSet(def.Typ.EnumValues, v); err != nil {
+				return nil, invalid(i, err)
+			}
+		}
+		vec.Col = vs
+	default:
+		return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("insert values of type '%s' not support now", vec.Typ))
+	}
+	if vector.Length(vec) != int(col.RowCount) {
+		return nil, errors.New(errno.DatatypeMismatch, fmt.Sprintf("Incorrect values for column '%s'", def.Name))
+	}
+	// the operators read the fixed-length values from the memory of the vector
+	return vector.Dup(vec, proc.Mp)
+}
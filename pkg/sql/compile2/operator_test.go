@@ -0,0 +1,65 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compile2
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/stretchr/testify/require"
+)
+
+func colExpr(relPos, colPos int32) *plan.Expr {
+	return &plan.Expr{
+		Expr: &plan.Expr_Col{
+			Col: &plan.ColRef{RelPos: relPos, ColPos: colPos},
+		},
+	}
+}
+
+func TestAggregateExprList(t *testing.T) {
+	// select a, sum(b), max(c) + a ... group by a, d
+	n := &plan.Node{
+		NodeType: plan.Node_AGG,
+		GroupBy:  []*plan.Expr{colExpr(0, 0), colExpr(0, 3)},
+		ProjectList: []*plan.Expr{
+			colExpr(-1, 0),
+			colExpr(-2, 0),
+			{
+				Expr: &plan.Expr_F{
+					F: &plan.Function{
+						Func: &plan.ObjectRef{ObjName: "+"},
+						Args: []*plan.Expr{colExpr(-2, 1), colExpr(-1, 0)},
+					},
+				},
+			},
+		},
+	}
+	es := constructProjection(n).Es
+	// the aggregates follow the 2 columns of the group by
+	require.Equal(t, int32(0), es[0].Expr.(*plan.Expr_Col).Col.ColPos)
+	require.Equal(t, int32(2), es[1].Expr.(*plan.Expr_Col).Col.ColPos)
+	args := es[2].Expr.(*plan.Expr_F).F.Args
+	require.Equal(t, int32(3), args[0].Expr.(*plan.Expr_Col).Col.ColPos)
+	require.Equal(t, int32(0), args[1].Expr.(*plan.Expr_Col).Col.ColPos)
+	// the plan is not modified
+	require.Equal(t, int32(0), n.ProjectList[1].Expr.(*plan.Expr_Col).Col.ColPos)
+
+	// without group by the batch of the group operator holds the aggregates only
+	n.GroupBy = nil
+	require.Equal(t, n.ProjectList, constructProjection(n).Es)
+	n.NodeType = plan.Node_PROJECT
+	require.Equal(t, n.ProjectList, constructProjection(n).Es)
+}
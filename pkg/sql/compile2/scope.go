@@ -17,12 +17,17 @@ package compile2
 import (
 	"context"
 	"fmt"
+	"io"
 	"runtime"
+	"strconv"
 
 	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/errno"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/connector"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/dispatch"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/group"
@@ -35,7 +40,11 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/mergetop"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/offset"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/order"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/output"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/top"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan2"
+	"github.com/matrixorigin/matrixone/pkg/sql/viewexec/mview"
 	"github.com/matrixorigin/matrixone/pkg/vm"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
@@ -71,7 +80,20 @@ func (s *Scope) CreateTable(ts uint64, snapshot engine.Snapshot, engine engine.E
 	if err != nil {
 		return err
 	}
-	return dbSource.Create(ts, qry.GetTableDef().GetName(), append(exeCols, exeDefs...), snapshot)
+	if qry.GetIfNotExists() {
+		if _, err := dbSource.Relation(qry.GetTableDef().GetName(), snapshot); err == nil {
+			return nil
+		}
+	}
+	if err := dbSource.Create(ts, qry.GetTableDef().GetName(), append(exeCols, exeDefs...), snapshot); err != nil {
+		return err
+	}
+	// a materialized view is filled with the groups of the rows of its table
+	view, err := plan2.GetMaterializedViewDef(qry.GetTableDef())
+	if err != nil || view == nil {
+		return err
+	}
+	return mview.Refresh(engine, snapshot, dbName, qry.GetTableDef().GetName())
 }
 
 func (s *Scope) DropTable(ts uint64, snapshot engine.Snapshot, engine engine.Engine) error {
@@ -80,13 +102,26 @@ func (s *Scope) DropTable(ts uint64, snapshot engine.Snapshot, engine engine.Eng
 	dbName := qry.GetDatabase()
 	dbSource, err := engine.Database(dbName, snapshot)
 	if err != nil {
+		if qry.GetIfExists() {
+			return nil
+		}
 		return err
 	}
 
 	tblName := qry.GetTable()
+	if qry.GetIfExists() {
+		if _, err := dbSource.Relation(tblName, snapshot); err != nil {
+			return nil
+		}
+	}
 	return dbSource.Delete(ts, tblName, snapshot)
 }
 
+func (s *Scope) RefreshMaterializedView(ts uint64, snapshot engine.Snapshot, engine engine.Engine) error {
+	qry := s.Plan.GetDdl().GetRefreshMaterializedView()
+	return mview.Refresh(engine, snapshot, qry.GetDatabase(), qry.GetTable())
+}
+
 func (s *Scope) CreateIndex(ts uint64, snapshot engine.Snapshot, engine engine.Engine) error {
 	return nil
 }
@@ -127,13 +162,7 @@ func planDefsToExeDefs(planDefs []*plan.TableDef_DefType) []engine.TableDef {
 func planColsToExeCols(planCols []*plan.ColDef) []engine.TableDef {
 	exeCols := make([]engine.TableDef, len(planCols))
 	for i, col := range planCols {
-		var alg compress.T
-		switch col.Alg {
-		case plan.CompressType_None:
-			alg = compress.None
-		case plan.CompressType_Lz4:
-			alg = compress.Lz4
-		}
+		alg := compress.NewT(compress.T(col.Alg), compress.Encoding(col.Encoding))
 		colTyp := col.GetTyp()
 		exeCols[i] = &engine.AttributeDef{
 			Attr: engine.Attribute{
@@ -151,7 +180,9 @@ func planColsToExeCols(planCols []*plan.ColDef) []engine.TableDef {
 					Value:  planValToExeVal(col.GetDefault().GetValue(), colTyp.GetId()),
 					IsNull: col.GetDefault().GetIsNull(),
 				},
-				Primary: col.GetPrimary(),
+				Primary:       col.GetPrimary(),
+				AutoIncrement: col.GetAutoIncrement(),
+				EnumValues:    colTyp.GetEnumValues(),
 			},
 		}
 	}
@@ -170,6 +201,10 @@ func planValToExeVal(value *plan.ConstantValue, typ plan.Type_TypeId) interface{
 			return int32(v.Int64V)
 		case plan.Type_INT64:
 			return v.Int64V
+		case plan.Type_TIME:
+			return types.Time(v.Int64V)
+		case plan.Type_YEAR:
+			return types.YearValue(v.Int64V)
 		}
 	case *plan.ConstantValue_Uint64V:
 		switch typ {
@@ -213,7 +248,7 @@ func planValToExeVal(value *plan.ConstantValue, typ plan.Type_TypeId) interface{
 // Print is to format scope list
 func PrintScope(prefix []byte, ss []*Scope) {
 	for _, s := range ss {
-		if s.Magic == Merge || s.Magic == Remote {
+		if s.Magic == Merge || s.Magic == Remote || s.Magic == Recursive {
 			PrintScope(append(prefix, '\t'), s.PreScopes)
 		}
 		p := pipeline2.NewMerge(s.Instructions, nil)
@@ -234,6 +269,9 @@ func (s *Scope) Run(e engine.Engine) (err error) {
 			return err
 		}
 	} else {
+		if c, ok := s.DataSource.R.(io.Closer); ok {
+			defer c.Close()
+		}
 		if _, err = p.Run(s.DataSource.R, s.Proc); err != nil {
 			return err
 		}
@@ -278,6 +316,14 @@ func (s *Scope) MergeRun(e engine.Engine) error {
 				}()
 				err = cs.ParallelRun(e)
 			}(s.PreScopes[i])
+		case Recursive:
+			go func(cs *Scope) {
+				var err error
+				defer func() {
+					errChan <- err
+				}()
+				err = cs.RecursiveRun(e)
+			}(s.PreScopes[i])
 		}
 	}
 	p := pipeline2.NewMerge(s.Instructions, s.Reg)
@@ -311,6 +357,7 @@ func (s *Scope) DispatchRun(e engine.Engine) error {
 		ss[i].Proc.Id = s.Proc.Id
 		ss[i].Proc.Lim = s.Proc.Lim
 		ss[i].Proc.UnixTime = s.Proc.UnixTime
+		ss[i].Proc.TimeZone = s.Proc.TimeZone
 		ss[i].Proc.Snapshot = s.Proc.Snapshot
 		ss[i].Proc.Cancel = cancel
 		ss[i].Proc.Reg.MergeReceivers = make([]*process.WaitRegister, len(s.PreScopes))
@@ -358,6 +405,146 @@ func (s *Scope) DispatchRun(e engine.Engine) error {
 	return s.MergeRun(e)
 }
 
+// RecursiveRun runs a recursive cte. The rows of the non-recursive part are the working table of the
+// first iteration, and the rows of every iteration are the working table of the next one, until an
+// iteration produces no rows. All the rows are processed by the instructions of the scope at last.
+func (s *Scope) RecursiveRun(e engine.Engine) error {
+	bat, err := s.recursiveRows(e)
+	if err != nil {
+		// an empty batch still ends the pipeline, so the consumers of the scope are not blocked
+		bat = colexec2.NewWriteBatch(s.Recursive.Attrs, s.Recursive.Types)
+	}
+	p := pipeline2.New(nil, s.Instructions, s.Reg)
+	if _, rerr := p.ConstRun(bat, s.Proc); err == nil {
+		err = rerr
+	}
+	return err
+}
+
+// recursiveRows runs the anchor part and then the recursive part until no new rows are produced,
+// and returns all the rows of the recursive cte
+func (s *Scope) recursiveRows(e engine.Engine) (*batch.Batch, error) {
+	rc := s.Recursive
+	rows, err := s.collectRows(s.PreScopes, e)
+	if err != nil {
+		return nil, err
+	}
+	var seen map[string]struct{}
+	if rc.Distinct {
+		seen = make(map[string]struct{})
+	}
+	bat := colexec2.NewWriteBatch(rc.Attrs, rc.Types)
+	for depth := int64(0); ; depth++ {
+		if seen != nil {
+			if rows, err = distinctRows(rows, seen, s.Proc); err != nil {
+				bat.Clean(s.Proc.Mp)
+				return nil, err
+			}
+		}
+		if len(rows.Zs) == 0 {
+			rows.Clean(s.Proc.Mp)
+			return bat, nil
+		}
+		if depth > s.Proc.Lim.MaxRecursionDepth {
+			rows.Clean(s.Proc.Mp)
+			bat.Clean(s.Proc.Mp)
+			return nil, errors.New(errno.ProgramLimitExceeded, fmt.Sprintf("Recursive query aborted after %v iterations. Try increasing @@cte_max_recursion_depth to a larger value", depth))
+		}
+		if err = colexec2.AppendBatch(bat, rows, s.Proc); err != nil {
+			rows.Clean(s.Proc.Mp)
+			bat.Clean(s.Proc.Mp)
+			return nil, err
+		}
+		ss, err := rc.Iterate(rows)
+		if err != nil {
+			bat.Clean(s.Proc.Mp)
+			return nil, err
+		}
+		if rows, err = s.collectRows(ss, e); err != nil {
+			bat.Clean(s.Proc.Mp)
+			return nil, err
+		}
+	}
+}
+
+// collectRows runs the scopes and returns all the rows of them as a batch of the recursive cte
+func (s *Scope) collectRows(ss []*Scope, e engine.Engine) (*batch.Batch, error) {
+	bat := colexec2.NewWriteBatch(s.Recursive.Attrs, s.Recursive.Types)
+	if err := runScopes(ss, e, s.Proc, func(rows *batch.Batch) error {
+		return colexec2.AppendBatch(bat, rows, s.Proc)
+	}); err != nil {
+		bat.Clean(s.Proc.Mp)
+		return nil, err
+	}
+	return bat, nil
+}
+
+// NewReader returns a reader of the rows of the material, the batches read are allocated by proc
+func (m *Material) NewReader(proc *process.Process) engine.Reader {
+	return &materialReader{
+		m: m,
+		r: m.spool.NewReader(proc),
+	}
+}
+
+// Read computes the rows of the material if no reference has read them,
+// and returns the next batch of the rows.
+func (r *materialReader) Read(refCnts []uint64, attrs []string) (*batch.Batch, error) {
+	m := r.m
+	m.once.Do(func() {
+		m.err = runScopes(m.Scopes, m.e, m.proc, m.spool.Append)
+	})
+	if m.err != nil {
+		return nil, m.err
+	}
+	return r.r.Read(refCnts, attrs)
+}
+
+// runScopes runs the scopes and calls fill for every batch produced by them
+func runScopes(ss []*Scope, e engine.Engine, proc *process.Process, fill func(*batch.Batch) error) error {
+	rs := &Scope{
+		Magic:     Merge,
+		PreScopes: ss,
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	rs.Proc = process.New(mheap.New(proc.Mp.Gm))
+	rs.Proc.Cancel = cancel
+	rs.Proc.Id = proc.Id
+	rs.Proc.Lim = proc.Lim
+	rs.Proc.UnixTime = proc.UnixTime
+	rs.Proc.TimeZone = proc.TimeZone
+	rs.Proc.Snapshot = proc.Snapshot
+	rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, len(ss))
+	for i := range ss {
+		rs.Proc.Reg.MergeReceivers[i] = &process.WaitRegister{
+			Ctx: ctx,
+			Ch:  make(chan *batch.Batch, 1),
+		}
+		ss[i].Instructions = append(ss[i].Instructions, vm.Instruction{
+			Op: overload.Connector,
+			Arg: &connector.Argument{
+				Mmu: rs.Proc.Mp.Gm,
+				Reg: rs.Proc.Reg.MergeReceivers[i],
+			},
+		})
+	}
+	rs.Instructions = vm.Instructions{
+		{
+			Op:  overload.Merge,
+			Arg: &merge.Argument{},
+		},
+		{
+			Op: overload.Output,
+			Arg: &output.Argument{
+				Func: func(_ interface{}, rows *batch.Batch) error {
+					return fill(rows)
+				},
+			},
+		},
+	}
+	return rs.MergeRun(e)
+}
+
 // RemoteRun send the scope to a remote node (if target node is itself, it is same to function ParallelRun) and run it.
 func (s *Scope) RemoteRun(e engine.Engine) error {
 	return s.ParallelRun(e)
@@ -382,6 +569,13 @@ func (s *Scope) ParallelRun(e engine.Engine) error {
 			return err
 		}
 		rds = rel.NewReader(mcpu, nil, s.NodeInfo.Data, snap)
+		if len(s.DataSource.RuntimeFilters) > 0 {
+			for _, rd := range rds {
+				if r, ok := rd.(engine.RuntimeFilterReader); ok {
+					r.SetRuntimeFilters(s.DataSource.RuntimeFilters)
+				}
+			}
+		}
 	}
 	ss := make([]*Scope, mcpu)
 	for i := 0; i < mcpu; i++ {
@@ -398,6 +592,7 @@ func (s *Scope) ParallelRun(e engine.Engine) error {
 		ss[i].Proc.Id = s.Proc.Id
 		ss[i].Proc.Lim = s.Proc.Lim
 		ss[i].Proc.UnixTime = s.Proc.UnixTime
+		ss[i].Proc.TimeZone = s.Proc.TimeZone
 		ss[i].Proc.Snapshot = s.Proc.Snapshot
 	}
 	{
@@ -543,3 +738,49 @@ func (s *Scope) ParallelRun(e engine.Engine) error {
 	}
 	return s.MergeRun(e)
 }
+
+// distinctRows removes the rows of bat which are seen before, and adds the rest to seen
+func distinctRows(bat *batch.Batch, seen map[string]struct{}, proc *process.Process) (*batch.Batch, error) {
+	var key []byte
+
+	sels := make([]int64, 0, len(bat.Zs))
+	for i := range bat.Zs {
+		key = key[:0]
+		for _, vec := range bat.Vecs {
+			if nulls.Contains(vec.Nsp, uint64(i)) {
+				key = append(key, 0)
+				continue
+			}
+			v := colexec2.ValueKey(vec, int64(i))
+			key = append(key, 1)
+			key = strconv.AppendInt(key, int64(len(v)), 10)
+			key = append(key, ':')
+			key = append(key, v...)
+		}
+		if _, ok := seen[string(key)]; ok {
+			continue
+		}
+		seen[string(key)] = struct{}{}
+		sels = append(sels, int64(i))
+	}
+	if len(sels) == len(bat.Zs) {
+		return bat, nil
+	}
+	rbat := batch.NewWithSize(len(bat.Vecs))
+	rbat.Attrs = bat.Attrs
+	for i, vec := range bat.Vecs {
+		rvec, err := colexec2.CopyRows(vec, sels, proc)
+		if err != nil {
+			rbat.Clean(proc.Mp)
+			bat.Clean(proc.Mp)
+			return nil, err
+		}
+		rbat.Vecs[i] = rvec
+	}
+	rbat.Zs = make([]int64, len(sels))
+	for i := range rbat.Zs {
+		rbat.Zs[i] = 1
+	}
+	bat.Clean(proc.Mp)
+	return rbat, nil
+}
@@ -15,9 +15,12 @@
 package compile2
 
 import (
+	"sync"
+
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/spool"
 	"github.com/matrixorigin/matrixone/pkg/vm"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
@@ -29,12 +32,14 @@ const (
 	Normal
 	Remote
 	Parallel
+	Recursive
 	CreateDatabase
 	CreateTable
 	CreateIndex
 	DropDatabase
 	DropTable
 	DropIndex
+	RefreshMaterializedView
 )
 
 // Address is the ip:port of local node
@@ -47,6 +52,8 @@ type Source struct {
 	Attributes   []string
 	R            engine.Reader
 	Bat          *batch.Batch
+	// RuntimeFilters are set to the readers of the relation if they can apply them
+	RuntimeFilters []*engine.RuntimeFilter
 }
 
 // Col is the information of attribute
@@ -80,10 +87,44 @@ type Scope struct {
 	Proc *process.Process
 
 	Reg *process.WaitRegister
+
+	// Recursive is used by the scope running a recursive cte.
+	Recursive *RecursiveCte
+}
+
+// RecursiveCte contains the information needed to run a recursive cte.
+// The non-recursive part of the cte is the pre-scopes of the scope running it.
+type RecursiveCte struct {
+	// Distinct is true if the rows of the iterations are combined by UNION,
+	// the duplicate rows are discarded.
+	Distinct bool
+	Attrs    []string
+	Types    []types.Type
+	// Iterate returns the scopes of the recursive part reading the rows of the last iteration.
+	Iterate func(*batch.Batch) ([]*Scope, error)
+}
+
+// Material computes the rows of a SINK, the sink of a cte referenced more than once or of an
+// uncorrelated subquery. The rows are computed once by the first reference reading them, and
+// are buffered in a spool read by all the references.
+type Material struct {
+	once  sync.Once
+	err   error
+	e     engine.Engine
+	proc  *process.Process
+	spool *spool.Spool
+	// Scopes computes the rows of the sink.
+	Scopes []*Scope
+}
+
+// materialReader reads the rows of a material for a reference of the cte
+type materialReader struct {
+	m *Material
+	r *spool.Reader
 }
 
-// compile contains all the information needed for compilation.
-type compile struct {
+// Compile contains all the information needed for compilation.
+type Compile struct {
 	scope *Scope
 	u     interface{}
 	//fill is a result writer runs a callback function.
@@ -100,4 +141,13 @@ type compile struct {
 	e engine.Engine
 	// proc stores the execution context.
 	proc *process.Process
+	// working maps the name of a recursive cte to the rows of its last iteration,
+	// it is used to compile the recursive part of the cte.
+	working map[string]*batch.Batch
+	// materials maps the id of a SINK to its rows shared by the references.
+	materials map[int32]*Material
+	// joinFilters maps the id of a hash join to the runtime filters built from its right side,
+	// scanFilters maps the id of a table scan to the runtime filters applied by its readers.
+	joinFilters map[int32][]*engine.RuntimeFilter
+	scanFilters map[int32][]*engine.RuntimeFilter
 }
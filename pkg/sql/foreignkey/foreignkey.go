@@ -0,0 +1,383 @@
+// Copyright 2021 - 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package foreignkey
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan2"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+)
+
+// Check is the engine.ConstraintChecker of the foreign keys. The rows appended to a table must
+// reference existing rows of the tables referenced by its foreign keys, and the rows referencing
+// the rows deleted from a table are deleted, set null or make the transaction fail.
+func Check(e engine.Engine, snapshot engine.Snapshot, ts uint64, mp *mheap.Mheap, deltas []*engine.Delta) error {
+	c := &checker{
+		e:        e,
+		snapshot: snapshot,
+		ts:       ts,
+		mp:       mp,
+	}
+	// the actions of the rows deleted are applied before the rows appended are checked,
+	// since the rows appended may reference the rows deleted
+	for _, deleted := range []bool{true, false} {
+		for _, delta := range deltas {
+			if delta.Deleted != deleted {
+				continue
+			}
+			rel, err := c.relation(delta.Database, delta.Table)
+			if err != nil {
+				return err
+			}
+			if deleted {
+				err = c.checkDeleted(rel, delta)
+			} else {
+				err = c.checkAppended(rel, delta)
+			}
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+type checker struct {
+	e        engine.Engine
+	snapshot engine.Snapshot
+	ts       uint64
+	mp       *mheap.Mheap
+}
+
+// checkAppended checks that the rows appended to the table reference existing rows. A row
+// referencing no row may be deleted or changed by the actions applied before, so such rows
+// are looked up again by their primary keys before the transaction fails.
+func (c *checker) checkAppended(rel engine.Relation, delta *engine.Delta) error {
+	fks, err := foreignKeys(rel, c.snapshot)
+	if err != nil || len(fks) == 0 {
+		return err
+	}
+	for _, fk := range fks {
+		vec := batch.GetVector(delta.Bat, fk.Column)
+		if vec == nil {
+			return errors.New(errno.UndefinedColumn, fmt.Sprintf("column '%v' of the foreign key '%v' doesn't exist", fk.Column, fk.Name))
+		}
+		parent, err := c.keyRelation(referenced(fk, delta.Database), fk.Table)
+		if err != nil {
+			return err
+		}
+		_, sels, err := parent.GetByKeys(vec, []string{fk.RefColumn}, c.snapshot)
+		if err != nil {
+			return err
+		}
+		rows := missingRows(vec, sels)
+		if len(rows) == 0 {
+			continue
+		}
+		child, err := keyRelation(rel, delta.Table)
+		if err != nil {
+			return err
+		}
+		pk := primaryKey(rel, c.snapshot)
+		keys, err := c.union(batch.GetVector(delta.Bat, pk), rows)
+		if err != nil {
+			return err
+		}
+		bat, _, err := child.GetByKeys(keys, []string{fk.Column}, c.snapshot)
+		vector.Clean(keys, c.mp)
+		if err != nil {
+			return err
+		}
+		_, sels, err = parent.GetByKeys(bat.Vecs[0], []string{fk.RefColumn}, c.snapshot)
+		if err != nil {
+			return err
+		}
+		if len(missingRows(bat.Vecs[0], sels)) > 0 {
+			return errors.New(errno.IntegrityConstraintViolation, fmt.Sprintf("Cannot add or update a child row: a foreign key constraint fails (%s)", describe(delta.Database, delta.Table, fk)))
+		}
+	}
+	return nil
+}
+
+// checkDeleted applies the actions of the foreign keys referencing the table to the rows
+// referencing the rows deleted. The rows deleted may be added back by an update, and
+// the tables referencing the table are checked only if some keys are really deleted.
+func (c *checker) checkDeleted(rel engine.Relation, delta *engine.Delta) error {
+	if len(delta.Referencing) == 0 {
+		return nil
+	}
+	parent, err := keyRelation(rel, delta.Table)
+	if err != nil {
+		return err
+	}
+	pk := primaryKey(rel, c.snapshot)
+	keys := batch.GetVector(delta.Bat, pk)
+	if keys == nil {
+		return errors.New(errno.UndefinedColumn, fmt.Sprintf("primary key '%v' of the table '%v' doesn't exist", pk, delta.Table))
+	}
+	_, sels, err := parent.GetByKeys(keys, []string{pk}, c.snapshot)
+	if err != nil {
+		return err
+	}
+	rows := missingRows(keys, sels)
+	if len(rows) == 0 {
+		return nil
+	}
+	deleted, err := c.union(keys, rows)
+	if err != nil {
+		return err
+	}
+	defer vector.Clean(deleted, c.mp)
+	for _, ref := range delta.Referencing {
+		dbName, name, _ := strings.Cut(ref, ".")
+		r, err := c.relation(dbName, name)
+		if err != nil {
+			return err
+		}
+		fks, err := foreignKeys(r, c.snapshot)
+		if err != nil {
+			return err
+		}
+		for _, fk := range fks {
+			if referenced(fk, dbName) != delta.Database || fk.Table != delta.Table {
+				continue
+			}
+			if err := c.applyAction(r, dbName, name, parent, fk, deleted); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// applyAction applies the action of the foreign key to the rows of the child table referencing
+// the keys deleted from the parent table
+func (c *checker) applyAction(rel engine.Relation, dbName, name string, parent engine.KeyRelation, fk *plan2.ForeignKeyDef, deleted *vector.Vector) error {
+	var attrs []string
+	for _, def := range rel.TableDefs(c.snapshot) {
+		if attr, ok := def.(*engine.AttributeDef); ok {
+			attrs = append(attrs, attr.Attr.Name)
+		}
+	}
+	bat, err := c.orphans(rel, parent, fk, attrs, deleted)
+	if err != nil || bat == nil {
+		return err
+	}
+	defer c.free(bat)
+	switch fk.OnDelete {
+	case tree.REFERENCE_OPTION_CASCADE:
+		pk := primaryKey(rel, c.snapshot)
+		return rel.Delete(c.ts, batch.GetVector(bat, pk), pk, c.snapshot)
+	case tree.REFERENCE_OPTION_SET_NULL:
+		vec := batch.GetVector(bat, fk.Column)
+		for i := range bat.Zs {
+			nulls.Add(vec.Nsp, uint64(i))
+		}
+		return rel.Update(c.ts, bat, c.snapshot)
+	default:
+		return errors.New(errno.IntegrityConstraintViolation, fmt.Sprintf("Cannot delete or update a parent row: a foreign key constraint fails (%s)", describe(dbName, name, fk)))
+	}
+}
+
+// orphans returns the attrs of the rows of the child table referencing the keys deleted from
+// the parent table, or nil if there is no such row. The attrs must contain the column of the
+// foreign key. The child table is probed by its primary key if it is the column of the foreign
+// key, or else it is read with a runtime filter of the keys.
+func (c *checker) orphans(rel engine.Relation, parent engine.KeyRelation, fk *plan2.ForeignKeyDef, attrs []string, deleted *vector.Vector) (*batch.Batch, error) {
+	var orphans *batch.Batch
+	add := func(bat *batch.Batch) error {
+		vec := batch.GetVector(bat, fk.Column)
+		_, sels, err := parent.GetByKeys(vec, []string{fk.RefColumn}, c.snapshot)
+		if err != nil {
+			return err
+		}
+		rows := missingRows(vec, sels)
+		if len(rows) == 0 {
+			return nil
+		}
+		if orphans == nil {
+			orphans = batch.New(true, attrs)
+			for i, v := range bat.Vecs {
+				orphans.Vecs[i] = vector.New(v.Typ)
+			}
+		}
+		for i, v := range bat.Vecs {
+			if err := vector.Union(orphans.Vecs[i], v, rows, c.mp); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if fk.Column == primaryKey(rel, c.snapshot) {
+		child, err := keyRelation(rel, rel.ID(c.snapshot))
+		if err != nil {
+			return nil, err
+		}
+		bat, _, err := child.GetByKeys(deleted, attrs, c.snapshot)
+		if err != nil {
+			return nil, err
+		}
+		if len(bat.Zs) > 0 {
+			err = add(bat)
+		}
+		return c.finish(orphans, err)
+	}
+
+	f := engine.NewRuntimeFilter(fk.Column, deleted.Typ)
+	if f != nil {
+		b := f.NewBuilder()
+		b.Add(deleted)
+		b.Build()
+	}
+	for _, r := range rel.NewReader(1, nil, nil, c.snapshot) {
+		if fr, ok := r.(engine.RuntimeFilterReader); ok && f != nil {
+			fr.SetRuntimeFilters([]*engine.RuntimeFilter{f})
+		}
+		for {
+			bat, err := r.Read(make([]uint64, len(attrs)), attrs)
+			if err != nil {
+				return c.finish(orphans, err)
+			}
+			if bat == nil {
+				break
+			}
+			if err = add(bat); err != nil {
+				return c.finish(orphans, err)
+			}
+		}
+	}
+	return c.finish(orphans, nil)
+}
+
+// finish returns the orphans, or frees them if there is an error
+func (c *checker) finish(orphans *batch.Batch, err error) (*batch.Batch, error) {
+	if orphans == nil {
+		return nil, err
+	}
+	if err != nil {
+		c.free(orphans)
+		return nil, err
+	}
+	orphans.InitZsOne(vector.Length(orphans.Vecs[0]))
+	return orphans, nil
+}
+
+// union returns the rows of the vector, which is allocated in the memory of the transaction
+func (c *checker) union(vec *vector.Vector, rows []int64) (*vector.Vector, error) {
+	ret := vector.New(vec.Typ)
+	if err := vector.Union(ret, vec, rows, c.mp); err != nil {
+		vector.Clean(ret, c.mp)
+		return nil, err
+	}
+	return ret, nil
+}
+
+func (c *checker) free(bat *batch.Batch) {
+	for _, vec := range bat.Vecs {
+		vector.Clean(vec, c.mp)
+	}
+}
+
+func (c *checker) relation(dbName, name string) (engine.Relation, error) {
+	db, err := c.e.Database(dbName, c.snapshot)
+	if err != nil {
+		return nil, err
+	}
+	return db.Relation(name, c.snapshot)
+}
+
+func (c *checker) keyRelation(dbName, name string) (engine.KeyRelation, error) {
+	rel, err := c.relation(dbName, name)
+	if err != nil {
+		return nil, err
+	}
+	return keyRelation(rel, name)
+}
+
+func describe(dbName, table string, fk *plan2.ForeignKeyDef) string {
+	return fmt.Sprintf("`%s`.`%s`, CONSTRAINT `%s` FOREIGN KEY (`%s`) REFERENCES `%s`.`%s` (`%s`)",
+		dbName, table, fk.Name, fk.Column, referenced(fk, dbName), fk.Table, fk.RefColumn)
+}
+
+// referenced returns the database of the table referenced by the foreign key of a table of
+// the database, the foreign keys defined without it reference a table of the same database
+func referenced(fk *plan2.ForeignKeyDef, dbName string) string {
+	if fk.Database == "" {
+		return dbName
+	}
+	return fk.Database
+}
+
+// foreignKeys returns the foreign keys of the relation
+func foreignKeys(rel engine.Relation, snapshot engine.Snapshot) ([]*plan2.ForeignKeyDef, error) {
+	var fks []*plan2.ForeignKeyDef
+	for _, def := range rel.TableDefs(snapshot) {
+		d, ok := def.(*engine.PropertiesDef)
+		if !ok {
+			continue
+		}
+		for _, p := range d.Properties {
+			if p.Key != plan2.ForeignKeyKey {
+				continue
+			}
+			if err := json.Unmarshal([]byte(p.Value), &fks); err != nil {
+				return nil, errors.New(errno.DataException, fmt.Sprintf("invalid foreign keys of the table '%v'", rel.ID(snapshot)))
+			}
+		}
+	}
+	return fks, nil
+}
+
+func keyRelation(rel engine.Relation, name string) (engine.KeyRelation, error) {
+	r, ok := rel.(engine.KeyRelation)
+	if !ok {
+		return nil, errors.New(errno.FeatureNotSupported, fmt.Sprintf("the foreign keys of the table '%v' can not be checked by the engine", name))
+	}
+	return r, nil
+}
+
+func primaryKey(rel engine.Relation, snapshot engine.Snapshot) string {
+	attrs, _ := rel.GetPriKeyOrHideKey(snapshot)
+	return attrs[0].Name
+}
+
+// missingRows returns the rows of the vector which are not null and not in sels
+func missingRows(vec *vector.Vector, sels []int64) []int64 {
+	found := make(map[int64]struct{}, len(sels))
+	for _, sel := range sels {
+		found[sel] = struct{}{}
+	}
+	var rows []int64
+	for i, n := int64(0), int64(vector.Length(vec)); i < n; i++ {
+		if nulls.Contains(vec.Nsp, uint64(i)) {
+			continue
+		}
+		if _, ok := found[i]; !ok {
+			rows = append(rows, i)
+		}
+	}
+	return rows
+}
@@ -0,0 +1,201 @@
+// Copyright 2021 - 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package foreignkey
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan2"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/dataio/mockio"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/moengine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/testutils"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	ModuleName = "ForeignKey"
+)
+
+var int32Type = types.Type{Oid: types.T_int32, Size: 4}
+
+func TestForeignKey(t *testing.T) {
+	mockio.ResetFS()
+	tae, err := db.Open(testutils.InitTestEnv(ModuleName, t), nil)
+	require.NoError(t, err)
+	defer tae.Close()
+	e := moengine.NewEngine(tae)
+	e.SetConstraintChecker(Check)
+
+	txn, err := e.StartTxn(nil)
+	require.NoError(t, err)
+	require.NoError(t, e.Create(0, "test", 0, txn.GetCtx()))
+	dbase, err := e.Database("test", txn.GetCtx())
+	require.NoError(t, err)
+	createTable(t, dbase, txn.GetCtx(), "parent", nil)
+	createTable(t, dbase, txn.GetCtx(), "restricted", &plan2.ForeignKeyDef{OnDelete: tree.REFERENCE_OPTION_RESTRICT})
+	createTable(t, dbase, txn.GetCtx(), "cascaded", &plan2.ForeignKeyDef{OnDelete: tree.REFERENCE_OPTION_CASCADE})
+	createTable(t, dbase, txn.GetCtx(), "nullified", &plan2.ForeignKeyDef{OnDelete: tree.REFERENCE_OPTION_SET_NULL})
+	createTable(t, dbase, txn.GetCtx(), "twin", &plan2.ForeignKeyDef{Column: "id", OnDelete: tree.REFERENCE_OPTION_CASCADE})
+	require.NoError(t, e.Create(0, "other", 0, txn.GetCtx()))
+	other, err := e.Database("other", txn.GetCtx())
+	require.NoError(t, err)
+	createTable(t, other, txn.GetCtx(), "cousin", &plan2.ForeignKeyDef{OnDelete: tree.REFERENCE_OPTION_CASCADE})
+	require.NoError(t, txn.Commit())
+
+	// the rows referencing missing rows can not be written
+	txn, err = e.StartTxn(nil)
+	require.NoError(t, err)
+	require.NoError(t, relation(t, e, txn.GetCtx(), "parent").Write(0, rows([]int32{1, 2, 3}, nil), txn.GetCtx()))
+	require.NoError(t, relation(t, e, txn.GetCtx(), "cascaded").Write(0, rows([]int32{10, 11, 12}, []int32{1, 2, -1}), txn.GetCtx()))
+	require.NoError(t, relation(t, e, txn.GetCtx(), "nullified").Write(0, rows([]int32{20, 21}, []int32{1, 2}), txn.GetCtx()))
+	require.NoError(t, relation(t, e, txn.GetCtx(), "restricted").Write(0, rows([]int32{30}, []int32{4}), txn.GetCtx()))
+	require.Error(t, txn.Commit())
+
+	txn, err = e.StartTxn(nil)
+	require.NoError(t, err)
+	require.NoError(t, relation(t, e, txn.GetCtx(), "parent").Write(0, rows([]int32{1, 2, 3}, nil), txn.GetCtx()))
+	require.NoError(t, relation(t, e, txn.GetCtx(), "cascaded").Write(0, rows([]int32{10, 11, 12}, []int32{1, 2, -1}), txn.GetCtx()))
+	require.NoError(t, relation(t, e, txn.GetCtx(), "nullified").Write(0, rows([]int32{20, 21}, []int32{1, 2}), txn.GetCtx()))
+	require.NoError(t, relation(t, e, txn.GetCtx(), "restricted").Write(0, rows([]int32{30}, []int32{3}), txn.GetCtx()))
+	require.NoError(t, relation(t, e, txn.GetCtx(), "twin").Write(0, rows([]int32{1, 2}, nil), txn.GetCtx()))
+	require.NoError(t, relation(t, e, txn.GetCtx(), "other.cousin").Write(0, rows([]int32{40, 41}, []int32{1, 2}), txn.GetCtx()))
+	require.NoError(t, txn.Commit())
+
+	// the rows referenced by the restricted table can not be deleted
+	txn, err = e.StartTxn(nil)
+	require.NoError(t, err)
+	require.NoError(t, relation(t, e, txn.GetCtx(), "parent").Delete(0, keys([]int32{3}), "id", txn.GetCtx()))
+	require.Error(t, txn.Commit())
+
+	// the rows referencing the rows deleted are deleted or set null
+	txn, err = e.StartTxn(nil)
+	require.NoError(t, err)
+	require.NoError(t, relation(t, e, txn.GetCtx(), "parent").Delete(0, keys([]int32{1}), "id", txn.GetCtx()))
+	require.NoError(t, txn.Commit())
+	require.Equal(t, map[int32]int32{11: 2, 12: -1}, readTable(t, e, "cascaded"))
+	require.Equal(t, map[int32]int32{20: -1, 21: 2}, readTable(t, e, "nullified"))
+	require.Equal(t, map[int32]int32{30: 3}, readTable(t, e, "restricted"))
+	require.Equal(t, map[int32]int32{2: -1, 3: -1}, readTable(t, e, "parent"))
+	require.Equal(t, map[int32]int32{2: -1}, readTable(t, e, "twin"))
+	require.Equal(t, map[int32]int32{41: 2}, readTable(t, e, "other.cousin"))
+
+	// the rows appended are deleted with the row they reference
+	txn, err = e.StartTxn(nil)
+	require.NoError(t, err)
+	require.NoError(t, relation(t, e, txn.GetCtx(), "parent").Write(0, rows([]int32{5}, nil), txn.GetCtx()))
+	require.NoError(t, txn.Commit())
+	txn, err = e.StartTxn(nil)
+	require.NoError(t, err)
+	require.NoError(t, relation(t, e, txn.GetCtx(), "cascaded").Write(0, rows([]int32{14}, []int32{5}), txn.GetCtx()))
+	require.NoError(t, relation(t, e, txn.GetCtx(), "parent").Delete(0, keys([]int32{5}), "id", txn.GetCtx()))
+	require.NoError(t, txn.Commit())
+	require.Equal(t, map[int32]int32{11: 2, 12: -1}, readTable(t, e, "cascaded"))
+}
+
+// createTable creates a table of the columns id and ref, ref or the column of fk references
+// the parent if fk is not nil
+func createTable(t *testing.T, dbase engine.Database, snapshot engine.Snapshot, name string, fk *plan2.ForeignKeyDef) {
+	defs := []engine.TableDef{
+		&engine.AttributeDef{Attr: engine.Attribute{Name: "id", Type: int32Type, Primary: true}},
+		&engine.AttributeDef{Attr: engine.Attribute{Name: "ref", Type: int32Type}},
+		&engine.PrimaryIndexDef{Names: []string{"id"}},
+	}
+	if fk != nil {
+		if fk.Column == "" {
+			fk.Column = "ref"
+		}
+		fk.Name, fk.Database, fk.Table, fk.RefColumn = name+"_ibfk_1", "test", "parent", "id"
+		data, err := json.Marshal([]*plan2.ForeignKeyDef{fk})
+		require.NoError(t, err)
+		defs = append(defs, &engine.PropertiesDef{Properties: []engine.Property{
+			{Key: plan2.ForeignKeyReferenceKey, Value: "test.parent"},
+			{Key: plan2.ForeignKeyKey, Value: string(data)},
+		}})
+	}
+	require.NoError(t, dbase.Create(0, name, defs, snapshot))
+}
+
+// relation returns the table of the database test, or of the database before the dot of the name
+func relation(t *testing.T, e engine.Engine, snapshot engine.Snapshot, name string) engine.Relation {
+	dbName := "test"
+	if i := strings.IndexByte(name, '.'); i >= 0 {
+		dbName, name = name[:i], name[i+1:]
+	}
+	dbase, err := e.Database(dbName, snapshot)
+	require.NoError(t, err)
+	rel, err := dbase.Relation(name, snapshot)
+	require.NoError(t, err)
+	return rel
+}
+
+func keys(ids []int32) *vector.Vector {
+	vec := vector.New(int32Type)
+	_ = vector.Append(vec, ids)
+	return vec
+}
+
+// rows returns the rows of the ids, ref is null if refs is nil or the value is -1
+func rows(ids, refs []int32) *batch.Batch {
+	bat := batch.New(true, []string{"id", "ref"})
+	bat.Vecs[0] = keys(ids)
+	bat.Vecs[1] = vector.New(int32Type)
+	vs := make([]int32, len(ids))
+	for i := range ids {
+		if refs == nil || refs[i] < 0 {
+			nulls.Add(bat.Vecs[1].Nsp, uint64(i))
+			continue
+		}
+		vs[i] = refs[i]
+	}
+	_ = vector.Append(bat.Vecs[1], vs)
+	bat.InitZsOne(len(ids))
+	return bat
+}
+
+// readTable returns the rows of the table, the null refs are -1
+func readTable(t *testing.T, e moengine.TxnEngine, name string) map[int32]int32 {
+	txn, err := e.StartTxn(nil)
+	require.NoError(t, err)
+	defer func() { require.NoError(t, txn.Commit()) }()
+	rel := relation(t, e, txn.GetCtx(), name)
+	attrs := []string{"id", "ref"}
+	result := make(map[int32]int32)
+	for _, r := range rel.NewReader(1, nil, nil, txn.GetCtx()) {
+		for {
+			bat, err := r.Read(make([]uint64, len(attrs)), attrs)
+			require.NoError(t, err)
+			if bat == nil {
+				break
+			}
+			ids := bat.Vecs[0].Col.([]int32)
+			for i, id := range ids {
+				result[id] = bat.Vecs[1].Col.([]int32)[i]
+				if nulls.Contains(bat.Vecs[1].Nsp, uint64(i)) {
+					result[id] = -1
+				}
+			}
+		}
+	}
+	return result
+}
@@ -78,369 +78,382 @@ const VALUE_ARG = 57400
 const LIST_ARG = 57401
 const COMMENT = 57402
 const COMMENT_KEYWORD = 57403
-const INTEGRAL = 57404
-const HEX = 57405
-const HEXNUM = 57406
-const BIT_LITERAL = 57407
-const FLOAT = 57408
-const NULL = 57409
-const TRUE = 57410
-const FALSE = 57411
-const EMPTY_FROM_CLAUSE = 57412
-const LOWER_THAN_CHARSET = 57413
-const CHARSET = 57414
-const UNIQUE = 57415
-const KEY = 57416
-const OR = 57417
-const XOR = 57418
-const AND = 57419
-const NOT = 57420
-const BETWEEN = 57421
-const CASE = 57422
-const WHEN = 57423
-const THEN = 57424
-const ELSE = 57425
-const END = 57426
-const LE = 57427
-const GE = 57428
-const NE = 57429
-const NULL_SAFE_EQUAL = 57430
-const IS = 57431
-const LIKE = 57432
-const REGEXP = 57433
-const IN = 57434
-const ASSIGNMENT = 57435
-const SHIFT_LEFT = 57436
-const SHIFT_RIGHT = 57437
-const DIV = 57438
-const MOD = 57439
-const UNARY = 57440
-const COLLATE = 57441
-const BINARY = 57442
-const UNDERSCORE_BINARY = 57443
-const INTERVAL = 57444
-const BEGIN = 57445
-const START = 57446
-const TRANSACTION = 57447
-const COMMIT = 57448
-const ROLLBACK = 57449
-const WORK = 57450
-const CONSISTENT = 57451
-const SNAPSHOT = 57452
-const CHAIN = 57453
-const NO = 57454
-const RELEASE = 57455
-const BIT = 57456
-const TINYINT = 57457
-const SMALLINT = 57458
-const MEDIUMINT = 57459
-const INT = 57460
-const INTEGER = 57461
-const BIGINT = 57462
-const INTNUM = 57463
-const REAL = 57464
-const DOUBLE = 57465
-const FLOAT_TYPE = 57466
-const DECIMAL = 57467
-const NUMERIC = 57468
-const TIME = 57469
-const TIMESTAMP = 57470
-const DATETIME = 57471
-const YEAR = 57472
-const CHAR = 57473
-const VARCHAR = 57474
-const BOOL = 57475
-const CHARACTER = 57476
-const VARBINARY = 57477
-const NCHAR = 57478
-const TEXT = 57479
-const TINYTEXT = 57480
-const MEDIUMTEXT = 57481
-const LONGTEXT = 57482
-const BLOB = 57483
-const TINYBLOB = 57484
-const MEDIUMBLOB = 57485
-const LONGBLOB = 57486
-const JSON = 57487
-const ENUM = 57488
-const GEOMETRY = 57489
-const POINT = 57490
-const LINESTRING = 57491
-const POLYGON = 57492
-const GEOMETRYCOLLECTION = 57493
-const MULTIPOINT = 57494
-const MULTILINESTRING = 57495
-const MULTIPOLYGON = 57496
-const INT1 = 57497
-const INT2 = 57498
-const INT3 = 57499
-const INT4 = 57500
-const INT8 = 57501
-const CREATE = 57502
-const ALTER = 57503
-const DROP = 57504
-const RENAME = 57505
-const ANALYZE = 57506
-const ADD = 57507
-const SCHEMA = 57508
-const TABLE = 57509
-const INDEX = 57510
-const VIEW = 57511
-const TO = 57512
-const IGNORE = 57513
-const IF = 57514
-const PRIMARY = 57515
-const COLUMN = 57516
-const CONSTRAINT = 57517
-const SPATIAL = 57518
-const FULLTEXT = 57519
-const FOREIGN = 57520
-const KEY_BLOCK_SIZE = 57521
-const SHOW = 57522
-const DESCRIBE = 57523
-const EXPLAIN = 57524
-const DATE = 57525
-const ESCAPE = 57526
-const REPAIR = 57527
-const OPTIMIZE = 57528
-const TRUNCATE = 57529
-const MAXVALUE = 57530
-const PARTITION = 57531
-const REORGANIZE = 57532
-const LESS = 57533
-const THAN = 57534
-const PROCEDURE = 57535
-const TRIGGER = 57536
-const STATUS = 57537
-const VARIABLES = 57538
-const ROLE = 57539
-const PROXY = 57540
-const AVG_ROW_LENGTH = 57541
-const STORAGE = 57542
-const DISK = 57543
-const MEMORY = 57544
-const CHECKSUM = 57545
-const COMPRESSION = 57546
-const DATA = 57547
-const DIRECTORY = 57548
-const DELAY_KEY_WRITE = 57549
-const ENCRYPTION = 57550
-const ENGINE = 57551
-const MAX_ROWS = 57552
-const MIN_ROWS = 57553
-const PACK_KEYS = 57554
-const ROW_FORMAT = 57555
-const STATS_AUTO_RECALC = 57556
-const STATS_PERSISTENT = 57557
-const STATS_SAMPLE_PAGES = 57558
-const DYNAMIC = 57559
-const COMPRESSED = 57560
-const REDUNDANT = 57561
-const COMPACT = 57562
-const FIXED = 57563
-const COLUMN_FORMAT = 57564
-const AUTO_RANDOM = 57565
-const RESTRICT = 57566
-const CASCADE = 57567
-const ACTION = 57568
-const PARTIAL = 57569
-const SIMPLE = 57570
-const CHECK = 57571
-const ENFORCED = 57572
-const RANGE = 57573
-const LIST = 57574
-const ALGORITHM = 57575
-const LINEAR = 57576
-const PARTITIONS = 57577
-const SUBPARTITION = 57578
-const SUBPARTITIONS = 57579
-const TYPE = 57580
-const PROPERTIES = 57581
-const PARSER = 57582
-const VISIBLE = 57583
-const INVISIBLE = 57584
-const BTREE = 57585
-const HASH = 57586
-const RTREE = 57587
-const BSI = 57588
-const ZONEMAP = 57589
-const EXPIRE = 57590
-const ACCOUNT = 57591
-const UNLOCK = 57592
-const DAY = 57593
-const NEVER = 57594
-const SECOND = 57595
-const ASCII = 57596
-const COALESCE = 57597
-const COLLATION = 57598
-const HOUR = 57599
-const MICROSECOND = 57600
-const MINUTE = 57601
-const MONTH = 57602
-const QUARTER = 57603
-const REPEAT = 57604
-const REVERSE = 57605
-const ROW_COUNT = 57606
-const WEEK = 57607
-const REVOKE = 57608
-const FUNCTION = 57609
-const PRIVILEGES = 57610
-const TABLESPACE = 57611
-const EXECUTE = 57612
-const SUPER = 57613
-const GRANT = 57614
-const OPTION = 57615
-const REFERENCES = 57616
-const REPLICATION = 57617
-const SLAVE = 57618
-const CLIENT = 57619
-const USAGE = 57620
-const RELOAD = 57621
-const FILE = 57622
-const TEMPORARY = 57623
-const ROUTINE = 57624
-const EVENT = 57625
-const SHUTDOWN = 57626
-const NULLX = 57627
-const AUTO_INCREMENT = 57628
-const APPROXNUM = 57629
-const SIGNED = 57630
-const UNSIGNED = 57631
-const ZEROFILL = 57632
-const USER = 57633
-const IDENTIFIED = 57634
-const CIPHER = 57635
-const ISSUER = 57636
-const X509 = 57637
-const SUBJECT = 57638
-const SAN = 57639
-const REQUIRE = 57640
-const SSL = 57641
-const NONE = 57642
-const PASSWORD = 57643
-const MAX_QUERIES_PER_HOUR = 57644
-const MAX_UPDATES_PER_HOUR = 57645
-const MAX_CONNECTIONS_PER_HOUR = 57646
-const MAX_USER_CONNECTIONS = 57647
-const FORMAT = 57648
-const VERBOSE = 57649
-const CONNECTION = 57650
-const LOAD = 57651
-const INFILE = 57652
-const TERMINATED = 57653
-const OPTIONALLY = 57654
-const ENCLOSED = 57655
-const ESCAPED = 57656
-const STARTING = 57657
-const LINES = 57658
-const DATABASES = 57659
-const TABLES = 57660
-const EXTENDED = 57661
-const FULL = 57662
-const PROCESSLIST = 57663
-const FIELDS = 57664
-const COLUMNS = 57665
-const OPEN = 57666
-const ERRORS = 57667
-const WARNINGS = 57668
-const INDEXES = 57669
-const NAMES = 57670
-const GLOBAL = 57671
-const SESSION = 57672
-const ISOLATION = 57673
-const LEVEL = 57674
-const READ = 57675
-const WRITE = 57676
-const ONLY = 57677
-const REPEATABLE = 57678
-const COMMITTED = 57679
-const UNCOMMITTED = 57680
-const SERIALIZABLE = 57681
-const LOCAL = 57682
-const EXCEPT = 57683
-const CURRENT_TIMESTAMP = 57684
-const DATABASE = 57685
-const CURRENT_TIME = 57686
-const LOCALTIME = 57687
-const LOCALTIMESTAMP = 57688
-const UTC_DATE = 57689
-const UTC_TIME = 57690
-const UTC_TIMESTAMP = 57691
-const REPLACE = 57692
-const CONVERT = 57693
-const SEPARATOR = 57694
-const CURRENT_DATE = 57695
-const CURRENT_USER = 57696
-const CURRENT_ROLE = 57697
-const SECOND_MICROSECOND = 57698
-const MINUTE_MICROSECOND = 57699
-const MINUTE_SECOND = 57700
-const HOUR_MICROSECOND = 57701
-const HOUR_SECOND = 57702
-const HOUR_MINUTE = 57703
-const DAY_MICROSECOND = 57704
-const DAY_SECOND = 57705
-const DAY_MINUTE = 57706
-const DAY_HOUR = 57707
-const YEAR_MONTH = 57708
-const SQL_TSI_HOUR = 57709
-const SQL_TSI_DAY = 57710
-const SQL_TSI_WEEK = 57711
-const SQL_TSI_MONTH = 57712
-const SQL_TSI_QUARTER = 57713
-const SQL_TSI_YEAR = 57714
-const SQL_TSI_SECOND = 57715
-const SQL_TSI_MINUTE = 57716
-const RECURSIVE = 57717
-const MATCH = 57718
-const AGAINST = 57719
-const BOOLEAN = 57720
-const LANGUAGE = 57721
-const WITH = 57722
-const QUERY = 57723
-const EXPANSION = 57724
-const ADDDATE = 57725
-const BIT_AND = 57726
-const BIT_OR = 57727
-const BIT_XOR = 57728
-const CAST = 57729
-const COUNT = 57730
-const APPROX_COUNT_DISTINCT = 57731
-const APPROX_PERCENTILE = 57732
-const CURDATE = 57733
-const CURTIME = 57734
-const DATE_ADD = 57735
-const DATE_SUB = 57736
-const EXTRACT = 57737
-const GROUP_CONCAT = 57738
-const MAX = 57739
-const MID = 57740
-const MIN = 57741
-const NOW = 57742
-const POSITION = 57743
-const SESSION_USER = 57744
-const STD = 57745
-const STDDEV = 57746
-const STDDEV_POP = 57747
-const STDDEV_SAMP = 57748
-const SUBDATE = 57749
-const SUBSTR = 57750
-const SUBSTRING = 57751
-const SUM = 57752
-const SYSDATE = 57753
-const SYSTEM_USER = 57754
-const TRANSLATE = 57755
-const TRIM = 57756
-const VARIANCE = 57757
-const VAR_POP = 57758
-const VAR_SAMP = 57759
-const AVG = 57760
-const ROW = 57761
-const OUTFILE = 57762
-const HEADER = 57763
-const MAX_FILE_SIZE = 57764
-const FORCE_QUOTE = 57765
-const UNUSED = 57766
+const JSON_EXTRACT_OP = 57404
+const JSON_UNQUOTE_EXTRACT_OP = 57405
+const INTEGRAL = 57406
+const HEX = 57407
+const HEXNUM = 57408
+const BIT_LITERAL = 57409
+const FLOAT = 57410
+const NULL = 57411
+const TRUE = 57412
+const FALSE = 57413
+const EMPTY_FROM_CLAUSE = 57414
+const LOWER_THAN_CHARSET = 57415
+const CHARSET = 57416
+const UNIQUE = 57417
+const KEY = 57418
+const OR = 57419
+const XOR = 57420
+const AND = 57421
+const NOT = 57422
+const BETWEEN = 57423
+const CASE = 57424
+const WHEN = 57425
+const THEN = 57426
+const ELSE = 57427
+const END = 57428
+const LE = 57429
+const GE = 57430
+const NE = 57431
+const NULL_SAFE_EQUAL = 57432
+const IS = 57433
+const LIKE = 57434
+const REGEXP = 57435
+const IN = 57436
+const ASSIGNMENT = 57437
+const SHIFT_LEFT = 57438
+const SHIFT_RIGHT = 57439
+const DIV = 57440
+const MOD = 57441
+const UNARY = 57442
+const COLLATE = 57443
+const BINARY = 57444
+const UNDERSCORE_BINARY = 57445
+const INTERVAL = 57446
+const BEGIN = 57447
+const START = 57448
+const TRANSACTION = 57449
+const COMMIT = 57450
+const ROLLBACK = 57451
+const WORK = 57452
+const CONSISTENT = 57453
+const SNAPSHOT = 57454
+const CHAIN = 57455
+const NO = 57456
+const RELEASE = 57457
+const BIT = 57458
+const TINYINT = 57459
+const SMALLINT = 57460
+const MEDIUMINT = 57461
+const INT = 57462
+const INTEGER = 57463
+const BIGINT = 57464
+const INTNUM = 57465
+const REAL = 57466
+const DOUBLE = 57467
+const FLOAT_TYPE = 57468
+const DECIMAL = 57469
+const NUMERIC = 57470
+const TIME = 57471
+const TIMESTAMP = 57472
+const DATETIME = 57473
+const YEAR = 57474
+const CHAR = 57475
+const VARCHAR = 57476
+const BOOL = 57477
+const CHARACTER = 57478
+const VARBINARY = 57479
+const NCHAR = 57480
+const TEXT = 57481
+const TINYTEXT = 57482
+const MEDIUMTEXT = 57483
+const LONGTEXT = 57484
+const BLOB = 57485
+const TINYBLOB = 57486
+const MEDIUMBLOB = 57487
+const LONGBLOB = 57488
+const JSON = 57489
+const ENUM = 57490
+const UUID = 57491
+const GEOMETRY = 57492
+const POINT = 57493
+const LINESTRING = 57494
+const POLYGON = 57495
+const GEOMETRYCOLLECTION = 57496
+const MULTIPOINT = 57497
+const MULTILINESTRING = 57498
+const MULTIPOLYGON = 57499
+const INT1 = 57500
+const INT2 = 57501
+const INT3 = 57502
+const INT4 = 57503
+const INT8 = 57504
+const CREATE = 57505
+const ALTER = 57506
+const DROP = 57507
+const RENAME = 57508
+const ANALYZE = 57509
+const ADD = 57510
+const SCHEMA = 57511
+const TABLE = 57512
+const INDEX = 57513
+const VIEW = 57514
+const TO = 57515
+const IGNORE = 57516
+const IF = 57517
+const PRIMARY = 57518
+const COLUMN = 57519
+const CONSTRAINT = 57520
+const SPATIAL = 57521
+const FULLTEXT = 57522
+const FOREIGN = 57523
+const KEY_BLOCK_SIZE = 57524
+const SHOW = 57525
+const DESCRIBE = 57526
+const EXPLAIN = 57527
+const DATE = 57528
+const ESCAPE = 57529
+const REPAIR = 57530
+const OPTIMIZE = 57531
+const TRUNCATE = 57532
+const MAXVALUE = 57533
+const PARTITION = 57534
+const REORGANIZE = 57535
+const LESS = 57536
+const THAN = 57537
+const PROCEDURE = 57538
+const TRIGGER = 57539
+const STATUS = 57540
+const VARIABLES = 57541
+const ROLE = 57542
+const PROXY = 57543
+const AVG_ROW_LENGTH = 57544
+const STORAGE = 57545
+const DISK = 57546
+const MEMORY = 57547
+const CHECKSUM = 57548
+const COMPRESSION = 57549
+const DATA = 57550
+const DIRECTORY = 57551
+const DELAY_KEY_WRITE = 57552
+const ENCRYPTION = 57553
+const ENGINE = 57554
+const MAX_ROWS = 57555
+const MIN_ROWS = 57556
+const PACK_KEYS = 57557
+const ROW_FORMAT = 57558
+const STATS_AUTO_RECALC = 57559
+const STATS_PERSISTENT = 57560
+const STATS_SAMPLE_PAGES = 57561
+const DYNAMIC = 57562
+const COMPRESSED = 57563
+const REDUNDANT = 57564
+const COMPACT = 57565
+const FIXED = 57566
+const COLUMN_FORMAT = 57567
+const AUTO_RANDOM = 57568
+const RESTRICT = 57569
+const CASCADE = 57570
+const ACTION = 57571
+const PARTIAL = 57572
+const SIMPLE = 57573
+const CHECK = 57574
+const ENFORCED = 57575
+const RANGE = 57576
+const LIST = 57577
+const ALGORITHM = 57578
+const LINEAR = 57579
+const PARTITIONS = 57580
+const SUBPARTITION = 57581
+const SUBPARTITIONS = 57582
+const TYPE = 57583
+const EXTERNAL = 57584
+const LOCATION = 57585
+const PROPERTIES = 57586
+const PARSER = 57587
+const VISIBLE = 57588
+const INVISIBLE = 57589
+const BTREE = 57590
+const HASH = 57591
+const RTREE = 57592
+const BSI = 57593
+const ZONEMAP = 57594
+const EXPIRE = 57595
+const ACCOUNT = 57596
+const UNLOCK = 57597
+const DAY = 57598
+const NEVER = 57599
+const SECOND = 57600
+const ASCII = 57601
+const COALESCE = 57602
+const COLLATION = 57603
+const HOUR = 57604
+const MICROSECOND = 57605
+const MINUTE = 57606
+const MONTH = 57607
+const QUARTER = 57608
+const REPEAT = 57609
+const REVERSE = 57610
+const ROW_COUNT = 57611
+const WEEK = 57612
+const REVOKE = 57613
+const FUNCTION = 57614
+const PRIVILEGES = 57615
+const TABLESPACE = 57616
+const EXECUTE = 57617
+const SUPER = 57618
+const GRANT = 57619
+const OPTION = 57620
+const REFERENCES = 57621
+const REPLICATION = 57622
+const SLAVE = 57623
+const CLIENT = 57624
+const USAGE = 57625
+const RELOAD = 57626
+const FILE = 57627
+const TEMPORARY = 57628
+const ROUTINE = 57629
+const EVENT = 57630
+const SHUTDOWN = 57631
+const NULLX = 57632
+const AUTO_INCREMENT = 57633
+const APPROXNUM = 57634
+const SIGNED = 57635
+const UNSIGNED = 57636
+const ZEROFILL = 57637
+const USER = 57638
+const IDENTIFIED = 57639
+const CIPHER = 57640
+const ISSUER = 57641
+const X509 = 57642
+const SUBJECT = 57643
+const SAN = 57644
+const REQUIRE = 57645
+const SSL = 57646
+const NONE = 57647
+const PASSWORD = 57648
+const MAX_QUERIES_PER_HOUR = 57649
+const MAX_UPDATES_PER_HOUR = 57650
+const MAX_CONNECTIONS_PER_HOUR = 57651
+const MAX_USER_CONNECTIONS = 57652
+const FORMAT = 57653
+const VERBOSE = 57654
+const CONNECTION = 57655
+const LOAD = 57656
+const INFILE = 57657
+const TERMINATED = 57658
+const OPTIONALLY = 57659
+const ENCLOSED = 57660
+const ESCAPED = 57661
+const STARTING = 57662
+const LINES = 57663
+const DATABASES = 57664
+const TABLES = 57665
+const EXTENDED = 57666
+const FULL = 57667
+const PROCESSLIST = 57668
+const FIELDS = 57669
+const COLUMNS = 57670
+const OPEN = 57671
+const ERRORS = 57672
+const WARNINGS = 57673
+const INDEXES = 57674
+const NAMES = 57675
+const GLOBAL = 57676
+const SESSION = 57677
+const ISOLATION = 57678
+const LEVEL = 57679
+const READ = 57680
+const WRITE = 57681
+const ONLY = 57682
+const REPEATABLE = 57683
+const COMMITTED = 57684
+const UNCOMMITTED = 57685
+const SERIALIZABLE = 57686
+const LOCAL = 57687
+const EXCEPT = 57688
+const CURRENT_TIMESTAMP = 57689
+const DATABASE = 57690
+const CURRENT_TIME = 57691
+const LOCALTIME = 57692
+const LOCALTIMESTAMP = 57693
+const UTC_DATE = 57694
+const UTC_TIME = 57695
+const UTC_TIMESTAMP = 57696
+const REPLACE = 57697
+const CONVERT = 57698
+const SEPARATOR = 57699
+const CURRENT_DATE = 57700
+const CURRENT_USER = 57701
+const CURRENT_ROLE = 57702
+const SECOND_MICROSECOND = 57703
+const MINUTE_MICROSECOND = 57704
+const MINUTE_SECOND = 57705
+const HOUR_MICROSECOND = 57706
+const HOUR_SECOND = 57707
+const HOUR_MINUTE = 57708
+const DAY_MICROSECOND = 57709
+const DAY_SECOND = 57710
+const DAY_MINUTE = 57711
+const DAY_HOUR = 57712
+const YEAR_MONTH = 57713
+const SQL_TSI_HOUR = 57714
+const SQL_TSI_DAY = 57715
+const SQL_TSI_WEEK = 57716
+const SQL_TSI_MONTH = 57717
+const SQL_TSI_QUARTER = 57718
+const SQL_TSI_YEAR = 57719
+const SQL_TSI_SECOND = 57720
+const SQL_TSI_MINUTE = 57721
+const RECURSIVE = 57722
+const TABLESAMPLE = 57723
+const SYSTEM = 57724
+const BERNOULLI = 57725
+const PERCENT = 57726
+const MATERIALIZED = 57727
+const REFRESH = 57728
+const MATCH = 57729
+const AGAINST = 57730
+const BOOLEAN = 57731
+const LANGUAGE = 57732
+const WITH = 57733
+const QUERY = 57734
+const EXPANSION = 57735
+const ADDDATE = 57736
+const BIT_AND = 57737
+const BIT_OR = 57738
+const BIT_XOR = 57739
+const CAST = 57740
+const COUNT = 57741
+const APPROX_COUNT_DISTINCT = 57742
+const APPROX_PERCENTILE = 57743
+const CURDATE = 57744
+const CURTIME = 57745
+const DATE_ADD = 57746
+const DATE_SUB = 57747
+const EXTRACT = 57748
+const GROUP_CONCAT = 57749
+const MAX = 57750
+const MID = 57751
+const MIN = 57752
+const NOW = 57753
+const POSITION = 57754
+const SESSION_USER = 57755
+const STD = 57756
+const STDDEV = 57757
+const STDDEV_POP = 57758
+const STDDEV_SAMP = 57759
+const SUBDATE = 57760
+const SUBSTR = 57761
+const SUBSTRING = 57762
+const SUM = 57763
+const SYSDATE = 57764
+const SYSTEM_USER = 57765
+const TRANSLATE = 57766
+const TRIM = 57767
+const VARIANCE = 57768
+const VAR_POP = 57769
+const VAR_SAMP = 57770
+const AVG = 57771
+const TIMESTAMPADD = 57772
+const TIMESTAMPDIFF = 57773
+const ROW = 57774
+const OUTFILE = 57775
+const HEADER = 57776
+const MAX_FILE_SIZE = 57777
+const FORCE_QUOTE = 57778
+const UNUSED = 57779
 
 var yyToknames = [...]string{
 	"$end",
@@ -507,6 +520,8 @@ var yyToknames = [...]string{
 	"LIST_ARG",
 	"COMMENT",
 	"COMMENT_KEYWORD",
+	"JSON_EXTRACT_OP",
+	"JSON_UNQUOTE_EXTRACT_OP",
 	"INTEGRAL",
 	"HEX",
 	"HEXNUM",
@@ -606,6 +621,7 @@ var yyToknames = [...]string{
 	"LONGBLOB",
 	"JSON",
 	"ENUM",
+	"UUID",
 	"GEOMETRY",
 	"POINT",
 	"LINESTRING",
@@ -698,6 +714,8 @@ var yyToknames = [...]string{
 	"SUBPARTITION",
 	"SUBPARTITIONS",
 	"TYPE",
+	"EXTERNAL",
+	"LOCATION",
 	"PROPERTIES",
 	"PARSER",
 	"VISIBLE",
@@ -835,6 +853,12 @@ var yyToknames = [...]string{
 	"SQL_TSI_SECOND",
 	"SQL_TSI_MINUTE",
 	"RECURSIVE",
+	"TABLESAMPLE",
+	"SYSTEM",
+	"BERNOULLI",
+	"PERCENT",
+	"MATERIALIZED",
+	"REFRESH",
 	"MATCH",
 	"AGAINST",
 	"BOOLEAN",
@@ -878,6 +902,8 @@ var yyToknames = [...]string{
 	"VAR_POP",
 	"VAR_SAMP",
 	"AVG",
+	"TIMESTAMPADD",
+	"TIMESTAMPDIFF",
 	"ROW",
 	"OUTFILE",
 	"HEADER",
@@ -894,1895 +920,1996 @@ const yyEofCode = 1
 const yyErrCode = 2
 const yyInitialStackSize = 16
 
-//line mysql_sql.y:6320
+//line mysql_sql.y:6610
 
 //line yacctab:1
 var yyExca = [...]int{
 	-1, 1,
 	1, -1,
 	-2, 0,
-	-1, 52,
-	17, 353,
-	-2, 334,
-	-1, 57,
-	185, 495,
-	-2, 531,
-	-1, 66,
-	212, 243,
-	213, 243,
-	-2, 263,
-	-1, 313,
-	58, 1288,
-	443, 1288,
-	-2, 92,
-	-1, 332,
-	58, 658,
-	443, 658,
-	-2, 493,
-	-1, 333,
-	58, 486,
-	443, 486,
-	-2, 494,
-	-1, 339,
-	17, 354,
-	-2, 317,
-	-1, 563,
-	17, 354,
-	-2, 317,
-	-1, 593,
-	54, 1309,
-	-2, 1322,
-	-1, 594,
-	54, 1310,
-	-2, 1323,
-	-1, 598,
-	54, 1311,
-	-2, 1329,
-	-1, 599,
-	54, 784,
-	-2, 1332,
-	-1, 600,
-	54, 785,
-	-2, 1333,
-	-1, 601,
-	54, 786,
-	-2, 1334,
-	-1, 603,
-	54, 794,
+	-1, 54,
+	17, 365,
+	-2, 346,
+	-1, 59,
+	188, 520,
+	-2, 557,
+	-1, 69,
+	215, 249,
+	216, 249,
+	-2, 269,
+	-1, 328,
+	58, 1332,
+	456, 1332,
+	-2, 98,
+	-1, 347,
+	58, 684,
+	456, 684,
+	-2, 518,
+	-1, 348,
+	58, 511,
+	456, 511,
+	-2, 519,
+	-1, 355,
+	17, 366,
+	-2, 329,
+	-1, 591,
+	17, 366,
+	-2, 329,
+	-1, 624,
+	54, 1354,
+	-2, 1368,
+	-1, 625,
+	54, 1355,
+	-2, 1369,
+	-1, 629,
+	54, 1356,
+	-2, 1375,
+	-1, 630,
+	54, 814,
+	-2, 1378,
+	-1, 631,
+	54, 815,
+	-2, 1379,
+	-1, 632,
+	54, 816,
+	-2, 1380,
+	-1, 634,
+	54, 826,
+	-2, 1383,
+	-1, 635,
+	54, 825,
+	-2, 1384,
+	-1, 643,
+	54, 1357,
+	-2, 1251,
+	-1, 644,
+	54, 901,
+	-2, 1275,
+	-1, 645,
+	54, 912,
 	-2, 1337,
-	-1, 604,
-	54, 793,
+	-1, 646,
+	54, 913,
 	-2, 1338,
-	-1, 610,
-	54, 868,
-	-2, 1233,
-	-1, 611,
-	54, 879,
-	-2, 1293,
-	-1, 612,
-	54, 881,
-	-2, 1303,
-	-1, 613,
-	54, 869,
-	-2, 1308,
-	-1, 766,
-	1, 521,
-	56, 521,
-	442, 521,
-	-2, 528,
-	-1, 883,
-	17, 353,
-	-2, 716,
-	-1, 930,
-	119, 1007,
-	-2, 1005,
-	-1, 932,
-	119, 435,
-	-2, 1002,
-	-1, 933,
-	119, 436,
-	-2, 1003,
-	-1, 1127,
-	1, 522,
-	56, 522,
-	442, 522,
-	-2, 528,
-	-1, 1549,
-	75, 528,
-	115, 528,
-	148, 528,
-	151, 528,
-	-2, 568,
-	-1, 1551,
-	246, 683,
-	-2, 664,
-	-1, 1669,
-	75, 528,
-	115, 528,
-	148, 528,
-	151, 528,
-	-2, 569,
-	-1, 1697,
-	246, 683,
-	-2, 665,
-	-1, 2088,
-	55, 543,
-	56, 543,
-	-2, 528,
-	-1, 2092,
-	55, 543,
-	56, 543,
-	-2, 528,
-	-1, 2104,
-	55, 547,
+	-1, 647,
+	54, 916,
+	-2, 1348,
+	-1, 648,
+	54, 902,
+	-2, 1353,
+	-1, 811,
+	1, 546,
+	56, 546,
+	455, 546,
+	-2, 553,
+	-1, 937,
+	17, 365,
+	-2, 743,
+	-1, 986,
+	121, 1043,
+	-2, 1041,
+	-1, 988,
+	121, 459,
+	-2, 1038,
+	-1, 989,
+	121, 460,
+	-2, 1039,
+	-1, 1192,
+	1, 547,
 	56, 547,
-	-2, 528,
-	-1, 2107,
-	55, 548,
-	56, 548,
-	-2, 528,
+	455, 547,
+	-2, 553,
+	-1, 1638,
+	77, 553,
+	117, 553,
+	150, 553,
+	153, 553,
+	-2, 594,
+	-1, 1640,
+	249, 710,
+	-2, 690,
+	-1, 1765,
+	77, 553,
+	117, 553,
+	150, 553,
+	153, 553,
+	-2, 595,
+	-1, 1793,
+	249, 710,
+	-2, 691,
+	-1, 2204,
+	55, 569,
+	56, 569,
+	-2, 553,
+	-1, 2212,
+	55, 569,
+	56, 569,
+	-2, 553,
+	-1, 2225,
+	55, 573,
+	56, 573,
+	-2, 553,
+	-1, 2228,
+	55, 574,
+	56, 574,
+	-2, 553,
 }
 
 const yyPrivate = 57344
 
-const yyLast = 17430
+const yyLast = 18374
 
 var yyAct = [...]int{
-	756, 1179, 2094, 2092, 2091, 2099, 2065, 616, 2039, 1742,
-	745, 614, 1929, 634, 2010, 1180, 2054, 1709, 1991, 1905,
-	550, 1992, 1665, 1882, 84, 516, 1543, 289, 1114, 1740,
-	1908, 818, 1837, 548, 1741, 1893, 1732, 87, 1810, 454,
-	84, 302, 300, 389, 1344, 293, 19, 504, 1610, 334,
-	334, 1731, 1628, 643, 52, 1439, 1630, 1627, 1443, 1467,
-	1698, 802, 574, 1427, 1639, 584, 83, 1635, 1476, 1320,
-	1455, 1448, 1596, 390, 1444, 1120, 1493, 1494, 1380, 411,
-	52, 912, 295, 84, 825, 739, 927, 520, 615, 558,
-	922, 930, 921, 697, 913, 1257, 625, 1243, 795, 51,
-	292, 12, 290, 6, 291, 5, 3, 1673, 742, 1314,
-	1128, 758, 1181, 740, 1194, 340, 577, 492, 339, 1178,
-	714, 799, 771, 772, 770, 420, 19, 1096, 400, 402,
-	282, 820, 1087, 456, 52, 431, 855, 285, 731, 559,
-	382, 305, 410, 541, 442, 296, 80, 306, 304, 1103,
-	471, 1755, 1661, 1542, 753, 915, 408, 309, 309, 1099,
-	79, 341, 1296, 79, 1957, 23, 39, 24, 79, 79,
-	23, 39, 24, 79, 79, 401, 527, 525, 1428, 1315,
-	77, 12, 1946, 6, 502, 5, 1303, 417, 789, 396,
-	406, 405, 398, 694, 523, 491, 691, 1306, 336, 1979,
-	1404, 784, 785, 517, 518, 1995, 1996, 369, 75, 515,
-	774, 75, 514, 517, 518, 352, 75, 693, 1977, 383,
-	404, 75, 75, 528, 748, 486, 482, 2014, 1835, 1431,
-	359, 1838, 1839, 1840, 1841, 397, 1917, 1432, 1920, 1433,
-	1758, 1544, 752, 1456, 1457, 1458, 1459, 434, 1283, 1477,
-	425, 1323, 1321, 1318, 1322, 1324, 1480, 1317, 1316, 796,
-	1323, 1321, 1101, 1322, 1324, 1099, 370, 1809, 1718, 1717,
-	473, 484, 485, 1714, 1658, 483, 1539, 732, 472, 1826,
-	1622, 1981, 84, 424, 1618, 1495, 1894, 1895, 1896, 1898,
-	1897, 2005, 423, 1816, 2100, 84, 1956, 2084, 1994, 1460,
-	2019, 1479, 1621, 734, 1976, 1931, 2026, 477, 1506, 1503,
-	1504, 1505, 1954, 1500, 403, 1499, 1498, 1496, 1804, 2075,
-	1907, 458, 354, 1326, 1327, 1328, 1329, 1927, 1928, 1773,
-	1931, 1795, 351, 350, 2057, 478, 1772, 438, 459, 338,
-	52, 52, 402, 1983, 1984, 1937, 537, 513, 512, 434,
-	480, 2101, 2095, 346, 2066, 464, 1761, 419, 1959, 1960,
-	1381, 505, 526, 393, 422, 1304, 407, 1915, 468, 1497,
-	524, 1300, 1150, 1107, 481, 760, 507, 733, 1540, 334,
-	1452, 294, 1637, 1636, 1342, 390, 390, 390, 401, 436,
-	435, 1619, 503, 1146, 506, 497, 508, 1148, 1147, 531,
-	787, 463, 788, 1332, 529, 530, 374, 475, 1145, 786,
-	411, 706, 707, 580, 371, 372, 2079, 2043, 1434, 476,
-	479, 809, 696, 553, 427, 428, 1354, 1294, 579, 474,
-	1799, 1293, 868, 2058, 1282, 1276, 395, 349, 711, 1334,
-	424, 84, 84, 84, 84, 521, 1140, 345, 1112, 715,
-	1081, 561, 728, 1867, 366, 376, 375, 837, 699, 1420,
-	555, 437, 421, 2061, 692, 1982, 1183, 1182, 334, 334,
-	424, 334, 52, 1422, 1501, 1502, 458, 540, 509, 746,
-	494, 429, 2052, 52, 517, 518, 1906, 309, 1453, 334,
-	334, 436, 435, 459, 710, 729, 1468, 1958, 1941, 353,
-	488, 1428, 709, 517, 518, 334, 510, 334, 1278, 766,
-	84, 755, 1098, 1333, 759, 562, 564, 797, 398, 563,
-	1617, 1152, 496, 1421, 779, 1122, 334, 536, 765, 1323,
-	1321, 547, 1322, 1324, 1102, 470, 1797, 1085, 334, 390,
-	1796, 334, 1620, 1297, 1522, 2055, 2056, 539, 777, 426,
-	761, 78, 767, 1188, 78, 1258, 810, 803, 393, 78,
-	78, 397, 1097, 803, 78, 78, 573, 702, 334, 334,
-	817, 84, 780, 411, 750, 1312, 826, 309, 560, 747,
-	835, 567, 568, 569, 570, 571, 716, 717, 718, 719,
-	762, 519, 821, 522, 511, 727, 763, 838, 1767, 363,
-	768, 769, 832, 751, 544, 545, 546, 364, 735, 822,
-	776, 781, 754, 819, 775, 309, 744, 1449, 1452, 1800,
-	1801, 885, 542, 1258, 1391, 1386, 749, 1175, 833, 834,
-	832, 395, 1806, 543, 1334, 884, 1524, 554, 1176, 773,
-	1805, 1651, 1790, 892, 834, 832, 309, 460, 461, 462,
-	551, 1600, 812, 815, 798, 764, 1250, 1595, 1868, 1870,
-	1871, 1872, 1869, 1355, 2074, 460, 461, 462, 551, 808,
-	1248, 1249, 1247, 883, 373, 894, 811, 309, 1650, 794,
-	895, 813, 460, 461, 462, 1612, 793, 1666, 805, 806,
-	807, 833, 834, 832, 919, 919, 924, 73, 1191, 549,
-	833, 834, 832, 2090, 814, 2073, 552, 1193, 1878, 886,
-	887, 888, 889, 826, 926, 823, 1988, 816, 2071, 401,
-	932, 2036, 2020, 890, 552, 2015, 1453, 460, 461, 462,
-	551, 1446, 1966, 2004, 1111, 1447, 1450, 933, 833, 834,
-	832, 1613, 1913, 862, 1877, 910, 377, 402, 869, 870,
-	871, 872, 873, 874, 875, 868, 361, 52, 362, 369,
-	1912, 84, 84, 360, 358, 357, 365, 1884, 367, 368,
-	1876, 1110, 399, 1862, 289, 871, 872, 873, 874, 875,
-	868, 1142, 1861, 1860, 918, 902, 552, 1451, 1857, 1851,
-	334, 1095, 821, 401, 833, 834, 832, 1082, 1389, 1115,
-	1116, 1388, 1848, 1117, 1119, 1847, 1875, 1083, 1813, 822,
-	334, 1874, 1911, 925, 1756, 1750, 398, 833, 834, 832,
-	1749, 803, 803, 803, 833, 834, 832, 1748, 1747, 580,
-	1744, 84, 1606, 931, 833, 834, 832, 1172, 1173, 1963,
-	1080, 1079, 1833, 1605, 579, 1604, 1603, 1873, 1169, 1170,
-	1171, 1092, 833, 834, 832, 1189, 1190, 1143, 1134, 1864,
-	1416, 1131, 1132, 1133, 833, 834, 832, 1186, 841, 842,
-	843, 844, 845, 846, 1129, 839, 700, 1106, 1231, 1232,
-	1233, 1234, 1235, 1236, 1237, 1238, 1239, 1240, 1241, 1242,
-	910, 1987, 1821, 1252, 1253, 1863, 2104, 1136, 309, 1138,
-	773, 1139, 1645, 1135, 1137, 1266, 1177, 1883, 2082, 1259,
-	1948, 1530, 1262, 1168, 833, 834, 832, 1521, 1157, 1935,
-	1268, 1165, 1934, 1865, 833, 834, 832, 1153, 1154, 1155,
-	1158, 1858, 1159, 833, 834, 832, 1854, 1853, 1149, 833,
-	834, 832, 1852, 1811, 1572, 1166, 1792, 1757, 1701, 867,
-	866, 876, 877, 869, 870, 871, 872, 873, 874, 875,
-	868, 1345, 1184, 1185, 1515, 1187, 1664, 1662, 1514, 1251,
-	1614, 1224, 1225, 1226, 1227, 1245, 1228, 1229, 1230, 1513,
-	1465, 1464, 1512, 1704, 1463, 1511, 833, 834, 832, 1699,
-	833, 834, 832, 1462, 1109, 1712, 1713, 1510, 1108, 906,
-	1700, 833, 834, 832, 833, 834, 832, 833, 834, 832,
-	1260, 460, 461, 462, 1962, 1281, 1261, 1263, 1264, 833,
-	834, 832, 905, 904, 1270, 701, 1942, 1267, 1395, 1269,
-	1560, 1357, 1394, 1891, 1705, 876, 877, 869, 870, 871,
-	872, 873, 874, 875, 868, 1579, 1583, 1585, 1587, 1589,
-	1590, 1592, 1828, 1506, 1503, 1504, 1505, 1509, 1574, 1575,
-	1576, 1577, 1558, 1559, 1580, 1827, 1561, 1652, 1562, 1563,
-	1564, 1565, 1566, 1567, 1568, 1569, 1570, 1571, 1578, 833,
-	834, 832, 1284, 1361, 1649, 424, 1582, 1584, 1586, 1588,
-	1591, 1648, 343, 1626, 715, 1492, 1357, 2109, 1491, 1549,
-	334, 1288, 342, 334, 1289, 1531, 424, 1291, 334, 1711,
-	1490, 1445, 1482, 1309, 1573, 1299, 2060, 833, 834, 832,
-	833, 834, 832, 1254, 2103, 2102, 1307, 1308, 1481, 759,
-	1105, 2085, 833, 834, 832, 1398, 1707, 1396, 833, 834,
-	832, 1339, 1393, 566, 1392, 833, 834, 832, 2081, 2080,
-	1390, 334, 1105, 2069, 1105, 2068, 2042, 2041, 1706, 1708,
-	1366, 84, 84, 1823, 2002, 1350, 1823, 1997, 1161, 1985,
-	1974, 1973, 1363, 1331, 1823, 1952, 1823, 1951, 1823, 1950,
-	1311, 1823, 1949, 1940, 1939, 1889, 1890, 1889, 1888, 1362,
-	1832, 1831, 1356, 1301, 1830, 1829, 1341, 1358, 1265, 1286,
-	1359, 1360, 398, 1347, 1348, 1287, 1823, 1822, 19, 698,
-	1714, 1298, 730, 1295, 1164, 1534, 52, 1357, 1516, 1357,
-	1507, 565, 1702, 487, 1336, 1310, 1337, 466, 1335, 1357,
-	1365, 1357, 1364, 1164, 1285, 1129, 1343, 1357, 1330, 830,
-	1368, 1369, 1370, 1371, 1372, 1373, 1374, 1271, 1375, 1280,
-	1279, 1346, 1084, 1340, 1274, 1273, 1164, 1163, 1105, 1104,
-	467, 1378, 1379, 12, 1338, 6, 465, 5, 1550, 1349,
-	466, 1383, 704, 703, 1387, 919, 1099, 1408, 919, 1532,
-	1353, 1411, 468, 828, 1277, 1255, 1399, 1161, 803, 1113,
-	572, 826, 538, 334, 803, 79, 2105, 334, 334, 2051,
-	883, 334, 2045, 1414, 468, 2027, 2024, 2022, 1965, 1581,
-	1903, 1887, 1885, 1880, 424, 1842, 1629, 1819, 439, 1405,
-	1415, 1818, 1817, 1442, 1814, 1803, 84, 52, 1788, 444,
-	447, 448, 449, 445, 1403, 446, 450, 1377, 1728, 1725,
-	1410, 1724, 1815, 75, 1245, 1376, 401, 1631, 575, 1640,
-	1643, 1608, 1385, 1601, 84, 1487, 1246, 1407, 1313, 1290,
-	1272, 1162, 1151, 1144, 1125, 1406, 1400, 911, 1466, 1412,
-	1409, 909, 908, 1489, 1413, 1418, 1417, 907, 1419, 903,
-	856, 900, 898, 1508, 897, 896, 1426, 893, 75, 1461,
-	1518, 865, 1469, 1470, 444, 447, 448, 449, 445, 864,
-	446, 450, 1523, 863, 861, 1423, 1425, 1527, 860, 1529,
-	859, 867, 866, 876, 877, 869, 870, 871, 872, 873,
-	874, 875, 868, 1526, 858, 334, 1473, 857, 698, 1528,
-	1471, 1472, 854, 853, 852, 1487, 1486, 84, 851, 850,
-	849, 848, 847, 712, 695, 469, 1594, 2032, 1520, 1088,
-	1089, 2030, 1993, 1325, 1160, 1091, 1517, 444, 447, 448,
-	449, 445, 489, 446, 450, 1525, 303, 724, 722, 1094,
-	1519, 1093, 725, 723, 726, 721, 448, 449, 720, 1548,
-	2089, 1547, 1275, 2007, 556, 557, 1533, 1625, 1130, 52,
-	1611, 1115, 1116, 1436, 1429, 493, 1598, 1536, 1123, 783,
-	1624, 1609, 1538, 1759, 1537, 413, 415, 416, 495, 1435,
-	824, 79, 452, 23, 39, 24, 335, 1078, 1593, 1597,
-	1557, 1597, 1599, 1183, 1182, 1602, 499, 500, 2046, 1970,
-	1607, 65, 1968, 1535, 1922, 72, 1921, 334, 334, 1919,
-	1647, 84, 1845, 1843, 1616, 1663, 1623, 1546, 803, 1545,
-	1485, 424, 1670, 343, 40, 498, 1632, 1633, 1634, 75,
-	1442, 342, 1352, 342, 1484, 698, 1367, 1615, 1292, 1641,
-	1638, 1644, 866, 876, 877, 869, 870, 871, 872, 873,
-	874, 875, 868, 1659, 1646, 2034, 2033, 2034, 281, 2033,
-	451, 355, 1, 501, 1654, 708, 1733, 1735, 433, 1733,
-	1733, 705, 1657, 432, 430, 74, 1695, 2072, 1256, 424,
-	1715, 1667, 1721, 1195, 1719, 1720, 644, 1739, 1722, 1723,
-	914, 920, 1881, 2006, 2038, 68, 69, 1964, 70, 71,
-	2009, 633, 1726, 1734, 1729, 1730, 617, 1914, 1430, 1834,
-	1916, 1836, 2049, 1305, 1752, 1655, 1656, 1302, 1738, 490,
-	1736, 1737, 867, 866, 876, 877, 869, 870, 871, 872,
-	873, 874, 875, 868, 1401, 1402, 657, 1751, 1746, 647,
-	899, 648, 1763, 690, 414, 646, 1745, 1478, 344, 412,
-	356, 1808, 57, 67, 76, 1753, 38, 867, 866, 876,
-	877, 869, 870, 871, 872, 873, 874, 875, 868, 1541,
-	1716, 1642, 66, 64, 63, 1727, 1192, 2098, 2088, 2064,
-	2044, 1930, 2083, 1791, 1975, 84, 2025, 1766, 2018, 1926,
-	1760, 307, 790, 532, 380, 1904, 1611, 387, 713, 1454,
-	1319, 1764, 1765, 1121, 1768, 1769, 1770, 1771, 1100, 1735,
-	1774, 1775, 1776, 1777, 1778, 1779, 1780, 1781, 1782, 1783,
-	1784, 1785, 1786, 1787, 1793, 741, 1715, 1807, 1789, 1825,
-	308, 1955, 1886, 347, 1812, 1846, 1124, 348, 1127, 1126,
-	840, 1244, 901, 891, 582, 1820, 1384, 624, 618, 1475,
-	1474, 1710, 778, 26, 453, 831, 928, 1879, 48, 645,
-	86, 1141, 929, 1923, 49, 1754, 2011, 458, 632, 631,
-	1824, 630, 629, 443, 441, 1844, 440, 299, 298, 1351,
-	1483, 827, 829, 52, 459, 424, 1859, 1990, 424, 424,
-	424, 1989, 1944, 1945, 424, 1660, 1849, 1850, 1802, 1866,
-	1798, 50, 1855, 1856, 1794, 1936, 1669, 1668, 1696, 1697,
-	1703, 1556, 1552, 1924, 1892, 1554, 1555, 1900, 1901, 1902,
-	1553, 1551, 1899, 1910, 1440, 1441, 1438, 1437, 1909, 1090,
-	1086, 916, 923, 418, 757, 81, 1925, 297, 1382, 1918,
-	1167, 576, 11, 18, 17, 16, 47, 46, 45, 44,
-	15, 84, 8, 1932, 1933, 43, 42, 41, 424, 867,
-	866, 876, 877, 869, 870, 871, 872, 873, 874, 875,
-	868, 1943, 78, 14, 424, 13, 37, 36, 35, 34,
-	33, 32, 1938, 31, 30, 29, 28, 1947, 27, 9,
-	56, 55, 54, 819, 53, 20, 21, 22, 62, 61,
-	60, 59, 58, 1953, 25, 10, 7, 4, 2, 0,
-	1961, 0, 1969, 1967, 1971, 1972, 0, 0, 0, 2047,
-	0, 0, 0, 1978, 1980, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 1986, 0, 2013, 0, 0, 0,
-	0, 0, 0, 0, 0, 2017, 0, 0, 0, 2012,
-	1998, 1999, 2000, 2001, 0, 0, 0, 0, 0, 2021,
-	0, 2023, 0, 2016, 867, 866, 876, 877, 869, 870,
-	871, 872, 873, 874, 875, 868, 0, 0, 2028, 0,
-	0, 2031, 0, 2029, 0, 2040, 2003, 0, 0, 0,
-	2035, 0, 0, 424, 0, 424, 2037, 0, 0, 0,
-	0, 0, 746, 2048, 746, 2050, 0, 0, 0, 2053,
-	0, 0, 0, 2013, 2063, 0, 0, 0, 0, 0,
-	0, 2059, 424, 0, 0, 0, 2012, 2062, 0, 2067,
-	0, 746, 2070, 0, 0, 0, 0, 0, 2040, 2076,
-	0, 0, 0, 0, 0, 0, 0, 2078, 0, 0,
-	2086, 0, 0, 0, 0, 0, 0, 0, 2087, 0,
-	0, 0, 0, 0, 0, 2097, 0, 2096, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 2108, 2107, 2106,
-	2097, 1046, 1032, 0, 994, 1048, 966, 982, 1056, 984,
-	985, 1019, 944, 1003, 211, 980, 936, 969, 970, 938,
-	977, 939, 967, 996, 155, 965, 1035, 1006, 180, 1054,
-	182, 0, 0, 240, 195, 0, 0, 999, 1037, 1001,
-	1024, 993, 1020, 952, 1013, 1049, 981, 1017, 1050, 0,
-	0, 0, 0, 460, 461, 462, 0, 0, 0, 0,
-	138, 0, 0, 0, 0, 0, 1016, 1042, 979, 0,
-	0, 953, 1047, 1000, 1018, 0, 937, 1014, 0, 942,
-	945, 1055, 1040, 974, 975, 0, 0, 0, 0, 0,
-	0, 0, 997, 1002, 1021, 990, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 971, 0, 1010, 0, 0,
-	0, 947, 943, 0, 995, 0, 129, 245, 259, 139,
-	236, 272, 143, 243, 135, 210, 232, 131, 257, 242,
-	192, 174, 175, 130, 0, 227, 153, 166, 150, 208,
-	1044, 1045, 149, 275, 946, 267, 133, 134, 266, 207,
-	254, 258, 193, 187, 132, 256, 191, 186, 178, 157,
-	170, 220, 185, 221, 171, 197, 196, 198, 1066, 1067,
-	1068, 1069, 1070, 951, 0, 972, 1022, 0, 935, 1031,
-	1038, 992, 269, 1041, 989, 988, 1073, 0, 1072, 244,
-	1074, 1075, 179, 1036, 968, 978, 973, 976, 230, 213,
-	1043, 1009, 218, 228, 183, 255, 222, 260, 246, 268,
-	1025, 223, 125, 247, 152, 194, 136, 137, 148, 154,
-	156, 158, 159, 203, 204, 216, 235, 248, 249, 250,
-	151, 144, 229, 145, 168, 146, 126, 237, 147, 127,
-	217, 253, 1071, 165, 225, 190, 128, 189, 219, 252,
-	251, 276, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 162, 934, 264, 0, 209, 1033, 940, 950, 948,
-	986, 1011, 1012, 205, 280, 1027, 1030, 1028, 1057, 233,
-	1215, 0, 0, 0, 0, 173, 215, 0, 234, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 941,
-	0, 241, 262, 274, 265, 987, 959, 998, 273, 962,
-	960, 1026, 961, 1015, 1059, 199, 200, 201, 202, 983,
-	0, 142, 1007, 991, 1060, 1061, 1062, 1063, 1064, 1065,
-	964, 1039, 161, 167, 0, 169, 141, 214, 164, 271,
-	176, 206, 172, 238, 177, 184, 226, 270, 212, 231,
-	140, 261, 239, 188, 163, 958, 963, 957, 1004, 1005,
-	1051, 1052, 1053, 1023, 949, 1034, 954, 956, 955, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1029, 1008,
-	124, 0, 181, 1058, 224, 160, 0, 0, 0, 0,
-	0, 1211, 0, 1208, 0, 0, 0, 1210, 1207, 1209,
-	1213, 1214, 0, 0, 0, 1212, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 79, 0, 653, 0,
-	0, 0, 1076, 1077, 277, 278, 279, 263, 211, 0,
-	0, 0, 0, 0, 626, 0, 0, 0, 155, 0,
-	0, 0, 180, 0, 182, 0, 0, 240, 195, 0,
-	0, 0, 0, 669, 675, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 619, 0, 0, 583, 659, 658,
-	635, 0, 0, 0, 138, 636, 0, 641, 0, 637,
-	640, 638, 639, 0, 0, 661, 0, 0, 0, 0,
-	0, 581, 623, 0, 627, 0, 1196, 1197, 1198, 1199,
-	1200, 1201, 1202, 1203, 1204, 1205, 1206, 1218, 1219, 1220,
-	1221, 1222, 1223, 1216, 1217, 620, 621, 0, 0, 0,
-	0, 654, 0, 622, 0, 0, 656, 0, 642, 0,
-	129, 245, 259, 139, 236, 272, 143, 243, 135, 210,
-	232, 131, 257, 242, 192, 174, 175, 130, 0, 227,
-	153, 166, 150, 208, 651, 652, 149, 612, 649, 267,
-	133, 134, 266, 207, 254, 258, 193, 187, 132, 256,
-	191, 186, 178, 157, 170, 220, 185, 221, 171, 197,
-	196, 198, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 269, 0, 0, 667,
-	0, 0, 0, 244, 0, 0, 179, 0, 0, 0,
-	650, 0, 230, 213, 678, 0, 218, 228, 183, 255,
-	222, 260, 246, 268, 0, 223, 125, 247, 152, 194,
-	136, 137, 148, 154, 156, 158, 159, 203, 204, 216,
-	235, 248, 249, 250, 151, 144, 229, 145, 168, 146,
-	126, 237, 147, 127, 217, 253, 0, 165, 225, 190,
-	128, 189, 219, 252, 251, 276, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 162, 0, 264, 665, 209,
-	677, 660, 662, 663, 666, 670, 671, 610, 613, 672,
-	674, 676, 679, 233, 0, 0, 0, 0, 0, 173,
-	215, 0, 234, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 241, 262, 274, 611, 0,
-	0, 0, 273, 0, 0, 0, 0, 0, 655, 199,
-	200, 201, 202, 668, 0, 142, 0, 0, 1653, 0,
-	0, 0, 0, 0, 0, 0, 161, 167, 0, 169,
-	141, 214, 164, 271, 176, 206, 172, 238, 177, 184,
-	226, 270, 212, 231, 140, 261, 239, 188, 163, 685,
-	664, 684, 686, 687, 683, 688, 689, 673, 628, 0,
-	681, 680, 682, 867, 866, 876, 877, 869, 870, 871,
-	872, 873, 874, 875, 868, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 124, 0, 181, 78, 224, 160,
-	88, 585, 586, 587, 588, 589, 590, 591, 96, 592,
-	593, 594, 595, 101, 596, 103, 597, 598, 106, 107,
-	599, 600, 601, 602, 112, 603, 604, 605, 606, 117,
-	118, 119, 120, 607, 608, 609, 653, 0, 277, 278,
-	279, 263, 0, 0, 0, 0, 211, 0, 0, 0,
-	0, 0, 626, 0, 0, 0, 155, 804, 0, 0,
-	180, 0, 182, 0, 0, 240, 195, 1397, 0, 0,
-	0, 669, 675, 0, 0, 0, 0, 0, 0, 800,
-	0, 0, 619, 0, 0, 583, 659, 658, 635, 0,
-	0, 0, 138, 636, 0, 641, 0, 637, 640, 638,
-	639, 0, 0, 661, 0, 0, 0, 0, 0, 581,
-	623, 0, 627, 867, 866, 876, 877, 869, 870, 871,
-	872, 873, 874, 875, 868, 0, 0, 0, 0, 0,
-	0, 0, 0, 620, 621, 0, 0, 0, 0, 654,
-	0, 622, 0, 0, 801, 0, 642, 0, 129, 245,
-	259, 139, 236, 272, 143, 243, 135, 210, 232, 131,
-	257, 242, 192, 174, 175, 130, 0, 227, 153, 166,
-	150, 208, 651, 652, 149, 612, 649, 267, 133, 134,
-	266, 207, 254, 258, 193, 187, 132, 256, 191, 186,
-	178, 157, 170, 220, 185, 221, 171, 197, 196, 198,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 269, 0, 0, 667, 0, 0,
-	0, 244, 0, 0, 179, 0, 0, 0, 650, 0,
-	230, 213, 678, 0, 218, 228, 183, 255, 222, 260,
-	246, 268, 0, 223, 125, 247, 152, 194, 136, 137,
-	148, 154, 156, 158, 159, 203, 204, 216, 235, 248,
-	249, 250, 151, 144, 229, 145, 168, 146, 126, 237,
-	147, 127, 217, 253, 0, 165, 225, 190, 128, 189,
-	219, 252, 251, 276, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 162, 0, 264, 665, 209, 677, 660,
-	662, 663, 666, 670, 671, 610, 613, 672, 674, 676,
-	679, 233, 0, 0, 0, 0, 0, 173, 215, 0,
-	234, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 241, 262, 274, 611, 0, 0, 0,
-	273, 0, 0, 0, 0, 0, 655, 199, 200, 201,
-	202, 668, 0, 142, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 161, 167, 0, 169, 141, 214,
-	164, 271, 176, 206, 172, 238, 177, 184, 226, 270,
-	212, 231, 140, 261, 239, 188, 163, 685, 664, 684,
-	686, 687, 683, 688, 689, 673, 628, 0, 681, 680,
-	682, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 124, 0, 181, 0, 224, 160, 88, 585,
-	586, 587, 588, 589, 590, 591, 96, 592, 593, 594,
-	595, 101, 596, 103, 597, 598, 106, 107, 599, 600,
-	601, 602, 112, 603, 604, 605, 606, 117, 118, 119,
-	120, 607, 608, 609, 653, 0, 277, 278, 279, 263,
-	0, 0, 0, 0, 211, 0, 0, 0, 0, 0,
-	626, 0, 0, 0, 155, 2077, 0, 0, 180, 0,
-	182, 0, 0, 240, 195, 0, 0, 0, 0, 669,
-	675, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	619, 0, 0, 583, 659, 658, 635, 0, 0, 0,
-	138, 636, 0, 641, 0, 637, 640, 638, 639, 0,
-	0, 661, 0, 0, 0, 0, 0, 581, 623, 0,
-	627, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 620, 621, 0, 0, 0, 0, 654, 0, 622,
-	0, 0, 656, 0, 642, 0, 129, 245, 259, 139,
-	236, 272, 143, 243, 135, 210, 232, 131, 257, 242,
-	192, 174, 175, 130, 0, 227, 153, 166, 150, 208,
-	651, 652, 149, 612, 649, 267, 133, 134, 266, 207,
-	254, 258, 193, 187, 132, 256, 191, 186, 178, 157,
-	170, 220, 185, 221, 171, 197, 196, 198, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 269, 0, 0, 667, 0, 0, 0, 244,
-	0, 0, 179, 0, 0, 0, 650, 0, 230, 213,
-	678, 0, 218, 228, 183, 255, 222, 260, 246, 268,
-	0, 223, 125, 247, 152, 194, 136, 137, 148, 154,
-	156, 158, 159, 203, 204, 216, 235, 248, 249, 250,
-	151, 144, 229, 145, 168, 146, 126, 237, 147, 127,
-	217, 253, 0, 165, 225, 190, 128, 189, 219, 252,
-	251, 276, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 162, 0, 264, 665, 209, 677, 660, 662, 663,
-	666, 670, 671, 610, 613, 672, 674, 676, 679, 233,
-	0, 0, 0, 0, 0, 173, 215, 0, 234, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 241, 262, 274, 611, 0, 0, 0, 273, 0,
-	0, 0, 0, 0, 655, 199, 200, 201, 202, 668,
-	0, 142, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 161, 167, 0, 169, 141, 214, 164, 271,
-	176, 206, 172, 238, 177, 184, 226, 270, 212, 231,
-	140, 261, 239, 188, 163, 685, 664, 684, 686, 687,
-	683, 688, 689, 673, 628, 0, 681, 680, 682, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	124, 0, 181, 0, 224, 160, 88, 585, 586, 587,
-	588, 589, 590, 591, 96, 592, 593, 594, 595, 101,
-	596, 103, 597, 598, 106, 107, 599, 600, 601, 602,
-	112, 603, 604, 605, 606, 117, 118, 119, 120, 607,
-	608, 609, 653, 0, 277, 278, 279, 263, 0, 0,
-	0, 0, 211, 0, 0, 0, 0, 0, 626, 0,
-	0, 0, 155, 804, 0, 0, 180, 0, 182, 0,
-	0, 240, 195, 0, 0, 0, 0, 669, 675, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 619, 0,
-	0, 583, 659, 658, 635, 0, 0, 0, 138, 636,
-	0, 641, 0, 637, 640, 638, 639, 0, 0, 661,
-	0, 0, 0, 0, 0, 581, 623, 0, 627, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 620,
-	621, 0, 0, 0, 0, 654, 0, 622, 0, 0,
-	656, 0, 642, 0, 129, 245, 259, 139, 236, 272,
-	143, 243, 135, 210, 232, 131, 257, 242, 192, 174,
-	175, 130, 0, 227, 153, 166, 150, 208, 651, 652,
-	149, 612, 649, 267, 133, 134, 266, 207, 254, 258,
-	193, 187, 132, 256, 191, 186, 178, 157, 170, 220,
-	185, 221, 171, 197, 196, 198, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	269, 0, 0, 667, 0, 0, 0, 244, 0, 0,
-	179, 0, 0, 0, 650, 0, 230, 213, 678, 0,
-	218, 228, 183, 255, 222, 260, 246, 268, 0, 223,
-	125, 247, 152, 194, 136, 137, 148, 154, 156, 158,
-	159, 203, 204, 216, 235, 248, 249, 250, 151, 144,
-	229, 145, 168, 146, 126, 237, 147, 127, 217, 253,
-	0, 165, 225, 190, 128, 189, 219, 252, 251, 276,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 162,
-	0, 264, 665, 209, 677, 660, 662, 663, 666, 670,
-	671, 610, 613, 672, 674, 676, 679, 233, 0, 0,
-	0, 0, 0, 173, 215, 0, 234, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 241,
-	262, 274, 611, 0, 0, 0, 273, 0, 0, 0,
-	0, 0, 655, 199, 200, 201, 202, 668, 0, 142,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	161, 167, 0, 169, 141, 214, 164, 271, 176, 206,
-	172, 238, 177, 184, 226, 270, 212, 231, 140, 261,
-	239, 188, 163, 685, 664, 684, 686, 687, 683, 688,
-	689, 673, 628, 0, 681, 680, 682, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 124, 0,
-	181, 0, 224, 160, 88, 585, 586, 587, 588, 589,
-	590, 591, 96, 592, 593, 594, 595, 101, 596, 103,
-	597, 598, 106, 107, 599, 600, 601, 602, 112, 603,
-	604, 605, 606, 117, 118, 119, 120, 607, 608, 609,
-	653, 0, 277, 278, 279, 263, 0, 0, 0, 0,
-	211, 0, 0, 0, 0, 0, 626, 0, 0, 0,
-	155, 0, 0, 0, 180, 0, 182, 0, 0, 240,
-	195, 0, 0, 0, 0, 669, 675, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 619, 0, 0, 583,
-	659, 658, 635, 0, 0, 0, 138, 636, 0, 641,
-	0, 637, 640, 638, 639, 0, 0, 661, 0, 0,
-	0, 0, 0, 581, 623, 0, 627, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 620, 621, 578,
-	0, 0, 0, 654, 0, 622, 0, 0, 656, 0,
-	642, 0, 129, 245, 259, 139, 236, 272, 143, 243,
-	135, 210, 232, 131, 257, 242, 192, 174, 175, 130,
-	0, 227, 153, 166, 150, 208, 651, 652, 149, 612,
-	649, 267, 133, 134, 266, 207, 254, 258, 193, 187,
-	132, 256, 191, 186, 178, 157, 170, 220, 185, 221,
-	171, 197, 196, 198, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 269, 0,
-	0, 667, 0, 0, 0, 244, 0, 0, 179, 0,
-	0, 0, 650, 0, 230, 213, 678, 0, 218, 228,
-	183, 255, 222, 260, 246, 268, 0, 223, 125, 247,
-	152, 194, 136, 137, 148, 154, 156, 158, 159, 203,
-	204, 216, 235, 248, 249, 250, 151, 144, 229, 145,
-	168, 146, 126, 237, 147, 127, 217, 253, 0, 165,
-	225, 190, 128, 189, 219, 252, 251, 276, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 162, 0, 264,
-	665, 209, 677, 660, 662, 663, 666, 670, 671, 610,
-	613, 672, 674, 676, 679, 233, 0, 0, 0, 0,
-	0, 173, 215, 0, 234, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 241, 262, 274,
-	611, 0, 0, 0, 273, 0, 0, 0, 0, 0,
-	655, 199, 200, 201, 202, 668, 0, 142, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 161, 167,
-	0, 169, 141, 214, 164, 271, 176, 206, 172, 238,
-	177, 184, 226, 270, 212, 231, 140, 261, 239, 188,
-	163, 685, 664, 684, 686, 687, 683, 688, 689, 673,
-	628, 0, 681, 680, 682, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 124, 0, 181, 0,
-	224, 160, 88, 585, 586, 587, 588, 589, 590, 591,
-	96, 592, 593, 594, 595, 101, 596, 103, 597, 598,
-	106, 107, 599, 600, 601, 602, 112, 603, 604, 605,
-	606, 117, 118, 119, 120, 607, 608, 609, 653, 0,
-	277, 278, 279, 263, 0, 0, 0, 0, 211, 0,
-	0, 0, 0, 0, 626, 0, 0, 0, 155, 0,
-	0, 0, 180, 0, 182, 0, 0, 240, 195, 0,
-	0, 0, 0, 669, 675, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 619, 0, 0, 583, 659, 658,
-	635, 0, 0, 0, 138, 636, 0, 641, 0, 637,
-	640, 638, 639, 0, 0, 661, 0, 0, 0, 0,
-	0, 581, 623, 0, 627, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 620, 621, 0, 0, 0,
-	0, 654, 0, 622, 0, 0, 656, 0, 642, 0,
-	129, 245, 259, 139, 236, 272, 143, 243, 135, 210,
-	232, 131, 257, 242, 192, 174, 175, 130, 0, 227,
-	153, 166, 150, 208, 651, 652, 149, 612, 649, 267,
-	133, 134, 266, 207, 254, 258, 193, 187, 132, 256,
-	191, 186, 178, 157, 170, 220, 185, 221, 171, 197,
-	196, 198, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 269, 0, 0, 667,
-	0, 0, 0, 244, 0, 0, 179, 0, 0, 0,
-	650, 0, 230, 213, 678, 0, 218, 228, 183, 255,
-	222, 260, 246, 268, 0, 223, 125, 247, 152, 194,
-	136, 137, 148, 154, 156, 158, 159, 203, 204, 216,
-	235, 248, 249, 250, 151, 144, 229, 145, 168, 146,
-	126, 237, 147, 127, 217, 253, 0, 165, 225, 190,
-	128, 189, 219, 252, 251, 276, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 162, 0, 264, 665, 209,
-	677, 660, 662, 663, 666, 670, 671, 610, 613, 672,
-	674, 676, 679, 233, 0, 0, 0, 0, 0, 173,
-	215, 0, 234, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 241, 262, 274, 611, 0,
-	0, 0, 273, 0, 0, 0, 0, 0, 655, 199,
-	200, 201, 202, 668, 0, 142, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 161, 167, 0, 169,
-	141, 214, 164, 271, 176, 206, 172, 238, 177, 184,
-	226, 270, 212, 231, 140, 261, 239, 188, 163, 685,
-	664, 684, 686, 687, 683, 688, 689, 673, 628, 0,
-	681, 680, 682, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 124, 0, 181, 0, 224, 160,
-	88, 585, 586, 587, 588, 589, 590, 591, 96, 592,
-	593, 594, 595, 101, 596, 103, 597, 598, 106, 107,
-	599, 600, 601, 602, 112, 603, 604, 605, 606, 117,
-	118, 119, 120, 607, 608, 609, 653, 0, 277, 278,
-	279, 263, 0, 0, 0, 0, 211, 0, 0, 0,
-	0, 0, 626, 0, 0, 0, 155, 0, 0, 0,
-	180, 0, 182, 0, 0, 240, 195, 0, 0, 0,
-	0, 669, 675, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 619, 0, 0, 583, 659, 658, 635, 0,
-	0, 0, 138, 636, 0, 641, 0, 637, 640, 638,
-	639, 0, 0, 661, 0, 0, 0, 0, 0, 0,
-	623, 0, 627, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 620, 621, 0, 0, 0, 0, 654,
-	0, 622, 0, 0, 656, 0, 642, 0, 129, 245,
-	259, 139, 236, 272, 143, 243, 135, 210, 232, 131,
-	257, 242, 192, 174, 175, 130, 0, 227, 153, 166,
-	150, 208, 651, 652, 149, 612, 649, 267, 133, 134,
-	266, 207, 254, 258, 193, 187, 132, 256, 191, 186,
-	178, 157, 170, 220, 185, 221, 171, 197, 196, 198,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 269, 0, 0, 667, 0, 0,
-	0, 244, 0, 0, 179, 0, 0, 0, 650, 0,
-	230, 213, 678, 0, 218, 228, 183, 255, 222, 260,
-	246, 268, 0, 223, 125, 247, 152, 194, 136, 137,
-	148, 154, 156, 158, 159, 203, 204, 216, 235, 248,
-	249, 250, 151, 144, 229, 145, 168, 146, 126, 237,
-	147, 127, 217, 253, 0, 165, 225, 190, 128, 189,
-	219, 252, 251, 276, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 162, 0, 264, 665, 209, 677, 660,
-	662, 663, 666, 670, 671, 610, 613, 672, 674, 676,
-	679, 233, 0, 0, 0, 0, 0, 173, 215, 0,
-	234, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 241, 262, 274, 611, 0, 0, 0,
-	273, 0, 0, 0, 0, 0, 655, 199, 200, 201,
-	202, 668, 0, 142, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 161, 167, 0, 169, 141, 214,
-	164, 271, 176, 206, 172, 238, 177, 184, 226, 270,
-	212, 231, 140, 261, 239, 188, 163, 685, 664, 684,
-	686, 687, 683, 688, 689, 673, 628, 0, 681, 680,
-	682, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 124, 0, 181, 0, 224, 160, 88, 585,
-	586, 587, 588, 589, 590, 591, 96, 592, 593, 594,
-	595, 101, 596, 103, 597, 598, 106, 107, 599, 600,
-	601, 602, 112, 603, 604, 605, 606, 117, 118, 119,
-	120, 607, 608, 609, 0, 0, 277, 278, 279, 263,
-	319, 0, 318, 322, 314, 0, 0, 0, 0, 0,
-	0, 0, 211, 0, 310, 0, 0, 879, 0, 882,
-	0, 0, 155, 0, 0, 329, 180, 0, 182, 0,
-	0, 240, 195, 880, 881, 878, 0, 867, 866, 876,
-	877, 869, 870, 871, 872, 873, 874, 875, 868, 0,
-	0, 332, 0, 0, 333, 0, 0, 0, 138, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 319, 0, 318, 322, 314, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 310, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 329, 0,
-	0, 0, 0, 0, 129, 245, 259, 139, 236, 272,
-	143, 243, 135, 210, 232, 131, 257, 242, 192, 174,
-	175, 130, 0, 227, 153, 166, 150, 208, 0, 0,
-	149, 275, 0, 267, 133, 134, 266, 207, 254, 258,
-	193, 187, 132, 256, 191, 186, 178, 157, 170, 220,
-	185, 221, 171, 197, 196, 198, 0, 0, 0, 0,
-	0, 312, 311, 315, 0, 0, 0, 0, 0, 317,
-	269, 0, 0, 0, 0, 0, 0, 244, 0, 0,
-	179, 321, 0, 0, 0, 0, 230, 213, 0, 0,
-	218, 228, 183, 255, 222, 313, 246, 268, 0, 337,
-	125, 247, 152, 194, 136, 137, 148, 154, 156, 158,
-	159, 203, 204, 216, 235, 248, 249, 250, 151, 144,
-	229, 145, 168, 146, 126, 237, 147, 127, 217, 253,
-	0, 165, 225, 190, 128, 189, 219, 252, 251, 276,
-	0, 0, 0, 0, 312, 311, 315, 0, 0, 162,
-	0, 264, 317, 209, 0, 0, 0, 0, 0, 0,
-	0, 205, 280, 0, 321, 0, 0, 233, 0, 0,
-	0, 316, 320, 323, 215, 324, 325, 0, 736, 326,
-	327, 328, 0, 0, 330, 331, 0, 0, 0, 241,
-	262, 274, 265, 0, 0, 0, 273, 0, 0, 0,
-	0, 0, 0, 199, 200, 201, 202, 0, 0, 142,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	161, 167, 0, 169, 141, 214, 164, 271, 176, 206,
-	172, 238, 177, 184, 226, 270, 212, 231, 140, 261,
-	239, 188, 163, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 316, 320, 737, 0, 324, 738,
-	0, 0, 326, 327, 328, 0, 0, 330, 331, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 124, 0,
-	181, 0, 224, 160, 88, 89, 90, 91, 92, 93,
-	94, 95, 96, 97, 98, 99, 100, 101, 102, 103,
-	104, 105, 106, 107, 108, 109, 110, 111, 112, 113,
-	114, 115, 116, 117, 118, 119, 120, 121, 122, 123,
-	0, 0, 277, 278, 279, 263, 319, 0, 318, 322,
-	314, 0, 0, 0, 0, 0, 0, 0, 211, 0,
-	310, 0, 0, 0, 0, 0, 0, 0, 155, 0,
-	0, 329, 180, 0, 182, 0, 0, 240, 195, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 332, 0, 0,
-	333, 0, 0, 0, 138, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	129, 245, 259, 139, 236, 272, 143, 243, 135, 210,
-	232, 131, 257, 242, 192, 174, 175, 130, 0, 227,
-	153, 166, 150, 208, 0, 0, 149, 275, 0, 267,
-	133, 134, 266, 207, 254, 258, 193, 187, 132, 256,
-	191, 186, 178, 157, 170, 220, 185, 221, 171, 197,
-	196, 198, 0, 0, 0, 0, 0, 312, 311, 315,
-	0, 0, 0, 0, 0, 317, 269, 0, 0, 0,
-	0, 0, 0, 244, 0, 0, 179, 321, 0, 0,
-	0, 0, 230, 213, 0, 0, 218, 228, 183, 255,
-	222, 313, 246, 268, 0, 223, 125, 247, 152, 194,
-	136, 137, 148, 154, 156, 158, 159, 203, 204, 216,
-	235, 248, 249, 250, 151, 144, 229, 145, 168, 146,
-	126, 237, 147, 127, 217, 253, 0, 165, 225, 190,
-	128, 189, 219, 252, 251, 276, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 162, 0, 264, 0, 209,
-	0, 0, 0, 0, 0, 0, 0, 205, 280, 0,
-	0, 0, 0, 233, 0, 0, 0, 316, 320, 323,
-	215, 324, 325, 0, 0, 326, 327, 328, 0, 0,
-	330, 331, 0, 0, 0, 241, 262, 274, 265, 0,
-	0, 0, 273, 0, 0, 0, 0, 0, 0, 199,
-	200, 201, 202, 0, 0, 142, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 161, 167, 0, 169,
-	141, 214, 164, 271, 176, 206, 172, 238, 177, 184,
-	226, 270, 212, 231, 140, 261, 239, 188, 163, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 124, 0, 181, 0, 224, 160,
-	88, 89, 90, 91, 92, 93, 94, 95, 96, 97,
-	98, 99, 100, 101, 102, 103, 104, 105, 106, 107,
-	108, 109, 110, 111, 112, 113, 114, 115, 116, 117,
-	118, 119, 120, 121, 122, 123, 0, 0, 277, 278,
-	279, 263, 79, 0, 23, 39, 24, 0, 0, 0,
-	0, 0, 0, 0, 211, 283, 0, 0, 0, 0,
-	0, 0, 0, 0, 155, 0, 0, 0, 180, 0,
-	182, 0, 0, 240, 195, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	288, 0, 0, 85, 0, 0, 0, 0, 0, 0,
-	138, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 129, 245, 259, 139,
-	236, 272, 143, 243, 135, 210, 232, 131, 257, 242,
-	192, 174, 175, 130, 0, 227, 153, 166, 150, 208,
-	0, 0, 149, 275, 0, 267, 133, 134, 266, 207,
-	254, 258, 193, 187, 132, 256, 191, 186, 178, 157,
-	170, 220, 185, 221, 171, 197, 196, 198, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 287, 0, 0,
-	0, 0, 269, 0, 0, 0, 0, 0, 0, 244,
-	0, 0, 179, 0, 0, 0, 0, 0, 230, 213,
-	0, 0, 218, 228, 183, 255, 222, 260, 246, 268,
-	0, 223, 125, 247, 152, 194, 136, 137, 148, 154,
-	156, 158, 159, 203, 204, 216, 235, 248, 249, 250,
-	151, 144, 229, 145, 168, 146, 126, 237, 147, 127,
-	217, 253, 0, 165, 225, 190, 128, 189, 219, 252,
-	251, 276, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 162, 0, 264, 0, 209, 0, 0, 0, 0,
-	0, 0, 0, 205, 280, 0, 0, 0, 0, 233,
-	0, 0, 0, 0, 0, 173, 215, 0, 234, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 241, 262, 274, 265, 0, 0, 0, 273, 0,
-	0, 0, 0, 0, 0, 199, 200, 201, 202, 284,
-	286, 142, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 161, 167, 0, 169, 141, 214, 164, 271,
-	176, 206, 172, 238, 177, 184, 226, 270, 212, 231,
-	140, 261, 239, 188, 163, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	124, 0, 181, 78, 224, 160, 88, 89, 90, 91,
-	92, 93, 94, 95, 96, 97, 98, 99, 100, 101,
-	102, 103, 104, 105, 106, 107, 108, 109, 110, 111,
-	112, 113, 114, 115, 116, 117, 118, 119, 120, 121,
-	122, 123, 211, 0, 277, 278, 279, 263, 0, 0,
-	0, 0, 155, 0, 0, 0, 180, 0, 182, 0,
-	0, 240, 195, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 85, 0, 0, 0, 0, 0, 0, 138, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1449, 1452, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 129, 245, 259, 139, 236, 272,
-	143, 243, 135, 210, 232, 131, 257, 242, 192, 174,
-	175, 130, 0, 227, 153, 166, 150, 208, 0, 0,
-	149, 275, 0, 267, 133, 134, 266, 207, 254, 258,
-	193, 187, 132, 256, 191, 186, 178, 157, 170, 220,
-	185, 221, 171, 197, 196, 198, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1453,
-	269, 0, 0, 0, 1446, 0, 1445, 244, 1447, 1450,
-	179, 0, 0, 0, 0, 0, 230, 213, 0, 0,
-	218, 228, 183, 255, 222, 260, 246, 268, 0, 223,
-	125, 247, 152, 194, 136, 137, 148, 154, 156, 158,
-	159, 203, 204, 216, 235, 248, 249, 250, 151, 144,
-	229, 145, 168, 146, 126, 237, 147, 127, 217, 253,
-	1451, 165, 225, 190, 128, 189, 219, 252, 251, 276,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 162,
-	0, 264, 0, 209, 0, 0, 0, 0, 0, 0,
-	0, 205, 280, 0, 0, 0, 0, 233, 0, 0,
-	0, 0, 0, 173, 215, 0, 234, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 241,
-	262, 274, 265, 0, 0, 0, 273, 0, 0, 0,
-	0, 0, 0, 199, 200, 201, 202, 0, 0, 142,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	161, 167, 0, 169, 141, 214, 164, 271, 176, 206,
-	172, 238, 177, 184, 226, 270, 212, 231, 140, 261,
-	239, 188, 163, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 124, 0,
-	181, 0, 224, 160, 88, 89, 90, 91, 92, 93,
-	94, 95, 96, 97, 98, 99, 100, 101, 102, 103,
-	104, 105, 106, 107, 108, 109, 110, 111, 112, 113,
-	114, 115, 116, 117, 118, 119, 120, 121, 122, 123,
-	211, 0, 277, 278, 279, 263, 0, 0, 0, 0,
-	155, 379, 0, 0, 180, 0, 182, 0, 0, 240,
-	195, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 85,
-	391, 392, 0, 0, 0, 0, 138, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 393, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 129, 245, 259, 139, 236, 272, 143, 243,
-	135, 210, 232, 131, 257, 242, 192, 174, 175, 130,
-	0, 227, 153, 166, 150, 208, 0, 0, 149, 275,
-	395, 267, 133, 394, 266, 207, 254, 258, 193, 187,
-	132, 256, 191, 186, 178, 157, 170, 220, 185, 221,
-	171, 197, 196, 198, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 269, 0,
-	0, 0, 0, 0, 0, 244, 0, 0, 179, 0,
-	0, 0, 0, 0, 230, 213, 0, 0, 218, 228,
-	183, 255, 222, 260, 246, 268, 378, 223, 125, 247,
-	152, 194, 136, 137, 148, 154, 156, 158, 159, 203,
-	204, 216, 235, 248, 249, 250, 151, 144, 229, 145,
-	168, 146, 126, 237, 147, 127, 217, 253, 0, 165,
-	225, 190, 128, 189, 219, 252, 251, 276, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 162, 0, 264,
-	0, 209, 0, 0, 0, 0, 0, 0, 0, 205,
-	280, 0, 0, 0, 0, 233, 0, 0, 0, 0,
-	0, 173, 215, 0, 234, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 241, 262, 274,
-	265, 0, 0, 0, 273, 0, 0, 0, 0, 0,
-	381, 199, 200, 201, 202, 0, 0, 142, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 161, 167,
-	0, 169, 141, 214, 164, 271, 176, 388, 384, 385,
-	177, 184, 226, 270, 212, 231, 140, 261, 239, 386,
-	163, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 124, 0, 181, 0,
-	224, 160, 88, 89, 90, 91, 92, 93, 94, 95,
+	774, 772, 2214, 2212, 2211, 2220, 651, 2188, 2176, 669,
+	2168, 789, 2029, 1761, 1839, 1632, 2158, 1806, 649, 2092,
+	2008, 578, 2093, 2108, 2069, 87, 2019, 2011, 302, 1985,
+	2020, 1941, 1837, 1178, 865, 773, 576, 1854, 1838, 1996,
+	474, 316, 317, 1829, 407, 314, 90, 1913, 1421, 1701,
+	349, 349, 1719, 1828, 786, 530, 1720, 1522, 1526, 1794,
+	1551, 1722, 306, 20, 1511, 749, 604, 849, 1731, 1727,
+	86, 678, 54, 614, 1539, 1396, 408, 1561, 1686, 1531,
+	1527, 1185, 431, 733, 650, 1577, 87, 968, 1578, 1458,
+	308, 586, 983, 977, 1520, 547, 783, 874, 986, 54,
+	969, 3, 978, 1327, 324, 324, 660, 1390, 53, 1311,
+	305, 12, 303, 6, 304, 5, 842, 1769, 1193, 802,
+	356, 1260, 355, 750, 784, 771, 607, 818, 1261, 1246,
+	319, 440, 517, 1161, 846, 816, 298, 817, 295, 869,
+	1144, 451, 905, 476, 430, 587, 20, 569, 400, 418,
+	420, 553, 824, 775, 462, 54, 321, 83, 1857, 1168,
+	495, 1757, 320, 1631, 798, 971, 82, 370, 2057, 1372,
+	357, 428, 1164, 555, 1512, 1391, 80, 2046, 309, 82,
+	354, 351, 1623, 1156, 1157, 437, 768, 419, 82, 527,
+	24, 40, 25, 82, 12, 1379, 6, 414, 5, 416,
+	82, 836, 24, 40, 25, 82, 1483, 515, 424, 423,
+	425, 401, 1853, 550, 78, 551, 1382, 730, 831, 832,
+	727, 544, 545, 2096, 2097, 542, 556, 78, 541, 544,
+	545, 387, 820, 792, 510, 2172, 78, 2067, 422, 2080,
+	377, 729, 1515, 506, 2111, 2114, 1860, 415, 78, 1516,
+	1633, 1517, 2078, 78, 2070, 2071, 2072, 2073, 796, 1354,
+	445, 1855, 454, 1540, 1541, 1542, 1543, 1399, 1397, 1394,
+	1398, 1400, 1562, 1393, 1392, 372, 1399, 1397, 473, 1398,
+	1400, 843, 1565, 1164, 1166, 369, 368, 388, 497, 1579,
+	1912, 1815, 1814, 508, 509, 316, 444, 496, 501, 1811,
+	1754, 507, 1628, 2056, 1930, 1713, 363, 776, 87, 1714,
+	443, 2082, 1591, 1588, 1589, 1590, 1841, 1584, 2095, 1583,
+	1582, 1580, 1710, 2106, 1564, 1919, 502, 1997, 1998, 1999,
+	2001, 2000, 2197, 778, 421, 478, 478, 1585, 1402, 1403,
+	1404, 1405, 2221, 2119, 454, 2077, 2027, 2028, 366, 2031,
+	2031, 2126, 2010, 458, 1907, 484, 2054, 2186, 1875, 1874,
+	479, 479, 353, 1942, 2037, 1532, 1535, 2059, 2060, 2084,
+	2085, 54, 54, 420, 1581, 565, 1380, 411, 1901, 540,
+	539, 2222, 504, 2215, 2177, 442, 427, 487, 1863, 439,
+	1459, 552, 367, 505, 531, 554, 1711, 349, 2161, 528,
+	499, 2109, 362, 408, 408, 408, 456, 455, 543, 777,
+	419, 492, 500, 503, 1897, 1376, 1216, 1408, 804, 532,
+	1172, 534, 498, 426, 486, 1544, 600, 529, 516, 522,
+	431, 533, 1629, 610, 535, 307, 447, 448, 1729, 1728,
+	1214, 1213, 732, 1419, 581, 1212, 559, 557, 558, 834,
+	413, 324, 835, 1410, 371, 1535, 392, 1211, 747, 833,
+	444, 316, 316, 316, 316, 389, 390, 2210, 609, 2192,
+	856, 1502, 764, 1431, 751, 1536, 1970, 920, 1370, 1369,
+	1529, 1586, 1587, 1353, 1530, 1533, 1347, 589, 456, 455,
+	1206, 361, 349, 349, 444, 349, 1160, 478, 2083, 2162,
+	1138, 728, 519, 886, 735, 394, 393, 478, 790, 583,
+	54, 536, 2058, 349, 349, 457, 766, 87, 441, 2009,
+	1506, 54, 479, 449, 1504, 1512, 769, 564, 1409, 544,
+	545, 349, 479, 349, 521, 811, 1534, 87, 590, 592,
+	416, 591, 544, 545, 844, 1281, 512, 324, 1187, 791,
+	1712, 825, 825, 575, 349, 810, 800, 1167, 494, 803,
+	548, 1373, 797, 1709, 1536, 488, 349, 408, 81, 349,
+	1902, 1903, 1505, 595, 596, 597, 598, 599, 570, 601,
+	823, 81, 813, 805, 857, 806, 738, 324, 415, 571,
+	81, 812, 588, 483, 1163, 81, 349, 349, 864, 87,
+	87, 603, 81, 431, 537, 850, 875, 81, 827, 794,
+	884, 850, 2159, 2160, 572, 573, 574, 742, 743, 546,
+	324, 549, 1899, 870, 2200, 763, 1898, 807, 1248, 1247,
+	411, 821, 441, 822, 867, 814, 815, 568, 795, 752,
+	753, 754, 755, 2156, 866, 866, 1162, 887, 871, 788,
+	828, 324, 799, 779, 1552, 2041, 939, 1349, 1399, 1397,
+	1218, 1398, 1400, 809, 1142, 793, 882, 883, 881, 1277,
+	1869, 1274, 446, 1609, 1611, 1276, 1273, 1275, 1279, 1280,
+	1387, 819, 859, 1278, 1971, 1973, 1974, 1975, 1972, 1328,
+	938, 1464, 862, 845, 538, 808, 840, 1328, 946, 384,
+	746, 883, 881, 413, 826, 582, 1410, 1242, 745, 567,
+	855, 1797, 882, 883, 881, 841, 1745, 1253, 1243, 858,
+	852, 853, 854, 881, 860, 1909, 937, 863, 1908, 1690,
+	975, 975, 980, 480, 481, 482, 579, 1685, 861, 1478,
+	940, 941, 942, 943, 1892, 1437, 1800, 872, 1257, 875,
+	2185, 76, 868, 1744, 1795, 391, 1432, 988, 1318, 1259,
+	1809, 1810, 1981, 419, 1762, 1796, 875, 2206, 944, 2182,
+	982, 2089, 1316, 1317, 1315, 2141, 964, 882, 883, 881,
+	912, 2136, 989, 2120, 2018, 1284, 1285, 1286, 1287, 1288,
+	1289, 1282, 1283, 2184, 580, 882, 883, 881, 2017, 1801,
+	1980, 420, 882, 883, 881, 87, 87, 87, 1176, 2016,
+	2014, 54, 577, 87, 923, 924, 925, 926, 927, 920,
+	302, 2173, 480, 481, 482, 579, 956, 1979, 1208, 417,
+	395, 1152, 974, 1140, 882, 883, 881, 349, 419, 870,
+	480, 481, 482, 579, 1500, 1175, 1139, 381, 1501, 1987,
+	1181, 1183, 1184, 1965, 981, 382, 416, 349, 1196, 480,
+	481, 482, 1703, 1153, 871, 1978, 948, 1281, 1964, 87,
+	882, 883, 881, 949, 1963, 1808, 1136, 1528, 610, 987,
+	316, 1960, 1137, 580, 1954, 1951, 1239, 1240, 850, 850,
+	850, 324, 1977, 1467, 1967, 1149, 1466, 1197, 1198, 1199,
+	1950, 580, 1803, 1228, 1254, 1255, 1804, 1916, 2225, 1858,
+	1209, 1223, 1200, 609, 866, 1848, 1847, 1236, 1237, 1238,
+	1704, 882, 883, 881, 1802, 1805, 1846, 1171, 1845, 1194,
+	1976, 1842, 1966, 964, 1697, 1696, 1251, 1299, 1300, 1301,
+	1302, 1303, 1304, 1305, 1306, 1307, 1308, 1309, 1310, 1695,
+	1694, 1205, 1320, 1321, 1202, 1495, 1204, 1201, 1203, 1336,
+	1232, 819, 736, 1244, 935, 936, 2105, 1215, 1179, 1180,
+	1235, 2088, 890, 891, 892, 893, 894, 895, 1811, 888,
+	1986, 1338, 2048, 1329, 2035, 1739, 1332, 1219, 1220, 1221,
+	1798, 1277, 1224, 1274, 1225, 2034, 2021, 1276, 1273, 1275,
+	1279, 1280, 1968, 2195, 1233, 1278, 379, 1961, 380, 387,
+	882, 883, 881, 378, 376, 375, 383, 1319, 385, 386,
+	1957, 1956, 1245, 882, 883, 881, 1955, 1943, 1249, 1250,
+	1929, 1252, 931, 1914, 934, 1904, 1894, 1313, 1290, 1291,
+	1292, 1293, 1294, 1295, 1859, 1296, 1297, 1298, 932, 933,
+	930, 1422, 919, 918, 928, 929, 921, 922, 923, 924,
+	925, 926, 927, 920, 1760, 1758, 1939, 1705, 2183, 1549,
+	1918, 1330, 2065, 1352, 1548, 1547, 1331, 1333, 1334, 1546,
+	2153, 1323, 480, 481, 482, 2064, 1322, 1337, 1469, 1339,
+	882, 883, 881, 1174, 1173, 1340, 1262, 1263, 1264, 1265,
+	1266, 1267, 1268, 1269, 1270, 1271, 1272, 1284, 1285, 1286,
+	1287, 1288, 1289, 1282, 1283, 919, 918, 928, 929, 921,
+	922, 923, 924, 925, 926, 927, 920, 919, 918, 928,
+	929, 921, 922, 923, 924, 925, 926, 927, 920, 1355,
+	960, 959, 444, 919, 918, 928, 929, 921, 922, 923,
+	924, 925, 926, 927, 920, 875, 751, 882, 883, 881,
+	958, 1367, 737, 1473, 359, 349, 1159, 1472, 349, 1159,
+	2230, 444, 2063, 349, 358, 2224, 2223, 2042, 1359, 1170,
+	2198, 1360, 2194, 2193, 1362, 1375, 921, 922, 923, 924,
+	925, 926, 927, 920, 1938, 1366, 918, 928, 929, 921,
+	922, 923, 924, 925, 926, 927, 920, 1416, 1994, 1937,
+	1932, 1383, 1384, 803, 1931, 594, 1924, 349, 882, 883,
+	881, 1737, 1374, 2191, 2190, 1170, 2180, 87, 87, 1750,
+	1738, 2199, 1427, 882, 883, 881, 1170, 2179, 1746, 1407,
+	882, 883, 881, 1926, 2103, 882, 883, 881, 1386, 1743,
+	1617, 1389, 1364, 1608, 1438, 882, 883, 881, 1926, 2098,
+	1742, 1357, 1718, 416, 1706, 1358, 1227, 2086, 1638, 1377,
+	1602, 1619, 1424, 1425, 882, 883, 881, 882, 883, 881,
+	1601, 1567, 1434, 1600, 1412, 1435, 1436, 2075, 2074, 1371,
+	1926, 2052, 20, 1566, 882, 883, 881, 1385, 1413, 1597,
+	1414, 54, 1926, 2051, 882, 883, 881, 882, 883, 881,
+	1194, 1406, 1476, 1420, 1926, 2050, 1453, 1417, 1926, 2049,
+	2040, 2039, 1474, 882, 883, 881, 1444, 1445, 1446, 1447,
+	1596, 1426, 1450, 1451, 1452, 1423, 1456, 1457, 1992, 1993,
+	12, 1415, 6, 1471, 5, 1470, 975, 1468, 1487, 975,
+	1992, 1991, 1490, 1442, 882, 883, 881, 1595, 1936, 1935,
+	1439, 1461, 875, 1433, 1465, 1934, 1933, 1926, 1925, 349,
+	1231, 1622, 1418, 349, 349, 1493, 1477, 349, 1335, 850,
+	1594, 882, 883, 881, 1576, 850, 937, 1159, 1603, 444,
+	928, 929, 921, 922, 923, 924, 925, 926, 927, 920,
+	1494, 1455, 87, 1525, 882, 883, 881, 1484, 882, 883,
+	881, 1482, 444, 1575, 1159, 1592, 54, 1489, 1231, 1498,
+	1159, 1441, 1313, 419, 1256, 1454, 1525, 1507, 1509, 1574,
+	1463, 316, 1572, 1158, 1486, 734, 1324, 882, 883, 881,
+	1159, 1440, 1488, 1479, 1231, 1363, 1491, 1550, 1485, 1496,
+	1231, 1356, 1492, 882, 883, 881, 1351, 1350, 879, 1497,
+	882, 883, 881, 1345, 1344, 1503, 1231, 1230, 770, 1553,
+	1554, 1545, 593, 1510, 1170, 1169, 740, 739, 1141, 1593,
+	491, 1141, 511, 489, 1598, 1599, 490, 490, 1159, 1342,
+	1639, 1164, 1620, 1449, 1616, 1448, 1430, 1556, 1557, 1227,
+	1610, 492, 877, 1572, 1613, 1614, 1555, 1348, 1325, 349,
+	1615, 1558, 1177, 602, 82, 566, 2226, 2155, 734, 2149,
+	87, 1571, 2140, 1190, 492, 459, 2127, 2124, 2122, 1684,
+	2006, 1990, 1988, 1983, 1607, 1945, 464, 467, 468, 469,
+	465, 1940, 466, 470, 1606, 1604, 1721, 464, 467, 468,
+	469, 465, 1612, 466, 470, 1922, 1921, 1920, 1917, 1906,
+	2132, 1890, 78, 1624, 1825, 1637, 1822, 1821, 1723, 605,
+	1732, 1621, 1717, 1735, 1699, 1702, 464, 467, 468, 469,
+	465, 1636, 466, 470, 1691, 1314, 1411, 1688, 1700, 2130,
+	54, 1388, 1627, 1365, 1361, 1343, 1229, 1217, 1210, 1154,
+	1692, 965, 963, 962, 961, 1716, 957, 1683, 906, 54,
+	1687, 1689, 1687, 1693, 1646, 2151, 954, 952, 951, 444,
+	1698, 950, 947, 78, 917, 916, 349, 349, 915, 914,
+	87, 913, 911, 751, 910, 1724, 1725, 1726, 1708, 909,
+	444, 1766, 1707, 908, 907, 904, 903, 1741, 902, 901,
+	900, 899, 898, 897, 1525, 896, 850, 1730, 1733, 765,
+	1736, 748, 919, 918, 928, 929, 921, 922, 923, 924,
+	925, 926, 927, 920, 731, 1755, 493, 1145, 1146, 1740,
+	1751, 1752, 2094, 1401, 1748, 1226, 1830, 1832, 1749, 1830,
+	1830, 1148, 1753, 967, 513, 1151, 1150, 1791, 1816, 1812,
+	444, 1747, 1819, 1820, 1763, 1818, 757, 760, 758, 1817,
+	756, 318, 761, 759, 2205, 1346, 2165, 1823, 584, 1826,
+	1827, 762, 1195, 468, 469, 585, 1341, 1836, 1179, 1180,
+	1831, 1513, 518, 1625, 1519, 1188, 830, 1861, 1537, 1518,
+	1626, 873, 1833, 1834, 472, 1135, 1835, 1475, 919, 918,
+	928, 929, 921, 922, 923, 924, 925, 926, 927, 920,
+	520, 1605, 350, 2150, 1844, 433, 435, 436, 1865, 1248,
+	1247, 524, 525, 2145, 1849, 1850, 2143, 2116, 2115, 2113,
+	1948, 1851, 919, 918, 928, 929, 921, 922, 923, 924,
+	925, 926, 927, 920, 1946, 919, 918, 928, 929, 921,
+	922, 923, 924, 925, 926, 927, 920, 1759, 1715, 1635,
+	1634, 1570, 87, 523, 1868, 359, 1893, 358, 1569, 1429,
+	734, 2134, 2133, 1702, 1443, 358, 1368, 294, 1866, 1867,
+	2133, 1870, 1871, 1872, 1873, 2134, 1832, 1876, 1877, 1878,
+	1879, 1880, 1881, 1882, 1883, 1884, 1885, 1886, 1887, 1888,
+	1889, 1895, 1812, 1891, 1618, 471, 373, 1910, 1, 526,
+	744, 453, 741, 452, 450, 77, 1326, 1949, 679, 970,
+	1915, 976, 1984, 2164, 2187, 2139, 1928, 2167, 668, 1923,
+	1460, 652, 1514, 2066, 2110, 2068, 1381, 1378, 514, 1982,
+	1480, 1481, 1927, 692, 1944, 682, 953, 683, 726, 434,
+	478, 919, 918, 928, 929, 921, 922, 923, 924, 925,
+	926, 927, 920, 681, 1843, 1563, 360, 432, 444, 1962,
+	374, 444, 444, 444, 1947, 479, 1911, 444, 1630, 1952,
+	1953, 1813, 1734, 54, 1824, 1958, 1959, 1258, 2219, 2204,
+	2175, 2148, 2030, 2196, 2076, 2125, 2024, 2118, 2026, 1862,
+	1995, 322, 837, 2003, 2004, 2005, 560, 398, 2007, 2002,
+	2025, 2013, 405, 966, 1538, 2012, 1395, 2015, 1186, 1165,
+	785, 323, 2055, 1989, 364, 1189, 2022, 365, 1192, 1191,
+	889, 1312, 955, 945, 87, 612, 1462, 659, 653, 2032,
+	2033, 444, 1560, 1559, 1807, 27, 880, 984, 680, 89,
+	1207, 985, 2023, 1856, 2169, 667, 666, 444, 665, 664,
+	463, 461, 460, 312, 1852, 1499, 1155, 767, 2038, 311,
+	1428, 1568, 876, 2047, 2043, 878, 2091, 2090, 2044, 866,
+	2045, 1756, 1905, 1969, 1900, 1896, 2036, 1765, 1764, 2053,
+	1792, 1793, 1799, 1645, 1641, 1643, 1644, 2062, 2061, 1642,
+	1640, 1523, 1524, 1521, 1147, 1143, 972, 979, 2079, 2081,
+	438, 801, 313, 84, 310, 1234, 606, 11, 18, 17,
+	2087, 16, 48, 47, 46, 45, 2117, 2099, 2100, 2101,
+	2102, 15, 8, 44, 43, 42, 14, 19, 13, 2107,
+	38, 37, 36, 2112, 35, 34, 33, 2121, 32, 2123,
+	31, 30, 29, 28, 9, 58, 57, 56, 55, 21,
+	2128, 22, 23, 2131, 65, 2129, 64, 2104, 62, 63,
+	61, 2135, 60, 444, 26, 444, 2142, 2144, 2137, 2146,
+	2147, 2138, 10, 7, 2152, 4, 2154, 790, 2, 790,
+	0, 2171, 0, 0, 0, 0, 2157, 0, 0, 0,
+	2170, 2163, 0, 0, 0, 0, 444, 0, 0, 2174,
+	0, 0, 0, 2178, 0, 0, 0, 2181, 0, 0,
+	790, 0, 2189, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 2171, 2202, 0, 0, 0, 0, 0, 0,
+	0, 2170, 2201, 2203, 0, 0, 2189, 2207, 0, 0,
+	0, 2216, 0, 0, 0, 2218, 0, 2217, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 2229, 2228,
+	2227, 2218, 0, 0, 1103, 1088, 2209, 1050, 1105, 1022,
+	1038, 1113, 1040, 1041, 1075, 1000, 1059, 221, 1036, 992,
+	1025, 1026, 994, 1033, 995, 1023, 1052, 163, 1021, 1091,
+	1062, 189, 1111, 191, 0, 0, 251, 205, 0, 0,
+	1055, 1093, 1057, 1080, 1049, 1076, 1008, 1069, 1106, 1037,
+	1073, 1107, 0, 0, 0, 0, 480, 481, 482, 0,
+	0, 0, 0, 146, 0, 0, 0, 0, 0, 0,
+	0, 1072, 1099, 1035, 0, 0, 1009, 1104, 1056, 1074,
+	0, 993, 1070, 0, 998, 1001, 1112, 1097, 1030, 1031,
+	0, 0, 0, 0, 0, 0, 0, 1053, 1058, 1077,
+	1046, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1027, 0, 1066, 0, 0, 0, 1003, 999, 0, 1051,
+	0, 134, 256, 271, 147, 247, 285, 151, 254, 143,
+	220, 243, 139, 269, 253, 202, 183, 184, 138, 0,
+	238, 161, 175, 158, 218, 1101, 1102, 157, 288, 1002,
+	280, 141, 142, 279, 217, 266, 270, 203, 196, 140,
+	268, 201, 195, 187, 165, 278, 179, 231, 194, 232,
+	180, 207, 206, 208, 1123, 1124, 1125, 1126, 1127, 1007,
+	0, 1028, 1078, 0, 991, 1087, 1094, 1048, 282, 1098,
+	1045, 1044, 1130, 0, 1129, 255, 1131, 1132, 188, 1092,
+	1024, 1034, 1029, 1032, 241, 223, 1100, 1065, 228, 239,
+	192, 267, 233, 272, 257, 281, 1081, 234, 130, 258,
+	160, 204, 144, 145, 156, 162, 164, 166, 167, 213,
+	214, 226, 246, 259, 260, 261, 159, 152, 240, 153,
+	177, 154, 131, 248, 155, 132, 227, 265, 1128, 174,
+	236, 200, 133, 199, 229, 263, 262, 289, 169, 198,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 171,
+	990, 276, 0, 219, 1089, 996, 1006, 1004, 1042, 1067,
+	1068, 215, 293, 1083, 1086, 1084, 1114, 244, 0, 0,
+	0, 0, 0, 182, 225, 0, 245, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 997, 0, 252,
+	274, 287, 277, 1043, 1015, 1054, 286, 1018, 1016, 1082,
+	1017, 1071, 1116, 209, 210, 211, 212, 1039, 0, 150,
+	1063, 1047, 1117, 1118, 1119, 1120, 1121, 1122, 1020, 1096,
+	170, 176, 0, 178, 149, 224, 173, 284, 185, 216,
+	181, 249, 186, 193, 237, 283, 222, 242, 148, 273,
+	250, 197, 172, 1014, 1019, 1013, 1060, 1061, 1108, 1109,
+	1110, 1079, 1005, 1090, 1010, 1012, 1011, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1085, 1095, 264, 135,
+	230, 136, 137, 1064, 129, 0, 190, 1115, 235, 168,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 82, 0, 688, 0, 0, 0, 1133, 1134,
+	290, 291, 292, 275, 221, 0, 0, 0, 0, 0,
+	661, 0, 0, 0, 163, 0, 0, 0, 189, 714,
+	643, 0, 0, 251, 205, 0, 0, 0, 0, 704,
+	710, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	654, 0, 0, 613, 694, 693, 670, 0, 0, 0,
+	146, 0, 0, 671, 0, 676, 0, 672, 675, 673,
+	674, 0, 0, 696, 0, 0, 0, 0, 0, 611,
+	658, 0, 662, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 655, 656, 0, 0, 0, 0, 689,
+	0, 657, 0, 0, 691, 0, 677, 0, 134, 256,
+	271, 147, 247, 285, 151, 254, 143, 220, 243, 139,
+	269, 253, 202, 183, 184, 138, 0, 238, 161, 175,
+	158, 218, 686, 687, 157, 647, 684, 280, 141, 142,
+	279, 217, 266, 270, 203, 196, 140, 268, 201, 195,
+	187, 165, 646, 179, 231, 194, 232, 180, 207, 206,
+	208, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 282, 0, 0, 702, 0,
+	0, 0, 255, 0, 0, 188, 0, 0, 0, 685,
+	0, 241, 223, 713, 0, 228, 239, 192, 267, 233,
+	272, 257, 281, 0, 234, 130, 258, 160, 204, 144,
+	145, 156, 162, 164, 166, 167, 213, 214, 226, 246,
+	259, 260, 261, 159, 152, 240, 153, 177, 154, 131,
+	248, 155, 132, 227, 265, 0, 174, 236, 200, 133,
+	199, 229, 263, 262, 289, 169, 198, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 171, 0, 276, 700,
+	219, 712, 695, 697, 698, 701, 705, 706, 644, 648,
+	707, 709, 711, 715, 244, 0, 0, 0, 0, 0,
+	182, 225, 0, 245, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 252, 274, 287, 645,
+	0, 0, 0, 286, 0, 0, 0, 0, 0, 690,
+	209, 210, 211, 212, 703, 0, 150, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 170, 176, 0,
+	178, 149, 224, 173, 284, 185, 216, 181, 249, 186,
+	193, 237, 283, 222, 242, 148, 273, 250, 197, 172,
+	721, 699, 720, 722, 723, 719, 724, 725, 708, 663,
+	0, 717, 716, 718, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 264, 135, 230, 136, 137,
+	0, 129, 0, 190, 81, 235, 168, 91, 615, 616,
+	617, 618, 619, 620, 621, 622, 623, 624, 625, 626,
+	104, 627, 106, 628, 629, 109, 110, 630, 631, 632,
+	633, 115, 634, 635, 636, 637, 120, 123, 124, 125,
+	640, 641, 642, 638, 639, 688, 0, 290, 291, 292,
+	275, 0, 0, 0, 0, 221, 0, 0, 0, 0,
+	0, 661, 0, 0, 0, 163, 851, 0, 0, 189,
+	714, 643, 0, 0, 251, 205, 0, 0, 0, 0,
+	704, 710, 0, 0, 0, 0, 0, 0, 847, 0,
+	0, 654, 0, 0, 613, 694, 693, 670, 0, 0,
+	0, 146, 0, 0, 671, 0, 676, 0, 672, 675,
+	673, 674, 0, 0, 696, 0, 0, 0, 0, 0,
+	611, 658, 0, 662, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 655, 656, 0, 0, 0, 0,
+	689, 0, 657, 0, 0, 848, 0, 677, 0, 134,
+	256, 271, 147, 247, 285, 151, 254, 143, 220, 243,
+	139, 269, 253, 202, 183, 184, 138, 0, 238, 161,
+	175, 158, 218, 686, 687, 157, 647, 684, 280, 141,
+	142, 279, 217, 266, 270, 203, 196, 140, 268, 201,
+	195, 187, 165, 646, 179, 231, 194, 232, 180, 207,
+	206, 208, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 282, 0, 0, 702,
+	0, 0, 0, 255, 0, 0, 188, 0, 0, 0,
+	685, 0, 241, 223, 713, 0, 228, 239, 192, 267,
+	233, 272, 257, 281, 0, 234, 130, 258, 160, 204,
+	144, 145, 156, 162, 164, 166, 167, 213, 214, 226,
+	246, 259, 260, 261, 159, 152, 240, 153, 177, 154,
+	131, 248, 155, 132, 227, 265, 0, 174, 236, 200,
+	133, 199, 229, 263, 262, 289, 169, 198, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 171, 0, 276,
+	700, 219, 712, 695, 697, 698, 701, 705, 706, 644,
+	648, 707, 709, 711, 715, 244, 0, 0, 0, 0,
+	0, 182, 225, 0, 245, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 252, 274, 287,
+	645, 0, 0, 0, 286, 0, 0, 0, 0, 0,
+	690, 209, 210, 211, 212, 703, 0, 150, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 170, 176,
+	0, 178, 149, 224, 173, 284, 185, 216, 181, 249,
+	186, 193, 237, 283, 222, 242, 148, 273, 250, 197,
+	172, 721, 699, 720, 722, 723, 719, 724, 725, 708,
+	663, 0, 717, 716, 718, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 264, 135, 230, 136,
+	137, 0, 129, 0, 190, 0, 235, 168, 91, 615,
+	616, 617, 618, 619, 620, 621, 622, 623, 624, 625,
+	626, 104, 627, 106, 628, 629, 109, 110, 630, 631,
+	632, 633, 115, 634, 635, 636, 637, 120, 123, 124,
+	125, 640, 641, 642, 638, 639, 688, 0, 290, 291,
+	292, 275, 0, 0, 0, 0, 221, 0, 0, 0,
+	0, 0, 661, 0, 0, 0, 163, 2208, 0, 0,
+	189, 714, 643, 0, 0, 251, 205, 0, 0, 0,
+	0, 704, 710, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 654, 0, 0, 613, 694, 693, 670, 0,
+	0, 0, 146, 0, 0, 671, 0, 676, 0, 672,
+	675, 673, 674, 0, 0, 696, 0, 0, 0, 0,
+	0, 611, 658, 0, 662, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 655, 656, 0, 0, 0,
+	0, 689, 0, 657, 0, 0, 691, 0, 677, 0,
+	134, 256, 271, 147, 247, 285, 151, 254, 143, 220,
+	243, 139, 269, 253, 202, 183, 184, 138, 0, 238,
+	161, 175, 158, 218, 686, 687, 157, 647, 684, 280,
+	141, 142, 279, 217, 266, 270, 203, 196, 140, 268,
+	201, 195, 187, 165, 646, 179, 231, 194, 232, 180,
+	207, 206, 208, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 282, 0, 0,
+	702, 0, 0, 0, 255, 0, 0, 188, 0, 0,
+	0, 685, 0, 241, 223, 713, 0, 228, 239, 192,
+	267, 233, 272, 257, 281, 0, 234, 130, 258, 160,
+	204, 144, 145, 156, 162, 164, 166, 167, 213, 214,
+	226, 246, 259, 260, 261, 159, 152, 240, 153, 177,
+	154, 131, 248, 155, 132, 227, 265, 0, 174, 236,
+	200, 133, 199, 229, 263, 262, 289, 169, 198, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 171, 0,
+	276, 700, 219, 712, 695, 697, 698, 701, 705, 706,
+	644, 648, 707, 709, 711, 715, 244, 0, 0, 0,
+	0, 0, 182, 225, 0, 245, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 252, 274,
+	287, 645, 0, 0, 0, 286, 0, 0, 0, 0,
+	0, 690, 209, 210, 211, 212, 703, 0, 150, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 170,
+	176, 0, 178, 149, 224, 173, 284, 185, 216, 181,
+	249, 186, 193, 237, 283, 222, 242, 148, 273, 250,
+	197, 172, 721, 699, 720, 722, 723, 719, 724, 725,
+	708, 663, 0, 717, 716, 718, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 264, 135, 230,
+	136, 137, 0, 129, 0, 190, 0, 235, 168, 91,
+	615, 616, 617, 618, 619, 620, 621, 622, 623, 624,
+	625, 626, 104, 627, 106, 628, 629, 109, 110, 630,
+	631, 632, 633, 115, 634, 635, 636, 637, 120, 123,
+	124, 125, 640, 641, 642, 638, 639, 688, 0, 290,
+	291, 292, 275, 0, 0, 0, 0, 221, 0, 0,
+	0, 0, 0, 661, 0, 0, 0, 163, 851, 0,
+	0, 189, 714, 643, 0, 0, 251, 205, 0, 0,
+	0, 0, 704, 710, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 654, 0, 0, 613, 694, 693, 670,
+	0, 0, 0, 146, 0, 0, 671, 0, 676, 0,
+	672, 675, 673, 674, 0, 0, 696, 0, 0, 0,
+	0, 0, 611, 658, 0, 662, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 655, 656, 0, 0,
+	0, 0, 689, 0, 657, 0, 0, 691, 0, 677,
+	0, 134, 256, 271, 147, 247, 285, 151, 254, 143,
+	220, 243, 139, 269, 253, 202, 183, 184, 138, 0,
+	238, 161, 175, 158, 218, 686, 687, 157, 647, 684,
+	280, 141, 142, 279, 217, 266, 270, 203, 196, 140,
+	268, 201, 195, 187, 165, 646, 179, 231, 194, 232,
+	180, 207, 206, 208, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 282, 0,
+	0, 702, 0, 0, 0, 255, 0, 0, 188, 0,
+	0, 0, 685, 0, 241, 223, 713, 0, 228, 239,
+	192, 267, 233, 272, 257, 281, 0, 234, 130, 258,
+	160, 204, 144, 145, 156, 162, 164, 166, 167, 213,
+	214, 226, 246, 259, 260, 261, 159, 152, 240, 153,
+	177, 154, 131, 248, 155, 132, 227, 265, 0, 174,
+	236, 200, 133, 199, 229, 263, 262, 289, 169, 198,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 171,
+	0, 276, 700, 219, 712, 695, 697, 698, 701, 705,
+	706, 644, 648, 707, 709, 711, 715, 244, 0, 0,
+	0, 0, 0, 182, 225, 0, 245, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 252,
+	274, 287, 645, 0, 0, 0, 286, 0, 0, 0,
+	0, 0, 690, 209, 210, 211, 212, 703, 0, 150,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	170, 176, 0, 178, 149, 224, 173, 284, 185, 216,
+	181, 249, 186, 193, 237, 283, 222, 242, 148, 273,
+	250, 197, 172, 721, 699, 720, 722, 723, 719, 724,
+	725, 708, 663, 0, 717, 716, 718, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 264, 135,
+	230, 136, 137, 0, 129, 0, 190, 0, 235, 168,
+	91, 615, 616, 617, 618, 619, 620, 621, 622, 623,
+	624, 625, 626, 104, 627, 106, 628, 629, 109, 110,
+	630, 631, 632, 633, 115, 634, 635, 636, 637, 120,
+	123, 124, 125, 640, 641, 642, 638, 639, 688, 0,
+	290, 291, 292, 275, 0, 0, 0, 0, 221, 0,
+	0, 0, 0, 0, 661, 0, 0, 0, 163, 0,
+	0, 0, 189, 714, 643, 0, 0, 251, 205, 0,
+	0, 0, 0, 704, 710, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 654, 0, 0, 613, 694, 693,
+	670, 0, 0, 0, 146, 0, 0, 671, 0, 676,
+	0, 672, 675, 673, 674, 0, 0, 696, 0, 0,
+	0, 0, 0, 611, 658, 0, 662, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 655, 656, 608,
+	0, 0, 0, 689, 0, 657, 0, 0, 691, 0,
+	677, 0, 134, 256, 271, 147, 247, 285, 151, 254,
+	143, 220, 243, 139, 269, 253, 202, 183, 184, 138,
+	0, 238, 161, 175, 158, 218, 686, 687, 157, 647,
+	684, 280, 141, 142, 279, 217, 266, 270, 203, 196,
+	140, 268, 201, 195, 187, 165, 646, 179, 231, 194,
+	232, 180, 207, 206, 208, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 282,
+	0, 0, 702, 0, 0, 0, 255, 0, 0, 188,
+	0, 0, 0, 685, 0, 241, 223, 713, 0, 228,
+	239, 192, 267, 233, 272, 257, 281, 0, 234, 130,
+	258, 160, 204, 144, 145, 156, 162, 164, 166, 167,
+	213, 214, 226, 246, 259, 260, 261, 159, 152, 240,
+	153, 177, 154, 131, 248, 155, 132, 227, 265, 0,
+	174, 236, 200, 133, 199, 229, 263, 262, 289, 169,
+	198, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	171, 0, 276, 700, 219, 712, 695, 697, 698, 701,
+	705, 706, 644, 648, 707, 709, 711, 715, 244, 0,
+	0, 0, 0, 0, 182, 225, 0, 245, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	252, 274, 287, 645, 0, 0, 0, 286, 0, 0,
+	0, 0, 0, 690, 209, 210, 211, 212, 703, 0,
+	150, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 170, 176, 0, 178, 149, 224, 173, 284, 185,
+	216, 181, 249, 186, 193, 237, 283, 222, 242, 148,
+	273, 250, 197, 172, 721, 699, 720, 722, 723, 719,
+	724, 725, 708, 663, 0, 717, 716, 718, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 264,
+	135, 230, 136, 137, 0, 129, 0, 190, 0, 235,
+	168, 91, 615, 616, 617, 618, 619, 620, 621, 622,
+	623, 624, 625, 626, 104, 627, 106, 628, 629, 109,
+	110, 630, 631, 632, 633, 115, 634, 635, 636, 637,
+	120, 123, 124, 125, 640, 641, 642, 638, 639, 688,
+	0, 290, 291, 292, 275, 0, 0, 0, 0, 221,
+	0, 0, 0, 0, 0, 661, 0, 0, 0, 163,
+	0, 0, 0, 189, 714, 643, 0, 0, 251, 205,
+	0, 0, 0, 0, 704, 710, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 654, 0, 0, 613, 694,
+	693, 670, 0, 0, 0, 146, 0, 0, 671, 0,
+	676, 0, 672, 675, 673, 674, 0, 0, 696, 0,
+	0, 0, 0, 0, 611, 658, 0, 662, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 655, 656,
+	0, 0, 0, 0, 689, 0, 657, 0, 0, 691,
+	0, 677, 0, 134, 256, 271, 147, 247, 285, 151,
+	254, 143, 220, 243, 139, 269, 253, 202, 183, 184,
+	138, 0, 238, 161, 175, 158, 218, 686, 687, 157,
+	647, 684, 280, 141, 142, 279, 217, 266, 270, 203,
+	196, 140, 268, 201, 195, 187, 165, 646, 179, 231,
+	194, 232, 180, 207, 206, 208, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	282, 0, 0, 702, 0, 0, 0, 255, 0, 0,
+	188, 0, 0, 0, 685, 0, 241, 223, 713, 0,
+	228, 239, 192, 267, 233, 272, 257, 281, 0, 234,
+	130, 258, 160, 204, 144, 145, 156, 162, 164, 166,
+	167, 213, 214, 226, 246, 259, 260, 261, 159, 152,
+	240, 153, 177, 154, 131, 248, 155, 132, 227, 265,
+	0, 174, 236, 200, 133, 199, 229, 263, 262, 289,
+	169, 198, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 171, 0, 276, 700, 219, 712, 695, 697, 698,
+	701, 705, 706, 644, 648, 707, 709, 711, 715, 244,
+	0, 0, 0, 0, 0, 182, 225, 0, 245, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 252, 274, 287, 645, 0, 0, 0, 286, 0,
+	0, 0, 0, 0, 690, 209, 210, 211, 212, 703,
+	0, 150, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 170, 176, 0, 178, 149, 224, 173, 284,
+	185, 216, 181, 249, 186, 193, 237, 283, 222, 242,
+	148, 273, 250, 197, 172, 721, 699, 720, 722, 723,
+	719, 724, 725, 708, 663, 0, 717, 716, 718, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	264, 135, 230, 136, 137, 0, 129, 0, 190, 0,
+	235, 168, 91, 615, 616, 617, 618, 619, 620, 621,
+	622, 623, 624, 625, 626, 104, 627, 106, 628, 629,
+	109, 110, 630, 631, 632, 633, 115, 634, 635, 636,
+	637, 120, 123, 124, 125, 640, 641, 642, 638, 639,
+	688, 0, 290, 291, 292, 275, 0, 0, 0, 0,
+	221, 0, 0, 0, 0, 0, 661, 0, 0, 0,
+	163, 0, 0, 0, 189, 714, 643, 0, 0, 251,
+	205, 0, 0, 0, 0, 704, 710, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 654, 0, 0, 613,
+	694, 693, 670, 0, 0, 0, 146, 0, 0, 671,
+	0, 676, 0, 672, 675, 673, 674, 0, 0, 696,
+	0, 0, 0, 0, 0, 0, 658, 0, 662, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 655,
+	656, 0, 0, 0, 0, 689, 0, 657, 0, 0,
+	691, 0, 677, 0, 134, 256, 271, 147, 247, 285,
+	151, 254, 143, 220, 243, 139, 269, 253, 202, 183,
+	184, 138, 0, 238, 161, 175, 158, 218, 686, 687,
+	157, 647, 684, 280, 141, 142, 279, 217, 266, 270,
+	203, 196, 140, 268, 201, 195, 187, 165, 646, 179,
+	231, 194, 232, 180, 207, 206, 208, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 282, 0, 0, 702, 0, 0, 0, 255, 0,
+	0, 188, 0, 0, 0, 685, 0, 241, 223, 713,
+	0, 228, 239, 192, 267, 233, 272, 257, 281, 0,
+	234, 130, 258, 160, 204, 144, 145, 156, 162, 164,
+	166, 167, 213, 214, 226, 246, 259, 260, 261, 159,
+	152, 240, 153, 177, 154, 131, 248, 155, 132, 227,
+	265, 0, 174, 236, 200, 133, 199, 229, 263, 262,
+	289, 169, 198, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 171, 0, 276, 700, 219, 712, 695, 697,
+	698, 701, 705, 706, 644, 648, 707, 709, 711, 715,
+	244, 0, 0, 0, 0, 0, 182, 225, 0, 245,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 252, 274, 287, 645, 0, 0, 0, 286,
+	0, 0, 0, 0, 0, 690, 209, 210, 211, 212,
+	703, 0, 150, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 170, 176, 0, 178, 149, 224, 173,
+	284, 185, 216, 181, 249, 186, 193, 237, 283, 222,
+	242, 148, 273, 250, 197, 172, 721, 699, 720, 722,
+	723, 719, 724, 725, 708, 663, 0, 717, 716, 718,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 264, 135, 230, 136, 137, 0, 129, 0, 190,
+	0, 235, 168, 91, 615, 616, 617, 618, 619, 620,
+	621, 622, 623, 624, 625, 626, 104, 627, 106, 628,
+	629, 109, 110, 630, 631, 632, 633, 115, 634, 635,
+	636, 637, 120, 123, 124, 125, 640, 641, 642, 638,
+	639, 0, 0, 290, 291, 292, 275, 334, 0, 333,
+	337, 329, 0, 0, 0, 0, 0, 0, 0, 221,
+	0, 325, 0, 0, 0, 0, 0, 0, 0, 163,
+	0, 0, 344, 189, 0, 191, 0, 0, 251, 205,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 347, 0,
+	0, 348, 0, 0, 0, 146, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 334, 0, 333, 337, 329, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 325, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 344, 0, 0,
+	0, 0, 0, 134, 256, 271, 147, 247, 285, 151,
+	254, 143, 220, 243, 139, 269, 253, 202, 183, 184,
+	138, 0, 238, 161, 175, 158, 218, 0, 0, 157,
+	288, 0, 280, 141, 142, 279, 217, 266, 270, 203,
+	196, 140, 268, 201, 195, 187, 165, 278, 179, 231,
+	194, 232, 180, 207, 206, 208, 0, 0, 0, 0,
+	0, 327, 326, 330, 0, 0, 0, 0, 0, 332,
+	282, 0, 0, 0, 0, 0, 0, 255, 0, 0,
+	188, 336, 0, 0, 0, 0, 241, 223, 0, 0,
+	228, 239, 192, 267, 233, 328, 257, 281, 0, 352,
+	130, 258, 160, 204, 144, 145, 156, 162, 164, 166,
+	167, 213, 214, 226, 246, 259, 260, 261, 159, 152,
+	240, 153, 177, 154, 131, 248, 155, 132, 227, 265,
+	0, 174, 236, 200, 133, 199, 229, 263, 262, 289,
+	169, 198, 0, 0, 0, 0, 327, 326, 330, 0,
+	0, 171, 0, 276, 332, 219, 0, 0, 0, 0,
+	0, 0, 0, 215, 293, 0, 336, 0, 0, 244,
+	0, 0, 0, 331, 335, 338, 225, 339, 340, 0,
+	780, 341, 342, 343, 0, 0, 345, 346, 0, 0,
+	0, 252, 274, 287, 277, 0, 0, 0, 286, 0,
+	0, 0, 0, 0, 0, 209, 210, 211, 212, 0,
+	0, 150, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 170, 176, 0, 178, 149, 224, 173, 284,
+	185, 216, 181, 249, 186, 193, 237, 283, 222, 242,
+	148, 273, 250, 197, 172, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 331, 335,
+	781, 0, 339, 782, 0, 0, 341, 342, 343, 0,
+	0, 345, 346, 0, 0, 0, 0, 0, 0, 0,
+	264, 135, 230, 136, 137, 0, 129, 0, 190, 0,
+	235, 168, 91, 92, 93, 94, 95, 96, 97, 98,
+	99, 100, 101, 102, 103, 104, 105, 106, 107, 108,
+	109, 110, 111, 112, 113, 114, 115, 116, 117, 118,
+	119, 120, 123, 124, 125, 126, 127, 128, 121, 122,
+	0, 0, 290, 291, 292, 275, 334, 0, 333, 337,
+	329, 0, 0, 0, 0, 0, 0, 0, 221, 0,
+	325, 0, 0, 0, 0, 0, 0, 0, 163, 0,
+	0, 344, 189, 0, 191, 0, 0, 251, 205, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 347, 0, 0,
+	348, 0, 0, 0, 146, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 134, 256, 271, 147, 247, 285, 151, 254,
+	143, 220, 243, 139, 269, 253, 202, 183, 184, 138,
+	0, 238, 161, 175, 158, 218, 0, 0, 157, 288,
+	0, 280, 141, 142, 279, 217, 266, 270, 203, 196,
+	140, 268, 201, 195, 187, 165, 278, 179, 231, 194,
+	232, 180, 207, 206, 208, 0, 0, 0, 0, 0,
+	327, 326, 330, 0, 0, 0, 0, 0, 332, 282,
+	0, 0, 0, 0, 0, 0, 255, 0, 0, 188,
+	336, 0, 0, 0, 0, 241, 223, 0, 0, 228,
+	239, 192, 267, 233, 328, 257, 281, 0, 234, 130,
+	258, 160, 204, 144, 145, 156, 162, 164, 166, 167,
+	213, 214, 226, 246, 259, 260, 261, 159, 152, 240,
+	153, 177, 154, 131, 248, 155, 132, 227, 265, 0,
+	174, 236, 200, 133, 199, 229, 263, 262, 289, 169,
+	198, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	171, 0, 276, 0, 219, 0, 0, 0, 0, 0,
+	0, 0, 215, 293, 0, 0, 0, 0, 244, 0,
+	0, 0, 331, 335, 338, 225, 339, 340, 0, 0,
+	341, 342, 343, 0, 0, 345, 346, 0, 0, 0,
+	252, 274, 287, 277, 0, 0, 0, 286, 0, 0,
+	0, 0, 0, 0, 209, 210, 211, 212, 0, 0,
+	150, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 170, 176, 0, 178, 149, 224, 173, 284, 185,
+	216, 181, 249, 186, 193, 237, 283, 222, 242, 148,
+	273, 250, 197, 172, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 264,
+	135, 230, 136, 137, 0, 129, 0, 190, 0, 235,
+	168, 91, 92, 93, 94, 95, 96, 97, 98, 99,
+	100, 101, 102, 103, 104, 105, 106, 107, 108, 109,
+	110, 111, 112, 113, 114, 115, 116, 117, 118, 119,
+	120, 123, 124, 125, 126, 127, 128, 121, 122, 0,
+	0, 290, 291, 292, 275, 82, 0, 24, 40, 25,
+	0, 0, 0, 0, 0, 0, 0, 221, 296, 0,
+	0, 0, 0, 0, 0, 0, 0, 163, 0, 0,
+	0, 189, 0, 191, 0, 0, 251, 205, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 301, 0, 0, 88, 0, 0, 0,
+	0, 0, 0, 146, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 134, 256, 271, 147, 247, 285, 151, 254, 143,
+	220, 243, 139, 269, 253, 202, 183, 184, 138, 0,
+	238, 161, 175, 158, 218, 0, 0, 157, 288, 0,
+	280, 141, 142, 279, 217, 266, 270, 203, 196, 140,
+	268, 201, 195, 187, 165, 278, 179, 231, 194, 232,
+	180, 207, 206, 208, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 300, 0, 0, 0, 0, 282, 0,
+	0, 0, 0, 0, 0, 255, 0, 0, 188, 0,
+	0, 0, 0, 0, 241, 223, 0, 0, 228, 239,
+	192, 267, 233, 272, 257, 281, 0, 234, 130, 258,
+	160, 204, 144, 145, 156, 162, 164, 166, 167, 213,
+	214, 226, 246, 259, 260, 261, 159, 152, 240, 153,
+	177, 154, 131, 248, 155, 132, 227, 265, 0, 174,
+	236, 200, 133, 199, 229, 263, 262, 289, 169, 198,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 171,
+	0, 276, 0, 219, 0, 0, 0, 0, 0, 0,
+	0, 215, 293, 0, 0, 0, 0, 244, 0, 0,
+	0, 0, 0, 182, 225, 0, 245, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 252,
+	274, 287, 277, 0, 0, 0, 286, 0, 0, 0,
+	0, 0, 0, 209, 210, 211, 212, 297, 299, 150,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	170, 176, 0, 178, 149, 224, 173, 284, 185, 216,
+	181, 249, 186, 193, 237, 283, 222, 242, 148, 273,
+	250, 197, 172, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 264, 135,
+	230, 136, 137, 0, 129, 0, 190, 81, 235, 168,
+	91, 92, 93, 94, 95, 96, 97, 98, 99, 100,
+	101, 102, 103, 104, 105, 106, 107, 108, 109, 110,
+	111, 112, 113, 114, 115, 116, 117, 118, 119, 120,
+	123, 124, 125, 126, 127, 128, 121, 122, 221, 0,
+	290, 291, 292, 275, 0, 0, 0, 0, 163, 0,
+	0, 0, 189, 0, 191, 0, 0, 251, 205, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 88, 0, 0,
+	0, 0, 0, 0, 146, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1532, 1535,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 134, 256, 271, 147, 247, 285, 151, 254,
+	143, 220, 243, 139, 269, 253, 202, 183, 184, 138,
+	0, 238, 161, 175, 158, 218, 0, 0, 157, 288,
+	0, 280, 141, 142, 279, 217, 266, 270, 203, 196,
+	140, 268, 201, 195, 187, 165, 278, 179, 231, 194,
+	232, 180, 207, 206, 208, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1536, 282,
+	0, 0, 0, 1529, 0, 1528, 255, 1530, 1533, 188,
+	0, 0, 0, 0, 0, 241, 223, 0, 0, 228,
+	239, 192, 267, 233, 272, 257, 281, 0, 234, 130,
+	258, 160, 204, 144, 145, 156, 162, 164, 166, 167,
+	213, 214, 226, 246, 259, 260, 261, 159, 152, 240,
+	153, 177, 154, 131, 248, 155, 132, 227, 265, 1534,
+	174, 236, 200, 133, 199, 229, 263, 262, 289, 169,
+	198, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	171, 0, 276, 0, 219, 0, 0, 0, 0, 0,
+	0, 0, 215, 293, 0, 0, 0, 0, 244, 0,
+	0, 0, 0, 0, 182, 225, 0, 245, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	252, 274, 287, 277, 0, 0, 0, 286, 0, 0,
+	0, 0, 0, 0, 209, 210, 211, 212, 0, 0,
+	150, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 170, 176, 0, 178, 149, 224, 173, 284, 185,
+	216, 181, 249, 186, 193, 237, 283, 222, 242, 148,
+	273, 250, 197, 172, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1662, 264,
+	135, 230, 136, 137, 0, 129, 0, 190, 0, 235,
+	168, 91, 92, 93, 94, 95, 96, 97, 98, 99,
+	100, 101, 102, 103, 104, 105, 106, 107, 108, 109,
+	110, 111, 112, 113, 114, 115, 116, 117, 118, 119,
+	120, 123, 124, 125, 126, 127, 128, 121, 122, 221,
+	0, 290, 291, 292, 275, 0, 0, 0, 0, 163,
+	397, 0, 0, 189, 0, 191, 0, 0, 251, 205,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1649, 0, 88, 409,
+	410, 0, 0, 0, 0, 146, 0, 0, 0, 0,
+	0, 1669, 1673, 1675, 1677, 1679, 1680, 1682, 411, 1591,
+	1588, 1589, 1590, 0, 1664, 1665, 1666, 1667, 1647, 1648,
+	1670, 0, 1650, 0, 1651, 1652, 1653, 1654, 1655, 1656,
+	1657, 1658, 1659, 1661, 1660, 1668, 0, 0, 0, 0,
+	0, 0, 0, 1672, 1674, 1676, 1678, 1681, 0, 0,
+	0, 0, 0, 134, 256, 271, 147, 247, 285, 151,
+	254, 143, 220, 243, 139, 269, 253, 202, 183, 184,
+	138, 1663, 238, 161, 175, 158, 218, 0, 0, 157,
+	288, 413, 280, 141, 412, 279, 217, 266, 270, 203,
+	196, 140, 268, 201, 195, 187, 165, 278, 179, 231,
+	194, 232, 180, 207, 206, 208, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	282, 0, 0, 0, 0, 0, 0, 255, 0, 0,
+	188, 0, 0, 0, 0, 0, 241, 223, 0, 0,
+	228, 239, 192, 267, 233, 272, 257, 281, 396, 234,
+	130, 258, 160, 204, 144, 145, 156, 162, 164, 166,
+	167, 213, 214, 226, 246, 259, 260, 261, 159, 152,
+	240, 153, 177, 154, 131, 248, 155, 132, 227, 265,
+	0, 174, 236, 200, 133, 199, 229, 263, 262, 289,
+	169, 198, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 171, 0, 276, 0, 219, 0, 0, 0, 0,
+	0, 0, 0, 215, 293, 0, 0, 0, 0, 244,
+	0, 0, 0, 0, 0, 182, 225, 0, 245, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 252, 274, 287, 277, 0, 0, 0, 286, 0,
+	0, 0, 0, 0, 399, 209, 210, 211, 212, 0,
+	0, 150, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 170, 176, 1671, 178, 149, 224, 173, 284,
+	185, 406, 402, 403, 186, 193, 237, 283, 222, 242,
+	148, 273, 250, 404, 172, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	264, 135, 230, 136, 137, 0, 129, 0, 190, 0,
+	235, 168, 91, 92, 93, 94, 95, 96, 97, 98,
+	99, 100, 101, 102, 103, 104, 105, 106, 107, 108,
+	109, 110, 111, 112, 113, 114, 115, 116, 117, 118,
+	119, 120, 123, 124, 125, 126, 127, 128, 121, 122,
+	82, 0, 290, 291, 292, 275, 0, 0, 0, 0,
+	0, 0, 221, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 163, 0, 0, 0, 189, 0, 191, 0,
+	0, 251, 205, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 78, 0,
+	973, 88, 0, 0, 0, 0, 0, 0, 146, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 134, 256, 271, 147,
+	247, 285, 151, 254, 143, 220, 243, 139, 269, 253,
+	202, 183, 184, 138, 0, 238, 161, 175, 158, 218,
+	0, 0, 157, 288, 0, 280, 141, 142, 279, 217,
+	266, 270, 203, 196, 140, 268, 201, 195, 187, 165,
+	278, 179, 231, 194, 232, 180, 207, 206, 208, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 282, 0, 0, 0, 0, 0, 0,
+	255, 0, 0, 188, 0, 0, 0, 0, 0, 241,
+	223, 0, 0, 228, 239, 192, 267, 233, 272, 257,
+	281, 0, 234, 130, 258, 160, 204, 144, 145, 156,
+	162, 164, 166, 167, 213, 214, 226, 246, 259, 260,
+	261, 159, 152, 240, 153, 177, 154, 131, 248, 155,
+	132, 227, 265, 0, 174, 236, 200, 133, 199, 229,
+	263, 262, 289, 169, 198, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 171, 0, 276, 0, 219, 0,
+	0, 0, 0, 0, 0, 0, 215, 293, 0, 0,
+	0, 0, 244, 0, 0, 0, 0, 0, 182, 225,
+	0, 245, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 252, 274, 287, 277, 0, 0,
+	0, 286, 0, 0, 0, 0, 0, 0, 209, 210,
+	211, 212, 0, 0, 150, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 170, 176, 0, 178, 149,
+	224, 173, 284, 185, 216, 181, 249, 186, 193, 237,
+	283, 222, 242, 148, 273, 250, 197, 172, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 264, 135, 230, 136, 137, 0, 129,
+	0, 190, 81, 235, 168, 91, 92, 93, 94, 95,
 	96, 97, 98, 99, 100, 101, 102, 103, 104, 105,
 	106, 107, 108, 109, 110, 111, 112, 113, 114, 115,
-	116, 117, 118, 119, 120, 121, 122, 123, 79, 0,
-	277, 278, 279, 263, 0, 0, 0, 0, 0, 0,
-	211, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	155, 0, 0, 0, 180, 0, 182, 0, 0, 240,
-	195, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 75, 0, 917, 85,
-	0, 0, 0, 0, 0, 0, 138, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 129, 245, 259, 139, 236, 272, 143, 243,
-	135, 210, 232, 131, 257, 242, 192, 174, 175, 130,
-	0, 227, 153, 166, 150, 208, 0, 0, 149, 275,
-	0, 267, 133, 134, 266, 207, 254, 258, 193, 187,
-	132, 256, 191, 186, 178, 157, 170, 220, 185, 221,
-	171, 197, 196, 198, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 269, 0,
-	0, 0, 0, 0, 0, 244, 0, 0, 179, 0,
-	0, 0, 0, 0, 230, 213, 0, 0, 218, 228,
-	183, 255, 222, 260, 246, 268, 0, 223, 125, 247,
-	152, 194, 136, 137, 148, 154, 156, 158, 159, 203,
-	204, 216, 235, 248, 249, 250, 151, 144, 229, 145,
-	168, 146, 126, 237, 147, 127, 217, 253, 0, 165,
-	225, 190, 128, 189, 219, 252, 251, 276, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 162, 0, 264,
-	0, 209, 0, 0, 0, 0, 0, 0, 0, 205,
-	280, 0, 0, 0, 0, 233, 0, 0, 0, 0,
-	0, 173, 215, 0, 234, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 241, 262, 274,
-	265, 0, 0, 0, 273, 0, 0, 0, 0, 0,
-	0, 199, 200, 201, 202, 0, 0, 142, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 161, 167,
-	0, 169, 141, 214, 164, 271, 176, 206, 172, 238,
-	177, 184, 226, 270, 212, 231, 140, 261, 239, 188,
-	163, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 124, 0, 181, 78,
-	224, 160, 88, 89, 90, 91, 92, 93, 94, 95,
+	116, 117, 118, 119, 120, 123, 124, 125, 126, 127,
+	128, 121, 122, 0, 221, 290, 291, 292, 275, 885,
+	0, 0, 0, 0, 163, 0, 0, 0, 189, 0,
+	191, 0, 0, 251, 205, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 88, 0, 0, 0, 0, 0, 0,
+	146, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 882, 883, 881, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 134, 256,
+	271, 147, 247, 285, 151, 254, 143, 220, 243, 139,
+	269, 253, 202, 183, 184, 138, 0, 238, 161, 175,
+	158, 218, 0, 0, 157, 288, 0, 280, 141, 142,
+	279, 217, 266, 270, 203, 196, 140, 268, 201, 195,
+	187, 165, 278, 179, 231, 194, 232, 180, 207, 206,
+	208, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 282, 0, 0, 0, 0,
+	0, 0, 255, 0, 0, 188, 0, 0, 0, 0,
+	0, 241, 223, 0, 0, 228, 239, 192, 267, 233,
+	272, 257, 281, 0, 234, 130, 258, 160, 204, 144,
+	145, 156, 162, 164, 166, 167, 213, 214, 226, 246,
+	259, 260, 261, 159, 152, 240, 153, 177, 154, 131,
+	248, 155, 132, 227, 265, 0, 174, 236, 200, 133,
+	199, 229, 263, 262, 289, 169, 198, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 171, 0, 276, 0,
+	219, 0, 0, 0, 0, 0, 0, 0, 215, 293,
+	0, 0, 0, 0, 244, 0, 0, 0, 0, 0,
+	182, 225, 0, 245, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 252, 274, 287, 277,
+	0, 0, 0, 286, 0, 0, 0, 0, 0, 0,
+	209, 210, 211, 212, 0, 0, 150, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 170, 176, 0,
+	178, 149, 224, 173, 284, 185, 216, 181, 249, 186,
+	193, 237, 283, 222, 242, 148, 273, 250, 197, 172,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 264, 135, 230, 136, 137,
+	0, 129, 0, 190, 0, 235, 168, 91, 92, 93,
+	94, 95, 96, 97, 98, 99, 100, 101, 102, 103,
+	104, 105, 106, 107, 108, 109, 110, 111, 112, 113,
+	114, 115, 116, 117, 118, 119, 120, 123, 124, 125,
+	126, 127, 128, 121, 122, 221, 0, 290, 291, 292,
+	275, 0, 0, 0, 0, 163, 0, 0, 0, 189,
+	0, 191, 0, 0, 251, 205, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 88, 409, 410, 0, 0, 0,
+	0, 146, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 411, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 134,
+	256, 271, 147, 247, 285, 151, 254, 143, 220, 243,
+	139, 269, 253, 202, 183, 184, 138, 0, 238, 161,
+	175, 158, 218, 0, 0, 157, 288, 413, 280, 141,
+	412, 279, 217, 266, 270, 203, 196, 140, 268, 201,
+	195, 187, 165, 278, 179, 231, 194, 232, 180, 207,
+	206, 208, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 282, 0, 0, 0,
+	0, 0, 0, 255, 0, 0, 188, 0, 0, 0,
+	0, 0, 241, 223, 0, 0, 228, 239, 192, 267,
+	233, 272, 257, 281, 0, 234, 130, 258, 160, 204,
+	144, 145, 156, 162, 164, 166, 167, 213, 214, 226,
+	246, 259, 260, 261, 159, 152, 240, 153, 177, 154,
+	131, 248, 155, 132, 227, 265, 0, 174, 236, 200,
+	133, 199, 229, 263, 262, 289, 169, 198, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 171, 0, 276,
+	0, 219, 0, 0, 0, 0, 0, 0, 0, 215,
+	293, 0, 0, 0, 0, 244, 0, 0, 0, 0,
+	0, 182, 225, 0, 245, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 252, 274, 287,
+	277, 0, 0, 0, 286, 0, 0, 0, 0, 0,
+	0, 209, 210, 211, 212, 0, 0, 150, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 170, 176,
+	0, 178, 149, 224, 173, 284, 185, 406, 402, 403,
+	186, 193, 237, 283, 222, 242, 148, 273, 250, 404,
+	172, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 264, 135, 230, 136,
+	137, 0, 129, 0, 190, 0, 235, 168, 91, 92,
+	93, 94, 95, 96, 97, 98, 99, 100, 101, 102,
+	103, 104, 105, 106, 107, 108, 109, 110, 111, 112,
+	113, 114, 115, 116, 117, 118, 119, 120, 123, 124,
+	125, 126, 127, 128, 121, 122, 0, 0, 290, 291,
+	292, 275, 221, 0, 561, 0, 0, 0, 0, 0,
+	0, 0, 163, 562, 0, 0, 189, 0, 191, 0,
+	0, 251, 205, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 347, 0, 0, 348, 0, 0, 0, 146, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 134, 256, 271, 147,
+	247, 285, 151, 254, 143, 220, 243, 139, 269, 253,
+	202, 183, 184, 138, 0, 238, 161, 175, 158, 218,
+	0, 0, 157, 288, 0, 280, 141, 142, 279, 217,
+	266, 270, 203, 196, 140, 268, 201, 195, 187, 165,
+	278, 179, 231, 194, 232, 180, 207, 206, 208, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 282, 0, 0, 0, 0, 0, 0,
+	255, 0, 0, 188, 0, 0, 0, 0, 0, 241,
+	223, 0, 0, 228, 239, 192, 267, 233, 272, 257,
+	281, 0, 234, 130, 258, 160, 204, 144, 145, 156,
+	162, 164, 166, 167, 213, 214, 226, 246, 259, 260,
+	261, 159, 152, 240, 153, 177, 154, 131, 248, 155,
+	132, 227, 265, 0, 174, 236, 200, 133, 199, 229,
+	263, 262, 289, 169, 198, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 171, 0, 276, 0, 219, 0,
+	0, 0, 0, 0, 0, 0, 215, 293, 0, 0,
+	0, 0, 244, 0, 0, 0, 0, 0, 182, 225,
+	0, 245, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 252, 274, 287, 277, 0, 0,
+	0, 286, 0, 0, 0, 0, 563, 0, 209, 210,
+	211, 212, 0, 0, 150, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 170, 176, 0, 178, 149,
+	224, 173, 284, 185, 216, 181, 249, 186, 193, 237,
+	283, 222, 242, 148, 273, 250, 197, 172, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 264, 135, 230, 136, 137, 0, 129,
+	0, 190, 0, 235, 168, 91, 92, 93, 94, 95,
 	96, 97, 98, 99, 100, 101, 102, 103, 104, 105,
 	106, 107, 108, 109, 110, 111, 112, 113, 114, 115,
-	116, 117, 118, 119, 120, 121, 122, 123, 0, 211,
-	277, 278, 279, 263, 836, 0, 0, 0, 0, 155,
-	0, 0, 0, 180, 0, 182, 0, 0, 240, 195,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 85, 0,
-	0, 0, 0, 0, 0, 138, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 833,
-	834, 832, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 129, 245, 259, 139, 236, 272, 143, 243, 135,
-	210, 232, 131, 257, 242, 192, 174, 175, 130, 0,
-	227, 153, 166, 150, 208, 0, 0, 149, 275, 0,
-	267, 133, 134, 266, 207, 254, 258, 193, 187, 132,
-	256, 191, 186, 178, 157, 170, 220, 185, 221, 171,
-	197, 196, 198, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 269, 0, 0,
-	0, 0, 0, 0, 244, 0, 0, 179, 0, 0,
-	0, 0, 0, 230, 213, 0, 0, 218, 228, 183,
-	255, 222, 260, 246, 268, 0, 223, 125, 247, 152,
-	194, 136, 137, 148, 154, 156, 158, 159, 203, 204,
-	216, 235, 248, 249, 250, 151, 144, 229, 145, 168,
-	146, 126, 237, 147, 127, 217, 253, 0, 165, 225,
-	190, 128, 189, 219, 252, 251, 276, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 162, 0, 264, 0,
-	209, 0, 0, 0, 0, 0, 0, 0, 205, 280,
-	0, 0, 0, 0, 233, 0, 0, 0, 0, 0,
-	173, 215, 0, 234, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 241, 262, 274, 265,
-	0, 0, 0, 273, 0, 0, 0, 0, 0, 0,
-	199, 200, 201, 202, 0, 0, 142, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 161, 167, 0,
-	169, 141, 214, 164, 271, 176, 206, 172, 238, 177,
-	184, 226, 270, 212, 231, 140, 261, 239, 188, 163,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 124, 0, 181, 0, 224,
-	160, 88, 89, 90, 91, 92, 93, 94, 95, 96,
-	97, 98, 99, 100, 101, 102, 103, 104, 105, 106,
-	107, 108, 109, 110, 111, 112, 113, 114, 115, 116,
-	117, 118, 119, 120, 121, 122, 123, 211, 0, 277,
-	278, 279, 263, 0, 0, 0, 0, 155, 0, 0,
-	0, 180, 0, 182, 0, 0, 240, 195, 0, 0,
+	116, 117, 118, 119, 120, 123, 124, 125, 126, 127,
+	128, 121, 122, 0, 0, 290, 291, 292, 275, 221,
+	0, 839, 0, 0, 0, 0, 0, 0, 0, 163,
+	0, 0, 0, 189, 0, 191, 0, 0, 251, 205,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 85, 391, 392, 0,
-	0, 0, 0, 138, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 393, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 347, 0,
+	0, 348, 0, 0, 0, 146, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 129,
-	245, 259, 139, 236, 272, 143, 243, 135, 210, 232,
-	131, 257, 242, 192, 174, 175, 130, 0, 227, 153,
-	166, 150, 208, 0, 0, 149, 275, 395, 267, 133,
-	394, 266, 207, 254, 258, 193, 187, 132, 256, 191,
-	186, 178, 157, 170, 220, 185, 221, 171, 197, 196,
-	198, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 269, 0, 0, 0, 0,
-	0, 0, 244, 0, 0, 179, 0, 0, 0, 0,
-	0, 230, 213, 0, 0, 218, 228, 183, 255, 222,
-	260, 246, 268, 0, 223, 125, 247, 152, 194, 136,
-	137, 148, 154, 156, 158, 159, 203, 204, 216, 235,
-	248, 249, 250, 151, 144, 229, 145, 168, 146, 126,
-	237, 147, 127, 217, 253, 0, 165, 225, 190, 128,
-	189, 219, 252, 251, 276, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 162, 0, 264, 0, 209, 0,
-	0, 0, 0, 0, 0, 0, 205, 280, 0, 0,
-	0, 0, 233, 0, 0, 0, 0, 0, 173, 215,
-	0, 234, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 241, 262, 274, 265, 0, 0,
-	0, 273, 0, 0, 0, 0, 0, 0, 199, 200,
-	201, 202, 0, 0, 142, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 161, 167, 0, 169, 141,
-	214, 164, 271, 176, 388, 384, 385, 177, 184, 226,
-	270, 212, 231, 140, 261, 239, 386, 163, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 124, 0, 181, 0, 224, 160, 88,
-	89, 90, 91, 92, 93, 94, 95, 96, 97, 98,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 134, 256, 271, 147, 247, 285, 151,
+	254, 143, 220, 243, 139, 269, 253, 202, 183, 184,
+	138, 0, 238, 161, 175, 158, 218, 0, 0, 157,
+	288, 0, 280, 141, 142, 279, 217, 266, 270, 203,
+	196, 140, 268, 201, 195, 187, 165, 278, 179, 231,
+	194, 232, 180, 207, 206, 208, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	282, 0, 0, 0, 0, 0, 0, 255, 0, 0,
+	188, 0, 0, 0, 0, 0, 241, 223, 0, 0,
+	228, 239, 192, 267, 233, 272, 257, 281, 0, 234,
+	130, 258, 160, 204, 144, 145, 156, 162, 164, 166,
+	167, 213, 214, 226, 246, 259, 260, 261, 159, 152,
+	240, 153, 177, 154, 131, 248, 155, 132, 227, 265,
+	0, 174, 236, 200, 133, 199, 229, 263, 262, 289,
+	169, 198, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 171, 0, 276, 0, 219, 0, 0, 0, 0,
+	0, 0, 0, 215, 293, 0, 0, 0, 0, 244,
+	0, 0, 0, 0, 0, 182, 225, 0, 245, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 252, 274, 287, 277, 0, 0, 0, 286, 0,
+	0, 0, 0, 838, 0, 209, 210, 211, 212, 0,
+	0, 150, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 170, 176, 0, 178, 149, 224, 173, 284,
+	185, 216, 181, 249, 186, 193, 237, 283, 222, 242,
+	148, 273, 250, 197, 172, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	264, 135, 230, 136, 137, 0, 129, 0, 190, 0,
+	235, 168, 91, 92, 93, 94, 95, 96, 97, 98,
 	99, 100, 101, 102, 103, 104, 105, 106, 107, 108,
 	109, 110, 111, 112, 113, 114, 115, 116, 117, 118,
-	119, 120, 121, 122, 123, 0, 0, 277, 278, 279,
-	263, 211, 0, 533, 0, 0, 0, 0, 0, 0,
-	0, 155, 534, 0, 0, 180, 0, 182, 0, 0,
-	240, 195, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	332, 0, 0, 333, 0, 0, 0, 138, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 129, 245, 259, 139, 236, 272, 143,
-	243, 135, 210, 232, 131, 257, 242, 192, 174, 175,
-	130, 0, 227, 153, 166, 150, 208, 0, 0, 149,
-	275, 0, 267, 133, 134, 266, 207, 254, 258, 193,
-	187, 132, 256, 191, 186, 178, 157, 170, 220, 185,
-	221, 171, 197, 196, 198, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 269,
-	0, 0, 0, 0, 0, 0, 244, 0, 0, 179,
-	0, 0, 0, 0, 0, 230, 213, 0, 0, 218,
-	228, 183, 255, 222, 260, 246, 268, 0, 223, 125,
-	247, 152, 194, 136, 137, 148, 154, 156, 158, 159,
-	203, 204, 216, 235, 248, 249, 250, 151, 144, 229,
-	145, 168, 146, 126, 237, 147, 127, 217, 253, 0,
-	165, 225, 190, 128, 189, 219, 252, 251, 276, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 162, 0,
-	264, 0, 209, 0, 0, 0, 0, 0, 0, 0,
-	205, 280, 0, 0, 0, 0, 233, 0, 0, 0,
-	0, 0, 173, 215, 0, 234, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 241, 262,
-	274, 265, 0, 0, 0, 273, 0, 0, 0, 0,
-	535, 0, 199, 200, 201, 202, 0, 0, 142, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 161,
-	167, 0, 169, 141, 214, 164, 271, 176, 206, 172,
-	238, 177, 184, 226, 270, 212, 231, 140, 261, 239,
-	188, 163, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 124, 0, 181,
-	0, 224, 160, 88, 89, 90, 91, 92, 93, 94,
-	95, 96, 97, 98, 99, 100, 101, 102, 103, 104,
-	105, 106, 107, 108, 109, 110, 111, 112, 113, 114,
-	115, 116, 117, 118, 119, 120, 121, 122, 123, 0,
-	0, 277, 278, 279, 263, 211, 0, 792, 0, 0,
-	0, 0, 0, 0, 0, 155, 0, 0, 0, 180,
-	0, 182, 0, 0, 240, 195, 0, 0, 0, 0,
+	119, 120, 123, 124, 125, 126, 127, 128, 121, 122,
+	221, 0, 290, 291, 292, 275, 0, 0, 0, 0,
+	163, 0, 0, 0, 189, 0, 191, 0, 0, 251,
+	205, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 2166, 88,
+	694, 0, 0, 0, 0, 0, 146, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 332, 0, 0, 333, 0, 0,
-	0, 138, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 129, 245, 259,
-	139, 236, 272, 143, 243, 135, 210, 232, 131, 257,
-	242, 192, 174, 175, 130, 0, 227, 153, 166, 150,
-	208, 0, 0, 149, 275, 0, 267, 133, 134, 266,
-	207, 254, 258, 193, 187, 132, 256, 191, 186, 178,
-	157, 170, 220, 185, 221, 171, 197, 196, 198, 0,
+	0, 0, 0, 0, 134, 256, 271, 147, 247, 285,
+	151, 254, 143, 220, 243, 139, 269, 253, 202, 183,
+	184, 138, 0, 238, 161, 175, 158, 218, 0, 0,
+	157, 288, 0, 280, 141, 142, 279, 217, 266, 270,
+	203, 196, 140, 268, 201, 195, 187, 165, 278, 179,
+	231, 194, 232, 180, 207, 206, 208, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 269, 0, 0, 0, 0, 0, 0,
-	244, 0, 0, 179, 0, 0, 0, 0, 0, 230,
-	213, 0, 0, 218, 228, 183, 255, 222, 260, 246,
-	268, 0, 223, 125, 247, 152, 194, 136, 137, 148,
-	154, 156, 158, 159, 203, 204, 216, 235, 248, 249,
-	250, 151, 144, 229, 145, 168, 146, 126, 237, 147,
-	127, 217, 253, 0, 165, 225, 190, 128, 189, 219,
-	252, 251, 276, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 162, 0, 264, 0, 209, 0, 0, 0,
-	0, 0, 0, 0, 205, 280, 0, 0, 0, 0,
-	233, 0, 0, 0, 0, 0, 173, 215, 0, 234,
+	0, 282, 0, 0, 0, 0, 0, 0, 255, 0,
+	0, 188, 0, 0, 0, 0, 0, 241, 223, 0,
+	0, 228, 239, 192, 267, 233, 272, 257, 281, 0,
+	234, 130, 258, 160, 204, 144, 145, 156, 162, 164,
+	166, 167, 213, 214, 226, 246, 259, 260, 261, 159,
+	152, 240, 153, 177, 154, 131, 248, 155, 132, 227,
+	265, 0, 174, 236, 200, 133, 199, 229, 263, 262,
+	289, 169, 198, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 171, 0, 276, 0, 219, 0, 0, 0,
+	0, 0, 0, 0, 215, 293, 0, 0, 0, 0,
+	244, 0, 0, 0, 0, 0, 182, 225, 0, 245,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 241, 262, 274, 265, 0, 0, 0, 273,
-	0, 0, 0, 0, 791, 0, 199, 200, 201, 202,
-	0, 0, 142, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 161, 167, 0, 169, 141, 214, 164,
-	271, 176, 206, 172, 238, 177, 184, 226, 270, 212,
-	231, 140, 261, 239, 188, 163, 0, 0, 0, 0,
+	0, 0, 252, 274, 287, 277, 0, 0, 0, 286,
+	0, 0, 0, 0, 0, 0, 209, 210, 211, 212,
+	0, 0, 150, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 170, 176, 0, 178, 149, 224, 173,
+	284, 185, 216, 181, 249, 186, 193, 237, 283, 222,
+	242, 148, 273, 250, 197, 172, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 124, 0, 181, 0, 224, 160, 88, 89, 90,
-	91, 92, 93, 94, 95, 96, 97, 98, 99, 100,
-	101, 102, 103, 104, 105, 106, 107, 108, 109, 110,
-	111, 112, 113, 114, 115, 116, 117, 118, 119, 120,
-	121, 122, 123, 211, 0, 277, 278, 279, 263, 0,
-	0, 0, 0, 155, 0, 0, 0, 180, 0, 182,
-	0, 0, 240, 195, 0, 0, 0, 0, 0, 0,
+	0, 264, 135, 230, 136, 137, 0, 129, 0, 190,
+	0, 235, 168, 91, 92, 93, 94, 95, 96, 97,
+	98, 99, 100, 101, 102, 103, 104, 105, 106, 107,
+	108, 109, 110, 111, 112, 113, 114, 115, 116, 117,
+	118, 119, 120, 123, 124, 125, 126, 127, 128, 121,
+	122, 221, 0, 290, 291, 292, 275, 0, 0, 0,
+	0, 163, 0, 0, 0, 189, 0, 191, 0, 0,
+	251, 205, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 2008, 85, 659, 0, 0, 0, 0, 0, 138,
+	88, 0, 0, 787, 0, 0, 0, 146, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 129, 245, 259, 139, 236,
-	272, 143, 243, 135, 210, 232, 131, 257, 242, 192,
-	174, 175, 130, 0, 227, 153, 166, 150, 208, 0,
-	0, 149, 275, 0, 267, 133, 134, 266, 207, 254,
-	258, 193, 187, 132, 256, 191, 186, 178, 157, 170,
-	220, 185, 221, 171, 197, 196, 198, 0, 0, 0,
+	0, 0, 0, 0, 0, 134, 256, 271, 147, 247,
+	285, 151, 254, 143, 220, 243, 139, 269, 253, 202,
+	183, 184, 138, 0, 238, 161, 175, 158, 218, 0,
+	0, 157, 288, 0, 280, 141, 142, 279, 217, 266,
+	270, 203, 196, 140, 268, 201, 195, 187, 165, 278,
+	179, 231, 194, 232, 180, 207, 206, 208, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 269, 0, 0, 0, 0, 0, 0, 244, 0,
-	0, 179, 0, 0, 0, 0, 0, 230, 213, 0,
-	0, 218, 228, 183, 255, 222, 260, 246, 268, 0,
-	223, 125, 247, 152, 194, 136, 137, 148, 154, 156,
-	158, 159, 203, 204, 216, 235, 248, 249, 250, 151,
-	144, 229, 145, 168, 146, 126, 237, 147, 127, 217,
-	253, 0, 165, 225, 190, 128, 189, 219, 252, 251,
-	276, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	162, 0, 264, 0, 209, 0, 0, 0, 0, 0,
-	0, 0, 205, 280, 0, 0, 0, 0, 233, 0,
-	0, 0, 0, 0, 173, 215, 0, 234, 0, 0,
+	0, 0, 282, 0, 0, 0, 0, 0, 0, 255,
+	0, 0, 188, 0, 0, 0, 0, 0, 241, 223,
+	0, 0, 228, 239, 192, 267, 233, 272, 257, 281,
+	0, 234, 130, 258, 160, 204, 144, 145, 156, 162,
+	164, 166, 167, 213, 214, 226, 246, 259, 260, 261,
+	159, 152, 240, 153, 177, 154, 131, 248, 155, 132,
+	227, 265, 0, 174, 236, 200, 133, 199, 229, 263,
+	262, 289, 169, 198, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 171, 0, 276, 0, 219, 0, 0,
+	0, 0, 0, 0, 0, 215, 293, 0, 0, 0,
+	0, 244, 0, 0, 0, 0, 0, 182, 225, 0,
+	245, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 252, 274, 287, 277, 0, 0, 0,
+	286, 0, 0, 0, 0, 0, 1508, 209, 210, 211,
+	212, 0, 0, 150, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 170, 176, 0, 178, 149, 224,
+	173, 284, 185, 216, 181, 249, 186, 193, 237, 283,
+	222, 242, 148, 273, 250, 197, 172, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	241, 262, 274, 265, 0, 0, 0, 273, 0, 0,
-	0, 0, 0, 0, 199, 200, 201, 202, 0, 0,
-	142, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 161, 167, 0, 169, 141, 214, 164, 271, 176,
-	206, 172, 238, 177, 184, 226, 270, 212, 231, 140,
-	261, 239, 188, 163, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 124,
-	0, 181, 0, 224, 160, 88, 89, 90, 91, 92,
-	93, 94, 95, 96, 97, 98, 99, 100, 101, 102,
-	103, 104, 105, 106, 107, 108, 109, 110, 111, 112,
-	113, 114, 115, 116, 117, 118, 119, 120, 121, 122,
-	123, 211, 0, 277, 278, 279, 263, 0, 0, 0,
-	0, 155, 0, 0, 0, 180, 0, 182, 0, 0,
-	240, 195, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	85, 0, 0, 743, 0, 0, 0, 138, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 129, 245, 259, 139, 236, 272, 143,
-	243, 135, 210, 232, 131, 257, 242, 192, 174, 175,
-	130, 0, 227, 153, 166, 150, 208, 0, 0, 149,
-	275, 0, 267, 133, 134, 266, 207, 254, 258, 193,
-	187, 132, 256, 191, 186, 178, 157, 170, 220, 185,
-	221, 171, 197, 196, 198, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 269,
-	0, 0, 0, 0, 0, 0, 244, 0, 0, 179,
-	0, 0, 0, 0, 0, 230, 213, 0, 0, 218,
-	228, 183, 255, 222, 260, 246, 268, 0, 223, 125,
-	247, 152, 194, 136, 137, 148, 154, 156, 158, 159,
-	203, 204, 216, 235, 248, 249, 250, 151, 144, 229,
-	145, 168, 146, 126, 237, 147, 127, 217, 253, 0,
-	165, 225, 190, 128, 189, 219, 252, 251, 276, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 162, 0,
-	264, 0, 209, 0, 0, 0, 0, 0, 0, 0,
-	205, 280, 0, 0, 0, 0, 233, 0, 0, 0,
-	0, 0, 173, 215, 0, 234, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 241, 262,
-	274, 265, 0, 0, 0, 273, 0, 0, 0, 0,
-	0, 1424, 199, 200, 201, 202, 0, 0, 142, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 161,
-	167, 0, 169, 141, 214, 164, 271, 176, 206, 172,
-	238, 177, 184, 226, 270, 212, 231, 140, 261, 239,
-	188, 163, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 124, 0, 181,
-	0, 224, 160, 88, 89, 90, 91, 92, 93, 94,
-	95, 96, 97, 98, 99, 100, 101, 102, 103, 104,
-	105, 106, 107, 108, 109, 110, 111, 112, 113, 114,
-	115, 116, 117, 118, 119, 120, 121, 122, 123, 211,
-	0, 277, 278, 279, 263, 0, 0, 0, 0, 155,
-	1156, 0, 0, 180, 0, 182, 0, 0, 240, 195,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 85, 0,
-	0, 743, 0, 0, 0, 138, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 129, 245, 259, 139, 236, 272, 143, 243, 135,
-	210, 232, 131, 257, 242, 192, 174, 175, 130, 0,
-	227, 153, 166, 150, 208, 0, 0, 149, 275, 0,
-	267, 133, 134, 266, 207, 254, 258, 193, 187, 132,
-	256, 191, 186, 178, 157, 170, 220, 185, 221, 171,
-	197, 196, 198, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 269, 0, 0,
-	0, 0, 0, 0, 244, 0, 0, 179, 0, 0,
-	0, 0, 0, 230, 213, 0, 0, 218, 228, 183,
-	255, 222, 260, 246, 268, 0, 223, 125, 247, 152,
-	194, 136, 137, 148, 154, 156, 158, 159, 203, 204,
-	216, 235, 248, 249, 250, 151, 144, 229, 145, 168,
-	146, 126, 237, 147, 127, 217, 253, 0, 165, 225,
-	190, 128, 189, 219, 252, 251, 276, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 162, 0, 264, 0,
-	209, 0, 0, 0, 0, 0, 0, 0, 205, 280,
-	0, 0, 0, 0, 233, 0, 0, 0, 0, 0,
-	173, 215, 0, 234, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 241, 262, 274, 265,
-	0, 0, 0, 273, 0, 0, 0, 0, 0, 0,
-	199, 200, 201, 202, 0, 0, 142, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 161, 167, 0,
-	169, 141, 214, 164, 271, 176, 206, 172, 238, 177,
-	184, 226, 270, 212, 231, 140, 261, 239, 188, 163,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 124, 0, 181, 0, 224,
-	160, 88, 89, 90, 91, 92, 93, 94, 95, 96,
+	0, 0, 264, 135, 230, 136, 137, 0, 129, 0,
+	190, 0, 235, 168, 91, 92, 93, 94, 95, 96,
 	97, 98, 99, 100, 101, 102, 103, 104, 105, 106,
 	107, 108, 109, 110, 111, 112, 113, 114, 115, 116,
-	117, 118, 119, 120, 121, 122, 123, 211, 0, 277,
-	278, 279, 263, 0, 0, 0, 0, 155, 0, 0,
-	0, 180, 0, 182, 0, 0, 240, 195, 0, 0,
+	117, 118, 119, 120, 123, 124, 125, 126, 127, 128,
+	121, 122, 221, 0, 290, 291, 292, 275, 0, 0,
+	0, 0, 163, 1222, 0, 0, 189, 0, 191, 0,
+	0, 251, 205, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 85, 659, 0, 0,
-	0, 0, 0, 138, 0, 0, 0, 0, 0, 0,
+	0, 88, 0, 0, 787, 0, 0, 0, 146, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 129,
-	245, 259, 139, 236, 272, 143, 243, 135, 210, 232,
-	131, 257, 242, 192, 174, 175, 130, 0, 227, 153,
-	166, 150, 208, 0, 0, 149, 275, 0, 267, 133,
-	134, 266, 207, 254, 258, 193, 187, 132, 256, 191,
-	186, 178, 157, 170, 220, 185, 221, 171, 197, 196,
-	198, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 269, 0, 0, 0, 0,
-	0, 0, 244, 0, 0, 179, 0, 0, 0, 0,
-	0, 230, 213, 0, 0, 218, 228, 183, 255, 222,
-	260, 246, 268, 0, 223, 125, 247, 152, 194, 136,
-	137, 148, 154, 156, 158, 159, 203, 204, 216, 235,
-	248, 249, 250, 151, 144, 229, 145, 168, 146, 126,
-	237, 147, 127, 217, 253, 0, 165, 225, 190, 128,
-	189, 219, 252, 251, 276, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 162, 0, 264, 0, 209, 0,
-	0, 0, 0, 0, 0, 0, 205, 280, 0, 0,
-	0, 0, 233, 0, 0, 0, 0, 0, 173, 215,
-	0, 234, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 241, 262, 274, 265, 0, 0,
-	0, 273, 0, 0, 0, 0, 0, 0, 199, 200,
-	201, 202, 0, 0, 142, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 161, 167, 0, 169, 141,
-	214, 164, 271, 176, 206, 172, 238, 177, 184, 226,
-	270, 212, 231, 140, 261, 239, 188, 163, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 124, 0, 181, 0, 224, 160, 88,
-	89, 90, 91, 92, 93, 94, 95, 96, 97, 98,
-	99, 100, 101, 102, 103, 104, 105, 106, 107, 108,
-	109, 110, 111, 112, 113, 114, 115, 116, 117, 118,
-	119, 120, 121, 122, 123, 211, 0, 277, 278, 279,
-	263, 0, 0, 0, 0, 155, 0, 0, 0, 180,
-	0, 182, 0, 0, 240, 195, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1743, 0, 0, 85, 0, 0, 0, 0, 0,
-	0, 138, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 134, 256, 271, 147,
+	247, 285, 151, 254, 143, 220, 243, 139, 269, 253,
+	202, 183, 184, 138, 0, 238, 161, 175, 158, 218,
+	0, 0, 157, 288, 0, 280, 141, 142, 279, 217,
+	266, 270, 203, 196, 140, 268, 201, 195, 187, 165,
+	278, 179, 231, 194, 232, 180, 207, 206, 208, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 282, 0, 0, 0, 0, 0, 0,
+	255, 0, 0, 188, 0, 0, 0, 0, 0, 241,
+	223, 0, 0, 228, 239, 192, 267, 233, 272, 257,
+	281, 0, 234, 130, 258, 160, 204, 144, 145, 156,
+	162, 164, 166, 167, 213, 214, 226, 246, 259, 260,
+	261, 159, 152, 240, 153, 177, 154, 131, 248, 155,
+	132, 227, 265, 0, 174, 236, 200, 133, 199, 229,
+	263, 262, 289, 169, 198, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 171, 0, 276, 0, 219, 0,
+	0, 0, 0, 0, 0, 0, 215, 293, 0, 0,
+	0, 0, 244, 0, 0, 0, 0, 0, 182, 225,
+	0, 245, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 252, 274, 287, 277, 0, 0,
+	0, 286, 0, 0, 0, 0, 0, 0, 209, 210,
+	211, 212, 0, 0, 150, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 170, 176, 0, 178, 149,
+	224, 173, 284, 185, 216, 181, 249, 186, 193, 237,
+	283, 222, 242, 148, 273, 250, 197, 172, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 264, 135, 230, 136, 137, 0, 129,
+	0, 190, 0, 235, 168, 91, 92, 93, 94, 95,
+	96, 97, 98, 99, 100, 101, 102, 103, 104, 105,
+	106, 107, 108, 109, 110, 111, 112, 113, 114, 115,
+	116, 117, 118, 119, 120, 123, 124, 125, 126, 127,
+	128, 121, 122, 221, 0, 290, 291, 292, 275, 0,
+	0, 0, 0, 163, 0, 0, 0, 189, 0, 191,
+	0, 0, 251, 205, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 88, 694, 0, 0, 0, 0, 0, 146,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 129, 245, 259,
-	139, 236, 272, 143, 243, 135, 210, 232, 131, 257,
-	242, 192, 174, 175, 130, 0, 227, 153, 166, 150,
-	208, 0, 0, 149, 275, 0, 267, 133, 134, 266,
-	207, 254, 258, 193, 187, 132, 256, 191, 186, 178,
-	157, 170, 220, 185, 221, 171, 197, 196, 198, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 269, 0, 0, 0, 0, 0, 0,
-	244, 0, 0, 179, 0, 0, 0, 0, 0, 230,
-	213, 0, 0, 218, 228, 183, 255, 222, 260, 246,
-	268, 0, 223, 125, 247, 152, 194, 136, 137, 148,
-	154, 156, 158, 159, 203, 204, 216, 235, 248, 249,
-	250, 151, 144, 229, 145, 168, 146, 126, 237, 147,
-	127, 217, 253, 0, 165, 225, 190, 128, 189, 219,
-	252, 251, 276, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 162, 0, 264, 0, 209, 0, 0, 0,
-	0, 0, 0, 0, 205, 280, 0, 0, 0, 0,
-	233, 0, 0, 0, 0, 0, 173, 215, 0, 234,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 241, 262, 274, 265, 0, 0, 0, 273,
-	0, 0, 0, 0, 0, 0, 199, 200, 201, 202,
-	0, 0, 142, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 161, 167, 0, 169, 141, 214, 164,
-	271, 176, 206, 172, 238, 177, 184, 226, 270, 212,
-	231, 140, 261, 239, 188, 163, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 134, 256, 271,
+	147, 247, 285, 151, 254, 143, 220, 243, 139, 269,
+	253, 202, 183, 184, 138, 0, 238, 161, 175, 158,
+	218, 0, 0, 157, 288, 0, 280, 141, 142, 279,
+	217, 266, 270, 203, 196, 140, 268, 201, 195, 187,
+	165, 278, 179, 231, 194, 232, 180, 207, 206, 208,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 282, 0, 0, 0, 0, 0,
+	0, 255, 0, 0, 188, 0, 0, 0, 0, 0,
+	241, 223, 0, 0, 228, 239, 192, 267, 233, 272,
+	257, 281, 0, 234, 130, 258, 160, 204, 144, 145,
+	156, 162, 164, 166, 167, 213, 214, 226, 246, 259,
+	260, 261, 159, 152, 240, 153, 177, 154, 131, 248,
+	155, 132, 227, 265, 0, 174, 236, 200, 133, 199,
+	229, 263, 262, 289, 169, 198, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 171, 0, 276, 0, 219,
+	0, 0, 0, 0, 0, 0, 0, 215, 293, 0,
+	0, 0, 0, 244, 0, 0, 0, 0, 0, 182,
+	225, 0, 245, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 252, 274, 287, 277, 0,
+	0, 0, 286, 0, 0, 0, 0, 0, 0, 209,
+	210, 211, 212, 0, 0, 150, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 170, 176, 0, 178,
+	149, 224, 173, 284, 185, 216, 181, 249, 186, 193,
+	237, 283, 222, 242, 148, 273, 250, 197, 172, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 124, 0, 181, 0, 224, 160, 88, 89, 90,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 264, 135, 230, 136, 137, 0,
+	129, 0, 190, 0, 235, 168, 91, 92, 93, 94,
+	95, 96, 97, 98, 99, 100, 101, 102, 103, 104,
+	105, 106, 107, 108, 109, 110, 111, 112, 113, 114,
+	115, 116, 117, 118, 119, 120, 123, 124, 125, 126,
+	127, 128, 121, 122, 221, 0, 290, 291, 292, 275,
+	0, 0, 0, 0, 163, 0, 0, 0, 189, 0,
+	191, 0, 0, 251, 205, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1840, 0, 0, 88, 0, 0, 0, 0, 0, 0,
+	146, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 134, 256,
+	271, 147, 247, 285, 151, 254, 143, 220, 243, 139,
+	269, 253, 202, 183, 184, 138, 0, 238, 161, 175,
+	158, 218, 0, 0, 157, 288, 0, 280, 141, 142,
+	279, 217, 266, 270, 203, 196, 140, 268, 201, 195,
+	187, 165, 278, 179, 231, 194, 232, 180, 207, 206,
+	208, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 282, 0, 0, 0, 0,
+	0, 0, 255, 0, 0, 188, 0, 0, 0, 0,
+	0, 241, 223, 0, 0, 228, 239, 192, 267, 233,
+	272, 257, 281, 0, 234, 130, 258, 160, 204, 144,
+	145, 156, 162, 164, 166, 167, 213, 214, 226, 246,
+	259, 260, 261, 159, 152, 240, 153, 177, 154, 131,
+	248, 155, 132, 227, 265, 0, 174, 236, 200, 133,
+	199, 229, 263, 262, 289, 169, 198, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 171, 0, 276, 0,
+	219, 0, 0, 0, 0, 0, 0, 0, 215, 293,
+	0, 0, 0, 0, 244, 0, 0, 0, 0, 0,
+	182, 225, 0, 245, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 252, 274, 287, 277,
+	0, 0, 0, 286, 0, 0, 0, 0, 0, 0,
+	209, 210, 211, 212, 0, 0, 150, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 170, 176, 0,
+	178, 149, 224, 173, 284, 185, 216, 181, 249, 186,
+	193, 237, 283, 222, 242, 148, 273, 250, 197, 172,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 264, 135, 230, 136, 137,
+	0, 129, 0, 190, 0, 235, 168, 91, 92, 93,
+	94, 95, 96, 97, 98, 99, 100, 101, 102, 103,
+	104, 105, 106, 107, 108, 109, 110, 111, 112, 113,
+	114, 115, 116, 117, 118, 119, 120, 123, 124, 125,
+	126, 127, 128, 121, 122, 221, 0, 290, 291, 292,
+	275, 0, 0, 0, 0, 163, 0, 0, 0, 189,
+	0, 191, 0, 0, 251, 205, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 88, 0, 0, 787, 0, 0,
+	0, 146, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 134,
+	256, 271, 147, 247, 285, 151, 254, 143, 220, 243,
+	139, 269, 253, 202, 183, 184, 138, 0, 238, 161,
+	175, 158, 218, 0, 0, 157, 288, 0, 280, 141,
+	142, 279, 217, 266, 270, 203, 196, 140, 268, 201,
+	195, 187, 165, 278, 179, 231, 194, 232, 180, 207,
+	206, 208, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 282, 0, 0, 0,
+	0, 0, 0, 255, 0, 0, 188, 0, 0, 0,
+	0, 0, 241, 223, 0, 0, 228, 239, 192, 267,
+	233, 272, 257, 281, 0, 234, 130, 258, 160, 204,
+	144, 145, 156, 162, 164, 166, 167, 213, 214, 226,
+	246, 259, 260, 261, 159, 152, 240, 153, 177, 154,
+	131, 248, 155, 132, 227, 265, 0, 174, 236, 200,
+	133, 199, 229, 263, 262, 289, 169, 198, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 171, 0, 276,
+	0, 219, 0, 0, 0, 0, 0, 0, 0, 215,
+	293, 0, 0, 0, 0, 244, 0, 0, 0, 0,
+	0, 182, 225, 0, 245, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 252, 274, 287,
+	277, 0, 0, 0, 286, 0, 0, 0, 0, 0,
+	0, 209, 210, 211, 212, 0, 0, 150, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 170, 176,
+	0, 178, 149, 224, 173, 284, 185, 216, 181, 249,
+	186, 193, 237, 283, 222, 242, 148, 273, 250, 197,
+	172, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 264, 135, 230, 136,
+	137, 0, 129, 0, 190, 0, 235, 168, 91, 92,
+	93, 94, 95, 96, 97, 98, 99, 100, 101, 102,
+	103, 104, 105, 106, 107, 108, 109, 110, 111, 112,
+	113, 114, 115, 116, 117, 118, 119, 120, 123, 124,
+	125, 126, 127, 128, 121, 122, 221, 0, 290, 291,
+	292, 275, 0, 0, 0, 0, 163, 0, 0, 0,
+	189, 0, 191, 0, 0, 251, 205, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 88, 0, 0, 0, 0,
+	0, 0, 146, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1573, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	134, 256, 271, 147, 247, 285, 151, 254, 143, 220,
+	243, 139, 269, 253, 202, 183, 184, 138, 0, 238,
+	161, 175, 158, 218, 0, 0, 157, 288, 0, 280,
+	141, 142, 279, 217, 266, 270, 203, 196, 140, 268,
+	201, 195, 187, 165, 278, 179, 231, 194, 232, 180,
+	207, 206, 208, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 282, 0, 0,
+	0, 0, 0, 0, 255, 0, 0, 188, 0, 0,
+	0, 0, 0, 241, 223, 0, 0, 228, 239, 192,
+	267, 233, 272, 257, 281, 0, 234, 130, 258, 160,
+	204, 144, 145, 156, 162, 164, 166, 167, 213, 214,
+	226, 246, 259, 260, 261, 159, 152, 240, 153, 177,
+	154, 131, 248, 155, 132, 227, 265, 0, 174, 236,
+	200, 133, 199, 229, 263, 262, 289, 169, 198, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 171, 0,
+	276, 0, 219, 0, 0, 0, 0, 0, 0, 0,
+	215, 293, 0, 0, 0, 0, 244, 0, 0, 0,
+	0, 0, 182, 225, 0, 245, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 252, 274,
+	287, 277, 0, 0, 0, 286, 0, 0, 0, 0,
+	0, 0, 209, 210, 211, 212, 0, 0, 150, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 170,
+	176, 0, 178, 149, 224, 173, 284, 185, 216, 181,
+	249, 186, 193, 237, 283, 222, 242, 148, 273, 250,
+	197, 172, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 264, 135, 230,
+	136, 137, 0, 129, 0, 190, 0, 235, 168, 91,
+	92, 93, 94, 95, 96, 97, 98, 99, 100, 101,
+	102, 103, 104, 105, 106, 107, 108, 109, 110, 111,
+	112, 113, 114, 115, 116, 117, 118, 119, 120, 123,
+	124, 125, 126, 127, 128, 121, 122, 221, 0, 290,
+	291, 292, 275, 0, 0, 0, 0, 163, 0, 0,
+	0, 189, 0, 191, 0, 0, 251, 205, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 315, 0, 0, 88, 0, 0, 0,
+	0, 0, 0, 146, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 134, 256, 271, 147, 247, 285, 151, 254, 143,
+	220, 243, 139, 269, 253, 202, 183, 184, 138, 0,
+	238, 161, 175, 158, 218, 0, 0, 157, 288, 0,
+	280, 141, 142, 279, 217, 266, 270, 203, 196, 140,
+	268, 201, 195, 187, 165, 278, 179, 231, 194, 232,
+	180, 207, 206, 208, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 282, 0,
+	0, 0, 0, 0, 0, 255, 0, 0, 188, 0,
+	0, 0, 0, 0, 241, 223, 0, 0, 228, 239,
+	192, 267, 233, 272, 257, 281, 0, 234, 130, 258,
+	160, 204, 144, 145, 156, 162, 164, 166, 167, 213,
+	214, 226, 246, 259, 260, 261, 159, 152, 240, 153,
+	177, 154, 131, 248, 155, 132, 227, 265, 0, 174,
+	236, 200, 133, 199, 229, 263, 262, 289, 169, 198,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 171,
+	0, 276, 0, 219, 0, 0, 0, 0, 0, 0,
+	0, 215, 293, 0, 0, 0, 0, 244, 0, 0,
+	0, 0, 0, 182, 225, 0, 245, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 252,
+	274, 287, 277, 0, 0, 0, 286, 0, 0, 0,
+	0, 0, 0, 209, 210, 211, 212, 0, 0, 150,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	170, 176, 0, 178, 149, 224, 173, 284, 185, 216,
+	181, 249, 186, 193, 237, 283, 222, 242, 148, 273,
+	250, 197, 172, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 264, 135,
+	230, 136, 137, 0, 129, 0, 190, 0, 235, 168,
 	91, 92, 93, 94, 95, 96, 97, 98, 99, 100,
 	101, 102, 103, 104, 105, 106, 107, 108, 109, 110,
 	111, 112, 113, 114, 115, 116, 117, 118, 119, 120,
-	121, 122, 123, 211, 0, 277, 278, 279, 263, 0,
-	0, 0, 0, 155, 0, 0, 0, 180, 0, 182,
-	0, 0, 240, 195, 0, 0, 0, 0, 0, 0,
+	123, 124, 125, 126, 127, 128, 121, 122, 221, 0,
+	290, 291, 292, 275, 0, 0, 0, 0, 163, 0,
+	0, 0, 189, 0, 191, 0, 0, 251, 205, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 88, 0, 0,
+	0, 0, 0, 0, 146, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1241,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 134, 256, 271, 147, 247, 285, 151, 254,
+	143, 220, 243, 139, 269, 253, 202, 183, 184, 138,
+	0, 238, 161, 175, 158, 218, 0, 0, 157, 288,
+	0, 280, 141, 142, 279, 217, 266, 270, 203, 196,
+	140, 268, 201, 195, 187, 165, 278, 179, 231, 194,
+	232, 180, 207, 206, 208, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 282,
+	0, 0, 0, 0, 0, 0, 255, 0, 0, 188,
+	0, 0, 0, 0, 0, 241, 223, 0, 0, 228,
+	239, 192, 267, 233, 272, 257, 281, 0, 234, 130,
+	258, 160, 204, 144, 145, 156, 162, 164, 166, 167,
+	213, 214, 226, 246, 259, 260, 261, 159, 152, 240,
+	153, 177, 154, 131, 248, 155, 132, 227, 265, 0,
+	174, 236, 200, 133, 199, 229, 263, 262, 289, 169,
+	198, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	171, 0, 276, 0, 219, 0, 0, 0, 0, 0,
+	0, 0, 215, 293, 0, 0, 0, 0, 244, 0,
+	0, 0, 0, 0, 182, 225, 0, 245, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 85, 0, 0, 743, 0, 0, 0, 138,
+	252, 274, 287, 277, 0, 0, 0, 286, 0, 0,
+	0, 0, 0, 0, 209, 210, 211, 212, 0, 0,
+	150, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 170, 176, 0, 178, 149, 224, 173, 284, 185,
+	216, 181, 249, 186, 193, 237, 283, 222, 242, 148,
+	273, 250, 197, 172, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 264,
+	135, 230, 136, 137, 0, 129, 0, 190, 0, 235,
+	168, 91, 92, 93, 94, 95, 96, 97, 98, 99,
+	100, 101, 102, 103, 104, 105, 106, 107, 108, 109,
+	110, 111, 112, 113, 114, 115, 116, 117, 118, 119,
+	120, 123, 124, 125, 126, 127, 128, 121, 122, 221,
+	0, 290, 291, 292, 275, 0, 0, 0, 0, 163,
+	0, 0, 0, 189, 0, 191, 0, 0, 251, 205,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 347, 0,
+	0, 348, 0, 0, 0, 146, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 129, 245, 259, 139, 236,
-	272, 143, 243, 135, 210, 232, 131, 257, 242, 192,
-	174, 175, 130, 0, 227, 153, 166, 150, 208, 0,
-	0, 149, 275, 0, 267, 133, 134, 266, 207, 254,
-	258, 193, 187, 132, 256, 191, 186, 178, 157, 170,
-	220, 185, 221, 171, 197, 196, 198, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 269, 0, 0, 0, 0, 0, 0, 244, 0,
-	0, 179, 0, 0, 0, 0, 0, 230, 213, 0,
-	0, 218, 228, 183, 255, 222, 260, 246, 268, 0,
-	223, 125, 247, 152, 194, 136, 137, 148, 154, 156,
-	158, 159, 203, 204, 216, 235, 248, 249, 250, 151,
-	144, 229, 145, 168, 146, 126, 237, 147, 127, 217,
-	253, 0, 165, 225, 190, 128, 189, 219, 252, 251,
-	276, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	162, 0, 264, 0, 209, 0, 0, 0, 0, 0,
-	0, 0, 205, 280, 0, 0, 0, 0, 233, 0,
-	0, 0, 0, 0, 173, 215, 0, 234, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	241, 262, 274, 265, 0, 0, 0, 273, 0, 0,
-	0, 0, 0, 0, 199, 200, 201, 202, 0, 0,
-	142, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 161, 167, 0, 169, 141, 214, 164, 271, 176,
-	206, 172, 238, 177, 184, 226, 270, 212, 231, 140,
-	261, 239, 188, 163, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 134, 256, 271, 147, 247, 285, 151,
+	254, 143, 220, 243, 139, 269, 253, 202, 183, 184,
+	138, 0, 238, 161, 175, 158, 218, 0, 0, 157,
+	288, 0, 280, 141, 142, 279, 217, 266, 270, 203,
+	196, 140, 268, 201, 195, 187, 165, 278, 179, 231,
+	194, 232, 180, 207, 206, 208, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 124,
-	0, 181, 0, 224, 160, 88, 89, 90, 91, 92,
-	93, 94, 95, 96, 97, 98, 99, 100, 101, 102,
-	103, 104, 105, 106, 107, 108, 109, 110, 111, 112,
-	113, 114, 115, 116, 117, 118, 119, 120, 121, 122,
-	123, 211, 0, 277, 278, 279, 263, 0, 0, 0,
-	0, 155, 0, 0, 0, 180, 0, 182, 0, 0,
-	240, 195, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	85, 0, 0, 0, 0, 0, 0, 138, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1488, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 129, 245, 259, 139, 236, 272, 143,
-	243, 135, 210, 232, 131, 257, 242, 192, 174, 175,
-	130, 0, 227, 153, 166, 150, 208, 0, 0, 149,
-	275, 0, 267, 133, 134, 266, 207, 254, 258, 193,
-	187, 132, 256, 191, 186, 178, 157, 170, 220, 185,
-	221, 171, 197, 196, 198, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 269,
-	0, 0, 0, 0, 0, 0, 244, 0, 0, 179,
-	0, 0, 0, 0, 0, 230, 213, 0, 0, 218,
-	228, 183, 255, 222, 260, 246, 268, 0, 223, 125,
-	247, 152, 194, 136, 137, 148, 154, 156, 158, 159,
-	203, 204, 216, 235, 248, 249, 250, 151, 144, 229,
-	145, 168, 146, 126, 237, 147, 127, 217, 253, 0,
-	165, 225, 190, 128, 189, 219, 252, 251, 276, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 162, 0,
-	264, 0, 209, 0, 0, 0, 0, 0, 0, 0,
-	205, 280, 0, 0, 0, 0, 233, 0, 0, 0,
-	0, 0, 173, 215, 0, 234, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 241, 262,
-	274, 265, 0, 0, 0, 273, 0, 0, 0, 0,
-	0, 0, 199, 200, 201, 202, 0, 0, 142, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 161,
-	167, 0, 169, 141, 214, 164, 271, 176, 206, 172,
-	238, 177, 184, 226, 270, 212, 231, 140, 261, 239,
-	188, 163, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 124, 0, 181,
-	0, 224, 160, 88, 89, 90, 91, 92, 93, 94,
-	95, 96, 97, 98, 99, 100, 101, 102, 103, 104,
-	105, 106, 107, 108, 109, 110, 111, 112, 113, 114,
-	115, 116, 117, 118, 119, 120, 121, 122, 123, 211,
-	0, 277, 278, 279, 263, 0, 0, 0, 0, 155,
-	0, 0, 0, 180, 0, 182, 0, 0, 240, 195,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 301, 0, 0, 85, 0,
-	0, 0, 0, 0, 0, 138, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 129, 245, 259, 139, 236, 272, 143, 243, 135,
-	210, 232, 131, 257, 242, 192, 174, 175, 130, 0,
-	227, 153, 166, 150, 208, 0, 0, 149, 275, 0,
-	267, 133, 134, 266, 207, 254, 258, 193, 187, 132,
-	256, 191, 186, 178, 157, 170, 220, 185, 221, 171,
-	197, 196, 198, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 269, 0, 0,
-	0, 0, 0, 0, 244, 0, 0, 179, 0, 0,
-	0, 0, 0, 230, 213, 0, 0, 218, 228, 183,
-	255, 222, 260, 246, 268, 0, 223, 125, 247, 152,
-	194, 136, 137, 148, 154, 156, 158, 159, 203, 204,
-	216, 235, 248, 249, 250, 151, 144, 229, 145, 168,
-	146, 126, 237, 147, 127, 217, 253, 0, 165, 225,
-	190, 128, 189, 219, 252, 251, 276, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 162, 0, 264, 0,
-	209, 0, 0, 0, 0, 0, 0, 0, 205, 280,
-	0, 0, 0, 0, 233, 0, 0, 0, 0, 0,
-	173, 215, 0, 234, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 241, 262, 274, 265,
-	0, 0, 0, 273, 0, 0, 0, 0, 0, 0,
-	199, 200, 201, 202, 0, 0, 142, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 161, 167, 0,
-	169, 141, 214, 164, 271, 176, 206, 172, 238, 177,
-	184, 226, 270, 212, 231, 140, 261, 239, 188, 163,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 124, 0, 181, 0, 224,
-	160, 88, 89, 90, 91, 92, 93, 94, 95, 96,
-	97, 98, 99, 100, 101, 102, 103, 104, 105, 106,
-	107, 108, 109, 110, 111, 112, 113, 114, 115, 116,
-	117, 118, 119, 120, 121, 122, 123, 211, 0, 277,
-	278, 279, 263, 0, 0, 0, 0, 155, 0, 0,
-	0, 180, 0, 182, 0, 0, 240, 195, 0, 0,
+	282, 0, 0, 0, 0, 0, 0, 255, 0, 0,
+	188, 0, 0, 0, 0, 0, 241, 223, 0, 0,
+	228, 239, 192, 267, 233, 272, 257, 281, 0, 234,
+	130, 258, 160, 204, 144, 145, 156, 162, 164, 166,
+	167, 213, 214, 226, 246, 259, 260, 261, 159, 152,
+	240, 153, 177, 154, 131, 248, 155, 132, 227, 265,
+	0, 174, 236, 200, 133, 199, 229, 263, 262, 289,
+	169, 198, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 171, 0, 276, 0, 219, 0, 0, 0, 0,
+	0, 0, 0, 215, 293, 0, 0, 0, 0, 244,
+	0, 0, 0, 0, 0, 182, 225, 0, 245, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 85, 0, 0, 0,
-	0, 0, 0, 138, 0, 0, 0, 0, 0, 0,
+	0, 252, 274, 287, 277, 0, 0, 0, 286, 0,
+	0, 0, 0, 0, 0, 209, 210, 211, 212, 0,
+	0, 150, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 170, 176, 0, 178, 149, 224, 173, 284,
+	185, 216, 181, 249, 186, 193, 237, 283, 222, 242,
+	148, 273, 250, 197, 172, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1174, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 129,
-	245, 259, 139, 236, 272, 143, 243, 135, 210, 232,
-	131, 257, 242, 192, 174, 175, 130, 0, 227, 153,
-	166, 150, 208, 0, 0, 149, 275, 0, 267, 133,
-	134, 266, 207, 254, 258, 193, 187, 132, 256, 191,
-	186, 178, 157, 170, 220, 185, 221, 171, 197, 196,
-	198, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 269, 0, 0, 0, 0,
-	0, 0, 244, 0, 0, 179, 0, 0, 0, 0,
-	0, 230, 213, 0, 0, 218, 228, 183, 255, 222,
-	260, 246, 268, 0, 223, 125, 247, 152, 194, 136,
-	137, 148, 154, 156, 158, 159, 203, 204, 216, 235,
-	248, 249, 250, 151, 144, 229, 145, 168, 146, 126,
-	237, 147, 127, 217, 253, 0, 165, 225, 190, 128,
-	189, 219, 252, 251, 276, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 162, 0, 264, 0, 209, 0,
-	0, 0, 0, 0, 0, 0, 205, 280, 0, 0,
-	0, 0, 233, 0, 0, 0, 0, 0, 173, 215,
-	0, 234, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 241, 262, 274, 265, 0, 0,
-	0, 273, 0, 0, 0, 0, 0, 0, 199, 200,
-	201, 202, 0, 0, 142, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 161, 167, 0, 169, 141,
-	214, 164, 271, 176, 206, 172, 238, 177, 184, 226,
-	270, 212, 231, 140, 261, 239, 188, 163, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 124, 0, 181, 0, 224, 160, 88,
-	89, 90, 91, 92, 93, 94, 95, 96, 97, 98,
+	264, 135, 230, 136, 137, 0, 129, 0, 190, 0,
+	235, 168, 91, 92, 93, 94, 95, 96, 97, 98,
 	99, 100, 101, 102, 103, 104, 105, 106, 107, 108,
 	109, 110, 111, 112, 113, 114, 115, 116, 117, 118,
-	119, 120, 121, 122, 123, 211, 0, 277, 278, 279,
-	263, 0, 0, 0, 0, 155, 0, 0, 0, 180,
-	0, 182, 0, 0, 240, 195, 0, 0, 0, 0,
+	119, 120, 123, 124, 125, 126, 127, 128, 121, 122,
+	221, 0, 290, 291, 292, 275, 0, 0, 0, 0,
+	163, 0, 0, 0, 189, 0, 191, 0, 0, 251,
+	205, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 88,
+	0, 0, 0, 0, 0, 0, 146, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 332, 0, 0, 333, 0, 0,
-	0, 138, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 129, 245, 259,
-	139, 236, 272, 143, 243, 135, 210, 232, 131, 257,
-	242, 192, 174, 175, 130, 0, 227, 153, 166, 150,
-	208, 0, 0, 149, 275, 0, 267, 133, 134, 266,
-	207, 254, 258, 193, 187, 132, 256, 191, 186, 178,
-	157, 170, 220, 185, 221, 171, 197, 196, 198, 0,
+	0, 0, 0, 0, 134, 256, 271, 147, 247, 285,
+	151, 254, 143, 220, 243, 139, 269, 253, 202, 183,
+	184, 138, 0, 238, 161, 175, 158, 218, 0, 0,
+	157, 288, 0, 280, 141, 142, 279, 217, 266, 270,
+	203, 196, 140, 268, 201, 195, 187, 165, 278, 179,
+	231, 194, 232, 180, 207, 206, 208, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 269, 0, 0, 0, 0, 0, 0,
-	244, 0, 0, 179, 0, 0, 0, 0, 0, 230,
-	213, 0, 0, 218, 228, 183, 255, 222, 260, 246,
-	268, 0, 223, 125, 247, 152, 194, 136, 137, 148,
-	154, 156, 158, 159, 203, 204, 216, 235, 248, 249,
-	250, 151, 144, 229, 145, 168, 146, 126, 237, 147,
-	127, 217, 253, 0, 165, 225, 190, 128, 189, 219,
-	252, 251, 276, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 162, 0, 264, 0, 209, 0, 0, 0,
-	0, 0, 0, 0, 205, 280, 0, 0, 0, 0,
-	233, 0, 0, 0, 0, 0, 173, 215, 0, 234,
+	0, 282, 0, 0, 1182, 0, 0, 0, 255, 0,
+	0, 188, 0, 0, 0, 0, 0, 241, 223, 0,
+	0, 228, 239, 192, 267, 233, 272, 257, 281, 0,
+	234, 130, 258, 160, 204, 144, 145, 156, 162, 164,
+	166, 167, 213, 214, 226, 246, 259, 260, 261, 159,
+	152, 240, 153, 177, 154, 131, 248, 155, 132, 227,
+	265, 0, 174, 236, 200, 133, 199, 229, 263, 262,
+	289, 169, 198, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 171, 0, 276, 0, 219, 0, 0, 0,
+	0, 0, 0, 0, 215, 293, 0, 0, 0, 0,
+	244, 0, 0, 0, 0, 0, 182, 225, 0, 245,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 241, 262, 274, 265, 0, 0, 0, 273,
-	0, 0, 0, 0, 0, 0, 199, 200, 201, 202,
-	0, 0, 142, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 161, 167, 0, 169, 141, 214, 164,
-	271, 176, 206, 172, 238, 177, 184, 226, 270, 212,
-	231, 140, 261, 239, 188, 163, 0, 0, 0, 0,
+	0, 0, 252, 274, 287, 277, 0, 0, 0, 286,
+	0, 0, 0, 0, 0, 0, 209, 210, 211, 212,
+	0, 0, 150, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 170, 176, 0, 178, 149, 224, 173,
+	284, 185, 216, 181, 249, 186, 193, 237, 283, 222,
+	242, 148, 273, 250, 197, 172, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 124, 0, 181, 0, 224, 160, 88, 89, 90,
-	91, 92, 93, 94, 95, 96, 97, 98, 99, 100,
-	101, 102, 103, 104, 105, 106, 107, 108, 109, 110,
-	111, 112, 113, 114, 115, 116, 117, 118, 119, 120,
-	121, 122, 123, 211, 0, 277, 278, 279, 263, 0,
-	0, 0, 0, 155, 0, 0, 0, 180, 0, 182,
-	0, 0, 240, 195, 0, 0, 0, 0, 0, 0,
+	0, 264, 135, 230, 136, 137, 0, 129, 0, 190,
+	0, 235, 168, 91, 92, 93, 94, 95, 96, 97,
+	98, 99, 100, 101, 102, 103, 104, 105, 106, 107,
+	108, 109, 110, 111, 112, 113, 114, 115, 116, 117,
+	118, 119, 120, 123, 124, 125, 126, 127, 128, 121,
+	122, 221, 0, 290, 291, 292, 275, 0, 0, 0,
+	0, 163, 0, 0, 0, 189, 0, 191, 0, 0,
+	251, 205, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 85, 0, 0, 0, 0, 0, 0, 138,
+	88, 0, 0, 787, 0, 0, 0, 146, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 129, 245, 259, 139, 236,
-	272, 143, 243, 135, 210, 232, 131, 257, 242, 192,
-	174, 175, 130, 0, 227, 153, 166, 150, 208, 0,
-	0, 149, 275, 0, 267, 133, 134, 266, 207, 254,
-	258, 193, 187, 132, 256, 191, 186, 178, 157, 170,
-	220, 185, 221, 171, 197, 196, 198, 0, 0, 0,
+	0, 0, 0, 0, 0, 134, 256, 271, 147, 247,
+	285, 151, 254, 143, 220, 243, 139, 269, 253, 202,
+	183, 184, 138, 0, 238, 161, 175, 158, 218, 0,
+	0, 157, 288, 0, 280, 141, 142, 279, 217, 266,
+	270, 203, 196, 140, 268, 201, 195, 187, 165, 278,
+	179, 231, 194, 232, 180, 207, 206, 208, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 269, 0, 0, 1118, 0, 0, 0, 244, 0,
-	0, 179, 0, 0, 0, 0, 0, 230, 213, 0,
-	0, 218, 228, 183, 255, 222, 260, 246, 268, 0,
-	223, 125, 247, 152, 194, 136, 137, 148, 154, 156,
-	158, 159, 203, 204, 216, 235, 248, 249, 250, 151,
-	144, 229, 145, 168, 146, 126, 237, 147, 127, 217,
-	253, 0, 165, 225, 190, 128, 189, 219, 252, 251,
-	276, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	162, 0, 264, 0, 209, 0, 0, 0, 0, 0,
-	0, 0, 205, 280, 0, 0, 0, 0, 233, 0,
-	0, 0, 0, 0, 173, 215, 0, 234, 0, 0,
+	0, 0, 282, 0, 0, 0, 0, 0, 0, 255,
+	0, 0, 188, 0, 0, 0, 0, 0, 241, 223,
+	0, 0, 228, 239, 192, 267, 233, 272, 257, 281,
+	0, 234, 130, 258, 160, 204, 144, 145, 156, 162,
+	164, 166, 167, 213, 214, 226, 246, 259, 260, 261,
+	159, 152, 240, 153, 177, 154, 131, 248, 155, 132,
+	227, 265, 0, 174, 236, 200, 133, 199, 229, 263,
+	262, 289, 169, 198, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 171, 0, 276, 0, 219, 0, 0,
+	0, 0, 0, 0, 0, 215, 293, 0, 0, 0,
+	0, 244, 0, 0, 0, 0, 0, 182, 225, 0,
+	245, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 252, 274, 287, 829, 0, 0, 0,
+	286, 0, 0, 0, 0, 0, 0, 209, 210, 211,
+	212, 0, 0, 150, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 170, 176, 0, 178, 149, 224,
+	173, 284, 185, 216, 181, 249, 186, 193, 237, 283,
+	222, 242, 148, 273, 250, 197, 172, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	241, 262, 274, 265, 0, 0, 0, 273, 0, 0,
-	0, 0, 0, 0, 199, 200, 201, 202, 0, 0,
-	142, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 161, 167, 0, 169, 141, 214, 164, 271, 176,
-	206, 172, 238, 177, 184, 226, 270, 212, 231, 140,
-	261, 239, 188, 163, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 124,
-	0, 181, 0, 224, 160, 88, 89, 90, 91, 92,
-	93, 94, 95, 96, 97, 98, 99, 100, 101, 102,
-	103, 104, 105, 106, 107, 108, 109, 110, 111, 112,
-	113, 114, 115, 116, 117, 118, 119, 120, 121, 122,
-	123, 211, 0, 277, 278, 279, 263, 0, 0, 0,
-	0, 155, 0, 0, 0, 180, 0, 182, 0, 0,
-	240, 195, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	85, 0, 0, 743, 0, 0, 0, 138, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 129, 245, 259, 139, 236, 272, 143,
-	243, 135, 210, 232, 131, 257, 242, 192, 174, 175,
-	130, 0, 227, 153, 166, 150, 208, 0, 0, 149,
-	275, 0, 267, 133, 134, 266, 207, 254, 258, 193,
-	187, 132, 256, 191, 186, 178, 157, 170, 220, 185,
-	221, 171, 197, 196, 198, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 269,
-	0, 0, 0, 0, 0, 0, 244, 0, 0, 179,
-	0, 0, 0, 0, 0, 230, 213, 0, 0, 218,
-	228, 183, 255, 222, 260, 246, 268, 0, 223, 125,
-	247, 152, 194, 136, 137, 148, 154, 156, 158, 159,
-	203, 204, 216, 235, 248, 249, 250, 151, 144, 229,
-	145, 168, 146, 126, 237, 147, 127, 217, 253, 0,
-	165, 225, 190, 128, 189, 219, 252, 251, 276, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 162, 0,
-	264, 0, 209, 0, 0, 0, 0, 0, 0, 0,
-	205, 280, 0, 0, 0, 0, 233, 0, 0, 0,
-	0, 0, 173, 215, 0, 234, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 241, 262,
-	274, 782, 0, 0, 0, 273, 0, 0, 0, 0,
-	0, 0, 199, 200, 201, 202, 0, 0, 142, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 161,
-	167, 0, 169, 141, 214, 164, 271, 176, 206, 172,
-	238, 177, 184, 226, 270, 212, 231, 140, 261, 239,
-	188, 163, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 124, 0, 181,
-	0, 224, 160, 88, 89, 90, 91, 92, 93, 94,
-	95, 96, 97, 98, 99, 100, 101, 102, 103, 104,
-	105, 106, 107, 108, 109, 110, 111, 112, 113, 114,
-	115, 116, 117, 118, 119, 120, 121, 122, 123, 211,
-	0, 277, 278, 279, 263, 0, 0, 0, 0, 155,
-	0, 0, 0, 180, 0, 182, 0, 0, 240, 195,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 85, 0,
-	0, 0, 0, 0, 0, 138, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 129, 245, 259, 139, 236, 272, 143, 243, 135,
-	210, 232, 131, 257, 242, 192, 174, 175, 130, 0,
-	227, 153, 166, 150, 208, 0, 0, 149, 275, 0,
-	267, 133, 134, 266, 207, 254, 258, 193, 187, 132,
-	256, 191, 186, 178, 157, 170, 220, 185, 221, 171,
-	197, 196, 198, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 269, 0, 0,
-	0, 0, 0, 0, 244, 0, 0, 179, 0, 0,
-	0, 0, 0, 230, 213, 0, 0, 218, 228, 183,
-	255, 222, 260, 246, 268, 0, 223, 125, 247, 152,
-	194, 136, 137, 148, 154, 156, 158, 159, 203, 204,
-	216, 235, 248, 249, 250, 151, 144, 229, 145, 168,
-	146, 126, 237, 147, 127, 217, 253, 0, 165, 225,
-	190, 128, 189, 219, 252, 251, 276, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 162, 0, 264, 0,
-	209, 0, 0, 0, 0, 0, 0, 0, 205, 280,
-	0, 0, 0, 0, 233, 0, 0, 0, 0, 0,
-	173, 215, 0, 234, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 241, 262, 274, 265,
-	0, 0, 0, 273, 0, 0, 0, 0, 0, 0,
-	199, 200, 201, 202, 0, 0, 142, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 161, 167, 0,
-	169, 141, 214, 164, 271, 176, 206, 172, 238, 177,
-	184, 226, 270, 212, 231, 140, 261, 239, 188, 163,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 409, 0, 124, 0, 181, 0, 224,
-	160, 88, 89, 90, 91, 92, 93, 94, 95, 96,
+	0, 0, 264, 135, 230, 136, 137, 0, 129, 0,
+	190, 0, 235, 168, 91, 92, 93, 94, 95, 96,
 	97, 98, 99, 100, 101, 102, 103, 104, 105, 106,
 	107, 108, 109, 110, 111, 112, 113, 114, 115, 116,
-	117, 118, 119, 120, 121, 122, 123, 211, 0, 277,
-	278, 279, 263, 0, 0, 0, 82, 155, 0, 0,
-	0, 180, 0, 182, 0, 0, 240, 195, 0, 0,
+	117, 118, 119, 120, 123, 124, 125, 126, 127, 128,
+	121, 122, 221, 0, 290, 291, 292, 275, 0, 0,
+	0, 0, 163, 0, 0, 0, 189, 0, 191, 0,
+	0, 251, 205, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 85, 0, 0, 0,
-	0, 0, 0, 138, 0, 0, 0, 0, 0, 0,
+	0, 88, 0, 0, 0, 0, 0, 0, 146, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 129,
-	245, 259, 139, 236, 272, 143, 243, 135, 210, 232,
-	131, 257, 242, 192, 174, 175, 130, 0, 227, 153,
-	166, 150, 208, 0, 0, 149, 275, 0, 267, 133,
-	134, 266, 207, 254, 258, 193, 187, 132, 256, 191,
-	186, 178, 157, 170, 220, 185, 221, 171, 197, 196,
-	198, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 269, 0, 0, 0, 0,
-	0, 0, 244, 0, 0, 179, 0, 0, 0, 0,
-	0, 230, 213, 0, 0, 218, 228, 183, 255, 222,
-	260, 246, 268, 0, 223, 125, 247, 152, 194, 136,
-	137, 148, 154, 156, 158, 159, 203, 204, 216, 235,
-	248, 249, 250, 151, 144, 229, 145, 168, 146, 126,
-	237, 147, 127, 217, 253, 0, 165, 225, 190, 128,
-	189, 219, 252, 251, 276, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 162, 0, 264, 0, 209, 0,
-	0, 0, 0, 0, 0, 0, 205, 280, 0, 0,
-	0, 0, 233, 0, 0, 0, 0, 0, 173, 215,
-	0, 234, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 241, 262, 274, 265, 0, 0,
-	0, 273, 0, 0, 0, 0, 0, 0, 199, 200,
-	201, 202, 0, 0, 142, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 161, 167, 0, 169, 141,
-	214, 164, 271, 176, 206, 172, 238, 177, 184, 226,
-	270, 212, 231, 140, 261, 239, 188, 163, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 124, 0, 181, 0, 224, 160, 88,
-	89, 90, 91, 92, 93, 94, 95, 96, 97, 98,
-	99, 100, 101, 102, 103, 104, 105, 106, 107, 108,
-	109, 110, 111, 112, 113, 114, 115, 116, 117, 118,
-	119, 120, 121, 122, 123, 211, 0, 277, 278, 279,
-	263, 0, 0, 0, 0, 155, 0, 0, 0, 180,
-	0, 182, 0, 0, 240, 195, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 85, 0, 0, 0, 0, 0,
-	0, 138, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 134, 256, 271, 147,
+	247, 285, 151, 254, 143, 220, 243, 139, 269, 253,
+	202, 183, 184, 138, 0, 238, 161, 175, 158, 218,
+	0, 0, 157, 288, 0, 280, 141, 142, 279, 217,
+	266, 270, 203, 196, 140, 268, 201, 195, 187, 165,
+	278, 179, 231, 194, 232, 180, 207, 206, 208, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 282, 0, 0, 0, 0, 0, 0,
+	255, 0, 0, 188, 0, 0, 0, 0, 0, 241,
+	223, 0, 0, 228, 239, 192, 267, 233, 272, 257,
+	281, 0, 234, 130, 258, 160, 204, 144, 145, 156,
+	162, 164, 166, 167, 213, 214, 226, 246, 259, 260,
+	261, 159, 152, 240, 153, 177, 154, 131, 248, 155,
+	132, 227, 265, 0, 174, 236, 200, 133, 199, 229,
+	263, 262, 289, 169, 198, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 171, 0, 276, 0, 219, 0,
+	0, 0, 0, 0, 0, 0, 215, 293, 0, 0,
+	0, 0, 244, 0, 0, 0, 0, 0, 182, 225,
+	0, 245, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 252, 274, 287, 277, 0, 0,
+	0, 286, 0, 0, 0, 0, 0, 0, 209, 210,
+	211, 212, 0, 0, 150, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 170, 176, 0, 178, 149,
+	224, 173, 284, 185, 216, 181, 249, 186, 193, 237,
+	283, 222, 242, 148, 273, 250, 197, 172, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 129, 245, 259,
-	139, 236, 272, 143, 243, 135, 210, 232, 131, 257,
-	242, 192, 174, 175, 130, 0, 227, 153, 166, 150,
-	208, 0, 0, 149, 275, 0, 267, 133, 134, 266,
-	207, 254, 258, 193, 187, 132, 256, 191, 186, 178,
-	157, 170, 220, 185, 221, 171, 197, 196, 198, 0,
+	0, 429, 0, 264, 135, 230, 136, 137, 0, 129,
+	0, 190, 0, 235, 168, 91, 92, 93, 94, 95,
+	96, 97, 98, 99, 100, 101, 102, 103, 104, 105,
+	106, 107, 108, 109, 110, 111, 112, 113, 114, 115,
+	116, 117, 118, 119, 120, 123, 124, 125, 126, 127,
+	128, 121, 122, 221, 0, 290, 291, 292, 275, 0,
+	0, 0, 85, 163, 0, 0, 0, 189, 0, 191,
+	0, 0, 251, 205, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 269, 0, 0, 0, 0, 0, 0,
-	244, 0, 0, 179, 0, 0, 0, 0, 0, 230,
-	213, 0, 0, 218, 228, 183, 255, 222, 260, 246,
-	268, 0, 223, 125, 247, 152, 194, 136, 137, 148,
-	154, 156, 158, 159, 203, 204, 216, 235, 248, 249,
-	250, 151, 144, 229, 145, 168, 146, 126, 237, 147,
-	127, 217, 253, 0, 165, 225, 190, 128, 189, 219,
-	252, 251, 276, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 162, 0, 264, 0, 209, 0, 0, 0,
-	0, 0, 0, 0, 205, 280, 0, 0, 0, 0,
-	233, 0, 0, 0, 0, 0, 173, 215, 0, 234,
+	0, 0, 88, 0, 0, 0, 0, 0, 0, 146,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 241, 262, 274, 265, 0, 0, 0, 273,
-	0, 0, 0, 0, 0, 0, 199, 200, 201, 202,
-	0, 0, 142, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 161, 167, 0, 169, 141, 214, 164,
-	271, 176, 206, 172, 238, 177, 184, 226, 270, 212,
-	231, 140, 261, 239, 188, 163, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 124, 0, 181, 0, 224, 160, 88, 89, 90,
-	91, 92, 93, 94, 95, 96, 97, 98, 99, 100,
-	101, 102, 103, 104, 105, 106, 107, 108, 109, 110,
-	111, 112, 113, 114, 115, 116, 117, 118, 119, 120,
-	121, 122, 123, 0, 211, 277, 278, 279, 263, 455,
-	0, 0, 0, 0, 155, 0, 0, 0, 180, 0,
-	182, 0, 0, 240, 195, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 460, 461, 462, 457, 0, 0, 0,
-	138, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 129, 245, 259, 139,
-	236, 272, 143, 243, 135, 210, 232, 131, 257, 242,
-	192, 174, 175, 130, 0, 227, 153, 166, 150, 208,
-	0, 0, 149, 275, 0, 267, 133, 134, 266, 207,
-	254, 258, 193, 187, 132, 256, 191, 186, 178, 157,
-	170, 220, 185, 221, 171, 197, 196, 198, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 269, 0, 0, 0, 0, 0, 0, 244,
-	0, 0, 179, 0, 0, 0, 0, 0, 230, 213,
-	0, 0, 218, 228, 183, 255, 222, 260, 246, 268,
-	0, 223, 125, 247, 152, 194, 136, 137, 148, 154,
-	156, 158, 159, 203, 204, 216, 235, 248, 249, 250,
-	151, 144, 229, 145, 168, 146, 126, 237, 147, 127,
-	217, 253, 0, 165, 225, 190, 128, 189, 219, 252,
-	251, 276, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 162, 0, 264, 0, 209, 0, 0, 0, 0,
-	0, 0, 0, 205, 280, 0, 0, 0, 0, 233,
-	0, 0, 0, 0, 0, 173, 215, 0, 234, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 241, 262, 274, 265, 0, 0, 0, 273, 0,
-	0, 0, 0, 0, 0, 199, 200, 201, 202, 0,
-	0, 142, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 161, 167, 0, 169, 141, 214, 164, 271,
-	176, 206, 172, 238, 177, 184, 226, 270, 212, 231,
-	140, 261, 239, 188, 163, 0, 0, 211, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 155, 0, 0,
-	0, 180, 0, 182, 0, 0, 240, 195, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	124, 0, 181, 0, 224, 160, 460, 461, 462, 457,
-	0, 0, 0, 138, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 277, 278, 279, 263, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 129,
-	245, 259, 139, 236, 272, 143, 243, 135, 210, 232,
-	131, 257, 242, 192, 174, 175, 130, 0, 227, 153,
-	166, 150, 208, 0, 0, 149, 275, 0, 267, 133,
-	134, 266, 207, 254, 258, 193, 187, 132, 256, 191,
-	186, 178, 157, 170, 220, 185, 221, 171, 197, 196,
-	198, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 269, 0, 0, 0, 0,
-	0, 0, 244, 0, 0, 179, 0, 0, 0, 0,
-	0, 230, 213, 0, 0, 218, 228, 183, 255, 222,
-	260, 246, 268, 0, 223, 125, 247, 152, 194, 136,
-	137, 148, 154, 156, 158, 159, 203, 204, 216, 235,
-	248, 249, 250, 151, 144, 229, 145, 168, 146, 126,
-	237, 147, 127, 217, 253, 0, 165, 225, 190, 128,
-	189, 219, 252, 251, 276, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 162, 0, 264, 0, 209, 0,
-	0, 0, 0, 0, 0, 0, 205, 280, 0, 0,
-	0, 0, 233, 0, 0, 0, 0, 0, 173, 215,
-	0, 234, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 241, 262, 274, 265, 0, 0,
-	0, 273, 0, 0, 0, 0, 0, 0, 199, 200,
-	201, 202, 0, 0, 142, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 161, 167, 0, 169, 141,
-	214, 164, 271, 176, 206, 172, 238, 177, 184, 226,
-	270, 212, 231, 140, 261, 239, 188, 163, 0, 0,
-	211, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	155, 0, 0, 0, 180, 0, 182, 0, 0, 240,
-	195, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 124, 0, 181, 0, 224, 160, 460,
-	461, 462, 0, 0, 0, 0, 138, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 277, 278, 279,
-	263, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 129, 245, 259, 139, 236, 272, 143, 243,
-	135, 210, 232, 131, 257, 242, 192, 174, 175, 130,
-	0, 227, 153, 166, 150, 208, 0, 0, 149, 275,
-	0, 267, 133, 134, 266, 207, 254, 258, 193, 187,
-	132, 256, 191, 186, 178, 157, 170, 220, 185, 221,
-	171, 197, 196, 198, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 269, 0,
-	0, 0, 0, 0, 0, 244, 0, 0, 179, 0,
-	0, 0, 0, 0, 230, 213, 0, 0, 218, 228,
-	183, 255, 222, 260, 246, 268, 0, 223, 125, 247,
-	152, 194, 136, 137, 148, 154, 156, 158, 159, 203,
-	204, 216, 235, 248, 249, 250, 151, 144, 229, 145,
-	168, 146, 126, 237, 147, 127, 217, 253, 0, 165,
-	225, 190, 128, 189, 219, 252, 251, 276, 0, 0,
-	0, 0, 0, 0, 0, 0, 1693, 162, 0, 264,
-	0, 209, 0, 0, 0, 0, 0, 0, 0, 205,
-	280, 0, 0, 0, 0, 233, 0, 0, 0, 0,
-	1130, 173, 215, 0, 234, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 241, 262, 274,
-	265, 0, 0, 0, 273, 2093, 1693, 0, 0, 0,
-	0, 199, 200, 201, 202, 1675, 0, 142, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 161, 167,
-	1130, 169, 141, 214, 164, 271, 176, 206, 172, 238,
-	177, 184, 226, 270, 212, 231, 140, 261, 239, 188,
-	163, 0, 0, 0, 0, 0, 1762, 0, 0, 0,
-	0, 0, 0, 0, 0, 1675, 0, 0, 0, 1693,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 124, 0, 181, 0,
-	224, 160, 0, 1130, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1675, 0,
-	277, 278, 279, 263, 0, 0, 1679, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1683, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1672, 0, 0,
-	0, 1674, 1676, 1678, 0, 1680, 1681, 1682, 1684, 1685,
-	1686, 1688, 1689, 1690, 1691, 0, 1679, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1683, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1694, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1672, 0, 0,
-	0, 1674, 1676, 1678, 0, 1680, 1681, 1682, 1684, 1685,
-	1686, 1688, 1689, 1690, 1691, 0, 0, 1692, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1679,
-	0, 0, 0, 0, 1671, 0, 0, 1694, 0, 0,
-	1683, 0, 0, 0, 0, 0, 0, 0, 0, 1687,
-	0, 0, 0, 0, 0, 0, 1677, 0, 0, 0,
-	1672, 0, 0, 0, 1674, 1676, 1678, 1692, 1680, 1681,
-	1682, 1684, 1685, 1686, 1688, 1689, 1690, 1691, 0, 0,
-	0, 0, 0, 0, 1671, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1687,
-	1694, 0, 0, 0, 0, 0, 1677, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1692, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1671, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1687, 0, 0, 0, 0, 0, 0, 1677,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 134, 256, 271,
+	147, 247, 285, 151, 254, 143, 220, 243, 139, 269,
+	253, 202, 183, 184, 138, 0, 238, 161, 175, 158,
+	218, 0, 0, 157, 288, 0, 280, 141, 142, 279,
+	217, 266, 270, 203, 196, 140, 268, 201, 195, 187,
+	165, 278, 179, 231, 194, 232, 180, 207, 206, 208,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 282, 0, 0, 0, 0, 0,
+	0, 255, 0, 0, 188, 0, 0, 0, 0, 0,
+	241, 223, 0, 0, 228, 239, 192, 267, 233, 272,
+	257, 281, 0, 234, 130, 258, 160, 204, 144, 145,
+	156, 162, 164, 166, 167, 213, 214, 226, 246, 259,
+	260, 261, 159, 152, 240, 153, 177, 154, 131, 248,
+	155, 132, 227, 265, 0, 174, 236, 200, 133, 199,
+	229, 263, 262, 289, 169, 198, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 171, 0, 276, 0, 219,
+	0, 0, 0, 0, 0, 0, 0, 215, 293, 0,
+	0, 0, 0, 244, 0, 0, 0, 0, 0, 182,
+	225, 0, 245, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 252, 274, 287, 277, 0,
+	0, 0, 286, 0, 0, 0, 0, 0, 0, 209,
+	210, 211, 212, 0, 0, 150, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 170, 176, 0, 178,
+	149, 224, 173, 284, 185, 216, 181, 249, 186, 193,
+	237, 283, 222, 242, 148, 273, 250, 197, 172, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 264, 135, 230, 136, 137, 0,
+	129, 0, 190, 0, 235, 168, 91, 92, 93, 94,
+	95, 96, 97, 98, 99, 100, 101, 102, 103, 104,
+	105, 106, 107, 108, 109, 110, 111, 112, 113, 114,
+	115, 116, 117, 118, 119, 120, 123, 124, 125, 126,
+	127, 128, 121, 122, 221, 0, 290, 291, 292, 275,
+	0, 0, 0, 0, 163, 0, 0, 0, 189, 0,
+	191, 0, 0, 251, 205, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 88, 0, 0, 0, 0, 0, 0,
+	146, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 134, 256,
+	271, 147, 247, 285, 151, 254, 143, 220, 243, 139,
+	269, 253, 202, 183, 184, 138, 0, 238, 161, 175,
+	158, 218, 0, 0, 157, 288, 0, 280, 141, 142,
+	279, 217, 266, 270, 203, 196, 140, 268, 201, 195,
+	187, 165, 278, 179, 231, 194, 232, 180, 207, 206,
+	208, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 282, 0, 0, 0, 0,
+	0, 0, 255, 0, 0, 188, 0, 0, 0, 0,
+	0, 241, 223, 0, 0, 228, 239, 192, 267, 233,
+	272, 257, 281, 0, 234, 130, 258, 160, 204, 144,
+	145, 156, 162, 164, 166, 167, 213, 214, 226, 246,
+	259, 260, 261, 159, 152, 240, 153, 177, 154, 131,
+	248, 155, 132, 227, 265, 0, 174, 236, 200, 133,
+	199, 229, 263, 262, 289, 169, 198, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 171, 0, 276, 0,
+	219, 0, 0, 0, 0, 0, 0, 0, 215, 293,
+	0, 0, 0, 0, 244, 0, 0, 0, 0, 0,
+	182, 225, 0, 245, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 252, 274, 287, 277,
+	0, 0, 0, 286, 0, 0, 0, 0, 0, 0,
+	209, 210, 211, 212, 0, 0, 150, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 170, 176, 0,
+	178, 149, 224, 173, 284, 185, 216, 181, 249, 186,
+	193, 237, 283, 222, 242, 148, 273, 250, 197, 172,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 264, 135, 230, 136, 137,
+	0, 129, 0, 190, 0, 235, 168, 91, 92, 93,
+	94, 95, 96, 97, 98, 99, 100, 101, 102, 103,
+	104, 105, 106, 107, 108, 109, 110, 111, 112, 113,
+	114, 115, 116, 117, 118, 119, 120, 123, 124, 125,
+	126, 127, 128, 121, 122, 0, 221, 290, 291, 292,
+	275, 485, 0, 0, 0, 0, 163, 0, 0, 0,
+	189, 0, 191, 0, 0, 251, 205, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 480, 481, 482, 477, 0,
+	0, 0, 146, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	134, 256, 271, 147, 247, 285, 151, 254, 143, 220,
+	243, 139, 269, 253, 202, 183, 184, 138, 0, 238,
+	161, 175, 158, 218, 0, 0, 157, 288, 0, 280,
+	141, 142, 279, 217, 266, 270, 203, 196, 140, 268,
+	201, 195, 187, 165, 278, 179, 231, 194, 232, 180,
+	207, 206, 208, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 282, 0, 0,
+	0, 0, 0, 0, 255, 0, 0, 188, 0, 0,
+	0, 0, 0, 241, 223, 0, 0, 228, 239, 192,
+	267, 233, 272, 257, 281, 0, 234, 130, 258, 160,
+	204, 144, 145, 156, 162, 164, 166, 167, 213, 214,
+	226, 246, 259, 260, 261, 159, 152, 240, 153, 177,
+	154, 131, 248, 155, 132, 227, 265, 0, 174, 236,
+	200, 133, 199, 229, 263, 262, 289, 169, 198, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 171, 0,
+	276, 0, 219, 0, 0, 0, 0, 0, 0, 0,
+	215, 293, 0, 0, 0, 0, 244, 0, 0, 0,
+	0, 0, 182, 225, 0, 245, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 252, 274,
+	287, 277, 0, 0, 0, 286, 0, 0, 0, 0,
+	0, 0, 209, 210, 211, 212, 0, 0, 150, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 170,
+	176, 0, 178, 149, 224, 173, 284, 185, 216, 181,
+	249, 186, 193, 237, 283, 222, 242, 148, 273, 250,
+	197, 172, 0, 0, 0, 0, 0, 0, 0, 0,
+	221, 0, 0, 0, 0, 475, 0, 0, 0, 0,
+	163, 0, 0, 0, 189, 0, 191, 0, 0, 251,
+	205, 0, 0, 0, 0, 0, 0, 264, 135, 230,
+	136, 137, 0, 129, 0, 190, 0, 235, 168, 480,
+	481, 482, 477, 0, 0, 0, 146, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 290,
+	291, 292, 275, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 134, 256, 271, 147, 247, 285,
+	151, 254, 143, 220, 243, 139, 269, 253, 202, 183,
+	184, 138, 0, 238, 161, 175, 158, 218, 0, 0,
+	157, 288, 0, 280, 141, 142, 279, 217, 266, 270,
+	203, 196, 140, 268, 201, 195, 187, 165, 278, 179,
+	231, 194, 232, 180, 207, 206, 208, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 282, 0, 0, 0, 0, 0, 0, 255, 0,
+	0, 188, 0, 0, 0, 0, 0, 241, 223, 0,
+	0, 228, 239, 192, 267, 233, 272, 257, 281, 0,
+	234, 130, 258, 160, 204, 144, 145, 156, 162, 164,
+	166, 167, 213, 214, 226, 246, 259, 260, 261, 159,
+	152, 240, 153, 177, 154, 131, 248, 155, 132, 227,
+	265, 0, 174, 236, 200, 133, 199, 229, 263, 262,
+	289, 169, 198, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 171, 0, 276, 0, 219, 0, 0, 0,
+	0, 0, 0, 0, 215, 293, 0, 0, 0, 0,
+	244, 0, 0, 0, 0, 0, 182, 225, 0, 245,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 252, 274, 287, 277, 0, 0, 0, 286,
+	0, 0, 0, 0, 0, 0, 209, 210, 211, 212,
+	0, 0, 150, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 170, 176, 0, 178, 149, 224, 173,
+	284, 185, 216, 181, 249, 186, 193, 237, 283, 222,
+	242, 148, 273, 250, 197, 172, 0, 0, 0, 0,
+	0, 0, 0, 0, 221, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 163, 0, 0, 0, 189, 0,
+	191, 0, 0, 251, 205, 0, 0, 0, 0, 0,
+	0, 264, 135, 230, 136, 137, 0, 129, 0, 190,
+	0, 235, 168, 480, 481, 482, 477, 0, 0, 0,
+	146, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 290, 291, 292, 275, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 134, 256,
+	271, 147, 247, 285, 151, 254, 143, 220, 243, 139,
+	269, 253, 202, 183, 184, 138, 0, 238, 161, 175,
+	158, 218, 0, 0, 157, 288, 0, 280, 141, 142,
+	279, 217, 266, 270, 203, 196, 140, 268, 201, 195,
+	187, 165, 278, 179, 231, 194, 232, 180, 207, 206,
+	208, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 282, 0, 0, 0, 0,
+	0, 0, 255, 0, 0, 188, 0, 0, 0, 0,
+	0, 241, 223, 0, 0, 228, 239, 192, 267, 233,
+	272, 257, 281, 0, 234, 130, 258, 160, 204, 144,
+	145, 156, 162, 164, 166, 167, 213, 214, 226, 246,
+	259, 260, 261, 159, 152, 240, 153, 177, 154, 131,
+	248, 155, 132, 227, 265, 0, 174, 236, 200, 133,
+	199, 229, 263, 262, 289, 169, 198, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 171, 0, 276, 0,
+	219, 0, 0, 0, 0, 0, 0, 0, 215, 293,
+	0, 0, 0, 0, 244, 0, 0, 0, 0, 0,
+	182, 225, 0, 245, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 252, 274, 287, 277,
+	0, 0, 0, 286, 0, 0, 0, 0, 0, 0,
+	209, 210, 211, 212, 0, 0, 150, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 170, 176, 0,
+	178, 149, 224, 173, 284, 185, 216, 181, 249, 186,
+	193, 237, 283, 222, 242, 148, 273, 250, 197, 172,
+	0, 0, 0, 0, 0, 0, 0, 0, 221, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 163, 0,
+	0, 0, 189, 0, 191, 0, 0, 251, 205, 0,
+	0, 0, 0, 0, 0, 264, 135, 230, 136, 137,
+	0, 129, 0, 190, 0, 235, 168, 480, 481, 482,
+	0, 0, 0, 0, 146, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 290, 291, 292,
+	275, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 134, 256, 271, 147, 247, 285, 151, 254,
+	143, 220, 243, 139, 269, 253, 202, 183, 184, 138,
+	0, 238, 161, 175, 158, 218, 0, 0, 157, 288,
+	0, 280, 141, 142, 279, 217, 266, 270, 203, 196,
+	140, 268, 201, 195, 187, 165, 278, 179, 231, 194,
+	232, 180, 207, 206, 208, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 282,
+	0, 0, 0, 0, 0, 0, 255, 0, 0, 188,
+	0, 0, 0, 0, 0, 241, 223, 0, 0, 228,
+	239, 192, 267, 233, 272, 257, 281, 0, 234, 130,
+	258, 160, 204, 144, 145, 156, 162, 164, 166, 167,
+	213, 214, 226, 246, 259, 260, 261, 159, 152, 240,
+	153, 177, 154, 131, 248, 155, 132, 227, 265, 1789,
+	174, 236, 200, 133, 199, 229, 263, 262, 289, 169,
+	198, 82, 0, 24, 40, 25, 0, 0, 0, 0,
+	171, 0, 276, 1195, 219, 0, 0, 0, 0, 0,
+	0, 68, 215, 293, 0, 75, 0, 0, 244, 0,
+	0, 0, 0, 0, 182, 225, 0, 245, 2213, 0,
+	0, 0, 0, 0, 41, 0, 0, 0, 1771, 78,
+	252, 274, 287, 277, 0, 0, 0, 286, 0, 0,
+	0, 0, 0, 0, 209, 210, 211, 212, 0, 0,
+	150, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 170, 176, 0, 178, 149, 224, 173, 284, 185,
+	216, 181, 249, 186, 193, 237, 283, 222, 242, 148,
+	273, 250, 197, 172, 0, 1789, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 71, 72, 0,
+	73, 74, 0, 0, 0, 0, 0, 0, 1789, 1195,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 264,
+	135, 230, 136, 137, 0, 129, 0, 190, 0, 235,
+	168, 0, 1195, 0, 0, 1864, 0, 0, 0, 0,
+	0, 0, 0, 0, 1771, 0, 0, 0, 0, 0,
+	0, 0, 1775, 0, 0, 59, 70, 79, 0, 39,
+	0, 0, 0, 1779, 0, 0, 0, 1771, 0, 0,
+	0, 290, 291, 292, 275, 69, 67, 66, 0, 0,
+	0, 0, 0, 1768, 0, 0, 0, 1770, 1772, 1774,
+	0, 1776, 1777, 1778, 1780, 1781, 1782, 1784, 1785, 1786,
+	1787, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1790, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1788, 0, 0, 0, 0,
+	0, 0, 0, 49, 0, 0, 0, 0, 1775, 50,
+	0, 0, 1767, 0, 0, 0, 0, 0, 0, 1779,
+	0, 0, 0, 0, 0, 0, 0, 1783, 0, 0,
+	0, 1775, 0, 0, 1773, 0, 0, 0, 0, 1768,
+	0, 0, 1779, 1770, 1772, 1774, 51, 1776, 1777, 1778,
+	1780, 1781, 1782, 1784, 1785, 1786, 1787, 0, 0, 0,
+	0, 0, 1768, 0, 0, 0, 1770, 1772, 1774, 0,
+	1776, 1777, 1778, 1780, 1781, 1782, 1784, 1785, 1786, 1787,
+	0, 1790, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1790, 0, 0, 0, 0, 0,
+	0, 1788, 0, 0, 0, 0, 0, 0, 52, 0,
+	0, 0, 0, 81, 0, 0, 0, 0, 1767, 0,
+	0, 0, 0, 0, 1788, 0, 0, 0, 0, 0,
+	0, 0, 0, 1783, 0, 0, 0, 0, 0, 0,
+	1773, 1767, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1783, 0, 0, 0,
+	0, 0, 0, 1773,
 }
 
 var yyPact = [...]int{
-	1505, -1000, -296, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	17915, -1000, -298, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 15209, 1577, -1000, 6406, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 197, 12701,
-	15627, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 5970, 5534,
-	117, -1000, 1548, -1000, -1000, -1000, -1000, 139, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 422, -42, 289, 293,
-	326, 326, 7242, 1548, 1289, 154, 6, -1000, 14791, 1485,
-	1505, 151, 15627, -1000, 343, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 15735, 1816, -1000, 6659, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 248,
+	13149, 16166, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 6210,
+	5761, 137, -222, -1000, 1810, -1000, -1000, -1000, -1000, 89,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 667,
+	-26, 338, 342, 374, 374, 7521, 1810, 1508, 199, 21,
+	-1000, 15304, 1745, 17915, 180, 16166, -1000, 397, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -2802,71 +2929,78 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 12701, 15627, -75, 460, -1000, 162, 157, 168, 342,
-	-1000, -1000, -1000, -1000, 15627, 1288, -1000, -1000, -1000, 1489,
-	16046, 154, -1000, 1215, 1249, -1000, -1000, 1391, -1000, 92,
-	-7, -29, 121, -1000, -1000, 136, -1000, -1000, -1000, -1000,
-	-1000, 40, -1000, -15, -1000, -22, -1000, -1000, -1000, -110,
-	-1000, -1000, -1000, -1000, -1000, 1172, 313, 1411, -162, 1468,
-	1491, 1289, 1539, 1506, 0, 172, 172, 191, 172, -1000,
-	-1000, -1000, -1000, -1000, -1000, 495, 135, -1000, -1000, -126,
-	-130, 348, -130, 10, -1000, -1000, -1000, -1000, -1000, -1000,
-	173, -1000, -174, -1000, 276, -1000, 269, -1000, 8933, 132,
-	1237, 458, -1000, 533, 15627, 15627, 15627, 533, 670, 608,
-	341, -1000, -1000, -1000, 1454, 1455, 1491, 1289, -1000, 1548,
-	1548, 1165, 1087, 173, 173, 173, 173, 173, 1235, 15627,
-	-1000, 1294, 4242, -1000, -1000, -1000, -1000, -1000, 163, 1390,
-	-1000, 15627, 1416, -1000, 339, 811, 965, -1000, -1000, 162,
-	1217, -1000, 340, -1000, -1000, -1000, -1000, 15627, 1389, 15627,
-	12701, 12701, 12701, 12701, -1000, 1437, 1434, -1000, 1427, 1426,
-	1433, 15627, -1000, -1000, -1000, 16389, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 1156, 1548, 93, 5617, 11865, 13537, 15627,
-	11865, -1000, -1000, -1000, -1000, -1000, -111, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 93, 11865, 11865,
-	-85, -1000, -1000, -283, 1468, 4670, -1000, -1000, 4670, -1000,
-	-1000, 189, 172, -1000, 11865, 515, 13537, 954, 15627, 15627,
-	-1000, -1000, 348, 348, -1000, 495, 495, -1000, -1000, -125,
-	1553, 5098, -136, 15627, 172, 14373, 1475, -150, 283, 271,
-	274, -1000, -1000, -170, -1000, -1000, 1227, 9357, 8509, 199,
-	11865, 2958, -1000, -1000, 533, 533, 533, 2958, 306, -1000,
-	-1000, -1000, -1000, -1000, -1000, 15627, -1000, -1000, 1468, -1000,
-	-1000, -1000, 1491, 1468, 1491, -1000, -1000, 11865, 13537, 15627,
-	15627, 16732, 15627, 1235, 1487, 15627, 1228, -1000, -1000, 8091,
-	338, 4670, 779, 1388, -1000, 1387, 1386, 1385, 1384, 1380,
-	1379, 1378, 1326, -1000, -1000, 1373, 1370, 1356, -1000, -1000,
-	-1000, -1000, 1354, -1000, -1000, 1350, 1326, 1349, 1345, 1337,
-	-1000, -1000, -1000, -1000, 5476, -1000, -1000, -1000, -1000, 2530,
-	5098, 5098, 5098, 5098, -1000, -1000, 1334, 4670, 1333, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 615, -1000, 1331, 1330, 1328, 1327, 1326, 1325,
-	963, 962, 939, 1323, 1318, 1317, 5098, 1313, -1000, -1000,
+	-1000, -1000, -1000, -1000, 13149, 16166, -70, 581, -1000, 182,
+	194, 160, 394, -1000, -1000, -1000, -1000, 16166, 1495, -1000,
+	-1000, -1000, 1721, 16952, 16598, 199, 511, -1000, 1432, 1469,
+	-1000, -1000, 1622, -1000, 102, 7, -16, 109, -1000, -1000,
+	165, -1000, -1000, -1000, -1000, -1000, 54, -1000, 6, -1000,
+	-5, -1000, -1000, -1000, -106, -1000, -1000, -1000, -1000, -1000,
+	1431, 356, 1643, -155, 239, 1705, 1743, 1508, 1797, 1751,
+	2, 238, 202, 202, 243, 202, 247, -1000, -1000, -1000,
+	-1000, -1000, -1000, 593, 164, -1000, -1000, -115, -111, 461,
+	-111, 26, -1000, -1000, -1000, -1000, -1000, -1000, 203, -1000,
+	-182, -1000, 317, -1000, 314, -1000, 9264, 158, 1460, 618,
+	-1000, 487, 16166, 16166, 16166, 487, 783, 676, 388, -1000,
+	-1000, -1000, 1688, 1695, 1743, 1508, -1000, 1810, 1810, 1416,
+	1159, 203, 203, 203, 203, 203, 237, 203, 1458, 16166,
+	-1000, 1515, 4430, -1000, -1000, -1000, -1000, -1000, 187, 1620,
+	-1000, 16166, 1506, -1000, 383, 895, 1102, -1000, -1000, 182,
+	1421, -1000, 544, -1000, -1000, -1000, -1000, 16166, 1607, 16166,
+	13149, 13149, 13149, 13149, -1000, 1669, 1665, -1000, 1667, 1666,
+	1680, 16166, -1000, -1000, 1605, 17306, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -212, -1000, 17306, 1412, 1810, 4871, 120,
+	5846, 12287, 14011, 16166, 12287, -1000, -1000, -1000, -1000, -1000,
+	-107, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 120, 12287, 12287, -74, -1000, 16166, -1000, -286, 1705,
+	4871, -1000, -1000, 4871, -1000, -1000, 229, 202, -1000, 202,
+	12287, 612, 14011, 1025, 16166, 202, 16166, -1000, -1000, 461,
+	461, -1000, 593, 593, -1000, -1000, -108, 1808, 5312, -123,
+	16166, 16166, 202, 14873, 1712, -138, 331, 318, 322, -1000,
+	-1000, -162, -1000, -1000, 1446, 9701, 8827, 221, 12287, 3107,
+	-1000, -1000, 487, 487, 487, 3107, 353, -1000, -1000, -1000,
+	-1000, -1000, -1000, 16166, -1000, -1000, 1705, -1000, -1000, -1000,
+	1743, 1705, 1743, -1000, -1000, 12287, 14011, 16166, 16166, 16166,
+	203, 17660, 16166, 1458, 1718, 16166, 1447, -1000, -1000, 8396,
+	382, 4871, 881, 1601, -1000, 1599, 1598, 1597, 1596, 1595,
+	1594, 1592, 1591, 1554, -1000, -1000, 1590, 1589, 1585, -1000,
+	-1000, -1000, -1000, 1580, -1000, -1000, 1578, 1554, 1577, 1575,
+	1574, 1571, 1570, -1000, -1000, -1000, -1000, -1000, -1000, 949,
+	-1000, 899, -1000, -1000, 2666, 5312, 5312, 5312, 5312, -1000,
+	-1000, 1569, 4871, 1568, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 806, -1000, 1567,
+	1564, 1563, 1562, 1554, 1552, 1100, 1081, 1080, 1550, 1549,
+	1548, 5312, 1547, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 1642, -284, -1000, 7964,
+	16166, 16166, -1000, 1802, 4871, 2229, -1000, 1726, -1000, 182,
+	78, -1000, -1000, -1000, -1000, -1000, -1000, 379, 16166, 1423,
+	-1000, 573, 1626, 1640, 1626, -1000, -1000, -1000, -1000, 1655,
+	-1000, 1654, -1000, -1000, 1515, 16166, 1545, -1000, -216, -1000,
+	-1000, 1377, 1433, 632, 375, 537, -1000, -1000, -1000, -1000,
+	-1000, 6, -5, 1436, -1000, -30, 101, -1000, -1000, 1419,
+	-1000, -1000, -1000, 537, 1436, 230, 1034, -1000, 1033, -1000,
+	790, 1457, -1000, 943, 14442, 16166, 16166, 228, 1711, 1446,
+	1471, 1693, 16166, 1808, 1808, 1808, 461, 17660, 593, 16166,
+	593, -1000, -1000, 593, -1000, 369, -1000, 16166, 228, 1544,
+	-1000, -1000, -1000, 328, 313, 309, 14011, 226, -1000, -1000,
+	1446, -1000, -1000, -1000, 1543, 569, -1000, -1000, 5312, -1000,
+	632, -1000, 3107, 3107, 3107, -1000, 10994, -1000, -1000, 1705,
+	-1000, 1705, 1436, 1446, 1634, 1444, -1000, 1444, 16166, -1000,
+	-1000, -1000, -1000, 1542, 1411, -1000, 1808, 4430, -1000, 13149,
+	-1000, 4871, 4871, 4871, -1000, 16166, 13580, -1000, 635, 5312,
+	-1000, -1000, -1000, -1000, -1000, -1000, 4871, 1749, 1749, 1749,
+	4871, 608, 4871, 4871, 1368, -1000, 692, 718, 1749, 1749,
+	1749, 1749, -1000, 396, 396, 1749, 1749, 1749, 5312, 5312,
+	5312, 5312, 5312, 5312, 5312, 5312, 5312, 5312, 5312, 5312,
+	1531, 673, 5312, 5312, 5312, 1026, 1021, 1159, 1380, 1453,
+	-1000, -1000, -1000, -1000, -1000, 610, 632, 4871, -1000, 718,
+	4871, 4871, 4871, -1000, 1322, -1000, -1000, 4871, -1000, -1000,
+	-1000, 4871, 5312, 4871, -1000, 1749, -1000, 1698, 1434, -1000,
+	1541, -1000, 1408, 1682, -1000, 365, 1452, -1000, 566, 1401,
+	-1000, 1743, 632, -1000, 362, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -281, -1000, 7672, 15627, 15627, -1000, 1546, 4670, 2106,
-	-1000, 1498, -1000, 162, 66, -1000, -1000, -1000, -1000, -1000,
-	-1000, 331, 15627, 1197, -1000, 448, 1398, 1404, 1398, -1000,
-	-1000, -1000, -1000, 1430, -1000, 1428, -1000, -1000, 1294, -1000,
-	-1000, 455, -1000, -1000, -1000, -1000, -1000, -15, -22, 1221,
-	-1000, -47, 91, -1000, -1000, 1203, -1000, -1000, -1000, 455,
-	1221, 186, 938, 934, -1000, 716, 329, 1234, -1000, 774,
-	13955, 15627, 210, 1474, 1227, 1312, 1459, 1553, 1553, 1553,
-	348, 16732, 495, 15627, 495, -1000, -1000, 495, -1000, 327,
-	15627, 210, 1309, -1000, -1000, -1000, 281, 263, 268, 13537,
-	185, -1000, -1000, 1227, -1000, -1000, -1000, 1308, 432, -1000,
-	-1000, 5098, -1000, 739, -1000, 2958, 2958, 2958, -1000, 10611,
-	-1000, -1000, 1468, -1000, 1468, 1221, 1227, 1403, 1232, -1000,
-	-1000, -1000, -1000, -1000, 1307, 1201, -1000, 1553, 4242, -1000,
-	12701, -1000, 4670, 4670, 4670, -1000, 15627, 13119, -1000, 557,
-	5098, -1000, -1000, -1000, -1000, -1000, -1000, 4670, 1503, 1503,
-	1503, 4670, 446, 4670, 4670, -1000, 642, 2243, 1503, 1503,
-	1503, 1503, -1000, 1503, 1503, 1503, 5098, 5098, 5098, 5098,
-	5098, 5098, 5098, 5098, 5098, 5098, 5098, 5098, 1302, 573,
-	5098, 5098, 5098, 1087, 1067, 1230, -1000, -1000, -1000, -1000,
-	-1000, 470, 739, 4670, -1000, 2243, 4670, 4670, 4670, -1000,
-	1142, -1000, -1000, 4670, -1000, -1000, -1000, 4670, 5098, 4670,
-	-1000, 1503, 1192, -1000, 1306, -1000, 1199, 1449, -1000, 316,
-	1229, -1000, 419, 1194, -1000, 1491, 739, -1000, 315, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -2880,151 +3014,158 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -71, -1000, -1000, 16166, 1395,
+	1802, 16166, 4871, -1000, -1000, 4871, 1540, -1000, 4871, -1000,
+	-1000, -1000, -1000, 1389, 16166, 1539, -1000, -1000, -1000, 4871,
+	16166, 1815, 358, 357, 12287, -1000, 153, 12287, -1000, -1000,
+	16166, 225, 12287, 4, -128, 4871, 4871, 4871, -1000, -1000,
+	-1000, 1515, 597, 1537, 1515, -233, -1000, -48, -1000, 1632,
+	73, -1000, 1693, -1000, 300, -1000, 1532, -1000, -1000, -1000,
+	1808, -1000, 461, -1000, 461, 593, 16166, -1000, -1000, -233,
+	1316, -1000, -1000, -1000, 311, 1446, 12287, 991, 221, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 16166, 16166, 1444, 17915,
+	-1000, 16166, 1806, -1000, 1441, 1535, -1000, 620, 641, -1000,
+	352, -1000, -1000, 684, -1000, 1307, 4871, -1000, -1000, 4871,
+	4871, 722, 4871, 1304, 1385, 1365, -1000, -1000, 1297, -1000,
+	1813, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -77, -1000,
-	-1000, 15627, 1178, 1546, 15627, 4670, -1000, -1000, 4670, 1305,
-	-1000, 4670, -1000, -1000, -1000, -1000, 1557, 312, 308, 11865,
-	-1000, 146, 11865, -1000, -1000, 15627, 184, 11865, -2, -142,
-	4670, 4670, 15627, 4670, -1000, -1000, -1000, 1294, 494, 1304,
-	-218, -1000, -59, -1000, 1402, 63, -1000, 1459, -1000, 288,
-	-1000, -1000, -1000, -1000, 1553, -1000, 348, -1000, 348, 495,
-	15627, -1000, -1000, -218, 1140, -1000, -1000, -1000, 254, 1227,
-	11865, 901, 199, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	15627, 15627, 1505, -1000, 15627, 1549, -1000, 1225, 1353, -1000,
-	565, 522, -1000, 307, -1000, -1000, 593, -1000, 1136, 1182,
-	739, 4670, -1000, -1000, 4670, 4670, 1060, 4670, 1116, 1176,
-	1174, -1000, 1104, -1000, 1555, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	4871, 4871, 4871, 4871, 1440, 1438, 4871, 4871, 4871, 1285,
+	1092, -1000, 705, 705, 363, 363, 363, 363, 363, 1079,
+	1079, -1000, -1000, -1000, 2666, 1531, 5312, 5312, 5312, 186,
+	1040, 1798, -1000, -1000, -1000, 4871, 602, -1000, 4871, 841,
+	-1000, 1291, 1077, 1289, 1287, -1000, 1111, 1266, 1692, 1256,
+	4871, 660, -284, 3989, 173, 16166, -284, 16166, 16166, 3989,
+	-1000, 16166, -1000, 2229, 888, -1000, -1000, 1743, -1000, 632,
+	632, 16166, 632, -1000, 1363, 777, 632, 350, 12287, 415,
+	463, -1000, 10563, 12287, -1000, -1000, 12287, 117, 1704, -1000,
+	-1000, -96, -84, 632, 632, -1000, 1716, 1710, 7090, 1715,
+	-1000, -61, -1000, -1000, -1000, 343, -1000, 1019, 1015, 1014,
+	1009, 16166, -1000, -1000, -1000, -1000, -1000, 563, 563, 563,
+	1688, 7090, -1000, 1808, 1808, 461, -1000, 1, -32, -1000,
+	1436, 1237, -1000, -1000, -1000, -1000, 1225, -1000, 1804, 1795,
+	13149, 12718, -1000, -1000, 1373, 1357, 1328, 171, 1359, -1000,
+	-1000, -1000, -1000, 4871, 1324, 1301, 1274, 1243, 4871, 4871,
+	1227, 1224, 1214, 1332, -1000, 186, 1040, 1679, -1000, 5312,
+	5312, 1197, 583, -1000, 4871, 586, 171, 765, -1000, 4871,
+	-1000, -1000, -1000, 765, -1000, 5312, -1000, 1194, 1845, -1000,
+	1215, 1437, -1000, -284, -1000, -1000, 1434, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 1315, -1000, -219,
+	-1000, -1000, 16166, 1436, -1000, -1000, -1000, -1000, 12287, 1717,
+	228, -1000, 8, 245, -288, -83, 1794, 1793, 199, 16166,
+	1212, 1435, -1000, -1000, -1000, 7458, 287, -1000, 16166, 658,
+	376, 202, 376, 650, 1530, -1000, -1000, 199, -61, -1000,
+	883, 882, 868, 867, -39, -1000, -1000, -1000, -1000, -1000,
+	1520, 765, -1000, 802, 1007, 1208, -1000, -1000, 1808, -1000,
+	1, -1000, 291, 276, 39, 1792, -1000, -1000, -1000, 4871,
+	4871, 1535, -1000, -1000, -1000, -1000, -1000, 1206, -1000, 1492,
+	1514, -1000, 1492, 1492, 1492, -1000, 301, 301, 1516, 1516,
+	1519, 1516, -1000, 1165, -1000, -1000, -1000, -1000, 1175, 930,
+	-1000, -1000, -1000, -1000, -1000, 5312, -1000, -1000, -1000, -1000,
+	632, 4871, 1204, 1193, 697, 1182, 1645, -1000, 16166, -1000,
+	3989, 1434, -1000, 1173, -1000, 12287, 12287, -234, 5, 16166,
+	-291, 1005, -1000, 1791, 1004, 704, -1000, 1515, 18043, 7090,
+	682, -19, -1000, -1000, -1000, 1492, -1000, 1514, 1492, 1492,
+	1492, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 1513, 1512, -1000, 1492, 1510, 1492, 1492, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 4670, 4670, 4670, 4670, 4670, 4670,
-	4670, 932, 1470, -1000, 668, 668, 320, 320, 320, 320,
-	320, 643, 643, -1000, -1000, -1000, 2530, 1302, 5098, 5098,
-	5098, 159, 848, 1788, -1000, 4670, 538, -1000, 4670, 746,
-	-1000, 1094, 613, 1088, 1086, -1000, 976, 1081, 2942, 1079,
-	4670, -281, 3814, 167, 15627, -281, 15627, 15627, 3814, -1000,
-	15627, -1000, 2106, 795, -1000, -1000, 1491, -1000, 739, 739,
-	15627, 739, 11865, 352, 416, -1000, 10193, 11865, -1000, -1000,
-	11865, 104, 1467, -1000, -1000, -104, -91, 739, 739, 299,
-	-1000, 1486, 1469, 6824, -1000, -76, -1000, -1000, -1000, 219,
-	-1000, 933, 924, 921, 920, 15627, -1000, -1000, -1000, -1000,
-	-1000, 407, 407, 407, 1454, -1000, 1553, 1553, 348, -1000,
-	-17, -53, -1000, 1221, 1072, -1000, -1000, -1000, -1000, 1056,
-	-1000, 1550, 1534, 12701, 12283, -1000, -1000, 4670, 1054, 1042,
-	1039, 169, 1164, -1000, -1000, -1000, -1000, 4670, 1001, 941,
-	929, 926, 923, 912, 908, 1162, -1000, 159, 848, 1310,
-	-1000, 5098, 5098, 861, 456, -1000, 4670, 550, 169, 590,
-	-1000, 4670, -1000, -1000, -1000, 590, -1000, 5098, -1000, 855,
-	-1000, 1049, 1224, -1000, -281, -1000, -1000, 1192, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1159, 1221,
-	-1000, -1000, -1000, -1000, 11865, 1481, 210, -1000, -13, 194,
-	-285, -87, 1533, 1531, 15627, 154, 15627, 1043, 1213, -1000,
-	-1000, -1000, 914, 541, -1000, 15627, 580, 303, 172, 303,
-	574, 1299, -1000, -1000, -76, -1000, 781, 780, 778, 767,
-	-50, -1000, -1000, -1000, -1000, -1000, 1297, 590, -1000, 625,
-	910, -1000, -1000, 1553, -1000, -17, -1000, 253, 273, 15,
-	1530, -1000, -1000, -1000, 4670, 4670, 1353, -1000, -1000, 739,
-	-1000, -1000, -1000, 1037, -1000, 1262, 1293, -1000, 1262, 1262,
-	1262, 247, 247, 1295, 1295, 1296, 1295, -1000, 846, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 5098, -1000,
-	-1000, -1000, -1000, 739, 4670, 1035, 1028, 622, 1011, 2802,
-	-1000, -1000, 3814, 1192, -1000, -1000, 11865, 11865, -219, -16,
-	15627, -287, 907, -1000, 1529, 906, 627, -1000, 1294, 17104,
-	6824, 919, -37, -1000, -1000, -1000, 1262, -1000, 1293, 1262,
-	1262, 1262, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 1287, 1285, -1000, 1262, 1284, 1262, 1262, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 16166, 16166, -1000, 16166, 16166,
+	202, 4871, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 11856,
+	-1000, -1000, -1000, -1000, -1000, -1000, 56, -1000, -1000, -1000,
+	-1000, 864, -1000, -1000, -1000, 991, 632, 1433, -1000, -1000,
+	-1000, 861, -1000, 859, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 849, -1000, -1000, 848, -1000, -1000, 4871, 4871,
+	-1000, 632, -1000, -1000, -1000, 4871, -1000, -1000, 1426, -1000,
+	-146, -1000, -1000, -1000, -1000, -67, -295, 842, -1000, 984,
+	-89, -1000, -1000, 1714, 179, 18020, -1000, 563, 563, 553,
+	563, 563, 563, 563, 133, 132, 563, 563, 563, 563,
+	563, 563, 563, 563, 563, 563, 563, 563, 563, 563,
+	1507, -1000, -1000, 682, -1000, -1000, 672, 5312, -1000, -1000,
+	976, 802, 385, 349, 975, 1505, -1000, 105, 649, 646,
+	-1000, 16166, -1000, -22, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 973, 973, -1000, -1000, 840, -1000, -1000, 1504, 1018,
+	67, 1503, -1000, 1502, 1501, 16166, 1160, 1312, -1000, 1492,
+	4871, 970, 31, -1000, -1000, 1158, 1154, 1310, 1303, 1153,
+	1138, 1010, -1000, 1487, 139, 967, -67, 1481, -1000, -1000,
+	1778, 199, -1000, 1764, 18043, -1000, 833, 818, 563, 563,
+	817, 966, 961, 960, 563, 563, 814, 947, 17306, 807,
+	801, 786, 865, 942, 447, 863, 798, 733, 16166, 1479,
+	920, -1000, -1000, 1040, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 782, 1478, -1000, -1000,
+	1477, -1000, -1000, 1295, -1000, 1283, 1152, 11856, 62, 62,
+	11856, 11856, 11856, 1476, 269, -1000, 11856, 1703, 754, -67,
+	-1000, -1000, -1000, -1000, 742, -1000, 731, -1000, -1000, -1000,
+	717, -123, 936, -1000, 139, 16166, 704, -1000, 95, -1000,
+	-1000, -1000, 765, 765, -1000, -1000, -1000, -1000, 935, 924,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 15627, 15627, -1000, 15627, 15627,
-	172, 4670, -1000, -1000, -1000, -1000, -1000, -1000, 11447, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 765,
-	-1000, -1000, -1000, 901, 739, 1182, -1000, -1000, -1000, 763,
-	-1000, 762, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	755, -1000, -1000, 750, -1000, -1000, -1000, 739, -1000, -1000,
-	-1000, 4670, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -136,
-	-289, 749, -1000, 887, -90, -1000, -1000, 1480, 150, 17041,
-	-1000, 407, 407, 483, 407, 407, 407, 407, 113, 106,
-	407, 407, 407, 407, 407, 407, 407, 407, 407, 407,
-	407, 407, 407, 407, 1274, -1000, -1000, 919, -1000, -1000,
-	572, 5098, -1000, -1000, 886, 625, 302, 401, 1271, -1000,
-	72, 563, 555, -1000, 15627, -1000, -40, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 883, 883, -1000, -1000, 743, -1000,
-	-1000, 1270, 1290, 38, 1268, -1000, 1267, 1263, 15627, 836,
-	1151, -1000, 1262, 4670, 11, -1000, -1000, 1009, 996, 1139,
-	1135, 786, -105, -97, -1000, 1261, -1000, -1000, 1527, 154,
-	-1000, 1526, 17104, -1000, 740, 737, 407, 407, 724, 882,
-	877, 876, 407, 407, 723, 871, 16389, 718, 717, 708,
-	830, 863, 424, 782, 741, 679, 15627, 1259, 847, -1000,
-	-1000, 848, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 702, 1258, -1000, -1000, 1257, -1000, -1000,
-	1132, -1000, 1130, 977, 11447, 26, 26, 11447, 11447, 11447,
-	1256, 239, -1000, 11447, 1466, 756, -1000, -1000, -1000, -1000,
-	695, -1000, 677, -1000, 179, -96, -97, -1000, 1523, -92,
-	1520, 1518, 15627, 627, -1000, 79, -1000, -1000, -1000, 590,
-	590, -1000, -1000, -1000, -1000, 862, 859, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 128,
-	15627, 1128, -1000, 409, 970, 4670, -211, 11447, -1000, 850,
-	-1000, -1000, 1126, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	1123, 1121, 1119, 11447, -1000, -1000, -1000, 65, 100, -1000,
-	-1000, 1466, 958, 783, 1254, 667, -87, 1516, -1000, 627,
-	1513, 627, 627, 1115, -1000, -1000, 52, 164, 145, -1000,
-	204, -1000, -1000, -1000, -1000, -1000, -1000, 125, 1113, -1000,
-	847, 831, -1000, 660, 1401, -1000, -39, 1111, -1000, -1000,
-	-1000, -1000, -1000, 1108, -1000, -1000, 407, 673, 34, -1000,
-	-1000, -1000, -1000, -1000, 1453, 9775, -106, -1000, 665, -1000,
-	627, -1000, -1000, -1000, 15627, 47, 657, 5098, 1253, 5098,
-	1252, 56, 1251, -1000, -1000, -1000, -1000, -1000, 239, -1000,
-	-1000, 1400, 1396, 1576, -1000, -1000, -1000, -1000, 100, 100,
-	100, 100, -18, 656, -1000, 954, -1000, 15627, -1000, 1101,
-	-1000, -1000, -1000, 298, -1000, -1000, -1000, -1000, 1248, 1512,
-	-1000, 1893, 15627, 1586, 15627, 1245, 393, 5098, -1000, -1000,
-	1578, -1000, 1579, 304, 304, -1000, -1000, -1000, 1061, -1000,
-	374, -1000, 11029, 15627, -1000, 148, 54, -1000, 1099, -1000,
-	1097, 15627, 653, 1551, -1000, -1000, -1000, 635, 76, -1000,
-	15627, 3386, -1000, 297, 1093, -1000, 851, 43, -1000, -1000,
-	1075, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 739, 15627,
-	-1000, 148, 1447, -1000, 638, -1000, -1000, -1000, 16991, 144,
-	-1000, -1000, 16991, 41, -1000, 142, -1000, -1000, 1069, -1000,
-	839, 1242, -1000, 41, 17104, 4670, -1000, 17104, 1041, -1000,
+	-1000, -1000, 144, 16166, 1265, -1000, 564, 1121, 4871, -227,
+	11856, -1000, 922, -1000, -1000, 1263, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 1259, 1247, 1235, 11856, -1000, -1000, -1000,
+	106, 104, -1000, -1000, 1703, 139, 1116, 1029, 1016, -101,
+	-79, -1000, -1000, 1232, -1000, -1000, 90, 198, 185, -1000,
+	232, -1000, -1000, -1000, -1000, -1000, -1000, 148, 1211, -1000,
+	920, 911, -1000, 715, 1631, -1000, -24, 1203, -1000, -1000,
+	-1000, -1000, -1000, 1188, -1000, -1000, 563, 906, 61, -1000,
+	-1000, -1000, -123, -1000, -1000, -1000, 210, -93, -79, -1000,
+	1763, -90, 1762, 1761, -1000, 16166, 87, 716, 5312, 1474,
+	5312, 1473, 98, 1472, -1000, -1000, -1000, -1000, -1000, 269,
+	-1000, -1000, 1538, 1509, 1812, -1000, -1000, -1000, -1000, 104,
+	104, 104, 104, 3, 714, -1000, 1025, 210, 1468, 708,
+	-83, 1760, -1000, 704, 1757, 704, 704, -1000, 1465, 1747,
+	-1000, 1559, 16166, 1024, 16166, 1463, 552, 5312, -1000, -1000,
+	1826, -1000, 1820, 368, 368, -1000, -1000, -1000, -1000, 1686,
+	10132, -103, -1000, 761, -1000, 704, -1000, -1000, -1000, 175,
+	96, -1000, 1181, -1000, 1170, 16166, 702, 1012, -1000, -1000,
+	-1000, 721, 111, -1000, -1000, 16166, -1000, 1168, -1000, -1000,
+	-1000, 348, -1000, -1000, -1000, 1127, -1000, 946, 75, -1000,
+	-1000, 1124, -1000, -1000, -1000, -1000, -1000, 1176, -1000, 533,
+	-1000, 11425, 16166, -1000, 175, 1681, -1000, 700, -1000, 16166,
+	3548, -1000, 346, -1000, 17904, 172, -1000, -1000, -1000, 632,
+	16166, -1000, 17904, 86, -1000, 169, -1000, -1000, -1000, 1120,
+	-1000, 851, 1462, -1000, 86, 18043, 4871, -1000, 18043, 1114,
+	-1000,
 }
 
 var yyPgo = [...]int{
-	0, 106, 1938, 1937, 104, 102, 1936, 1935, 1934, 1932,
-	1931, 1930, 1929, 1928, 1927, 1926, 1925, 1924, 1922, 1921,
-	1920, 1919, 1918, 1916, 1915, 1914, 1913, 1911, 1910, 1909,
-	1908, 1907, 1906, 100, 1905, 1903, 1887, 1886, 1885, 1882,
-	137, 1880, 1879, 1878, 1877, 1876, 1875, 1874, 1873, 1872,
-	117, 45, 99, 697, 53, 180, 1871, 116, 1870, 82,
-	145, 1867, 1865, 28, 111, 1864, 118, 115, 89, 139,
-	90, 84, 62, 1863, 1862, 1861, 132, 1860, 1859, 1857,
-	1856, 55, 1855, 74, 42, 31, 1854, 76, 1851, 1850,
-	1846, 1845, 1842, 77, 1841, 67, 60, 1840, 1839, 1838,
-	1837, 1836, 33, 1835, 48, 1834, 1830, 1829, 1828, 1825,
-	1823, 1822, 16, 18, 21, 1821, 1817, 17, 2, 1812,
-	1811, 93, 1810, 1809, 1808, 161, 1807, 1806, 1804, 144,
-	1803, 112, 1802, 1801, 1799, 1798, 9, 1796, 38, 1795,
-	1793, 1792, 43, 1791, 1790, 1789, 91, 37, 59, 86,
-	1786, 1785, 1784, 133, 20, 108, 0, 131, 39, 1783,
-	130, 123, 1782, 87, 230, 124, 44, 1781, 58, 68,
-	1780, 1779, 1778, 65, 11, 1777, 88, 1776, 15, 78,
-	1774, 97, 1773, 119, 1, 94, 1772, 136, 1771, 1770,
-	110, 1769, 1768, 47, 107, 1767, 1766, 1763, 29, 1762,
-	34, 30, 1761, 148, 147, 1760, 1755, 1738, 113, 85,
-	75, 1733, 1730, 69, 1729, 109, 70, 120, 1728, 674,
-	1727, 98, 63, 19, 1725, 140, 1724, 219, 143, 121,
-	1723, 1722, 141, 1466, 138, 1721, 127, 10, 1720, 1719,
-	12, 1718, 25, 1716, 1714, 1712, 1711, 6, 1710, 1709,
-	1708, 3, 5, 1707, 4, 96, 1706, 57, 56, 52,
-	1705, 64, 1701, 1700, 1699, 1681, 1680, 177, 1679, 1678,
-	1677, 1676, 1675, 1674, 1673, 81, 1671, 1670, 1669, 1666,
-	61, 1665, 1664, 1649, 1647, 1644, 32, 1643, 1641, 22,
-	1640, 26, 1639, 1638, 1637, 13, 1636, 1631, 14, 1630,
-	1627, 7, 8, 1624, 1623, 51, 36, 35, 72, 71,
-	1622, 23, 1621, 92, 1620, 1616, 114, 1613, 95, 1608,
-	1605, 142, 156, 1604, 135, 1603, 1601, 1598, 1595, 1593,
-	1592, 1591, 122, 1590,
-}
-
-//line mysql_sql.y:6320
+	0, 101, 2138, 2135, 114, 112, 2133, 2132, 2124, 2122,
+	2120, 2119, 2118, 2116, 2114, 2112, 2111, 2109, 2108, 2107,
+	2106, 2105, 2104, 2103, 2102, 2101, 2100, 2098, 2096, 2095,
+	2094, 2092, 2091, 2090, 110, 2088, 2087, 2086, 2085, 2084,
+	2083, 2082, 136, 2081, 2075, 2074, 2073, 2072, 2071, 2069,
+	2068, 2067, 132, 62, 108, 751, 71, 176, 2066, 126,
+	2065, 90, 178, 2064, 2063, 2062, 33, 119, 2061, 122,
+	120, 91, 145, 102, 97, 66, 2060, 2057, 2056, 140,
+	2055, 2054, 94, 2053, 57, 2052, 80, 45, 34, 2051,
+	85, 2050, 2049, 2046, 2045, 2044, 88, 2043, 69, 59,
+	2042, 2041, 2040, 2038, 2037, 36, 2036, 49, 2035, 2034,
+	2033, 2032, 2031, 2030, 2028, 16, 19, 22, 2027, 2026,
+	17, 2, 2025, 2022, 83, 2021, 2020, 2019, 2017, 2016,
+	2015, 2014, 170, 2013, 2012, 2011, 154, 2010, 129, 2009,
+	2008, 2006, 2005, 14, 2004, 47, 2003, 2002, 2001, 44,
+	2000, 1999, 1998, 98, 46, 60, 92, 1997, 1996, 278,
+	143, 21, 54, 0, 139, 40, 1995, 138, 127, 152,
+	95, 240, 135, 48, 1994, 58, 77, 1993, 1992, 1988,
+	73, 18, 1987, 84, 1986, 35, 89, 1985, 109, 1983,
+	125, 1, 100, 1982, 142, 1981, 1980, 118, 1979, 1978,
+	55, 117, 1977, 1975, 1974, 32, 1973, 38, 27, 1972,
+	130, 156, 1971, 1970, 1969, 124, 96, 81, 1968, 1966,
+	75, 1964, 107, 74, 123, 65, 1963, 755, 1962, 116,
+	64, 20, 1958, 148, 1957, 211, 147, 134, 1956, 1952,
+	162, 1711, 153, 1951, 133, 11, 1949, 1948, 12, 1947,
+	30, 37, 31, 1945, 1944, 1943, 1942, 8, 1941, 1940,
+	1939, 3, 5, 1938, 4, 106, 1937, 52, 61, 56,
+	1934, 68, 1932, 1931, 1928, 1926, 1920, 151, 1917, 1916,
+	1915, 1914, 1913, 1899, 1898, 87, 1897, 1896, 1895, 1893,
+	67, 1891, 1890, 1888, 1887, 26, 24, 1886, 1885, 13,
+	1884, 15, 1883, 1882, 23, 9, 1881, 1878, 10, 1877,
+	1875, 6, 7, 1874, 1873, 53, 43, 39, 78, 79,
+	1872, 29, 1871, 93, 1869, 1868, 121, 128, 103, 1866,
+	1865, 144, 171, 1864, 141, 1863, 1862, 1861, 1860, 1859,
+	1858, 1856, 137, 1855,
+}
+
+//line mysql_sql.y:6610
 type yySymType struct {
 	union interface{}
 	id    int
@@ -3398,6 +3539,11 @@ func (st *yySymType) rowsExprsUnion() []tree.Exprs {
 	return v
 }
 
+func (st *yySymType) sampleMethodUnion() tree.SampleMethod {
+	v, _ := st.union.(tree.SampleMethod)
+	return v
+}
+
 func (st *yySymType) selectUnion() *tree.Select {
 	v, _ := st.union.(*tree.Select)
 	return v
@@ -3498,6 +3644,11 @@ func (st *yySymType) tableOptionsUnion() []tree.TableOption {
 	return v
 }
 
+func (st *yySymType) tableSampleUnion() *tree.TableSample {
+	v, _ := st.union.(*tree.TableSample)
+	return v
+}
+
 func (st *yySymType) tlsOptionUnion() tree.TlsOption {
 	v, _ := st.union.(tree.TlsOption)
 	return v
@@ -3619,246 +3770,256 @@ func (st *yySymType) zeroFillOptUnion() bool {
 }
 
 var yyR1 = [...]int{
-	0, 330, 2, 2, 1, 1, 1, 1, 1, 1,
+	0, 340, 2, 2, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 48, 304, 304, 303, 303, 302, 302, 301, 301,
-	301, 300, 300, 300, 299, 299, 298, 298, 296, 296,
-	297, 295, 294, 294, 292, 292, 290, 290, 291, 291,
-	285, 285, 288, 288, 286, 286, 286, 286, 289, 284,
-	284, 284, 283, 283, 47, 47, 47, 222, 222, 46,
-	46, 236, 236, 236, 236, 236, 234, 234, 234, 234,
-	233, 233, 232, 232, 237, 237, 235, 235, 235, 235,
-	235, 235, 235, 235, 235, 235, 235, 235, 235, 235,
-	235, 235, 235, 235, 235, 235, 235, 235, 235, 235,
-	235, 235, 235, 235, 235, 235, 235, 235, 235, 41,
-	41, 41, 41, 44, 45, 230, 230, 230, 230, 230,
-	231, 231, 231, 42, 43, 43, 221, 221, 226, 226,
-	225, 225, 225, 225, 225, 225, 225, 225, 225, 225,
-	225, 220, 220, 229, 229, 229, 228, 228, 227, 227,
-	35, 35, 35, 38, 37, 219, 219, 219, 219, 219,
-	219, 219, 219, 36, 36, 36, 36, 36, 36, 34,
-	34, 33, 218, 218, 217, 40, 40, 40, 40, 39,
-	39, 39, 39, 39, 39, 39, 39, 39, 159, 159,
-	159, 323, 323, 324, 325, 326, 326, 326, 49, 7,
-	32, 32, 267, 267, 170, 170, 171, 171, 169, 169,
-	169, 169, 169, 169, 270, 271, 166, 21, 21, 21,
-	21, 21, 21, 21, 21, 21, 21, 31, 331, 331,
-	331, 29, 30, 266, 266, 266, 28, 27, 26, 25,
-	25, 24, 23, 23, 163, 163, 165, 165, 161, 332,
-	332, 242, 242, 164, 164, 22, 22, 162, 162, 143,
-	160, 160, 160, 6, 8, 8, 8, 8, 8, 13,
-	12, 11, 10, 9, 5, 4, 274, 274, 274, 274,
-	274, 274, 312, 312, 312, 313, 75, 75, 70, 70,
-	275, 275, 185, 314, 314, 282, 282, 281, 281, 280,
-	280, 73, 73, 74, 74, 62, 62, 50, 50, 287,
-	287, 287, 287, 293, 293, 264, 264, 109, 109, 139,
-	139, 140, 140, 51, 51, 52, 52, 52, 52, 52,
-	52, 320, 320, 322, 322, 321, 72, 72, 68, 68,
-	69, 69, 69, 67, 67, 66, 65, 65, 64, 63,
-	63, 63, 54, 54, 53, 53, 53, 53, 53, 125,
-	125, 125, 55, 268, 268, 268, 273, 273, 122, 122,
-	123, 123, 121, 121, 56, 56, 57, 57, 57, 57,
-	120, 120, 119, 58, 58, 59, 59, 61, 61, 61,
-	61, 130, 130, 129, 129, 129, 129, 78, 78, 128,
-	127, 127, 127, 77, 77, 76, 76, 71, 71, 60,
-	60, 126, 333, 333, 124, 152, 152, 152, 158, 158,
-	151, 151, 151, 157, 157, 153, 153, 154, 154, 154,
-	3, 3, 3, 16, 16, 16, 16, 20, 20, 329,
-	329, 14, 215, 215, 214, 214, 216, 216, 216, 216,
-	210, 210, 211, 211, 211, 211, 212, 212, 212, 213,
-	213, 213, 213, 209, 209, 208, 206, 206, 206, 207,
-	207, 207, 207, 207, 207, 155, 155, 15, 203, 203,
-	204, 204, 204, 205, 205, 197, 197, 197, 197, 19,
-	201, 201, 202, 202, 202, 202, 202, 198, 198, 200,
-	200, 196, 196, 196, 196, 196, 18, 195, 195, 193,
-	193, 191, 191, 192, 192, 190, 190, 190, 194, 194,
-	17, 269, 269, 238, 238, 241, 241, 248, 248, 249,
-	249, 247, 247, 254, 254, 253, 253, 252, 252, 251,
-	251, 250, 250, 245, 245, 244, 244, 239, 239, 239,
-	239, 239, 240, 240, 243, 243, 246, 246, 100, 100,
-	101, 101, 101, 118, 118, 118, 118, 118, 118, 118,
-	118, 118, 118, 118, 118, 118, 118, 118, 118, 118,
-	118, 118, 118, 118, 118, 118, 118, 118, 118, 118,
-	118, 118, 310, 310, 311, 103, 103, 103, 107, 107,
-	107, 107, 107, 107, 102, 102, 102, 104, 104, 104,
-	85, 85, 84, 84, 79, 79, 80, 80, 81, 81,
-	82, 82, 83, 83, 83, 83, 83, 83, 224, 224,
-	308, 308, 309, 309, 305, 305, 305, 307, 307, 307,
-	307, 307, 306, 306, 86, 137, 137, 137, 156, 156,
-	156, 136, 136, 136, 99, 99, 98, 98, 96, 96,
-	96, 96, 96, 96, 96, 96, 96, 96, 96, 96,
-	96, 223, 223, 167, 167, 168, 168, 117, 115, 115,
-	116, 116, 116, 116, 113, 114, 112, 112, 112, 112,
-	112, 111, 111, 110, 110, 110, 199, 199, 108, 108,
-	106, 106, 106, 105, 105, 105, 255, 174, 174, 174,
-	174, 174, 174, 174, 174, 174, 174, 174, 174, 174,
-	176, 176, 176, 176, 176, 176, 176, 176, 176, 176,
-	176, 176, 176, 176, 176, 176, 176, 176, 176, 176,
-	177, 177, 182, 182, 319, 319, 318, 87, 87, 87,
-	87, 87, 87, 87, 87, 87, 95, 95, 95, 135,
-	135, 135, 135, 135, 135, 135, 135, 135, 135, 135,
-	135, 135, 135, 135, 279, 279, 279, 132, 132, 132,
-	132, 132, 132, 315, 315, 316, 316, 316, 316, 316,
-	316, 316, 316, 316, 316, 316, 316, 317, 317, 317,
-	317, 317, 317, 317, 317, 317, 317, 317, 317, 317,
-	317, 317, 317, 317, 134, 134, 133, 133, 133, 133,
-	133, 133, 133, 133, 133, 133, 133, 133, 186, 186,
-	187, 187, 276, 276, 276, 276, 276, 276, 277, 277,
-	278, 278, 278, 278, 272, 272, 272, 272, 272, 272,
-	272, 272, 272, 272, 272, 272, 272, 272, 272, 272,
-	272, 272, 272, 272, 272, 272, 272, 272, 272, 272,
-	272, 272, 175, 175, 131, 131, 131, 188, 183, 183,
-	184, 184, 178, 178, 178, 178, 178, 180, 180, 180,
-	180, 173, 173, 173, 173, 173, 173, 173, 173, 173,
-	179, 179, 181, 181, 189, 189, 189, 189, 189, 189,
-	97, 97, 97, 97, 256, 172, 172, 172, 172, 172,
-	172, 172, 88, 88, 88, 88, 92, 92, 94, 94,
-	94, 94, 94, 94, 94, 94, 94, 94, 94, 94,
-	94, 94, 93, 93, 93, 93, 91, 91, 91, 91,
-	91, 89, 89, 89, 89, 89, 89, 89, 89, 89,
-	89, 89, 89, 89, 89, 89, 90, 138, 138, 257,
-	257, 260, 260, 258, 258, 259, 261, 261, 261, 262,
-	262, 262, 263, 263, 263, 265, 265, 142, 142, 142,
-	148, 148, 141, 141, 149, 149, 150, 150, 146, 146,
-	146, 146, 146, 146, 146, 146, 146, 146, 146, 146,
-	146, 146, 146, 146, 146, 146, 146, 146, 146, 146,
-	146, 146, 146, 146, 146, 146, 146, 146, 146, 146,
-	146, 146, 146, 146, 146, 146, 146, 146, 146, 146,
-	146, 146, 146, 146, 146, 146, 146, 146, 146, 146,
-	146, 146, 146, 146, 146, 146, 146, 146, 146, 146,
-	146, 146, 146, 146, 146, 146, 146, 146, 146, 146,
-	146, 146, 146, 146, 146, 146, 146, 146, 146, 146,
-	146, 146, 146, 146, 146, 146, 146, 146, 146, 146,
-	146, 146, 146, 146, 146, 146, 146, 146, 146, 146,
-	146, 146, 146, 146, 146, 146, 146, 146, 146, 146,
-	146, 146, 146, 146, 146, 146, 146, 146, 146, 146,
-	146, 146, 146, 146, 146, 146, 146, 146, 146, 146,
-	146, 146, 146, 146, 146, 146, 146, 146, 146, 146,
-	146, 146, 147, 147, 147, 147, 147, 147, 147, 147,
-	147, 147, 147, 147, 147, 147, 147, 147, 147, 147,
-	147, 147, 147, 147, 147, 147, 147, 147, 147, 147,
-	147, 147, 147, 147, 147, 147, 147, 147, 147, 147,
-	147, 147, 147, 147, 147, 147, 147, 147, 147, 147,
-	147, 147, 147, 147, 147, 147, 147, 147, 147, 147,
-	147, 147, 147, 147, 147, 147, 147, 147, 147, 147,
-	147, 147, 147, 147, 147, 147, 147, 147, 147, 147,
-	147, 147, 147, 147, 147, 147, 147, 147, 147, 147,
-	147, 147, 147, 147, 147, 147, 147, 147, 147, 147,
-	147, 147, 147, 147, 147, 147, 147, 147, 147, 147,
-	147, 147, 147, 147, 147, 147, 147, 147, 147, 147,
-	147, 147, 147, 147, 147, 147, 147, 147, 147, 147,
-	147, 147, 147, 147, 147, 147, 147, 147, 147, 147,
-	147, 147, 147, 147, 147, 147, 147, 147, 147, 147,
-	147, 147, 147, 147, 147, 147, 147, 147, 147, 145,
-	145, 145, 144, 144, 144, 144, 144, 144, 144, 144,
-	144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
-	144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
-	144, 144, 144, 144, 144, 144, 144, 144, 327, 327,
-	327, 328, 328,
+	1, 1, 36, 50, 251, 251, 252, 252, 314, 314,
+	313, 313, 312, 312, 311, 311, 311, 310, 310, 310,
+	309, 309, 308, 308, 306, 306, 307, 305, 304, 304,
+	302, 302, 300, 300, 301, 301, 295, 295, 298, 298,
+	296, 296, 296, 296, 299, 294, 294, 294, 293, 293,
+	49, 49, 49, 230, 230, 48, 48, 244, 244, 244,
+	244, 244, 242, 242, 242, 242, 241, 241, 240, 240,
+	245, 245, 243, 243, 243, 243, 243, 243, 243, 243,
+	243, 243, 243, 243, 243, 243, 243, 243, 243, 243,
+	243, 243, 243, 243, 243, 243, 243, 243, 243, 243,
+	243, 243, 243, 243, 243, 43, 43, 43, 43, 46,
+	47, 238, 238, 238, 238, 238, 239, 239, 239, 44,
+	45, 45, 229, 229, 234, 234, 233, 233, 233, 233,
+	233, 233, 233, 233, 233, 233, 233, 228, 228, 237,
+	237, 237, 236, 236, 235, 235, 37, 37, 37, 40,
+	39, 227, 227, 227, 227, 227, 227, 227, 227, 38,
+	38, 38, 38, 38, 38, 35, 35, 34, 225, 225,
+	224, 42, 42, 42, 42, 41, 41, 41, 41, 41,
+	41, 41, 41, 41, 166, 166, 166, 333, 333, 334,
+	335, 336, 336, 336, 51, 7, 33, 33, 277, 277,
+	177, 177, 178, 178, 176, 176, 176, 176, 176, 176,
+	280, 281, 173, 22, 22, 22, 22, 22, 22, 22,
+	22, 22, 22, 32, 341, 341, 341, 30, 31, 276,
+	276, 276, 29, 28, 27, 26, 26, 25, 24, 24,
+	170, 170, 172, 172, 168, 342, 342, 250, 250, 171,
+	171, 23, 23, 23, 169, 169, 150, 167, 167, 167,
+	6, 8, 8, 8, 8, 8, 8, 14, 13, 11,
+	10, 12, 12, 9, 5, 4, 226, 226, 284, 284,
+	284, 284, 284, 284, 322, 322, 322, 323, 78, 78,
+	73, 73, 285, 285, 192, 324, 324, 292, 292, 291,
+	291, 290, 290, 76, 76, 77, 77, 64, 64, 52,
+	52, 297, 297, 297, 297, 303, 303, 274, 274, 112,
+	112, 146, 146, 147, 147, 53, 53, 54, 54, 54,
+	54, 54, 54, 330, 330, 332, 332, 331, 75, 75,
+	71, 71, 72, 72, 72, 70, 70, 69, 68, 68,
+	67, 66, 66, 66, 56, 56, 55, 55, 55, 55,
+	55, 132, 132, 132, 57, 278, 278, 278, 283, 283,
+	125, 125, 126, 126, 124, 124, 58, 58, 59, 59,
+	59, 59, 123, 123, 122, 60, 60, 61, 61, 63,
+	63, 63, 63, 137, 137, 136, 136, 136, 136, 81,
+	81, 135, 134, 134, 134, 80, 80, 79, 79, 74,
+	74, 62, 62, 62, 62, 62, 65, 133, 343, 343,
+	127, 128, 128, 129, 129, 130, 130, 131, 131, 159,
+	159, 159, 165, 165, 158, 158, 158, 164, 164, 160,
+	160, 161, 161, 161, 3, 3, 3, 17, 17, 17,
+	17, 21, 21, 21, 339, 339, 15, 222, 222, 221,
+	221, 223, 223, 223, 223, 217, 217, 218, 218, 218,
+	218, 219, 219, 219, 220, 220, 220, 220, 216, 216,
+	215, 213, 213, 213, 214, 214, 214, 214, 214, 214,
+	162, 162, 16, 210, 210, 211, 211, 211, 212, 212,
+	204, 204, 204, 204, 20, 208, 208, 209, 209, 209,
+	209, 209, 205, 205, 207, 207, 203, 203, 203, 203,
+	203, 19, 202, 202, 200, 200, 198, 198, 199, 199,
+	197, 197, 197, 201, 201, 18, 18, 279, 279, 246,
+	246, 249, 249, 258, 258, 259, 259, 257, 257, 264,
+	264, 263, 263, 262, 262, 261, 261, 260, 260, 255,
+	255, 254, 254, 247, 247, 247, 247, 247, 248, 248,
+	253, 253, 256, 256, 103, 103, 104, 104, 104, 121,
+	121, 121, 121, 121, 121, 121, 121, 121, 121, 121,
+	121, 121, 121, 121, 121, 121, 121, 121, 121, 121,
+	121, 121, 121, 121, 121, 121, 121, 121, 320, 320,
+	321, 106, 106, 106, 110, 110, 110, 110, 110, 110,
+	105, 105, 105, 107, 107, 107, 88, 88, 87, 87,
+	82, 82, 83, 83, 84, 84, 85, 85, 86, 86,
+	86, 86, 86, 86, 232, 232, 318, 318, 319, 319,
+	315, 315, 315, 317, 317, 317, 317, 317, 316, 316,
+	89, 144, 144, 144, 163, 163, 163, 143, 143, 143,
+	102, 102, 101, 101, 99, 99, 99, 99, 99, 99,
+	99, 99, 99, 99, 99, 99, 99, 99, 231, 231,
+	174, 174, 175, 175, 120, 118, 118, 119, 119, 119,
+	119, 116, 117, 115, 115, 115, 115, 115, 114, 114,
+	113, 113, 113, 206, 206, 111, 111, 109, 109, 109,
+	108, 108, 108, 265, 181, 181, 181, 181, 181, 181,
+	181, 181, 181, 181, 181, 181, 181, 183, 183, 183,
+	183, 183, 183, 183, 183, 183, 183, 183, 183, 183,
+	183, 183, 183, 183, 183, 183, 183, 183, 183, 184,
+	184, 189, 189, 329, 329, 328, 90, 90, 90, 90,
+	90, 90, 90, 90, 90, 90, 98, 98, 98, 142,
+	142, 142, 142, 142, 142, 142, 142, 142, 142, 142,
+	142, 142, 142, 142, 289, 289, 289, 139, 139, 139,
+	139, 139, 139, 139, 139, 325, 325, 326, 326, 326,
+	326, 326, 326, 326, 326, 326, 326, 326, 326, 327,
+	327, 327, 327, 327, 327, 327, 327, 327, 327, 327,
+	327, 327, 327, 327, 327, 327, 141, 141, 141, 140,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
+	140, 193, 193, 194, 194, 286, 286, 286, 286, 286,
+	286, 287, 287, 288, 288, 288, 288, 282, 282, 282,
+	282, 282, 282, 282, 282, 282, 282, 282, 282, 282,
+	282, 282, 282, 282, 282, 282, 282, 282, 282, 282,
+	282, 282, 282, 282, 282, 282, 282, 182, 182, 138,
+	138, 138, 195, 190, 190, 191, 191, 185, 185, 185,
+	185, 185, 187, 187, 187, 187, 180, 180, 180, 180,
+	180, 180, 180, 180, 180, 186, 186, 188, 188, 196,
+	196, 196, 196, 196, 196, 100, 100, 100, 100, 266,
+	179, 179, 179, 179, 179, 179, 179, 91, 91, 91,
+	91, 95, 95, 97, 97, 97, 97, 97, 97, 97,
+	97, 97, 97, 97, 97, 97, 97, 96, 96, 96,
+	96, 94, 94, 94, 94, 94, 92, 92, 92, 92,
+	92, 92, 92, 92, 92, 92, 92, 92, 92, 92,
+	92, 92, 93, 145, 145, 267, 267, 270, 270, 268,
+	268, 269, 271, 271, 271, 272, 272, 272, 273, 273,
+	273, 275, 275, 149, 149, 149, 155, 155, 148, 148,
+	156, 156, 157, 157, 153, 153, 153, 153, 153, 153,
+	153, 153, 153, 153, 153, 153, 153, 153, 153, 153,
+	153, 153, 153, 153, 153, 153, 153, 153, 153, 153,
+	153, 153, 153, 153, 153, 153, 153, 153, 153, 153,
+	153, 153, 153, 153, 153, 153, 153, 153, 153, 153,
+	153, 153, 153, 153, 153, 153, 153, 153, 153, 153,
+	153, 153, 153, 153, 153, 153, 153, 153, 153, 153,
+	153, 153, 153, 153, 153, 153, 153, 153, 153, 153,
+	153, 153, 153, 153, 153, 153, 153, 153, 153, 153,
+	153, 153, 153, 153, 153, 153, 153, 153, 153, 153,
+	153, 153, 153, 153, 153, 153, 153, 153, 153, 153,
+	153, 153, 153, 153, 153, 153, 153, 153, 153, 153,
+	153, 153, 153, 153, 153, 153, 153, 153, 153, 153,
+	153, 153, 153, 153, 153, 153, 153, 153, 153, 153,
+	153, 153, 153, 153, 153, 153, 153, 153, 153, 154,
+	154, 154, 154, 154, 154, 154, 154, 154, 154, 154,
+	154, 154, 154, 154, 154, 154, 154, 154, 154, 154,
+	154, 154, 154, 154, 154, 154, 154, 154, 154, 154,
+	154, 154, 154, 154, 154, 154, 154, 154, 154, 154,
+	154, 154, 154, 154, 154, 154, 154, 154, 154, 154,
+	154, 154, 154, 154, 154, 154, 154, 154, 154, 154,
+	154, 154, 154, 154, 154, 154, 154, 154, 154, 154,
+	154, 154, 154, 154, 154, 154, 154, 154, 154, 154,
+	154, 154, 154, 154, 154, 154, 154, 154, 154, 154,
+	154, 154, 154, 154, 154, 154, 154, 154, 154, 154,
+	154, 154, 154, 154, 154, 154, 154, 154, 154, 154,
+	154, 154, 154, 154, 154, 154, 154, 154, 154, 154,
+	154, 154, 154, 154, 154, 154, 154, 154, 154, 154,
+	154, 154, 154, 154, 154, 154, 154, 154, 154, 154,
+	154, 154, 154, 154, 154, 154, 154, 154, 154, 154,
+	154, 154, 154, 154, 154, 154, 154, 154, 154, 154,
+	154, 154, 154, 154, 152, 152, 152, 152, 151, 151,
+	151, 151, 151, 151, 151, 151, 151, 151, 151, 151,
+	151, 151, 151, 151, 151, 151, 151, 151, 151, 151,
+	151, 151, 151, 151, 151, 151, 151, 151, 151, 151,
+	151, 151, 151, 151, 151, 151, 337, 337, 337, 338,
+	338,
 }
 
 var yyR2 = [...]int{
 	0, 1, 1, 3, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	0, 14, 0, 2, 1, 3, 3, 3, 1, 3,
-	5, 0, 2, 3, 1, 3, 1, 1, 1, 1,
-	1, 1, 0, 3, 0, 3, 0, 3, 0, 3,
-	0, 2, 1, 2, 3, 4, 3, 3, 1, 0,
-	1, 1, 0, 1, 9, 4, 7, 0, 3, 7,
-	4, 1, 3, 3, 3, 1, 0, 1, 1, 1,
-	1, 3, 1, 4, 1, 3, 1, 2, 1, 1,
-	2, 2, 1, 1, 1, 1, 1, 1, 1, 1,
-	2, 1, 2, 1, 2, 2, 1, 1, 1, 3,
-	2, 2, 2, 2, 2, 2, 2, 1, 1, 1,
-	1, 1, 1, 3, 6, 3, 1, 1, 1, 1,
-	1, 1, 1, 2, 4, 6, 1, 4, 1, 3,
-	3, 4, 4, 4, 3, 2, 4, 4, 2, 2,
-	2, 1, 1, 1, 1, 1, 1, 1, 1, 3,
-	1, 1, 1, 2, 2, 0, 4, 2, 4, 1,
-	5, 3, 2, 1, 2, 2, 4, 4, 5, 2,
-	1, 7, 1, 3, 3, 1, 1, 1, 1, 2,
-	3, 4, 7, 2, 3, 3, 4, 5, 1, 1,
-	1, 1, 3, 2, 1, 1, 1, 1, 6, 1,
-	7, 9, 0, 2, 0, 1, 1, 2, 2, 2,
-	1, 4, 2, 2, 3, 0, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 5, 1, 1,
-	1, 5, 5, 0, 1, 1, 2, 2, 3, 6,
-	7, 4, 7, 8, 0, 2, 0, 2, 2, 1,
-	1, 1, 1, 0, 1, 4, 5, 1, 3, 1,
-	1, 3, 5, 1, 1, 1, 1, 1, 1, 4,
-	4, 6, 4, 4, 6, 4, 2, 1, 5, 4,
-	4, 2, 0, 1, 3, 3, 1, 3, 1, 3,
-	1, 3, 4, 0, 1, 0, 1, 1, 3, 1,
-	1, 0, 4, 1, 3, 2, 1, 0, 8, 0,
-	4, 7, 4, 0, 2, 0, 2, 0, 2, 0,
-	4, 1, 3, 1, 1, 4, 3, 4, 5, 4,
-	5, 2, 3, 1, 3, 6, 0, 3, 0, 1,
-	2, 4, 4, 0, 1, 3, 1, 3, 2, 0,
-	1, 1, 3, 3, 1, 3, 3, 3, 3, 1,
-	2, 2, 7, 0, 1, 1, 1, 1, 0, 2,
-	0, 3, 0, 2, 1, 3, 1, 2, 3, 5,
-	0, 1, 2, 1, 3, 1, 1, 4, 4, 4,
-	3, 2, 2, 2, 3, 2, 3, 0, 2, 1,
-	1, 2, 2, 0, 1, 2, 4, 1, 3, 1,
-	4, 3, 0, 1, 2, 0, 1, 2, 1, 1,
-	0, 1, 2, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 8, 11, 0,
-	1, 6, 0, 2, 1, 2, 2, 2, 2, 2,
-	0, 1, 2, 2, 2, 2, 1, 3, 2, 2,
-	2, 2, 2, 1, 3, 2, 1, 3, 2, 0,
-	3, 3, 5, 5, 4, 1, 1, 4, 1, 3,
-	1, 3, 2, 1, 1, 0, 1, 1, 1, 11,
-	0, 2, 3, 2, 3, 1, 1, 1, 3, 3,
-	4, 0, 2, 2, 2, 2, 5, 1, 1, 0,
-	3, 0, 1, 1, 2, 4, 4, 4, 0, 1,
-	10, 0, 1, 0, 6, 0, 4, 0, 3, 1,
-	3, 4, 5, 0, 3, 1, 3, 2, 3, 1,
-	2, 0, 6, 0, 2, 0, 2, 4, 5, 4,
-	5, 1, 6, 5, 0, 3, 0, 1, 0, 1,
-	1, 3, 2, 3, 3, 4, 4, 3, 3, 3,
-	3, 4, 4, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 4,
-	5, 4, 1, 3, 3, 0, 2, 2, 1, 1,
+	1, 0, 4, 16, 0, 2, 0, 2, 0, 2,
+	1, 3, 3, 3, 1, 3, 5, 0, 2, 3,
+	1, 3, 1, 1, 1, 1, 1, 1, 0, 3,
+	0, 3, 0, 3, 0, 3, 0, 2, 1, 2,
+	3, 4, 3, 3, 1, 0, 1, 1, 0, 1,
+	9, 4, 7, 0, 3, 7, 4, 1, 3, 3,
+	3, 1, 0, 1, 1, 1, 1, 3, 1, 4,
+	1, 3, 1, 2, 1, 1, 2, 2, 1, 1,
+	1, 1, 1, 1, 1, 1, 2, 1, 2, 1,
+	2, 2, 1, 1, 1, 3, 2, 2, 2, 2,
+	2, 2, 2, 1, 1, 1, 1, 1, 1, 3,
+	6, 3, 1, 1, 1, 1, 1, 1, 1, 2,
+	4, 6, 1, 4, 1, 3, 3, 4, 4, 4,
+	3, 2, 4, 4, 2, 2, 2, 1, 1, 1,
+	1, 1, 1, 1, 1, 3, 1, 1, 1, 2,
+	2, 0, 4, 2, 4, 1, 5, 3, 2, 1,
+	2, 2, 4, 4, 5, 2, 1, 7, 1, 3,
+	3, 1, 1, 1, 1, 2, 3, 4, 7, 2,
+	3, 3, 4, 5, 1, 1, 1, 1, 3, 2,
+	1, 1, 1, 1, 6, 1, 7, 9, 0, 2,
+	0, 1, 1, 2, 2, 2, 1, 4, 2, 2,
+	3, 0, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 5, 1, 1, 1, 5, 5, 0,
+	1, 1, 2, 2, 3, 6, 7, 4, 7, 8,
+	0, 2, 0, 2, 2, 1, 1, 1, 1, 0,
+	1, 4, 4, 5, 1, 3, 1, 1, 3, 5,
+	1, 1, 1, 1, 1, 1, 1, 4, 4, 6,
+	4, 4, 5, 4, 6, 5, 0, 5, 2, 1,
+	5, 4, 4, 2, 0, 1, 3, 3, 1, 3,
+	1, 3, 1, 3, 4, 0, 1, 0, 1, 1,
+	3, 1, 1, 0, 4, 1, 3, 2, 1, 0,
+	10, 0, 4, 7, 4, 0, 2, 0, 2, 0,
+	2, 0, 4, 1, 3, 1, 1, 4, 3, 4,
+	5, 4, 5, 2, 3, 1, 3, 6, 0, 3,
+	0, 1, 2, 4, 4, 0, 1, 3, 1, 3,
+	2, 0, 1, 1, 3, 3, 1, 3, 3, 3,
+	3, 1, 2, 2, 7, 0, 1, 1, 1, 1,
+	0, 2, 0, 3, 0, 2, 1, 3, 1, 2,
+	3, 5, 0, 1, 2, 1, 3, 1, 1, 4,
+	4, 4, 3, 2, 2, 2, 3, 2, 3, 0,
+	2, 1, 1, 2, 2, 0, 1, 2, 4, 1,
+	3, 1, 4, 2, 5, 6, 4, 3, 0, 1,
+	3, 0, 7, 1, 1, 1, 1, 0, 4, 0,
+	1, 2, 1, 1, 0, 1, 2, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 3, 1, 3, 0, 1, 1, 3, 1, 1,
-	2, 1, 7, 7, 7, 7, 8, 5, 0, 1,
-	0, 1, 1, 1, 1, 3, 3, 1, 1, 1,
-	1, 1, 0, 1, 3, 1, 3, 5, 1, 1,
-	1, 1, 3, 5, 0, 1, 1, 2, 1, 2,
-	2, 1, 1, 2, 2, 2, 2, 2, 1, 5,
-	6, 1, 2, 0, 1, 1, 2, 5, 0, 1,
-	1, 1, 2, 2, 3, 3, 1, 1, 2, 2,
-	2, 0, 1, 2, 2, 2, 0, 3, 0, 3,
-	1, 1, 1, 1, 1, 1, 1, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 1,
-	1, 1, 1, 3, 5, 2, 2, 2, 2, 1,
-	1, 2, 5, 6, 6, 6, 1, 1, 1, 1,
-	0, 2, 0, 1, 1, 2, 4, 1, 2, 2,
-	1, 2, 2, 2, 2, 2, 0, 1, 1, 5,
+	1, 8, 11, 8, 0, 1, 6, 0, 2, 1,
+	2, 2, 2, 2, 2, 0, 1, 2, 2, 2,
+	2, 1, 3, 2, 2, 2, 2, 2, 1, 3,
+	2, 1, 3, 2, 0, 3, 3, 5, 5, 4,
+	1, 1, 4, 1, 3, 1, 3, 2, 1, 1,
+	0, 1, 1, 1, 11, 0, 2, 3, 2, 3,
+	1, 1, 1, 3, 3, 4, 0, 2, 2, 2,
+	2, 5, 1, 1, 0, 3, 0, 1, 1, 2,
+	4, 4, 4, 0, 1, 10, 14, 0, 1, 0,
+	6, 0, 4, 0, 3, 1, 3, 4, 5, 0,
+	3, 1, 3, 2, 3, 1, 2, 0, 6, 0,
+	2, 0, 2, 4, 5, 4, 5, 1, 6, 5,
+	0, 3, 0, 1, 0, 1, 1, 3, 2, 3,
+	3, 4, 4, 3, 3, 3, 3, 4, 4, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 4, 5, 4, 1, 3,
+	3, 0, 2, 2, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 3, 1, 3,
+	0, 1, 1, 3, 1, 1, 2, 1, 7, 7,
+	7, 7, 8, 5, 0, 1, 0, 1, 1, 1,
+	1, 3, 3, 1, 1, 1, 1, 1, 0, 1,
+	3, 1, 3, 5, 1, 1, 1, 1, 3, 5,
+	0, 1, 1, 2, 1, 2, 2, 1, 1, 2,
+	2, 2, 2, 2, 2, 1, 5, 6, 1, 2,
+	0, 1, 1, 2, 5, 0, 1, 1, 1, 2,
+	2, 3, 3, 1, 1, 2, 2, 2, 0, 1,
+	2, 2, 2, 0, 3, 0, 3, 1, 1, 1,
+	1, 1, 1, 1, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 1, 1, 3, 3,
+	1, 1, 3, 5, 2, 2, 2, 2, 1, 1,
+	2, 5, 6, 6, 6, 1, 1, 1, 1, 0,
+	2, 0, 1, 1, 2, 4, 1, 2, 2, 1,
+	2, 2, 2, 1, 2, 2, 0, 1, 1, 5,
 	4, 4, 5, 5, 5, 5, 4, 5, 5, 5,
 	5, 5, 5, 5, 1, 1, 1, 4, 4, 6,
-	8, 6, 4, 1, 1, 1, 1, 1, 1, 1,
+	8, 6, 8, 8, 4, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 3, 2, 2, 4,
+	2, 2, 4, 6, 2, 2, 2, 4, 6, 4,
+	2, 0, 1, 2, 3, 1, 1, 1, 1, 1,
+	1, 0, 2, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 2, 2, 4, 2, 2, 4,
-	6, 2, 2, 2, 4, 6, 4, 2, 0, 1,
-	2, 3, 1, 1, 1, 1, 1, 1, 0, 2,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 2, 3, 0,
+	1, 1, 3, 0, 1, 1, 3, 3, 3, 3,
+	2, 1, 3, 4, 3, 1, 3, 4, 4, 5,
+	3, 4, 5, 6, 1, 0, 2, 1, 1, 1,
+	1, 1, 1, 1, 1, 2, 2, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 3, 1, 1,
+	1, 2, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 2, 2, 2,
+	2, 1, 2, 2, 2, 2, 2, 2, 2, 2,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	4, 4, 1, 1, 3, 0, 1, 0, 3, 0,
+	3, 3, 0, 3, 5, 0, 3, 5, 0, 1,
+	1, 0, 1, 1, 2, 2, 0, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 2, 3, 0, 1, 1, 3, 0, 1,
-	1, 3, 3, 3, 3, 2, 1, 3, 4, 3,
-	1, 3, 4, 4, 5, 3, 4, 5, 6, 1,
-	0, 2, 1, 1, 1, 1, 1, 1, 1, 1,
-	2, 2, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 3, 1, 1, 1, 2, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 2, 2, 2, 2, 1, 2, 2, 2,
-	2, 2, 2, 2, 2, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 4, 4, 1, 1, 3, 0,
-	1, 0, 3, 0, 3, 3, 0, 3, 5, 0,
-	3, 5, 0, 1, 1, 0, 1, 1, 2, 2,
-	0, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
@@ -3893,321 +4054,336 @@ var yyR2 = [...]int{
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1,
+	1,
 }
 
 var yyChk = [...]int{
-	-1000, -330, -2, -1, -3, -4, -5, -6, -39, -21,
-	-7, -49, -33, -34, -35, -41, -46, -47, -48, -51,
-	-16, -15, -14, 8, 10, -8, -159, -22, -23, -24,
-	-25, -26, -27, -28, -29, -30, -31, -32, 181, 9,
-	49, -36, -37, -38, -42, -43, -44, -45, 283, 289,
-	326, -52, -54, -17, -18, -19, -20, 177, -9, -10,
-	-11, -12, -13, 199, 198, 26, 197, 178, 120, 121,
-	123, 124, 30, -53, -320, 54, 179, -55, 397, 6,
-	442, -62, 27, -84, -156, 57, -144, -147, 400, 401,
-	402, 403, 404, 405, 406, 407, 408, 409, 410, 411,
-	412, 413, 414, 415, 416, 417, 418, 419, 420, 421,
-	422, 423, 424, 425, 426, 427, 428, 429, 430, 431,
-	432, 433, 434, 435, 394, 216, 240, 243, 250, 120,
-	137, 131, 158, 150, 151, 128, 220, 221, 64, 123,
-	354, 340, 325, 126, 235, 237, 239, 242, 222, 146,
-	142, 234, 218, 140, 223, 28, 224, 163, 225, 226,
-	399, 336, 265, 358, 342, 247, 141, 337, 238, 339,
-	164, 168, 346, 289, 135, 136, 344, 348, 162, 196,
-	32, 396, 34, 208, 349, 166, 161, 157, 357, 251,
-	249, 160, 134, 156, 219, 38, 170, 169, 171, 319,
-	320, 321, 322, 227, 228, 277, 345, 153, 143, 269,
-	129, 18, 352, 203, 341, 290, 229, 244, 206, 252,
-	165, 167, 210, 215, 398, 248, 350, 139, 207, 236,
-	202, 353, 130, 283, 292, 230, 124, 241, 347, 356,
-	37, 305, 133, 127, 193, 121, 212, 217, 231, 232,
-	233, 254, 253, 245, 154, 209, 159, 132, 155, 122,
-	211, 355, 306, 441, 267, 308, 152, 149, 213, 186,
-	351, 343, 125, 312, 307, 147, 255, 438, 439, 440,
-	278, 11, -160, 19, 323, -40, 324, 181, 54, -156,
-	-5, -4, -33, -51, 184, -59, -60, -61, -124, -126,
-	-84, 54, -156, -233, -203, -232, -204, -235, -205, -155,
-	20, 178, 177, 211, 10, 179, 287, 185, 8, 6,
-	288, 197, 9, 289, 291, 292, 295, 296, 297, 31,
-	300, 301, 57, 60, -156, -233, -203, 215, 222, -66,
-	-67, -125, 15, 5, -269, 308, 214, -197, -195, 298,
-	194, 193, 76, 360, 183, -331, -266, 343, 342, -164,
-	341, 334, 336, 177, 185, 344, 32, 346, 347, 337,
-	308, 125, 122, -219, 80, 130, 129, -219, 214, 29,
-	-226, 318, -225, -227, 346, 347, 357, -220, 345, -142,
-	-156, 58, 59, 75, 151, 148, -67, -125, -66, -53,
-	-54, -52, -54, 308, 214, 185, 184, 360, -322, 392,
-	-321, -156, -268, 20, -273, 21, 22, -1, -73, 206,
-	-84, 119, -59, -136, -156, 325, 89, -40, -40, 324,
-	-323, -324, -325, -327, 181, 324, 323, 119, -84, 30,
-	-127, -128, -129, -130, 41, 45, 47, 42, 43, 44,
-	48, -333, 23, -152, -158, 23, -153, 60, -154, -147,
-	57, 58, 59, -52, -54, 51, 55, 11, 55, 54,
-	443, 58, 285, 299, 308, 286, 298, 186, 214, 299,
-	214, 334, 186, 290, 293, 294, 335, 51, 187, 51,
-	-283, 357, -50, 27, -69, 17, -55, -54, 16, 20,
-	21, -329, 184, 392, -193, 189, -193, 185, -193, -332,
-	11, 99, 213, 212, 338, 335, -242, 339, 340, -164,
-	-163, 97, -164, 184, 360, -267, 189, 350, 397, 128,
-	129, 130, -230, 20, 29, 317, -203, 214, 55, 89,
-	19, -228, 89, 100, -227, -227, -227, -228, -102, 29,
-	-154, 60, 116, -102, 29, 119, 30, 30, -68, -69,
-	-55, -54, -67, -66, -67, 56, 56, -267, -267, -267,
-	-267, -267, 55, -322, -72, 54, -56, -57, 107, -178,
-	-156, 81, -180, 57, -173, 401, 402, 403, 404, 405,
-	406, 407, 409, 410, 411, 412, 414, 416, 417, 420,
-	421, 422, 423, 425, 426, 427, 428, 433, 434, 435,
-	277, 308, 147, 278, -174, -176, -301, -296, -172, 54,
-	105, 106, 113, 82, -175, -255, 24, 84, 368, -132,
-	-133, -134, -135, -297, -295, 60, 65, 69, 71, 72,
-	70, 67, 118, -54, -315, -145, -272, -278, -276, 148,
-	200, 144, 145, 8, 111, 318, 116, -279, 59, 58,
-	271, 75, 272, 273, 360, 268, 274, 189, 323, 43,
-	275, 276, 279, 367, 280, 44, 281, 270, 204, 282,
-	371, 370, 372, 364, 361, 359, 362, 363, 365, 366,
-	-274, 33, -51, 54, 30, 54, -156, -121, 12, 119,
-	65, 60, -40, 56, 55, -326, 71, 72, -328, 162,
-	154, -156, 54, -218, -217, -136, -60, -60, -60, -60,
-	41, 41, 41, 46, 41, 46, 41, -129, -156, -158,
-	56, -234, 184, 284, 210, -232, 211, 289, 292, -209,
-	-208, -206, -155, 60, -204, -237, -136, -155, 335, -234,
-	-209, -208, 327, 437, -50, -178, -156, -65, -64, -178,
-	186, -193, -209, 81, -203, -154, -156, -84, -163, -163,
-	-165, -332, -161, -332, 335, -121, -176, -242, -162, -156,
-	-193, -209, 308, 24, 351, 352, 126, 129, 128, 358,
-	-231, 317, 20, -203, -225, -221, 60, 318, -208, -229,
-	51, 116, -280, -178, 29, -228, -228, -228, -229, 115,
-	-156, -50, -68, -50, -69, -209, -203, -156, -85, -84,
-	-157, -154, -147, -321, 23, -71, -156, -120, 55, -119,
-	11, -151, 80, 78, 79, -156, 23, 119, -178, 96,
-	-189, 89, 90, 91, 92, 93, 94, 54, 54, 54,
-	54, 54, 54, 54, 54, -187, 54, 54, 54, 54,
-	54, 54, -187, 54, 54, 54, 102, 101, 112, 105,
-	106, 107, 108, 109, 110, 111, 103, 104, 99, 81,
-	97, 98, 83, -54, -178, -184, -176, -176, -176, -176,
-	-255, -182, -178, 54, 60, 65, 54, 54, 54, -277,
-	54, -186, -187, 54, 60, 60, 60, 54, 54, 54,
-	-176, 54, -275, -185, -314, 436, -75, 56, -70, -156,
-	-312, -313, -70, -74, -156, -67, -178, -149, -150, -141,
-	-146, -153, -154, -147, 266, 182, 20, 80, 23, 25,
-	271, 303, 83, 116, 16, 84, 148, 115, 273, 368,
-	272, 177, 47, 75, 370, 372, 371, 361, 359, 310,
-	314, 316, 313, 360, 334, 29, 10, 26, 198, 21,
-	22, 109, 179, 200, 87, 88, 201, 24, 199, 72,
-	19, 50, 11, 323, 13, 14, 274, 309, 189, 188,
-	99, 327, 185, 45, 8, 118, 27, 96, 311, 41,
-	77, 43, 97, 17, 362, 363, 31, 326, 393, 205,
-	111, 275, 276, 48, 81, 317, 70, 51, 78, 15,
-	46, 98, 180, 367, 44, 214, 315, 279, 281, 392,
-	280, 183, 6, 270, 369, 30, 197, 42, 184, 335,
-	86, 187, 71, 204, 144, 145, 5, 76, 9, 49,
-	52, 364, 365, 366, 33, 85, 12, 282, 397, 318,
-	328, 329, 330, 331, 332, 333, 172, 173, 174, 175,
-	176, 246, 192, 190, 194, 195, 436, 437, 19, -40,
-	-324, 119, -71, -121, 55, 89, -77, -76, 51, 52,
-	-78, 51, -76, 41, 41, -72, -236, 107, 57, 55,
-	-207, 309, 443, 58, 56, 55, -236, 187, 60, 60,
-	55, 18, 119, 55, -63, 25, 26, -84, 189, -84,
-	-210, -211, 315, 24, -196, 52, -191, -192, -190, -194,
-	29, -121, -121, -121, -163, -157, -165, -160, -165, -161,
-	119, -143, -156, -210, 54, 127, 130, 130, 129, -203,
-	187, 54, 89, -229, -229, -229, 29, -155, -50, -50,
-	51, 55, 54, 56, 55, -121, -57, -58, -59, -178,
-	-178, -178, -156, -156, 107, 70, 81, -173, -183, -184,
-	-178, -131, 21, 20, -131, -131, -178, -131, 107, -184,
-	-184, 56, -256, 65, -316, -317, 373, 374, 375, 376,
-	377, 378, 379, 380, 381, 382, 383, 275, 270, 276,
-	274, 268, 282, 277, 278, 147, 390, 391, 384, 385,
-	386, 387, 388, 389, -131, -131, -131, -131, -131, -131,
-	-131, -174, -174, -174, -174, -174, -174, -174, -174, -174,
-	-174, -174, -174, -181, -188, -255, 54, 99, 97, 98,
-	83, -176, -174, -174, 56, 55, -319, -318, 85, -178,
-	-316, -183, -178, -183, -183, 56, -184, -183, -174, -183,
-	-131, 55, 54, 56, 55, 33, 119, 55, 89, 56,
-	55, -68, 119, 325, -156, 56, -67, -217, -178, -178,
-	54, -178, 11, 119, 119, -208, 16, 397, -155, -136,
-	187, -209, -284, 188, 367, -287, 339, -178, -178, -156,
-	-64, -72, 81, 54, -215, 397, 317, 316, 312, -212,
-	-213, 311, 313, 310, 314, 51, 260, 261, 262, 263,
-	-190, -142, 115, 225, 151, -121, -163, -163, -165, -156,
-	-215, 56, 130, -209, -166, 60, -221, -84, -84, -1,
-	-156, -123, 13, 55, 119, 70, 56, 55, -178, -178,
-	-178, 23, -184, 56, 56, 56, 56, 11, -178, -178,
-	-178, -178, -178, -178, -178, -184, -181, -176, -174, -174,
-	-179, 201, 80, -178, -177, -318, 87, -178, 55, 52,
-	56, 11, 56, 56, 56, 52, 56, 55, 56, -178,
-	-185, -282, -281, -280, 33, -51, -70, -275, -156, -313,
-	-280, -156, -149, -146, -154, -147, 65, -68, -71, -209,
-	107, 107, 57, -155, 318, -155, -209, -222, 397, 27,
-	-293, 333, 328, 330, 119, 23, 24, -79, -80, -81,
-	-86, -82, -136, -168, -83, 192, 190, 194, -309, 76,
-	195, 246, 77, 185, -214, -216, 319, 320, 321, 322,
-	80, -213, 60, 60, 60, 60, -84, -148, 89, -148,
-	-148, -121, -121, -163, -170, -171, -169, 266, -270, 318,
-	309, 56, 56, -122, 14, 16, -59, -156, 107, -178,
-	56, 56, 56, -87, -93, 116, 148, 200, 147, 146,
-	144, 305, 306, 140, 141, 142, 139, 56, -178, 56,
-	56, 56, 56, 56, 56, 56, 56, -179, 80, -176,
-	-173, 56, 88, -178, 86, -87, -102, -178, -102, -174,
-	56, 56, 55, -275, 56, -155, 16, 23, -210, 289,
-	184, -264, 438, -291, 328, 16, 16, -51, -84, 56,
-	55, -88, -92, -89, -91, -90, -94, -93, 148, 149,
-	116, 152, 154, 155, 156, 157, 158, 159, 160, 161,
-	162, 163, 30, 200, 144, 145, 146, 147, 164, 131,
-	150, 395, 172, 132, 173, 133, 174, 134, 175, 135,
-	136, 176, 137, -83, -156, 77, -308, -309, -193, -308,
-	77, 54, -216, 65, 65, 65, 65, -213, 54, -102,
-	-104, -154, 60, 116, 60, -121, -169, 267, 31, 118,
-	269, 29, 265, 16, -178, -184, 56, -257, -259, 54,
-	-258, 54, -257, -257, -257, -95, 136, 135, -95, -261,
-	54, -261, -262, 54, -261, 56, -173, -178, 56, 56,
-	56, 19, 56, 56, -280, -155, -155, -222, 290, -84,
-	-109, 439, 60, 16, 60, -289, 60, -72, -100, -101,
-	-118, 303, 216, -194, 220, 64, 221, 325, 222, 185,
-	224, 225, 226, 196, 227, 228, 229, 318, 230, 231,
-	232, 233, 286, 5, 256, -81, -99, -98, -96, 70,
-	81, 29, 303, -97, 64, 115, 239, 217, 240, -117,
-	-167, 190, 76, 77, 291, -168, -263, 306, 305, -257,
-	-258, -259, -257, -257, 54, 54, -257, -260, 54, -257,
-	-257, -305, -306, -156, -306, -156, -305, -305, -193, -178,
-	-198, -200, -136, 54, 65, -271, -166, 65, 65, 65,
-	65, -178, -285, -242, -139, 440, 65, 60, 330, 23,
-	-238, 206, 55, -118, -148, -148, -142, 115, -148, -148,
-	-148, -148, 223, 223, -148, -148, -148, -148, -148, -148,
-	-148, -148, -148, -148, -148, -148, -148, -148, 54, -96,
-	70, -174, 60, -104, -105, 29, 238, 234, -106, 29,
-	218, 219, -108, 54, 246, 77, 77, -84, -265, 307,
-	-138, 60, -138, 65, 54, 52, 255, 54, 54, 54,
-	-306, 56, 56, 55, -257, -178, 268, 56, 56, 56,
-	55, 56, 55, 56, -292, 333, -288, -286, 328, 329,
-	330, 331, 54, 16, -51, 16, -118, 65, 65, -148,
-	-148, 65, 60, 60, 60, -148, -148, 65, 60, -158,
-	65, 65, 65, 65, 29, 60, -107, 29, 234, 238,
-	235, 236, 237, 65, 29, 65, 29, 65, 29, -156,
-	54, -310, -311, 60, 65, 54, -199, 54, 56, 55,
-	56, 56, -198, -307, 260, 261, 262, 264, 263, -307,
-	-198, -198, -198, 54, -224, -223, 247, 81, -201, -200,
-	-63, 56, 65, 65, -294, 188, -290, 332, -286, 16,
-	330, 16, 16, -140, -156, -289, -239, 248, 249, -240,
-	-246, 251, -102, -102, 60, 60, -103, 217, -85, 56,
-	55, 89, 56, -178, -111, -110, 393, -198, 60, 56,
-	56, 56, 56, -198, 247, -202, 196, 64, 397, 258,
-	259, -63, 56, 56, -300, 54, 65, -291, 16, -289,
-	16, -289, -289, 56, 55, -244, 252, 54, -242, 54,
-	-242, 77, 261, 218, 219, 56, -311, 60, 56, -115,
-	-116, -113, -114, 51, 337, 244, 245, 56, -201, -201,
-	-201, -201, 56, -148, 60, 257, -304, 30, 56, -299,
-	-298, -137, -295, -156, 333, 60, -289, -156, -241, 253,
-	65, -174, 54, -174, 54, -243, 250, 54, -223, -114,
-	51, -113, 51, 10, 9, -117, 65, -154, -303, -302,
-	-301, 56, 55, 119, -248, 54, 16, 56, -237, 56,
-	-237, 54, 89, -174, -112, 241, 242, 30, 129, -112,
-	55, 89, -298, -156, -249, -247, 206, -240, 56, 56,
-	-237, 65, 56, 70, 29, 243, -302, 29, -178, 119,
-	56, 55, 57, -245, 254, 56, -156, -247, -250, 33,
-	65, -254, -251, 54, -118, 208, -254, -118, -253, -252,
-	253, 209, 56, 55, 57, 54, -252, -251, -184, 56,
+	-1000, -340, -2, -1, -3, -4, -5, -6, -41, -22,
+	-7, -51, -34, -35, -37, -43, -48, -49, -50, -36,
+	-53, -17, -16, -15, 8, 10, -8, -166, -23, -24,
+	-25, -26, -27, -28, -29, -30, -31, -32, -33, 184,
+	9, 49, -38, -39, -40, -44, -45, -46, -47, 288,
+	294, 331, 403, -54, -56, -18, -19, -20, -21, 180,
+	-9, -10, -12, -11, -13, -14, 202, 201, 26, 200,
+	181, 122, 123, 125, 126, 30, -55, -330, 54, 182,
+	-57, 408, 6, 455, -64, 27, -87, -163, 57, -151,
+	-154, 411, 412, 413, 414, 415, 416, 417, 418, 419,
+	420, 421, 422, 423, 424, 425, 426, 427, 428, 429,
+	430, 431, 432, 433, 434, 435, 436, 437, 438, 439,
+	440, 447, 448, 441, 442, 443, 444, 445, 446, 405,
+	219, 243, 246, 253, 122, 400, 402, 403, 139, 133,
+	160, 152, 153, 130, 223, 224, 64, 125, 359, 345,
+	330, 128, 238, 240, 242, 245, 225, 148, 144, 237,
+	221, 142, 226, 28, 227, 165, 228, 229, 410, 259,
+	341, 270, 363, 347, 250, 143, 342, 241, 344, 167,
+	171, 351, 294, 137, 138, 349, 353, 164, 199, 32,
+	407, 34, 211, 354, 169, 163, 159, 362, 260, 254,
+	252, 162, 136, 158, 222, 38, 173, 172, 174, 324,
+	325, 326, 327, 230, 231, 282, 350, 155, 145, 274,
+	131, 18, 357, 206, 346, 295, 232, 247, 209, 255,
+	401, 168, 170, 213, 218, 409, 251, 355, 141, 210,
+	239, 205, 358, 132, 288, 297, 233, 126, 244, 352,
+	361, 37, 310, 135, 129, 196, 123, 215, 220, 234,
+	235, 236, 257, 256, 399, 248, 156, 212, 161, 134,
+	157, 124, 214, 360, 311, 454, 272, 313, 166, 154,
+	151, 216, 189, 356, 348, 127, 317, 312, 149, 258,
+	451, 452, 453, 283, 11, -167, 19, 328, -42, 329,
+	184, 54, -163, -5, -4, -34, -53, 187, -61, -62,
+	-63, -127, -133, -65, -87, 54, -163, -163, -241, -210,
+	-240, -211, -243, -212, -162, 20, 181, 180, 214, 10,
+	182, 292, 188, 8, 6, 293, 200, 9, 294, 296,
+	297, 300, 301, 302, 31, 305, 306, 57, 60, -163,
+	-241, -210, 218, 225, 402, -69, -70, -132, 15, 5,
+	-279, 402, 313, 217, -204, -202, 259, 303, 197, 196,
+	78, 365, 186, -341, -276, 348, 347, -171, 346, 339,
+	341, 180, 188, 349, 32, 351, 352, 342, 313, 127,
+	124, -227, 82, 132, 131, -227, 217, 29, -234, 323,
+	-233, -235, 351, 352, 362, -228, 350, -149, -163, 58,
+	59, 77, 153, 150, -70, -132, -69, -55, -56, -54,
+	-56, 313, 217, 188, 187, 189, 402, 365, -332, 397,
+	-331, -163, -278, 20, -283, 21, 22, -1, -76, 209,
+	-87, 121, -61, -143, -163, 330, 91, -42, -42, 329,
+	-333, -334, -335, -337, 184, 329, 328, 121, -87, 30,
+	-134, -135, -136, -137, 41, 45, 47, 42, 43, 44,
+	48, -343, 23, -159, -165, 23, -160, 60, -161, -154,
+	57, 58, 59, -159, -165, 23, -54, -56, 54, 51,
+	55, 11, 55, 54, 456, 58, 290, 304, 313, 291,
+	303, 189, 217, 304, 217, 339, 189, 295, 298, 299,
+	340, 51, 190, 51, -293, 362, 189, -52, 27, -72,
+	17, -57, -56, 16, 20, 21, -339, 187, 397, 189,
+	-200, 192, -200, 188, -200, 187, -342, 11, 101, 216,
+	215, 343, 340, -250, 344, 345, -171, -170, 99, -171,
+	187, 189, 365, -277, 192, 355, 408, 130, 131, 132,
+	-238, 20, 29, 322, -210, 217, 55, 91, 19, -236,
+	91, 102, -235, -235, -235, -236, -105, 29, -161, 60,
+	118, -105, 29, 121, 30, 30, -71, -72, -57, -56,
+	-70, -69, -70, 56, 56, -277, -277, -277, -277, -277,
+	189, -277, 55, -332, -75, 54, -58, -59, 109, -185,
+	-163, 83, -187, 57, -180, 412, 413, 414, 415, 416,
+	417, 418, 419, 420, 421, 422, 423, 425, 427, 428,
+	431, 432, 433, 434, 436, 437, 438, 439, 447, 448,
+	444, 445, 446, 34, 282, 313, 166, 149, 283, -181,
+	-183, -311, -306, -179, 54, 107, 108, 115, 84, -182,
+	-265, 24, 86, 373, -139, -140, -141, -142, -307, -305,
+	60, 67, 71, 73, 74, 72, 69, 120, -56, -325,
+	-152, -282, -288, -286, 150, 203, 146, 147, 8, 113,
+	323, 118, -289, 59, 58, 276, 77, 277, 278, 365,
+	273, 279, 192, 328, 43, 280, 281, 284, 372, 285,
+	44, 286, 275, 207, 33, 287, 376, 375, 377, 369,
+	366, 364, 367, 368, 370, 371, -284, 33, -53, 54,
+	30, 54, -163, -124, 12, 121, 67, 60, -42, 56,
+	55, -336, 73, 74, -338, 164, 156, -163, 54, -225,
+	-224, -143, -62, -62, -62, -62, 41, 41, 41, 46,
+	41, 46, 41, -136, -163, 54, -165, -128, 398, -165,
+	56, -190, -191, -185, -163, -242, 187, 289, 213, -240,
+	214, 294, 297, -216, -215, -213, -162, 60, -211, -245,
+	-143, -162, 340, -242, -216, -215, 332, -87, 450, -52,
+	-185, -68, -67, -185, 189, -200, -200, -216, 83, -210,
+	-161, -163, -200, -87, -170, -170, -172, -342, -168, -342,
+	340, -124, -183, -250, -169, -163, -169, -200, -216, 313,
+	24, 356, 357, 128, 131, 130, 363, -239, 322, 20,
+	-210, -233, -229, 60, 323, -215, -237, 51, 118, -290,
+	-185, 29, -236, -236, -236, -237, 117, -163, -52, -71,
+	-52, -72, -216, -210, -163, -88, -87, -88, -277, -164,
+	-161, -154, -331, 23, -74, -163, -123, 55, -122, 11,
+	-158, 82, 80, 81, -163, 23, 121, -185, 98, -196,
+	91, 92, 93, 94, 95, 96, 54, 54, 54, 54,
+	54, 54, 54, 54, 54, -194, 54, 54, 54, 54,
+	54, 54, -194, 54, 54, 54, 54, 54, 104, 103,
+	114, 107, 108, 109, 110, 111, 112, 113, 105, 106,
+	101, 83, 99, 100, 85, 65, 66, -56, -185, -191,
+	-183, -183, -183, -183, -265, -189, -185, 54, 60, 67,
+	54, 54, 54, -287, 54, -193, -194, 54, 60, 60,
+	60, 54, 54, 54, -183, 54, -226, 51, -285, -192,
+	-324, 449, -78, 56, -73, -163, -322, -323, -73, -77,
+	-163, -70, -185, -156, -157, -148, -153, -160, -161, -154,
+	271, 185, 20, 82, 23, 25, 276, 308, 85, 118,
+	16, 86, 150, 117, 278, 373, 277, 180, 47, 77,
+	375, 377, 376, 366, 364, 315, 319, 321, 318, 365,
+	339, 29, 10, 26, 201, 21, 22, 111, 182, 203,
+	89, 90, 204, 24, 202, 74, 19, 50, 11, 328,
+	13, 14, 279, 314, 192, 191, 101, 332, 188, 45,
+	8, 120, 27, 98, 316, 41, 79, 43, 99, 17,
+	367, 368, 31, 331, 404, 208, 113, 280, 281, 48,
+	83, 322, 72, 51, 80, 15, 46, 100, 183, 372,
+	44, 217, 320, 284, 286, 397, 285, 186, 6, 275,
+	374, 30, 200, 42, 187, 398, 340, 88, 190, 73,
+	207, 146, 147, 5, 78, 9, 49, 52, 369, 370,
+	371, 33, 87, 12, 287, 408, 323, 333, 334, 335,
+	336, 337, 338, 175, 176, 177, 178, 179, 249, 195,
+	193, 197, 198, 449, 450, 19, -42, -334, 121, -74,
+	-124, 55, 91, -80, -79, 51, 52, -81, 51, -79,
+	41, 41, -75, -74, 54, -129, 399, 400, 56, 55,
+	121, -244, 109, 57, 55, -214, 314, 456, 58, 56,
+	55, -244, 190, 60, 60, 55, 18, 55, -66, 25,
+	26, -87, 192, -87, -87, -217, -218, 320, 24, -203,
+	52, -198, -199, -197, -201, 29, -87, -124, -124, -124,
+	-170, -164, -172, -167, -172, -168, 121, -150, -163, -217,
+	54, 129, 132, 132, 131, -210, 190, 54, 91, -237,
+	-237, -237, 29, -162, -52, -52, 51, 55, -88, 54,
+	56, 55, -124, -59, -60, -61, -185, -185, -185, -163,
+	-163, 109, 72, 83, -180, -190, -138, 21, 20, -138,
+	-138, -185, -138, 109, -191, -191, 56, 56, -266, 67,
+	-326, -327, 378, 379, 380, 381, 382, 383, 384, 385,
+	386, 387, 388, 280, 275, 281, 279, 273, 287, 282,
+	283, 149, 395, 396, 389, 390, 391, 392, 393, 394,
+	-138, -138, -138, -138, -327, -327, -138, -138, -138, -181,
+	-181, -181, -181, -181, -181, -181, -181, -181, -181, -181,
+	-181, -188, -195, -265, 54, 101, 99, 100, 85, -183,
+	-181, -181, 60, 60, 56, 55, -329, -328, 87, -185,
+	-326, -190, -185, -190, -190, 56, -191, -190, -181, -190,
+	-138, 28, 55, 54, 56, 55, 33, 121, 55, 91,
+	56, 55, -71, 121, 330, -163, 56, -70, -224, -185,
+	-185, 54, -185, 56, -74, 54, -185, -163, 11, 121,
+	121, -215, 16, 408, -162, -143, 190, -216, -294, 191,
+	372, -297, 344, -185, -185, -67, -75, 83, 54, -75,
+	-222, 408, 322, 321, 317, -219, -220, 316, 318, 315,
+	319, 51, 265, 266, 267, 268, -197, -149, 117, 228,
+	153, 54, -124, -170, -170, -172, -163, -222, 56, 132,
+	-216, -173, 60, -229, -87, -87, -1, -163, -126, 13,
+	55, 121, 72, 56, -185, -185, -185, 23, -191, 56,
+	56, 56, 56, 11, -185, -185, -185, -185, 55, 55,
+	-185, -185, -185, -191, -188, -183, -181, -181, -186, 204,
+	82, -185, -184, -328, 89, -185, 55, 52, 56, 11,
+	56, 56, 56, 52, 56, 55, 56, -185, 79, -192,
+	-292, -291, -290, 33, -53, -73, -285, -163, -323, -290,
+	-163, -156, -153, -161, -154, 67, -71, -74, 56, -130,
+	67, 71, 121, -216, 109, 109, 57, -162, 323, -162,
+	-216, -230, 408, 27, -303, 338, 333, 335, 23, 24,
+	-82, -83, -84, -89, -85, -143, -175, -86, 195, 193,
+	197, -319, 78, 198, 249, 79, 188, 23, -221, -223,
+	324, 325, 326, 327, 82, -220, 60, 60, 60, 60,
+	-87, -155, 91, -155, -155, -82, -124, -124, -170, -177,
+	-178, -176, 271, -280, 323, 314, 56, 56, -125, 14,
+	16, -61, -163, 109, 56, 56, 56, -90, -96, 118,
+	150, 203, 149, 148, 146, 166, 310, 311, 142, 143,
+	144, 141, 56, -185, 56, 56, 56, 56, -185, -185,
+	56, 56, 56, 56, -186, 82, -183, -180, 56, 90,
+	-185, 88, -90, -105, -185, -105, -181, 56, 9, 56,
+	55, -285, 56, 401, -162, 16, 23, -217, 294, 187,
+	-274, 451, -301, 333, 16, 16, -53, -87, 56, 55,
+	-91, -95, -92, -94, -93, -97, -96, 150, 151, 118,
+	154, 156, 157, 158, 159, 160, 161, 162, 163, 164,
+	166, 165, 30, 203, 146, 147, 148, 149, 167, 133,
+	152, 406, 175, 134, 176, 135, 177, 136, 178, 137,
+	138, 179, 139, -86, -163, 79, -318, -319, -200, -318,
+	79, 54, -53, -223, 67, 67, 67, 67, -220, 54,
+	-105, -107, -161, 60, 118, 60, 56, -124, -176, 272,
+	31, 120, 274, 29, 270, 16, -185, -191, 56, -267,
+	-269, 54, -268, 54, -267, -267, -267, -98, 138, 137,
+	-98, -271, 54, -271, -272, 54, -271, 56, 55, 55,
+	-180, -185, 56, 56, 56, 19, 56, 56, -225, -290,
+	56, -162, -162, -230, 295, -87, -112, 452, 60, 16,
+	60, -299, 60, -75, -103, -104, -121, 308, 219, -201,
+	223, 64, 224, 330, 225, 188, 227, 228, 229, 199,
+	230, 231, 232, 323, 233, 234, 235, 236, 291, 5,
+	261, -84, -102, -101, -99, 72, 83, 29, 308, -100,
+	64, 117, 242, 220, 224, 243, -120, -174, 193, 78,
+	79, 296, -175, -273, 311, 310, -267, -268, -269, -267,
+	-267, 54, 54, -267, -270, 54, -267, -267, -315, -316,
+	-163, -316, -163, -315, -315, -200, -185, -205, -207, -143,
+	54, 260, 67, -281, -173, 67, 67, 67, 67, -185,
+	-185, -185, -131, 358, -251, 328, -146, 453, 67, 60,
+	335, 23, -246, 209, 55, -121, -155, -155, -149, 117,
+	-155, -155, -155, -155, 226, 226, -155, -155, -155, -155,
+	-155, -155, -155, -155, -155, -155, -155, -155, -155, -155,
+	54, -99, 72, -181, 60, -107, -108, 29, 241, 237,
+	-109, 29, 221, 222, 60, -111, 54, 249, 79, 79,
+	-87, -275, 312, -145, 60, -145, 67, 54, 52, 258,
+	54, 54, 54, -316, 56, 56, 55, -267, -185, 60,
+	273, 56, 56, 56, 55, 56, 55, 56, 56, 56,
+	54, -252, 224, 60, -251, 54, 16, -53, 16, -121,
+	67, 67, -155, -155, 67, 60, 60, 60, -155, -155,
+	67, 60, -165, 67, 67, 67, 67, 29, 60, -110,
+	29, 237, 241, 238, 239, 240, 67, 29, 67, 29,
+	67, 29, -163, 54, -320, -321, 60, 67, 54, -206,
+	54, 56, 55, 56, 56, -205, -317, 265, 266, 267,
+	269, 268, -317, -205, -205, -205, 54, -232, -231, 250,
+	83, -208, -207, -66, 56, -251, 67, 67, 67, -295,
+	-250, 60, -252, -147, -163, -299, -247, 251, 252, -248,
+	-256, 254, -105, -105, 60, 60, -106, 220, -88, 56,
+	55, 91, 56, -185, -114, -113, 404, -205, 60, 56,
+	56, 56, 56, -205, 250, -209, 199, 64, 408, 263,
+	264, -66, -252, 56, 56, 56, -302, 338, -298, -296,
+	333, 334, 335, 336, 56, 55, -254, 255, 54, -250,
+	54, -250, 79, 266, 221, 222, 56, -321, 60, 56,
+	-118, -119, -116, -117, 51, 342, 247, 248, 56, -208,
+	-208, -208, -208, 56, -155, 60, 262, -295, -304, 191,
+	-300, 337, -296, 16, 335, 16, 16, -163, -249, 256,
+	67, -181, 54, -181, 54, -253, 253, 54, -231, -117,
+	51, -116, 51, 10, 9, -120, 67, -161, -304, -310,
+	54, 67, -301, 16, -299, 16, -299, -299, -258, 54,
+	16, 56, -245, 56, -245, 54, 91, -181, -115, 244,
+	245, 30, 131, -115, -314, 30, 56, -309, -308, -144,
+	-305, -163, 338, 60, -299, -259, -257, 209, -248, 56,
+	56, -245, 67, 56, 72, 29, 246, -313, -312, -311,
+	56, 55, 121, 56, 55, 57, -255, 257, 56, 55,
+	91, -308, -163, -257, -260, 33, 67, -312, 29, -185,
+	121, -264, -261, 54, -121, 211, -163, -264, -121, -263,
+	-262, 256, 212, 56, 55, 57, 54, -262, -261, -191,
+	56,
 }
 
 var yyDef = [...]int{
-	20, -2, 1, 2, 4, 5, 6, 7, 8, 9,
+	21, -2, 1, 2, 4, 5, 6, 7, 8, 9,
 	10, 11, 12, 13, 14, 15, 16, 17, 18, 19,
-	440, 441, 442, 0, 0, 273, 0, 227, 228, 229,
-	230, 231, 232, 233, 234, 235, 236, 209, 0, 0,
-	180, 160, 161, 162, 119, 120, 121, 122, 0, 0,
-	0, 333, -2, 443, 444, 445, 446, -2, 274, 275,
-	276, 277, 278, 198, 199, 200, -2, 0, 173, 0,
-	165, 165, 0, 353, 0, 0, 0, 364, 0, 373,
-	20, 311, 0, 316, 622, 658, 659, 660, 1312, 1313,
-	1314, 1315, 1316, 1317, 1318, 1319, 1320, 1321, 1322, 1323,
-	1324, 1325, 1326, 1327, 1328, 1329, 1330, 1331, 1332, 1333,
-	1334, 1335, 1336, 1337, 1338, 1339, 1340, 1341, 1342, 1343,
-	1344, 1345, 1346, 1347, 1152, 1153, 1154, 1155, 1156, 1157,
-	1158, 1159, 1160, 1161, 1162, 1163, 1164, 1165, 1166, 1167,
-	1168, 1169, 1170, 1171, 1172, 1173, 1174, 1175, 1176, 1177,
-	1178, 1179, 1180, 1181, 1182, 1183, 1184, 1185, 1186, 1187,
-	1188, 1189, 1190, 1191, 1192, 1193, 1194, 1195, 1196, 1197,
-	1198, 1199, 1200, 1201, 1202, 1203, 1204, 1205, 1206, 1207,
-	1208, 1209, 1210, 1211, 1212, 1213, 1214, 1215, 1216, 1217,
-	1218, 1219, 1220, 1221, 1222, 1223, 1224, 1225, 1226, 1227,
-	1228, 1229, 1230, 1231, 1232, 1233, 1234, 1235, 1236, 1237,
-	1238, 1239, 1240, 1241, 1242, 1243, 1244, 1245, 1246, 1247,
-	1248, 1249, 1250, 1251, 1252, 1253, 1254, 1255, 1256, 1257,
-	1258, 1259, 1260, 1261, 1262, 1263, 1264, 1265, 1266, 1267,
-	1268, 1269, 1270, 1271, 1272, 1273, 1274, 1275, 1276, 1277,
-	1278, 1279, 1280, 1281, 1282, 1283, 1284, 1285, 1286, 1287,
-	1288, 1289, 1290, 1291, 1292, 1293, 1294, 1295, 1296, 1297,
-	1298, 1299, 1300, 1301, 1302, 1303, 1304, 1305, 1306, 1307,
-	1308, 0, 189, 0, 0, 193, 0, 0, 0, 270,
-	185, 186, 187, 188, 0, 0, 395, 396, 419, 422,
-	425, 0, 179, 0, 0, 80, 488, 82, 490, 0,
-	86, 88, 89, -2, 93, 94, 95, 96, 97, 98,
-	99, 0, 101, 1201, 103, 1262, 106, 107, 108, 0,
-	117, 118, -2, -2, 485, 0, 0, 1251, 62, -2,
-	0, 0, 0, 369, 449, 519, 519, 0, 519, 532,
-	496, 497, 498, 517, 518, 0, 0, 246, 247, 0,
-	263, 254, 263, 0, 238, 239, 240, 244, 245, 264,
-	212, 174, 175, 164, 0, 169, 0, 163, 0, 0,
-	133, 0, 138, 0, 1200, 1266, 1216, 0, 1234, 0,
-	158, 151, 152, 997, 1162, 0, 348, 0, 354, 353,
-	353, 0, 353, 212, 212, 212, 212, 212, 341, 0,
-	343, 346, 0, 374, 375, 376, 377, 3, 0, 0,
-	315, 0, 382, 190, 661, 0, 0, 194, 195, 0,
-	0, 201, 0, 204, 1348, 1349, 1350, 0, 0, 0,
-	0, 0, 0, 0, 410, 0, 0, 409, 0, 0,
-	0, 0, 423, 424, 426, 0, 428, 429, 435, 436,
-	437, 438, 439, 0, 353, 76, 0, 0, 0, 0,
-	0, 492, 87, 116, 90, 91, 0, 111, 113, 115,
-	114, 100, 112, 102, 104, 105, 110, 76, 0, 0,
-	0, 63, 336, 0, 317, 0, 366, 368, 0, 370,
-	371, 0, 519, 450, 0, 0, 0, 0, 0, 0,
-	259, 260, 254, 254, 248, 256, 0, 261, 262, 0,
-	382, 0, 0, 0, 519, 0, 0, 0, 0, 167,
-	0, 172, 123, 128, 126, 127, 129, 0, 0, 0,
-	0, 0, 156, 157, 0, 0, 0, 0, 145, 148,
-	614, 615, 616, 149, 150, 0, 998, 999, 317, 349,
-	365, 367, 348, -2, 0, 362, 363, 0, 0, 0,
-	0, 0, 0, 342, 0, 0, 390, 384, 386, 430,
-	28, 0, 896, 658, 900, 1313, 1314, 1315, 1316, 1317,
-	1318, 1319, 1321, -2, -2, 1324, 1326, 1328, -2, -2,
-	-2, -2, 1335, -2, -2, 1339, 1340, 1345, 1346, 1347,
-	-2, -2, -2, -2, 909, 729, 730, 731, 732, 0,
-	0, 0, 0, 0, 739, 740, 0, 752, 0, 746,
-	747, 748, 749, 38, 39, 925, 926, 927, 928, 929,
-	930, 931, 863, 716, 0, 0, 0, 848, 838, 0,
-	858, 876, 877, 0, 0, 0, 0, 0, 40, 41,
-	854, 855, 856, 857, 859, 860, 861, 862, 864, 865,
-	866, 867, 870, 871, 872, 873, 874, 875, 878, 880,
-	850, 851, 852, 853, 842, 843, 844, 845, 846, 847,
-	285, 303, 287, 0, 292, 0, 623, 353, 0, 0,
-	191, 0, 196, 0, 0, 203, 205, 206, 207, 1351,
-	1352, 271, 0, 382, 182, 0, 413, 407, 0, 400,
-	411, 412, 403, 0, 405, 0, 401, 402, 346, 427,
-	421, 0, 77, 78, 79, 81, 92, 0, 0, 70,
-	473, 479, 476, 486, 489, 0, 84, 491, 109, 0,
-	65, 0, 0, 0, 337, 350, 28, 355, 356, 359,
-	0, 0, 460, 0, 487, 511, -2, 382, 382, 382,
-	254, 0, 256, 0, 256, 251, 255, 0, 265, 267,
-	0, 460, 1293, 213, 176, 177, 0, 0, 171, 0,
-	0, 130, 131, 132, 139, 134, 136, 0, 0, 140,
-	153, 154, 155, 309, 310, 0, 0, 0, 144, 0,
-	159, 335, 317, 339, 317, 279, 280, 0, 282, 620,
-	283, 433, 434, 344, 0, 0, 417, 382, 0, 391,
-	0, 387, 0, 0, 0, 431, 0, 0, 895, 0,
-	0, 914, 915, 916, 917, 918, 919, 888, 884, 884,
-	884, 0, 884, 0, 0, 824, 0, 0, 884, 884,
-	884, 884, 825, 884, 884, 884, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, -2, 890, 0, 735, 736, 737, 738,
-	741, 0, 753, 0, 882, 0, 888, 888, 888, 827,
-	0, 828, 839, 0, 831, 832, 833, 888, 0, 888,
-	837, 884, 286, 300, 0, 304, 0, 0, 296, 298,
-	291, 293, 0, 0, 313, 348, 383, 662, 0, 1004,
-	-2, 1006, -2, -2, 1008, 1009, 1010, 1011, 1012, 1013,
-	1014, 1015, 1016, 1017, 1018, 1019, 1020, 1021, 1022, 1023,
-	1024, 1025, 1026, 1027, 1028, 1029, 1030, 1031, 1032, 1033,
-	1034, 1035, 1036, 1037, 1038, 1039, 1040, 1041, 1042, 1043,
+	20, 464, 465, 466, 0, 0, 280, 0, 233, 234,
+	235, 236, 237, 238, 239, 240, 241, 242, 215, 0,
+	0, 186, 166, 167, 168, 125, 126, 127, 128, 0,
+	0, 0, 0, 345, -2, 467, 468, 469, 470, -2,
+	281, 282, 283, 284, 285, 286, 204, 205, 206, -2,
+	0, 179, 0, 171, 171, 0, 365, 0, 0, 0,
+	376, 0, 385, 21, 323, 0, 328, 648, 684, 685,
+	686, 1358, 1359, 1360, 1361, 1362, 1363, 1364, 1365, 1366,
+	1367, 1368, 1369, 1370, 1371, 1372, 1373, 1374, 1375, 1376,
+	1377, 1378, 1379, 1380, 1381, 1382, 1383, 1384, 1385, 1386,
+	1387, 1388, 1389, 1390, 1391, 1392, 1393, 1394, 1395, 1189,
+	1190, 1191, 1192, 1193, 1194, 1195, 1196, 1197, 1198, 1199,
+	1200, 1201, 1202, 1203, 1204, 1205, 1206, 1207, 1208, 1209,
+	1210, 1211, 1212, 1213, 1214, 1215, 1216, 1217, 1218, 1219,
+	1220, 1221, 1222, 1223, 1224, 1225, 1226, 1227, 1228, 1229,
+	1230, 1231, 1232, 1233, 1234, 1235, 1236, 1237, 1238, 1239,
+	1240, 1241, 1242, 1243, 1244, 1245, 1246, 1247, 1248, 1249,
+	1250, 1251, 1252, 1253, 1254, 1255, 1256, 1257, 1258, 1259,
+	1260, 1261, 1262, 1263, 1264, 1265, 1266, 1267, 1268, 1269,
+	1270, 1271, 1272, 1273, 1274, 1275, 1276, 1277, 1278, 1279,
+	1280, 1281, 1282, 1283, 1284, 1285, 1286, 1287, 1288, 1289,
+	1290, 1291, 1292, 1293, 1294, 1295, 1296, 1297, 1298, 1299,
+	1300, 1301, 1302, 1303, 1304, 1305, 1306, 1307, 1308, 1309,
+	1310, 1311, 1312, 1313, 1314, 1315, 1316, 1317, 1318, 1319,
+	1320, 1321, 1322, 1323, 1324, 1325, 1326, 1327, 1328, 1329,
+	1330, 1331, 1332, 1333, 1334, 1335, 1336, 1337, 1338, 1339,
+	1340, 1341, 1342, 1343, 1344, 1345, 1346, 1347, 1348, 1349,
+	1350, 1351, 1352, 1353, 0, 195, 0, 0, 199, 0,
+	0, 0, 277, 191, 192, 193, 194, 0, 0, 407,
+	408, 431, 438, 449, 449, 0, 648, 185, 0, 0,
+	86, 513, 88, 515, 0, 92, 94, 95, -2, 99,
+	100, 101, 102, 103, 104, 105, 0, 107, 1242, 109,
+	1305, 112, 113, 114, 0, 123, 124, -2, -2, 510,
+	0, 0, 1294, 68, 0, -2, 0, 0, 0, 381,
+	474, 0, 544, 544, 0, 544, 0, 558, 521, 522,
+	523, 542, 543, 0, 0, 252, 253, 0, 269, 260,
+	269, 0, 244, 245, 246, 250, 251, 270, 218, 180,
+	181, 170, 0, 175, 0, 169, 0, 0, 139, 0,
+	144, 0, 1241, 1309, 1257, 0, 1276, 0, 164, 157,
+	158, 1033, 1202, 0, 360, 0, 366, 365, 365, 0,
+	365, 218, 218, 218, 218, 218, 0, 218, 353, 0,
+	355, 358, 0, 386, 387, 388, 389, 3, 0, 0,
+	327, 0, 394, 196, 687, 0, 0, 200, 201, 0,
+	0, 207, 0, 210, 1396, 1397, 1398, 0, 0, 0,
+	0, 0, 0, 0, 422, 0, 0, 421, 0, 0,
+	0, 0, 439, 433, 450, 0, 452, 453, 459, 460,
+	461, 462, 463, 441, 450, 0, 0, 365, 923, 82,
+	0, 0, 0, 0, 0, 517, 93, 122, 96, 97,
+	0, 117, 119, 121, 120, 106, 118, 108, 110, 111,
+	116, 82, 0, 0, 0, 69, 0, 348, 0, 329,
+	0, 378, 380, 0, 382, 383, 0, 544, 475, 544,
+	0, 0, 0, 0, 0, 544, 0, 265, 266, 260,
+	260, 254, 262, 0, 267, 268, 0, 394, 0, 0,
+	0, 0, 544, 0, 0, 0, 0, 173, 0, 178,
+	129, 134, 132, 133, 135, 0, 0, 0, 0, 0,
+	162, 163, 0, 0, 0, 0, 151, 154, 640, 641,
+	642, 155, 156, 0, 1034, 1035, 329, 361, 377, 379,
+	360, -2, 0, 374, 375, 0, 0, 0, 0, 0,
+	218, 0, 0, 354, 0, 0, 402, 396, 398, 454,
+	34, 0, 931, 684, 935, 1359, 1360, 1361, 1362, 1363,
+	1364, 1365, 1366, 1367, -2, -2, 1370, 1372, 1374, -2,
+	-2, -2, -2, 1381, -2, -2, 1385, 1386, 1388, 1389,
+	1393, 1394, 1395, -2, -2, -2, -2, -2, -2, 944,
+	756, 757, 760, 761, 0, 0, 0, 0, 0, 768,
+	769, 0, 781, 0, 775, 776, 777, 778, 44, 45,
+	960, 961, 962, 963, 964, 965, 966, 896, 743, 0,
+	0, 0, 881, 871, 0, 891, 909, 910, 0, 0,
+	0, 0, 0, 46, 47, 887, 888, 889, 890, 892,
+	893, 894, 895, 897, 898, 899, 900, 903, 904, 905,
+	906, 907, 908, 911, 914, 915, 883, 884, 885, 886,
+	875, 876, 877, 878, 879, 880, 296, 315, 299, 0,
+	304, 0, 649, 365, 0, 0, 197, 0, 202, 0,
+	0, 209, 211, 212, 213, 1399, 1400, 278, 0, 394,
+	188, 0, 425, 419, 0, 412, 423, 424, 415, 0,
+	417, 0, 413, 414, 358, 0, 451, 440, 0, 451,
+	437, 0, 924, 925, 34, 0, 83, 84, 85, 87,
+	98, 0, 0, 76, 498, 504, 501, 511, 514, 0,
+	90, 516, 115, 0, 71, 0, 0, 22, 0, 349,
+	362, 367, 368, 371, 0, 0, 0, 485, 0, 512,
+	536, -2, 0, 394, 394, 394, 260, 0, 262, 0,
+	262, 257, 261, 0, 271, 274, 272, 0, 485, 1337,
+	219, 182, 183, 0, 0, 177, 0, 0, 136, 137,
+	138, 145, 140, 142, 0, 0, 146, 159, 160, 161,
+	321, 322, 0, 0, 0, 150, 0, 165, 347, 329,
+	351, 329, 287, 288, 0, 290, 646, 291, 0, 293,
+	457, 458, 356, 0, 0, 429, 394, 0, 403, 0,
+	399, 0, 0, 0, 455, 0, 0, 930, 0, 0,
+	949, 950, 951, 952, 953, 954, 923, 919, 919, 919,
+	0, 919, 0, 0, 0, 857, 0, 0, 919, 919,
+	919, 919, 858, 0, 0, 919, 919, 919, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, -2, 925, 0,
+	764, 765, 766, 767, 770, 0, 782, 0, 917, 0,
+	923, 923, 923, 860, 0, 861, 872, 0, 864, 865,
+	866, 923, 0, 923, 870, 919, 295, 0, 298, 312,
+	0, 316, 0, 0, 308, 310, 303, 305, 0, 0,
+	325, 360, 395, 688, 0, 1040, -2, 1042, -2, -2,
 	1044, 1045, 1046, 1047, 1048, 1049, 1050, 1051, 1052, 1053,
 	1054, 1055, 1056, 1057, 1058, 1059, 1060, 1061, 1062, 1063,
 	1064, 1065, 1066, 1067, 1068, 1069, 1070, 1071, 1072, 1073,
@@ -4218,126 +4394,137 @@ var yyDef = [...]int{
 	1114, 1115, 1116, 1117, 1118, 1119, 1120, 1121, 1122, 1123,
 	1124, 1125, 1126, 1127, 1128, 1129, 1130, 1131, 1132, 1133,
 	1134, 1135, 1136, 1137, 1138, 1139, 1140, 1141, 1142, 1143,
-	1144, 1145, 1146, 1147, 1148, 1149, 1150, 1151, 0, 197,
-	202, 0, 0, 353, 0, 0, 397, 414, 0, 0,
-	398, 0, 399, 404, 406, 420, 0, 71, 75, 0,
-	475, 0, 0, 478, 83, 0, 0, 0, 59, 319,
-	0, 0, 0, 0, 358, 360, 361, 346, 0, 0,
-	452, 461, 0, 520, 0, 0, 516, -2, 523, 0,
-	529, 237, 241, 242, 382, 257, 254, 258, 254, 256,
-	0, 266, 269, 452, 0, 178, 166, 168, 0, 125,
-	0, 0, 0, 141, 142, 143, 146, 147, 338, 340,
-	0, 0, 20, 347, 0, 380, 385, 392, 393, 892,
-	893, 894, 432, 29, 388, 897, 0, 899, 0, 889,
-	890, 0, 885, 886, 0, 0, 0, 0, 0, 0,
-	0, 840, 0, 924, 0, 795, 796, 797, 798, 799,
-	800, 801, 802, 803, 804, 805, 806, 807, 808, 809,
-	810, 811, 812, 813, 814, 815, 816, 817, 818, 819,
-	820, 821, 822, 823, 0, 0, 0, 0, 0, 0,
-	0, 717, 718, 719, 720, 721, 722, 723, 724, 725,
-	726, 727, 728, 901, 912, 913, 0, 0, 0, 0,
-	0, 910, 905, 0, 733, 0, 750, 754, 0, 0,
-	883, 0, 890, 0, 0, 849, 0, 0, 0, 0,
-	0, 303, 305, 0, 0, 303, 0, 0, 0, 312,
-	0, 284, 0, 0, 272, 208, 348, 183, 184, 415,
-	0, 408, 0, 0, 0, 474, 0, 0, 477, 85,
-	0, 67, 0, 60, 61, 323, 0, 351, 352, 29,
-	357, 0, 0, 624, 451, 0, 462, 463, 464, 465,
-	466, 0, 0, 0, 0, 0, 512, 513, 514, 515,
-	524, 1000, 1000, 1000, 0, 249, 382, 382, 254, 268,
-	214, 0, 170, 124, 0, 226, 135, 281, 621, 0,
-	418, 378, 0, 0, 0, 898, 787, 0, 0, 0,
-	0, 0, 0, 776, 770, 771, 841, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 902, 910, 906, 0,
-	903, 0, 0, 891, 0, 755, 0, 0, 0, 0,
-	788, 0, 792, 826, 829, 0, 834, 0, 836, 0,
-	301, 0, 306, 307, 303, 290, 297, 289, 299, 294,
-	295, 314, 663, 1005, 1002, 1003, 192, 181, 0, 69,
-	72, 73, 74, 480, 0, 481, 460, 66, 0, 0,
-	325, 48, 0, 0, 0, 0, 0, 0, 625, 626,
-	628, 629, 0, 0, 631, 685, 0, 640, 519, 640,
-	0, 0, 642, 643, 453, 454, 0, 0, 0, 0,
-	0, 468, 469, 470, 471, 472, 0, 0, 1001, 0,
-	0, 252, 250, 382, 210, 215, 216, 0, 220, 0,
-	0, 137, 345, 372, 0, 0, 394, 30, 389, 891,
-	772, 773, 774, 0, 757, 979, 983, 760, 979, 979,
-	979, 766, 766, 986, 986, 989, 986, 775, 0, 777,
-	778, 781, 779, 782, 783, 769, 887, 904, 0, 911,
-	907, 734, 742, 751, 0, 0, 0, 0, 0, 0,
-	780, 302, 0, 288, 416, 484, 0, 0, 67, 0,
-	0, 327, 0, 324, 0, 0, 0, 447, 346, -2,
-	0, -2, 992, 933, 934, 935, 979, 937, 983, 0,
-	979, 979, 965, 966, 967, 968, 969, 970, 971, 972,
-	973, 0, 0, 956, 979, 981, 979, 979, 976, 938,
-	939, 940, 941, 942, 943, 944, 945, 946, 947, 948,
-	949, 950, 951, 630, 686, 652, 652, 641, 652, 652,
-	519, 0, 455, 456, 457, 458, 459, 467, 0, 525,
-	526, 617, 618, 619, 527, 253, 217, 218, 219, 0,
-	222, 223, 225, 0, 379, 381, 743, 758, 980, 0,
-	759, 0, 761, 762, 763, 764, 767, 768, 765, 952,
-	0, 953, 954, 0, 955, 791, 908, 756, 744, 745,
-	789, 0, 830, 835, 308, 482, 483, 64, 68, 50,
-	329, 0, 326, 0, 320, 322, 58, 0, 533, -2,
-	570, 1000, 1000, 0, 1000, 1000, 1000, 1000, 0, 0,
-	1000, 1000, 1000, 1000, 1000, 1000, 1000, 1000, 1000, 1000,
-	1000, 1000, 1000, 1000, 0, 627, 654, -2, 666, 668,
-	0, 0, 671, 672, 0, 0, 0, 0, 708, 678,
-	0, 0, 922, 923, 0, 684, 995, 993, 994, 936,
-	961, 962, 963, 964, 0, 0, 957, 958, 0, 959,
-	960, 0, 644, 653, 0, 653, 0, 0, 652, 0,
-	0, 507, 979, 0, 0, 224, 211, 0, 0, 0,
-	0, 0, 44, 0, 318, 0, 328, 49, 0, 0,
-	530, 0, 528, 572, 0, 0, 1000, 1000, 0, 0,
-	0, 0, 1000, 1000, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 667,
-	669, 670, 673, 674, 675, 713, 714, 715, 676, 710,
-	711, 712, 677, 0, 0, 920, 921, 706, 932, 996,
-	0, 977, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 638, 500, 0, 359, 0, 221, 985, 984, 987,
-	0, 990, 0, 790, 42, 46, 51, 52, 0, 0,
-	0, 0, 0, 0, 448, 566, 571, 573, 574, 0,
-	0, 577, 578, 579, 580, 0, 0, 583, 584, 585,
-	586, 587, 588, 589, 590, 591, 592, 608, 609, 610,
-	611, 612, 613, 593, 594, 595, 596, 597, 598, 605,
-	0, 0, 602, 0, 0, 0, 701, 0, 974, 0,
-	975, 982, 0, 645, 647, 648, 649, 650, 651, 646,
-	0, 0, 0, 0, 637, 639, 681, 0, 499, 508,
-	509, 359, 0, 0, 31, 0, 48, 0, 53, 0,
-	0, 0, 0, 0, 331, 321, 555, 0, 0, 561,
-	0, 567, 575, 576, 581, 582, 599, 0, 0, 601,
-	0, 0, 709, 0, 688, 702, 0, 0, 978, 500,
-	500, 500, 500, 0, 682, 501, 1000, 0, 0, 505,
-	506, 510, 988, 991, 22, 0, 0, 45, 0, 54,
-	0, 56, 57, 330, 0, 535, 0, 0, 0, 0,
-	0, 564, 0, 606, 607, 600, 603, 604, 679, 687,
-	689, 690, 691, 0, 703, 704, 705, 707, 632, 633,
-	634, 635, 0, 0, 503, 0, 21, 0, 32, 0,
-	34, 36, 37, 655, 43, 47, 55, 332, 537, 0,
-	556, 0, 0, 0, 0, 0, 0, 0, 680, 692,
-	0, 693, 0, 0, 0, 636, 502, 504, 23, 24,
-	0, 33, 0, 0, 534, 0, 566, 557, 0, 559,
-	0, 0, 0, 0, 694, 696, 697, 0, 0, 695,
-	0, 0, 35, 656, 0, 539, 0, 553, 558, 560,
-	0, 565, 563, 698, 700, 699, 25, 26, 27, 0,
-	538, 0, 551, 536, 0, 562, 657, 540, -2, 0,
-	554, 541, -2, 0, 549, 0, 542, 550, 0, 545,
-	0, 0, 544, 0, -2, 0, 546, -2, 0, 552,
+	1144, 1145, 1146, 1147, 1148, 1149, 1150, 1151, 1152, 1153,
+	1154, 1155, 1156, 1157, 1158, 1159, 1160, 1161, 1162, 1163,
+	1164, 1165, 1166, 1167, 1168, 1169, 1170, 1171, 1172, 1173,
+	1174, 1175, 1176, 1177, 1178, 1179, 1180, 1181, 1182, 1183,
+	1184, 1185, 1186, 1187, 1188, 0, 203, 208, 0, 0,
+	365, 0, 0, 409, 426, 0, 0, 410, 0, 411,
+	416, 418, 432, 0, 0, 0, 443, 444, 436, 0,
+	0, 0, 77, 81, 0, 500, 0, 0, 503, 89,
+	0, 0, 0, 65, 331, 0, 0, 0, 370, 372,
+	373, 358, 0, 0, 358, 477, 486, 0, 545, 0,
+	0, 541, -2, 548, 0, 554, 0, 243, 247, 248,
+	394, 263, 260, 264, 260, 262, 0, 273, 276, 477,
+	0, 184, 172, 174, 0, 131, 0, 0, 0, 147,
+	148, 149, 152, 153, 350, 352, 0, 0, 292, 21,
+	359, 0, 392, 397, 404, 405, 927, 928, 929, 456,
+	35, 400, 932, 0, 934, 0, 0, 920, 921, 0,
+	0, 0, 0, 0, 0, 0, 856, 873, 0, 959,
+	0, 827, 828, 829, 830, 831, 832, 833, 834, 835,
+	836, 837, 838, 839, 840, 841, 842, 843, 844, 845,
+	846, 847, 848, 849, 850, 851, 852, 853, 854, 855,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 744,
+	745, 746, 747, 748, 749, 750, 751, 752, 753, 754,
+	755, 936, 947, 948, 0, 0, 0, 0, 0, 945,
+	940, 0, 758, 759, 762, 0, 779, 783, 0, 0,
+	918, 0, 925, 0, 0, 882, 0, 0, 0, 0,
+	0, 0, 315, 317, 0, 0, 315, 0, 0, 0,
+	324, 0, 294, 0, 0, 279, 214, 360, 189, 190,
+	427, 0, 420, 434, 0, 0, 926, 35, 0, 0,
+	0, 499, 0, 0, 502, 91, 0, 73, 0, 66,
+	67, 335, 0, 363, 364, 369, 0, 0, 650, 0,
+	476, 0, 487, 488, 489, 490, 491, 0, 0, 0,
+	0, 0, 537, 538, 539, 540, 549, 1036, 1036, 1036,
+	0, 650, 255, 394, 394, 260, 275, 220, 0, 176,
+	130, 0, 232, 141, 289, 647, 0, 430, 390, 0,
+	0, 0, 933, 817, 0, 0, 0, 0, 0, 806,
+	800, 801, 874, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 937, 945, 941, 0, 938, 0,
+	0, 926, 0, 784, 0, 0, 0, 0, 818, 0,
+	824, 859, 862, 0, 867, 0, 869, 0, 0, 313,
+	0, 318, 319, 315, 302, 309, 301, 311, 306, 307,
+	326, 689, 1041, 1038, 1039, 198, 187, 0, 435, 0,
+	445, 446, 0, 75, 78, 79, 80, 505, 0, 506,
+	485, 72, 0, 0, 337, 54, 0, 0, 0, 0,
+	0, 651, 652, 654, 655, 0, 0, 657, 712, 0,
+	666, 544, 666, 0, 0, 668, 669, 0, 478, 479,
+	0, 0, 0, 0, 0, 493, 494, 495, 496, 497,
+	0, 0, 1037, 0, 0, 0, 258, 256, 394, 216,
+	221, 222, 0, 226, 0, 0, 143, 357, 384, 0,
+	0, 406, 36, 401, 802, 803, 804, 0, 786, 1015,
+	1019, 789, 1015, 1015, 1015, 793, 796, 796, 1022, 1022,
+	1025, 1022, 805, 0, 807, 808, 811, 809, 0, 0,
+	812, 813, 799, 922, 939, 0, 946, 942, 763, 771,
+	780, 0, 0, 0, 0, 0, 0, 810, 0, 314,
+	0, 300, 428, 0, 509, 0, 0, 73, 0, 0,
+	339, 0, 336, 0, 0, 0, 471, 358, -2, 0,
+	-2, 1028, 968, 969, 970, 1015, 972, 1019, 0, 1015,
+	1015, 1000, 1001, 1002, 1003, 1004, 1005, 1006, 1007, 1008,
+	1009, 0, 0, 991, 1015, 1017, 1015, 1015, 1012, 973,
+	974, 975, 976, 977, 978, 979, 980, 981, 982, 983,
+	984, 985, 986, 656, 713, 678, 678, 667, 678, 678,
+	544, 0, 473, 480, 481, 482, 483, 484, 492, 0,
+	550, 551, 643, 644, 645, 552, 0, 259, 223, 224,
+	225, 0, 228, 229, 231, 0, 391, 393, 772, 787,
+	1016, 0, 788, 0, 790, 791, 792, 794, 797, 798,
+	795, 987, 0, 988, 989, 0, 990, 821, 0, 0,
+	943, 785, 773, 774, 819, 0, 863, 868, 297, 320,
+	447, 507, 508, 70, 74, 24, 341, 0, 338, 0,
+	332, 334, 64, 0, 559, -2, 596, 1036, 1036, 0,
+	1036, 1036, 1036, 1036, 0, 0, 1036, 1036, 1036, 1036,
+	1036, 1036, 1036, 1036, 1036, 1036, 1036, 1036, 1036, 1036,
+	0, 653, 680, -2, 692, 694, 0, 0, 697, 698,
+	0, 0, 0, 0, 0, 735, 705, 0, 0, 957,
+	958, 0, 711, 1031, 1029, 1030, 971, 996, 997, 998,
+	999, 0, 0, 992, 993, 0, 994, 995, 0, 670,
+	679, 0, 679, 0, 0, 678, 0, 0, 532, 1015,
+	0, 0, 0, 230, 217, 0, 0, 0, 0, 0,
+	0, 0, 442, 0, 26, 0, 24, 0, 340, 55,
+	0, 0, 555, 0, 553, 598, 0, 0, 1036, 1036,
+	0, 0, 0, 0, 1036, 1036, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 693, 695, 696, 699, 700, 701, 740, 741, 742,
+	702, 737, 738, 739, 703, 704, 0, 0, 955, 956,
+	733, 967, 1032, 0, 1013, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 664, 525, 0, 371, 0, 24,
+	227, 1021, 1020, 1023, 0, 1026, 0, 822, 823, 820,
+	0, 56, 0, 25, 26, 0, 0, 472, 592, 597,
+	599, 600, 0, 0, 603, 604, 605, 606, 0, 0,
+	609, 610, 611, 612, 613, 614, 615, 616, 617, 618,
+	634, 635, 636, 637, 638, 639, 619, 620, 621, 622,
+	623, 624, 631, 0, 0, 628, 0, 0, 0, 728,
+	0, 1010, 0, 1011, 1018, 0, 671, 673, 674, 675,
+	676, 677, 672, 0, 0, 0, 0, 663, 665, 708,
+	0, 524, 533, 534, 371, 26, 0, 0, 0, 50,
+	0, 27, 330, 0, 343, 333, 581, 0, 0, 587,
+	0, 593, 601, 602, 607, 608, 625, 0, 0, 627,
+	0, 0, 736, 0, 715, 729, 0, 0, 1014, 525,
+	525, 525, 525, 0, 709, 526, 1036, 0, 0, 530,
+	531, 535, 56, 1024, 1027, 448, 48, 52, 57, 58,
+	0, 0, 0, 0, 342, 0, 561, 0, 0, 0,
+	0, 0, 590, 0, 632, 633, 626, 629, 630, 706,
+	714, 716, 717, 718, 0, 730, 731, 732, 734, 658,
+	659, 660, 661, 0, 0, 528, 0, 48, 37, 0,
+	54, 0, 59, 0, 0, 0, 0, 344, 563, 0,
+	582, 0, 0, 0, 0, 0, 0, 0, 707, 719,
+	0, 720, 0, 0, 0, 662, 527, 529, 556, 28,
+	0, 0, 51, 0, 60, 0, 62, 63, 560, 0,
+	592, 583, 0, 585, 0, 0, 0, 0, 721, 723,
+	724, 0, 0, 722, 23, 0, 38, 0, 40, 42,
+	43, 681, 49, 53, 61, 0, 565, 0, 579, 584,
+	586, 0, 591, 589, 725, 727, 726, 29, 30, 0,
+	39, 0, 0, 564, 0, 577, 562, 0, 588, 0,
+	0, 41, 682, 566, -2, 0, 580, 31, 32, 33,
+	0, 567, -2, 0, 575, 0, 683, 568, 576, 0,
+	571, 0, 0, 570, 0, -2, 0, 572, -2, 0,
+	578,
 }
 
 var yyTok1 = [...]int{
 	1, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 82, 3, 3, 3, 110, 102, 3,
-	54, 56, 107, 105, 55, 106, 119, 108, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 442,
-	90, 89, 91, 3, 443, 3, 3, 3, 3, 3,
+	3, 3, 3, 84, 3, 3, 3, 112, 104, 3,
+	54, 56, 109, 107, 55, 108, 121, 110, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 455,
+	92, 91, 93, 3, 456, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 112, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 114, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 101, 3, 113,
+	3, 3, 3, 3, 103, 3, 115,
 }
 
 var yyTok2 = [...]int{
@@ -4348,10 +4535,10 @@ var yyTok2 = [...]int{
 	42, 43, 44, 45, 46, 47, 48, 49, 50, 51,
 	52, 53, 57, 58, 59, 60, 61, 62, 63, 64,
 	65, 66, 67, 68, 69, 70, 71, 72, 73, 74,
-	75, 76, 77, 78, 79, 80, 81, 83, 84, 85,
-	86, 87, 88, 92, 93, 94, 95, 96, 97, 98,
-	99, 100, 103, 104, 109, 111, 114, 115, 116, 117,
-	118, 120, 121, 122, 123, 124, 125, 126, 127, 128,
+	75, 76, 77, 78, 79, 80, 81, 82, 83, 85,
+	86, 87, 88, 89, 90, 94, 95, 96, 97, 98,
+	99, 100, 101, 102, 105, 106, 111, 113, 116, 117,
+	118, 119, 120, 122, 123, 124, 125, 126, 127, 128,
 	129, 130, 131, 132, 133, 134, 135, 136, 137, 138,
 	139, 140, 141, 142, 143, 144, 145, 146, 147, 148,
 	149, 150, 151, 152, 153, 154, 155, 156, 157, 158,
@@ -4403,7 +4590,10 @@ var yyTok3 = [...]int{
 	57750, 425, 57751, 426, 57752, 427, 57753, 428, 57754, 429,
 	57755, 430, 57756, 431, 57757, 432, 57758, 433, 57759, 434,
 	57760, 435, 57761, 436, 57762, 437, 57763, 438, 57764, 439,
-	57765, 440, 57766, 441, 0,
+	57765, 440, 57766, 441, 57767, 442, 57768, 443, 57769, 444,
+	57770, 445, 57771, 446, 57772, 447, 57773, 448, 57774, 449,
+	57775, 450, 57776, 451, 57777, 452, 57778, 453, 57779, 454,
+	0,
 }
 
 var yyErrorMessages = [...]struct {
@@ -4753,7 +4943,7 @@ yydefault:
 
 	case 2:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:502
+//line mysql_sql.y:515
 		{
 			if yyDollar[1].statementUnion() != nil {
 				yylex.(*Lexer).AppendStmt(yyDollar[1].statementUnion())
@@ -4761,82 +4951,132 @@ yydefault:
 		}
 	case 3:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:508
+//line mysql_sql.y:521
 		{
 			if yyDollar[3].statementUnion() != nil {
 				yylex.(*Lexer).AppendStmt(yyDollar[3].statementUnion())
 			}
 		}
-	case 19:
+	case 20:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:531
+//line mysql_sql.y:545
 		{
 			yyLOCAL = yyDollar[1].selectUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 20:
+	case 21:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:535
+//line mysql_sql.y:549
 		{
 			yyLOCAL = tree.Statement(nil)
 		}
 		yyVAL.union = yyLOCAL
-	case 21:
-		yyDollar = yyS[yypt-14 : yypt+1]
+	case 22:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:555
+		{
+			yyLOCAL = &tree.RefreshMaterializedView{Name: yyDollar[4].tableNameUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 23:
+		yyDollar = yyS[yypt-16 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:541
+//line mysql_sql.y:561
 		{
 			yyLOCAL = &tree.Load{
 				Local:             yyDollar[3].boolValUnion(),
 				File:              yyDollar[5].str,
 				DuplicateHandling: yyDollar[6].duplicateKeyUnion(),
 				Table:             yyDollar[9].tableNameUnion(),
-				Fields:            yyDollar[10].fieldsUnion(),
-				Lines:             yyDollar[11].linesUnion(),
-				IgnoredLines:      uint64(yyDollar[12].int64ValUnion()),
-				ColumnList:        yyDollar[13].loadColumnsUnion(),
-				Assignments:       yyDollar[14].updateExprsUnion(),
+				FileFormat:        yyDollar[10].str,
+				Compression:       yyDollar[11].str,
+				Fields:            yyDollar[12].fieldsUnion(),
+				Lines:             yyDollar[13].linesUnion(),
+				IgnoredLines:      uint64(yyDollar[14].int64ValUnion()),
+				ColumnList:        yyDollar[15].loadColumnsUnion(),
+				Assignments:       yyDollar[16].updateExprsUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 22:
+	case 24:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line mysql_sql.y:578
+		{
+			yyVAL.str = tree.CSV
+		}
+	case 25:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line mysql_sql.y:582
+		{
+			str := strings.ToLower(yyDollar[2].str)
+			switch str {
+			case tree.CSV, tree.JSONLINE, tree.PARQUET:
+				yyVAL.str = str
+			case "jsonl":
+				yyVAL.str = tree.JSONLINE
+			default:
+				yylex.Error("error file format")
+				return 1
+			}
+		}
+	case 26:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line mysql_sql.y:596
+		{
+			yyVAL.str = tree.AUTO
+		}
+	case 27:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line mysql_sql.y:600
+		{
+			str := strings.ToLower(yyDollar[2].str)
+			switch str {
+			case tree.AUTO, tree.NOCOMPRESS, tree.GZIP, tree.ZSTD, tree.LZ4:
+				yyVAL.str = str
+			default:
+				yylex.Error("error compression")
+				return 1
+			}
+		}
+	case 28:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:556
+//line mysql_sql.y:612
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 23:
+	case 29:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:560
+//line mysql_sql.y:616
 		{
 			yyLOCAL = yyDollar[2].updateExprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 24:
+	case 30:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:566
+//line mysql_sql.y:622
 		{
 			yyLOCAL = tree.UpdateExprs{yyDollar[1].updateExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 25:
+	case 31:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:570
+//line mysql_sql.y:626
 		{
 			yyLOCAL = append(yyDollar[1].updateExprsUnion(), yyDollar[3].updateExprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 26:
+	case 32:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UpdateExpr
-//line mysql_sql.y:576
+//line mysql_sql.y:632
 		{
 			yyLOCAL = &tree.UpdateExpr{
 				Names: []*tree.UnresolvedName{yyDollar[1].unresolvedNameUnion()},
@@ -4844,10 +5084,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 27:
+	case 33:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UpdateExpr
-//line mysql_sql.y:583
+//line mysql_sql.y:639
 		{
 			yyLOCAL = &tree.UpdateExpr{
 				Names: []*tree.UnresolvedName{yyDollar[1].unresolvedNameUnion()},
@@ -4855,58 +5095,58 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 28:
+	case 34:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:592
+//line mysql_sql.y:648
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 29:
+	case 35:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:596
+//line mysql_sql.y:652
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str, yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 30:
+	case 36:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:600
+//line mysql_sql.y:656
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str, yyDollar[3].str, yyDollar[5].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 31:
+	case 37:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:605
+//line mysql_sql.y:661
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 32:
+	case 38:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:609
+//line mysql_sql.y:665
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 33:
+	case 39:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:613
+//line mysql_sql.y:669
 		{
 			yyLOCAL = yyDollar[2].loadColumnsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 34:
+	case 40:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:619
+//line mysql_sql.y:675
 		{
 			switch yyDollar[1].loadColumnUnion().(type) {
 			case *tree.UnresolvedName:
@@ -4916,10 +5156,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 35:
+	case 41:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:628
+//line mysql_sql.y:684
 		{
 			switch yyDollar[3].loadColumnUnion().(type) {
 			case *tree.UnresolvedName:
@@ -4929,42 +5169,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 36:
+	case 42:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.LoadColumn
-//line mysql_sql.y:639
+//line mysql_sql.y:695
 		{
 			yyLOCAL = yyDollar[1].unresolvedNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 37:
+	case 43:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.LoadColumn
-//line mysql_sql.y:643
+//line mysql_sql.y:699
 		{
 			yyLOCAL = yyDollar[1].varExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 38:
+	case 44:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.VarExpr
-//line mysql_sql.y:649
+//line mysql_sql.y:705
 		{
 			yyLOCAL = yyDollar[1].varExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 39:
+	case 45:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.VarExpr
-//line mysql_sql.y:653
+//line mysql_sql.y:709
 		{
 			yyLOCAL = yyDollar[1].varExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 40:
+	case 46:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.VarExpr
-//line mysql_sql.y:659
+//line mysql_sql.y:715
 		{
 			vs := strings.Split(yyDollar[1].str, ".")
 			var isGlobal bool
@@ -4987,10 +5227,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 41:
+	case 47:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.VarExpr
-//line mysql_sql.y:683
+//line mysql_sql.y:739
 		{
 			vs := strings.Split(yyDollar[1].str, ".")
 			var r string
@@ -5009,34 +5249,34 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 42:
+	case 48:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:702
+//line mysql_sql.y:758
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 43:
+	case 49:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:706
+//line mysql_sql.y:762
 		{
 			yyLOCAL = yyDollar[2].item.(int64)
 		}
 		yyVAL.union = yyLOCAL
-	case 44:
+	case 50:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Lines
-//line mysql_sql.y:711
+//line mysql_sql.y:767
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 45:
+	case 51:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Lines
-//line mysql_sql.y:715
+//line mysql_sql.y:771
 		{
 			yyLOCAL = &tree.Lines{
 				StartingBy:   yyDollar[2].str,
@@ -5044,42 +5284,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 46:
+	case 52:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:723
+//line mysql_sql.y:779
 		{
 			yyVAL.str = ""
 		}
-	case 47:
+	case 53:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:727
+//line mysql_sql.y:783
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 48:
+	case 54:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:732
+//line mysql_sql.y:788
 		{
 			yyVAL.str = "\n"
 		}
-	case 49:
+	case 55:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:736
+//line mysql_sql.y:792
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 50:
+	case 56:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:741
+//line mysql_sql.y:797
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 51:
+	case 57:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:745
+//line mysql_sql.y:801
 		{
 			res := &tree.Fields{
 				Terminated: "\t",
@@ -5102,36 +5342,36 @@ yydefault:
 			yyLOCAL = res
 		}
 		yyVAL.union = yyLOCAL
-	case 52:
+	case 58:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Fields
-//line mysql_sql.y:769
+//line mysql_sql.y:825
 		{
 			yyLOCAL = []*tree.Fields{yyDollar[1].fieldsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 53:
+	case 59:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []*tree.Fields
-//line mysql_sql.y:773
+//line mysql_sql.y:829
 		{
 			yyLOCAL = append(yyDollar[1].fieldsListUnion(), yyDollar[2].fieldsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 54:
+	case 60:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:779
+//line mysql_sql.y:835
 		{
 			yyLOCAL = &tree.Fields{
 				Terminated: yyDollar[3].str,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 55:
+	case 61:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:785
+//line mysql_sql.y:841
 		{
 			str := yyDollar[4].str
 			if str != "\\" && len(str) > 1 {
@@ -5150,10 +5390,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 56:
+	case 62:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:803
+//line mysql_sql.y:859
 		{
 			str := yyDollar[3].str
 			if str != "\\" && len(str) > 1 {
@@ -5171,10 +5411,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 57:
+	case 63:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:820
+//line mysql_sql.y:876
 		{
 			str := yyDollar[3].str
 			if str != "\\" && len(str) > 1 {
@@ -5192,50 +5432,50 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 59:
+	case 65:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.DuplicateKey
-//line mysql_sql.y:843
+//line mysql_sql.y:899
 		{
 			yyLOCAL = &tree.DuplicateKeyError{}
 		}
 		yyVAL.union = yyLOCAL
-	case 60:
+	case 66:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.DuplicateKey
-//line mysql_sql.y:847
+//line mysql_sql.y:903
 		{
 			yyLOCAL = &tree.DuplicateKeyIgnore{}
 		}
 		yyVAL.union = yyLOCAL
-	case 61:
+	case 67:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.DuplicateKey
-//line mysql_sql.y:851
+//line mysql_sql.y:907
 		{
 			yyLOCAL = &tree.DuplicateKeyReplace{}
 		}
 		yyVAL.union = yyLOCAL
-	case 62:
+	case 68:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:856
+//line mysql_sql.y:912
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 63:
+	case 69:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:860
+//line mysql_sql.y:916
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 64:
+	case 70:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:866
+//line mysql_sql.y:922
 		{
 			yyLOCAL = &tree.Grant{
 				Privileges:  yyDollar[2].privilegesUnion(),
@@ -5246,10 +5486,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 65:
+	case 71:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:876
+//line mysql_sql.y:932
 		{
 			yyLOCAL = &tree.Grant{
 				IsGrantRole:      true,
@@ -5258,10 +5498,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 66:
+	case 72:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:884
+//line mysql_sql.y:940
 		{
 			yyLOCAL = &tree.Grant{
 				IsProxy:     true,
@@ -5271,26 +5511,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 67:
+	case 73:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:894
+//line mysql_sql.y:950
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 68:
+	case 74:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:898
+//line mysql_sql.y:954
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 69:
+	case 75:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:908
+//line mysql_sql.y:964
 		{
 			yyLOCAL = &tree.Revoke{
 				Privileges: yyDollar[2].privilegesUnion(),
@@ -5301,10 +5541,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 70:
+	case 76:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:918
+//line mysql_sql.y:974
 		{
 			yyLOCAL = &tree.Revoke{
 				IsRevokeRole:      true,
@@ -5313,30 +5553,30 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 71:
+	case 77:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:928
+//line mysql_sql.y:984
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level: tree.PRIVILEGE_LEVEL_TYPE_DATABASE,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 72:
+	case 78:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:934
+//line mysql_sql.y:990
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level: tree.PRIVILEGE_LEVEL_TYPE_GLOBAL,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 73:
+	case 79:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:940
+//line mysql_sql.y:996
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level:  tree.PRIVILEGE_LEVEL_TYPE_DATABASE,
@@ -5344,10 +5584,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 74:
+	case 80:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:947
+//line mysql_sql.y:1003
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level:   tree.PRIVILEGE_LEVEL_TYPE_TABLE,
@@ -5356,10 +5596,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 75:
+	case 81:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:955
+//line mysql_sql.y:1011
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level:   tree.PRIVILEGE_LEVEL_TYPE_TABLE,
@@ -5367,58 +5607,58 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 76:
+	case 82:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:963
+//line mysql_sql.y:1019
 		{
 			yyLOCAL = tree.OBJECT_TYPE_NONE
 		}
 		yyVAL.union = yyLOCAL
-	case 77:
+	case 83:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:967
+//line mysql_sql.y:1023
 		{
 			yyLOCAL = tree.OBJECT_TYPE_TABLE
 		}
 		yyVAL.union = yyLOCAL
-	case 78:
+	case 84:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:971
+//line mysql_sql.y:1027
 		{
 			yyLOCAL = tree.OBJECT_TYPE_FUNCTION
 		}
 		yyVAL.union = yyLOCAL
-	case 79:
+	case 85:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:975
+//line mysql_sql.y:1031
 		{
 			yyLOCAL = tree.OBJECT_TYPE_PROCEDURE
 		}
 		yyVAL.union = yyLOCAL
-	case 80:
+	case 86:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Privilege
-//line mysql_sql.y:981
+//line mysql_sql.y:1037
 		{
 			yyLOCAL = []*tree.Privilege{yyDollar[1].privilegeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 81:
+	case 87:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Privilege
-//line mysql_sql.y:985
+//line mysql_sql.y:1041
 		{
 			yyLOCAL = append(yyDollar[1].privilegesUnion(), yyDollar[3].privilegeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 82:
+	case 88:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Privilege
-//line mysql_sql.y:991
+//line mysql_sql.y:1047
 		{
 			yyLOCAL = &tree.Privilege{
 				Type:       yyDollar[1].privilegeTypeUnion(),
@@ -5426,10 +5666,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 83:
+	case 89:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Privilege
-//line mysql_sql.y:998
+//line mysql_sql.y:1054
 		{
 			yyLOCAL = &tree.Privilege{
 				Type:       yyDollar[1].privilegeTypeUnion(),
@@ -5437,418 +5677,418 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 84:
+	case 90:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.UnresolvedName
-//line mysql_sql.y:1007
+//line mysql_sql.y:1063
 		{
 			yyLOCAL = []*tree.UnresolvedName{yyDollar[1].unresolvedNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 85:
+	case 91:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.UnresolvedName
-//line mysql_sql.y:1011
+//line mysql_sql.y:1067
 		{
 			yyLOCAL = append(yyDollar[1].unresolveNamesUnion(), yyDollar[3].unresolvedNameUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 86:
+	case 92:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1017
+//line mysql_sql.y:1073
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALL
 		}
 		yyVAL.union = yyLOCAL
-	case 87:
+	case 93:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1021
+//line mysql_sql.y:1077
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALL
 		}
 		yyVAL.union = yyLOCAL
-	case 88:
+	case 94:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1025
+//line mysql_sql.y:1081
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALTER
 		}
 		yyVAL.union = yyLOCAL
-	case 89:
+	case 95:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1029
+//line mysql_sql.y:1085
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE
 		}
 		yyVAL.union = yyLOCAL
-	case 90:
+	case 96:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1033
+//line mysql_sql.y:1089
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_USER
 		}
 		yyVAL.union = yyLOCAL
-	case 91:
+	case 97:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1037
+//line mysql_sql.y:1093
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_TABLESPACE
 		}
 		yyVAL.union = yyLOCAL
-	case 92:
+	case 98:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1041
+//line mysql_sql.y:1097
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_TRIGGER
 		}
 		yyVAL.union = yyLOCAL
-	case 93:
+	case 99:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1045
+//line mysql_sql.y:1101
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_DELETE
 		}
 		yyVAL.union = yyLOCAL
-	case 94:
+	case 100:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1049
+//line mysql_sql.y:1105
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_DROP
 		}
 		yyVAL.union = yyLOCAL
-	case 95:
+	case 101:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1053
+//line mysql_sql.y:1109
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_EXECUTE
 		}
 		yyVAL.union = yyLOCAL
-	case 96:
+	case 102:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1057
+//line mysql_sql.y:1113
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_INDEX
 		}
 		yyVAL.union = yyLOCAL
-	case 97:
+	case 103:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1061
+//line mysql_sql.y:1117
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_INSERT
 		}
 		yyVAL.union = yyLOCAL
-	case 98:
+	case 104:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1065
+//line mysql_sql.y:1121
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SELECT
 		}
 		yyVAL.union = yyLOCAL
-	case 99:
+	case 105:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1069
+//line mysql_sql.y:1125
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SUPER
 		}
 		yyVAL.union = yyLOCAL
-	case 100:
+	case 106:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1073
+//line mysql_sql.y:1129
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SHOW_DATABASES
 		}
 		yyVAL.union = yyLOCAL
-	case 101:
+	case 107:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1077
+//line mysql_sql.y:1133
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_UPDATE
 		}
 		yyVAL.union = yyLOCAL
-	case 102:
+	case 108:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1081
+//line mysql_sql.y:1137
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_GRANT_OPTION
 		}
 		yyVAL.union = yyLOCAL
-	case 103:
+	case 109:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1085
+//line mysql_sql.y:1141
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_REFERENCES
 		}
 		yyVAL.union = yyLOCAL
-	case 104:
+	case 110:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1089
+//line mysql_sql.y:1145
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_REPLICATION_SLAVE
 		}
 		yyVAL.union = yyLOCAL
-	case 105:
+	case 111:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1093
+//line mysql_sql.y:1149
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_REPLICATION_CLIENT
 		}
 		yyVAL.union = yyLOCAL
-	case 106:
+	case 112:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1097
+//line mysql_sql.y:1153
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_USAGE
 		}
 		yyVAL.union = yyLOCAL
-	case 107:
+	case 113:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1101
+//line mysql_sql.y:1157
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_RELOAD
 		}
 		yyVAL.union = yyLOCAL
-	case 108:
+	case 114:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1105
+//line mysql_sql.y:1161
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_FILE
 		}
 		yyVAL.union = yyLOCAL
-	case 109:
+	case 115:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1109
+//line mysql_sql.y:1165
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_TEMPORARY_TABLES
 		}
 		yyVAL.union = yyLOCAL
-	case 110:
+	case 116:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1113
+//line mysql_sql.y:1169
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_LOCK_TABLES
 		}
 		yyVAL.union = yyLOCAL
-	case 111:
+	case 117:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1117
+//line mysql_sql.y:1173
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_VIEW
 		}
 		yyVAL.union = yyLOCAL
-	case 112:
+	case 118:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1121
+//line mysql_sql.y:1177
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SHOW_VIEW
 		}
 		yyVAL.union = yyLOCAL
-	case 113:
+	case 119:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1125
+//line mysql_sql.y:1181
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_ROLE
 		}
 		yyVAL.union = yyLOCAL
-	case 114:
+	case 120:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1129
+//line mysql_sql.y:1185
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_DROP_ROLE
 		}
 		yyVAL.union = yyLOCAL
-	case 115:
+	case 121:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1133
+//line mysql_sql.y:1189
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_ROUTINE
 		}
 		yyVAL.union = yyLOCAL
-	case 116:
+	case 122:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1137
+//line mysql_sql.y:1193
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALTER_ROUTINE
 		}
 		yyVAL.union = yyLOCAL
-	case 117:
+	case 123:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1141
+//line mysql_sql.y:1197
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_EVENT
 		}
 		yyVAL.union = yyLOCAL
-	case 118:
+	case 124:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1145
+//line mysql_sql.y:1201
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SHUTDOWN
 		}
 		yyVAL.union = yyLOCAL
-	case 123:
+	case 129:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1157
+//line mysql_sql.y:1213
 		{
 			yyLOCAL = yyDollar[3].setRoleUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 124:
+	case 130:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1163
+//line mysql_sql.y:1219
 		{
 			dr := yyDollar[4].setDefaultRoleUnion()
 			dr.Users = yyDollar[6].usersUnion()
 			yyLOCAL = dr
 		}
 		yyVAL.union = yyLOCAL
-	case 125:
+	case 131:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.SetRole
-//line mysql_sql.y:1171
+//line mysql_sql.y:1227
 		{
 			yyLOCAL = &tree.SetRole{Type: tree.SET_ROLE_TYPE_ALL_EXCEPT, Roles: yyDollar[3].rolesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 126:
+	case 132:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetRole
-//line mysql_sql.y:1175
+//line mysql_sql.y:1231
 		{
 			yyLOCAL = &tree.SetRole{Type: tree.SET_ROLE_TYPE_DEFAULT, Roles: nil}
 		}
 		yyVAL.union = yyLOCAL
-	case 127:
+	case 133:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetRole
-//line mysql_sql.y:1179
+//line mysql_sql.y:1235
 		{
 			yyLOCAL = &tree.SetRole{Type: tree.SET_ROLE_TYPE_NONE, Roles: nil}
 		}
 		yyVAL.union = yyLOCAL
-	case 128:
+	case 134:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetRole
-//line mysql_sql.y:1183
+//line mysql_sql.y:1239
 		{
 			yyLOCAL = &tree.SetRole{Type: tree.SET_ROLE_TYPE_ALL, Roles: nil}
 		}
 		yyVAL.union = yyLOCAL
-	case 129:
+	case 135:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetRole
-//line mysql_sql.y:1187
+//line mysql_sql.y:1243
 		{
 			yyLOCAL = &tree.SetRole{Type: tree.SET_ROLE_TYPE_NORMAL, Roles: yyDollar[1].rolesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 130:
+	case 136:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetDefaultRole
-//line mysql_sql.y:1193
+//line mysql_sql.y:1249
 		{
 			yyLOCAL = &tree.SetDefaultRole{Type: tree.SET_DEFAULT_ROLE_TYPE_NONE, Roles: nil}
 		}
 		yyVAL.union = yyLOCAL
-	case 131:
+	case 137:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetDefaultRole
-//line mysql_sql.y:1197
+//line mysql_sql.y:1253
 		{
 			yyLOCAL = &tree.SetDefaultRole{Type: tree.SET_DEFAULT_ROLE_TYPE_ALL, Roles: nil}
 		}
 		yyVAL.union = yyLOCAL
-	case 132:
+	case 138:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetDefaultRole
-//line mysql_sql.y:1201
+//line mysql_sql.y:1257
 		{
 			yyLOCAL = &tree.SetDefaultRole{Type: tree.SET_DEFAULT_ROLE_TYPE_NORMAL, Roles: yyDollar[1].rolesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 133:
+	case 139:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1207
+//line mysql_sql.y:1263
 		{
 			yyLOCAL = &tree.SetVar{Assignments: yyDollar[2].varAssignmentExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 134:
+	case 140:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1213
+//line mysql_sql.y:1269
 		{
 			yyLOCAL = &tree.SetPassword{Password: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 135:
+	case 141:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1217
+//line mysql_sql.y:1273
 		{
 			yyLOCAL = &tree.SetPassword{User: yyDollar[4].userUnion(), Password: yyDollar[6].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 137:
+	case 143:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line mysql_sql.y:1224
+//line mysql_sql.y:1280
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 138:
+	case 144:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.VarAssignmentExpr
-//line mysql_sql.y:1230
+//line mysql_sql.y:1286
 		{
 			yyLOCAL = []*tree.VarAssignmentExpr{yyDollar[1].varAssignmentExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 139:
+	case 145:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.VarAssignmentExpr
-//line mysql_sql.y:1234
+//line mysql_sql.y:1290
 		{
 			yyLOCAL = append(yyDollar[1].varAssignmentExprsUnion(), yyDollar[3].varAssignmentExprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 140:
+	case 146:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1240
+//line mysql_sql.y:1296
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				System: true,
@@ -5857,10 +6097,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 141:
+	case 147:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1248
+//line mysql_sql.y:1304
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				System: true,
@@ -5870,10 +6110,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 142:
+	case 148:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1257
+//line mysql_sql.y:1313
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				System: true,
@@ -5882,10 +6122,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 143:
+	case 149:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1265
+//line mysql_sql.y:1321
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				System: true,
@@ -5894,10 +6134,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 144:
+	case 150:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1273
+//line mysql_sql.y:1329
 		{
 			vs := strings.Split(yyDollar[1].str, ".")
 			var isGlobal bool
@@ -5921,10 +6161,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 145:
+	case 151:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1296
+//line mysql_sql.y:1352
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:  yyDollar[1].str,
@@ -5932,10 +6172,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 146:
+	case 152:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1303
+//line mysql_sql.y:1359
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:  yyDollar[1].str,
@@ -5943,10 +6183,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 147:
+	case 153:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1310
+//line mysql_sql.y:1366
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:     yyDollar[1].str,
@@ -5955,10 +6195,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 148:
+	case 154:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1318
+//line mysql_sql.y:1374
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:  yyDollar[1].str,
@@ -5966,10 +6206,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 149:
+	case 155:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1325
+//line mysql_sql.y:1381
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:  yyDollar[1].str,
@@ -5977,10 +6217,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 150:
+	case 156:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1332
+//line mysql_sql.y:1388
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:  yyDollar[1].str,
@@ -5988,198 +6228,198 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 153:
+	case 159:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:1345
+//line mysql_sql.y:1401
 		{
 			yyLOCAL = tree.NewNumVal(constant.MakeString(yyDollar[1].str), yyDollar[1].str, false)
 		}
 		yyVAL.union = yyLOCAL
-	case 154:
+	case 160:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:1349
+//line mysql_sql.y:1405
 		{
 			yyLOCAL = tree.NewNumVal(constant.MakeString(yyDollar[1].str), yyDollar[1].str, false)
 		}
 		yyVAL.union = yyLOCAL
-	case 155:
+	case 161:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:1353
+//line mysql_sql.y:1409
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 156:
+	case 162:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:1359
+//line mysql_sql.y:1415
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 157:
+	case 163:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:1363
+//line mysql_sql.y:1419
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 159:
+	case 165:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:1370
+//line mysql_sql.y:1426
 		{
 			yyVAL.str = yyDollar[1].str + "." + yyDollar[3].str
 		}
-	case 163:
+	case 169:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1381
+//line mysql_sql.y:1437
 		{
 			yyLOCAL = &tree.RollbackTransaction{Type: yyDollar[2].completionTypeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 164:
+	case 170:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1387
+//line mysql_sql.y:1443
 		{
 			yyLOCAL = &tree.CommitTransaction{Type: yyDollar[2].completionTypeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 165:
+	case 171:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1392
+//line mysql_sql.y:1448
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_NO_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 166:
+	case 172:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1396
+//line mysql_sql.y:1452
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 167:
+	case 173:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1400
+//line mysql_sql.y:1456
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 168:
+	case 174:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1404
+//line mysql_sql.y:1460
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_RELEASE
 		}
 		yyVAL.union = yyLOCAL
-	case 169:
+	case 175:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1408
+//line mysql_sql.y:1464
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_RELEASE
 		}
 		yyVAL.union = yyLOCAL
-	case 170:
+	case 176:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1412
+//line mysql_sql.y:1468
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_NO_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 171:
+	case 177:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1416
+//line mysql_sql.y:1472
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_NO_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 172:
+	case 178:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1420
+//line mysql_sql.y:1476
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_NO_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 173:
+	case 179:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1426
+//line mysql_sql.y:1482
 		{
 			yyLOCAL = &tree.BeginTransaction{}
 		}
 		yyVAL.union = yyLOCAL
-	case 174:
+	case 180:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1430
+//line mysql_sql.y:1486
 		{
 			yyLOCAL = &tree.BeginTransaction{}
 		}
 		yyVAL.union = yyLOCAL
-	case 175:
+	case 181:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1434
+//line mysql_sql.y:1490
 		{
 			yyLOCAL = &tree.BeginTransaction{}
 		}
 		yyVAL.union = yyLOCAL
-	case 176:
+	case 182:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1438
+//line mysql_sql.y:1494
 		{
 			m := tree.MakeTransactionModes(tree.READ_WRITE_MODE_READ_WRITE)
 			yyLOCAL = &tree.BeginTransaction{Modes: m}
 		}
 		yyVAL.union = yyLOCAL
-	case 177:
+	case 183:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1443
+//line mysql_sql.y:1499
 		{
 			m := tree.MakeTransactionModes(tree.READ_WRITE_MODE_READ_ONLY)
 			yyLOCAL = &tree.BeginTransaction{Modes: m}
 		}
 		yyVAL.union = yyLOCAL
-	case 178:
+	case 184:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1448
+//line mysql_sql.y:1504
 		{
 			yyLOCAL = &tree.BeginTransaction{}
 		}
 		yyVAL.union = yyLOCAL
-	case 179:
+	case 185:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1454
+//line mysql_sql.y:1510
 		{
 			yyLOCAL = &tree.Use{Name: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 180:
+	case 186:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1458
+//line mysql_sql.y:1514
 		{
 			yyLOCAL = &tree.Use{}
 		}
 		yyVAL.union = yyLOCAL
-	case 181:
+	case 187:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1464
+//line mysql_sql.y:1520
 		{
 			yyLOCAL = &tree.Update{
 				Table:   yyDollar[2].tableExprUnion(),
@@ -6190,84 +6430,84 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 182:
+	case 188:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:1477
+//line mysql_sql.y:1533
 		{
 			yyLOCAL = tree.UpdateExprs{yyDollar[1].updateExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 183:
+	case 189:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:1481
+//line mysql_sql.y:1537
 		{
 			yyLOCAL = append(yyDollar[1].updateExprsUnion(), yyDollar[3].updateExprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 184:
+	case 190:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UpdateExpr
-//line mysql_sql.y:1487
+//line mysql_sql.y:1543
 		{
 			yyLOCAL = &tree.UpdateExpr{Names: []*tree.UnresolvedName{yyDollar[1].unresolvedNameUnion()}, Expr: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 188:
+	case 194:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1496
+//line mysql_sql.y:1552
 		{
 			yyLOCAL = yyDollar[1].selectUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 189:
+	case 195:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1502
+//line mysql_sql.y:1558
 		{
 			st := &tree.ShowColumns{Table: yyDollar[2].unresolvedObjectNameUnion()}
 			yyLOCAL = tree.NewExplainStmt(st, "")
 		}
 		yyVAL.union = yyLOCAL
-	case 190:
+	case 196:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1507
+//line mysql_sql.y:1563
 		{
 			st := &tree.ShowColumns{Table: yyDollar[2].unresolvedObjectNameUnion(), ColName: yyDollar[3].unresolvedNameUnion()}
 			yyLOCAL = tree.NewExplainStmt(st, "")
 		}
 		yyVAL.union = yyLOCAL
-	case 191:
+	case 197:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1512
+//line mysql_sql.y:1568
 		{
 			yyLOCAL = tree.NewExplainFor("", uint64(yyDollar[4].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 192:
+	case 198:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1516
+//line mysql_sql.y:1572
 		{
 			yyLOCAL = tree.NewExplainFor(yyDollar[4].str, uint64(yyDollar[7].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 193:
+	case 199:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1520
+//line mysql_sql.y:1576
 		{
 			yyLOCAL = tree.NewExplainStmt(yyDollar[2].statementUnion(), "text")
 		}
 		yyVAL.union = yyLOCAL
-	case 194:
+	case 200:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1524
+//line mysql_sql.y:1580
 		{
 			explainStmt := tree.NewExplainStmt(yyDollar[3].statementUnion(), "text")
 			optionElem := tree.MakeOptionElem("verbose", "NULL")
@@ -6277,10 +6517,10 @@ yydefault:
 
 		}
 		yyVAL.union = yyLOCAL
-	case 195:
+	case 201:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1533
+//line mysql_sql.y:1589
 		{
 			explainStmt := tree.NewExplainStmt(yyDollar[3].statementUnion(), "text")
 			optionElem := tree.MakeOptionElem("analyze", "NULL")
@@ -6289,10 +6529,10 @@ yydefault:
 			yyLOCAL = explainStmt
 		}
 		yyVAL.union = yyLOCAL
-	case 196:
+	case 202:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1541
+//line mysql_sql.y:1597
 		{
 			explainStmt := tree.NewExplainStmt(yyDollar[4].statementUnion(), "text")
 			optionElem1 := tree.MakeOptionElem("analyze", "NULL")
@@ -6303,76 +6543,76 @@ yydefault:
 			yyLOCAL = explainStmt
 		}
 		yyVAL.union = yyLOCAL
-	case 197:
+	case 203:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1551
+//line mysql_sql.y:1607
 		{
 			explainStmt := tree.NewExplainStmt(yyDollar[5].statementUnion(), "text")
 			explainStmt.Options = yyDollar[3].epxlainOptionsUnion()
 			yyLOCAL = explainStmt
 		}
 		yyVAL.union = yyLOCAL
-	case 201:
+	case 207:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.OptionElem
-//line mysql_sql.y:1564
+//line mysql_sql.y:1620
 		{
 			yyLOCAL = tree.MakeOptions(yyDollar[1].epxlainOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 202:
+	case 208:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.OptionElem
-//line mysql_sql.y:1568
+//line mysql_sql.y:1624
 		{
 			yyLOCAL = append(yyDollar[1].epxlainOptionsUnion(), yyDollar[3].epxlainOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 203:
+	case 209:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.OptionElem
-//line mysql_sql.y:1574
+//line mysql_sql.y:1630
 		{
 			yyLOCAL = tree.MakeOptionElem(yyDollar[1].str, yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 204:
+	case 210:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:1580
+//line mysql_sql.y:1636
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 205:
+	case 211:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:1585
+//line mysql_sql.y:1641
 		{
 			yyVAL.str = "true"
 		}
-	case 206:
+	case 212:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:1586
+//line mysql_sql.y:1642
 		{
 			yyVAL.str = "false"
 		}
-	case 207:
+	case 213:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:1587
+//line mysql_sql.y:1643
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 208:
+	case 214:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1592
+//line mysql_sql.y:1648
 		{
 			yyLOCAL = tree.NewAnalyzeStmt(yyDollar[3].tableNameUnion(), yyDollar[5].identifierListUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 210:
+	case 216:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1602
+//line mysql_sql.y:1658
 		{
 			yyLOCAL = &tree.AlterUser{
 				IfExists:   yyDollar[3].boolValUnion(),
@@ -6384,10 +6624,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 211:
+	case 217:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1613
+//line mysql_sql.y:1669
 		{
 			auth := &tree.User{
 				AuthString: yyDollar[9].str,
@@ -6400,118 +6640,118 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 212:
+	case 218:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1626
+//line mysql_sql.y:1682
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 213:
+	case 219:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1630
+//line mysql_sql.y:1686
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 214:
+	case 220:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.UserMiscOption
-//line mysql_sql.y:1635
+//line mysql_sql.y:1691
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 215:
+	case 221:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.UserMiscOption
-//line mysql_sql.y:1639
+//line mysql_sql.y:1695
 		{
 			yyLOCAL = yyDollar[1].userMiscOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 216:
+	case 222:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.UserMiscOption
-//line mysql_sql.y:1645
+//line mysql_sql.y:1701
 		{
 			yyLOCAL = []tree.UserMiscOption{yyDollar[1].userMiscOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 217:
+	case 223:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.UserMiscOption
-//line mysql_sql.y:1649
+//line mysql_sql.y:1705
 		{
 			yyLOCAL = append(yyDollar[1].userMiscOptionsUnion(), yyDollar[2].userMiscOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 218:
+	case 224:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1655
+//line mysql_sql.y:1711
 		{
 			yyLOCAL = &tree.UserMiscOptionAccountUnlock{}
 		}
 		yyVAL.union = yyLOCAL
-	case 219:
+	case 225:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1659
+//line mysql_sql.y:1715
 		{
 			yyLOCAL = &tree.UserMiscOptionAccountLock{}
 		}
 		yyVAL.union = yyLOCAL
-	case 220:
+	case 226:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1663
+//line mysql_sql.y:1719
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordExpireNone{}
 		}
 		yyVAL.union = yyLOCAL
-	case 221:
+	case 227:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1667
+//line mysql_sql.y:1723
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordExpireInterval{Value: yyDollar[3].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 222:
+	case 228:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1671
+//line mysql_sql.y:1727
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordExpireNever{}
 		}
 		yyVAL.union = yyLOCAL
-	case 223:
+	case 229:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1675
+//line mysql_sql.y:1731
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordExpireDefault{}
 		}
 		yyVAL.union = yyLOCAL
-	case 224:
+	case 230:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:1681
+//line mysql_sql.y:1737
 		{
 			yyVAL.item = nil
 		}
-	case 225:
+	case 231:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:1686
+//line mysql_sql.y:1742
 		{
 			yyVAL.item = nil
 		}
-	case 237:
+	case 243:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1707
+//line mysql_sql.y:1763
 		{
 			yyLOCAL = &tree.ShowIndex{
 				TableName: *yyDollar[4].tableNameUnion(),
@@ -6519,10 +6759,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 241:
+	case 247:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1721
+//line mysql_sql.y:1777
 		{
 			yyLOCAL = &tree.ShowVariables{
 				Global: yyDollar[2].boolValUnion(),
@@ -6531,10 +6771,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 242:
+	case 248:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1731
+//line mysql_sql.y:1787
 		{
 			yyLOCAL = &tree.ShowStatus{
 				Global: yyDollar[2].boolValUnion(),
@@ -6543,58 +6783,58 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 243:
+	case 249:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1740
+//line mysql_sql.y:1796
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 244:
+	case 250:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1744
+//line mysql_sql.y:1800
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 245:
+	case 251:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1748
+//line mysql_sql.y:1804
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 246:
+	case 252:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1754
+//line mysql_sql.y:1810
 		{
 			yyLOCAL = &tree.ShowWarnings{}
 		}
 		yyVAL.union = yyLOCAL
-	case 247:
+	case 253:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1760
+//line mysql_sql.y:1816
 		{
 			yyLOCAL = &tree.ShowErrors{}
 		}
 		yyVAL.union = yyLOCAL
-	case 248:
+	case 254:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1766
+//line mysql_sql.y:1822
 		{
 			yyLOCAL = &tree.ShowProcessList{Full: yyDollar[2].fullOptUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 249:
+	case 255:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1772
+//line mysql_sql.y:1828
 		{
 			yyLOCAL = &tree.ShowTables{
 				Open:   false,
@@ -6605,10 +6845,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 250:
+	case 256:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1782
+//line mysql_sql.y:1838
 		{
 			yyLOCAL = &tree.ShowTables{
 				Open:   true,
@@ -6619,18 +6859,18 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 251:
+	case 257:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1794
+//line mysql_sql.y:1850
 		{
 			yyLOCAL = &tree.ShowDatabases{Like: yyDollar[3].comparisionExprUnion(), Where: yyDollar[4].whereUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 252:
+	case 258:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1800
+//line mysql_sql.y:1856
 		{
 			yyLOCAL = &tree.ShowColumns{
 				Ext:   false,
@@ -6643,10 +6883,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 253:
+	case 259:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1812
+//line mysql_sql.y:1868
 		{
 			yyLOCAL = &tree.ShowColumns{
 				Ext:   true,
@@ -6659,118 +6899,126 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 254:
+	case 260:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.ComparisonExpr
-//line mysql_sql.y:1825
+//line mysql_sql.y:1881
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 255:
+	case 261:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.ComparisonExpr
-//line mysql_sql.y:1829
+//line mysql_sql.y:1885
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.LIKE, nil, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 256:
+	case 262:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:1834
+//line mysql_sql.y:1890
 		{
 			yyVAL.str = ""
 		}
-	case 257:
+	case 263:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:1838
+//line mysql_sql.y:1894
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 258:
+	case 264:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:1844
+//line mysql_sql.y:1900
 		{
 			yyLOCAL = yyDollar[2].unresolvedObjectNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 263:
+	case 269:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1857
+//line mysql_sql.y:1913
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 264:
+	case 270:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1861
+//line mysql_sql.y:1917
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 265:
+	case 271:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1867
+//line mysql_sql.y:1923
 		{
 			yyLOCAL = &tree.ShowCreateTable{Name: yyDollar[4].unresolvedObjectNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 266:
+	case 272:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:1927
+		{
+			yyLOCAL = &tree.ShowCreateView{Name: yyDollar[4].unresolvedObjectNameUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 273:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1871
+//line mysql_sql.y:1931
 		{
 			yyLOCAL = &tree.ShowCreateDatabase{IfNotExists: yyDollar[4].ifNotExistsUnion(), Name: yyDollar[5].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 267:
+	case 274:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:1877
+//line mysql_sql.y:1937
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(1, [3]string{yyDollar[1].str})
 		}
 		yyVAL.union = yyLOCAL
-	case 268:
+	case 275:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:1881
+//line mysql_sql.y:1941
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(2, [3]string{yyDollar[3].str, yyDollar[1].str})
 		}
 		yyVAL.union = yyLOCAL
-	case 270:
+	case 277:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:1890
+//line mysql_sql.y:1950
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(1, [3]string{yyDollar[1].str})
 		}
 		yyVAL.union = yyLOCAL
-	case 271:
+	case 278:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:1894
+//line mysql_sql.y:1954
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(2, [3]string{yyDollar[3].str, yyDollar[1].str})
 		}
 		yyVAL.union = yyLOCAL
-	case 272:
+	case 279:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:1898
+//line mysql_sql.y:1958
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(3, [3]string{yyDollar[5].str, yyDollar[3].str, yyDollar[1].str})
 		}
 		yyVAL.union = yyLOCAL
-	case 279:
+	case 287:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1914
+//line mysql_sql.y:1975
 		{
 			yyLOCAL = &tree.DropUser{
 				IfExists: yyDollar[3].boolValUnion(),
@@ -6778,10 +7026,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 280:
+	case 288:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1923
+//line mysql_sql.y:1984
 		{
 			yyLOCAL = &tree.DropRole{
 				IfExists: yyDollar[3].boolValUnion(),
@@ -6789,10 +7037,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 281:
+	case 289:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1932
+//line mysql_sql.y:1993
 		{
 			yyLOCAL = &tree.DropIndex{
 				Name:      tree.Identifier(yyDollar[4].str),
@@ -6801,26 +7049,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 282:
+	case 290:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1942
+//line mysql_sql.y:2003
 		{
 			yyLOCAL = &tree.DropTable{IfExists: yyDollar[3].boolValUnion(), Names: yyDollar[4].tableNamesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 283:
+	case 291:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:2009
+		{
+			yyLOCAL = &tree.DropView{IfExists: yyDollar[3].boolValUnion(), Names: yyDollar[4].tableNamesUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 292:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:2013
+		{
+			yyLOCAL = &tree.DropView{IfExists: yyDollar[4].boolValUnion(), Names: yyDollar[5].tableNamesUnion(), Materialized: true}
+		}
+		yyVAL.union = yyLOCAL
+	case 293:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1948
+//line mysql_sql.y:2019
 		{
 			yyLOCAL = &tree.DropDatabase{Name: tree.Identifier(yyDollar[4].str), IfExists: yyDollar[3].boolValUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 284:
+	case 294:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1954
+//line mysql_sql.y:2025
 		{
 			yyLOCAL = &tree.Delete{
 				Table:   yyDollar[3].tableExprUnion(),
@@ -6830,21 +7094,38 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 285:
-		yyDollar = yyS[yypt-4 : yypt+1]
+	case 295:
+		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1969
+//line mysql_sql.y:2040
 		{
 			ins := yyDollar[4].insertUnion()
 			ins.Table = yyDollar[2].tableExprUnion()
 			ins.PartitionNames = yyDollar[3].identifierListUnion()
+			ins.OnDuplicateUpdate = yyDollar[5].updateExprsUnion()
 			yyLOCAL = ins
 		}
 		yyVAL.union = yyLOCAL
-	case 286:
+	case 296:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL tree.UpdateExprs
+//line mysql_sql.y:2049
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 297:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.UpdateExprs
+//line mysql_sql.y:2053
+		{
+			yyLOCAL = yyDollar[5].updateExprsUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 298:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:1978
+//line mysql_sql.y:2059
 		{
 			vc := tree.NewValuesClause(yyDollar[2].rowsExprsUnion())
 			yyLOCAL = &tree.Insert{
@@ -6852,20 +7133,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 287:
+	case 299:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:1985
+//line mysql_sql.y:2066
 		{
 			yyLOCAL = &tree.Insert{
 				Rows: tree.NewSelect(yyDollar[1].selectUnion(), nil, nil),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 288:
+	case 300:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:1991
+//line mysql_sql.y:2072
 		{
 			vc := tree.NewValuesClause(yyDollar[5].rowsExprsUnion())
 			yyLOCAL = &tree.Insert{
@@ -6874,10 +7155,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 289:
+	case 301:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:1999
+//line mysql_sql.y:2080
 		{
 			vc := tree.NewValuesClause(yyDollar[4].rowsExprsUnion())
 			yyLOCAL = &tree.Insert{
@@ -6885,10 +7166,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 290:
+	case 302:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:2006
+//line mysql_sql.y:2087
 		{
 			yyLOCAL = &tree.Insert{
 				Columns: yyDollar[2].identifierListUnion(),
@@ -6896,10 +7177,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 291:
+	case 303:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:2013
+//line mysql_sql.y:2094
 		{
 			if yyDollar[2].assignmentsUnion() == nil {
 				yylex.Error("the set list of insert can not be empty")
@@ -6918,34 +7199,34 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 292:
+	case 304:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*tree.Assignment
-//line mysql_sql.y:2032
+//line mysql_sql.y:2113
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 293:
+	case 305:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Assignment
-//line mysql_sql.y:2036
+//line mysql_sql.y:2117
 		{
 			yyLOCAL = []*tree.Assignment{yyDollar[1].assignmentUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 294:
+	case 306:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Assignment
-//line mysql_sql.y:2040
+//line mysql_sql.y:2121
 		{
 			yyLOCAL = append(yyDollar[1].assignmentsUnion(), yyDollar[3].assignmentUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 295:
+	case 307:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Assignment
-//line mysql_sql.y:2046
+//line mysql_sql.y:2127
 		{
 			yyLOCAL = &tree.Assignment{
 				Column: tree.Identifier(yyDollar[1].str),
@@ -6953,155 +7234,155 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 296:
+	case 308:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2055
+//line mysql_sql.y:2136
 		{
 			yyLOCAL = tree.IdentifierList{tree.Identifier(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 297:
+	case 309:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2059
+//line mysql_sql.y:2140
 		{
 			yyLOCAL = append(yyDollar[1].identifierListUnion(), tree.Identifier(yyDollar[3].str))
 		}
 		yyVAL.union = yyLOCAL
-	case 298:
+	case 310:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2065
+//line mysql_sql.y:2146
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 299:
+	case 311:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:2069
+//line mysql_sql.y:2150
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 300:
+	case 312:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.Exprs
-//line mysql_sql.y:2075
+//line mysql_sql.y:2156
 		{
 			yyLOCAL = []tree.Exprs{yyDollar[1].exprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 301:
+	case 313:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.Exprs
-//line mysql_sql.y:2079
+//line mysql_sql.y:2160
 		{
 			yyLOCAL = append(yyDollar[1].rowsExprsUnion(), yyDollar[3].exprsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 302:
+	case 314:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:2085
+//line mysql_sql.y:2166
 		{
 			yyLOCAL = yyDollar[3].exprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 303:
+	case 315:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:2090
+//line mysql_sql.y:2171
 		{
 		}
-	case 305:
+	case 317:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:2094
+//line mysql_sql.y:2175
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 307:
+	case 319:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:2101
+//line mysql_sql.y:2182
 		{
 			yyLOCAL = tree.Exprs{yyDollar[1].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 308:
+	case 320:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:2105
+//line mysql_sql.y:2186
 		{
 			yyLOCAL = append(yyDollar[1].exprsUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 310:
+	case 322:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:2112
+//line mysql_sql.y:2193
 		{
 			yyLOCAL = &tree.DefaultVal{}
 		}
 		yyVAL.union = yyLOCAL
-	case 311:
+	case 323:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2117
+//line mysql_sql.y:2198
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 312:
+	case 324:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2121
+//line mysql_sql.y:2202
 		{
 			yyLOCAL = yyDollar[3].identifierListUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 313:
+	case 325:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2127
+//line mysql_sql.y:2208
 		{
 			yyLOCAL = tree.IdentifierList{tree.Identifier(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 314:
+	case 326:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2131
+//line mysql_sql.y:2212
 		{
 			yyLOCAL = append(yyDollar[1].identifierListUnion(), tree.Identifier(yyDollar[3].str))
 		}
 		yyVAL.union = yyLOCAL
-	case 315:
+	case 327:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2137
+//line mysql_sql.y:2218
 		{
 			yyLOCAL = yyDollar[2].tableNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 316:
+	case 328:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2141
+//line mysql_sql.y:2222
 		{
 			yyLOCAL = yyDollar[1].tableNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 317:
+	case 329:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.ExportParam
-//line mysql_sql.y:2146
+//line mysql_sql.y:2227
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 318:
-		yyDollar = yyS[yypt-8 : yypt+1]
+	case 330:
+		yyDollar = yyS[yypt-10 : yypt+1]
 		var yyLOCAL *tree.ExportParam
-//line mysql_sql.y:2150
+//line mysql_sql.y:2231
 		{
 			yyLOCAL = &tree.ExportParam{
 				Outfile:     true,
@@ -7111,13 +7392,15 @@ yydefault:
 				Header:      yyDollar[6].unsignedOptUnion(),
 				MaxFileSize: uint64(yyDollar[7].int64ValUnion()) * 1024,
 				ForceQuote:  yyDollar[8].strsUnion(),
+				FileFormat:  yyDollar[9].str,
+				Compression: yyDollar[10].str,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 319:
+	case 331:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:2163
+//line mysql_sql.y:2246
 		{
 			yyLOCAL = &tree.Fields{
 				Terminated: ",",
@@ -7125,10 +7408,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 320:
+	case 332:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:2170
+//line mysql_sql.y:2253
 		{
 			yyLOCAL = &tree.Fields{
 				Terminated: yyDollar[4].str,
@@ -7136,10 +7419,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 321:
+	case 333:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:2177
+//line mysql_sql.y:2260
 		{
 			str := yyDollar[7].str
 			if str != "\\" && len(str) > 1 {
@@ -7158,10 +7441,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 322:
+	case 334:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:2195
+//line mysql_sql.y:2278
 		{
 			str := yyDollar[4].str
 			if str != "\\" && len(str) > 1 {
@@ -7180,38 +7463,38 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 323:
+	case 335:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Lines
-//line mysql_sql.y:2214
+//line mysql_sql.y:2297
 		{
 			yyLOCAL = &tree.Lines{
 				TerminatedBy: "\n",
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 324:
+	case 336:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Lines
-//line mysql_sql.y:2220
+//line mysql_sql.y:2303
 		{
 			yyLOCAL = &tree.Lines{
 				TerminatedBy: yyDollar[2].str,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 325:
+	case 337:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2227
+//line mysql_sql.y:2310
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 326:
+	case 338:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2231
+//line mysql_sql.y:2314
 		{
 			str := strings.ToLower(yyDollar[2].str)
 			if str == "true" {
@@ -7224,115 +7507,115 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 327:
+	case 339:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:2244
+//line mysql_sql.y:2327
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 328:
+	case 340:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:2248
+//line mysql_sql.y:2331
 		{
 			yyLOCAL = yyDollar[2].item.(int64)
 		}
 		yyVAL.union = yyLOCAL
-	case 329:
+	case 341:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:2253
+//line mysql_sql.y:2336
 		{
 			yyLOCAL = []string{}
 		}
 		yyVAL.union = yyLOCAL
-	case 330:
+	case 342:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:2257
+//line mysql_sql.y:2340
 		{
 			yyLOCAL = yyDollar[3].strsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 331:
+	case 343:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:2264
+//line mysql_sql.y:2347
 		{
 			yyLOCAL = make([]string, 0, 4)
 			yyLOCAL = append(yyLOCAL, yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 332:
+	case 344:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:2269
+//line mysql_sql.y:2352
 		{
 			yyLOCAL = append(yyDollar[1].strsUnion(), yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 334:
+	case 346:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:2276
+//line mysql_sql.y:2359
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[1].selectStatementUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 335:
+	case 347:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:2282
+//line mysql_sql.y:2365
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[1].selectStatementUnion(), OrderBy: yyDollar[2].orderByUnion(), Limit: yyDollar[3].limitUnion(), Ep: yyDollar[4].exportParmUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 336:
+	case 348:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:2286
+//line mysql_sql.y:2369
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[1].selectStatementUnion(), OrderBy: yyDollar[2].orderByUnion(), Ep: yyDollar[3].exportParmUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 337:
+	case 349:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:2290
+//line mysql_sql.y:2373
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[1].selectStatementUnion(), OrderBy: yyDollar[2].orderByUnion(), Limit: yyDollar[3].limitUnion(), Ep: yyDollar[4].exportParmUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 338:
+	case 350:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:2294
+//line mysql_sql.y:2377
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[2].selectStatementUnion(), OrderBy: yyDollar[3].orderByUnion(), Limit: yyDollar[4].limitUnion(), Ep: yyDollar[5].exportParmUnion(), With: yyDollar[1].withClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 339:
+	case 351:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:2298
+//line mysql_sql.y:2381
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[2].selectStatementUnion(), OrderBy: yyDollar[3].orderByUnion(), Ep: yyDollar[4].exportParmUnion(), With: yyDollar[1].withClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 340:
+	case 352:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:2302
+//line mysql_sql.y:2385
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[2].selectStatementUnion(), OrderBy: yyDollar[3].orderByUnion(), Limit: yyDollar[4].limitUnion(), Ep: yyDollar[5].exportParmUnion(), With: yyDollar[1].withClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 341:
+	case 353:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.With
-//line mysql_sql.y:2308
+//line mysql_sql.y:2391
 		{
 			yyLOCAL = &tree.With{
 				IsRecursive: false,
@@ -7340,10 +7623,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 342:
+	case 354:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.With
-//line mysql_sql.y:2315
+//line mysql_sql.y:2398
 		{
 			yyLOCAL = &tree.With{
 				IsRecursive: true,
@@ -7351,26 +7634,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 343:
+	case 355:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.CTE
-//line mysql_sql.y:2324
+//line mysql_sql.y:2407
 		{
 			yyLOCAL = []*tree.CTE{yyDollar[1].cteUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 344:
+	case 356:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.CTE
-//line mysql_sql.y:2328
+//line mysql_sql.y:2411
 		{
 			yyLOCAL = append(yyDollar[1].cteListUnion(), yyDollar[3].cteUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 345:
+	case 357:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.CTE
-//line mysql_sql.y:2334
+//line mysql_sql.y:2417
 		{
 			yyLOCAL = &tree.CTE{
 				Name: &tree.AliasClause{Alias: tree.Identifier(yyDollar[1].str), Cols: yyDollar[2].identifierListUnion()},
@@ -7378,162 +7661,162 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 346:
+	case 358:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2342
+//line mysql_sql.y:2425
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 347:
+	case 359:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2346
+//line mysql_sql.y:2429
 		{
 			yyLOCAL = yyDollar[2].identifierListUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 348:
+	case 360:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:2351
+//line mysql_sql.y:2434
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 349:
+	case 361:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:2355
+//line mysql_sql.y:2438
 		{
 			yyLOCAL = yyDollar[1].limitUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 350:
+	case 362:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:2361
+//line mysql_sql.y:2444
 		{
 			yyLOCAL = &tree.Limit{Count: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 351:
+	case 363:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:2365
+//line mysql_sql.y:2448
 		{
 			yyLOCAL = &tree.Limit{Offset: yyDollar[2].exprUnion(), Count: yyDollar[4].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 352:
+	case 364:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:2369
+//line mysql_sql.y:2452
 		{
 			yyLOCAL = &tree.Limit{Offset: yyDollar[4].exprUnion(), Count: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 353:
+	case 365:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:2374
+//line mysql_sql.y:2457
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 354:
+	case 366:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:2378
+//line mysql_sql.y:2461
 		{
 			yyLOCAL = yyDollar[1].orderByUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 355:
+	case 367:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:2384
+//line mysql_sql.y:2467
 		{
 			yyLOCAL = yyDollar[3].orderByUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 356:
+	case 368:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:2390
+//line mysql_sql.y:2473
 		{
 			yyLOCAL = tree.OrderBy{yyDollar[1].orderUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 357:
+	case 369:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:2394
+//line mysql_sql.y:2477
 		{
 			yyLOCAL = append(yyDollar[1].orderByUnion(), yyDollar[3].orderUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 358:
+	case 370:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Order
-//line mysql_sql.y:2400
+//line mysql_sql.y:2483
 		{
 			yyLOCAL = &tree.Order{Expr: yyDollar[1].exprUnion(), Direction: yyDollar[2].directionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 359:
+	case 371:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Direction
-//line mysql_sql.y:2405
+//line mysql_sql.y:2488
 		{
 			yyLOCAL = tree.DefaultDirection
 		}
 		yyVAL.union = yyLOCAL
-	case 360:
+	case 372:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Direction
-//line mysql_sql.y:2409
+//line mysql_sql.y:2492
 		{
 			yyLOCAL = tree.Ascending
 		}
 		yyVAL.union = yyLOCAL
-	case 361:
+	case 373:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Direction
-//line mysql_sql.y:2413
+//line mysql_sql.y:2496
 		{
 			yyLOCAL = tree.Descending
 		}
 		yyVAL.union = yyLOCAL
-	case 362:
+	case 374:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2420
+//line mysql_sql.y:2503
 		{
 			yyLOCAL = &tree.ParenSelect{Select: yyDollar[2].selectUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 363:
+	case 375:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2424
+//line mysql_sql.y:2507
 		{
 			yyLOCAL = &tree.ParenSelect{Select: &tree.Select{Select: yyDollar[2].selectStatementUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 364:
+	case 376:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2430
+//line mysql_sql.y:2513
 		{
 			yyLOCAL = yyDollar[1].selectStatementUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 365:
+	case 377:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2434
+//line mysql_sql.y:2517
 		{
 			yyLOCAL = &tree.UnionClause{
 				Type:     yyDollar[2].unionTypeRecordUnion().Type,
@@ -7544,10 +7827,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 366:
+	case 378:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2444
+//line mysql_sql.y:2527
 		{
 			yyLOCAL = &tree.UnionClause{
 				Type:     yyDollar[2].unionTypeRecordUnion().Type,
@@ -7558,10 +7841,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 367:
+	case 379:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2454
+//line mysql_sql.y:2537
 		{
 			yyLOCAL = &tree.UnionClause{
 				Type:     yyDollar[2].unionTypeRecordUnion().Type,
@@ -7572,10 +7855,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 368:
+	case 380:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2464
+//line mysql_sql.y:2547
 		{
 			yyLOCAL = &tree.UnionClause{
 				Type:     yyDollar[2].unionTypeRecordUnion().Type,
@@ -7586,10 +7869,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 369:
+	case 381:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:2476
+//line mysql_sql.y:2559
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.UNION,
@@ -7598,10 +7881,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 370:
+	case 382:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:2484
+//line mysql_sql.y:2567
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.UNION,
@@ -7610,10 +7893,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 371:
+	case 383:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:2492
+//line mysql_sql.y:2575
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.UNION,
@@ -7622,10 +7905,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 372:
+	case 384:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2502
+//line mysql_sql.y:2585
 		{
 			yyLOCAL = &tree.SelectClause{
 				Distinct: yyDollar[2].boolValUnion(),
@@ -7637,130 +7920,130 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 373:
+	case 385:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2514
+//line mysql_sql.y:2597
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 374:
+	case 386:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2518
+//line mysql_sql.y:2601
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 375:
+	case 387:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2522
+//line mysql_sql.y:2605
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 378:
+	case 390:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Where
-//line mysql_sql.y:2531
+//line mysql_sql.y:2614
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 379:
+	case 391:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Where
-//line mysql_sql.y:2535
+//line mysql_sql.y:2618
 		{
 			yyLOCAL = &tree.Where{Type: tree.AstHaving, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 380:
+	case 392:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.GroupBy
-//line mysql_sql.y:2540
+//line mysql_sql.y:2623
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 381:
+	case 393:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.GroupBy
-//line mysql_sql.y:2544
+//line mysql_sql.y:2627
 		{
 			yyLOCAL = tree.GroupBy(yyDollar[3].exprsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 382:
+	case 394:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Where
-//line mysql_sql.y:2549
+//line mysql_sql.y:2632
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 383:
+	case 395:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Where
-//line mysql_sql.y:2553
+//line mysql_sql.y:2636
 		{
 			yyLOCAL = &tree.Where{Type: tree.AstWhere, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 384:
+	case 396:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.SelectExprs
-//line mysql_sql.y:2559
+//line mysql_sql.y:2642
 		{
 			yyLOCAL = tree.SelectExprs{yyDollar[1].selectExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 385:
+	case 397:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectExprs
-//line mysql_sql.y:2563
+//line mysql_sql.y:2646
 		{
 			yyLOCAL = append(yyDollar[1].selectExprsUnion(), yyDollar[3].selectExprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 386:
+	case 398:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.SelectExpr
-//line mysql_sql.y:2569
+//line mysql_sql.y:2652
 		{
 			yyLOCAL = tree.SelectExpr{Expr: tree.StarExpr()}
 		}
 		yyVAL.union = yyLOCAL
-	case 387:
+	case 399:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.SelectExpr
-//line mysql_sql.y:2573
+//line mysql_sql.y:2656
 		{
 			yyLOCAL = tree.SelectExpr{Expr: yyDollar[1].exprUnion(), As: tree.UnrestrictedIdentifier(yyDollar[2].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 388:
+	case 400:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectExpr
-//line mysql_sql.y:2577
+//line mysql_sql.y:2660
 		{
 			yyLOCAL = tree.SelectExpr{Expr: tree.SetUnresolvedNameWithStar(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 389:
+	case 401:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.SelectExpr
-//line mysql_sql.y:2581
+//line mysql_sql.y:2664
 		{
 			yyLOCAL = tree.SelectExpr{Expr: tree.SetUnresolvedNameWithStar(yyDollar[3].str, yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 390:
+	case 402:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.From
-//line mysql_sql.y:2587
+//line mysql_sql.y:2670
 		{
 			prefix := tree.ObjectNamePrefix{ExplicitSchema: false}
 			tn := tree.NewTableName(tree.Identifier("dual"), prefix)
@@ -7769,44 +8052,44 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 391:
+	case 403:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.From
-//line mysql_sql.y:2595
+//line mysql_sql.y:2678
 		{
 			yyLOCAL = yyDollar[1].fromUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 392:
+	case 404:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.From
-//line mysql_sql.y:2601
+//line mysql_sql.y:2684
 		{
 			yyLOCAL = &tree.From{
 				Tables: yyDollar[2].tableExprsUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 393:
+	case 405:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableExprs
-//line mysql_sql.y:2609
+//line mysql_sql.y:2692
 		{
 			yyLOCAL = tree.TableExprs{yyDollar[1].tableExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 394:
+	case 406:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableExprs
-//line mysql_sql.y:2613
+//line mysql_sql.y:2696
 		{
 			yyLOCAL = append(yyDollar[1].tableExprsUnion(), yyDollar[3].tableExprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 397:
+	case 409:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2623
+//line mysql_sql.y:2706
 		{
 			yyLOCAL = &tree.JoinTableExpr{
 				Left:     yyDollar[1].tableExprUnion(),
@@ -7816,10 +8099,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 398:
+	case 410:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2632
+//line mysql_sql.y:2715
 		{
 			yyLOCAL = &tree.JoinTableExpr{
 				Left:     yyDollar[1].tableExprUnion(),
@@ -7829,10 +8112,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 399:
+	case 411:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2642
+//line mysql_sql.y:2725
 		{
 			yyLOCAL = &tree.JoinTableExpr{
 				Left:     yyDollar[1].tableExprUnion(),
@@ -7842,10 +8125,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 400:
+	case 412:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2651
+//line mysql_sql.y:2734
 		{
 			yyLOCAL = &tree.JoinTableExpr{
 				Left:     yyDollar[1].tableExprUnion(),
@@ -7854,15 +8137,15 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 401:
+	case 413:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2661
+//line mysql_sql.y:2744
 		{
 			yyVAL.str = tree.JOIN_TYPE_NATURAL
 		}
-	case 402:
+	case 414:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2665
+//line mysql_sql.y:2748
 		{
 			if yyDollar[2].str == tree.JOIN_TYPE_LEFT {
 				yyVAL.str = tree.JOIN_TYPE_NATURAL_LEFT
@@ -7870,130 +8153,130 @@ yydefault:
 				yyVAL.str = tree.JOIN_TYPE_NATURAL_RIGHT
 			}
 		}
-	case 403:
+	case 415:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2675
+//line mysql_sql.y:2758
 		{
 			yyVAL.str = tree.JOIN_TYPE_LEFT
 		}
-	case 404:
+	case 416:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:2679
+//line mysql_sql.y:2762
 		{
 			yyVAL.str = tree.JOIN_TYPE_LEFT
 		}
-	case 405:
+	case 417:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2683
+//line mysql_sql.y:2766
 		{
 			yyVAL.str = tree.JOIN_TYPE_RIGHT
 		}
-	case 406:
+	case 418:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:2687
+//line mysql_sql.y:2770
 		{
 			yyVAL.str = tree.JOIN_TYPE_RIGHT
 		}
-	case 407:
+	case 419:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:2693
+//line mysql_sql.y:2776
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 408:
+	case 420:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:2697
+//line mysql_sql.y:2780
 		{
 			yyLOCAL = &tree.OnJoinCond{Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 409:
+	case 421:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2703
+//line mysql_sql.y:2786
 		{
 			yyVAL.str = tree.JOIN_TYPE_STRAIGHT
 		}
-	case 410:
+	case 422:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2709
+//line mysql_sql.y:2792
 		{
 			yyVAL.str = tree.JOIN_TYPE_INNER
 		}
-	case 411:
+	case 423:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2713
+//line mysql_sql.y:2796
 		{
 			yyVAL.str = tree.JOIN_TYPE_INNER
 		}
-	case 412:
+	case 424:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2717
+//line mysql_sql.y:2800
 		{
 			yyVAL.str = tree.JOIN_TYPE_CROSS
 		}
-	case 413:
+	case 425:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:2723
+//line mysql_sql.y:2806
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 414:
+	case 426:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:2727
+//line mysql_sql.y:2810
 		{
 			yyLOCAL = yyDollar[1].joinCondUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 415:
+	case 427:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:2733
+//line mysql_sql.y:2816
 		{
 			yyLOCAL = &tree.OnJoinCond{Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 416:
+	case 428:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:2737
+//line mysql_sql.y:2820
 		{
 			yyLOCAL = &tree.UsingJoinCond{Cols: yyDollar[3].identifierListUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 417:
+	case 429:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2743
+//line mysql_sql.y:2826
 		{
 			yyLOCAL = tree.IdentifierList{tree.Identifier(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 418:
+	case 430:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2747
+//line mysql_sql.y:2830
 		{
 			yyLOCAL = append(yyDollar[1].identifierListUnion(), tree.Identifier(yyDollar[3].str))
 		}
 		yyVAL.union = yyLOCAL
-	case 419:
+	case 431:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2753
+//line mysql_sql.y:2836
 		{
 			yyLOCAL = yyDollar[1].aliasedTableExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 420:
+	case 432:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2757
+//line mysql_sql.y:2840
 		{
 			yyLOCAL = &tree.AliasedTableExpr{
 				Expr: yyDollar[1].parenTableExprUnion(),
@@ -8004,77 +8287,204 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 421:
+	case 433:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.TableExpr
+//line mysql_sql.y:2850
+		{
+			yyLOCAL = &tree.AliasedTableExpr{
+				Expr: yyDollar[1].tableExprUnion(),
+				As: tree.AliasClause{
+					Alias: tree.Identifier(yyDollar[2].str),
+				},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 434:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.TableExpr
+//line mysql_sql.y:2859
+		{
+			yyLOCAL = &tree.AliasedTableExpr{
+				Expr: yyDollar[1].tableExprUnion(),
+				As: tree.AliasClause{
+					Alias: tree.Identifier(yyDollar[2].str),
+					Cols:  yyDollar[4].identifierListUnion(),
+				},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 435:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL tree.TableExpr
+//line mysql_sql.y:2869
+		{
+			yyLOCAL = &tree.AliasedTableExpr{
+				Expr: yyDollar[1].tableExprUnion(),
+				As: tree.AliasClause{
+					Alias: tree.Identifier(yyDollar[3].str),
+					Cols:  yyDollar[5].identifierListUnion(),
+				},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 436:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.TableExpr
+//line mysql_sql.y:2882
+		{
+			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
+			yyLOCAL = &tree.TableFunction{
+				Func: &tree.FuncExpr{
+					Func:  tree.FuncName2ResolvableFunctionReference(name),
+					Exprs: yyDollar[3].exprsUnion(),
+				},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 437:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.ParenTableExpr
-//line mysql_sql.y:2770
+//line mysql_sql.y:2894
 		{
 			yyLOCAL = &tree.ParenTableExpr{Expr: yyDollar[2].selectUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 422:
+	case 438:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:2775
+//line mysql_sql.y:2899
 		{
 		}
-	case 423:
+	case 439:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2776
+//line mysql_sql.y:2900
 		{
 		}
-	case 424:
-		yyDollar = yyS[yypt-2 : yypt+1]
+	case 440:
+		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.AliasedTableExpr
-//line mysql_sql.y:2780
+//line mysql_sql.y:2904
 		{
 			yyLOCAL = &tree.AliasedTableExpr{
 				Expr: yyDollar[1].tableNameUnion(),
 				As: tree.AliasClause{
 					Alias: tree.Identifier(yyDollar[2].str),
 				},
+				Sample: yyDollar[3].tableSampleUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 425:
+	case 441:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:2792
+		var yyLOCAL *tree.TableSample
+//line mysql_sql.y:2917
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 442:
+		yyDollar = yyS[yypt-7 : yypt+1]
+		var yyLOCAL *tree.TableSample
+//line mysql_sql.y:2921
+		{
+			yyLOCAL = &tree.TableSample{
+				Method:  yyDollar[2].sampleMethodUnion(),
+				Percent: yyDollar[4].numValUnion(),
+				Seed:    yyDollar[7].numValUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 443:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.SampleMethod
+//line mysql_sql.y:2931
+		{
+			yyLOCAL = tree.SampleSystem
+		}
+		yyVAL.union = yyLOCAL
+	case 444:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.SampleMethod
+//line mysql_sql.y:2935
+		{
+			yyLOCAL = tree.SampleBernoulli
+		}
+		yyVAL.union = yyLOCAL
+	case 445:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.NumVal
+//line mysql_sql.y:2941
+		{
+			ival := util.GetUint64(yyDollar[1].item)
+			yyLOCAL = tree.NewNumVal(constant.MakeUint64(ival), strconv.FormatUint(ival, 10), false)
+		}
+		yyVAL.union = yyLOCAL
+	case 446:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.NumVal
+//line mysql_sql.y:2946
+		{
+			fval := yyDollar[1].item.(float64)
+			yyLOCAL = tree.NewNumValWithResFoalt(constant.MakeFloat64(fval), strconv.FormatFloat(fval, 'f', -1, 64), false, fval)
+		}
+		yyVAL.union = yyLOCAL
+	case 447:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL *tree.NumVal
+//line mysql_sql.y:2952
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 448:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *tree.NumVal
+//line mysql_sql.y:2956
+		{
+			ival := util.GetUint64(yyDollar[3].item)
+			yyLOCAL = tree.NewNumVal(constant.MakeUint64(ival), strconv.FormatUint(ival, 10), false)
+		}
+		yyVAL.union = yyLOCAL
+	case 449:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line mysql_sql.y:2962
 		{
 			yyVAL.str = ""
 		}
-	case 426:
+	case 450:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2796
+//line mysql_sql.y:2966
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 427:
+	case 451:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2800
+//line mysql_sql.y:2970
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 430:
+	case 454:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:2809
+//line mysql_sql.y:2979
 		{
 			yyVAL.str = ""
 		}
-	case 431:
+	case 455:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2813
+//line mysql_sql.y:2983
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 432:
+	case 456:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2817
+//line mysql_sql.y:2987
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 447:
+	case 471:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2847
+//line mysql_sql.y:3017
 		{
 			yyLOCAL = &tree.CreateView{
 				Name:        yyDollar[5].tableNameUnion(),
@@ -8085,10 +8495,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 448:
+	case 472:
 		yyDollar = yyS[yypt-11 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2857
+//line mysql_sql.y:3027
 		{
 			yyLOCAL = &tree.CreateView{
 				Name:        yyDollar[8].tableNameUnion(),
@@ -8099,15 +8509,29 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 449:
+	case 473:
+		yyDollar = yyS[yypt-8 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:3038
+		{
+			yyLOCAL = &tree.CreateView{
+				Name:         yyDollar[5].tableNameUnion(),
+				ColNames:     yyDollar[6].identifierListUnion(),
+				AsSource:     yyDollar[8].selectUnion(),
+				IfNotExists:  yyDollar[4].ifNotExistsUnion(),
+				Materialized: true,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 474:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:2868
+//line mysql_sql.y:3049
 		{
 		}
-	case 451:
+	case 476:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2873
+//line mysql_sql.y:3054
 		{
 			yyLOCAL = &tree.CreateUser{
 				IfNotExists: yyDollar[3].ifNotExistsUnion(),
@@ -8117,189 +8541,189 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 452:
+	case 477:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.ResourceOption
-//line mysql_sql.y:2883
+//line mysql_sql.y:3064
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 453:
+	case 478:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.ResourceOption
-//line mysql_sql.y:2887
+//line mysql_sql.y:3068
 		{
 			yyLOCAL = yyDollar[2].resourceOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 454:
+	case 479:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.ResourceOption
-//line mysql_sql.y:2893
+//line mysql_sql.y:3074
 		{
 			yyLOCAL = []tree.ResourceOption{yyDollar[1].resourceOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 455:
+	case 480:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.ResourceOption
-//line mysql_sql.y:2897
+//line mysql_sql.y:3078
 		{
 			yyLOCAL = append(yyDollar[1].resourceOptionsUnion(), yyDollar[2].resourceOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 456:
+	case 481:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ResourceOption
-//line mysql_sql.y:2903
+//line mysql_sql.y:3084
 		{
 			yyLOCAL = &tree.ResourceOptionMaxQueriesPerHour{Count: yyDollar[2].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 457:
+	case 482:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ResourceOption
-//line mysql_sql.y:2907
+//line mysql_sql.y:3088
 		{
 			yyLOCAL = &tree.ResourceOptionMaxUpdatesPerHour{Count: yyDollar[2].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 458:
+	case 483:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ResourceOption
-//line mysql_sql.y:2911
+//line mysql_sql.y:3092
 		{
 			yyLOCAL = &tree.ResourceOptionMaxConnectionPerHour{Count: yyDollar[2].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 459:
+	case 484:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ResourceOption
-//line mysql_sql.y:2915
+//line mysql_sql.y:3096
 		{
 			yyLOCAL = &tree.ResourceOptionMaxUserConnections{Count: yyDollar[2].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 460:
+	case 485:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.TlsOption
-//line mysql_sql.y:2921
+//line mysql_sql.y:3102
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 462:
+	case 487:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TlsOption
-//line mysql_sql.y:2928
+//line mysql_sql.y:3109
 		{
 			t := &tree.TlsOptionNone{}
 			yyLOCAL = []tree.TlsOption{t}
 		}
 		yyVAL.union = yyLOCAL
-	case 463:
+	case 488:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TlsOption
-//line mysql_sql.y:2933
+//line mysql_sql.y:3114
 		{
 			t := &tree.TlsOptionSSL{}
 			yyLOCAL = []tree.TlsOption{t}
 		}
 		yyVAL.union = yyLOCAL
-	case 464:
+	case 489:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TlsOption
-//line mysql_sql.y:2938
+//line mysql_sql.y:3119
 		{
 			t := &tree.TlsOptionX509{}
 			yyLOCAL = []tree.TlsOption{t}
 		}
 		yyVAL.union = yyLOCAL
-	case 465:
+	case 490:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TlsOption
-//line mysql_sql.y:2943
+//line mysql_sql.y:3124
 		{
 			yyLOCAL = yyDollar[2].tlsOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 466:
+	case 491:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.TlsOption
-//line mysql_sql.y:2949
+//line mysql_sql.y:3130
 		{
 			yyLOCAL = []tree.TlsOption{yyDollar[1].tlsOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 467:
+	case 492:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.TlsOption
-//line mysql_sql.y:2953
+//line mysql_sql.y:3134
 		{
 			yyLOCAL = append(yyDollar[1].tlsOptionsUnion(), yyDollar[3].tlsOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 468:
+	case 493:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TlsOption
-//line mysql_sql.y:2957
+//line mysql_sql.y:3138
 		{
 			yyLOCAL = append(yyDollar[1].tlsOptionsUnion(), yyDollar[2].tlsOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 469:
+	case 494:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TlsOption
-//line mysql_sql.y:2963
+//line mysql_sql.y:3144
 		{
 			yyLOCAL = &tree.TlsOptionIssuer{Issuer: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 470:
+	case 495:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TlsOption
-//line mysql_sql.y:2967
+//line mysql_sql.y:3148
 		{
 			yyLOCAL = &tree.TlsOptionSubject{Subject: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 471:
+	case 496:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TlsOption
-//line mysql_sql.y:2971
+//line mysql_sql.y:3152
 		{
 			yyLOCAL = &tree.TlsOptionCipher{Cipher: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 472:
+	case 497:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TlsOption
-//line mysql_sql.y:2975
+//line mysql_sql.y:3156
 		{
 			yyLOCAL = &tree.TlsOptionSan{San: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 473:
+	case 498:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.User
-//line mysql_sql.y:2981
+//line mysql_sql.y:3162
 		{
 			yyLOCAL = []*tree.User{yyDollar[1].userUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 474:
+	case 499:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.User
-//line mysql_sql.y:2985
+//line mysql_sql.y:3166
 		{
 			yyLOCAL = append(yyDollar[1].usersUnion(), yyDollar[3].userUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 475:
+	case 500:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.User
-//line mysql_sql.y:2991
+//line mysql_sql.y:3172
 		{
 			yyLOCAL = &tree.User{
 				Username:   yyDollar[1].usernameRecordUnion().Username,
@@ -8311,42 +8735,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 476:
+	case 501:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UsernameRecord
-//line mysql_sql.y:3004
+//line mysql_sql.y:3185
 		{
 			yyLOCAL = &tree.UsernameRecord{Username: yyDollar[1].str, Hostname: "%"}
 		}
 		yyVAL.union = yyLOCAL
-	case 477:
+	case 502:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UsernameRecord
-//line mysql_sql.y:3008
+//line mysql_sql.y:3189
 		{
 			yyLOCAL = &tree.UsernameRecord{Username: yyDollar[1].str, Hostname: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 478:
+	case 503:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UsernameRecord
-//line mysql_sql.y:3012
+//line mysql_sql.y:3193
 		{
 			yyLOCAL = &tree.UsernameRecord{Username: yyDollar[1].str, Hostname: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 479:
+	case 504:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.AuthRecord
-//line mysql_sql.y:3017
+//line mysql_sql.y:3198
 		{
 			yyLOCAL = &tree.AuthRecord{}
 		}
 		yyVAL.union = yyLOCAL
-	case 480:
+	case 505:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.AuthRecord
-//line mysql_sql.y:3021
+//line mysql_sql.y:3202
 		{
 			yyLOCAL = &tree.AuthRecord{
 				AuthString: yyDollar[3].str,
@@ -8354,20 +8778,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 481:
+	case 506:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.AuthRecord
-//line mysql_sql.y:3028
+//line mysql_sql.y:3209
 		{
 			yyLOCAL = &tree.AuthRecord{
 				AuthPlugin: yyDollar[3].str,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 482:
+	case 507:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.AuthRecord
-//line mysql_sql.y:3034
+//line mysql_sql.y:3215
 		{
 			yyLOCAL = &tree.AuthRecord{
 				AuthPlugin: yyDollar[3].str,
@@ -8376,10 +8800,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 483:
+	case 508:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.AuthRecord
-//line mysql_sql.y:3042
+//line mysql_sql.y:3223
 		{
 			yyLOCAL = &tree.AuthRecord{
 				AuthPlugin: yyDollar[3].str,
@@ -8387,20 +8811,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 484:
+	case 509:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.AuthRecord
-//line mysql_sql.y:3049
+//line mysql_sql.y:3230
 		{
 			yyLOCAL = &tree.AuthRecord{
 				HashString: yyDollar[4].str,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 487:
+	case 512:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3061
+//line mysql_sql.y:3242
 		{
 			yyLOCAL = &tree.CreateRole{
 				IfNotExists: yyDollar[3].ifNotExistsUnion(),
@@ -8408,82 +8832,82 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 488:
+	case 513:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Role
-//line mysql_sql.y:3070
+//line mysql_sql.y:3251
 		{
 			yyLOCAL = []*tree.Role{yyDollar[1].roleUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 489:
+	case 514:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Role
-//line mysql_sql.y:3074
+//line mysql_sql.y:3255
 		{
 			yyLOCAL = append(yyDollar[1].rolesUnion(), yyDollar[3].roleUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 490:
+	case 515:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Role
-//line mysql_sql.y:3080
+//line mysql_sql.y:3261
 		{
 			yyLOCAL = &tree.Role{UserName: yyDollar[1].str, HostName: "%"}
 		}
 		yyVAL.union = yyLOCAL
-	case 491:
+	case 516:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Role
-//line mysql_sql.y:3084
+//line mysql_sql.y:3265
 		{
 			yyLOCAL = &tree.Role{UserName: yyDollar[1].str, HostName: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 492:
+	case 517:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Role
-//line mysql_sql.y:3088
+//line mysql_sql.y:3269
 		{
 			yyLOCAL = &tree.Role{UserName: yyDollar[1].str, HostName: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 495:
+	case 520:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.IndexCategory
-//line mysql_sql.y:3097
+//line mysql_sql.y:3278
 		{
 			yyLOCAL = tree.INDEX_CATEGORY_NONE
 		}
 		yyVAL.union = yyLOCAL
-	case 496:
+	case 521:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IndexCategory
-//line mysql_sql.y:3101
+//line mysql_sql.y:3282
 		{
 			yyLOCAL = tree.INDEX_CATEGORY_FULLTEXT
 		}
 		yyVAL.union = yyLOCAL
-	case 497:
+	case 522:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IndexCategory
-//line mysql_sql.y:3105
+//line mysql_sql.y:3286
 		{
 			yyLOCAL = tree.INDEX_CATEGORY_SPATIAL
 		}
 		yyVAL.union = yyLOCAL
-	case 498:
+	case 523:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IndexCategory
-//line mysql_sql.y:3109
+//line mysql_sql.y:3290
 		{
 			yyLOCAL = tree.INDEX_CATEGORY_UNIQUE
 		}
 		yyVAL.union = yyLOCAL
-	case 499:
+	case 524:
 		yyDollar = yyS[yypt-11 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3115
+//line mysql_sql.y:3296
 		{
 			var io *tree.IndexOption = nil
 			if yyDollar[11].indexOptionUnion() == nil && yyDollar[5].indexTypeUnion() != tree.INDEX_TYPE_INVALID {
@@ -8502,18 +8926,18 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 500:
+	case 525:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:3134
+//line mysql_sql.y:3315
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 501:
+	case 526:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:3138
+//line mysql_sql.y:3319
 		{
 			// Merge the options
 			if yyDollar[1].indexOptionUnion() == nil {
@@ -8534,123 +8958,123 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 502:
+	case 527:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:3160
+//line mysql_sql.y:3341
 		{
 			yyLOCAL = &tree.IndexOption{KeyBlockSize: uint64(yyDollar[3].item.(int64))}
 		}
 		yyVAL.union = yyLOCAL
-	case 503:
+	case 528:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:3164
+//line mysql_sql.y:3345
 		{
 			yyLOCAL = &tree.IndexOption{Comment: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 504:
+	case 529:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:3168
+//line mysql_sql.y:3349
 		{
 			yyLOCAL = &tree.IndexOption{ParserName: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 505:
+	case 530:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:3172
+//line mysql_sql.y:3353
 		{
 			yyLOCAL = &tree.IndexOption{Visible: tree.VISIBLE_TYPE_VISIBLE}
 		}
 		yyVAL.union = yyLOCAL
-	case 506:
+	case 531:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:3176
+//line mysql_sql.y:3357
 		{
 			yyLOCAL = &tree.IndexOption{Visible: tree.VISIBLE_TYPE_INVISIBLE}
 		}
 		yyVAL.union = yyLOCAL
-	case 507:
+	case 532:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.KeyPart
-//line mysql_sql.y:3182
+//line mysql_sql.y:3363
 		{
 			yyLOCAL = []*tree.KeyPart{yyDollar[1].keyPartUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 508:
+	case 533:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.KeyPart
-//line mysql_sql.y:3186
+//line mysql_sql.y:3367
 		{
 			yyLOCAL = append(yyDollar[1].keyPartsUnion(), yyDollar[3].keyPartUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 509:
+	case 534:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.KeyPart
-//line mysql_sql.y:3192
+//line mysql_sql.y:3373
 		{
 			// Order is parsed but just ignored as MySQL did.
 			yyLOCAL = &tree.KeyPart{ColName: yyDollar[1].unresolvedNameUnion(), Length: int(yyDollar[2].lengthOptUnion()), Direction: yyDollar[3].directionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 510:
+	case 535:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.KeyPart
-//line mysql_sql.y:3197
+//line mysql_sql.y:3378
 		{
 			yyLOCAL = &tree.KeyPart{Expr: yyDollar[2].exprUnion(), Direction: yyDollar[4].directionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 511:
+	case 536:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:3202
+//line mysql_sql.y:3383
 		{
 			yyLOCAL = tree.INDEX_TYPE_INVALID
 		}
 		yyVAL.union = yyLOCAL
-	case 512:
+	case 537:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:3206
+//line mysql_sql.y:3387
 		{
 			yyLOCAL = tree.INDEX_TYPE_BTREE
 		}
 		yyVAL.union = yyLOCAL
-	case 513:
+	case 538:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:3210
+//line mysql_sql.y:3391
 		{
 			yyLOCAL = tree.INDEX_TYPE_HASH
 		}
 		yyVAL.union = yyLOCAL
-	case 514:
+	case 539:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:3214
+//line mysql_sql.y:3395
 		{
 			yyLOCAL = tree.INDEX_TYPE_RTREE
 		}
 		yyVAL.union = yyLOCAL
-	case 515:
+	case 540:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:3218
+//line mysql_sql.y:3399
 		{
 			yyLOCAL = tree.INDEX_TYPE_BSI
 		}
 		yyVAL.union = yyLOCAL
-	case 516:
+	case 541:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3224
+//line mysql_sql.y:3405
 		{
 			yyLOCAL = &tree.CreateDatabase{
 				IfNotExists:   yyDollar[3].ifNotExistsUnion(),
@@ -8659,98 +9083,98 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 519:
+	case 544:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3238
+//line mysql_sql.y:3419
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 520:
+	case 545:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3242
+//line mysql_sql.y:3423
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 521:
+	case 546:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.CreateOption
-//line mysql_sql.y:3247
+//line mysql_sql.y:3428
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 522:
+	case 547:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.CreateOption
-//line mysql_sql.y:3251
+//line mysql_sql.y:3432
 		{
 			yyLOCAL = yyDollar[1].createOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 523:
+	case 548:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.CreateOption
-//line mysql_sql.y:3257
+//line mysql_sql.y:3438
 		{
 			yyLOCAL = []tree.CreateOption{yyDollar[1].createOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 524:
+	case 549:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.CreateOption
-//line mysql_sql.y:3261
+//line mysql_sql.y:3442
 		{
 			yyLOCAL = append(yyDollar[1].createOptionsUnion(), yyDollar[2].createOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 525:
+	case 550:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CreateOption
-//line mysql_sql.y:3267
+//line mysql_sql.y:3448
 		{
 			yyLOCAL = &tree.CreateOptionCharset{IsDefault: yyDollar[1].defaultOptionalUnion(), Charset: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 526:
+	case 551:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CreateOption
-//line mysql_sql.y:3271
+//line mysql_sql.y:3452
 		{
 			yyLOCAL = &tree.CreateOptionCollate{IsDefault: yyDollar[1].defaultOptionalUnion(), Collate: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 527:
+	case 552:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CreateOption
-//line mysql_sql.y:3275
+//line mysql_sql.y:3456
 		{
 			yyLOCAL = &tree.CreateOptionEncryption{Encrypt: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 528:
+	case 553:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3280
+//line mysql_sql.y:3461
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 529:
+	case 554:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3284
+//line mysql_sql.y:3465
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 530:
+	case 555:
 		yyDollar = yyS[yypt-10 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3290
+//line mysql_sql.y:3471
 		{
 			yyLOCAL = &tree.CreateTable{
 				Temporary:       yyDollar[2].boolValUnion(),
@@ -8762,34 +9186,53 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 531:
+	case 556:
+		yyDollar = yyS[yypt-14 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:3482
+		{
+			yyLOCAL = &tree.CreateTable{
+				IfNotExists: yyDollar[4].ifNotExistsUnion(),
+				Table:       *yyDollar[5].tableNameUnion(),
+				Defs:        yyDollar[7].tableDefsUnion(),
+				Param: &tree.ExternParam{
+					Location:     yyDollar[10].str,
+					FileFormat:   yyDollar[11].str,
+					Compression:  yyDollar[12].str,
+					Fields:       yyDollar[13].fieldsUnion(),
+					IgnoredLines: uint64(yyDollar[14].int64ValUnion()),
+				},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 557:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3302
+//line mysql_sql.y:3498
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 532:
+	case 558:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3306
+//line mysql_sql.y:3502
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 533:
+	case 559:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.PartitionOption
-//line mysql_sql.y:3311
+//line mysql_sql.y:3507
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 534:
+	case 560:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.PartitionOption
-//line mysql_sql.y:3315
+//line mysql_sql.y:3511
 		{
 			yyDollar[3].partitionByUnion().Num = uint64(yyDollar[4].int64ValUnion())
 			yyLOCAL = &tree.PartitionOption{
@@ -8799,18 +9242,18 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 535:
+	case 561:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:3325
+//line mysql_sql.y:3521
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 536:
+	case 562:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:3329
+//line mysql_sql.y:3525
 		{
 			yyLOCAL = &tree.PartitionBy{
 				IsSubPartition: true,
@@ -8819,42 +9262,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 537:
+	case 563:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*tree.Partition
-//line mysql_sql.y:3338
+//line mysql_sql.y:3534
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 538:
+	case 564:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Partition
-//line mysql_sql.y:3342
+//line mysql_sql.y:3538
 		{
 			yyLOCAL = yyDollar[2].partitionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 539:
+	case 565:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Partition
-//line mysql_sql.y:3348
+//line mysql_sql.y:3544
 		{
 			yyLOCAL = []*tree.Partition{yyDollar[1].partitionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 540:
+	case 566:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Partition
-//line mysql_sql.y:3352
+//line mysql_sql.y:3548
 		{
 			yyLOCAL = append(yyDollar[1].partitionsUnion(), yyDollar[3].partitionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 541:
+	case 567:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Partition
-//line mysql_sql.y:3358
+//line mysql_sql.y:3554
 		{
 			yyLOCAL = &tree.Partition{
 				Name:    tree.Identifier(yyDollar[2].str),
@@ -8864,10 +9307,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 542:
+	case 568:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.Partition
-//line mysql_sql.y:3367
+//line mysql_sql.y:3563
 		{
 			yyLOCAL = &tree.Partition{
 				Name:    tree.Identifier(yyDollar[2].str),
@@ -8877,42 +9320,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 543:
+	case 569:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*tree.SubPartition
-//line mysql_sql.y:3377
+//line mysql_sql.y:3573
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 544:
+	case 570:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.SubPartition
-//line mysql_sql.y:3381
+//line mysql_sql.y:3577
 		{
 			yyLOCAL = yyDollar[2].subPartitionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 545:
+	case 571:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.SubPartition
-//line mysql_sql.y:3387
+//line mysql_sql.y:3583
 		{
 			yyLOCAL = []*tree.SubPartition{yyDollar[1].subPartitionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 546:
+	case 572:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.SubPartition
-//line mysql_sql.y:3391
+//line mysql_sql.y:3587
 		{
 			yyLOCAL = append(yyDollar[1].subPartitionsUnion(), yyDollar[3].subPartitionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 547:
+	case 573:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.SubPartition
-//line mysql_sql.y:3397
+//line mysql_sql.y:3593
 		{
 			yyLOCAL = &tree.SubPartition{
 				Name:    tree.Identifier(yyDollar[2].str),
@@ -8920,10 +9363,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 548:
+	case 574:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.SubPartition
-//line mysql_sql.y:3404
+//line mysql_sql.y:3600
 		{
 			yyLOCAL = &tree.SubPartition{
 				Name:    tree.Identifier(yyDollar[2].str),
@@ -8931,50 +9374,50 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 549:
+	case 575:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:3413
+//line mysql_sql.y:3609
 		{
 			yyLOCAL = []tree.TableOption{yyDollar[1].tableOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 550:
+	case 576:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:3417
+//line mysql_sql.y:3613
 		{
 			yyLOCAL = append(yyDollar[1].tableOptionsUnion(), yyDollar[2].tableOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 551:
+	case 577:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Values
-//line mysql_sql.y:3422
+//line mysql_sql.y:3618
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 552:
+	case 578:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Values
-//line mysql_sql.y:3426
+//line mysql_sql.y:3622
 		{
 			yyLOCAL = &tree.ValuesLessThan{ValueList: yyDollar[5].exprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 553:
+	case 579:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:3431
+//line mysql_sql.y:3627
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 554:
+	case 580:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:3435
+//line mysql_sql.y:3631
 		{
 			res := yyDollar[2].item.(int64)
 			if res == 0 {
@@ -8984,18 +9427,18 @@ yydefault:
 			yyLOCAL = res
 		}
 		yyVAL.union = yyLOCAL
-	case 555:
+	case 581:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:3445
+//line mysql_sql.y:3641
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 556:
+	case 582:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:3449
+//line mysql_sql.y:3645
 		{
 			res := yyDollar[2].item.(int64)
 			if res == 0 {
@@ -9005,10 +9448,10 @@ yydefault:
 			yyLOCAL = res
 		}
 		yyVAL.union = yyLOCAL
-	case 557:
+	case 583:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:3460
+//line mysql_sql.y:3656
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.RangeType{
@@ -9017,10 +9460,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 558:
+	case 584:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:3468
+//line mysql_sql.y:3664
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.RangeType{
@@ -9029,10 +9472,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 559:
+	case 585:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:3476
+//line mysql_sql.y:3672
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.ListType{
@@ -9041,10 +9484,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 560:
+	case 586:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:3484
+//line mysql_sql.y:3680
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.ListType{
@@ -9053,10 +9496,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 562:
+	case 588:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:3495
+//line mysql_sql.y:3691
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.KeyType{
@@ -9067,10 +9510,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 563:
+	case 589:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:3505
+//line mysql_sql.y:3701
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.HashType{
@@ -9080,500 +9523,506 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 564:
+	case 590:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:3515
+//line mysql_sql.y:3711
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 565:
+	case 591:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:3519
+//line mysql_sql.y:3715
 		{
 			yyLOCAL = yyDollar[3].item.(int64)
 		}
 		yyVAL.union = yyLOCAL
-	case 566:
+	case 592:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3524
+//line mysql_sql.y:3720
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 567:
+	case 593:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3528
+//line mysql_sql.y:3724
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 568:
+	case 594:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:3533
+//line mysql_sql.y:3729
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 569:
+	case 595:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:3537
+//line mysql_sql.y:3733
 		{
 			yyLOCAL = yyDollar[1].tableOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 570:
+	case 596:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:3543
+//line mysql_sql.y:3739
 		{
 			yyLOCAL = []tree.TableOption{yyDollar[1].tableOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 571:
+	case 597:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:3547
+//line mysql_sql.y:3743
 		{
 			yyLOCAL = append(yyDollar[1].tableOptionsUnion(), yyDollar[3].tableOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 572:
+	case 598:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:3551
+//line mysql_sql.y:3747
 		{
 			yyLOCAL = append(yyDollar[1].tableOptionsUnion(), yyDollar[2].tableOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 573:
+	case 599:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3557
+//line mysql_sql.y:3753
 		{
 			yyLOCAL = tree.NewTableOptionAutoIncrement(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 574:
+	case 600:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3561
+//line mysql_sql.y:3757
 		{
 			yyLOCAL = tree.NewTableOptionAvgRowLength(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 575:
+	case 601:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3565
+//line mysql_sql.y:3761
 		{
 			yyLOCAL = tree.NewTableOptionCharset(yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 576:
+	case 602:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3569
+//line mysql_sql.y:3765
 		{
 			yyLOCAL = tree.NewTableOptionCollate(yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 577:
+	case 603:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3573
+//line mysql_sql.y:3769
 		{
 			yyLOCAL = tree.NewTableOptionChecksum(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 578:
+	case 604:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3577
+//line mysql_sql.y:3773
 		{
 			yyLOCAL = tree.NewTableOptionComment(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 579:
+	case 605:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3581
+//line mysql_sql.y:3777
 		{
 			yyLOCAL = tree.NewTableOptionCompression(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 580:
+	case 606:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3585
+//line mysql_sql.y:3781
 		{
 			yyLOCAL = tree.NewTableOptionConnection(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 581:
+	case 607:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3589
+//line mysql_sql.y:3785
 		{
 			yyLOCAL = tree.NewTableOptionDataDirectory(yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 582:
+	case 608:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3593
+//line mysql_sql.y:3789
 		{
 			yyLOCAL = tree.NewTableOptionIndexDirectory(yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 583:
+	case 609:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3597
+//line mysql_sql.y:3793
 		{
 			yyLOCAL = tree.NewTableOptionDelayKeyWrite(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 584:
+	case 610:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3601
+//line mysql_sql.y:3797
 		{
 			yyLOCAL = tree.NewTableOptionEncryption(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 585:
+	case 611:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3605
+//line mysql_sql.y:3801
 		{
 			yyLOCAL = tree.NewTableOptionEngine(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 586:
+	case 612:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3609
+//line mysql_sql.y:3805
 		{
 			yyLOCAL = tree.NewTableOptionKeyBlockSize(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 587:
+	case 613:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3613
+//line mysql_sql.y:3809
 		{
 			yyLOCAL = tree.NewTableOptionMaxRows(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 588:
+	case 614:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3617
+//line mysql_sql.y:3813
 		{
 			yyLOCAL = tree.NewTableOptionMinRows(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 589:
+	case 615:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3621
+//line mysql_sql.y:3817
 		{
 			yyLOCAL = &tree.TableOptionPackKeys{Value: yyDollar[3].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 590:
+	case 616:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3625
+//line mysql_sql.y:3821
 		{
 			yyLOCAL = &tree.TableOptionPackKeys{Default: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 591:
+	case 617:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3629
+//line mysql_sql.y:3825
 		{
 			yyLOCAL = tree.NewTableOptionPassword(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 592:
+	case 618:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3633
+//line mysql_sql.y:3829
 		{
 			yyLOCAL = tree.NewTableOptionRowFormat(yyDollar[3].rowFormatTypeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 593:
+	case 619:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3637
+//line mysql_sql.y:3833
 		{
 			yyLOCAL = &tree.TableOptionStatsAutoRecalc{Value: uint64(yyDollar[3].item.(int64))}
 		}
 		yyVAL.union = yyLOCAL
-	case 594:
+	case 620:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3641
+//line mysql_sql.y:3837
 		{
 			yyLOCAL = &tree.TableOptionStatsAutoRecalc{Default: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 595:
+	case 621:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3645
+//line mysql_sql.y:3841
 		{
 			yyLOCAL = &tree.TableOptionStatsPersistent{Value: uint64(yyDollar[3].item.(int64))}
 		}
 		yyVAL.union = yyLOCAL
-	case 596:
+	case 622:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3649
+//line mysql_sql.y:3845
 		{
 			yyLOCAL = &tree.TableOptionStatsPersistent{Default: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 597:
+	case 623:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3653
+//line mysql_sql.y:3849
 		{
 			yyLOCAL = &tree.TableOptionStatsSamplePages{Value: uint64(yyDollar[3].item.(int64))}
 		}
 		yyVAL.union = yyLOCAL
-	case 598:
+	case 624:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3657
+//line mysql_sql.y:3853
 		{
 			yyLOCAL = &tree.TableOptionStatsSamplePages{Default: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 599:
+	case 625:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3661
+//line mysql_sql.y:3857
 		{
 			yyLOCAL = tree.NewTableOptionTablespace(yyDollar[3].str, yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 600:
+	case 626:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3665
+//line mysql_sql.y:3861
 		{
 			yyLOCAL = tree.NewTableOptionUnion(yyDollar[4].tableNamesUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 601:
+	case 627:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3669
+//line mysql_sql.y:3865
 		{
 			yyLOCAL = &tree.TableOptionProperties{Preperties: yyDollar[3].propertiesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 602:
+	case 628:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.Property
-//line mysql_sql.y:3676
+//line mysql_sql.y:3872
 		{
 			yyLOCAL = []tree.Property{yyDollar[1].propertyUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 603:
+	case 629:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.Property
-//line mysql_sql.y:3680
+//line mysql_sql.y:3876
 		{
 			yyLOCAL = append(yyDollar[1].propertiesUnion(), yyDollar[3].propertyUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 604:
+	case 630:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Property
-//line mysql_sql.y:3686
+//line mysql_sql.y:3882
 		{
 			yyLOCAL = tree.Property{Key: yyDollar[1].str, Value: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 605:
+	case 631:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:3691
+//line mysql_sql.y:3887
 		{
 			yyVAL.str = ""
 		}
-	case 606:
+	case 632:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:3695
+//line mysql_sql.y:3891
 		{
 			yyVAL.str = " " + yyDollar[1].str + " " + yyDollar[2].str
 		}
-	case 607:
+	case 633:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:3699
+//line mysql_sql.y:3895
 		{
 			yyVAL.str = " " + yyDollar[1].str + " " + yyDollar[2].str
 		}
-	case 608:
+	case 634:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:3705
+//line mysql_sql.y:3901
 		{
 			yyLOCAL = tree.ROW_FORMAT_DEFAULT
 		}
 		yyVAL.union = yyLOCAL
-	case 609:
+	case 635:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:3709
+//line mysql_sql.y:3905
 		{
 			yyLOCAL = tree.ROW_FORMAT_DYNAMIC
 		}
 		yyVAL.union = yyLOCAL
-	case 610:
+	case 636:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:3713
+//line mysql_sql.y:3909
 		{
 			yyLOCAL = tree.ROW_FORMAT_FIXED
 		}
 		yyVAL.union = yyLOCAL
-	case 611:
+	case 637:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:3717
+//line mysql_sql.y:3913
 		{
 			yyLOCAL = tree.ROW_FORMAT_COMPRESSED
 		}
 		yyVAL.union = yyLOCAL
-	case 612:
+	case 638:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:3721
+//line mysql_sql.y:3917
 		{
 			yyLOCAL = tree.ROW_FORMAT_REDUNDANT
 		}
 		yyVAL.union = yyLOCAL
-	case 613:
+	case 639:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:3725
+//line mysql_sql.y:3921
 		{
 			yyLOCAL = tree.ROW_FORMAT_COMPACT
 		}
 		yyVAL.union = yyLOCAL
-	case 620:
+	case 646:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableNames
-//line mysql_sql.y:3741
+//line mysql_sql.y:3937
 		{
 			yyLOCAL = tree.TableNames{yyDollar[1].tableNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 621:
+	case 647:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableNames
-//line mysql_sql.y:3745
+//line mysql_sql.y:3941
 		{
 			yyLOCAL = append(yyDollar[1].tableNamesUnion(), yyDollar[3].tableNameUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 622:
+	case 648:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.TableName
-//line mysql_sql.y:3754
+//line mysql_sql.y:3950
 		{
 			prefix := tree.ObjectNamePrefix{ExplicitSchema: false}
 			yyLOCAL = tree.NewTableName(tree.Identifier(yyDollar[1].str), prefix)
 		}
 		yyVAL.union = yyLOCAL
-	case 623:
+	case 649:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.TableName
-//line mysql_sql.y:3759
+//line mysql_sql.y:3955
 		{
 			prefix := tree.ObjectNamePrefix{SchemaName: tree.Identifier(yyDollar[1].str), ExplicitSchema: true}
 			yyLOCAL = tree.NewTableName(tree.Identifier(yyDollar[3].str), prefix)
 		}
 		yyVAL.union = yyLOCAL
-	case 624:
+	case 650:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.TableDefs
-//line mysql_sql.y:3765
+//line mysql_sql.y:3961
 		{
 			yyLOCAL = tree.TableDefs(nil)
 		}
 		yyVAL.union = yyLOCAL
-	case 626:
+	case 652:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableDefs
-//line mysql_sql.y:3772
+//line mysql_sql.y:3968
 		{
 			yyLOCAL = tree.TableDefs{yyDollar[1].tableDefUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 627:
+	case 653:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableDefs
-//line mysql_sql.y:3776
+//line mysql_sql.y:3972
 		{
 			yyLOCAL = append(yyDollar[1].tableDefsUnion(), yyDollar[3].tableDefUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 628:
+	case 654:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3782
+//line mysql_sql.y:3978
 		{
 			yyLOCAL = tree.TableDef(yyDollar[1].columnTableDefUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 629:
+	case 655:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3786
+//line mysql_sql.y:3982
 		{
 			yyLOCAL = yyDollar[1].tableDefUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 630:
+	case 656:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3792
+//line mysql_sql.y:3988
 		{
 			if yyDollar[1].str != "" {
 				switch v := yyDollar[2].tableDefUnion().(type) {
 				case *tree.PrimaryKeyIndex:
 					v.Name = yyDollar[1].str
+				case *tree.ForeignKey:
+					if v.Name == "" {
+						v.Name = yyDollar[1].str
+					}
+				case *tree.CheckIndex:
+					v.Name = yyDollar[1].str
 				}
 			}
 			yyLOCAL = yyDollar[2].tableDefUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 631:
+	case 657:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3802
+//line mysql_sql.y:4004
 		{
 			yyLOCAL = yyDollar[1].tableDefUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 632:
+	case 658:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3808
+//line mysql_sql.y:4010
 		{
 			yyLOCAL = &tree.PrimaryKeyIndex{
 				KeyParts:    yyDollar[5].keyPartsUnion(),
@@ -9583,10 +10032,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 633:
+	case 659:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3817
+//line mysql_sql.y:4019
 		{
 			yyLOCAL = &tree.FullTextIndex{
 				KeyParts:    yyDollar[5].keyPartsUnion(),
@@ -9596,10 +10045,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 634:
+	case 660:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3826
+//line mysql_sql.y:4028
 		{
 			keyTyp := tree.INDEX_TYPE_INVALID
 			if yyDollar[3].strsUnion()[1] != "" {
@@ -9623,10 +10072,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 635:
+	case 661:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3849
+//line mysql_sql.y:4051
 		{
 			yyLOCAL = &tree.UniqueIndex{
 				KeyParts:    yyDollar[5].keyPartsUnion(),
@@ -9636,10 +10085,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 636:
+	case 662:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3858
+//line mysql_sql.y:4060
 		{
 			yyLOCAL = &tree.ForeignKey{
 				IfNotExists: yyDollar[3].ifNotExistsUnion(),
@@ -9650,10 +10099,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 637:
+	case 663:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3868
+//line mysql_sql.y:4070
 		{
 			yyLOCAL = &tree.CheckIndex{
 				Expr:     yyDollar[3].exprUnion(),
@@ -9661,298 +10110,306 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 638:
+	case 664:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3876
+//line mysql_sql.y:4078
 		{
-			yyLOCAL = false
+			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 640:
+	case 666:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:3882
+//line mysql_sql.y:4084
 		{
 			yyVAL.str = ""
 		}
-	case 641:
+	case 667:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:3886
+//line mysql_sql.y:4088
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 644:
+	case 670:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:3896
+//line mysql_sql.y:4098
 		{
 			yyLOCAL = make([]string, 2)
 			yyLOCAL[0] = yyDollar[1].str
 			yyLOCAL[1] = ""
 		}
 		yyVAL.union = yyLOCAL
-	case 645:
+	case 671:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:3902
+//line mysql_sql.y:4104
 		{
 			yyLOCAL = make([]string, 2)
 			yyLOCAL[0] = yyDollar[1].str
 			yyLOCAL[1] = yyDollar[3].str
 		}
 		yyVAL.union = yyLOCAL
-	case 646:
+	case 672:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:3908
+//line mysql_sql.y:4110
 		{
 			yyLOCAL = make([]string, 2)
 			yyLOCAL[0] = yyDollar[1].str
 			yyLOCAL[1] = yyDollar[3].str
 		}
 		yyVAL.union = yyLOCAL
-	case 652:
+	case 678:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:3922
+//line mysql_sql.y:4124
 		{
 			yyVAL.str = ""
 		}
-	case 654:
+	case 680:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.ColumnTableDef
-//line mysql_sql.y:3929
+//line mysql_sql.y:4131
 		{
 			yyLOCAL = tree.NewColumnTableDef(yyDollar[1].unresolvedNameUnion(), yyDollar[2].columnTypeUnion(), yyDollar[3].columnAttributesUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 655:
+	case 681:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:3935
+//line mysql_sql.y:4137
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 656:
+	case 682:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:3939
+//line mysql_sql.y:4141
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str, yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 657:
+	case 683:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:3943
+//line mysql_sql.y:4145
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str, yyDollar[3].str, yyDollar[5].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 661:
+	case 687:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:3954
+//line mysql_sql.y:4156
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 662:
+	case 688:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:3958
+//line mysql_sql.y:4160
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str, yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 663:
+	case 689:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:3962
+//line mysql_sql.y:4164
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str, yyDollar[3].str, yyDollar[5].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 664:
+	case 690:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.ColumnAttribute
-//line mysql_sql.y:3967
+//line mysql_sql.y:4169
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 665:
+	case 691:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.ColumnAttribute
-//line mysql_sql.y:3971
+//line mysql_sql.y:4173
 		{
 			yyLOCAL = yyDollar[1].columnAttributesUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 666:
+	case 692:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.ColumnAttribute
-//line mysql_sql.y:3977
+//line mysql_sql.y:4179
 		{
 			yyLOCAL = []tree.ColumnAttribute{yyDollar[1].columnAttributeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 667:
+	case 693:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.ColumnAttribute
-//line mysql_sql.y:3981
+//line mysql_sql.y:4183
 		{
 			yyLOCAL = append(yyDollar[1].columnAttributesUnion(), yyDollar[2].columnAttributeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 668:
+	case 694:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:3987
+//line mysql_sql.y:4189
 		{
 			yyLOCAL = tree.NewAttributeNull(true)
 		}
 		yyVAL.union = yyLOCAL
-	case 669:
+	case 695:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:3991
+//line mysql_sql.y:4193
 		{
 			yyLOCAL = tree.NewAttributeNull(false)
 		}
 		yyVAL.union = yyLOCAL
-	case 670:
+	case 696:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:3995
+//line mysql_sql.y:4197
 		{
 			yyLOCAL = tree.NewAttributeDefault(yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 671:
+	case 697:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:3999
+//line mysql_sql.y:4201
 		{
 			yyLOCAL = tree.NewAttributeAutoIncrement()
 		}
 		yyVAL.union = yyLOCAL
-	case 672:
+	case 698:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4003
+//line mysql_sql.y:4205
 		{
 			yyLOCAL = yyDollar[1].columnAttributeUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 673:
+	case 699:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4007
+//line mysql_sql.y:4209
 		{
 			yyLOCAL = tree.NewAttributeComment(tree.NewNumVal(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false))
 		}
 		yyVAL.union = yyLOCAL
-	case 674:
+	case 700:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4011
+//line mysql_sql.y:4213
 		{
 			yyLOCAL = tree.NewAttributeCollate(yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 675:
+	case 701:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4015
+//line mysql_sql.y:4217
 		{
 			yyLOCAL = tree.NewAttributeColumnFormat(yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 676:
+	case 702:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4019
+//line mysql_sql.y:4221
 		{
 			yyLOCAL = tree.NewAttributeStorage(yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 677:
+	case 703:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.ColumnAttribute
+//line mysql_sql.y:4225
+		{
+			yyLOCAL = tree.NewAttributeCompression(yyDollar[2].str)
+		}
+		yyVAL.union = yyLOCAL
+	case 704:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4023
+//line mysql_sql.y:4229
 		{
 			yyLOCAL = tree.NewAttributeAutoRandom(int(yyDollar[2].int64ValUnion()))
 		}
 		yyVAL.union = yyLOCAL
-	case 678:
+	case 705:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4027
+//line mysql_sql.y:4233
 		{
 			yyLOCAL = yyDollar[1].attributeReferenceUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 679:
+	case 706:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4031
+//line mysql_sql.y:4237
 		{
-			yyLOCAL = tree.NewAttributeCheck(yyDollar[4].exprUnion(), false, yyDollar[1].str)
+			yyLOCAL = tree.NewAttributeCheck(yyDollar[4].exprUnion(), true, yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 680:
+	case 707:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4035
+//line mysql_sql.y:4241
 		{
 			yyLOCAL = tree.NewAttributeCheck(yyDollar[4].exprUnion(), yyDollar[6].boolValUnion(), yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 681:
+	case 708:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:4045
+//line mysql_sql.y:4251
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 682:
+	case 709:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:4049
+//line mysql_sql.y:4255
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 683:
+	case 710:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:4054
+//line mysql_sql.y:4260
 		{
 			yyVAL.str = ""
 		}
-	case 684:
+	case 711:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4058
+//line mysql_sql.y:4264
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 685:
+	case 712:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4064
+//line mysql_sql.y:4270
 		{
 			yyVAL.str = ""
 		}
-	case 686:
+	case 713:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:4068
+//line mysql_sql.y:4274
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 687:
+	case 714:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.AttributeReference
-//line mysql_sql.y:4074
+//line mysql_sql.y:4280
 		{
 			yyLOCAL = &tree.AttributeReference{
 				TableName: yyDollar[2].tableNameUnion(),
@@ -9963,10 +10420,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 688:
+	case 715:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:4085
+//line mysql_sql.y:4291
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: tree.REFERENCE_OPTION_INVALID,
@@ -9974,10 +10431,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 690:
+	case 717:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:4095
+//line mysql_sql.y:4301
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: yyDollar[1].referenceOptionTypeUnion(),
@@ -9985,10 +10442,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 691:
+	case 718:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:4102
+//line mysql_sql.y:4308
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: tree.REFERENCE_OPTION_INVALID,
@@ -9996,10 +10453,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 692:
+	case 719:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:4109
+//line mysql_sql.y:4315
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: yyDollar[1].referenceOptionTypeUnion(),
@@ -10007,10 +10464,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 693:
+	case 720:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:4116
+//line mysql_sql.y:4322
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: yyDollar[2].referenceOptionTypeUnion(),
@@ -10018,339 +10475,368 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 694:
+	case 721:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:4125
+//line mysql_sql.y:4331
 		{
 			yyLOCAL = yyDollar[3].referenceOptionTypeUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 695:
+	case 722:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:4131
+//line mysql_sql.y:4337
 		{
 			yyLOCAL = yyDollar[3].referenceOptionTypeUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 696:
+	case 723:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:4137
+//line mysql_sql.y:4343
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_RESTRICT
 		}
 		yyVAL.union = yyLOCAL
-	case 697:
+	case 724:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:4141
+//line mysql_sql.y:4347
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_CASCADE
 		}
 		yyVAL.union = yyLOCAL
-	case 698:
+	case 725:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:4145
+//line mysql_sql.y:4351
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_SET_NULL
 		}
 		yyVAL.union = yyLOCAL
-	case 699:
+	case 726:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:4149
+//line mysql_sql.y:4355
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_NO_ACTION
 		}
 		yyVAL.union = yyLOCAL
-	case 700:
+	case 727:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:4153
+//line mysql_sql.y:4359
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_SET_DEFAULT
 		}
 		yyVAL.union = yyLOCAL
-	case 701:
+	case 728:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.MatchType
-//line mysql_sql.y:4158
+//line mysql_sql.y:4364
 		{
 			yyLOCAL = tree.MATCH_INVALID
 		}
 		yyVAL.union = yyLOCAL
-	case 703:
+	case 730:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.MatchType
-//line mysql_sql.y:4165
+//line mysql_sql.y:4371
 		{
 			yyLOCAL = tree.MATCH_FULL
 		}
 		yyVAL.union = yyLOCAL
-	case 704:
+	case 731:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.MatchType
-//line mysql_sql.y:4169
+//line mysql_sql.y:4375
 		{
 			yyLOCAL = tree.MATCH_PARTIAL
 		}
 		yyVAL.union = yyLOCAL
-	case 705:
+	case 732:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.MatchType
-//line mysql_sql.y:4173
+//line mysql_sql.y:4379
 		{
 			yyLOCAL = tree.MATCH_SIMPLE
 		}
 		yyVAL.union = yyLOCAL
-	case 706:
+	case 733:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*tree.KeyPart
-//line mysql_sql.y:4178
+//line mysql_sql.y:4384
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 707:
+	case 734:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.KeyPart
-//line mysql_sql.y:4182
+//line mysql_sql.y:4388
 		{
 			yyLOCAL = yyDollar[2].keyPartsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 708:
+	case 735:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:4187
+//line mysql_sql.y:4393
 		{
 			yyLOCAL = -1
 		}
 		yyVAL.union = yyLOCAL
-	case 709:
+	case 736:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:4191
+//line mysql_sql.y:4397
 		{
 			yyLOCAL = yyDollar[2].item.(int64)
 		}
 		yyVAL.union = yyLOCAL
-	case 716:
+	case 743:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Subquery
-//line mysql_sql.y:4207
+//line mysql_sql.y:4413
 		{
 			yyLOCAL = &tree.Subquery{Select: yyDollar[1].selectStatementUnion(), Exists: false}
 		}
 		yyVAL.union = yyLOCAL
-	case 717:
+	case 744:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4213
+//line mysql_sql.y:4419
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.BIT_AND, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 718:
+	case 745:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4217
+//line mysql_sql.y:4423
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.BIT_OR, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 719:
+	case 746:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4221
+//line mysql_sql.y:4427
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.BIT_XOR, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 720:
+	case 747:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4225
+//line mysql_sql.y:4431
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.PLUS, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 721:
+	case 748:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4229
+//line mysql_sql.y:4435
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.MINUS, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 722:
+	case 749:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4233
+//line mysql_sql.y:4439
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.MULTI, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 723:
+	case 750:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4237
+//line mysql_sql.y:4443
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.DIV, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 724:
+	case 751:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4241
+//line mysql_sql.y:4447
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.INTEGER_DIV, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 725:
+	case 752:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4245
+//line mysql_sql.y:4451
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.MOD, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 726:
+	case 753:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4249
+//line mysql_sql.y:4455
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.MOD, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 727:
+	case 754:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4253
+//line mysql_sql.y:4459
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.LEFT_SHIFT, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 728:
+	case 755:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4257
+//line mysql_sql.y:4463
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.RIGHT_SHIFT, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 729:
+	case 756:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4261
+//line mysql_sql.y:4467
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 730:
+	case 757:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4267
+//line mysql_sql.y:4473
 		{
 			yyLOCAL = yyDollar[1].unresolvedNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 731:
+	case 758:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Expr
+//line mysql_sql.y:4477
+		{
+			name := tree.SetUnresolvedName("json_extract")
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: tree.Exprs{yyDollar[1].unresolvedNameUnion(), tree.NewNumVal(constant.MakeString(yyDollar[3].str), yyDollar[3].str, false)},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 759:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Expr
+//line mysql_sql.y:4485
+		{
+			name := tree.SetUnresolvedName("json_extract")
+			extract := &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: tree.Exprs{yyDollar[1].unresolvedNameUnion(), tree.NewNumVal(constant.MakeString(yyDollar[3].str), yyDollar[3].str, false)},
+			}
+			name = tree.SetUnresolvedName("json_unquote")
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: tree.Exprs{extract},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 760:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4271
+//line mysql_sql.y:4498
 		{
 			yyLOCAL = yyDollar[1].varExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 732:
+	case 761:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4275
+//line mysql_sql.y:4502
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 733:
+	case 762:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4279
+//line mysql_sql.y:4506
 		{
 			yyLOCAL = tree.NewParenExpr(yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 734:
+	case 763:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4283
+//line mysql_sql.y:4510
 		{
 			yyLOCAL = tree.NewTuple(append(yyDollar[2].exprsUnion(), yyDollar[4].exprUnion()))
 		}
 		yyVAL.union = yyLOCAL
-	case 735:
+	case 764:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4287
+//line mysql_sql.y:4514
 		{
 			yyLOCAL = tree.NewUnaryExpr(tree.UNARY_PLUS, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 736:
+	case 765:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4291
+//line mysql_sql.y:4518
 		{
 			yyLOCAL = tree.NewUnaryExpr(tree.UNARY_MINUS, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 737:
+	case 766:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4295
+//line mysql_sql.y:4522
 		{
 			yyLOCAL = tree.NewUnaryExpr(tree.UNARY_TILDE, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 738:
+	case 767:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4299
+//line mysql_sql.y:4526
 		{
 			yyLOCAL = tree.NewUnaryExpr(tree.UNARY_MARK, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 739:
+	case 768:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4303
+//line mysql_sql.y:4530
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 740:
+	case 769:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4307
+//line mysql_sql.y:4534
 		{
 			yyLOCAL = yyDollar[1].subqueryUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 741:
+	case 770:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4311
+//line mysql_sql.y:4538
 		{
 			yyDollar[2].subqueryUnion().Exists = true
 			yyLOCAL = yyDollar[2].subqueryUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 742:
+	case 771:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4316
+//line mysql_sql.y:4543
 		{
 			yyLOCAL = &tree.CaseExpr{
 				Expr:  yyDollar[2].exprUnion(),
@@ -10359,26 +10845,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 743:
+	case 772:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4324
+//line mysql_sql.y:4551
 		{
 			yyLOCAL = tree.NewCastExpr(yyDollar[3].exprUnion(), yyDollar[5].columnTypeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 744:
+	case 773:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4328
+//line mysql_sql.y:4555
 		{
 			yyLOCAL = tree.NewCastExpr(yyDollar[3].exprUnion(), yyDollar[5].columnTypeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 745:
+	case 774:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4332
+//line mysql_sql.y:4559
 		{
 			name := tree.SetUnresolvedName("convert")
 			es := tree.NewNumVal(constant.MakeString(yyDollar[5].str), yyDollar[5].str, false)
@@ -10388,90 +10874,90 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 746:
+	case 775:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4341
+//line mysql_sql.y:4568
 		{
 			yyLOCAL = yyDollar[1].funcExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 747:
+	case 776:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4345
+//line mysql_sql.y:4572
 		{
 			yyLOCAL = yyDollar[1].funcExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 748:
+	case 777:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4349
+//line mysql_sql.y:4576
 		{
 			yyLOCAL = yyDollar[1].funcExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 749:
+	case 778:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4353
+//line mysql_sql.y:4580
 		{
 			yyLOCAL = yyDollar[1].funcExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 750:
+	case 779:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4358
+//line mysql_sql.y:4585
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 751:
+	case 780:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4362
+//line mysql_sql.y:4589
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 752:
+	case 781:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4367
+//line mysql_sql.y:4594
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 753:
+	case 782:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4371
+//line mysql_sql.y:4598
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 754:
+	case 783:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.When
-//line mysql_sql.y:4377
+//line mysql_sql.y:4604
 		{
 			yyLOCAL = []*tree.When{yyDollar[1].whenClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 755:
+	case 784:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []*tree.When
-//line mysql_sql.y:4381
+//line mysql_sql.y:4608
 		{
 			yyLOCAL = append(yyDollar[1].whenClauseListUnion(), yyDollar[2].whenClauseUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 756:
+	case 785:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.When
-//line mysql_sql.y:4387
+//line mysql_sql.y:4614
 		{
 			yyLOCAL = &tree.When{
 				Cond: yyDollar[2].exprUnion(),
@@ -10479,10 +10965,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 758:
+	case 787:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4397
+//line mysql_sql.y:4624
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10496,10 +10982,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 759:
+	case 788:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4410
+//line mysql_sql.y:4637
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10513,10 +10999,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 760:
+	case 789:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4423
+//line mysql_sql.y:4650
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10529,10 +11015,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 761:
+	case 790:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4435
+//line mysql_sql.y:4662
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10547,10 +11033,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 762:
+	case 791:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4449
+//line mysql_sql.y:4676
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10566,10 +11052,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 763:
+	case 792:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4464
+//line mysql_sql.y:4691
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10585,10 +11071,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 764:
+	case 793:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.T
+//line mysql_sql.y:4706
+		{
+			locale := ""
+			yyLOCAL = &tree.T{
+				InternalType: tree.InternalType{
+					Family:       tree.UuidFamily,
+					FamilyString: yyDollar[1].str,
+					Locale:       &locale,
+					Oid:          uint32(defines.MYSQL_TYPE_UUID),
+				},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 794:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4479
+//line mysql_sql.y:4718
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10602,10 +11104,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 765:
+	case 795:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4492
+//line mysql_sql.y:4731
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10620,15 +11122,15 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 766:
+	case 796:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:4507
+//line mysql_sql.y:4746
 		{
 		}
-	case 769:
+	case 799:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4513
+//line mysql_sql.y:4752
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10638,10 +11140,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 770:
+	case 800:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4522
+//line mysql_sql.y:4761
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10650,10 +11152,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 771:
+	case 801:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4530
+//line mysql_sql.y:4769
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10662,10 +11164,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 772:
+	case 802:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4538
+//line mysql_sql.y:4777
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10675,10 +11177,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 773:
+	case 803:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4547
+//line mysql_sql.y:4786
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10688,10 +11190,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 774:
+	case 804:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4556
+//line mysql_sql.y:4795
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10701,10 +11203,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 775:
+	case 805:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4565
+//line mysql_sql.y:4804
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10714,10 +11216,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 776:
+	case 806:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4574
+//line mysql_sql.y:4813
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			es := tree.NewNumVal(constant.MakeString("*"), "*", false)
@@ -10727,10 +11229,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 777:
+	case 807:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4583
+//line mysql_sql.y:4822
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10740,10 +11242,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 778:
+	case 808:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4592
+//line mysql_sql.y:4831
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10753,10 +11255,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 779:
+	case 809:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4601
+//line mysql_sql.y:4840
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10766,10 +11268,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 780:
+	case 810:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4610
+//line mysql_sql.y:4849
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10779,10 +11281,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 781:
+	case 811:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4619
+//line mysql_sql.y:4858
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10792,10 +11294,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 782:
+	case 812:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4628
+//line mysql_sql.y:4867
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10805,10 +11307,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 783:
+	case 813:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4637
+//line mysql_sql.y:4876
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10818,10 +11320,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 787:
+	case 817:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4653
+//line mysql_sql.y:4892
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10830,10 +11332,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 788:
+	case 818:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4661
+//line mysql_sql.y:4900
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10842,10 +11344,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 789:
+	case 819:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4669
+//line mysql_sql.y:4908
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10854,10 +11356,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 790:
+	case 820:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4677
+//line mysql_sql.y:4916
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10866,10 +11368,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 791:
+	case 821:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4685
+//line mysql_sql.y:4924
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			timeUinit := tree.SetUnresolvedName(strings.ToLower(yyDollar[3].str))
@@ -10879,10 +11381,36 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 792:
+	case 822:
+		yyDollar = yyS[yypt-8 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line mysql_sql.y:4933
+		{
+			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
+			timeUnit := tree.SetUnresolvedName(strings.ToLower(yyDollar[3].str))
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: tree.Exprs{timeUnit, yyDollar[5].exprUnion(), yyDollar[7].exprUnion()},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 823:
+		yyDollar = yyS[yypt-8 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line mysql_sql.y:4942
+		{
+			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
+			timeUnit := tree.SetUnresolvedName(strings.ToLower(yyDollar[3].str))
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: tree.Exprs{timeUnit, yyDollar[5].exprUnion(), yyDollar[7].exprUnion()},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 824:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4694
+//line mysql_sql.y:4951
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10891,16 +11419,27 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 795:
+	case 827:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4708
+//line mysql_sql.y:4965
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 824:
+	case 856:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line mysql_sql.y:5001
+		{
+			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
+			yyLOCAL = &tree.FuncExpr{
+				Func: tree.FuncName2ResolvableFunctionReference(name),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 857:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4744
+//line mysql_sql.y:5008
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			var es tree.Exprs = nil
@@ -10913,10 +11452,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 825:
+	case 858:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4756
+//line mysql_sql.y:5020
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			var es tree.Exprs = nil
@@ -10929,10 +11468,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 826:
+	case 859:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4770
+//line mysql_sql.y:5034
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10941,10 +11480,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 827:
+	case 860:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4778
+//line mysql_sql.y:5042
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10952,10 +11491,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 828:
+	case 861:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4785
+//line mysql_sql.y:5049
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			var es tree.Exprs = nil
@@ -10968,10 +11507,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 829:
+	case 862:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4797
+//line mysql_sql.y:5061
 		{
 			name := tree.SetUnresolvedName("char")
 			yyLOCAL = &tree.FuncExpr{
@@ -10980,10 +11519,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 830:
+	case 863:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4805
+//line mysql_sql.y:5069
 		{
 			cn := tree.NewNumVal(constant.MakeString(yyDollar[5].str), yyDollar[5].str, false)
 			es := yyDollar[3].exprsUnion()
@@ -10995,10 +11534,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 831:
+	case 864:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4816
+//line mysql_sql.y:5080
 		{
 			val := tree.NewNumVal(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false)
 			name := tree.SetUnresolvedName("date")
@@ -11008,10 +11547,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 832:
+	case 865:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4825
+//line mysql_sql.y:5089
 		{
 			val := tree.NewNumVal(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false)
 			name := tree.SetUnresolvedName("time")
@@ -11021,10 +11560,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 833:
+	case 866:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4834
+//line mysql_sql.y:5098
 		{
 			val := tree.NewNumVal(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false)
 			name := tree.SetUnresolvedName("timestamp")
@@ -11034,10 +11573,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 834:
+	case 867:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4843
+//line mysql_sql.y:5107
 		{
 			name := tree.SetUnresolvedName("insert")
 			yyLOCAL = &tree.FuncExpr{
@@ -11046,10 +11585,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 835:
+	case 868:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4851
+//line mysql_sql.y:5115
 		{
 			es := tree.Exprs{yyDollar[3].exprUnion()}
 			es = append(es, yyDollar[5].exprUnion())
@@ -11060,10 +11599,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 836:
+	case 869:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4861
+//line mysql_sql.y:5125
 		{
 			name := tree.SetUnresolvedName("password")
 			yyLOCAL = &tree.FuncExpr{
@@ -11072,10 +11611,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 837:
+	case 870:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4869
+//line mysql_sql.y:5133
 		{
 			name := tree.SetUnresolvedName("binary")
 			yyLOCAL = &tree.FuncExpr{
@@ -11084,52 +11623,52 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 838:
+	case 871:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4878
+//line mysql_sql.y:5142
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 839:
+	case 872:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4882
+//line mysql_sql.y:5146
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 840:
+	case 873:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4888
+//line mysql_sql.y:5152
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 841:
+	case 874:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4892
+//line mysql_sql.y:5156
 		{
 			yyLOCAL = yyDollar[2].numValUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 848:
+	case 881:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:4905
+//line mysql_sql.y:5169
 		{
 		}
-	case 849:
+	case 882:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:4907
+//line mysql_sql.y:5171
 		{
 		}
-	case 882:
+	case 917:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4947
+//line mysql_sql.y:5213
 		{
 			name := tree.SetUnresolvedName("interval")
 			es := tree.NewNumVal(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false)
@@ -11139,10 +11678,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 883:
+	case 918:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4956
+//line mysql_sql.y:5222
 		{
 			//        name := tree.SetUnresolvedName("interval")
 			//        ival := util.GetUint64($2)
@@ -11163,314 +11702,314 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 884:
+	case 919:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.FuncType
-//line mysql_sql.y:4977
+//line mysql_sql.y:5243
 		{
 			yyLOCAL = tree.FUNC_TYPE_DEFAULT
 		}
 		yyVAL.union = yyLOCAL
-	case 885:
+	case 920:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.FuncType
-//line mysql_sql.y:4981
+//line mysql_sql.y:5247
 		{
 			yyLOCAL = tree.FUNC_TYPE_DISTINCT
 		}
 		yyVAL.union = yyLOCAL
-	case 886:
+	case 921:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.FuncType
-//line mysql_sql.y:4985
+//line mysql_sql.y:5251
 		{
 			yyLOCAL = tree.FUNC_TYPE_ALL
 		}
 		yyVAL.union = yyLOCAL
-	case 887:
+	case 922:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Tuple
-//line mysql_sql.y:4991
+//line mysql_sql.y:5257
 		{
 			yyLOCAL = tree.NewTuple(yyDollar[2].exprsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 888:
+	case 923:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:4996
+//line mysql_sql.y:5262
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 889:
+	case 924:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:5000
+//line mysql_sql.y:5266
 		{
 			yyLOCAL = yyDollar[1].exprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 890:
+	case 925:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:5006
+//line mysql_sql.y:5272
 		{
 			yyLOCAL = tree.Exprs{yyDollar[1].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 891:
+	case 926:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:5010
+//line mysql_sql.y:5276
 		{
 			yyLOCAL = append(yyDollar[1].exprsUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 892:
+	case 927:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5017
+//line mysql_sql.y:5283
 		{
 			yyLOCAL = tree.NewAndExpr(yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 893:
+	case 928:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5021
+//line mysql_sql.y:5287
 		{
 			yyLOCAL = tree.NewOrExpr(yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 894:
+	case 929:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5025
+//line mysql_sql.y:5291
 		{
 			yyLOCAL = tree.NewXorExpr(yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 895:
+	case 930:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5029
+//line mysql_sql.y:5295
 		{
 			yyLOCAL = tree.NewNotExpr(yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 896:
+	case 931:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5033
+//line mysql_sql.y:5299
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 897:
+	case 932:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5039
+//line mysql_sql.y:5305
 		{
 			yyLOCAL = tree.NewIsNullExpr(yyDollar[1].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 898:
+	case 933:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5043
+//line mysql_sql.y:5309
 		{
 			yyLOCAL = tree.NewIsNotNullExpr(yyDollar[1].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 899:
+	case 934:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5047
+//line mysql_sql.y:5313
 		{
 			yyLOCAL = tree.NewComparisonExpr(yyDollar[2].comparisonOpUnion(), yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 901:
+	case 936:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5054
+//line mysql_sql.y:5320
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.IN, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 902:
+	case 937:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5058
+//line mysql_sql.y:5324
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.NOT_IN, yyDollar[1].exprUnion(), yyDollar[4].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 903:
+	case 938:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5062
+//line mysql_sql.y:5328
 		{
 			yyLOCAL = tree.NewComparisonExprWithEscape(tree.LIKE, yyDollar[1].exprUnion(), yyDollar[3].exprUnion(), yyDollar[4].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 904:
+	case 939:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5066
+//line mysql_sql.y:5332
 		{
 			yyLOCAL = tree.NewComparisonExprWithEscape(tree.NOT_LIKE, yyDollar[1].exprUnion(), yyDollar[4].exprUnion(), yyDollar[5].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 905:
+	case 940:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5070
+//line mysql_sql.y:5336
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.REG_MATCH, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 906:
+	case 941:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5074
+//line mysql_sql.y:5340
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.NOT_REG_MATCH, yyDollar[1].exprUnion(), yyDollar[4].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 907:
+	case 942:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5078
+//line mysql_sql.y:5344
 		{
 			yyLOCAL = tree.NewRangeCond(false, yyDollar[1].exprUnion(), yyDollar[3].exprUnion(), yyDollar[5].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 908:
+	case 943:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5082
+//line mysql_sql.y:5348
 		{
 			yyLOCAL = tree.NewRangeCond(true, yyDollar[1].exprUnion(), yyDollar[4].exprUnion(), yyDollar[6].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 910:
+	case 945:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5088
+//line mysql_sql.y:5354
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 911:
+	case 946:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5092
+//line mysql_sql.y:5358
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 912:
+	case 947:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5098
+//line mysql_sql.y:5364
 		{
 			yyLOCAL = yyDollar[1].tupleUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 913:
+	case 948:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5102
+//line mysql_sql.y:5368
 		{
 			yyLOCAL = yyDollar[1].subqueryUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 914:
+	case 949:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:5109
+//line mysql_sql.y:5375
 		{
 			yyLOCAL = tree.EQUAL
 		}
 		yyVAL.union = yyLOCAL
-	case 915:
+	case 950:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:5113
+//line mysql_sql.y:5379
 		{
 			yyLOCAL = tree.LESS_THAN
 		}
 		yyVAL.union = yyLOCAL
-	case 916:
+	case 951:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:5117
+//line mysql_sql.y:5383
 		{
 			yyLOCAL = tree.GREAT_THAN
 		}
 		yyVAL.union = yyLOCAL
-	case 917:
+	case 952:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:5121
+//line mysql_sql.y:5387
 		{
 			yyLOCAL = tree.LESS_THAN_EQUAL
 		}
 		yyVAL.union = yyLOCAL
-	case 918:
+	case 953:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:5125
+//line mysql_sql.y:5391
 		{
 			yyLOCAL = tree.GREAT_THAN_EQUAL
 		}
 		yyVAL.union = yyLOCAL
-	case 919:
+	case 954:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:5129
+//line mysql_sql.y:5395
 		{
 			yyLOCAL = tree.NOT_EQUAL
 		}
 		yyVAL.union = yyLOCAL
-	case 920:
+	case 955:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:5136
+//line mysql_sql.y:5402
 		{
 			yyLOCAL = tree.NewAttributePrimaryKey()
 		}
 		yyVAL.union = yyLOCAL
-	case 921:
+	case 956:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:5140
+//line mysql_sql.y:5406
 		{
 			yyLOCAL = tree.NewAttributeUniqueKey()
 		}
 		yyVAL.union = yyLOCAL
-	case 922:
+	case 957:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:5144
+//line mysql_sql.y:5410
 		{
 			yyLOCAL = tree.NewAttributeUnique()
 		}
 		yyVAL.union = yyLOCAL
-	case 923:
+	case 958:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:5148
+//line mysql_sql.y:5414
 		{
 			yyLOCAL = tree.NewAttributeKey()
 		}
 		yyVAL.union = yyLOCAL
-	case 924:
+	case 959:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.NumVal
-//line mysql_sql.y:5154
+//line mysql_sql.y:5420
 		{
 			ival, errStr := util.GetInt64(yyDollar[1].item)
 			if errStr != "" {
@@ -11484,96 +12023,96 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 925:
+	case 960:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5169
+//line mysql_sql.y:5435
 		{
 			yyLOCAL = tree.NewNumVal(constant.MakeString(yyDollar[1].str), yyDollar[1].str, false)
 		}
 		yyVAL.union = yyLOCAL
-	case 926:
+	case 961:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5173
+//line mysql_sql.y:5439
 		{
 			ival := util.GetUint64(yyDollar[1].item)
 			yyLOCAL = tree.NewNumVal(constant.MakeUint64(ival), yylex.(*Lexer).scanner.LastToken, false)
 		}
 		yyVAL.union = yyLOCAL
-	case 927:
+	case 962:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5178
+//line mysql_sql.y:5444
 		{
 			fval := yyDollar[1].item.(float64)
 			yyLOCAL = tree.NewNumValWithResFoalt(constant.MakeFloat64(fval), yylex.(*Lexer).scanner.LastToken, false, fval)
 		}
 		yyVAL.union = yyLOCAL
-	case 928:
+	case 963:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5183
+//line mysql_sql.y:5449
 		{
 			yyLOCAL = tree.NewNumVal(constant.MakeBool(true), "", false)
 		}
 		yyVAL.union = yyLOCAL
-	case 929:
+	case 964:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5187
+//line mysql_sql.y:5453
 		{
 			yyLOCAL = tree.NewNumVal(constant.MakeBool(false), "", false)
 		}
 		yyVAL.union = yyLOCAL
-	case 930:
+	case 965:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5191
+//line mysql_sql.y:5457
 		{
 			yyLOCAL = tree.NewNumVal(constant.MakeUnknown(), "", false)
 		}
 		yyVAL.union = yyLOCAL
-	case 931:
+	case 966:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5195
+//line mysql_sql.y:5461
 		{
 			ival := util.GetUint64(yyDollar[1].item)
 			yyLOCAL = tree.NewNumVal(constant.MakeUint64(ival), yylex.(*Lexer).scanner.LastToken, false)
 		}
 		yyVAL.union = yyLOCAL
-	case 932:
+	case 967:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5205
+//line mysql_sql.y:5471
 		{
 			yyLOCAL = yyDollar[1].columnTypeUnion()
 			yyLOCAL.InternalType.Unsigned = yyDollar[2].unsignedOptUnion()
 			yyLOCAL.InternalType.Zerofill = yyDollar[3].zeroFillOptUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 936:
+	case 971:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5216
+//line mysql_sql.y:5482
 		{
 			yyLOCAL = yyDollar[1].columnTypeUnion()
 			yyLOCAL.InternalType.DisplayWith = yyDollar[2].lengthOptUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 937:
+	case 972:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5221
+//line mysql_sql.y:5487
 		{
 			yyLOCAL = yyDollar[1].columnTypeUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 938:
+	case 973:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5227
+//line mysql_sql.y:5493
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11586,10 +12125,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 939:
+	case 974:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5239
+//line mysql_sql.y:5505
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11602,10 +12141,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 940:
+	case 975:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5251
+//line mysql_sql.y:5517
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11618,10 +12157,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 941:
+	case 976:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5263
+//line mysql_sql.y:5529
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11635,10 +12174,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 942:
+	case 977:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5276
+//line mysql_sql.y:5542
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11652,10 +12191,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 943:
+	case 978:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5289
+//line mysql_sql.y:5555
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11669,10 +12208,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 944:
+	case 979:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5302
+//line mysql_sql.y:5568
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11686,10 +12225,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 945:
+	case 980:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5315
+//line mysql_sql.y:5581
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11703,10 +12242,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 946:
+	case 981:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5328
+//line mysql_sql.y:5594
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11720,10 +12259,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 947:
+	case 982:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5341
+//line mysql_sql.y:5607
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11737,10 +12276,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 948:
+	case 983:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5354
+//line mysql_sql.y:5620
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11754,10 +12293,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 949:
+	case 984:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5367
+//line mysql_sql.y:5633
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11771,10 +12310,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 950:
+	case 985:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5380
+//line mysql_sql.y:5646
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11788,10 +12327,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 951:
+	case 986:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5393
+//line mysql_sql.y:5659
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11805,10 +12344,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 952:
+	case 987:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5408
+//line mysql_sql.y:5674
 		{
 			locale := ""
 			if yyDollar[2].lengthScaleOptUnion().DisplayWith > 255 {
@@ -11832,10 +12371,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 953:
+	case 988:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5431
+//line mysql_sql.y:5697
 		{
 			locale := ""
 			if yyDollar[2].lengthScaleOptUnion().Precision != tree.NotDefineDec && yyDollar[2].lengthScaleOptUnion().Precision > yyDollar[2].lengthScaleOptUnion().DisplayWith {
@@ -11872,10 +12411,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 954:
+	case 989:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5468
+//line mysql_sql.y:5734
 		{
 			locale := ""
 			if yyDollar[2].lengthScaleOptUnion().Precision != tree.NotDefineDec && yyDollar[2].lengthScaleOptUnion().Precision > yyDollar[2].lengthScaleOptUnion().DisplayWith {
@@ -11912,10 +12451,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 955:
+	case 990:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5516
+//line mysql_sql.y:5782
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11931,10 +12470,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 956:
+	case 991:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5533
+//line mysql_sql.y:5799
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11947,10 +12486,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 957:
+	case 992:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5545
+//line mysql_sql.y:5811
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11966,10 +12505,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 958:
+	case 993:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5560
+//line mysql_sql.y:5826
 		{
 			locale := ""
 			if yyDollar[2].lengthOptUnion() < 0 || yyDollar[2].lengthOptUnion() > 6 {
@@ -11990,10 +12529,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 959:
+	case 994:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5580
+//line mysql_sql.y:5846
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12009,10 +12548,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 960:
+	case 995:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5595
+//line mysql_sql.y:5861
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12027,10 +12566,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 961:
+	case 996:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5611
+//line mysql_sql.y:5877
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12044,10 +12583,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 962:
+	case 997:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5624
+//line mysql_sql.y:5890
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12061,10 +12600,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 963:
+	case 998:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5637
+//line mysql_sql.y:5903
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12078,10 +12617,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 964:
+	case 999:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5650
+//line mysql_sql.y:5916
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12095,10 +12634,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 965:
+	case 1000:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5663
+//line mysql_sql.y:5929
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12111,10 +12650,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 966:
+	case 1001:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5675
+//line mysql_sql.y:5941
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12127,10 +12666,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 967:
+	case 1002:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5687
+//line mysql_sql.y:5953
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12143,10 +12682,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 968:
+	case 1003:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5699
+//line mysql_sql.y:5965
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12159,10 +12698,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 969:
+	case 1004:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5711
+//line mysql_sql.y:5977
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12175,10 +12714,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 970:
+	case 1005:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5723
+//line mysql_sql.y:5989
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12191,10 +12730,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 971:
+	case 1006:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5735
+//line mysql_sql.y:6001
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12207,10 +12746,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 972:
+	case 1007:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5747
+//line mysql_sql.y:6013
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12223,10 +12762,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 973:
+	case 1008:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5759
+//line mysql_sql.y:6025
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12239,10 +12778,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 974:
+	case 1009:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.T
+//line mysql_sql.y:6037
+		{
+			locale := ""
+			yyLOCAL = &tree.T{
+				InternalType: tree.InternalType{
+					Family:       tree.UuidFamily,
+					FamilyString: yyDollar[1].str,
+					Locale:       &locale,
+					Oid:          uint32(defines.MYSQL_TYPE_UUID),
+				},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 1010:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5771
+//line mysql_sql.y:6049
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12256,10 +12811,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 975:
+	case 1011:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5784
+//line mysql_sql.y:6062
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12273,10 +12828,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 976:
+	case 1012:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5799
+//line mysql_sql.y:6077
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12289,75 +12844,75 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 977:
+	case 1013:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:5822
+//line mysql_sql.y:6100
 		{
 			yyLOCAL = make([]string, 0, 4)
 			yyLOCAL = append(yyLOCAL, yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 978:
+	case 1014:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:5827
+//line mysql_sql.y:6105
 		{
 			yyLOCAL = append(yyDollar[1].strsUnion(), yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 979:
+	case 1015:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:5833
+//line mysql_sql.y:6111
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 981:
+	case 1017:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:5840
+//line mysql_sql.y:6118
 		{
 			yyLOCAL = 6
 		}
 		yyVAL.union = yyLOCAL
-	case 982:
+	case 1018:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:5844
+//line mysql_sql.y:6122
 		{
 			yyLOCAL = int32(yyDollar[2].item.(int64))
 		}
 		yyVAL.union = yyLOCAL
-	case 983:
+	case 1019:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:5849
+//line mysql_sql.y:6127
 		{
 			yyLOCAL = int32(-1)
 		}
 		yyVAL.union = yyLOCAL
-	case 984:
+	case 1020:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:5853
+//line mysql_sql.y:6131
 		{
 			yyLOCAL = int32(yyDollar[2].item.(int64))
 		}
 		yyVAL.union = yyLOCAL
-	case 985:
+	case 1021:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:5859
+//line mysql_sql.y:6137
 		{
 			yyLOCAL = tree.GetDisplayWith(int32(yyDollar[2].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 986:
+	case 1022:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:5865
+//line mysql_sql.y:6143
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.NotDefineDisplayWidth,
@@ -12365,10 +12920,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 987:
+	case 1023:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:5872
+//line mysql_sql.y:6150
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.GetDisplayWith(int32(yyDollar[2].item.(int64))),
@@ -12376,10 +12931,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 988:
+	case 1024:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:5879
+//line mysql_sql.y:6157
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.GetDisplayWith(int32(yyDollar[2].item.(int64))),
@@ -12387,10 +12942,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 989:
+	case 1025:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:5888
+//line mysql_sql.y:6166
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: 10, // this is the default precision for decimal
@@ -12398,10 +12953,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 990:
+	case 1026:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:5895
+//line mysql_sql.y:6173
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.GetDisplayWith(int32(yyDollar[2].item.(int64))),
@@ -12409,10 +12964,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 991:
+	case 1027:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:5902
+//line mysql_sql.y:6180
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.GetDisplayWith(int32(yyDollar[2].item.(int64))),
@@ -12420,52 +12975,52 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 992:
+	case 1028:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:5911
+//line mysql_sql.y:6189
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 993:
+	case 1029:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:5915
+//line mysql_sql.y:6193
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 994:
+	case 1030:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:5919
+//line mysql_sql.y:6197
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 995:
+	case 1031:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:5925
+//line mysql_sql.y:6203
 		{
 		}
-	case 996:
+	case 1032:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:5927
+//line mysql_sql.y:6205
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 1000:
+	case 1036:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:5937
+//line mysql_sql.y:6215
 		{
 			yyVAL.str = ""
 		}
-	case 1001:
+	case 1037:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:5941
+//line mysql_sql.y:6219
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
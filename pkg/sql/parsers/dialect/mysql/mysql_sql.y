@@ -162,6 +162,8 @@ import (
     withClause *tree.With
     cte *tree.CTE
     cteList []*tree.CTE
+    tableSample *tree.TableSample
+    sampleMethod tree.SampleMethod
 }
 
 %token LEX_ERROR
@@ -176,6 +178,7 @@ import (
 %left <str> SUBQUERY_AS_EXPR
 %left <str> '(' ',' ')'
 %token <str> ID AT_ID AT_AT_ID STRING VALUE_ARG LIST_ARG COMMENT COMMENT_KEYWORD
+%token <str> JSON_EXTRACT_OP JSON_UNQUOTE_EXTRACT_OP
 %token <item> INTEGRAL HEX HEXNUM BIT_LITERAL FLOAT
 %token <str> NULL TRUE FALSE
 %left EMPTY_FROM_CLAUSE
@@ -210,7 +213,7 @@ import (
 %token <str> TIME TIMESTAMP DATETIME YEAR
 %token <str> CHAR VARCHAR BOOL CHARACTER VARBINARY NCHAR
 %token <str> TEXT TINYTEXT MEDIUMTEXT LONGTEXT
-%token <str> BLOB TINYBLOB MEDIUMBLOB LONGBLOB JSON ENUM
+%token <str> BLOB TINYBLOB MEDIUMBLOB LONGBLOB JSON ENUM UUID
 %token <str> GEOMETRY POINT LINESTRING POLYGON GEOMETRYCOLLECTION MULTIPOINT MULTILINESTRING MULTIPOLYGON
 %token <str> INT1 INT2 INT3 INT4 INT8
 
@@ -225,7 +228,7 @@ import (
 %token <str> DYNAMIC COMPRESSED REDUNDANT COMPACT FIXED COLUMN_FORMAT AUTO_RANDOM
 %token <str> RESTRICT CASCADE ACTION PARTIAL SIMPLE CHECK ENFORCED
 %token <str> RANGE LIST ALGORITHM LINEAR PARTITIONS SUBPARTITION SUBPARTITIONS
-%token <str> TYPE
+%token <str> TYPE EXTERNAL LOCATION
 
 // MO table option
 %token <str> PROPERTIES
@@ -282,6 +285,12 @@ import (
 // With
 %token <str> RECURSIVE
 
+// Table sample
+%token <str> TABLESAMPLE SYSTEM BERNOULLI PERCENT
+
+// Materialized view
+%token <str> MATERIALIZED REFRESH
+
 // Match
 %token <str> MATCH AGAINST BOOLEAN LANGUAGE WITH QUERY EXPANSION
 
@@ -291,6 +300,7 @@ import (
 %token <str> GROUP_CONCAT MAX MID MIN NOW POSITION SESSION_USER STD STDDEV
 %token <str> STDDEV_POP STDDEV_SAMP SUBDATE SUBSTR SUBSTRING SUM SYSDATE
 %token <str> SYSTEM_USER TRANSLATE TRIM VARIANCE VAR_POP VAR_SAMP AVG
+%token <str> TIMESTAMPADD TIMESTAMPDIFF
 
 // Insert
 %token <str> ROW OUTFILE HEADER MAX_FILE_SIZE FORCE_QUOTE
@@ -300,14 +310,14 @@ import (
 %type <statement> stmt
 %type <statements> stmt_list
 %type <statement> create_stmt insert_stmt delete_stmt drop_stmt alter_stmt
-%type <statement> drop_ddl_stmt drop_database_stmt drop_table_stmt drop_index_stmt
+%type <statement> drop_ddl_stmt drop_database_stmt drop_table_stmt drop_index_stmt drop_view_stmt
 %type <statement> drop_role_stmt drop_user_stmt
 %type <statement> create_user_stmt create_role_stmt
 %type <statement> create_ddl_stmt create_table_stmt create_database_stmt create_index_stmt create_view_stmt
 %type <statement> show_stmt show_create_stmt show_columns_stmt show_databases_stmt
 %type <statement> show_tables_stmt show_process_stmt show_errors_stmt show_warnings_stmt
 %type <statement> show_variables_stmt show_status_stmt show_index_stmt
-%type <statement> alter_user_stmt update_stmt use_stmt
+%type <statement> alter_user_stmt update_stmt use_stmt refresh_stmt
 %type <statement> transaction_stmt begin_stmt commit_stmt rollback_stmt
 %type <statement> explain_stmt explainable_stmt
 %type <statement> set_stmt set_variable_stmt set_password_stmt set_role_stmt set_default_role_stmt
@@ -321,7 +331,7 @@ import (
 %type <selectExprs> select_expression_list
 %type <selectExpr> select_expression
 %type <tableExprs> table_references
-%type <tableExpr> table_reference table_factor join_table into_table_name
+%type <tableExpr> table_reference table_factor join_table into_table_name table_function
 %type <direction> asc_desc_opt
 %type <order> order
 %type <orderBy> order_list order_by_clause order_by_opt
@@ -354,6 +364,9 @@ import (
 %type <where> where_expression_opt having_opt
 %type <groupBy> group_by_opt
 %type <aliasedTableExpr> aliased_table_name
+%type <tableSample> table_sample_opt
+%type <sampleMethod> sample_method
+%type <numVal> sample_percent sample_seed_opt
 %type <unionTypeRecord> union_op
 %type <parenTableExpr> derived_table
 %type <str> inner_join straight_join outer_join natural_join
@@ -411,7 +424,7 @@ import (
 %type <resourceOptions> conn_option_list conn_options
 %type <resourceOption> conn_option
 %type <updateExpr> update_expression
-%type <updateExprs> update_list
+%type <updateExprs> update_list on_duplicate_key_update_opt
 %type <completionType> completion_type
 %type <str> id_prefix_at password_opt
 %type <boolVal> grant_option_opt enforce enforce_opt
@@ -430,7 +443,7 @@ import (
 %type <unresolveNames> column_name_list
 %type <partitionOption> partition_by_opt
 %type <partitionBy> partition_method sub_partition_method sub_partition_opt
-%type <str> fields_or_columns
+%type <str> fields_or_columns file_format_opt compression_opt
 %type <int64Val> algorithm_opt partition_num_opt sub_partition_num_opt
 %type <boolVal> linear_opt
 %type <partition> partition
@@ -527,6 +540,7 @@ stmt:
 |   revoke_stmt
 |   grant_stmt
 |   load_data_stmt
+|   refresh_stmt
 |   select_stmt
     {
         $$ = $1
@@ -536,19 +550,61 @@ stmt:
         $$ = tree.Statement(nil)
     }
 
+refresh_stmt:
+    REFRESH MATERIALIZED VIEW table_name
+    {
+        $$ = &tree.RefreshMaterializedView{Name: $4}
+    }
+
 load_data_stmt:
-    LOAD DATA local_opt INFILE STRING duplicate_opt INTO TABLE table_name load_fields load_lines ignore_lines columns_or_variable_list_opt load_set_spec_opt
+    LOAD DATA local_opt INFILE STRING duplicate_opt INTO TABLE table_name file_format_opt compression_opt load_fields load_lines ignore_lines columns_or_variable_list_opt load_set_spec_opt
     {
         $$ = &tree.Load{
             Local: $3,
             File: $5,
             DuplicateHandling: $6,
             Table: $9,
-            Fields: $10,
-            Lines: $11,
-            IgnoredLines: uint64($12),
-            ColumnList: $13,
-            Assignments: $14,
+            FileFormat: $10,
+            Compression: $11,
+            Fields: $12,
+            Lines: $13,
+            IgnoredLines: uint64($14),
+            ColumnList: $15,
+            Assignments: $16,
+        }
+    }
+
+file_format_opt:
+    {
+        $$ = tree.CSV
+    }
+|   FORMAT STRING
+    {
+        str := strings.ToLower($2)
+        switch str {
+        case tree.CSV, tree.JSONLINE, tree.PARQUET:
+            $$ = str
+        case "jsonl":
+            $$ = tree.JSONLINE
+        default:
+            yylex.Error("error file format")
+            return 1
+        }
+    }
+
+compression_opt:
+    {
+        $$ = tree.AUTO
+    }
+|   COMPRESSION STRING
+    {
+        str := strings.ToLower($2)
+        switch str {
+        case tree.AUTO, tree.NOCOMPRESS, tree.GZIP, tree.ZSTD, tree.LZ4:
+            $$ = str
+        default:
+            yylex.Error("error compression")
+            return 1
         }
     }
 
@@ -1867,6 +1923,10 @@ show_create_stmt:
     {
         $$ = &tree.ShowCreateTable{Name: $4}
     }
+|   SHOW CREATE VIEW table_name_unresolved
+    {
+        $$ = &tree.ShowCreateView{Name: $4}
+    }
 |   SHOW CREATE DATABASE not_exists_opt db_name
     {
         $$ = &tree.ShowCreateDatabase{IfNotExists: $4, Name: $5}
@@ -1905,6 +1965,7 @@ drop_stmt:
 drop_ddl_stmt:
     drop_database_stmt
 |   drop_table_stmt
+|   drop_view_stmt
 |   drop_index_stmt
 |   drop_role_stmt
 |   drop_user_stmt
@@ -1943,6 +2004,16 @@ drop_table_stmt:
         $$ = &tree.DropTable{IfExists: $3, Names: $4}
     }
 
+drop_view_stmt:
+    DROP VIEW exists_opt table_name_list
+    {
+        $$ = &tree.DropView{IfExists: $3, Names: $4}
+    }
+|   DROP MATERIALIZED VIEW exists_opt table_name_list
+    {
+        $$ = &tree.DropView{IfExists: $4, Names: $5, Materialized: true}
+    }
+
 drop_database_stmt:
     DROP DATABASE exists_opt database_id
     {
@@ -1965,14 +2036,24 @@ delete_stmt:
 // |   DELETE comment_opt ignore_opt delete_table_list from_or_using table_references where_expression_opt
 
 insert_stmt:
-    INSERT into_table_name partition_clause_opt insert_data
+    INSERT into_table_name partition_clause_opt insert_data on_duplicate_key_update_opt
     {
         ins := $4
         ins.Table = $2
         ins.PartitionNames = $3
+        ins.OnDuplicateUpdate = $5
         $$ = ins
     }
 
+on_duplicate_key_update_opt:
+    {
+        $$ = nil
+    }
+|   ON DUPLICATE KEY UPDATE update_list
+    {
+        $$ = $5
+    }
+
 insert_data:
     VALUES values_list
     {
@@ -2146,7 +2227,7 @@ export_data_param_opt:
     {
         $$ = nil
     }
-|   INTO OUTFILE STRING export_fields export_lines_opt header_opt max_file_size_opt force_quote_opt
+|   INTO OUTFILE STRING export_fields export_lines_opt header_opt max_file_size_opt force_quote_opt file_format_opt compression_opt
     {
         $$ = &tree.ExportParam{
             Outfile:    true,
@@ -2156,6 +2237,8 @@ export_data_param_opt:
             Header:     $6,
             MaxFileSize:uint64($7)*1024,
             ForceQuote: $8,
+            FileFormat: $9,
+            Compression: $10,
         }
     }
 
@@ -2763,8 +2846,49 @@ table_factor:
             },
         }
     }
+|   table_function as_opt_id
+    {
+        $$ = &tree.AliasedTableExpr{
+            Expr: $1,
+            As: tree.AliasClause{
+                Alias: tree.Identifier($2),
+            },
+        }
+    }
+|   table_function table_alias '(' column_list ')'
+    {
+        $$ = &tree.AliasedTableExpr{
+            Expr: $1,
+            As: tree.AliasClause{
+                Alias: tree.Identifier($2),
+                Cols: $4,
+            },
+        }
+    }
+|   table_function AS table_alias '(' column_list ')'
+    {
+        $$ = &tree.AliasedTableExpr{
+            Expr: $1,
+            As: tree.AliasClause{
+                Alias: tree.Identifier($3),
+                Cols: $5,
+            },
+        }
+    }
 // |   '(' table_references ')'
 
+table_function:
+    ident '(' expression_list_opt ')'
+    {
+        name := tree.SetUnresolvedName(strings.ToLower($1))
+        $$ = &tree.TableFunction{
+            Func: &tree.FuncExpr{
+                Func: tree.FuncName2ResolvableFunctionReference(name),
+                Exprs: $3,
+            },
+        }
+    }
+
 derived_table:
     '(' select_no_parens ')'
     {
@@ -2776,18 +2900,64 @@ as_opt:
 |   AS {}
 
 aliased_table_name:
-    table_name as_opt_id // index_hint_list
+    table_name as_opt_id table_sample_opt // index_hint_list
     {
         $$ = &tree.AliasedTableExpr{
             Expr: $1,
             As: tree.AliasClause{
                 Alias: tree.Identifier($2),
             },
+            Sample: $3,
         }
     }
 // |   table_name PARTITION '(' partition_id_list ')' as_opt_id index_hint_list
 
 
+table_sample_opt:
+    {
+        $$ = nil
+    }
+|   TABLESAMPLE sample_method '(' sample_percent PERCENT ')' sample_seed_opt
+    {
+        $$ = &tree.TableSample{
+            Method: $2,
+            Percent: $4,
+            Seed: $7,
+        }
+    }
+
+sample_method:
+    SYSTEM
+    {
+        $$ = tree.SampleSystem
+    }
+|   BERNOULLI
+    {
+        $$ = tree.SampleBernoulli
+    }
+
+sample_percent:
+    INTEGRAL
+    {
+        ival := util.GetUint64($1)
+        $$ = tree.NewNumVal(constant.MakeUint64(ival), strconv.FormatUint(ival, 10), false)
+    }
+|   FLOAT
+    {
+        fval := $1.(float64)
+        $$ = tree.NewNumValWithResFoalt(constant.MakeFloat64(fval), strconv.FormatFloat(fval, 'f', -1, 64), false, fval)
+    }
+
+sample_seed_opt:
+    {
+        $$ = nil
+    }
+|   REPEATABLE '(' INTEGRAL ')'
+    {
+        ival := util.GetUint64($3)
+        $$ = tree.NewNumVal(constant.MakeUint64(ival), strconv.FormatUint(ival, 10), false)
+    }
+
 as_opt_id:
     {
         $$ = ""
@@ -2864,6 +3034,17 @@ create_view_stmt:
        }
     }
 
+|	CREATE MATERIALIZED VIEW not_exists_opt table_name column_list_opt AS select_stmt
+    {
+		$$ = &tree.CreateView{
+			Name: $5,
+			ColNames: $6,
+			AsSource: $8,
+			IfNotExists: $4,
+			Materialized: true,
+		}
+    }
+
 view_recursive_opt:
 	{}
 |	RECURSIVE
@@ -3297,6 +3478,21 @@ create_table_stmt:
             PartitionOption: $10,
         }
     }
+|   CREATE EXTERNAL TABLE not_exists_opt table_name '(' table_elem_list_opt ')' LOCATION STRING file_format_opt compression_opt load_fields ignore_lines
+    {
+        $$ = &tree.CreateTable {
+            IfNotExists: $4,
+            Table: *$5,
+            Defs: $7,
+            Param: &tree.ExternParam{
+                Location: $10,
+                FileFormat: $11,
+                Compression: $12,
+                Fields: $13,
+                IgnoredLines: uint64($14),
+            },
+        }
+    }
 
 temporary_opt:
     {
@@ -3794,6 +3990,12 @@ constaint_def:
 			switch v := $2.(type) {
             case *tree.PrimaryKeyIndex:
             	v.Name = $1
+            case *tree.ForeignKey:
+            	if v.Name == "" {
+            		v.Name = $1
+            	}
+            case *tree.CheckIndex:
+            	v.Name = $1
             }
 		}
 		$$ = $2
@@ -3874,7 +4076,7 @@ constraint_elem:
 
 enforce_opt:
 	{
-		$$ = false
+		$$ = true
 	}
 |	enforce
 
@@ -4019,6 +4221,10 @@ column_attribute_elem:
     {
         $$ = tree.NewAttributeStorage($2)
     }
+|   COMPRESSION STRING
+    {
+        $$ = tree.NewAttributeCompression($2)
+    }
 |   AUTO_RANDOM field_length_opt
     {
         $$ = tree.NewAttributeAutoRandom(int($2))
@@ -4029,7 +4235,7 @@ column_attribute_elem:
     }
 |   constraint_keyword_opt CHECK '(' expression ')'
     {
-        $$ = tree.NewAttributeCheck($4, false, $1)
+        $$ = tree.NewAttributeCheck($4, true, $1)
     }
 |   constraint_keyword_opt CHECK '(' expression ')' enforce
     {
@@ -4267,6 +4473,27 @@ simple_expr:
     {
         $$ = $1
     }
+|   normal_ident JSON_EXTRACT_OP STRING
+    {
+        name := tree.SetUnresolvedName("json_extract")
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            Exprs: tree.Exprs{$1, tree.NewNumVal(constant.MakeString($3), $3, false)},
+        }
+    }
+|   normal_ident JSON_UNQUOTE_EXTRACT_OP STRING
+    {
+        name := tree.SetUnresolvedName("json_extract")
+        extract := &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            Exprs: tree.Exprs{$1, tree.NewNumVal(constant.MakeString($3), $3, false)},
+        }
+        name = tree.SetUnresolvedName("json_unquote")
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            Exprs: tree.Exprs{extract},
+        }
+    }
 |   variable
     {
         $$ = $1
@@ -4475,6 +4702,18 @@ cast_type:
 	        },
         }
     }
+|   UUID
+    {
+        locale := ""
+        $$ = &tree.T{
+            InternalType: tree.InternalType{
+		        Family: tree.UuidFamily,
+                FamilyString: $1,
+		        Locale: &locale,
+		        Oid:    uint32(defines.MYSQL_TYPE_UUID),
+	        },
+        }
+    }
 |   SIGNED integer_opt
     {
         locale := ""
@@ -4690,6 +4929,24 @@ function_call_generic:
              Exprs: tree.Exprs{timeUinit, $5},
        }
     }
+|   TIMESTAMPADD '(' time_stamp_unit ',' expression ',' expression ')'
+    {
+        name := tree.SetUnresolvedName(strings.ToLower($1))
+        timeUnit := tree.SetUnresolvedName(strings.ToLower($3))
+        $$ = &tree.FuncExpr{
+             Func: tree.FuncName2ResolvableFunctionReference(name),
+             Exprs: tree.Exprs{timeUnit, $5, $7},
+       }
+    }
+|   TIMESTAMPDIFF '(' time_stamp_unit ',' expression ',' expression ')'
+    {
+        name := tree.SetUnresolvedName(strings.ToLower($1))
+        timeUnit := tree.SetUnresolvedName(strings.ToLower($3))
+        $$ = &tree.FuncExpr{
+             Func: tree.FuncName2ResolvableFunctionReference(name),
+             Exprs: tree.Exprs{timeUnit, $5, $7},
+       }
+    }
 |	func_not_keyword '(' expression_list_opt ')'
 	{
         name := tree.SetUnresolvedName(strings.ToLower($1))
@@ -4740,7 +4997,14 @@ time_stamp_unit:
 |	SQL_TSI_YEAR
 
 function_call_nonkeyword:
-    CURTIME datatime_precision
+    CURDATE '(' ')'
+    {
+        name := tree.SetUnresolvedName(strings.ToLower($1))
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+        }
+    }
+|   CURTIME datatime_precision
     {
         name := tree.SetUnresolvedName(strings.ToLower($1))
         var es tree.Exprs = nil
@@ -4939,6 +5203,8 @@ name_confict:
 |   TIMESTAMP
 |   TRUNCATE
 |   USER
+|   UUID
+|   VALUES
 |   WEEK
 |   YEAR
 
@@ -5767,6 +6033,18 @@ char_type:
             },
         }
     }
+|   UUID
+    {
+        locale := ""
+        $$ = &tree.T{
+            InternalType: tree.InternalType{
+		        Family: tree.UuidFamily,
+                FamilyString: $1,
+		        Locale: &locale,
+		        Oid:uint32(defines.MYSQL_TYPE_UUID),
+            },
+        }
+    }
 |   ENUM '(' enum_values ')'
     {
         locale := ""
@@ -6060,6 +6338,7 @@ reserved_keyword:
 |   SHOW
 |   STRAIGHT_JOIN
 |   TABLE
+|   TABLESAMPLE
 |   TABLES
 |   THEN
 |   TO
@@ -6107,6 +6386,9 @@ non_reserved_keyword:
 |   ACTION
 |   ALGORITHM
 |   BEGIN
+|   BERNOULLI
+|   MATERIALIZED
+|   REFRESH
 |   BIGINT
 |   BIT
 |   BLOB
@@ -6138,6 +6420,7 @@ non_reserved_keyword:
 |   ENCRYPTION
 |   ENGINE
 |   EXPANSION
+|   EXTERNAL
 |   EXTENDED
 |   EXPIRE
 |   EXCEPT
@@ -6166,6 +6449,7 @@ non_reserved_keyword:
 |   LONGBLOB
 |   LONGTEXT
 |   LOCAL
+|   LOCATION
 |   LINEAR
 |   LIST
 |   MEDIUMBLOB
@@ -6197,6 +6481,7 @@ non_reserved_keyword:
 |   PARTIAL
 |   PARTITION
 |   PARTITIONS
+|   PERCENT
 |   POINT
 |   POLYGON
 |   PROCEDURE
@@ -6230,6 +6515,7 @@ non_reserved_keyword:
 |   STATS_SAMPLE_PAGES
 |   SUBPARTITIONS
 |   SUBPARTITION
+|   SYSTEM
 |   SIMPLE
 |   TEXT
 |   THAN
@@ -6243,6 +6529,7 @@ non_reserved_keyword:
 |   UNUSED
 |   UNLOCK
 |   USER
+|   UUID
 |   VARBINARY
 |   VARCHAR
 |   VARIABLES
@@ -6263,6 +6550,7 @@ func_not_keyword:
 	DATE_ADD
 |	DATE_SUB
 |   NOW
+|   LAST_INSERT_ID
 
 not_keyword:
     ADDDATE
@@ -6295,6 +6583,8 @@ not_keyword:
 |   SUM
 |   SYSDATE
 |   SYSTEM_USER
+|   TIMESTAMPADD
+|   TIMESTAMPDIFF
 |   TRANSLATE
 |   TRIM
 |   VARIANCE
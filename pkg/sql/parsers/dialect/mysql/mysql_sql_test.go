@@ -64,6 +64,27 @@ var (
 	}, {
 		input:  "select date_add(now(), interval '1 day') from t1;",
 		output: "select date_add(now(), interval(1 day)) from t1",
+	}, {
+		input:  "drop view if exists v1, db.v2",
+		output: "drop view if exists v1, db.v2",
+	}, {
+		input:  "show create view db.v",
+		output: "show create view db.v",
+	}, {
+		input:  "create materialized view if not exists db.mv (a, c) as select a, count(*) from t group by a",
+		output: "create materialized view if not exists db.mv (a, c) as select a, count(*) from t group by a",
+	}, {
+		input:  "refresh materialized view mv",
+		output: "refresh materialized view mv",
+	}, {
+		input:  "drop materialized view if exists mv",
+		output: "drop materialized view if exists mv",
+	}, {
+		input:  "select last_insert_id()",
+		output: "select last_insert_id() from dual",
+	}, {
+		input:  "create table t (a int auto_increment primary key, b int) auto_increment = 10",
+		output: "create table t (a int auto_increment primary key, b int) auto_increment = 10",
 	}, {
 		input:  "SELECT md.datname as `Database` FROM TT md",
 		output: "select md.datname as Database from tt as md",
@@ -88,6 +109,29 @@ var (
 		input: "with tw as (select * from t2), tf as (select * from t3) select * from tw where a > 1",
 	}, {
 		input: "with tw as (select * from t2) select * from tw where a > 1",
+	}, {
+		input:  "with recursive c (n) as (select 1 union all select n + 1 from c where n < 10) select n from c",
+		output: "with recursive c(n) as (select 1 from dual union all select n + 1 from c where n < 10) select n from c",
+	}, {
+		input: "select count(*) from t tablesample system (10 percent)",
+	}, {
+		input: "select a from t as t1 tablesample bernoulli (0.5 percent) repeatable (42) where a > 1",
+	}, {
+		input: "select approx_percentile(a, 0.9) from t1 tablesample system (20 percent) repeatable (7)",
+	}, {
+		input:  "create external table if not exists t (a int, b varchar(10)) location 'file:///data/t/*.csv' fields terminated by '|' ignore 1 lines",
+		output: "create external table if not exists t (a int, b varchar(10)) location file:///data/t/*.csv fields terminated by | ignore 1 lines",
+	}, {
+		input:  "create external table t (a int) location 'file:///data/t.jsonl' format 'JSONL' compression 'gzip'",
+		output: "create external table t (a int) location file:///data/t.jsonl format jsonline compression gzip",
+	}, {
+		input: "select * from generate_series(1, 10, 2) as g(n) where n > 3",
+	}, {
+		input:  "select * from unnest('[1, 2, 3]') u",
+		output: "select * from unnest([1, 2, 3]) as u",
+	}, {
+		input:  "select t.rows from metadata_scan('db.t1') as t join t2 on t.block_id = t2.a",
+		output: "select t.rows from metadata_scan(db.t1) as t inner join t2 on t.block_id = t2.a",
 	}, {
 		input:  "create table t (a double(13))  // comment",
 		output: "create table t (a double(13))",
@@ -96,6 +140,17 @@ var (
 	}, {
 		input:  "select extract(year from l_shipdate) as l_year from t",
 		output: "select extract(year, l_shipdate) as l_year from t",
+	}, {
+		input: "select timestampdiff(minute, a, b), timestampadd(week, 2, a), curdate() from t",
+	}, {
+		input: "create table t (a json)",
+	}, {
+		input: "create table t (a uuid primary key, b enum('x', 'y'), c set('r', 'w'))",
+	}, {
+		input: "select uuid(), uuid_to_bin(a, 1), bin_to_uuid(b) from t",
+	}, {
+		input:  "select a->'$.b', t.a->>'$.c[0]', json_extract(a, '$.d', '$.e') from t where a -> '$.b' = 1",
+		output: "select json_extract(a, $.b), json_unquote(json_extract(t.a, $.c[0])), json_extract(a, $.d, $.e) from t where json_extract(a, $.b) = 1",
 	}, {
 		input:  "select * from R join S on R.uid = S.uid where l_shipdate <= date '1998-12-01' - interval '112 day'",
 		output: "select * from r inner join s on r.uid = s.uid where l_shipdate <= date(1998-12-01) - interval(112 day)",
@@ -126,6 +181,9 @@ var (
 		input: "create table t (a int, index idx1 using zonemap (a))",
 	}, {
 		input: "create table t (a int, index idx1 using bsi (a))",
+	}, {
+		input:  "create table t (a int compression 'delta,zstd', b varchar(10) compression 'dict')",
+		output: "create table t (a int compression delta,zstd, b varchar(10) compression dict)",
 	}, {
 		input:  "set @@sql_mode ='TRADITIONAL'",
 		output: "set sql_mode = TRADITIONAL",
@@ -155,6 +213,12 @@ var (
 	}, {
 		input:  "INSERT INTO t1 SET f1 = -1;",
 		output: "insert into t1 (f1) values (-1)",
+	}, {
+		input:  "insert into t1 values (1, 2) on duplicate key update b = values(b) + 1, c = 3",
+		output: "insert into t1 values (1, 2) on duplicate key update b = values(b) + 1, c = 3",
+	}, {
+		input:  "insert into t1 (a, b) select a, b from t2 on duplicate key update b = t2.b",
+		output: "insert into t1 (a, b) select a, b from t2 on duplicate key update b = t2.b",
 	}, {
 		input:  "insert into t1 values (18446744073709551615), (0xFFFFFFFFFFFFFFFE), (18446744073709551613), (18446744073709551612)",
 		output: "insert into t1 values (18446744073709551615), (0xfffffffffffffffe), (18446744073709551613), (18446744073709551612)",
@@ -169,6 +233,14 @@ var (
 		output: "load data infile /root/lineorder_flat_10.tbl into table lineorder_flat fields terminated by \t optionally enclosed by \u0000 lines",
 	}, {
 		input: "create table t (a int, b char, check (1 + 1) enforced)",
+	}, {
+		input:  "create table t (a int, b char, constraint c1 check (a > 0))",
+		output: "create table t (a int, b char, constraint c1 check (a > 0) enforced)",
+	}, {
+		input: "create table t (a int, b char, check (a > 0) not enforced)",
+	}, {
+		input:  "create table t (a int, b char, constraint fk1 foreign key (a) references p(id) on delete set null)",
+		output: "create table t (a int, b char, foreign key fk1 (a) references p(id) on delete set null)",
 	}, {
 		input: "create table t (a int, b char, foreign key sdf (a, b) references b(a asc, b desc))",
 	}, {
@@ -211,7 +283,7 @@ var (
 		input:  "load data local infile 'data' replace into table db.a (a, b, @vc, @vd) set a = @vc != 0, d = @vd != 1",
 		output: "load data local infile data replace into table db.a (a, b, @vc, @vd) set a = @vc != 0, d = @vd != 1",
 	}, {
-		input: "load data local infile 'data' replace into table db.a lines starting by '#' terminated by '\t' ignore 2 lines",
+		input:  "load data local infile 'data' replace into table db.a lines starting by '#' terminated by '\t' ignore 2 lines",
 		output: "load data local infile data replace into table db.a lines starting by # terminated by 	 ignore 2 lines",
 	}, {
 		input:  "load data infile 'data.txt' into table db.a fields terminated by '\t' escaped by '\t'",
@@ -222,6 +294,18 @@ var (
 	}, {
 		input:  "load data infile 'data.txt' into table db.a",
 		output: "load data infile data.txt into table db.a",
+	}, {
+		input:  "load data infile 'data.parquet' into table db.a format 'PARQUET' (a, b)",
+		output: "load data infile data.parquet into table db.a format parquet (a, b)",
+	}, {
+		input:  "load data infile 'data.json' into table db.a format 'jsonline' ignore 1 lines",
+		output: "load data infile data.json into table db.a format jsonline ignore 1 lines",
+	}, {
+		input:  "load data infile 'data.csv.gz' into table db.a compression 'GZIP' fields terminated by ','",
+		output: "load data infile data.csv.gz into table db.a compression gzip fields terminated by ,",
+	}, {
+		input:  "load data infile 'data.json' into table db.a format 'jsonline' compression 'auto'",
+		output: "load data infile data.json into table db.a format jsonline",
 	}, {
 		input:  "show tables from test01 where tables_in_test01 like '%t2%'",
 		output: "show tables from test01 where tables_in_test01 like %t2%",
@@ -339,6 +423,9 @@ var (
 	}, {
 		input:  "select * from t where a like 'a%'",
 		output: "select * from t where a like a%",
+	}, {
+		input:  "select * from t where a rlike 'a.*' and b not regexp '^b'",
+		output: "select * from t where a regexp a.* and b not regexp ^b",
 	}, {
 		input: "select sysdate(), curtime(22) from t",
 	}, {
@@ -738,6 +825,15 @@ var (
 	}, {
 		input:  "select $ from t into outfile '/Users/tmp/test' FIELDS TERMINATED BY ',' LINES TERMINATED BY '\n' header 'FALSE' MAX_FILE_SIZE 100 FORCE_QUOTE (a, b)",
 		output: "select $ from t into outfile /Users/tmp/test fields terminated by , enclosed by \" lines terminated by \n header false max_file_size 102400 force_quote a, b",
+	}, {
+		input:  "select $ from t into outfile '/Users/tmp/test.parquet' format 'parquet'",
+		output: "select $ from t into outfile /Users/tmp/test.parquet fields terminated by , enclosed by \" lines terminated by \n header true format parquet",
+	}, {
+		input:  "select $ from t into outfile '/Users/tmp/test.json' header 'FALSE' format 'jsonline'",
+		output: "select $ from t into outfile /Users/tmp/test.json fields terminated by , enclosed by \" lines terminated by \n header false format jsonline",
+	}, {
+		input:  "select $ from t into outfile '/Users/tmp/test.csv' max_file_size 100 compression 'zstd'",
+		output: "select $ from t into outfile /Users/tmp/test.csv fields terminated by , enclosed by \" lines terminated by \n header true max_file_size 102400 compression zstd",
 	}}
 )
 
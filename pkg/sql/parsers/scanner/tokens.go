@@ -70,6 +70,7 @@ func initTokens(dialectType dialect.DialectType) {
 		ADDDATE = MYSQL_ADDDATE
 		COUNT = MYSQL_COUNT
 		APPROX_COUNT_DISTINCT = MYSQL_APPROX_COUNT_DISTINCT
+		APPROX_PERCENTILE = MYSQL_APPROX_PERCENTILE
 		CURDATE = MYSQL_CURDATE
 		DATE_ADD = MYSQL_DATE_ADD
 		DATE_SUB = MYSQL_DATE_SUB
@@ -92,6 +93,8 @@ func initTokens(dialectType dialect.DialectType) {
 		VAR_POP = MYSQL_VAR_POP
 		VAR_SAMP = MYSQL_VAR_SAMP
 		CURTIME = MYSQL_CURTIME
+		TIMESTAMPADD = MYSQL_TIMESTAMPADD
+		TIMESTAMPDIFF = MYSQL_TIMESTAMPDIFF
 		SYSDATE = MYSQL_SYSDATE
 		QUARTER = MYSQL_QUARTER
 		REPEAT = MYSQL_REPEAT
@@ -156,6 +159,15 @@ func initTokens(dialectType dialect.DialectType) {
 		GRANT = MYSQL_GRANT
 		OPTION = MYSQL_OPTION
 		REFERENCES = MYSQL_REFERENCES
+		RECURSIVE = MYSQL_RECURSIVE
+		TABLESAMPLE = MYSQL_TABLESAMPLE
+		SYSTEM = MYSQL_SYSTEM
+		BERNOULLI = MYSQL_BERNOULLI
+		PERCENT = MYSQL_PERCENT
+		EXTERNAL = MYSQL_EXTERNAL
+		LOCATION = MYSQL_LOCATION
+		MATERIALIZED = MYSQL_MATERIALIZED
+		REFRESH = MYSQL_REFRESH
 		REPLICATION = MYSQL_REPLICATION
 		SLAVE = MYSQL_SLAVE
 		CLIENT = MYSQL_CLIENT
@@ -295,6 +307,8 @@ func initTokens(dialectType dialect.DialectType) {
 		IN = MYSQL_IN
 		SHIFT_LEFT = MYSQL_SHIFT_LEFT
 		SHIFT_RIGHT = MYSQL_SHIFT_RIGHT
+		JSON_EXTRACT_OP = MYSQL_JSON_EXTRACT_OP
+		JSON_UNQUOTE_EXTRACT_OP = MYSQL_JSON_UNQUOTE_EXTRACT_OP
 		DIV = MYSQL_DIV
 		MOD = MYSQL_MOD
 		UNARY = MYSQL_UNARY
@@ -340,6 +354,7 @@ func initTokens(dialectType dialect.DialectType) {
 		LONGBLOB = MYSQL_LONGBLOB
 		JSON = MYSQL_JSON
 		ENUM = MYSQL_ENUM
+		UUID = MYSQL_UUID
 		GEOMETRY = MYSQL_GEOMETRY
 		POINT = MYSQL_POINT
 		LINESTRING = MYSQL_LINESTRING
@@ -466,6 +481,7 @@ func initTokens(dialectType dialect.DialectType) {
 		"bsi":                      BSI,
 		"before":                   UNUSED,
 		"begin":                    BEGIN,
+		"bernoulli":                BERNOULLI,
 		"between":                  BETWEEN,
 		"bigint":                   BIGINT,
 		"binary":                   BINARY,
@@ -564,6 +580,7 @@ func initTokens(dialectType dialect.DialectType) {
 		"exit":                     UNUSED,
 		"explain":                  EXPLAIN,
 		"expansion":                EXPANSION,
+		"external":                 EXTERNAL,
 		"extended":                 EXTENDED,
 		"expire":                   EXPIRE,
 		"except":                   EXCEPT,
@@ -653,8 +670,10 @@ func initTokens(dialectType dialect.DialectType) {
 		"loop":                     UNUSED,
 		"low_priority":             UNUSED,
 		"local":                    LOCAL,
+		"location":                 LOCATION,
 		"master_bind":              UNUSED,
 		"match":                    MATCH,
+		"materialized":             MATERIALIZED,
 		"maxvalue":                 MAXVALUE,
 		"mediumblob":               MEDIUMBLOB,
 		"mediumint":                MEDIUMINT,
@@ -709,6 +728,7 @@ func initTokens(dialectType dialect.DialectType) {
 		"partial":                  PARTIAL,
 		"password":                 PASSWORD,
 		"pack_keys":                PACK_KEYS,
+		"percent":                  PERCENT,
 		"point":                    POINT,
 		"polygon":                  POLYGON,
 		"precision":                UNUSED,
@@ -726,7 +746,9 @@ func initTokens(dialectType dialect.DialectType) {
 		"redundant":                REDUNDANT,
 		"read_write":               UNUSED,
 		"real":                     REAL,
+		"recursive":                RECURSIVE,
 		"references":               REFERENCES,
+		"refresh":                  REFRESH,
 		"regexp":                   REGEXP,
 		"release":                  RELEASE,
 		"rename":                   RENAME,
@@ -794,6 +816,7 @@ func initTokens(dialectType dialect.DialectType) {
 		"super":                    SUPER,
 		"table":                    TABLE,
 		"tables":                   TABLES,
+		"tablesample":              TABLESAMPLE,
 		"tablespace":               TABLESPACE,
 		"terminated":               TERMINATED,
 		"text":                     TEXT,
@@ -802,6 +825,8 @@ func initTokens(dialectType dialect.DialectType) {
 		"then":                     THEN,
 		"time":                     TIME,
 		"timestamp":                TIMESTAMP,
+		"timestampadd":             TIMESTAMPADD,
+		"timestampdiff":            TIMESTAMPDIFF,
 		"tinyblob":                 TINYBLOB,
 		"tinyint":                  TINYINT,
 		"tinytext":                 TINYTEXT,
@@ -825,6 +850,7 @@ func initTokens(dialectType dialect.DialectType) {
 		"utc_date":                 UTC_DATE,
 		"utc_time":                 UTC_TIME,
 		"utc_timestamp":            UTC_TIMESTAMP,
+		"uuid":                     UUID,
 		"values":                   VALUES,
 		"variables":                VARIABLES,
 		"varbinary":                VARBINARY,
@@ -867,6 +893,7 @@ func initTokens(dialectType dialect.DialectType) {
 		"subdate":                  SUBDATE,
 		"sum":                      SUM,
 		"system_user":              SYSTEM_USER,
+		"system":                   SYSTEM,
 		"translate":                TRANSLATE,
 		"trim":                     TRIM,
 		"variance":                 VARIANCE,
@@ -1019,6 +1046,15 @@ var (
 	GRANT                    int
 	OPTION                   int
 	REFERENCES               int
+	RECURSIVE                int
+	TABLESAMPLE              int
+	SYSTEM                   int
+	BERNOULLI                int
+	PERCENT                  int
+	EXTERNAL                 int
+	LOCATION                 int
+	MATERIALIZED             int
+	REFRESH                  int
 	REPLICATION              int
 	SLAVE                    int
 	CLIENT                   int
@@ -1152,6 +1188,8 @@ var (
 	IN                       int
 	SHIFT_LEFT               int
 	SHIFT_RIGHT              int
+	JSON_EXTRACT_OP          int
+	JSON_UNQUOTE_EXTRACT_OP  int
 	DIV                      int
 	MOD                      int
 	UNARY                    int
@@ -1197,6 +1235,7 @@ var (
 	LONGBLOB                 int
 	JSON                     int
 	ENUM                     int
+	UUID                     int
 	GEOMETRY                 int
 	POINT                    int
 	LINESTRING               int
@@ -1294,6 +1333,8 @@ var (
 	CURRENT_ROLE             int
 	MONTH                    int
 	CURTIME                  int
+	TIMESTAMPADD             int
+	TIMESTAMPDIFF            int
 	SYSDATE                  int
 	AVG                      int
 	LOAD                     int
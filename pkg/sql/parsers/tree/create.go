@@ -123,6 +123,8 @@ type CreateTable struct {
 	Defs            TableDefs
 	Options         []TableOption
 	PartitionOption *PartitionOption
+	// Param is not nil for the external table
+	Param *ExternParam
 }
 
 func (node *CreateTable) Format(ctx *FmtCtx) {
@@ -130,6 +132,9 @@ func (node *CreateTable) Format(ctx *FmtCtx) {
 	if node.Temporary {
 		ctx.WriteString(" temporary")
 	}
+	if node.Param != nil {
+		ctx.WriteString(" external")
+	}
 	ctx.WriteString(" table")
 
 	if node.IfNotExists {
@@ -162,6 +167,47 @@ func (node *CreateTable) Format(ctx *FmtCtx) {
 		ctx.WriteByte(' ')
 		node.PartitionOption.Format(ctx)
 	}
+
+	if node.Param != nil {
+		ctx.WriteByte(' ')
+		node.Param.Format(ctx)
+	}
+}
+
+// ExternParam describes the files an external table reads its rows from.
+type ExternParam struct {
+	// Location is the url of the files, it may contain the wildcards
+	Location string
+	// FileFormat is the format of the files
+	FileFormat string
+	// Compression is the compression of the files
+	Compression string
+	// Fields is the separator of the csv files
+	Fields *Fields
+	// IgnoredLines is the count of the leading lines skipped in every file
+	IgnoredLines uint64
+}
+
+func (node *ExternParam) Format(ctx *FmtCtx) {
+	ctx.WriteString("location ")
+	ctx.WriteString(node.Location)
+	if node.FileFormat != "" && node.FileFormat != CSV {
+		ctx.WriteString(" format ")
+		ctx.WriteString(node.FileFormat)
+	}
+	if node.Compression != "" && node.Compression != AUTO {
+		ctx.WriteString(" compression ")
+		ctx.WriteString(node.Compression)
+	}
+	if node.Fields != nil {
+		ctx.WriteByte(' ')
+		node.Fields.Format(ctx)
+	}
+	if node.IgnoredLines != 0 {
+		ctx.WriteString(" ignore ")
+		ctx.WriteString(strconv.FormatUint(node.IgnoredLines, 10))
+		ctx.WriteString(" lines")
+	}
 }
 
 type TableDef interface {
@@ -374,6 +420,22 @@ func NewAttributeStorage(s string) *AttributeStorage {
 	}
 }
 
+type AttributeCompression struct {
+	columnAttributeImpl
+	Compression string
+}
+
+func (node *AttributeCompression) Format(ctx *FmtCtx) {
+	ctx.WriteString("compression ")
+	ctx.WriteString(node.Compression)
+}
+
+func NewAttributeCompression(s string) *AttributeCompression {
+	return &AttributeCompression{
+		Compression: s,
+	}
+}
+
 type AttributeCheckConstraint struct {
 	columnAttributeImpl
 	Name     string
@@ -904,16 +966,24 @@ func NewFullTextIndex(k []*KeyPart, n string, e bool, io *IndexOption) *FullText
 
 type CheckIndex struct {
 	tableDefImpl
+	Name     string
 	Expr     Expr
 	Enforced bool
 }
 
 func (node *CheckIndex) Format(ctx *FmtCtx) {
+	if node.Name != "" {
+		ctx.WriteString("constraint ")
+		ctx.WriteString(node.Name)
+		ctx.WriteByte(' ')
+	}
 	ctx.WriteString("check (")
 	node.Expr.Format(ctx)
 	ctx.WriteByte(')')
 	if node.Enforced {
 		ctx.WriteString(" enforced")
+	} else {
+		ctx.WriteString(" not enforced")
 	}
 }
 
@@ -63,6 +63,26 @@ func NewDropTable(i bool, n TableNames) *DropTable {
 	}
 }
 
+type DropView struct {
+	statementImpl
+	IfExists     bool
+	Names        TableNames
+	Materialized bool
+}
+
+func (node *DropView) Format(ctx *FmtCtx) {
+	ctx.WriteString("drop ")
+	if node.Materialized {
+		ctx.WriteString("materialized ")
+	}
+	ctx.WriteString("view")
+	if node.IfExists {
+		ctx.WriteString(" if exists")
+	}
+	ctx.WriteByte(' ')
+	node.Names.Format(ctx)
+}
+
 type DropIndex struct {
 	statementImpl
 	Name       Identifier
@@ -227,9 +227,9 @@ func (op ComparisonOp) ToString() string {
 	case NOT_LIKE:
 		return "not like"
 	case REG_MATCH:
-		return "reg_match"
+		return "regexp"
 	case NOT_REG_MATCH:
-		return "not reg_match"
+		return "not regexp"
 	case IS_DISTINCT_FROM:
 		return "is distinct from"
 	case IS_NOT_DISTINCT_FROM:
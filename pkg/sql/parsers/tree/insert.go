@@ -21,6 +21,8 @@ type Insert struct {
 	PartitionNames IdentifierList
 	Columns        IdentifierList
 	Rows           *Select
+	// OnDuplicateUpdate is the assignment list of ON DUPLICATE KEY UPDATE
+	OnDuplicateUpdate UpdateExprs
 }
 
 func (node *Insert) Format(ctx *FmtCtx) {
@@ -42,6 +44,10 @@ func (node *Insert) Format(ctx *FmtCtx) {
 		ctx.WriteByte(' ')
 		node.Rows.Format(ctx)
 	}
+	if node.OnDuplicateUpdate != nil {
+		ctx.WriteString(" on duplicate key update ")
+		node.OnDuplicateUpdate.Format(ctx)
+	}
 }
 
 func NewInsert(t TableExpr, c IdentifierList, r *Select, p IdentifierList) *Insert {
@@ -400,8 +400,9 @@ func (node *AliasClause) Format(ctx *FmtCtx) {
 //the table expression coupled with an optional alias.
 type AliasedTableExpr struct {
 	TableExpr
-	Expr TableExpr
-	As   AliasClause
+	Expr   TableExpr
+	As     AliasClause
+	Sample *TableSample
 }
 
 func (node *AliasedTableExpr) Format(ctx *FmtCtx) {
@@ -410,6 +411,62 @@ func (node *AliasedTableExpr) Format(ctx *FmtCtx) {
 		ctx.WriteString(" as ")
 		node.As.Format(ctx)
 	}
+	if node.Sample != nil {
+		ctx.WriteByte(' ')
+		node.Sample.Format(ctx)
+	}
+}
+
+//the function returning a set of rows in the from clause.
+type TableFunction struct {
+	TableExpr
+	Func *FuncExpr
+}
+
+func (node *TableFunction) Format(ctx *FmtCtx) {
+	node.Func.Format(ctx)
+}
+
+type SampleMethod int
+
+const (
+	// SampleSystem samples whole blocks of the table.
+	SampleSystem SampleMethod = iota
+	// SampleBernoulli samples every row of the table.
+	SampleBernoulli
+)
+
+func (m SampleMethod) ToString() string {
+	switch m {
+	case SampleSystem:
+		return "system"
+	case SampleBernoulli:
+		return "bernoulli"
+	default:
+		return "Unknown SampleMethod"
+	}
+}
+
+// the TABLESAMPLE clause of a table.
+type TableSample struct {
+	NodeFormatter
+	Method  SampleMethod
+	Percent *NumVal
+	// Seed is nil if the sample is not repeatable.
+	Seed *NumVal
+}
+
+func (node *TableSample) Format(ctx *FmtCtx) {
+	ctx.WriteString("tablesample ")
+	ctx.WriteString(node.Method.ToString())
+	ctx.WriteString(" (")
+	node.Percent.Format(ctx)
+	ctx.WriteString(" percent)")
+	if node.Seed != nil {
+		ctx.WriteString(" repeatable (")
+		node.Seed.Format(ctx)
+		ctx.WriteByte(')')
+	}
 }
 
 func NewAliasedTableExpr(e TableExpr, a AliasClause) *AliasedTableExpr {
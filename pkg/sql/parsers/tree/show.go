@@ -37,6 +37,17 @@ func NewShowCreate(n *UnresolvedObjectName) *ShowCreateTable {
 	return &ShowCreateTable{Name: n}
 }
 
+//SHOW CREATE VIEW statement
+type ShowCreateView struct {
+	showImpl
+	Name *UnresolvedObjectName
+}
+
+func (node *ShowCreateView) Format(ctx *FmtCtx) {
+	ctx.WriteString("show create view ")
+	node.Name.ToTableName().Format(ctx)
+}
+
 //SHOW CREATE DATABASE statement
 type ShowCreateDatabase struct {
 	showImpl
@@ -37,6 +37,7 @@ const (
 	JsonFamily
 	EnumFamily
 	SetFamily
+	UuidFamily
 
 	//test
 	BlobFamily
@@ -137,6 +138,14 @@ func (node *InternalType) Format(ctx *FmtCtx) {
 
 	switch fs {
 	case "set", "enum":
+		ctx.WriteByte('(')
+		for i, v := range node.EnumValues {
+			if i > 0 {
+				ctx.WriteString(", ")
+			}
+			ctx.WriteString("'" + v + "'")
+		}
+		ctx.WriteByte(')')
 	case "char":
 		if node.DisplayWith >= 0 {
 			ctx.WriteByte('(')
@@ -339,6 +348,12 @@ var (
 		Oid:    uint32(defines.MYSQL_TYPE_SET),
 	}}
 
+	TYPE_UUID = &T{InternalType: InternalType{
+		Family: UuidFamily,
+		Locale: &emptyLocale,
+		Oid:    uint32(defines.MYSQL_TYPE_UUID),
+	}}
+
 	TYPE_TINY_BLOB = &T{InternalType: InternalType{
 		Family: BlobFamily,
 		Locale: &emptyLocale,
@@ -16,6 +16,7 @@ package tree
 
 import (
 	"bufio"
+	"io"
 	"os"
 	"strconv"
 )
@@ -105,6 +106,23 @@ func NewUpdateExpr(t bool, n []*UnresolvedName, e Expr) *UpdateExpr {
 	}
 }
 
+// file formats of LOAD DATA and SELECT ... INTO OUTFILE
+const (
+	CSV      = "csv"
+	JSONLINE = "jsonline"
+	PARQUET  = "parquet"
+)
+
+// compressions of the file of LOAD DATA and SELECT ... INTO OUTFILE,
+// AUTO decides the compression by the extension of the file
+const (
+	AUTO       = "auto"
+	NOCOMPRESS = "none"
+	GZIP       = "gzip"
+	ZSTD       = "zstd"
+	LZ4        = "lz4"
+)
+
 //Load data statement
 type Load struct {
 	statementImpl
@@ -112,6 +130,10 @@ type Load struct {
 	File              string
 	DuplicateHandling DuplicateKey
 	Table             *TableName
+	//file format
+	FileFormat string
+	//compression of the file
+	Compression string
 	//Partition
 	//Fields
 	Fields *Fields
@@ -144,6 +166,15 @@ func (node *Load) Format(ctx *FmtCtx) {
 	ctx.WriteString(" into table ")
 	node.Table.Format(ctx)
 
+	if node.FileFormat != "" && node.FileFormat != CSV {
+		ctx.WriteString(" format ")
+		ctx.WriteString(node.FileFormat)
+	}
+	if node.Compression != "" && node.Compression != AUTO {
+		ctx.WriteString(" compression ")
+		ctx.WriteString(node.Compression)
+	}
+
 	if node.Fields != nil {
 		ctx.WriteByte(' ')
 		node.Fields.Format(ctx)
@@ -313,6 +344,16 @@ type ExportParam struct {
 	ForceQuote []string
 	ColumnFlag []bool
 	Symbol     [][]byte
+	// file format
+	FileFormat string
+	// FormatWriter encodes rows of the formats that are not line based
+	FormatWriter interface{}
+	// compression of the file
+	Compression string
+	// CompressWriter compresses the data written into the file
+	CompressWriter io.WriteCloser
+	// CompressedFileSize is the size of the compressed data written into the file
+	CompressedFileSize uint64
 
 	// default flush size
 	DefaultBufSize int64
@@ -352,6 +393,14 @@ func (ep *ExportParam) Format(ctx *FmtCtx) {
 			prefix = ", "
 		}
 	}
+	if ep.FileFormat != "" && ep.FileFormat != CSV {
+		ctx.WriteString(" format ")
+		ctx.WriteString(ep.FileFormat)
+	}
+	if ep.Compression != "" && ep.Compression != AUTO {
+		ctx.WriteString(" compression ")
+		ctx.WriteString(ep.Compression)
+	}
 }
 
 var _ LoadColumn = &UnresolvedName{}
@@ -21,6 +21,8 @@ type CreateView struct {
 	AsSource    *Select
 	IfNotExists bool
 	Temporary   bool
+	// Materialized is true if the rows of the view are stored and maintained incrementally
+	Materialized bool
 }
 
 func (node *CreateView) Format(ctx *FmtCtx) {
@@ -29,6 +31,9 @@ func (node *CreateView) Format(ctx *FmtCtx) {
 	if node.Temporary {
 		ctx.WriteString("temporary ")
 	}
+	if node.Materialized {
+		ctx.WriteString("materialized ")
+	}
 	ctx.WriteString("view ")
 
 	if node.IfNotExists {
@@ -44,3 +49,14 @@ func (node *CreateView) Format(ctx *FmtCtx) {
 	ctx.WriteString(" as ")
 	node.AsSource.Format(ctx)
 }
+
+// RefreshMaterializedView rebuilds the rows of a materialized view from its select statement
+type RefreshMaterializedView struct {
+	statementImpl
+	Name *TableName
+}
+
+func (node *RefreshMaterializedView) Format(ctx *FmtCtx) {
+	ctx.WriteString("refresh materialized view ")
+	node.Name.Format(ctx)
+}
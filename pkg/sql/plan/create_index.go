@@ -139,7 +139,7 @@ func (b *build) tableName(tbl *tree.TableName) (string, string, engine.Relation,
 // bsiSupport returns error if bsi index not support this data type
 func bsiSupport(t types.Type) error {
 	switch t.Oid {
-	case types.T_char, types.T_varchar:
+	case types.T_char, types.T_varchar, types.T_text, types.T_blob:
 		return errBsiUnsupported
 	}
 	return nil
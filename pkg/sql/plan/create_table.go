@@ -18,8 +18,10 @@ import (
 	"fmt"
 	"go/constant"
 	"math"
+	"strings"
 
 	"github.com/matrixorigin/matrixone/pkg/compress"
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/defines"
 	"github.com/matrixorigin/matrixone/pkg/errno"
@@ -125,16 +127,22 @@ func (b *build) getTableDef(def tree.TableDef) (engine.TableDef, []string, error
 			return nil, nil, err
 		}
 
+		alg := compress.T(compress.Lz4)
 		for _, attr := range n.Attributes {
-			if _, ok := attr.(*tree.AttributePrimaryKey); ok {
+			switch a := attr.(type) {
+			case *tree.AttributePrimaryKey:
 				primaryKeys = append(primaryKeys, n.Name.Parts[0])
+			case *tree.AttributeCompression:
+				if alg, err = compress.Parse(a.Compression); err != nil {
+					return nil, nil, errors.New(errno.InvalidOptionValue, err.Error())
+				}
 			}
 		}
 
 		return &engine.AttributeDef{
 			Attr: engine.Attribute{
 				Name:    n.Name.Parts[0],
-				Alg:     compress.Lz4,
+				Alg:     alg,
 				Type:    *typ,
 				Default: defaultExpr,
 			},
@@ -226,8 +234,20 @@ func (b *build) getTableDefType(typ tree.ResolvableTypeReference) (*types.Type,
 				return &types.Type{Oid: types.T_char, Size: 24, Width: 1}, nil
 			}
 			return &types.Type{Oid: types.T_varchar, Size: 24, Width: n.InternalType.DisplayWith}, nil
+		case defines.MYSQL_TYPE_TINY_BLOB, defines.MYSQL_TYPE_BLOB, defines.MYSQL_TYPE_MEDIUM_BLOB, defines.MYSQL_TYPE_LONG_BLOB:
+			width := defines.BlobMaxLength[uint8(n.InternalType.Oid)]
+			if strings.HasSuffix(strings.ToLower(n.InternalType.FamilyString), "text") {
+				return &types.Type{Oid: types.T_text, Size: 24, Width: width}, nil
+			}
+			return &types.Type{Oid: types.T_blob, Size: 24, Width: width}, nil
+		case defines.MYSQL_TYPE_JSON:
+			return &types.Type{Oid: types.T_json, Size: 24, Width: defines.BlobMaxLength[defines.MYSQL_TYPE_LONG_BLOB]}, nil
 		case defines.MYSQL_TYPE_DATE:
 			return &types.Type{Oid: types.T_date, Size: 4}, nil
+		case defines.MYSQL_TYPE_TIME:
+			return &types.Type{Oid: types.T_time, Size: 8, Precision: n.InternalType.DisplayWith}, nil
+		case defines.MYSQL_TYPE_YEAR:
+			return &types.Type{Oid: types.T_year, Size: 2}, nil
 		case defines.MYSQL_TYPE_DATETIME:
 			return &types.Type{Oid: types.T_datetime, Size: 8}, nil
 		case defines.MYSQL_TYPE_TIMESTAMP:
@@ -272,6 +292,9 @@ func getDefaultExprFromColumnDef(column *tree.ColumnTableDef, typ *types.Type) (
 				return engine.MakeDefaultExpr(true, nil, true), nil
 			}
 
+			if typ.Oid == types.T_json {
+				return engine.EmptyDefaultExpr, errors.New(errno.InvalidColumnDefinition, fmt.Sprintf("JSON column '%s' can't have a default value", column.Name.Parts[0]))
+			}
 			// check value and its type, only support constant value for default expression now.
 			var value interface{}
 			var err error
@@ -356,7 +379,7 @@ func rangeCheck(value interface{}, typ types.Type, columnName string, rowNumber
 		return nil, errors.New(errno.DataException, fmt.Sprintf(errString, columnName, rowNumber))
 	case string:
 		switch typ.Oid {
-		case types.T_char, types.T_varchar: // string family should compare the length but not value
+		case types.T_char, types.T_varchar, types.T_text, types.T_blob: // string family should compare the length but not value
 			if len(v) > math.MaxUint16 {
 				return nil, errors.New(errno.DataException, "length out of uint16 is unexpected for char / varchar value")
 			}
@@ -367,8 +390,13 @@ func rangeCheck(value interface{}, typ types.Type, columnName string, rowNumber
 			return nil, errors.New(errno.DatatypeMismatch, "unexpected type and value")
 		}
 		return nil, errors.New(errno.DataException, fmt.Sprintf("Data too long for column '%s' at row %d", columnName, rowNumber))
-	case types.Date, types.Datetime, types.Timestamp, types.Decimal64, types.Decimal128, bool:
+	case types.Date, types.Time, types.Datetime, types.Timestamp, types.YearValue, types.Decimal64, types.Decimal128, bool:
 		return v, nil
+	case bytejson.ByteJson:
+		if typ.Oid == types.T_json {
+			return v, nil
+		}
+		return nil, errors.New(errno.DatatypeMismatch, "unexpected type and value")
 	default:
 		return nil, errors.New(errno.DatatypeMismatch, "unexpected type and value")
 	}
@@ -20,7 +20,9 @@ import (
 	"math"
 	"strconv"
 	"strings"
+	"time"
 
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/defines"
@@ -186,12 +188,29 @@ func (b *build) buildCast(e *tree.CastExpr, qry *Query, fn func(tree.Expr, *Quer
 	case defines.MYSQL_TYPE_DOUBLE:
 		typ.Size = 8
 		typ.Oid = types.T_float64
+	case defines.MYSQL_TYPE_DECIMAL:
+		typ.Width = e.Type.(*tree.T).InternalType.DisplayWith
+		typ.Scale = e.Type.(*tree.T).InternalType.Precision
+		if typ.Width > types.MaxDecimal64Width {
+			typ.Size = 16
+			typ.Oid = types.T_decimal128
+		} else {
+			typ.Size = 8
+			typ.Oid = types.T_decimal64
+		}
 	case defines.MYSQL_TYPE_DATE:
 		typ.Size = 4
 		typ.Oid = types.T_date
+	case defines.MYSQL_TYPE_TIME:
+		typ.Size = 8
+		typ.Oid = types.T_time
+		typ.Precision = e.Type.(*tree.T).InternalType.DisplayWith
 	case defines.MYSQL_TYPE_DATETIME:
 		typ.Size = 8
 		typ.Oid = types.T_datetime
+	case defines.MYSQL_TYPE_YEAR:
+		typ.Size = 2
+		typ.Oid = types.T_year
 	case defines.MYSQL_TYPE_VARCHAR, defines.MYSQL_TYPE_VAR_STRING:
 		typ.Size = 24
 		typ.Oid = types.T_varchar
@@ -739,6 +758,13 @@ func buildConstantValue(typ types.Type, num *tree.NumVal) (interface{}, error) {
 			if !num.Negative() {
 				return types.ParseDatetime(str)
 			}
+		case types.T_time:
+			return types.ParseTime(str, typ.Precision)
+		case types.T_year:
+			if !num.Negative() {
+				v, _ := constant.Int64Val(val)
+				return types.YearFromInt(v)
+			}
 		}
 	case constant.Float:
 		switch typ.Oid {
@@ -806,14 +832,25 @@ func buildConstantValue(typ types.Type, num *tree.NumVal) (interface{}, error) {
 		}
 		if !num.Negative() {
 			switch typ.Oid {
-			case types.T_char, types.T_varchar:
+			case types.T_char, types.T_varchar, types.T_text, types.T_blob:
 				return constant.StringVal(val), nil
 			case types.T_date:
 				return types.ParseDate(constant.StringVal(val))
 			case types.T_datetime:
 				return types.ParseDatetime(constant.StringVal(val))
+			case types.T_time:
+				return types.ParseTime(constant.StringVal(val), typ.Precision)
 			case types.T_timestamp:
-				return types.ParseTimestamp(constant.StringVal(val), typ.Precision)
+				// constants are interpreted in the local time zone of the server while building plan
+				return types.ParseTimestamp(time.Local, constant.StringVal(val), typ.Precision)
+			case types.T_year:
+				return types.ParseYear(constant.StringVal(val))
+			case types.T_json:
+				bj, err := bytejson.ParseFromString(constant.StringVal(val))
+				if err != nil {
+					return nil, errors.New(errno.DataException, fmt.Sprintf("Invalid JSON text: '%s'", constant.StringVal(val)))
+				}
+				return bj, nil
 			}
 		}
 	}
@@ -18,8 +18,10 @@ import (
 	"fmt"
 	"go/constant"
 	"strconv"
+	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
 	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
@@ -355,7 +357,7 @@ func (b *build) BuildInsert(stmt *tree.Insert, plan *Insert) error {
 			if err := vector.Append(vec, vs); err != nil {
 				return err
 			}
-		case types.T_char, types.T_varchar:
+		case types.T_char, types.T_varchar, types.T_text, types.T_blob:
 			vs := make([][]byte, len(rows.Rows))
 			{
 				for j, row := range rows.Rows {
@@ -443,6 +445,72 @@ func (b *build) BuildInsert(stmt *tree.Insert, plan *Insert) error {
 			if err := vector.Append(vec, vs); err != nil {
 				return err
 			}
+		case types.T_time:
+			vs := make([]types.Time, len(rows.Rows))
+			{
+				for j, row := range rows.Rows {
+					v, err := buildConstant(vec.Typ, row[i])
+					if err != nil {
+						return err
+					}
+					if v == nil {
+						nulls.Add(vec.Nsp, uint64(j))
+					} else {
+						if vv, err := rangeCheck(v.(types.Time), vec.Typ, bat.Attrs[i], j+1); err != nil {
+							return err
+						} else {
+							vs[j] = vv.(types.Time)
+						}
+					}
+				}
+			}
+			if err := vector.Append(vec, vs); err != nil {
+				return err
+			}
+		case types.T_year:
+			vs := make([]types.YearValue, len(rows.Rows))
+			{
+				for j, row := range rows.Rows {
+					v, err := buildConstant(vec.Typ, row[i])
+					if err != nil {
+						return err
+					}
+					if v == nil {
+						nulls.Add(vec.Nsp, uint64(j))
+					} else {
+						if vv, err := rangeCheck(v.(types.YearValue), vec.Typ, bat.Attrs[i], j+1); err != nil {
+							return err
+						} else {
+							vs[j] = vv.(types.YearValue)
+						}
+					}
+				}
+			}
+			if err := vector.Append(vec, vs); err != nil {
+				return err
+			}
+		case types.T_json:
+			vs := make([][]byte, len(rows.Rows))
+			{
+				for j, row := range rows.Rows {
+					v, err := buildConstant(vec.Typ, row[i])
+					if err != nil {
+						return err
+					}
+					if v == nil {
+						nulls.Add(vec.Nsp, uint64(j))
+					} else {
+						if vv, err := rangeCheck(v, vec.Typ, bat.Attrs[i], j+1); err != nil {
+							return err
+						} else {
+							vs[j] = vv.(bytejson.ByteJson).Marshal()
+						}
+					}
+				}
+			}
+			if err := vector.Append(vec, vs); err != nil {
+				return err
+			}
 		case types.T_decimal64:
 			vs := make([]types.Decimal64, len(rows.Rows))
 			{
@@ -519,7 +587,7 @@ func (b *build) BuildInsert(stmt *tree.Insert, plan *Insert) error {
 			vec.Col = make([]float32, len(rows.Rows))
 		case types.T_float64:
 			vec.Col = make([]float64, len(rows.Rows))
-		case types.T_char, types.T_varchar:
+		case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 			col := &types.Bytes{}
 			if err = col.Append(make([][]byte, len(rows.Rows))); err != nil {
 				return err
@@ -527,8 +595,14 @@ func (b *build) BuildInsert(stmt *tree.Insert, plan *Insert) error {
 			vec.Col = col
 		case types.T_date:
 			vec.Col = make([]types.Date, len(rows.Rows))
+		case types.T_time:
+			vec.Col = make([]types.Time, len(rows.Rows))
 		case types.T_datetime:
 			vec.Col = make([]types.Datetime, len(rows.Rows))
+		case types.T_timestamp:
+			vec.Col = make([]types.Timestamp, len(rows.Rows))
+		case types.T_year:
+			vec.Col = make([]types.YearValue, len(rows.Rows))
 		case types.T_decimal64:
 			vec.Col = make([]types.Decimal64, len(rows.Rows))
 		case types.T_decimal128:
@@ -563,15 +637,24 @@ func makeExprFromVal(typ types.Type, value interface{}, isNull bool) tree.Expr {
 		res := value.(float64)
 		str := strconv.FormatFloat(res, 'f', 10, 64)
 		return tree.NewNumVal(constant.MakeFloat64(res), str, res < 0)
-	case types.T_char, types.T_varchar:
+	case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 		res := value.(string)
 		return tree.NewNumVal(constant.MakeString(res), res, false)
 	case types.T_date:
 		res := value.(types.Date).String()
 		return tree.NewNumVal(constant.MakeString(res), res, false)
+	case types.T_time:
+		res := value.(types.Time).String2(typ.Precision)
+		return tree.NewNumVal(constant.MakeString(res), res, false)
 	case types.T_datetime:
 		res := value.(types.Datetime).String()
 		return tree.NewNumVal(constant.MakeString(res), res, false)
+	case types.T_timestamp:
+		res := value.(types.Timestamp).String2(time.Local, typ.Precision)
+		return tree.NewNumVal(constant.MakeString(res), res, false)
+	case types.T_year:
+		res := value.(types.YearValue).String()
+		return tree.NewNumVal(constant.MakeString(res), res, false)
 	}
 	return tree.NewNumVal(constant.MakeUnknown(), "NULL", false)
 }
@@ -29,6 +29,10 @@ func BuildPlan(ctx CompilerContext, stmt tree.Statement) (*Plan, error) {
 		query, binderCtx := newQueryAndSelectCtx(plan.Query_SELECT)
 		nodeId, err := buildSelect(stmt, ctx, query, binderCtx)
 		query.Steps = append(query.Steps, nodeId)
+		if err == nil {
+			materializeCtes(query)
+			materializeSubqueries(query)
+		}
 		return &Plan{
 			Plan: &plan.Plan_Query{
 				Query: query,
@@ -61,6 +65,12 @@ func BuildPlan(ctx CompilerContext, stmt tree.Statement) (*Plan, error) {
 		return buildCreateTable(stmt, ctx)
 	case *tree.DropTable:
 		return buildDropTable(stmt, ctx)
+	case *tree.CreateView:
+		return buildCreateView(stmt, ctx)
+	case *tree.DropView:
+		return buildDropView(stmt, ctx)
+	case *tree.RefreshMaterializedView:
+		return buildRefreshMaterializedView(stmt, ctx)
 	case *tree.CreateIndex:
 		return buildCreateIndex(stmt, ctx)
 	case *tree.DropIndex:
@@ -69,6 +79,8 @@ func BuildPlan(ctx CompilerContext, stmt tree.Statement) (*Plan, error) {
 		return buildShowCreateDatabase(stmt, ctx)
 	case *tree.ShowCreateTable:
 		return buildShowCreateTable(stmt, ctx)
+	case *tree.ShowCreateView:
+		return buildShowCreateView(stmt, ctx)
 	case *tree.ShowDatabases:
 		return buildShowDatabases(stmt, ctx)
 	case *tree.ShowTables:
@@ -95,7 +107,8 @@ func BuildPlan(ctx CompilerContext, stmt tree.Statement) (*Plan, error) {
 //GetResultColumnsFromPlan
 func GetResultColumnsFromPlan(p *Plan) []*ColDef {
 	getResultColumnsByProjectionlist := func(query *Query) []*ColDef {
-		lastNode := query.Nodes[len(query.Nodes)-1]
+		// the root is the last step, which need not be the last node
+		lastNode := query.Nodes[query.Steps[len(query.Steps)-1]]
 		columns := make([]*ColDef, len(lastNode.ProjectList))
 		for idx, expr := range lastNode.ProjectList {
 			columns[idx] = &ColDef{
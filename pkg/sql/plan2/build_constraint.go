@@ -0,0 +1,295 @@
+// Copyright 2021 - 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan2
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"google.golang.org/protobuf/proto"
+)
+
+// buildChecks binds the CHECK constraints to the columns of the table and keeps them in its properties.
+// The constraints are evaluated on the rows written by INSERT and UPDATE.
+func buildChecks(checks []*tree.CheckIndex, ctx CompilerContext, tableDef *TableDef) error {
+	if len(checks) == 0 {
+		return nil
+	}
+	cols := make([]*ColDef, len(tableDef.Cols))
+	for i, col := range tableDef.Cols {
+		cols[i] = &ColDef{
+			Name:  col.Name,
+			Alias: col.Name,
+			Typ:   col.Typ,
+		}
+	}
+	node := &Node{
+		NodeType: plan.Node_TABLE_SCAN,
+		TableDef: &TableDef{
+			Name:  tableDef.Name,
+			Alias: tableDef.Name,
+			Cols:  cols,
+		},
+	}
+
+	defs := make([]*CheckDef, len(checks))
+	names := make(map[string]struct{})
+	for i, check := range checks {
+		def := &CheckDef{
+			Name:     check.Name,
+			Text:     tree.String(check.Expr, dialect.MYSQL),
+			Enforced: check.Enforced,
+		}
+		if def.Name == "" {
+			def.Name = fmt.Sprintf("%s_chk_%d", tableDef.Name, i+1)
+		}
+		if _, ok := names[def.Name]; ok {
+			return errors.New(errno.DuplicateObject, fmt.Sprintf("duplicate check constraint name '%s'", def.Name))
+		}
+		names[def.Name] = struct{}{}
+
+		query, binderCtx := newQueryAndSelectCtx(plan.Query_SELECT)
+		expr, isAgg, err := buildExpr(check.Expr, ctx, query, node, binderCtx, false)
+		if err != nil {
+			return err
+		}
+		if isAgg || !isRowExpr(expr) {
+			return errors.New(errno.InvalidTableDefinition, fmt.Sprintf("check constraint '%s' can only refer to the columns of the row", def.Name))
+		}
+		if expr.Typ.Id != plan.Type_BOOL {
+			return errors.New(errno.InvalidTableDefinition, fmt.Sprintf("check constraint '%s' must be a boolean expression", def.Name))
+		}
+		if def.Expr, err = proto.Marshal(expr); err != nil {
+			return err
+		}
+		defs[i] = def
+	}
+	data, err := json.Marshal(defs)
+	if err != nil {
+		return err
+	}
+	tableDef.Defs = append(tableDef.Defs, &plan.TableDef_DefType{
+		Def: &plan.TableDef_DefType_Properties{
+			Properties: &plan.PropertiesDef{
+				Properties: []*plan.Property{
+					{
+						Key:   CheckKey,
+						Value: string(data),
+					},
+				},
+			},
+		},
+	})
+	return nil
+}
+
+// isRowExpr returns true if the expression is computed from the values of a row
+func isRowExpr(expr *Expr) bool {
+	switch e := expr.Expr.(type) {
+	case *plan.Expr_C:
+		return true
+	case *plan.Expr_Col:
+		return e.Col.RelPos == 0
+	case *plan.Expr_F:
+		for _, arg := range e.F.Args {
+			if !isRowExpr(arg) {
+				return false
+			}
+		}
+		return true
+	case *plan.Expr_List:
+		for _, arg := range e.List.List {
+			if !isRowExpr(arg) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// buildForeignKeys checks the foreign keys and keeps them in the properties of the table, the tables
+// referenced are kept too so that the engine can find the foreign keys referencing a table.
+// A foreign key is a single column referencing the primary key of a table.
+func buildForeignKeys(fks []*tree.ForeignKey, ctx CompilerContext, database string, tableDef *TableDef, primaryKeys []string) error {
+	if len(fks) == 0 {
+		return nil
+	}
+	if database == "" {
+		database = ctx.DefaultDatabase()
+	}
+	defs := make([]*ForeignKeyDef, len(fks))
+	names := make(map[string]struct{})
+	var properties []*plan.Property
+	for i, fk := range fks {
+		def := &ForeignKeyDef{
+			Name: fk.Name,
+		}
+		if def.Name == "" {
+			def.Name = fmt.Sprintf("%s_ibfk_%d", tableDef.Name, i+1)
+		}
+		if _, ok := names[def.Name]; ok {
+			return errors.New(errno.DuplicateObject, fmt.Sprintf("duplicate foreign key constraint name '%s'", def.Name))
+		}
+		names[def.Name] = struct{}{}
+
+		if len(fk.KeyParts) != 1 {
+			return errors.New(errno.FeatureNotSupported, fmt.Sprintf("foreign key '%s' must have a single column", def.Name))
+		}
+		def.Column = fk.KeyParts[0].ColName.Parts[0]
+		col := findColDef(tableDef.Cols, def.Column)
+		if col == nil {
+			return errors.New(errno.InvalidForeignKey, fmt.Sprintf("key column '%s' of foreign key '%s' doesn't exist in table", def.Column, def.Name))
+		}
+
+		ref := fk.Refer
+		def.Database = database
+		if ref.TableName.SchemaName != "" {
+			def.Database = string(ref.TableName.SchemaName)
+		}
+		def.Table = string(ref.TableName.ObjectName)
+		refCols, refPks := tableDef.Cols, primaryKeys
+		if def.Database != database || def.Table != tableDef.Name {
+			_, refDef := ctx.Resolve(def.Database, def.Table)
+			if refDef == nil {
+				return errors.New(errno.InvalidForeignKey, fmt.Sprintf("table '%s' referenced by foreign key '%s' doesn't exist", def.Table, def.Name))
+			}
+			if err := checkModifiable(refDef); err != nil {
+				return errors.New(errno.InvalidForeignKey, fmt.Sprintf("foreign key '%s' must reference a base table", def.Name))
+			}
+			refCols, refPks = refDef.Cols, getPrimaryKeys(refDef)
+		}
+		if len(refPks) != 1 || (len(ref.KeyParts) > 0 && (len(ref.KeyParts) != 1 || ref.KeyParts[0].ColName.Parts[0] != refPks[0])) {
+			return errors.New(errno.InvalidForeignKey, fmt.Sprintf("foreign key '%s' must reference the primary key of table '%s'", def.Name, def.Table))
+		}
+		def.RefColumn = refPks[0]
+		refCol := findColDef(refCols, def.RefColumn)
+		if refCol == nil || refCol.Typ.Id != col.Typ.Id {
+			return errors.New(errno.InvalidForeignKey, fmt.Sprintf("column '%s' and referenced column '%s' of foreign key '%s' are incompatible", def.Column, def.RefColumn, def.Name))
+		}
+
+		switch ref.OnDelete {
+		case tree.REFERENCE_OPTION_INVALID, tree.REFERENCE_OPTION_NO_ACTION, tree.REFERENCE_OPTION_RESTRICT:
+			def.OnDelete = tree.REFERENCE_OPTION_RESTRICT
+		case tree.REFERENCE_OPTION_CASCADE:
+			def.OnDelete = tree.REFERENCE_OPTION_CASCADE
+		case tree.REFERENCE_OPTION_SET_NULL:
+			for _, pk := range primaryKeys {
+				if pk == def.Column {
+					return errors.New(errno.InvalidForeignKey, fmt.Sprintf("column '%s' of foreign key '%s' is the primary key and can not be set null", def.Column, def.Name))
+				}
+			}
+			def.OnDelete = tree.REFERENCE_OPTION_SET_NULL
+		default:
+			return errors.New(errno.FeatureNotSupported, fmt.Sprintf("ON DELETE %s of foreign key '%s' is not supported", ref.OnDelete.ToString(), def.Name))
+		}
+		// a changed key is handled as deleted, so the action on update must be the one on delete
+		onUpdate := ref.OnUpdate
+		if onUpdate == tree.REFERENCE_OPTION_NO_ACTION {
+			onUpdate = tree.REFERENCE_OPTION_RESTRICT
+		}
+		if onUpdate != tree.REFERENCE_OPTION_INVALID && onUpdate != def.OnDelete {
+			return errors.New(errno.FeatureNotSupported, fmt.Sprintf("ON UPDATE %s of foreign key '%s' is not supported, it must be the same as ON DELETE", ref.OnUpdate.ToString(), def.Name))
+		}
+
+		defs[i] = def
+		properties = append(properties, &plan.Property{
+			Key:   ForeignKeyReferenceKey,
+			Value: def.Database + "." + def.Table,
+		})
+	}
+	data, err := json.Marshal(defs)
+	if err != nil {
+		return err
+	}
+	properties = append(properties, &plan.Property{
+		Key:   ForeignKeyKey,
+		Value: string(data),
+	})
+	tableDef.Defs = append(tableDef.Defs, &plan.TableDef_DefType{
+		Def: &plan.TableDef_DefType_Properties{
+			Properties: &plan.PropertiesDef{
+				Properties: properties,
+			},
+		},
+	})
+	return nil
+}
+
+// GetCheckDefs returns the CHECK constraints of the table
+func GetCheckDefs(tableDef *TableDef) ([]*CheckDef, error) {
+	value, ok := getTableProperty(tableDef, CheckKey)
+	if !ok {
+		return nil, nil
+	}
+	var defs []*CheckDef
+	if err := json.Unmarshal([]byte(value), &defs); err != nil {
+		return nil, errors.New(errno.DataException, fmt.Sprintf("invalid check constraints of the table '%v'", tableDef.Name))
+	}
+	return defs, nil
+}
+
+// GetForeignKeyDefs returns the foreign keys of the table
+func GetForeignKeyDefs(tableDef *TableDef) ([]*ForeignKeyDef, error) {
+	value, ok := getTableProperty(tableDef, ForeignKeyKey)
+	if !ok {
+		return nil, nil
+	}
+	var defs []*ForeignKeyDef
+	if err := json.Unmarshal([]byte(value), &defs); err != nil {
+		return nil, errors.New(errno.DataException, fmt.Sprintf("invalid foreign keys of the table '%v'", tableDef.Name))
+	}
+	return defs, nil
+}
+
+// CheckExpr returns the expression of the constraint, its columns are the columns of the table
+func (check *CheckDef) CheckExpr() (*Expr, error) {
+	expr := &Expr{}
+	if err := proto.Unmarshal(check.Expr, expr); err != nil {
+		return nil, errors.New(errno.DataException, fmt.Sprintf("invalid check constraint '%v'", check.Name))
+	}
+	return expr, nil
+}
+
+// getPrimaryKeys returns the names of the primary key columns of the table
+func getPrimaryKeys(tableDef *TableDef) []string {
+	for _, def := range tableDef.Defs {
+		if pk, ok := def.Def.(*plan.TableDef_DefType_Pk); ok {
+			return pk.Pk.Names
+		}
+	}
+	// the table resolved from the engine marks its primary key columns
+	var names []string
+	for _, col := range tableDef.Cols {
+		if col.Primary {
+			names = append(names, col.Name)
+		}
+	}
+	return names
+}
+
+func findColDef(cols []*ColDef, name string) *ColDef {
+	for _, col := range cols {
+		if col.Name == name {
+			return col
+		}
+	}
+	return nil
+}
@@ -15,8 +15,13 @@
 package plan2
 
 import (
+	"encoding/json"
 	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
 
+	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/errno"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/errors"
@@ -49,7 +54,7 @@ func buildCreateTable(stmt *tree.CreateTable, ctx CompilerContext) (*Plan, error
 	}
 
 	// set tableDef
-	err := buildTableDefs(stmt.Defs, ctx, createTable.TableDef)
+	err := buildTableDefs(stmt.Defs, ctx, createTable.Database, createTable.TableDef)
 	if err != nil {
 		return nil, err
 	}
@@ -87,6 +92,19 @@ func buildCreateTable(stmt *tree.CreateTable, ctx CompilerContext) (*Plan, error
 					},
 				},
 			})
+		case *tree.TableOptionAutoIncrement:
+			createTable.TableDef.Defs = append(createTable.TableDef.Defs, &plan.TableDef_DefType{
+				Def: &plan.TableDef_DefType_Properties{
+					Properties: &plan.PropertiesDef{
+						Properties: []*plan.Property{
+							{
+								Key:   AutoIncrementKey,
+								Value: strconv.FormatUint(opt.Value, 10),
+							},
+						},
+					},
+				},
+			})
 		// these table options is not support in plan
 		// case *tree.TableOptionEngine, *tree.TableOptionSecondaryEngine, *tree.TableOptionCharset,
 		// 	*tree.TableOptionCollate, *tree.TableOptionAutoIncrement, *tree.TableOptionComment,
@@ -108,6 +126,13 @@ func buildCreateTable(stmt *tree.CreateTable, ctx CompilerContext) (*Plan, error
 		return nil, errors.New(errno.SQLStatementNotYetComplete, fmt.Sprintf("partition unsupport now; statement: '%v'", tree.String(stmt, dialect.MYSQL)))
 	}
 
+	// set the files of the external table
+	if stmt.Param != nil {
+		if err := buildExternParam(stmt.Param, createTable.TableDef); err != nil {
+			return nil, err
+		}
+	}
+
 	return &Plan{
 		Plan: &plan.Plan_Ddl{
 			Ddl: &plan.DataDefinition{
@@ -120,8 +145,52 @@ func buildCreateTable(stmt *tree.CreateTable, ctx CompilerContext) (*Plan, error
 	}, nil
 }
 
-func buildTableDefs(defs tree.TableDefs, ctx CompilerContext, tableDef *TableDef) error {
+// buildExternParam checks the parameters of the external table and keeps them in the properties of the table
+func buildExternParam(param *tree.ExternParam, tableDef *TableDef) error {
+	if strings.Contains(param.Location, "://") && !strings.HasPrefix(param.Location, ExternFilePrefix) {
+		return errors.New(errno.FeatureNotSupported, fmt.Sprintf("unsupported location '%v' of the external table, only the local files are supported", param.Location))
+	}
+	if !filepath.IsAbs(strings.TrimPrefix(param.Location, ExternFilePrefix)) {
+		return errors.New(errno.InvalidOptionValue, fmt.Sprintf("the location '%v' of the external table must be an absolute path", param.Location))
+	}
+	for _, elem := range strings.Split(param.Location, "/") {
+		if elem == ".." {
+			return errors.New(errno.InvalidOptionValue, fmt.Sprintf("the location '%v' of the external table must not refer to the parent directory", param.Location))
+		}
+	}
+	if _, err := filepath.Match(filepath.Base(param.Location), ""); err != nil {
+		return errors.New(errno.InvalidOptionValue, fmt.Sprintf("invalid location '%v' of the external table", param.Location))
+	}
+	if param.FileFormat == tree.PARQUET && param.Compression != "" && param.Compression != tree.AUTO && param.Compression != tree.NOCOMPRESS {
+		return errors.New(errno.InvalidOptionValue, fmt.Sprintf("the parquet file can not be compressed by %s", param.Compression))
+	}
+	if param.Fields != nil && len(param.Fields.Terminated) != 1 {
+		return errors.New(errno.InvalidOptionValue, fmt.Sprintf("the fields of the external table must be terminated by a single character, got '%v'", param.Fields.Terminated))
+	}
+	data, err := json.Marshal(param)
+	if err != nil {
+		return err
+	}
+	tableDef.Defs = append(tableDef.Defs, &plan.TableDef_DefType{
+		Def: &plan.TableDef_DefType_Properties{
+			Properties: &plan.PropertiesDef{
+				Properties: []*plan.Property{
+					{
+						Key:   ExternParamKey,
+						Value: string(data),
+					},
+				},
+			},
+		},
+	})
+	return nil
+}
+
+func buildTableDefs(defs tree.TableDefs, ctx CompilerContext, database string, tableDef *TableDef) error {
 	var primaryKeys []string
+	var autoIncrement string
+	var checks []*tree.CheckIndex
+	var fks []*tree.ForeignKey
 	for _, item := range defs {
 		switch def := item.(type) {
 		case *tree.ColumnTableDef:
@@ -143,8 +212,36 @@ func buildTableDefs(defs tree.TableDefs, ctx CompilerContext, tableDef *TableDef
 
 			var pks []string
 			for _, attr := range def.Attributes {
-				if _, ok := attr.(*tree.AttributePrimaryKey); ok {
+				switch a := attr.(type) {
+				case *tree.AttributePrimaryKey:
 					pks = append(pks, def.Name.Parts[0])
+				case *tree.AttributeCompression:
+					alg, err := compress.Parse(a.Compression)
+					if err != nil {
+						return errors.New(errno.InvalidOptionValue, err.Error())
+					}
+					col.Alg = plan.CompressType(alg.Codec())
+					col.Encoding = plan.EncodingType(alg.Encoding())
+				case *tree.AttributeAutoIncrement:
+					if autoIncrement != "" {
+						return errors.New(errno.InvalidTableDefinition, "there can be only one auto increment column")
+					}
+					if !isIntegerType(colType.Id) {
+						return errors.New(errno.InvalidTableDefinition, fmt.Sprintf("auto increment column '%s' must be an integer", col.Name))
+					}
+					autoIncrement = col.Name
+					col.AutoIncrement = true
+				case *tree.AttributeCheckConstraint:
+					checks = append(checks, &tree.CheckIndex{
+						Name:     a.Name,
+						Expr:     a.Expr,
+						Enforced: a.Enforced,
+					})
+				case *tree.AttributeReference:
+					fks = append(fks, &tree.ForeignKey{
+						KeyParts: []*tree.KeyPart{{ColName: def.Name}},
+						Refer:    a,
+					})
 				}
 			}
 			if len(pks) > 0 {
@@ -200,7 +297,11 @@ func buildTableDefs(defs tree.TableDefs, ctx CompilerContext, tableDef *TableDef
 					Idx: idxDef,
 				},
 			})
-		case *tree.UniqueIndex, *tree.CheckIndex, *tree.ForeignKey, *tree.FullTextIndex:
+		case *tree.CheckIndex:
+			checks = append(checks, def)
+		case *tree.ForeignKey:
+			fks = append(fks, def)
+		case *tree.UniqueIndex, *tree.FullTextIndex:
 			// unsupport in plan. will support in next version.
 			return errors.New(errno.SQLStatementNotYetComplete, fmt.Sprintf("unsupport table def: '%v'", def))
 		default:
@@ -218,7 +319,10 @@ func buildTableDefs(defs tree.TableDefs, ctx CompilerContext, tableDef *TableDef
 		})
 	}
 
-	return nil
+	if err := buildChecks(checks, ctx, tableDef); err != nil {
+		return err
+	}
+	return buildForeignKeys(fks, ctx, database, tableDef, primaryKeys)
 }
 
 func buildDropTable(stmt *tree.DropTable, ctx CompilerContext) (*Plan, error) {
@@ -233,14 +337,29 @@ func buildDropTable(stmt *tree.DropTable, ctx CompilerContext) (*Plan, error) {
 		dropTable.Database = ctx.DefaultDatabase()
 	}
 	dropTable.Table = string(stmt.Names[0].ObjectName)
-	if !stmt.IfExists {
-		if !ctx.DatabaseExists(dropTable.Database) {
-			return nil, errors.New(errno.InvalidSchemaName, fmt.Sprintf("database '%v' doesn't exist", dropTable.Database))
-		}
-		_, tableDef := ctx.Resolve(dropTable.Database, dropTable.Table)
-		if tableDef == nil {
+	if !stmt.IfExists && !ctx.DatabaseExists(dropTable.Database) {
+		return nil, errors.New(errno.InvalidSchemaName, fmt.Sprintf("database '%v' doesn't exist", dropTable.Database))
+	}
+	_, tableDef := ctx.Resolve(dropTable.Database, dropTable.Table)
+	if tableDef == nil {
+		if !stmt.IfExists {
 			return nil, errors.New(errno.UndefinedTable, fmt.Sprintf("table '%v' doesn't exist", dropTable.Table))
 		}
+	} else {
+		view, err := GetViewDef(tableDef)
+		if err != nil {
+			return nil, err
+		}
+		if view != nil {
+			return nil, errors.New(errno.WrongObjectType, fmt.Sprintf("'%v.%v' is a view, use DROP VIEW to drop it", dropTable.Database, dropTable.Table))
+		}
+		mview, err := GetMaterializedViewDef(tableDef)
+		if err != nil {
+			return nil, err
+		}
+		if mview != nil {
+			return nil, errors.New(errno.WrongObjectType, fmt.Sprintf("'%v.%v' is a materialized view, use DROP MATERIALIZED VIEW to drop it", dropTable.Database, dropTable.Table))
+		}
 	}
 
 	return &Plan{
@@ -336,3 +455,12 @@ func buildDropIndex(stmt *tree.DropIndex, ctx CompilerContext) (*Plan, error) {
 	// 	},
 	// }, nil
 }
+
+func isIntegerType(id plan.Type_TypeId) bool {
+	switch id {
+	case plan.Type_INT8, plan.Type_INT16, plan.Type_INT32, plan.Type_INT64,
+		plan.Type_UINT8, plan.Type_UINT16, plan.Type_UINT32, plan.Type_UINT64:
+		return true
+	}
+	return false
+}
@@ -52,12 +52,24 @@ func appendDeleteNode(query *Query) (*Plan, error) {
 	if tableDef == nil {
 		return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, "cannot find delete table")
 	}
+	if err := checkModifiable(tableDef); err != nil {
+		return nil, err
+	}
+
+	// the delete node outputs the primary keys of the rows to delete
+	nodeId := query.Steps[len(query.Steps)-1]
+	cols, err := getTargetColumns(query, nodeId, tableDef)
+	if err != nil {
+		return nil, err
+	}
 
 	// append delete node
 	node := &Node{
-		NodeType: plan.Node_DELETE,
-		ObjRef:   objRef,
-		TableDef: tableDef,
+		NodeType:    plan.Node_DELETE,
+		ObjRef:      objRef,
+		TableDef:    tableDef,
+		Children:    []int32{nodeId},
+		ProjectList: []*Expr{cols[getPrimaryKeyPos(tableDef)]},
 	}
 	appendQueryNode(query, node)
 
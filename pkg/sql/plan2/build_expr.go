@@ -363,6 +363,17 @@ func buildFunctionExpr(astExpr *tree.FuncExpr, ctx CompilerContext, query *Query
 	}
 	funcName := strings.ToUpper(funcReference.Parts[0])
 
+	if funcName == "VALUES" && binderCtx != nil && binderCtx.insertValues != nil {
+		if len(astExpr.Exprs) == 1 {
+			if name, ok := astExpr.Exprs[0].(*tree.UnresolvedName); ok && name.NumParts == 1 {
+				if expr, ok := binderCtx.insertValues[name.Parts[0]]; ok {
+					return expr, needAgg, nil
+				}
+			}
+		}
+		return nil, false, errors.New(errno.InvalidColumnReference, fmt.Sprintf("'%v' must refer to a column of the inserted table", tree.String(astExpr, dialect.MYSQL)))
+	}
+
 	// TODO confirm: change count(*) to count(col_name)  but count(*) funcReference.Star is false why?
 	// if funcName == "COUNT" && funcReference.Star {
 	// 	funObjRef := getFunctionObjRef(funcName)
@@ -418,6 +429,15 @@ func buildComparisonExpr(astExpr *tree.ComparisonExpr, ctx CompilerContext, quer
 		}
 		resultExpr, _, err = getFunctionExprByNameAndPlanExprs("NOT", []*Expr{resultExpr})
 		return
+	case tree.REG_MATCH:
+		return getFunctionExprByNameAndAstExprs("REGEXP", []tree.Expr{astExpr.Left, astExpr.Right}, ctx, query, node, binderCtx, needAgg)
+	case tree.NOT_REG_MATCH:
+		resultExpr, isAgg, err = getFunctionExprByNameAndAstExprs("REGEXP", []tree.Expr{astExpr.Left, astExpr.Right}, ctx, query, node, binderCtx, needAgg)
+		if err != nil {
+			return
+		}
+		resultExpr, _, err = getFunctionExprByNameAndPlanExprs("NOT", []*Expr{resultExpr})
+		return
 	case tree.IN:
 		return getFunctionExprByNameAndAstExprs("IN", []tree.Expr{astExpr.Left, astExpr.Right}, ctx, query, node, binderCtx, needAgg)
 	case tree.NOT_IN:
@@ -16,6 +16,7 @@ package plan2
 
 import (
 	"fmt"
+	"go/constant"
 	"strings"
 
 	"github.com/matrixorigin/matrixone/pkg/errno"
@@ -68,6 +69,7 @@ func buildTable(stmt tree.TableExpr, ctx CompilerContext, query *Query, binderCt
 			subqueryIsCorrelated: false,
 			subqueryParentIds:    subqueryParentIds,
 			cteTables:            binderCtx.cteTables,
+			cteNodes:             binderCtx.cteNodes,
 		}
 		return buildSelect(tbl, ctx, query, newCtx)
 
@@ -84,6 +86,18 @@ func buildTable(stmt tree.TableExpr, ctx CompilerContext, query *Query, binderCt
 			if tableDef == nil {
 				return 0, errors.New(errno.InvalidTableDefinition, fmt.Sprintf("table '%v' does not exist", tblName))
 			}
+			if !isCte {
+				view, err := GetViewDef(tableDef)
+				if err != nil {
+					return 0, err
+				}
+				if view != nil {
+					return buildView(tableDef, view, ctx, query)
+				}
+				if tableDef, err = hideMaterializedViewColumns(tableDef); err != nil {
+					return 0, err
+				}
+			}
 			node := &Node{
 				ObjRef:   obj,
 				TableDef: tableDef,
@@ -91,12 +105,24 @@ func buildTable(stmt tree.TableExpr, ctx CompilerContext, query *Query, binderCt
 			if isCte {
 				node.NodeType = plan.Node_MATERIAL_SCAN
 			} else {
-				node.NodeType = plan.Node_TABLE_SCAN
+				param, err := GetExternParam(tableDef)
+				if err != nil {
+					return 0, err
+				}
+				if param != nil {
+					node.NodeType = plan.Node_EXTERNAL_SCAN
+				} else {
+					node.NodeType = plan.Node_TABLE_SCAN
+					node.Cost = ctx.Cost(obj, nil)
+				}
 			}
 			nodeId = appendQueryNode(query, node)
 		}
 		return
 
+	case *tree.TableFunction:
+		return buildTableFunction(tbl, ctx, query, binderCtx)
+
 	case *tree.JoinTableExpr:
 		return buildJoinTable(tbl, ctx, query, binderCtx)
 
@@ -114,9 +140,16 @@ func buildTable(stmt tree.TableExpr, ctx CompilerContext, query *Query, binderCt
 		if err != nil {
 			return
 		}
-		nodeId, err = fillTableProjectList(query, nodeId, tbl.As)
-
-		return
+		alias := tbl.As
+		if name, ok := tbl.Expr.(*tree.TableName); ok && alias.Alias == "" {
+			// the columns of an expanded view are referenced by the name of the view
+			alias.Alias = name.ObjectName
+		}
+		nodeId, err = fillTableProjectList(query, nodeId, alias)
+		if err != nil || tbl.Sample == nil {
+			return
+		}
+		return buildSample(tbl.Sample, query, nodeId)
 
 	case *tree.StatementSource:
 		// log.Printf("StatementSource")
@@ -127,6 +160,7 @@ func buildTable(stmt tree.TableExpr, ctx CompilerContext, query *Query, binderCt
 }
 
 func buildJoinTable(tbl *tree.JoinTableExpr, ctx CompilerContext, query *Query, binderCtx *BinderContext) (nodeId int32, err error) {
+	var joinType plan.Node_JoinFlag
 	var leftJoinType plan.Node_JoinFlag
 	var rightJoinType plan.Node_JoinFlag
 
@@ -136,12 +170,15 @@ func buildJoinTable(tbl *tree.JoinTableExpr, ctx CompilerContext, query *Query,
 		leftJoinType = plan.Node_INNER
 		rightJoinType = plan.Node_INNER
 	case tree.JOIN_TYPE_LEFT, tree.JOIN_TYPE_NATURAL_LEFT:
+		joinType = plan.Node_OUTER
 		leftJoinType = plan.Node_OUTER
 		rightJoinType = plan.Node_INNER
 	case tree.JOIN_TYPE_RIGHT, tree.JOIN_TYPE_NATURAL_RIGHT:
+		joinType = plan.Node_RIGHT
 		leftJoinType = plan.Node_INNER
 		rightJoinType = plan.Node_OUTER
 	case tree.JOIN_TYPE_FULL:
+		joinType = plan.Node_OUTER | plan.Node_RIGHT
 		leftJoinType = plan.Node_OUTER
 		rightJoinType = plan.Node_OUTER
 	}
@@ -159,6 +196,7 @@ func buildJoinTable(tbl *tree.JoinTableExpr, ctx CompilerContext, query *Query,
 	node := &Node{
 		NodeType: plan.Node_JOIN,
 		Children: []int32{leftChildId, rightChildId},
+		JoinType: joinType,
 	}
 
 	leftChild := query.Nodes[leftChildId]
@@ -231,3 +269,77 @@ func buildJoinTable(tbl *tree.JoinTableExpr, ctx CompilerContext, query *Query,
 
 	return
 }
+
+// buildSample appends a SAMPLE node reading a part of the blocks or rows of the table scan
+func buildSample(stmt *tree.TableSample, query *Query, nodeId int32) (int32, error) {
+	scanNode := query.Nodes[nodeId]
+	if scanNode.NodeType != plan.Node_TABLE_SCAN {
+		return 0, errors.New(errno.WrongObjectType, "TABLESAMPLE can only be applied to a base table")
+	}
+	percent, ok := constant.Float64Val(constant.ToFloat(stmt.Percent.Value))
+	if !ok || percent < 0 || percent > 100 {
+		return 0, errors.New(errno.DataException, fmt.Sprintf("sample percentage must be between 0 and 100, got %v", stmt.Percent))
+	}
+	smp := &Sample{
+		Method:  stmt.Method,
+		Percent: percent,
+	}
+	if stmt.Seed != nil {
+		seed, ok := constant.Int64Val(stmt.Seed.Value)
+		if !ok {
+			return 0, errors.New(errno.DataException, fmt.Sprintf("invalid sample seed %v", stmt.Seed))
+		}
+		smp.Repeatable = true
+		smp.Seed = seed
+	}
+
+	node := &Node{
+		NodeType:     plan.Node_SAMPLE,
+		Children:     []int32{nodeId},
+		ExtraOptions: smp.String(),
+		ProjectList:  make([]*Expr, len(scanNode.ProjectList)),
+	}
+	for i, expr := range scanNode.ProjectList {
+		node.ProjectList[i] = &Expr{
+			Typ:       expr.Typ,
+			TableName: expr.TableName,
+			ColName:   expr.ColName,
+			Expr: &plan.Expr_Col{
+				Col: &ColRef{
+					RelPos: 0,
+					ColPos: int32(i),
+				},
+			},
+		}
+	}
+	return appendQueryNode(query, node), nil
+}
+
+func (smp *Sample) String() string {
+	s := fmt.Sprintf("%s (%v percent)", smp.Method.ToString(), smp.Percent)
+	if smp.Repeatable {
+		s += fmt.Sprintf(" repeatable (%v)", smp.Seed)
+	}
+	return s
+}
+
+// ParseSample returns the sample kept in the ExtraOptions of a SAMPLE node
+func ParseSample(options string) (*Sample, error) {
+	var method string
+
+	smp := new(Sample)
+	if n, _ := fmt.Sscanf(options, "%s (%g percent) repeatable (%d)", &method, &smp.Percent, &smp.Seed); n == 3 {
+		smp.Repeatable = true
+	} else if n, _ := fmt.Sscanf(options, "%s (%g percent)", &method, &smp.Percent); n != 2 {
+		return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("invalid sample '%s'", options))
+	}
+	switch method {
+	case tree.SampleSystem.ToString():
+		smp.Method = tree.SampleSystem
+	case tree.SampleBernoulli.ToString():
+		smp.Method = tree.SampleBernoulli
+	default:
+		return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("invalid sample method '%s'", method))
+	}
+	return smp, nil
+}
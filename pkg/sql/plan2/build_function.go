@@ -15,6 +15,7 @@
 package plan2
 
 import (
+	"fmt"
 	"go/constant"
 	"strings"
 
@@ -86,6 +87,9 @@ func getFunctionExprByNameAndPlanExprs(name string, exprs []*Expr) (resultExpr *
 	returnType := &Type{
 		Id: plan.Type_TypeId(funcDef.ReturnTyp),
 	}
+	if funcDef.ReturnTyp == types.T_decimal64 || funcDef.ReturnTyp == types.T_decimal128 {
+		returnType.Width, returnType.Scale = getDecimalResultType(name, exprs)
+	}
 	resultExpr = &Expr{
 		Expr: &plan.Expr_F{
 			F: &plan.Function{
@@ -100,12 +104,40 @@ func getFunctionExprByNameAndPlanExprs(name string, exprs []*Expr) (resultExpr *
 	return
 }
 
+// getDecimalResultType returns the precision and scale of a function returning decimal, which follow the rules of mysql
+func getDecimalResultType(name string, exprs []*Expr) (int32, int32) {
+	switch {
+	case len(exprs) == 2:
+		l, r := exprs[0].Typ, exprs[1].Typ
+		switch name {
+		case "+", "-":
+			return types.DecimalAddResultType(l.Width, l.Scale, r.Width, r.Scale)
+		case "*":
+			return types.DecimalMulResultType(l.Width, l.Scale, r.Width, r.Scale)
+		case "/":
+			return types.DecimalDivResultType(l.Width, l.Scale, r.Width, r.Scale)
+		}
+	case len(exprs) == 1:
+		arg := exprs[0].Typ
+		switch name {
+		case "sum":
+			return types.DecimalSumResultType(arg.Width, arg.Scale)
+		case "avg":
+			return types.DecimalAvgResultType(arg.Width, arg.Scale)
+		}
+	}
+	if len(exprs) > 0 {
+		return exprs[0].Typ.Width, exprs[0].Typ.Scale
+	}
+	return types.MaxDecimal128Width, 0
+}
+
 func getFunctionExprByNameAndAstExprs(name string, astExprs []tree.Expr, ctx CompilerContext, query *Query, node *Node, binderCtx *BinderContext, needAgg bool) (resultExpr *Expr, isAgg bool, err error) {
 	name = strings.ToLower(name)
 	args := make([]*Expr, len(astExprs))
 	// deal with special function
 	switch name {
-	case "extract":
+	case "extract", "timestampdiff", "timestampadd":
 		kindExpr := astExprs[0].(*tree.UnresolvedName)
 		astExprs[0] = tree.NewNumVal(constant.MakeString(kindExpr.Parts[0]), kindExpr.Parts[0], false)
 	}
@@ -124,6 +156,16 @@ func getFunctionExprByNameAndAstExprs(name string, astExprs []tree.Expr, ctx Com
 		args[idx] = expr
 	}
 
+	// the percentile of approx_percentile and percentile_xxx is a constant parameter of the aggregate
+	switch name {
+	case "approx_percentile", "approx_percentile_error", "percentile_cont", "percentile_disc", "percentile_approx":
+		if len(args) == 2 {
+			if err = checkPercentile(args[1]); err != nil {
+				return
+			}
+		}
+	}
+
 	// deal with special function
 	switch name {
 	case "date":
@@ -154,6 +196,26 @@ func getFunctionExprByNameAndAstExprs(name string, astExprs []tree.Expr, ctx Com
 	return
 }
 
+// checkPercentile checks that the percentile of approx_percentile and percentile_xxx is a constant number in [0, 1]
+func checkPercentile(expr *Expr) error {
+	if c, ok := expr.Expr.(*plan.Expr_C); ok {
+		var p float64
+		switch v := c.C.Value.(type) {
+		case *plan.Const_Dval:
+			p = v.Dval
+		case *plan.Const_Ival:
+			p = float64(v.Ival)
+		default:
+			return errors.New(errno.DataException, "the percentile must be a number")
+		}
+		if p < 0 || p > 1 {
+			return errors.New(errno.DataException, fmt.Sprintf("the percentile '%v' is out of range [0, 1]", p))
+		}
+		return nil
+	}
+	return errors.New(errno.DataException, "the percentile must be a constant")
+}
+
 func appendCastExpr(expr *Expr, toType *Type) (*Expr, error) {
 	argsType := []types.T{
 		types.T(expr.Typ.Id),
@@ -17,7 +17,9 @@ package plan2
 import (
 	"fmt"
 	"go/constant"
+	"go/token"
 
+	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/errno"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/errors"
@@ -93,8 +95,21 @@ func buildInsert(stmt *tree.Insert, ctx CompilerContext) (p *Plan, err error) {
 			return
 		}
 		node := &Node{
-			NodeType:   plan.Node_VALUE_SCAN,
-			RowsetData: rowset,
+			NodeType:    plan.Node_VALUE_SCAN,
+			RowsetData:  rowset,
+			ProjectList: make([]*Expr, columnCount),
+		}
+		for idx, col := range columns {
+			node.ProjectList[idx] = &Expr{
+				Typ:     col.Typ,
+				ColName: col.Name,
+				Expr: &plan.Expr_Col{
+					Col: &ColRef{
+						RelPos: 0,
+						ColPos: int32(idx),
+					},
+				},
+			}
 		}
 		nodeId = appendQueryNode(query, node)
 	default:
@@ -107,6 +122,17 @@ func buildInsert(stmt *tree.Insert, ctx CompilerContext) (p *Plan, err error) {
 		TableDef: tableDef,
 		Children: []int32{nodeId},
 	}
+	if len(stmt.OnDuplicateUpdate) == 0 {
+		node.ProjectList, err = buildInsertRow(tableDef, columns, query.Nodes[nodeId].ProjectList, 0)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		err = buildOnDuplicateUpdate(stmt.OnDuplicateUpdate, ctx, query, node, columns)
+		if err != nil {
+			return nil, err
+		}
+	}
 	appendQueryNode(query, node)
 
 	preNode := query.Nodes[len(query.Nodes)-1]
@@ -123,9 +149,278 @@ func buildInsert(stmt *tree.Insert, ctx CompilerContext) (p *Plan, err error) {
 	}, nil
 }
 
+// buildInsertRow returns the values of a row to insert in the order of the table's columns.
+// The value of columns[j] is the column offset+j of the child, the other columns get their default values.
+func buildInsertRow(tableDef *TableDef, columns []*ColDef, srcList []*Expr, offset int) ([]*Expr, error) {
+	row := make([]*Expr, len(tableDef.Cols))
+	for i, col := range tableDef.Cols {
+		for j, c := range columns {
+			if c.Name == col.Name {
+				row[i] = &Expr{
+					Typ:     srcList[j].Typ,
+					ColName: col.Name,
+					Expr: &plan.Expr_Col{
+						Col: &ColRef{
+							RelPos: 0,
+							ColPos: int32(offset + j),
+						},
+					},
+				}
+				break
+			}
+		}
+		if row[i] == nil {
+			expr, err := getDefaultExpr(col)
+			if err != nil {
+				return nil, err
+			}
+			row[i] = expr
+		}
+	}
+	return row, nil
+}
+
+// getDefaultExpr returns the constant of a column's default value, the values which have
+// no constant form are kept as strings and converted to the column's type at execution.
+func getDefaultExpr(col *ColDef) (*Expr, error) {
+	expr := &Expr{
+		Typ:     col.Typ,
+		ColName: col.Name,
+	}
+	c := &Const{}
+	expr.Expr = &plan.Expr_C{C: c}
+	dflt := col.GetDefault()
+	if !dflt.GetExist() || dflt.GetIsNull() {
+		c.Isnull = true
+		return expr, nil
+	}
+	switch v := dflt.GetValue().GetConstantValue().(type) {
+	case *plan.ConstantValue_Int64V:
+		switch col.Typ.Id {
+		case plan.Type_TIME:
+			c.Value = &plan.Const_Sval{Sval: types.Time(v.Int64V).String2(col.Typ.Precision)}
+		case plan.Type_YEAR:
+			c.Value = &plan.Const_Sval{Sval: types.YearValue(v.Int64V).String()}
+		default:
+			c.Value = &plan.Const_Ival{Ival: v.Int64V}
+		}
+	case *plan.ConstantValue_Uint64V:
+		c.Value = &plan.Const_Ival{Ival: int64(v.Uint64V)}
+	case *plan.ConstantValue_Float32V:
+		c.Value = &plan.Const_Dval{Dval: float64(v.Float32V)}
+	case *plan.ConstantValue_Float64V:
+		c.Value = &plan.Const_Dval{Dval: v.Float64V}
+	case *plan.ConstantValue_StringV:
+		c.Value = &plan.Const_Sval{Sval: v.StringV}
+	case *plan.ConstantValue_DateV:
+		c.Value = &plan.Const_Sval{Sval: types.Date(v.DateV).String()}
+	case *plan.ConstantValue_DateTimeV:
+		c.Value = &plan.Const_Sval{Sval: types.Datetime(v.DateTimeV).String()}
+	case *plan.ConstantValue_TimeStampV:
+		c.Value = &plan.Const_Sval{Sval: types.Timestamp(v.TimeStampV).String()}
+	case *plan.ConstantValue_Decimal64V:
+		c.Value = &plan.Const_Sval{Sval: string(types.Decimal64(v.Decimal64V).Decimal64ToString(col.Typ.Scale))}
+	case *plan.ConstantValue_Decimal128V:
+		d := types.Decimal128{Lo: v.Decimal128V.Lo, Hi: v.Decimal128V.Hi}
+		c.Value = &plan.Const_Sval{Sval: string(d.Decimal128ToString(col.Typ.Scale))}
+	default:
+		return nil, errors.New(errno.InvalidColumnDefinition, fmt.Sprintf("unsupport default value of column '%v'", col.Name))
+	}
+	return expr, nil
+}
+
+// buildOnDuplicateUpdate plans INSERT ... ON DUPLICATE KEY UPDATE. The rows to insert are
+// left joined with the target table on the primary key, and the INSERT node projects
+// the new row, the updated row and the existing row.
+func buildOnDuplicateUpdate(exprs tree.UpdateExprs, ctx CompilerContext, query *Query, node *Node, columns []*ColDef) error {
+	tableDef := node.TableDef
+	srcId := node.Children[0]
+	srcList := query.Nodes[srcId].ProjectList
+	pkPos := getPrimaryKeyPos(tableDef)
+	pkName := tableDef.Cols[pkPos].Name
+	srcPkPos := -1
+	for j, col := range columns {
+		if col.Name == pkName {
+			srcPkPos = j
+		}
+	}
+	if srcPkPos == -1 {
+		return errors.New(errno.FeatureNotSupported, fmt.Sprintf("insert with on duplicate key update must give the primary key '%v'", pkName))
+	}
+
+	// scan the target table
+	scanId := appendQueryNode(query, &Node{
+		NodeType: plan.Node_TABLE_SCAN,
+		ObjRef:   node.ObjRef,
+		TableDef: tableDef,
+	})
+	if _, err := fillTableProjectList(query, scanId, tree.AliasClause{}); err != nil {
+		return err
+	}
+	scanList := query.Nodes[scanId].ProjectList
+
+	// the join outputs the new rows followed by the existing rows
+	joinNode := &Node{
+		NodeType:    plan.Node_JOIN,
+		JoinType:    plan.Node_OUTER,
+		Children:    []int32{srcId, scanId},
+		ProjectList: make([]*Expr, 0, len(srcList)+len(scanList)),
+	}
+	for j, expr := range srcList {
+		col := &Expr{
+			Typ: expr.Typ,
+			Expr: &plan.Expr_Col{
+				Col: &ColRef{
+					RelPos: 0,
+					ColPos: int32(j),
+				},
+			},
+		}
+		// the unqualified names refer to the existing rows
+		if len(expr.TableName) > 0 {
+			col.TableName, col.ColName = expr.TableName, expr.ColName
+		}
+		joinNode.ProjectList = append(joinNode.ProjectList, col)
+	}
+	for i, expr := range scanList {
+		joinNode.ProjectList = append(joinNode.ProjectList, &Expr{
+			Typ:       expr.Typ,
+			TableName: expr.TableName,
+			ColName:   expr.ColName,
+			Expr: &plan.Expr_Col{
+				Col: &ColRef{
+					RelPos: 1,
+					ColPos: int32(i),
+				},
+			},
+		})
+	}
+	cond, _, err := getFunctionExprByNameAndPlanExprs("=", []*Expr{
+		{
+			Typ: srcList[srcPkPos].Typ,
+			Expr: &plan.Expr_Col{
+				Col: &ColRef{
+					RelPos: 0,
+					ColPos: int32(srcPkPos),
+				},
+			},
+		},
+		{
+			Typ: tableDef.Cols[pkPos].Typ,
+			Expr: &plan.Expr_Col{
+				Col: &ColRef{
+					RelPos: 1,
+					ColPos: int32(pkPos),
+				},
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	for _, arg := range cond.Expr.(*plan.Expr_F).F.Args {
+		if _, ok := arg.Expr.(*plan.Expr_Col); !ok {
+			return errors.New(errno.DatatypeMismatch, fmt.Sprintf("the type of the inserted primary key '%v' does not match the column", pkName))
+		}
+	}
+	joinNode.OnList = []*Expr{cond}
+	node.Children[0] = appendQueryNode(query, joinNode)
+
+	newRow, err := buildInsertRow(tableDef, columns, srcList, 0)
+	if err != nil {
+		return err
+	}
+	oldRow := make([]*Expr, len(tableDef.Cols))
+	for i, col := range tableDef.Cols {
+		oldRow[i] = &Expr{
+			Typ:       col.Typ,
+			TableName: tableDef.Name,
+			ColName:   col.Name,
+			Expr: &plan.Expr_Col{
+				Col: &ColRef{
+					RelPos: 0,
+					ColPos: int32(len(srcList) + i),
+				},
+			},
+		}
+	}
+
+	// VALUES(col) refers to the value which would be inserted into col
+	binderCtx := &BinderContext{
+		columnAlias:  make(map[string]*Expr),
+		insertValues: make(map[string]*Expr),
+	}
+	for i, col := range tableDef.Cols {
+		binderCtx.insertValues[col.Name] = newRow[i]
+	}
+	updRow := make([]*Expr, len(oldRow))
+	copy(updRow, oldRow)
+	columnExprs := make([]*Expr, 0, len(exprs))
+	values := make([]*Expr, 0, len(exprs))
+	for _, expr := range exprs {
+		if len(expr.Names) != 1 || expr.Names[0].NumParts != 1 {
+			return errors.New(errno.CaseNotFound, "the set list of update must be one")
+		}
+		idx := -1
+		for i, col := range tableDef.Cols {
+			if col.Name == expr.Names[0].Parts[0] {
+				idx = i
+			}
+		}
+		if idx == -1 {
+			return errors.New(errno.CaseNotFound, fmt.Sprintf("set column name [%v] is not found", expr.Names[0].Parts[0]))
+		}
+		value, _, err := buildExpr(expr.Expr, ctx, query, node, binderCtx, false)
+		if err != nil {
+			return err
+		}
+		updRow[idx] = value
+		columnExprs = append(columnExprs, oldRow[idx])
+		values = append(values, value)
+	}
+	node.UpdateList = &plan.UpdateList{
+		Columns: columnExprs,
+		Values:  values,
+	}
+
+	node.ProjectList = make([]*Expr, 0, 3*len(tableDef.Cols))
+	node.ProjectList = append(node.ProjectList, newRow...)
+	node.ProjectList = append(node.ProjectList, updRow...)
+	node.ProjectList = append(node.ProjectList, oldRow...)
+	return nil
+}
+
+// getPrimaryKeyPos returns the position of the primary key in the table's columns,
+// the first column is used as the hidden key of the tables without primary key.
+func getPrimaryKeyPos(tableDef *TableDef) int {
+	for i, col := range tableDef.Cols {
+		if col.Primary {
+			return i
+		}
+	}
+	return 0
+}
+
 func getValues(rowset *RowsetData, rows *tree.ValuesClause, columnCount int) error {
 	setColData := func(col *plan.ColData, typ *plan.Type, val constant.Value) error {
 		switch val.Kind() {
+		case constant.Unknown:
+			// NULL takes the zero value of the column's slot
+			col.NullCount++
+			col.Nulls[len(col.Nulls)-1] = true
+			switch typ.Id {
+			case plan.Type_INT8, plan.Type_INT16, plan.Type_INT32, plan.Type_UINT8, plan.Type_UINT16:
+				col.I32 = append(col.I32, 0)
+			case plan.Type_INT64, plan.Type_INT128, plan.Type_UINT32, plan.Type_UINT64, plan.Type_UINT128:
+				col.I64 = append(col.I64, 0)
+			case plan.Type_FLOAT32:
+				col.F32 = append(col.F32, 0)
+			case plan.Type_FLOAT64, plan.Type_DECIMAL, plan.Type_DECIMAL64, plan.Type_DECIMAL128:
+				col.F64 = append(col.F64, 0)
+			default:
+				col.S = append(col.S, "")
+			}
+			return nil
 		case constant.Int:
 			switch typ.Id {
 			case plan.Type_INT8, plan.Type_INT16, plan.Type_INT32, plan.Type_UINT8, plan.Type_UINT16:
@@ -142,6 +437,14 @@ func getValues(rowset *RowsetData, rows *tree.ValuesClause, columnCount int) err
 				}
 				col.I64 = append(col.I64, colVal)
 				return nil
+			case plan.Type_FLOAT32:
+				colVal, _ := constant.Float32Val(val)
+				col.F32 = append(col.F32, colVal)
+				return nil
+			case plan.Type_FLOAT64, plan.Type_DECIMAL, plan.Type_DECIMAL64, plan.Type_DECIMAL128:
+				colVal, _ := constant.Float64Val(val)
+				col.F64 = append(col.F64, colVal)
+				return nil
 			default:
 				return errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("[%v] type must be int", val))
 			}
@@ -179,12 +482,22 @@ func getValues(rowset *RowsetData, rows *tree.ValuesClause, columnCount int) err
 		}
 
 		for idx, colExpr := range row {
+			rowset.Cols[idx].Nulls = append(rowset.Cols[idx].Nulls, false)
 			switch astExpr := colExpr.(type) {
 			case *tree.NumVal:
 				err := setColData(rowset.Cols[idx], rowset.Schema.Cols[idx].Typ, astExpr.Value)
 				if err != nil {
 					return err
 				}
+			case *tree.UnaryExpr:
+				num, ok := astExpr.Expr.(*tree.NumVal)
+				if !ok || astExpr.Op != tree.UNARY_MINUS {
+					return errors.New(errno.InvalidSchemaName, "insert value must be constant")
+				}
+				err := setColData(rowset.Cols[idx], rowset.Schema.Cols[idx].Typ, constant.UnaryOp(token.SUB, num.Value, 0))
+				if err != nil {
+					return err
+				}
 			default:
 				return errors.New(errno.InvalidSchemaName, "insert value must be constant")
 			}
@@ -203,6 +516,9 @@ func getInsertTable(stmt tree.TableExpr, ctx CompilerContext, query *Query) (*Ob
 		if tableDef == nil {
 			return nil, nil, errors.New(errno.InvalidSchemaName, fmt.Sprintf("table '%v' is not exist", tblName))
 		}
+		if err := checkModifiable(tableDef); err != nil {
+			return nil, nil, err
+		}
 		return objRef, tableDef, nil
 	case *tree.ParenTableExpr:
 		return getInsertTable(tbl.Expr, ctx, query)
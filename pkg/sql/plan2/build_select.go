@@ -204,6 +204,15 @@ func buildCTE(withExpr *tree.With, ctx CompilerContext, query *Query, binderCtx
 
 	var err error
 	for _, cte := range withExpr.CTEs {
+		if withExpr.IsRecursive {
+			if union, ok := getRecursiveUnion(cte); ok {
+				if err = buildRecursiveCTE(cte, union, ctx, query, binderCtx); err != nil {
+					return err
+				}
+				continue
+			}
+		}
+
 		switch stmt := cte.Stmt.(type) {
 		case *tree.Select:
 			_, err = buildSelect(stmt, ctx, query, binderCtx)
@@ -220,48 +229,15 @@ func buildCTE(withExpr *tree.With, ctx CompilerContext, query *Query, binderCtx
 		alias := string(cte.Name.Alias)
 		preNode := query.Nodes[len(query.Nodes)-1]
 
-		node := &Node{
-			NodeType: plan.Node_MATERIAL,
-			Children: []int32{preNode.NodeId},
-		}
-
-		columnLength := len(preNode.ProjectList)
-		tableDef := &TableDef{
-			Name: alias,
-			Cols: make([]*ColDef, columnLength),
+		tableDef, exprs, err := buildCTETable(cte, preNode.ProjectList)
+		if err != nil {
+			return err
 		}
-		exprs := make([]*Expr, columnLength)
-		if cte.Name.Cols != nil {
-			if len(preNode.ProjectList) != len(cte.Name.Cols) {
-				return errors.New(errno.InvalidColumnReference, "CTE table column length not match")
-			}
-			for idx, col := range cte.Name.Cols {
-				exprs[idx] = &Expr{
-					Expr:      nil,
-					TableName: alias,
-					ColName:   string(col),
-					Typ:       preNode.ProjectList[idx].Typ,
-				}
-				tableDef.Cols[idx] = &ColDef{
-					Typ:  preNode.ProjectList[idx].Typ,
-					Name: string(col),
-				}
-			}
-			node.ProjectList = exprs
-		} else {
-			for idx, col := range preNode.ProjectList {
-				exprs[idx] = &Expr{
-					Expr:      nil,
-					TableName: alias,
-					ColName:   col.ColName,
-					Typ:       col.Typ,
-				}
-				tableDef.Cols[idx] = &ColDef{
-					Typ:  col.Typ,
-					Name: col.ColName,
-				}
-			}
-			node.ProjectList = exprs
+		node := &Node{
+			NodeType:    plan.Node_MATERIAL,
+			Children:    []int32{preNode.NodeId},
+			ProjectList: exprs,
+			TableDef:    tableDef,
 		}
 
 		// set cte table to binderCtx
@@ -271,12 +247,167 @@ func buildCTE(withExpr *tree.With, ctx CompilerContext, query *Query, binderCtx
 
 		// set cte table node_id to step
 		cteNodeId := query.Nodes[len(query.Nodes)-1].NodeId
+		binderCtx.cteNodes[strings.ToLower(alias)] = cteNodeId
 		query.Steps = append(query.Steps, cteNodeId)
 	}
 
 	return nil
 }
 
+// materializeCtes turns the ctes referenced more than once into SINK nodes and their references
+// into SINK_SCAN nodes, the rows of such a cte are computed once and read by all the references.
+// A cte referenced once is still computed by its MATERIAL_SCAN. The references refer to the node
+// of their cte by the Obj of their ObjRef, so the ctes of the same name in different scopes are
+// not mixed up.
+func materializeCtes(query *Query) {
+	refs := make(map[int64]int)
+	for _, node := range query.Nodes {
+		if node.NodeType == plan.Node_MATERIAL_SCAN {
+			refs[node.ObjRef.Obj]++
+		}
+	}
+	for _, step := range query.Steps {
+		if node := query.Nodes[step]; node.NodeType == plan.Node_MATERIAL && refs[int64(node.NodeId)] > 1 {
+			node.NodeType = plan.Node_SINK
+		}
+	}
+	for _, node := range query.Nodes {
+		// the recursive part of a recursive cte refers to no node
+		if node.NodeType == plan.Node_MATERIAL_SCAN && node.ObjRef.Obj >= 0 &&
+			query.Nodes[node.ObjRef.Obj].NodeType == plan.Node_SINK {
+			node.NodeType = plan.Node_SINK_SCAN
+		}
+	}
+}
+
+// buildCTETable returns the table definition of the cte and the columns of the table
+func buildCTETable(cte *tree.CTE, projectList []*Expr) (*TableDef, []*Expr, error) {
+	alias := string(cte.Name.Alias)
+	columnLength := len(projectList)
+	tableDef := &TableDef{
+		Name: alias,
+		Cols: make([]*ColDef, columnLength),
+	}
+	exprs := make([]*Expr, columnLength)
+	if cte.Name.Cols != nil {
+		if len(projectList) != len(cte.Name.Cols) {
+			return nil, nil, errors.New(errno.InvalidColumnReference, "CTE table column length not match")
+		}
+		for idx, col := range cte.Name.Cols {
+			exprs[idx] = &Expr{
+				Expr:      nil,
+				TableName: alias,
+				ColName:   string(col),
+				Typ:       projectList[idx].Typ,
+			}
+			tableDef.Cols[idx] = &ColDef{
+				Typ:  projectList[idx].Typ,
+				Name: string(col),
+			}
+		}
+	} else {
+		for idx, col := range projectList {
+			exprs[idx] = &Expr{
+				Expr:      nil,
+				TableName: alias,
+				ColName:   col.ColName,
+				Typ:       col.Typ,
+			}
+			tableDef.Cols[idx] = &ColDef{
+				Typ:  col.Typ,
+				Name: col.ColName,
+			}
+		}
+	}
+	return tableDef, exprs, nil
+}
+
+// getRecursiveUnion returns the union of the non-recursive part and the recursive part of a cte
+func getRecursiveUnion(cte *tree.CTE) (*tree.UnionClause, bool) {
+	var stmt *tree.Select
+	switch s := cte.Stmt.(type) {
+	case *tree.Select:
+		stmt = s
+	case *tree.ParenSelect:
+		stmt = s.Select
+	default:
+		return nil, false
+	}
+	union, ok := stmt.Select.(*tree.UnionClause)
+	if !ok || stmt.OrderBy != nil || stmt.Limit != nil {
+		return nil, false
+	}
+	return union, union.Type == tree.UNION
+}
+
+// buildRecursiveCTE builds a recursive cte to a RECURSIVE_CTE node, the child of the node is a
+// UNION or UNION_ALL node whose children are the non-recursive part and the recursive part.
+// The recursive part reads the rows of the last iteration by the MATERIAL_SCAN of the cte.
+func buildRecursiveCTE(cte *tree.CTE, union *tree.UnionClause, ctx CompilerContext, query *Query, binderCtx *BinderContext) error {
+	alias := string(cte.Name.Alias)
+
+	// the cte is not visible to the non-recursive part
+	anchorId, err := buildSelect(&tree.Select{Select: union.Left}, ctx, query, binderCtx)
+	if err != nil {
+		return err
+	}
+	tableDef, exprs, err := buildCTETable(cte, query.Nodes[anchorId].ProjectList)
+	if err != nil {
+		return err
+	}
+	binderCtx.cteTables[strings.ToLower(alias)] = tableDef
+	// the recursive part reads the last iteration, not the rows of a node
+	binderCtx.cteNodes[strings.ToLower(alias)] = -1
+
+	nodeCount := len(query.Nodes)
+	recursiveId, err := buildSelect(&tree.Select{Select: union.Right}, ctx, query, binderCtx)
+	if err != nil {
+		return err
+	}
+	refs := 0
+	for _, node := range query.Nodes[nodeCount:] {
+		if node.NodeType == plan.Node_SORT || node.Limit != nil || node.Offset != nil {
+			return errors.New(errno.InvalidRecursion, fmt.Sprintf("recursive cte '%v' can contain neither ORDER BY nor LIMIT in recursive query block", alias))
+		}
+		if len(node.AggList) > 0 || node.NodeType == plan.Node_AGG || node.NodeType == plan.Node_WINDOW {
+			return errors.New(errno.InvalidRecursion, fmt.Sprintf("recursive cte '%v' can contain neither aggregation nor window functions in recursive query block", alias))
+		}
+		if node.NodeType == plan.Node_MATERIAL_SCAN && node.TableDef.Name == alias {
+			refs++
+		}
+	}
+	switch {
+	case refs == 0:
+		return errors.New(errno.InvalidRecursion, fmt.Sprintf("recursive cte '%v' should have one or more non-recursive query blocks followed by one or more recursive ones", alias))
+	case refs > 1:
+		return errors.New(errno.InvalidRecursion, fmt.Sprintf("recursive cte '%v' can be referenced only once in recursive query block", alias))
+	}
+	if len(query.Nodes[recursiveId].ProjectList) != len(exprs) {
+		return errors.New(errno.InvalidRecursion, "the used SELECT statements have a different number of columns")
+	}
+
+	unionNode := &Node{
+		NodeType:    plan.Node_UNION,
+		Children:    []int32{anchorId, recursiveId},
+		ProjectList: exprs,
+	}
+	if union.All {
+		unionNode.NodeType = plan.Node_UNION_ALL
+	}
+	unionId := appendQueryNode(query, unionNode)
+
+	node := &Node{
+		NodeType:    plan.Node_RECURSIVE_CTE,
+		Children:    []int32{unionId},
+		ProjectList: exprs,
+		TableDef:    tableDef,
+	}
+	nodeId := appendQueryNode(query, node)
+	binderCtx.cteNodes[strings.ToLower(alias)] = nodeId
+	query.Steps = append(query.Steps, nodeId)
+	return nil
+}
+
 func buildSelectClause(stmt *tree.SelectClause, ctx CompilerContext, query *Query, binderCtx *BinderContext) (nodeId int32, selectExprs tree.SelectExprs, err error) {
 	// from
 	nodeId, err = buildFrom(stmt.From.Tables, ctx, query, binderCtx)
@@ -292,6 +423,10 @@ func buildSelectClause(stmt *tree.SelectClause, ctx CompilerContext, query *Quer
 		if err != nil {
 			return
 		}
+		nodeId, err = buildSubqueryJoins(query, nodeId)
+		if err != nil {
+			return
+		}
 	}
 
 	if stmt.GroupBy != nil || stmt.Having != nil || stmt.Distinct {
@@ -21,6 +21,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/errors"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"google.golang.org/protobuf/proto"
 )
 
 func buildSubQuery(subquery *tree.Subquery, ctx CompilerContext, query *Query, node *Node, binderCtx *BinderContext) (*Expr, error) {
@@ -30,10 +31,12 @@ func buildSubQuery(subquery *tree.Subquery, ctx CompilerContext, query *Query, n
 		subqueryIsCorrelated: false,
 		subqueryParentIds:    subqueryParentIds,
 		cteTables:            binderCtx.cteTables,
+		cteNodes:             binderCtx.cteNodes,
 	}
 
 	var nodeId int32
 	var err error
+	nodeCount := len(query.Nodes)
 	switch sub := subquery.Select.(type) {
 	case *tree.ParenSelect:
 		nodeId, err = buildSelect(sub.Select, ctx, query, newCtx)
@@ -49,7 +52,7 @@ func buildSubQuery(subquery *tree.Subquery, ctx CompilerContext, query *Query, n
 	expr := &plan.SubQuery{
 		NodeId:       nodeId,
 		IsScalar:     false,
-		IsCorrelated: newCtx.subqueryIsCorrelated,
+		IsCorrelated: newCtx.subqueryIsCorrelated || refersOuterNodes(query, nodeCount),
 	}
 
 	returnExpr := &Expr{
@@ -68,3 +71,328 @@ func buildSubQuery(subquery *tree.Subquery, ctx CompilerContext, query *Query, n
 	}
 	return returnExpr, nil
 }
+
+// buildSubqueryJoins plans the uncorrelated IN and EXISTS subqueries of the conjuncts of the
+// WHERE clause of the node as joins of the node with the subqueries:
+//
+//	x IN (subquery)        a SEMI join on x keeping the rows matched by the subquery
+//	x NOT IN (subquery)    a MARK join on x followed by a filter on NOT mark, the mark is
+//	                       null if x is compared with a null, as the NOT IN is
+//	EXISTS (subquery)      a SEMI join without conditions
+//	NOT EXISTS (subquery)  an ANTI join without conditions
+//
+// The other conjuncts stay in the WHERE clause of the node. If a join is planned, the returned
+// node is a PROJECT on top of the joins with the columns of the node.
+func buildSubqueryJoins(query *Query, nodeId int32) (int32, error) {
+	node := query.Nodes[nodeId]
+	whereList := make([]*Expr, 0, len(node.WhereList))
+	topId := nodeId
+	for _, cond := range node.WhereList {
+		joinType, key, sub := subqueryJoinCond(cond)
+		if sub == nil {
+			whereList = append(whereList, cond)
+			continue
+		}
+		join := &Node{
+			NodeType:    plan.Node_JOIN,
+			Children:    []int32{topId, sub.NodeId},
+			JoinType:    joinType,
+			ProjectList: columnsOf(node),
+		}
+		if key != nil {
+			right := query.Nodes[sub.NodeId]
+			if len(right.ProjectList) != 1 {
+				return 0, errors.New(errno.CardinalityViolation, "operand should contain 1 column(s)")
+			}
+			onCond, ok, err := subqueryJoinOn(node, key, right.ProjectList[0])
+			if err != nil {
+				return 0, err
+			}
+			if !ok {
+				whereList = append(whereList, cond)
+				continue
+			}
+			join.OnList = []*Expr{onCond}
+		}
+		if joinType == plan.Node_MARK {
+			// the mark is computed by the join, it follows the columns of the left side
+			mark := &Expr{
+				Typ: &plan.Type{
+					Id:       plan.Type_BOOL,
+					Nullable: true,
+				},
+				ColName: "mark",
+				Expr: &plan.Expr_Col{
+					Col: &ColRef{
+						RelPos: -1,
+						ColPos: int32(len(join.ProjectList)),
+					},
+				},
+			}
+			join.ProjectList = append(join.ProjectList, mark)
+			topId = appendQueryNode(query, join)
+			notMark, _, err := getFunctionExprByNameAndPlanExprs("NOT", []*Expr{{
+				Typ:     mark.Typ,
+				ColName: mark.ColName,
+				Expr: &plan.Expr_Col{
+					Col: &ColRef{
+						RelPos: 0,
+						ColPos: int32(len(join.ProjectList) - 1),
+					},
+				},
+			}})
+			if err != nil {
+				return 0, err
+			}
+			topId = appendQueryNode(query, &Node{
+				NodeType:    plan.Node_PROJECT,
+				Children:    []int32{topId},
+				WhereList:   []*Expr{notMark},
+				ProjectList: columnsOf(node),
+			})
+			continue
+		}
+		topId = appendQueryNode(query, join)
+	}
+	node.WhereList = whereList
+	if topId != nodeId && query.Nodes[topId].NodeType == plan.Node_JOIN {
+		topId = appendQueryNode(query, &Node{
+			NodeType:    plan.Node_PROJECT,
+			Children:    []int32{topId},
+			ProjectList: columnsOf(node),
+		})
+	}
+	return topId, nil
+}
+
+// subqueryJoinCond returns the join type, the key of the left side and the subquery of a
+// conjunct which can be planned as a join, or a nil subquery if it can not.
+func subqueryJoinCond(cond *Expr) (plan.Node_JoinFlag, *Expr, *plan.SubQuery) {
+	negated := false
+	f, ok := cond.Expr.(*plan.Expr_F)
+	if ok && f.F.Func.GetObjName() == "not" && len(f.F.Args) == 1 {
+		negated = true
+		f, ok = f.F.Args[0].Expr.(*plan.Expr_F)
+	}
+	if !ok {
+		return 0, nil, nil
+	}
+	switch name := f.F.Func.GetObjName(); {
+	case name == "exists" && len(f.F.Args) == 1:
+		sub, ok := f.F.Args[0].Expr.(*plan.Expr_Sub)
+		if !ok || sub.Sub.IsCorrelated {
+			return 0, nil, nil
+		}
+		if negated {
+			return plan.Node_ANTI, nil, sub.Sub
+		}
+		return plan.Node_SEMI, nil, sub.Sub
+	case name == "in" && len(f.F.Args) == 2:
+		sub, ok := f.F.Args[1].Expr.(*plan.Expr_Sub)
+		if !ok || sub.Sub.IsCorrelated {
+			return 0, nil, nil
+		}
+		if negated {
+			return plan.Node_MARK, f.F.Args[0], sub.Sub
+		}
+		return plan.Node_SEMI, f.F.Args[0], sub.Sub
+	}
+	return 0, nil, nil
+}
+
+// subqueryJoinOn returns the condition joining the key of the node with the column of the
+// subquery, it returns false if the key is not a column of the node, or the types of the
+// key and the column differ.
+func subqueryJoinOn(node *Node, key *Expr, col *Expr) (*Expr, bool, error) {
+	keyCol, ok := key.Expr.(*plan.Expr_Col)
+	if !ok || key.Typ.Id != col.Typ.Id {
+		return nil, false, nil
+	}
+	pos := -1
+	for i, expr := range node.ProjectList {
+		if col, ok := expr.Expr.(*plan.Expr_Col); ok && col.Col.RelPos == keyCol.Col.RelPos && col.Col.ColPos == keyCol.Col.ColPos {
+			pos = i
+			break
+		}
+	}
+	if pos < 0 {
+		return nil, false, nil
+	}
+	cond, _, err := getFunctionExprByNameAndPlanExprs("=", []*Expr{
+		{
+			Typ:       key.Typ,
+			TableName: key.TableName,
+			ColName:   key.ColName,
+			Expr: &plan.Expr_Col{
+				Col: &ColRef{
+					RelPos: 0,
+					ColPos: int32(pos),
+				},
+			},
+		},
+		{
+			Typ:       col.Typ,
+			TableName: col.TableName,
+			ColName:   col.ColName,
+			Expr: &plan.Expr_Col{
+				Col: &ColRef{
+					RelPos: 1,
+					ColPos: 0,
+				},
+			},
+		},
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	return cond, true, nil
+}
+
+// columnsOf returns the columns of the output of the node, referred by its parent
+func columnsOf(node *Node) []*Expr {
+	cols := make([]*Expr, len(node.ProjectList))
+	for i, expr := range node.ProjectList {
+		cols[i] = &Expr{
+			Typ:       expr.Typ,
+			TableName: expr.TableName,
+			ColName:   expr.ColName,
+			Expr: &plan.Expr_Col{
+				Col: &ColRef{
+					RelPos: 0,
+					ColPos: int32(i),
+				},
+			},
+		}
+	}
+	return cols
+}
+
+// materializeSubqueries computes every uncorrelated subquery once: the root of the subquery
+// becomes the child of a SINK step, and the subquery refers to a SINK_SCAN reading the rows
+// of the step. A correlated subquery depends on the row of its parent, it is left as it is.
+func materializeSubqueries(query *Query) {
+	steps := append([]int32{}, query.Steps[:len(query.Steps)-1]...)
+	for i, n := 0, len(query.Nodes); i < n; i++ {
+		for _, expr := range nodeExprs(query.Nodes[i]) {
+			steps = materializeSubquery(query, expr, steps)
+		}
+	}
+	query.Steps = append(steps, query.Steps[len(query.Steps)-1])
+}
+
+// refersOuterNodes returns true if the nodes built since the node start refer to the
+// columns of a node built before it, that is, a subquery built from start is correlated.
+func refersOuterNodes(query *Query, start int) bool {
+	var refers func(expr *Expr) bool
+	refers = func(expr *Expr) bool {
+		switch e := expr.Expr.(type) {
+		case *plan.Expr_Corr:
+			return e.Corr.NodeId < int32(start)
+		case *plan.Expr_F:
+			for _, arg := range e.F.Args {
+				if refers(arg) {
+					return true
+				}
+			}
+		case *plan.Expr_List:
+			for _, arg := range e.List.List {
+				if refers(arg) {
+					return true
+				}
+			}
+		}
+		return false
+	}
+	for _, node := range query.Nodes[start:] {
+		for _, expr := range nodeExprs(node) {
+			if refers(expr) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// nodeExprs returns the expressions of the node
+func nodeExprs(node *Node) []*Expr {
+	exprs := make([]*Expr, 0, len(node.ProjectList)+len(node.OnList)+len(node.WhereList))
+	exprs = append(exprs, node.ProjectList...)
+	exprs = append(exprs, node.OnList...)
+	exprs = append(exprs, node.WhereList...)
+	exprs = append(exprs, node.GroupBy...)
+	exprs = append(exprs, node.AggList...)
+	for _, spec := range node.OrderBy {
+		exprs = append(exprs, spec.Expr)
+	}
+	return exprs
+}
+
+// materializeSubquery materializes the uncorrelated subqueries in the expression, and returns
+// the steps with the SINK steps of them.
+func materializeSubquery(query *Query, expr *Expr, steps []int32) []int32 {
+	switch e := expr.Expr.(type) {
+	case *plan.Expr_F:
+		for _, arg := range e.F.Args {
+			steps = materializeSubquery(query, arg, steps)
+		}
+	case *plan.Expr_List:
+		for _, arg := range e.List.List {
+			steps = materializeSubquery(query, arg, steps)
+		}
+	case *plan.Expr_Sub:
+		// the expressions may be shared by several nodes
+		root := query.Nodes[e.Sub.NodeId]
+		if e.Sub.IsCorrelated || root.NodeType == plan.Node_SINK_SCAN {
+			return steps
+		}
+		name := fmt.Sprintf("subquery_%d", root.NodeId)
+		tableDef := &TableDef{
+			Name: name,
+			Cols: make([]*ColDef, len(root.ProjectList)),
+		}
+		exprs := make([]*Expr, len(root.ProjectList))
+		for i, col := range root.ProjectList {
+			tableDef.Cols[i] = &ColDef{
+				Name:  col.ColName,
+				Alias: col.ColName,
+				Typ:   col.Typ,
+			}
+			exprs[i] = &Expr{
+				TableName: name,
+				ColName:   col.ColName,
+				Typ:       col.Typ,
+			}
+		}
+		sinkId := appendQueryNode(query, &Node{
+			NodeType:    plan.Node_SINK,
+			Children:    []int32{root.NodeId},
+			ProjectList: exprs,
+			TableDef:    tableDef,
+		})
+		scan := &Node{
+			NodeType: plan.Node_SINK_SCAN,
+			ObjRef: &ObjectRef{
+				Obj:     int64(sinkId),
+				ObjName: name,
+			},
+			TableDef:    proto.Clone(tableDef).(*TableDef),
+			ProjectList: make([]*Expr, len(exprs)),
+		}
+		for i, col := range exprs {
+			scan.ProjectList[i] = &Expr{
+				Typ:       col.Typ,
+				TableName: name,
+				ColName:   col.ColName,
+				Expr: &plan.Expr_Col{
+					Col: &ColRef{
+						RelPos: 0,
+						ColPos: int32(i),
+					},
+				},
+			}
+		}
+		e.Sub.NodeId = appendQueryNode(query, scan)
+		steps = append(steps, sinkId)
+	}
+	return steps
+}
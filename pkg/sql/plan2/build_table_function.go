@@ -0,0 +1,183 @@
+// Copyright 2021 - 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan2
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// tableFunctions are the functions used as a table in the from clause, they check
+// the arguments and return the columns of the rows produced by the function.
+var tableFunctions = map[string]func(args []*Expr, ctx CompilerContext) ([]*ColDef, error){
+	"generate_series": buildGenerateSeries,
+	"unnest":          buildUnnest,
+	"metadata_scan":   buildMetadataScan,
+}
+
+// buildTableFunction appends a FUNCTION_SCAN node, the arguments of the function must not refer to any column
+func buildTableFunction(tbl *tree.TableFunction, ctx CompilerContext, query *Query, binderCtx *BinderContext) (int32, error) {
+	funcReference, ok := tbl.Func.Func.FunctionReference.(*tree.UnresolvedName)
+	if !ok {
+		return 0, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("table function '%v' is not support now", tbl.Func))
+	}
+	name := strings.ToLower(funcReference.Parts[0])
+	build, ok := tableFunctions[name]
+	if !ok {
+		return 0, errors.New(errno.UndefinedFunction, fmt.Sprintf("table function '%v' does not exist", name))
+	}
+
+	node := &Node{
+		NodeType: plan.Node_FUNCTION_SCAN,
+		TableDef: &TableDef{
+			Name: name,
+		},
+		TableFuncArgs: make([]*Expr, len(tbl.Func.Exprs)),
+	}
+	for i, astExpr := range tbl.Func.Exprs {
+		expr, _, err := buildExpr(astExpr, ctx, query, node, binderCtx, false)
+		if err != nil {
+			return 0, err
+		}
+		if refersColumn(expr) {
+			return 0, errors.New(errno.InvalidColumnReference, fmt.Sprintf("the arguments of table function '%v' can not refer to columns", name))
+		}
+		node.TableFuncArgs[i] = expr
+	}
+	cols, err := build(node.TableFuncArgs, ctx)
+	if err != nil {
+		return 0, err
+	}
+	node.TableDef.Cols = cols
+	return appendQueryNode(query, node), nil
+}
+
+// buildGenerateSeries checks generate_series(start, stop[, step]) which returns the integers from start to stop
+func buildGenerateSeries(args []*Expr, _ CompilerContext) ([]*ColDef, error) {
+	if len(args) != 2 && len(args) != 3 {
+		return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, "generate_series needs 2 or 3 arguments")
+	}
+	for i, arg := range args {
+		switch arg.Typ.Id {
+		case plan.Type_INT64:
+		case plan.Type_INT8, plan.Type_INT16, plan.Type_INT32, plan.Type_UINT8, plan.Type_UINT16, plan.Type_UINT32, plan.Type_UINT64:
+			expr, err := appendCastExpr(arg, &plan.Type{
+				Id:   plan.Type_INT64,
+				Size: 8,
+			})
+			if err != nil {
+				return nil, err
+			}
+			args[i] = expr
+		default:
+			return nil, errors.New(errno.DatatypeMismatch, fmt.Sprintf("the arguments of generate_series must be integers, got '%v'", arg.Typ.Id))
+		}
+	}
+	return []*ColDef{
+		newTableFunctionCol("generate_series", plan.Type_INT64, 8),
+	}, nil
+}
+
+// buildUnnest checks unnest(array) which returns the elements of the json array
+func buildUnnest(args []*Expr, _ CompilerContext) ([]*ColDef, error) {
+	if len(args) != 1 {
+		return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, "unnest needs 1 argument")
+	}
+	switch args[0].Typ.Id {
+	case plan.Type_CHAR, plan.Type_VARCHAR, plan.Type_TEXT, plan.Type_JSON:
+	default:
+		return nil, errors.New(errno.DatatypeMismatch, fmt.Sprintf("the argument of unnest must be a json array, got '%v'", args[0].Typ.Id))
+	}
+	return []*ColDef{
+		newTableFunctionCol("unnest", plan.Type_VARCHAR, 24),
+	}, nil
+}
+
+// buildMetadataScan checks metadata_scan('db.table') which returns the blocks of the table,
+// the name of the table is qualified by the default database for the execution.
+func buildMetadataScan(args []*Expr, ctx CompilerContext) ([]*ColDef, error) {
+	if len(args) != 1 {
+		return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, "metadata_scan needs 1 argument")
+	}
+	c, ok := args[0].Expr.(*plan.Expr_C)
+	if !ok || c.C.Isnull {
+		return nil, errors.New(errno.DataException, "the argument of metadata_scan must be a table name")
+	}
+	sval, ok := c.C.Value.(*plan.Const_Sval)
+	if !ok {
+		return nil, errors.New(errno.DataException, "the argument of metadata_scan must be a table name")
+	}
+	dbName, tblName := ctx.DefaultDatabase(), sval.Sval
+	if i := strings.IndexByte(sval.Sval, '.'); i >= 0 {
+		dbName, tblName = sval.Sval[:i], sval.Sval[i+1:]
+	}
+	_, tableDef := ctx.Resolve(dbName, tblName)
+	if tableDef == nil {
+		return nil, errors.New(errno.InvalidTableDefinition, fmt.Sprintf("table '%v' does not exist", sval.Sval))
+	}
+	sval.Sval = dbName + "." + tblName
+	return []*ColDef{
+		newTableFunctionCol("segment_id", plan.Type_UINT64, 8),
+		newTableFunctionCol("block_id", plan.Type_UINT64, 8),
+		newTableFunctionCol("appendable", plan.Type_BOOL, 1),
+		newTableFunctionCol("rows", plan.Type_INT64, 8),
+		newTableFunctionCol("min_key", plan.Type_VARCHAR, 24),
+		newTableFunctionCol("max_key", plan.Type_VARCHAR, 24),
+		newTableFunctionCol("size", plan.Type_INT64, 8),
+		newTableFunctionCol("origin_size", plan.Type_INT64, 8),
+	}, nil
+}
+
+func newTableFunctionCol(name string, id plan.Type_TypeId, size int32) *ColDef {
+	typ := &plan.Type{
+		Id:       id,
+		Nullable: true,
+		Size:     size,
+	}
+	if id == plan.Type_VARCHAR {
+		typ.Width = math.MaxInt32
+	}
+	return &ColDef{
+		Name: name,
+		Typ:  typ,
+	}
+}
+
+// refersColumn returns true if the expression refers to a column of any table
+func refersColumn(expr *Expr) bool {
+	switch e := expr.Expr.(type) {
+	case *plan.Expr_Col, *plan.Expr_Corr, *plan.Expr_Sub:
+		return true
+	case *plan.Expr_F:
+		for _, arg := range e.F.Args {
+			if refersColumn(arg) {
+				return true
+			}
+		}
+	case *plan.Expr_List:
+		for _, arg := range e.List.List {
+			if refersColumn(arg) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -24,9 +24,10 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 )
 
-//only use in developing
+// only use in developing
 func TestSingleSql(t *testing.T) {
 	// sql := `SELECT * FROM (SELECT relname as Tables_in_mo FROM mo_tables WHERE reldatabase = 'mo') a`
 	sql := `show create table tpch.nation`
@@ -44,7 +45,7 @@ func TestSingleSql(t *testing.T) {
 	outPutPlan(logicPlan, true, t)
 }
 
-//Test Query Node Tree
+// Test Query Node Tree
 func TestNodeTree(t *testing.T) {
 	type queryCheck struct {
 		steps    []int32                    //steps
@@ -267,12 +268,16 @@ func TestNodeTree(t *testing.T) {
 		},
 		// uncorrelated subquery
 		"SELECT * FROM NATION where N_REGIONKEY > (select max(R_REGIONKEY) from REGION)": {
-			steps: []int32{0},
+			steps: []int32{2, 0},
 			nodeType: map[int]plan.Node_NodeType{
 				0: plan.Node_TABLE_SCAN, //nodeid = 1  here is the subquery
 				1: plan.Node_TABLE_SCAN, //nodeid = 0, here is SELECT * FROM NATION where N_REGIONKEY > [subquery]
+				2: plan.Node_SINK,       //the subquery is computed once by the sink
+				3: plan.Node_SINK_SCAN,  //the subquery reads the rows of the sink
+			},
+			children: map[int][]int32{
+				2: {1},
 			},
-			children: map[int][]int32{},
 		},
 		// correlated subquery
 		`SELECT * FROM NATION where N_REGIONKEY >
@@ -304,6 +309,40 @@ func TestNodeTree(t *testing.T) {
 				3: {2},
 			},
 		},
+		// the cte of a subquery hides the cte of the same name, each is referenced once
+		`with tbl(col1) as (select n_nationkey from nation) select * from tbl where col1 in (with tbl(col1) as (select r_regionkey from region) select col1 from tbl)`: {
+			steps: []int32{1, 4, 7},
+			nodeType: map[int]plan.Node_NodeType{
+				0: plan.Node_TABLE_SCAN,
+				1: plan.Node_MATERIAL,
+				2: plan.Node_MATERIAL_SCAN,
+				3: plan.Node_TABLE_SCAN,
+				4: plan.Node_MATERIAL,
+				5: plan.Node_MATERIAL_SCAN,
+				6: plan.Node_JOIN,
+				7: plan.Node_PROJECT,
+			},
+			children: map[int][]int32{
+				1: {0},
+				4: {3},
+				6: {2, 5},
+				7: {6},
+			},
+		},
+		// cte referenced more than once
+		`with tbl(col1, col2) as (select n_nationkey, n_name from nation) select * from tbl a join tbl b on a.col1 = b.col1`: {
+			steps: []int32{1, 4},
+			nodeType: map[int]plan.Node_NodeType{
+				0: plan.Node_TABLE_SCAN,
+				1: plan.Node_SINK,
+				2: plan.Node_SINK_SCAN,
+				3: plan.Node_SINK_SCAN,
+				4: plan.Node_JOIN,
+			},
+			children: map[int][]int32{
+				1: {0},
+			},
+		},
 	}
 
 	// run test and check node tree
@@ -341,7 +380,94 @@ func TestNodeTree(t *testing.T) {
 	}
 }
 
-//test single table plan building
+func TestCteReference(t *testing.T) {
+	sql := `with tbl(col1) as (select n_nationkey from nation)
+		select * from tbl where col1 in (with tbl(col1) as (select r_regionkey from region) select col1 from tbl)`
+	logicPlan, err := runOneStmt(NewMockOptimizer(), t, sql)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	// every reference refers to the cte of its own scope
+	query := logicPlan.GetQuery()
+	for id, ref := range map[int]int64{2: 1, 5: 4} {
+		if obj := query.Nodes[id].ObjRef.Obj; obj != ref {
+			t.Fatalf("node %v refers to node %v, expected %v", id, obj, ref)
+		}
+	}
+}
+
+func TestSubqueryJoin(t *testing.T) {
+	mock := NewMockOptimizer()
+	subqueryJoin := func(sql string) (*Node, *Query) {
+		logicPlan, err := runOneStmt(mock, t, sql)
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		query := logicPlan.GetQuery()
+		for _, n := range query.Nodes {
+			if n.NodeType == plan.Node_JOIN {
+				return n, query
+			}
+		}
+		return nil, query
+	}
+
+	checks := map[string]struct {
+		joinType plan.Node_JoinFlag
+		conds    int
+	}{
+		"SELECT N_NAME FROM NATION WHERE N_REGIONKEY IN (SELECT R_REGIONKEY FROM REGION) AND N_NATIONKEY > 1":                            {plan.Node_SEMI, 1},
+		"SELECT N_NAME FROM NATION WHERE N_REGIONKEY NOT IN (SELECT R_REGIONKEY FROM REGION)":                                            {plan.Node_MARK, 1},
+		"SELECT N_NAME FROM NATION WHERE EXISTS (SELECT R_REGIONKEY FROM REGION WHERE R_NAME = 'ASIA')":                                  {plan.Node_SEMI, 0},
+		"SELECT N_NAME FROM NATION WHERE NOT EXISTS (SELECT R_REGIONKEY FROM REGION WHERE R_NAME = 'ASIA')":                              {plan.Node_ANTI, 0},
+		"SELECT * FROM NATION a JOIN REGION b ON a.N_REGIONKEY = b.R_REGIONKEY WHERE a.N_NATIONKEY IN (SELECT N_NATIONKEY FROM NATION2)": {plan.Node_SEMI, 1},
+	}
+	for sql, check := range checks {
+		_, query := subqueryJoin(sql)
+		root := query.Nodes[query.Steps[len(query.Steps)-1]]
+		if root.NodeType != plan.Node_PROJECT {
+			t.Fatalf("unexpected root %v: %v", root.NodeType, sql)
+		}
+		var join *Node
+		for _, n := range query.Nodes {
+			if n.NodeType == plan.Node_JOIN && n.JoinType != plan.Node_INNER {
+				join = n
+			}
+		}
+		if join == nil {
+			t.Fatalf("no subquery join: %v", sql)
+		}
+		if join.JoinType != check.joinType || len(join.OnList) != check.conds {
+			t.Fatalf("unexpected %v join with %v conditions: %v", join.JoinType, len(join.OnList), sql)
+		}
+		// the subqueries are children of the joins, not computed by the filters
+		for _, n := range query.Nodes {
+			if n.NodeType == plan.Node_SINK {
+				t.Fatalf("subquery materialized: %v", sql)
+			}
+		}
+		// the filter of NOT IN keeps the rows whose mark is false
+		if check.joinType == plan.Node_MARK && (len(root.WhereList) != 1 || len(root.ProjectList) != 1) {
+			t.Fatalf("unexpected filter of the mark join: %v", sql)
+		}
+	}
+
+	// the correlated subqueries and the keys of other types are filtered
+	for _, sql := range []string{
+		"SELECT N_NAME FROM NATION WHERE N_REGIONKEY IN (SELECT R_REGIONKEY FROM REGION WHERE R_REGIONKEY < N_NATIONKEY)",
+		"SELECT N_NAME FROM NATION WHERE N_NAME IN (SELECT R_REGIONKEY FROM REGION)",
+		"SELECT N_NAME FROM NATION WHERE N_REGIONKEY + 1 IN (SELECT R_REGIONKEY FROM REGION)",
+	} {
+		if join, _ := subqueryJoin(sql); join != nil {
+			t.Fatalf("unexpected %v join: %v", join.JoinType, sql)
+		}
+	}
+	if _, err := runOneStmt(mock, t, "SELECT N_NAME FROM NATION WHERE N_REGIONKEY IN (SELECT R_REGIONKEY, R_NAME FROM REGION)"); err == nil {
+		t.Fatalf("subquery of two columns should fail")
+	}
+}
+
+// test single table plan building
 func TestSingleTableSqlBuilder(t *testing.T) {
 	mock := NewMockOptimizer()
 
@@ -363,6 +489,17 @@ func TestSingleTableSqlBuilder(t *testing.T) {
 		// "SELECT N_REGIONKEY FROM NATION where N_REGIONKEY is null and N_NAME is not null",                                                                                 //test more expr
 
 		"SELECT -1",
+		"SELECT json_extract('{\"a\": [1, 2]}', '$.a[0]'), json_unquote(json_extract(N_NAME, '$.b', '$.c')) FROM NATION",
+		"SELECT uuid(), bin_to_uuid(uuid_to_bin(N_NAME, 1), 1), cast(cast(N_NAME as uuid) as char) FROM NATION",
+		"SELECT N_NAME FROM NATION WHERE N_NAME REGEXP '^A' OR N_COMMENT RLIKE 'x' AND N_NAME NOT REGEXP 'B$'",
+		"SELECT regexp_like(N_NAME, 'a', 'i'), regexp_instr(N_NAME, 'a', 1, 2, 1, 'c'), regexp_substr(N_COMMENT, '[a-z]+', 2), regexp_replace(N_NAME, '(a)', '$1$1', 1, 0) FROM NATION",
+		"SELECT date_format(O_ORDERDATE, '%Y-%m-%d'), str_to_date('01,5,2013', '%d,%m,%Y'), datediff(O_ORDERDATE, '2022-01-01'), last_day(O_ORDERDATE) FROM ORDERS",
+		"SELECT timestampdiff(month, O_ORDERDATE, now()), timestampadd(week, 2, O_ORDERDATE), date_trunc('quarter', O_ORDERDATE) FROM ORDERS",
+		"SELECT unix_timestamp(), unix_timestamp(O_ORDERDATE), from_unixtime(1447430881), from_unixtime(1447430881, '%Y %D %M') FROM ORDERS",
+		"SELECT now(3), current_timestamp, curdate(), current_date, week(O_ORDERDATE, 3), yearweek(O_ORDERDATE), quarter(O_ORDERDATE) FROM ORDERS",
+		"SELECT convert_tz(O_ORDERDATE, '+00:00', 'Asia/Shanghai') FROM ORDERS",
+		"SELECT N_REGIONKEY, median(N_NATIONKEY), percentile_cont(N_NATIONKEY, 0.9), percentile_disc(N_NATIONKEY, 0.1), percentile_approx(N_NATIONKEY, 0.99) FROM NATION GROUP BY N_REGIONKEY",
+		"SELECT var_samp(N_NATIONKEY), stddev_samp(N_NATIONKEY), covar_pop(N_NATIONKEY, N_REGIONKEY), covar_samp(N_NATIONKEY, N_REGIONKEY), corr(N_NATIONKEY, N_REGIONKEY) FROM NATION",
 	}
 	runTestShouldPass(mock, t, sqls, false, false)
 
@@ -373,6 +510,15 @@ func TestSingleTableSqlBuilder(t *testing.T) {
 		"SELECT N_NAME, N_REGIONKEY a FROM NATION ORDER BY cccc",            //column alias not exist
 		"SELECT N_NAME, b.N_REGIONKEY FROM NATION a ORDER BY b.N_REGIONKEY", //table alias not exist
 		"SELECT N_NAME FROM NATION WHERE ffff(N_REGIONKEY) > 0",             //function name not exist
+		"SELECT json_extract(N_NAME) FROM NATION",                           //json path missing
+		"SELECT bin_to_uuid(N_NAME) FROM NATION",                            //not an uuid
+		"SELECT regexp_replace(N_NAME, 'a') FROM NATION",                    //replacement missing
+		"SELECT regexp_instr(N_NAME, 'a', 1, 1, 0, 'i', 1) FROM NATION",     //too many arguments
+		"SELECT date_format(O_ORDERDATE, 1) FROM ORDERS",                    //format is not a string
+		"SELECT week(O_ORDERDATE, 'a') FROM ORDERS",                         //mode is not an integer
+		"SELECT percentile_cont(N_NATIONKEY, 1.5) FROM NATION",              //percentile out of range
+		"SELECT percentile_disc(N_NATIONKEY, N_REGIONKEY) FROM NATION",      //percentile not constant
+		"SELECT corr(N_NATIONKEY, N_NAME) FROM NATION",                      //not a number
 		"SELECT NATION.N_NAME FROM NATION a",                                // mysql should error, but i don't think it is necesssary
 
 		"SELECT DISTINCT N_NAME FROM NATION GROUP BY N_REGIONKEY", //test distinct with group by
@@ -380,7 +526,7 @@ func TestSingleTableSqlBuilder(t *testing.T) {
 	runTestShouldError(mock, t, sqls)
 }
 
-//test jion table plan building
+// test jion table plan building
 func TestJoinTableSqlBuilder(t *testing.T) {
 	mock := NewMockOptimizer()
 
@@ -410,7 +556,39 @@ func TestJoinTableSqlBuilder(t *testing.T) {
 	runTestShouldError(mock, t, sqls)
 }
 
-//test derived table plan building
+// test derived table plan building
+func TestJoinType(t *testing.T) {
+	mock := NewMockOptimizer()
+
+	tests := []struct {
+		sql  string
+		want plan.Node_JoinFlag
+	}{
+		{"SELECT N_NAME FROM NATION join REGION on NATION.N_REGIONKEY = REGION.R_REGIONKEY", plan.Node_INNER},
+		{"SELECT N_NAME FROM NATION left join REGION on NATION.N_REGIONKEY = REGION.R_REGIONKEY", plan.Node_OUTER},
+		{"SELECT N_NAME FROM NATION2 natural left join REGION", plan.Node_OUTER},
+		{"SELECT N_NAME FROM NATION right join REGION on NATION.N_REGIONKEY = REGION.R_REGIONKEY", plan.Node_RIGHT},
+	}
+	for _, tt := range tests {
+		pl, err := runOneStmt(mock, t, tt.sql)
+		if err != nil {
+			t.Fatalf("%s: %+v", tt.sql, err)
+		}
+		found := false
+		for _, n := range pl.GetQuery().Nodes {
+			if n.NodeType == plan.Node_JOIN {
+				found = true
+				if n.JoinType != tt.want {
+					t.Fatalf("%s: join type is %v, want %v", tt.sql, n.JoinType, tt.want)
+				}
+			}
+		}
+		if !found {
+			t.Fatalf("%s: no join node", tt.sql)
+		}
+	}
+}
+
 func TestDerivedTableSqlBuilder(t *testing.T) {
 	mock := NewMockOptimizer()
 	// should pass
@@ -436,6 +614,428 @@ func TestDerivedTableSqlBuilder(t *testing.T) {
 	runTestShouldError(mock, t, sqls)
 }
 
+func TestRecursiveCTE(t *testing.T) {
+	mock := NewMockOptimizer()
+	// should pass
+	sqls := []string{
+		"WITH RECURSIVE C(N) AS (SELECT 1 UNION ALL SELECT N + 1 FROM C WHERE N < 10) SELECT N FROM C",
+		"WITH RECURSIVE C AS (SELECT N_NATIONKEY, N_REGIONKEY FROM NATION WHERE N_REGIONKEY = 0 UNION SELECT A.N_NATIONKEY, A.N_REGIONKEY FROM NATION A JOIN C ON A.N_REGIONKEY = C.N_NATIONKEY) SELECT * FROM C",
+		"WITH RECURSIVE C(N) AS (SELECT 1 UNION ALL SELECT N + 1 FROM C WHERE N < 10) SELECT N_NAME FROM NATION JOIN C ON N_NATIONKEY = C.N",
+	}
+	runTestShouldPass(mock, t, sqls, false, false)
+
+	// should error
+	sqls = []string{
+		"WITH RECURSIVE C(N) AS (SELECT 1 UNION ALL SELECT N_NATIONKEY FROM NATION) SELECT N FROM C",                  // no recursive reference
+		"WITH RECURSIVE C(N) AS (SELECT 1 UNION ALL SELECT C.N + 1 FROM C, C AS D WHERE C.N < 10) SELECT N FROM C",    // referenced twice
+		"WITH RECURSIVE C(N) AS (SELECT 1 UNION ALL SELECT COUNT(N) FROM C) SELECT N FROM C",                          // aggregation
+		"WITH RECURSIVE C(N) AS (SELECT 1 UNION ALL SELECT N + 1 FROM C ORDER BY N) SELECT N FROM C",                  // order by
+		"WITH RECURSIVE C(N) AS (SELECT 1 UNION ALL SELECT N + 1, N FROM C WHERE N < 10) SELECT N FROM C",             // column length not match
+		"WITH RECURSIVE C(N) AS (SELECT N FROM C UNION ALL SELECT N + 1 FROM NATION, C WHERE N < 10) SELECT N FROM C", // referenced by non-recursive part
+	}
+	runTestShouldError(mock, t, sqls)
+}
+
+func TestSample(t *testing.T) {
+	mock := NewMockOptimizer()
+	// should pass
+	sqls := []string{
+		"SELECT N_NAME FROM NATION TABLESAMPLE SYSTEM (10 PERCENT)",
+		"SELECT N_NAME FROM NATION A TABLESAMPLE BERNOULLI (0.5 PERCENT) REPEATABLE (42) WHERE A.N_REGIONKEY > 1",
+		"SELECT N_NAME, R_NAME FROM NATION TABLESAMPLE SYSTEM (50 PERCENT) JOIN REGION ON N_REGIONKEY = R_REGIONKEY",
+		"SELECT APPROX_PERCENTILE(N_NATIONKEY, 0.9), APPROX_PERCENTILE_ERROR(N_NATIONKEY, 0.9) FROM NATION TABLESAMPLE SYSTEM (20 PERCENT)",
+		"SELECT APPROX_COUNT_DISTINCT(N_NAME), APPROX_COUNT_DISTINCT_ERROR(N_NAME) FROM NATION GROUP BY N_REGIONKEY",
+	}
+	runTestShouldPass(mock, t, sqls, false, false)
+
+	// should error
+	sqls = []string{
+		"SELECT N_NAME FROM NATION TABLESAMPLE SYSTEM (101 PERCENT)",                                 // percent out of range
+		"WITH C AS (SELECT N_NAME FROM NATION) SELECT N_NAME FROM C TABLESAMPLE SYSTEM (10 PERCENT)", // not a base table
+		"SELECT APPROX_PERCENTILE(N_NATIONKEY, 2) FROM NATION",                                       // percentile out of range
+		"SELECT APPROX_PERCENTILE(N_NATIONKEY, N_REGIONKEY) FROM NATION",                             // percentile not constant
+		"SELECT APPROX_PERCENTILE(N_NAME, 0.5) FROM NATION",                                          // not a number
+	}
+	runTestShouldError(mock, t, sqls)
+
+	logicPlan, err := runOneStmt(mock, t, "SELECT N_NAME FROM NATION TABLESAMPLE BERNOULLI (2.5 PERCENT) REPEATABLE (7)")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	for _, n := range logicPlan.GetQuery().Nodes {
+		if n.NodeType == plan.Node_SAMPLE {
+			smp, err := ParseSample(n.ExtraOptions)
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			if *smp != (Sample{Method: tree.SampleBernoulli, Percent: 2.5, Repeatable: true, Seed: 7}) {
+				t.Fatalf("unexpected sample %+v", smp)
+			}
+			return
+		}
+	}
+	t.Fatalf("no sample node")
+}
+
+func TestExternalTable(t *testing.T) {
+	mock := NewMockOptimizer()
+	// should pass
+	sqls := []string{
+		"CREATE EXTERNAL TABLE T1 (A INT, B VARCHAR(10)) LOCATION 'file:///data/t1/*.csv' FIELDS TERMINATED BY '|' IGNORE 1 LINES",
+		"CREATE EXTERNAL TABLE T1 (A INT) LOCATION '/data/t1.jsonl.gz' FORMAT 'jsonl'",
+		"CREATE EXTERNAL TABLE T1 (A INT) LOCATION '/data/t1.parquet' FORMAT 'parquet'",
+		"SELECT N_NAME FROM NATION_EXT WHERE N_REGIONKEY > 1",
+		"SELECT N_NAME, R_NAME FROM NATION_EXT JOIN REGION ON N_REGIONKEY = R_REGIONKEY",
+		"INSERT INTO NATION SELECT * FROM NATION_EXT",
+	}
+	runTestShouldPass(mock, t, sqls, false, false)
+
+	// should error
+	sqls = []string{
+		"CREATE EXTERNAL TABLE T1 (A INT) LOCATION 'data/t1.csv'",                                          // relative path
+		"CREATE EXTERNAL TABLE T1 (A INT) LOCATION 's3://bucket/t1.csv'",                                   // not a local file
+		"CREATE EXTERNAL TABLE T1 (A INT) LOCATION '/data/t1.parquet' FORMAT 'parquet' COMPRESSION 'gzip'", // compressed parquet
+		"CREATE EXTERNAL TABLE T1 (A INT) LOCATION '/data/t1.csv' FIELDS TERMINATED BY '||'",               // multi-byte separator
+		"CREATE EXTERNAL TABLE T1 (A INT) LOCATION '/data/../etc/passwd'",                                  // parent directory
+		"INSERT INTO NATION_EXT SELECT * FROM NATION",
+		"UPDATE NATION_EXT SET N_NAME = 'a'",
+		"DELETE FROM NATION_EXT",
+		"SELECT N_NAME FROM NATION_EXT TABLESAMPLE SYSTEM (10 PERCENT)",
+	}
+	runTestShouldError(mock, t, sqls)
+
+	logicPlan, err := runOneStmt(mock, t, "SELECT N_NAME FROM NATION_EXT")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	for _, n := range logicPlan.GetQuery().Nodes {
+		if n.NodeType == plan.Node_EXTERNAL_SCAN {
+			param, err := GetExternParam(n.TableDef)
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			if param.Location != "file:///data/tpch/nation*.csv" || param.FileFormat != tree.CSV {
+				t.Fatalf("unexpected parameters %+v", param)
+			}
+			return
+		}
+	}
+	t.Fatalf("no external scan node")
+}
+
+func TestView(t *testing.T) {
+	mock := NewMockOptimizer()
+	// should pass
+	sqls := []string{
+		"CREATE VIEW V1 AS SELECT N_NAME, R_NAME FROM NATION JOIN REGION ON N_REGIONKEY = R_REGIONKEY",
+		"CREATE VIEW tpch.V1 (A, B) AS SELECT N_NATIONKEY, count(*) FROM NATION GROUP BY N_NATIONKEY",
+		"CREATE VIEW IF NOT EXISTS NATION_VIEW AS SELECT N_NAME FROM NATION",
+		"CREATE VIEW V1 AS SELECT NATION_NAME FROM NATION_VIEW",
+		"SELECT * FROM NATION_VIEW",
+		"SELECT NATION_NAME FROM NATION_VIEW WHERE NATION_KEY > 1 ORDER BY NATION_NAME",
+		"SELECT NATION_VIEW.NATION_NAME, R_NAME FROM NATION_VIEW JOIN REGION ON NATION_KEY = R_REGIONKEY",
+		"SELECT v.NATION_NAME FROM NATION_VIEW v, NATION_VIEW w WHERE v.NATION_KEY = w.NATION_KEY",
+		"SELECT N_NAME FROM NATION WHERE N_NATIONKEY IN (SELECT NATION_KEY FROM NATION_VIEW)",
+		"SHOW CREATE VIEW NATION_VIEW",
+		"SHOW CREATE VIEW tpch.NATION_VIEW",
+		"DROP VIEW NATION_VIEW",
+		"DROP VIEW IF EXISTS NO_SUCH_VIEW",
+	}
+	runTestShouldPass(mock, t, sqls, false, false)
+
+	// should error
+	sqls = []string{
+		"CREATE VIEW NATION_VIEW AS SELECT N_NAME FROM NATION",         // exists
+		"CREATE VIEW V1 (A) AS SELECT N_NAME, N_NATIONKEY FROM NATION", // column count
+		"CREATE VIEW V1 AS SELECT N_NAME, N_NAME FROM NATION",          // duplicate column
+		"CREATE VIEW V1 AS SELECT N_NAME FROM NO_SUCH_TABLE",           // invalid table
+		"CREATE TEMPORARY VIEW V1 AS SELECT N_NAME FROM NATION",        // temporary
+		"SELECT * FROM INVALID_VIEW",                                   // references a dropped table
+		"SELECT N_NAME FROM NATION_VIEW",                               // renamed column
+		"INSERT INTO NATION_VIEW VALUES (1, 'a')",                      // not updatable
+		"DELETE FROM NATION_VIEW",                                      // not updatable
+		"DROP VIEW NATION",                                             // not a view
+		"DROP VIEW NO_SUCH_VIEW",                                       // missing
+		"DROP TABLE NATION_VIEW",                                       // a view
+		"SHOW CREATE VIEW NATION",                                      // not a view
+		"SHOW CREATE VIEW NO_SUCH_VIEW",                                // missing
+	}
+	runTestShouldError(mock, t, sqls)
+
+	logicPlan, err := runOneStmt(mock, t, "SELECT * FROM NATION_VIEW")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	cols := GetResultColumnsFromPlan(logicPlan)
+	if len(cols) != 2 || cols[0].Name != "nation_key" || cols[1].Name != "nation_name" {
+		t.Fatalf("unexpected columns %+v", cols)
+	}
+
+	logicPlan, err = runOneStmt(mock, t, "CREATE VIEW V1 (A, B) AS SELECT N_NATIONKEY, N_NAME FROM NATION")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	view, err := GetViewDef(logicPlan.GetDdl().GetCreateTable().GetTableDef())
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if view == nil || view.Database != "tpch" || view.Stmt != "select n_nationkey, n_name from nation" {
+		t.Fatalf("unexpected view %+v", view)
+	}
+}
+
+func TestMaterializedView(t *testing.T) {
+	mock := NewMockOptimizer()
+	// should pass
+	sqls := []string{
+		"CREATE MATERIALIZED VIEW MV1 AS SELECT N_REGIONKEY, count(*), sum(N_NATIONKEY), avg(N_NATIONKEY) FROM NATION GROUP BY N_REGIONKEY",
+		"CREATE MATERIALIZED VIEW MV1 (A, B) AS SELECT count(N_NAME), N_REGIONKEY FROM NATION WHERE N_NATIONKEY > 1 GROUP BY N_REGIONKEY",
+		"CREATE MATERIALIZED VIEW IF NOT EXISTS NATION_MV AS SELECT N_REGIONKEY, count(*) FROM NATION GROUP BY N_REGIONKEY",
+		"SELECT * FROM NATION_MV",
+		"SELECT REGION_KEY, NATIONS FROM NATION_MV WHERE NATIONS > 1",
+		"SHOW CREATE VIEW NATION_MV",
+		"REFRESH MATERIALIZED VIEW NATION_MV",
+		"REFRESH MATERIALIZED VIEW tpch.NATION_MV",
+		"DROP MATERIALIZED VIEW NATION_MV",
+		"DROP MATERIALIZED VIEW IF EXISTS NO_SUCH_VIEW",
+	}
+	runTestShouldPass(mock, t, sqls, false, false)
+
+	// should error
+	sqls = []string{
+		"CREATE MATERIALIZED VIEW NATION_MV AS SELECT N_REGIONKEY, count(*) FROM NATION GROUP BY N_REGIONKEY",                          // exists
+		"CREATE MATERIALIZED VIEW MV1 AS SELECT N_NAME FROM NATION",                                                                    // no group by
+		"CREATE MATERIALIZED VIEW MV1 AS SELECT N_REGIONKEY, max(N_NATIONKEY) FROM NATION GROUP BY N_REGIONKEY",                        // not incremental
+		"CREATE MATERIALIZED VIEW MV1 AS SELECT N_REGIONKEY, sum(N_NAME) FROM NATION GROUP BY N_REGIONKEY",                             // not a number
+		"CREATE MATERIALIZED VIEW MV1 AS SELECT N_REGIONKEY, count(distinct N_NAME) FROM NATION GROUP BY N_REGIONKEY",                  // distinct
+		"CREATE MATERIALIZED VIEW MV1 AS SELECT N_REGIONKEY, count(*) FROM NATION GROUP BY N_REGIONKEY HAVING count(*) > 1",            // having
+		"CREATE MATERIALIZED VIEW MV1 AS SELECT N_REGIONKEY, count(*) FROM NATION GROUP BY N_REGIONKEY ORDER BY N_REGIONKEY",           // order by
+		"CREATE MATERIALIZED VIEW MV1 AS SELECT N_REGIONKEY, count(*) + 1 FROM NATION GROUP BY N_REGIONKEY",                            // expression
+		"CREATE MATERIALIZED VIEW MV1 AS SELECT R_NAME, count(*) FROM NATION JOIN REGION ON N_REGIONKEY = R_REGIONKEY GROUP BY R_NAME", // join
+		"CREATE MATERIALIZED VIEW MV1 AS SELECT REGION_KEY, count(*) FROM NATION_MV GROUP BY REGION_KEY",                               // over a materialized view
+		"CREATE MATERIALIZED VIEW MV1 (__MO_MV_A, B) AS SELECT N_REGIONKEY, count(*) FROM NATION GROUP BY N_REGIONKEY",                 // reserved column
+		"SELECT __mo_mv_key FROM NATION_MV",      // hidden column
+		"INSERT INTO NATION_MV VALUES (1, 1)",    // read only
+		"DELETE FROM NATION_MV",                  // read only
+		"DROP TABLE NATION_MV",                   // a materialized view
+		"DROP VIEW NATION_MV",                    // not a view
+		"DROP MATERIALIZED VIEW NATION_VIEW",     // not a materialized view
+		"REFRESH MATERIALIZED VIEW NATION",       // not a materialized view
+		"REFRESH MATERIALIZED VIEW NO_SUCH_VIEW", // missing
+	}
+	runTestShouldError(mock, t, sqls)
+
+	logicPlan, err := runOneStmt(mock, t, "SELECT * FROM NATION_MV")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	cols := GetResultColumnsFromPlan(logicPlan)
+	if len(cols) != 2 || cols[0].Name != "region_key" || cols[1].Name != "nations" {
+		t.Fatalf("unexpected columns %+v", cols)
+	}
+
+	logicPlan, err = runOneStmt(mock, t, "CREATE MATERIALIZED VIEW MV1 (A, B, C) AS SELECT N_REGIONKEY, count(*), avg(N_NATIONKEY) FROM NATION WHERE N_NATIONKEY > 1 GROUP BY N_REGIONKEY")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	tableDef := logicPlan.GetDdl().GetCreateTable().GetTableDef()
+	names := make([]string, len(tableDef.Cols))
+	for i, col := range tableDef.Cols {
+		names[i] = col.Name
+	}
+	if strings.Join(names, ",") != "a,b,c,__mo_mv_key,__mo_mv_count,__mo_mv_value_0,__mo_mv_nulls_0,__mo_mv_value_1,__mo_mv_nulls_1" {
+		t.Fatalf("unexpected columns %v", names)
+	}
+	mview, err := GetMaterializedViewDef(tableDef)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if mview == nil || mview.Database != "tpch" || mview.Table != "nation" || len(mview.Columns) != 4 {
+		t.Fatalf("unexpected materialized view %+v", mview)
+	}
+	agg, err := mview.AggNode()
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if len(agg.WhereList) != 1 || len(agg.GroupBy) != 1 || len(agg.AggList) != 2 || len(agg.ProjectList) != 3 {
+		t.Fatalf("unexpected aggregation %+v", agg)
+	}
+}
+
+func TestConstraint(t *testing.T) {
+	mock := NewMockOptimizer()
+	// should pass
+	sqls := []string{
+		"create table t1 (a int primary key, b int check (b > 0), constraint c1 check (a < b))",
+		"create table t1 (a int primary key, b int, check (b > 0 and a + b < 10) not enforced)",
+		"create table t1 (a int primary key, b int references t1(a) on delete cascade)",
+		"create table t1 (a int primary key, b int, foreign key (b) references t1(a) on delete set null on update set null)",
+		"create table t1 (a int primary key, b int, constraint fk1 foreign key (b) references t1 (a) on delete restrict)",
+	}
+	runTestShouldPass(mock, t, sqls, false, false)
+
+	// should error
+	sqls = []string{
+		"create table t1 (a int primary key, b int check (b + 1))",                                             // not a boolean
+		"create table t1 (a int primary key, b int check (c > 0))",                                             // no such column
+		"create table t1 (a int primary key, b int, constraint c1 check (a > 0), constraint c1 check (b > 0))", // duplicate name
+		"create table t1 (a int primary key, b int references nation(n_nationkey))",                            // no primary key
+		"create table t1 (a int primary key, b int references no_such_table(a))",                               // missing
+		"create table t1 (a int primary key, b varchar(10) references t1(a))",                                  // incompatible
+		"create table t1 (a int primary key, b int, foreign key (c) references t1(a))",                         // no such column
+		"create table t1 (a int primary key, b int, c int, foreign key (b, c) references t1(a))",               // multiple columns
+		"create table t1 (a int primary key references t1(a) on delete set null)",                              // set primary key null
+		"create table t1 (a int primary key, b int references t1(a) on delete set default)",                    // unsupported
+		"create table t1 (a int primary key, b int references t1(a) on delete cascade on update set null)",     // different actions
+	}
+	runTestShouldError(mock, t, sqls)
+
+	logicPlan, err := runOneStmt(mock, t, "create table t1 (a int primary key, b int check (b > 0), c int, foreign key (c) references t1(a) on delete cascade)")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	tableDef := logicPlan.GetDdl().GetCreateTable().GetTableDef()
+	checks, err := GetCheckDefs(tableDef)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if len(checks) != 1 || checks[0].Name != "t1_chk_1" || !checks[0].Enforced {
+		t.Fatalf("unexpected check constraints %+v", checks)
+	}
+	expr, err := checks[0].CheckExpr()
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if expr.Typ.Id != plan.Type_BOOL {
+		t.Fatalf("unexpected check expression %+v", expr)
+	}
+	fks, err := GetForeignKeyDefs(tableDef)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if len(fks) != 1 || fks[0].Name != "t1_ibfk_1" || fks[0].Column != "c" || fks[0].Database != "tpch" || fks[0].Table != "t1" || fks[0].RefColumn != "a" || fks[0].OnDelete != tree.REFERENCE_OPTION_CASCADE {
+		t.Fatalf("unexpected foreign keys %+v", fks)
+	}
+}
+
+func TestTableFunction(t *testing.T) {
+	mock := NewMockOptimizer()
+	// should pass
+	sqls := []string{
+		"SELECT * FROM generate_series(1, 10)",
+		"SELECT n FROM generate_series(10, 1, -2) AS g(n) WHERE n > 3 ORDER BY n",
+		"SELECT N_NAME, g.generate_series FROM NATION, generate_series(1, 3) g",
+		"SELECT unnest FROM unnest('[1, \"a\", null]')",
+		"SELECT u.v, count(*) FROM unnest('[1, 2, 2]') AS u(v) GROUP BY u.v",
+		"SELECT block_id, rows, min_key, max_key FROM metadata_scan('tpch.nation') WHERE appendable = false",
+		"SELECT sum(size) FROM metadata_scan('region')",
+		"SELECT N_NAME FROM NATION WHERE N_NATIONKEY IN (SELECT generate_series FROM generate_series(1, 5))",
+	}
+	runTestShouldPass(mock, t, sqls, false, false)
+
+	// should error
+	sqls = []string{
+		"SELECT * FROM no_such_function(1)",
+		"SELECT * FROM generate_series(1)",
+		"SELECT * FROM generate_series(1, 'a')",
+		"SELECT * FROM generate_series(1, 2.5)",
+		"SELECT * FROM NATION, generate_series(1, N_NATIONKEY)",
+		"SELECT * FROM unnest(1)",
+		"SELECT * FROM metadata_scan('tpch.no_such_table')",
+		"SELECT * FROM metadata_scan(1)",
+		"SELECT * FROM generate_series(1, 3) AS g(a, b)",
+	}
+	runTestShouldError(mock, t, sqls)
+
+	logicPlan, err := runOneStmt(mock, t, "SELECT * FROM metadata_scan('nation')")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	for _, n := range logicPlan.GetQuery().Nodes {
+		if n.NodeType == plan.Node_FUNCTION_SCAN {
+			if n.TableDef.Name != "metadata_scan" || len(n.TableDef.Cols) != 8 {
+				t.Fatalf("unexpected table def %+v", n.TableDef)
+			}
+			if name := n.TableFuncArgs[0].GetC().GetSval(); name != "tpch.nation" {
+				t.Fatalf("unexpected table name %v", name)
+			}
+			return
+		}
+	}
+	t.Fatalf("no function scan node")
+}
+
+func TestJoinAlgo(t *testing.T) {
+	mock := NewMockOptimizer()
+	mock.ctxt.tables["region"].Cols[0].Primary = true
+	defer func() { mock.ctxt.tables["region"].Cols[0].Primary = false }()
+	joinAlgo := func(sql string) plan.Node_JoinAlgo {
+		logicPlan, err := runOneStmt(mock, t, sql)
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		qry := logicPlan.GetQuery()
+		ChooseJoinAlgos(qry)
+		for _, n := range qry.Nodes {
+			if n.NodeType == plan.Node_JOIN {
+				return n.JoinAlgo
+			}
+		}
+		t.Fatalf("no join: %v", sql)
+		return 0
+	}
+
+	// the left side is too large to look up the right side row by row
+	if algo := joinAlgo("SELECT N_NAME, R_NAME FROM NATION JOIN REGION ON N_REGIONKEY = R_REGIONKEY"); algo != plan.Node_HASH {
+		t.Fatalf("unexpected join algorithm %v", algo)
+	}
+	// the left side is sorted, but not the right side
+	if algo := joinAlgo("SELECT a.k, R_NAME FROM (SELECT N_REGIONKEY k FROM NATION ORDER BY N_REGIONKEY) a JOIN REGION ON a.k = R_REGIONKEY"); algo != plan.Node_HASH {
+		t.Fatalf("unexpected join algorithm %v", algo)
+	}
+	if algo := joinAlgo("SELECT a.k, b.k FROM (SELECT N_REGIONKEY k FROM NATION ORDER BY N_REGIONKEY) a JOIN (SELECT R_REGIONKEY k FROM REGION ORDER BY R_REGIONKEY) b ON a.k = b.k"); algo != plan.Node_MERGE {
+		t.Fatalf("unexpected join algorithm %v", algo)
+	}
+	if algo := joinAlgo("SELECT a.k, b.k FROM (SELECT N_REGIONKEY k FROM NATION ORDER BY N_REGIONKEY DESC) a JOIN (SELECT R_REGIONKEY k FROM REGION ORDER BY R_REGIONKEY) b ON a.k = b.k"); algo != plan.Node_HASH {
+		t.Fatalf("unexpected join algorithm %v", algo)
+	}
+	// the scans of the sorted tables are sorted by their primary keys
+	nation, region := mock.ctxt.tables["nation"], mock.ctxt.tables["region"]
+	nation.Cols[0].Primary = true
+	sorted := &plan.TableDef_DefType{
+		Def: &plan.TableDef_DefType_Properties{
+			Properties: &plan.PropertiesDef{
+				Properties: []*plan.Property{{Key: SortedKey, Value: "true"}},
+			},
+		},
+	}
+	nation.Defs, region.Defs = append(nation.Defs, sorted), append(region.Defs, sorted)
+	defer func() {
+		nation.Cols[0].Primary = false
+		nation.Defs, region.Defs = nation.Defs[:len(nation.Defs)-1], region.Defs[:len(region.Defs)-1]
+	}()
+	if algo := joinAlgo("SELECT N_NAME, R_NAME FROM NATION JOIN REGION ON N_NATIONKEY = R_REGIONKEY"); algo != plan.Node_MERGE {
+		t.Fatalf("unexpected join algorithm %v", algo)
+	}
+	if algo := joinAlgo("SELECT N_NAME, R_NAME FROM NATION JOIN REGION ON N_REGIONKEY = R_REGIONKEY"); algo != plan.Node_HASH {
+		t.Fatalf("unexpected join algorithm %v", algo)
+	}
+
+	threshold := IndexJoinThreshold
+	defer func() { IndexJoinThreshold = threshold }()
+	IndexJoinThreshold = 1e7
+	if algo := joinAlgo("SELECT N_NAME, R_NAME FROM NATION JOIN REGION ON N_REGIONKEY = R_REGIONKEY"); algo != plan.Node_INDEX {
+		t.Fatalf("unexpected join algorithm %v", algo)
+	}
+	// the right side is not scanned by its primary key
+	if algo := joinAlgo("SELECT N_NAME, R_NAME FROM NATION JOIN REGION ON N_NAME = R_NAME"); algo != plan.Node_HASH {
+		t.Fatalf("unexpected join algorithm %v", algo)
+	}
+	if algo := joinAlgo("SELECT N_NAME, r.R_NAME FROM NATION JOIN (SELECT * FROM REGION WHERE R_NAME = 'ASIA') r ON N_REGIONKEY = r.R_REGIONKEY"); algo != plan.Node_HASH {
+		t.Fatalf("unexpected join algorithm %v", algo)
+	}
+}
+
 func TestInsert(t *testing.T) {
 	mock := NewMockOptimizer()
 	// should pass
@@ -443,6 +1043,9 @@ func TestInsert(t *testing.T) {
 		"INSERT NATION VALUES (1, 'NAME1',21, 'COMMENT1'), (2, 'NAME2', 22, 'COMMENT2')",
 		"INSERT NATION (N_NATIONKEY, N_REGIONKEY, N_NAME) VALUES (1, 21, 'NAME1'), (2, 22, 'NAME2')",
 		"INSERT INTO NATION SELECT * FROM NATION2",
+		"INSERT NATION VALUES (1, 'NAME1', NULL, 'COMMENT1'), (-2, 'NAME2', 22, NULL)",
+		"INSERT NATION VALUES (1, 'NAME1', 21, 'COMMENT1') ON DUPLICATE KEY UPDATE N_NAME = VALUES(N_NAME)",
+		"INSERT NATION (N_NATIONKEY, N_NAME) VALUES (1, 'NAME1') ON DUPLICATE KEY UPDATE N_COMMENT = N_NAME",
 	}
 	runTestShouldPass(mock, t, sqls, false, false)
 
@@ -456,6 +1059,8 @@ func TestInsert(t *testing.T) {
 		"INSERT NATION (N_NATIONKEY, N_REGIONKEY, N_NAME2222) VALUES (1, 2.22, 'NAME1'), (2, 22, 'NAME2')",           // column type not match
 		"INSERT NATION (N_NATIONKEY, N_REGIONKEY, N_NAME2222) VALUES (1, 2, 'NAME1'), (2, 22, 'NAME2')",              // function expr not support now
 		"INSERT INTO region SELECT * FROM NATION2",                                                                   // column length not match
+		"INSERT NATION (N_NAME) VALUES ('NAME1') ON DUPLICATE KEY UPDATE N_COMMENT = 'C'",                            // primary key is not inserted
+		"INSERT NATION VALUES (1, 'NAME1', 21, 'COMMENT1') ON DUPLICATE KEY UPDATE N_NAME = VALUES(N_NAME2)",         // column not exist
 	}
 	runTestShouldError(mock, t, sqls)
 }
@@ -467,6 +1072,7 @@ func TestUpdate(t *testing.T) {
 		"UPDATE NATION SET N_NAME ='U1', N_REGIONKEY=2",
 		"UPDATE NATION SET N_NAME ='U1', N_REGIONKEY=2 WHERE N_NATIONKEY > 10 LIMIT 20",
 		"UPDATE NATION SET N_NAME ='U1', N_REGIONKEY=N_REGIONKEY+2 WHERE N_NATIONKEY > 10 LIMIT 20",
+		"UPDATE NATION SET NATION.N_NAME ='U1' WHERE N_NATIONKEY > 10",
 	}
 	runTestShouldPass(mock, t, sqls, false, false)
 
@@ -474,6 +1080,7 @@ func TestUpdate(t *testing.T) {
 	sqls = []string{
 		"UPDATE NATION SET N_NAME2 ='U1', N_REGIONKEY=2",    // column not exist
 		"UPDATE NATION2222 SET N_NAME ='U1', N_REGIONKEY=2", // table not exist
+		"UPDATE NATION SET REGION.N_NAME ='U1'",             // column not of the table
 		// "UPDATE NATION SET N_NAME = 2, N_REGIONKEY=2",       // column type not match
 		// "UPDATE NATION SET N_NAME = 'U1', N_REGIONKEY=2.2",  // column type not match
 	}
@@ -564,6 +1171,10 @@ func TestDdl(t *testing.T) {
 		"create table tbl_name (t bool(20), b int unsigned, c char(20), d varchar(20), primary key(b), index idx_t(c)) comment 'test comment'",
 		"create table if not exists tbl_name (b int default 20 primary key, c char(20) default 'ss', d varchar(20) default 'kkk')",
 		"create table if not exists nation (t bool(20), b int, c char(20), d varchar(20))",
+		"create table tbl_name (a text, b mediumtext, c longblob, d blob)",
+		"create table tbl_name (a time, b time(3), c year, d timestamp)",
+		"create table tbl_name (a json, b int)",
+		"create table tbl_name (a uuid primary key, b enum('x', 'y') default 'y', c set('r', 'w') default 'r,w')",
 		"drop table if exists tbl_name",
 		"drop table if exists nation",
 		"drop table nation",
@@ -583,10 +1194,30 @@ func TestDdl(t *testing.T) {
 		"drop table tpch.tbl_not_exist", //database not exists
 		"drop table db_not_exist.tbl",   //table not exists
 
+		"create table tbl_name (a enum('x', 'x'))",             //duplicated member
+		"create table tbl_name (a enum('x', 'y') default 'z')", //not a member
+		"create table tbl_name (a uuid default 'abc')",         //not an uuid
+
 		"create index idx1 using bsi on a(a)", //unsupport now
 		"drop index idx1 on tbl",              //unsupport now
 	}
 	runTestShouldError(mock, t, sqls)
+
+	// the codec and the encoding of a column are planned separately
+	logicPlan, err := runOneStmt(mock, t, "create table tbl_name (a int compression 'delta,zstd', b varchar(10) compression 'dict', c int)")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	cols := logicPlan.GetDdl().GetCreateTable().GetTableDef().Cols
+	if cols[0].Alg != plan.CompressType_Zstd || cols[0].Encoding != plan.EncodingType_Delta {
+		t.Fatalf("unexpected compression of a: %v, %v", cols[0].Alg, cols[0].Encoding)
+	}
+	if cols[1].Alg != plan.CompressType_Lz4 || cols[1].Encoding != plan.EncodingType_Dict {
+		t.Fatalf("unexpected compression of b: %v, %v", cols[1].Alg, cols[1].Encoding)
+	}
+	if cols[2].Alg != plan.CompressType_Lz4 || cols[2].Encoding != plan.EncodingType_Plain {
+		t.Fatalf("unexpected compression of c: %v, %v", cols[2].Alg, cols[2].Encoding)
+	}
 }
 
 func TestShow(t *testing.T) {
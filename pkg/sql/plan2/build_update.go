@@ -20,6 +20,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/errno"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 )
 
@@ -55,24 +56,28 @@ func buildUpdate(stmt *tree.Update, ctx CompilerContext) (*Plan, error) {
 	if tableDef == nil {
 		return nil, errors.New(errno.CaseNotFound, "can not find table in sql")
 	}
+	if err := checkModifiable(tableDef); err != nil {
+		return nil, err
+	}
 
-	getColumnName := func(name string) *Expr {
+	// the columns of the target table in the output of the select
+	oldRow, err := getTargetColumns(query, nodeId, tableDef)
+	if err != nil {
+		return nil, err
+	}
+	getColumnName := func(name *tree.UnresolvedName) (int, error) {
+		if name.NumParts == 2 && name.Parts[1] != getTableAlias(tableDef) {
+			return -1, errors.New(errno.CaseNotFound, fmt.Sprintf("set column name [%v] is not a column of table [%v]", tree.String(name, dialect.MYSQL), tableDef.Name))
+		}
+		if name.NumParts > 2 {
+			return -1, errors.New(errno.CaseNotFound, "the set list of update must be one")
+		}
 		for idx, col := range tableDef.Cols {
-			if col.Name == name {
-				return &Expr{
-					TableName: tableDef.Name,
-					ColName:   col.Name,
-					Expr: &plan.Expr_Col{
-						Col: &ColRef{
-							RelPos: 0,
-							ColPos: int32(idx),
-						},
-					},
-					Typ: col.Typ,
-				}
+			if col.Name == name.Parts[0] {
+				return idx, nil
 			}
 		}
-		return nil
+		return -1, errors.New(errno.CaseNotFound, fmt.Sprintf("set column name [%v] is not found", name.Parts[0]))
 	}
 
 	columnLength := len(stmt.Exprs)
@@ -87,38 +92,30 @@ func buildUpdate(stmt *tree.Update, ctx CompilerContext) (*Plan, error) {
 		Children: []int32{nodeId},
 	}
 
+	newRow := make([]*Expr, len(oldRow))
+	copy(newRow, oldRow)
 	columns := make([]*Expr, 0, columnLength)
 	values := make([]*Expr, 0, columnLength)
 	for _, expr := range stmt.Exprs {
 		if len(expr.Names) != 1 {
 			return nil, errors.New(errno.CaseNotFound, "the set list of update must be one")
 		}
-		if expr.Names[0].NumParts != 1 {
-			return nil, errors.New(errno.CaseNotFound, "the set list of update must be one")
-		}
-
-		column := getColumnName(expr.Names[0].Parts[0])
-		if column == nil {
-			return nil, errors.New(errno.CaseNotFound, fmt.Sprintf("set column name [%v] is not found", expr.Names[0].Parts[0]))
+		idx, err := getColumnName(expr.Names[0])
+		if err != nil {
+			return nil, err
 		}
 
+		// the value is converted to the column's type when it is written
 		value, _, err := buildExpr(expr.Expr, ctx, query, node, binderCtx, false)
 		if err != nil {
 			return nil, err
 		}
 
-		// cast value type
-		if column.Typ.Id != value.Typ.Id {
-			tmp, err := appendCastExpr(value, column.Typ)
-			if err != nil {
-				return nil, err
-			}
-			value = tmp
-		}
-
-		columns = append(columns, column)
+		newRow[idx] = value
+		columns = append(columns, oldRow[idx])
 		values = append(values, value)
 	}
+	node.ProjectList = append(newRow, oldRow[getPrimaryKeyPos(tableDef)])
 
 	node.UpdateList = &plan.UpdateList{
 		Columns: columns,
@@ -15,11 +15,13 @@
 package plan2
 
 import (
+	"encoding/json"
 	"fmt"
 	"go/constant"
 	"math"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 
@@ -28,6 +30,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/errors"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"google.golang.org/protobuf/proto"
 )
 
 //splitExprToAND split a expression to a list of AND conditions.
@@ -258,7 +261,7 @@ func buildUnresolvedName(query *Query, node *Node, colName string, tableName str
 		return colName == expr.ColName && (len(tableName) == 0 || tableName == expr.TableName)
 	}
 
-	if node.NodeType == plan.Node_TABLE_SCAN {
+	if node.NodeType == plan.Node_TABLE_SCAN || node.NodeType == plan.Node_EXTERNAL_SCAN || node.NodeType == plan.Node_FUNCTION_SCAN || node.NodeType == plan.Node_MATERIAL_SCAN {
 		// search name from TableDef
 		if len(tableName) == 0 || tableName == node.TableDef.Alias {
 			for j, col := range node.TableDef.Cols {
@@ -357,14 +360,105 @@ func getResolveTable(dbName string, tableName string, ctx CompilerContext, binde
 	tableDef, ok := binderCtx.cteTables[tableName]
 	if ok {
 		objRef = &ObjectRef{
+			Obj:        int64(binderCtx.cteNodes[tableName]),
 			SchemaName: dbName,
 			ObjName:    tableName,
 		}
-		return objRef, tableDef, true
+		// every reference of the cte has its own alias
+		return objRef, proto.Clone(tableDef).(*TableDef), true
 	}
 	return nil, nil, false
 }
 
+// GetExternParam returns the parameters of the external table, or nil if the table is not external
+func GetExternParam(tableDef *TableDef) (*tree.ExternParam, error) {
+	for _, def := range tableDef.Defs {
+		properties, ok := def.Def.(*plan.TableDef_DefType_Properties)
+		if !ok {
+			continue
+		}
+		for _, property := range properties.Properties.Properties {
+			if property.Key != ExternParamKey {
+				continue
+			}
+			param := &tree.ExternParam{}
+			if err := json.Unmarshal([]byte(property.Value), param); err != nil {
+				return nil, errors.New(errno.DataException, fmt.Sprintf("invalid parameters of the external table '%v'", tableDef.Name))
+			}
+			return param, nil
+		}
+	}
+	return nil, nil
+}
+
+// GetViewDef returns the definition of the view, or nil if the table is not a view
+func GetViewDef(tableDef *TableDef) (*ViewDef, error) {
+	value, ok := getTableProperty(tableDef, ViewKey)
+	if !ok {
+		return nil, nil
+	}
+	view := &ViewDef{}
+	if err := json.Unmarshal([]byte(value), view); err != nil {
+		return nil, errors.New(errno.DataException, fmt.Sprintf("invalid definition of the view '%v'", tableDef.Name))
+	}
+	return view, nil
+}
+
+// GetMaterializedViewDef returns the definition of the materialized view, or nil if the table is not one
+func GetMaterializedViewDef(tableDef *TableDef) (*MaterializedViewDef, error) {
+	value, ok := getTableProperty(tableDef, MaterializedViewKey)
+	if !ok {
+		return nil, nil
+	}
+	view := &MaterializedViewDef{}
+	if err := json.Unmarshal([]byte(value), view); err != nil {
+		return nil, errors.New(errno.DataException, fmt.Sprintf("invalid definition of the materialized view '%v'", tableDef.Name))
+	}
+	return view, nil
+}
+
+func getTableProperty(tableDef *TableDef, key string) (string, bool) {
+	for _, def := range tableDef.Defs {
+		properties, ok := def.Def.(*plan.TableDef_DefType_Properties)
+		if !ok {
+			continue
+		}
+		for _, property := range properties.Properties.Properties {
+			if property.Key == key {
+				return property.Value, true
+			}
+		}
+	}
+	return "", false
+}
+
+// checkModifiable returns an error if the table to modify is an external table or a view,
+// the materialized views are only changed by the tables they aggregate.
+func checkModifiable(tableDef *TableDef) error {
+	param, err := GetExternParam(tableDef)
+	if err != nil {
+		return err
+	}
+	if param != nil {
+		return errors.New(errno.WrongObjectType, fmt.Sprintf("the external table '%v' is read only", tableDef.Name))
+	}
+	view, err := GetViewDef(tableDef)
+	if err != nil {
+		return err
+	}
+	if view != nil {
+		return errors.New(errno.WrongObjectType, fmt.Sprintf("the view '%v' is not updatable", tableDef.Name))
+	}
+	mview, err := GetMaterializedViewDef(tableDef)
+	if err != nil {
+		return err
+	}
+	if mview != nil {
+		return errors.New(errno.WrongObjectType, fmt.Sprintf("the materialized view '%v' is read only", tableDef.Name))
+	}
+	return nil
+}
+
 //getLastTableDef get insert/update/delete tableDef
 // FIXME
 func getLastTableDef(query *Query) (*ObjectRef, *TableDef) {
@@ -381,10 +475,53 @@ func getLastTableDef(query *Query) (*ObjectRef, *TableDef) {
 	return nil, nil
 }
 
+// getTableAlias returns the name the table is referred by in the query
+func getTableAlias(tableDef *TableDef) string {
+	if tableDef.Alias != "" {
+		return tableDef.Alias
+	}
+	return tableDef.Name
+}
+
+// getTargetColumns returns the columns of the update/delete table in the output of node,
+// in the order of the table's columns
+func getTargetColumns(query *Query, nodeId int32, tableDef *TableDef) ([]*Expr, error) {
+	tableName := getTableAlias(tableDef)
+	projectList := query.Nodes[nodeId].ProjectList
+	cols := make([]*Expr, len(tableDef.Cols))
+	for i, col := range tableDef.Cols {
+		colName := col.Alias
+		if colName == "" {
+			colName = col.Name
+		}
+		for j, expr := range projectList {
+			if expr.TableName == tableName && expr.ColName == colName {
+				cols[i] = &Expr{
+					Typ:       expr.Typ,
+					TableName: tableName,
+					ColName:   col.Name,
+					Expr: &plan.Expr_Col{
+						Col: &ColRef{
+							RelPos: 0,
+							ColPos: int32(j),
+						},
+					},
+				}
+				break
+			}
+		}
+		if cols[i] == nil {
+			return nil, errors.New(errno.InvalidColumnReference, fmt.Sprintf("column '%v' of table '%v' is not found", col.Name, tableName))
+		}
+	}
+	return cols, nil
+}
+
 func newQueryAndSelectCtx(typ plan.Query_StatementType) (*Query, *BinderContext) {
 	binderCtx := &BinderContext{
 		columnAlias: make(map[string]*Expr),
 		cteTables:   make(map[string]*TableDef),
+		cteNodes:    make(map[string]int32),
 	}
 	query := &Query{
 		StmtType: typ,
@@ -429,8 +566,32 @@ func getTypeFromAst(typ tree.ResolvableTypeReference) (*plan.Type, error) {
 				return &plan.Type{Id: plan.Type_CHAR, Size: 24, Width: 1}, nil
 			}
 			return &plan.Type{Id: plan.Type_VARCHAR, Size: 24, Width: n.InternalType.DisplayWith}, nil
+		case defines.MYSQL_TYPE_TINY_BLOB, defines.MYSQL_TYPE_BLOB, defines.MYSQL_TYPE_MEDIUM_BLOB, defines.MYSQL_TYPE_LONG_BLOB:
+			width := defines.BlobMaxLength[uint8(n.InternalType.Oid)]
+			if strings.HasSuffix(strings.ToLower(n.InternalType.FamilyString), "text") {
+				return &plan.Type{Id: plan.Type_TEXT, Size: 24, Width: width}, nil
+			}
+			return &plan.Type{Id: plan.Type_BLOB, Size: 24, Width: width}, nil
+		case defines.MYSQL_TYPE_JSON:
+			return &plan.Type{Id: plan.Type_JSON, Size: 24, Width: defines.BlobMaxLength[defines.MYSQL_TYPE_LONG_BLOB]}, nil
+		case defines.MYSQL_TYPE_UUID:
+			return &plan.Type{Id: plan.Type_UUID, Size: 16}, nil
+		case defines.MYSQL_TYPE_ENUM:
+			if err := types.CheckEnumValues(n.InternalType.EnumValues, false); err != nil {
+				return nil, err
+			}
+			return &plan.Type{Id: plan.Type_ENUM, Size: 2, EnumValues: n.InternalType.EnumValues}, nil
+		case defines.MYSQL_TYPE_SET:
+			if err := types.CheckEnumValues(n.InternalType.EnumValues, true); err != nil {
+				return nil, err
+			}
+			return &plan.Type{Id: plan.Type_SET, Size: 8, EnumValues: n.InternalType.EnumValues}, nil
 		case defines.MYSQL_TYPE_DATE:
 			return &plan.Type{Id: plan.Type_DATE, Size: 4}, nil
+		case defines.MYSQL_TYPE_TIME:
+			return &plan.Type{Id: plan.Type_TIME, Size: 8, Precision: n.InternalType.DisplayWith}, nil
+		case defines.MYSQL_TYPE_YEAR:
+			return &plan.Type{Id: plan.Type_YEAR, Size: 2}, nil
 		case defines.MYSQL_TYPE_DATETIME:
 			return &plan.Type{Id: plan.Type_DATETIME, Size: 8}, nil
 		case defines.MYSQL_TYPE_TIMESTAMP:
@@ -486,7 +647,7 @@ func getDefaultExprFromColumn(column *tree.ColumnTableDef, typ *plan.Type) (*pla
 			if err != nil {
 				return nil, errors.New(errno.InvalidColumnDefinition, fmt.Sprintf("Invalid default value for '%s'", column.Name.Parts[0]))
 			}
-			constantValue := convertToPlanValue(value)
+			constantValue := ConvertToPlanValue(value)
 			return &plan.DefaultExpr{
 				Exist:  true,
 				Value:  constantValue,
@@ -499,8 +660,21 @@ func getDefaultExprFromColumn(column *tree.ColumnTableDef, typ *plan.Type) (*pla
 	}, nil
 }
 
-func convertToPlanValue(value interface{}) *plan.ConstantValue {
+// ConvertToPlanValue converts a value of a column to the plan's constant value
+func ConvertToPlanValue(value interface{}) *plan.ConstantValue {
 	switch v := value.(type) {
+	case int8:
+		return ConvertToPlanValue(int64(v))
+	case int16:
+		return ConvertToPlanValue(int64(v))
+	case int32:
+		return ConvertToPlanValue(int64(v))
+	case uint8:
+		return ConvertToPlanValue(uint64(v))
+	case uint16:
+		return ConvertToPlanValue(uint64(v))
+	case uint32:
+		return ConvertToPlanValue(uint64(v))
 	case int64:
 		return &plan.ConstantValue{
 			ConstantValue: &plan.ConstantValue_Int64V{Int64V: v},
@@ -533,6 +707,14 @@ func convertToPlanValue(value interface{}) *plan.ConstantValue {
 		return &plan.ConstantValue{
 			ConstantValue: &plan.ConstantValue_TimeStampV{TimeStampV: int64(v)},
 		}
+	case types.Time:
+		return &plan.ConstantValue{
+			ConstantValue: &plan.ConstantValue_Int64V{Int64V: int64(v)},
+		}
+	case types.YearValue:
+		return &plan.ConstantValue{
+			ConstantValue: &plan.ConstantValue_Int64V{Int64V: int64(v)},
+		}
 	case types.Decimal64:
 		return &plan.ConstantValue{
 			ConstantValue: &plan.ConstantValue_Decimal64V{Decimal64V: int64(v)},
@@ -621,11 +803,17 @@ func rangeCheck(value interface{}, typ *plan.Type, columnName string, rowNumber
 			if len(v) <= int(typ.Width) {
 				return v, nil
 			}
+		case plan.Type_TEXT, plan.Type_BLOB: // large values are not limited by uint16
+			if len(v) <= int(typ.Width) {
+				return v, nil
+			}
+		case plan.Type_UUID, plan.Type_ENUM, plan.Type_SET: // checked while building the constant
+			return v, nil
 		default:
 			return nil, errors.New(errno.DatatypeMismatch, "unexpected type and value")
 		}
 		return nil, errors.New(errno.DataException, fmt.Sprintf("Data too long for column '%s' at row %d", columnName, rowNumber))
-	case types.Date, types.Datetime, types.Timestamp, types.Decimal64, types.Decimal128:
+	case types.Date, types.Time, types.Datetime, types.Timestamp, types.YearValue, types.Decimal64, types.Decimal128:
 		return v, nil
 	default:
 		return nil, errors.New(errno.DatatypeMismatch, "unexpected type and value")
@@ -858,6 +1046,24 @@ func buildConstantValue(typ *plan.Type, num *tree.NumVal) (interface{}, error) {
 				}
 			}
 			return uint64(v), nil
+		case plan.Type_ENUM:
+			if !num.Negative() {
+				v, _ := constant.Int64Val(val)
+				idx, err := types.EnumFromInt(typ.EnumValues, v)
+				if err != nil {
+					return nil, err
+				}
+				return types.EnumString(typ.EnumValues, idx), nil
+			}
+		case plan.Type_SET:
+			if !num.Negative() {
+				v, _ := constant.Int64Val(val)
+				bits, err := types.SetFromInt(typ.EnumValues, v)
+				if err != nil {
+					return nil, err
+				}
+				return types.SetString(typ.EnumValues, bits), nil
+			}
 		case plan.Type_FLOAT32:
 			v, _ := constant.Float32Val(val)
 			if num.Negative() {
@@ -878,6 +1084,13 @@ func buildConstantValue(typ *plan.Type, num *tree.NumVal) (interface{}, error) {
 			if !num.Negative() {
 				return types.ParseDatetime(str)
 			}
+		case plan.Type_TIME:
+			return types.ParseTime(str, typ.Precision)
+		case plan.Type_YEAR:
+			if !num.Negative() {
+				v, _ := constant.Int64Val(val)
+				return types.YearFromInt(v)
+			}
 		}
 	case constant.Float:
 		switch typ.GetId() {
@@ -951,8 +1164,33 @@ func buildConstantValue(typ *plan.Type, num *tree.NumVal) (interface{}, error) {
 				return types.ParseDate(constant.StringVal(val))
 			case plan.Type_DATETIME:
 				return types.ParseDatetime(constant.StringVal(val))
+			case plan.Type_TIME:
+				return types.ParseTime(constant.StringVal(val), typ.Precision)
 			case plan.Type_TIMESTAMP:
-				return types.ParseTimestamp(constant.StringVal(val), typ.Precision)
+				// constants are interpreted in the local time zone of the server while building plan
+				return types.ParseTimestamp(time.Local, constant.StringVal(val), typ.Precision)
+			case plan.Type_YEAR:
+				return types.ParseYear(constant.StringVal(val))
+			case plan.Type_UUID:
+				// uuid values are kept in the canonical text
+				u, err := types.ParseUuid(constant.StringVal(val))
+				if err != nil {
+					return nil, err
+				}
+				return u.String(), nil
+			case plan.Type_ENUM:
+				// enum and set values are kept in the text of the members
+				idx, err := types.ParseEnum(typ.EnumValues, constant.StringVal(val))
+				if err != nil {
+					return nil, err
+				}
+				return types.EnumString(typ.EnumValues, idx), nil
+			case plan.Type_SET:
+				bits, err := types.ParseSet(typ.EnumValues, constant.StringVal(val))
+				if err != nil {
+					return nil, err
+				}
+				return types.SetString(typ.EnumValues, bits), nil
 			}
 		}
 	}
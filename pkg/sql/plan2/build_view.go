@@ -0,0 +1,523 @@
+// Copyright 2021 - 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan2
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"google.golang.org/protobuf/proto"
+)
+
+// viewContext is the context of building the select statement of a view, the tables
+// without database are resolved in the database of the view.
+type viewContext struct {
+	CompilerContext
+	database string
+	// the views being expanded, used to find the views referencing themselves
+	views []string
+}
+
+func newViewContext(ctx CompilerContext, database, name string) (*viewContext, error) {
+	var views []string
+	if vctx, ok := ctx.(*viewContext); ok {
+		views = vctx.views
+	}
+	key := database + "." + name
+	for _, view := range views {
+		if view == key {
+			return nil, errors.New(errno.InvalidObjectDefinition, fmt.Sprintf("view '%v' references itself", key))
+		}
+	}
+	return &viewContext{
+		CompilerContext: ctx,
+		database:        database,
+		views:           append(append([]string{}, views...), key),
+	}, nil
+}
+
+func (vctx *viewContext) DefaultDatabase() string {
+	return vctx.database
+}
+
+func (vctx *viewContext) Resolve(schemaName string, tableName string) (*ObjectRef, *TableDef) {
+	if schemaName == "" {
+		schemaName = vctx.database
+	}
+	return vctx.CompilerContext.Resolve(schemaName, tableName)
+}
+
+// buildCreateView checks the select statement of the view, the view is created as a table
+// without rows whose columns are the result columns of the select statement, and whose
+// ViewKey property keeps the statement. A materialized view is a table keeping the result.
+func buildCreateView(stmt *tree.CreateView, ctx CompilerContext) (*Plan, error) {
+	if stmt.Temporary {
+		return nil, errors.New(errno.FeatureNotSupported, "temporary view is not supported now")
+	}
+	dbName := string(stmt.Name.SchemaName)
+	if dbName == "" {
+		dbName = ctx.DefaultDatabase()
+	}
+	if !ctx.DatabaseExists(dbName) {
+		return nil, errors.New(errno.InvalidSchemaName, fmt.Sprintf("database '%v' doesn't exist", dbName))
+	}
+	viewName := string(stmt.Name.ObjectName)
+	if !stmt.IfNotExists {
+		if _, tableDef := ctx.Resolve(dbName, viewName); tableDef != nil {
+			return nil, errors.New(errno.DuplicateTable, fmt.Sprintf("table '%v' already exists", viewName))
+		}
+	}
+
+	// the statement is kept before building, which may change it
+	viewStmt := tree.String(stmt.AsSource, dialect.MYSQL)
+	vctx, err := newViewContext(ctx, dbName, viewName)
+	if err != nil {
+		return nil, err
+	}
+	selectPlan, err := BuildPlan(vctx, stmt.AsSource)
+	if err != nil {
+		return nil, err
+	}
+	cols := GetResultColumnsFromPlan(selectPlan)
+	if len(stmt.ColNames) > 0 {
+		if len(stmt.ColNames) != len(cols) {
+			return nil, errors.New(errno.InvalidColumnReference, fmt.Sprintf("view '%v' has %v columns but %v column names are specified", viewName, len(cols), len(stmt.ColNames)))
+		}
+		for i, name := range stmt.ColNames {
+			cols[i].Name = string(name)
+		}
+	}
+	names := make(map[string]bool)
+	for _, col := range cols {
+		name := strings.ToLower(col.Name)
+		if names[name] {
+			return nil, errors.New(errno.DuplicateColumn, fmt.Sprintf("duplicate column name '%v' in view '%v'", col.Name, viewName))
+		}
+		names[name] = true
+		col.Alg = plan.CompressType_Lz4
+	}
+	if stmt.Materialized {
+		return buildCreateMaterializedView(stmt, dbName, viewStmt, selectPlan.GetQuery(), cols)
+	}
+
+	data, err := json.Marshal(&ViewDef{
+		Stmt:     viewStmt,
+		Database: dbName,
+	})
+	if err != nil {
+		return nil, err
+	}
+	createTable := &plan.CreateTable{
+		IfNotExists: stmt.IfNotExists,
+		Database:    dbName,
+		TableDef: &TableDef{
+			Name: viewName,
+			Cols: cols,
+			Defs: []*plan.TableDef_DefType{
+				{
+					Def: &plan.TableDef_DefType_Properties{
+						Properties: &plan.PropertiesDef{
+							Properties: []*plan.Property{
+								{
+									Key:   ViewKey,
+									Value: string(data),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	return &Plan{
+		Plan: &plan.Plan_Ddl{
+			Ddl: &plan.DataDefinition{
+				DdlType: plan.DataDefinition_CREATE_TABLE,
+				Definition: &plan.DataDefinition_CreateTable{
+					CreateTable: createTable,
+				},
+			},
+		},
+	}, nil
+}
+
+func buildDropView(stmt *tree.DropView, ctx CompilerContext) (*Plan, error) {
+	if len(stmt.Names) != 1 {
+		return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, "support drop one view now")
+	}
+	dropTable := &plan.DropTable{
+		IfExists: stmt.IfExists,
+		Database: string(stmt.Names[0].SchemaName),
+		Table:    string(stmt.Names[0].ObjectName),
+	}
+	if dropTable.Database == "" {
+		dropTable.Database = ctx.DefaultDatabase()
+	}
+	kind := "view"
+	if stmt.Materialized {
+		kind = "materialized view"
+	}
+	_, tableDef := ctx.Resolve(dropTable.Database, dropTable.Table)
+	if tableDef == nil {
+		if !stmt.IfExists {
+			return nil, errors.New(errno.UndefinedTable, fmt.Sprintf("%v '%v' doesn't exist", kind, dropTable.Table))
+		}
+	} else {
+		view, err := GetViewDef(tableDef)
+		if err != nil {
+			return nil, err
+		}
+		mview, err := GetMaterializedViewDef(tableDef)
+		if err != nil {
+			return nil, err
+		}
+		if (stmt.Materialized && mview == nil) || (!stmt.Materialized && view == nil) {
+			return nil, errors.New(errno.WrongObjectType, fmt.Sprintf("'%v.%v' is not a %v", dropTable.Database, dropTable.Table, kind))
+		}
+	}
+
+	return &Plan{
+		Plan: &plan.Plan_Ddl{
+			Ddl: &plan.DataDefinition{
+				DdlType: plan.DataDefinition_DROP_TABLE,
+				Definition: &plan.DataDefinition_DropTable{
+					DropTable: dropTable,
+				},
+			},
+		},
+	}, nil
+}
+
+// buildRefreshMaterializedView rebuilds the materialized view from all the rows of the table it aggregates
+func buildRefreshMaterializedView(stmt *tree.RefreshMaterializedView, ctx CompilerContext) (*Plan, error) {
+	refresh := &plan.RefreshMaterializedView{
+		Database: string(stmt.Name.SchemaName),
+		Table:    string(stmt.Name.ObjectName),
+	}
+	if refresh.Database == "" {
+		refresh.Database = ctx.DefaultDatabase()
+	}
+	_, tableDef := ctx.Resolve(refresh.Database, refresh.Table)
+	if tableDef == nil {
+		return nil, errors.New(errno.UndefinedTable, fmt.Sprintf("materialized view '%v' doesn't exist", refresh.Table))
+	}
+	mview, err := GetMaterializedViewDef(tableDef)
+	if err != nil {
+		return nil, err
+	}
+	if mview == nil {
+		return nil, errors.New(errno.WrongObjectType, fmt.Sprintf("'%v.%v' is not a materialized view", refresh.Database, refresh.Table))
+	}
+
+	return &Plan{
+		Plan: &plan.Plan_Ddl{
+			Ddl: &plan.DataDefinition{
+				DdlType: plan.DataDefinition_REFRESH_MATERIALIZEDVIEW,
+				Definition: &plan.DataDefinition_RefreshMaterializedView{
+					RefreshMaterializedView: refresh,
+				},
+			},
+		},
+	}, nil
+}
+
+func buildShowCreateView(stmt *tree.ShowCreateView, ctx CompilerContext) (*Plan, error) {
+	tblName := stmt.Name.Parts[0]
+	dbName := ctx.DefaultDatabase()
+	if stmt.Name.NumParts == 2 {
+		dbName = stmt.Name.Parts[1]
+	}
+
+	_, tableDef := ctx.Resolve(dbName, tblName)
+	if tableDef == nil {
+		return nil, errors.New(errno.UndefinedTable, fmt.Sprintf("view '%v' doesn't exist", tblName))
+	}
+	view, err := GetViewDef(tableDef)
+	if err != nil {
+		return nil, err
+	}
+	mview, err := GetMaterializedViewDef(tableDef)
+	if err != nil {
+		return nil, err
+	}
+	var cols []string
+	for _, col := range tableDef.Cols {
+		if !strings.HasPrefix(col.Name, MaterializedViewColumnPrefix) {
+			cols = append(cols, "`"+col.Name+"`")
+		}
+	}
+	var createSql string
+	switch {
+	case view != nil:
+		createSql = fmt.Sprintf("CREATE VIEW `%s` (%s) AS %s", tblName, strings.Join(cols, ", "), view.Stmt)
+	case mview != nil:
+		createSql = fmt.Sprintf("CREATE MATERIALIZED VIEW `%s` (%s) AS %s", tblName, strings.Join(cols, ", "), mview.Stmt)
+	default:
+		return nil, errors.New(errno.WrongObjectType, fmt.Sprintf("'%v.%v' is not a view", dbName, tblName))
+	}
+	sql := fmt.Sprintf("SELECT '%s' AS `View`, '%s' AS `Create View`", quoteString(tblName), quoteString(createSql))
+	return returnByRewriteSql(ctx, sql, plan.DataDefinition_SHOW_CREATEVIEW)
+}
+
+// buildView expands the view by building its select statement, the result columns are renamed
+// to the columns of the view. It fails if the objects referenced by the view are changed.
+func buildView(tableDef *TableDef, view *ViewDef, ctx CompilerContext, query *Query) (int32, error) {
+	vctx, err := newViewContext(ctx, view.Database, tableDef.Name)
+	if err != nil {
+		return 0, err
+	}
+	invalid := func(err error) error {
+		return errors.New(errno.InvalidObjectDefinition, fmt.Sprintf("view '%v.%v' references invalid table(s) or column(s) or function(s): %v", view.Database, tableDef.Name, err))
+	}
+	stmt, err := getRewriteSqlStmt(view.Stmt)
+	if err != nil {
+		return 0, invalid(err)
+	}
+	sel, ok := stmt.(*tree.Select)
+	if !ok {
+		return 0, invalid(errors.New(errno.InvalidObjectDefinition, fmt.Sprintf("unexpected statement '%v'", view.Stmt)))
+	}
+	binderCtx := &BinderContext{
+		columnAlias: make(map[string]*Expr),
+	}
+	nodeId, err := buildSelect(sel, vctx, query, binderCtx)
+	if err != nil {
+		return 0, invalid(err)
+	}
+	node := query.Nodes[nodeId]
+	if len(node.ProjectList) != len(tableDef.Cols) {
+		return 0, invalid(errors.New(errno.InvalidObjectDefinition, fmt.Sprintf("the select statement returns %v columns, %v expected", len(node.ProjectList), len(tableDef.Cols))))
+	}
+	for i, expr := range node.ProjectList {
+		expr.ColName = tableDef.Cols[i].Name
+	}
+	return nodeId, nil
+}
+
+// buildCreateMaterializedView checks that the view aggregates a single table by aggregates which
+// can be maintained incrementally: COUNT, SUM and AVG of numbers. The rows deleted from the table
+// are subtracted from the state of these aggregates, which is kept by the hidden columns of the view.
+func buildCreateMaterializedView(stmt *tree.CreateView, dbName, viewStmt string, qry *Query, cols []*ColDef) (*Plan, error) {
+	viewName := string(stmt.Name.ObjectName)
+	unsupported := func(reason string) error {
+		return errors.New(errno.FeatureNotSupported, fmt.Sprintf("materialized view '%v' can not be maintained: %v", viewName, reason))
+	}
+	clause, ok := stmt.AsSource.Select.(*tree.SelectClause)
+	if !ok || stmt.AsSource.With != nil || stmt.AsSource.OrderBy != nil || stmt.AsSource.Limit != nil {
+		return nil, unsupported("the statement must be a single select without WITH, ORDER BY or LIMIT")
+	}
+	if len(clause.GroupBy) == 0 || clause.Having != nil {
+		return nil, unsupported("the select must have GROUP BY and no HAVING")
+	}
+	for _, expr := range clause.Exprs {
+		if f, ok := expr.Expr.(*tree.FuncExpr); ok && f.Type == tree.FUNC_TYPE_DISTINCT {
+			return nil, unsupported("the aggregates can not be DISTINCT")
+		}
+	}
+	if len(qry.Nodes) != 2 || len(qry.Steps) != 1 || qry.Steps[0] != 1 ||
+		qry.Nodes[0].NodeType != plan.Node_TABLE_SCAN || qry.Nodes[1].NodeType != plan.Node_AGG {
+		return nil, unsupported("the select must aggregate a single table")
+	}
+	scan, agg := qry.Nodes[0], qry.Nodes[1]
+	if mview, err := GetMaterializedViewDef(scan.TableDef); err != nil || mview != nil {
+		return nil, unsupported("the table aggregated can not be a materialized view")
+	}
+	if scan.ObjRef.SchemaName != dbName {
+		return nil, unsupported("the table aggregated must be in the database of the view")
+	}
+	for i, expr := range agg.ProjectList {
+		if _, ok := expr.Expr.(*plan.Expr_Col); !ok {
+			return nil, unsupported("the columns must be the group by expressions or the aggregates")
+		}
+		expr.ColName = cols[i].Name
+	}
+	for _, col := range cols {
+		// the results of the expressions have no sizes, but the columns of the view are stored
+		if col.Typ.Size == 0 {
+			if col.Typ.Id == plan.Type_BOOL {
+				col.Typ.Size = 1
+			} else {
+				col.Typ.Size = int32(types.T(col.Typ.Id).TypeLen())
+			}
+		}
+		if strings.HasPrefix(strings.ToLower(col.Name), MaterializedViewColumnPrefix) {
+			return nil, errors.New(errno.InvalidColumnReference, fmt.Sprintf("column name '%v' is reserved by materialized views", col.Name))
+		}
+	}
+
+	cols = append(cols,
+		&ColDef{
+			Name: MaterializedViewKeyColumn,
+			Alg:  plan.CompressType_Lz4,
+			Typ:  &plan.Type{Id: plan.Type_VARCHAR, Width: math.MaxInt32, Size: 24},
+		},
+		&ColDef{
+			Name: MaterializedViewCountColumn,
+			Alg:  plan.CompressType_Lz4,
+			Typ:  &plan.Type{Id: plan.Type_INT64, Size: 8},
+		},
+	)
+	for i, expr := range agg.AggList {
+		typ, ok := materializedViewStateType(expr)
+		if !ok {
+			return nil, unsupported(fmt.Sprintf("'%v' is not COUNT, SUM or AVG of numbers", expr.ColName))
+		}
+		value, nulls := MaterializedViewStateColumns(i)
+		cols = append(cols,
+			&ColDef{Name: value, Alg: plan.CompressType_Lz4, Typ: typ},
+			&ColDef{Name: nulls, Alg: plan.CompressType_Lz4, Typ: &plan.Type{Id: plan.Type_INT64, Size: 8}},
+		)
+	}
+
+	// the filter of the table is kept by the aggregation, which is the only node kept
+	columns := make([]string, len(scan.TableDef.Cols))
+	for i, col := range scan.TableDef.Cols {
+		columns[i] = col.Name
+	}
+	agg.WhereList = scan.WhereList
+	agg.Children = nil
+	aggData, err := proto.Marshal(agg)
+	if err != nil {
+		return nil, err
+	}
+	data, err := json.Marshal(&MaterializedViewDef{
+		Stmt:     viewStmt,
+		Database: dbName,
+		Table:    scan.TableDef.Name,
+		Columns:  columns,
+		Agg:      aggData,
+	})
+	if err != nil {
+		return nil, err
+	}
+	createTable := &plan.CreateTable{
+		IfNotExists: stmt.IfNotExists,
+		Database:    dbName,
+		TableDef: &TableDef{
+			Name: viewName,
+			Cols: cols,
+			Defs: []*plan.TableDef_DefType{
+				{
+					Def: &plan.TableDef_DefType_Pk{
+						Pk: &plan.PrimaryKeyDef{
+							Names: []string{MaterializedViewKeyColumn},
+						},
+					},
+				},
+				{
+					Def: &plan.TableDef_DefType_Properties{
+						Properties: &plan.PropertiesDef{
+							Properties: []*plan.Property{
+								{
+									Key:   MaterializedViewKey,
+									Value: string(data),
+								},
+								{
+									Key:   MaterializedViewSourceKey,
+									Value: scan.TableDef.Name,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	return &Plan{
+		Plan: &plan.Plan_Ddl{
+			Ddl: &plan.DataDefinition{
+				DdlType: plan.DataDefinition_CREATE_TABLE,
+				Definition: &plan.DataDefinition_CreateTable{
+					CreateTable: createTable,
+				},
+			},
+		},
+	}, nil
+}
+
+// materializedViewStateType returns the type of the state of the aggregate kept by a materialized view,
+// which is the type of the values of its ring.
+func materializedViewStateType(expr *Expr) (*plan.Type, bool) {
+	f, ok := expr.Expr.(*plan.Expr_F)
+	if !ok || len(f.F.Args) != 1 {
+		return nil, false
+	}
+	arg := f.F.Args[0].Typ.GetId()
+	switch f.F.Func.GetObjName() {
+	case "count", "starcount":
+		return &plan.Type{Id: plan.Type_INT64, Size: 8}, true
+	case "sum":
+		switch arg {
+		case plan.Type_INT8, plan.Type_INT16, plan.Type_INT32, plan.Type_INT64:
+			return &plan.Type{Id: plan.Type_INT64, Size: 8}, true
+		case plan.Type_UINT8, plan.Type_UINT16, plan.Type_UINT32, plan.Type_UINT64:
+			return &plan.Type{Id: plan.Type_UINT64, Size: 8}, true
+		case plan.Type_FLOAT32, plan.Type_FLOAT64:
+			return &plan.Type{Id: plan.Type_FLOAT64, Size: 8}, true
+		}
+	case "avg":
+		switch arg {
+		case plan.Type_INT8, plan.Type_INT16, plan.Type_INT32, plan.Type_INT64,
+			plan.Type_UINT8, plan.Type_UINT16, plan.Type_UINT32, plan.Type_UINT64,
+			plan.Type_FLOAT32, plan.Type_FLOAT64:
+			return &plan.Type{Id: plan.Type_FLOAT64, Size: 8}, true
+		}
+	}
+	return nil, false
+}
+
+// MaterializedViewStateColumns returns the hidden columns keeping the state of the i-th aggregate
+// of a materialized view, the value and the number of the null arguments of its ring.
+func MaterializedViewStateColumns(i int) (string, string) {
+	return fmt.Sprintf("%svalue_%d", MaterializedViewColumnPrefix, i), fmt.Sprintf("%snulls_%d", MaterializedViewColumnPrefix, i)
+}
+
+// AggNode returns the aggregation of the materialized view
+func (view *MaterializedViewDef) AggNode() (*Node, error) {
+	node := &Node{}
+	if err := proto.Unmarshal(view.Agg, node); err != nil {
+		return nil, errors.New(errno.DataException, fmt.Sprintf("invalid definition of the materialized view on '%v.%v'", view.Database, view.Table))
+	}
+	return node, nil
+}
+
+// hideMaterializedViewColumns returns the table without the hidden columns if it is a materialized view
+func hideMaterializedViewColumns(tableDef *TableDef) (*TableDef, error) {
+	mview, err := GetMaterializedViewDef(tableDef)
+	if err != nil || mview == nil {
+		return tableDef, err
+	}
+	visible := proto.Clone(tableDef).(*TableDef)
+	visible.Cols = visible.Cols[:0]
+	for _, col := range tableDef.Cols {
+		if !strings.HasPrefix(col.Name, MaterializedViewColumnPrefix) {
+			visible.Cols = append(visible.Cols, proto.Clone(col).(*ColDef))
+		}
+	}
+	return visible, nil
+}
+
+// quoteString escapes the string to be used as a string literal
+func quoteString(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(s)
+}
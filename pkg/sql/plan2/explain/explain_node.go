@@ -16,6 +16,7 @@ package explain
 
 import (
 	"strconv"
+	"strings"
 
 	"github.com/matrixorigin/matrixone/pkg/errno"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
@@ -67,7 +68,14 @@ func (ndesc *NodeDescribeImpl) GetNodeBasicInfo(options *ExplainOptions) (string
 	case plan.Node_AGG:
 		pname = "Aggregate"
 	case plan.Node_JOIN:
-		pname = "Join"
+		switch ndesc.Node.JoinAlgo {
+		case plan.Node_MERGE:
+			pname = "Merge Join"
+		case plan.Node_INDEX:
+			pname = "Index Join"
+		default:
+			pname = "Join"
+		}
 	case plan.Node_SAMPLE:
 		pname = "Sample"
 	case plan.Node_SORT:
@@ -112,6 +120,8 @@ func (ndesc *NodeDescribeImpl) GetNodeBasicInfo(options *ExplainOptions) (string
 			fallthrough
 		case plan.Node_MATERIAL_SCAN:
 			fallthrough
+		case plan.Node_SINK_SCAN:
+			fallthrough
 		case plan.Node_INSERT:
 			fallthrough
 		case plan.Node_UPDATE:
@@ -133,8 +143,6 @@ func (ndesc *NodeDescribeImpl) GetNodeBasicInfo(options *ExplainOptions) (string
 			fallthrough
 		case plan.Node_SINK:
 			fallthrough
-		case plan.Node_SINK_SCAN:
-			fallthrough
 		case plan.Node_AGG:
 			fallthrough
 		case plan.Node_JOIN:
@@ -233,6 +241,20 @@ func (ndesc *NodeDescribeImpl) GetExtraInfo(options *ExplainOptions) ([]string,
 		lines = append(lines, filterInfo)
 	}
 
+	// Get Sample info
+	if ndesc.Node.NodeType == plan.Node_SAMPLE {
+		lines = append(lines, "Sample: "+ndesc.Node.ExtraOptions)
+	}
+
+	// Get Table Function info
+	if ndesc.Node.NodeType == plan.Node_FUNCTION_SCAN {
+		argsInfo, err := NewExprListDescribeImpl(ndesc.Node.TableFuncArgs).GetDescription(options)
+		if err != nil {
+			return nil, err
+		}
+		lines = append(lines, "Table Function: "+ndesc.Node.TableDef.GetName()+"("+strings.TrimSpace(argsInfo)+")")
+	}
+
 	// Get Limit And Offset info
 	if ndesc.Node.Limit != nil {
 		var temp string
@@ -396,12 +418,12 @@ func (c *CostDescribeImpl) GetDescription(options *ExplainOptions) (string, erro
 	if c.Cost == nil {
 		result = " (cost=%.2f..%.2f rows=%.2f ndv=%.2f rowsize=%.f)"
 	} else {
-		result = "(cost=" +
+		result = " (cost=" +
 			strconv.FormatFloat(c.Cost.Start, 'f', 2, 64) +
 			".." + strconv.FormatFloat(c.Cost.Total, 'f', 2, 64) +
 			" card=" + strconv.FormatFloat(c.Cost.Card, 'f', 2, 64) +
 			" ndv=" + strconv.FormatFloat(c.Cost.Ndv, 'f', 2, 64) +
-			" rowsize=" + strconv.FormatFloat(c.Cost.Rowsize, 'f', 0, 64)
+			" rowsize=" + strconv.FormatFloat(c.Cost.Rowsize, 'f', 0, 64) + ")"
 	}
 
 	return result, nil
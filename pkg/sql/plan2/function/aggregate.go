@@ -15,6 +15,9 @@
 package function
 
 import (
+	"github.com/matrixorigin/matrixone/pkg/container/ring/approxpct"
+	"github.com/matrixorigin/matrixone/pkg/container/ring/moment"
+	"github.com/matrixorigin/matrixone/pkg/container/ring/percentile"
 	"github.com/matrixorigin/matrixone/pkg/container/ring/stddevpop"
 	"github.com/matrixorigin/matrixone/pkg/container/ring/variance"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
@@ -462,7 +465,7 @@ var aggregates = map[int][]Function{
 			Layout:        STANDARD_FUNCTION,
 			Args:          []types.T{types.T_decimal64},
 			TypeCheckFn:   strictTypeCheck,
-			ReturnTyp:     types.T_decimal64,
+			ReturnTyp:     types.T_decimal128,
 			AggregateInfo: aggregate.Sum,
 		},
 		{
@@ -482,12 +485,30 @@ var aggregates = map[int][]Function{
 			Layout:    STANDARD_FUNCTION,
 			ReturnTyp: types.T_float64,
 			TypeCheckFn: func(inputTypes []types.T, _ []types.T) (match bool) {
-				if len(inputTypes) == 1 && isNumberType(inputTypes[0]) {
+				if len(inputTypes) == 1 && isNumberType(inputTypes[0]) && !isDecimalType(inputTypes[0]) {
 					return true
 				}
 				return false
 			},
-			AggregateInfo: aggregate.Sum,
+			AggregateInfo: aggregate.Avg,
+		},
+		{
+			Index:         1,
+			Flag:          plan.Function_AGG,
+			Layout:        STANDARD_FUNCTION,
+			Args:          []types.T{types.T_decimal64},
+			TypeCheckFn:   strictTypeCheck,
+			ReturnTyp:     types.T_decimal128,
+			AggregateInfo: aggregate.Avg,
+		},
+		{
+			Index:         2,
+			Flag:          plan.Function_AGG,
+			Layout:        STANDARD_FUNCTION,
+			Args:          []types.T{types.T_decimal128},
+			TypeCheckFn:   strictTypeCheck,
+			ReturnTyp:     types.T_decimal128,
+			AggregateInfo: aggregate.Avg,
 		},
 	},
 	COUNT: {
@@ -616,4 +637,175 @@ var aggregates = map[int][]Function{
 			AggregateInfo: aggregate.ApproxCountDistinct,
 		},
 	},
+	APPROX_COUNT_DISTINCT_ERROR: {
+		{
+			Index:     0,
+			Flag:      plan.Function_AGG,
+			Layout:    STANDARD_FUNCTION,
+			ReturnTyp: types.T_float64,
+			TypeCheckFn: func(inputTypes []types.T, _ []types.T) (match bool) {
+				return len(inputTypes) == 1
+			},
+			AggregateInfo: aggregate.ApproxCountDistinctError,
+		},
+	},
+	APPROX_PERCENTILE: {
+		{
+			Index:         0,
+			Flag:          plan.Function_AGG,
+			Layout:        STANDARD_FUNCTION,
+			ReturnTyp:     types.T_float64,
+			TypeCheckFn:   approxPercentileTypeCheck,
+			AggregateInfo: aggregate.ApproxPercentile,
+		},
+	},
+	APPROX_PERCENTILE_ERROR: {
+		{
+			Index:         0,
+			Flag:          plan.Function_AGG,
+			Layout:        STANDARD_FUNCTION,
+			ReturnTyp:     types.T_float64,
+			TypeCheckFn:   approxPercentileTypeCheck,
+			AggregateInfo: aggregate.ApproxPercentileError,
+		},
+	},
+	MEDIAN: {
+		{
+			Index:     0,
+			Flag:      plan.Function_AGG,
+			Layout:    STANDARD_FUNCTION,
+			ReturnTyp: types.T_float64,
+			TypeCheckFn: func(inputTypes []types.T, _ []types.T) (match bool) {
+				if len(inputTypes) == 1 {
+					_, err := percentile.NewPercentileRingWithTypeCheck(types.Type{Oid: inputTypes[0]}, 0.5, false)
+					return err == nil
+				}
+				return false
+			},
+			AggregateInfo: aggregate.Median,
+		},
+	},
+	PERCENTILE_CONT: {
+		{
+			Index:         0,
+			Flag:          plan.Function_AGG,
+			Layout:        STANDARD_FUNCTION,
+			ReturnTyp:     types.T_float64,
+			TypeCheckFn:   percentileTypeCheck,
+			AggregateInfo: aggregate.PercentileCont,
+		},
+	},
+	PERCENTILE_DISC: {
+		{
+			Index:         0,
+			Flag:          plan.Function_AGG,
+			Layout:        STANDARD_FUNCTION,
+			ReturnTyp:     types.T_float64,
+			TypeCheckFn:   percentileTypeCheck,
+			AggregateInfo: aggregate.PercentileDisc,
+		},
+	},
+	VAR_SAMPLE: {
+		{
+			Index:         0,
+			Flag:          plan.Function_AGG,
+			Layout:        STANDARD_FUNCTION,
+			ReturnTyp:     types.T_float64,
+			TypeCheckFn:   momentTypeCheck,
+			AggregateInfo: aggregate.VarSample,
+		},
+	},
+	STDDEV_SAMPLE: {
+		{
+			Index:         0,
+			Flag:          plan.Function_AGG,
+			Layout:        STANDARD_FUNCTION,
+			ReturnTyp:     types.T_float64,
+			TypeCheckFn:   momentTypeCheck,
+			AggregateInfo: aggregate.StdDevSample,
+		},
+	},
+	COVAR_POP: {
+		{
+			Index:         0,
+			Flag:          plan.Function_AGG,
+			Layout:        STANDARD_FUNCTION,
+			ReturnTyp:     types.T_float64,
+			TypeCheckFn:   binaryMomentTypeCheck,
+			AggregateInfo: aggregate.CovarPop,
+		},
+	},
+	COVAR_SAMPLE: {
+		{
+			Index:         0,
+			Flag:          plan.Function_AGG,
+			Layout:        STANDARD_FUNCTION,
+			ReturnTyp:     types.T_float64,
+			TypeCheckFn:   binaryMomentTypeCheck,
+			AggregateInfo: aggregate.CovarSample,
+		},
+	},
+	CORR: {
+		{
+			Index:         0,
+			Flag:          plan.Function_AGG,
+			Layout:        STANDARD_FUNCTION,
+			ReturnTyp:     types.T_float64,
+			TypeCheckFn:   binaryMomentTypeCheck,
+			AggregateInfo: aggregate.Corr,
+		},
+	},
+}
+
+// approxPercentileTypeCheck checks the arguments of approx_percentile(x, p), the percentile p should be a number
+// and it is checked to be a constant in [0, 1] while building the plan.
+func approxPercentileTypeCheck(inputTypes []types.T, _ []types.T) (match bool) {
+	if len(inputTypes) == 2 {
+		switch inputTypes[1] {
+		case types.T_float64, types.T_int64:
+		default:
+			return false
+		}
+		_, err := approxpct.NewApproxPercentileRingWithTypeCheck(types.Type{Oid: inputTypes[0]}, 0, false)
+		return err == nil
+	}
+	return false
+}
+
+// percentileTypeCheck checks the arguments of percentile_cont(x, p) and percentile_disc(x, p),
+// which are the same as those of approx_percentile.
+func percentileTypeCheck(inputTypes []types.T, _ []types.T) (match bool) {
+	if len(inputTypes) == 2 {
+		switch inputTypes[1] {
+		case types.T_float64, types.T_int64:
+		default:
+			return false
+		}
+		_, err := percentile.NewPercentileRingWithTypeCheck(types.Type{Oid: inputTypes[0]}, 0, false)
+		return err == nil
+	}
+	return false
+}
+
+// momentTypeCheck checks the argument of var_samp(x) and stddev_samp(x)
+func momentTypeCheck(inputTypes []types.T, _ []types.T) (match bool) {
+	if len(inputTypes) == 1 {
+		_, err := moment.NewMomentRingWithTypeCheck(types.Type{Oid: inputTypes[0]}, moment.VarSample)
+		return err == nil
+	}
+	return false
+}
+
+// binaryMomentTypeCheck checks the arguments of covar_pop(x, y), covar_samp(x, y) and corr(x, y),
+// both of them should be numbers.
+func binaryMomentTypeCheck(inputTypes []types.T, _ []types.T) (match bool) {
+	if len(inputTypes) == 2 {
+		for _, typ := range inputTypes {
+			if _, err := moment.NewMomentRingWithTypeCheck(types.Type{Oid: typ}, moment.Corr); err != nil {
+				return false
+			}
+		}
+		return true
+	}
+	return false
 }
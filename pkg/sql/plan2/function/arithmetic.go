@@ -0,0 +1,94 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// arithmeticFn returns the binary arithmetic of the overload package, the result is null if any of
+// the operands is the constant null.
+func arithmeticFn(op int, ret types.T) func(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return func(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+		if proc == nil {
+			// the operation allocates the result from the memory of the process
+			return nil, errors.New(errno.InternalError, "arithmetic can not be evaluated without a process")
+		}
+		lv, rv := vs[0], vs[1]
+		if isNullConst(lv) || isNullConst(rv) {
+			return newNullConst(ret, lv, rv), nil
+		}
+		defer retain(lv, rv)()
+		vec, err := overload.BinaryEval(op, lv.Typ.Oid, rv.Typ.Oid, lv.IsConst, rv.IsConst, lv, rv, proc)
+		if err != nil {
+			return nil, err
+		}
+		if lv.IsConst && rv.IsConst {
+			vec.IsConst = true
+			vec.Length = lv.Length
+		}
+		return vec, nil
+	}
+}
+
+// unaryMinusFn implements the negation of the overload package
+func unaryMinusFn(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	if proc == nil {
+		return nil, errors.New(errno.InternalError, "arithmetic can not be evaluated without a process")
+	}
+	v := vs[0]
+	if isNullConst(v) {
+		return newNullConst(v.Typ.Oid, v), nil
+	}
+	defer retain(v)()
+	vec, err := overload.UnaryEval(overload.UnaryMinus, v.Typ.Oid, v.IsConst, v, proc)
+	if err != nil {
+		return nil, err
+	}
+	if v.IsConst {
+		vec.IsConst = true
+		vec.Length = v.Length
+	}
+	return vec, nil
+}
+
+// retain keeps the operands from being modified in place or released by the overload package,
+// which takes a vector whose Ref is 0 or 1 as owned by the operation. The returned function
+// restores the Ref of the operands.
+func retain(vs ...*vector.Vector) func() {
+	for _, v := range vs {
+		v.Ref += 2
+	}
+	return func() {
+		for _, v := range vs {
+			v.Ref -= 2
+		}
+	}
+}
+
+// newNullConst returns the constant null of the type, whose length is the row count of the operands
+func newNullConst(typ types.T, vs ...*vector.Vector) *vector.Vector {
+	length, _ := rowCount(vs)
+	vec := vector.NewConst(types.Type{Oid: typ, Size: int32(typ.TypeLen())})
+	nulls.Add(vec.Nsp, 0)
+	vec.Length = length
+	return vec
+}
@@ -116,4 +116,114 @@ var builtins = map[int][]Function{
 			Fn:          nil,
 		},
 	},
+	JSON_EXTRACT: {
+		{
+			Index:       0,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        nil,
+			ReturnTyp:   types.T_json,
+			TypeCheckFn: jsonExtractTypeCheck,
+			Fn:          jsonExtract,
+		},
+	},
+	JSON_UNQUOTE: {
+		{
+			Index:       0,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        nil,
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: jsonUnquoteTypeCheck,
+			Fn:          jsonUnquote,
+		},
+	},
+	UUID: {
+		{
+			Index:       0,
+			Flag:        plan.Function_NONE,
+			Layout:      STANDARD_FUNCTION,
+			Args:        nil,
+			ReturnTyp:   types.T_uuid,
+			TypeCheckFn: uuidTypeCheck,
+			Fn:          generateUuid,
+		},
+	},
+	UUID_TO_BIN: {
+		{
+			Index:       0,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        nil,
+			ReturnTyp:   types.T_uuid,
+			TypeCheckFn: uuidToBinTypeCheck,
+			Fn:          uuidToBin,
+		},
+	},
+	BIN_TO_UUID: {
+		{
+			Index:       0,
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        nil,
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: binToUuidTypeCheck,
+			Fn:          binToUuid,
+		},
+	},
+	REGEXP:         regexpOverloads([]types.T{types.T_varchar, types.T_varchar}, 2, types.T_bool, regexpLike),
+	REGEXP_LIKE:    regexpOverloads([]types.T{types.T_varchar, types.T_varchar, types.T_varchar}, 2, types.T_bool, regexpLike),
+	REGEXP_INSTR:   regexpOverloads([]types.T{types.T_varchar, types.T_varchar, types.T_int64, types.T_int64, types.T_int64, types.T_varchar}, 2, types.T_int64, regexpInstr),
+	REGEXP_SUBSTR:  regexpOverloads([]types.T{types.T_varchar, types.T_varchar, types.T_int64, types.T_int64, types.T_varchar}, 2, types.T_varchar, regexpSubstr),
+	REGEXP_REPLACE: regexpOverloads([]types.T{types.T_varchar, types.T_varchar, types.T_varchar, types.T_int64, types.T_int64, types.T_varchar}, 3, types.T_varchar, regexpReplace),
+
+	// date and time functions
+	DATE_FORMAT: {
+		timeOverload(0, []types.T{types.T_datetime, types.T_varchar}, types.T_varchar, timeTypeCheck(isTimeType, isStringType), dateFormat),
+	},
+	STR_TO_DATE: {
+		timeOverload(0, []types.T{types.T_varchar, types.T_varchar}, types.T_datetime, timeTypeCheck(isStringType, isStringType), strToDate),
+	},
+	DATEDIFF: {
+		timeOverload(0, []types.T{types.T_date, types.T_date}, types.T_int64, timeTypeCheck(isTimeType, isTimeType), dateDiff),
+	},
+	TIMESTAMPDIFF: {
+		timeOverload(0, []types.T{types.T_varchar, types.T_datetime, types.T_datetime}, types.T_int64, timeTypeCheck(isStringType, isTimeType, isTimeType), timestampDiff),
+	},
+	TIMESTAMPADD: {
+		timeOverload(0, []types.T{types.T_varchar, types.T_int64, types.T_datetime}, types.T_datetime, timeTypeCheck(isStringType, isIntegerType, isTimeType), timestampAdd),
+	},
+	UNIX_TIMESTAMP: {
+		timeOverload(0, nil, types.T_int64, noArgTypeCheck, unixTimestampNow),
+		timeOverload(1, []types.T{types.T_timestamp}, types.T_int64, timeTypeCheck(isTimeType), unixTimestamp),
+	},
+	FROM_UNIXTIME: {
+		timeOverload(0, []types.T{types.T_int64}, types.T_datetime, timeTypeCheck(isIntegerType), fromUnixtime),
+		timeOverload(1, []types.T{types.T_int64, types.T_varchar}, types.T_varchar, timeTypeCheck(isIntegerType, isStringType), fromUnixtimeFormat),
+	},
+	CURRENT_TIMESTAMP: {
+		timeOverload(0, nil, types.T_datetime, noArgTypeCheck, now),
+		timeOverload(1, []types.T{types.T_int64}, types.T_datetime, precisionTypeCheck, nowPrecision),
+	},
+	CURRENT_DATE: {
+		timeOverload(0, nil, types.T_date, noArgTypeCheck, curdate),
+	},
+	LAST_DAY: {
+		timeOverload(0, []types.T{types.T_date}, types.T_date, timeTypeCheck(isTimeType), lastDay),
+	},
+	WEEK: {
+		timeOverload(0, []types.T{types.T_date, types.T_int64}, types.T_int64, weekTypeCheck, week),
+	},
+	YEARWEEK: {
+		timeOverload(0, []types.T{types.T_date, types.T_int64}, types.T_int64, weekTypeCheck, yearWeek),
+	},
+	QUARTER: {
+		timeOverload(0, []types.T{types.T_date}, types.T_int64, timeTypeCheck(isTimeType), quarter),
+	},
+	DATE_TRUNC: {
+		timeOverload(0, []types.T{types.T_varchar, types.T_datetime}, types.T_datetime, timeTypeCheck(isStringType, isTimeType), dateTrunc),
+	},
+	CONVERT_TZ: {
+		timeOverload(0, []types.T{types.T_datetime, types.T_varchar, types.T_varchar}, types.T_datetime, timeTypeCheck(isTimeType, isStringType, isStringType), convertTz),
+	},
 }
@@ -0,0 +1,51 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// castFn returns the cast to the type by the type casts of the overload package
+func castFn(to types.T) func(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return func(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+		v := vs[0]
+		if isNullConst(v) {
+			return newNullConst(to, v), nil
+		}
+		if v.Typ.Oid == to {
+			return v, nil
+		}
+		if proc == nil {
+			return nil, errors.New(errno.InternalError, "cast can not be evaluated without a process")
+		}
+		typ := types.Type{Oid: to, Size: int32(to.TypeLen())}
+		defer retain(v)()
+		vec, err := overload.BinaryEval(overload.Typecast, v.Typ.Oid, to, v.IsConst, false, v, vector.New(typ), proc)
+		if err != nil {
+			return nil, err
+		}
+		if v.IsConst {
+			vec.IsConst = true
+			vec.Length = v.Length
+		}
+		return vec, nil
+	}
+}
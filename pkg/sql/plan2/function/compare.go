@@ -0,0 +1,281 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"golang.org/x/exp/constraints"
+)
+
+var boolType = types.Type{Oid: types.T_bool, Size: 1}
+
+// genericOperatorFn returns the implementation of the operators which have no implementation
+// of their own overloads, or nil if the operator can not be evaluated yet.
+func genericOperatorFn(fid int, f Function) func(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	switch fid {
+	case EQUAL:
+		return compareFn(func(c int) bool { return c == 0 })
+	case NOT_EQUAL:
+		return compareFn(func(c int) bool { return c != 0 })
+	case GREAT_THAN:
+		return compareFn(func(c int) bool { return c > 0 })
+	case GREAT_EQUAL:
+		return compareFn(func(c int) bool { return c >= 0 })
+	case LESS_THAN:
+		return compareFn(func(c int) bool { return c < 0 })
+	case LESS_EQUAL:
+		return compareFn(func(c int) bool { return c <= 0 })
+	case AND:
+		return andFn
+	case OR:
+		return orFn
+	case NOT:
+		return notFn
+	case PLUS:
+		return arithmeticFn(overload.Plus, f.ReturnTyp)
+	case MINUS:
+		return arithmeticFn(overload.Minus, f.ReturnTyp)
+	case MULTI:
+		return arithmeticFn(overload.Mult, f.ReturnTyp)
+	case DIV:
+		return arithmeticFn(overload.Div, f.ReturnTyp)
+	case MOD:
+		return arithmeticFn(overload.Mod, f.ReturnTyp)
+	case UNARY_MINUS:
+		return unaryMinusFn
+	case CAST:
+		if len(f.Args) == 2 {
+			return castFn(f.Args[1])
+		}
+	}
+	return nil
+}
+
+// compareFn returns the comparison of two vectors of the same type, the result
+// is test of the order of the values, and null if any of the values is null.
+func compareFn(test func(int) bool) func(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return func(vs []*vector.Vector, _ *process.Process) (*vector.Vector, error) {
+		length, isConst := rowCount(vs)
+		rows := length
+		if isConst {
+			rows = 1
+		}
+		values := make([]bool, rows)
+		isNull := make([]bool, rows)
+		if isNullConst(vs[0]) || isNullConst(vs[1]) {
+			for i := range isNull {
+				isNull[i] = true
+			}
+			return newBoolResult(values, isNull, isConst, length)
+		}
+		cmp, err := comparer(vs[0], vs[1])
+		if err != nil {
+			return nil, err
+		}
+		for i := 0; i < rows; i++ {
+			l, r := valuePosition(vs[0], i), valuePosition(vs[1], i)
+			if nulls.Contains(vs[0].Nsp, uint64(l)) || nulls.Contains(vs[1].Nsp, uint64(r)) {
+				isNull[i] = true
+				continue
+			}
+			values[i] = test(cmp(l, r))
+		}
+		return newBoolResult(values, isNull, isConst, length)
+	}
+}
+
+// comparer returns the function comparing the i-th value of lv with the j-th value of rv
+func comparer(lv, rv *vector.Vector) (func(i, j int) int, error) {
+	switch l := lv.Col.(type) {
+	case []bool:
+		r := rv.Col.([]bool)
+		return func(i, j int) int {
+			switch {
+			case l[i] == r[j]:
+				return 0
+			case r[j]:
+				return -1
+			}
+			return 1
+		}, nil
+	case []int8:
+		return orderedComparer(l, rv.Col.([]int8)), nil
+	case []int16:
+		return orderedComparer(l, rv.Col.([]int16)), nil
+	case []int32:
+		return orderedComparer(l, rv.Col.([]int32)), nil
+	case []int64:
+		return orderedComparer(l, rv.Col.([]int64)), nil
+	case []uint8:
+		return orderedComparer(l, rv.Col.([]uint8)), nil
+	case []uint16:
+		return orderedComparer(l, rv.Col.([]uint16)), nil
+	case []uint32:
+		return orderedComparer(l, rv.Col.([]uint32)), nil
+	case []uint64:
+		return orderedComparer(l, rv.Col.([]uint64)), nil
+	case []float32:
+		return orderedComparer(l, rv.Col.([]float32)), nil
+	case []float64:
+		return orderedComparer(l, rv.Col.([]float64)), nil
+	case []types.Date:
+		return orderedComparer(l, rv.Col.([]types.Date)), nil
+	case []types.Datetime:
+		return orderedComparer(l, rv.Col.([]types.Datetime)), nil
+	case []types.Timestamp:
+		return orderedComparer(l, rv.Col.([]types.Timestamp)), nil
+	case []types.Time:
+		return orderedComparer(l, rv.Col.([]types.Time)), nil
+	case []types.YearValue:
+		return orderedComparer(l, rv.Col.([]types.YearValue)), nil
+	case []types.Decimal64:
+		r := rv.Col.([]types.Decimal64)
+		return func(i, j int) int {
+			return int(types.CompareDecimal64Decimal64(l[i], r[j], lv.Typ.Scale, rv.Typ.Scale))
+		}, nil
+	case []types.Decimal128:
+		r := rv.Col.([]types.Decimal128)
+		return func(i, j int) int {
+			return int(types.CompareDecimal128Decimal128(l[i], r[j], lv.Typ.Scale, rv.Typ.Scale))
+		}, nil
+	case []types.Uuid:
+		r := rv.Col.([]types.Uuid)
+		return func(i, j int) int {
+			return types.CompareUuid(l[i], r[j])
+		}, nil
+	case *types.Bytes:
+		r := rv.Col.(*types.Bytes)
+		return func(i, j int) int {
+			return bytes.Compare(l.Get(int64(i)), r.Get(int64(j)))
+		}, nil
+	}
+	return nil, errors.New(errno.FeatureNotSupported, fmt.Sprintf("comparison of type '%s' is not supported", lv.Typ))
+}
+
+func orderedComparer[T constraints.Ordered](l, r []T) func(i, j int) int {
+	return func(i, j int) int {
+		switch {
+		case l[i] < r[j]:
+			return -1
+		case l[i] > r[j]:
+			return 1
+		}
+		return 0
+	}
+}
+
+// andFn implements AND, false wins over null
+func andFn(vs []*vector.Vector, _ *process.Process) (*vector.Vector, error) {
+	return logicFn(vs, func(l, r, lNull, rNull bool) (bool, bool) {
+		if (!lNull && !l) || (!rNull && !r) {
+			return false, false
+		}
+		return true, lNull || rNull
+	})
+}
+
+// orFn implements OR, true wins over null
+func orFn(vs []*vector.Vector, _ *process.Process) (*vector.Vector, error) {
+	return logicFn(vs, func(l, r, lNull, rNull bool) (bool, bool) {
+		if (!lNull && l) || (!rNull && r) {
+			return true, false
+		}
+		return false, lNull || rNull
+	})
+}
+
+// notFn implements NOT, the negation of null is null
+func notFn(vs []*vector.Vector, _ *process.Process) (*vector.Vector, error) {
+	return logicFn(vs[:1], func(v, _, vNull, _ bool) (bool, bool) {
+		return !v, vNull
+	})
+}
+
+// logicFn evaluates the three valued logic of the boolean vectors, eval returns the
+// result and whether it is null from the values of the operands and their nullities.
+func logicFn(vs []*vector.Vector, eval func(l, r, lNull, rNull bool) (bool, bool)) (*vector.Vector, error) {
+	length, isConst := rowCount(vs)
+	rows := length
+	if isConst {
+		rows = 1
+	}
+	values := make([]bool, rows)
+	isNull := make([]bool, rows)
+	for i := 0; i < rows; i++ {
+		l, lNull := getBoolValue(vs[0], i)
+		r, rNull := l, lNull
+		if len(vs) > 1 {
+			r, rNull = getBoolValue(vs[1], i)
+		}
+		values[i], isNull[i] = eval(l, r, lNull, rNull)
+	}
+	return newBoolResult(values, isNull, isConst, length)
+}
+
+// getBoolValue returns the i-th value of a boolean vector, and true if it is null
+func getBoolValue(v *vector.Vector, i int) (bool, bool) {
+	if isNullConst(v) {
+		return false, true
+	}
+	i = valuePosition(v, i)
+	if nulls.Contains(v.Nsp, uint64(i)) {
+		return false, true
+	}
+	return v.Col.([]bool)[i], false
+}
+
+// isNullConst returns true if the vector is the constant null
+func isNullConst(v *vector.Vector) bool {
+	return v.IsConst && nulls.Contains(v.Nsp, 0)
+}
+
+// valuePosition returns the position of the i-th value, a constant has only one value
+func valuePosition(v *vector.Vector, i int) int {
+	if v.IsConst {
+		return 0
+	}
+	return i
+}
+
+// newBoolResult builds the result vector from the values, the values whose isNull is true are null.
+func newBoolResult(values []bool, isNull []bool, isConst bool, length int) (*vector.Vector, error) {
+	var vec *vector.Vector
+	if isConst {
+		vec = vector.NewConst(boolType)
+	} else {
+		vec = vector.New(boolType)
+	}
+	for i, null := range isNull {
+		if null {
+			nulls.Add(vec.Nsp, uint64(i))
+		}
+	}
+	if err := vector.Append(vec, values); err != nil {
+		return nil, err
+	}
+	if isConst {
+		vec.Length = length
+	}
+	return vec, nil
+}
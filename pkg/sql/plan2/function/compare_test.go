@@ -0,0 +1,154 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/stretchr/testify/require"
+)
+
+func makeInt64Vector(t *testing.T, vs []int64, nsp []uint64) *vector.Vector {
+	vec := vector.New(types.Type{Oid: types.T_int64, Size: 8})
+	require.NoError(t, vector.Append(vec, vs))
+	for _, i := range nsp {
+		nulls.Add(vec.Nsp, i)
+	}
+	return vec
+}
+
+func makeBoolVector(t *testing.T, vs []bool, nsp []uint64) *vector.Vector {
+	vec := vector.New(boolType)
+	require.NoError(t, vector.Append(vec, vs))
+	for _, i := range nsp {
+		nulls.Add(vec.Nsp, i)
+	}
+	return vec
+}
+
+func evalOperator(t *testing.T, name string, vs []*vector.Vector, proc *process.Process) *vector.Vector {
+	args := make([]types.T, len(vs))
+	for i, v := range vs {
+		args[i] = v.Typ.Oid
+	}
+	f, _, _, err := GetFunctionByName(name, args)
+	require.NoError(t, err)
+	rs, err := f.VecFn(vs, proc)
+	require.NoError(t, err)
+	return rs
+}
+
+func TestCompareOperator(t *testing.T) {
+	l := makeInt64Vector(t, []int64{1, 2, 3, 4}, []uint64{3})
+	r := makeInt64Vector(t, []int64{2, 2, 2, 2}, nil)
+	tests := []struct {
+		name string
+		want []bool
+	}{
+		{"=", []bool{false, true, false}},
+		{"<>", []bool{true, false, true}},
+		{">", []bool{false, false, true}},
+		{">=", []bool{false, true, true}},
+		{"<", []bool{true, false, false}},
+		{"<=", []bool{true, true, false}},
+	}
+	for _, tt := range tests {
+		rs := evalOperator(t, tt.name, []*vector.Vector{l, r}, nil)
+		require.Equal(t, tt.want, rs.Col.([]bool)[:3], tt.name)
+		// the comparison with null is null
+		require.True(t, nulls.Contains(rs.Nsp, 3), tt.name)
+	}
+
+	c := vector.NewConst(types.Type{Oid: types.T_int64, Size: 8})
+	c.Col = []int64{3}
+	c.Length = 4
+	rs := evalOperator(t, "=", []*vector.Vector{l, c}, nil)
+	require.Equal(t, []bool{false, false, true}, rs.Col.([]bool)[:3])
+
+	null := vector.NewConst(types.Type{Oid: types.T_int64, Size: 8})
+	nulls.Add(null.Nsp, 0)
+	null.Length = 4
+	rs = evalOperator(t, "=", []*vector.Vector{l, null}, nil)
+	for i := uint64(0); i < 4; i++ {
+		require.True(t, nulls.Contains(rs.Nsp, i))
+	}
+}
+
+func TestLogicOperator(t *testing.T) {
+	// every pair of true, false and null
+	l := makeBoolVector(t, []bool{true, true, true, false, false, false, false, false, false}, []uint64{6, 7, 8})
+	r := makeBoolVector(t, []bool{true, false, false, true, false, false, true, false, false}, []uint64{2, 5, 8})
+	tests := []struct {
+		name  string
+		want  []bool
+		isNul []bool
+	}{
+		{"and", []bool{true, false, true, false, false, false, true, false, true}, []bool{false, false, true, false, false, false, true, false, true}},
+		{"or", []bool{true, true, true, true, false, false, true, false, false}, []bool{false, false, false, false, false, true, false, true, true}},
+	}
+	for _, tt := range tests {
+		rs := evalOperator(t, tt.name, []*vector.Vector{l, r}, nil)
+		for i, null := range tt.isNul {
+			require.Equal(t, null, nulls.Contains(rs.Nsp, uint64(i)), "%s %d", tt.name, i)
+			if !null {
+				require.Equal(t, tt.want[i], rs.Col.([]bool)[i], "%s %d", tt.name, i)
+			}
+		}
+	}
+
+	rs := evalOperator(t, "not", []*vector.Vector{l}, nil)
+	require.Equal(t, []bool{false, false, false, true, true, true}, rs.Col.([]bool)[:6])
+	require.True(t, nulls.Contains(rs.Nsp, 6))
+}
+
+func TestArithmeticOperator(t *testing.T) {
+	proc := process.New(mheap.New(guest.New(1<<30, host.New(1<<30))))
+	l := makeInt64Vector(t, []int64{1, 2, 3}, []uint64{2})
+	r := makeInt64Vector(t, []int64{10, 20, 30}, nil)
+
+	rs := evalOperator(t, "+", []*vector.Vector{l, r}, proc)
+	require.Equal(t, []int64{11, 22}, rs.Col.([]int64)[:2])
+	require.True(t, nulls.Contains(rs.Nsp, 2))
+	// the operands are not released
+	require.Equal(t, []int64{1, 2, 3}, l.Col.([]int64))
+
+	rs = evalOperator(t, "unary_minus", []*vector.Vector{r}, proc)
+	require.Equal(t, []int64{-10, -20, -30}, rs.Col.([]int64))
+
+	require.Equal(t, []int64{10, 20, 30}, r.Col.([]int64))
+
+	// the arithmetic allocates from the process
+	f, _, _, err := GetFunctionByName("+", []types.T{types.T_int64, types.T_int64})
+	require.NoError(t, err)
+	_, err = f.VecFn([]*vector.Vector{l, r}, nil)
+	require.Error(t, err)
+}
+
+func TestCastOperator(t *testing.T) {
+	proc := process.New(mheap.New(guest.New(1<<30, host.New(1<<30))))
+	v := makeInt64Vector(t, []int64{1, 2}, []uint64{1})
+	to := vector.New(types.Type{Oid: types.T_float64, Size: 8})
+	rs := evalOperator(t, "cast", []*vector.Vector{v, to}, proc)
+	require.Equal(t, types.T_float64, rs.Typ.Oid)
+	require.Equal(t, float64(1), rs.Col.([]float64)[0])
+	require.True(t, nulls.Contains(rs.Nsp, 1))
+}
@@ -0,0 +1,583 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/calendar"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/dateformat"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/timeunit"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/unixtime"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+/*
+The arguments of the date and time functions may be dates, datetimes, timestamps or
+their strings, a timestamp is the wall clock in the time zone of the session, and a
+string which is not a valid datetime is null. The current time is the start time of
+the statement, so that all the rows of a statement get the same value, and the functions
+depending on the session can not be evaluated without the process.
+*/
+
+var (
+	dateType     = types.Type{Oid: types.T_date, Size: 4}
+	datetimeType = types.Type{Oid: types.T_datetime, Size: 8}
+)
+
+// isTimeType returns true if the type can be an argument of the date and time functions
+func isTimeType(t types.T) bool {
+	return t == types.T_date || t == types.T_datetime || t == types.T_timestamp || isStringType(t)
+}
+
+func isIntegerType(t types.T) bool {
+	switch t {
+	case types.T_int8, types.T_int16, types.T_int32, types.T_int64,
+		types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64, ScalarNull:
+		return true
+	}
+	return false
+}
+
+// timeTypeCheck returns a type check accepting the arguments of which check is true
+func timeTypeCheck(checks ...func(types.T) bool) func([]types.T, []types.T) bool {
+	return func(inputTypes []types.T, _ []types.T) bool {
+		if len(inputTypes) != len(checks) {
+			return false
+		}
+		for i, check := range checks {
+			if !check(inputTypes[i]) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// timeOverload returns an overload of the date and time functions with its own type check,
+// args are the usual types of the arguments to tell the overloads apart.
+func timeOverload(index int32, args []types.T, ret types.T, typeCheck func([]types.T, []types.T) bool, fn func([]*vector.Vector, *process.Process) (*vector.Vector, error)) Function {
+	return Function{
+		Index:       index,
+		Flag:        plan.Function_STRICT,
+		Layout:      STANDARD_FUNCTION,
+		Args:        args,
+		ReturnTyp:   ret,
+		TypeCheckFn: typeCheck,
+		Fn:          fn,
+	}
+}
+
+var (
+	weekTypeCheck = func(inputTypes []types.T, _ []types.T) bool {
+		return timeTypeCheck(isTimeType)(inputTypes, nil) || timeTypeCheck(isTimeType, isIntegerType)(inputTypes, nil)
+	}
+	noArgTypeCheck = func(inputTypes []types.T, _ []types.T) bool {
+		return len(inputTypes) == 0
+	}
+	// precisionTypeCheck accepts the fractional seconds precision of the current time
+	precisionTypeCheck = timeTypeCheck(isIntegerType)
+)
+
+// location returns the time zone of the session
+func location(proc *process.Process) (*time.Location, error) {
+	if proc == nil {
+		return nil, errors.New(errno.FeatureNotSupported, "the time zone of the session is unknown")
+	}
+	if proc.TimeZone == nil {
+		return time.Local, nil
+	}
+	return proc.TimeZone, nil
+}
+
+// currentTimestamp returns the start time of the statement
+func currentTimestamp(proc *process.Process) (types.Timestamp, error) {
+	if proc == nil {
+		return 0, errors.New(errno.FeatureNotSupported, "the current time is unknown")
+	}
+	return types.TimestampFromUnixMicro(proc.UnixTime / 1000), nil
+}
+
+// datetimeValues returns the values of a date and time argument as datetimes,
+// the length of them is rows and the constant is repeated.
+func datetimeValues(v *vector.Vector, rows int, proc *process.Process) ([]types.Datetime, *nulls.Nulls, error) {
+	rs := make([]types.Datetime, rows)
+	nsp := new(nulls.Nulls)
+	if isNullConst(v) {
+		for i := range rs {
+			nulls.Add(nsp, uint64(i))
+		}
+		return rs, nsp, nil
+	}
+	var loc *time.Location
+	if v.Typ.Oid == types.T_timestamp {
+		var err error
+		if loc, err = location(proc); err != nil {
+			return nil, nil, err
+		}
+	}
+	for i := range rs {
+		j := valuePosition(v, i)
+		if nulls.Contains(v.Nsp, uint64(j)) {
+			nulls.Add(nsp, uint64(i))
+			continue
+		}
+		switch v.Typ.Oid {
+		case types.T_date:
+			rs[i] = v.Col.([]types.Date)[j].ToTime()
+		case types.T_datetime:
+			rs[i] = v.Col.([]types.Datetime)[j]
+		case types.T_timestamp:
+			rs[i] = v.Col.([]types.Timestamp)[j].ToDatetime(loc)
+		default:
+			dt, err := types.ParseDatetime(string(v.Col.(*types.Bytes).Get(int64(j))))
+			if err != nil {
+				nulls.Add(nsp, uint64(i))
+				continue
+			}
+			rs[i] = dt
+		}
+	}
+	return rs, nsp, nil
+}
+
+// dateValues returns the dates of the values of a date and time argument
+func dateValues(v *vector.Vector, rows int, proc *process.Process) ([]types.Date, *nulls.Nulls, error) {
+	dts, nsp, err := datetimeValues(v, rows, proc)
+	if err != nil {
+		return nil, nil, err
+	}
+	rs := make([]types.Date, rows)
+	for i, dt := range dts {
+		rs[i] = dt.ToDate()
+	}
+	return rs, nsp, nil
+}
+
+// int64Values returns the values of an integer argument, the length of them is rows
+func int64Values(v *vector.Vector, rows int) ([]int64, *nulls.Nulls) {
+	rs := make([]int64, rows)
+	nsp := new(nulls.Nulls)
+	for i := range rs {
+		j := valuePosition(v, i)
+		if nulls.Contains(v.Nsp, uint64(j)) {
+			nulls.Add(nsp, uint64(i))
+			continue
+		}
+		switch col := v.Col.(type) {
+		case []int8:
+			rs[i] = int64(col[j])
+		case []int16:
+			rs[i] = int64(col[j])
+		case []int32:
+			rs[i] = int64(col[j])
+		case []int64:
+			rs[i] = col[j]
+		case []uint8:
+			rs[i] = int64(col[j])
+		case []uint16:
+			rs[i] = int64(col[j])
+		case []uint32:
+			rs[i] = int64(col[j])
+		case []uint64:
+			rs[i] = int64(col[j])
+		}
+	}
+	return rs, nsp
+}
+
+// timeUnit returns the unit which must be a constant string
+func timeUnit(v *vector.Vector, name string) (timeunit.Unit, error) {
+	s, ok := getBytesValue(v, 0)
+	if !v.IsConst || !ok {
+		return 0, errors.New(errno.DataException, fmt.Sprintf("the unit of %s must be a constant", name))
+	}
+	u, err := timeunit.ParseUnit(string(s))
+	if err != nil {
+		return 0, errors.New(errno.DataException, err.Error())
+	}
+	return u, nil
+}
+
+// rowsOf returns the count of the rows of the result and the count of the values to compute
+func rowsOf(vs []*vector.Vector) (int, int, bool) {
+	length, isConst := rowCount(vs)
+	if isConst {
+		return length, 1, true
+	}
+	return length, length, false
+}
+
+// dateFormat implements DATE_FORMAT(date, format)
+func dateFormat(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	length, rows, isConst := rowsOf(vs)
+	xs, nsp, err := datetimeValues(vs[0], rows, proc)
+	if err != nil {
+		return nil, err
+	}
+	values := make([][]byte, rows)
+	if vs[1].IsConst {
+		format, ok := getBytesValue(vs[1], 0)
+		if !ok {
+			return newBytesResult(varcharType, values, isConst, length)
+		}
+		return newBytesResult(varcharType, dateformat.DatetimeFormat(xs, format, nsp, values), isConst, length)
+	}
+	for i, x := range xs {
+		format, ok := getBytesValue(vs[1], i)
+		if ok && !nulls.Contains(nsp, uint64(i)) {
+			values[i] = dateformat.Format(make([]byte, 0, 2*len(format)), x, format)
+		}
+	}
+	return newBytesResult(varcharType, values, isConst, length)
+}
+
+// strToDate implements STR_TO_DATE(str, format), the result is null if the string does not match the format
+func strToDate(vs []*vector.Vector, _ *process.Process) (*vector.Vector, error) {
+	length, rows, isConst := rowsOf(vs)
+	values, isNull := make([]types.Datetime, rows), make([]bool, rows)
+	if !vs[0].IsConst && vs[1].IsConst {
+		format, ok := getBytesValue(vs[1], 0)
+		if !ok {
+			return newNullConst(types.T_datetime, vs...), nil
+		}
+		dateformat.StrToDatetime(vs[0].Col.(*types.Bytes), format, vs[0].Nsp, values, isNull)
+		return newFixedResult(datetimeType, values, isNull, isConst, length)
+	}
+	for i := range values {
+		s, ok := getBytesValue(vs[0], i)
+		format, formatOk := getBytesValue(vs[1], i)
+		if !ok || !formatOk {
+			isNull[i] = true
+			continue
+		}
+		if values[i], ok = dateformat.Parse(s, format); !ok {
+			isNull[i] = true
+		}
+	}
+	return newFixedResult(datetimeType, values, isNull, isConst, length)
+}
+
+// dateDiff implements DATEDIFF(expr1, expr2), which is the number of days from expr2 to expr1
+func dateDiff(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	length, rows, isConst := rowsOf(vs)
+	xs, xNsp, err := dateValues(vs[0], rows, proc)
+	if err != nil {
+		return nil, err
+	}
+	ys, yNsp, err := dateValues(vs[1], rows, proc)
+	if err != nil {
+		return nil, err
+	}
+	return newInt64Result(calendar.DateDiff(xs, ys, make([]int64, rows)), nullsOf(rows, xNsp, yNsp), isConst, length)
+}
+
+// timestampDiff implements TIMESTAMPDIFF(unit, expr1, expr2), which is the number of the whole units from expr1 to expr2
+func timestampDiff(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	u, err := timeUnit(vs[0], "timestampdiff")
+	if err != nil {
+		return nil, err
+	}
+	length, rows, isConst := rowsOf(vs)
+	xs, xNsp, err := datetimeValues(vs[1], rows, proc)
+	if err != nil {
+		return nil, err
+	}
+	ys, yNsp, err := datetimeValues(vs[2], rows, proc)
+	if err != nil {
+		return nil, err
+	}
+	return newInt64Result(timeunit.DatetimeDiff(u, xs, ys, make([]int64, rows)), nullsOf(rows, xNsp, yNsp), isConst, length)
+}
+
+// timestampAdd implements TIMESTAMPADD(unit, interval, expr), the result is null if it is out of range
+func timestampAdd(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	u, err := timeUnit(vs[0], "timestampadd")
+	if err != nil {
+		return nil, err
+	}
+	length, rows, isConst := rowsOf(vs)
+	ns, nNsp := int64Values(vs[1], rows)
+	xs, xNsp, err := datetimeValues(vs[2], rows, proc)
+	if err != nil {
+		return nil, err
+	}
+	isNull := nullsOf(rows, nNsp, xNsp)
+	values, isNull := timeunit.DatetimeAdd(u, ns, xs, make([]types.Datetime, rows), isNull)
+	return newFixedResult(datetimeType, values, isNull, isConst, length)
+}
+
+// dateTrunc implements DATE_TRUNC(unit, expr), which truncates expr to the start of the unit
+func dateTrunc(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	u, err := timeUnit(vs[0], "date_trunc")
+	if err != nil {
+		return nil, err
+	}
+	length, rows, isConst := rowsOf(vs)
+	xs, nsp, err := datetimeValues(vs[1], rows, proc)
+	if err != nil {
+		return nil, err
+	}
+	return newFixedResult(datetimeType, timeunit.DatetimeTrunc(u, xs, make([]types.Datetime, rows)), nullsOf(rows, nsp), isConst, length)
+}
+
+// unixTimestampNow implements UNIX_TIMESTAMP(), the seconds since 1970-01-01 00:00:00 UTC of the current time
+func unixTimestampNow(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	now, err := currentTimestamp(proc)
+	if err != nil {
+		return nil, err
+	}
+	length, _ := rowCount(vs)
+	return newInt64Result(unixtime.TimestampToUnix([]types.Timestamp{now}, make([]int64, 1)), nil, true, length)
+}
+
+// unixTimestamp implements UNIX_TIMESTAMP(date), a date or datetime is the wall clock in the time zone of the session
+func unixTimestamp(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	length, rows, isConst := rowsOf(vs)
+	values := make([]int64, rows)
+	if vs[0].Typ.Oid == types.T_timestamp && !isNullConst(vs[0]) {
+		xs := vs[0].Col.([]types.Timestamp)
+		if isConst {
+			xs = xs[:1]
+		}
+		return newInt64Result(unixtime.TimestampToUnix(xs, values), nullsOf(rows, vs[0].Nsp), isConst, length)
+	}
+	loc, err := location(proc)
+	if err != nil {
+		return nil, err
+	}
+	xs, nsp, err := datetimeValues(vs[0], rows, proc)
+	if err != nil {
+		return nil, err
+	}
+	return newInt64Result(unixtime.DatetimeToUnix(loc, xs, values), nullsOf(rows, nsp), isConst, length)
+}
+
+// fromUnixtime implements FROM_UNIXTIME(unix_timestamp), which is the wall clock in the time zone of the session
+func fromUnixtime(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	loc, err := location(proc)
+	if err != nil {
+		return nil, err
+	}
+	length, rows, isConst := rowsOf(vs)
+	xs, nsp := int64Values(vs[0], rows)
+	values, isNull := unixtime.UnixToDatetime(loc, xs, make([]types.Datetime, rows), nullsOf(rows, nsp))
+	return newFixedResult(datetimeType, values, isNull, isConst, length)
+}
+
+// fromUnixtimeFormat implements FROM_UNIXTIME(unix_timestamp, format)
+func fromUnixtimeFormat(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	vec, err := fromUnixtime(vs[:1], proc)
+	if err != nil {
+		return nil, err
+	}
+	if vec.IsConst && !vs[1].IsConst {
+		vec.Length = vector.Length(vs[1])
+	}
+	return dateFormat([]*vector.Vector{vec, vs[1]}, proc)
+}
+
+// now implements NOW() and CURRENT_TIMESTAMP(), the start time of the statement in seconds
+func now(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return currentTime(vs, proc, 0)
+}
+
+// nowPrecision implements NOW(fsp) and CURRENT_TIMESTAMP(fsp), the fractional
+// seconds of the start time of the statement are kept to fsp digits.
+func nowPrecision(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	if !vs[0].IsConst {
+		return nil, errors.New(errno.DataException, "the precision of the current time must be a constant")
+	}
+	if isNullConst(vs[0]) {
+		return currentTime(vs, proc, 0)
+	}
+	ps, _ := int64Values(vs[0], 1)
+	if ps[0] < 0 || ps[0] > 6 {
+		return nil, errors.New(errno.DataException, fmt.Sprintf("Too-big precision %d specified for 'now'. Maximum is 6.", ps[0]))
+	}
+	return currentTime(vs, proc, ps[0])
+}
+
+func currentTime(vs []*vector.Vector, proc *process.Process, precision int64) (*vector.Vector, error) {
+	ts, err := currentTimestamp(proc)
+	if err != nil {
+		return nil, err
+	}
+	loc, err := location(proc)
+	if err != nil {
+		return nil, err
+	}
+	unit := int64(1)
+	for i := precision; i < 6; i++ {
+		unit *= 10
+	}
+	us := ts.UnixMicro()
+	ts = types.TimestampFromUnixMicro(us - us%unit)
+	length, _ := rowCount(vs)
+	return newFixedResult(datetimeType, []types.Datetime{ts.ToDatetime(loc)}, nil, true, length)
+}
+
+// curdate implements CURDATE() and CURRENT_DATE, the date of the start time of the statement
+func curdate(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	ts, err := currentTimestamp(proc)
+	if err != nil {
+		return nil, err
+	}
+	loc, err := location(proc)
+	if err != nil {
+		return nil, err
+	}
+	length, _ := rowCount(vs)
+	return newFixedResult(dateType, []types.Date{ts.ToDatetime(loc).ToDate()}, nil, true, length)
+}
+
+// lastDay implements LAST_DAY(date), the last day of the month of date
+func lastDay(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	length, rows, isConst := rowsOf(vs)
+	xs, nsp, err := dateValues(vs[0], rows, proc)
+	if err != nil {
+		return nil, err
+	}
+	return newFixedResult(dateType, calendar.DateToLastDay(xs, make([]types.Date, rows)), nullsOf(rows, nsp), isConst, length)
+}
+
+// quarter implements QUARTER(date), the quarter of the year of date in the range 1 to 4
+func quarter(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	length, rows, isConst := rowsOf(vs)
+	xs, nsp, err := dateValues(vs[0], rows, proc)
+	if err != nil {
+		return nil, err
+	}
+	return newInt64Result(calendar.DateToQuarter(xs, make([]int64, rows)), nullsOf(rows, nsp), isConst, length)
+}
+
+// week implements WEEK(date[, mode]), the mode is 0 if it is not given
+func week(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return weekFn(vs, proc, calendar.DateToWeek)
+}
+
+// yearWeek implements YEARWEEK(date[, mode]), which is the year and the week of date
+func yearWeek(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return weekFn(vs, proc, calendar.DateToYearWeek)
+}
+
+func weekFn(vs []*vector.Vector, proc *process.Process, fn func([]types.Date, int64, []int64) []int64) (*vector.Vector, error) {
+	length, rows, isConst := rowsOf(vs)
+	xs, nsp, err := dateValues(vs[0], rows, proc)
+	if err != nil {
+		return nil, err
+	}
+	values := make([]int64, rows)
+	if len(vs) == 1 || vs[1].IsConst {
+		mode := int64(0)
+		if len(vs) > 1 {
+			modes, modeNsp := int64Values(vs[1], 1)
+			if nulls.Contains(modeNsp, 0) {
+				return newNullConst(types.T_int64, vs...), nil
+			}
+			mode = modes[0]
+		}
+		return newInt64Result(fn(xs, mode, values), nullsOf(rows, nsp), isConst, length)
+	}
+	modes, modeNsp := int64Values(vs[1], rows)
+	for i := range xs {
+		fn(xs[i:i+1], modes[i], values[i:i+1])
+	}
+	return newInt64Result(values, nullsOf(rows, nsp, modeNsp), isConst, length)
+}
+
+// convertTz implements CONVERT_TZ(dt, from_tz, to_tz), the result is null if a time zone is invalid
+func convertTz(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	length, rows, isConst := rowsOf(vs)
+	xs, nsp, err := datetimeValues(vs[0], rows, proc)
+	if err != nil {
+		return nil, err
+	}
+	isNull := nullsOf(rows, nsp)
+	values := make([]types.Datetime, rows)
+	if vs[1].IsConst && vs[2].IsConst {
+		from, fromOk := timeZoneValue(vs[1], 0)
+		to, toOk := timeZoneValue(vs[2], 0)
+		if !fromOk || !toOk {
+			return newNullConst(types.T_datetime, vs...), nil
+		}
+		return newFixedResult(datetimeType, unixtime.ConvertTz(xs, from, to, values), isNull, isConst, length)
+	}
+	for i := range xs {
+		from, fromOk := timeZoneValue(vs[1], i)
+		to, toOk := timeZoneValue(vs[2], i)
+		if !fromOk || !toOk {
+			isNull[i] = true
+			continue
+		}
+		unixtime.ConvertTz(xs[i:i+1], from, to, values[i:i+1])
+	}
+	return newFixedResult(datetimeType, values, isNull, isConst, length)
+}
+
+// timeZoneValue returns the time zone of the i-th value, and false if it is null or invalid
+func timeZoneValue(v *vector.Vector, i int) (*time.Location, bool) {
+	name, ok := getBytesValue(v, i)
+	if !ok {
+		return nil, false
+	}
+	loc, err := types.ParseTimeZone(string(name))
+	return loc, err == nil
+}
+
+// nullsOf returns whether each of the rows is null in any of the nulls
+func nullsOf(rows int, nsps ...*nulls.Nulls) []bool {
+	isNull := make([]bool, rows)
+	for _, nsp := range nsps {
+		if !nulls.Any(nsp) {
+			continue
+		}
+		for i := range isNull {
+			if nulls.Contains(nsp, uint64(i)) {
+				isNull[i] = true
+			}
+		}
+	}
+	return isNull
+}
+
+// newFixedResult builds the result vector of a fixed length type from the values, the values whose isNull is true are null.
+func newFixedResult[T any](typ types.Type, values []T, isNull []bool, isConst bool, length int) (*vector.Vector, error) {
+	var vec *vector.Vector
+	if isConst {
+		vec = vector.NewConst(typ)
+	} else {
+		vec = vector.New(typ)
+	}
+	for i, null := range isNull {
+		if null {
+			nulls.Add(vec.Nsp, uint64(i))
+		}
+	}
+	if err := vector.Append(vec, values); err != nil {
+		return nil, err
+	}
+	if isConst {
+		vec.Length = length
+	}
+	return vec, nil
+}
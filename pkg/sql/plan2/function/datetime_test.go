@@ -0,0 +1,199 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/stretchr/testify/require"
+)
+
+func makeDateVector(t *testing.T, ss []string) *vector.Vector {
+	vec := vector.New(dateType)
+	ds := make([]types.Date, len(ss))
+	for i, s := range ss {
+		if s == "" {
+			nulls.Add(vec.Nsp, uint64(i))
+			continue
+		}
+		d, err := types.ParseDate(s)
+		require.NoError(t, err)
+		ds[i] = d
+	}
+	require.NoError(t, vector.Append(vec, ds))
+	return vec
+}
+
+func datetimeStrings(vec *vector.Vector) []string {
+	ss := make([]string, len(vec.Col.([]types.Datetime)))
+	for i, dt := range vec.Col.([]types.Datetime) {
+		if !nulls.Contains(vec.Nsp, uint64(i)) {
+			ss[i] = dt.String()
+		}
+	}
+	return ss
+}
+
+func newTimeProcess(t *testing.T, now string, zone string) *process.Process {
+	loc, err := types.ParseTimeZone(zone)
+	require.NoError(t, err)
+	ts, err := time.Parse("2006-01-02 15:04:05.999999", now)
+	require.NoError(t, err)
+	return &process.Process{UnixTime: ts.UnixNano(), TimeZone: loc}
+}
+
+func TestDateFormatAndStrToDate(t *testing.T) {
+	dates := makeStringVector(t, []string{"2009-10-04 22:23:00", "", "bad"})
+	rs, err := dateFormat([]*vector.Vector{dates, makeConstStringVector("%W %M %Y", 3)}, nil)
+	require.NoError(t, err)
+	require.Equal(t, "Sunday October 2009", string(rs.Col.(*types.Bytes).Get(0)))
+	require.True(t, nulls.Contains(rs.Nsp, 1))
+	require.True(t, nulls.Contains(rs.Nsp, 2))
+
+	formats := makeStringVector(t, []string{"%Y", "%m", "%d"})
+	rs, err = dateFormat([]*vector.Vector{makeDateVector(t, []string{"2022-05-03", "2022-05-03", "2022-05-03"}), formats}, nil)
+	require.NoError(t, err)
+	require.Equal(t, "2022", string(rs.Col.(*types.Bytes).Get(0)))
+	require.Equal(t, "05", string(rs.Col.(*types.Bytes).Get(1)))
+	require.Equal(t, "03", string(rs.Col.(*types.Bytes).Get(2)))
+
+	rs, err = strToDate([]*vector.Vector{makeStringVector(t, []string{"01,5,2013", "", "5,2013"}), makeConstStringVector("%d,%m,%Y", 3)}, nil)
+	require.NoError(t, err)
+	require.Equal(t, []string{"2013-05-01 00:00:00", "", ""}, datetimeStrings(rs))
+}
+
+func TestDateDiffAndTimestampDiff(t *testing.T) {
+	rs, err := dateDiff([]*vector.Vector{makeDateVector(t, []string{"2007-12-31", "2010-11-30", ""}), makeConstStringVector("2007-12-30 23:59:59", 3)}, nil)
+	require.NoError(t, err)
+	require.Equal(t, []int64{1, 1066}, rs.Col.([]int64)[:2])
+	require.True(t, nulls.Contains(rs.Nsp, 2))
+
+	xs := makeStringVector(t, []string{"2003-02-01", "2003-05-01 12:05:55"})
+	ys := makeStringVector(t, []string{"2003-05-01", "2002-05-01 12:05:54"})
+	rs, err = timestampDiff([]*vector.Vector{makeConstStringVector("month", 2), xs, ys}, nil)
+	require.NoError(t, err)
+	require.Equal(t, []int64{3, -12}, rs.Col)
+	rs, err = timestampDiff([]*vector.Vector{makeConstStringVector("minute", 2), xs, ys}, nil)
+	require.NoError(t, err)
+	require.Equal(t, []int64{128160, -525600}, rs.Col)
+
+	_, err = timestampDiff([]*vector.Vector{makeConstStringVector("fortnight", 2), xs, ys}, nil)
+	require.Error(t, err)
+	_, err = timestampDiff([]*vector.Vector{makeStringVector(t, []string{"day", "day"}), xs, ys}, nil)
+	require.Error(t, err)
+}
+
+func TestTimestampAddAndDateTrunc(t *testing.T) {
+	xs := makeStringVector(t, []string{"2003-01-31 10:00:00", "9999-12-31 00:00:00", ""})
+	rs, err := timestampAdd([]*vector.Vector{makeConstStringVector("month", 3), makeConstInt64Vector(1, 3), xs}, nil)
+	require.NoError(t, err)
+	require.Equal(t, []string{"2003-02-28 10:00:00", "", ""}, datetimeStrings(rs))
+
+	rs, err = dateTrunc([]*vector.Vector{makeConstStringVector("quarter", 3), xs}, nil)
+	require.NoError(t, err)
+	require.Equal(t, []string{"2003-01-01 00:00:00", "9999-10-01 00:00:00", ""}, datetimeStrings(rs))
+}
+
+func TestUnixTime(t *testing.T) {
+	proc := newTimeProcess(t, "2015-11-13 10:20:19.012345", "+08:00")
+	rs, err := unixTimestampNow([]*vector.Vector{makeConstInt64Vector(0, 2)}, proc)
+	require.NoError(t, err)
+	require.True(t, rs.IsConst)
+	require.Equal(t, []int64{1447410019}, rs.Col)
+
+	// the datetime is the wall clock of the session
+	rs, err = unixTimestamp([]*vector.Vector{makeStringVector(t, []string{"2015-11-13 18:20:19", ""})}, proc)
+	require.NoError(t, err)
+	require.Equal(t, int64(1447410019), rs.Col.([]int64)[0])
+	require.True(t, nulls.Contains(rs.Nsp, 1))
+
+	rs, err = fromUnixtime([]*vector.Vector{makeConstInt64Vector(1447410019, 1)}, proc)
+	require.NoError(t, err)
+	require.Equal(t, []string{"2015-11-13 18:20:19"}, datetimeStrings(rs))
+	rs, err = fromUnixtimeFormat([]*vector.Vector{makeConstInt64Vector(1447410019, 1), makeConstStringVector("%Y %D %M %h:%i:%s %x", 1)}, proc)
+	require.NoError(t, err)
+	require.Equal(t, "2015 13th November 06:20:19 2015", string(rs.Col.(*types.Bytes).Get(0)))
+
+	// the session is needed
+	_, err = unixTimestampNow([]*vector.Vector{makeConstInt64Vector(0, 1)}, nil)
+	require.Error(t, err)
+	_, err = fromUnixtime([]*vector.Vector{makeConstInt64Vector(0, 1)}, nil)
+	require.Error(t, err)
+}
+
+func TestCurrentTime(t *testing.T) {
+	proc := newTimeProcess(t, "2022-05-31 23:20:19.012345", "+08:00")
+	rs, err := now([]*vector.Vector{makeConstInt64Vector(0, 3)}, proc)
+	require.NoError(t, err)
+	require.True(t, rs.IsConst)
+	require.Equal(t, 3, rs.Length)
+	require.Equal(t, []string{"2022-06-01 07:20:19"}, datetimeStrings(rs))
+
+	rs, err = nowPrecision([]*vector.Vector{makeConstInt64Vector(3, 1)}, proc)
+	require.NoError(t, err)
+	require.Equal(t, int64(12000), rs.Col.([]types.Datetime)[0].MicroSec())
+	_, err = nowPrecision([]*vector.Vector{makeConstInt64Vector(7, 1)}, proc)
+	require.Error(t, err)
+
+	rs, err = curdate([]*vector.Vector{makeConstInt64Vector(0, 1)}, proc)
+	require.NoError(t, err)
+	require.Equal(t, "2022-06-01", rs.Col.([]types.Date)[0].String())
+
+	_, err = now([]*vector.Vector{makeConstInt64Vector(0, 1)}, nil)
+	require.Error(t, err)
+}
+
+func TestCalendarFunctions(t *testing.T) {
+	dates := makeDateVector(t, []string{"2008-02-20", "2008-12-31", ""})
+	rs, err := lastDay([]*vector.Vector{dates}, nil)
+	require.NoError(t, err)
+	require.Equal(t, "2008-02-29", rs.Col.([]types.Date)[0].String())
+	require.True(t, nulls.Contains(rs.Nsp, 2))
+
+	rs, err = quarter([]*vector.Vector{dates}, nil)
+	require.NoError(t, err)
+	require.Equal(t, []int64{1, 4}, rs.Col.([]int64)[:2])
+
+	rs, err = week([]*vector.Vector{dates}, nil)
+	require.NoError(t, err)
+	require.Equal(t, []int64{7, 52}, rs.Col.([]int64)[:2])
+	rs, err = week([]*vector.Vector{dates, makeConstInt64Vector(1, 3)}, nil)
+	require.NoError(t, err)
+	require.Equal(t, []int64{8, 53}, rs.Col.([]int64)[:2])
+
+	rs, err = yearWeek([]*vector.Vector{dates, makeConstInt64Vector(3, 3)}, nil)
+	require.NoError(t, err)
+	require.Equal(t, []int64{200808, 200901}, rs.Col.([]int64)[:2])
+}
+
+func TestConvertTz(t *testing.T) {
+	xs := makeStringVector(t, []string{"2004-01-01 12:00:00", ""})
+	rs, err := convertTz([]*vector.Vector{xs, makeConstStringVector("+00:00", 2), makeConstStringVector("+10:00", 2)}, nil)
+	require.NoError(t, err)
+	require.Equal(t, []string{"2004-01-01 22:00:00", ""}, datetimeStrings(rs))
+
+	rs, err = convertTz([]*vector.Vector{xs, makeConstStringVector("+00:00", 2), makeConstStringVector("Mars/Olympus", 2)}, nil)
+	require.NoError(t, err)
+	require.True(t, isNullConst(rs))
+
+	rs, err = convertTz([]*vector.Vector{xs, makeStringVector(t, []string{"bad", "+00:00"}), makeConstStringVector("+10:00", 2)}, nil)
+	require.NoError(t, err)
+	require.True(t, nulls.Contains(rs.Nsp, 0))
+}
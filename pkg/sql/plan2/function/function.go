@@ -79,6 +79,14 @@ var (
 		types.T_decimal64: {types.T_decimal128},
 		types.T_char:      {types.T_varchar},
 		types.T_varchar:   {types.T_char},
+		types.T_text:      {types.T_varchar},
+		types.T_blob:      {types.T_varchar},
+		types.T_enum: {
+			types.T_uint16, types.T_uint32, types.T_uint64,
+			types.T_int32, types.T_int64,
+			types.T_float64, types.T_decimal64, types.T_decimal128,
+		},
+		types.T_set: {types.T_uint64, types.T_float64, types.T_decimal64, types.T_decimal128},
 
 		types.T_tuple: {types.T_float64},
 	}
@@ -319,3 +327,7 @@ func isNumberType(t types.T) bool {
 	}
 	return false
 }
+
+func isDecimalType(t types.T) bool {
+	return t == types.T_decimal64 || t == types.T_decimal128
+}
@@ -173,6 +173,36 @@ const (
 	DATE_SUB              // DATE_SUB
 	APPROX_COUNT_DISTINCT // APPROX_COUNT_DISTINCT, special aggregate
 
+	JSON_EXTRACT // JSON_EXTRACT
+	JSON_UNQUOTE // JSON_UNQUOTE
+
+	UUID        // UUID
+	UUID_TO_BIN // UUID_TO_BIN
+	BIN_TO_UUID // BIN_TO_UUID
+
+	APPROX_COUNT_DISTINCT_ERROR // APPROX_COUNT_DISTINCT_ERROR
+	APPROX_PERCENTILE           // APPROX_PERCENTILE
+	APPROX_PERCENTILE_ERROR     // APPROX_PERCENTILE_ERROR
+
+	REGEXP_LIKE  // REGEXP_LIKE
+	REGEXP_INSTR // REGEXP_INSTR
+
+	DATE_FORMAT    // DATE_FORMAT
+	STR_TO_DATE    // STR_TO_DATE
+	TIMESTAMPDIFF  // TIMESTAMPDIFF
+	TIMESTAMPADD   // TIMESTAMPADD
+	UNIX_TIMESTAMP // UNIX_TIMESTAMP
+	FROM_UNIXTIME  // FROM_UNIXTIME
+	LAST_DAY       // LAST_DAY
+	WEEK           // WEEK
+	YEARWEEK       // YEARWEEK
+	QUARTER        // QUARTER
+	DATE_TRUNC     // DATE_TRUNC
+	CONVERT_TZ     // CONVERT_TZ
+
+	PERCENTILE_CONT // PERCENTILE_CONT
+	PERCENTILE_DISC // PERCENTILE_DISC
+
 	// FUNCTION_END_NUMBER is not a function, just a flag to record the max number of function.
 	// TODO: every one should put the new function id in front of this one if you want to make a new function.
 	FUNCTION_END_NUMBER
@@ -219,12 +249,56 @@ var functionIdRegister = map[string]int32{
 	"stddev_pop":            STDDEV_POP,
 	"variance":              VAR_POP,
 	"approx_count_distinct": APPROX_COUNT_DISTINCT,
+
+	"approx_count_distinct_error": APPROX_COUNT_DISTINCT_ERROR,
+	"approx_percentile":           APPROX_PERCENTILE,
+	"approx_percentile_error":     APPROX_PERCENTILE_ERROR,
+	"median":                      MEDIAN,
+	"percentile_cont":             PERCENTILE_CONT,
+	"percentile_disc":             PERCENTILE_DISC,
+	"percentile_approx":           APPROX_PERCENTILE,
+	"var_samp":                    VAR_SAMPLE,
+	"stddev_samp":                 STDDEV_SAMPLE,
+	"covar_pop":                   COVAR_POP,
+	"covar_samp":                  COVAR_SAMPLE,
+	"corr":                        CORR,
 	// builtin
-	"extract":   EXTRACT,
-	"year":      YEAR,
-	"substr":    SUBSTRING,
-	"substring": SUBSTRING,
-	"iff":       IFF,
-	"date_add":  DATE_ADD,
-	"date_sub":  DATE_SUB,
+	"extract":      EXTRACT,
+	"year":         YEAR,
+	"substr":       SUBSTRING,
+	"substring":    SUBSTRING,
+	"iff":          IFF,
+	"date_add":     DATE_ADD,
+	"date_sub":     DATE_SUB,
+	"json_extract": JSON_EXTRACT,
+	"json_unquote": JSON_UNQUOTE,
+	"uuid":         UUID,
+	"uuid_to_bin":  UUID_TO_BIN,
+	"bin_to_uuid":  BIN_TO_UUID,
+	// regular expression
+	"regexp":         REGEXP,
+	"regexp_like":    REGEXP_LIKE,
+	"regexp_instr":   REGEXP_INSTR,
+	"regexp_replace": REGEXP_REPLACE,
+	"regexp_substr":  REGEXP_SUBSTR,
+	// date and time
+	"date_format":       DATE_FORMAT,
+	"str_to_date":       STR_TO_DATE,
+	"datediff":          DATEDIFF,
+	"timestampdiff":     TIMESTAMPDIFF,
+	"timestampadd":      TIMESTAMPADD,
+	"unix_timestamp":    UNIX_TIMESTAMP,
+	"from_unixtime":     FROM_UNIXTIME,
+	"now":               CURRENT_TIMESTAMP,
+	"current_timestamp": CURRENT_TIMESTAMP,
+	"localtime":         CURRENT_TIMESTAMP,
+	"localtimestamp":    CURRENT_TIMESTAMP,
+	"curdate":           CURRENT_DATE,
+	"current_date":      CURRENT_DATE,
+	"last_day":          LAST_DAY,
+	"week":              WEEK,
+	"yearweek":          YEARWEEK,
+	"quarter":           QUARTER,
+	"date_trunc":        DATE_TRUNC,
+	"convert_tz":        CONVERT_TZ,
 }
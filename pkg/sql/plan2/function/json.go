@@ -0,0 +1,247 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// isStringType returns true if t is a type of the string family or the scalar null
+func isStringType(t types.T) bool {
+	return t == types.T_char || t == types.T_varchar || t == types.T_text || t == ScalarNull
+}
+
+// jsonExtractTypeCheck accepts a json document (or its text) followed by one or more paths
+func jsonExtractTypeCheck(inputTypes []types.T, _ []types.T) bool {
+	if len(inputTypes) < 2 {
+		return false
+	}
+	if inputTypes[0] != types.T_json && !isStringType(inputTypes[0]) {
+		return false
+	}
+	for _, t := range inputTypes[1:] {
+		if !isStringType(t) {
+			return false
+		}
+	}
+	return true
+}
+
+func jsonUnquoteTypeCheck(inputTypes []types.T, _ []types.T) bool {
+	return len(inputTypes) == 1 && (inputTypes[0] == types.T_json || isStringType(inputTypes[0]))
+}
+
+// rowCount returns the row count of the result and whether all the arguments are constant
+func rowCount(vs []*vector.Vector) (int, bool) {
+	for _, v := range vs {
+		if !v.IsConst {
+			return vector.Length(v), false
+		}
+	}
+	return vs[0].Length, true
+}
+
+// getBytesValue returns the i-th value of a varlena vector, and false if it is null
+func getBytesValue(v *vector.Vector, i int) ([]byte, bool) {
+	if v.IsConst {
+		i = 0
+	}
+	if nulls.Contains(v.Nsp, uint64(i)) {
+		return nil, false
+	}
+	return v.Col.(*types.Bytes).Get(int64(i)), true
+}
+
+// getByteJson returns the i-th value of a json vector, a string vector is parsed as json text.
+func getByteJson(v *vector.Vector, i int) (bytejson.ByteJson, bool, error) {
+	data, ok := getBytesValue(v, i)
+	if !ok {
+		return bytejson.Null, false, nil
+	}
+	if v.Typ.Oid == types.T_json {
+		bj, err := bytejson.Unmarshal(data)
+		return bj, true, err
+	}
+	bj, err := bytejson.ParseFromByteSlice(data)
+	if err != nil {
+		return bj, true, errors.New(errno.DataException, fmt.Sprintf("Invalid JSON text: '%s'", data))
+	}
+	return bj, true, nil
+}
+
+// newBytesResult builds the result vector from the values, nil value is null.
+func newBytesResult(typ types.Type, values [][]byte, isConst bool, length int) (*vector.Vector, error) {
+	var vec *vector.Vector
+	if isConst {
+		vec = vector.NewConst(typ)
+	} else {
+		vec = vector.New(typ)
+	}
+	for i, v := range values {
+		if v == nil {
+			nulls.Add(vec.Nsp, uint64(i))
+		}
+	}
+	if err := vector.Append(vec, values); err != nil {
+		return nil, err
+	}
+	if isConst {
+		vec.Length = length
+	}
+	return vec, nil
+}
+
+// jsonExtract implements JSON_EXTRACT(json_doc, path[, path] ...), the result is null
+// if any argument is null or no value is selected by the paths.
+func jsonExtract(vs []*vector.Vector, _ *process.Process) (*vector.Vector, error) {
+	length, isConst := rowCount(vs)
+	rows := length
+	if isConst {
+		rows = 1
+	}
+
+	// the paths are parsed only once if all of them are constant
+	var constPaths []bytejson.Path
+	pathsConst := true
+	for _, v := range vs[1:] {
+		pathsConst = pathsConst && v.IsConst
+	}
+	if pathsConst {
+		var err error
+		if constPaths, err = getJsonPaths(vs[1:], 0); err != nil {
+			return nil, err
+		}
+	}
+
+	values := make([][]byte, rows)
+	for i := 0; i < rows; i++ {
+		bj, ok, err := getByteJson(vs[0], i)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			continue
+		}
+		paths := constPaths
+		if !pathsConst {
+			if paths, err = getJsonPaths(vs[1:], i); err != nil {
+				return nil, err
+			}
+		}
+		if paths == nil {
+			continue
+		}
+		if v, found := bj.Extract(paths); found {
+			values[i] = v.Marshal()
+		}
+	}
+	return newBytesResult(types.Type{Oid: types.T_json, Size: 24}, values, isConst, length)
+}
+
+// getJsonPaths parses the i-th paths, it returns nil if any of them is null
+func getJsonPaths(vs []*vector.Vector, i int) ([]bytejson.Path, error) {
+	paths := make([]bytejson.Path, len(vs))
+	for j, v := range vs {
+		data, ok := getBytesValue(v, i)
+		if !ok {
+			return nil, nil
+		}
+		p, err := bytejson.ParseJsonPath(string(data))
+		if err != nil {
+			return nil, errors.New(errno.DataException, fmt.Sprintf("Invalid JSON path expression: '%s'", data))
+		}
+		paths[j] = p
+	}
+	return paths, nil
+}
+
+// jsonUnquote implements JSON_UNQUOTE(json_val), a json string is returned without the quotes
+// and other json values are returned as their text.
+func jsonUnquote(vs []*vector.Vector, _ *process.Process) (*vector.Vector, error) {
+	length, isConst := rowCount(vs)
+	rows := length
+	if isConst {
+		rows = 1
+	}
+	values := make([][]byte, rows)
+	for i := 0; i < rows; i++ {
+		data, ok := getBytesValue(vs[0], i)
+		if !ok {
+			continue
+		}
+		if vs[0].Typ.Oid == types.T_json {
+			bj, err := bytejson.Unmarshal(data)
+			if err != nil {
+				return nil, err
+			}
+			values[i] = []byte(bj.Unquote())
+			continue
+		}
+		s, err := bytejson.UnquoteString(string(data))
+		if err != nil {
+			return nil, errors.New(errno.DataException, fmt.Sprintf("Invalid JSON text: '%s'", data))
+		}
+		values[i] = []byte(s)
+	}
+	return newBytesResult(types.Type{Oid: types.T_varchar, Size: 24}, values, isConst, length)
+}
+
+// castVarcharToJson parses the json text into the binary format
+func castVarcharToJson(vs []*vector.Vector, _ *process.Process) (*vector.Vector, error) {
+	length, isConst := rowCount(vs[:1])
+	rows := length
+	if isConst {
+		rows = 1
+	}
+	values := make([][]byte, rows)
+	for i := 0; i < rows; i++ {
+		bj, ok, err := getByteJson(vs[0], i)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			values[i] = bj.Marshal()
+		}
+	}
+	return newBytesResult(types.Type{Oid: types.T_json, Size: 24}, values, isConst, length)
+}
+
+// castJsonToVarchar returns the text of the json values
+func castJsonToVarchar(vs []*vector.Vector, _ *process.Process) (*vector.Vector, error) {
+	length, isConst := rowCount(vs[:1])
+	rows := length
+	if isConst {
+		rows = 1
+	}
+	values := make([][]byte, rows)
+	for i := 0; i < rows; i++ {
+		bj, ok, err := getByteJson(vs[0], i)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			values[i] = []byte(bj.String())
+		}
+	}
+	return newBytesResult(types.Type{Oid: types.T_varchar, Size: 24}, values, isConst, length)
+}
@@ -0,0 +1,98 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/stretchr/testify/require"
+)
+
+func makeJsonVector(t *testing.T, docs []string) *vector.Vector {
+	vec := vector.New(types.Type{Oid: types.T_json, Size: 24})
+	values := make([][]byte, len(docs))
+	for i, doc := range docs {
+		if doc == "" {
+			nulls.Add(vec.Nsp, uint64(i))
+			continue
+		}
+		bj, err := bytejson.ParseFromString(doc)
+		require.NoError(t, err)
+		values[i] = bj.Marshal()
+	}
+	require.NoError(t, vector.Append(vec, values))
+	return vec
+}
+
+func makeConstStringVector(s string, length int) *vector.Vector {
+	vec := vector.NewConst(types.Type{Oid: types.T_varchar, Size: 24})
+	vec.Col = &types.Bytes{
+		Data:    []byte(s),
+		Offsets: []uint32{0},
+		Lengths: []uint32{uint32(len(s))},
+	}
+	vec.Length = length
+	return vec
+}
+
+func TestJsonExtract(t *testing.T) {
+	docs := makeJsonVector(t, []string{`{"a": {"b": "x"}}`, `{"a": [1, 2]}`, ``, `[3]`})
+	path := makeConstStringVector(`$.a.b`, 4)
+
+	rs, err := jsonExtract([]*vector.Vector{docs, path}, nil)
+	require.NoError(t, err)
+	require.Equal(t, types.T_json, rs.Typ.Oid)
+	bj, err := bytejson.Unmarshal(rs.Col.(*types.Bytes).Get(0))
+	require.NoError(t, err)
+	require.Equal(t, `"x"`, bj.String())
+	for i := 1; i < 4; i++ {
+		require.True(t, nulls.Contains(rs.Nsp, uint64(i)))
+	}
+
+	rs, err = jsonUnquote([]*vector.Vector{rs}, nil)
+	require.NoError(t, err)
+	require.Equal(t, "x", string(rs.Col.(*types.Bytes).Get(0)))
+	require.True(t, nulls.Contains(rs.Nsp, 1))
+
+	// json text and multiple paths
+	doc := makeConstStringVector(`{"a": 1, "b": [true]}`, 2)
+	rs, err = jsonExtract([]*vector.Vector{doc, makeConstStringVector(`$.a`, 2), makeConstStringVector(`$.b[0]`, 2)}, nil)
+	require.NoError(t, err)
+	require.True(t, rs.IsConst)
+	require.Equal(t, 2, rs.Length)
+	bj, err = bytejson.Unmarshal(rs.Col.(*types.Bytes).Get(0))
+	require.NoError(t, err)
+	require.Equal(t, `[1, true]`, bj.String())
+
+	_, err = jsonExtract([]*vector.Vector{makeConstStringVector(`{"a"`, 1), path}, nil)
+	require.Error(t, err)
+	_, err = jsonExtract([]*vector.Vector{docs, makeConstStringVector(`a.b`, 4)}, nil)
+	require.Error(t, err)
+}
+
+func TestCastJson(t *testing.T) {
+	rs, err := castVarcharToJson([]*vector.Vector{makeConstStringVector(`{"b": 1, "a": null}`, 1)}, nil)
+	require.NoError(t, err)
+	rs, err = castJsonToVarchar([]*vector.Vector{rs}, nil)
+	require.NoError(t, err)
+	require.Equal(t, `{"a": null, "b": 1}`, string(rs.Col.(*types.Bytes).Get(0)))
+
+	_, err = castVarcharToJson([]*vector.Vector{makeConstStringVector(`[1,`, 1)}, nil)
+	require.Error(t, err)
+}
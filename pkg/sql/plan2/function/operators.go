@@ -26,6 +26,9 @@ func initOperators() {
 
 	for name, fs := range operators {
 		for _, f := range fs {
+			if f.Fn == nil {
+				f.Fn = genericOperatorFn(name, f)
+			}
 			err = appendFunction(name, f)
 			if err != nil {
 				panic(err)
@@ -230,6 +233,18 @@ var operators = map[int][]Function{
 			TypeCheckFn: strictTypeCheck,
 			Fn:          nil,
 		},
+		{
+			Index:  16,
+			Flag:   plan.Function_STRICT,
+			Layout: COMPARISON_OPERATOR,
+			Args: []types.T{
+				types.T_uuid,
+				types.T_uuid,
+			},
+			ReturnTyp:   types.T_bool,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          nil,
+		},
 	},
 	GREAT_THAN: {
 		{
@@ -424,6 +439,18 @@ var operators = map[int][]Function{
 			TypeCheckFn: strictTypeCheck,
 			Fn:          nil,
 		},
+		{
+			Index:  16,
+			Flag:   plan.Function_STRICT,
+			Layout: COMPARISON_OPERATOR,
+			Args: []types.T{
+				types.T_uuid,
+				types.T_uuid,
+			},
+			ReturnTyp:   types.T_bool,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          nil,
+		},
 	},
 	GREAT_EQUAL: {
 		{
@@ -618,6 +645,18 @@ var operators = map[int][]Function{
 			TypeCheckFn: strictTypeCheck,
 			Fn:          nil,
 		},
+		{
+			Index:  16,
+			Flag:   plan.Function_STRICT,
+			Layout: COMPARISON_OPERATOR,
+			Args: []types.T{
+				types.T_uuid,
+				types.T_uuid,
+			},
+			ReturnTyp:   types.T_bool,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          nil,
+		},
 	},
 	LESS_THAN: {
 		{
@@ -812,6 +851,18 @@ var operators = map[int][]Function{
 			TypeCheckFn: strictTypeCheck,
 			Fn:          nil,
 		},
+		{
+			Index:  16,
+			Flag:   plan.Function_STRICT,
+			Layout: COMPARISON_OPERATOR,
+			Args: []types.T{
+				types.T_uuid,
+				types.T_uuid,
+			},
+			ReturnTyp:   types.T_bool,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          nil,
+		},
 	},
 	LESS_EQUAL: {
 		{
@@ -1006,6 +1057,18 @@ var operators = map[int][]Function{
 			TypeCheckFn: strictTypeCheck,
 			Fn:          nil,
 		},
+		{
+			Index:  16,
+			Flag:   plan.Function_STRICT,
+			Layout: COMPARISON_OPERATOR,
+			Args: []types.T{
+				types.T_uuid,
+				types.T_uuid,
+			},
+			ReturnTyp:   types.T_bool,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          nil,
+		},
 	},
 	NOT_EQUAL: {
 		{
@@ -1200,6 +1263,18 @@ var operators = map[int][]Function{
 			TypeCheckFn: strictTypeCheck,
 			Fn:          nil,
 		},
+		{
+			Index:  16,
+			Flag:   plan.Function_STRICT,
+			Layout: COMPARISON_OPERATOR,
+			Args: []types.T{
+				types.T_uuid,
+				types.T_uuid,
+			},
+			ReturnTyp:   types.T_bool,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          nil,
+		},
 	},
 	LIKE: {
 		{
@@ -1217,10 +1292,10 @@ var operators = map[int][]Function{
 					return false
 				}
 				typ1, typ2 := inputTypes[0], inputTypes[1]
-				if typ1 != types.T_char && typ1 != types.T_varchar {
+				if typ1 != types.T_char && typ1 != types.T_varchar && typ1 != types.T_text {
 					return false
 				}
-				if typ2 != types.T_char && typ2 != types.T_varchar {
+				if typ2 != types.T_char && typ2 != types.T_varchar && typ2 != types.T_text {
 					return false
 				}
 				return true
@@ -1776,7 +1851,7 @@ var operators = map[int][]Function{
 			Flag:        plan.Function_STRICT,
 			Layout:      BINARY_ARITHMETIC_OPERATOR,
 			Args:        []types.T{types.T_decimal64, types.T_decimal64},
-			ReturnTyp:   types.T_decimal64,
+			ReturnTyp:   types.T_decimal128,
 			TypeCheckFn: strictTypeCheck,
 			Fn:          nil,
 		},
@@ -1814,7 +1889,7 @@ var operators = map[int][]Function{
 			Flag:        plan.Function_STRICT,
 			Layout:      BINARY_ARITHMETIC_OPERATOR,
 			Args:        []types.T{types.T_decimal64, types.T_decimal64},
-			ReturnTyp:   types.T_decimal64,
+			ReturnTyp:   types.T_decimal128,
 			TypeCheckFn: strictTypeCheck,
 			Fn:          nil,
 		},
@@ -1823,7 +1898,7 @@ var operators = map[int][]Function{
 			Flag:        plan.Function_STRICT,
 			Layout:      BINARY_ARITHMETIC_OPERATOR,
 			Args:        []types.T{types.T_decimal128, types.T_decimal128},
-			ReturnTyp:   types.T_decimal64,
+			ReturnTyp:   types.T_decimal128,
 			TypeCheckFn: strictTypeCheck,
 			Fn:          nil,
 		},
@@ -3599,6 +3674,60 @@ var operators = map[int][]Function{
 			TypeCheckFn: strictTypeCheck,
 			Fn:          nil,
 		},
+		{
+			Index:       159,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_varchar, types.T_time},
+			ReturnTyp:   types.T_time,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          nil,
+		},
+		{
+			Index:       160,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_varchar, types.T_year},
+			ReturnTyp:   types.T_year,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          nil,
+		},
+		{
+			Index:       161,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_varchar, types.T_json},
+			ReturnTyp:   types.T_json,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          castVarcharToJson,
+		},
+		{
+			Index:       162,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_json, types.T_varchar},
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          castJsonToVarchar,
+		},
+		{
+			Index:       163,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_varchar, types.T_uuid},
+			ReturnTyp:   types.T_uuid,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          castVarcharToUuid,
+		},
+		{
+			Index:       164,
+			Flag:        plan.Function_STRICT,
+			Layout:      CAST_EXPRESSION,
+			Args:        []types.T{types.T_uuid, types.T_varchar},
+			ReturnTyp:   types.T_varchar,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          castUuidToVarchar,
+		},
 	},
 	CASE: {
 		{
@@ -0,0 +1,285 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"regexp"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/regular"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+var (
+	varcharType = types.Type{Oid: types.T_varchar, Size: 24}
+	int64Type   = types.Type{Oid: types.T_int64, Size: 8}
+)
+
+// regexpOverloads returns an overload for each count of the arguments from min to len(args),
+// the optional arguments are the trailing ones.
+func regexpOverloads(args []types.T, min int, ret types.T, fn func([]*vector.Vector, *process.Process) (*vector.Vector, error)) []Function {
+	fs := make([]Function, 0, len(args)-min+1)
+	for n := min; n <= len(args); n++ {
+		fs = append(fs, Function{
+			Index:       int32(len(fs)),
+			Flag:        plan.Function_STRICT,
+			Layout:      STANDARD_FUNCTION,
+			Args:        args[:n],
+			ReturnTyp:   ret,
+			TypeCheckFn: strictTypeCheck,
+			Fn:          fn,
+		})
+	}
+	return fs
+}
+
+// regexpArgs are the arguments of a regular expression function, the
+// pattern is compiled only once if it and the match type are constant.
+type regexpArgs struct {
+	vs        []*vector.Vector
+	pattern   int
+	matchType int // -1 if there is no match type
+	re        *regexp.Regexp
+}
+
+func newRegexpArgs(vs []*vector.Vector, pattern, matchType int) (*regexpArgs, error) {
+	args := &regexpArgs{
+		vs:        vs,
+		pattern:   pattern,
+		matchType: matchType,
+	}
+	if matchType >= len(vs) {
+		args.matchType = -1
+	}
+	if !vs[pattern].IsConst || (args.matchType >= 0 && !vs[args.matchType].IsConst) {
+		return args, nil
+	}
+	re, ok, err := args.compile(0)
+	if err != nil || !ok {
+		return args, err
+	}
+	args.re = re
+	return args, nil
+}
+
+// regexp returns the regular expression of the i-th row, and false if the pattern or the match type is null
+func (args *regexpArgs) regexp(i int) (*regexp.Regexp, bool, error) {
+	if args.re != nil {
+		return args.re, true, nil
+	}
+	return args.compile(i)
+}
+
+func (args *regexpArgs) compile(i int) (*regexp.Regexp, bool, error) {
+	pattern, ok := getBytesValue(args.vs[args.pattern], i)
+	if !ok {
+		return nil, false, nil
+	}
+	var matchType []byte
+	if args.matchType >= 0 {
+		if matchType, ok = getBytesValue(args.vs[args.matchType], i); !ok {
+			return nil, false, nil
+		}
+	}
+	re, err := regular.Compile(pattern, matchType)
+	if err != nil {
+		return nil, false, errors.New(errno.DataException, err.Error())
+	}
+	return re, true, nil
+}
+
+// int64Arg returns the i-th value of the optional integer argument, or def if it is not given
+func (args *regexpArgs) int64Arg(idx, i int, def int64) (int64, bool) {
+	if idx >= len(args.vs) {
+		return def, true
+	}
+	v := args.vs[idx]
+	if v.IsConst {
+		i = 0
+	}
+	if nulls.Contains(v.Nsp, uint64(i)) {
+		return 0, false
+	}
+	return v.Col.([]int64)[i], true
+}
+
+// regexpLike implements REGEXP_LIKE(expr, pat[, match_type]), and the operator expr REGEXP pat
+func regexpLike(vs []*vector.Vector, _ *process.Process) (*vector.Vector, error) {
+	length, isConst := rowCount(vs)
+	rows := length
+	if isConst {
+		rows = 1
+	}
+	args, err := newRegexpArgs(vs, 1, 2)
+	if err != nil {
+		return nil, err
+	}
+	values, isNull := make([]bool, rows), make([]bool, rows)
+	if args.re != nil && !vs[0].IsConst {
+		// the common case of a column and a constant pattern
+		regular.Like(vs[0].Col.(*types.Bytes), args.re, vs[0].Nsp, values)
+		for i := range isNull {
+			isNull[i] = nulls.Contains(vs[0].Nsp, uint64(i))
+		}
+		return newBoolResult(values, isNull, false, length)
+	}
+	for i := 0; i < rows; i++ {
+		s, ok := getBytesValue(vs[0], i)
+		if !ok {
+			isNull[i] = true
+			continue
+		}
+		re, ok, err := args.regexp(i)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			isNull[i] = true
+			continue
+		}
+		values[i] = re.Match(s)
+	}
+	return newBoolResult(values, isNull, isConst, length)
+}
+
+// regexpInstr implements REGEXP_INSTR(expr, pat[, pos[, occurrence[, return_option[, match_type]]]])
+func regexpInstr(vs []*vector.Vector, _ *process.Process) (*vector.Vector, error) {
+	length, isConst := rowCount(vs)
+	rows := length
+	if isConst {
+		rows = 1
+	}
+	args, err := newRegexpArgs(vs, 1, 5)
+	if err != nil {
+		return nil, err
+	}
+	values, isNull := make([]int64, rows), make([]bool, rows)
+	for i := 0; i < rows; i++ {
+		s, ok := getBytesValue(vs[0], i)
+		re, reOk, err := args.regexp(i)
+		if err != nil {
+			return nil, err
+		}
+		pos, posOk := args.int64Arg(2, i, 1)
+		occurrence, occurrenceOk := args.int64Arg(3, i, 1)
+		returnOption, returnOk := args.int64Arg(4, i, 0)
+		if !ok || !reOk || !posOk || !occurrenceOk || !returnOk {
+			isNull[i] = true
+			continue
+		}
+		if values[i], err = regular.Instr(s, re, pos, occurrence, returnOption); err != nil {
+			return nil, errors.New(errno.DataException, err.Error())
+		}
+	}
+	return newInt64Result(values, isNull, isConst, length)
+}
+
+// regexpSubstr implements REGEXP_SUBSTR(expr, pat[, pos[, occurrence[, match_type]]]),
+// the result is null if there is no match.
+func regexpSubstr(vs []*vector.Vector, _ *process.Process) (*vector.Vector, error) {
+	length, isConst := rowCount(vs)
+	rows := length
+	if isConst {
+		rows = 1
+	}
+	args, err := newRegexpArgs(vs, 1, 4)
+	if err != nil {
+		return nil, err
+	}
+	values := make([][]byte, rows)
+	for i := 0; i < rows; i++ {
+		s, ok := getBytesValue(vs[0], i)
+		re, reOk, err := args.regexp(i)
+		if err != nil {
+			return nil, err
+		}
+		pos, posOk := args.int64Arg(2, i, 1)
+		occurrence, occurrenceOk := args.int64Arg(3, i, 1)
+		if !ok || !reOk || !posOk || !occurrenceOk {
+			continue
+		}
+		match, found, err := regular.Substr(s, re, pos, occurrence)
+		if err != nil {
+			return nil, errors.New(errno.DataException, err.Error())
+		}
+		if found {
+			// the empty match is not null
+			values[i] = append([]byte{}, match...)
+		}
+	}
+	return newBytesResult(varcharType, values, isConst, length)
+}
+
+// regexpReplace implements REGEXP_REPLACE(expr, pat, repl[, pos[, occurrence[, match_type]]]),
+// all the matches are replaced if occurrence is 0 which is the default.
+func regexpReplace(vs []*vector.Vector, _ *process.Process) (*vector.Vector, error) {
+	length, isConst := rowCount(vs)
+	rows := length
+	if isConst {
+		rows = 1
+	}
+	args, err := newRegexpArgs(vs, 1, 5)
+	if err != nil {
+		return nil, err
+	}
+	values := make([][]byte, rows)
+	for i := 0; i < rows; i++ {
+		s, ok := getBytesValue(vs[0], i)
+		re, reOk, err := args.regexp(i)
+		if err != nil {
+			return nil, err
+		}
+		repl, replOk := getBytesValue(vs[2], i)
+		pos, posOk := args.int64Arg(3, i, 1)
+		occurrence, occurrenceOk := args.int64Arg(4, i, 0)
+		if !ok || !reOk || !replOk || !posOk || !occurrenceOk {
+			continue
+		}
+		if values[i], err = regular.Replace(s, re, repl, pos, occurrence); err != nil {
+			return nil, errors.New(errno.DataException, err.Error())
+		}
+		if values[i] == nil {
+			values[i] = []byte{}
+		}
+	}
+	return newBytesResult(varcharType, values, isConst, length)
+}
+
+// newInt64Result builds the result vector from the values, the values whose isNull is true are null.
+func newInt64Result(values []int64, isNull []bool, isConst bool, length int) (*vector.Vector, error) {
+	var vec *vector.Vector
+	if isConst {
+		vec = vector.NewConst(int64Type)
+	} else {
+		vec = vector.New(int64Type)
+	}
+	for i, null := range isNull {
+		if null {
+			nulls.Add(vec.Nsp, uint64(i))
+		}
+	}
+	if err := vector.Append(vec, values); err != nil {
+		return nil, err
+	}
+	if isConst {
+		vec.Length = length
+	}
+	return vec, nil
+}
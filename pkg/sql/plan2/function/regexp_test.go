@@ -0,0 +1,86 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/stretchr/testify/require"
+)
+
+func makeConstInt64Vector(v int64, length int) *vector.Vector {
+	vec := vector.NewConst(types.Type{Oid: types.T_int64, Size: 8})
+	vec.Col = []int64{v}
+	vec.Length = length
+	return vec
+}
+
+func TestRegexpLike(t *testing.T) {
+	logs := makeStringVector(t, []string{"ERROR disk full", "info started", "", "Error timeout"})
+	rs, err := regexpLike([]*vector.Vector{logs, makeConstStringVector("^error", 4)}, nil)
+	require.NoError(t, err)
+	require.Equal(t, []bool{false, false, false, false}, rs.Col)
+	require.True(t, nulls.Contains(rs.Nsp, 2))
+
+	rs, err = regexpLike([]*vector.Vector{logs, makeConstStringVector("^error", 4), makeConstStringVector("i", 4)}, nil)
+	require.NoError(t, err)
+	require.Equal(t, []bool{true, false, false, true}, rs.Col)
+
+	// the patterns of the rows
+	patterns := makeStringVector(t, []string{"full$", "^warn", "x", ""})
+	rs, err = regexpLike([]*vector.Vector{logs, patterns}, nil)
+	require.NoError(t, err)
+	require.Equal(t, []bool{true, false, false, false}, rs.Col)
+	require.True(t, nulls.Contains(rs.Nsp, 3))
+
+	_, err = regexpLike([]*vector.Vector{logs, makeConstStringVector("(", 4)}, nil)
+	require.Error(t, err)
+	_, err = regexpLike([]*vector.Vector{logs, makeConstStringVector("a", 4), makeConstStringVector("z", 4)}, nil)
+	require.Error(t, err)
+}
+
+func TestRegexpInstrAndSubstr(t *testing.T) {
+	logs := makeStringVector(t, []string{"took 12ms, 7ms", "no time", ""})
+	rs, err := regexpInstr([]*vector.Vector{logs, makeConstStringVector("[0-9]+ms", 3), makeConstInt64Vector(1, 3), makeConstInt64Vector(2, 3)}, nil)
+	require.NoError(t, err)
+	require.Equal(t, int64(12), rs.Col.([]int64)[0])
+	require.Equal(t, int64(0), rs.Col.([]int64)[1])
+	require.True(t, nulls.Contains(rs.Nsp, 2))
+
+	rs, err = regexpSubstr([]*vector.Vector{logs, makeConstStringVector("[0-9]+ms", 3)}, nil)
+	require.NoError(t, err)
+	require.Equal(t, "12ms", string(rs.Col.(*types.Bytes).Get(0)))
+	require.True(t, nulls.Contains(rs.Nsp, 1))
+	require.True(t, nulls.Contains(rs.Nsp, 2))
+
+	_, err = regexpSubstr([]*vector.Vector{logs, makeConstStringVector("ms", 3), makeConstInt64Vector(0, 3)}, nil)
+	require.Error(t, err)
+}
+
+func TestRegexpReplace(t *testing.T) {
+	rs, err := regexpReplace([]*vector.Vector{
+		makeConstStringVector("user=alice ip=10.0.0.1", 2),
+		makeConstStringVector("([0-9]+)\\.[0-9.]+", 2),
+		makeConstStringVector("$1.x.x.x", 2),
+	}, nil)
+	require.NoError(t, err)
+	require.True(t, rs.IsConst)
+	require.Equal(t, 2, rs.Length)
+	require.Equal(t, "user=alice ip=10.x.x.x", string(rs.Col.(*types.Bytes).Get(0)))
+
+}
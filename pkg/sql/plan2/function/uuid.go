@@ -0,0 +1,172 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+var uuidType = types.Type{Oid: types.T_uuid, Size: 16}
+
+func uuidTypeCheck(inputTypes []types.T, _ []types.T) bool {
+	return len(inputTypes) == 0
+}
+
+// uuidToBinTypeCheck accepts the text of uuid and an optional swap flag
+func uuidToBinTypeCheck(inputTypes []types.T, _ []types.T) bool {
+	if len(inputTypes) < 1 || len(inputTypes) > 2 || !isStringType(inputTypes[0]) {
+		return false
+	}
+	return len(inputTypes) == 1 || inputTypes[1] == types.T_int64 || inputTypes[1] == ScalarNull
+}
+
+// binToUuidTypeCheck accepts an uuid and an optional swap flag
+func binToUuidTypeCheck(inputTypes []types.T, _ []types.T) bool {
+	if len(inputTypes) < 1 || len(inputTypes) > 2 {
+		return false
+	}
+	if inputTypes[0] != types.T_uuid && inputTypes[0] != ScalarNull {
+		return false
+	}
+	return len(inputTypes) == 1 || inputTypes[1] == types.T_int64 || inputTypes[1] == ScalarNull
+}
+
+// getUuidValue returns the i-th value of an uuid vector, and false if it is null
+func getUuidValue(v *vector.Vector, i int) (types.Uuid, bool) {
+	if v.IsConst {
+		i = 0
+	}
+	if nulls.Contains(v.Nsp, uint64(i)) {
+		return types.Uuid{}, false
+	}
+	return v.Col.([]types.Uuid)[i], true
+}
+
+// getSwapFlag returns the i-th swap flag, a null flag means no swap
+func getSwapFlag(vs []*vector.Vector, i int) bool {
+	if len(vs) < 2 {
+		return false
+	}
+	v := vs[1]
+	if v.IsConst {
+		i = 0
+	}
+	if nulls.Contains(v.Nsp, uint64(i)) {
+		return false
+	}
+	return v.Col.([]int64)[i] != 0
+}
+
+// newUuidResult builds the result vector from the values, the values whose isNull is true are null.
+func newUuidResult(values []types.Uuid, isNull []bool, isConst bool, length int) (*vector.Vector, error) {
+	var vec *vector.Vector
+	if isConst {
+		vec = vector.NewConst(uuidType)
+	} else {
+		vec = vector.New(uuidType)
+	}
+	for i, null := range isNull {
+		if null {
+			nulls.Add(vec.Nsp, uint64(i))
+		}
+	}
+	if err := vector.Append(vec, values); err != nil {
+		return nil, err
+	}
+	if isConst {
+		vec.Length = length
+	}
+	return vec, nil
+}
+
+// generateUuid implements UUID(), it returns a version 1 uuid for every row.
+// The row count is taken from the constant null passed by the evaluator.
+func generateUuid(vs []*vector.Vector, _ *process.Process) (*vector.Vector, error) {
+	length, _ := rowCount(vs)
+	values := make([]types.Uuid, length)
+	for i := range values {
+		u, err := types.NewUuid()
+		if err != nil {
+			return nil, err
+		}
+		values[i] = u
+	}
+	return newUuidResult(values, nil, false, length)
+}
+
+// uuidToBin implements UUID_TO_BIN(string_uuid[, swap_flag])
+func uuidToBin(vs []*vector.Vector, _ *process.Process) (*vector.Vector, error) {
+	length, isConst := rowCount(vs)
+	rows := length
+	if isConst {
+		rows = 1
+	}
+	values := make([]types.Uuid, rows)
+	isNull := make([]bool, rows)
+	for i := 0; i < rows; i++ {
+		data, ok := getBytesValue(vs[0], i)
+		if !ok {
+			isNull[i] = true
+			continue
+		}
+		u, err := types.ParseUuid(string(data))
+		if err != nil {
+			return nil, errors.New(errno.DataException, fmt.Sprintf("Incorrect string value: '%s' for function uuid_to_bin", data))
+		}
+		if getSwapFlag(vs, i) {
+			u = u.SwapTime()
+		}
+		values[i] = u
+	}
+	return newUuidResult(values, isNull, isConst, length)
+}
+
+// binToUuid implements BIN_TO_UUID(binary_uuid[, swap_flag])
+func binToUuid(vs []*vector.Vector, _ *process.Process) (*vector.Vector, error) {
+	length, isConst := rowCount(vs)
+	rows := length
+	if isConst {
+		rows = 1
+	}
+	values := make([][]byte, rows)
+	for i := 0; i < rows; i++ {
+		u, ok := getUuidValue(vs[0], i)
+		if !ok {
+			continue
+		}
+		if getSwapFlag(vs, i) {
+			u = u.UnswapTime()
+		}
+		values[i] = []byte(u.String())
+	}
+	return newBytesResult(types.Type{Oid: types.T_varchar, Size: 24}, values, isConst, length)
+}
+
+// castVarcharToUuid parses the text of uuid
+func castVarcharToUuid(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return uuidToBin(vs[:1], proc)
+}
+
+// castUuidToVarchar returns the text of uuid
+func castUuidToVarchar(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return binToUuid(vs[:1], proc)
+}
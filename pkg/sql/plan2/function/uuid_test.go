@@ -0,0 +1,76 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package function
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/stretchr/testify/require"
+)
+
+func makeStringVector(t *testing.T, ss []string) *vector.Vector {
+	vec := vector.New(types.Type{Oid: types.T_varchar, Size: 24})
+	values := make([][]byte, len(ss))
+	for i, s := range ss {
+		if s == "" {
+			nulls.Add(vec.Nsp, uint64(i))
+			continue
+		}
+		values[i] = []byte(s)
+	}
+	require.NoError(t, vector.Append(vec, values))
+	return vec
+}
+
+func TestGenerateUuid(t *testing.T) {
+	rowCount := vector.NewConst(types.Type{Oid: types.T_int64})
+	nulls.Add(rowCount.Nsp, 0)
+	rowCount.Length = 3
+
+	rs, err := generateUuid([]*vector.Vector{rowCount}, nil)
+	require.NoError(t, err)
+	require.Equal(t, types.T_uuid, rs.Typ.Oid)
+	us := rs.Col.([]types.Uuid)
+	require.Equal(t, 3, len(us))
+	require.NotEqual(t, us[0], us[1])
+	require.NotEqual(t, us[1], us[2])
+}
+
+func TestUuidToBin(t *testing.T) {
+	text := "6ccd780c-baba-1026-9564-5b8c656024db"
+	ss := makeStringVector(t, []string{text, ""})
+
+	rs, err := uuidToBin([]*vector.Vector{ss}, nil)
+	require.NoError(t, err)
+	require.Equal(t, text, rs.Col.([]types.Uuid)[0].String())
+	require.True(t, nulls.Contains(rs.Nsp, 1))
+
+	swap := vector.NewConst(types.Type{Oid: types.T_int64})
+	swap.Col = []int64{1}
+	swap.Length = 2
+	swapped, err := uuidToBin([]*vector.Vector{ss, swap}, nil)
+	require.NoError(t, err)
+	require.Equal(t, "1026baba-6ccd-780c-9564-5b8c656024db", swapped.Col.([]types.Uuid)[0].String())
+
+	rs, err = binToUuid([]*vector.Vector{swapped, swap}, nil)
+	require.NoError(t, err)
+	require.Equal(t, text, string(rs.Col.(*types.Bytes).Get(0)))
+	require.True(t, nulls.Contains(rs.Nsp, 1))
+
+	_, err = uuidToBin([]*vector.Vector{makeStringVector(t, []string{"abc"})}, nil)
+	require.Error(t, err)
+}
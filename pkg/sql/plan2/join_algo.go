@@ -0,0 +1,257 @@
+// Copyright 2021 - 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan2
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+)
+
+// IndexJoinThreshold is the max estimated rows of the left side of a join looking up
+// the rows of the right side by its primary key, one lookup is done for each row.
+var IndexJoinThreshold float64 = 10000
+
+// mergeJoinTypes are the types of the keys which can be compared by the merge join
+var mergeJoinTypes = map[plan.Type_TypeId]bool{
+	plan.Type_INT8:       true,
+	plan.Type_INT16:      true,
+	plan.Type_INT32:      true,
+	plan.Type_INT64:      true,
+	plan.Type_UINT8:      true,
+	plan.Type_UINT16:     true,
+	plan.Type_UINT32:     true,
+	plan.Type_UINT64:     true,
+	plan.Type_FLOAT32:    true,
+	plan.Type_FLOAT64:    true,
+	plan.Type_DECIMAL64:  true,
+	plan.Type_DECIMAL128: true,
+	plan.Type_DATE:       true,
+	plan.Type_DATETIME:   true,
+	plan.Type_CHAR:       true,
+	plan.Type_VARCHAR:    true,
+	plan.Type_UUID:       true,
+}
+
+// ChooseJoinAlgos picks the algorithm of every inner equi-join of the query:
+//
+//	INDEX if the right side is a plain scan joined on the primary key of its table and the
+//	left side is small, the rows of the right side are looked up by the keys of the left rows.
+//	MERGE if both sides are sorted ascending on the join keys, by a sort or by the scan of a
+//	sorted table, no hash table is built.
+//
+// The other joins stay hash joins.
+func ChooseJoinAlgos(qry *Query) {
+	if qry.StmtType != plan.Query_SELECT {
+		return
+	}
+	for _, n := range qry.Nodes {
+		if n.NodeType != plan.Node_JOIN || n.JoinType != plan.Node_INNER {
+			continue
+		}
+		left, right := qry.Nodes[n.Children[0]], qry.Nodes[n.Children[1]]
+		lkeys, rkeys, ok := joinKeys(n, left, right)
+		if !ok {
+			continue
+		}
+		switch {
+		case canIndexJoin(qry, left, right, rkeys):
+			n.JoinAlgo = plan.Node_INDEX
+		case canMergeJoin(qry, n, left, right, lkeys, rkeys):
+			n.JoinAlgo = plan.Node_MERGE
+		}
+	}
+}
+
+// canIndexJoin returns true if the only key of the right side is the primary key of the scanned table
+func canIndexJoin(qry *Query, left, right *Node, rkeys []*Expr) bool {
+	if len(rkeys) != 1 || right.NodeType != plan.Node_TABLE_SCAN || right.TableDef == nil {
+		return false
+	}
+	if len(right.WhereList) > 0 || len(right.OrderBy) > 0 || right.Limit != nil || right.Offset != nil {
+		return false
+	}
+	for _, e := range right.ProjectList {
+		if _, ok := e.Expr.(*plan.Expr_Col); !ok {
+			return false
+		}
+	}
+	col := right.ProjectList[rkeys[0].Expr.(*plan.Expr_Col).Col.ColPos].Expr.(*plan.Expr_Col).Col
+	if int(col.ColPos) >= len(right.TableDef.Cols) || !right.TableDef.Cols[col.ColPos].Primary {
+		return false
+	}
+	return estimateRows(qry, left) <= IndexJoinThreshold
+}
+
+// canMergeJoin returns true if both sides are sorted on the keys, the conditions
+// of the join are reordered to follow the order of the sides.
+func canMergeJoin(qry *Query, n, left, right *Node, lkeys, rkeys []*Expr) bool {
+	lorder, rorder := sortedOn(qry, left), sortedOn(qry, right)
+	if len(lorder) < len(lkeys) || len(rorder) < len(rkeys) {
+		return false
+	}
+	conds := make([]*Expr, len(n.OnList))
+	for k := range conds {
+		for i, cond := range n.OnList {
+			if lkeys[i].Expr.(*plan.Expr_Col).Col.ColPos == lorder[k] &&
+				rkeys[i].Expr.(*plan.Expr_Col).Col.ColPos == rorder[k] &&
+				mergeJoinTypes[lkeys[i].Typ.GetId()] {
+				conds[k] = cond
+				break
+			}
+		}
+		if conds[k] == nil {
+			return false
+		}
+	}
+	n.OnList = conds
+	return true
+}
+
+// sortedOn returns the columns of the node's output by which its rows are sorted ascending,
+// in the order of the sort keys.
+func sortedOn(qry *Query, n *Node) []int32 {
+	var cols []int32
+	switch n.NodeType {
+	case plan.Node_TABLE_SCAN:
+		// a sorted table is read in the order of its primary key
+		if value, ok := getTableProperty(n.TableDef, SortedKey); !ok || value != "true" || len(n.OrderBy) > 0 {
+			return nil
+		}
+		for i, e := range n.ProjectList {
+			if col, ok := e.Expr.(*plan.Expr_Col); ok && int(col.Col.ColPos) < len(n.TableDef.Cols) &&
+				n.TableDef.Cols[col.Col.ColPos].Primary {
+				return []int32{int32(i)}
+			}
+		}
+		return nil
+	case plan.Node_SORT:
+		for _, spec := range n.OrderBy {
+			col, ok := spec.Expr.Expr.(*plan.Expr_Col)
+			if !ok || spec.Flag == plan.OrderBySpec_DESC || spec.Collation == "DESC" {
+				break
+			}
+			cols = append(cols, col.Col.ColPos)
+		}
+	case plan.Node_PROJECT:
+		if len(n.OrderBy) > 0 {
+			return nil
+		}
+		cols = sortedOn(qry, qry.Nodes[n.Children[0]])
+	default:
+		return nil
+	}
+	// map the sorted columns of the input to the output
+	child := qry.Nodes[n.Children[0]]
+	var outs []int32
+	for _, c := range cols {
+		pos := int32(-1)
+		for i, e := range n.ProjectList {
+			if col, ok := e.Expr.(*plan.Expr_Col); ok && col.Col.RelPos == 0 && sameColumn(child, col.Col.ColPos, c) {
+				pos = int32(i)
+				break
+			}
+		}
+		if pos < 0 {
+			break
+		}
+		outs = append(outs, pos)
+	}
+	return outs
+}
+
+// sameColumn returns true if the columns x and y of the node's output are the same column of its input
+func sameColumn(n *Node, x, y int32) bool {
+	if x == y {
+		return true
+	}
+	if int(x) >= len(n.ProjectList) || int(y) >= len(n.ProjectList) {
+		return false
+	}
+	cx, ok := n.ProjectList[x].Expr.(*plan.Expr_Col)
+	if !ok {
+		return false
+	}
+	cy, ok := n.ProjectList[y].Expr.(*plan.Expr_Col)
+	return ok && cx.Col.RelPos == cy.Col.RelPos && cx.Col.ColPos == cy.Col.ColPos
+}
+
+// joinKeys returns the keys of both sides of the join if the join can be partitioned by them,
+// the keys of both sides must have the same type to be hashed to the same partition.
+func joinKeys(n, left, right *Node) ([]*Expr, []*Expr, bool) {
+	switch n.JoinType {
+	case plan.Node_INNER, plan.Node_OUTER, plan.Node_SEMI, plan.Node_RIGHT, plan.Node_OUTER | plan.Node_RIGHT:
+	default:
+		return nil, nil, false
+	}
+	if len(n.OnList) == 0 {
+		return nil, nil, false
+	}
+	lkeys := make([]*Expr, len(n.OnList))
+	rkeys := make([]*Expr, len(n.OnList))
+	for i, cond := range n.OnList {
+		f, ok := cond.Expr.(*plan.Expr_F)
+		if !ok || f.F.Func.GetObjName() != "=" || len(f.F.Args) != 2 {
+			return nil, nil, false
+		}
+		for _, arg := range f.F.Args {
+			col, ok := arg.Expr.(*plan.Expr_Col)
+			if !ok {
+				return nil, nil, false
+			}
+			switch {
+			case col.Col.RelPos == 0 && int(col.Col.ColPos) < len(left.ProjectList):
+				lkeys[i] = &Expr{
+					Typ:     arg.Typ,
+					ColName: arg.ColName,
+					Expr:    &plan.Expr_Col{Col: &ColRef{ColPos: col.Col.ColPos}},
+				}
+			case col.Col.RelPos == 1 && int(col.Col.ColPos) < len(right.ProjectList):
+				rkeys[i] = &Expr{
+					Typ:     arg.Typ,
+					ColName: arg.ColName,
+					Expr:    &plan.Expr_Col{Col: &ColRef{ColPos: col.Col.ColPos}},
+				}
+			default:
+				return nil, nil, false
+			}
+		}
+		if lkeys[i] == nil || rkeys[i] == nil {
+			return nil, nil, false
+		}
+		if lkeys[i].Typ.GetId() != rkeys[i].Typ.GetId() || lkeys[i].Typ.GetScale() != rkeys[i].Typ.GetScale() {
+			return nil, nil, false
+		}
+	}
+	return lkeys, rkeys, true
+}
+
+// estimateRows returns the estimated number of rows produced by the node, 0 means unknown
+func estimateRows(qry *Query, n *Node) float64 {
+	switch n.NodeType {
+	case plan.Node_TABLE_SCAN:
+		return n.GetCost().GetCard()
+	case plan.Node_JOIN:
+		var rows float64
+		for _, child := range n.Children {
+			if r := estimateRows(qry, qry.Nodes[child]); r > rows {
+				rows = r
+			}
+		}
+		return rows
+	}
+	if len(n.Children) > 0 {
+		return estimateRows(qry, qry.Nodes[n.Children[0]])
+	}
+	return 0
+}
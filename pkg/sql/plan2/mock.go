@@ -195,6 +195,102 @@ func NewMockCompilerContext() *MockCompilerContext {
 		}
 	}
 
+	// nation_ext is an external table over the csv files of the nation
+	tables["nation_ext"] = &TableDef{
+		Name: "nation_ext",
+		Cols: tables["nation"].Cols,
+		Defs: []*plan.TableDef_DefType{
+			{
+				Def: &plan.TableDef_DefType_Properties{
+					Properties: &plan.PropertiesDef{
+						Properties: []*plan.Property{
+							{
+								Key:   ExternParamKey,
+								Value: `{"Location":"file:///data/tpch/nation*.csv","FileFormat":"csv"}`,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	objects["nation_ext"] = &ObjectRef{
+		Obj:        int64(len(tpchSchema)),
+		SchemaName: "tpch",
+		ObjName:    "nation_ext",
+	}
+
+	// nation_view is a view over the nation, invalid_view references a dropped table
+	views := map[string]string{
+		"nation_view":  `{"stmt":"select n_nationkey, n_name from nation where n_regionkey = 1","database":"tpch"}`,
+		"invalid_view": `{"stmt":"select n_nationkey, n_name from dropped_nation","database":"tpch"}`,
+	}
+	for name, view := range views {
+		tables[name] = &TableDef{
+			Name: name,
+			Cols: []*ColDef{
+				{Name: "nation_key", Typ: tables["nation"].Cols[0].Typ},
+				{Name: "nation_name", Typ: tables["nation"].Cols[1].Typ},
+			},
+			Defs: []*plan.TableDef_DefType{
+				{
+					Def: &plan.TableDef_DefType_Properties{
+						Properties: &plan.PropertiesDef{
+							Properties: []*plan.Property{
+								{
+									Key:   ViewKey,
+									Value: view,
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+		objects[name] = &ObjectRef{
+			Obj:        int64(len(objects)),
+			SchemaName: "tpch",
+			ObjName:    name,
+		}
+	}
+
+	// nation_mv is a materialized view counting the nations of the regions
+	int64Type := &plan.Type{Id: plan.Type_INT64, Size: 8}
+	tables["nation_mv"] = &TableDef{
+		Name: "nation_mv",
+		Cols: []*ColDef{
+			{Name: "region_key", Typ: tables["nation"].Cols[2].Typ},
+			{Name: "nations", Typ: int64Type},
+			{Name: MaterializedViewKeyColumn, Typ: &plan.Type{Id: plan.Type_VARCHAR, Width: 1024}},
+			{Name: MaterializedViewCountColumn, Typ: int64Type},
+			{Name: "__mo_mv_value_0", Typ: int64Type},
+			{Name: "__mo_mv_nulls_0", Typ: int64Type},
+		},
+		Defs: []*plan.TableDef_DefType{
+			{
+				Def: &plan.TableDef_DefType_Properties{
+					Properties: &plan.PropertiesDef{
+						Properties: []*plan.Property{
+							{
+								Key:   MaterializedViewKey,
+								Value: `{"stmt":"select n_regionkey, count(*) from nation group by n_regionkey","database":"tpch","table":"nation"}`,
+							},
+							{
+								Key:   MaterializedViewSourceKey,
+								Value: "nation",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	objects["nation_mv"] = &ObjectRef{
+		Obj:        int64(len(objects)),
+		SchemaName: "tpch",
+		ObjName:    "nation_mv",
+	}
+
 	return &MockCompilerContext{
 		objects: objects,
 		tables:  tables,
@@ -61,6 +61,7 @@ func (opt *BaseOptimizer) optimize() (*Query, error) {
 	for _, step := range opt.qry.Steps {
 		opt.exploreNode(opt.qry.Nodes[step])
 	}
+	ChooseJoinAlgos(opt.qry)
 	return opt.qry, nil
 }
 
@@ -48,6 +48,11 @@ func (r *ConstantFold) Apply(n *plan.Node, _ *plan.Query) {
 			n.ProjectList[i] = r.constantFold(n.ProjectList[i])
 		}
 	}
+	if len(n.TableFuncArgs) > 0 {
+		for i := range n.TableFuncArgs {
+			n.TableFuncArgs[i] = r.constantFold(n.TableFuncArgs[i])
+		}
+	}
 }
 
 func (r *ConstantFold) constantFold(e *plan.Expr) *plan.Expr {
@@ -60,12 +60,100 @@ type BaseOptimizer struct {
 	ctx   CompilerContext
 }
 
+// Sample is the TABLESAMPLE clause of a table, which is kept in the ExtraOptions of the SAMPLE node
+type Sample struct {
+	Method     tree.SampleMethod
+	Percent    float64
+	Repeatable bool
+	Seed       int64
+}
+
+const (
+	// ExternParamKey is the property of the external table keeping the json of its tree.ExternParam
+	ExternParamKey = "ExternParam"
+	// AutoIncrementKey is the property of the table keeping the first value of its auto increment column
+	AutoIncrementKey = "AutoIncrement"
+	// ViewKey is the property of the view keeping the json of its ViewDef
+	ViewKey = "View"
+	// MaterializedViewKey is the property of the materialized view keeping the json of its MaterializedViewDef
+	MaterializedViewKey = "MaterializedView"
+	// MaterializedViewSourceKey is the property of the materialized view keeping the name of the table it aggregates
+	MaterializedViewSourceKey = "MaterializedViewSource"
+	// CheckKey is the property of the table keeping the json of its CHECK constraints
+	CheckKey = "Check"
+	// ForeignKeyKey is the property of the table keeping the json of its foreign keys
+	ForeignKeyKey = "ForeignKey"
+	// ForeignKeyReferenceKey is the property of the table keeping a table referenced by its foreign keys, as database.table
+	ForeignKeyReferenceKey = "ForeignKeyReference"
+	// SortedKey is the property of the table read in the order of its primary key, it is
+	// set by the compiler context when the table is resolved, and is never stored.
+	SortedKey = "Sorted"
+	// ExternFilePrefix is the scheme of the location of the local files
+	ExternFilePrefix = "file://"
+)
+
+// ViewDef is the definition of a view, the view is expanded by building its select statement
+// in the database where it was created
+type ViewDef struct {
+	Stmt     string `json:"stmt"`
+	Database string `json:"database"`
+}
+
+const (
+	// MaterializedViewKeyColumn is the hidden primary key of a materialized view, the encoded group keys
+	MaterializedViewKeyColumn = "__mo_mv_key"
+	// MaterializedViewCountColumn is the hidden column keeping the number of the rows of a group
+	MaterializedViewCountColumn = "__mo_mv_count"
+	// MaterializedViewColumnPrefix is the prefix of the hidden columns of a materialized view
+	MaterializedViewColumnPrefix = "__mo_mv_"
+)
+
+// MaterializedViewDef is the definition of a materialized view, whose rows are the groups of an
+// aggregation of a table. The visible columns of the view are followed by the hidden columns
+// keeping the key, the row count and the state of the aggregates of every group, so that the
+// groups are updated by the rows changed in the table without reading it again.
+type MaterializedViewDef struct {
+	Stmt     string `json:"stmt"`
+	Database string `json:"database"`
+	// Table is the table aggregated, Columns are its columns in the order of the column
+	// positions referenced by the expressions of Agg
+	Table   string   `json:"table"`
+	Columns []string `json:"columns"`
+	// Agg is the protobuf of the AGG node, its WhereList filters the rows of the table,
+	// and its ProjectList is the visible columns of the view
+	Agg []byte `json:"agg"`
+}
+
+// CheckDef is a CHECK constraint of a table, the rows written into the table must not make the
+// expression false. Expr is the protobuf of the expression whose columns are the table's columns.
+type CheckDef struct {
+	Name     string `json:"name"`
+	Text     string `json:"text"`
+	Enforced bool   `json:"enforced"`
+	Expr     []byte `json:"expr"`
+}
+
+// ForeignKeyDef is a foreign key of a table, the non-null values of Column must be the primary key
+// of a row of Table in Database. OnDelete is the action on the rows referencing a row deleted
+// from Table, a primary key changed by UPDATE is handled as the delete of the old key.
+type ForeignKeyDef struct {
+	Name      string                   `json:"name"`
+	Column    string                   `json:"column"`
+	Database  string                   `json:"database"`
+	Table     string                   `json:"table"`
+	RefColumn string                   `json:"ref_column"`
+	OnDelete  tree.ReferenceOptionType `json:"on_delete"`
+}
+
 //use for build select
 type BinderContext struct {
 	// when build_projection we may set columnAlias and then use in build_orderby
 	columnAlias map[string]*Expr
 	// when build_cte will set cteTables and use in build_from
 	cteTables map[string]*TableDef
+	// cteNodes maps the name of a cte to the node computing its rows, the references
+	// of the cte refer to the node by the Obj of their ObjRef.
+	cteNodes map[string]int32
 
 	// use for build subquery
 	subqueryIsCorrelated bool
@@ -73,4 +161,7 @@ type BinderContext struct {
 	// subqueryIsScalar     bool
 
 	subqueryParentIds []int32
+
+	// when build ON DUPLICATE KEY UPDATE, VALUES(col) refers to insertValues[col]
+	insertValues map[string]*Expr
 }
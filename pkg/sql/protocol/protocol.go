@@ -28,10 +28,13 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/ring"
 	"github.com/matrixorigin/matrixone/pkg/container/ring/approxcd"
+	"github.com/matrixorigin/matrixone/pkg/container/ring/approxpct"
 	"github.com/matrixorigin/matrixone/pkg/container/ring/avg"
 	"github.com/matrixorigin/matrixone/pkg/container/ring/count"
 	"github.com/matrixorigin/matrixone/pkg/container/ring/max"
 	"github.com/matrixorigin/matrixone/pkg/container/ring/min"
+	"github.com/matrixorigin/matrixone/pkg/container/ring/moment"
+	"github.com/matrixorigin/matrixone/pkg/container/ring/percentile"
 	"github.com/matrixorigin/matrixone/pkg/container/ring/starcount"
 	"github.com/matrixorigin/matrixone/pkg/container/ring/sum"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
@@ -1120,6 +1123,15 @@ func EncodeRing(r ring.Ring, buf *bytes.Buffer) error {
 	case *approxcd.ApproxCountDistinctRing:
 		buf.WriteByte(ApproxCountDistinctRing)
 		return v.Marshal(buf)
+	case *approxpct.ApproxPercentileRing:
+		buf.WriteByte(ApproxPercentileRing)
+		return v.Marshal(buf)
+	case *moment.MomentRing:
+		buf.WriteByte(MomentRing)
+		return v.Marshal(buf)
+	case *percentile.PercentileRing:
+		buf.WriteByte(PercentileRing)
+		return v.Marshal(buf)
 	case *max.Int8Ring:
 		buf.WriteByte(MaxInt8Ring)
 		// Ns
@@ -2074,6 +2086,21 @@ func DecodeRing(data []byte) (ring.Ring, []byte, error) {
 		r := approxcd.NewApproxCountDistinct(types.Type{})
 		data, err := r.Unmarshal(data)
 		return r, data, err
+	case ApproxPercentileRing:
+		data = data[1:]
+		r := approxpct.NewApproxPercentile(types.Type{}, 0, false)
+		data, err := r.Unmarshal(data)
+		return r, data, err
+	case MomentRing:
+		data = data[1:]
+		r := moment.NewMomentRing(types.Type{}, 0)
+		data, err := r.Unmarshal(data)
+		return r, data, err
+	case PercentileRing:
+		data = data[1:]
+		r := percentile.NewPercentile(types.Type{}, 0, false)
+		data, err := r.Unmarshal(data)
+		return r, data, err
 	case MaxInt8Ring:
 		r := new(max.Int8Ring)
 		data = data[1:]
@@ -3288,6 +3315,21 @@ func DecodeRingWithProcess(data []byte, proc *process.Process) (ring.Ring, []byt
 		r := approxcd.NewApproxCountDistinct(types.Type{})
 		data, err := r.UnmarshalWithProc(data, proc)
 		return r, data, err
+	case ApproxPercentileRing:
+		data = data[1:]
+		r := approxpct.NewApproxPercentile(types.Type{}, 0, false)
+		data, err := r.UnmarshalWithProc(data, proc)
+		return r, data, err
+	case MomentRing:
+		data = data[1:]
+		r := moment.NewMomentRing(types.Type{}, 0)
+		data, err := r.UnmarshalWithProc(data, proc)
+		return r, data, err
+	case PercentileRing:
+		data = data[1:]
+		r := percentile.NewPercentile(types.Type{}, 0, false)
+		data, err := r.UnmarshalWithProc(data, proc)
+		return r, data, err
 	case MaxInt8Ring:
 		r := new(max.Int8Ring)
 		data = data[1:]
@@ -4622,7 +4664,7 @@ func EncodeVector(v *vector.Vector, buf *bytes.Buffer) error {
 		buf.Write(encoding.EncodeUint64(v.Link))
 		buf.Write(encoding.EncodeUint32(uint32(len(v.Data))))
 		buf.Write(v.Data)
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		buf.Write(encoding.EncodeType(v.Typ))
 		buf.Write(encoding.EncodeUint64(v.Ref))
 		nb, err := v.Nsp.Show()
@@ -4656,7 +4698,7 @@ func EncodeVector(v *vector.Vector, buf *bytes.Buffer) error {
 		buf.Write(encoding.EncodeUint64(v.Link))
 		buf.Write(encoding.EncodeUint32(uint32(len(v.Data))))
 		buf.Write(v.Data)
-	case types.T_uint64:
+	case types.T_uint64, types.T_set:
 		buf.Write(encoding.EncodeType(v.Typ))
 		buf.Write(encoding.EncodeUint64(v.Ref))
 		nb, err := v.Nsp.Show()
@@ -4707,7 +4749,7 @@ func EncodeVector(v *vector.Vector, buf *bytes.Buffer) error {
 		buf.Write(encoding.EncodeUint64(v.Link))
 		buf.Write(encoding.EncodeUint32(uint32(len(v.Data))))
 		buf.Write(v.Data)
-	case types.T_char, types.T_varchar:
+	case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 		buf.Write(encoding.EncodeType(v.Typ))
 		buf.Write(encoding.EncodeUint64(v.Ref))
 		nb, err := v.Nsp.Show()
@@ -4810,6 +4852,57 @@ func EncodeVector(v *vector.Vector, buf *bytes.Buffer) error {
 		buf.Write(encoding.EncodeUint64(v.Link))
 		buf.Write(encoding.EncodeUint32(uint32(len(v.Data))))
 		buf.Write(v.Data)
+	case types.T_time:
+		buf.Write(encoding.EncodeType(v.Typ))
+		buf.Write(encoding.EncodeUint64(v.Ref))
+		nb, err := v.Nsp.Show()
+		if err != nil {
+			return err
+		}
+		buf.Write(encoding.EncodeUint32(uint32(len(nb))))
+		if len(nb) > 0 {
+			buf.Write(nb)
+		}
+		vs := v.Col.([]types.Time)
+		buf.Write(encoding.EncodeUint32(uint32(len(vs))))
+		buf.Write(encoding.EncodeTimeSlice(vs))
+		buf.Write(encoding.EncodeUint64(v.Link))
+		buf.Write(encoding.EncodeUint32(uint32(len(v.Data))))
+		buf.Write(v.Data)
+	case types.T_year:
+		buf.Write(encoding.EncodeType(v.Typ))
+		buf.Write(encoding.EncodeUint64(v.Ref))
+		nb, err := v.Nsp.Show()
+		if err != nil {
+			return err
+		}
+		buf.Write(encoding.EncodeUint32(uint32(len(nb))))
+		if len(nb) > 0 {
+			buf.Write(nb)
+		}
+		vs := v.Col.([]types.YearValue)
+		buf.Write(encoding.EncodeUint32(uint32(len(vs))))
+		buf.Write(encoding.EncodeYearValueSlice(vs))
+		buf.Write(encoding.EncodeUint64(v.Link))
+		buf.Write(encoding.EncodeUint32(uint32(len(v.Data))))
+		buf.Write(v.Data)
+	case types.T_uuid:
+		buf.Write(encoding.EncodeType(v.Typ))
+		buf.Write(encoding.EncodeUint64(v.Ref))
+		nb, err := v.Nsp.Show()
+		if err != nil {
+			return err
+		}
+		buf.Write(encoding.EncodeUint32(uint32(len(nb))))
+		if len(nb) > 0 {
+			buf.Write(nb)
+		}
+		vs := v.Col.([]types.Uuid)
+		buf.Write(encoding.EncodeUint32(uint32(len(vs))))
+		buf.Write(encoding.EncodeUuidSlice(vs))
+		buf.Write(encoding.EncodeUint64(v.Link))
+		buf.Write(encoding.EncodeUint32(uint32(len(v.Data))))
+		buf.Write(v.Data)
 	case types.T_decimal64:
 		buf.Write(encoding.EncodeType(v.Typ))
 		buf.Write(encoding.EncodeUint64(v.Ref))
@@ -4994,7 +5087,7 @@ func DecodeVector(data []byte) (*vector.Vector, []byte, error) {
 		v.Data = data[:n]
 		data = data[n:]
 		return v, data, nil
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		v := vector.New(typ)
 		v.Or = true
 		v.Ref = encoding.DecodeUint64(data[:8])
@@ -5050,7 +5143,7 @@ func DecodeVector(data []byte) (*vector.Vector, []byte, error) {
 		v.Data = data[:n]
 		data = data[n:]
 		return v, data, nil
-	case types.T_uint64:
+	case types.T_uint64, types.T_set:
 		v := vector.New(typ)
 		v.Or = true
 		v.Ref = encoding.DecodeUint64(data[:8])
@@ -5134,7 +5227,7 @@ func DecodeVector(data []byte) (*vector.Vector, []byte, error) {
 		v.Data = data[:n]
 		data = data[n:]
 		return v, data, nil
-	case types.T_char, types.T_varchar:
+	case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 		v := vector.New(typ)
 		v.Or = true
 		v.Ref = encoding.DecodeUint64(data[:8])
@@ -5292,6 +5385,90 @@ func DecodeVector(data []byte) (*vector.Vector, []byte, error) {
 		v.Data = data[:n]
 		data = data[n:]
 		return v, data, nil
+	case types.T_time:
+		v := vector.New(typ)
+		v.Or = true
+		v.Ref = encoding.DecodeUint64(data[:8])
+		data = data[8:]
+		if n := encoding.DecodeUint32(data[:4]); n > 0 {
+			data = data[4:]
+			if err := v.Nsp.Read(data[:n]); err != nil {
+				return nil, nil, err
+			}
+			data = data[n:]
+		} else {
+			data = data[4:]
+		}
+		if n := encoding.DecodeUint32(data[:4]); n > 0 {
+			data = data[4:]
+			v.Col = encoding.DecodeTimeSlice(data[:n*8])
+			data = data[n*8:]
+		} else {
+			data = data[4:]
+		}
+		v.Link = encoding.DecodeUint64(data[:8])
+		data = data[8:]
+		n := encoding.DecodeUint32(data[:4])
+		data = data[4:]
+		v.Data = data[:n]
+		data = data[n:]
+		return v, data, nil
+	case types.T_year:
+		v := vector.New(typ)
+		v.Or = true
+		v.Ref = encoding.DecodeUint64(data[:8])
+		data = data[8:]
+		if n := encoding.DecodeUint32(data[:4]); n > 0 {
+			data = data[4:]
+			if err := v.Nsp.Read(data[:n]); err != nil {
+				return nil, nil, err
+			}
+			data = data[n:]
+		} else {
+			data = data[4:]
+		}
+		if n := encoding.DecodeUint32(data[:4]); n > 0 {
+			data = data[4:]
+			v.Col = encoding.DecodeYearValueSlice(data[:n*2])
+			data = data[n*2:]
+		} else {
+			data = data[4:]
+		}
+		v.Link = encoding.DecodeUint64(data[:8])
+		data = data[8:]
+		n := encoding.DecodeUint32(data[:4])
+		data = data[4:]
+		v.Data = data[:n]
+		data = data[n:]
+		return v, data, nil
+	case types.T_uuid:
+		v := vector.New(typ)
+		v.Or = true
+		v.Ref = encoding.DecodeUint64(data[:8])
+		data = data[8:]
+		if n := encoding.DecodeUint32(data[:4]); n > 0 {
+			data = data[4:]
+			if err := v.Nsp.Read(data[:n]); err != nil {
+				return nil, nil, err
+			}
+			data = data[n:]
+		} else {
+			data = data[4:]
+		}
+		if n := encoding.DecodeUint32(data[:4]); n > 0 {
+			data = data[4:]
+			v.Col = encoding.DecodeUuidSlice(data[:n*16])
+			data = data[n*16:]
+		} else {
+			data = data[4:]
+		}
+		v.Link = encoding.DecodeUint64(data[:8])
+		data = data[8:]
+		n := encoding.DecodeUint32(data[:4])
+		data = data[4:]
+		v.Data = data[:n]
+		data = data[n:]
+		return v, data, nil
 	case types.T_decimal64:
 		v := vector.New(typ)
 		v.Or = true
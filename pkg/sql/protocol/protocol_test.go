@@ -27,12 +27,16 @@ import (
 
 	"github.com/axiomhq/hyperloglog"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/ring"
 	"github.com/matrixorigin/matrixone/pkg/container/ring/approxcd"
+	"github.com/matrixorigin/matrixone/pkg/container/ring/approxpct"
 	"github.com/matrixorigin/matrixone/pkg/container/ring/avg"
 	"github.com/matrixorigin/matrixone/pkg/container/ring/count"
 	"github.com/matrixorigin/matrixone/pkg/container/ring/max"
 	"github.com/matrixorigin/matrixone/pkg/container/ring/min"
+	"github.com/matrixorigin/matrixone/pkg/container/ring/moment"
+	"github.com/matrixorigin/matrixone/pkg/container/ring/percentile"
 	"github.com/matrixorigin/matrixone/pkg/container/ring/starcount"
 	"github.com/matrixorigin/matrixone/pkg/container/ring/sum"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
@@ -56,6 +60,10 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/viewexec/transformer"
 	"github.com/matrixorigin/matrixone/pkg/sql/viewexec/untransform"
 	"github.com/matrixorigin/matrixone/pkg/vm"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
 	"github.com/stretchr/testify/require"
 )
 
@@ -1687,6 +1695,100 @@ func TestRing(t *testing.T) {
 	}
 }
 
+func TestApproxPercentileRing(t *testing.T) {
+	proc := process.New(mheap.New(guest.New(1<<30, host.New(1<<30))))
+	vec := vector.New(types.Type{Oid: types.T_float64})
+	require.NoError(t, vector.Append(vec, []float64{-3.5, 0, 1, 2, 100, 1e6}))
+	nulls.Add(vec.Nsp, 5)
+	r := approxpct.NewApproxPercentile(vec.Typ, 0.5, true)
+	require.NoError(t, r.Grows(3, proc.Mp))
+	r.BulkFill(0, []int64{1, 1, 2, 1, 3, 1}, vec)
+	r.Fill(1, 4, 2, vec)
+
+	var buf bytes.Buffer
+	require.NoError(t, EncodeRing(r, &buf))
+	decoded, data, err := DecodeRing(buf.Bytes())
+	require.NoError(t, err)
+	require.Equal(t, 0, len(data))
+	withProc, data, err := DecodeRingWithProcess(buf.Bytes(), proc)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(data))
+
+	expect := r.Eval(nil)
+	for _, got := range []ring.Ring{decoded, withProc} {
+		pr := got.(*approxpct.ApproxPercentileRing)
+		require.Equal(t, r.Typ, pr.Typ)
+		require.Equal(t, 0.5, pr.Percentile)
+		require.True(t, pr.Bound)
+		vec := pr.Eval(nil)
+		require.Equal(t, expect.Col, vec.Col)
+		require.Equal(t, expect.Nsp, vec.Nsp)
+	}
+}
+
+func TestMomentRing(t *testing.T) {
+	proc := process.New(mheap.New(guest.New(1<<30, host.New(1<<30))))
+	xs := vector.New(types.Type{Oid: types.T_int64})
+	require.NoError(t, vector.Append(xs, []int64{1, 2, 3, 4, 5, 6}))
+	ys := vector.New(types.Type{Oid: types.T_float64})
+	require.NoError(t, vector.Append(ys, []float64{2, 4.5, 5, 9, 11, 0}))
+	nulls.Add(ys.Nsp, 5)
+	r := moment.NewMomentRing(xs.Typ, moment.Corr)
+	r.SetArgument(ys)
+	require.NoError(t, r.Grows(2, proc.Mp))
+	r.BulkFill(0, []int64{1, 2, 1, 1, 3, 1}, xs)
+
+	var buf bytes.Buffer
+	require.NoError(t, EncodeRing(r, &buf))
+	decoded, data, err := DecodeRing(buf.Bytes())
+	require.NoError(t, err)
+	require.Equal(t, 0, len(data))
+	withProc, data, err := DecodeRingWithProcess(buf.Bytes(), proc)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(data))
+
+	expect := r.Eval(nil)
+	for _, got := range []ring.Ring{decoded, withProc} {
+		mr := got.(*moment.MomentRing)
+		require.Equal(t, xs.Typ, mr.Typ)
+		require.Equal(t, moment.Corr, mr.Kind)
+		vec := mr.Eval(nil)
+		require.Equal(t, expect.Col, vec.Col)
+		require.Equal(t, expect.Nsp, vec.Nsp)
+	}
+}
+
+func TestPercentileRing(t *testing.T) {
+	proc := process.New(mheap.New(guest.New(1<<30, host.New(1<<30))))
+	vec := vector.New(types.Type{Oid: types.T_float64})
+	require.NoError(t, vector.Append(vec, []float64{-3.5, 0, 1, 2, 100, 1e6}))
+	nulls.Add(vec.Nsp, 5)
+	r := percentile.NewPercentile(vec.Typ, 0.25, true)
+	require.NoError(t, r.Grows(3, proc.Mp))
+	r.BulkFill(0, []int64{1, 1, 2, 1, 3, 1}, vec)
+	r.Fill(1, 4, 2, vec)
+
+	var buf bytes.Buffer
+	require.NoError(t, EncodeRing(r, &buf))
+	decoded, data, err := DecodeRing(buf.Bytes())
+	require.NoError(t, err)
+	require.Equal(t, 0, len(data))
+	withProc, data, err := DecodeRingWithProcess(buf.Bytes(), proc)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(data))
+
+	expect := r.Eval(nil)
+	for _, got := range []ring.Ring{decoded, withProc} {
+		pr := got.(*percentile.PercentileRing)
+		require.Equal(t, r.Typ, pr.Typ)
+		require.Equal(t, 0.25, pr.Percentile)
+		require.True(t, pr.Disc)
+		vec := pr.Eval(nil)
+		require.Equal(t, expect.Col, vec.Col)
+		require.Equal(t, expect.Nsp, vec.Nsp)
+	}
+}
+
 func TestBatch(t *testing.T) {
 	var buf bytes.Buffer
 
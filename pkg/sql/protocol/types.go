@@ -88,6 +88,12 @@ const (
 	BitXorRing
 	// StdDevPop
 	StdDevPopRing
+	// ApproxPercentile
+	ApproxPercentileRing
+	// VarSamp, StdDevSamp, CovarPop, CovarSamp and Corr
+	MomentRing
+	// Median and Percentile
+	PercentileRing
 )
 
 // colexec
@@ -0,0 +1,119 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spool
+
+import (
+	"bytes"
+	"os"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/sql/protocol"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// New returns a spool keeping at most limit bytes of batches in memory,
+// the batches are never spilled if limit is not positive.
+func New(limit int64) *Spool {
+	return &Spool{
+		limit: limit,
+	}
+}
+
+// Append appends a copy of bat to the spool, it must not be called
+// after the spool is read.
+func (s *Spool) Append(bat *batch.Batch) error {
+	if len(bat.Zs) == 0 {
+		return nil
+	}
+	var buf bytes.Buffer
+
+	if err := protocol.EncodeBatch(bat, &buf); err != nil {
+		return err
+	}
+	data := buf.Bytes()
+	if s.file == nil && (s.limit <= 0 || s.size+int64(len(data)) <= s.limit) {
+		s.size += int64(len(data))
+		s.mem = append(s.mem, data)
+		return nil
+	}
+	if s.file == nil {
+		file, err := os.CreateTemp("", "spool")
+		if err != nil {
+			return err
+		}
+		s.file = file
+		s.offs = append(s.offs, 0)
+	}
+	off := s.offs[len(s.offs)-1]
+	if _, err := s.file.WriteAt(data, off); err != nil {
+		return err
+	}
+	s.offs = append(s.offs, off+int64(len(data)))
+	return nil
+}
+
+// Spilled returns true if some batches of the spool are in the temporary file
+func (s *Spool) Spilled() bool {
+	return s.file != nil
+}
+
+// Free releases the batches of the spool and removes the temporary file
+func (s *Spool) Free() error {
+	s.mem = nil
+	if s.file == nil {
+		return nil
+	}
+	name := s.file.Name()
+	err := s.file.Close()
+	if rerr := os.Remove(name); err == nil {
+		err = rerr
+	}
+	s.file, s.offs = nil, nil
+	return err
+}
+
+// NewReader returns a reader of the spool, the batches read are allocated by proc
+func (s *Spool) NewReader(proc *process.Process) *Reader {
+	return &Reader{
+		s:    s,
+		proc: proc,
+	}
+}
+
+// Read returns the next batch of the spool, and nil after the last one
+func (r *Reader) Read(_ []uint64, _ []string) (*batch.Batch, error) {
+	var data []byte
+
+	s := r.s
+	switch {
+	case r.i < len(s.mem):
+		data = s.mem[r.i]
+	case r.i-len(s.mem) < len(s.offs)-1:
+		j := r.i - len(s.mem)
+		data = make([]byte, s.offs[j+1]-s.offs[j])
+		if _, err := s.file.ReadAt(data, s.offs[j]); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, nil
+	}
+	r.i++
+	bat, _, err := protocol.DecodeBatchWithProcess(data, r.proc)
+	if err != nil {
+		return nil, err
+	}
+	bat.Cnt = 1
+	return bat, nil
+}
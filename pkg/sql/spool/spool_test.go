@@ -0,0 +1,83 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spool
+
+import (
+	"os"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSpool(t *testing.T) {
+	for _, limit := range []int64{0, 1} {
+		gm := guest.New(1<<30, host.New(1<<30))
+		proc := process.New(mheap.New(gm))
+		s := New(limit)
+		for i := int64(0); i < 4; i++ {
+			bat := newBatch(t, proc, i)
+			require.NoError(t, s.Append(bat))
+			bat.Clean(proc.Mp)
+		}
+		require.Equal(t, limit > 0, s.Spilled())
+		// every reader reads all the batches
+		for i := 0; i < 2; i++ {
+			r := s.NewReader(proc)
+			var vs []int64
+			for {
+				bat, err := r.Read(nil, nil)
+				require.NoError(t, err)
+				if bat == nil {
+					break
+				}
+				require.Equal(t, []string{"a"}, bat.Attrs)
+				vs = append(vs, bat.Vecs[0].Col.([]int64)...)
+				bat.Clean(proc.Mp)
+			}
+			require.Equal(t, []int64{0, 1, 2, 3}, vs)
+		}
+		require.Equal(t, int64(0), mheap.Size(proc.Mp))
+		var name string
+		if s.Spilled() {
+			name = s.file.Name()
+		}
+		require.NoError(t, s.Free())
+		if name != "" {
+			_, err := os.Stat(name)
+			require.True(t, os.IsNotExist(err))
+		}
+	}
+}
+
+func newBatch(t *testing.T, proc *process.Process, v int64) *batch.Batch {
+	bat := batch.NewWithSize(1)
+	bat.Attrs = []string{"a"}
+	bat.Vecs[0] = vector.New(types.Type{Oid: types.T_int64, Size: 8})
+	data, err := mheap.Alloc(proc.Mp, 8)
+	require.NoError(t, err)
+	bat.Vecs[0].Data = data
+	bat.Vecs[0].Col = encoding.DecodeInt64Slice(data)[:1]
+	bat.Vecs[0].Col.([]int64)[0] = v
+	bat.InitZsOne(1)
+	return bat
+}
@@ -0,0 +1,42 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spool
+
+import (
+	"os"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// Spool buffers the batches written once and read by any number of readers, every
+// reader reads all the batches. The batches are kept in memory until their size
+// exceeds the limit, the following batches are spilled to a temporary file.
+type Spool struct {
+	limit int64
+	size  int64
+	// mem is the encoded batches kept in memory
+	mem [][]byte
+	// file is the temporary file of the spilled batches, offs is the offset
+	// of every spilled batch followed by the end of the last one
+	file *os.File
+	offs []int64
+}
+
+// Reader reads the batches of a spool
+type Reader struct {
+	i    int
+	s    *Spool
+	proc *process.Process
+}
@@ -34,6 +34,21 @@ func TestDecimalType(t *testing.T) {
 	test(t, testCases)
 }
 
+func TestDecimalCast(t *testing.T) {
+	testCases := []testCase{
+		{sql: "create table decimal_table (d1 decimal(10, 5), f1 double, s1 varchar(20));"},
+		{sql: "insert into decimal_table values (333.333, 1.005, '-12.345');"},
+		{sql: "select cast(d1 as decimal(10, 2)), cast(f1 as decimal(10, 2)), cast(s1 as decimal(20, 2)) from decimal_table;", res: executeResult{
+			data: [][]string{{"33333", "101", "{-1235 -1}"}},
+		}},
+		{sql: "select cast(d1 as double), cast(d1 as char) from decimal_table;", res: executeResult{
+			data: [][]string{{"333.333000", "333.33300"}},
+		}},
+		{sql: "select cast(d1 as decimal(4, 2)) from decimal_table;", err: "decimal value is out of range"},
+	}
+	test(t, testCases)
+}
+
 func TestDecimalComparison(t *testing.T) {
 	testCases := []testCase{
 		{sql: "create table decimal_table (d1 decimal(10, 5));"},
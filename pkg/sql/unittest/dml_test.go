@@ -27,6 +27,8 @@ func TestInsertAndSelectFunction(t *testing.T) {
 		{sql: "create table datetimes(dt1 datetime);"},
 		{sql: "create table timestamps(ts1 timestamp);"},
 		{sql: "create table timestamps1(ts1 timestamp(3));"},
+		{sql: "create table times(t1 time, t2 time(3));"},
+		{sql: "create table years(y1 year);"},
 		{sql: "create table def1 (i1 int default 888, i2 int default 888, i3 int default 888);"},
 		{sql: "create table def2 (id int default 1, name varchar(255) unique, age int);"},
 		{sql: "create table def3 (i int default -1, v varchar(10) default 'abc', c char(10) default '', price double default 0.00);"},
@@ -45,6 +47,8 @@ func TestInsertAndSelectFunction(t *testing.T) {
 		{sql: "insert into datetimes values ('1999-04-05 11:01:02'), ('2004-04-03 13:11:10');"},
 		{sql: "insert into timestamps values ('1999-04-05 11:01:02'), ('2004-04-03 13:11:10'), ('1999-04-05 11:01:02.123456'), ('2004-04-03 13:11:10.123456');"},
 		{sql: "insert into timestamps1 values ('1999-04-05 11:01:02'), ('2004-04-03 13:11:10'), ('1999-04-05 11:01:02.123456'), ('2004-04-03 13:11:10.123456');"},
+		{sql: "insert into times values ('11:01:02', '11:01:02.123456'), ('-838:59:59', '1 02:03'), (123456, '0');"},
+		{sql: "insert into years values ('1999'), (2004), ('69'), (0);"},
 		{sql: "insert into def1 values (default, default, default), (1, default, default), (default, -1, default), (default, default, 0);"},
 		{sql: "insert into def2 (name, age) values ('Abby', 24);"},
 		{sql: "insert into def3 () values (), ();"},
@@ -135,6 +139,22 @@ func TestInsertAndSelectFunction(t *testing.T) {
 			attr: []string{"ts1"},
 			data: [][]string{{"1999-04-05 11:01:02.000000"}, {"2004-04-03 13:11:10.000000"}, {"1999-04-05 11:01:02.123000"}, {"2004-04-03 13:11:10.123000"}},
 		}},
+		{sql: "select * from times", res: executeResult{
+			attr: []string{"t1", "t2"},
+			data: [][]string{{"11:01:02", "11:01:02.123"}, {"-838:59:59", "26:03:00.000"}, {"12:34:56", "00:00:00.000"}},
+		}},
+		{sql: "select * from years", res: executeResult{
+			attr: []string{"y1"},
+			data: [][]string{{"1999"}, {"2004"}, {"2069"}, {"0000"}},
+		}},
+		{sql: "select * from years where y1 > cast('2000' as year)", res: executeResult{
+			attr: []string{"y1"},
+			data: [][]string{{"2004"}, {"2069"}},
+		}},
+		{sql: "select t1 from times where t1 < cast('12:00:00' as time)", res: executeResult{
+			attr: []string{"t1"},
+			data: [][]string{{"11:01:02"}, {"-838:59:59"}},
+		}},
 		{sql: "select * from def1;", res: executeResult{
 			attr: []string{"i1", "i2", "i3"},
 			data: [][]string{
@@ -430,13 +450,13 @@ func TestAQ(t *testing.T) {
 		{sql: "select sum(money), sum(money2) from in_out;", res: executeResult{
 			attr: []string{"sum(money)", "sum(money2)"},
 			data: [][]string{
-				{"17280", "{172835 0}"}, // 'cause the DecimalToString function will only be called at the frontend
+				{"{17280 0}", "{172835 0}"}, // 'cause the DecimalToString function will only be called at the frontend
 			},
 		}},
 		{sql: "select max(money), max(money2), avg(money), avg(money2) from in_out;", res: executeResult{
 			attr: []string{"max(money)", "max(money2)", "avg(money)", "avg(money2)"},
 			data: [][]string{
-				{"5678", "{56789 0}", "{3456 0}", "{34567 0}"}, // 'cause the DecimalToString function will only be called at the frontend
+				{"5678", "{56789 0}", "{34560000 0}", "{345670000 0}"}, // 'cause the DecimalToString function will only be called at the frontend
 			},
 		}},
 	}
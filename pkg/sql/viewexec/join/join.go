@@ -554,7 +554,7 @@ func (ctr *Container) probeView(i, n int, bat *batch.Batch, v *view) error {
 					}
 				}
 			}
-		case types.T_char, types.T_varchar:
+		case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 			vs := vec.Col.(*types.Bytes)
 			if !nulls.Any(vec.Nsp) {
 				for k := 0; k < n; k++ {
@@ -814,7 +814,7 @@ func (ctr *Container) probeViewWithOneVar(i, n int, bat *batch.Batch, v *view) e
 			ctr.hashes[0] = 0
 			v.intHashMap.FindBatchWithRing(n, ctr.zValues, ctr.hashes, unsafe.Pointer(&ctr.h8.keys[0]), v.values)
 		}
-	case types.T_char, types.T_varchar:
+	case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 		vs := vec.Col.(*types.Bytes)
 		if !nulls.Any(vec.Nsp) {
 			for k := 0; k < n; k++ {
@@ -0,0 +1,564 @@
+// Copyright 2021 - 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mview
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/ring"
+	"github.com/matrixorigin/matrixone/pkg/container/ring/avg"
+	"github.com/matrixorigin/matrixone/pkg/container/ring/count"
+	"github.com/matrixorigin/matrixone/pkg/container/ring/starcount"
+	"github.com/matrixorigin/matrixone/pkg/container/ring/sum"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	colexec "github.com/matrixorigin/matrixone/pkg/sql/colexec2"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/aggregate"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan2"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan2/function"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// Maintain is the engine.ViewMaintainer of the materialized views, the rows changed in a table
+// by a transaction are aggregated and merged into the groups of the views of the table.
+func Maintain(e engine.Engine, snapshot engine.Snapshot, deltas []*engine.Delta) error {
+	proc := newProcess(snapshot)
+
+	// the deltas of a table are merged at once, in the order they were made
+	var tables []string
+	changes := make(map[string][]*engine.Delta)
+	for _, delta := range deltas {
+		key := delta.Database + "." + delta.Table
+		if _, ok := changes[key]; !ok {
+			tables = append(tables, key)
+		}
+		changes[key] = append(changes[key], delta)
+	}
+	for _, key := range tables {
+		ds := changes[key]
+		views, err := materializedViews(e, ds[0].Database, ds[0].Table, snapshot)
+		if err != nil {
+			return err
+		}
+		for _, v := range views {
+			gs := v.newGroups()
+			for _, delta := range ds {
+				z := int64(1)
+				if delta.Deleted {
+					z = -1
+				}
+				if err := gs.fill(proc, delta.Bat, z); err != nil {
+					return err
+				}
+			}
+			if err := gs.merge(proc); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Refresh rebuilds the materialized view from all the rows of the table it aggregates
+func Refresh(e engine.Engine, snapshot engine.Snapshot, dbName, name string) error {
+	proc := newProcess(snapshot)
+	db, err := e.Database(dbName, snapshot)
+	if err != nil {
+		return err
+	}
+	rel, err := db.Relation(name, snapshot)
+	if err != nil {
+		return err
+	}
+	v, err := newView(rel, snapshot)
+	if err != nil {
+		return err
+	}
+	if v == nil {
+		return errors.New(errno.WrongObjectType, fmt.Sprintf("'%v.%v' is not a materialized view", dbName, name))
+	}
+	src, err := db.Relation(v.def.Table, snapshot)
+	if err != nil {
+		return err
+	}
+
+	// the groups are removed before the rows of the table are aggregated again
+	keys, err := readKeys(rel, snapshot, proc)
+	if err != nil {
+		return err
+	}
+	if vector.Length(keys) > 0 {
+		if err := rel.Delete(0, keys, plan2.MaterializedViewKeyColumn, snapshot); err != nil {
+			return err
+		}
+	}
+	gs := v.newGroups()
+	for _, r := range src.NewReader(1, nil, nil, snapshot) {
+		for {
+			bat, err := r.Read(make([]uint64, len(v.def.Columns)), v.def.Columns)
+			if err != nil {
+				return err
+			}
+			if bat == nil {
+				break
+			}
+			if err := gs.fill(proc, bat, 1); err != nil {
+				return err
+			}
+		}
+	}
+	return gs.merge(proc)
+}
+
+func newProcess(snapshot engine.Snapshot) *process.Process {
+	proc := process.New(mheap.New(guest.New(1<<30, host.New(1<<30))))
+	proc.Snapshot = snapshot
+	return proc
+}
+
+// view is a materialized view and the aggregation maintaining it
+type view struct {
+	name string
+	def  *plan2.MaterializedViewDef
+	agg  *plan.Node
+	ops  []int
+	rel  engine.Relation
+	// attrs are the columns of the view in the order they are written
+	attrs []string
+	typs  []types.Type
+}
+
+// groups are the changes of the groups of a view made by the rows changed in its table
+type groups struct {
+	v     *view
+	index map[string]int64
+	keys  [][]byte
+	vecs  []*vector.Vector // the values of the group by expressions
+	rings []ring.Ring
+	zs    []int64 // the changes of the numbers of the rows
+}
+
+// materializedViews returns the materialized views aggregating the table
+func materializedViews(e engine.Engine, dbName, table string, snapshot engine.Snapshot) ([]*view, error) {
+	db, err := e.Database(dbName, snapshot)
+	if err != nil {
+		return nil, err
+	}
+	var views []*view
+	for _, name := range db.Relations(snapshot) {
+		rel, err := db.Relation(name, snapshot)
+		if err != nil {
+			return nil, err
+		}
+		v, err := newView(rel, snapshot)
+		if err != nil {
+			return nil, err
+		}
+		if v != nil && v.def.Table == table {
+			views = append(views, v)
+		}
+	}
+	return views, nil
+}
+
+// newView returns the materialized view of the relation, or nil if the relation is not one
+func newView(rel engine.Relation, snapshot engine.Snapshot) (*view, error) {
+	v := &view{
+		name: rel.ID(snapshot),
+		rel:  rel,
+	}
+	for _, def := range rel.TableDefs(snapshot) {
+		switch d := def.(type) {
+		case *engine.AttributeDef:
+			v.attrs = append(v.attrs, d.Attr.Name)
+			v.typs = append(v.typs, d.Attr.Type)
+		case *engine.PropertiesDef:
+			for _, p := range d.Properties {
+				if p.Key != plan2.MaterializedViewKey {
+					continue
+				}
+				v.def = &plan2.MaterializedViewDef{}
+				if err := json.Unmarshal([]byte(p.Value), v.def); err != nil {
+					return nil, errors.New(errno.DataException, fmt.Sprintf("invalid definition of the materialized view '%v'", v.name))
+				}
+			}
+		}
+	}
+	if v.def == nil {
+		return nil, nil
+	}
+	agg, err := v.def.AggNode()
+	if err != nil {
+		return nil, err
+	}
+	v.agg = agg
+	v.ops = make([]int, len(agg.AggList))
+	for i, expr := range agg.AggList {
+		f, ok := expr.Expr.(*plan.Expr_F)
+		if !ok {
+			return nil, errors.New(errno.DataException, fmt.Sprintf("invalid aggregate '%v' of the materialized view '%v'", expr.ColName, v.name))
+		}
+		fun, err := function.GetFunctionByID(f.F.Func.GetObj())
+		if err != nil {
+			return nil, err
+		}
+		v.ops[i] = fun.AggregateInfo
+	}
+	return v, nil
+}
+
+func (v *view) newGroups() *groups {
+	gs := &groups{
+		v:     v,
+		index: make(map[string]int64),
+		vecs:  make([]*vector.Vector, len(v.agg.GroupBy)),
+		rings: make([]ring.Ring, len(v.agg.AggList)),
+	}
+	for i, expr := range v.agg.GroupBy {
+		gs.vecs[i] = vector.New(planType(expr.Typ))
+	}
+	return gs
+}
+
+// fill aggregates the rows of the table, z is -1 if the rows are deleted
+func (gs *groups) fill(proc *process.Process, src *batch.Batch, z int64) error {
+	v := gs.v
+	bat := batch.New(true, v.def.Columns)
+	for i, name := range v.def.Columns {
+		if bat.Vecs[i] = batch.GetVector(src, name); bat.Vecs[i] == nil {
+			return errors.New(errno.UndefinedColumn, fmt.Sprintf("column '%v' of the table '%v' aggregated by the materialized view '%v' doesn't exist", name, v.def.Table, v.name))
+		}
+	}
+	n := vector.Length(bat.Vecs[0])
+	if n == 0 {
+		return nil
+	}
+	bat.InitZsOne(n)
+	sels, err := filter(proc, bat, v.agg.WhereList)
+	if err != nil {
+		return err
+	}
+	groupVecs := make([]*vector.Vector, len(v.agg.GroupBy))
+	for i, expr := range v.agg.GroupBy {
+		if groupVecs[i], err = colexec.EvalExpr(bat, proc, expr); err != nil {
+			return err
+		}
+	}
+	argVecs := make([]*vector.Vector, len(v.agg.AggList))
+	for i, expr := range v.agg.AggList {
+		if argVecs[i], err = colexec.EvalExpr(bat, proc, expr.Expr.(*plan.Expr_F).F.Args[0]); err != nil {
+			return err
+		}
+		if gs.rings[i] == nil {
+			if gs.rings[i], err = aggregate.New(v.ops[i], argVecs[i].Typ); err != nil {
+				return err
+			}
+		}
+	}
+	for _, row := range sels {
+		key := groupKey(groupVecs, row)
+		i, ok := gs.index[key]
+		if !ok {
+			if i, err = gs.grow(proc, key, groupVecs, row); err != nil {
+				return err
+			}
+		}
+		gs.zs[i] += z
+		for j, r := range gs.rings {
+			r.Fill(i, position(argVecs[j], row), z, argVecs[j])
+		}
+	}
+	return nil
+}
+
+// grow adds the group of the row
+func (gs *groups) grow(proc *process.Process, key string, groupVecs []*vector.Vector, row int64) (int64, error) {
+	i := int64(len(gs.keys))
+	for j, vec := range groupVecs {
+		if err := vector.UnionOne(gs.vecs[j], vec, position(vec, row), proc.Mp); err != nil {
+			return 0, err
+		}
+	}
+	for _, r := range gs.rings {
+		if err := r.Grow(proc.Mp); err != nil {
+			return 0, err
+		}
+	}
+	gs.index[key] = i
+	gs.keys = append(gs.keys, []byte(key))
+	gs.zs = append(gs.zs, 0)
+	return i, nil
+}
+
+// merge adds the state kept by the view to the changes of the groups, and replaces the groups
+// of the view by the result. The groups without rows are removed.
+func (gs *groups) merge(proc *process.Process) error {
+	if len(gs.keys) == 0 {
+		return nil
+	}
+	v := gs.v
+	snapshot := engine.Snapshot(proc.Snapshot)
+	keys := vector.New(types.Type{Oid: types.T_varchar, Size: 24})
+	if err := vector.Append(keys, gs.keys); err != nil {
+		return err
+	}
+
+	// the rows of the groups kept by the view
+	rel, ok := v.rel.(engine.KeyRelation)
+	if !ok {
+		return errors.New(errno.FeatureNotSupported, fmt.Sprintf("materialized view '%v' is not supported by the engine", v.name))
+	}
+	attrs := []string{plan2.MaterializedViewCountColumn}
+	for i := range gs.rings {
+		value, nulls := plan2.MaterializedViewStateColumns(i)
+		attrs = append(attrs, value, nulls)
+	}
+	old, sels, err := rel.GetByKeys(keys, attrs, snapshot)
+	if err != nil {
+		return err
+	}
+	if len(sels) > 0 {
+		counts := old.Vecs[0].Col.([]int64)
+		for j, sel := range sels {
+			gs.zs[sel] += counts[j]
+		}
+		for i, r := range gs.rings {
+			kept := r.Dup()
+			if err := kept.Grows(len(gs.keys), proc.Mp); err != nil {
+				return err
+			}
+			for j, sel := range sels {
+				if err := loadState(kept, sel, old.Vecs[1+2*i], old.Vecs[2+2*i], int64(j)); err != nil {
+					return err
+				}
+			}
+			for _, sel := range sels {
+				r.Add(kept, sel, sel)
+			}
+			kept.Free(proc.Mp)
+		}
+		found := vector.New(keys.Typ)
+		if err := vector.Union(found, keys, sels, proc.Mp); err != nil {
+			return err
+		}
+		if err := v.rel.Delete(0, found, plan2.MaterializedViewKeyColumn, snapshot); err != nil {
+			return err
+		}
+	}
+
+	// the groups having rows are written again
+	live := make([]int64, 0, len(gs.keys))
+	for i, z := range gs.zs {
+		if z > 0 {
+			live = append(live, int64(i))
+		}
+	}
+	if len(live) == 0 {
+		return nil
+	}
+	vecs := make(map[string]*vector.Vector)
+	vecs[plan2.MaterializedViewKeyColumn] = keys
+	vecs[plan2.MaterializedViewCountColumn] = int64Vector(gs.zs)
+	results := make([]*vector.Vector, len(gs.rings))
+	for i, r := range gs.rings {
+		value, nulls := plan2.MaterializedViewStateColumns(i)
+		if vecs[value], vecs[nulls], err = saveState(r); err != nil {
+			return err
+		}
+		results[i] = r.Eval(gs.zs)
+	}
+	for _, expr := range v.agg.ProjectList {
+		col := expr.Expr.(*plan.Expr_Col).Col
+		if col.RelPos == -1 {
+			vecs[expr.ColName] = gs.vecs[col.ColPos]
+		} else {
+			vecs[expr.ColName] = results[col.ColPos]
+		}
+	}
+	bat := batch.New(true, v.attrs)
+	shrunk := make(map[*vector.Vector]bool)
+	for i, attr := range v.attrs {
+		vec, ok := vecs[attr]
+		if !ok {
+			return errors.New(errno.DataException, fmt.Sprintf("column '%v' of the materialized view '%v' is not maintained", attr, v.name))
+		}
+		// a group by expression may be referenced by several columns
+		if len(live) < len(gs.keys) && !shrunk[vec] {
+			vector.Shrink(vec, live)
+			shrunk[vec] = true
+		}
+		vec.Typ = v.typs[i]
+		bat.Vecs[i] = vec
+	}
+	bat.InitZsOne(len(live))
+	return v.rel.Write(0, bat, snapshot)
+}
+
+// filter returns the rows passing all the filters
+func filter(proc *process.Process, bat *batch.Batch, filters []*plan.Expr) ([]int64, error) {
+	sels := make([]int64, len(bat.Zs))
+	for i := range sels {
+		sels[i] = int64(i)
+	}
+	for _, expr := range filters {
+		vec, err := colexec.EvalExpr(bat, proc, expr)
+		if err != nil {
+			return nil, err
+		}
+		bs := vec.Col.([]bool)
+		passed := sels[:0]
+		for _, row := range sels {
+			pos := position(vec, row)
+			if bs[pos] && !nulls.Contains(vec.Nsp, uint64(pos)) {
+				passed = append(passed, row)
+			}
+		}
+		sels = passed
+	}
+	return sels, nil
+}
+
+// groupKey encodes the values of the group by expressions of the row, the nulls and the lengths
+// of the values are encoded so that different groups never share a key.
+func groupKey(vecs []*vector.Vector, row int64) string {
+	var b strings.Builder
+	for _, vec := range vecs {
+		pos := position(vec, row)
+		if nulls.Contains(vec.Nsp, uint64(pos)) {
+			b.WriteString("N;")
+			continue
+		}
+		v := colexec.ValueKey(vec, pos)
+		fmt.Fprintf(&b, "%d:%s;", len(v), v)
+	}
+	return b.String()
+}
+
+// position returns the position of the row in the vector, a constant has only one value
+func position(vec *vector.Vector, row int64) int64 {
+	if vec.IsConst {
+		return 0
+	}
+	return row
+}
+
+// ringState returns the values and the null counts of the groups of the ring,
+// only the rings which can be maintained incrementally are supported.
+func ringState(r ring.Ring) (interface{}, []int64, bool) {
+	switch r := r.(type) {
+	case *count.CountRing:
+		return r.Vs, r.Ns, true
+	case *starcount.CountRing:
+		return r.Vs, r.Ns, true
+	case *sum.IntRing:
+		return r.Vs, r.Ns, true
+	case *sum.UIntRing:
+		return r.Vs, r.Ns, true
+	case *sum.FloatRing:
+		return r.Vs, r.Ns, true
+	case *avg.AvgRing:
+		return r.Vs, r.Ns, true
+	}
+	return nil, nil, false
+}
+
+// loadState sets the state of the i-th group of the ring to the row of the vectors
+func loadState(r ring.Ring, i int64, values, nullCounts *vector.Vector, row int64) error {
+	vs, ns, ok := ringState(r)
+	if !ok {
+		return errors.New(errno.FeatureNotSupported, fmt.Sprintf("aggregate '%v' can not be maintained incrementally", r.Type()))
+	}
+	switch vs := vs.(type) {
+	case []int64:
+		vs[i] = values.Col.([]int64)[row]
+	case []uint64:
+		vs[i] = values.Col.([]uint64)[row]
+	case []float64:
+		vs[i] = values.Col.([]float64)[row]
+	}
+	ns[i] = nullCounts.Col.([]int64)[row]
+	return nil
+}
+
+// saveState returns the vectors of the state of the groups of the ring
+func saveState(r ring.Ring) (*vector.Vector, *vector.Vector, error) {
+	vs, ns, ok := ringState(r)
+	if !ok {
+		return nil, nil, errors.New(errno.FeatureNotSupported, fmt.Sprintf("aggregate '%v' can not be maintained incrementally", r.Type()))
+	}
+	var values *vector.Vector
+	switch vs := vs.(type) {
+	case []int64:
+		values = int64Vector(vs)
+	case []uint64:
+		values = vector.New(types.Type{Oid: types.T_uint64, Size: 8})
+		vector.SetCol(values, append([]uint64{}, vs...))
+	case []float64:
+		values = vector.New(types.Type{Oid: types.T_float64, Size: 8})
+		vector.SetCol(values, append([]float64{}, vs...))
+	}
+	return values, int64Vector(ns), nil
+}
+
+func int64Vector(vs []int64) *vector.Vector {
+	vec := vector.New(types.Type{Oid: types.T_int64, Size: 8})
+	vector.SetCol(vec, append([]int64{}, vs...))
+	return vec
+}
+
+// readKeys returns the keys of all the groups of the view
+func readKeys(rel engine.Relation, snapshot engine.Snapshot, proc *process.Process) (*vector.Vector, error) {
+	attrs := []string{plan2.MaterializedViewKeyColumn}
+	keys := vector.New(types.Type{Oid: types.T_varchar, Size: 24})
+	for _, r := range rel.NewReader(1, nil, nil, snapshot) {
+		for {
+			bat, err := r.Read(make([]uint64, len(attrs)), attrs)
+			if err != nil {
+				return nil, err
+			}
+			if bat == nil {
+				break
+			}
+			vs := bat.Vecs[0].Col.(*types.Bytes)
+			for i := range vs.Lengths {
+				if err := vector.Append(keys, [][]byte{append([]byte{}, vs.Get(int64(i))...)}); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+	return keys, nil
+}
+
+func planType(typ *plan.Type) types.Type {
+	return types.Type{
+		Oid:       types.T(typ.Id),
+		Size:      typ.Size,
+		Width:     typ.Width,
+		Scale:     typ.Scale,
+		Precision: typ.Precision,
+	}
+}
@@ -0,0 +1,194 @@
+// Copyright 2021 - 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mview
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan2"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/dataio/mockio"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/moengine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/testutils"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	ModuleName = "MaterializedView"
+)
+
+// group is a row of the materialized view of the test
+type group struct {
+	nations int64
+	total   int64
+	average float64
+}
+
+func TestMaterializedView(t *testing.T) {
+	mockio.ResetFS()
+	tae, err := db.Open(testutils.InitTestEnv(ModuleName, t), nil)
+	require.NoError(t, err)
+	defer tae.Close()
+	e := moengine.NewEngine(tae)
+	e.SetViewMaintainer(Maintain)
+
+	txn, err := e.StartTxn(nil)
+	require.NoError(t, err)
+	require.NoError(t, e.Create(0, "tpch", 0, txn.GetCtx()))
+	dbase, err := e.Database("tpch", txn.GetCtx())
+	require.NoError(t, err)
+	require.NoError(t, dbase.Create(0, "nation", []engine.TableDef{
+		&engine.AttributeDef{Attr: engine.Attribute{Name: "n_nationkey", Type: types.Type{Oid: types.T_int32, Size: 4}, Primary: true}},
+		&engine.AttributeDef{Attr: engine.Attribute{Name: "n_name", Type: types.Type{Oid: types.T_varchar, Size: 24, Width: 25}}},
+		&engine.AttributeDef{Attr: engine.Attribute{Name: "n_regionkey", Type: types.Type{Oid: types.T_int32, Size: 4}}},
+		&engine.AttributeDef{Attr: engine.Attribute{Name: "n_comment", Type: types.Type{Oid: types.T_varchar, Size: 24, Width: 152}}},
+		&engine.PrimaryIndexDef{Names: []string{"n_nationkey"}},
+	}, txn.GetCtx()))
+	createView(t, dbase, txn.GetCtx(), "CREATE MATERIALIZED VIEW nation_agg (region, nations, total, average) AS "+
+		"SELECT n_regionkey, count(*), sum(n_nationkey), avg(n_nationkey) FROM nation GROUP BY n_regionkey")
+	require.NoError(t, Refresh(e, txn.GetCtx(), "tpch", "nation_agg"))
+	require.NoError(t, txn.Commit())
+
+	// the groups are added by the rows written
+	txn, err = e.StartTxn(nil)
+	require.NoError(t, err)
+	rel := relation(t, e, txn.GetCtx(), "nation")
+	require.NoError(t, rel.Write(0, nations(10), txn.GetCtx()))
+	require.NoError(t, txn.Commit())
+	require.Equal(t, map[int32]group{
+		0: {4, 18, 4.5},
+		1: {3, 12, 4},
+		2: {3, 15, 5},
+	}, readView(t, e))
+
+	// the groups are changed by the rows deleted, and removed when all their rows are deleted
+	txn, err = e.StartTxn(nil)
+	require.NoError(t, err)
+	rel = relation(t, e, txn.GetCtx(), "nation")
+	keys := vector.New(types.Type{Oid: types.T_int32, Size: 4})
+	require.NoError(t, vector.Append(keys, []int32{0, 1, 3, 6, 9}))
+	require.NoError(t, rel.Delete(0, keys, "n_nationkey", txn.GetCtx()))
+	require.NoError(t, txn.Commit())
+	expected := map[int32]group{
+		1: {2, 11, 5.5},
+		2: {3, 15, 5},
+	}
+	require.Equal(t, expected, readView(t, e))
+
+	// the view is rebuilt from the rows of the table
+	txn, err = e.StartTxn(nil)
+	require.NoError(t, err)
+	require.NoError(t, Refresh(e, txn.GetCtx(), "tpch", "nation_agg"))
+	require.NoError(t, txn.Commit())
+	require.Equal(t, expected, readView(t, e))
+
+	// the view can not be written
+	txn, err = e.StartTxn(nil)
+	require.NoError(t, err)
+	require.Error(t, Refresh(e, txn.GetCtx(), "tpch", "nation"))
+	require.NoError(t, txn.Rollback())
+}
+
+// createView creates the materialized view as compile2 does
+func createView(t *testing.T, dbase engine.Database, snapshot engine.Snapshot, sql string) {
+	stmts, err := mysql.Parse(sql)
+	require.NoError(t, err)
+	pn, err := plan2.BuildPlan(plan2.NewMockOptimizer().CurrentContext(), stmts[0])
+	require.NoError(t, err)
+	tableDef := pn.GetDdl().GetCreateTable().GetTableDef()
+	var defs []engine.TableDef
+	for _, col := range tableDef.Cols {
+		defs = append(defs, &engine.AttributeDef{Attr: engine.Attribute{
+			Name: col.Name,
+			Type: planType(col.Typ),
+		}})
+	}
+	for _, def := range tableDef.Defs {
+		switch d := def.Def.(type) {
+		case *plan.TableDef_DefType_Pk:
+			defs = append(defs, &engine.PrimaryIndexDef{Names: d.Pk.Names})
+		case *plan.TableDef_DefType_Properties:
+			properties := make([]engine.Property, len(d.Properties.Properties))
+			for i, p := range d.Properties.Properties {
+				properties[i] = engine.Property{Key: p.Key, Value: p.Value}
+			}
+			defs = append(defs, &engine.PropertiesDef{Properties: properties})
+		}
+	}
+	require.NoError(t, dbase.Create(0, tableDef.Name, defs, snapshot))
+}
+
+func relation(t *testing.T, e engine.Engine, snapshot engine.Snapshot, name string) engine.Relation {
+	dbase, err := e.Database("tpch", snapshot)
+	require.NoError(t, err)
+	rel, err := dbase.Relation(name, snapshot)
+	require.NoError(t, err)
+	return rel
+}
+
+// nations returns the rows of the nations 0 .. n-1, the nation i is in the region i % 3
+func nations(n int) *batch.Batch {
+	attrs := []string{"n_nationkey", "n_name", "n_regionkey", "n_comment"}
+	bat := batch.New(true, attrs)
+	keys, regions := make([]int32, n), make([]int32, n)
+	names := make([][]byte, n)
+	for i := 0; i < n; i++ {
+		keys[i], regions[i] = int32(i), int32(i%3)
+		names[i] = []byte{'a' + byte(i)}
+	}
+	bat.Vecs[0] = vector.New(types.Type{Oid: types.T_int32, Size: 4})
+	vector.SetCol(bat.Vecs[0], keys)
+	bat.Vecs[1] = vector.New(types.Type{Oid: types.T_varchar, Size: 24, Width: 25})
+	_ = vector.Append(bat.Vecs[1], names)
+	bat.Vecs[2] = vector.New(types.Type{Oid: types.T_int32, Size: 4})
+	vector.SetCol(bat.Vecs[2], regions)
+	bat.Vecs[3] = vector.New(types.Type{Oid: types.T_varchar, Size: 24, Width: 152})
+	_ = vector.Append(bat.Vecs[3], names)
+	bat.InitZsOne(n)
+	return bat
+}
+
+func readView(t *testing.T, e moengine.TxnEngine) map[int32]group {
+	txn, err := e.StartTxn(nil)
+	require.NoError(t, err)
+	defer func() { require.NoError(t, txn.Commit()) }()
+	rel := relation(t, e, txn.GetCtx(), "nation_agg")
+	attrs := []string{"region", "nations", "total", "average"}
+	groups := make(map[int32]group)
+	for _, r := range rel.NewReader(1, nil, nil, txn.GetCtx()) {
+		for {
+			bat, err := r.Read(make([]uint64, len(attrs)), attrs)
+			require.NoError(t, err)
+			if bat == nil {
+				break
+			}
+			regions := bat.Vecs[0].Col.([]int32)
+			for i, region := range regions {
+				groups[region] = group{
+					nations: bat.Vecs[1].Col.([]int64)[i],
+					total:   bat.Vecs[2].Col.([]int64)[i],
+					average: bat.Vecs[3].Col.([]float64)[i],
+				}
+			}
+		}
+	}
+	return groups
+}
@@ -487,7 +487,7 @@ func (ctr *Container) fillH8(bat *batch.Batch, proc *process.Process) error {
 							}
 						}
 					}
-				case types.T_char, types.T_varchar:
+				case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 					vs := vecs[j].Col.(*types.Bytes)
 					vData := vs.Data
 					vOff := vs.Offsets
@@ -768,7 +768,7 @@ func (ctr *Container) fillH8(bat *batch.Batch, proc *process.Process) error {
 						}
 					}
 				}
-			case types.T_char, types.T_varchar:
+			case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 				vs := vecs[j].Col.(*types.Bytes)
 				vData := vs.Data
 				vOff := vs.Offsets
@@ -1083,7 +1083,7 @@ func (ctr *Container) fillH24(bat *batch.Batch, proc *process.Process) error {
 							}
 						}
 					}
-				case types.T_char, types.T_varchar:
+				case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 					vs := vecs[j].Col.(*types.Bytes)
 					if !nulls.Any(vecs[j].Nsp) {
 						for k := int64(0); k < n; k++ {
@@ -1363,7 +1363,7 @@ func (ctr *Container) fillH24(bat *batch.Batch, proc *process.Process) error {
 						}
 					}
 				}
-			case types.T_char, types.T_varchar:
+			case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 				vs := vecs[j].Col.(*types.Bytes)
 				if !nulls.Any(vecs[j].Nsp) {
 					for k := int64(0); k < n; k++ {
@@ -1676,7 +1676,7 @@ func (ctr *Container) fillH32(bat *batch.Batch, proc *process.Process) error {
 							}
 						}
 					}
-				case types.T_char, types.T_varchar:
+				case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 					vs := vecs[j].Col.(*types.Bytes)
 					if !nulls.Any(vecs[j].Nsp) {
 						for k := int64(0); k < n; k++ {
@@ -1956,7 +1956,7 @@ func (ctr *Container) fillH32(bat *batch.Batch, proc *process.Process) error {
 						}
 					}
 				}
-			case types.T_char, types.T_varchar:
+			case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 				vs := vecs[j].Col.(*types.Bytes)
 				if !nulls.Any(vecs[j].Nsp) {
 					for k := int64(0); k < n; k++ {
@@ -2269,7 +2269,7 @@ func (ctr *Container) fillH40(bat *batch.Batch, proc *process.Process) error {
 							}
 						}
 					}
-				case types.T_char, types.T_varchar:
+				case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 					vs := vecs[j].Col.(*types.Bytes)
 					if !nulls.Any(vecs[j].Nsp) {
 						for k := int64(0); k < n; k++ {
@@ -2549,7 +2549,7 @@ func (ctr *Container) fillH40(bat *batch.Batch, proc *process.Process) error {
 						}
 					}
 				}
-			case types.T_char, types.T_varchar:
+			case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 				vs := vecs[j].Col.(*types.Bytes)
 				if !nulls.Any(vecs[j].Nsp) {
 					for k := int64(0); k < n; k++ {
@@ -2835,7 +2835,7 @@ func (ctr *Container) fillHStr(bat *batch.Batch, proc *process.Process) error {
 							}
 						}
 					}
-				case types.T_char, types.T_varchar:
+				case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 					vs := vecs[j].Col.(*types.Bytes)
 					if !nulls.Any(vecs[j].Nsp) {
 						for k := int64(0); k < n; k++ {
@@ -3091,7 +3091,7 @@ func (ctr *Container) fillHStr(bat *batch.Batch, proc *process.Process) error {
 						}
 					}
 				}
-			case types.T_char, types.T_varchar:
+			case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 				vs := vecs[j].Col.(*types.Bytes)
 				if !nulls.Any(vecs[j].Nsp) {
 					for k := int64(0); k < n; k++ {
@@ -479,7 +479,7 @@ func (ctr *Container) fillH8(bat *batch.Batch, proc *process.Process) error {
 						}
 					}
 				}
-			case types.T_char, types.T_varchar:
+			case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 				vs := vecs[j].Col.(*types.Bytes)
 				vData := vs.Data
 				vOff := vs.Offsets
@@ -799,7 +799,7 @@ func (ctr *Container) fillH24(bat *batch.Batch, proc *process.Process) error {
 						}
 					}
 				}
-			case types.T_char, types.T_varchar:
+			case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 				vs := vecs[j].Col.(*types.Bytes)
 				if !nulls.Any(vecs[j].Nsp) {
 					for k := int64(0); k < n; k++ {
@@ -1117,7 +1117,7 @@ func (ctr *Container) fillH32(bat *batch.Batch, proc *process.Process) error {
 						}
 					}
 				}
-			case types.T_char, types.T_varchar:
+			case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 				vs := vecs[j].Col.(*types.Bytes)
 				if !nulls.Any(vecs[j].Nsp) {
 					for k := int64(0); k < n; k++ {
@@ -1435,7 +1435,7 @@ func (ctr *Container) fillH40(bat *batch.Batch, proc *process.Process) error {
 						}
 					}
 				}
-			case types.T_char, types.T_varchar:
+			case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 				vs := vecs[j].Col.(*types.Bytes)
 				if !nulls.Any(vecs[j].Nsp) {
 					for k := int64(0); k < n; k++ {
@@ -1726,7 +1726,7 @@ func (ctr *Container) fillHStr(bat *batch.Batch, proc *process.Process) error {
 						}
 					}
 				}
-			case types.T_char, types.T_varchar:
+			case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 				vs := vecs[j].Col.(*types.Bytes)
 				if !nulls.Any(vecs[j].Nsp) {
 					for k := int64(0); k < n; k++ {
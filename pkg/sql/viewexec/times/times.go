@@ -185,7 +185,7 @@ func (ctr *Container) probe(bat *batch.Batch, proc *process.Process) error {
 				size += 4 + 1
 			case types.T_int64, types.T_uint64, types.T_float64, types.T_datetime:
 				size += 8 + 1
-			case types.T_char, types.T_varchar:
+			case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 				if width := vec.Typ.Width; width > 0 {
 					size += int(width) + 1
 				} else {
@@ -1050,7 +1050,7 @@ func (ctr *Container) processJoinH8(n, start int, bat *batch.Batch, proc *proces
 							}
 						}
 					}
-				case types.T_char, types.T_varchar:
+				case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 					vs := vec.Col.(*types.Bytes)
 					vData := vs.Data
 					vOff := vs.Offsets
@@ -1408,7 +1408,7 @@ func (ctr *Container) processPureJoinH8(n, start int, bat *batch.Batch, proc *pr
 						}
 					}
 				}
-			case types.T_char, types.T_varchar:
+			case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 				vs := vec.Col.(*types.Bytes)
 				vData := vs.Data
 				vOff := vs.Offsets
@@ -1737,7 +1737,7 @@ func (ctr *Container) processPureJoinH8(n, start int, bat *batch.Batch, proc *pr
 							}
 						}
 					}
-				case types.T_char, types.T_varchar:
+				case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 					vs := vec.Col.(*types.Bytes)
 					vData := vs.Data
 					vOff := vs.Offsets
@@ -2142,7 +2142,7 @@ func (ctr *Container) processJoinH24(n, start int, bat *batch.Batch, proc *proce
 						}
 					}
 				}
-			case types.T_char, types.T_varchar:
+			case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 				vs := vec.Col.(*types.Bytes)
 				if !nulls.Any(vec.Nsp) {
 					for k, row := range rows {
@@ -2411,7 +2411,7 @@ func (ctr *Container) processJoinH24(n, start int, bat *batch.Batch, proc *proce
 							}
 						}
 					}
-				case types.T_char, types.T_varchar:
+				case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 					vs := vec.Col.(*types.Bytes)
 					if !nulls.Any(vec.Nsp) {
 						for k, row := range rows {
@@ -2773,7 +2773,7 @@ func (ctr *Container) processPureJoinH24(n, start int, bat *batch.Batch, proc *p
 						}
 					}
 				}
-			case types.T_char, types.T_varchar:
+			case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 				vs := vec.Col.(*types.Bytes)
 				if !nulls.Any(vec.Nsp) {
 					for k := 0; k < n; k++ {
@@ -3101,7 +3101,7 @@ func (ctr *Container) processPureJoinH24(n, start int, bat *batch.Batch, proc *p
 							}
 						}
 					}
-				case types.T_char, types.T_varchar:
+				case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 					vs := vec.Col.(*types.Bytes)
 					if !nulls.Any(vec.Nsp) {
 						for k := 0; k < n; k++ {
@@ -3505,7 +3505,7 @@ func (ctr *Container) processJoinH32(n, start int, bat *batch.Batch, proc *proce
 						}
 					}
 				}
-			case types.T_char, types.T_varchar:
+			case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 				vs := vec.Col.(*types.Bytes)
 				if !nulls.Any(vec.Nsp) {
 					for k, row := range rows {
@@ -3774,7 +3774,7 @@ func (ctr *Container) processJoinH32(n, start int, bat *batch.Batch, proc *proce
 							}
 						}
 					}
-				case types.T_char, types.T_varchar:
+				case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 					vs := vec.Col.(*types.Bytes)
 					if !nulls.Any(vec.Nsp) {
 						for k, row := range rows {
@@ -4136,7 +4136,7 @@ func (ctr *Container) processPureJoinH32(n, start int, bat *batch.Batch, proc *p
 						}
 					}
 				}
-			case types.T_char, types.T_varchar:
+			case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 				vs := vec.Col.(*types.Bytes)
 				if !nulls.Any(vec.Nsp) {
 					for k := 0; k < n; k++ {
@@ -4464,7 +4464,7 @@ func (ctr *Container) processPureJoinH32(n, start int, bat *batch.Batch, proc *p
 							}
 						}
 					}
-				case types.T_char, types.T_varchar:
+				case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 					vs := vec.Col.(*types.Bytes)
 					if !nulls.Any(vec.Nsp) {
 						for k := 0; k < n; k++ {
@@ -4868,7 +4868,7 @@ func (ctr *Container) processJoinH40(n, start int, bat *batch.Batch, proc *proce
 						}
 					}
 				}
-			case types.T_char, types.T_varchar:
+			case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 				vs := vec.Col.(*types.Bytes)
 				if !nulls.Any(vec.Nsp) {
 					for k, row := range rows {
@@ -5137,7 +5137,7 @@ func (ctr *Container) processJoinH40(n, start int, bat *batch.Batch, proc *proce
 							}
 						}
 					}
-				case types.T_char, types.T_varchar:
+				case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 					vs := vec.Col.(*types.Bytes)
 					if !nulls.Any(vec.Nsp) {
 						for k, row := range rows {
@@ -5499,7 +5499,7 @@ func (ctr *Container) processPureJoinH40(n, start int, bat *batch.Batch, proc *p
 						}
 					}
 				}
-			case types.T_char, types.T_varchar:
+			case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 				vs := vec.Col.(*types.Bytes)
 				if !nulls.Any(vec.Nsp) {
 					for k := 0; k < n; k++ {
@@ -5827,7 +5827,7 @@ func (ctr *Container) processPureJoinH40(n, start int, bat *batch.Batch, proc *p
 							}
 						}
 					}
-				case types.T_char, types.T_varchar:
+				case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 					vs := vec.Col.(*types.Bytes)
 					if !nulls.Any(vec.Nsp) {
 						for k := 0; k < n; k++ {
@@ -6200,7 +6200,7 @@ func (ctr *Container) processJoinHStr(n, start int, bat *batch.Batch, proc *proc
 						}
 					}
 				}
-			case types.T_char, types.T_varchar:
+			case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 				vs := vec.Col.(*types.Bytes)
 				if !nulls.Any(vec.Nsp) {
 					for k, row := range rows {
@@ -6440,7 +6440,7 @@ func (ctr *Container) processJoinHStr(n, start int, bat *batch.Batch, proc *proc
 							}
 						}
 					}
-				case types.T_char, types.T_varchar:
+				case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 					vs := vec.Col.(*types.Bytes)
 					if !nulls.Any(vec.Nsp) {
 						for k, row := range rows {
@@ -6776,7 +6776,7 @@ func (ctr *Container) processPureJoinHStr(n, start int, bat *batch.Batch, proc *
 						}
 					}
 				}
-			case types.T_char, types.T_varchar:
+			case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 				vs := vec.Col.(*types.Bytes)
 				if !nulls.Any(vec.Nsp) {
 					for k := 0; k < n; k++ {
@@ -7088,7 +7088,7 @@ func (ctr *Container) processPureJoinHStr(n, start int, bat *batch.Batch, proc *
 							}
 						}
 					}
-				case types.T_char, types.T_varchar:
+				case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 					vs := vec.Col.(*types.Bytes)
 					if !nulls.Any(vec.Nsp) {
 						for k := 0; k < n; k++ {
@@ -7406,7 +7406,7 @@ func (ctr *Container) probeView(i, n int, bat *batch.Batch, v *view) error {
 					}
 				}
 			}
-		case types.T_char, types.T_varchar:
+		case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 			vs := vec.Col.(*types.Bytes)
 			if !nulls.Any(vec.Nsp) {
 				for k := 0; k < n; k++ {
@@ -7669,7 +7669,7 @@ func (ctr *Container) probeViewWithOneVar(i, n int, bat *batch.Batch, v *view) e
 			ctr.hashes[0] = 0
 			v.intHashMap.FindBatchWithRing(n, ctr.zValues, ctr.hashes, unsafe.Pointer(&ctr.h8.keys[0]), v.values)
 		}
-	case types.T_char, types.T_varchar:
+	case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 		vs := vec.Col.(*types.Bytes)
 		if !nulls.Any(vec.Nsp) {
 			for k := 0; k < n; k++ {
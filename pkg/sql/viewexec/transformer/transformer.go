@@ -52,7 +52,7 @@ var sumReturnTypes = map[types.T]types.T{
 	types.T_uint64:     types.T_uint64,
 	types.T_float32:    types.T_float64,
 	types.T_float64:    types.T_float64,
-	types.T_decimal64:  types.T_decimal64,
+	types.T_decimal64:  types.T_decimal128,
 	types.T_decimal128: types.T_decimal128,
 }
 
@@ -152,9 +152,8 @@ func NewSum(typ types.Type) (ring.Ring, error) {
 		return sum.NewInt(typ), nil
 	case types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64:
 		return sum.NewUint(typ), nil
-	case types.T_decimal64:
-		return sum.NewDecimal64(typ), nil
-	case types.T_decimal128:
+	case types.T_decimal64, types.T_decimal128:
+		// decimal64 values are summed up in decimal128 to keep away from overflow
 		return sum.NewDecimal128(typ), nil
 	}
 	return nil, fmt.Errorf("'%v' not support Sum", typ)
@@ -182,7 +181,7 @@ func NewMax(typ types.Type) (ring.Ring, error) {
 		return max.NewFloat32(typ), nil
 	case types.T_float64:
 		return max.NewFloat64(typ), nil
-	case types.T_char, types.T_varchar:
+	case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 		return max.NewStr(typ), nil
 	case types.T_date:
 		return max.NewDate(typ), nil
@@ -218,7 +217,7 @@ func NewMin(typ types.Type) (ring.Ring, error) {
 		return min.NewFloat32(typ), nil
 	case types.T_float64:
 		return min.NewFloat64(typ), nil
-	case types.T_char, types.T_varchar:
+	case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 		return min.NewStr(typ), nil
 	case types.T_date:
 		return min.NewDate(typ), nil
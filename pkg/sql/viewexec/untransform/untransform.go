@@ -307,7 +307,7 @@ func (ctr *Container) fillBatch(fvars []string, bat *batch.Batch, proc *process.
 				size += 4 + 1
 			case types.T_int64, types.T_uint64, types.T_float64, types.T_datetime:
 				size += 8 + 1
-			case types.T_char, types.T_varchar:
+			case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 				if width := vec.Typ.Width; width > 0 {
 					size += int(width) + 1
 				} else {
@@ -625,7 +625,7 @@ func (ctr *Container) processH8(fvars []string, bat *batch.Batch, proc *process.
 						}
 					}
 				}
-			case types.T_char, types.T_varchar:
+			case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 				vs := vecs[j].Col.(*types.Bytes)
 				vData := vs.Data
 				vOff := vs.Offsets
@@ -939,7 +939,7 @@ func (ctr *Container) processH24(fvars []string, bat *batch.Batch, proc *process
 						}
 					}
 				}
-			case types.T_char, types.T_varchar:
+			case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 				vs := vecs[j].Col.(*types.Bytes)
 				if !nulls.Any(vecs[j].Nsp) {
 					for k := int64(0); k < n; k++ {
@@ -1251,7 +1251,7 @@ func (ctr *Container) processH32(fvars []string, bat *batch.Batch, proc *process
 						}
 					}
 				}
-			case types.T_char, types.T_varchar:
+			case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 				vs := vecs[j].Col.(*types.Bytes)
 				if !nulls.Any(vecs[j].Nsp) {
 					for k := int64(0); k < n; k++ {
@@ -1564,7 +1564,7 @@ func (ctr *Container) processH40(fvars []string, bat *batch.Batch, proc *process
 						}
 					}
 				}
-			case types.T_char, types.T_varchar:
+			case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 				vs := vecs[j].Col.(*types.Bytes)
 				if !nulls.Any(vecs[j].Nsp) {
 					for k := int64(0); k < n; k++ {
@@ -1850,7 +1850,7 @@ func (ctr *Container) processHStr(fvars []string, bat *batch.Batch, proc *proces
 						}
 					}
 				}
-			case types.T_char, types.T_varchar:
+			case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 				vs := vecs[j].Col.(*types.Bytes)
 				if !nulls.Any(vecs[j].Nsp) {
 					for k := int64(0); k < n; k++ {
@@ -17,6 +17,9 @@ package add
 import (
 	"fmt"
 	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/stretchr/testify/require"
 )
 
 func makeIbuffer(l int) []int64 {
@@ -48,3 +51,15 @@ func TestI64Add(t *testing.T) {
 	fmt.Printf("sum: %v\n", Int64Add(xs[:50], xs[50:], res))
 	fmt.Printf("pure sum: %v\n", numericAdd(xs[:50], xs[50:], res))
 }
+
+func TestDecimal64Add(t *testing.T) {
+	// 1.5 + 0.25 and 999999999999999999 + 1
+	xs := []types.Decimal64{15, 999999999999999999}
+	ys := []types.Decimal64{25, 1}
+	res := make([]types.Decimal64, 2)
+	rs, err := Decimal64Add(xs[:1], ys[:1], 1, 2, res)
+	require.NoError(t, err)
+	require.Equal(t, types.Decimal64(175), rs[0])
+	_, err = Decimal64Add(xs, ys, 0, 0, res)
+	require.Equal(t, types.ErrDecimalOverflow, err)
+}
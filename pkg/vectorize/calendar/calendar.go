@@ -0,0 +1,166 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package calendar
+
+import "github.com/matrixorigin/matrixone/pkg/container/types"
+
+var (
+	DateToWeek     func([]types.Date, int64, []int64) []int64
+	DateToYearWeek func([]types.Date, int64, []int64) []int64
+	DateToQuarter  func([]types.Date, []int64) []int64
+	DateToLastDay  func([]types.Date, []types.Date) []types.Date
+	DateDiff       func([]types.Date, []types.Date, []int64) []int64
+)
+
+func init() {
+	DateToWeek = dateToWeek
+	DateToYearWeek = dateToYearWeek
+	DateToQuarter = dateToQuarter
+	DateToLastDay = dateToLastDay
+	DateDiff = dateDiff
+}
+
+// The behaviours of the week calculation, which are the same as mysql
+const (
+	// WeekMondayFirst means that the week starts on Monday, otherwise on Sunday
+	WeekMondayFirst = 1
+	// WeekYear means that the week of a date in the last week of the previous year
+	// is the week of that year instead of 0, and the range of the weeks is 1 to 53
+	WeekYear = 2
+	// WeekFirstWeekday means that the first week of the year is the week containing
+	// the first day of week, otherwise the week containing 4 or more days of the year
+	WeekFirstWeekday = 4
+)
+
+// WeekBehaviour returns the behaviour of the mode of WEEK and YEARWEEK:
+//
+//	mode  first day  range  week 1 is the first week ...
+//	0     Sunday     0-53   with a Sunday in this year
+//	1     Monday     0-53   with 4 or more days this year
+//	2     Sunday     1-53   with a Sunday in this year
+//	3     Monday     1-53   with 4 or more days this year
+//	4     Sunday     0-53   with 4 or more days this year
+//	5     Monday     0-53   with a Monday in this year
+//	6     Sunday     1-53   with 4 or more days this year
+//	7     Monday     1-53   with a Monday in this year
+func WeekBehaviour(mode int64) int {
+	behaviour := int(mode & 7)
+	if behaviour&WeekMondayFirst == 0 {
+		behaviour ^= WeekFirstWeekday
+	}
+	return behaviour
+}
+
+// CalcWeek returns the year and the week of the date with the behaviour
+func CalcWeek(d types.Date, behaviour int) (int32, int64) {
+	year, month, day, _ := d.Calendar(true)
+	mondayFirst := behaviour&WeekMondayFirst != 0
+	weekYear := behaviour&WeekYear != 0
+	firstWeekday := behaviour&WeekFirstWeekday != 0
+
+	dayNum := int64(d)
+	firstDayNum := int64(types.FromCalendar(year, 1, 1))
+	weekday := weekdayOf(firstDayNum, mondayFirst)
+
+	if month == 1 && int64(day) <= 7-weekday {
+		if !weekYear && ((firstWeekday && weekday != 0) || (!firstWeekday && weekday >= 4)) {
+			return year, 0
+		}
+		weekYear = true
+		year--
+		days := daysInYear(year)
+		firstDayNum -= days
+		weekday = (weekday + 53*7 - days) % 7
+	}
+
+	var days int64
+	if (firstWeekday && weekday != 0) || (!firstWeekday && weekday >= 4) {
+		days = dayNum - (firstDayNum + 7 - weekday)
+	} else {
+		days = dayNum - (firstDayNum - weekday)
+	}
+
+	if weekYear && days >= 52*7 {
+		weekday = (weekday + daysInYear(year)) % 7
+		if (!firstWeekday && weekday < 4) || (firstWeekday && weekday == 0) {
+			return year + 1, 1
+		}
+	}
+	return year, days/7 + 1
+}
+
+// weekdayOf returns the day of the week of the day number, which starts from 0
+func weekdayOf(dayNum int64, mondayFirst bool) int64 {
+	// January 1, year 1 in Gregorian calendar, was a Monday.
+	if mondayFirst {
+		return dayNum % 7
+	}
+	return (dayNum + 1) % 7
+}
+
+func daysInYear(year int32) int64 {
+	if year%4 == 0 && (year%100 != 0 || year%400 == 0) {
+		return 366
+	}
+	return 365
+}
+
+// LastDay returns the last day of the month of the date
+func LastDay(d types.Date) types.Date {
+	year, month, _, _ := d.Calendar(true)
+	if month == 12 {
+		return types.FromCalendar(year+1, 1, 1) - 1
+	}
+	return types.FromCalendar(year, month+1, 1) - 1
+}
+
+func dateToWeek(xs []types.Date, mode int64, rs []int64) []int64 {
+	behaviour := WeekBehaviour(mode)
+	for i, x := range xs {
+		_, rs[i] = CalcWeek(x, behaviour)
+	}
+	return rs
+}
+
+func dateToYearWeek(xs []types.Date, mode int64, rs []int64) []int64 {
+	behaviour := WeekBehaviour(mode) | WeekYear
+	for i, x := range xs {
+		year, week := CalcWeek(x, behaviour)
+		rs[i] = int64(year)*100 + week
+	}
+	return rs
+}
+
+func dateToQuarter(xs []types.Date, rs []int64) []int64 {
+	for i, x := range xs {
+		rs[i] = int64(x.Month()-1)/3 + 1
+	}
+	return rs
+}
+
+func dateToLastDay(xs []types.Date, rs []types.Date) []types.Date {
+	for i, x := range xs {
+		rs[i] = LastDay(x)
+	}
+	return rs
+}
+
+// dateDiff returns the numbers of days from ys to xs
+func dateDiff(xs, ys []types.Date, rs []int64) []int64 {
+	for i := range xs {
+		rs[i] = int64(xs[i]) - int64(ys[i])
+	}
+	return rs
+}
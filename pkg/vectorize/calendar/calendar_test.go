@@ -0,0 +1,78 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package calendar
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/stretchr/testify/require"
+)
+
+func parseDate(s string) types.Date {
+	d, _ := types.ParseDate(s)
+	return d
+}
+
+func parseDates(ss ...string) []types.Date {
+	ds := make([]types.Date, len(ss))
+	for i, s := range ss {
+		ds[i] = parseDate(s)
+	}
+	return ds
+}
+
+func TestDateToWeek(t *testing.T) {
+	xs := parseDates("2008-02-20", "2008-12-31", "2000-01-01", "2022-01-01", "2022-01-02", "2021-12-31")
+	tests := []struct {
+		mode int64
+		want []int64
+	}{
+		{0, []int64{7, 52, 0, 0, 1, 52}},
+		{1, []int64{8, 53, 0, 0, 0, 52}},
+		{2, []int64{7, 52, 52, 52, 1, 52}},
+		{3, []int64{8, 1, 52, 52, 52, 52}},
+		{4, []int64{8, 53, 0, 0, 1, 52}},
+		{5, []int64{7, 52, 0, 0, 0, 52}},
+		{6, []int64{8, 53, 52, 52, 1, 52}},
+		{7, []int64{7, 52, 52, 52, 52, 52}},
+	}
+	for _, tt := range tests {
+		require.Equal(t, tt.want, DateToWeek(xs, tt.mode, make([]int64, len(xs))), "mode %d", tt.mode)
+	}
+}
+
+func TestDateToYearWeek(t *testing.T) {
+	xs := parseDates("1987-01-01", "2008-12-31", "2000-01-01", "2022-01-02")
+	require.Equal(t, []int64{198652, 200852, 199952, 202201}, DateToYearWeek(xs, 0, make([]int64, len(xs))))
+	require.Equal(t, []int64{198701, 200901, 199952, 202152}, DateToYearWeek(xs, 3, make([]int64, len(xs))))
+}
+
+func TestDateToQuarter(t *testing.T) {
+	xs := parseDates("2022-01-01", "2022-03-31", "2022-04-01", "2022-09-30", "2022-12-31")
+	require.Equal(t, []int64{1, 1, 2, 3, 4}, DateToQuarter(xs, make([]int64, len(xs))))
+}
+
+func TestDateToLastDay(t *testing.T) {
+	xs := parseDates("2003-02-05", "2004-02-05", "2004-01-01", "2003-03-31", "2022-12-15")
+	want := parseDates("2003-02-28", "2004-02-29", "2004-01-31", "2003-03-31", "2022-12-31")
+	require.Equal(t, want, DateToLastDay(xs, make([]types.Date, len(xs))))
+}
+
+func TestDateDiff(t *testing.T) {
+	xs := parseDates("2007-12-31", "2010-11-30")
+	ys := parseDates("2007-12-30", "2010-12-31")
+	require.Equal(t, []int64{1, -31}, DateDiff(xs, ys, make([]int64, len(xs))))
+}
@@ -0,0 +1,476 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dateformat
+
+import (
+	"bytes"
+	"strconv"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/calendar"
+)
+
+/*
+The specifiers of DATE_FORMAT and STR_TO_DATE are the same as mysql:
+	%a	abbreviated weekday name (Sun..Sat)
+	%b	abbreviated month name (Jan..Dec)
+	%c	month, numeric (0..12)
+	%D	day of the month with English suffix (0th, 1st, 2nd, 3rd, …)
+	%d	day of the month, numeric (00..31)
+	%e	day of the month, numeric (0..31)
+	%f	microseconds (000000..999999)
+	%H	hour (00..23)
+	%h	hour (01..12)
+	%I	hour (01..12)
+	%i	minutes, numeric (00..59)
+	%j	day of year (001..366)
+	%k	hour (0..23)
+	%l	hour (1..12)
+	%M	month name (January..December)
+	%m	month, numeric (00..12)
+	%p	AM or PM
+	%r	time, 12-hour (hh:mm:ss followed by AM or PM)
+	%S	seconds (00..59)
+	%s	seconds (00..59)
+	%T	time, 24-hour (hh:mm:ss)
+	%U	week (00..53), where Sunday is the first day of the week, WEEK() mode 0
+	%u	week (00..53), where Monday is the first day of the week, WEEK() mode 1
+	%V	week (01..53), where Sunday is the first day of the week, WEEK() mode 2, used with %X
+	%v	week (01..53), where Monday is the first day of the week, WEEK() mode 3, used with %x
+	%W	weekday name (Sunday..Saturday)
+	%w	day of the week (0=Sunday..6=Saturday)
+	%X	year for the week where Sunday is the first day of the week, numeric, four digits, used with %V
+	%x	year for the week, where Monday is the first day of the week, numeric, four digits, used with %v
+	%Y	year, numeric, four digits
+	%y	year, numeric (two digits)
+	%%	a literal % character
+	%?	the character ?, for any character not listed above
+*/
+
+var (
+	DatetimeFormat func([]types.Datetime, []byte, *nulls.Nulls, [][]byte) [][]byte
+	StrToDatetime  func(*types.Bytes, []byte, *nulls.Nulls, []types.Datetime, []bool) ([]types.Datetime, []bool)
+)
+
+func init() {
+	DatetimeFormat = datetimeFormat
+	StrToDatetime = strToDatetime
+}
+
+var (
+	monthNames = []string{
+		"January", "February", "March", "April", "May", "June",
+		"July", "August", "September", "October", "November", "December",
+	}
+	dayNames = []string{
+		"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday",
+	}
+)
+
+// datetimeFormat formats the datetimes which are not null
+func datetimeFormat(xs []types.Datetime, format []byte, nsp *nulls.Nulls, rs [][]byte) [][]byte {
+	for i, x := range xs {
+		if nulls.Contains(nsp, uint64(i)) {
+			continue
+		}
+		rs[i] = Format(make([]byte, 0, 2*len(format)), x, format)
+	}
+	return rs
+}
+
+// strToDatetime parses the strings which are not null, isNull[i] is true if the i-th string can not be parsed
+func strToDatetime(xs *types.Bytes, format []byte, nsp *nulls.Nulls, rs []types.Datetime, isNull []bool) ([]types.Datetime, []bool) {
+	for i := range xs.Lengths {
+		if nulls.Contains(nsp, uint64(i)) {
+			isNull[i] = true
+			continue
+		}
+		var ok bool
+		if rs[i], ok = Parse(xs.Get(int64(i)), format); !ok {
+			isNull[i] = true
+		}
+	}
+	return rs, isNull
+}
+
+// Format appends the datetime formatted by the format to buf
+func Format(buf []byte, dt types.Datetime, format []byte) []byte {
+	date := dt.ToDate()
+	year, month, day, yday := date.Calendar(true)
+	hour, minute, sec := dt.Clock()
+	for i := 0; i < len(format); i++ {
+		if format[i] != '%' || i+1 == len(format) {
+			buf = append(buf, format[i])
+			continue
+		}
+		i++
+		switch format[i] {
+		case 'a':
+			buf = append(buf, dayNames[date.DayOfWeek()][:3]...)
+		case 'b':
+			buf = append(buf, monthNames[month-1][:3]...)
+		case 'c':
+			buf = strconv.AppendInt(buf, int64(month), 10)
+		case 'D':
+			buf = strconv.AppendInt(buf, int64(day), 10)
+			buf = append(buf, daySuffix(day)...)
+		case 'd':
+			buf = appendInt(buf, int64(day), 2)
+		case 'e':
+			buf = strconv.AppendInt(buf, int64(day), 10)
+		case 'f':
+			buf = appendInt(buf, dt.MicroSec(), 6)
+		case 'H':
+			buf = appendInt(buf, int64(hour), 2)
+		case 'h', 'I':
+			buf = appendInt(buf, int64(hour12(hour)), 2)
+		case 'i':
+			buf = appendInt(buf, int64(minute), 2)
+		case 'j':
+			buf = appendInt(buf, int64(yday), 3)
+		case 'k':
+			buf = strconv.AppendInt(buf, int64(hour), 10)
+		case 'l':
+			buf = strconv.AppendInt(buf, int64(hour12(hour)), 10)
+		case 'M':
+			buf = append(buf, monthNames[month-1]...)
+		case 'm':
+			buf = appendInt(buf, int64(month), 2)
+		case 'p':
+			buf = append(buf, meridiem(hour)...)
+		case 'r':
+			buf = appendInt(buf, int64(hour12(hour)), 2)
+			buf = append(buf, ':')
+			buf = appendInt(buf, int64(minute), 2)
+			buf = append(buf, ':')
+			buf = appendInt(buf, int64(sec), 2)
+			buf = append(buf, ' ')
+			buf = append(buf, meridiem(hour)...)
+		case 'S', 's':
+			buf = appendInt(buf, int64(sec), 2)
+		case 'T':
+			buf = appendInt(buf, int64(hour), 2)
+			buf = append(buf, ':')
+			buf = appendInt(buf, int64(minute), 2)
+			buf = append(buf, ':')
+			buf = appendInt(buf, int64(sec), 2)
+		case 'U':
+			_, week := calendar.CalcWeek(date, calendar.WeekFirstWeekday)
+			buf = appendInt(buf, week, 2)
+		case 'u':
+			_, week := calendar.CalcWeek(date, calendar.WeekMondayFirst)
+			buf = appendInt(buf, week, 2)
+		case 'V':
+			_, week := calendar.CalcWeek(date, calendar.WeekYear|calendar.WeekFirstWeekday)
+			buf = appendInt(buf, week, 2)
+		case 'v':
+			_, week := calendar.CalcWeek(date, calendar.WeekYear|calendar.WeekMondayFirst)
+			buf = appendInt(buf, week, 2)
+		case 'W':
+			buf = append(buf, dayNames[date.DayOfWeek()]...)
+		case 'w':
+			buf = strconv.AppendInt(buf, int64(date.DayOfWeek()), 10)
+		case 'X':
+			y, _ := calendar.CalcWeek(date, calendar.WeekYear|calendar.WeekFirstWeekday)
+			buf = appendInt(buf, int64(y), 4)
+		case 'x':
+			y, _ := calendar.CalcWeek(date, calendar.WeekYear|calendar.WeekMondayFirst)
+			buf = appendInt(buf, int64(y), 4)
+		case 'Y':
+			buf = appendInt(buf, int64(year), 4)
+		case 'y':
+			buf = appendInt(buf, int64(year%100), 2)
+		default:
+			buf = append(buf, format[i])
+		}
+	}
+	return buf
+}
+
+// Parse parses the string by the format, and returns false if it does not match the format
+// or it is not a valid datetime. The date must be given, and the time fields not given are 0.
+func Parse(s, format []byte) (types.Datetime, bool) {
+	p := &parser{s: s}
+	if !p.parse(format) {
+		return 0, false
+	}
+	return p.datetime()
+}
+
+type parser struct {
+	s []byte
+
+	year, month, day, yday  int64
+	hour, minute, sec, msec int64
+	hasYday, isHour12       bool
+	pm                      int // 0 if there is no AM or PM, 1 for AM and 2 for PM
+}
+
+func (p *parser) parse(format []byte) bool {
+	for i := 0; i < len(format); i++ {
+		c := format[i]
+		if isSpace(c) {
+			p.skipSpaces()
+			continue
+		}
+		if c != '%' || i+1 == len(format) {
+			if !p.literal(c) {
+				return false
+			}
+			continue
+		}
+		p.skipSpaces()
+		i++
+		ok := true
+		switch format[i] {
+		case 'a':
+			_, ok = p.name(dayNames, 3)
+		case 'W':
+			_, ok = p.name(dayNames, 0)
+		case 'b':
+			p.month, ok = p.name(monthNames, 3)
+			p.month++
+		case 'M':
+			p.month, ok = p.name(monthNames, 0)
+			p.month++
+		case 'c', 'm':
+			p.month, ok = p.number(2)
+		case 'd', 'e':
+			p.day, ok = p.number(2)
+		case 'D':
+			if p.day, ok = p.number(2); ok {
+				ok = p.suffix()
+			}
+		case 'f':
+			ok = p.microseconds()
+		case 'H', 'k':
+			p.hour, ok = p.number(2)
+		case 'h', 'I', 'l':
+			p.hour, ok = p.number(2)
+			p.isHour12 = true
+		case 'i':
+			p.minute, ok = p.number(2)
+		case 'j':
+			p.yday, ok = p.number(3)
+			p.hasYday = true
+		case 'p':
+			ok = p.meridiem()
+		case 'r':
+			ok = p.parse([]byte("%I:%i:%S %p"))
+		case 'S', 's':
+			p.sec, ok = p.number(2)
+		case 'T':
+			ok = p.parse([]byte("%H:%i:%S"))
+		case 'U', 'u', 'V', 'v', 'w':
+			_, ok = p.number(2)
+		case 'X', 'x':
+			_, ok = p.number(4)
+		case 'Y':
+			start := len(p.s)
+			p.year, ok = p.number(4)
+			if ok && start-len(p.s) <= 2 {
+				p.year = twoDigitYear(p.year)
+			}
+		case 'y':
+			p.year, ok = p.number(2)
+			p.year = twoDigitYear(p.year)
+		default:
+			ok = p.literal(format[i])
+		}
+		if !ok {
+			return false
+		}
+	}
+	return true
+}
+
+func (p *parser) datetime() (types.Datetime, bool) {
+	if p.isHour12 {
+		if p.hour < 1 || p.hour > 12 {
+			return 0, false
+		}
+		if p.hour == 12 {
+			p.hour = 0
+		}
+		if p.pm == 2 {
+			p.hour += 12
+		}
+	} else if p.pm != 0 {
+		// AM or PM is only valid with the 12-hour clock
+		return 0, false
+	}
+	if p.hour > 23 || p.minute > 59 || p.sec > 59 || p.year > types.MaxDateYear {
+		return 0, false
+	}
+	var date types.Date
+	switch {
+	case p.hasYday:
+		if p.yday < 1 || p.yday > 366 || p.year < 1 {
+			return 0, false
+		}
+		date = types.FromCalendar(int32(p.year), 1, 1) + types.Date(p.yday-1)
+		if y, _, _, _ := date.Calendar(false); int64(y) != p.year {
+			return 0, false
+		}
+	default:
+		// the date of mysql may be zero, which can not be represented here
+		if p.year < 1 || p.month < 1 || p.month > 12 || p.day < 1 || p.day > 31 {
+			return 0, false
+		}
+		date = types.FromCalendar(int32(p.year), uint8(p.month), 1) + types.Date(p.day-1)
+		if _, m, _, _ := date.Calendar(true); int64(m) != p.month {
+			return 0, false
+		}
+	}
+	return date.ToTime() + types.Datetime((p.hour*3600+p.minute*60+p.sec)<<20+p.msec), true
+}
+
+// number parses an unsigned integer of at most width digits
+func (p *parser) number(width int) (int64, bool) {
+	n := 0
+	for n < width && n < len(p.s) && p.s[n] >= '0' && p.s[n] <= '9' {
+		n++
+	}
+	if n == 0 {
+		return 0, false
+	}
+	v, _ := strconv.ParseInt(string(p.s[:n]), 10, 64)
+	p.s = p.s[n:]
+	return v, true
+}
+
+// microseconds parses at most 6 digits as the fraction of a second
+func (p *parser) microseconds() bool {
+	start := len(p.s)
+	v, ok := p.number(6)
+	if !ok {
+		return false
+	}
+	for n := start - len(p.s); n < 6; n++ {
+		v *= 10
+	}
+	p.msec = v
+	return true
+}
+
+// name parses a name of the names ignoring case, or the prefix of length n if n > 0
+func (p *parser) name(names []string, n int) (int64, bool) {
+	for i, name := range names {
+		if n > 0 {
+			name = name[:n]
+		}
+		if len(p.s) >= len(name) && bytes.EqualFold(p.s[:len(name)], []byte(name)) {
+			p.s = p.s[len(name):]
+			return int64(i), true
+		}
+	}
+	return 0, false
+}
+
+func (p *parser) suffix() bool {
+	if len(p.s) < 2 {
+		return false
+	}
+	switch string(bytes.ToLower(p.s[:2])) {
+	case "st", "nd", "rd", "th":
+		p.s = p.s[2:]
+		return true
+	}
+	return false
+}
+
+func (p *parser) meridiem() bool {
+	if len(p.s) < 2 {
+		return false
+	}
+	switch string(bytes.ToUpper(p.s[:2])) {
+	case "AM":
+		p.pm = 1
+	case "PM":
+		p.pm = 2
+	default:
+		return false
+	}
+	p.s = p.s[2:]
+	return true
+}
+
+func (p *parser) literal(c byte) bool {
+	if len(p.s) == 0 || p.s[0] != c {
+		return false
+	}
+	p.s = p.s[1:]
+	return true
+}
+
+func (p *parser) skipSpaces() {
+	for len(p.s) > 0 && isSpace(p.s[0]) {
+		p.s = p.s[1:]
+	}
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r'
+}
+
+// twoDigitYear returns the year of a two-digit year, 70-99 are 1970-1999 and 00-69 are 2000-2069
+func twoDigitYear(y int64) int64 {
+	if y < 70 {
+		return y + 2000
+	}
+	if y < 100 {
+		return y + 1900
+	}
+	return y
+}
+
+// appendInt appends v padded with zeros to width digits
+func appendInt(buf []byte, v int64, width int) []byte {
+	s := strconv.FormatInt(v, 10)
+	for n := len(s); n < width; n++ {
+		buf = append(buf, '0')
+	}
+	return append(buf, s...)
+}
+
+func daySuffix(day uint8) string {
+	if day/10 == 1 {
+		return "th"
+	}
+	switch day % 10 {
+	case 1:
+		return "st"
+	case 2:
+		return "nd"
+	case 3:
+		return "rd"
+	}
+	return "th"
+}
+
+func hour12(hour int8) int8 {
+	if hour%12 == 0 {
+		return 12
+	}
+	return hour % 12
+}
+
+func meridiem(hour int8) string {
+	if hour < 12 {
+		return "AM"
+	}
+	return "PM"
+}
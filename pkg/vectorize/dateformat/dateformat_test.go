@@ -0,0 +1,108 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dateformat
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/stretchr/testify/require"
+)
+
+func parseDatetime(s string) types.Datetime {
+	dt, _ := types.ParseDatetime(s)
+	return dt
+}
+
+func TestDatetimeFormat(t *testing.T) {
+	xs := []types.Datetime{
+		parseDatetime("2009-10-04 22:23:00"),
+		parseDatetime("2007-10-04 22:23:00"),
+		parseDatetime("1900-10-04 22:23:00"),
+		parseDatetime("1997-10-04 22:23:00"),
+		parseDatetime("1999-01-01 00:00:00"),
+		parseDatetime("2006-06-01 00:00:00"),
+		parseDatetime("2022-05-03 12:05:09.012345"),
+	}
+	nsp := new(nulls.Nulls)
+	nulls.Add(nsp, 1)
+	tests := []struct {
+		format string
+		want   []string
+	}{
+		{"%W %M %Y", []string{"Sunday October 2009", "", "Thursday October 1900", "Saturday October 1997", "Friday January 1999", "Thursday June 2006", "Tuesday May 2022"}},
+		{"%H:%i:%s", []string{"22:23:00", "", "22:23:00", "22:23:00", "00:00:00", "00:00:00", "12:05:09"}},
+		{"%D %y %a %d %m %b %j", []string{"4th 09 Sun 04 10 Oct 277", "", "4th 00 Thu 04 10 Oct 277", "4th 97 Sat 04 10 Oct 277", "1st 99 Fri 01 01 Jan 001", "1st 06 Thu 01 06 Jun 152", "3rd 22 Tue 03 05 May 123"}},
+		{"%H %k %I %r %T %S %w", []string{"22 22 10 10:23:00 PM 22:23:00 00 0", "", "22 22 10 10:23:00 PM 22:23:00 00 4", "22 22 10 10:23:00 PM 22:23:00 00 6", "00 0 12 12:00:00 AM 00:00:00 00 5", "00 0 12 12:00:00 AM 00:00:00 00 4", "12 12 12 12:05:09 PM 12:05:09 09 2"}},
+		{"%X %V", []string{"2009 40", "", "1900 39", "1997 39", "1998 52", "2006 22", "2022 18"}},
+		{"%c/%e %l%p %f %% %q", []string{"10/4 10PM 000000 % q", "", "10/4 10PM 000000 % q", "10/4 10PM 000000 % q", "1/1 12AM 000000 % q", "6/1 12AM 000000 % q", "5/3 12PM 012345 % q"}},
+		{"%U %u %v %x", []string{"40 40 40 2009", "", "39 40 40 1900", "39 40 40 1997", "00 00 53 1998", "22 22 22 2006", "18 18 18 2022"}},
+	}
+	for _, tt := range tests {
+		rs := DatetimeFormat(xs, []byte(tt.format), nsp, make([][]byte, len(xs)))
+		got := make([]string, len(rs))
+		for i, r := range rs {
+			got[i] = string(r)
+		}
+		require.Equal(t, tt.want, got, tt.format)
+	}
+}
+
+func TestStrToDatetime(t *testing.T) {
+	tests := []struct {
+		s, format string
+		want      string // empty if the string can not be parsed
+	}{
+		{"01,5,2013", "%d,%m,%Y", "2013-05-01 00:00:00"},
+		{"May 1, 2013", "%M %d,%Y", "2013-05-01 00:00:00"},
+		{"a09:30:17", "a%h:%i:%s", ""},
+		{"2013-05-01 09:30:17 pm", "%Y-%m-%d %h:%i:%s %p", "2013-05-01 21:30:17"},
+		{"2013-05-01 12:30:17 AM", "%Y-%m-%d %r", "2013-05-01 00:30:17"},
+		{"15-Feb-2022 23:01:02.5", "%d-%b-%Y %T.%f", "2022-02-15 23:01:02"},
+		{"Tuesday 3rd May 22", "%W %D %M %y", "2022-05-03 00:00:00"},
+		{"2022 032", "%Y %j", "2022-02-01 00:00:00"},
+		{"2022-02-30", "%Y-%m-%d", ""},
+		{"2022-13-01", "%Y-%m-%d", ""},
+		{"2022-01-01 13:00 PM", "%Y-%m-%d %H:%i %p", ""},
+		{"2022-01", "%Y-%m-%d", ""},
+		{"2022", "%Y", ""},
+		{"  2022-01-02", "%Y-%m-%d", "2022-01-02 00:00:00"},
+	}
+	for _, tt := range tests {
+		dt, ok := Parse([]byte(tt.s), []byte(tt.format))
+		if tt.want == "" {
+			require.False(t, ok, tt.s)
+			continue
+		}
+		require.True(t, ok, tt.s)
+		require.Equal(t, tt.want, dt.String(), tt.s)
+	}
+	dt, ok := Parse([]byte("15-Feb-2022 23:01:02.5"), []byte("%d-%b-%Y %T.%f"))
+	require.True(t, ok)
+	require.Equal(t, int64(500000), dt.MicroSec())
+
+	xs := &types.Bytes{}
+	for _, s := range []string{"2022-01-02", "", "bad"} {
+		xs.Offsets = append(xs.Offsets, uint32(len(xs.Data)))
+		xs.Lengths = append(xs.Lengths, uint32(len(s)))
+		xs.Data = append(xs.Data, s...)
+	}
+	nsp := new(nulls.Nulls)
+	nulls.Add(nsp, 1)
+	rs, isNull := StrToDatetime(xs, []byte("%Y-%m-%d"), nsp, make([]types.Datetime, 3), make([]bool, 3))
+	require.Equal(t, []bool{false, true, true}, isNull)
+	require.Equal(t, parseDatetime("2022-01-02 00:00:00"), rs[0])
+}
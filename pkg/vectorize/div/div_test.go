@@ -3,6 +3,7 @@ package div
 import (
 	"testing"
 
+	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -768,3 +769,24 @@ func TestFloat64IntegerDivByScalarSels(t *testing.T) {
 	}
 	require.Equal(t, rsCorrect, rs)
 }
+
+func TestDecimal64Div(t *testing.T) {
+	// 1.00 / 3, 2.00 / 3, -1.00 / 8 with result scale 6
+	xs := []types.Decimal64{100, 200, -100}
+	ys := []types.Decimal64{3, 3, 8}
+	rs := make([]types.Decimal128, len(xs))
+	rs, err := Decimal64Div(xs, ys, 2, 0, 6, rs)
+	require.NoError(t, err)
+	require.Equal(t, []types.Decimal128{types.InitDecimal128(333333), types.InitDecimal128(666667), types.InitDecimal128(-125000)}, rs)
+}
+
+func TestDecimal128DivByScalarSels(t *testing.T) {
+	x := types.InitDecimal128(4)
+	ys := []types.Decimal128{types.InitDecimal128(10), types.InitDecimal128(20), types.InitDecimal128(30)}
+	rs := make([]types.Decimal128, len(ys))
+	rs, err := Decimal128DivByScalarSels(x, ys, 0, 0, 1, rs, []int64{0, 2})
+	require.NoError(t, err)
+	require.Equal(t, []types.Decimal128{types.InitDecimal128(25), {}, types.InitDecimal128(75)}, rs)
+	_, err = Decimal128DivScalar(x, []types.Decimal128{{}}, 0, 0, 1, rs)
+	require.Equal(t, types.ErrDecimalDivByZero, err)
+}
@@ -156,58 +156,91 @@ func numericMulScalarSels2[TSmall, TBig constraints.Integer | constraints.Float]
 }
 */
 
-func decimal64Mul(xs []types.Decimal64, ys []types.Decimal64, rs []types.Decimal128) []types.Decimal128 {
+func decimal64Mul(xs, ys []types.Decimal64, xsScale, ysScale, rsScale int32, rs []types.Decimal128) ([]types.Decimal128, error) {
+	// the result scale is normally given by types.DecimalMulResultType, the product is rounded half up to it
+	var err error
+
 	for i, x := range xs {
-		rs[i] = types.Decimal64Decimal64Mul(x, ys[i])
+		if rs[i], err = types.Decimal64MulChecked(x, ys[i], xsScale, ysScale, rsScale); err != nil {
+			return nil, err
+		}
 	}
-	return rs
+	return rs, nil
 }
 
-func decimal64MulSels(xs, ys []types.Decimal64, rs []types.Decimal128, sels []int64) []types.Decimal128 {
+func decimal64MulSels(xs, ys []types.Decimal64, xsScale, ysScale, rsScale int32, rs []types.Decimal128, sels []int64) ([]types.Decimal128, error) {
+	var err error
+
 	for i, sel := range sels {
-		rs[i] = types.Decimal64Decimal64Mul(xs[sel], ys[sel])
+		if rs[i], err = types.Decimal64MulChecked(xs[sel], ys[sel], xsScale, ysScale, rsScale); err != nil {
+			return nil, err
+		}
 	}
-	return rs
+	return rs, nil
 }
 
-func decimal64MulScalar(x types.Decimal64, ys []types.Decimal64, rs []types.Decimal128) []types.Decimal128 {
+func decimal64MulScalar(x types.Decimal64, ys []types.Decimal64, xScale, ysScale, rsScale int32, rs []types.Decimal128) ([]types.Decimal128, error) {
+	var err error
+
 	for i, y := range ys {
-		rs[i] = types.Decimal64Decimal64Mul(x, y)
+		if rs[i], err = types.Decimal64MulChecked(x, y, xScale, ysScale, rsScale); err != nil {
+			return nil, err
+		}
 	}
-	return rs
+	return rs, nil
 }
 
-func decimal64MulScalarSels(x types.Decimal64, ys []types.Decimal64, rs []types.Decimal128, sels []int64) []types.Decimal128 {
+func decimal64MulScalarSels(x types.Decimal64, ys []types.Decimal64, xScale, ysScale, rsScale int32, rs []types.Decimal128, sels []int64) ([]types.Decimal128, error) {
+	var err error
+
 	for i, sel := range sels {
-		rs[i] = types.Decimal64Decimal64Mul(x, ys[sel])
+		if rs[i], err = types.Decimal64MulChecked(x, ys[sel], xScale, ysScale, rsScale); err != nil {
+			return nil, err
+		}
 	}
-	return rs
+	return rs, nil
 }
 
-func decimal128Mul(xs []types.Decimal128, ys []types.Decimal128, rs []types.Decimal128) []types.Decimal128 {
+func decimal128Mul(xs, ys []types.Decimal128, xsScale, ysScale, rsScale int32, rs []types.Decimal128) ([]types.Decimal128, error) {
+	var err error
+
 	for i, x := range xs {
-		rs[i] = types.Decimal128Decimal128Mul(x, ys[i])
+		if rs[i], err = types.Decimal128MulChecked(x, ys[i], xsScale, ysScale, rsScale); err != nil {
+			return nil, err
+		}
 	}
-	return rs
+	return rs, nil
 }
 
-func decimal128MulSels(xs, ys []types.Decimal128, rs []types.Decimal128, sels []int64) []types.Decimal128 {
+func decimal128MulSels(xs, ys []types.Decimal128, xsScale, ysScale, rsScale int32, rs []types.Decimal128, sels []int64) ([]types.Decimal128, error) {
+	var err error
+
 	for i, sel := range sels {
-		rs[i] = types.Decimal128Decimal128Mul(xs[sel], ys[sel])
+		if rs[i], err = types.Decimal128MulChecked(xs[sel], ys[sel], xsScale, ysScale, rsScale); err != nil {
+			return nil, err
+		}
 	}
-	return rs
+	return rs, nil
 }
 
-func decimal128MulScalar(x types.Decimal128, ys []types.Decimal128, rs []types.Decimal128) []types.Decimal128 {
+func decimal128MulScalar(x types.Decimal128, ys []types.Decimal128, xScale, ysScale, rsScale int32, rs []types.Decimal128) ([]types.Decimal128, error) {
+	var err error
+
 	for i, y := range ys {
-		rs[i] = types.Decimal128Decimal128Mul(x, y)
+		if rs[i], err = types.Decimal128MulChecked(x, y, xScale, ysScale, rsScale); err != nil {
+			return nil, err
+		}
 	}
-	return rs
+	return rs, nil
 }
 
-func decimal128MulScalarSels(x types.Decimal128, ys []types.Decimal128, rs []types.Decimal128, sels []int64) []types.Decimal128 {
+func decimal128MulScalarSels(x types.Decimal128, ys []types.Decimal128, xScale, ysScale, rsScale int32, rs []types.Decimal128, sels []int64) ([]types.Decimal128, error) {
+	var err error
+
 	for i, sel := range sels {
-		rs[i] = types.Decimal128Decimal128Mul(x, ys[sel])
+		if rs[i], err = types.Decimal128MulChecked(x, ys[sel], xScale, ysScale, rsScale); err != nil {
+			return nil, err
+		}
 	}
-	return rs
+	return rs, nil
 }
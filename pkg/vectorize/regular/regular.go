@@ -0,0 +1,218 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package regular
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+/*
+Regular expression functions of MySQL, the match type is a string of the flags:
+	c: case sensitive matching (the default)
+	i: case insensitive matching
+	m: multiple-line mode, ^ and $ match at the line terminators
+	n: the . character matches the line terminators
+	u: unix-only line endings, only \n is a line terminator, which is always true here
+If c and i are both given, the rightmost one takes precedence.
+The positions are the positions of the characters starting from 1.
+*/
+
+// maxCached is the max number of the compiled patterns kept
+const maxCached = 1024
+
+var (
+	ErrMatchType   = errors.New("invalid match type of regular expression")
+	ErrPosition    = errors.New("index out of bounds in regular expression search")
+	ErrReturnValue = errors.New("incorrect return option of regular expression, it must be 0 or 1")
+)
+
+// cache keeps the compiled patterns so that a constant pattern is compiled only once
+var cache = struct {
+	sync.RWMutex
+	res map[string]*regexp.Regexp
+}{res: make(map[string]*regexp.Regexp)}
+
+// Compile returns the regular expression of the pattern and the match type
+func Compile(pattern, matchType []byte) (*regexp.Regexp, error) {
+	flags, err := parseMatchType(matchType)
+	if err != nil {
+		return nil, err
+	}
+	expr := flags + string(pattern)
+	cache.RLock()
+	re, ok := cache.res[expr]
+	cache.RUnlock()
+	if ok {
+		return re, nil
+	}
+	if re, err = regexp.Compile(expr); err != nil {
+		return nil, fmt.Errorf("invalid regular expression '%s': %v", pattern, err)
+	}
+	cache.Lock()
+	if len(cache.res) >= maxCached {
+		cache.res = make(map[string]*regexp.Regexp)
+	}
+	cache.res[expr] = re
+	cache.Unlock()
+	return re, nil
+}
+
+// parseMatchType returns the flags of the go regular expression
+func parseMatchType(matchType []byte) (string, error) {
+	insensitive, multiline, dotAll := false, false, false
+	for _, c := range matchType {
+		switch c {
+		case 'c':
+			insensitive = false
+		case 'i':
+			insensitive = true
+		case 'm':
+			multiline = true
+		case 'n':
+			dotAll = true
+		case 'u':
+		default:
+			return "", ErrMatchType
+		}
+	}
+	flags := ""
+	if insensitive {
+		flags += "i"
+	}
+	if multiline {
+		flags += "m"
+	}
+	if dotAll {
+		flags += "s"
+	}
+	if flags == "" {
+		return "", nil
+	}
+	return "(?" + flags + ")", nil
+}
+
+// Like sets rs[i] to true if the i-th string matches the regular expression, the nulls are skipped
+func Like(xs *types.Bytes, re *regexp.Regexp, nsp *nulls.Nulls, rs []bool) []bool {
+	for i := range xs.Lengths {
+		if nulls.Contains(nsp, uint64(i)) {
+			continue
+		}
+		rs[i] = re.Match(xs.Get(int64(i)))
+	}
+	return rs
+}
+
+// Instr returns the position of the occurrence-th match starting from the position pos, or 0 if there
+// is no such match. The position is the first character of the match if returnOption is 0, and the
+// character following the match if it is 1.
+func Instr(s []byte, re *regexp.Regexp, pos, occurrence, returnOption int64) (int64, error) {
+	if returnOption != 0 && returnOption != 1 {
+		return 0, ErrReturnValue
+	}
+	start, err := byteOffset(s, pos)
+	if err != nil {
+		return 0, err
+	}
+	loc := find(s[start:], re, occurrence)
+	if loc == nil {
+		return 0, nil
+	}
+	end := start + loc[0]
+	if returnOption == 1 {
+		end = start + loc[1]
+	}
+	return int64(utf8.RuneCount(s[:end])) + 1, nil
+}
+
+// Substr returns the occurrence-th match starting from the position pos, and false if there is no such match
+func Substr(s []byte, re *regexp.Regexp, pos, occurrence int64) ([]byte, bool, error) {
+	start, err := byteOffset(s, pos)
+	if err != nil {
+		return nil, false, err
+	}
+	loc := find(s[start:], re, occurrence)
+	if loc == nil {
+		return nil, false, nil
+	}
+	return s[start+loc[0] : start+loc[1]], true, nil
+}
+
+// Replace replaces the matches starting from the position pos by the replacement, whose $n is the n-th
+// group of the match. All the matches are replaced if occurrence is 0, otherwise only the occurrence-th one.
+func Replace(s []byte, re *regexp.Regexp, repl []byte, pos, occurrence int64) ([]byte, error) {
+	start, err := byteOffset(s, pos)
+	if err != nil {
+		return nil, err
+	}
+	n := -1
+	if occurrence > 0 {
+		n = int(occurrence)
+	}
+	locs := re.FindAllSubmatchIndex(s[start:], n)
+	if occurrence > 0 {
+		if len(locs) < int(occurrence) {
+			return s, nil
+		}
+		locs = locs[len(locs)-1:]
+	}
+	if len(locs) == 0 {
+		return s, nil
+	}
+	rs := make([]byte, 0, len(s))
+	rs = append(rs, s[:start]...)
+	last := 0
+	src := s[start:]
+	for _, loc := range locs {
+		rs = append(rs, src[last:loc[0]]...)
+		rs = re.Expand(rs, repl, src, loc)
+		last = loc[1]
+	}
+	return append(rs, src[last:]...), nil
+}
+
+// find returns the location of the occurrence-th match, occurrence less than 1 means the first one
+func find(s []byte, re *regexp.Regexp, occurrence int64) []int {
+	if occurrence < 1 {
+		occurrence = 1
+	}
+	locs := re.FindAllIndex(s, int(occurrence))
+	if len(locs) < int(occurrence) {
+		return nil
+	}
+	return locs[occurrence-1]
+}
+
+// byteOffset returns the offset of the character at the position, which may be the end of the string
+func byteOffset(s []byte, pos int64) (int, error) {
+	if pos < 1 {
+		return 0, ErrPosition
+	}
+	offset := 0
+	for i := int64(1); i < pos; i++ {
+		if offset >= len(s) {
+			return 0, ErrPosition
+		}
+		_, size := utf8.DecodeRune(s[offset:])
+		offset += size
+	}
+	return offset, nil
+}
@@ -0,0 +1,122 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package regular
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompile(t *testing.T) {
+	re, err := Compile([]byte("^abc$"), []byte("ic"))
+	require.NoError(t, err)
+	require.False(t, re.MatchString("ABC"))
+	re, err = Compile([]byte("^abc$"), []byte("ci"))
+	require.NoError(t, err)
+	require.True(t, re.MatchString("ABC"))
+
+	re, err = Compile([]byte("^b.c$"), []byte("mn"))
+	require.NoError(t, err)
+	require.True(t, re.MatchString("a\nb\nc"))
+
+	// the same pattern is compiled only once
+	again, err := Compile([]byte("^b.c$"), []byte("nm"))
+	require.NoError(t, err)
+	require.True(t, re == again)
+
+	_, err = Compile([]byte("a"), []byte("x"))
+	require.Equal(t, ErrMatchType, err)
+	_, err = Compile([]byte("a("), nil)
+	require.Error(t, err)
+}
+
+func TestLike(t *testing.T) {
+	xs := &types.Bytes{
+		Data:    []byte("error: diskinfo: okwarn"),
+		Offsets: []uint32{0, 12, 21},
+		Lengths: []uint32{12, 9, 4},
+	}
+	re, err := Compile([]byte("^(error|warn)"), nil)
+	require.NoError(t, err)
+	nsp := &nulls.Nulls{}
+	nulls.Add(nsp, 2)
+	require.Equal(t, []bool{true, false, false}, Like(xs, re, nsp, make([]bool, 3)))
+}
+
+func TestInstr(t *testing.T) {
+	re, err := Compile([]byte("o+"), nil)
+	require.NoError(t, err)
+	s := []byte("fóo boo zoo")
+	kases := []struct {
+		pos, occurrence, returnOption int64
+		want                          int64
+	}{
+		{1, 1, 0, 3},
+		{1, 1, 1, 4},
+		{1, 2, 0, 6},
+		{4, 2, 0, 10},
+		{1, 4, 0, 0},
+		{12, 1, 0, 0},
+	}
+	for _, k := range kases {
+		got, err := Instr(s, re, k.pos, k.occurrence, k.returnOption)
+		require.NoError(t, err)
+		require.Equal(t, k.want, got, "%+v", k)
+	}
+	_, err = Instr(s, re, 0, 1, 0)
+	require.Equal(t, ErrPosition, err)
+	_, err = Instr(s, re, 13, 1, 0)
+	require.Equal(t, ErrPosition, err)
+	_, err = Instr(s, re, 1, 1, 2)
+	require.Equal(t, ErrReturnValue, err)
+}
+
+func TestSubstr(t *testing.T) {
+	re, err := Compile([]byte("[0-9]+"), nil)
+	require.NoError(t, err)
+	s := []byte("id=12 ms=345")
+	match, ok, err := Substr(s, re, 1, 1)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, "12", string(match))
+	match, ok, err = Substr(s, re, 6, 1)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, "345", string(match))
+	_, ok, err = Substr(s, re, 1, 3)
+	require.NoError(t, err)
+	require.False(t, ok)
+}
+
+func TestReplace(t *testing.T) {
+	re, err := Compile([]byte("([a-z]+)=([0-9]+)"), nil)
+	require.NoError(t, err)
+	s := []byte("a=1 b=2 c=3")
+	rs, err := Replace(s, re, []byte("$2:$1"), 1, 0)
+	require.NoError(t, err)
+	require.Equal(t, "1:a 2:b 3:c", string(rs))
+	rs, err = Replace(s, re, []byte("x"), 1, 2)
+	require.NoError(t, err)
+	require.Equal(t, "a=1 x c=3", string(rs))
+	rs, err = Replace(s, re, []byte("x"), 5, 0)
+	require.NoError(t, err)
+	require.Equal(t, "a=1 x x", string(rs))
+	rs, err = Replace(s, re, []byte("x"), 1, 4)
+	require.NoError(t, err)
+	require.Equal(t, "a=1 b=2 c=3", string(rs))
+}
@@ -37,8 +37,12 @@ var (
 	Decimal128Shuffle = fixedLengthShuffle[types.Decimal128]
 
 	DateShuffle      = fixedLengthShuffle[types.Date]
+	TimeShuffle      = fixedLengthShuffle[types.Time]
 	DatetimeShuffle  = fixedLengthShuffle[types.Datetime]
 	TimestampShuffle = fixedLengthShuffle[types.Timestamp]
+	YearValueShuffle = fixedLengthShuffle[types.YearValue]
+
+	UuidShuffle = fixedLengthShuffle[types.Uuid]
 
 	TupleShuffle = tupleShuffle
 
@@ -46,7 +50,7 @@ var (
 )
 
 type fixedLength interface {
-	constraints.Integer | constraints.Float | types.Decimal128
+	constraints.Integer | constraints.Float | types.Decimal128 | types.Uuid
 }
 
 func fixedLengthShuffle[T fixedLength](vs, ws []T, sels []int64) []T {
@@ -0,0 +1,205 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package timeunit
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/calendar"
+)
+
+// Unit is the unit of TIMESTAMPDIFF, TIMESTAMPADD and DATE_TRUNC
+type Unit int
+
+const (
+	Microsecond Unit = iota
+	Second
+	Minute
+	Hour
+	Day
+	Week
+	Month
+	Quarter
+	Year
+)
+
+var units = map[string]Unit{
+	"microsecond": Microsecond,
+	"second":      Second,
+	"minute":      Minute,
+	"hour":        Hour,
+	"day":         Day,
+	"week":        Week,
+	"month":       Month,
+	"quarter":     Quarter,
+	"year":        Year,
+}
+
+// microseconds is the number of microseconds of the units shorter than a month
+var microseconds = []int64{
+	Microsecond: 1,
+	Second:      1000000,
+	Minute:      60 * 1000000,
+	Hour:        60 * 60 * 1000000,
+	Day:         24 * 60 * 60 * 1000000,
+	Week:        7 * 24 * 60 * 60 * 1000000,
+}
+
+// months is the number of months of the units not shorter than a month
+var months = map[Unit]int64{
+	Month:   1,
+	Quarter: 3,
+	Year:    12,
+}
+
+var (
+	DatetimeDiff  func(Unit, []types.Datetime, []types.Datetime, []int64) []int64
+	DatetimeAdd   func(Unit, []int64, []types.Datetime, []types.Datetime, []bool) ([]types.Datetime, []bool)
+	DatetimeTrunc func(Unit, []types.Datetime, []types.Datetime) []types.Datetime
+)
+
+func init() {
+	DatetimeDiff = datetimeDiff
+	DatetimeAdd = datetimeAdd
+	DatetimeTrunc = datetimeTrunc
+}
+
+// ParseUnit returns the unit of the name, the SQL_TSI_ prefix of odbc is allowed
+func ParseUnit(name string) (Unit, error) {
+	s := strings.TrimPrefix(strings.ToLower(name), "sql_tsi_")
+	if u, ok := units[s]; ok {
+		return u, nil
+	}
+	return 0, fmt.Errorf("invalid time unit '%s'", name)
+}
+
+// Diff returns the number of the whole units from x to y, which is negative if y is before x
+func Diff(u Unit, x, y types.Datetime) int64 {
+	if n, ok := months[u]; ok {
+		return monthDiff(x, y) / n
+	}
+	return (y.UnixMicro() - x.UnixMicro()) / microseconds[u]
+}
+
+// monthDiff returns the number of the whole months from x to y
+func monthDiff(x, y types.Datetime) int64 {
+	neg := false
+	if y < x {
+		x, y = y, x
+		neg = true
+	}
+	xYear, xMonth, xDay, _ := x.ToDate().Calendar(true)
+	yYear, yMonth, yDay, _ := y.ToDate().Calendar(true)
+	n := int64(yYear-xYear)*12 + int64(yMonth) - int64(xMonth)
+	// the last month is not whole if the day and the time of y are before those of x
+	if yDay < xDay || (yDay == xDay && timeOfDay(y) < timeOfDay(x)) {
+		n--
+	}
+	if neg {
+		return -n
+	}
+	return n
+}
+
+// timeOfDay returns the microseconds since the midnight
+func timeOfDay(dt types.Datetime) int64 {
+	return dt.UnixMicro() - dt.ToDate().ToTime().UnixMicro()
+}
+
+// Add returns the datetime n units after dt, and false if the result is out of the range of
+// the datetime. The day is the last day of the month if it is beyond that month.
+func Add(u Unit, n int64, dt types.Datetime) (types.Datetime, bool) {
+	if m, ok := months[u]; ok {
+		if n > (types.MaxDateYear+1)*12 || n < -(types.MaxDateYear+1)*12 {
+			return 0, false
+		}
+		year, month, day, _ := dt.ToDate().Calendar(true)
+		total := int64(year)*12 + int64(month) - 1 + n*m
+		if total < 12 || total >= (types.MaxDateYear+1)*12 {
+			return 0, false
+		}
+		year, month = int32(total/12), uint8(total%12+1)
+		date := types.FromCalendar(year, month, 1)
+		if last := calendar.LastDay(date); date+types.Date(day-1) > last {
+			date = last
+		} else {
+			date += types.Date(day - 1)
+		}
+		return types.DatetimeFromUnixMicro(date.ToTime().UnixMicro() + timeOfDay(dt)), true
+	}
+	// the limit of n keeps the result from overflow
+	const limit = int64(1) << 62
+	us := microseconds[u]
+	if n > limit/us || n < -limit/us {
+		return 0, false
+	}
+	r := types.DatetimeFromUnixMicro(dt.UnixMicro() + n*us)
+	if r < 0 || r.ToDate().Year() > types.MaxDateYear {
+		return 0, false
+	}
+	return r, true
+}
+
+// Trunc returns dt truncated to the start of the unit, a week starts on Monday
+func Trunc(u Unit, dt types.Datetime) types.Datetime {
+	date := dt.ToDate()
+	switch u {
+	case Week:
+		// January 1, year 1 in Gregorian calendar, was a Monday.
+		return (date - date%7).ToTime()
+	case Month, Quarter, Year:
+		year, month, _, _ := date.Calendar(true)
+		switch u {
+		case Quarter:
+			month = (month-1)/3*3 + 1
+		case Year:
+			month = 1
+		}
+		return types.FromCalendar(year, month, 1).ToTime()
+	}
+	us := dt.UnixMicro()
+	r := us % microseconds[u]
+	if r < 0 {
+		r += microseconds[u]
+	}
+	return types.DatetimeFromUnixMicro(us - r)
+}
+
+func datetimeDiff(u Unit, xs, ys []types.Datetime, rs []int64) []int64 {
+	for i := range xs {
+		rs[i] = Diff(u, xs[i], ys[i])
+	}
+	return rs
+}
+
+// datetimeAdd adds ns[i] units to xs[i], isNull[i] is true if the result is out of range
+func datetimeAdd(u Unit, ns []int64, xs []types.Datetime, rs []types.Datetime, isNull []bool) ([]types.Datetime, []bool) {
+	for i := range xs {
+		var ok bool
+		if rs[i], ok = Add(u, ns[i], xs[i]); !ok {
+			isNull[i] = true
+		}
+	}
+	return rs, isNull
+}
+
+func datetimeTrunc(u Unit, xs []types.Datetime, rs []types.Datetime) []types.Datetime {
+	for i, x := range xs {
+		rs[i] = Trunc(u, x)
+	}
+	return rs
+}
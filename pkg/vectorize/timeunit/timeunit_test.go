@@ -0,0 +1,114 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package timeunit
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/stretchr/testify/require"
+)
+
+func parseDatetime(s string) types.Datetime {
+	dt, _ := types.ParseDatetime(s)
+	return dt
+}
+
+func parseDatetimes(ss ...string) []types.Datetime {
+	dts := make([]types.Datetime, len(ss))
+	for i, s := range ss {
+		dts[i] = parseDatetime(s)
+	}
+	return dts
+}
+
+func TestParseUnit(t *testing.T) {
+	u, err := ParseUnit("MINUTE")
+	require.NoError(t, err)
+	require.Equal(t, Minute, u)
+	u, err = ParseUnit("sql_tsi_quarter")
+	require.NoError(t, err)
+	require.Equal(t, Quarter, u)
+	_, err = ParseUnit("fortnight")
+	require.Error(t, err)
+}
+
+func TestDatetimeDiff(t *testing.T) {
+	xs := parseDatetimes("2003-02-01 00:00:00", "2003-05-01 12:05:55", "2022-01-31 10:00:00", "2022-03-31 10:00:00", "2022-03-31 10:00:00")
+	ys := parseDatetimes("2003-05-01 00:00:00", "2002-05-01 12:05:54", "2022-02-28 10:00:00", "2022-02-28 10:00:00", "2022-04-30 09:59:59")
+	tests := []struct {
+		unit Unit
+		want []int64
+	}{
+		{Month, []int64{3, -12, 0, -1, 0}},
+		{Quarter, []int64{1, -4, 0, 0, 0}},
+		{Year, []int64{0, -1, 0, 0, 0}},
+		{Day, []int64{89, -365, 28, -31, 29}},
+		{Week, []int64{12, -52, 4, -4, 4}},
+		{Minute, []int64{128160, -525600, 40320, -44640, 43199}},
+		{Second, []int64{7689600, -31536001, 2419200, -2678400, 2591999}},
+	}
+	for _, tt := range tests {
+		require.Equal(t, tt.want, DatetimeDiff(tt.unit, xs, ys, make([]int64, len(xs))), "unit %d", tt.unit)
+	}
+}
+
+func TestDatetimeAdd(t *testing.T) {
+	xs := parseDatetimes("2003-01-02 00:00:00", "2022-01-31 10:11:12", "2020-02-29 00:00:00", "9999-12-31 23:59:59", "0001-01-01 00:00:00")
+	tests := []struct {
+		unit Unit
+		ns   []int64
+		want []string // empty if the result is null
+	}{
+		{Minute, []int64{1, 50, -1, 1, -1}, []string{"2003-01-02 00:01:00", "2022-01-31 11:01:12", "2020-02-28 23:59:00", "", ""}},
+		{Week, []int64{1, 1, 1, 0, 0}, []string{"2003-01-09 00:00:00", "2022-02-07 10:11:12", "2020-03-07 00:00:00", "9999-12-31 23:59:59", "0001-01-01 00:00:00"}},
+		{Month, []int64{1, 1, 12, 1, -1}, []string{"2003-02-02 00:00:00", "2022-02-28 10:11:12", "2021-02-28 00:00:00", "", ""}},
+		{Quarter, []int64{-1, 1, 1, -4, 4}, []string{"2002-10-02 00:00:00", "2022-04-30 10:11:12", "2020-05-29 00:00:00", "9998-12-31 23:59:59", "0002-01-01 00:00:00"}},
+		{Year, []int64{1, 1 << 62, 1, 0, 0}, []string{"2004-01-02 00:00:00", "", "2021-02-28 00:00:00", "9999-12-31 23:59:59", "0001-01-01 00:00:00"}},
+	}
+	for _, tt := range tests {
+		rs, isNull := DatetimeAdd(tt.unit, tt.ns, xs, make([]types.Datetime, len(xs)), make([]bool, len(xs)))
+		got := make([]string, len(rs))
+		for i, r := range rs {
+			if !isNull[i] {
+				got[i] = r.String()
+			}
+		}
+		require.Equal(t, tt.want, got, "unit %d", tt.unit)
+	}
+}
+
+func TestDatetimeTrunc(t *testing.T) {
+	xs := parseDatetimes("2022-08-18 13:14:15.123456")
+	tests := []struct {
+		unit Unit
+		want string
+	}{
+		{Year, "2022-01-01 00:00:00"},
+		{Quarter, "2022-07-01 00:00:00"},
+		{Month, "2022-08-01 00:00:00"},
+		{Week, "2022-08-15 00:00:00"},
+		{Day, "2022-08-18 00:00:00"},
+		{Hour, "2022-08-18 13:00:00"},
+		{Minute, "2022-08-18 13:14:00"},
+		{Second, "2022-08-18 13:14:15"},
+	}
+	for _, tt := range tests {
+		rs := DatetimeTrunc(tt.unit, xs, make([]types.Datetime, 1))
+		require.Equal(t, tt.want, rs[0].String(), "unit %d", tt.unit)
+	}
+	rs := DatetimeTrunc(Second, xs, make([]types.Datetime, 1))
+	require.Equal(t, int64(0), rs[0].MicroSec())
+}
@@ -16,6 +16,7 @@ package typecast
 
 import (
 	"strconv"
+	"time"
 	"unsafe"
 
 	"github.com/matrixorigin/matrixone/pkg/container/types"
@@ -144,7 +145,22 @@ var (
 	BytesToFloat64 = bytesToFloat[float64]
 	Float64ToBytes = floatToBytes[float64]
 
-	Decimal64ToDecimal128 = decimal64ToDecimal128Pure
+	Decimal64ToDecimal128  = decimal64ToDecimal128Pure
+	Decimal64ToDecimal64   = decimal64ToDecimal64
+	Decimal128ToDecimal64  = decimal128ToDecimal64
+	Decimal128ToDecimal128 = decimal128ToDecimal128
+
+	Float32ToDecimal64  = floatToDecimal64[float32]
+	Float64ToDecimal64  = floatToDecimal64[float64]
+	Float32ToDecimal128 = floatToDecimal128[float32]
+	Float64ToDecimal128 = floatToDecimal128[float64]
+	Decimal64ToFloat32  = decimal64ToFloat[float32]
+	Decimal64ToFloat64  = decimal64ToFloat[float64]
+	Decimal128ToFloat32 = decimal128ToFloat[float32]
+	Decimal128ToFloat64 = decimal128ToFloat[float64]
+
+	Decimal64ToBytes  = decimal64ToBytes
+	Decimal128ToBytes = decimal128ToBytes
 
 	Int8ToDecimal128   = intToDecimal128[int8]
 	Int16ToDecimal128  = intToDecimal128[int16]
@@ -226,6 +242,113 @@ func decimal64ToDecimal128Pure(xs []types.Decimal64, rs []types.Decimal128) ([]t
 	return rs, nil
 }
 
+// decimal64ToDecimal64 changes the scale of decimals to be rsScale, ErrDecimalOverflow is returned
+// if a value has more than rsWidth digits after that.
+func decimal64ToDecimal64(xs []types.Decimal64, xsScale, rsWidth, rsScale int32, rs []types.Decimal64) ([]types.Decimal64, error) {
+	var err error
+
+	for i, x := range xs {
+		if rs[i], err = types.Decimal64Rescale(x, xsScale, rsScale, types.RoundHalfUp); err != nil {
+			return nil, err
+		}
+		if err = types.CheckDecimal64Width(rs[i], rsWidth); err != nil {
+			return nil, err
+		}
+	}
+	return rs, nil
+}
+
+func decimal128ToDecimal64(xs []types.Decimal128, xsScale, rsWidth, rsScale int32, rs []types.Decimal64) ([]types.Decimal64, error) {
+	for i, x := range xs {
+		r, err := types.Decimal128Rescale(x, xsScale, rsScale, types.RoundHalfUp)
+		if err != nil {
+			return nil, err
+		}
+		if rsWidth <= 0 || rsWidth > types.MaxDecimal64Width {
+			rsWidth = types.MaxDecimal64Width
+		}
+		if err = types.CheckDecimal128Width(r, rsWidth); err != nil {
+			return nil, err
+		}
+		rs[i] = types.Decimal64(r.Lo)
+	}
+	return rs, nil
+}
+
+func decimal128ToDecimal128(xs []types.Decimal128, xsScale, rsWidth, rsScale int32, rs []types.Decimal128) ([]types.Decimal128, error) {
+	var err error
+
+	for i, x := range xs {
+		if rs[i], err = types.Decimal128Rescale(x, xsScale, rsScale, types.RoundHalfUp); err != nil {
+			return nil, err
+		}
+		if err = types.CheckDecimal128Width(rs[i], rsWidth); err != nil {
+			return nil, err
+		}
+	}
+	return rs, nil
+}
+
+func floatToDecimal64[T constraints.Float](xs []T, rsWidth, rsScale int32, rs []types.Decimal64) ([]types.Decimal64, error) {
+	var err error
+
+	for i, x := range xs {
+		if rs[i], err = types.Decimal64FromFloat64(float64(x), rsWidth, rsScale); err != nil {
+			return nil, err
+		}
+	}
+	return rs, nil
+}
+
+func floatToDecimal128[T constraints.Float](xs []T, rsWidth, rsScale int32, rs []types.Decimal128) ([]types.Decimal128, error) {
+	var err error
+
+	for i, x := range xs {
+		if rs[i], err = types.Decimal128FromFloat64(float64(x), rsWidth, rsScale); err != nil {
+			return nil, err
+		}
+	}
+	return rs, nil
+}
+
+func decimal64ToFloat[T constraints.Float](xs []types.Decimal64, xsScale int32, rs []T) ([]T, error) {
+	for i, x := range xs {
+		rs[i] = T(x.ToFloat64(xsScale))
+	}
+	return rs, nil
+}
+
+func decimal128ToFloat[T constraints.Float](xs []types.Decimal128, xsScale int32, rs []T) ([]T, error) {
+	for i, x := range xs {
+		rs[i] = T(x.ToFloat64(xsScale))
+	}
+	return rs, nil
+}
+
+func decimal64ToBytes(xs []types.Decimal64, xsScale int32, rs *types.Bytes) (*types.Bytes, error) {
+	oldLen := uint32(0)
+	for _, x := range xs {
+		rs.Data = append(rs.Data, x.Decimal64ToString(xsScale)...)
+		newLen := uint32(len(rs.Data))
+		rs.Offsets = append(rs.Offsets, oldLen)
+		rs.Lengths = append(rs.Lengths, newLen-oldLen)
+		oldLen = newLen
+	}
+	return rs, nil
+}
+
+func decimal128ToBytes(xs []types.Decimal128, xsScale int32, rs *types.Bytes) (*types.Bytes, error) {
+	oldLen := uint32(0)
+	for _, x := range xs {
+		rs.Data = append(rs.Data, x.Decimal128ToString(xsScale)...)
+		newLen := uint32(len(rs.Data))
+		rs.Offsets = append(rs.Offsets, oldLen)
+		rs.Lengths = append(rs.Lengths, newLen-oldLen)
+		oldLen = newLen
+	}
+	return rs, nil
+}
+
 func intToDecimal128[T constraints.Integer](xs []T, rs []types.Decimal128) ([]types.Decimal128, error) {
 	for i, x := range xs {
 		rs[i] = types.InitDecimal128(int64(x))
@@ -240,6 +363,6 @@ func uintToDecimal128[T constraints.Integer](xs []T, rs []types.Decimal128) ([]t
 	return rs, nil
 }
 
-func timestampToDatetime(xs []types.Timestamp, rs []types.Datetime) ([]types.Datetime, error) {
-	return types.TimestampToDatetime(xs, rs)
+func timestampToDatetime(loc *time.Location, xs []types.Timestamp, rs []types.Datetime) ([]types.Datetime, error) {
+	return types.TimestampToDatetime(loc, xs, rs)
 }
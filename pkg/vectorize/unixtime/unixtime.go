@@ -0,0 +1,83 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unixtime
+
+import (
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+var (
+	TimestampToUnix func([]types.Timestamp, []int64) []int64
+	DatetimeToUnix  func(*time.Location, []types.Datetime, []int64) []int64
+	UnixToDatetime  func(*time.Location, []int64, []types.Datetime, []bool) ([]types.Datetime, []bool)
+	ConvertTz       func([]types.Datetime, *time.Location, *time.Location, []types.Datetime) []types.Datetime
+)
+
+func init() {
+	TimestampToUnix = timestampToUnix
+	DatetimeToUnix = datetimeToUnix
+	UnixToDatetime = unixToDatetime
+	ConvertTz = convertTz
+}
+
+// maxUnixTime is the max seconds of FROM_UNIXTIME, which is 3001-01-19 03:14:07.999999 UTC as mysql
+const maxUnixTime = 32536771199
+
+// timestampToUnix returns the seconds since 1970-01-01 00:00:00 UTC, rounded down
+func timestampToUnix(xs []types.Timestamp, rs []int64) []int64 {
+	for i, x := range xs {
+		rs[i] = floorSeconds(x.UnixMicro())
+	}
+	return rs
+}
+
+// datetimeToUnix returns the seconds since 1970-01-01 00:00:00 UTC of the wall clocks in time zone loc
+func datetimeToUnix(loc *time.Location, xs []types.Datetime, rs []int64) []int64 {
+	for i, x := range xs {
+		rs[i] = floorSeconds(x.ToTimestamp(loc).UnixMicro())
+	}
+	return rs
+}
+
+// unixToDatetime returns the wall clocks in time zone loc of the seconds since 1970-01-01 00:00:00 UTC,
+// isNull[i] is true if xs[i] is negative or too large.
+func unixToDatetime(loc *time.Location, xs []int64, rs []types.Datetime, isNull []bool) ([]types.Datetime, []bool) {
+	for i, x := range xs {
+		if x < 0 || x > maxUnixTime {
+			isNull[i] = true
+			continue
+		}
+		rs[i] = types.TimestampFromUnixMicro(x * 1000000).ToDatetime(loc)
+	}
+	return rs, isNull
+}
+
+// convertTz converts the wall clocks in time zone from to the ones in time zone to
+func convertTz(xs []types.Datetime, from, to *time.Location, rs []types.Datetime) []types.Datetime {
+	for i, x := range xs {
+		rs[i] = x.ToTimestamp(from).ToDatetime(to)
+	}
+	return rs
+}
+
+func floorSeconds(us int64) int64 {
+	s := us / 1000000
+	if us%1000000 < 0 {
+		s--
+	}
+	return s
+}
@@ -0,0 +1,63 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unixtime
+
+import (
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/stretchr/testify/require"
+)
+
+func parseDatetime(s string) types.Datetime {
+	dt, _ := types.ParseDatetime(s)
+	return dt
+}
+
+func TestTimestampToUnix(t *testing.T) {
+	a, err := types.ParseTimestamp(time.UTC, "2015-11-13 10:20:19.5", 6)
+	require.NoError(t, err)
+	b, err := types.ParseTimestamp(time.UTC, "1969-12-31 23:59:59.5", 6)
+	require.NoError(t, err)
+	require.Equal(t, []int64{1447410019, -1}, TimestampToUnix([]types.Timestamp{a, b}, make([]int64, 2)))
+}
+
+func TestDatetimeToUnix(t *testing.T) {
+	shanghai := time.FixedZone("UTC+8", 8*3600)
+	xs := []types.Datetime{parseDatetime("2015-11-13 18:20:19"), parseDatetime("1970-01-01 08:00:00")}
+	require.Equal(t, []int64{1447410019, 0}, DatetimeToUnix(shanghai, xs, make([]int64, 2)))
+	require.Equal(t, []int64{1447438819, 28800}, DatetimeToUnix(time.UTC, xs, make([]int64, 2)))
+}
+
+func TestUnixToDatetime(t *testing.T) {
+	shanghai := time.FixedZone("UTC+8", 8*3600)
+	rs, isNull := UnixToDatetime(shanghai, []int64{1447410019, -1, 0, maxUnixTime + 1}, make([]types.Datetime, 4), make([]bool, 4))
+	require.Equal(t, []bool{false, true, false, true}, isNull)
+	require.Equal(t, "2015-11-13 18:20:19", rs[0].String())
+	require.Equal(t, "1970-01-01 08:00:00", rs[2].String())
+}
+
+func TestConvertTz(t *testing.T) {
+	met := time.FixedZone("MET", 3600)
+	shanghai := time.FixedZone("UTC+8", 8*3600)
+	xs := []types.Datetime{parseDatetime("2004-01-01 12:00:00"), parseDatetime("2004-01-01 00:00:00")}
+	rs := ConvertTz(xs, time.UTC, met, make([]types.Datetime, 2))
+	require.Equal(t, "2004-01-01 13:00:00", rs[0].String())
+	require.Equal(t, "2004-01-01 01:00:00", rs[1].String())
+	rs = ConvertTz(xs, shanghai, time.UTC, make([]types.Datetime, 2))
+	require.Equal(t, "2004-01-01 04:00:00", rs[0].String())
+	require.Equal(t, "2003-12-31 16:00:00", rs[1].String())
+}
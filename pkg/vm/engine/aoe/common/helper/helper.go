@@ -78,11 +78,13 @@ func UnTransfer(tbl aoe.TableInfo) (uint64, uint64, uint64, string, []engine.Tab
 	for _, col := range tbl.Columns {
 		defs = append(defs, &engine.AttributeDef{
 			Attr: engine.Attribute{
-				Alg:     compress.T(col.Alg),
-				Name:    col.Name,
-				Type:    col.Type,
-				Default: col.Default,
-				Primary: col.PrimaryKey,
+				Alg:           compress.T(col.Alg),
+				Name:          col.Name,
+				Type:          col.Type,
+				Default:       col.Default,
+				Primary:       col.PrimaryKey,
+				AutoIncrement: col.AutoIncrement,
+				EnumValues:    col.EnumValues,
 			},
 		})
 	}
@@ -106,6 +108,16 @@ func UnTransfer(tbl aoe.TableInfo) (uint64, uint64, uint64, string, []engine.Tab
 			Name:     idx.Name,
 		})
 	}
+	if len(tbl.Properties) > 0 {
+		properties := make([]engine.Property, len(tbl.Properties))
+		for i, property := range tbl.Properties {
+			properties[i] = engine.Property{
+				Key:   property.Key,
+				Value: property.Value,
+			}
+		}
+		defs = append(defs, &engine.PropertiesDef{Properties: properties})
+	}
 	if tbl.Comment != nil {
 		comment.Comment = string(tbl.Comment)
 		defs = append(defs, comment)
@@ -183,13 +195,15 @@ func ColumnDefs(sid, tid uint64, defs []engine.TableDef) []aoe.ColumnInfo {
 	for _, def := range defs {
 		if v, ok := def.(*engine.AttributeDef); ok {
 			col := aoe.ColumnInfo{
-				SchemaId: sid,
-				TableID:  tid,
-				Id:       id,
-				Name:     v.Attr.Name,
-				Alg:      int(v.Attr.Alg),
-				Type:     v.Attr.Type,
-				Default:  v.Attr.Default,
+				SchemaId:      sid,
+				TableID:       tid,
+				Id:            id,
+				Name:          v.Attr.Name,
+				Alg:           int(v.Attr.Alg),
+				Type:          v.Attr.Type,
+				Default:       v.Attr.Default,
+				AutoIncrement: v.Attr.AutoIncrement,
+				EnumValues:    v.Attr.EnumValues,
 			}
 			for _, primaryKey := range primaryKeys {
 				if col.Name == primaryKey {
@@ -226,20 +240,18 @@ func PartitionDef(defs []engine.TableDef) ([]byte, error) {
 }
 
 func PropertyDef(defs []engine.TableDef) ([]aoe.Property, error) {
+	var properties []aoe.Property
 	for _, def := range defs {
 		if propertiesDef, ok := def.(*engine.PropertiesDef); ok {
-			properties := make([]aoe.Property, len(propertiesDef.Properties))
-			for i, engineProperty := range propertiesDef.Properties {
-				property := aoe.Property{
+			for _, engineProperty := range propertiesDef.Properties {
+				properties = append(properties, aoe.Property{
 					Key:   engineProperty.Key,
 					Value: engineProperty.Value,
-				}
-				properties[i] = property
+				})
 			}
-			return properties, nil
 		}
 	}
-	return nil, nil
+	return properties, nil
 }
 
 func Index(tbl aoe.TableInfo) []*engine.IndexTableDef {
@@ -258,10 +270,11 @@ func Attribute(tbl aoe.TableInfo) []engine.Attribute {
 	attrs := make([]engine.Attribute, len(tbl.Columns))
 	for i, col := range tbl.Columns {
 		attrs[i] = engine.Attribute{
-			Alg:     compress.T(col.Alg),
-			Name:    col.Name,
-			Type:    col.Type,
-			Default: col.Default,
+			Alg:        compress.T(col.Alg),
+			Name:       col.Name,
+			Type:       col.Type,
+			Default:    col.Default,
+			EnumValues: col.EnumValues,
 		}
 	}
 	return attrs
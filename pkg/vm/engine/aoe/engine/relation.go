@@ -266,6 +266,14 @@ func (r *relation) DelTableDef(u uint64, def engine.TableDef, _ engine.Snapshot)
 	return nil
 }
 
+func (_ *relation) Update(_ uint64, _ *batch.Batch, _ engine.Snapshot) error {
+	return engine.ErrNotSupported
+}
+
+func (_ *relation) Delete(_ uint64, _ *vector.Vector, _ string, _ engine.Snapshot) error {
+	return engine.ErrNotSupported
+}
+
 func (r *relation) NewReader(num int, e extend.Extend, _ []byte, _ engine.Snapshot) []engine.Reader {
 	fcs := getFilterContext(e)
 	iodepth := num / int(r.cfg.QueueMaxReaderCount)
@@ -421,7 +429,7 @@ func getVectorValue(vec *vector.Vector) interface{} {
 		return vec.Col.([]types.Date)[0]
 	case types.T_datetime:
 		return vec.Col.([]types.Datetime)[0]
-	case types.T_char, types.T_varchar:
+	case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 		return vec.Col.(*types.Bytes).Data
 	}
 	return nil
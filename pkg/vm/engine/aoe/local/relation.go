@@ -16,6 +16,7 @@ package local
 
 import (
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe"
@@ -106,6 +107,14 @@ func (r *localRoRelation) Write(_ uint64, _ *batch.Batch, _ engine.Snapshot) err
 	panic("not supported")
 }
 
+func (_ *localRoRelation) Update(_ uint64, _ *batch.Batch, _ engine.Snapshot) error {
+	return engine.ErrNotSupported
+}
+
+func (_ *localRoRelation) Delete(_ uint64, _ *vector.Vector, _ string, _ engine.Snapshot) error {
+	return engine.ErrNotSupported
+}
+
 func (r *localRoRelation) AddAttribute(_ uint64, _ engine.TableDef) error {
 	panic("not supported")
 }
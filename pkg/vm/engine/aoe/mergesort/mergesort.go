@@ -60,7 +60,7 @@ func SortBlockColumns(cols []*vector.Vector, pk int) error {
 		dates.Sort(cols[pk], sortedIdx)
 	case types.T_datetime:
 		datetimes.Sort(cols[pk], sortedIdx)
-	case types.T_char, types.T_json, types.T_varchar:
+	case types.T_char, types.T_json, types.T_varchar, types.T_text, types.T_blob:
 		varchar.Sort(cols[pk], sortedIdx)
 	}
 
@@ -93,7 +93,7 @@ func SortBlockColumns(cols []*vector.Vector, pk int) error {
 			dates.Shuffle(cols[i], sortedIdx)
 		case types.T_datetime:
 			datetimes.Shuffle(cols[i], sortedIdx)
-		case types.T_char, types.T_json, types.T_varchar:
+		case types.T_char, types.T_json, types.T_varchar, types.T_text, types.T_blob:
 			varchar.Shuffle(cols[i], sortedIdx)
 		}
 	}
@@ -127,7 +127,7 @@ func MergeSortedColumn(column []*vector.Vector, sortedIdx *[]uint16) error {
 		dates.Merge(column, sortedIdx)
 	case types.T_datetime:
 		datetimes.Merge(column, sortedIdx)
-	case types.T_char, types.T_json, types.T_varchar:
+	case types.T_char, types.T_json, types.T_varchar, types.T_text, types.T_blob:
 		varchar.Merge(column, sortedIdx)
 	}
 	return nil
@@ -159,7 +159,7 @@ func ShuffleColumn(column []*vector.Vector, sortedIdx []uint16) error {
 		dates.Multiplex(column, sortedIdx)
 	case types.T_datetime:
 		datetimes.Multiplex(column, sortedIdx)
-	case types.T_char, types.T_json, types.T_varchar:
+	case types.T_char, types.T_json, types.T_varchar, types.T_text, types.T_blob:
 		varchar.Multiplex(column, sortedIdx)
 	}
 	return nil
@@ -199,7 +199,7 @@ func ShuffleColumn(column []*vector.Vector, sortedIdx []uint16) error {
 //		dates.Merge(col, mergedSrc)
 //	case types.T_datetime:
 //		datetimes.Merge(col, mergedSrc)
-//	case types.T_char, types.T_json, types.T_varchar:
+//	case types.T_char, types.T_json, types.T_varchar, types.T_text, types.T_blob:
 //		varchar.Merge(col, mergedSrc)
 //	}
 //
@@ -236,7 +236,7 @@ func ShuffleColumn(column []*vector.Vector, sortedIdx []uint16) error {
 //			dates.Multiplex(col, mergedSrc)
 //		case types.T_datetime:
 //			datetimes.Multiplex(col, mergedSrc)
-//		case types.T_char, types.T_json, types.T_varchar:
+//		case types.T_char, types.T_json, types.T_varchar, types.T_text, types.T_blob:
 //			varchar.Multiplex(col, mergedSrc)
 //		}
 //	}
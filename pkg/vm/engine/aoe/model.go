@@ -88,6 +88,9 @@ type ColumnInfo struct {
 	Epoch       uint64             `json:"epoch"`
 	PrimaryKey  bool               `json:"primary_key"` // PrimaryKey is the name of the column of the primary key
 	NullAbility bool               `json:"nullability"`
+	EnumValues  []string           `json:"enum_values,omitempty"` // EnumValues are the members of the enum or set column
+	// AutoIncrement is true if the values of the column are generated by the sequence of the table
+	AutoIncrement bool `json:"auto_increment,omitempty"`
 }
 
 type IndexInfo struct {
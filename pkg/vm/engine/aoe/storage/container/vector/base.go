@@ -168,7 +168,7 @@ func MockVector(t types.Type, rows uint64) IVector {
 		if err != nil {
 			panic(err)
 		}
-	case types.T_varchar, types.T_char:
+	case types.T_varchar, types.T_char, types.T_json, types.T_text, types.T_blob:
 		vec = NewStrVector(t, rows)
 		vals := make([][]byte, 0, rows)
 		prefix := "str"
@@ -182,7 +182,7 @@ func (v *StrVector) Append(n int, vals interface{}) error {
 func (v *StrVector) appendWithOffset(offset, n int, vals interface{}) error {
 	var data [][]byte
 	switch v.Type.Oid {
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 		data = vals.([][]byte)[offset : offset+n]
 	default:
 		return ErrVecTypeNotSupport
@@ -351,7 +351,7 @@ func (v *StrVector) CopyToVector() (*ro.Vector, error) {
 	vec := ro.New(v.Type)
 	vec.Data = v.Data.Data
 	switch v.Type.Oid {
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 		col := vec.Col.(*types.Bytes)
 		col.Data = make([]byte, len(v.Data.Data))
 		col.Lengths = make([]uint32, len(v.Data.Lengths))
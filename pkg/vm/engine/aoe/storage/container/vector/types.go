@@ -83,7 +83,7 @@ type StrVector struct {
 
 func NewVector(t types.Type, capacity uint64) IVector {
 	switch t.Oid {
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 		return NewStrVector(t, capacity)
 	default:
 		return NewStdVector(t, capacity)
@@ -136,7 +136,7 @@ func (vec *VectorWrapper) GetValue(idx int) (interface{}, error) {
 		return nil, ErrVecInvalidOffset
 	}
 	switch vec.Typ.Oid {
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 		val := vec.Col.(*types.Bytes)
 		return val.Data[val.Offsets[idx] : val.Offsets[idx]+val.Lengths[idx]], nil
 	case types.T_int8:
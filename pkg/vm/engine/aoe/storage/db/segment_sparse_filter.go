@@ -439,7 +439,7 @@ func compare(val1, val2 interface{}, typ types.Type) int {
 		return int(val1.(float32) - val2.(float32))
 	case types.T_float64:
 		return int(val1.(float64) - val2.(float64))
-	case types.T_char, types.T_json, types.T_varchar:
+	case types.T_char, types.T_json, types.T_varchar, types.T_text, types.T_blob:
 		return bytes.Compare(val1.([]byte), val2.([]byte))
 	case types.T_datetime:
 		return int(val1.(types.Datetime) - val2.(types.Datetime))
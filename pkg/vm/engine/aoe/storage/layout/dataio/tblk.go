@@ -235,7 +235,7 @@ func (f *TransientBlockFile) LoadBatch(meta *metadata.Block) batch.IBatch {
 			panic(err)
 		}
 		switch colDef.Type.Oid {
-		case types.T_char, types.T_varchar, types.T_json:
+		case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 			vec := vector.NewStrVector(colDef.Type, meta.Segment.Table.Schema.BlockMaxRows)
 			err = vec.Unmarshal(obuf)
 			if err != nil {
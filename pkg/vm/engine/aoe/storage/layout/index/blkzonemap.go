@@ -210,7 +210,7 @@ func (i *BlockZoneMapIndex) Unmarshal(data []byte) error {
 		i.MaxV = encoding.DecodeDecimal128(buf[:16])
 		// buf = buf[8:] // unused
 		return nil
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 		lenminv := encoding.DecodeInt16(buf[:2])
 		buf = buf[2:]
 		minBuf := make([]byte, int(lenminv))
@@ -292,7 +292,7 @@ func (i *BlockZoneMapIndex) Marshal() ([]byte, error) {
 		buf.Write(encoding.EncodeDatetime(i.MinV.(types.Datetime)))
 		buf.Write(encoding.EncodeDatetime(i.MaxV.(types.Datetime)))
 		return buf.Bytes(), nil
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 		buf.Write(encoding.EncodeType(i.T))
 		minv := i.MinV.([]byte)
 		maxv := i.MaxV.([]byte)
@@ -349,7 +349,7 @@ func (i *BlockZoneMapIndex) Eq(v interface{}) bool {
 		return v.(int64) >= i.MinV.(int64) && v.(int64) <= i.MaxV.(int64)
 	case types.T_tuple:
 		panic("not supported")
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 		if bytes.Compare(v.([]byte), i.MinV.([]byte)) < 0 {
 			return false
 		}
@@ -411,7 +411,7 @@ func (i *BlockZoneMapIndex) Lt(v interface{}) bool {
 		return v.(int64) > i.MinV.(int64)
 	case types.T_tuple:
 		panic("not supported")
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 		return bytes.Compare(v.([]byte), i.MinV.([]byte)) > 0
 	case types.T_decimal64:
 		return types.CompareDecimal64Decimal64Aligned(v.(types.Decimal64), i.MinV.(types.Decimal64)) > 0
@@ -451,7 +451,7 @@ func (i *BlockZoneMapIndex) Le(v interface{}) bool {
 		return v.(int64) >= i.MinV.(int64)
 	case types.T_tuple:
 		panic("not supported")
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 		return bytes.Compare(v.([]byte), i.MinV.([]byte)) >= 0
 	case types.T_decimal64:
 		return types.CompareDecimal64Decimal64Aligned(v.(types.Decimal64), i.MinV.(types.Decimal64)) >= 0
@@ -491,7 +491,7 @@ func (i *BlockZoneMapIndex) Gt(v interface{}) bool {
 		return v.(int64) < i.MaxV.(int64)
 	case types.T_tuple:
 		panic("not supported")
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 		return bytes.Compare(v.([]byte), i.MaxV.([]byte)) < 0
 	case types.T_decimal64:
 		return types.CompareDecimal64Decimal64Aligned(v.(types.Decimal64), i.MaxV.(types.Decimal64)) < 0
@@ -531,7 +531,7 @@ func (i *BlockZoneMapIndex) Ge(v interface{}) bool {
 		return v.(int64) <= i.MaxV.(int64)
 	case types.T_tuple:
 		panic("not supported")
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 		return bytes.Compare(v.([]byte), i.MaxV.([]byte)) <= 0
 	case types.T_decimal64:
 		return types.CompareDecimal64Decimal64Aligned(v.(types.Decimal64), i.MaxV.(types.Decimal64)) <= 0
@@ -817,7 +817,7 @@ func BuildSegmentZoneMapIndex(data []*vector.Vector, t types.Type, colIdx int16,
 		}
 		zmi := NewSegmentZoneMap(t, globalMin, globalMax, colIdx, partMins, partMaxs)
 		return zmi, nil
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 		var globalMin, globalMax []byte
 		var partMins, partMaxs []interface{}
 		if isSorted {
@@ -1148,7 +1148,7 @@ func BuildBlockZoneMapIndex(data *vector.Vector, t types.Type, colIdx int16, isS
 		}
 		zmi := NewBlockZoneMap(t, min, max, colIdx)
 		return zmi, nil
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 		vec := data.Col.(*types.Bytes)
 		var min, max []byte
 		if isSorted {
@@ -300,7 +300,7 @@ func (i *SegmentZoneMapIndex) Unmarshal(data []byte) error {
 			buf = buf[8:]
 		}
 		return nil
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 		lenminv := encoding.DecodeInt16(buf[:2])
 		buf = buf[2:]
 		minBuf := make([]byte, int(lenminv))
@@ -506,7 +506,7 @@ func (i *SegmentZoneMapIndex) Marshal() ([]byte, error) {
 			buf.Write(encoding.EncodeFloat64(i.BlkMax[j].(float64)))
 		}
 		return buf.Bytes(), nil
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 		buf.Write(encoding.EncodeType(i.T))
 		minv := i.MinV.([]byte)
 		maxv := i.MaxV.([]byte)
@@ -600,7 +600,7 @@ func (i *SegmentZoneMapIndex) Eq(v interface{}) bool {
 		return v.(int64) >= i.MinV.(int64) && v.(int64) <= i.MaxV.(int64)
 	case types.T_tuple:
 		panic("not supported")
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 		if bytes.Compare(v.([]byte), i.MinV.([]byte)) < 0 {
 			return false
 		}
@@ -662,7 +662,7 @@ func (i *SegmentZoneMapIndex) Lt(v interface{}) bool {
 		return v.(int64) > i.MinV.(int64)
 	case types.T_tuple:
 		panic("not supported")
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 		return bytes.Compare(v.([]byte), i.MinV.([]byte)) > 0
 	case types.T_decimal64:
 		return types.CompareDecimal64Decimal64Aligned(v.(types.Decimal64), i.MinV.(types.Decimal64)) > 0
@@ -702,7 +702,7 @@ func (i *SegmentZoneMapIndex) Le(v interface{}) bool {
 		return v.(int64) >= i.MinV.(int64)
 	case types.T_tuple:
 		panic("not supported")
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 		return bytes.Compare(v.([]byte), i.MinV.([]byte)) >= 0
 	case types.T_decimal64:
 		return types.CompareDecimal64Decimal64Aligned(v.(types.Decimal64), i.MinV.(types.Decimal64)) >= 0
@@ -742,7 +742,7 @@ func (i *SegmentZoneMapIndex) Gt(v interface{}) bool {
 		return v.(int64) < i.MaxV.(int64)
 	case types.T_tuple:
 		panic("not supported")
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 		return bytes.Compare(v.([]byte), i.MaxV.([]byte)) < 0
 	case types.T_decimal64:
 		return types.CompareDecimal64Decimal64Aligned(v.(types.Decimal64), i.MaxV.(types.Decimal64)) < 0
@@ -782,7 +782,7 @@ func (i *SegmentZoneMapIndex) Ge(v interface{}) bool {
 		return v.(int64) <= i.MaxV.(int64)
 	case types.T_tuple:
 		panic("not supported")
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 		return bytes.Compare(v.([]byte), i.MaxV.([]byte)) <= 0
 	case types.T_decimal64:
 		return types.CompareDecimal64Decimal64Aligned(v.(types.Decimal64), i.MaxV.(types.Decimal64)) <= 0
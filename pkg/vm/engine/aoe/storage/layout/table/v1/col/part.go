@@ -86,7 +86,7 @@ func NewColumnPart(host iface.IBlock, blk IColumnBlock, capacity uint64) IColumn
 	case base.TRANSIENT_BLK:
 		bufMgr = host.GetMTBufMgr()
 		switch blk.GetColType().Oid {
-		case types.T_char, types.T_varchar, types.T_json:
+		case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 			constructor = vector.StrVectorConstructor
 		default:
 			constructor = vector.StdVectorConstructor
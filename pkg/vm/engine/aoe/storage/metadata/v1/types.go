@@ -340,7 +340,7 @@ func (s *Sequence) TryUpdateIndexId(id uint64) bool {
 
 func EstimateColumnBlockSize(colIdx int, meta *Block) uint64 {
 	switch meta.Segment.Table.Schema.ColDefs[colIdx].Type.Oid {
-	case types.T_json, types.T_char, types.T_varchar:
+	case types.T_json, types.T_char, types.T_varchar, types.T_text, types.T_blob:
 		return meta.Segment.Table.Schema.BlockMaxRows * 2 * 4
 	default:
 		return meta.Segment.Table.Schema.BlockMaxRows * uint64(meta.Segment.Table.Schema.ColDefs[colIdx].Type.Size)
@@ -104,7 +104,7 @@ func makeVal2Str(typ types.Type, value interface{}, isNull bool) string {
 		res := value.(float64)
 		str := strconv.FormatFloat(res, 'f', 10, 64)
 		return str
-	case types.T_char, types.T_varchar:
+	case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 		res := value.(string)
 		return res
 	case types.T_date:
@@ -28,7 +28,7 @@ func (r *reader) Read(cs []uint64, attrs []string) (*batch.Batch, error) {
 	r.segs = r.segs[1:]
 	for i, attr := range attrs {
 		md := r.attrs[attr]
-		if md.Alg == compress.None {
+		if md.Alg.Codec() == compress.None {
 			data, err := r.db.Get(id+"."+attr, r.dds[i])
 			if err != nil {
 				return nil, err
@@ -51,7 +51,7 @@ func (r *reader) Read(cs []uint64, attrs []string) (*batch.Batch, error) {
 				r.dds[i].Grow(n)
 			}
 			buf := r.dds[i].Bytes()[:n]
-			_, err = compress.Decompress(data[:len(data)-4], buf, int(md.Alg))
+			_, err = compress.Decompress(data[:len(data)-4], buf, int(md.Alg.Codec()))
 			if err != nil {
 				return nil, err
 			}
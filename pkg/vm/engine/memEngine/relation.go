@@ -5,11 +5,10 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/encoding"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
-
-	"github.com/pierrec/lz4"
 )
 
 func (r *relation) Close(_ engine.Snapshot) {}
@@ -104,9 +103,9 @@ func (r *relation) Write(_ uint64, bat *batch.Batch, _ engine.Snapshot) error {
 		if err != nil {
 			return err
 		}
-		if r.md.Attrs[i].Alg == compress.Lz4 {
-			data := make([]byte, lz4.CompressBlockBound(len(v)))
-			if data, err = compress.Compress(v, data, compress.Lz4); err != nil {
+		if codec := int(r.md.Attrs[i].Alg.Codec()); codec != compress.None {
+			data := make([]byte, compress.CompressBound(len(v), codec))
+			if data, err = compress.Compress(v, data, codec); err != nil {
 				return err
 			}
 			data = append(data, encoding.EncodeInt32(int32(len(v)))...)
@@ -145,6 +144,14 @@ func (r *relation) DelTableDef(_ uint64, _ engine.TableDef, _ engine.Snapshot) e
 	return nil
 }
 
+func (_ *relation) Update(_ uint64, _ *batch.Batch, _ engine.Snapshot) error {
+	return engine.ErrNotSupported
+}
+
+func (_ *relation) Delete(_ uint64, _ *vector.Vector, _ string, _ engine.Snapshot) error {
+	return engine.ErrNotSupported
+}
+
 func sKey(num int, id string) string {
 	return fmt.Sprintf("%v.%v", id, num)
 }
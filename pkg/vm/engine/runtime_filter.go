@@ -0,0 +1,392 @@
+// Copyright 2021 - 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"bytes"
+	"math"
+	"sync/atomic"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"golang.org/x/exp/constraints"
+)
+
+// RuntimeFilterInLimit is the max number of the keys kept as an in-list by a runtime filter,
+// a bloom filter of the keys is used if there are more.
+var RuntimeFilterInLimit = 1024
+
+// RuntimeFilterReader is a reader which can skip the blocks and drop the rows by runtime filters
+type RuntimeFilterReader interface {
+	Reader
+
+	// SetRuntimeFilters sets the filters of the reader, the rows read must pass all of them
+	SetRuntimeFilters([]*RuntimeFilter)
+}
+
+// RuntimeFilter is a filter on a column of a table built from the keys of the build side
+// of a hash join, the rows whose value is not one of the keys can not be joined. The filter
+// passes every row until the build side is finished, the readers applying it never wait for it.
+type RuntimeFilter struct {
+	// Attr is the name of the column filtered
+	Attr string
+	Typ  types.Type
+	keys atomic.Value // *runtimeKeys
+}
+
+// RuntimeFilterBuilder collects the keys of a runtime filter
+type RuntimeFilterBuilder struct {
+	f        *RuntimeFilter
+	min, max interface{}
+	nan      bool                // some keys are NaN, which are not ordered
+	in       map[uint64]struct{} // nil if there are more than RuntimeFilterInLimit keys
+	hashes   []uint64
+}
+
+type runtimeKeys struct {
+	min, max interface{} // nil if there is no key
+	in       map[uint64]struct{}
+	bloom    []uint64
+}
+
+// NewRuntimeFilter returns the filter of the named column, nil if the type can not be filtered
+func NewRuntimeFilter(attr string, typ types.Type) *RuntimeFilter {
+	switch typ.Oid {
+	case types.T_int8, types.T_int16, types.T_int32, types.T_int64,
+		types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64,
+		types.T_float32, types.T_float64, types.T_date, types.T_datetime,
+		types.T_char, types.T_varchar, types.T_text:
+		return &RuntimeFilter{Attr: attr, Typ: typ}
+	}
+	return nil
+}
+
+// NewBuilder returns a builder of the filter, a filter is built only once,
+// the keys of the other builders are ignored.
+func (f *RuntimeFilter) NewBuilder() *RuntimeFilterBuilder {
+	return &RuntimeFilterBuilder{
+		f:  f,
+		in: make(map[uint64]struct{}),
+	}
+}
+
+// Ready returns true if the keys of the filter are known
+func (f *RuntimeFilter) Ready() bool {
+	return f.keys.Load() != nil
+}
+
+// MayContainRange returns false if none of the keys is in [min, max], the range of the
+// values of a block. It returns true if the filter is not ready.
+func (f *RuntimeFilter) MayContainRange(min, max interface{}) bool {
+	ks, ok := f.keys.Load().(*runtimeKeys)
+	if !ok {
+		return true
+	}
+	if ks.min == nil {
+		return false
+	}
+	return compareKey(max, ks.min) >= 0 && compareKey(min, ks.max) <= 0
+}
+
+// Filter returns the rows of the vector which may be one of the keys, the null rows are
+// never returned. It returns false if the filter is not ready.
+func (f *RuntimeFilter) Filter(vec *vector.Vector) ([]int64, bool) {
+	ks, ok := f.keys.Load().(*runtimeKeys)
+	if !ok {
+		return nil, false
+	}
+	if ks.min == nil {
+		return []int64{}, true
+	}
+	switch vs := vec.Col.(type) {
+	case []int8:
+		return filterKeys(ks, vs, vec.Nsp, intKey[int8]), true
+	case []int16:
+		return filterKeys(ks, vs, vec.Nsp, intKey[int16]), true
+	case []int32:
+		return filterKeys(ks, vs, vec.Nsp, intKey[int32]), true
+	case []int64:
+		return filterKeys(ks, vs, vec.Nsp, intKey[int64]), true
+	case []uint8:
+		return filterKeys(ks, vs, vec.Nsp, uintKey[uint8]), true
+	case []uint16:
+		return filterKeys(ks, vs, vec.Nsp, uintKey[uint16]), true
+	case []uint32:
+		return filterKeys(ks, vs, vec.Nsp, uintKey[uint32]), true
+	case []uint64:
+		return filterKeys(ks, vs, vec.Nsp, uintKey[uint64]), true
+	case []float32:
+		return filterKeys(ks, vs, vec.Nsp, float32Key), true
+	case []float64:
+		return filterKeys(ks, vs, vec.Nsp, float64Key), true
+	case []types.Date:
+		return filterKeys(ks, vs, vec.Nsp, intKey[types.Date]), true
+	case []types.Datetime:
+		return filterKeys(ks, vs, vec.Nsp, intKey[types.Datetime]), true
+	case *types.Bytes:
+		sels := make([]int64, 0, len(vs.Lengths))
+		for i := range vs.Lengths {
+			if nulls.Contains(vec.Nsp, uint64(i)) {
+				continue
+			}
+			v := vs.Get(int64(i))
+			if bytes.Compare(v, ks.min.([]byte)) < 0 || bytes.Compare(v, ks.max.([]byte)) > 0 {
+				continue
+			}
+			if ks.contains(bytesKey(v)) {
+				sels = append(sels, int64(i))
+			}
+		}
+		return sels, true
+	}
+	return nil, false
+}
+
+// Add adds the keys of the vector to the filter, the null rows are ignored
+func (b *RuntimeFilterBuilder) Add(vec *vector.Vector) {
+	switch vs := vec.Col.(type) {
+	case []int8:
+		addKeys(b, vs, vec.Nsp, intKey[int8])
+	case []int16:
+		addKeys(b, vs, vec.Nsp, intKey[int16])
+	case []int32:
+		addKeys(b, vs, vec.Nsp, intKey[int32])
+	case []int64:
+		addKeys(b, vs, vec.Nsp, intKey[int64])
+	case []uint8:
+		addKeys(b, vs, vec.Nsp, uintKey[uint8])
+	case []uint16:
+		addKeys(b, vs, vec.Nsp, uintKey[uint16])
+	case []uint32:
+		addKeys(b, vs, vec.Nsp, uintKey[uint32])
+	case []uint64:
+		addKeys(b, vs, vec.Nsp, uintKey[uint64])
+	case []float32:
+		addKeys(b, vs, vec.Nsp, float32Key)
+	case []float64:
+		addKeys(b, vs, vec.Nsp, float64Key)
+	case []types.Date:
+		addKeys(b, vs, vec.Nsp, intKey[types.Date])
+	case []types.Datetime:
+		addKeys(b, vs, vec.Nsp, intKey[types.Datetime])
+	case *types.Bytes:
+		for i := range vs.Lengths {
+			if nulls.Contains(vec.Nsp, uint64(i)) {
+				continue
+			}
+			v := vs.Get(int64(i))
+			if b.min == nil || bytes.Compare(v, b.min.([]byte)) < 0 {
+				b.min = append([]byte{}, v...)
+			}
+			if b.max == nil || bytes.Compare(v, b.max.([]byte)) > 0 {
+				b.max = append([]byte{}, v...)
+			}
+			b.add(bytesKey(v))
+		}
+	}
+}
+
+// Build publishes the keys added to the filter
+func (b *RuntimeFilterBuilder) Build() {
+	ks := &runtimeKeys{
+		min: b.min,
+		max: b.max,
+		in:  b.in,
+	}
+	if b.nan {
+		// a NaN is in no range, the rows can not be filtered by the range of the keys
+		switch b.f.Typ.Oid {
+		case types.T_float32:
+			ks.min, ks.max = float32(math.Inf(-1)), float32(math.Inf(1))
+		default:
+			ks.min, ks.max = math.Inf(-1), math.Inf(1)
+		}
+	}
+	if ks.in == nil {
+		ks.bloom = make([]uint64, bloomWords(len(b.hashes)))
+		for _, h := range b.hashes {
+			ks.addBloom(h)
+		}
+	}
+	b.f.keys.CompareAndSwap(nil, ks)
+}
+
+func (b *RuntimeFilterBuilder) add(k uint64) {
+	b.hashes = append(b.hashes, k)
+	if b.in == nil {
+		return
+	}
+	b.in[k] = struct{}{}
+	if len(b.in) > RuntimeFilterInLimit {
+		b.in = nil
+	}
+}
+
+func (ks *runtimeKeys) contains(k uint64) bool {
+	if ks.in != nil {
+		_, ok := ks.in[k]
+		return ok
+	}
+	m := uint64(len(ks.bloom)*64 - 1)
+	h1, h2 := bloomHashes(k)
+	for i := uint64(0); i < bloomProbes; i++ {
+		if p := (h1 + i*h2) & m; ks.bloom[p/64]&(1<<(p%64)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func (ks *runtimeKeys) addBloom(k uint64) {
+	m := uint64(len(ks.bloom)*64 - 1)
+	h1, h2 := bloomHashes(k)
+	for i := uint64(0); i < bloomProbes; i++ {
+		p := (h1 + i*h2) & m
+		ks.bloom[p/64] |= 1 << (p % 64)
+	}
+}
+
+const bloomProbes = 3
+
+// bloomWords returns the number of the words of a bloom filter of n keys, about 10 bits per key
+func bloomWords(n int) int {
+	w := 1
+	for w*64 < n*10 {
+		w <<= 1
+	}
+	return w
+}
+
+func bloomHashes(k uint64) (uint64, uint64) {
+	// the finalizer of splitmix64
+	k ^= k >> 30
+	k *= 0xbf58476d1ce4e5b9
+	k ^= k >> 27
+	k *= 0x94d049bb133111eb
+	k ^= k >> 31
+	return k, k>>32 | 1
+}
+
+func addKeys[T constraints.Integer | constraints.Float](b *RuntimeFilterBuilder, vs []T, nsp *nulls.Nulls, key func(T) uint64) {
+	for i, v := range vs {
+		if nulls.Contains(nsp, uint64(i)) {
+			continue
+		}
+		if v != v { // NaN
+			b.nan = true
+			b.add(key(v))
+			continue
+		}
+		if b.min == nil || v < b.min.(T) {
+			b.min = v
+		}
+		if b.max == nil || v > b.max.(T) {
+			b.max = v
+		}
+		b.add(key(v))
+	}
+}
+
+func filterKeys[T constraints.Integer | constraints.Float](ks *runtimeKeys, vs []T, nsp *nulls.Nulls, key func(T) uint64) []int64 {
+	min, max := ks.min.(T), ks.max.(T)
+	sels := make([]int64, 0, len(vs))
+	for i, v := range vs {
+		if v < min || v > max || nulls.Contains(nsp, uint64(i)) {
+			continue
+		}
+		if ks.contains(key(v)) {
+			sels = append(sels, int64(i))
+		}
+	}
+	return sels
+}
+
+func intKey[T constraints.Signed](v T) uint64 {
+	return uint64(int64(v))
+}
+
+func uintKey[T constraints.Unsigned](v T) uint64 {
+	return uint64(v)
+}
+
+// float64Key returns the key of a float, the zeros of both signs are equal and have the same key,
+// so have all the NaNs whatever their payloads, the keys of other values are their bits.
+func float64Key(v float64) uint64 {
+	switch {
+	case v == 0:
+		return 0
+	case math.IsNaN(v):
+		return math.Float64bits(math.NaN())
+	}
+	return math.Float64bits(v)
+}
+
+func float32Key(v float32) uint64 {
+	return float64Key(float64(v))
+}
+
+// bytesKey returns the fnv-1a hash of the bytes
+func bytesKey(v []byte) uint64 {
+	h := uint64(14695981039346656037)
+	for _, c := range v {
+		h ^= uint64(c)
+		h *= 1099511628211
+	}
+	return h
+}
+
+// compareKey compares two values of the same type
+func compareKey(a, b interface{}) int {
+	switch x := a.(type) {
+	case int8:
+		return compareOrdered(x, b.(int8))
+	case int16:
+		return compareOrdered(x, b.(int16))
+	case int32:
+		return compareOrdered(x, b.(int32))
+	case int64:
+		return compareOrdered(x, b.(int64))
+	case uint8:
+		return compareOrdered(x, b.(uint8))
+	case uint16:
+		return compareOrdered(x, b.(uint16))
+	case uint32:
+		return compareOrdered(x, b.(uint32))
+	case uint64:
+		return compareOrdered(x, b.(uint64))
+	case float32:
+		return compareOrdered(x, b.(float32))
+	case float64:
+		return compareOrdered(x, b.(float64))
+	case types.Date:
+		return compareOrdered(x, b.(types.Date))
+	case types.Datetime:
+		return compareOrdered(x, b.(types.Datetime))
+	case []byte:
+		return bytes.Compare(x, b.([]byte))
+	}
+	return 0
+}
+
+func compareOrdered[T constraints.Ordered](a, b T) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,77 @@
+// Copyright 2021 - 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"math"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/stretchr/testify/require"
+)
+
+func newFloatFilter[T float32 | float64](oid types.T, keys []T) *RuntimeFilter {
+	f := NewRuntimeFilter("a", types.Type{Oid: oid})
+	vec := vector.New(f.Typ)
+	vec.Col = keys
+	b := f.NewBuilder()
+	b.Add(vec)
+	b.Build()
+	return f
+}
+
+func filterFloats[T float32 | float64](f *RuntimeFilter, vs []T) []int64 {
+	vec := vector.New(f.Typ)
+	vec.Col = vs
+	sels, ok := f.Filter(vec)
+	if !ok {
+		panic("filter not ready")
+	}
+	return sels
+}
+
+func TestRuntimeFilterFloatZero(t *testing.T) {
+	negZero := math.Copysign(0, -1)
+	// the zeros of both signs are equal
+	f := newFloatFilter(types.T_float64, []float64{negZero, 2})
+	require.Equal(t, []int64{0, 1}, filterFloats(f, []float64{0, negZero, 1}))
+	f = newFloatFilter(types.T_float64, []float64{0})
+	require.Equal(t, []int64{0, 1}, filterFloats(f, []float64{negZero, 0}))
+	require.True(t, f.MayContainRange(negZero, negZero))
+
+	f = newFloatFilter(types.T_float32, []float32{float32(negZero)})
+	require.Equal(t, []int64{0, 1}, filterFloats(f, []float32{0, float32(negZero)}))
+}
+
+func TestRuntimeFilterFloatNaN(t *testing.T) {
+	// a NaN of another payload
+	otherNaN := math.Float64frombits(math.Float64bits(math.NaN()) | 1)
+	require.True(t, math.IsNaN(otherNaN))
+
+	// the NaNs are equal whatever their payloads, the rows are not filtered by the range
+	f := newFloatFilter(types.T_float64, []float64{math.NaN(), 1, 2})
+	require.Equal(t, []int64{0, 1, 3}, filterFloats(f, []float64{otherNaN, 1, 3, 2}))
+	require.True(t, f.MayContainRange(float64(10), float64(20)))
+
+	// a filter of NaN keys only is not empty
+	f = newFloatFilter(types.T_float32, []float32{float32(math.NaN())})
+	require.Equal(t, []int64{1}, filterFloats(f, []float32{1, float32(otherNaN)}))
+
+	// no NaN is a key
+	f = newFloatFilter(types.T_float64, []float64{1, 2})
+	require.Equal(t, []int64{1}, filterFloats(f, []float64{math.NaN(), 2}))
+	require.False(t, f.MayContainRange(float64(10), float64(20)))
+}
@@ -55,6 +55,9 @@ type Catalog struct {
 	link      *common.Link
 
 	nodesMu sync.RWMutex
+
+	seqMu     sync.Mutex
+	sequences map[uint64]*sequence
 }
 
 func MockCatalog(dir, name string, cfg *store.StoreCfg, scheduler tasks.TaskScheduler) *Catalog {
@@ -73,6 +76,7 @@ func MockCatalog(dir, name string, cfg *store.StoreCfg, scheduler tasks.TaskSche
 		link:        new(common.Link),
 		checkpoints: make([]*Checkpoint, 0),
 		scheduler:   scheduler,
+		sequences:   make(map[uint64]*sequence),
 	}
 	catalog.InitSystemDB()
 	return catalog
@@ -92,6 +96,7 @@ func OpenCatalog(dir, name string, cfg *store.StoreCfg, scheduler tasks.TaskSche
 		link:        new(common.Link),
 		checkpoints: make([]*Checkpoint, 0),
 		scheduler:   scheduler,
+		sequences:   make(map[uint64]*sequence),
 	}
 	catalog.InitSystemDB()
 	replayer := NewReplayer(dataFactory, catalog)
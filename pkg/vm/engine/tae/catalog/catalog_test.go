@@ -16,11 +16,14 @@ package catalog
 
 import (
 	"bytes"
+	"encoding/binary"
 	"fmt"
 	"sync"
 	"testing"
 	"time"
 
+	"github.com/matrixorigin/matrixone/pkg/compress"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/testutils"
@@ -435,6 +438,56 @@ func TestCommand(t *testing.T) {
 	assert.Equal(t, tb.db.ID, eCmd.DBID)
 }
 
+func TestSchemaVersion(t *testing.T) {
+	schema := MockSchema(2)
+	schema.ColDefs[0].Alg = compress.NewT(compress.Zstd, compress.Delta)
+	schema.ColDefs[1].EnumValues = []string{"x", "y"}
+	schema.Properties = []Property{{Key: "k", Value: "v"}}
+	buf, err := schema.Marshal()
+	assert.Nil(t, err)
+	replayed := NewEmptySchema("")
+	_, err = replayed.ReadFrom(bytes.NewBuffer(buf))
+	assert.Nil(t, err)
+	assert.Equal(t, schema.BlockMaxRows, replayed.BlockMaxRows)
+	assert.Equal(t, schema.ColDefs, replayed.ColDefs)
+	assert.Equal(t, schema.Properties, replayed.Properties)
+
+	// the layout before the versions ends the columns after AutoIncrement
+	var w bytes.Buffer
+	_ = binary.Write(&w, binary.BigEndian, schema.BlockMaxRows)
+	_ = binary.Write(&w, binary.BigEndian, schema.PrimaryKey)
+	_ = binary.Write(&w, binary.BigEndian, schema.SegmentMaxBlocks)
+	_, _ = common.WriteString(schema.Name, &w)
+	_, _ = common.WriteString(schema.Comment, &w)
+	_ = binary.Write(&w, binary.BigEndian, uint16(len(schema.ColDefs)))
+	for _, colDef := range schema.ColDefs {
+		w.Write(encoding.EncodeType(colDef.Type))
+		_, _ = common.WriteString(colDef.Name, &w)
+		_, _ = common.WriteString(colDef.Comment, &w)
+		_ = binary.Write(&w, binary.BigEndian, colDef.NullAbility)
+		_ = binary.Write(&w, binary.BigEndian, colDef.Hidden)
+		_ = binary.Write(&w, binary.BigEndian, colDef.AutoIncrement)
+	}
+	replayed = NewEmptySchema("")
+	_, err = replayed.ReadFrom(&w)
+	assert.Nil(t, err)
+	assert.Equal(t, schema.BlockMaxRows, replayed.BlockMaxRows)
+	assert.Equal(t, schema.Name, replayed.Name)
+	assert.Equal(t, 2, len(replayed.ColDefs))
+	for i, colDef := range replayed.ColDefs {
+		assert.Equal(t, schema.ColDefs[i].Name, colDef.Name)
+		assert.Equal(t, schema.ColDefs[i].Type, colDef.Type)
+		assert.Equal(t, compress.T(compress.Lz4), colDef.Alg)
+		assert.Nil(t, colDef.EnumValues)
+	}
+	assert.Nil(t, replayed.Properties)
+
+	// the unknown versions are rejected
+	buf[5] = byte(SchemaVersion + 1)
+	_, err = NewEmptySchema("").ReadFrom(bytes.NewBuffer(buf))
+	assert.ErrorIs(t, err, ErrValidation)
+}
+
 // UT Steps
 // 1. Start Txn1, create a database "db", table "tb" and segment "seg1", then commit Txn1
 // 1. Start Txn2, create a segment "seg2". Txn2 scan "tb" and "seg1, seg2" found
@@ -31,6 +31,7 @@ var EmptyCheckpoint = new(Checkpoint)
 
 const (
 	ETCatalogCheckpoint = entry.ETCustomizedStart + 100 + iota
+	ETAutoIncrement
 )
 
 type CheckpointItem interface {
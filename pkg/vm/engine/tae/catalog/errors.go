@@ -24,5 +24,7 @@ var (
 
 	ErrValidation = errors.New("tae catalog: validataion")
 
+	ErrAutoIncrementOverflow = errors.New("tae catalog: auto increment overflow")
+
 	ErrStopCurrRecur = errors.New("tae catalog: stop current recursion")
 )
@@ -27,6 +27,12 @@ func NewReplayer(dataFactory DataFactory, catalog *Catalog) *Replayer {
 }
 
 func (replayer *Replayer) ReplayerHandle(group uint32, commitId uint64, payload []byte, typ uint16, info any) {
+	if typ == ETAutoIncrement {
+		if err := replayer.catalog.onReplaySequence(payload); err != nil {
+			panic(err)
+		}
+		return
+	}
 	if typ != ETCatalogCheckpoint {
 		return
 	}
@@ -20,9 +20,12 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"math"
 	"math/rand"
 	"time"
 
+	"github.com/matrixorigin/matrixone/pkg/compress"
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/encoding"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
@@ -53,6 +56,20 @@ func NewIndexInfo(name string, typ IndexT, colIdx ...int) *IndexInfo {
 	return index
 }
 
+// The versions of the binary layout of Schema. The layout before the versions
+// starts with BlockMaxRows, so a versioned layout starts with schemaVersionMarker,
+// which is never a valid BlockMaxRows, and the version follows it.
+const (
+	// SchemaVersion0 is the layout without the compressions, the json paths and
+	// the enum values of the columns and the properties of the table
+	SchemaVersion0 uint16 = iota
+	SchemaVersion1
+
+	SchemaVersion = SchemaVersion1
+)
+
+const schemaVersionMarker = math.MaxUint32
+
 type ColDef struct {
 	Name          string
 	Idx           int
@@ -61,6 +78,13 @@ type ColDef struct {
 	NullAbility   int8
 	AutoIncrement int8
 	Comment       string
+	// the codec and the encoding of the column
+	Alg compress.T
+	// JsonPaths are the paths of a JSON column whose values are kept in the
+	// static filters of the block, so that a point query on them can skip blocks
+	JsonPaths []string
+	// EnumValues are the members of an ENUM or SET column
+	EnumValues []string
 }
 
 type Schema struct {
@@ -71,6 +95,13 @@ type Schema struct {
 	PrimaryKey       int32          `json:"primarykey"`
 	SegmentMaxBlocks uint16         `json:"segblocks"`
 	Comment          string         `json:"comment"`
+	Properties       []Property     `json:"properties"`
+}
+
+// Property is a key value option of the table
+type Property struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
 }
 
 func NewEmptySchema(name string) *Schema {
@@ -82,9 +113,23 @@ func NewEmptySchema(name string) *Schema {
 }
 
 func (s *Schema) ReadFrom(r io.Reader) (n int64, err error) {
+	version := SchemaVersion0
 	if err = binary.Read(r, binary.BigEndian, &s.BlockMaxRows); err != nil {
 		return
 	}
+	if s.BlockMaxRows == schemaVersionMarker {
+		if err = binary.Read(r, binary.BigEndian, &version); err != nil {
+			return
+		}
+		if version > SchemaVersion {
+			err = fmt.Errorf("%w: unknown schema version %d", ErrValidation, version)
+			return
+		}
+		if err = binary.Read(r, binary.BigEndian, &s.BlockMaxRows); err != nil {
+			return
+		}
+		n += 2 + 4
+	}
 	if err = binary.Read(r, binary.BigEndian, &s.PrimaryKey); err != nil {
 		return
 	}
@@ -95,7 +140,7 @@ func (s *Schema) ReadFrom(r io.Reader) (n int64, err error) {
 	if s.Name, sn, err = common.ReadString(r); err != nil {
 		return
 	}
-	n = sn + 4 + 4 + 4 + 2
+	n += sn + 4 + 4 + 4 + 2
 	if s.Comment, sn, err = common.ReadString(r); err != nil {
 		return
 	}
@@ -134,12 +179,72 @@ func (s *Schema) ReadFrom(r io.Reader) (n int64, err error) {
 		n += 1
 		s.ColDefs = append(s.ColDefs, colDef)
 		colDef.Idx = int(i)
+		if version < SchemaVersion1 {
+			colDef.Alg = compress.Lz4
+			continue
+		}
+		if err = binary.Read(r, binary.BigEndian, &colDef.Alg); err != nil {
+			return
+		}
+		n += 1
+		pathCnt := uint16(0)
+		if err = binary.Read(r, binary.BigEndian, &pathCnt); err != nil {
+			return
+		}
+		n += 2
+		for j := uint16(0); j < pathCnt; j++ {
+			var path string
+			if path, sn, err = common.ReadString(r); err != nil {
+				return
+			}
+			n += sn
+			colDef.JsonPaths = append(colDef.JsonPaths, path)
+		}
+		valueCnt := uint16(0)
+		if err = binary.Read(r, binary.BigEndian, &valueCnt); err != nil {
+			return
+		}
+		n += 2
+		for j := uint16(0); j < valueCnt; j++ {
+			var value string
+			if value, sn, err = common.ReadString(r); err != nil {
+				return
+			}
+			n += sn
+			colDef.EnumValues = append(colDef.EnumValues, value)
+		}
+	}
+	if version < SchemaVersion1 {
+		return
+	}
+	propertyCnt := uint16(0)
+	if err = binary.Read(r, binary.BigEndian, &propertyCnt); err != nil {
+		return
+	}
+	n += 2
+	for i := uint16(0); i < propertyCnt; i++ {
+		var property Property
+		if property.Key, sn, err = common.ReadString(r); err != nil {
+			return
+		}
+		n += sn
+		if property.Value, sn, err = common.ReadString(r); err != nil {
+			return
+		}
+		n += sn
+		s.Properties = append(s.Properties, property)
 	}
 	return
 }
 
 func (s *Schema) Marshal() (buf []byte, err error) {
 	var w bytes.Buffer
+	if err = binary.Write(&w, binary.BigEndian, uint32(schemaVersionMarker)); err != nil {
+		return
+	}
+	if err = binary.Write(&w, binary.BigEndian, SchemaVersion); err != nil {
+		return
+	}
 	if err = binary.Write(&w, binary.BigEndian, s.BlockMaxRows); err != nil {
 		return
 	}
@@ -177,6 +282,36 @@ func (s *Schema) Marshal() (buf []byte, err error) {
 		if err = binary.Write(&w, binary.BigEndian, colDef.AutoIncrement); err != nil {
 			return
 		}
+		if err = binary.Write(&w, binary.BigEndian, colDef.Alg); err != nil {
+			return
+		}
+		if err = binary.Write(&w, binary.BigEndian, uint16(len(colDef.JsonPaths))); err != nil {
+			return
+		}
+		for _, path := range colDef.JsonPaths {
+			if _, err = common.WriteString(path, &w); err != nil {
+				return
+			}
+		}
+		if err = binary.Write(&w, binary.BigEndian, uint16(len(colDef.EnumValues))); err != nil {
+			return
+		}
+		for _, value := range colDef.EnumValues {
+			if _, err = common.WriteString(value, &w); err != nil {
+				return
+			}
+		}
+	}
+	if err = binary.Write(&w, binary.BigEndian, uint16(len(s.Properties))); err != nil {
+		return
+	}
+	for _, property := range s.Properties {
+		if _, err = common.WriteString(property.Key, &w); err != nil {
+			return
+		}
+		if _, err = common.WriteString(property.Value, &w); err != nil {
+			return
+		}
 	}
 	buf = w.Bytes()
 	return
@@ -187,11 +322,43 @@ func (s *Schema) AppendCol(name string, typ types.Type) {
 		Name: name,
 		Type: typ,
 		Idx:  len(s.ColDefs),
+		Alg:  compress.Lz4,
 	}
 	s.ColDefs = append(s.ColDefs, colDef)
 	s.NameIndex[name] = colDef.Idx
 }
 
+// SetJsonPaths sets the paths of the JSON column to build static filters on
+func (s *Schema) SetJsonPaths(name string, paths ...string) error {
+	idx, ok := s.NameIndex[name]
+	if !ok {
+		return ErrNotFound
+	}
+	colDef := s.ColDefs[idx]
+	if colDef.Type.Oid != types.T_json {
+		return fmt.Errorf("%w: column %s is not a json column", ErrValidation, name)
+	}
+	for _, path := range paths {
+		if _, err := bytejson.ParseJsonPath(path); err != nil {
+			return fmt.Errorf("%w: %v", ErrValidation, err)
+		}
+	}
+	colDef.JsonPaths = paths
+	return nil
+}
+
+// IndexCnt returns the count of the index files of every indexed column
+func (s *Schema) IndexCnt() map[int]int {
+	indexCnt := make(map[int]int)
+	indexCnt[int(s.PrimaryKey)] = 2
+	for _, colDef := range s.ColDefs {
+		if len(colDef.JsonPaths) > 0 {
+			indexCnt[colDef.Idx] = len(colDef.JsonPaths)
+		}
+	}
+	return indexCnt
+}
+
 func (s *Schema) String() string {
 	buf, _ := json.Marshal(s)
 	return string(buf)
@@ -225,6 +392,14 @@ func (s *Schema) Types() []types.Type {
 	return ts
 }
 
+func (s *Schema) Algs() []compress.T {
+	algs := make([]compress.T, len(s.ColDefs))
+	for i, colDef := range s.ColDefs {
+		algs[i] = colDef.Alg
+	}
+	return algs
+}
+
 func (s *Schema) Valid() bool {
 	if s == nil {
 		return false
@@ -244,6 +419,10 @@ func (s *Schema) Valid() bool {
 		}
 		names[colDef.Name] = true
 	}
+	// json values have no order, they can't be indexed by the zonemap of the primary key
+	if int(s.PrimaryKey) < len(s.ColDefs) && s.ColDefs[s.PrimaryKey].Type.Oid == types.T_json {
+		return false
+	}
 	return true
 }
 
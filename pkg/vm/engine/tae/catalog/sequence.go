@@ -0,0 +1,108 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package catalog
+
+import (
+	"encoding/binary"
+	"sync"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/logstore/entry"
+)
+
+// AutoIncrementStep is the number of the values of a sequence reserved in the log at once,
+// the values reserved but not allocated before a restart are skipped.
+var AutoIncrementStep uint64 = 1000
+
+// sequence generates the values of the auto increment column of a table
+type sequence struct {
+	sync.Mutex
+	// next is the next value to allocate
+	next uint64
+	// high is the end of the values reserved in the log
+	high uint64
+}
+
+// AllocAutoIncrement reserves n values of the auto increment column of the table and returns
+// the first one, which is not less than min. The values are never allocated again, even if
+// the txn allocating them is rolled back or the catalog is reopened.
+func (catalog *Catalog) AllocAutoIncrement(tableID uint64, n, min uint64) (first uint64, err error) {
+	seq := catalog.getSequence(tableID)
+	seq.Lock()
+	defer seq.Unlock()
+	if seq.next < min {
+		seq.next = min
+	}
+	first = seq.next
+	end := first + n
+	if end < first {
+		return 0, ErrAutoIncrementOverflow
+	}
+	if end > seq.high {
+		high := end + AutoIncrementStep
+		if high < end {
+			high = end
+		}
+		if err = catalog.logSequence(tableID, high); err != nil {
+			return 0, err
+		}
+		seq.high = high
+	}
+	seq.next = end
+	return first, nil
+}
+
+func (catalog *Catalog) getSequence(tableID uint64) *sequence {
+	catalog.seqMu.Lock()
+	defer catalog.seqMu.Unlock()
+	seq, ok := catalog.sequences[tableID]
+	if !ok {
+		seq = &sequence{next: 1}
+		catalog.sequences[tableID] = seq
+	}
+	return seq
+}
+
+// logSequence saves the reserved end of the sequence of the table in the catalog store
+func (catalog *Catalog) logSequence(tableID, high uint64) (err error) {
+	buf := make([]byte, 16)
+	binary.BigEndian.PutUint64(buf, tableID)
+	binary.BigEndian.PutUint64(buf[8:], high)
+	logEntry := entry.GetBase()
+	defer logEntry.Free()
+	logEntry.SetType(ETAutoIncrement)
+	if err = logEntry.Unmarshal(buf); err != nil {
+		return
+	}
+	if _, err = catalog.store.AppendEntry(0, logEntry); err != nil {
+		return
+	}
+	return logEntry.WaitDone()
+}
+
+// onReplaySequence restores a sequence from its log entry, the allocation goes on
+// from the end of the values reserved.
+func (catalog *Catalog) onReplaySequence(payload []byte) error {
+	if len(payload) < 16 {
+		return ErrValidation
+	}
+	tableID := binary.BigEndian.Uint64(payload)
+	high := binary.BigEndian.Uint64(payload[8:])
+	seq := catalog.getSequence(tableID)
+	if high > seq.high {
+		seq.high = high
+		seq.next = high
+	}
+	return nil
+}
@@ -61,7 +61,7 @@ func CompareGeneric(a, b any, t types.Type) int {
 		} else {
 			return 0
 		}
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		if a.(uint16) > b.(uint16) {
 			return 1
 		} else if a.(uint16) < b.(uint16) {
@@ -77,7 +77,7 @@ func CompareGeneric(a, b any, t types.Type) int {
 		} else {
 			return 0
 		}
-	case types.T_uint64:
+	case types.T_uint64, types.T_set:
 		if a.(uint64) > b.(uint64) {
 			return 1
 		} else if a.(uint64) < b.(uint64) {
@@ -117,7 +117,9 @@ func CompareGeneric(a, b any, t types.Type) int {
 		} else {
 			return 0
 		}
-	case types.T_char, types.T_varchar:
+	case types.T_uuid:
+		return types.CompareUuid(a.(types.Uuid), b.(types.Uuid))
+	case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 		res := bytes.Compare(a.([]byte), b.([]byte))
 		if res > 0 {
 			return 1
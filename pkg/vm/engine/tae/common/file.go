@@ -29,6 +29,8 @@ type FileInfo interface {
 	Name() string
 	Size() int64
 	OriginSize() int64
+	// CompressAlgo returns the compress.T of the file, which
+	// packs the codec and the encoding of the data
 	CompressAlgo() int
 }
 
@@ -49,6 +51,14 @@ type IRWFile interface {
 	IVFile
 }
 
+// ILargeValueFile is implemented by the column files which keep
+// the large values of TEXT and BLOB columns out of line.
+type ILargeValueFile interface {
+	// ReadLargeValues returns the out of line values, which is
+	// empty if there is no value moved out of the column data
+	ReadLargeValues() ([]byte, error)
+}
+
 func MockRWFile() *rwFile {
 	return &rwFile{
 		stat: baseFileInfo{},
@@ -47,13 +47,13 @@ func AppendValue(vec *gvec.Vector, v any) {
 	case types.T_uint8:
 		vvals := vec.Col.([]uint8)
 		vec.Col = append(vvals, v.(uint8))
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		vvals := vec.Col.([]uint16)
 		vec.Col = append(vvals, v.(uint16))
 	case types.T_uint32:
 		vvals := vec.Col.([]uint32)
 		vec.Col = append(vvals, v.(uint32))
-	case types.T_uint64:
+	case types.T_uint64, types.T_set:
 		vvals := vec.Col.([]uint64)
 		vec.Col = append(vvals, v.(uint64))
 	case types.T_decimal64:
@@ -71,7 +71,10 @@ func AppendValue(vec *gvec.Vector, v any) {
 	case types.T_datetime:
 		vvals := vec.Col.([]types.Datetime)
 		vec.Col = append(vvals, v.(types.Datetime))
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_uuid:
+		vvals := vec.Col.([]types.Uuid)
+		vec.Col = append(vvals, v.(types.Uuid))
+	case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 		vvals := vec.Col.(*types.Bytes)
 		offset := len(vvals.Data)
 		length := len(v.([]byte))
@@ -101,13 +104,13 @@ func GetValue(col *gvec.Vector, row uint32) any {
 	case types.T_uint8:
 		data := vals.([]uint8)
 		return data[row]
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		data := vals.([]uint16)
 		return data[row]
 	case types.T_uint32:
 		data := vals.([]uint32)
 		return data[row]
-	case types.T_uint64:
+	case types.T_uint64, types.T_set:
 		data := vals.([]uint64)
 		return data[row]
 	case types.T_decimal64:
@@ -125,7 +128,10 @@ func GetValue(col *gvec.Vector, row uint32) any {
 	case types.T_datetime:
 		data := vals.([]types.Datetime)
 		return data[row]
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_uuid:
+		data := vals.([]types.Uuid)
+		return data[row]
+	case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 		data := vals.(*types.Bytes)
 		s := data.Offsets[row]
 		e := data.Lengths[row]
@@ -159,7 +165,7 @@ func SetFixSizeTypeValue(col *gvec.Vector, row uint32, val any) error {
 		data := vals.([]uint8)
 		data[row] = val.(uint8)
 		col.Col = data
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		data := vals.([]uint16)
 		data[row] = val.(uint16)
 		col.Col = data
@@ -167,7 +173,7 @@ func SetFixSizeTypeValue(col *gvec.Vector, row uint32, val any) error {
 		data := vals.([]uint32)
 		data[row] = val.(uint32)
 		col.Col = data
-	case types.T_uint64:
+	case types.T_uint64, types.T_set:
 		data := vals.([]uint64)
 		data[row] = val.(uint64)
 		col.Col = data
@@ -191,7 +197,11 @@ func SetFixSizeTypeValue(col *gvec.Vector, row uint32, val any) error {
 		data := vals.([]types.Datetime)
 		data[row] = val.(types.Datetime)
 		col.Col = data
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_uuid:
+		data := vals.([]types.Uuid)
+		data[row] = val.(types.Uuid)
+		col.Col = data
+	case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 		// data := vals.(*types.Bytes)
 		// s := data.Offsets[row]
 		// e := data.Lengths[row]
@@ -225,7 +235,7 @@ func DeleteFixSizeTypeValue(col *gvec.Vector, row uint32) error {
 		data := vals.([]uint8)
 		data = append(data[:row], data[row+1:]...)
 		col.Col = data
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		data := vals.([]uint16)
 		data = append(data[:row], data[row+1:]...)
 		col.Col = data
@@ -233,7 +243,7 @@ func DeleteFixSizeTypeValue(col *gvec.Vector, row uint32) error {
 		data := vals.([]uint32)
 		data = append(data[:row], data[row+1:]...)
 		col.Col = data
-	case types.T_uint64:
+	case types.T_uint64, types.T_set:
 		data := vals.([]uint64)
 		data = append(data[:row], data[row+1:]...)
 		col.Col = data
@@ -257,7 +267,11 @@ func DeleteFixSizeTypeValue(col *gvec.Vector, row uint32) error {
 		data := vals.([]types.Datetime)
 		data = append(data[:row], data[row+1:]...)
 		col.Col = data
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_uuid:
+		data := vals.([]types.Uuid)
+		data = append(data[:row], data[row+1:]...)
+		col.Col = data
+	case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 		// data := vals.(*types.Bytes)
 		// s := data.Offsets[row]
 		// e := data.Lengths[row]
@@ -269,6 +283,9 @@ func DeleteFixSizeTypeValue(col *gvec.Vector, row uint32) error {
 }
 
 func UpdateOffsets(data *types.Bytes, start, end int) {
+	if len(data.Offsets) == 0 {
+		return
+	}
 	if start == -1 {
 		data.Offsets[0] = 0
 		start++
@@ -396,7 +413,7 @@ func ApplyDeleteToVector(vec *gvec.Vector, deletes *roaring.Bitmap) *gvec.Vector
 				nsp.Np.Add(n - uint64(deleted))
 			}
 		}
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 		data := col.(*types.Bytes)
 		pre := -1
 		for deletesIterator.HasNext() {
@@ -470,7 +487,7 @@ func ApplyUpdateToVector(vec *gvec.Vector, mask *roaring.Bitmap, vals map[uint32
 				}
 			}
 		}
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 		data := col.(*types.Bytes)
 		pre := -1
 		for iterator.HasNext() {
@@ -771,7 +788,7 @@ func CheckRowExists(data *gvec.Vector, v any, deletes *roaring.Bitmap) (offset u
 			}
 		}
 		return
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		column := data.Col.([]uint16)
 		val := v.(uint16)
 		start, end := 0, len(column)-1
@@ -813,7 +830,7 @@ func CheckRowExists(data *gvec.Vector, v any, deletes *roaring.Bitmap) (offset u
 			}
 		}
 		return
-	case types.T_uint64:
+	case types.T_uint64, types.T_set:
 		column := data.Col.([]uint64)
 		val := v.(uint64)
 		start, end := 0, len(column)-1
@@ -918,7 +935,29 @@ func CheckRowExists(data *gvec.Vector, v any, deletes *roaring.Bitmap) (offset u
 			}
 		}
 		return
-	case types.T_char, types.T_varchar:
+	case types.T_uuid:
+		column := data.Col.([]types.Uuid)
+		val := v.(types.Uuid)
+		start, end := 0, len(column)-1
+		var mid int
+		for start <= end {
+			mid = (start + end) / 2
+			res := types.CompareUuid(column[mid], val)
+			if res > 0 {
+				end = mid - 1
+			} else if res < 0 {
+				start = mid + 1
+			} else {
+				if deletes != nil && deletes.Contains(uint32(mid)) {
+					return
+				}
+				offset = uint32(mid)
+				exist = true
+				return
+			}
+		}
+		return
+	case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 		column := data.Col.(*types.Bytes)
 		val := v.([]byte)
 		start, end := 0, len(column.Offsets)-1
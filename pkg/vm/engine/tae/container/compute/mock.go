@@ -174,7 +174,7 @@ func MockIVector(t types.Type, rows uint64, unique bool, provider *gvec.Vector)
 			vals = append(vals, float64(val1)/float64(val2))
 		}
 		vec.Append(len(vals), vals)
-	case types.T_varchar, types.T_char:
+	case types.T_varchar, types.T_char, types.T_json, types.T_text, types.T_blob:
 		vec = vector.NewStrVector(t, rows)
 		vals := make([][]byte, 0, rows)
 		if unique {
@@ -336,7 +336,7 @@ func MockVec(typ types.Type, rows int, offset int) *gvec.Vector {
 			data = append(data, types.Datetime(i+offset))
 		}
 		_ = gvec.Append(vec, data)
-	case types.T_char, types.T_varchar:
+	case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 		data := make([][]byte, 0)
 		for i := 0; i < rows; i++ {
 			data = append(data, []byte(strconv.Itoa(i+offset)))
@@ -43,11 +43,11 @@ func DecodeKey(key []byte, typ types.Type) any {
 		return encoding.DecodeInt64(key)
 	case types.T_uint8:
 		return encoding.DecodeUint8(key)
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		return encoding.DecodeUint16(key)
 	case types.T_uint32:
 		return encoding.DecodeUint32(key)
-	case types.T_uint64:
+	case types.T_uint64, types.T_set:
 		return encoding.DecodeUint64(key)
 	case types.T_float32:
 		return encoding.DecodeFloat32(key)
@@ -57,7 +57,9 @@ func DecodeKey(key []byte, typ types.Type) any {
 		return encoding.DecodeDate(key)
 	case types.T_datetime:
 		return encoding.DecodeDatetime(key)
-	case types.T_char, types.T_varchar:
+	case types.T_uuid:
+		return encoding.DecodeUuid(key)
+	case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 		return key
 	default:
 		panic("unsupported type")
@@ -96,7 +98,7 @@ func EncodeKey(key any, typ types.Type) ([]byte, error) {
 		} else {
 			panic("unsupported type")
 		}
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		if v, ok := key.(uint16); ok {
 			return encoding.EncodeUint16(v), nil
 		} else {
@@ -108,7 +110,7 @@ func EncodeKey(key any, typ types.Type) ([]byte, error) {
 		} else {
 			panic("unsupported type")
 		}
-	case types.T_uint64:
+	case types.T_uint64, types.T_set:
 		if v, ok := key.(uint64); ok {
 			return encoding.EncodeUint64(v), nil
 		} else {
@@ -138,7 +140,13 @@ func EncodeKey(key any, typ types.Type) ([]byte, error) {
 		} else {
 			panic("unsupported type")
 		}
-	case types.T_char, types.T_varchar:
+	case types.T_uuid:
+		if v, ok := key.(types.Uuid); ok {
+			return encoding.EncodeUuid(v), nil
+		} else {
+			panic("unsupported type")
+		}
+	case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 		if v, ok := key.([]byte); ok {
 			return v, nil
 		} else {
@@ -235,7 +243,7 @@ func ProcessVector(vec *vector.Vector, offset uint32, length uint32, task func(v
 				}
 			}
 		}
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		vs := vec.Col.([]uint16)[offset:]
 		if keyselects == nil {
 			for i, v := range vs {
@@ -267,7 +275,7 @@ func ProcessVector(vec *vector.Vector, offset uint32, length uint32, task func(v
 				}
 			}
 		}
-	case types.T_uint64:
+	case types.T_uint64, types.T_set:
 		vs := vec.Col.([]uint64)[offset:]
 		if keyselects == nil {
 			for i, v := range vs {
@@ -347,7 +355,23 @@ func ProcessVector(vec *vector.Vector, offset uint32, length uint32, task func(v
 				}
 			}
 		}
-	case types.T_char, types.T_varchar:
+	case types.T_uuid:
+		vs := vec.Col.([]types.Uuid)[offset:]
+		if keyselects == nil {
+			for i, v := range vs {
+				if err := task(v, uint32(i)); err != nil {
+					return err
+				}
+			}
+		} else {
+			for _, idx := range idxes {
+				v := vs[idx]
+				if err := task(v, idx); err != nil {
+					return err
+				}
+			}
+		}
+	case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 		vs := vec.Col.(*types.Bytes)
 		if keyselects == nil {
 			for i := range vs.Offsets[offset:] {
@@ -139,7 +139,7 @@ func MockVector(t types.Type, rows uint64) IVector {
 			vals = append(vals, float64(i%5000))
 		}
 		vec.Append(len(vals), vals)
-	case types.T_varchar, types.T_char:
+	case types.T_varchar, types.T_char, types.T_json, types.T_text, types.T_blob:
 		vec = NewStrVector(t, rows)
 		vals := make([][]byte, 0, rows)
 		prefix := "str"
@@ -0,0 +1,253 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vector
+
+import (
+	"sort"
+
+	"github.com/RoaringBitmap/roaring"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	gvec "github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+)
+
+// The values of TEXT, BLOB and JSON columns larger than the threshold are kept out
+// of the column data, which keeps the column data small whatever the size of
+// the documents. The out of line values are laid out as:
+//
+//	[cnt u32][rows u32 * cnt][lengths u32 * cnt][values]
+//
+// and the moved values are left empty in the column data.
+
+// SplitLargeValues moves the values larger than threshold out of data, which
+// is in the format of gvec.Vector.Show. It returns nil ovf if no value is
+// moved or the type of the column is none of TEXT, BLOB and JSON.
+func SplitLargeValues(data []byte, threshold int) (inline, ovf []byte, err error) {
+	if len(data) < encoding.TypeSize {
+		return data, nil, nil
+	}
+	if typ := encoding.DecodeType(data[:encoding.TypeSize]); typ.Oid != types.T_text && typ.Oid != types.T_blob && typ.Oid != types.T_json {
+		return data, nil, nil
+	}
+	header, lengths, values, err := splitStrData(data)
+	if err != nil || len(lengths) == 0 {
+		return data, nil, err
+	}
+	var rows []uint32
+	for i, n := range lengths {
+		if int(n) > threshold {
+			rows = append(rows, uint32(i))
+		}
+	}
+	if len(rows) == 0 {
+		return data, nil, nil
+	}
+
+	inlineLengths := make([]uint32, len(lengths))
+	ovfLengths := make([]uint32, 0, len(rows))
+	inlineValues := make([]byte, 0, len(values))
+	var ovfValues []byte
+	offset, j := uint32(0), 0
+	for i, n := range lengths {
+		v := values[offset : offset+n]
+		offset += n
+		if j < len(rows) && rows[j] == uint32(i) {
+			ovfLengths = append(ovfLengths, n)
+			ovfValues = append(ovfValues, v...)
+			j++
+			continue
+		}
+		inlineLengths[i] = n
+		inlineValues = append(inlineValues, v...)
+	}
+
+	inline = make([]byte, 0, len(header)+4*len(lengths)+len(inlineValues))
+	inline = append(inline, header...)
+	inline = append(inline, encoding.EncodeUint32Slice(inlineLengths)...)
+	inline = append(inline, inlineValues...)
+
+	ovf = make([]byte, 0, 4+8*len(rows)+len(ovfValues))
+	ovf = append(ovf, encoding.EncodeUint32(uint32(len(rows)))...)
+	ovf = append(ovf, encoding.EncodeUint32Slice(rows)...)
+	ovf = append(ovf, encoding.EncodeUint32Slice(ovfLengths)...)
+	ovf = append(ovf, ovfValues...)
+	return inline, ovf, nil
+}
+
+// MergeLargeValues puts the values moved by SplitLargeValues back into data.
+func MergeLargeValues(data, ovf []byte) ([]byte, error) {
+	if len(ovf) == 0 {
+		return data, nil
+	}
+	header, lengths, values, err := splitStrData(data)
+	if err != nil {
+		return nil, err
+	}
+	rows, ovfLengths, ovfValues, err := splitLargeValues(ovf)
+	if err != nil {
+		return nil, err
+	}
+
+	size := len(values) + len(ovfValues)
+	merged := make([]byte, 0, len(header)+4*len(lengths)+size)
+	merged = append(merged, header...)
+	mergedLengths := make([]uint32, len(lengths))
+	copy(mergedLengths, lengths)
+	for i, row := range rows {
+		if int(row) >= len(lengths) {
+			return nil, ErrVecCorruptedData
+		}
+		mergedLengths[row] = ovfLengths[i]
+	}
+	merged = append(merged, encoding.EncodeUint32Slice(mergedLengths)...)
+
+	offset, ovfOffset, j := uint32(0), uint32(0), 0
+	for i, n := range lengths {
+		if j < len(rows) && rows[j] == uint32(i) {
+			end := ovfOffset + ovfLengths[j]
+			if end < ovfOffset || int(end) > len(ovfValues) {
+				return nil, ErrVecCorruptedData
+			}
+			merged = append(merged, ovfValues[ovfOffset:end]...)
+			ovfOffset = end
+			j++
+			continue
+		}
+		end := offset + n
+		if end < offset || int(end) > len(values) {
+			return nil, ErrVecCorruptedData
+		}
+		merged = append(merged, values[offset:end]...)
+		offset = end
+	}
+	return merged, nil
+}
+
+// ReadLargeValues merges the out of line values of f into data if f keeps any.
+func ReadLargeValues(data []byte, f any) ([]byte, error) {
+	lf, ok := f.(common.ILargeValueFile)
+	if !ok {
+		return data, nil
+	}
+	ovf, err := lf.ReadLargeValues()
+	if err != nil {
+		return nil, err
+	}
+	return MergeLargeValues(data, ovf)
+}
+
+// FillLargeValues puts the values moved by SplitLargeValues back into vec,
+// whose i-th value is the rows[i]-th row of the split data, or the i-th row
+// if rows is nil. The rows in skip, whose values are replaced, are not filled.
+func FillLargeValues(vec *gvec.Vector, ovf []byte, rows []uint32, skip *roaring.Bitmap) error {
+	if len(ovf) == 0 {
+		return nil
+	}
+	ovfRows, ovfLengths, ovfValues, err := splitLargeValues(ovf)
+	if err != nil {
+		return err
+	}
+	col := vec.Col.(*types.Bytes)
+	filled := make(map[int][]byte, len(ovfRows))
+	offset := uint32(0)
+	for i, row := range ovfRows {
+		end := offset + ovfLengths[i]
+		if int(end) > len(ovfValues) {
+			return ErrVecCorruptedData
+		}
+		v := ovfValues[offset:end]
+		offset = end
+		if skip != nil && skip.Contains(row) {
+			continue
+		}
+		pos := int(row)
+		if rows != nil {
+			pos = sort.Search(len(rows), func(j int) bool { return rows[j] >= row })
+			if pos == len(rows) || rows[pos] != row {
+				continue
+			}
+		} else if pos >= len(col.Lengths) {
+			return ErrVecCorruptedData
+		}
+		filled[pos] = v
+	}
+	if len(filled) == 0 {
+		return nil
+	}
+	// the column may refer to the buffer it is read from, which is not modified
+	data := make([]byte, 0, len(col.Data)+len(ovfValues))
+	offsets := make([]uint32, len(col.Offsets))
+	lengths := make([]uint32, len(col.Lengths))
+	for i, n := range col.Lengths {
+		v, ok := filled[i]
+		if !ok {
+			v = col.Data[col.Offsets[i] : col.Offsets[i]+n]
+		}
+		offsets[i] = uint32(len(data))
+		lengths[i] = uint32(len(v))
+		data = append(data, v...)
+	}
+	vec.Col = &types.Bytes{Data: data, Offsets: offsets, Lengths: lengths}
+	return nil
+}
+
+// splitLargeValues splits the out of line values into the rows,
+// the lengths and the values.
+func splitLargeValues(ovf []byte) (rows, lengths []uint32, values []byte, err error) {
+	if len(ovf) < 4 {
+		return nil, nil, nil, ErrVecCorruptedData
+	}
+	cnt := int(encoding.DecodeUint32(ovf[:4]))
+	ovf = ovf[4:]
+	if len(ovf) < 8*cnt {
+		return nil, nil, nil, ErrVecCorruptedData
+	}
+	rows = encoding.DecodeUint32Slice(ovf[:4*cnt])
+	lengths = encoding.DecodeUint32Slice(ovf[4*cnt : 8*cnt])
+	return rows, lengths, ovf[8*cnt:], nil
+}
+
+// splitStrData splits the string column data in the format of
+// gvec.Vector.Show into the header, the lengths and the values.
+func splitStrData(data []byte) (header []byte, lengths []uint32, values []byte, err error) {
+	pos := encoding.TypeSize
+	if len(data) < pos+4 {
+		return nil, nil, nil, ErrVecCorruptedData
+	}
+	pos += 4 + int(encoding.DecodeUint32(data[pos:pos+4]))
+	if len(data) < pos+4 {
+		return nil, nil, nil, ErrVecCorruptedData
+	}
+	cnt := int(encoding.DecodeInt32(data[pos : pos+4]))
+	pos += 4
+	header = data[:pos]
+	if cnt == 0 {
+		return header, nil, nil, nil
+	}
+	if len(data) < pos+4*cnt {
+		return nil, nil, nil, ErrVecCorruptedData
+	}
+	lengths = encoding.DecodeUint32Slice(data[pos : pos+4*cnt])
+	values = data[pos+4*cnt:]
+	size := 0
+	for _, n := range lengths {
+		size += int(n)
+	}
+	if size > len(values) {
+		return nil, nil, nil, ErrVecCorruptedData
+	}
+	return header, lengths, values, nil
+}
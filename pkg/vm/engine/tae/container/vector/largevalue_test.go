@@ -0,0 +1,112 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vector
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/RoaringBitmap/roaring"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLargeValues(t *testing.T) {
+	vs := [][]byte{
+		[]byte("small"),
+		bytes.Repeat([]byte("a"), 100),
+		nil,
+		[]byte("tiny"),
+		bytes.Repeat([]byte("b"), 64),
+	}
+	vec := vector.New(types.Type{Oid: types.T_text, Size: 24})
+	assert.Nil(t, vector.Append(vec, vs))
+	nulls.Add(vec.Nsp, 2)
+	data, err := vec.Show()
+	assert.Nil(t, err)
+
+	inline, ovf, err := SplitLargeValues(data, 32)
+	assert.Nil(t, err)
+	assert.NotNil(t, ovf)
+	assert.Less(t, len(inline), len(data)-150)
+
+	merged, err := MergeLargeValues(inline, ovf)
+	assert.Nil(t, err)
+	assert.Equal(t, data, merged)
+	res := vector.New(vec.Typ)
+	assert.Nil(t, res.Read(merged))
+	assert.Equal(t, vec.Col, res.Col)
+	assert.True(t, nulls.Contains(res.Nsp, 2))
+
+	// the inline values are truncated
+	_, err = MergeLargeValues(inline[:len(inline)-1], ovf)
+	assert.Equal(t, ErrVecCorruptedData, err)
+	// the out of line values are truncated
+	_, err = MergeLargeValues(inline, ovf[:len(ovf)-1])
+	assert.Equal(t, ErrVecCorruptedData, err)
+
+	// no value is larger than the threshold
+	inline, ovf, err = SplitLargeValues(data, 100)
+	assert.Nil(t, err)
+	assert.Nil(t, ovf)
+	assert.Equal(t, data, inline)
+
+	// only TEXT and BLOB values are kept out of line
+	vec = vector.New(types.Type{Oid: types.T_varchar, Size: 24})
+	assert.Nil(t, vector.Append(vec, vs))
+	data, err = vec.Show()
+	assert.Nil(t, err)
+	_, ovf, err = SplitLargeValues(data, 32)
+	assert.Nil(t, err)
+	assert.Nil(t, ovf)
+
+	_, err = MergeLargeValues(data, []byte{1})
+	assert.Equal(t, ErrVecCorruptedData, err)
+}
+
+func TestFillLargeValues(t *testing.T) {
+	vs := [][]byte{
+		[]byte("small"),
+		bytes.Repeat([]byte("a"), 100),
+		[]byte("tiny"),
+		[]byte("short"),
+		bytes.Repeat([]byte("b"), 64),
+	}
+	vec := vector.New(types.Type{Oid: types.T_text, Size: 24})
+	assert.Nil(t, vector.Append(vec, vs))
+	data, err := vec.Show()
+	assert.Nil(t, err)
+	inline, ovf, err := SplitLargeValues(data, 32)
+	assert.Nil(t, err)
+
+	res := vector.New(vec.Typ)
+	assert.Nil(t, res.Read(inline))
+	assert.Nil(t, FillLargeValues(res, ovf, nil, nil))
+	assert.Equal(t, vec.Col, res.Col)
+
+	// only the large values of the kept rows which are not replaced are filled
+	res = vector.New(vec.Typ)
+	assert.Nil(t, res.Read(inline))
+	vector.Shrink(res, []int64{1, 3, 4})
+	assert.Nil(t, FillLargeValues(res, ovf, []uint32{1, 3, 4}, roaring.BitmapOf(4)))
+	col := res.Col.(*types.Bytes)
+	assert.Equal(t, vs[1], col.Get(0))
+	assert.Equal(t, vs[3], col.Get(1))
+	assert.Equal(t, 0, len(col.Get(2)))
+
+	assert.Equal(t, ErrVecCorruptedData, FillLargeValues(res, []byte{1}, nil, nil))
+}
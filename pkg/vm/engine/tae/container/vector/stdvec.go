@@ -159,7 +159,7 @@ func (v *StdVector) SetValue(idx int, val any) error {
 		data := encoding.EncodeUint8(val.(uint8))
 		copy(v.Data[start:start+int(v.Type.Size)], data)
 		return nil
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		data := encoding.EncodeUint16(val.(uint16))
 		copy(v.Data[start:start+int(v.Type.Size)], data)
 		return nil
@@ -167,7 +167,7 @@ func (v *StdVector) SetValue(idx int, val any) error {
 		data := encoding.EncodeUint32(val.(uint32))
 		copy(v.Data[start:start+int(v.Type.Size)], data)
 		return nil
-	case types.T_uint64:
+	case types.T_uint64, types.T_set:
 		data := encoding.EncodeUint64(val.(uint64))
 		copy(v.Data[start:start+int(v.Type.Size)], data)
 		return nil
@@ -188,6 +188,10 @@ func (v *StdVector) SetValue(idx int, val any) error {
 		data := encoding.EncodeDatetime(val.(types.Datetime))
 		copy(v.Data[start:start+int(v.Type.Size)], data)
 		return nil
+	case types.T_uuid:
+		data := encoding.EncodeUuid(val.(types.Uuid))
+		copy(v.Data[start:start+int(v.Type.Size)], data)
+		return nil
 	default:
 		return ErrVecTypeNotSupport
 	}
@@ -216,11 +220,11 @@ func (v *StdVector) GetValue(idx int) (any, error) {
 		return encoding.DecodeInt64(data), nil
 	case types.T_uint8:
 		return encoding.DecodeUint8(data), nil
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		return encoding.DecodeUint16(data), nil
 	case types.T_uint32:
 		return encoding.DecodeUint32(data), nil
-	case types.T_uint64:
+	case types.T_uint64, types.T_set:
 		return encoding.DecodeUint64(data), nil
 	case types.T_float32:
 		return encoding.DecodeFloat32(data), nil
@@ -231,6 +235,8 @@ func (v *StdVector) GetValue(idx int) (any, error) {
 		return encoding.DecodeDate(data), nil
 	case types.T_datetime:
 		return encoding.DecodeDatetime(data), nil
+	case types.T_uuid:
+		return encoding.DecodeUuid(data), nil
 	default:
 		return nil, ErrVecTypeNotSupport
 	}
@@ -270,11 +276,11 @@ func (v *StdVector) appendWithOffset(offset, n int, vals any) error {
 		data = encoding.EncodeInt64Slice(vals.([]int64)[offset : offset+n])
 	case types.T_uint8:
 		data = encoding.EncodeUint8Slice(vals.([]uint8)[offset : offset+n])
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		data = encoding.EncodeUint16Slice(vals.([]uint16)[offset : offset+n])
 	case types.T_uint32:
 		data = encoding.EncodeUint32Slice(vals.([]uint32)[offset : offset+n])
-	case types.T_uint64:
+	case types.T_uint64, types.T_set:
 		data = encoding.EncodeUint64Slice(vals.([]uint64)[offset : offset+n])
 
 	case types.T_decimal64:
@@ -287,6 +293,8 @@ func (v *StdVector) appendWithOffset(offset, n int, vals any) error {
 		data = encoding.EncodeDateSlice(vals.([]types.Date)[offset : offset+n])
 	case types.T_datetime:
 		data = encoding.EncodeDatetimeSlice(vals.([]types.Datetime)[offset : offset+n])
+	case types.T_uuid:
+		data = encoding.EncodeUuidSlice(vals.([]types.Uuid)[offset : offset+n])
 	default:
 		return ErrVecTypeNotSupport
 	}
@@ -546,7 +554,7 @@ func (v *StdVector) CopyToVector() (*gvec.Vector, error) {
 		copy(col, curCol[:length])
 		vec.Col = col
 		vec.Nsp = nulls.Range(v.VMask, uint64(0), uint64(length), &nulls.Nulls{})
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		col := make([]uint16, length)
 		curCol := encoding.DecodeUint16Slice(v.Data)
 		copy(col, curCol[:length])
@@ -558,7 +566,7 @@ func (v *StdVector) CopyToVector() (*gvec.Vector, error) {
 		copy(col, curCol[:length])
 		vec.Col = col
 		vec.Nsp = nulls.Range(v.VMask, uint64(0), uint64(length), &nulls.Nulls{})
-	case types.T_uint64:
+	case types.T_uint64, types.T_set:
 		col := make([]uint64, length)
 		curCol := encoding.DecodeUint64Slice(v.Data)
 		copy(col, curCol[:length])
@@ -595,6 +603,12 @@ func (v *StdVector) CopyToVector() (*gvec.Vector, error) {
 		copy(col, curCol[:length])
 		vec.Col = col
 		vec.Nsp = nulls.Range(v.VMask, uint64(0), uint64(length), &nulls.Nulls{})
+	case types.T_uuid:
+		col := make([]types.Uuid, length)
+		curCol := encoding.DecodeUuidSlice(v.Data)
+		copy(col, curCol[:length])
+		vec.Col = col
+		vec.Nsp = nulls.Range(v.VMask, uint64(0), uint64(length), &nulls.Nulls{})
 	default:
 		return nil, ErrVecTypeNotSupport
 	}
@@ -27,7 +27,6 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
-	"github.com/pierrec/lz4"
 )
 
 type VectorWrapper struct {
@@ -36,6 +35,9 @@ type VectorWrapper struct {
 	FreeFunc    base.MemoryFreeFunc
 	File        common.IVFile
 	UseCompress bool
+	// DeferLargeValues leaves the values kept out of line by File empty,
+	// the reader fills them by FillLargeValues when it needs them
+	DeferLargeValues bool
 }
 
 func (v *VectorWrapper) Reset() {
@@ -136,7 +138,7 @@ func (v *VectorWrapper) GetValue(idx int) (any, error) {
 		return nil, ErrVecInvalidOffset
 	}
 	switch v.Typ.Oid {
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 		val := v.Col.(*types.Bytes)
 		return val.Data[val.Offsets[idx] : val.Offsets[idx]+val.Lengths[idx]], nil
 	case types.T_int8:
@@ -149,11 +151,11 @@ func (v *VectorWrapper) GetValue(idx int) (any, error) {
 		return v.Col.([]int64)[idx], nil
 	case types.T_uint8:
 		return v.Col.([]uint8)[idx], nil
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		return v.Col.([]uint16)[idx], nil
 	case types.T_uint32:
 		return v.Col.([]uint32)[idx], nil
-	case types.T_uint64:
+	case types.T_uint64, types.T_set:
 		return v.Col.([]uint64)[idx], nil
 	case types.T_decimal64:
 		return v.Col.([]types.Decimal64)[idx], nil
@@ -165,6 +167,8 @@ func (v *VectorWrapper) GetValue(idx int) (any, error) {
 		return v.Col.([]types.Date)[idx], nil
 	case types.T_datetime:
 		return v.Col.([]types.Datetime)[idx], nil
+	case types.T_uuid:
+		return v.Col.([]types.Uuid)[idx], nil
 	case types.T_sel:
 		return v.Col.([]int64)[idx], nil
 	case types.T_tuple:
@@ -212,14 +216,18 @@ func (vec *VectorWrapper) WriteTo(w io.Writer) (n int64, err error) {
 		return n, err
 	}
 	stat := vec.File.Stat()
-	switch stat.CompressAlgo() {
+	algo := compress.T(stat.CompressAlgo())
+	if buf, _, err = compress.Encode(buf, algo.Encoding()); err != nil {
+		return n, err
+	}
+	switch codec := int(algo.Codec()); codec {
 	case compress.None:
 		nw, err := w.Write(buf)
 		return int64(nw), err
-	case compress.Lz4:
-		nb := lz4.CompressBlockBound(len(buf))
+	case compress.Lz4, compress.Zstd, compress.Snappy:
+		nb := compress.CompressBound(len(buf), codec)
 		tmp := make([]byte, nb)
-		tmp, err = compress.Compress(buf, tmp, compress.Lz4)
+		tmp, err = compress.Compress(buf, tmp, codec)
 		if err != nil {
 			return 0, err
 		}
@@ -249,7 +257,8 @@ func (vec *VectorWrapper) ReadFrom(r io.Reader) (n int64, err error) {
 
 	stat := vec.File.Stat()
 	// logutil.Infof("%d, %d, %d", stat.CompressAlgo(), stat.Size(), stat.OriginSize())
-	switch stat.CompressAlgo() {
+	algo := compress.T(stat.CompressAlgo())
+	switch codec := int(algo.Codec()); codec {
 	case compress.None:
 		allocSize := uint64(stat.Size())
 		vec.MNode = common.GPool.Alloc(allocSize)
@@ -259,12 +268,16 @@ func (vec *VectorWrapper) ReadFrom(r io.Reader) (n int64, err error) {
 			common.GPool.Free(vec.MNode)
 			return n, err
 		}
+		if data, err = decodeData(data, algo, vec.largeValueFile()); err != nil {
+			common.GPool.Free(vec.MNode)
+			return n, err
+		}
 		t := encoding.DecodeType(data[:encoding.TypeSize])
 		v := gvec.New(t)
 		vec.Col = v.Col
 		err = vec.Vector.Read(data)
 		return int64(nr), err
-	case compress.Lz4:
+	case compress.Lz4, compress.Zstd, compress.Snappy:
 		loadSize := uint64(stat.Size())
 		originSize := uint64(stat.OriginSize())
 		tmpNode := common.GPool.Alloc(loadSize)
@@ -274,12 +287,16 @@ func (vec *VectorWrapper) ReadFrom(r io.Reader) (n int64, err error) {
 			return n, err
 		}
 		vec.MNode = common.GPool.Alloc(originSize)
-		_, err = compress.Decompress(tmpNode.Buf[:loadSize], vec.MNode.Buf[:originSize], compress.Lz4)
+		_, err = compress.Decompress(tmpNode.Buf[:loadSize], vec.MNode.Buf[:originSize], codec)
+		if err != nil {
+			common.GPool.Free(vec.MNode)
+			return n, err
+		}
+		data, err := decodeData(vec.MNode.Buf[:originSize], algo, vec.largeValueFile())
 		if err != nil {
 			common.GPool.Free(vec.MNode)
 			return n, err
 		}
-		data := vec.MNode.Buf[:originSize]
 		t := encoding.DecodeType(data[:encoding.TypeSize])
 		v := gvec.New(t)
 		vec.Col = v.Col
@@ -295,10 +312,14 @@ func (vec *VectorWrapper) ReadFrom(r io.Reader) (n int64, err error) {
 
 func (vec *VectorWrapper) ReadWithBuffer(r io.Reader, compressed *bytes.Buffer, deCompressed *bytes.Buffer) (n int64, err error) {
 	stat := vec.File.Stat()
-	switch stat.CompressAlgo() {
+	algo := compress.T(stat.CompressAlgo())
+	switch codec := int(algo.Codec()); codec {
 	case compress.None:
 		deCompressed.Reset()
 		vsize := int(vec.GetMemoryCapacity())
+		if algo.Encoding() != compress.Plain {
+			vsize = int(stat.Size())
+		}
 		if vsize > deCompressed.Cap() {
 			deCompressed.Grow(vsize)
 		}
@@ -308,12 +329,15 @@ func (vec *VectorWrapper) ReadWithBuffer(r io.Reader, compressed *bytes.Buffer,
 		if err != nil {
 			return n, err
 		}
+		if buf, err = decodeData(buf, algo, vec.largeValueFile()); err != nil {
+			return n, err
+		}
 		err = vec.Vector.Read(buf)
 		if err != nil {
 			return n, err
 		}
 		return int64(nr), err
-	case compress.Lz4:
+	case compress.Lz4, compress.Zstd, compress.Snappy:
 		loadSize := stat.Size()
 		originSize := stat.OriginSize()
 		var tmpBuf []byte
@@ -341,13 +365,16 @@ func (vec *VectorWrapper) ReadWithBuffer(r io.Reader, compressed *bytes.Buffer,
 		if err != nil {
 			return n, err
 		}
-		buf, err = compress.Decompress(tmpBuf, buf, compress.Lz4)
+		buf, err = compress.Decompress(tmpBuf, buf, codec)
 		if err != nil {
 			return n, err
 		}
 		if len(buf) != int(originSize) {
 			panic(fmt.Sprintf("invalid decompressed size: %d, %d is expected", len(buf), originSize))
 		}
+		if buf, err = decodeData(buf, algo, vec.largeValueFile()); err != nil {
+			return n, err
+		}
 		t := encoding.DecodeType(buf[:encoding.TypeSize])
 		v := gvec.New(t)
 		vec.Col = v.Col
@@ -365,3 +392,22 @@ func (vec *VectorWrapper) Marshal() ([]byte, error) {
 func (vec *VectorWrapper) Unmarshal(data []byte) error {
 	return vec.Vector.Read(data)
 }
+
+// largeValueFile returns the file to merge the large values from,
+// which is nil if the merge is deferred.
+func (vec *VectorWrapper) largeValueFile() common.IVFile {
+	if vec.DeferLargeValues {
+		return nil
+	}
+	return vec.File
+}
+
+// decodeData decodes the data by the encoding of algo and merges back
+// the large values kept out of line by f.
+func decodeData(data []byte, algo compress.T, f common.IVFile) ([]byte, error) {
+	data, err := compress.Decode(data, algo.Encoding())
+	if err != nil {
+		return nil, err
+	}
+	return ReadLargeValues(data, f)
+}
@@ -18,6 +18,7 @@ import (
 	"bytes"
 
 	"github.com/RoaringBitmap/roaring"
+	"github.com/matrixorigin/matrixone/pkg/compress"
 	gbat "github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	gvec "github.com/matrixorigin/matrixone/pkg/container/vector"
@@ -196,6 +197,9 @@ func (bf *blockFile) LoadBatch(attrs []string, colTypes []types.Type) (bat *gbat
 	return
 }
 
+// SetColumnAlgos is a no-op, the mock files are kept in memory without compression.
+func (bf *blockFile) SetColumnAlgos(algos []compress.T) {}
+
 func (bf *blockFile) WriteColumnVec(ts uint64, colIdx int, vec *gvec.Vector) (err error) {
 	cb, err := bf.OpenColumn(colIdx)
 	if err != nil {
@@ -205,23 +205,11 @@ func (bf *blockFile) LoadIBatch(colTypes []types.Type, maxRow uint32) (bat batch
 			return
 		}
 		vec := vector.NewVector(colTypes[i], uint64(maxRow))
-		if colBlk.data.stat.CompressAlgo() == compress.Lz4 {
-			decompress := make([]byte, colBlk.data.stat.OriginSize())
-			decompress, err = compress.Decompress(buf, decompress, compress.Lz4)
-			if err != nil {
-				return nil, err
-			}
-			if len(decompress) != int(colBlk.data.stat.OriginSize()) {
-				panic(any(fmt.Sprintf("invalid decompressed size: %d, %d is expected",
-					len(decompress), colBlk.data.stat.OriginSize())))
-			}
-			if err = vec.Unmarshal(decompress); err != nil {
-				return
-			}
-		} else {
-			if err = vec.Unmarshal(buf); err != nil {
-				return
-			}
+		if buf, err = decodeColumnData(buf, colBlk.data, ivectorHeaderSize); err != nil {
+			return
+		}
+		if err = vec.Unmarshal(buf); err != nil {
+			return
 		}
 		vecs[i] = vec
 		attrs[i] = i
@@ -244,41 +232,79 @@ func (bf *blockFile) LoadBatch(attrs []string, colTypes []types.Type) (bat *gbat
 			return
 		}
 		vec := gvec.New(colTypes[i])
-		if colBlk.data.stat.CompressAlgo() == compress.Lz4 {
-			decompress := make([]byte, colBlk.data.stat.OriginSize())
-			decompress, err = compress.Decompress(buf, decompress, compress.Lz4)
-			if err != nil {
-				return nil, err
-			}
-			if len(decompress) != int(colBlk.data.stat.OriginSize()) {
-				panic(any(fmt.Sprintf("invalid decompressed size: %d, %d is expected",
-					len(decompress), colBlk.data.stat.OriginSize())))
-			}
-			if err = vec.Read(decompress); err != nil {
-				return
-			}
-		} else {
-			if err = vec.Read(buf); err != nil {
-				return
-			}
+		if buf, err = decodeColumnData(buf, colBlk.data, 0); err != nil {
+			return
+		}
+		if err = vec.Read(buf); err != nil {
+			return
 		}
 		bat.Vecs[i] = vec
 	}
 	return
 }
 
-func (bf *blockFile) WriteColumnVec(ts uint64, colIdx int, vec *gvec.Vector) (err error) {
-	cb, err := bf.OpenColumn(colIdx)
+// ivectorHeaderSize is the size of the header vector.IVector.Marshal writes
+// before the data in the format of gvec.Vector.Show.
+const ivectorHeaderSize = 16
+
+// decodeColumnData decompresses and decodes the data of the column by the compression of the file,
+// and merges back the large values kept out of line. The first headerSize bytes are not encoded.
+func decodeColumnData(buf []byte, df *dataFile, headerSize int) ([]byte, error) {
+	stat := df.stat
+	algo := compress.T(stat.CompressAlgo())
+	if codec := algo.Codec(); codec != compress.None {
+		decompress := make([]byte, stat.OriginSize())
+		decompress, err := compress.Decompress(buf, decompress, int(codec))
+		if err != nil {
+			return nil, err
+		}
+		if len(decompress) != int(stat.OriginSize()) {
+			panic(any(fmt.Sprintf("invalid decompressed size: %d, %d is expected",
+				len(decompress), stat.OriginSize())))
+		}
+		buf = decompress
+	}
+	if len(buf) == 0 {
+		// the data of the column is not written yet
+		return buf, nil
+	} else if len(buf) < headerSize {
+		return nil, vector.ErrVecCorruptedData
+	}
+	header, buf := buf[:headerSize], buf[headerSize:]
+	buf, err := compress.Decode(buf, algo.Encoding())
 	if err != nil {
+		return nil, err
+	}
+	if buf, err = vector.ReadLargeValues(buf, df); err != nil || headerSize == 0 {
+		return buf, err
+	}
+	data := make([]byte, 0, headerSize+len(buf))
+	data = append(data, header...)
+	return append(data, buf...), nil
+}
+
+func (bf *blockFile) SetColumnAlgos(algos []compress.T) {
+	for i, algo := range algos {
+		if i < len(bf.columns) {
+			bf.columns[i].algo = algo
+		}
+	}
+}
+
+func (bf *blockFile) WriteColumnVec(ts uint64, colIdx int, vec *gvec.Vector) (err error) {
+	if _, err = bf.OpenColumn(colIdx); err != nil {
 		return err
 	}
+	cb := bf.columns[colIdx]
 	defer cb.Close()
-	err = cb.WriteTS(ts)
+	if err = cb.WriteTS(ts); err != nil {
+		return err
+	}
 	buf, err := vec.Show()
 	if err != nil {
 		return err
 	}
-	err = cb.WriteData(buf)
+	err = cb.writeColumn(nil, buf)
 	return
 }
 
@@ -350,7 +376,7 @@ func (bf *blockFile) WriteIBatch(bat batch.IBatch, ts uint64, masks map[uint16]*
 		if err != nil {
 			return err
 		}
-		if err = cb.WriteData(buf); err != nil {
+		if err = bf.columns[colIdx].writeColumn(buf[:ivectorHeaderSize], buf[ivectorHeaderSize:]); err != nil {
 			return err
 		}
 	}
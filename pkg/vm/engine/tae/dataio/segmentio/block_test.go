@@ -16,13 +16,19 @@ package segmentio
 
 import (
 	"bytes"
+	"fmt"
 	"path"
 	"testing"
 
 	"github.com/matrixorigin/matrixone/pkg/compress"
+	gbat "github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	gvec "github.com/matrixorigin/matrixone/pkg/container/vector"
 
 	"github.com/RoaringBitmap/roaring"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/file"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/testutils"
 	"github.com/stretchr/testify/assert"
@@ -84,3 +90,144 @@ func TestBlock1(t *testing.T) {
 
 	block.Unref()
 }
+
+func TestBlockColumnAlgos(t *testing.T) {
+	dir := testutils.InitTestEnv(ModuleName, t)
+	name := path.Join(dir, "seg")
+	seg := SegmentFileIOFactory(name, common.NextGlobalSeqNum())
+	block := newBlock(common.NextGlobalSeqNum(), seg, 3, nil)
+	algos := []compress.T{
+		compress.NewT(compress.Zstd, compress.Delta),
+		compress.NewT(compress.Snappy, compress.Dict),
+		compress.NewT(compress.Lz4, compress.Dict),
+	}
+	block.SetColumnAlgos(algos)
+
+	rows := 1000
+	colTypes := []types.Type{
+		{Oid: types.T_int64, Size: 8},
+		{Oid: types.T_varchar, Size: 24},
+		{Oid: types.T_int32, Size: 4},
+	}
+	attrs := []string{"a", "b", "c"}
+	bat := gbat.New(true, attrs)
+	i64s := make([]int64, rows)
+	strs := make([][]byte, rows)
+	i32s := make([]int32, rows)
+	for i := 0; i < rows; i++ {
+		i64s[i] = int64(1000000 + i*3)
+		strs[i] = []byte(fmt.Sprintf("v%d", i%4))
+		i32s[i] = int32(i)
+	}
+	for i, vs := range []any{i64s, strs, i32s} {
+		bat.Vecs[i] = gvec.New(colTypes[i])
+		assert.Nil(t, gvec.Append(bat.Vecs[i], vs))
+	}
+	assert.Nil(t, block.WriteBatch(bat, common.NextGlobalSeqNum()))
+
+	// dict can not encode the integers, the column is only compressed
+	expected := []compress.T{algos[0], algos[1], compress.Lz4}
+	for i, colBlk := range block.columns {
+		assert.Equal(t, expected[i], compress.T(colBlk.GetDataFileStat().CompressAlgo()))
+	}
+
+	loaded, err := block.LoadBatch(attrs, colTypes)
+	assert.Nil(t, err)
+	for i := range attrs {
+		assert.Equal(t, bat.Vecs[i].Col, loaded.Vecs[i].Col)
+	}
+	block.Unref()
+}
+
+func TestBlockIBatchAlgos(t *testing.T) {
+	dir := testutils.InitTestEnv(ModuleName, t)
+	name := path.Join(dir, "seg")
+	seg := SegmentFileIOFactory(name, common.NextGlobalSeqNum())
+	block := newBlock(common.NextGlobalSeqNum(), seg, 2, nil)
+	algos := []compress.T{
+		compress.NewT(compress.Zstd, compress.Delta),
+		compress.NewT(compress.Snappy, compress.Plain),
+	}
+	block.SetColumnAlgos(algos)
+
+	rows := uint64(1000)
+	colTypes := []types.Type{
+		{Oid: types.T_int64, Size: 8},
+		{Oid: types.T_varchar, Size: 24},
+	}
+	vecs := make([]vector.IVector, len(colTypes))
+	for i, typ := range colTypes {
+		vecs[i] = vector.MockVector(typ, rows)
+	}
+	bat, err := batch.NewBatch([]int{0, 1}, vecs)
+	assert.Nil(t, err)
+	assert.Nil(t, block.WriteIBatch(bat, common.NextGlobalSeqNum(), nil, nil, nil))
+
+	// the checkpointed columns are encoded like the columns of the appended blocks
+	for i, colBlk := range block.columns {
+		assert.Equal(t, algos[i], compress.T(colBlk.GetDataFileStat().CompressAlgo()))
+	}
+
+	loaded, err := block.LoadIBatch(colTypes, uint32(rows))
+	assert.Nil(t, err)
+	for i := range colTypes {
+		expected, err := vecs[i].CopyToVector()
+		assert.Nil(t, err)
+		vec, err := loaded.GetVectorByAttr(i)
+		assert.Nil(t, err)
+		actual, err := vec.CopyToVector()
+		assert.Nil(t, err)
+		assert.Equal(t, expected.Col, actual.Col)
+	}
+	block.Unref()
+}
+
+func TestBlockLargeValues(t *testing.T) {
+	dir := testutils.InitTestEnv(ModuleName, t)
+	name := path.Join(dir, "seg")
+	id := common.NextGlobalSeqNum()
+	seg := SegmentFileIOFactory(name, id)
+	blkId := common.NextGlobalSeqNum()
+	block := newBlock(blkId, seg, 2, nil)
+
+	colTypes := []types.Type{
+		{Oid: types.T_text, Size: 24},
+		{Oid: types.T_blob, Size: 24},
+	}
+	attrs := []string{"doc", "data"}
+	bat := gbat.New(true, attrs)
+	large := bytes.Repeat([]byte("matrixone"), LargeValueThreshold)
+	for i := range attrs {
+		vs := make([][]byte, 100)
+		for j := range vs {
+			vs[j] = []byte(fmt.Sprintf("%s-%d", attrs[i], j))
+		}
+		vs[7], vs[42] = large, large[:LargeValueThreshold+1]
+		bat.Vecs[i] = gvec.New(colTypes[i])
+		assert.Nil(t, gvec.Append(bat.Vecs[i], vs))
+	}
+	assert.Nil(t, block.WriteBatch(bat, common.NextGlobalSeqNum()))
+
+	// the large values are not in the column data
+	for _, colBlk := range block.columns {
+		assert.Less(t, colBlk.GetDataFileStat().OriginSize(), int64(LargeValueThreshold))
+		assert.NotNil(t, colBlk.data.overflow)
+	}
+	loaded, err := block.LoadBatch(attrs, colTypes)
+	assert.Nil(t, err)
+	for i := range attrs {
+		assert.Equal(t, bat.Vecs[i].Col, loaded.Vecs[i].Col)
+	}
+
+	seg = SegmentFileIOOpenFactory(name, id)
+	cache := bytes.NewBuffer(make([]byte, 10240*4096))
+	assert.Nil(t, seg.Replay(2, nil, cache))
+	replayed, err := seg.OpenBlock(blkId, 2, nil)
+	assert.Nil(t, err)
+	loaded, err = replayed.LoadBatch(attrs, colTypes)
+	assert.Nil(t, err)
+	for i := range attrs {
+		assert.Equal(t, bat.Vecs[i].Col, loaded.Vecs[i].Col)
+	}
+	block.Unref()
+}
@@ -16,8 +16,10 @@ package segmentio
 
 import (
 	"fmt"
+	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/file"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/layout/segment"
 	"sync"
@@ -32,6 +34,8 @@ type columnBlock struct {
 	updates *updatesFile
 	data    *dataFile
 	col     int
+	// the compression of the data written later
+	algo compress.T
 }
 
 func newColumnBlock(block *blockFile, indexCnt int, col int) *columnBlock {
@@ -39,6 +43,7 @@ func newColumnBlock(block *blockFile, indexCnt int, col int) *columnBlock {
 		block:   block,
 		indexes: make([]*indexFile, indexCnt),
 		col:     col,
+		algo:    compress.Lz4,
 	}
 	for i := range cb.indexes {
 		cb.indexes[i] = newIndex(cb)
@@ -58,6 +63,7 @@ func openColumnBlock(block *blockFile, indexCnt int, col int) *columnBlock {
 		block:   block,
 		indexes: make([]*indexFile, indexCnt),
 		col:     col,
+		algo:    compress.Lz4,
 	}
 	for i := range cb.indexes {
 		cb.indexes[i] = newIndex(cb)
@@ -82,8 +88,37 @@ func (cb *columnBlock) WriteTS(ts uint64) (err error) {
 }
 
 func (cb *columnBlock) WriteData(buf []byte) (err error) {
-	_, err = cb.data.Write(buf)
-	return
+	return cb.writeData(buf, compress.Plain)
+}
+
+// writeData writes the data encoded by enc, which is compressed by the codec of the column.
+func (cb *columnBlock) writeData(buf []byte, enc compress.Encoding) (err error) {
+	cb.data.algo = compress.NewT(cb.algo.Codec(), enc)
+	if _, err = cb.data.Write(buf); err != nil {
+		return
+	}
+	return cb.data.WriteLargeValues(nil)
+}
+
+// writeColumn writes the column data in the format of gvec.Vector.Show after
+// the header, which is written as is. The large values of TEXT and BLOB columns
+// are kept out of line.
+func (cb *columnBlock) writeColumn(header, buf []byte) (err error) {
+	buf, ovf, err := vector.SplitLargeValues(buf, LargeValueThreshold)
+	if err != nil {
+		return
+	}
+	buf, enc, err := compress.Encode(buf, cb.algo.Encoding())
+	if err != nil {
+		return
+	}
+	if len(header) > 0 {
+		buf = append(header[:len(header):len(header)], buf...)
+	}
+	if err = cb.writeData(buf, enc); err != nil {
+		return
+	}
+	return cb.data.WriteLargeValues(ovf)
 }
 
 func (cb *columnBlock) WriteUpdates(buf []byte) (err error) {
@@ -173,4 +208,7 @@ func (cb *columnBlock) Destroy() {
 			cb.block.seg.GetSegmentFile().ReleaseFile(file)
 		}
 	}
+	if cb.data.overflow != nil {
+		cb.block.seg.GetSegmentFile().ReleaseFile(cb.data.overflow)
+	}
 }
@@ -15,6 +15,7 @@
 package segmentio
 
 import (
+	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/layout/segment"
 )
@@ -25,8 +26,21 @@ type dataFile struct {
 	buf    []byte
 	stat   *fileStat
 	cache  []byte
+	// the compression of the data written later
+	algo compress.T
+	// the large values kept out of line, which belong to the latest data file
+	overflow *segment.BlockFile
 }
 
+// LargeValueThreshold is the size above which the values of TEXT and BLOB
+// columns are kept out of the column data
+var LargeValueThreshold = 4 * 1024
+
+// the suffix of the file keeping the large values of a data file
+const largeValueSuffix = ".ovf"
+
+func largeValueFileName(name string) string { return name + largeValueSuffix }
+
 type indexFile struct {
 	*dataFile
 }
@@ -44,6 +58,7 @@ func newData(colBlk *columnBlock) *dataFile {
 	df := &dataFile{
 		colBlk: colBlk,
 		buf:    make([]byte, 0),
+		algo:   compress.Lz4,
 	}
 	df.stat = &fileStat{}
 	return df
@@ -88,6 +103,7 @@ func (df *dataFile) Write(buf []byte) (n int, err error) {
 	df.colBlk.mutex.RLock()
 	file := df.file[len(df.file)-1]
 	df.colBlk.mutex.RUnlock()
+	file.SetAlgo(uint8(df.algo))
 	err = file.GetSegement().Append(file, buf)
 	df.stat.algo = file.GetAlgo()
 	df.stat.originSize = file.GetOriginSize()
@@ -112,6 +128,56 @@ func (df *dataFile) Read(buf []byte) (n int, err error) {
 	return n, nil
 }
 
+// WriteLargeValues writes the values moved out of the latest data file,
+// which are laid out as vector.SplitLargeValues returns.
+func (df *dataFile) WriteLargeValues(buf []byte) (err error) {
+	df.colBlk.mutex.Lock()
+	defer df.colBlk.mutex.Unlock()
+	if df.file == nil {
+		return
+	}
+	seg := df.colBlk.block.seg.GetSegmentFile()
+	if df.overflow != nil {
+		seg.ReleaseFile(df.overflow)
+		df.overflow = nil
+	}
+	if len(buf) == 0 {
+		return
+	}
+	file := seg.NewBlockFile(largeValueFileName(df.file[len(df.file)-1].GetName()))
+	file.SetAlgo(uint8(compress.NewT(df.algo.Codec(), compress.Plain)))
+	if err = seg.Append(file, buf); err != nil {
+		seg.ReleaseFile(file)
+		return
+	}
+	df.overflow = file
+	return
+}
+
+func (df *dataFile) ReadLargeValues() (buf []byte, err error) {
+	if df.file == nil {
+		return
+	}
+	df.colBlk.mutex.RLock()
+	file := df.file[len(df.file)-1]
+	overflow := df.overflow
+	df.colBlk.mutex.RUnlock()
+	if overflow == nil || overflow.GetName() != largeValueFileName(file.GetName()) {
+		return
+	}
+	buf = make([]byte, overflow.GetFileSize())
+	if _, err = overflow.Read(buf); err != nil {
+		return nil, err
+	}
+	if codec := compress.T(overflow.GetAlgo()).Codec(); codec != compress.None {
+		data := make([]byte, overflow.GetOriginSize())
+		if buf, err = compress.Decompress(buf, data, int(codec)); err != nil {
+			return nil, err
+		}
+	}
+	return
+}
+
 func (df *dataFile) GetFileType() common.FileType {
 	return common.DiskFile
 }
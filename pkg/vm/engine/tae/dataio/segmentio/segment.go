@@ -80,7 +80,12 @@ func (sf *segmentFile) Replay(colCnt int, indexCnt map[int]int, cache *bytes.Buf
 	nodes := sf.seg.GetNodes()
 	sf.Lock()
 	defer sf.Unlock()
+	overflows := make(map[string]*segment.BlockFile)
 	for name, file := range nodes {
+		if strings.HasSuffix(name, largeValueSuffix) {
+			overflows[name] = file
+			continue
+		}
 		tmpName := strings.Split(name, ".blk")
 		fileName := strings.Split(tmpName[0], "_")
 		if len(fileName) < 2 {
@@ -115,6 +120,15 @@ func (sf *segmentFile) Replay(colCnt int, indexCnt map[int]int, cache *bytes.Buf
 		}
 
 	}
+	for _, bf := range sf.blocks {
+		for _, cb := range bf.columns {
+			if len(cb.data.file) == 0 {
+				continue
+			}
+			name := largeValueFileName(cb.data.file[len(cb.data.file)-1].GetName())
+			cb.data.overflow = overflows[name]
+		}
+	}
 	return nil
 }
 
@@ -16,6 +16,7 @@ package db
 
 import (
 	"bytes"
+	"fmt"
 	"math"
 	"sync"
 	"sync/atomic"
@@ -25,6 +26,8 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/dataio/mockio"
 
 	gbat "github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
@@ -2263,3 +2266,207 @@ func TestChaos1(t *testing.T) {
 	t.Log(view.DeleteMask.String())
 	t.Log(view.String())
 }
+
+func TestJsonPathFilter(t *testing.T) {
+	tae := initDB(t, nil)
+	defer tae.Close()
+	schema := catalog.NewEmptySchema("json")
+	schema.AppendCol("id", types.Type{Oid: types.T_int32, Size: 4, Width: 4})
+	schema.AppendCol("doc", types.Type{Oid: types.T_json, Size: 24})
+	schema.PrimaryKey = 0
+	schema.BlockMaxRows = 1000
+	schema.SegmentMaxBlocks = 10
+	assert.Error(t, schema.SetJsonPaths("id", "$.a"))
+	assert.Error(t, schema.SetJsonPaths("doc", "a"))
+	assert.NoError(t, schema.SetJsonPaths("doc", "$.a", "$.b[*]", "$.c"))
+
+	rows := 100
+	bat := gbat.New(true, schema.Attrs())
+	bat.Vecs[0] = compute.MockVec(schema.ColDefs[0].Type, rows, 0)
+	bat.Vecs[1] = vector.New(schema.ColDefs[1].Type)
+	docs := make([][]byte, rows)
+	for i := range docs {
+		bj, err := bytejson.ParseFromString(fmt.Sprintf(`{"a": %d, "b": ["x%d", "y"]}`, i, i))
+		assert.NoError(t, err)
+		docs[i] = bj.Marshal()
+	}
+	assert.NoError(t, vector.Append(bat.Vecs[1], docs))
+	{
+		txn, _ := tae.StartTxn(nil)
+		database, err := txn.CreateDatabase("db")
+		assert.Nil(t, err)
+		rel, err := database.CreateRelation(schema)
+		assert.Nil(t, err)
+		err = rel.Append(bat)
+		assert.Nil(t, err)
+		assert.Nil(t, txn.Commit())
+	}
+	worker := ops.NewOpWorker("xx")
+	worker.Start()
+	defer worker.Stop()
+	var newBlockFp *common.ID
+	{
+		txn, _ := tae.StartTxn(nil)
+		database, _ := txn.GetDatabase("db")
+		rel, _ := database.GetRelationByName(schema.Name)
+		blkMeta := rel.MakeBlockIt().GetBlock().GetMeta().(*catalog.BlockEntry)
+		task, err := jobs.NewCompactBlockTask(&tasks.Context{Waitable: true}, txn, blkMeta, tae.Scheduler)
+		assert.Nil(t, err)
+		worker.SendOp(task)
+		assert.Nil(t, task.WaitDone())
+		newBlockFp = task.GetNewBlock().Fingerprint()
+		assert.Nil(t, txn.Commit())
+	}
+
+	txn, _ := tae.StartTxn(nil)
+	database, _ := txn.GetDatabase("db")
+	rel, _ := database.GetRelationByName(schema.Name)
+	seg, _ := rel.GetSegment(newBlockFp.SegmentID)
+	blk, err := seg.GetBlock(newBlockFp.BlockID)
+	assert.Nil(t, err)
+	blkData := blk.GetMeta().(*catalog.BlockEntry).GetBlockData()
+	assert.False(t, blkData.IsAppendable())
+
+	mustParse := func(s string) bytejson.ByteJson {
+		bj, err := bytejson.ParseFromString(s)
+		assert.NoError(t, err)
+		return bj
+	}
+	cases := []struct {
+		path  int
+		value string
+		exist bool
+	}{
+		{0, `10`, true},
+		{0, `"10"`, false},
+		{0, `1000`, false},
+		{1, `"x99"`, true},
+		{1, `"y"`, true},
+		{1, `"z"`, false},
+		// no value is selected by $.c, the path has no filter
+		{2, `1`, true},
+	}
+	for _, c := range cases {
+		exist, err := blkData.MayContainJsonPathValue(1, c.path, mustParse(c.value))
+		assert.NoError(t, err)
+		assert.Equal(t, c.exist, exist, c.value)
+	}
+	assert.Nil(t, txn.Commit())
+}
+
+func TestBlockKeyRange(t *testing.T) {
+	tae := initDB(t, nil)
+	defer tae.Close()
+	schema := catalog.MockSchemaAll(4)
+	schema.PrimaryKey = 2
+	schema.BlockMaxRows = 1000
+	schema.SegmentMaxBlocks = 10
+	bat := compute.MockBatch(schema.Types(), 100, int(schema.PrimaryKey), nil)
+	{
+		txn, _ := tae.StartTxn(nil)
+		database, err := txn.CreateDatabase("db")
+		assert.Nil(t, err)
+		rel, err := database.CreateRelation(schema)
+		assert.Nil(t, err)
+		assert.Nil(t, rel.Append(bat))
+		assert.Nil(t, txn.Commit())
+	}
+	worker := ops.NewOpWorker("xx")
+	worker.Start()
+	defer worker.Stop()
+	var newBlockFp *common.ID
+	{
+		txn, _ := tae.StartTxn(nil)
+		database, _ := txn.GetDatabase("db")
+		rel, _ := database.GetRelationByName(schema.Name)
+		blkMeta := rel.MakeBlockIt().GetBlock().GetMeta().(*catalog.BlockEntry)
+		min, max, ok := blkMeta.GetBlockData().GetKeyRange()
+		assert.True(t, ok)
+		assert.Equal(t, int32(0), min)
+		assert.Equal(t, int32(99), max)
+		task, err := jobs.NewCompactBlockTask(&tasks.Context{Waitable: true}, txn, blkMeta, tae.Scheduler)
+		assert.Nil(t, err)
+		worker.SendOp(task)
+		assert.Nil(t, task.WaitDone())
+		newBlockFp = task.GetNewBlock().Fingerprint()
+		assert.Nil(t, txn.Commit())
+	}
+
+	txn, _ := tae.StartTxn(nil)
+	database, _ := txn.GetDatabase("db")
+	rel, _ := database.GetRelationByName(schema.Name)
+	seg, _ := rel.GetSegment(newBlockFp.SegmentID)
+	blk, err := seg.GetBlock(newBlockFp.BlockID)
+	assert.Nil(t, err)
+	blkData := blk.GetMeta().(*catalog.BlockEntry).GetBlockData()
+	assert.False(t, blkData.IsAppendable())
+	min, max, ok := blkData.GetKeyRange()
+	assert.True(t, ok)
+	assert.Equal(t, int32(0), min)
+	assert.Equal(t, int32(99), max)
+	size, originSize := blkData.GetDataSize()
+	assert.True(t, size > 0)
+	assert.True(t, originSize > 0)
+	assert.Nil(t, txn.Commit())
+}
+
+// Test Steps
+// 1. Create DB|Relation and append 10 rows. Commit
+// 2. Start Txn. Update a row, delete a row and append 10 rows
+// 3. The changes of the txn are the old values of the rows updated and deleted,
+//    and the new values of the rows updated and appended
+func TestTxnChanges(t *testing.T) {
+	tae := initDB(t, nil)
+	defer tae.Close()
+	schema := catalog.MockSchemaAll(3)
+	schema.PrimaryKey = 2
+	bat := catalog.MockData(schema, 20)
+	bats := compute.SplitBatch(bat, 2)
+
+	// Step 1
+	txn, _ := tae.StartTxn(nil)
+	db, _ := txn.CreateDatabase("db")
+	rel, _ := db.CreateRelation(schema)
+	assert.NoError(t, rel.Append(bats[0]))
+	assert.NoError(t, txn.Commit())
+
+	// Step 2
+	txn, _ = tae.StartTxn(nil)
+	db, _ = txn.GetDatabase("db")
+	rel, _ = db.GetRelationByName(schema.Name)
+	updated := compute.GetValue(bat.Vecs[schema.PrimaryKey], 2)
+	assert.NoError(t, rel.UpdateByFilter(handle.NewEQFilter(updated), 1, int16(222)))
+	deleted := compute.GetValue(bat.Vecs[schema.PrimaryKey], 3)
+	id, row, err := rel.GetByFilter(handle.NewEQFilter(deleted))
+	assert.NoError(t, err)
+	assert.NoError(t, rel.RangeDelete(id, row, row))
+	assert.NoError(t, rel.Append(bats[1]))
+
+	// Step 3
+	olds := make(map[any]any)
+	news := make(map[any]any)
+	err = txn.GetStore().ForEachChange(func(dbName, table string, bat *gbat.Batch, isDeleted bool) error {
+		assert.Equal(t, "db", dbName)
+		assert.Equal(t, schema.Name, table)
+		assert.Equal(t, schema.Attrs(), bat.Attrs)
+		rows := olds
+		if !isDeleted {
+			rows = news
+		}
+		for i := range bat.Zs {
+			rows[compute.GetValue(bat.Vecs[schema.PrimaryKey], uint32(i))] = compute.GetValue(bat.Vecs[1], uint32(i))
+		}
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, map[any]any{
+		updated: compute.GetValue(bat.Vecs[1], 2),
+		deleted: compute.GetValue(bat.Vecs[1], 3),
+	}, olds)
+	assert.Equal(t, 11, len(news))
+	assert.Equal(t, int16(222), news[updated])
+	for i := 10; i < 20; i++ {
+		assert.Equal(t, compute.GetValue(bat.Vecs[1], uint32(i)), news[compute.GetValue(bat.Vecs[schema.PrimaryKey], uint32(i))])
+	}
+	assert.NoError(t, txn.Commit())
+}
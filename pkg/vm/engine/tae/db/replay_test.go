@@ -169,6 +169,48 @@ func TestReplayCatalog2(t *testing.T) {
 	assert.Equal(t, tae.Catalog.GetCheckpointed(), c.GetCheckpointed())
 }
 
+// The properties of a table, such as the parameters of an external table, are replayed
+// from both the checkpoint of the catalog and the WAL
+func TestReplaySchemaProperties(t *testing.T) {
+	tae := initDB(t, nil)
+	schema := catalog.MockSchema(2)
+	schema.Properties = []catalog.Property{{Key: "k1", Value: "v1"}, {Key: "k2", Value: ""}}
+	schema2 := catalog.MockSchema(2)
+	schema2.Properties = []catalog.Property{{Key: "k3", Value: "v3"}}
+
+	txn, _ := tae.StartTxn(nil)
+	db, err := txn.CreateDatabase("db")
+	assert.Nil(t, err)
+	_, err = db.CreateRelation(schema)
+	assert.Nil(t, err)
+	assert.Nil(t, txn.Commit())
+	err = tae.Catalog.Checkpoint(tae.Scheduler.GetSafeTS())
+	assert.Nil(t, err)
+
+	txn, _ = tae.StartTxn(nil)
+	db, err = txn.GetDatabase("db")
+	assert.Nil(t, err)
+	_, err = db.CreateRelation(schema2)
+	assert.Nil(t, err)
+	assert.Nil(t, txn.Commit())
+	tae.Close()
+
+	tae2, err := Open(tae.Dir, nil)
+	assert.Nil(t, err)
+	defer tae2.Close()
+
+	txn, _ = tae2.StartTxn(nil)
+	db, err = txn.GetDatabase("db")
+	assert.Nil(t, err)
+	for _, expected := range []*catalog.Schema{schema, schema2} {
+		rel, err := db.GetRelationByName(expected.Name)
+		assert.Nil(t, err)
+		replayed := rel.GetMeta().(*catalog.TableEntry).GetSchema()
+		assert.Equal(t, expected.Properties, replayed.Properties)
+	}
+	assert.Nil(t, txn.Commit())
+}
+
 func TestReplayCatalog3(t *testing.T) {
 	tae := initDB(t, nil)
 	schema := catalog.MockSchema(2)
@@ -20,6 +20,7 @@ import (
 
 	"github.com/RoaringBitmap/roaring"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/buffer/base"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
@@ -59,6 +60,7 @@ type Block interface {
 	Rows(txn txnif.AsyncTxn, coarse bool) int
 	GetColumnDataByName(txn txnif.AsyncTxn, attr string, compressed, decompressed *bytes.Buffer) (*model.ColumnView, error)
 	GetColumnDataById(txn txnif.AsyncTxn, colIdx int, compressed, decompressed *bytes.Buffer) (*model.ColumnView, error)
+	GetLazyColumnDataById(txn txnif.AsyncTxn, colIdx int, compressed, decompressed *bytes.Buffer) (*model.ColumnView, error)
 	GetMeta() any
 	GetBufMgr() base.INodeManager
 
@@ -71,6 +73,14 @@ type Block interface {
 	CollectAppendLogIndexes(startTs, endTs uint64) ([]*wal.Index, error)
 
 	BatchDedup(txn txnif.AsyncTxn, pks *vector.Vector, rowmask *roaring.Bitmap) error
+	// MayContainJsonPathValue returns false if no value selected by the pathIdx-th path of
+	// the json column equals to v, so the block can be skipped by a point query on the path
+	MayContainJsonPathValue(colIdx, pathIdx int, v bytejson.ByteJson) (bool, error)
+	// GetKeyRange returns the min and max primary key of the block, ok is false if the
+	// block has no indexed key
+	GetKeyRange() (min, max any, ok bool)
+	// GetDataSize returns the size of the column files of the block and the size before compression
+	GetDataSize() (size, originSize int64)
 	GetByFilter(txn txnif.AsyncTxn, filter *handle.Filter) (uint32, error)
 	GetValue(txn txnif.AsyncTxn, row uint32, col uint16) (any, error)
 	PPString(level common.PPLevel, depth int, prefix string) string
@@ -18,6 +18,7 @@ import (
 	"io"
 
 	"github.com/RoaringBitmap/roaring"
+	"github.com/matrixorigin/matrixone/pkg/compress"
 	gbat "github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	gvec "github.com/matrixorigin/matrixone/pkg/container/vector"
@@ -50,6 +51,8 @@ type Block interface {
 	WriteBatch(bat *gbat.Batch, ts uint64) error
 	LoadBatch(attrs []string, colTypes []types.Type) (bat *gbat.Batch, err error)
 	WriteColumnVec(ts uint64, colIdx int, vec *gvec.Vector) error
+	// SetColumnAlgos sets the compression of the columns written later
+	SetColumnAlgos(algos []compress.T)
 
 	Destroy() error
 }
@@ -59,6 +59,9 @@ type BlockReader interface {
 	GetByFilter(filter *Filter) (uint32, error)
 	GetColumnDataByName(string, *bytes.Buffer, *bytes.Buffer) (*model.ColumnView, error)
 	GetColumnDataById(int, *bytes.Buffer, *bytes.Buffer) (*model.ColumnView, error)
+	// GetLazyColumnDataByName leaves the large values of the column empty
+	// until ColumnView.FillLargeValues
+	GetLazyColumnDataByName(string, *bytes.Buffer, *bytes.Buffer) (*model.ColumnView, error)
 	GetMeta() any
 	Fingerprint() *common.ID
 	Rows() int
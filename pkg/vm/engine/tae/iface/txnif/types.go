@@ -165,6 +165,11 @@ type UpdateNode interface {
 	UpdateLocked(row uint32, v any) error
 }
 
+// TxnChangeFn is called with the rows of a table changed by a transaction, the batch holds
+// the whole rows in the order of the table's columns. The deleted rows have the values they
+// had before the transaction, an updated row is both deleted and appended.
+type TxnChangeFn = func(db, table string, bat *batch.Batch, deleted bool) error
+
 type TxnStore interface {
 	Txn2PC
 	io.Closer
@@ -205,6 +210,10 @@ type TxnStore interface {
 
 	IsReadonly() bool
 	IncreateWriteCnt() int
+	GetWriteCnt() int
+
+	// ForEachChange calls fn with the rows changed by the transaction, a block at a time
+	ForEachChange(fn TxnChangeFn) error
 }
 
 type TxnEntryType int16
@@ -0,0 +1,60 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package index
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+)
+
+// JsonPathKeyType is the type of the keys extracted from a json column
+var JsonPathKeyType = types.Type{Oid: types.T_varchar, Size: 24}
+
+// ExtractJsonPathKeys collects all the values selected by the path from a json column
+// in the binary format, which are the keys of the path's static filter. Null rows and
+// the rows without any selected value contribute nothing and duplicated keys are kept
+// only once.
+func ExtractJsonPathKeys(data *vector.Vector, path bytejson.Path) (*vector.Vector, error) {
+	if data.Typ.Oid != types.T_json {
+		return nil, ErrWrongType
+	}
+	col := data.Col.(*types.Bytes)
+	keys := make([][]byte, 0, len(col.Offsets))
+	seen := make(map[string]struct{})
+	for i := range col.Offsets {
+		if nulls.Contains(data.Nsp, uint64(i)) {
+			continue
+		}
+		bj, err := bytejson.Unmarshal(col.Get(int64(i)))
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range bj.Query(path) {
+			key := v.Marshal()
+			if _, ok := seen[string(key)]; ok {
+				continue
+			}
+			seen[string(key)] = struct{}{}
+			keys = append(keys, key)
+		}
+	}
+	vec := vector.New(JsonPathKeyType)
+	if err := vector.Append(vec, keys); err != nil {
+		return nil, err
+	}
+	return vec, nil
+}
@@ -65,6 +65,10 @@ func (zm *ZoneMap) BatchUpdate(KeysCtx *KeysCtx) error {
 	if !zm.typ.Eq(KeysCtx.Keys.Typ) {
 		return ErrWrongType
 	}
+	// json values have no order to build a zonemap on
+	if zm.typ.Oid == types.T_json {
+		return ErrWrongType
+	}
 	update := func(v any, _ uint32) error {
 		return zm.Update(v)
 	}
@@ -211,7 +215,7 @@ func (zm *ZoneMap) Marshal() (buf []byte, err error) {
 		}
 		buf = w.Bytes()
 		return
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		if _, err = w.Write(encoding.EncodeUint16(zm.min.(uint16))); err != nil {
 			return
 		}
@@ -229,7 +233,7 @@ func (zm *ZoneMap) Marshal() (buf []byte, err error) {
 		}
 		buf = w.Bytes()
 		return
-	case types.T_uint64:
+	case types.T_uint64, types.T_set:
 		if _, err = w.Write(encoding.EncodeUint64(zm.min.(uint64))); err != nil {
 			return
 		}
@@ -274,7 +278,16 @@ func (zm *ZoneMap) Marshal() (buf []byte, err error) {
 		}
 		buf = w.Bytes()
 		return
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_uuid:
+		if _, err = w.Write(encoding.EncodeUuid(zm.min.(types.Uuid))); err != nil {
+			return
+		}
+		if _, err = w.Write(encoding.EncodeUuid(zm.max.(types.Uuid))); err != nil {
+			return
+		}
+		buf = w.Bytes()
+		return
+	case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 		minv := zm.min.([]byte)
 		maxv := zm.max.([]byte)
 		if _, err = w.Write(encoding.EncodeInt16(int16(len(minv)))); err != nil {
@@ -331,7 +344,7 @@ func (zm *ZoneMap) Unmarshal(buf []byte) error {
 		buf = buf[1:]
 		zm.max = encoding.DecodeUint8(buf[:1])
 		return nil
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		zm.min = encoding.DecodeUint16(buf[:2])
 		buf = buf[2:]
 		zm.max = encoding.DecodeUint16(buf[:2])
@@ -341,7 +354,7 @@ func (zm *ZoneMap) Unmarshal(buf []byte) error {
 		buf = buf[4:]
 		zm.max = encoding.DecodeUint32(buf[:4])
 		return nil
-	case types.T_uint64:
+	case types.T_uint64, types.T_set:
 		zm.min = encoding.DecodeUint64(buf[:8])
 		buf = buf[8:]
 		zm.max = encoding.DecodeUint64(buf[:8])
@@ -368,7 +381,13 @@ func (zm *ZoneMap) Unmarshal(buf []byte) error {
 		zm.max = encoding.DecodeDatetime(buf[:8])
 		buf = buf[8:]
 		return nil
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_uuid:
+		zm.min = encoding.DecodeUuid(buf[:16])
+		buf = buf[16:]
+		zm.max = encoding.DecodeUuid(buf[:16])
+		buf = buf[16:]
+		return nil
+	case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 		lenminv := encoding.DecodeInt16(buf[:2])
 		buf = buf[2:]
 		minBuf := make([]byte, int(lenminv))
@@ -53,6 +53,13 @@ func (b *BlockFile) GetAlgo() uint8 {
 	return b.snode.algo
 }
 
+// SetAlgo sets the compression of the data appended later.
+func (b *BlockFile) SetAlgo(algo uint8) {
+	b.snode.mutex.Lock()
+	defer b.snode.mutex.Unlock()
+	b.snode.algo = algo
+}
+
 func (b *BlockFile) GetName() string {
 	return b.name
 }
@@ -19,7 +19,6 @@ import (
 	"encoding/binary"
 	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
-	"github.com/pierrec/lz4"
 	"os"
 	"sync"
 )
@@ -206,10 +205,9 @@ func (s *Segment) NewBlockFile(fname string) *BlockFile {
 
 func (s *Segment) Append(fd *BlockFile, pl []byte) (err error) {
 	buf := pl
-	if fd.snode.algo == compress.Lz4 {
-		colSize := len(pl)
-		buf = make([]byte, lz4.CompressBlockBound(colSize))
-		if buf, err = compress.Compress(pl, buf, compress.Lz4); err != nil {
+	if codec := int(compress.T(fd.GetAlgo()).Codec()); codec != compress.None {
+		buf = make([]byte, compress.CompressBound(len(pl), codec))
+		if buf, err = compress.Compress(pl, buf, codec); err != nil {
 			return err
 		}
 	}
@@ -25,11 +25,14 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/mergesort/int32s"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/mergesort/int64s"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/mergesort/int8s"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/mergesort/times"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/mergesort/uint16s"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/mergesort/uint32s"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/mergesort/uint64s"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/mergesort/uint8s"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/mergesort/uuids"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/mergesort/varchar"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/mergesort/years"
 )
 
 func SortBlockColumns(cols []*vector.Vector, pk int) error {
@@ -46,11 +49,11 @@ func SortBlockColumns(cols []*vector.Vector, pk int) error {
 		int64s.Sort(cols[pk], sortedIdx)
 	case types.T_uint8:
 		uint8s.Sort(cols[pk], sortedIdx)
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		uint16s.Sort(cols[pk], sortedIdx)
 	case types.T_uint32:
 		uint32s.Sort(cols[pk], sortedIdx)
-	case types.T_uint64:
+	case types.T_uint64, types.T_set:
 		uint64s.Sort(cols[pk], sortedIdx)
 	case types.T_float32:
 		float32s.Sort(cols[pk], sortedIdx)
@@ -60,7 +63,13 @@ func SortBlockColumns(cols []*vector.Vector, pk int) error {
 		dates.Sort(cols[pk], sortedIdx)
 	case types.T_datetime:
 		datetimes.Sort(cols[pk], sortedIdx)
-	case types.T_char, types.T_json, types.T_varchar:
+	case types.T_time:
+		times.Sort(cols[pk], sortedIdx)
+	case types.T_year:
+		years.Sort(cols[pk], sortedIdx)
+	case types.T_uuid:
+		uuids.Sort(cols[pk], sortedIdx)
+	case types.T_char, types.T_json, types.T_varchar, types.T_text, types.T_blob:
 		varchar.Sort(cols[pk], sortedIdx)
 	}
 
@@ -79,11 +88,11 @@ func SortBlockColumns(cols []*vector.Vector, pk int) error {
 			int64s.Shuffle(cols[i], sortedIdx)
 		case types.T_uint8:
 			uint8s.Shuffle(cols[i], sortedIdx)
-		case types.T_uint16:
+		case types.T_uint16, types.T_enum:
 			uint16s.Shuffle(cols[i], sortedIdx)
 		case types.T_uint32:
 			uint32s.Shuffle(cols[i], sortedIdx)
-		case types.T_uint64:
+		case types.T_uint64, types.T_set:
 			uint64s.Shuffle(cols[i], sortedIdx)
 		case types.T_float32:
 			float32s.Shuffle(cols[i], sortedIdx)
@@ -93,7 +102,13 @@ func SortBlockColumns(cols []*vector.Vector, pk int) error {
 			dates.Shuffle(cols[i], sortedIdx)
 		case types.T_datetime:
 			datetimes.Shuffle(cols[i], sortedIdx)
-		case types.T_char, types.T_json, types.T_varchar:
+		case types.T_time:
+			times.Shuffle(cols[i], sortedIdx)
+		case types.T_year:
+			years.Shuffle(cols[i], sortedIdx)
+		case types.T_uuid:
+			uuids.Shuffle(cols[i], sortedIdx)
+		case types.T_char, types.T_json, types.T_varchar, types.T_text, types.T_blob:
 			varchar.Shuffle(cols[i], sortedIdx)
 		}
 	}
@@ -113,11 +128,11 @@ func MergeSortedColumn(column []*vector.Vector, sortedIdx *[]uint32, fromLayout,
 		ret, mapping = int64s.Merge(column, sortedIdx, fromLayout, toLayout)
 	case types.T_uint8:
 		ret, mapping = uint8s.Merge(column, sortedIdx, fromLayout, toLayout)
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		ret, mapping = uint16s.Merge(column, sortedIdx, fromLayout, toLayout)
 	case types.T_uint32:
 		ret, mapping = uint32s.Merge(column, sortedIdx, fromLayout, toLayout)
-	case types.T_uint64:
+	case types.T_uint64, types.T_set:
 		ret, mapping = uint64s.Merge(column, sortedIdx, fromLayout, toLayout)
 	case types.T_float32:
 		ret, mapping = float32s.Merge(column, sortedIdx, fromLayout, toLayout)
@@ -127,7 +142,13 @@ func MergeSortedColumn(column []*vector.Vector, sortedIdx *[]uint32, fromLayout,
 		ret, mapping = dates.Merge(column, sortedIdx, fromLayout, toLayout)
 	case types.T_datetime:
 		ret, mapping = datetimes.Merge(column, sortedIdx, fromLayout, toLayout)
-	case types.T_char, types.T_json, types.T_varchar:
+	case types.T_time:
+		ret, mapping = times.Merge(column, sortedIdx, fromLayout, toLayout)
+	case types.T_year:
+		ret, mapping = years.Merge(column, sortedIdx, fromLayout, toLayout)
+	case types.T_uuid:
+		ret, mapping = uuids.Merge(column, sortedIdx, fromLayout, toLayout)
+	case types.T_char, types.T_json, types.T_varchar, types.T_text, types.T_blob:
 		ret, mapping = varchar.Merge(column, sortedIdx, fromLayout, toLayout)
 	}
 	return
@@ -145,11 +166,11 @@ func ShuffleColumn(column []*vector.Vector, sortedIdx []uint32, fromLayout, toLa
 		ret = int64s.Multiplex(column, sortedIdx, fromLayout, toLayout)
 	case types.T_uint8:
 		ret = uint8s.Multiplex(column, sortedIdx, fromLayout, toLayout)
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		ret = uint16s.Multiplex(column, sortedIdx, fromLayout, toLayout)
 	case types.T_uint32:
 		ret = uint32s.Multiplex(column, sortedIdx, fromLayout, toLayout)
-	case types.T_uint64:
+	case types.T_uint64, types.T_set:
 		ret = uint64s.Multiplex(column, sortedIdx, fromLayout, toLayout)
 	case types.T_float32:
 		ret = float32s.Multiplex(column, sortedIdx, fromLayout, toLayout)
@@ -159,7 +180,13 @@ func ShuffleColumn(column []*vector.Vector, sortedIdx []uint32, fromLayout, toLa
 		ret = dates.Multiplex(column, sortedIdx, fromLayout, toLayout)
 	case types.T_datetime:
 		ret = datetimes.Multiplex(column, sortedIdx, fromLayout, toLayout)
-	case types.T_char, types.T_json, types.T_varchar:
+	case types.T_time:
+		ret = times.Multiplex(column, sortedIdx, fromLayout, toLayout)
+	case types.T_year:
+		ret = years.Multiplex(column, sortedIdx, fromLayout, toLayout)
+	case types.T_uuid:
+		ret = uuids.Multiplex(column, sortedIdx, fromLayout, toLayout)
+	case types.T_char, types.T_json, types.T_varchar, types.T_text, types.T_blob:
 		ret = varchar.Multiplex(column, sortedIdx, fromLayout, toLayout)
 	}
 	return
@@ -199,7 +226,7 @@ func ShuffleColumn(column []*vector.Vector, sortedIdx []uint32, fromLayout, toLa
 //		dates.Merge(col, mergedSrc)
 //	case types.T_datetime:
 //		datetimes.Merge(col, mergedSrc)
-//	case types.T_char, types.T_json, types.T_varchar:
+//	case types.T_char, types.T_json, types.T_varchar, types.T_text, types.T_blob:
 //		varchar.Merge(col, mergedSrc)
 //	}
 //
@@ -236,7 +263,7 @@ func ShuffleColumn(column []*vector.Vector, sortedIdx []uint32, fromLayout, toLa
 //			dates.Multiplex(col, mergedSrc)
 //		case types.T_datetime:
 //			datetimes.Multiplex(col, mergedSrc)
-//		case types.T_char, types.T_json, types.T_varchar:
+//		case types.T_char, types.T_json, types.T_varchar, types.T_text, types.T_blob:
 //			varchar.Multiplex(col, mergedSrc)
 //		}
 //	}
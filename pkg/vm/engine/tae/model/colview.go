@@ -33,6 +33,13 @@ type ColumnView struct {
 	AppliedVec  *movec.Vector
 	AppliedIVec vector.IVector
 	MemNode     *common.MemNode
+	// LargeValues keeps the values of the column moved out of line, which
+	// are left empty in AppliedVec until FillLargeValues
+	LargeValues common.ILargeValueFile
+	// largeValueRows are the rows of the column data AppliedVec keeps, nil means all
+	largeValueRows []uint32
+	// updatedRows are the rows replaced by the updates
+	updatedRows *roaring.Bitmap
 }
 
 func NewColumnView(ts uint64, colIdx int) *ColumnView {
@@ -43,10 +50,58 @@ func NewColumnView(ts uint64, colIdx int) *ColumnView {
 }
 
 func (view *ColumnView) ApplyDeletes() *movec.Vector {
+	if view.LargeValues != nil && view.DeleteMask != nil && !view.DeleteMask.IsEmpty() {
+		rows := make([]uint32, 0, view.Length())
+		for i := 0; i < view.Length(); i++ {
+			if !view.DeleteMask.Contains(uint32(i)) {
+				rows = append(rows, view.largeValueRow(i))
+			}
+		}
+		view.largeValueRows = rows
+	}
 	view.AppliedVec = compute.ApplyDeleteToVector(view.AppliedVec, view.DeleteMask)
 	return view.AppliedVec
 }
 
+// ShrinkLargeValues keeps the large values of the rows of sels
+// after AppliedVec is shrunk by sels.
+func (view *ColumnView) ShrinkLargeValues(sels []int64) {
+	if view.LargeValues == nil {
+		return
+	}
+	rows := make([]uint32, len(sels))
+	for i, sel := range sels {
+		rows[i] = view.largeValueRow(int(sel))
+	}
+	view.largeValueRows = rows
+}
+
+// FillLargeValues reads the values of the column moved out of line
+// and puts them into AppliedVec.
+func (view *ColumnView) FillLargeValues() error {
+	if view.LargeValues == nil {
+		return nil
+	}
+	ovf, err := view.LargeValues.ReadLargeValues()
+	if err != nil {
+		return err
+	}
+	if err = vector.FillLargeValues(view.AppliedVec, ovf, view.largeValueRows, view.updatedRows); err != nil {
+		return err
+	}
+	view.LargeValues = nil
+	view.largeValueRows = nil
+	view.updatedRows = nil
+	return nil
+}
+
+func (view *ColumnView) largeValueRow(i int) uint32 {
+	if view.largeValueRows == nil {
+		return uint32(i)
+	}
+	return view.largeValueRows[i]
+}
+
 func (view *ColumnView) Eval(clear bool) error {
 	if view.RawIVec != nil {
 		view.AppliedIVec = compute.ApplyUpdateToIVector(view.RawIVec, view.UpdateMask, view.UpdateVals)
@@ -59,6 +114,9 @@ func (view *ColumnView) Eval(clear bool) error {
 	}
 
 	view.AppliedVec = compute.ApplyUpdateToVector(view.RawVec, view.UpdateMask, view.UpdateVals)
+	if view.LargeValues != nil {
+		view.updatedRows = view.UpdateMask
+	}
 	if clear {
 		view.RawVec = nil
 		view.UpdateMask = nil
@@ -95,4 +153,7 @@ func (view *ColumnView) Free() {
 	view.DeleteMask = nil
 	view.AppliedVec = nil
 	view.AppliedIVec = nil
+	view.LargeValues = nil
+	view.largeValueRows = nil
+	view.updatedRows = nil
 }
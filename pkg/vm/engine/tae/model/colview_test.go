@@ -0,0 +1,71 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/RoaringBitmap/roaring"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	movec "github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/vector"
+	"github.com/stretchr/testify/assert"
+)
+
+type largeValueFile []byte
+
+func (f largeValueFile) ReadLargeValues() ([]byte, error) { return f, nil }
+
+func TestColumnViewLargeValues(t *testing.T) {
+	vs := [][]byte{
+		[]byte("small"),
+		bytes.Repeat([]byte("a"), 100),
+		bytes.Repeat([]byte("b"), 64),
+		[]byte("tiny"),
+		bytes.Repeat([]byte("c"), 80),
+		[]byte("short"),
+	}
+	typ := types.Type{Oid: types.T_text, Size: 24}
+	vec := movec.New(typ)
+	assert.Nil(t, movec.Append(vec, vs))
+	data, err := vec.Show()
+	assert.Nil(t, err)
+	inline, ovf, err := vector.SplitLargeValues(data, 32)
+	assert.Nil(t, err)
+
+	view := NewColumnView(1, 0)
+	view.RawVec = movec.New(typ)
+	assert.Nil(t, view.RawVec.Read(inline))
+	view.LargeValues = largeValueFile(ovf)
+	view.UpdateMask = roaring.BitmapOf(4)
+	view.UpdateVals = map[uint32]any{4: []byte("updated")}
+	assert.Nil(t, view.Eval(true))
+	view.DeleteMask = roaring.BitmapOf(0)
+	view.ApplyDeletes()
+	// the rows 1, 2 and 4 are kept by a filter
+	sels := []int64{0, 1, 3}
+	movec.Shrink(view.AppliedVec, sels)
+	view.ShrinkLargeValues(sels)
+	assert.Equal(t, 0, len(view.AppliedVec.Col.(*types.Bytes).Get(0)))
+
+	assert.Nil(t, view.FillLargeValues())
+	col := view.AppliedVec.Col.(*types.Bytes)
+	assert.Equal(t, 3, len(col.Lengths))
+	assert.Equal(t, vs[1], col.Get(0))
+	assert.Equal(t, vs[2], col.Get(1))
+	assert.Equal(t, []byte("updated"), col.Get(2))
+	assert.Nil(t, view.LargeValues)
+}
@@ -30,18 +30,43 @@ func newBlock(h handle.Block) *txnBlock {
 }
 
 func (blk *txnBlock) Read(cs []uint64, attrs []string, compressed []*bytes.Buffer, deCompressed []*bytes.Buffer) (*batch.Batch, error) {
-	var view *model.ColumnView
-	var err error
+	bat, views, err := blk.read(cs, attrs, compressed, deCompressed)
+	if err != nil {
+		return nil, err
+	}
+	if err = fillLargeValues(views); err != nil {
+		return nil, err
+	}
+	return bat, nil
+}
+
+// read reads the columns of the block without the large values kept out of
+// line, which are filled by fillLargeValues after the rows are filtered.
+func (blk *txnBlock) read(cs []uint64, attrs []string, compressed []*bytes.Buffer, deCompressed []*bytes.Buffer) (*batch.Batch, []*model.ColumnView, error) {
 	bat := batch.New(true, attrs)
 	bat.Vecs = make([]*vector.Vector, len(attrs))
+	views := make([]*model.ColumnView, len(attrs))
 	for i, attr := range attrs {
-		view, err = blk.handle.GetColumnDataByName(attr, compressed[i], deCompressed[i])
+		view, err := blk.handle.GetLazyColumnDataByName(attr, compressed[i], deCompressed[i])
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		view.ApplyDeletes()
 		view.AppliedVec.Ref = cs[i]
 		bat.Vecs[i] = view.AppliedVec
+		views[i] = view
 	}
-	return bat, nil
+	if len(bat.Vecs) > 0 {
+		bat.InitZsOne(vector.Length(bat.Vecs[0]))
+	}
+	return bat, views, nil
+}
+
+func fillLargeValues(views []*model.ColumnView) error {
+	for _, view := range views {
+		if err := view.FillLargeValues(); err != nil {
+			return err
+		}
+	}
+	return nil
 }
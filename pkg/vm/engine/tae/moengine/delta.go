@@ -0,0 +1,179 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package moengine
+
+import (
+	"errors"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+)
+
+var (
+	ErrNoViewMaintainer    = errors.New("tae: the materialized views can not be maintained without a maintainer")
+	ErrNoConstraintChecker = errors.New("tae: the foreign keys can not be checked without a checker")
+)
+
+// deltaLimit is the memory the rows changed by a transaction may take while the foreign keys
+// are checked and the materialized views are maintained
+const deltaLimit = 1 << 30
+
+// Commit checks the foreign keys and maintains the materialized views of the tables changed by
+// the transaction, and then commits the transaction with the views and the actions of the keys.
+func (txn *txnHandle) Commit() error {
+	if err := txn.applyDeltas(); err != nil {
+		_ = txn.AsyncTxn.Rollback()
+		return err
+	}
+	return txn.AsyncTxn.Commit()
+}
+
+// applyDeltas checks the deltas until the actions of the foreign keys change no more rows,
+// the views are maintained at last by all the deltas of the transaction.
+func (txn *txnHandle) applyDeltas() error {
+	store := txn.GetStore()
+	if store.IsReadonly() {
+		return nil
+	}
+	mp := mheap.New(guest.New(deltaLimit, host.New(deltaLimit)))
+	var ds *txnDeltas
+	defer func() {
+		ds.free(mp)
+	}()
+	for {
+		writes := store.GetWriteCnt()
+		ds.free(mp)
+		var err error
+		if ds, err = txn.collectDeltas(mp); err != nil {
+			return err
+		}
+		if !ds.constraints {
+			break
+		}
+		if txn.e.checker == nil {
+			return ErrNoConstraintChecker
+		}
+		if err = txn.e.checker(txn.e, txn.GetCtx(), txn.GetStartTS(), mp, ds.deltas); err != nil {
+			return err
+		}
+		if store.GetWriteCnt() == writes {
+			break
+		}
+	}
+	if !ds.views {
+		return nil
+	}
+	if txn.e.maintainer == nil {
+		return ErrNoViewMaintainer
+	}
+	return txn.e.maintainer(txn.e, txn.GetCtx(), ds.deltas)
+}
+
+// collectDeltas copies the rows changed by the transaction in the tables with materialized
+// views or foreign keys into the memory of the transaction
+func (txn *txnHandle) collectDeltas(mp *mheap.Mheap) (*txnDeltas, error) {
+	ds := &txnDeltas{}
+	var uses map[string]*deltaUse
+	err := txn.GetStore().ForEachChange(func(db, table string, bat *batch.Batch, deleted bool) (err error) {
+		if uses == nil {
+			if uses, err = txn.deltaUses(); err != nil {
+				return err
+			}
+		}
+		use := uses[db+"."+table]
+		if use == nil {
+			return nil
+		}
+		delta := &engine.Delta{
+			Database:    db,
+			Table:       table,
+			Bat:         batch.New(true, bat.Attrs),
+			Deleted:     deleted,
+			Referencing: use.referencing,
+		}
+		ds.deltas = append(ds.deltas, delta)
+		for i, vec := range bat.Vecs {
+			dup, err := vector.Dup(vec, mp)
+			if err != nil {
+				return err
+			}
+			delta.Bat.Vecs[i] = dup
+		}
+		delta.Bat.Zs = bat.Zs
+		ds.views = ds.views || use.views
+		ds.constraints = ds.constraints || use.constraints
+		return nil
+	})
+	return ds, err
+}
+
+// deltaUses returns how the rows changed in the tables are used, keyed by database.table. The
+// tables referencing a table by their foreign keys are found by the catalog, so that the foreign
+// keys referencing the rows deleted are found without reading the definitions of the tables.
+func (txn *txnHandle) deltaUses() (map[string]*deltaUse, error) {
+	uses := make(map[string]*deltaUse)
+	use := func(key string) *deltaUse {
+		u, ok := uses[key]
+		if !ok {
+			u = &deltaUse{}
+			uses[key] = u
+		}
+		return u
+	}
+	for _, name := range txn.DatabaseNames() {
+		db, err := txn.GetDatabase(name)
+		if err != nil {
+			return nil, err
+		}
+		it := db.MakeRelationIt()
+		for it.Valid() {
+			schema := it.GetRelation().GetMeta().(*catalog.TableEntry).GetSchema()
+			key := name + "." + schema.Name
+			for _, property := range schema.Properties {
+				switch property.Key {
+				case engine.ForeignKeyProperty:
+					use(key).constraints = true
+				case engine.MaterializedViewSourceProperty:
+					use(name + "." + property.Value).views = true
+				case engine.ForeignKeyReferenceProperty:
+					u := use(property.Value)
+					u.constraints = true
+					u.referencing = append(u.referencing, key)
+				}
+			}
+			it.Next()
+		}
+	}
+	return uses, nil
+}
+
+// free releases the memory of the deltas
+func (ds *txnDeltas) free(mp *mheap.Mheap) {
+	if ds == nil {
+		return
+	}
+	for _, delta := range ds.deltas {
+		for _, vec := range delta.Bat.Vecs {
+			if vec != nil {
+				vector.Clean(vec, mp)
+			}
+		}
+	}
+}
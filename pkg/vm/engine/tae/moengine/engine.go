@@ -32,6 +32,16 @@ func NewEngine(impl *db.DB) *txnEngine {
 	}
 }
 
+// SetViewMaintainer sets the function maintaining the materialized views
+func (e *txnEngine) SetViewMaintainer(maintainer engine.ViewMaintainer) {
+	e.maintainer = maintainer
+}
+
+// SetConstraintChecker sets the function checking the foreign keys
+func (e *txnEngine) SetConstraintChecker(checker engine.ConstraintChecker) {
+	e.checker = checker
+}
+
 func (e *txnEngine) Delete(_ uint64, name string, ctx engine.Snapshot) (err error) {
 	var txn txnif.AsyncTxn
 	if txn, err = e.impl.GetTxnByCtx(ctx); err != nil {
@@ -77,5 +87,12 @@ func (e *txnEngine) Node(ip string, _ engine.Snapshot) *engine.NodeInfo {
 }
 
 func (e *txnEngine) StartTxn(info []byte) (txn Txn, err error) {
-	return e.impl.StartTxn(info)
+	impl, err := e.impl.StartTxn(info)
+	if err != nil {
+		return nil, err
+	}
+	return &txnHandle{
+		AsyncTxn: impl,
+		e:        e,
+	}, nil
 }
@@ -1,11 +1,14 @@
 package moengine
 
 import (
+	"bytes"
 	"fmt"
 	"testing"
 	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/common/helper"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/adaptor"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
@@ -13,6 +16,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/compute"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/dataio/mockio"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/options"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/testutils"
 	"github.com/stretchr/testify/assert"
@@ -75,3 +79,294 @@ func TestEngine(t *testing.T) {
 	}
 	t.Log(tae.Catalog.SimplePPString(common.PPL1))
 }
+
+func TestUpdateAndDelete(t *testing.T) {
+	tae := initDB(t, nil)
+	defer tae.Close()
+	e := NewEngine(tae)
+	txn, err := e.StartTxn(nil)
+	assert.Nil(t, err)
+	err = e.Create(0, "db", 0, txn.GetCtx())
+	assert.Nil(t, err)
+	dbase, err := e.Database("db", txn.GetCtx())
+	assert.Nil(t, err)
+	mockTbl := adaptor.MockTableInfo(4)
+	_, _, _, _, defs, _ := helper.UnTransfer(*mockTbl)
+	err = dbase.Create(0, mockTbl.Name, defs, txn.GetCtx())
+	assert.Nil(t, err)
+	rel, err := dbase.Relation(mockTbl.Name, txn.GetCtx())
+	assert.Nil(t, err)
+	schema := rel.(*txnRelation).handle.GetMeta().(*catalog.TableEntry).GetSchema()
+	bat := compute.MockBatch(schema.Types(), 20, int(schema.PrimaryKey), nil)
+	assert.Nil(t, rel.Write(0, bat, txn.GetCtx()))
+	assert.Nil(t, txn.Commit())
+
+	txn, err = e.StartTxn(nil)
+	assert.Nil(t, err)
+	dbase, err = e.Database("db", txn.GetCtx())
+	assert.Nil(t, err)
+	rel, err = dbase.Relation(mockTbl.Name, txn.GetCtx())
+	assert.Nil(t, err)
+	h := rel.(*txnRelation).handle
+
+	// rows with duplicated primary keys can only be replaced by Update
+	bat = compute.MockBatch(schema.Types(), 5, int(schema.PrimaryKey), nil)
+	assert.NotNil(t, rel.Write(0, bat, txn.GetCtx()))
+	assert.Nil(t, rel.Update(0, bat, txn.GetCtx()))
+
+	pkName := schema.ColDefs[schema.PrimaryKey].Name
+	vec := vector.New(schema.ColDefs[schema.PrimaryKey].Type)
+	assert.Nil(t, vector.Append(vec, []int32{1, 3}))
+	assert.Equal(t, engine.ErrNotSupported, rel.Delete(0, vec, schema.ColDefs[1].Name, txn.GetCtx()))
+	assert.Nil(t, rel.Delete(0, vec, pkName, txn.GetCtx()))
+	_, _, err = h.GetByFilter(handle.NewEQFilter(int32(1)))
+	assert.NotNil(t, err)
+	_, _, err = h.GetByFilter(handle.NewEQFilter(int32(2)))
+	assert.Nil(t, err)
+	assert.NotNil(t, rel.Delete(0, vec, pkName, txn.GetCtx()))
+	assert.Nil(t, txn.Commit())
+}
+
+func TestTableProperties(t *testing.T) {
+	tae := initDB(t, nil)
+	defer tae.Close()
+	e := NewEngine(tae)
+	txn, err := e.StartTxn(nil)
+	assert.Nil(t, err)
+	err = e.Create(0, "db", 0, txn.GetCtx())
+	assert.Nil(t, err)
+	dbase, err := e.Database("db", txn.GetCtx())
+	assert.Nil(t, err)
+	mockTbl := adaptor.MockTableInfo(4)
+	_, _, _, _, defs, _ := helper.UnTransfer(*mockTbl)
+	properties := []engine.Property{{Key: "ExternParam", Value: `{"Location":"/data/t.csv"}`}}
+	defs = append(defs, &engine.PropertiesDef{Properties: properties})
+	err = dbase.Create(0, mockTbl.Name, defs, txn.GetCtx())
+	assert.Nil(t, err)
+	assert.Nil(t, txn.Commit())
+
+	txn, err = e.StartTxn(nil)
+	assert.Nil(t, err)
+	dbase, err = e.Database("db", txn.GetCtx())
+	assert.Nil(t, err)
+	rel, err := dbase.Relation(mockTbl.Name, txn.GetCtx())
+	assert.Nil(t, err)
+	var found []engine.Property
+	for _, def := range rel.TableDefs(txn.GetCtx()) {
+		if propertiesDef, ok := def.(*engine.PropertiesDef); ok {
+			found = append(found, propertiesDef.Properties...)
+		}
+	}
+	assert.Equal(t, properties, found)
+
+	// the properties survive the replay of the catalog
+	schema := rel.(*txnRelation).handle.GetMeta().(*catalog.TableEntry).GetSchema()
+	buf, err := schema.Marshal()
+	assert.Nil(t, err)
+	replayed := catalog.NewEmptySchema("")
+	_, err = replayed.ReadFrom(bytes.NewReader(buf))
+	assert.Nil(t, err)
+	assert.Equal(t, schema.Properties, replayed.Properties)
+	assert.Equal(t, len(schema.ColDefs), len(replayed.ColDefs))
+	assert.Nil(t, txn.Commit())
+}
+
+func TestBlocks(t *testing.T) {
+	tae := initDB(t, nil)
+	defer tae.Close()
+	e := NewEngine(tae)
+	txn, err := e.StartTxn(nil)
+	assert.Nil(t, err)
+	err = e.Create(0, "db", 0, txn.GetCtx())
+	assert.Nil(t, err)
+	dbase, err := e.Database("db", txn.GetCtx())
+	assert.Nil(t, err)
+	mockTbl := adaptor.MockTableInfo(4)
+	_, _, _, _, defs, _ := helper.UnTransfer(*mockTbl)
+	err = dbase.Create(0, mockTbl.Name, defs, txn.GetCtx())
+	assert.Nil(t, err)
+	rel, err := dbase.Relation(mockTbl.Name, txn.GetCtx())
+	assert.Nil(t, err)
+	schema := rel.(*txnRelation).handle.GetMeta().(*catalog.TableEntry).GetSchema()
+	bat := compute.MockBatch(schema.Types(), 20, int(schema.PrimaryKey), nil)
+	assert.Nil(t, rel.Write(0, bat, txn.GetCtx()))
+	assert.Nil(t, txn.Commit())
+
+	txn, err = e.StartTxn(nil)
+	assert.Nil(t, err)
+	dbase, err = e.Database("db", txn.GetCtx())
+	assert.Nil(t, err)
+	rel, err = dbase.Relation(mockTbl.Name, txn.GetCtx())
+	assert.Nil(t, err)
+	blocks, err := rel.(engine.MetadataRelation).Blocks(txn.GetCtx())
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(blocks))
+	assert.True(t, blocks[0].Appendable)
+	assert.Equal(t, int64(20), blocks[0].Rows)
+	assert.Equal(t, int32(0), blocks[0].MinKey)
+	assert.Equal(t, int32(19), blocks[0].MaxKey)
+	assert.Nil(t, txn.Commit())
+}
+
+func TestGetByKeys(t *testing.T) {
+	tae := initDB(t, nil)
+	defer tae.Close()
+	e := NewEngine(tae)
+	txn, err := e.StartTxn(nil)
+	assert.Nil(t, err)
+	err = e.Create(0, "db", 0, txn.GetCtx())
+	assert.Nil(t, err)
+	dbase, err := e.Database("db", txn.GetCtx())
+	assert.Nil(t, err)
+	mockTbl := adaptor.MockTableInfo(4)
+	_, _, _, _, defs, _ := helper.UnTransfer(*mockTbl)
+	err = dbase.Create(0, mockTbl.Name, defs, txn.GetCtx())
+	assert.Nil(t, err)
+	rel, err := dbase.Relation(mockTbl.Name, txn.GetCtx())
+	assert.Nil(t, err)
+	schema := rel.(*txnRelation).handle.GetMeta().(*catalog.TableEntry).GetSchema()
+	bat := compute.MockBatch(schema.Types(), 20, int(schema.PrimaryKey), nil)
+	assert.Nil(t, rel.Write(0, bat, txn.GetCtx()))
+	assert.Nil(t, txn.Commit())
+
+	txn, err = e.StartTxn(nil)
+	assert.Nil(t, err)
+	dbase, err = e.Database("db", txn.GetCtx())
+	assert.Nil(t, err)
+	rel, err = dbase.Relation(mockTbl.Name, txn.GetCtx())
+	assert.Nil(t, err)
+	pk := schema.ColDefs[schema.PrimaryKey]
+	keys := vector.New(pk.Type)
+	keys.Col = []int32{3, 25, 7}
+	rows, sels, err := rel.(engine.KeyRelation).GetByKeys(keys, []string{pk.Name}, txn.GetCtx())
+	assert.Nil(t, err)
+	assert.Equal(t, []int64{0, 2}, sels)
+	assert.Equal(t, []int32{3, 7}, rows.Vecs[0].Col)
+	assert.Equal(t, 2, len(rows.Zs))
+	assert.Nil(t, txn.Commit())
+}
+
+func TestRuntimeFilter(t *testing.T) {
+	tae := initDB(t, nil)
+	defer tae.Close()
+	e := NewEngine(tae)
+	txn, err := e.StartTxn(nil)
+	assert.Nil(t, err)
+	err = e.Create(0, "db", 0, txn.GetCtx())
+	assert.Nil(t, err)
+	dbase, err := e.Database("db", txn.GetCtx())
+	assert.Nil(t, err)
+	mockTbl := adaptor.MockTableInfo(4)
+	_, _, _, _, defs, _ := helper.UnTransfer(*mockTbl)
+	err = dbase.Create(0, mockTbl.Name, defs, txn.GetCtx())
+	assert.Nil(t, err)
+	rel, err := dbase.Relation(mockTbl.Name, txn.GetCtx())
+	assert.Nil(t, err)
+	schema := rel.(*txnRelation).handle.GetMeta().(*catalog.TableEntry).GetSchema()
+	bat := compute.MockBatch(schema.Types(), 20, int(schema.PrimaryKey), nil)
+	assert.Nil(t, rel.Write(0, bat, txn.GetCtx()))
+	assert.Nil(t, txn.Commit())
+
+	pk := schema.ColDefs[schema.PrimaryKey]
+	read := func(f *engine.RuntimeFilter) []int32 {
+		txn, err := e.StartTxn(nil)
+		assert.Nil(t, err)
+		dbase, err := e.Database("db", txn.GetCtx())
+		assert.Nil(t, err)
+		rel, err := dbase.Relation(mockTbl.Name, txn.GetCtx())
+		assert.Nil(t, err)
+		rd := rel.NewReader(1, nil, nil, txn.GetCtx())[0]
+		rd.(engine.RuntimeFilterReader).SetRuntimeFilters([]*engine.RuntimeFilter{f})
+		var rows []int32
+		for {
+			bat, err := rd.Read([]uint64{1}, []string{pk.Name})
+			assert.Nil(t, err)
+			if bat == nil {
+				break
+			}
+			rows = append(rows, bat.Vecs[0].Col.([]int32)...)
+		}
+		assert.Nil(t, txn.Commit())
+		return rows
+	}
+	build := func(keys ...int32) *engine.RuntimeFilter {
+		f := engine.NewRuntimeFilter(pk.Name, pk.Type)
+		vec := vector.New(pk.Type)
+		vec.Col = keys
+		b := f.NewBuilder()
+		b.Add(vec)
+		b.Build()
+		return f
+	}
+
+	// the rows are not filtered until the keys are known
+	f := engine.NewRuntimeFilter(pk.Name, pk.Type)
+	assert.Equal(t, 20, len(read(f)))
+	// in-list
+	assert.Equal(t, []int32{3, 7}, read(build(7, 3, 7, 30)))
+	// the block is skipped by the zonemap
+	assert.Equal(t, 0, len(read(build(25, 30))))
+	// bloom filter
+	defer func(limit int) { engine.RuntimeFilterInLimit = limit }(engine.RuntimeFilterInLimit)
+	engine.RuntimeFilterInLimit = 1
+	rows := read(build(3, 7, 30))
+	assert.Contains(t, rows, int32(3))
+	assert.Contains(t, rows, int32(7))
+	assert.Less(t, len(rows), 20)
+}
+
+func TestAutoIncrement(t *testing.T) {
+	tae := initDB(t, nil)
+	e := NewEngine(tae)
+	txn, err := e.StartTxn(nil)
+	assert.Nil(t, err)
+	err = e.Create(0, "db", 0, txn.GetCtx())
+	assert.Nil(t, err)
+	dbase, err := e.Database("db", txn.GetCtx())
+	assert.Nil(t, err)
+	mockTbl := adaptor.MockTableInfo(4)
+	mockTbl.Columns[0].AutoIncrement = true
+	mockTbl.Properties = append(mockTbl.Properties, aoe.Property{Key: engine.AutoIncrementProperty, Value: "10"})
+	_, _, _, _, defs, _ := helper.UnTransfer(*mockTbl)
+	err = dbase.Create(0, mockTbl.Name, defs, txn.GetCtx())
+	assert.Nil(t, err)
+	rel, err := dbase.Relation(mockTbl.Name, txn.GetCtx())
+	assert.Nil(t, err)
+
+	var attr engine.Attribute
+	for _, def := range rel.TableDefs(txn.GetCtx()) {
+		if v, ok := def.(*engine.AttributeDef); ok && v.Attr.AutoIncrement {
+			attr = v.Attr
+		}
+	}
+	assert.Equal(t, mockTbl.Columns[0].Name, attr.Name)
+
+	arel := rel.(engine.AutoIncrementRelation)
+	first, err := arel.AllocAutoIncrement(5, 0, txn.GetCtx())
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(10), first)
+	first, err = arel.AllocAutoIncrement(1, 100, txn.GetCtx())
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(101), first)
+	first, err = arel.AllocAutoIncrement(1, 0, txn.GetCtx())
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(102), first)
+	assert.Nil(t, txn.Commit())
+	assert.Nil(t, tae.Close())
+
+	// the values allocated before the restart are never allocated again
+	tae2, err := db.Open(tae.Dir, nil)
+	assert.Nil(t, err)
+	defer tae2.Close()
+	e = NewEngine(tae2)
+	txn, err = e.StartTxn(nil)
+	assert.Nil(t, err)
+	dbase, err = e.Database("db", txn.GetCtx())
+	assert.Nil(t, err)
+	rel, err = dbase.Relation(mockTbl.Name, txn.GetCtx())
+	assert.Nil(t, err)
+	first, err = rel.(engine.AutoIncrementRelation).AllocAutoIncrement(1, 0, txn.GetCtx())
+	assert.Nil(t, err)
+	assert.Greater(t, first, uint64(102))
+	assert.Nil(t, txn.Commit())
+}
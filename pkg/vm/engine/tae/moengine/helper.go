@@ -17,6 +17,7 @@ package moengine
 import (
 	"fmt"
 
+	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe"
@@ -31,14 +32,25 @@ func SchemaToTableInfo(schema *catalog.Schema) aoe.TableInfo {
 	}
 	for idx, colDef := range schema.ColDefs {
 		col := aoe.ColumnInfo{
-			Name: colDef.Name,
-			Type: colDef.Type,
+			Name:       colDef.Name,
+			Type:       colDef.Type,
+			Alg:        int(colDef.Alg),
+			EnumValues: colDef.EnumValues,
+		}
+		if colDef.AutoIncrement != 0 {
+			col.AutoIncrement = true
 		}
 		if idx == int(schema.PrimaryKey) {
 			col.PrimaryKey = true
 		}
 		tblInfo.Columns = append(tblInfo.Columns, col)
 	}
+	for _, property := range schema.Properties {
+		tblInfo.Properties = append(tblInfo.Properties, aoe.Property{
+			Key:   property.Key,
+			Value: property.Value,
+		})
+	}
 	return tblInfo
 }
 
@@ -85,9 +97,14 @@ func TableInfoToSchema(info *aoe.TableInfo) *catalog.Schema {
 	schema := catalog.NewEmptySchema(info.Name)
 	for idx, colInfo := range info.Columns {
 		newInfo := &catalog.ColDef{
-			Name: colInfo.Name,
-			Idx:  idx,
-			Type: colInfo.Type,
+			Name:       colInfo.Name,
+			Idx:        idx,
+			Type:       colInfo.Type,
+			Alg:        compress.T(colInfo.Alg),
+			EnumValues: colInfo.EnumValues,
+		}
+		if colInfo.AutoIncrement {
+			newInfo.AutoIncrement = 1
 		}
 		if colInfo.PrimaryKey {
 			schema.PrimaryKey = int32(idx)
@@ -96,6 +113,12 @@ func TableInfoToSchema(info *aoe.TableInfo) *catalog.Schema {
 		schema.NameIndex[newInfo.Name] = len(schema.ColDefs)
 		schema.ColDefs = append(schema.ColDefs, newInfo)
 	}
+	for _, property := range info.Properties {
+		schema.Properties = append(schema.Properties, catalog.Property{
+			Key:   property.Key,
+			Value: property.Value,
+		})
+	}
 
 	return schema
 }
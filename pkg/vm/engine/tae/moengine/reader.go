@@ -21,14 +21,17 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/model"
 )
 
 var (
-	_ engine.Reader = (*txnReader)(nil)
+	_ engine.Reader              = (*txnReader)(nil)
+	_ engine.RuntimeFilterReader = (*txnReader)(nil)
 )
 
 func newReader(rel handle.Relation, it handle.BlockIt) *txnReader {
-	attrCnt := len(rel.GetMeta().(*catalog.TableEntry).GetSchema().ColDefs)
+	schema := rel.GetMeta().(*catalog.TableEntry).GetSchema()
+	attrCnt := len(schema.ColDefs)
 	cds := make([]*bytes.Buffer, attrCnt)
 	dds := make([]*bytes.Buffer, attrCnt)
 	for i := 0; i < attrCnt; i++ {
@@ -40,20 +43,89 @@ func newReader(rel handle.Relation, it handle.BlockIt) *txnReader {
 		decompressed: dds,
 		handle:       rel,
 		it:           it,
+		pk:           schema.GetPKColumnDef().Name,
 	}
 }
 
 func (r *txnReader) Read(refCount []uint64, attrs []string) (*batch.Batch, error) {
-	r.it.Lock()
-	if !r.it.Valid() {
+	for {
+		r.it.Lock()
+		if !r.it.Valid() {
+			r.it.Unlock()
+			return nil, nil
+		}
+		h := r.it.GetBlock()
+		r.it.Next()
 		r.it.Unlock()
-		return nil, nil
+		if r.skipBlock(h) {
+			continue
+		}
+		block := newBlock(h)
+		bat, views, err := block.read(refCount, attrs, r.compressed, r.decompressed)
+		if err != nil {
+			return nil, err
+		}
+		if len(r.filters) > 0 {
+			ok, err := r.filterRows(bat, attrs, views)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				continue
+			}
+		}
+		// the large values of the rows dropped by the filters are never read
+		if err = fillLargeValues(views); err != nil {
+			return nil, err
+		}
+		return bat, nil
 	}
-	h := r.it.GetBlock()
-	r.it.Next()
-	r.it.Unlock()
-	block := newBlock(h)
-	return block.Read(refCount, attrs, r.compressed, r.decompressed)
+}
+
+func (r *txnReader) SetRuntimeFilters(filters []*engine.RuntimeFilter) {
+	r.filters = filters
+}
+
+// skipBlock returns true if the zonemap of the primary key of the block
+// proves that none of its rows passes the runtime filters.
+func (r *txnReader) skipBlock(h handle.Block) bool {
+	for _, f := range r.filters {
+		if f.Attr != r.pk {
+			continue
+		}
+		blk := h.GetMeta().(*catalog.BlockEntry).GetBlockData()
+		if blk == nil {
+			return false
+		}
+		min, max, ok := blk.GetKeyRange()
+		if ok && !f.MayContainRange(min, max) {
+			return true
+		}
+	}
+	return false
+}
+
+// filterRows drops the rows of the batch which do not pass the runtime filters,
+// it returns false if no row is left.
+func (r *txnReader) filterRows(bat *batch.Batch, attrs []string, views []*model.ColumnView) (bool, error) {
+	for _, f := range r.filters {
+		for i, attr := range attrs {
+			if attr != f.Attr {
+				continue
+			}
+			if err := views[i].FillLargeValues(); err != nil {
+				return false, err
+			}
+			if sels, ok := f.Filter(bat.Vecs[i]); ok && len(sels) < len(bat.Zs) {
+				batch.Shrink(bat, sels)
+				for _, view := range views {
+					view.ShrinkLargeValues(sels)
+				}
+			}
+			break
+		}
+	}
+	return len(bat.Zs) > 0, nil
 }
 
 func (r *txnReader) NewFilter() engine.Filter {
@@ -15,16 +15,31 @@
 package moengine
 
 import (
+	"math"
+	"strconv"
+
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/common/helper"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/compute"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/data"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/txn/txnbase"
 )
 
 var (
-	_ engine.Relation = (*txnRelation)(nil)
+	_ engine.Relation         = (*txnRelation)(nil)
+	_ engine.MetadataRelation = (*txnRelation)(nil)
+	_ engine.KeyRelation      = (*txnRelation)(nil)
+	_ engine.SortedRelation   = (*txnRelation)(nil)
+
+	_ engine.AutoIncrementRelation = (*txnRelation)(nil)
 )
 
 func newRelation(h handle.Relation) *txnRelation {
@@ -39,8 +54,9 @@ func (rel *txnRelation) ID(_ engine.Snapshot) string {
 
 func (rel *txnRelation) Close(_ engine.Snapshot) {}
 
-func (rel *txnRelation) Nodes(_ engine.Snapshot) (nodes engine.Nodes) {
-	return
+func (rel *txnRelation) Nodes(_ engine.Snapshot) engine.Nodes {
+	// all the data of a txn relation is read through the local txn
+	return engine.Nodes{engine.Node{}}
 }
 
 func (_ *txnRelation) Size(_ string) int64 {
@@ -96,15 +112,119 @@ func (rel *txnRelation) Attribute() []engine.Attribute {
 	for idx, attr := range attrs {
 		attr.Name = meta.GetSchema().ColDefs[idx].Name
 		attr.Type = meta.GetSchema().ColDefs[idx].Type
+		attr.Alg = meta.GetSchema().ColDefs[idx].Alg
 		attrs[idx] = attr
 	}
 	return attrs
 }
 
+// AllocAutoIncrement reserves the values from the sequence of the table in the catalog, the first
+// value of the sequence is set by the AUTO_INCREMENT option of the table.
+func (rel *txnRelation) AllocAutoIncrement(n, max uint64, _ engine.Snapshot) (uint64, error) {
+	meta := rel.handle.GetMeta().(*catalog.TableEntry)
+	min := uint64(1)
+	for _, property := range meta.GetSchema().Properties {
+		if property.Key == engine.AutoIncrementProperty {
+			v, err := strconv.ParseUint(property.Value, 10, 64)
+			if err != nil {
+				return 0, err
+			}
+			min = v
+		}
+	}
+	if max >= min {
+		if max == math.MaxUint64 {
+			return 0, catalog.ErrAutoIncrementOverflow
+		}
+		min = max + 1
+	}
+	return meta.GetCatalog().AllocAutoIncrement(meta.GetID(), n, min)
+}
+
 func (rel *txnRelation) Write(_ uint64, bat *batch.Batch, _ engine.Snapshot) error {
 	return rel.handle.Append(bat)
 }
 
+func (rel *txnRelation) Update(_ uint64, bat *batch.Batch, _ engine.Snapshot) error {
+	schema := rel.handle.GetMeta().(*catalog.TableEntry).GetSchema()
+	// the batch holds the whole rows in the order of the table's columns
+	if err := rel.deleteByPrimaryKey(bat.Vecs[schema.PrimaryKey]); err != nil {
+		return err
+	}
+	return rel.handle.Append(bat)
+}
+
+func (rel *txnRelation) Delete(_ uint64, vec *vector.Vector, attr string, _ engine.Snapshot) error {
+	schema := rel.handle.GetMeta().(*catalog.TableEntry).GetSchema()
+	if schema.ColDefs[schema.PrimaryKey].Name != attr {
+		return engine.ErrNotSupported
+	}
+	return rel.deleteByPrimaryKey(vec)
+}
+
+// deleteByPrimaryKey removes the rows whose primary key is in the vector
+func (rel *txnRelation) deleteByPrimaryKey(vec *vector.Vector) error {
+	for i, n := 0, vector.Length(vec); i < n; i++ {
+		if nulls.Contains(vec.Nsp, uint64(i)) {
+			continue
+		}
+		id, row, err := rel.handle.GetByFilter(handle.NewEQFilter(compute.GetValue(vec, uint32(i))))
+		if err != nil {
+			return err
+		}
+		if err = rel.handle.RangeDelete(id, row, row); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetByKeys looks up the rows by the primary key index of the blocks, the keys not found are skipped
+func (rel *txnRelation) GetByKeys(vec *vector.Vector, attrs []string, _ engine.Snapshot) (*batch.Batch, []int64, error) {
+	schema := rel.handle.GetMeta().(*catalog.TableEntry).GetSchema()
+	cols := make([]uint16, len(attrs))
+	bat := batch.New(true, attrs)
+	for i, attr := range attrs {
+		idx := schema.GetColIdx(attr)
+		if idx < 0 {
+			return nil, nil, engine.ErrNotSupported
+		}
+		cols[i] = uint16(idx)
+		bat.Vecs[i] = vector.New(schema.ColDefs[idx].Type)
+	}
+	var sels []int64
+	for i, n := 0, vector.Length(vec); i < n; i++ {
+		if nulls.Contains(vec.Nsp, uint64(i)) {
+			continue
+		}
+		id, row, err := rel.handle.GetByFilter(handle.NewEQFilter(compute.GetValue(vec, uint32(i))))
+		if err == data.ErrNotFound || err == txnbase.ErrNotFound {
+			continue
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		vs := make([]any, len(cols))
+		for j, col := range cols {
+			if vs[j], err = rel.handle.GetValue(id, row, col); err != nil {
+				break
+			}
+		}
+		if err == txnbase.ErrNotFound { // deleted by the txn
+			continue
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		for j, v := range vs {
+			compute.AppendValue(bat.Vecs[j], v)
+		}
+		sels = append(sels, int64(i))
+	}
+	bat.InitZsOne(len(sels))
+	return bat, sels, nil
+}
+
 func (rel *txnRelation) NewReader(num int, _ extend.Extend, _ []byte, _ engine.Snapshot) (rds []engine.Reader) {
 	it := rel.handle.MakeBlockIt()
 	for i := 0; i < num; i++ {
@@ -113,3 +233,69 @@ func (rel *txnRelation) NewReader(num int, _ extend.Extend, _ []byte, _ engine.S
 	}
 	return
 }
+
+// sortedKeyTypes are the types of the primary keys whose ranges can be compared
+var sortedKeyTypes = map[types.T]bool{
+	types.T_int8:     true,
+	types.T_int16:    true,
+	types.T_int32:    true,
+	types.T_int64:    true,
+	types.T_uint8:    true,
+	types.T_uint16:   true,
+	types.T_uint32:   true,
+	types.T_uint64:   true,
+	types.T_float32:  true,
+	types.T_float64:  true,
+	types.T_date:     true,
+	types.T_datetime: true,
+	types.T_char:     true,
+	types.T_varchar:  true,
+}
+
+// Sorted returns true if every block of the relation is sorted by the primary key, and the key
+// range of every block is after the range of the block read before it. The appendable blocks
+// are not sorted, the rows of a block are sorted when the block is compacted.
+func (rel *txnRelation) Sorted(snap engine.Snapshot) bool {
+	schema := rel.handle.GetMeta().(*catalog.TableEntry).GetSchema()
+	typ := schema.ColDefs[schema.PrimaryKey].Type
+	if !sortedKeyTypes[typ.Oid] {
+		return false
+	}
+	infos, err := rel.Blocks(snap)
+	if err != nil {
+		return false
+	}
+	for i, info := range infos {
+		if info.Appendable || info.MinKey == nil {
+			return false
+		}
+		if i > 0 && common.CompareGeneric(infos[i-1].MaxKey, info.MinKey, typ) >= 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func (rel *txnRelation) Blocks(_ engine.Snapshot) ([]engine.BlockInfo, error) {
+	var infos []engine.BlockInfo
+	it := rel.handle.MakeBlockIt()
+	for it.Valid() {
+		h := it.GetBlock()
+		meta := h.GetMeta().(*catalog.BlockEntry)
+		info := engine.BlockInfo{
+			SegmentID:  meta.GetSegment().GetID(),
+			BlockID:    meta.GetID(),
+			Appendable: meta.IsAppendable(),
+			Rows:       int64(h.Rows()),
+		}
+		if blk := meta.GetBlockData(); blk != nil {
+			if min, max, ok := blk.GetKeyRange(); ok {
+				info.MinKey, info.MaxKey = min, max
+			}
+			info.Size, info.OriginSize = blk.GetDataSize()
+		}
+		infos = append(infos, info)
+		it.Next()
+	}
+	return infos, nil
+}
@@ -16,10 +16,12 @@ package moengine
 
 import (
 	"bytes"
+
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
 )
 
 type Txn interface {
@@ -41,6 +43,32 @@ var _ TxnEngine = &txnEngine{}
 
 type txnEngine struct {
 	impl *db.DB
+	// maintainer updates the materialized views before the transactions commit
+	maintainer engine.ViewMaintainer
+	// checker validates the foreign keys before the transactions commit
+	checker engine.ConstraintChecker
+}
+
+// txnDeltas are the rows changed by a transaction, views and constraints are true if
+// they are needed by the materialized views and the foreign keys
+type txnDeltas struct {
+	deltas      []*engine.Delta
+	views       bool
+	constraints bool
+}
+
+// deltaUse is whether the rows changed in a table are needed by the materialized views and
+// the foreign keys, referencing are the tables whose foreign keys reference the table
+type deltaUse struct {
+	views       bool
+	constraints bool
+	referencing []string
+}
+
+// txnHandle is a transaction started by the engine
+type txnHandle struct {
+	txnif.AsyncTxn
+	e *txnEngine
 }
 
 type txnDatabase struct {
@@ -60,4 +88,7 @@ type txnReader struct {
 	it           handle.BlockIt
 	compressed   []*bytes.Buffer
 	decompressed []*bytes.Buffer
+	// pk is the name of the primary key, the blocks are skipped by its zonemap
+	pk      string
+	filters []*engine.RuntimeFilter
 }
@@ -29,7 +29,6 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/compute"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db"
-	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/moengine"
 	"github.com/panjf2000/ants/v2"
 )
@@ -81,7 +80,8 @@ func main() {
 			panic(err)
 		}
 		{
-			db, _ := txn.(txnif.AsyncTxn).GetDatabase(dbName)
+			h, _ := tae.GetTxnByCtx(txn.GetCtx())
+			db, _ := h.GetDatabase(dbName)
 			rel, _ := db.GetRelationByName(tblInfo.Name)
 			schema = rel.GetMeta().(*catalog.TableEntry).GetSchema()
 		}
@@ -35,6 +35,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tasks"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/txn/txnbase"
 
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
 	movec "github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/buffer/base"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
@@ -61,12 +62,12 @@ type dataBlock struct {
 
 func newBlock(meta *catalog.BlockEntry, segFile file.Segment, bufMgr base.INodeManager, scheduler tasks.TaskScheduler) *dataBlock {
 	colCnt := len(meta.GetSchema().ColDefs)
-	indexCnt := make(map[int]int)
-	indexCnt[int(meta.GetSchema().PrimaryKey)] = 2
+	indexCnt := meta.GetSchema().IndexCnt()
 	file, err := segFile.OpenBlock(meta.GetID(), colCnt, indexCnt)
 	if err != nil {
 		panic(err)
 	}
+	file.SetColumnAlgos(meta.GetSchema().Algs())
 	colFiles := make(map[int]common.IRWFile)
 	for i := 0; i < colCnt; i++ {
 		if colBlk, err := file.OpenColumn(i); err != nil {
@@ -424,14 +425,27 @@ func (blk *dataBlock) GetColumnDataByName(txn txnif.AsyncTxn, attr string, compr
 }
 
 func (blk *dataBlock) GetColumnDataById(txn txnif.AsyncTxn, colIdx int, compressed, decompressed *bytes.Buffer) (view *model.ColumnView, err error) {
+	if view, err = blk.GetLazyColumnDataById(txn, colIdx, compressed, decompressed); err != nil {
+		return
+	}
+	err = view.FillLargeValues()
+	return
+}
+
+// GetLazyColumnDataById is GetColumnDataById, but the large values kept out of
+// line are not read until ColumnView.FillLargeValues.
+func (blk *dataBlock) GetLazyColumnDataById(txn txnif.AsyncTxn, colIdx int, compressed, decompressed *bytes.Buffer) (view *model.ColumnView, err error) {
 	if blk.meta.IsAppendable() {
 		return blk.getVectorCopy(txn.GetStartTS(), colIdx, compressed, decompressed, false)
 	}
 
 	view = model.NewColumnView(txn.GetStartTS(), colIdx)
-	if view.RawVec, err = blk.getVectorWithBuffer(colIdx, compressed, decompressed); err != nil {
+	if view.RawVec, err = blk.getVectorWithBuffer(colIdx, compressed, decompressed, true); err != nil {
 		return
 	}
+	if f, ok := blk.colFiles[colIdx].(common.ILargeValueFile); ok {
+		view.LargeValues = f
+	}
 
 	blk.mvcc.RLock()
 	err = blk.FillColumnUpdates(view)
@@ -612,11 +626,12 @@ func (blk *dataBlock) GetValue(txn txnif.AsyncTxn, row uint32, col uint16) (v an
 	return
 }
 
-func (blk *dataBlock) getVectorWithBuffer(colIdx int, compressed, decompressed *bytes.Buffer) (vec *movec.Vector, err error) {
+func (blk *dataBlock) getVectorWithBuffer(colIdx int, compressed, decompressed *bytes.Buffer, deferLargeValues bool) (vec *movec.Vector, err error) {
 	dataFile := blk.colFiles[colIdx]
 
 	wrapper := vector.NewEmptyWrapper(blk.meta.GetSchema().ColDefs[colIdx].Type)
 	wrapper.File = dataFile
+	wrapper.DeferLargeValues = deferLargeValues
 	if decompressed == nil {
 		decompressed = new(bytes.Buffer)
 	}
@@ -754,6 +769,33 @@ func (blk *dataBlock) ABlkApplyDeleteToIndex(gen common.RowGen, ts uint64) (err
 	return
 }
 
+func (blk *dataBlock) MayContainJsonPathValue(colIdx, pathIdx int, v bytejson.ByteJson) (bool, error) {
+	if blk.meta.IsAppendable() || blk.index == nil {
+		return true, nil
+	}
+	return blk.index.MayContainJsonPathValue(colIdx, pathIdx, v)
+}
+
+func (blk *dataBlock) GetKeyRange() (min, max any, ok bool) {
+	if blk.index == nil {
+		return
+	}
+	if blk.meta.IsAppendable() {
+		blk.mvcc.RLock()
+		defer blk.mvcc.RUnlock()
+	}
+	return blk.index.GetKeyRange()
+}
+
+func (blk *dataBlock) GetDataSize() (size, originSize int64) {
+	for _, file := range blk.colFiles {
+		stat := file.Stat()
+		size += stat.Size()
+		originSize += stat.OriginSize()
+	}
+	return
+}
+
 func (blk *dataBlock) BatchDedup(txn txnif.AsyncTxn, pks *movec.Vector, rowmask *roaring.Bitmap) (err error) {
 	if blk.meta.IsAppendable() {
 		blk.mvcc.RLock()
@@ -2,6 +2,7 @@ package indexwrapper
 
 import (
 	"github.com/RoaringBitmap/roaring"
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/data"
@@ -11,6 +12,8 @@ import (
 type immutableIndex struct {
 	zmReader *ZMReader
 	bfReader *BFReader
+	// jsonReaders are the filters of the json columns, indexed by the path
+	jsonReaders map[int][]*BFReader
 }
 
 func NewImmutableIndex() *immutableIndex {
@@ -67,11 +70,40 @@ func (index *immutableIndex) Close() (err error) {
 	return
 }
 
+func (index *immutableIndex) MayContainJsonPathValue(colIdx, pathIdx int, v bytejson.ByteJson) (bool, error) {
+	readers := index.jsonReaders[colIdx]
+	if pathIdx >= len(readers) || readers[pathIdx] == nil {
+		return true, nil
+	}
+	// the keys of the filter are the values in the binary format
+	exist, err := readers[pathIdx].MayContainsKey(v.Marshal())
+	return exist, TranslateError(err)
+}
+
+func (index *immutableIndex) GetKeyRange() (min, max any, ok bool) {
+	if index.zmReader == nil {
+		return
+	}
+	return index.zmReader.GetRange()
+}
+
 func (index *immutableIndex) Destroy() (err error) {
 	if err = index.zmReader.Destroy(); err != nil {
 		return
 	}
-	err = index.bfReader.Destroy()
+	if err = index.bfReader.Destroy(); err != nil {
+		return
+	}
+	for _, readers := range index.jsonReaders {
+		for _, reader := range readers {
+			if reader == nil {
+				continue
+			}
+			if err = reader.Destroy(); err != nil {
+				return
+			}
+		}
+	}
 	return
 }
 
@@ -83,11 +115,12 @@ func (index *immutableIndex) ReadFrom(blk data.Block) (err error) {
 	}
 	metas := idxMeta.(*IndicesMeta)
 	entry := blk.GetMeta().(*catalog.BlockEntry)
-	colFile, err := file.OpenColumn(int(entry.GetSchema().PrimaryKey))
-	if err != nil {
-		return
-	}
+	schema := entry.GetSchema()
 	for _, meta := range metas.Metas {
+		colFile, err := file.OpenColumn(int(meta.ColIdx))
+		if err != nil {
+			return err
+		}
 		idxFile, err := colFile.OpenIndexFile(int(meta.InternalIdx))
 		if err != nil {
 			return err
@@ -110,6 +143,15 @@ func (index *immutableIndex) ReadFrom(blk data.Block) (err error) {
 				return err
 			}
 			index.bfReader = NewBFReader(blk.GetBufMgr(), idxFile, id)
+		case JsonPathFilterIndex:
+			col := int(meta.ColIdx)
+			if index.jsonReaders == nil {
+				index.jsonReaders = make(map[int][]*BFReader)
+			}
+			if index.jsonReaders[col] == nil {
+				index.jsonReaders[col] = make([]*BFReader, len(schema.ColDefs[col].JsonPaths))
+			}
+			index.jsonReaders[col][meta.InternalIdx] = NewBFReader(blk.GetBufMgr(), idxFile, id)
 		default:
 			panic("unsupported index type")
 		}
@@ -14,6 +14,7 @@ const (
 	SegmentZoneMapIndex
 	StaticFilterIndex
 	ARTIndex
+	JsonPathFilterIndex
 )
 
 type CompressType uint8
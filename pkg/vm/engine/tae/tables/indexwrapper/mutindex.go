@@ -2,6 +2,7 @@ package indexwrapper
 
 import (
 	"github.com/RoaringBitmap/roaring"
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/compute"
@@ -106,5 +107,19 @@ func (idx *mutableIndex) Close() error {
 	return nil
 }
 
+// MayContainJsonPathValue always returns true, the appendable block has no json filters
+func (idx *mutableIndex) MayContainJsonPathValue(int, int, bytejson.ByteJson) (bool, error) {
+	return true, nil
+}
+
+func (idx *mutableIndex) GetKeyRange() (min, max any, ok bool) {
+	if idx.zonemap == nil {
+		return
+	}
+	min, max = idx.zonemap.GetMin(), idx.zonemap.GetMax()
+	ok = min != nil
+	return
+}
+
 func (idx *mutableIndex) ReadFrom(data.Block) error { panic("not supported") }
 func (idx *mutableIndex) WriteTo(data.Block) error  { panic("not supported") }
@@ -4,6 +4,7 @@ import (
 	"io"
 
 	"github.com/RoaringBitmap/roaring"
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
 	movec "github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/data"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index"
@@ -50,6 +51,13 @@ type Index interface {
 	GetActiveRow(key any) (row uint32, err error)
 	IsKeyDeleted(key any, ts uint64) (deleted, existed bool)
 
+	// MayContainJsonPathValue returns false only if none of the values selected by
+	// the pathIdx-th json path of the column equals to v
+	MayContainJsonPathValue(colIdx, pathIdx int, v bytejson.ByteJson) (bool, error)
+
+	// GetKeyRange returns the min and max key in the zonemap, ok is false if no key is indexed
+	GetKeyRange() (min, max any, ok bool)
+
 	ReadFrom(data.Block) error
 	WriteTo(data.Block) error
 }
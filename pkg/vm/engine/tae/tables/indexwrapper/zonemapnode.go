@@ -103,6 +103,14 @@ func (reader *ZMReader) Contains(key any) bool {
 	return reader.node.zonemap.Contains(key)
 }
 
+func (reader *ZMReader) GetRange() (min, max any, ok bool) {
+	handle := reader.node.mgr.Pin(reader.node)
+	defer handle.Close()
+	min, max = reader.node.zonemap.GetMin(), reader.node.zonemap.GetMax()
+	ok = min != nil
+	return
+}
+
 type ZMWriter struct {
 	cType       CompressType
 	file        common.IRWFile
@@ -15,15 +15,20 @@
 package jobs
 
 import (
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/file"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tables/indexwrapper"
 )
 
-func BuildAndFlushBlockIndex(file file.Block, meta *catalog.BlockEntry, pkColumnData *vector.Vector) (err error) {
+// BuildAndFlushBlockIndex builds the indexes of the block from the column data, only the
+// primary key and the json columns with paths are required in columnData.
+func BuildAndFlushBlockIndex(file file.Block, meta *catalog.BlockEntry, columnData []*vector.Vector) (err error) {
 	// write indexes, collect their meta, and refresh host's index holder
 	schema := meta.GetSchema()
+	pkColumnData := columnData[schema.PrimaryKey]
 	pkColumn, err := file.OpenColumn(int(schema.PrimaryKey))
 	if err != nil {
 		return
@@ -69,6 +74,16 @@ func BuildAndFlushBlockIndex(file file.Block, meta *catalog.BlockEntry, pkColumn
 		return err
 	}
 	metas.AddIndex(*sfMeta)
+
+	for _, colDef := range schema.ColDefs {
+		if len(colDef.JsonPaths) == 0 {
+			continue
+		}
+		if err = buildJsonPathIndexes(file, colDef, columnData[colDef.Idx], metas); err != nil {
+			return err
+		}
+	}
+
 	metaBuf, err := metas.Marshal()
 	if err != nil {
 		return err
@@ -80,3 +95,43 @@ func BuildAndFlushBlockIndex(file file.Block, meta *catalog.BlockEntry, pkColumn
 	}
 	return nil
 }
+
+// buildJsonPathIndexes writes a static filter of the values selected by every path of the
+// json column. A path selecting nothing in the block has no filter, it is always probed.
+func buildJsonPathIndexes(file file.Block, colDef *catalog.ColDef, data *vector.Vector, metas *indexwrapper.IndicesMeta) error {
+	column, err := file.OpenColumn(colDef.Idx)
+	if err != nil {
+		return err
+	}
+	for i, p := range colDef.JsonPaths {
+		path, err := bytejson.ParseJsonPath(p)
+		if err != nil {
+			return err
+		}
+		keys, err := index.ExtractJsonPathKeys(data, path)
+		if err != nil {
+			return err
+		}
+		if vector.Length(keys) == 0 {
+			continue
+		}
+		bfWriter := indexwrapper.NewBFWriter()
+		idxFile, err := column.OpenIndexFile(i)
+		if err != nil {
+			return err
+		}
+		if err = bfWriter.Init(idxFile, indexwrapper.Plain, uint16(colDef.Idx), uint16(i)); err != nil {
+			return err
+		}
+		if err = bfWriter.AddValues(keys); err != nil {
+			return err
+		}
+		idxMeta, err := bfWriter.Finalize()
+		if err != nil {
+			return err
+		}
+		idxMeta.SetIndexType(indexwrapper.JsonPathFilterIndex)
+		metas.AddIndex(*idxMeta)
+	}
+	return nil
+}
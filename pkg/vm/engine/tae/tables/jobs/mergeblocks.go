@@ -176,7 +176,9 @@ func (task *mergeBlocksTask) Execute() (err error) {
 	length = 0
 	var blk handle.Block
 	toAddr := make([]uint32, 0, len(vecs))
-	for _, vec := range vecs {
+	// the column data to build the indexes of every created block
+	indexData := make([][]*vector.Vector, len(vecs))
+	for pos, vec := range vecs {
 		toAddr = append(toAddr, uint32(length))
 		length += vector.Length(vec)
 		blk, err = toSegEntry.CreateNonAppendableBlock()
@@ -193,12 +195,8 @@ func (task *mergeBlocksTask) Execute() (err error) {
 		if err = flushTask.WaitDone(); err != nil {
 			return
 		}
-		if err = BuildAndFlushBlockIndex(meta.GetBlockData().GetBlockFile(), meta, vec); err != nil {
-			return
-		}
-		if err = meta.GetBlockData().ReplayData(); err != nil {
-			return
-		}
+		indexData[pos] = make([]*vector.Vector, len(schema.ColDefs))
+		indexData[pos][schema.PrimaryKey] = vec
 		// bf := blk.GetMeta().(*catalog.BlockEntry).GetBlockData().GetBlockFile()
 		// if bf.WriteColumnVec(task.txn.GetStartTS(), int(schema.PrimaryKey), vec); err != nil {
 		// 	return
@@ -219,6 +217,9 @@ func (task *mergeBlocksTask) Execute() (err error) {
 		}
 		vecs, _ = task.mergeColumn(vecs, &sortedIdx, false, rows, to)
 		for pos, vec := range vecs {
+			if len(schema.ColDefs[i].JsonPaths) > 0 {
+				indexData[pos][i] = vec
+			}
 			blk := task.createdBlks[pos]
 			closure := blk.GetBlockData().FlushColumnDataClosure(ts, i, vec, false)
 			flushTask, err = task.scheduler.ScheduleScopedFn(tasks.WaitableCtx, tasks.IOTask, blk.AsCommonID(), closure)
@@ -230,6 +231,14 @@ func (task *mergeBlocksTask) Execute() (err error) {
 			}
 		}
 	}
+	for pos, blk := range task.createdBlks {
+		if err = BuildAndFlushBlockIndex(blk.GetBlockData().GetBlockFile(), blk, indexData[pos]); err != nil {
+			return
+		}
+		if err = blk.GetBlockData().ReplayData(); err != nil {
+			return
+		}
+	}
 	for i, blk := range task.createdBlks {
 		closure := blk.GetBlockData().SyncBlockDataClosure(ts, rows[i])
 		flushTask, err = task.scheduler.ScheduleScopedFn(tasks.WaitableCtx, tasks.IOTask, blk.AsCommonID(), closure)
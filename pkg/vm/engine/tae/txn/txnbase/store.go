@@ -86,3 +86,6 @@ func (store *NoopTxnStore) LogTxnEntry(dbId, tableId uint64, entry txnif.TxnEntr
 
 func (store *NoopTxnStore) IsReadonly() bool      { return false }
 func (store *NoopTxnStore) IncreateWriteCnt() int { return 0 }
+func (store *NoopTxnStore) GetWriteCnt() int      { return 0 }
+
+func (store *NoopTxnStore) ForEachChange(fn txnif.TxnChangeFn) error { return nil }
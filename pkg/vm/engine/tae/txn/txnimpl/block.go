@@ -197,6 +197,13 @@ func (blk *txnBlock) GetColumnDataByName(attr string, compressed, decompressed *
 	}
 	return blk.entry.GetBlockData().GetColumnDataByName(blk.Txn, attr, compressed, decompressed)
 }
+func (blk *txnBlock) GetLazyColumnDataByName(attr string, compressed, decompressed *bytes.Buffer) (*model.ColumnView, error) {
+	attrId := blk.table.entry.GetSchema().GetColIdx(attr)
+	if blk.isUncommitted {
+		return blk.table.localSegment.GetColumnDataById(blk.entry, attrId, compressed, decompressed)
+	}
+	return blk.entry.GetBlockData().GetLazyColumnDataById(blk.Txn, attrId, compressed, decompressed)
+}
 
 func (blk *txnBlock) LogTxnEntry(entry txnif.TxnEntry, readed []*common.ID) (err error) {
 	return blk.Txn.GetStore().LogTxnEntry(blk.getDBID(), blk.entry.GetSegment().GetTable().GetID(), entry, readed)
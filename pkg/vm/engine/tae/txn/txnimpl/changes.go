@@ -0,0 +1,157 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package txnimpl
+
+import (
+	"time"
+
+	"github.com/RoaringBitmap/roaring"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tables/updates"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/txn/txnbase"
+)
+
+func (store *txnStore) ForEachChange(fn txnif.TxnChangeFn) (err error) {
+	for _, db := range store.dbs {
+		for _, tbl := range db.tables {
+			if err = tbl.forEachChange(fn); err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
+// forEachChange calls fn with the rows deleted and updated in the blocks of the table, and then
+// with the rows appended. The old values are read at the timestamp before the transaction
+// started: a row changed by the transaction can not be changed by another active transaction,
+// so its values at that timestamp are the ones the transaction saw before changing it.
+func (tbl *txnTable) forEachChange(fn txnif.TxnChangeFn) (err error) {
+	if tbl.IsDeleted() {
+		return
+	}
+	deletes := make(map[common.ID]*roaring.Bitmap)
+	for id, node := range tbl.deleteNodes {
+		node.RLock()
+		addChangedRows(deletes, id, node.GetRowMaskRefLocked())
+		node.RUnlock()
+	}
+	changes := make(map[common.ID]*roaring.Bitmap)
+	updated := make(map[common.ID]*roaring.Bitmap)
+	for id, mask := range deletes {
+		addChangedRows(changes, id, mask)
+	}
+	for id, node := range tbl.updateNodes {
+		node.RLock()
+		mask := node.(*updates.ColumnNode).GetMask()
+		addChangedRows(changes, id, mask)
+		addChangedRows(updated, id, mask)
+		node.RUnlock()
+	}
+
+	txn := tbl.store.txn
+	before := txnbase.NewTxn(nil, nil, txn.GetID(), txn.GetStartTS()-1, nil)
+	for id, rows := range changes {
+		if err = tbl.forEachBlockChange(fn, id, before, rows, true); err != nil {
+			return
+		}
+		rows = updated[id]
+		if rows == nil {
+			continue
+		}
+		if deleted := deletes[id]; deleted != nil {
+			rows.AndNot(deleted)
+		}
+		if err = tbl.forEachBlockChange(fn, id, txn, rows, false); err != nil {
+			return
+		}
+	}
+	if tbl.localSegment == nil {
+		return
+	}
+	for _, node := range tbl.localSegment.nodes {
+		if err = tbl.forEachLocalChange(fn, node); err != nil {
+			return
+		}
+	}
+	return
+}
+
+// forEachBlockChange calls fn with the rows of the block read by the txn
+func (tbl *txnTable) forEachBlockChange(fn txnif.TxnChangeFn, id common.ID, txn txnif.AsyncTxn, rows *roaring.Bitmap, deleted bool) (err error) {
+	if rows.IsEmpty() {
+		return
+	}
+	seg, err := tbl.entry.GetSegmentByID(id.SegmentID)
+	if err != nil {
+		return
+	}
+	blk, err := seg.GetBlockEntryByID(id.BlockID)
+	if err != nil {
+		return
+	}
+	sels := make([]int64, 0, rows.GetCardinality())
+	it := rows.Iterator()
+	for it.HasNext() {
+		sels = append(sels, int64(it.Next()))
+	}
+	schema := tbl.entry.GetSchema()
+	bat := batch.New(true, schema.Attrs())
+	for i := range schema.ColDefs {
+		view, err := blk.GetBlockData().GetColumnDataById(txn, i, nil, nil)
+		if err != nil {
+			return err
+		}
+		if view == nil {
+			return txnbase.ErrNotFound
+		}
+		vec := view.AppliedVec
+		vector.Shrink(vec, sels)
+		bat.Vecs[i] = vec
+	}
+	bat.InitZsOne(len(sels))
+	return fn(tbl.entry.GetDB().GetName(), schema.Name, bat, deleted)
+}
+
+// forEachLocalChange calls fn with the rows appended to the insert node and not deleted
+func (tbl *txnTable) forEachLocalChange(fn txnif.TxnChangeFn, node InsertNode) (err error) {
+	if node.RowsWithoutDeletes() == 0 {
+		return
+	}
+	h, err := tbl.store.nodesMgr.TryPin(node, time.Second)
+	if err != nil {
+		return
+	}
+	bat, err := node.Window(0, node.Rows()-1)
+	h.Close()
+	if err != nil {
+		return
+	}
+	bat.InitZsOne(vector.Length(bat.Vecs[0]))
+	return fn(tbl.entry.GetDB().GetName(), tbl.entry.GetSchema().Name, bat, false)
+}
+
+// addChangedRows adds the rows to the rows changed in the block, whatever column is changed
+func addChangedRows(changes map[common.ID]*roaring.Bitmap, id common.ID, rows *roaring.Bitmap) {
+	id.Idx = 0
+	if mask, ok := changes[id]; ok {
+		mask.Or(rows)
+		return
+	}
+	changes[id] = rows.Clone()
+}
@@ -51,7 +51,7 @@ func NewSimpleTableIndex() *simpleTableIndex {
 func (idx *simpleTableIndex) KeyToVector(kType types.Type) *gvec.Vector {
 	vec := gvec.New(kType)
 	switch kType.Oid {
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 		for k := range idx.tree {
 			compute.AppendValue(vec, []byte(k.(string)))
 		}
@@ -217,7 +217,7 @@ func (idx *simpleTableIndex) BatchInsert(col *gvec.Vector, start, count int, row
 			idx.tree[v] = row
 			row++
 		}
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		data := vals.([]uint16)
 		if dedupCol {
 			set := make(map[uint16]bool)
@@ -255,7 +255,7 @@ func (idx *simpleTableIndex) BatchInsert(col *gvec.Vector, start, count int, row
 			idx.tree[v] = row
 			row++
 		}
-	case types.T_uint64:
+	case types.T_uint64, types.T_set:
 		data := vals.([]uint64)
 		if dedupCol {
 			set := make(map[uint64]bool)
@@ -369,7 +369,26 @@ func (idx *simpleTableIndex) BatchInsert(col *gvec.Vector, start, count int, row
 			idx.tree[v] = row
 			row++
 		}
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_uuid:
+		data := vals.([]types.Uuid)
+		if dedupCol {
+			set := make(map[types.Uuid]bool)
+			for _, v := range data[start : start+count] {
+				if _, ok := set[v]; ok {
+					return txnbase.ErrDuplicated
+				}
+				set[v] = true
+			}
+			break
+		}
+		for _, v := range data[start : start+count] {
+			if _, ok := idx.tree[v]; ok {
+				return txnbase.ErrDuplicated
+			}
+			idx.tree[v] = row
+			row++
+		}
+	case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 		data := vals.(*types.Bytes)
 		if dedupCol {
 			set := make(map[string]bool)
@@ -439,7 +458,7 @@ func (idx *simpleTableIndex) BatchDedup(col *gvec.Vector) error {
 				return txnbase.ErrDuplicated
 			}
 		}
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		data := vals.([]uint16)
 		for _, v := range data {
 			if _, ok := idx.tree[v]; ok {
@@ -453,7 +472,7 @@ func (idx *simpleTableIndex) BatchDedup(col *gvec.Vector) error {
 				return txnbase.ErrDuplicated
 			}
 		}
-	case types.T_uint64:
+	case types.T_uint64, types.T_set:
 		data := vals.([]uint64)
 		for _, v := range data {
 			if _, ok := idx.tree[v]; ok {
@@ -495,7 +514,14 @@ func (idx *simpleTableIndex) BatchDedup(col *gvec.Vector) error {
 				return txnbase.ErrDuplicated
 			}
 		}
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_uuid:
+		data := vals.([]types.Uuid)
+		for _, v := range data {
+			if _, ok := idx.tree[v]; ok {
+				return txnbase.ErrDuplicated
+			}
+		}
+	case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 		data := vals.(*types.Bytes)
 		// bytes := make([]string, 0, len(data.Lengths))
 		for i, s := range data.Offsets {
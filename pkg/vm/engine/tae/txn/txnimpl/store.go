@@ -72,6 +72,10 @@ func (store *txnStore) IncreateWriteCnt() int {
 	return int(atomic.AddUint32(&store.writeOps, uint32(1)))
 }
 
+func (store *txnStore) GetWriteCnt() int {
+	return int(atomic.LoadUint32(&store.writeOps))
+}
+
 func (store *txnStore) LogTxnEntry(dbId uint64, tableId uint64, entry txnif.TxnEntry, readed []*common.ID) (err error) {
 	db, err := store.getOrSetDB(dbId)
 	if err != nil {
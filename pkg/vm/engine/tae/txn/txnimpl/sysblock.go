@@ -203,7 +203,7 @@ func (blk *txnSysBlock) getColumnTableData(colIdx int) (view *model.ColumnView,
 				}
 				compute.AppendValue(colData, v) // TODO
 			case catalog.SystemColAttr_IsAutoIncrement:
-				compute.AppendValue(colData, colDef.AutoIncrement) // TODO
+				compute.AppendValue(colData, colDef.AutoIncrement)
 			case catalog.SystemColAttr_Comment:
 				compute.AppendValue(colData, []byte(colDef.Comment)) // TODO
 			default:
@@ -300,6 +300,13 @@ func (blk *txnSysBlock) GetColumnDataByName(attr string, compressed, decompresse
 	return blk.GetColumnDataById(colIdx, compressed, decompressed)
 }
 
+func (blk *txnSysBlock) GetLazyColumnDataByName(attr string, compressed, decompressed *bytes.Buffer) (view *model.ColumnView, err error) {
+	if !blk.isSysTable() {
+		return blk.txnBlock.GetLazyColumnDataByName(attr, compressed, decompressed)
+	}
+	return blk.GetColumnDataByName(attr, compressed, decompressed)
+}
+
 func (blk *txnSysBlock) LogTxnEntry(entry txnif.TxnEntry, readed []*common.ID) (err error) {
 	if !blk.isSysTable() {
 		return blk.txnBlock.LogTxnEntry(entry, readed)
@@ -19,6 +19,7 @@ import (
 	"errors"
 
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
@@ -218,6 +219,14 @@ func (trel *TpeRelation) DelTableDef(u uint64, def engine.TableDef, _ engine.Sna
 	panic("implement me")
 }
 
+func (_ *TpeRelation) Update(_ uint64, _ *batch.Batch, _ engine.Snapshot) error {
+	return engine.ErrNotSupported
+}
+
+func (_ *TpeRelation) Delete(_ uint64, _ *vector.Vector, _ string, _ engine.Snapshot) error {
+	return engine.ErrNotSupported
+}
+
 func (trel *TpeRelation) parallelReader(cnt int, payload []byte) []engine.Reader {
 	tcnt := cnt
 	if cnt <= 0 {
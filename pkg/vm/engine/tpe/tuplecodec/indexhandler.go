@@ -354,7 +354,7 @@ func (ihi *IndexHandlerImpl) getPrimaryIndexKeyOfValue(readCtx interface{}, opt
 				return nil, err
 			}
 			value = v
-		case types.T_char, types.T_varchar:
+		case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 			value = attr
 		case types.T_date:
 			value = types.Date(v)
@@ -351,7 +351,7 @@ func (tbi *RowColumnConverterImpl) FillBatchFromDecodedIndexValue2(index *descri
 				}
 				cols[rowIdx] = d
 			}
-		case types.T_char, types.T_varchar:
+		case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 			vBytes := vec.Col.(*types.Bytes)
 			if isNullOrEmpty {
 				nulls.Add(vec.Nsp, uint64(rowIdx))
@@ -530,7 +530,7 @@ func (tbi *RowColumnConverterImpl) FillBatchFromDecodedIndexKey(
 				}
 				cols[rowIdx] = d
 			}
-		case types.T_char, types.T_varchar:
+		case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 			vBytes := vec.Col.(*types.Bytes)
 			if isNullOrEmpty {
 				nulls.Add(vec.Nsp, uint64(rowIdx))
@@ -140,7 +140,7 @@ func MakeBatch(batchSize int, attrName []string, cols []*engine.AttributeDef) *b
 		case types.T_float64:
 			vec.Data = make([]byte, batchSize*int(toTypesType(types.T_float64).Size))
 			vec.Col = encoding.DecodeFloat64Slice(vec.Data)
-		case types.T_char, types.T_varchar:
+		case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 			vBytes := &types.Bytes{
 				Offsets: make([]uint32, batchSize),
 				Lengths: make([]uint32, batchSize),
@@ -192,7 +192,7 @@ func randomLines(rowCnt int, attrName []string, cols []*engine.AttributeDef) [][
 				d = rand.Float32()
 			case types.T_float64:
 				d = rand.Float64()
-			case types.T_char, types.T_varchar:
+			case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 				d = random.String(10)
 			case types.T_date:
 				d = "2022-02-23"
@@ -344,7 +344,7 @@ func FillBatch(lines [][]string, batchData *batch.Batch) {
 					}
 					cols[rowIdx] = d
 				}
-			case types.T_char, types.T_varchar:
+			case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob:
 				vBytes := vec.Col.(*types.Bytes)
 				if isNullOrEmpty {
 					nulls.Add(vec.Nsp, uint64(rowIdx))
@@ -442,7 +442,7 @@ func TruncateBatch(bat *batch.Batch, batchSize, needLen int) {
 			needBytes := needLen * int(toTypesType(types.T_float64).Size)
 			vec.Data = vec.Data[:needBytes]
 			vec.Col = encoding.DecodeFloat64Slice(vec.Data)
-		case types.T_char, types.T_varchar: //bytes is different
+		case types.T_char, types.T_varchar, types.T_json, types.T_text, types.T_blob: //bytes is different
 			vBytes := vec.Col.(*types.Bytes)
 			if len(vBytes.Offsets) > needLen {
 				nextStart := vBytes.Offsets[needLen]
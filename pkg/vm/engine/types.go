@@ -15,13 +15,20 @@
 package engine
 
 import (
+	"errors"
+
 	roaring "github.com/RoaringBitmap/roaring/roaring64"
 	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
 )
 
+// ErrNotSupported is returned by the relations which can not modify their rows
+var ErrNotSupported = errors.New("operation is not supported by the storage engine")
+
 type Snapshot []byte
 
 type Nodes []Node
@@ -38,6 +45,10 @@ type Attribute struct {
 	Type    types.Type  // type of attribute
 	Default DefaultExpr // default value of this attribute.
 	Primary bool        // if true, it is primary key
+	// AutoIncrement is true if the values of the attribute are generated by the sequence of the table
+	AutoIncrement bool
+	// EnumValues are the members of an enum or set attribute
+	EnumValues []string
 }
 
 type DefaultExpr struct {
@@ -61,6 +72,25 @@ type Property struct {
 	Value string
 }
 
+// AutoIncrementProperty is the key of the property holding the first value of the auto increment column
+const AutoIncrementProperty = "AutoIncrement"
+
+const (
+	// MaterializedViewProperty is the key of the property holding the definition of a materialized view
+	MaterializedViewProperty = "MaterializedView"
+	// MaterializedViewSourceProperty is the key of the property holding the name of the table
+	// aggregated by a materialized view
+	MaterializedViewSourceProperty = "MaterializedViewSource"
+)
+
+const (
+	// ForeignKeyProperty is the key of the property holding the foreign keys of a table
+	ForeignKeyProperty = "ForeignKey"
+	// ForeignKeyReferenceProperty is the key of the property holding the database and the name
+	// of a table referenced by the foreign keys of a table, as database.table
+	ForeignKeyReferenceProperty = "ForeignKeyReference"
+)
+
 type NodeInfo struct {
 	Mcpu int
 }
@@ -148,6 +178,12 @@ type Relation interface {
 
 	Write(uint64, *batch.Batch, Snapshot) error
 
+	// Update replaces the rows which have the same primary key as the rows of the batch
+	Update(uint64, *batch.Batch, Snapshot) error
+
+	// Delete removes the rows whose value of the named attribute is in the vector
+	Delete(uint64, *vector.Vector, string, Snapshot) error
+
 	AddTableDef(uint64, TableDef, Snapshot) error
 	DelTableDef(uint64, TableDef, Snapshot) error
 
@@ -159,6 +195,75 @@ type Reader interface {
 	Read([]uint64, []string) (*batch.Batch, error)
 }
 
+// BlockInfo is the metadata of a block of a relation
+type BlockInfo struct {
+	SegmentID  uint64
+	BlockID    uint64
+	Appendable bool
+	Rows       int64
+	// MinKey and MaxKey are the range of the primary key in the block, nil if unknown
+	MinKey interface{}
+	MaxKey interface{}
+	// Size is the size of the column files of the block, OriginSize is the size before compression
+	Size       int64
+	OriginSize int64
+}
+
+// MetadataRelation is a relation which can list the metadata of its blocks
+type MetadataRelation interface {
+	Relation
+
+	Blocks(Snapshot) ([]BlockInfo, error)
+}
+
+// SortedRelation is a relation which may be read in the order of its primary key
+type SortedRelation interface {
+	Relation
+
+	// Sorted returns true if a single reader of the relation reads the rows in the
+	// ascending order of the primary key.
+	Sorted(Snapshot) bool
+}
+
+// KeyRelation is a relation which can look up its rows by the primary key
+type KeyRelation interface {
+	Relation
+
+	// GetByKeys returns the attrs of the rows whose primary key is one of the values of the vector,
+	// sels[i] is the row of the vector matching the i-th row returned.
+	GetByKeys(vec *vector.Vector, attrs []string, snapshot Snapshot) (bat *batch.Batch, sels []int64, err error)
+}
+
+// AutoIncrementRelation is a relation which can generate the values of its auto increment column
+type AutoIncrementRelation interface {
+	Relation
+
+	// AllocAutoIncrement reserves n values of the auto increment column and returns the first one,
+	// the values reserved are larger than max, the largest value written explicitly.
+	AllocAutoIncrement(n, max uint64, snapshot Snapshot) (first uint64, err error)
+}
+
+// Delta is the rows of a table appended or deleted by a transaction,
+// the batch holds the whole rows in the order of the table's columns.
+type Delta struct {
+	Database string
+	Table    string
+	Bat      *batch.Batch
+	Deleted  bool
+	// Referencing are the tables whose foreign keys reference the table, as database.table
+	Referencing []string
+}
+
+// ViewMaintainer updates the materialized views of the tables changed by a transaction before
+// the transaction commits, the views are written by the transaction so that they are committed
+// together with the deltas.
+type ViewMaintainer func(e Engine, snapshot Snapshot, deltas []*Delta) error
+
+// ConstraintChecker validates the foreign keys of the tables changed by a transaction before the
+// transaction commits, the actions on the rows referencing the deleted rows are written by the
+// transaction at ts and their deltas are checked again. mp is the memory of the transaction.
+type ConstraintChecker func(e Engine, snapshot Snapshot, ts uint64, mp *mheap.Mheap, deltas []*Delta) error
+
 type Filter interface {
 	Eq(string, interface{}) (*roaring.Bitmap, error)
 	Ne(string, interface{}) (*roaring.Bitmap, error)
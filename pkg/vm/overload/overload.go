@@ -19,13 +19,18 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/complement"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/connector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/deletion"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/dispatch"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/group"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/indexjoin"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/insert"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/join"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/left"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/limit"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/mark"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/merge"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/mergegroup"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/mergejoin"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/mergelimit"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/mergeoffset"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/mergeorder"
@@ -36,7 +41,11 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/product"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/projection"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/restrict"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/right"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/sample"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/semi"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/top"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/update"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
 
@@ -56,6 +65,16 @@ var stringFunc = [...]func(interface{}, *bytes.Buffer){
 	Connector:  connector.String,
 	Projection: projection.String,
 	Complement: complement.String,
+	Sample:     sample.String,
+	MergeJoin:  mergejoin.String,
+	IndexJoin:  indexjoin.String,
+	Right:      right.String,
+	Semi:       semi.String,
+	Mark:       mark.String,
+
+	Insert:   insert.String,
+	Update:   update.String,
+	Deletion: deletion.String,
 
 	MergeTop:    mergetop.String,
 	MergeLimit:  mergelimit.String,
@@ -80,6 +99,16 @@ var prepareFunc = [...]func(*process.Process, interface{}) error{
 	Connector:  connector.Prepare,
 	Projection: projection.Prepare,
 	Complement: complement.Prepare,
+	Sample:     sample.Prepare,
+	MergeJoin:  mergejoin.Prepare,
+	IndexJoin:  indexjoin.Prepare,
+	Right:      right.Prepare,
+	Semi:       semi.Prepare,
+	Mark:       mark.Prepare,
+
+	Insert:   insert.Prepare,
+	Update:   update.Prepare,
+	Deletion: deletion.Prepare,
 
 	MergeTop:    mergetop.Prepare,
 	MergeLimit:  mergelimit.Prepare,
@@ -104,6 +133,16 @@ var execFunc = [...]func(*process.Process, interface{}) (bool, error){
 	Connector:  connector.Call,
 	Projection: projection.Call,
 	Complement: complement.Call,
+	Sample:     sample.Call,
+	MergeJoin:  mergejoin.Call,
+	IndexJoin:  indexjoin.Call,
+	Right:      right.Call,
+	Semi:       semi.Call,
+	Mark:       mark.Call,
+
+	Insert:   insert.Call,
+	Update:   update.Call,
+	Deletion: deletion.Call,
 
 	MergeTop:    mergetop.Call,
 	MergeLimit:  mergelimit.Call,
@@ -30,6 +30,16 @@ const (
 	Connector
 	Projection
 	Complement
+	Sample
+	MergeJoin
+	IndexJoin
+	Right
+	Semi
+	Mark
+
+	Insert
+	Update
+	Deletion
 
 	MergeTop
 	MergeLimit
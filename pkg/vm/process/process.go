@@ -15,17 +15,24 @@
 package process
 
 import (
+	"time"
+
 	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
 )
 
+// DefaultMaxRecursionDepth is the default max iterations of a recursive cte
+const DefaultMaxRecursionDepth = 1000
+
 // New creates a new Process.
 // A process stores the execution context.
 func New(m *mheap.Mheap) *Process {
 	return &Process{
-		Mp: m,
+		Mp:       m,
+		Lim:      Limitation{MaxRecursionDepth: DefaultMaxRecursionDepth},
+		TimeZone: time.Local,
 	}
 }
 
@@ -16,6 +16,7 @@ package process
 
 import (
 	"context"
+	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
@@ -53,6 +54,10 @@ type Limitation struct {
 	BatchSize int64
 	// PartitionRows, max rows for partition.
 	PartitionRows int64
+	// MaxRecursionDepth, max iterations of a recursive cte.
+	MaxRecursionDepth int64
+	// ExternalDataDir, the directory the files of the external tables must be in.
+	ExternalDataDir string
 }
 
 // Process contains context used in query execution
@@ -68,6 +73,10 @@ type Process struct {
 	// unix timestamp
 	UnixTime int64
 
+	// TimeZone is the time zone of the session, timestamp values are
+	// converted from and to it
+	TimeZone *time.Location
+
 	// snapshot is transaction context
 	Snapshot []byte
 